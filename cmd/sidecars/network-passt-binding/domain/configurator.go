@@ -30,6 +30,7 @@ import (
 
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/network/flowexporter"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
 
@@ -45,6 +46,7 @@ type linkFinder interface {
 type NetworkConfiguratorOptions struct {
 	IstioProxyInjectionEnabled bool
 	UseVirtioTransitional      bool
+	FlowExporterEnabled        bool
 }
 
 type PasstNetworkConfigurator struct {
@@ -181,6 +183,10 @@ func (p PasstNetworkConfigurator) generatePortForward() []domainschema.Interface
 		}
 	}
 
+	if p.options.FlowExporterEnabled {
+		tcpPortsRange = append(tcpPortsRange, domainschema.InterfacePortForwardRange{Start: flowexporter.MetricsPort, Exclude: "yes"})
+	}
+
 	const (
 		protoTCP = "tcp"
 		protoUDP = "udp"
@@ -29,6 +29,7 @@ import (
 
 	"kubevirt.io/kubevirt/cmd/sidecars/network-passt-binding/domain"
 
+	"kubevirt.io/kubevirt/pkg/network/flowexporter"
 	domainschema "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -266,6 +267,20 @@ var _ = Describe("pod network configurator", func() {
 						}}},
 				},
 			),
+			Entry("flow exporter enabled",
+				&domain.NetworkConfiguratorOptions{FlowExporterEnabled: true},
+				&domainschema.Interface{
+					Alias:   domainschema.NewUserDefinedAlias("default"),
+					Type:    "user",
+					Source:  domainschema.InterfaceSource{Device: "eth0"},
+					Backend: &domainschema.InterfaceBackend{Type: "passt", LogFile: domain.PasstLogFilePath},
+					Model:   &domainschema.Model{Type: "virtio-non-transitional"},
+					PortForward: []domainschema.InterfacePortForward{
+						{Proto: "tcp", Ranges: []domainschema.InterfacePortForwardRange{
+							{Start: flowexporter.MetricsPort, Exclude: "yes"},
+						}}},
+				},
+			),
 		)
 
 		It("should not override other interfaces", func() {
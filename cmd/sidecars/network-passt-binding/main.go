@@ -20,17 +20,17 @@
 package main
 
 import (
-	"net"
 	"os"
-	"path/filepath"
+	"time"
 
-	"google.golang.org/grpc"
+	"github.com/spf13/pflag"
 
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/hooks"
-	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
-	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	"kubevirt.io/kubevirt/pkg/hooks/sdk"
+	"kubevirt.io/kubevirt/pkg/network/flowexporter"
+	"kubevirt.io/kubevirt/pkg/network/netbinding/sidecarconfig"
 
 	srv "kubevirt.io/kubevirt/cmd/sidecars/network-passt-binding/server"
 )
@@ -38,20 +38,38 @@ import (
 const hookSocket = "passt.sock"
 
 func main() {
-	socketPath := filepath.Join(hooks.HookSocketsSharedDirectory, hookSocket)
-	socket, err := net.Listen("unix", socketPath)
+	var shutdownTimeout time.Duration
+	var configPath string
+	pflag.DurationVar(&shutdownTimeout, "shutdown-timeout", hooks.ShutdownTimeout(),
+		"time given to the hook calls in flight to complete on shutdown, before the sidecar stops forcibly")
+	pflag.StringVar(&configPath, "config", sidecarconfig.DefaultPath, "path of the configuration file of the sidecar")
+	pflag.Parse()
+
+	config, err := sidecarconfig.Load(configPath)
 	if err != nil {
-		log.Log.Reason(err).Errorf("Failed to initialized socket on path: %s", socket)
-		log.Log.Error("Check whether given directory exists and socket name is not already taken by other file")
+		log.Log.Reason(err).Error("Failed to load the configuration")
 		os.Exit(1)
 	}
-	defer os.Remove(socketPath)
-
-	server := grpc.NewServer([]grpc.ServerOption{}...)
-	hooksInfo.RegisterInfoServer(server, srv.InfoServer{Version: "v1alpha3"})
+	if err := config.SetLogVerbosity(); err != nil {
+		log.Log.Reason(err).Error("Failed to set the log verbosity")
+		os.Exit(1)
+	}
+	if config.DHCP != nil {
+		log.Log.Warning("The DHCP options are not supported by the passt binding, ignoring them")
+	}
 
-	shutdownChan := make(chan struct{})
-	hooksV1alpha3.RegisterCallbacksServer(server, srv.V1alpha3Server{Done: shutdownChan})
-	log.Log.Infof("passt sidecar is now exposing its services on socket %s using %q API version", socketPath, "v1alpha3")
-	srv.Serve(server, socket, shutdownChan)
+	flowExporter := flowexporter.NewLauncher()
+	mutator := srv.DomainMutator{
+		FlowExporter: flowExporter,
+		Config:       config,
+	}
+	err = sdk.NewSidecar("network-passt-binding", mutator.Mutate).
+		WithSocketName(hookSocket).
+		WithShutdownTimeout(shutdownTimeout).
+		Run()
+	flowExporter.Stop()
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to run the passt sidecar")
+		os.Exit(1)
+	}
 }
@@ -21,58 +21,28 @@ package server
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net"
-	"os"
-	"os/signal"
 	"strings"
-	"syscall"
-
-	"google.golang.org/grpc"
 
 	vmschema "kubevirt.io/api/core/v1"
 
-	"kubevirt.io/client-go/log"
-
-	"kubevirt.io/kubevirt/cmd/sidecars/network-passt-binding/callback"
 	"kubevirt.io/kubevirt/cmd/sidecars/network-passt-binding/domain"
 
-	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
-	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	"kubevirt.io/kubevirt/pkg/network/flowexporter"
+	"kubevirt.io/kubevirt/pkg/network/netbinding/sidecarconfig"
+	domainschema "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
-type InfoServer struct {
-	Version string
-}
-
-func (s InfoServer) Info(_ context.Context, _ *hooksInfo.InfoParams) (*hooksInfo.InfoResult, error) {
-	return &hooksInfo.InfoResult{
-		Name: "network-passt-binding",
-		Versions: []string{
-			s.Version,
-		},
-		HookPoints: []*hooksInfo.HookPoint{
-			{
-				Name:     hooksInfo.OnDefineDomainHookPointName,
-				Priority: 0,
-			},
-			{
-				Name:     hooksInfo.ShutdownHookPointName,
-				Priority: 0,
-			},
-		},
-	}, nil
+// DomainMutator configures the passt interfaces of the domain, it is the sdk.DomainMutator of
+// the sidecar
+type DomainMutator struct {
+	FlowExporter *flowexporter.Launcher
+	Config       *sidecarconfig.Config
 }
 
-type V1alpha3Server struct {
-	Done chan struct{}
-}
-
-func (s V1alpha3Server) OnDefineDomain(_ context.Context, params *hooksV1alpha3.OnDefineDomainParams) (*hooksV1alpha3.OnDefineDomainResult, error) {
-	vmi := &vmschema.VirtualMachineInstance{}
-	if err := json.Unmarshal(params.GetVmi(), vmi); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal VMI: %v", err)
+func (m DomainMutator) Mutate(_ context.Context, vmi *vmschema.VirtualMachineInstance, domainSpec *domainschema.DomainSpec) (*domainschema.DomainSpec, error) {
+	if err := m.Config.SetMACAddresses(vmi); err != nil {
+		return nil, err
 	}
 
 	useVirtioTransitional := vmi.Spec.Domain.Devices.UseVirtioTransitional != nil && *vmi.Spec.Domain.Devices.UseVirtioTransitional
@@ -86,64 +56,17 @@ func (s V1alpha3Server) OnDefineDomain(_ context.Context, params *hooksV1alpha3.
 	opts := domain.NetworkConfiguratorOptions{
 		UseVirtioTransitional:      useVirtioTransitional,
 		IstioProxyInjectionEnabled: istioProxyInjectionEnabled,
+		FlowExporterEnabled:        flowexporter.IsEnabled(vmi),
 	}
 
-	passtConfigurator, err := domain.NewPasstNetworkConfigurator(vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks, opts, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create passt configurator: %v", err)
+	if err := m.FlowExporter.Start(vmi); err != nil {
+		return nil, fmt.Errorf("failed to start the flow exporter: %v", err)
 	}
 
-	newDomainXML, err := callback.OnDefineDomain(params.GetDomainXML(), passtConfigurator)
+	passtConfigurator, err := domain.NewPasstNetworkConfigurator(vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks, opts, nil)
 	if err != nil {
-		return nil, err
-	}
-
-	return &hooksV1alpha3.OnDefineDomainResult{
-		DomainXML: newDomainXML,
-	}, nil
-}
-
-func (s V1alpha3Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha3.PreCloudInitIsoParams) (*hooksV1alpha3.PreCloudInitIsoResult, error) {
-	return &hooksV1alpha3.PreCloudInitIsoResult{
-		CloudInitData: params.GetCloudInitData(),
-	}, nil
-}
-
-func (s V1alpha3Server) Shutdown(_ context.Context, _ *hooksV1alpha3.ShutdownParams) (*hooksV1alpha3.ShutdownResult, error) {
-	log.Log.Info("Shutdown passt network binding")
-	s.Done <- struct{}{}
-	return &hooksV1alpha3.ShutdownResult{}, nil
-}
-
-func waitForShutdown(server *grpc.Server, errChan <-chan error, shutdownChan <-chan struct{}) {
-	// Handle signals to properly shutdown process
-	signalStopChan := make(chan os.Signal, 1)
-	signal.Notify(signalStopChan, os.Interrupt,
-		syscall.SIGHUP,
-		syscall.SIGINT,
-		syscall.SIGTERM,
-		syscall.SIGQUIT,
-	)
-	var err error
-	select {
-	case s := <-signalStopChan:
-		log.Log.Infof("passt sidecar received signal: %s", s.String())
-	case err = <-errChan:
-		log.Log.Reason(err).Error("Failed to run grpc server")
-	case <-shutdownChan:
-		log.Log.Info("Exiting")
-	}
-
-	if err == nil {
-		server.GracefulStop()
+		return nil, fmt.Errorf("failed to create passt configurator: %v", err)
 	}
-}
-
-func Serve(server *grpc.Server, socket net.Listener, shutdownChan <-chan struct{}) {
-	errChan := make(chan error)
-	go func() {
-		errChan <- server.Serve(socket)
-	}()
 
-	waitForShutdown(server, errChan, shutdownChan)
+	return passtConfigurator.Mutate(domainSpec)
 }
@@ -20,15 +20,13 @@
 package main
 
 import (
-	"net"
 	"os"
-	"path/filepath"
 
-	"google.golang.org/grpc"
+	"github.com/spf13/pflag"
 
-	"kubevirt.io/kubevirt/pkg/hooks"
-	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
-	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
+	"kubevirt.io/kubevirt/pkg/hooks/sdk"
+	"kubevirt.io/kubevirt/pkg/network/flowexporter"
+	"kubevirt.io/kubevirt/pkg/network/netbinding/sidecarconfig"
 
 	"kubevirt.io/client-go/log"
 
@@ -37,25 +35,40 @@ import (
 )
 
 func main() {
-	searchDomains, err := dns.ReadResolvConfSearchDomains()
+	var configPath string
+	pflag.StringVar(&configPath, "config", sidecarconfig.DefaultPath, "path of the configuration file of the sidecar")
+	pflag.Parse()
+
+	config, err := sidecarconfig.Load(configPath)
 	if err != nil {
+		log.Log.Reason(err).Error("Failed to load the configuration")
+		os.Exit(1)
+	}
+	if err := config.SetLogVerbosity(); err != nil {
+		log.Log.Reason(err).Error("Failed to set the log verbosity")
+		os.Exit(1)
+	}
+
+	var searchDomains []string
+	if config.DHCP != nil && len(config.DHCP.DNSSearchDomains) > 0 {
+		searchDomains = config.DHCP.DNSSearchDomains
+	} else if searchDomains, err = dns.ReadResolvConfSearchDomains(); err != nil {
 		log.Log.Errorf("failed to read resolv.conf search domains: %v", err)
 		os.Exit(1)
 	}
 
-	socketPath := filepath.Join(hooks.HookSocketsSharedDirectory, "slirp.sock")
-	socket, err := net.Listen("unix", socketPath)
+	flowExporter := flowexporter.NewLauncher()
+	mutator := srv.DomainMutator{
+		SearchDomains: searchDomains,
+		FlowExporter:  flowExporter,
+		Config:        config,
+	}
+	err = sdk.NewSidecar("network-slirp-binding", mutator.Mutate).
+		WithSocketName("slirp.sock").
+		Run()
+	flowExporter.Stop()
 	if err != nil {
-		log.Log.Reason(err).Errorf("Failed to initialized socket on path: %s", socket)
-		log.Log.Error("Check whether given directory exists and socket name is not already taken by other file")
+		log.Log.Reason(err).Error("Failed to run the slirp sidecar")
 		os.Exit(1)
 	}
-	defer os.Remove(socketPath)
-
-	server := grpc.NewServer([]grpc.ServerOption{}...)
-	hooksInfo.RegisterInfoServer(server, srv.InfoServer{Version: "v1alpha2"})
-	hooksV1alpha2.RegisterCallbacksServer(server, srv.V1alpha2Server{SearchDomains: searchDomains})
-
-	log.Log.Infof("Starting hook server exposing 'info' and '%s' services on socket %q", socketPath, "v1alpha2")
-	server.Serve(socket)
 }
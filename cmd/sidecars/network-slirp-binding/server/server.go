@@ -21,72 +21,38 @@ package server
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	vmschema "kubevirt.io/api/core/v1"
 
-	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/network/flowexporter"
+	"kubevirt.io/kubevirt/pkg/network/netbinding/sidecarconfig"
+	domainschema "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 
-	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
-	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
-
-	"kubevirt.io/kubevirt/cmd/sidecars/network-slirp-binding/callback"
 	"kubevirt.io/kubevirt/cmd/sidecars/network-slirp-binding/domain"
 )
 
-type InfoServer struct {
-	Version string
-}
-
-func (s InfoServer) Info(_ context.Context, _ *hooksInfo.InfoParams) (*hooksInfo.InfoResult, error) {
-	log.Log.Infof("Info method has been called")
-
-	return &hooksInfo.InfoResult{
-		Name: "network-slirp-binding",
-		Versions: []string{
-			s.Version,
-		},
-		HookPoints: []*hooksInfo.HookPoint{
-			{
-				Name:     hooksInfo.OnDefineDomainHookPointName,
-				Priority: 0,
-			},
-		},
-	}, nil
-}
-
-type V1alpha2Server struct {
+// DomainMutator configures the slirp interfaces of the domain, it is the sdk.DomainMutator of
+// the sidecar
+type DomainMutator struct {
 	SearchDomains []string
+	FlowExporter  *flowexporter.Launcher
+	Config        *sidecarconfig.Config
 }
 
-func (s V1alpha2Server) OnDefineDomain(_ context.Context, params *hooksV1alpha2.OnDefineDomainParams) (*hooksV1alpha2.OnDefineDomainResult, error) {
-	log.Log.Info("OnDefineDomain callback method has been called")
-
-	vmi := &vmschema.VirtualMachineInstance{}
-	if err := json.Unmarshal(params.GetVmi(), vmi); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal VMI: %v", err)
+func (m DomainMutator) Mutate(_ context.Context, vmi *vmschema.VirtualMachineInstance, domainSpec *domainschema.DomainSpec) (*domainschema.DomainSpec, error) {
+	if err := m.Config.SetMACAddresses(vmi); err != nil {
+		return nil, err
 	}
 
-	slirpConfigurator, err := domain.NewSlirpNetworkConfigurator(vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks, s.SearchDomains)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create slirp configurator: %v", err)
+	if err := m.FlowExporter.Start(vmi); err != nil {
+		return nil, fmt.Errorf("failed to start the flow exporter: %v", err)
 	}
 
-	newDomainXML, err := callback.OnDefineDomain(params.GetDomainXML(), slirpConfigurator)
+	slirpConfigurator, err := domain.NewSlirpNetworkConfigurator(vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks, m.SearchDomains)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to create slirp configurator: %v", err)
 	}
 
-	return &hooksV1alpha2.OnDefineDomainResult{
-		DomainXML: newDomainXML,
-	}, nil
-}
-
-func (s V1alpha2Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha2.PreCloudInitIsoParams) (*hooksV1alpha2.PreCloudInitIsoResult, error) {
-	log.Log.Info("PreCloudInitIso method has been called")
-
-	return &hooksV1alpha2.PreCloudInitIsoResult{
-		CloudInitData: params.GetCloudInitData(),
-	}, nil
+	return slirpConfigurator.Mutate(domainSpec)
 }
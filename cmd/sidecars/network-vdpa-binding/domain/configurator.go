@@ -0,0 +1,155 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package domain
+
+import (
+	"fmt"
+
+	vmschema "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	domainschema "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+)
+
+const (
+	// VdpaPluginName vDPA binding plugin name should be registered to Kubevirt through Kubevirt CR
+	VdpaPluginName = "vdpa"
+)
+
+type NetworkConfiguratorOptions struct {
+	UseVirtioTransitional bool
+}
+
+// VdpaNetworkConfigurator configures the interfaces bound to the vDPA binding plugin as vDPA
+// interfaces of the domain, backed by the vhost-vdpa devices reported in the network-info of the pod
+type VdpaNetworkConfigurator struct {
+	vmiSpecIfaces []vmschema.Interface
+	devicePaths   map[string]string
+	options       NetworkConfiguratorOptions
+}
+
+func NewVdpaNetworkConfigurator(ifaces []vmschema.Interface, networks []vmschema.Network, networkInfo downwardapi.NetworkInfo, opts NetworkConfiguratorOptions) (*VdpaNetworkConfigurator, error) {
+	vdpaIfaces := vmispec.FilterInterfacesSpec(ifaces, func(iface vmschema.Interface) bool {
+		return iface.Binding != nil && iface.Binding.Name == VdpaPluginName
+	})
+	if len(vdpaIfaces) == 0 {
+		return nil, fmt.Errorf("no interface is set with vDPA network binding plugin")
+	}
+
+	devicePaths := map[string]string{}
+	for _, iface := range networkInfo.Interfaces {
+		if iface.DeviceInfo != nil && iface.DeviceInfo.Vdpa != nil && iface.DeviceInfo.Vdpa.Path != "" {
+			devicePaths[iface.Network] = iface.DeviceInfo.Vdpa.Path
+		}
+	}
+
+	for _, iface := range vdpaIfaces {
+		network := vmispec.LookupNetworkByName(networks, iface.Name)
+		if network == nil {
+			return nil, fmt.Errorf("no network found for interface %q", iface.Name)
+		}
+		if network.Multus == nil || network.Multus.Default {
+			return nil, fmt.Errorf("interface %q is not connected to a secondary Multus network", iface.Name)
+		}
+		if _, exists := devicePaths[iface.Name]; !exists {
+			return nil, fmt.Errorf("no vDPA device found in the network-info of interface %q", iface.Name)
+		}
+		if iface.Model != "" && iface.Model != vmschema.VirtIO {
+			return nil, fmt.Errorf("interface %q model %q is not supported by vDPA, only %q is", iface.Name, iface.Model, vmschema.VirtIO)
+		}
+	}
+
+	return &VdpaNetworkConfigurator{
+		vmiSpecIfaces: vdpaIfaces,
+		devicePaths:   devicePaths,
+		options:       opts,
+	}, nil
+}
+
+func (v VdpaNetworkConfigurator) Mutate(domainSpec *domainschema.DomainSpec) (*domainschema.DomainSpec, error) {
+	domainSpecCopy := domainSpec.DeepCopy()
+	for i := range v.vmiSpecIfaces {
+		generatedIface, err := v.generateInterface(&v.vmiSpecIfaces[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate domain interface spec: %v", err)
+		}
+
+		if iface := lookupIfaceByAliasName(domainSpecCopy.Devices.Interfaces, v.vmiSpecIfaces[i].Name); iface != nil {
+			*iface = *generatedIface
+		} else {
+			domainSpecCopy.Devices.Interfaces = append(domainSpecCopy.Devices.Interfaces, *generatedIface)
+		}
+
+		log.Log.Infof("vdpa interface is added to domain spec successfully: %+v", generatedIface)
+	}
+
+	return domainSpecCopy, nil
+}
+
+func lookupIfaceByAliasName(ifaces []domainschema.Interface, name string) *domainschema.Interface {
+	for i, iface := range ifaces {
+		if iface.Alias != nil && iface.Alias.GetName() == name {
+			return &ifaces[i]
+		}
+	}
+
+	return nil
+}
+
+func (v VdpaNetworkConfigurator) generateInterface(vmiSpecIface *vmschema.Interface) (*domainschema.Interface, error) {
+	var pciAddress *domainschema.Address
+	if vmiSpecIface.PciAddress != "" {
+		var err error
+		pciAddress, err = device.NewPciAddressField(vmiSpecIface.PciAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ifaceModelType := "virtio-non-transitional"
+	if v.options.UseVirtioTransitional {
+		ifaceModelType = "virtio-transitional"
+	}
+
+	var mac *domainschema.MAC
+	if vmiSpecIface.MacAddress != "" {
+		mac = &domainschema.MAC{MAC: vmiSpecIface.MacAddress}
+	}
+
+	var acpi *domainschema.ACPI
+	if vmiSpecIface.ACPIIndex > 0 {
+		acpi = &domainschema.ACPI{Index: uint(vmiSpecIface.ACPIIndex)}
+	}
+
+	const ifaceTypeVdpa = "vdpa"
+	return &domainschema.Interface{
+		Alias:   domainschema.NewUserDefinedAlias(vmiSpecIface.Name),
+		Model:   &domainschema.Model{Type: ifaceModelType},
+		Address: pciAddress,
+		MAC:     mac,
+		ACPI:    acpi,
+		Type:    ifaceTypeVdpa,
+		Source:  domainschema.InterfaceSource{Device: v.devicePaths[vmiSpecIface.Name]},
+	}, nil
+}
@@ -0,0 +1,159 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package domain_test
+
+import (
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vmschema "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/cmd/sidecars/network-vdpa-binding/domain"
+
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
+	domainschema "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	vdpaNetName = "vdpa-net"
+	vdpaPath    = "/dev/vhost-vdpa-0"
+)
+
+var _ = Describe("vdpa network configurator", func() {
+	var (
+		networks    []vmschema.Network
+		networkInfo downwardapi.NetworkInfo
+	)
+
+	BeforeEach(func() {
+		networks = []vmschema.Network{
+			*vmschema.DefaultPodNetwork(),
+			{Name: vdpaNetName, NetworkSource: vmschema.NetworkSource{Multus: &vmschema.MultusNetwork{NetworkName: "vdpa-nad"}}},
+		}
+		networkInfo = newNetworkInfo(vdpaNetName, vdpaPath)
+	})
+
+	DescribeTable("should fail to create configurator given",
+		func(ifaces []vmschema.Interface, networkInfo downwardapi.NetworkInfo) {
+			_, err := domain.NewVdpaNetworkConfigurator(ifaces, networks, networkInfo, domain.NetworkConfiguratorOptions{})
+
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("no interface with vdpa binding plugin",
+			[]vmschema.Interface{{Name: vdpaNetName, Binding: &vmschema.PluginBinding{Name: "no-vdpa"}}},
+			newNetworkInfo(vdpaNetName, vdpaPath),
+		),
+		Entry("no corresponding network",
+			[]vmschema.Interface{{Name: "not-vdpa-net", Binding: &vmschema.PluginBinding{Name: domain.VdpaPluginName}}},
+			newNetworkInfo("not-vdpa-net", vdpaPath),
+		),
+		Entry("interface on the pod network",
+			[]vmschema.Interface{{Name: "default", Binding: &vmschema.PluginBinding{Name: domain.VdpaPluginName}}},
+			newNetworkInfo("default", vdpaPath),
+		),
+		Entry("no vdpa device in the network-info",
+			[]vmschema.Interface{{Name: vdpaNetName, Binding: &vmschema.PluginBinding{Name: domain.VdpaPluginName}}},
+			downwardapi.NetworkInfo{Interfaces: []downwardapi.Interface{{
+				Network:    vdpaNetName,
+				DeviceInfo: &networkv1.DeviceInfo{Type: networkv1.DeviceInfoTypePCI, Pci: &networkv1.PciDevice{PciAddress: "0000:65:00.2"}},
+			}}},
+		),
+		Entry("non virtio model",
+			[]vmschema.Interface{{Name: vdpaNetName, Model: "e1000", Binding: &vmschema.PluginBinding{Name: domain.VdpaPluginName}}},
+			newNetworkInfo(vdpaNetName, vdpaPath),
+		),
+	)
+
+	It("should fail given interface with invalid PCI address", func() {
+		ifaces := []vmschema.Interface{{Name: vdpaNetName, Binding: &vmschema.PluginBinding{Name: domain.VdpaPluginName},
+			PciAddress: "invalid-pci-address"}}
+
+		testMutator, err := domain.NewVdpaNetworkConfigurator(ifaces, networks, networkInfo, domain.NetworkConfiguratorOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = testMutator.Mutate(&domainschema.DomainSpec{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should add a vdpa interface backed by the device of the network-info", func() {
+		ifaces := []vmschema.Interface{
+			{Name: "default", InterfaceBindingMethod: vmschema.InterfaceBindingMethod{Masquerade: &vmschema.InterfaceMasquerade{}}},
+			{
+				Name:       vdpaNetName,
+				Binding:    &vmschema.PluginBinding{Name: domain.VdpaPluginName},
+				MacAddress: "02:02:02:02:02:02",
+				PciAddress: "0000:02:02.0",
+				ACPIIndex:  2,
+			},
+		}
+		domainSpec := &domainschema.DomainSpec{Devices: domainschema.Devices{Interfaces: []domainschema.Interface{
+			{Alias: domainschema.NewUserDefinedAlias("default"), Type: "ethernet"},
+		}}}
+
+		testMutator, err := domain.NewVdpaNetworkConfigurator(ifaces, networks, networkInfo, domain.NetworkConfiguratorOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		mutatedDomainSpec, err := testMutator.Mutate(domainSpec)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(mutatedDomainSpec.Devices.Interfaces).To(Equal([]domainschema.Interface{
+			{Alias: domainschema.NewUserDefinedAlias("default"), Type: "ethernet"},
+			{
+				Alias:   domainschema.NewUserDefinedAlias(vdpaNetName),
+				Type:    "vdpa",
+				Source:  domainschema.InterfaceSource{Device: vdpaPath},
+				Model:   &domainschema.Model{Type: "virtio-non-transitional"},
+				MAC:     &domainschema.MAC{MAC: "02:02:02:02:02:02"},
+				Address: &domainschema.Address{Type: "pci", Domain: "0x0000", Bus: "0x02", Slot: "0x02", Function: "0x0"},
+				ACPI:    &domainschema.ACPI{Index: 2},
+			},
+		}))
+		Expect(domainSpec.Devices.Interfaces).To(HaveLen(1), "the given domain spec should not be mutated")
+	})
+
+	It("should replace the interface of the domain with the same alias", func() {
+		ifaces := []vmschema.Interface{{Name: vdpaNetName, Binding: &vmschema.PluginBinding{Name: domain.VdpaPluginName}}}
+		domainSpec := &domainschema.DomainSpec{Devices: domainschema.Devices{Interfaces: []domainschema.Interface{
+			{Alias: domainschema.NewUserDefinedAlias(vdpaNetName), Type: "ethernet"},
+		}}}
+
+		testMutator, err := domain.NewVdpaNetworkConfigurator(ifaces, networks, networkInfo, domain.NetworkConfiguratorOptions{UseVirtioTransitional: true})
+		Expect(err).ToNot(HaveOccurred())
+		mutatedDomainSpec, err := testMutator.Mutate(domainSpec)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(mutatedDomainSpec.Devices.Interfaces).To(Equal([]domainschema.Interface{{
+			Alias:  domainschema.NewUserDefinedAlias(vdpaNetName),
+			Type:   "vdpa",
+			Source: domainschema.InterfaceSource{Device: vdpaPath},
+			Model:  &domainschema.Model{Type: "virtio-transitional"},
+		}}))
+	})
+})
+
+func newNetworkInfo(networkName, path string) downwardapi.NetworkInfo {
+	return downwardapi.NetworkInfo{Interfaces: []downwardapi.Interface{{
+		Network: networkName,
+		DeviceInfo: &networkv1.DeviceInfo{
+			Type: networkv1.DeviceInfoTypeVDPA,
+			Vdpa: &networkv1.VdpaDevice{ParentDevice: "vdpa:0000:65:00.2", Driver: "vhost", Path: path},
+		},
+	}}}
+}
@@ -13,11 +13,11 @@
  * See the License for the specific language governing permissions and
  * limitations under the License.
  *
- * Copyright 2023 Red Hat, Inc.
+ * Copyright The KubeVirt Authors
  *
  */
 
-package callback_test
+package domain_test
 
 import (
 	"testing"
@@ -25,6 +25,6 @@ import (
 	"kubevirt.io/client-go/testutils"
 )
 
-func TestCallback(t *testing.T) {
+func TestDomain(t *testing.T) {
 	testutils.KubeVirtTestSuiteSetup(t)
 }
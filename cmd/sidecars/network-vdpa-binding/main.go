@@ -0,0 +1,76 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/hooks/sdk"
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
+	"kubevirt.io/kubevirt/pkg/network/netbinding/sidecarconfig"
+
+	srv "kubevirt.io/kubevirt/cmd/sidecars/network-vdpa-binding/server"
+)
+
+const hookSocket = "vdpa.sock"
+
+func main() {
+	var shutdownTimeout time.Duration
+	var configPath, networkInfoPath string
+	pflag.DurationVar(&shutdownTimeout, "shutdown-timeout", hooks.ShutdownTimeout(),
+		"time given to the hook calls in flight to complete on shutdown, before the sidecar stops forcibly")
+	pflag.StringVar(&configPath, "config", sidecarconfig.DefaultPath, "path of the configuration file of the sidecar")
+	pflag.StringVar(&networkInfoPath, "network-info", filepath.Join(downwardapi.MountPath, downwardapi.NetworkInfoVolumePath),
+		"path of the network-info annotation of the pod, mounted by the downward API")
+	pflag.Parse()
+
+	config, err := sidecarconfig.Load(configPath)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to load the configuration")
+		os.Exit(1)
+	}
+	if err := config.SetLogVerbosity(); err != nil {
+		log.Log.Reason(err).Error("Failed to set the log verbosity")
+		os.Exit(1)
+	}
+	if config.DHCP != nil {
+		log.Log.Warning("The DHCP options are not supported by the vdpa binding, ignoring them")
+	}
+
+	mutator := srv.DomainMutator{
+		Config:          config,
+		NetworkInfoPath: networkInfoPath,
+	}
+	err = sdk.NewSidecar("network-vdpa-binding", mutator.Mutate).
+		WithSocketName(hookSocket).
+		WithShutdownTimeout(shutdownTimeout).
+		Run()
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to run the vdpa sidecar")
+		os.Exit(1)
+	}
+}
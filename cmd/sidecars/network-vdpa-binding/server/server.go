@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	vmschema "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/cmd/sidecars/network-vdpa-binding/domain"
+
+	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
+	"kubevirt.io/kubevirt/pkg/network/netbinding/sidecarconfig"
+	domainschema "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	networkInfoPollInterval = 100 * time.Millisecond
+	networkInfoPollTimeout  = 10 * time.Second
+)
+
+// DomainMutator configures the vDPA interfaces of the domain, it is the sdk.DomainMutator of
+// the sidecar
+type DomainMutator struct {
+	Config *sidecarconfig.Config
+	// NetworkInfoPath is the network-info annotation of the pod, mounted by the downward API. It
+	// holds the device info of the network-status annotation of Multus, vhost-vdpa paths included.
+	NetworkInfoPath string
+}
+
+func (m DomainMutator) Mutate(_ context.Context, vmi *vmschema.VirtualMachineInstance, domainSpec *domainschema.DomainSpec) (*domainschema.DomainSpec, error) {
+	if err := m.Config.SetMACAddresses(vmi); err != nil {
+		return nil, err
+	}
+
+	networkInfo, err := m.readNetworkInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := domain.NetworkConfiguratorOptions{
+		UseVirtioTransitional: vmi.Spec.Domain.Devices.UseVirtioTransitional != nil && *vmi.Spec.Domain.Devices.UseVirtioTransitional,
+	}
+	vdpaConfigurator, err := domain.NewVdpaNetworkConfigurator(vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks, networkInfo, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vdpa configurator: %v", err)
+	}
+
+	return vdpaConfigurator.Mutate(domainSpec)
+}
+
+// readNetworkInfo waits for the network-info file to be populated, the annotation is set once
+// Multus reported the network-status of the pod
+func (m DomainMutator) readNetworkInfo() (downwardapi.NetworkInfo, error) {
+	var networkInfo downwardapi.NetworkInfo
+	var data []byte
+	err := virtwait.PollImmediately(networkInfoPollInterval, networkInfoPollTimeout, func(_ context.Context) (bool, error) {
+		var err error
+		data, err = os.ReadFile(m.NetworkInfoPath)
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return len(data) > 0, err
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return networkInfo, fmt.Errorf("%s is not populated with network-info, is the binding plugin registered with the device-info downward API?", m.NetworkInfoPath)
+	}
+	if err != nil {
+		return networkInfo, fmt.Errorf("failed to read network-info: %v", err)
+	}
+
+	if err := json.Unmarshal(data, &networkInfo); err != nil {
+		return networkInfo, fmt.Errorf("failed to unmarshal network-info: %v", err)
+	}
+	return networkInfo, nil
+}
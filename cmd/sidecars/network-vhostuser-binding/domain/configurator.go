@@ -0,0 +1,183 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package domain
+
+import (
+	"fmt"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	vmschema "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	domainschema "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+)
+
+const (
+	// VhostuserPluginName vhost-user binding plugin name should be registered to Kubevirt through Kubevirt CR
+	VhostuserPluginName = "vhostuser"
+)
+
+type NetworkConfiguratorOptions struct {
+	UseVirtioTransitional bool
+	// Hugepages is set when the memory of the VMI is backed by hugepages, the userspace dataplane
+	// maps the memory of the guest through the shared memory of the domain
+	Hugepages bool
+}
+
+// VhostuserNetworkConfigurator configures the interfaces bound to the vhost-user binding plugin as
+// vhost-user interfaces of the domain, connected to the sockets of a userspace dataplane (e.g.
+// OVS-DPDK or VPP) reported in the network-info of the pod
+type VhostuserNetworkConfigurator struct {
+	vmiSpecIfaces []vmschema.Interface
+	devices       map[string]networkv1.VhostDevice
+	options       NetworkConfiguratorOptions
+}
+
+func NewVhostuserNetworkConfigurator(ifaces []vmschema.Interface, networks []vmschema.Network, networkInfo downwardapi.NetworkInfo, opts NetworkConfiguratorOptions) (*VhostuserNetworkConfigurator, error) {
+	vhostuserIfaces := vmispec.FilterInterfacesSpec(ifaces, func(iface vmschema.Interface) bool {
+		return iface.Binding != nil && iface.Binding.Name == VhostuserPluginName
+	})
+	if len(vhostuserIfaces) == 0 {
+		return nil, fmt.Errorf("no interface is set with vhost-user network binding plugin")
+	}
+	if !opts.Hugepages {
+		return nil, fmt.Errorf("vhost-user interfaces require the memory of the VMI to be backed by hugepages")
+	}
+
+	devices := map[string]networkv1.VhostDevice{}
+	for _, iface := range networkInfo.Interfaces {
+		if iface.DeviceInfo != nil && iface.DeviceInfo.VhostUser != nil && iface.DeviceInfo.VhostUser.Path != "" {
+			devices[iface.Network] = *iface.DeviceInfo.VhostUser
+		}
+	}
+
+	for _, iface := range vhostuserIfaces {
+		network := vmispec.LookupNetworkByName(networks, iface.Name)
+		if network == nil {
+			return nil, fmt.Errorf("no network found for interface %q", iface.Name)
+		}
+		if network.Multus == nil || network.Multus.Default {
+			return nil, fmt.Errorf("interface %q is not connected to a secondary Multus network", iface.Name)
+		}
+		vhostDevice, exists := devices[iface.Name]
+		if !exists {
+			return nil, fmt.Errorf("no vhost-user socket found in the network-info of interface %q", iface.Name)
+		}
+		if vhostDevice.Mode != networkv1.VhostDeviceModeClient && vhostDevice.Mode != networkv1.VhostDeviceModeServer {
+			return nil, fmt.Errorf("interface %q vhost-user mode %q is not supported, only %q or %q are",
+				iface.Name, vhostDevice.Mode, networkv1.VhostDeviceModeClient, networkv1.VhostDeviceModeServer)
+		}
+		if iface.Model != "" && iface.Model != vmschema.VirtIO {
+			return nil, fmt.Errorf("interface %q model %q is not supported by vhost-user, only %q is", iface.Name, iface.Model, vmschema.VirtIO)
+		}
+	}
+
+	return &VhostuserNetworkConfigurator{
+		vmiSpecIfaces: vhostuserIfaces,
+		devices:       devices,
+		options:       opts,
+	}, nil
+}
+
+func (v VhostuserNetworkConfigurator) Mutate(domainSpec *domainschema.DomainSpec) (*domainschema.DomainSpec, error) {
+	domainSpecCopy := domainSpec.DeepCopy()
+	for i := range v.vmiSpecIfaces {
+		generatedIface, err := v.generateInterface(&v.vmiSpecIfaces[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate domain interface spec: %v", err)
+		}
+
+		if iface := lookupIfaceByAliasName(domainSpecCopy.Devices.Interfaces, v.vmiSpecIfaces[i].Name); iface != nil {
+			*iface = *generatedIface
+		} else {
+			domainSpecCopy.Devices.Interfaces = append(domainSpecCopy.Devices.Interfaces, *generatedIface)
+		}
+
+		log.Log.Infof("vhostuser interface is added to domain spec successfully: %+v", generatedIface)
+	}
+
+	// The dataplane accesses the memory of the guest directly, it has to be shared with it
+	if domainSpecCopy.MemoryBacking == nil {
+		domainSpecCopy.MemoryBacking = &domainschema.MemoryBacking{}
+	}
+	domainSpecCopy.MemoryBacking.Access = &domainschema.MemoryBackingAccess{Mode: "shared"}
+
+	return domainSpecCopy, nil
+}
+
+func lookupIfaceByAliasName(ifaces []domainschema.Interface, name string) *domainschema.Interface {
+	for i, iface := range ifaces {
+		if iface.Alias != nil && iface.Alias.GetName() == name {
+			return &ifaces[i]
+		}
+	}
+
+	return nil
+}
+
+func (v VhostuserNetworkConfigurator) generateInterface(vmiSpecIface *vmschema.Interface) (*domainschema.Interface, error) {
+	var pciAddress *domainschema.Address
+	if vmiSpecIface.PciAddress != "" {
+		var err error
+		pciAddress, err = device.NewPciAddressField(vmiSpecIface.PciAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ifaceModelType := "virtio-non-transitional"
+	if v.options.UseVirtioTransitional {
+		ifaceModelType = "virtio-transitional"
+	}
+
+	var mac *domainschema.MAC
+	if vmiSpecIface.MacAddress != "" {
+		mac = &domainschema.MAC{MAC: vmiSpecIface.MacAddress}
+	}
+
+	var acpi *domainschema.ACPI
+	if vmiSpecIface.ACPIIndex > 0 {
+		acpi = &domainschema.ACPI{Index: uint(vmiSpecIface.ACPIIndex)}
+	}
+
+	const (
+		ifaceTypeVhostuser = "vhostuser"
+		sourceTypeUnix     = "unix"
+	)
+	vhostDevice := v.devices[vmiSpecIface.Name]
+	return &domainschema.Interface{
+		Alias:   domainschema.NewUserDefinedAlias(vmiSpecIface.Name),
+		Model:   &domainschema.Model{Type: ifaceModelType},
+		Address: pciAddress,
+		MAC:     mac,
+		ACPI:    acpi,
+		Type:    ifaceTypeVhostuser,
+		Source: domainschema.InterfaceSource{
+			Type: sourceTypeUnix,
+			Path: vhostDevice.Path,
+			Mode: vhostDevice.Mode,
+		},
+	}, nil
+}
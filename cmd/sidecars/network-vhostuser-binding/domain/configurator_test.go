@@ -0,0 +1,192 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package domain_test
+
+import (
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vmschema "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/cmd/sidecars/network-vhostuser-binding/domain"
+
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
+	domainschema "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	vhostuserNetName = "vhostuser-net"
+	vhostuserPath    = "/var/run/vhost/vhu0.sock"
+)
+
+var _ = Describe("vhostuser network configurator", func() {
+	var (
+		networks    []vmschema.Network
+		networkInfo downwardapi.NetworkInfo
+		opts        domain.NetworkConfiguratorOptions
+	)
+
+	BeforeEach(func() {
+		networks = []vmschema.Network{
+			*vmschema.DefaultPodNetwork(),
+			{Name: vhostuserNetName, NetworkSource: vmschema.NetworkSource{Multus: &vmschema.MultusNetwork{NetworkName: "vhostuser-nad"}}},
+		}
+		networkInfo = newNetworkInfo(vhostuserNetName, vhostuserPath, networkv1.VhostDeviceModeServer)
+		opts = domain.NetworkConfiguratorOptions{Hugepages: true}
+	})
+
+	DescribeTable("should fail to create configurator given",
+		func(ifaces []vmschema.Interface, networkInfo downwardapi.NetworkInfo) {
+			_, err := domain.NewVhostuserNetworkConfigurator(ifaces, networks, networkInfo, opts)
+
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("no interface with vhostuser binding plugin",
+			[]vmschema.Interface{{Name: vhostuserNetName, Binding: &vmschema.PluginBinding{Name: "no-vhostuser"}}},
+			newNetworkInfo(vhostuserNetName, vhostuserPath, networkv1.VhostDeviceModeServer),
+		),
+		Entry("no corresponding network",
+			[]vmschema.Interface{{Name: "not-vhostuser-net", Binding: &vmschema.PluginBinding{Name: domain.VhostuserPluginName}}},
+			newNetworkInfo("not-vhostuser-net", vhostuserPath, networkv1.VhostDeviceModeServer),
+		),
+		Entry("interface on the pod network",
+			[]vmschema.Interface{{Name: "default", Binding: &vmschema.PluginBinding{Name: domain.VhostuserPluginName}}},
+			newNetworkInfo("default", vhostuserPath, networkv1.VhostDeviceModeServer),
+		),
+		Entry("no vhost-user socket in the network-info",
+			[]vmschema.Interface{{Name: vhostuserNetName, Binding: &vmschema.PluginBinding{Name: domain.VhostuserPluginName}}},
+			downwardapi.NetworkInfo{Interfaces: []downwardapi.Interface{{
+				Network:    vhostuserNetName,
+				DeviceInfo: &networkv1.DeviceInfo{Type: networkv1.DeviceInfoTypePCI, Pci: &networkv1.PciDevice{PciAddress: "0000:65:00.2"}},
+			}}},
+		),
+		Entry("unknown vhost-user mode",
+			[]vmschema.Interface{{Name: vhostuserNetName, Binding: &vmschema.PluginBinding{Name: domain.VhostuserPluginName}}},
+			newNetworkInfo(vhostuserNetName, vhostuserPath, "master"),
+		),
+		Entry("non virtio model",
+			[]vmschema.Interface{{Name: vhostuserNetName, Model: "e1000", Binding: &vmschema.PluginBinding{Name: domain.VhostuserPluginName}}},
+			newNetworkInfo(vhostuserNetName, vhostuserPath, networkv1.VhostDeviceModeServer),
+		),
+	)
+
+	It("should fail to create configurator given VMI without hugepages", func() {
+		ifaces := []vmschema.Interface{{Name: vhostuserNetName, Binding: &vmschema.PluginBinding{Name: domain.VhostuserPluginName}}}
+
+		_, err := domain.NewVhostuserNetworkConfigurator(ifaces, networks, networkInfo, domain.NetworkConfiguratorOptions{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail given interface with invalid PCI address", func() {
+		ifaces := []vmschema.Interface{{Name: vhostuserNetName, Binding: &vmschema.PluginBinding{Name: domain.VhostuserPluginName},
+			PciAddress: "invalid-pci-address"}}
+
+		testMutator, err := domain.NewVhostuserNetworkConfigurator(ifaces, networks, networkInfo, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = testMutator.Mutate(&domainschema.DomainSpec{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should add a vhostuser interface connected to the socket of the network-info", func() {
+		ifaces := []vmschema.Interface{
+			{Name: "default", InterfaceBindingMethod: vmschema.InterfaceBindingMethod{Masquerade: &vmschema.InterfaceMasquerade{}}},
+			{
+				Name:       vhostuserNetName,
+				Binding:    &vmschema.PluginBinding{Name: domain.VhostuserPluginName},
+				MacAddress: "02:02:02:02:02:02",
+				PciAddress: "0000:02:02.0",
+				ACPIIndex:  2,
+			},
+		}
+		domainSpec := &domainschema.DomainSpec{Devices: domainschema.Devices{Interfaces: []domainschema.Interface{
+			{Alias: domainschema.NewUserDefinedAlias("default"), Type: "ethernet"},
+		}}}
+
+		testMutator, err := domain.NewVhostuserNetworkConfigurator(ifaces, networks, networkInfo, opts)
+		Expect(err).ToNot(HaveOccurred())
+		mutatedDomainSpec, err := testMutator.Mutate(domainSpec)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(mutatedDomainSpec.Devices.Interfaces).To(Equal([]domainschema.Interface{
+			{Alias: domainschema.NewUserDefinedAlias("default"), Type: "ethernet"},
+			{
+				Alias:   domainschema.NewUserDefinedAlias(vhostuserNetName),
+				Type:    "vhostuser",
+				Source:  domainschema.InterfaceSource{Type: "unix", Path: vhostuserPath, Mode: networkv1.VhostDeviceModeServer},
+				Model:   &domainschema.Model{Type: "virtio-non-transitional"},
+				MAC:     &domainschema.MAC{MAC: "02:02:02:02:02:02"},
+				Address: &domainschema.Address{Type: "pci", Domain: "0x0000", Bus: "0x02", Slot: "0x02", Function: "0x0"},
+				ACPI:    &domainschema.ACPI{Index: 2},
+			},
+		}))
+		Expect(domainSpec.Devices.Interfaces).To(HaveLen(1), "the given domain spec should not be mutated")
+	})
+
+	It("should replace the interface of the domain with the same alias", func() {
+		ifaces := []vmschema.Interface{{Name: vhostuserNetName, Binding: &vmschema.PluginBinding{Name: domain.VhostuserPluginName}}}
+		domainSpec := &domainschema.DomainSpec{Devices: domainschema.Devices{Interfaces: []domainschema.Interface{
+			{Alias: domainschema.NewUserDefinedAlias(vhostuserNetName), Type: "ethernet"},
+		}}}
+		networkInfo = newNetworkInfo(vhostuserNetName, vhostuserPath, networkv1.VhostDeviceModeClient)
+		opts.UseVirtioTransitional = true
+
+		testMutator, err := domain.NewVhostuserNetworkConfigurator(ifaces, networks, networkInfo, opts)
+		Expect(err).ToNot(HaveOccurred())
+		mutatedDomainSpec, err := testMutator.Mutate(domainSpec)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(mutatedDomainSpec.Devices.Interfaces).To(Equal([]domainschema.Interface{{
+			Alias:  domainschema.NewUserDefinedAlias(vhostuserNetName),
+			Type:   "vhostuser",
+			Source: domainschema.InterfaceSource{Type: "unix", Path: vhostuserPath, Mode: networkv1.VhostDeviceModeClient},
+			Model:  &domainschema.Model{Type: "virtio-transitional"},
+		}}))
+	})
+
+	It("should share the memory of the domain, keeping its hugepages", func() {
+		ifaces := []vmschema.Interface{{Name: vhostuserNetName, Binding: &vmschema.PluginBinding{Name: domain.VhostuserPluginName}}}
+		domainSpec := &domainschema.DomainSpec{MemoryBacking: &domainschema.MemoryBacking{
+			HugePages: &domainschema.HugePages{},
+		}}
+
+		testMutator, err := domain.NewVhostuserNetworkConfigurator(ifaces, networks, networkInfo, opts)
+		Expect(err).ToNot(HaveOccurred())
+		mutatedDomainSpec, err := testMutator.Mutate(domainSpec)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(mutatedDomainSpec.MemoryBacking).To(Equal(&domainschema.MemoryBacking{
+			HugePages: &domainschema.HugePages{},
+			Access:    &domainschema.MemoryBackingAccess{Mode: "shared"},
+		}))
+		Expect(domainSpec.MemoryBacking.Access).To(BeNil(), "the given domain spec should not be mutated")
+	})
+})
+
+func newNetworkInfo(networkName, path, mode string) downwardapi.NetworkInfo {
+	return downwardapi.NetworkInfo{Interfaces: []downwardapi.Interface{{
+		Network: networkName,
+		DeviceInfo: &networkv1.DeviceInfo{
+			Type:      networkv1.DeviceInfoTypeVHostUser,
+			VhostUser: &networkv1.VhostDevice{Mode: mode, Path: path},
+		},
+	}}}
+}
@@ -32,9 +32,12 @@ import (
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"k8s.io/apimachinery/pkg/util/rand"
 
 	virtv1 "kubevirt.io/api/core/v1"
@@ -46,15 +49,25 @@ import (
 	hooksV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/v1alpha1"
 	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
+	hooksidecarmetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/hook-sidecar"
 )
 
 const (
-	onDefineDomainLoggingMessage  = "OnDefineDomain method has been called"
-	preCloudInitIsoLoggingMessage = "PreCloudInitIso method has been called"
-	onShutdownMessage             = "Hook's Shutdown callback method has been called"
-
-	onDefineDomainBin  = "onDefineDomain"
-	preCloudInitIsoBin = "preCloudInitIso"
+	onDefineDomainLoggingMessage    = "OnDefineDomain method has been called"
+	preCloudInitIsoLoggingMessage   = "PreCloudInitIso method has been called"
+	onGuestBootLoggingMessage       = "OnGuestBoot method has been called"
+	onMigrationSourceLoggingMessage = "OnMigrationSource method has been called"
+	onMigrationTargetLoggingMessage = "OnMigrationTarget method has been called"
+	postDomainStartLoggingMessage   = "PostDomainStart method has been called"
+	onShutdownMessage               = "Hook's Shutdown callback method has been called"
+
+	onDefineDomainBin    = "onDefineDomain"
+	preCloudInitIsoBin   = "preCloudInitIso"
+	onGuestBootBin       = "onGuestBoot"
+	onMigrationSourceBin = "onMigrationSource"
+	onMigrationTargetBin = "onMigrationTarget"
+	postDomainStartBin   = "postDomainStart"
 )
 
 type infoServer struct {
@@ -67,6 +80,14 @@ func (s infoServer) Info(ctx context.Context, params *hooksInfo.InfoParams) (*ho
 		hooksInfo.OnDefineDomainHookPointName:  onDefineDomainBin,
 		hooksInfo.PreCloudInitIsoHookPointName: preCloudInitIsoBin,
 	}
+	if s.Version != "v1alpha1" && s.Version != "v1alpha2" && s.Version != "v1alpha3" {
+		supportedHookPoints[hooksInfo.OnGuestBootHookPointName] = onGuestBootBin
+	}
+	if s.Version == hooksV1alpha4.Version {
+		supportedHookPoints[hooksInfo.OnMigrationSourceHookPointName] = onMigrationSourceBin
+		supportedHookPoints[hooksInfo.OnMigrationTargetHookPointName] = onMigrationTargetBin
+		supportedHookPoints[hooksInfo.PostDomainStartHookPointName] = postDomainStartBin
+	}
 	var hookPoints = []*hooksInfo.HookPoint{}
 
 	// Shutdown fixes proper termination of Sidecars. It isn't related to
@@ -104,14 +125,97 @@ func (s infoServer) Info(ctx context.Context, params *hooksInfo.InfoParams) (*ho
 type v1Alpha1Server struct{}
 type v1Alpha2Server struct{}
 type v1Alpha3Server struct {
-	done chan struct{}
+	done            chan struct{}
+	shutdownTimeout time.Duration
+}
+type v1Alpha4Server struct {
+	done            chan struct{}
+	shutdownTimeout time.Duration
+}
+
+func (s v1Alpha4Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha4.OnDefineDomainParams) (*hooksV1alpha4.OnDefineDomainResult, error) {
+	logger := hooks.Logger(ctx)
+	logger.Info(onDefineDomainLoggingMessage)
+	newDomainXML, err := runOnDefineDomain(logger, params.GetVmi(), params.GetDomainXML())
+	if err != nil {
+		logger.Reason(err).Error("Failed OnDefineDomain")
+		return nil, err
+	}
+	return &hooksV1alpha4.OnDefineDomainResult{
+		DomainXML: newDomainXML,
+	}, nil
+}
+
+func (s v1Alpha4Server) PreCloudInitIso(ctx context.Context, params *hooksV1alpha4.PreCloudInitIsoParams) (*hooksV1alpha4.PreCloudInitIsoResult, error) {
+	logger := hooks.Logger(ctx)
+	logger.Info(preCloudInitIsoLoggingMessage)
+	cloudInitData, err := runPreCloudInitIso(logger, params.GetVmi(), params.GetCloudInitData())
+	if err != nil {
+		logger.Reason(err).Error("Failed ProCloudInitIso")
+		return nil, err
+	}
+	return &hooksV1alpha4.PreCloudInitIsoResult{
+		CloudInitData: cloudInitData,
+	}, nil
+}
+
+func (s v1Alpha4Server) OnGuestBoot(ctx context.Context, params *hooksV1alpha4.OnGuestBootParams) (*hooksV1alpha4.OnGuestBootResult, error) {
+	logger := hooks.Logger(ctx)
+	logger.Info(onGuestBootLoggingMessage)
+	if err := runOnGuestBoot(logger, params.GetVmi(), params.GetGuestOSInfo()); err != nil {
+		logger.Reason(err).Error("Failed OnGuestBoot")
+		return nil, err
+	}
+	return &hooksV1alpha4.OnGuestBootResult{}, nil
+}
+
+func (s v1Alpha4Server) OnMigrationSource(ctx context.Context, params *hooksV1alpha4.OnMigrationSourceParams) (*hooksV1alpha4.OnMigrationSourceResult, error) {
+	logger := hooks.Logger(ctx)
+	logger.Info(onMigrationSourceLoggingMessage)
+	newDomainXML, err := runWithDomain(logger, onMigrationSourceBin, params.GetVmi(), params.GetDomainXML())
+	if err != nil {
+		logger.Reason(err).Error("Failed OnMigrationSource")
+		return nil, err
+	}
+	return &hooksV1alpha4.OnMigrationSourceResult{
+		DomainXML: newDomainXML,
+	}, nil
+}
+
+func (s v1Alpha4Server) OnMigrationTarget(ctx context.Context, params *hooksV1alpha4.OnMigrationTargetParams) (*hooksV1alpha4.OnMigrationTargetResult, error) {
+	logger := hooks.Logger(ctx)
+	logger.Info(onMigrationTargetLoggingMessage)
+	if _, err := runWithDomain(logger, onMigrationTargetBin, params.GetVmi(), params.GetDomainXML()); err != nil {
+		logger.Reason(err).Error("Failed OnMigrationTarget")
+		return nil, err
+	}
+	return &hooksV1alpha4.OnMigrationTargetResult{}, nil
+}
+
+func (s v1Alpha4Server) PostDomainStart(ctx context.Context, params *hooksV1alpha4.PostDomainStartParams) (*hooksV1alpha4.PostDomainStartResult, error) {
+	logger := hooks.Logger(ctx)
+	logger.Info(postDomainStartLoggingMessage)
+	if _, err := runWithDomain(logger, postDomainStartBin, params.GetVmi(), params.GetDomainXML()); err != nil {
+		logger.Reason(err).Error("Failed PostDomainStart")
+		return nil, err
+	}
+	return &hooksV1alpha4.PostDomainStartResult{}, nil
+}
+
+func (s v1Alpha4Server) Shutdown(ctx context.Context, _ *hooksV1alpha4.ShutdownParams) (*hooksV1alpha4.ShutdownResult, error) {
+	hooks.Logger(ctx).Info(onShutdownMessage)
+	if err := hooks.NotifyShutdown(ctx, s.done, s.shutdownTimeout); err != nil {
+		return nil, err
+	}
+	return &hooksV1alpha4.ShutdownResult{}, nil
 }
 
-func (s v1Alpha3Server) OnDefineDomain(_ context.Context, params *hooksV1alpha3.OnDefineDomainParams) (*hooksV1alpha3.OnDefineDomainResult, error) {
-	log.Log.Info(onDefineDomainLoggingMessage)
-	newDomainXML, err := runOnDefineDomain(params.GetVmi(), params.GetDomainXML())
+func (s v1Alpha3Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha3.OnDefineDomainParams) (*hooksV1alpha3.OnDefineDomainResult, error) {
+	logger := hooks.Logger(ctx)
+	logger.Info(onDefineDomainLoggingMessage)
+	newDomainXML, err := runOnDefineDomain(logger, params.GetVmi(), params.GetDomainXML())
 	if err != nil {
-		log.Log.Reason(err).Error("Failed OnDefineDomain")
+		logger.Reason(err).Error("Failed OnDefineDomain")
 		return nil, err
 	}
 	return &hooksV1alpha3.OnDefineDomainResult{
@@ -119,11 +223,12 @@ func (s v1Alpha3Server) OnDefineDomain(_ context.Context, params *hooksV1alpha3.
 	}, nil
 }
 
-func (s v1Alpha3Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha3.PreCloudInitIsoParams) (*hooksV1alpha3.PreCloudInitIsoResult, error) {
-	log.Log.Info(preCloudInitIsoLoggingMessage)
-	cloudInitData, err := runPreCloudInitIso(params.GetVmi(), params.GetCloudInitData())
+func (s v1Alpha3Server) PreCloudInitIso(ctx context.Context, params *hooksV1alpha3.PreCloudInitIsoParams) (*hooksV1alpha3.PreCloudInitIsoResult, error) {
+	logger := hooks.Logger(ctx)
+	logger.Info(preCloudInitIsoLoggingMessage)
+	cloudInitData, err := runPreCloudInitIso(logger, params.GetVmi(), params.GetCloudInitData())
 	if err != nil {
-		log.Log.Reason(err).Error("Failed ProCloudInitIso")
+		logger.Reason(err).Error("Failed ProCloudInitIso")
 		return nil, err
 	}
 	return &hooksV1alpha3.PreCloudInitIsoResult{
@@ -131,17 +236,20 @@ func (s v1Alpha3Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha3
 	}, nil
 }
 
-func (s v1Alpha3Server) Shutdown(_ context.Context, _ *hooksV1alpha3.ShutdownParams) (*hooksV1alpha3.ShutdownResult, error) {
-	log.Log.Info(onShutdownMessage)
-	s.done <- struct{}{}
+func (s v1Alpha3Server) Shutdown(ctx context.Context, _ *hooksV1alpha3.ShutdownParams) (*hooksV1alpha3.ShutdownResult, error) {
+	hooks.Logger(ctx).Info(onShutdownMessage)
+	if err := hooks.NotifyShutdown(ctx, s.done, s.shutdownTimeout); err != nil {
+		return nil, err
+	}
 	return &hooksV1alpha3.ShutdownResult{}, nil
 }
 
 func (s v1Alpha2Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha2.OnDefineDomainParams) (*hooksV1alpha2.OnDefineDomainResult, error) {
-	log.Log.Info(onDefineDomainLoggingMessage)
-	newDomainXML, err := runOnDefineDomain(params.GetVmi(), params.GetDomainXML())
+	logger := hooks.Logger(ctx)
+	logger.Info(onDefineDomainLoggingMessage)
+	newDomainXML, err := runOnDefineDomain(logger, params.GetVmi(), params.GetDomainXML())
 	if err != nil {
-		log.Log.Reason(err).Error("Failed OnDefineDomain")
+		logger.Reason(err).Error("Failed OnDefineDomain")
 		return nil, err
 	}
 	return &hooksV1alpha2.OnDefineDomainResult{
@@ -149,11 +257,12 @@ func (s v1Alpha2Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha
 	}, nil
 }
 
-func (s v1Alpha2Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha2.PreCloudInitIsoParams) (*hooksV1alpha2.PreCloudInitIsoResult, error) {
-	log.Log.Info(preCloudInitIsoLoggingMessage)
-	cloudInitData, err := runPreCloudInitIso(params.GetVmi(), params.GetCloudInitData())
+func (s v1Alpha2Server) PreCloudInitIso(ctx context.Context, params *hooksV1alpha2.PreCloudInitIsoParams) (*hooksV1alpha2.PreCloudInitIsoResult, error) {
+	logger := hooks.Logger(ctx)
+	logger.Info(preCloudInitIsoLoggingMessage)
+	cloudInitData, err := runPreCloudInitIso(logger, params.GetVmi(), params.GetCloudInitData())
 	if err != nil {
-		log.Log.Reason(err).Error("Failed ProCloudInitIso")
+		logger.Reason(err).Error("Failed ProCloudInitIso")
 		return nil, err
 	}
 	return &hooksV1alpha2.PreCloudInitIsoResult{
@@ -162,10 +271,11 @@ func (s v1Alpha2Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha2
 }
 
 func (s v1Alpha1Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha1.OnDefineDomainParams) (*hooksV1alpha1.OnDefineDomainResult, error) {
-	log.Log.Info(onDefineDomainLoggingMessage)
-	newDomainXML, err := runOnDefineDomain(params.GetVmi(), params.GetDomainXML())
+	logger := hooks.Logger(ctx)
+	logger.Info(onDefineDomainLoggingMessage)
+	newDomainXML, err := runOnDefineDomain(logger, params.GetVmi(), params.GetDomainXML())
 	if err != nil {
-		log.Log.Reason(err).Error("Failed OnDefineDomain")
+		logger.Reason(err).Error("Failed OnDefineDomain")
 		return nil, err
 	}
 	return &hooksV1alpha1.OnDefineDomainResult{
@@ -173,7 +283,7 @@ func (s v1Alpha1Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha
 	}, nil
 }
 
-func runPreCloudInitIso(vmiJSON []byte, cloudInitDataJSON []byte) ([]byte, error) {
+func runPreCloudInitIso(logger *log.FilteredLogger, vmiJSON []byte, cloudInitDataJSON []byte) ([]byte, error) {
 	// Check binary exists
 	if _, err := exec.LookPath(preCloudInitIsoBin); err != nil {
 		return nil, fmt.Errorf("Failed in finding %s in $PATH: %v", preCloudInitIsoBin, err)
@@ -195,17 +305,17 @@ func runPreCloudInitIso(vmiJSON []byte, cloudInitDataJSON []byte) ([]byte, error
 		"--vmi", string(vmiJSON),
 		"--cloud-init", string(cloudInitDataJSON))
 
-	log.Log.Infof("Executing %s", preCloudInitIsoBin)
+	logger.Infof("Executing %s", preCloudInitIsoBin)
 	command := exec.Command(preCloudInitIsoBin, args...)
 	if reader, err := command.StderrPipe(); err != nil {
-		log.Log.Reason(err).Infof("Could not pipe stderr")
+		logger.Reason(err).Infof("Could not pipe stderr")
 	} else {
-		go logStderr(reader, "cloudInitData")
+		go logStderr(logger, reader, "cloudInitData")
 	}
 	return command.Output()
 }
 
-func runOnDefineDomain(vmiJSON []byte, domainXML []byte) ([]byte, error) {
+func runOnDefineDomain(logger *log.FilteredLogger, vmiJSON []byte, domainXML []byte) ([]byte, error) {
 	if _, err := exec.LookPath(onDefineDomainBin); err != nil {
 		return nil, fmt.Errorf("Failed in finding %s in $PATH due %v", onDefineDomainBin, err)
 	}
@@ -219,36 +329,90 @@ func runOnDefineDomain(vmiJSON []byte, domainXML []byte) ([]byte, error) {
 		"--vmi", string(vmiJSON),
 		"--domain", string(domainXML))
 
-	log.Log.Infof("Executing %s", onDefineDomainBin)
+	logger.Infof("Executing %s", onDefineDomainBin)
 	command := exec.Command(onDefineDomainBin, args...)
 	if reader, err := command.StderrPipe(); err != nil {
-		log.Log.Reason(err).Infof("Could not pipe stderr")
+		logger.Reason(err).Infof("Could not pipe stderr")
 	} else {
-		go logStderr(reader, "onDefineDomain")
+		go logStderr(logger, reader, "onDefineDomain")
 	}
 	return command.Output()
 }
 
-func logStderr(reader io.Reader, hookName string) {
+func runOnGuestBoot(logger *log.FilteredLogger, vmiJSON []byte, guestOSInfoJSON []byte) error {
+	if _, err := exec.LookPath(onGuestBootBin); err != nil {
+		return fmt.Errorf("Failed in finding %s in $PATH due %v", onGuestBootBin, err)
+	}
+
+	vmiSpec := virtv1.VirtualMachineInstance{}
+	if err := json.Unmarshal(vmiJSON, &vmiSpec); err != nil {
+		return fmt.Errorf("Failed to unmarshal given VMI spec: %s due %v", vmiJSON, err)
+	}
+
+	args := append([]string{},
+		"--vmi", string(vmiJSON),
+		"--guest-os-info", string(guestOSInfoJSON))
+
+	logger.Infof("Executing %s", onGuestBootBin)
+	command := exec.Command(onGuestBootBin, args...)
+	if reader, err := command.StderrPipe(); err != nil {
+		logger.Reason(err).Infof("Could not pipe stderr")
+	} else {
+		go logStderr(logger, reader, "onGuestBoot")
+	}
+	return command.Run()
+}
+
+// runWithDomain runs the binary of a hook point with the VMI and the domain, and returns its output
+func runWithDomain(logger *log.FilteredLogger, bin string, vmiJSON []byte, domainXML []byte) ([]byte, error) {
+	if _, err := exec.LookPath(bin); err != nil {
+		return nil, fmt.Errorf("Failed in finding %s in $PATH due %v", bin, err)
+	}
+
+	vmiSpec := virtv1.VirtualMachineInstance{}
+	if err := json.Unmarshal(vmiJSON, &vmiSpec); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal given VMI spec: %s due %v", vmiJSON, err)
+	}
+
+	args := append([]string{},
+		"--vmi", string(vmiJSON),
+		"--domain", string(domainXML))
+
+	logger.Infof("Executing %s", bin)
+	command := exec.Command(bin, args...)
+	if reader, err := command.StderrPipe(); err != nil {
+		logger.Reason(err).Infof("Could not pipe stderr")
+	} else {
+		go logStderr(logger, reader, bin)
+	}
+	return command.Output()
+}
+
+func logStderr(logger *log.FilteredLogger, reader io.Reader, hookName string) {
 	scanner := bufio.NewScanner(reader)
 	scanner.Buffer(make([]byte, 1024), 512*1024)
 	for scanner.Scan() {
-		log.Log.With("hook", hookName).Info(scanner.Text())
+		logger.With("hook", hookName).Info(scanner.Text())
 	}
 
 	if err := scanner.Err(); err != nil {
-		log.Log.Reason(err).Error("failed to read hook logs")
+		logger.Reason(err).Error("failed to read hook logs")
 	}
 }
 
-func parseCommandLineArgs() (string, error) {
-	supportedVersions := []string{"v1alpha1", "v1alpha2", "v1alpha3"}
+func parseCommandLineArgs() (string, string, time.Duration, error) {
+	supportedVersions := []string{"v1alpha1", "v1alpha2", "v1alpha3", "v1alpha4"}
 	version := ""
+	metricsAddress := ""
+	var shutdownTimeout time.Duration
 
 	pflag.StringVar(&version, "version", "", "hook version to use")
+	pflag.StringVar(&metricsAddress, "metrics-address", "", "address to expose the metrics of the hooks on, e.g. :9090, disabled when empty")
+	pflag.DurationVar(&shutdownTimeout, "shutdown-timeout", hooks.ShutdownTimeout(),
+		"time given to the hook calls in flight to complete on shutdown, before the shim stops forcibly")
 	pflag.Parse()
 	if version == "" {
-		return "", fmt.Errorf("Missing --version parameter. Supported options are %s.", supportedVersions)
+		return "", "", 0, fmt.Errorf("Missing --version parameter. Supported options are %s.", supportedVersions)
 	}
 
 	supported := false
@@ -259,10 +423,10 @@ func parseCommandLineArgs() (string, error) {
 		}
 	}
 	if !supported {
-		return "", fmt.Errorf("Version %s is not supported. Supported options are %s.", version, supportedVersions)
+		return "", "", 0, fmt.Errorf("Version %s is not supported. Supported options are %s.", version, supportedVersions)
 	}
 
-	return version, nil
+	return version, metricsAddress, shutdownTimeout, nil
 }
 
 func getSocketPath() (string, error) {
@@ -288,7 +452,7 @@ func main() {
 	log.InitializeLogging("shim-sidecar")
 
 	// Shim arguments
-	version, err := parseCommandLineArgs()
+	version, metricsAddress, shutdownTimeout, err := parseCommandLineArgs()
 	if err != nil {
 		log.Log.Reason(err).Errorf("Input error")
 		os.Exit(1)
@@ -307,13 +471,32 @@ func main() {
 	}
 	defer os.Remove(socketPath)
 
-	server := grpc.NewServer([]grpc.ServerOption{}...)
+	serverOptions := append(hooks.IdentityServerOptions(), hooks.LoggingServerOptions()...)
+	serverOptions = append(serverOptions, hooks.PeerCredentialsServerOptions()...)
+	if metricsAddress != "" {
+		if err := hooksidecarmetrics.SetupMetrics(); err != nil {
+			log.Log.Reason(err).Errorf("Failed to set up the metrics")
+			os.Exit(1)
+		}
+		serverOptions = append(serverOptions, grpc.ChainUnaryInterceptor(hooksidecarmetrics.UnaryServerInterceptor()))
+		go func() {
+			log.Log.Infof("shim is exposing its metrics on %s", metricsAddress)
+			if err := hooksidecarmetrics.ListenAndServe(metricsAddress); err != nil {
+				log.Log.Reason(err).Error("Failed to serve the metrics")
+			}
+		}()
+	}
+
+	server := grpc.NewServer(serverOptions...)
 	hooksInfo.RegisterInfoServer(server, infoServer{Version: version})
 	hooksV1alpha1.RegisterCallbacksServer(server, v1Alpha1Server{})
 	hooksV1alpha2.RegisterCallbacksServer(server, v1Alpha2Server{})
 
 	shutdownChan := make(chan struct{})
-	hooksV1alpha3.RegisterCallbacksServer(server, v1Alpha3Server{done: shutdownChan})
+	hooksV1alpha3.RegisterCallbacksServer(server, v1Alpha3Server{done: shutdownChan, shutdownTimeout: shutdownTimeout})
+	hooksV1alpha4.RegisterCallbacksServer(server, v1Alpha4Server{done: shutdownChan, shutdownTimeout: shutdownTimeout})
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
 
 	// Handle signals to properly shutdown process
 	signalStopChan := make(chan os.Signal, 1)
@@ -339,7 +522,8 @@ func main() {
 		log.Log.Info("Exiting")
 	}
 
+	healthServer.Shutdown()
 	if err == nil {
-		server.GracefulStop()
+		hooks.StopServer(server, shutdownTimeout)
 	}
 }
@@ -0,0 +1,62 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package main
+
+import (
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/diskcheck/checker"
+)
+
+func main() {
+	log.InitializeLogging("virt-disk-check")
+
+	terminationLog := pflag.String("termination-log", "/dev/termination-log", "File the results of the check are written to")
+	pflag.Parse()
+
+	volumes, err := checker.VolumesFromEnv()
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to get the volumes to check")
+		os.Exit(1)
+	}
+
+	sparsify, err := checker.SparsifyFromEnv()
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to get the options of the rewrite of the images")
+		os.Exit(1)
+	}
+
+	results := checker.NewChecker().WithSparsify(sparsify).CheckVolumes(volumes)
+
+	encoded, err := checker.EncodeResults(results)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to encode the results of the check")
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*terminationLog, encoded, 0644); err != nil {
+		log.Log.Reason(err).Error("Failed to write the results of the check")
+		os.Exit(1)
+	}
+	log.Log.Info("Exiting...")
+}
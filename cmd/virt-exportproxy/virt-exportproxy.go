@@ -21,12 +21,15 @@ package main
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"flag"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httputil"
 	"regexp"
+	"sync"
+	"time"
 
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 
@@ -62,6 +65,10 @@ type exportProxyApp struct {
 	caManager       kvtls.ClientCAManager
 	exportStore     cache.Store
 	kubeVirtStore   cache.Store
+
+	transportLock     sync.Mutex
+	transport         *http.Transport
+	transportCertPool *x509.CertPool
 }
 
 func NewExportProxyApp() service.Service {
@@ -157,16 +164,36 @@ func (app *exportProxyApp) proxyHandler(w http.ResponseWriter, r *http.Request)
 				req.Header.Set("User-Agent", "")
 			}
 		},
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs: certPool,
-			},
-		},
+		Transport: app.proxyTransport(certPool),
 	}
 
 	p.ServeHTTP(w, r)
 }
 
+// proxyTransport returns the transport to the export servers. It is shared by the requests
+// for their connections to be reused, e.g. by the many range requests of a parallel download,
+// and replaced when the CA changes.
+func (app *exportProxyApp) proxyTransport(certPool *x509.CertPool) *http.Transport {
+	app.transportLock.Lock()
+	defer app.transportLock.Unlock()
+
+	if app.transport != nil && app.transportCertPool == certPool {
+		return app.transport
+	}
+	if app.transport != nil {
+		app.transport.CloseIdleConnections()
+	}
+	app.transport = &http.Transport{
+		TLSClientConfig: &tls.Config{
+			RootCAs: certPool,
+		},
+		MaxIdleConnsPerHost: 16,
+		IdleConnTimeout:     90 * time.Second,
+	}
+	app.transportCertPool = certPool
+	return app.transport
+}
+
 func (app *exportProxyApp) prepareInformers(stopChan <-chan struct{}) {
 	namespace, err := clientutil.GetNamespace()
 	if err != nil {
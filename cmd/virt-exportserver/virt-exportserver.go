@@ -46,6 +46,7 @@ func main() {
 		Deadline:   getDeadline(),
 		ListenAddr: getListenAddr(),
 		TokenFile:  getTokenFile(),
+		FIPSMode:   os.Getenv("FIPS_MODE") == "true",
 		Paths:      export.CreateServerPaths(export.EnvironToMap()),
 	}
 	server := exportServer.NewExportServer(config)
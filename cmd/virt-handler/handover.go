@@ -0,0 +1,159 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"kubevirt.io/client-go/log"
+
+	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	virthandler "kubevirt.io/kubevirt/pkg/virt-handler"
+	"kubevirt.io/kubevirt/pkg/virt-handler/handover"
+	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
+)
+
+const (
+	// handoverTimeout bounds the time the outgoing virt-handler takes to stop its controllers
+	handoverTimeout = 2 * time.Minute
+	// devicePluginsHandoverTimeout bounds the time the device plugins take to register again
+	devicePluginsHandoverTimeout = 30 * time.Second
+)
+
+// receiveHandover asks the outgoing virt-handler of the node for its state, and adopts its
+// migration listeners. It returns nil when there is no outgoing virt-handler.
+func (app *virtHandlerApp) receiveHandover(migrationProxy migrationproxy.ProxyManager, hotplugMountStateDir string) *handover.State {
+	state, err := handover.Receive(handover.SocketPath(app.VirtShareDir), handoverTimeout)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to receive the state of the outgoing virt-handler")
+		return nil
+	}
+	if state == nil {
+		return nil
+	}
+	log.Log.Infof("Received %d migration listeners, %d device plugins and %d hotplug mount records from the outgoing virt-handler",
+		len(state.MigrationListeners), len(state.DevicePlugins), len(state.HotplugMountRecords))
+
+	adopted := migrationProxy.AdoptListeners(state.MigrationListeners)
+	metrics.RecordHandoverItems(metrics.HandoverItemMigrationListener, adopted, len(state.MigrationListeners)-adopted)
+
+	records := sets.New(hotplugMountRecords(hotplugMountStateDir)...)
+	adopted = 0
+	for _, record := range state.HotplugMountRecords {
+		if records.Has(record) {
+			adopted++
+		} else {
+			log.Log.Warningf("The hotplug mount record %s handed over is missing", record)
+		}
+	}
+	metrics.RecordHandoverItems(metrics.HandoverItemHotplugMountRecord, adopted, len(state.HotplugMountRecords)-adopted)
+	return state
+}
+
+// checkHandedOverDevicePlugins waits for the device plugins of the outgoing virt-handler to be
+// registered again
+func checkHandedOverDevicePlugins(vmController *virthandler.VirtualMachineController, devicePlugins []string, stop chan struct{}) {
+	var registered sets.Set[string]
+	_ = virtwait.PollImmediately(time.Second, devicePluginsHandoverTimeout, func(_ context.Context) (done bool, err error) {
+		select {
+		case <-stop:
+			return true, nil
+		default:
+		}
+		registered = sets.New(vmController.RegisteredDevicePlugins()...)
+		return registered.HasAll(devicePlugins...), nil
+	})
+
+	adopted := 0
+	for _, name := range devicePlugins {
+		if registered.Has(name) {
+			adopted++
+		} else {
+			log.Log.Warningf("The device plugin %s handed over didn't register again", name)
+		}
+	}
+	metrics.RecordHandoverItems(metrics.HandoverItemDevicePlugin, adopted, len(devicePlugins)-adopted)
+}
+
+// serveHandover serves the state of virt-handler to its successor. Once asked for it, the
+// controllers are stopped and the listeners of the migration proxies are handed over, the
+// migrations in flight keep their connections in this process.
+func (app *virtHandlerApp) serveHandover(
+	vmController *virthandler.VirtualMachineController,
+	migrationProxy migrationproxy.ProxyManager,
+	hotplugMountStateDir string,
+	stopControllers func(),
+	controllersDone <-chan struct{},
+	errCh chan error,
+	stop chan struct{},
+) {
+	release := func() (*handover.State, error) {
+		log.Log.Info("Handing over to the incoming virt-handler")
+		app.handedOver.Store(true)
+		vmController.PrepareHandover()
+		devicePlugins := vmController.RegisteredDevicePlugins()
+
+		migrationProxy.InitiateGracefulShutdown()
+		stopControllers()
+		<-controllersDone
+
+		listeners, err := migrationProxy.HandOverListeners()
+		if err != nil {
+			return nil, err
+		}
+		return &handover.State{
+			MigrationListeners:  listeners,
+			DevicePlugins:       devicePlugins,
+			HotplugMountRecords: hotplugMountRecords(hotplugMountStateDir),
+		}, nil
+	}
+
+	if err := handover.Serve(handover.SocketPath(app.VirtShareDir), release, stop); err != nil {
+		if app.handedOver.Load() {
+			// The controllers are stopped already, restart to let the incoming virt-handler take over
+			errCh <- err
+			return
+		}
+		log.Log.Reason(err).Error("Failed to serve the state for the incoming virt-handler")
+	}
+}
+
+// hotplugMountRecords returns the UIDs of the VMIs with hotplug volumes mounted
+func hotplugMountRecords(hotplugMountStateDir string) []string {
+	entries, err := os.ReadDir(hotplugMountStateDir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Log.Reason(err).Errorf("Failed to list the hotplug mount records in %s", hotplugMountStateDir)
+		}
+		return nil
+	}
+	var records []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			records = append(records, entry.Name())
+		}
+	}
+	return records
+}
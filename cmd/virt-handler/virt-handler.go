@@ -24,12 +24,15 @@ import (
 	"crypto/tls"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -75,13 +78,17 @@ import (
 	"kubevirt.io/kubevirt/pkg/monitoring/profiler"
 	"kubevirt.io/kubevirt/pkg/network/netbinding"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
+	"kubevirt.io/kubevirt/pkg/redaction"
 	"kubevirt.io/kubevirt/pkg/service"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	virthandler "kubevirt.io/kubevirt/pkg/virt-handler"
 	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	deviceinventory "kubevirt.io/kubevirt/pkg/virt-handler/device-inventory"
 	dmetricsmanager "kubevirt.io/kubevirt/pkg/virt-handler/dmetrics-manager"
+	"kubevirt.io/kubevirt/pkg/virt-handler/handover"
+	"kubevirt.io/kubevirt/pkg/virt-handler/hugepages"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	nodelabeller "kubevirt.io/kubevirt/pkg/virt-handler/node-labeller"
@@ -112,6 +119,12 @@ const (
 	// Default period for resyncing virt-launcher domain cache
 	defaultDomainResyncPeriodSeconds = 300
 
+	// Default period for resizing the hugepage pools to the pending VMIs
+	hugepagesPoolSyncPeriod = 30 * time.Second
+
+	// Default period for reporting the host devices of the node
+	deviceInventorySyncPeriod = 60 * time.Second
+
 	// Default seconds to wait for migration connections to terminate before shutting down
 	defaultGracefulShutdownSeconds = 300
 
@@ -129,6 +142,7 @@ type virtHandlerApp struct {
 	service.ServiceListen
 	HostOverride              string
 	PodIpAddress              string
+	PodIpAddresses            []string
 	VirtShareDir              string
 	VirtPrivateDir            string
 	VirtLibDir                string
@@ -144,6 +158,9 @@ type virtHandlerApp struct {
 	customSELinuxPolicyInstalled bool
 	semoduleLock                 sync.Mutex
 
+	// Remember whether we have handed over to an incoming virt-handler, which keeps the node schedulable
+	handedOver atomic.Bool
+
 	caConfigMapName    string
 	clientCertFilePath string
 	clientKeyFilePath  string
@@ -225,7 +242,10 @@ func (app *virtHandlerApp) Run() {
 		panic(err)
 	}
 
-	app.markNodeAsUnschedulable(logger)
+	// An outgoing virt-handler handing over keeps the node schedulable
+	if _, err := os.Stat(handover.SocketPath(app.VirtShareDir)); err != nil {
+		app.markNodeAsUnschedulable(logger)
+	}
 
 	app.namespace, err = clientutil.GetNamespace()
 	if err != nil {
@@ -239,6 +259,10 @@ func (app *virtHandlerApp) Run() {
 
 		<-sigint
 
+		if app.handedOver.Load() {
+			// let the graceful shutdown wait for the migrations in flight
+			return
+		}
 		app.markNodeAsUnschedulable(logger)
 		os.Exit(0)
 	}()
@@ -247,13 +271,21 @@ func (app *virtHandlerApp) Run() {
 	broadcaster := record.NewBroadcaster()
 	broadcaster.StartRecordingToSink(&k8coresv1.EventSinkImpl{Interface: app.virtCli.CoreV1().Events(k8sv1.NamespaceAll)})
 	// Scheme is used to create an ObjectReference from an Object (e.g. VirtualMachineInstance) during Event creation
-	recorder := broadcaster.NewRecorder(scheme.Scheme, k8sv1.EventSource{Component: "virt-handler", Host: app.HostOverride})
+	var recorder record.EventRecorder = broadcaster.NewRecorder(scheme.Scheme, k8sv1.EventSource{Component: "virt-handler", Host: app.HostOverride})
+	redactedRecorder := broadcaster.NewRecorder(scheme.Scheme, k8sv1.EventSource{Component: "virt-handler"})
 
 	// Wire VirtualMachineInstance controller
 	factory := controller.NewKubeInformerFactory(app.virtCli.RestClient(), app.virtCli, nil, app.namespace)
 
 	vmiSourceInformer := factory.VMISourceHost(app.HostOverride)
 	vmiTargetInformer := factory.VMITargetHost(app.HostOverride)
+	vmiUnscheduledInformer := factory.VMIUnscheduled()
+
+	app.clusterConfig, err = virtconfig.NewClusterConfig(factory.CRD(), factory.KubeVirt(), app.namespace)
+	if err != nil {
+		panic(err)
+	}
+	recorder = redaction.NewRecorder(recorder, redactedRecorder, app.clusterConfig, app.virtCli, vmiSourceInformer.GetStore())
 
 	// Wire Domain controller
 	domainSharedInformer := virtcache.NewSharedInformer(app.VirtShareDir, int(app.WatchdogTimeoutDuration.Seconds()), recorder, vmiSourceInformer.GetStore(), time.Duration(app.domainResyncPeriodSeconds)*time.Second)
@@ -277,10 +309,6 @@ func (app *virtHandlerApp) Run() {
 	}
 
 	podIsolationDetector := isolation.NewSocketBasedIsolationDetector(app.VirtShareDir)
-	app.clusterConfig, err = virtconfig.NewClusterConfig(factory.CRD(), factory.KubeVirt(), app.namespace)
-	if err != nil {
-		panic(err)
-	}
 	// set log verbosity
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeLogVerbosity)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeRateLimiter)
@@ -323,6 +351,7 @@ func (app *virtHandlerApp) Run() {
 		app.HostOverride,
 		nodeLabellerrecorder,
 		capabilities.Host.CPU.Counter,
+		nodelabeller.CountPhysicalCores(&capabilities.Host),
 	)
 	if err != nil {
 		panic(err)
@@ -335,8 +364,12 @@ func (app *virtHandlerApp) Run() {
 		go nodeLabellerController.Run(10, stop)
 	}
 
-	migrationIpAddress := app.PodIpAddress
-	migrationIpAddress, err = virthandler.FindMigrationIP(migrationIpAddress)
+	// On dual-stack clusters the pod has an address of each family, the primary one comes first
+	podIpAddresses := app.PodIpAddresses
+	if len(podIpAddresses) == 0 {
+		podIpAddresses = []string{app.PodIpAddress}
+	}
+	migrationIpAddresses, err := virthandler.FindMigrationIPs(podIpAddresses)
 	if err != nil {
 		panic(err)
 	}
@@ -347,13 +380,14 @@ func (app *virtHandlerApp) Run() {
 		recorder,
 		app.virtCli,
 		app.HostOverride,
-		migrationIpAddress,
+		migrationIpAddresses,
 		app.VirtShareDir,
 		app.VirtPrivateDir,
 		app.KubeletPodsDir,
 		vmiSourceInformer,
 		vmiTargetInformer,
 		domainSharedInformer,
+		factory.GuestAgentPolicy(),
 		app.MaxDevices,
 		app.clusterConfig,
 		podIsolationDetector,
@@ -369,6 +403,13 @@ func (app *virtHandlerApp) Run() {
 		panic(err)
 	}
 
+	hugepagesPoolManager := hugepages.NewPoolManager(app.virtCli.CoreV1(), app.clusterConfig, app.HostOverride,
+		vmiSourceInformer.GetStore(), vmiUnscheduledInformer.GetStore())
+
+	deviceInventoryReporter := deviceinventory.NewInventoryReporter(app.virtCli.CoreV1(),
+		app.virtCli.GeneratedKubeVirtClient().HostdevicesV1alpha1(), app.clusterConfig, app.HostOverride,
+		domainSharedInformer.GetStore())
+
 	promErrCh := make(chan error)
 	go app.runPrometheusServer(promErrCh)
 
@@ -407,7 +448,7 @@ func (app *virtHandlerApp) Run() {
 		panic(fmt.Errorf("failed to detect the presence of selinux: %v", err))
 	}
 
-	cache.WaitForCacheSync(stop, vmiSourceInformer.HasSynced, factory.CRD().HasSynced, factory.KubeVirt().HasSynced)
+	cache.WaitForCacheSync(stop, vmiSourceInformer.HasSynced, vmiUnscheduledInformer.HasSynced, factory.CRD().HasSynced, factory.KubeVirt().HasSynced)
 
 	if err := metrics.SetupMetrics(app.VirtShareDir, app.HostOverride, app.MaxRequestsInFlight, vmiSourceInformer); err != nil {
 		panic(err)
@@ -421,7 +462,40 @@ func (app *virtHandlerApp) Run() {
 	// to avoid installing the SELinux policy when the feature gate is set
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldInstallSELinuxPolicy)
 
-	go vmController.Run(10, stop)
+	hotplugMountStateDir := filepath.Join(app.VirtPrivateDir, "hotplug-volume-mount-state")
+	var handedOverState *handover.State
+	if app.clusterConfig.VirtHandlerHandoverEnabled() {
+		handedOverState = app.receiveHandover(migrationProxy, hotplugMountStateDir)
+	}
+
+	// The controllers are stopped apart when handing over to an incoming virt-handler
+	controllerStop := make(chan struct{})
+	stopControllers := sync.OnceFunc(func() { close(controllerStop) })
+	controllersDone := make(chan struct{})
+	go func() {
+		defer close(controllersDone)
+		hugepagesDone := make(chan struct{})
+		go func() {
+			hugepagesPoolManager.Run(hugepagesPoolSyncPeriod, controllerStop)
+			close(hugepagesDone)
+		}()
+		deviceInventoryDone := make(chan struct{})
+		go func() {
+			deviceInventoryReporter.Run(deviceInventorySyncPeriod, controllerStop)
+			close(deviceInventoryDone)
+		}()
+		vmController.Run(10, controllerStop)
+		<-hugepagesDone
+		<-deviceInventoryDone
+	}()
+	go func() {
+		<-stop
+		stopControllers()
+	}()
+
+	if handedOverState != nil {
+		go checkHandedOverDevicePlugins(vmController, handedOverState.DevicePlugins, stop)
+	}
 
 	doneCh := make(chan string)
 	defer close(doneCh)
@@ -435,6 +509,10 @@ func (app *virtHandlerApp) Run() {
 	errCh := make(chan error)
 	go app.runServer(errCh, consoleHandler, lifecycleHandler)
 
+	if app.clusterConfig.VirtHandlerHandoverEnabled() {
+		go app.serveHandover(vmController, migrationProxy, hotplugMountStateDir, stopControllers, controllersDone, errCh, stop)
+	}
+
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt,
 		syscall.SIGHUP,
@@ -573,13 +651,16 @@ func (app *virtHandlerApp) runServer(errCh chan error, consoleHandler *rest.Cons
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestosinfo").To(lifecycleHandler.GetGuestInfo).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestAgentInfo{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/userlist").To(lifecycleHandler.GetUsers).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestOSUserList{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/filesystemlist").To(lifecycleHandler.GetFilesystems).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/metricssnapshot").To(lifecycleHandler.GetMetricsSnapshot).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceMetricsSnapshot{}))
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/devices").To(lifecycleHandler.GetDevices).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceDeviceList{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/vsock").Param(restful.QueryParameter("port", "Target VSOCK port")).To(consoleHandler.VSOCKHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/fetchcertchain").To(lifecycleHandler.SEVFetchCertChainHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVPlatformInfo{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/querylaunchmeasurement").To(lifecycleHandler.SEVQueryLaunchMeasurementHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVMeasurementInfo{}))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/injectlaunchsecret").To(lifecycleHandler.SEVInjectLaunchSecretHandler))
+	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/flatten").To(lifecycleHandler.FlattenHandler).Reads(v1.FlattenOptions{}))
 	restful.DefaultContainer.Add(ws)
 	server := &http.Server{
-		Addr:    fmt.Sprintf("%s:%d", app.ServiceListen.BindAddress, app.consoleServerPort),
+		Addr:    net.JoinHostPort(app.ServiceListen.BindAddress, strconv.Itoa(app.consoleServerPort)),
 		Handler: restful.DefaultContainer,
 		// we use migration TLS also for console connections (initiated by virt-api)
 		TLSConfig:   app.serverTLSConfig,
@@ -602,6 +683,9 @@ func (app *virtHandlerApp) AddFlags() {
 	flag.StringVar(&app.PodIpAddress, "pod-ip-address", podIpAddress,
 		"The pod ip address")
 
+	flag.StringSliceVar(&app.PodIpAddresses, "pod-ip-addresses", nil,
+		"The pod ip addresses of all IP families, the primary one first. Defaults to the pod ip address")
+
 	flag.StringVar(&app.VirtShareDir, "kubevirt-share-dir", util.VirtShareDir,
 		"Shared directory between virt-handler and virt-launcher")
 
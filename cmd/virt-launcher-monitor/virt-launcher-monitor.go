@@ -31,6 +31,8 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -48,6 +50,9 @@ const (
 	httpRequestTimeout                 = 2 * time.Second
 
 	passtLogFile = "/var/run/kubevirt/passt.log" // #nosec G101
+
+	// maxReattachAttempts bounds the restarts of a virt-launcher crashing again once reattached
+	maxReattachAttempts = 3
 )
 
 func cleanupContainerDiskDirectory(ephemeralDiskDir string) {
@@ -113,6 +118,7 @@ func main() {
 	containerDiskDir := pflag.String("container-disk-dir", "/var/run/kubevirt/container-disks", "Base directory for container disk data")
 	keepAfterFailure := pflag.Bool("keep-after-failure", false, "virt-launcher will be kept alive after failure for debugging if set to true")
 	uid := pflag.String("uid", "", "UID of the VirtualMachineInstance")
+	reattachAfterCrash := pflag.Bool("reattach-after-crash", false, "Restart a crashed virt-launcher, which reattaches to the running domain")
 
 	// set new default verbosity, was set to 0 by glog
 	goflag.Set("v", "2")
@@ -132,7 +138,7 @@ func main() {
 		}
 	}
 
-	exitCode, err := RunAndMonitor(*containerDiskDir, *uid)
+	exitCode, err := RunAndMonitor(*containerDiskDir, *uid, *reattachAfterCrash)
 	if *keepAfterFailure && (exitCode != 0 || err != nil) {
 		log.Log.Infof("keeping virt-launcher container alive since --keep-after-failure is set to true")
 		<-make(chan struct{})
@@ -147,8 +153,8 @@ func main() {
 }
 
 // RunAndMonitor run virt-launcher process and monitor it to give qemu an extra grace period to properly terminate
-// in case of crashes
-func RunAndMonitor(containerDiskDir, uid string) (int, error) {
+// in case of crashes. With reattachAfterCrash, a crashed virt-launcher is first restarted to reattach to qemu.
+func RunAndMonitor(containerDiskDir, uid string, reattachAfterCrash bool) (int, error) {
 	defer removeSerialConsoleTermFile(uid)
 	defer cleanupContainerDiskDirectory(containerDiskDir)
 	defer terminateIstioProxy()
@@ -170,14 +176,28 @@ func RunAndMonitor(containerDiskDir, uid string) (int, error) {
 		}
 	}()
 
-	cmd := exec.Command("/usr/bin/virt-launcher", args...)
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		AmbientCaps: []uintptr{unix.CAP_NET_BIND_SERVICE},
+	// The current virt-launcher process, it changes when virt-launcher is restarted
+	var launcherLock sync.Mutex
+	var cmd *exec.Cmd
+	startLauncher := func(args []string) error {
+		launcherLock.Lock()
+		defer launcherLock.Unlock()
+		cmd = exec.Command("/usr/bin/virt-launcher", args...)
+		cmd.SysProcAttr = &syscall.SysProcAttr{
+			AmbientCaps: []uintptr{unix.CAP_NET_BIND_SERVICE},
+		}
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		return cmd.Start()
+	}
+	launcherPid := func() int {
+		launcherLock.Lock()
+		defer launcherLock.Unlock()
+		return cmd.Process.Pid
 	}
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+	var shuttingDown atomic.Bool
 
-	if err := cmd.Start(); err != nil {
+	if err := startLauncher(args); err != nil {
 		log.Log.Reason(err).Error("failed to run virt-launcher")
 		return 1, err
 	}
@@ -196,13 +216,14 @@ func RunAndMonitor(containerDiskDir, uid string) (int, error) {
 				}
 
 				log.Log.Infof("Reaped pid %d with status %d", wpid, int(wstatus))
-				if wpid == cmd.Process.Pid {
+				if wpid == launcherPid() {
 					exitStatus <- wstatus.ExitStatus()
 				}
 
 			default:
 				log.Log.V(3).Log("signalling virt-launcher to shut down")
-				err := cmd.Process.Signal(syscall.SIGTERM)
+				shuttingDown.Store(true)
+				err := syscall.Kill(launcherPid(), syscall.SIGTERM)
 				sig.Signal()
 				if err != nil {
 					log.Log.Reason(err).Errorf("received signal %s but can't signal virt-launcher to shut down", sig.String())
@@ -212,6 +233,19 @@ func RunAndMonitor(containerDiskDir, uid string) (int, error) {
 	}()
 
 	exitCode := <-exitStatus
+	for attempt := 1; reattachAfterCrash && exitCode != 0 && !shuttingDown.Load() && attempt <= maxReattachAttempts; attempt++ {
+		// virt-launcher crashed, qemu keeps running as long as it is not shut down
+		if pid, _ := findQemuPid(); pid <= 0 {
+			break
+		}
+		log.Log.Errorf("virt-launcher crashed with exit-code %d, restarting it to reattach to the running domain (attempt %d/%d)", exitCode, attempt, maxReattachAttempts)
+		if err := startLauncher(append(args, "--reattach")); err != nil {
+			log.Log.Reason(err).Error("failed to restart virt-launcher")
+			break
+		}
+		exitCode = <-exitStatus
+	}
+
 	if exitCode != 0 {
 		log.Log.Errorf("dirty virt-launcher shutdown: exit-code %d", exitCode)
 	}
@@ -219,15 +253,7 @@ func RunAndMonitor(containerDiskDir, uid string) (int, error) {
 	dumpLogFile(passtLogFile)
 
 	// give qemu some time to shut down in case it survived virt-handler
-	// Most of the time we call `qemu-system=* binaries, but qemu-system-* packages
-	// are not everywhere available where libvirt and qemu are. There we usually call qemu-kvm
-	// which resides in /usr/libexec/qemu-kvm
-	pid, _ := findPid("qemu-system")
-	qemuProcessCommandPrefix := "qemu-system"
-	if pid <= 0 {
-		pid, _ = findPid("qemu-kvm")
-		qemuProcessCommandPrefix = "qemu-kvm"
-	}
+	pid, qemuProcessCommandPrefix := findQemuPid()
 
 	if pid > 0 {
 		p, err := os.FindProcess(pid)
@@ -269,6 +295,18 @@ func RunAndMonitor(containerDiskDir, uid string) (int, error) {
 	return exitCode, nil
 }
 
+// findQemuPid returns the pid of qemu and the prefix of its command.
+// Most of the time we call `qemu-system=* binaries, but qemu-system-* packages
+// are not everywhere available where libvirt and qemu are. There we usually call qemu-kvm
+// which resides in /usr/libexec/qemu-kvm
+func findQemuPid() (int, string) {
+	if pid, _ := findPid("qemu-system"); pid > 0 {
+		return pid, "qemu-system"
+	}
+	pid, _ := findPid("qemu-kvm")
+	return pid, "qemu-kvm"
+}
+
 func RemoveContents(dir string) error {
 	files, err := filepath.Glob(filepath.Join(dir, "*.sock"))
 	if err != nil {
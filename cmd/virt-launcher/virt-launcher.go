@@ -31,12 +31,14 @@ import (
 	"time"
 
 	"github.com/spf13/pflag"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"libvirt.org/go/libvirt"
 
 	"k8s.io/apimachinery/pkg/watch"
 
 	v1 "kubevirt.io/api/core/v1"
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
 	"kubevirt.io/client-go/log"
 
 	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
@@ -45,9 +47,11 @@ import (
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
+	"kubevirt.io/kubevirt/pkg/faultinjection"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/ignition"
+	"kubevirt.io/kubevirt/pkg/maintenancenotifications"
 	putil "kubevirt.io/kubevirt/pkg/util"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	virtlauncher "kubevirt.io/kubevirt/pkg/virt-launcher"
@@ -58,10 +62,20 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	virtcli "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 	cmdserver "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cmd-server"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/dryrun"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
 )
 
-const defaultStartTimeout = 3 * time.Minute
+const (
+	defaultStartTimeout = 3 * time.Minute
+
+	launcherStateFile          = "launcher-state.json"
+	launcherStateSaveInterval  = time.Second
+	orphanedDaemonsStopTimeout = 10 * time.Second
+
+	guestAgentCommandDeniedReason = "GuestAgentCommandDenied"
+)
 
 func init() {
 	// must registry the event impl before doing anything else.
@@ -156,6 +170,23 @@ func startDomainEventMonitoring(
 	}
 }
 
+// saveLauncherState periodically saves the state of virt-launcher, for a virt-launcher restarted
+// after a crash to reattach to the running domain
+func saveLauncherState(domainManager virtwrap.DomainManager, statePath string, stopChan chan struct{}) {
+	ticker := time.NewTicker(launcherStateSaveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-ticker.C:
+			if err := domainManager.SaveLauncherState(statePath); err != nil {
+				log.Log.Reason(err).Error("Failed to save the virt-launcher state")
+			}
+		}
+	}
+}
+
 func initializeDirs(ephemeralDiskDir string,
 	containerDiskDir string,
 	hotplugDiskDir string,
@@ -224,6 +255,16 @@ func initializeDirs(ephemeralDiskDir string,
 	if err != nil {
 		panic(err)
 	}
+
+	err = virtlauncher.InitializeDisksDirectories(converter.ChannelSocketDir)
+	if err != nil {
+		panic(err)
+	}
+
+	err = virtlauncher.InitializeDisksDirectories(maintenancenotifications.ChannelDir)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func detectDomainWithUUID(domainManager virtwrap.DomainManager) *api.Domain {
@@ -275,6 +316,40 @@ func waitForDomainUUID(timeout time.Duration, events chan watch.Event, stop chan
 	}
 }
 
+// notifyDryRunEvent sends the events of the placeholder domain to virt-handler. Like for the libvirt
+// events, only the addition and the deletion of the domain are reported to the wait loops.
+func notifyDryRunEvent(notifier *notifyclient.Notifier, events chan watch.Event, event watch.Event) {
+	if err := notifier.SendDomainEvent(event); err != nil {
+		log.Log.Reason(err).Error("Could not send domain notify event.")
+	}
+
+	domain := event.Object.(*api.Domain)
+	if event.Type == watch.Added || domain.ObjectMeta.DeletionTimestamp != nil {
+		select {
+		case events <- event:
+		default:
+		}
+	}
+}
+
+// waitForDryRunDomain waits for the placeholder domain to stop. A signal requests a graceful
+// shutdown, the domain is killed if it is still running after the grace period.
+func waitForDryRunDomain(dryRunManager *dryrun.DomainManager, gracePeriodSeconds int, signalStopChan chan struct{}, gracefulShutdownCallback func(), finalShutdownCallback func(int)) {
+	select {
+	case <-dryRunManager.Stopped():
+		return
+	case <-signalStopChan:
+		gracefulShutdownCallback()
+	}
+
+	select {
+	case <-dryRunManager.Stopped():
+	case <-time.After(time.Duration(gracePeriodSeconds) * time.Second):
+		log.Log.Info("Grace period expired, killing the domain")
+		finalShutdownCallback(0)
+	}
+}
+
 func waitForFinalNotify(deleteNotificationSent chan watch.Event,
 	domainManager virtwrap.DomainManager,
 	vmi *v1.VirtualMachineInstance) {
@@ -347,6 +422,9 @@ func main() {
 	allowEmulation := pflag.Bool("allow-emulation", false, "Allow use of software emulation as fallback")
 	runWithNonRoot := pflag.Bool("run-as-nonroot", false, "Run virtqemud with the 'virt' user")
 	hookSidecars := pflag.Uint("hook-sidecars", 0, "Number of requested hook sidecars, virt-launcher will wait for all of them to become available")
+	hookSidecarIdentity := pflag.Bool("hook-sidecar-identity", false, "Verify the identity of the hook sidecars with a token minted for each of them")
+	faultInjection := pflag.Bool("fault-injection", false, "Inject the faults requested by the VMI annotations")
+	dryRunVirtualization := pflag.Bool("dry-run-virtualization", false, "Run no guest, report a placeholder domain going through the lifecycle of a real one")
 	ovmfPath := pflag.String("ovmf-path", "/usr/share/OVMF", "The directory that contains the EFI roms (like OVMF_CODE.fd)")
 	qemuAgentSysInterval := pflag.Duration("qemu-agent-sys-interval", 120*time.Second, "Interval between consecutive qemu agent calls for sys commands")
 	qemuAgentFileInterval := pflag.Duration("qemu-agent-file-interval", 300*time.Second, "Interval between consecutive qemu agent calls for file command")
@@ -355,6 +433,8 @@ func main() {
 	qemuAgentFSFreezeStatusInterval := pflag.Duration("qemu-fsfreeze-status-interval", 5*time.Second, "Interval between consecutive qemu agent calls for fsfreeze status command")
 	simulateCrash := pflag.Bool("simulate-crash", false, "Causes virt-launcher to immediately crash. This is used by functional tests to simulate crash loop scenarios.")
 	libvirtLogFilters := pflag.String("libvirt-log-filters", "", "Set custom log filters for libvirt")
+	reattachAfterCrash := pflag.Bool("reattach-after-crash", false, "Save the state of virt-launcher for a restarted virt-launcher to reattach to the running domain")
+	reattach := pflag.Bool("reattach", false, "Reattach to the domain left running by a crashed virt-launcher")
 
 	// set new default verbosity, was set to 0 by glog
 	goflag.Set("v", "2")
@@ -388,8 +468,17 @@ func main() {
 		panic(fmt.Errorf("Simulated virt-launcher crash"))
 	}
 
+	if *faultInjection {
+		faultinjection.Enable()
+	}
+
 	// Block until all requested hookSidecars are ready
 	hookManager := hooks.GetManager()
+	if *hookSidecarIdentity {
+		if err := hooks.EnableIdentityVerification(); err != nil {
+			panic(err)
+		}
+	}
 	err := hookManager.Collect(*hookSidecars, *qemuTimeout)
 	if err != nil {
 		panic(err)
@@ -402,24 +491,10 @@ func main() {
 		panic(err)
 	}
 
-	// Start virtqemud, virtlogd, and establish libvirt connection
 	stopChan := make(chan struct{})
-
-	l := util.NewLibvirtWrapper(*runWithNonRoot)
-	err = l.SetupLibvirt(libvirtLogFilters)
-	if err != nil {
-		panic(err)
-	}
-
-	l.StartVirtqemud(stopChan)
 	// only single domain should be present
 	domainName := api.VMINamespaceKeyFunc(vmi)
 
-	util.StartVirtlog(stopChan, domainName, *runWithNonRoot)
-
-	domainConn := createLibvirtConnection(*runWithNonRoot)
-	defer domainConn.Close()
-
 	var agentStore = agentpoller.NewAsyncAgentStore()
 
 	notifier := notifyclient.NewNotifier(*virtShareDir)
@@ -427,11 +502,66 @@ func main() {
 
 	metadataCache := metadata.NewCache()
 
-	domainManager, err := virtwrap.NewLibvirtDomainManager(domainConn, *virtShareDir, *ephemeralDiskDir, &agentStore, *ovmfPath, ephemeralDiskCreator, metadataCache)
-	if err != nil {
-		panic(err)
+	events := make(chan watch.Event, 2)
+
+	var domainConn virtcli.Connection
+	var domainManager virtwrap.DomainManager
+	var dryRunManager *dryrun.DomainManager
+	if *dryRunVirtualization {
+		log.Log.Info("Running in dry-run virtualization mode, no guest will be started")
+		dryRunManager = dryrun.NewDomainManager(metadataCache, dryrun.DefaultDelay, func(event watch.Event) {
+			notifyDryRunEvent(notifier, events, event)
+		})
+		go dryRunManager.Run(stopChan)
+		domainManager = dryRunManager
+	} else {
+		// Start virtqemud, virtlogd, and establish libvirt connection
+		l := util.NewLibvirtWrapper(*runWithNonRoot)
+		err = l.SetupLibvirt(libvirtLogFilters)
+		if err != nil {
+			panic(err)
+		}
+
+		if *reattach {
+			// The domain keeps running, the libvirt daemons of the crashed virt-launcher are replaced
+			log.Log.Info("Reattaching to the domain left running by the crashed virt-launcher")
+			if err := util.TerminateOrphanedLibvirtDaemons(orphanedDaemonsStopTimeout); err != nil {
+				panic(err)
+			}
+		}
+
+		l.StartVirtqemud(stopChan)
+
+		util.StartVirtlog(stopChan, domainName, *runWithNonRoot)
+
+		domainConn = createLibvirtConnection(*runWithNonRoot)
+		defer domainConn.Close()
+
+		domainManager, err = virtwrap.NewLibvirtDomainManager(domainConn, *virtShareDir, *ephemeralDiskDir, &agentStore, *ovmfPath, ephemeralDiskCreator, metadataCache)
+		if err != nil {
+			panic(err)
+		}
+
+		statePath := filepath.Join(putil.VirtPrivateDir, *uid, launcherStateFile)
+		if *reattach {
+			if err := domainManager.RestoreLauncherState(statePath); err != nil {
+				log.Log.Reason(err).Error("Failed to restore the state of the crashed virt-launcher")
+			}
+			notifier.AnnounceRunningDomain()
+		}
+		if *reattachAfterCrash {
+			go saveLauncherState(domainManager, statePath, stopChan)
+		}
 	}
 
+	domainManager.GuestAgentPolicy().OnDenied(func(command guestagentv1alpha1.GuestAgentCommand) {
+		message := fmt.Sprintf("Guest agent command %s denied by the GuestAgentPolicy of namespace %s", command, vmi.Namespace)
+		log.Log.Object(vmi).Warning(message)
+		if err := notifier.SendK8sEvent(vmi, k8sv1.EventTypeWarning, guestAgentCommandDeniedReason, message); err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Failed to send the guest agent command denial event")
+		}
+	})
+
 	// Start the virt-launcher command service.
 	// Clients can use this service to tell virt-launcher
 	// to start/stop virtual machines
@@ -456,9 +586,10 @@ func main() {
 		}
 	}
 
-	events := make(chan watch.Event, 2)
-	// Send domain notifications to virt-handler
-	startDomainEventMonitoring(notifier, domainConn, events, vmi, domainName, &agentStore, *qemuAgentSysInterval, *qemuAgentFileInterval, *qemuAgentUserInterval, *qemuAgentVersionInterval, *qemuAgentFSFreezeStatusInterval, metadataCache)
+	if dryRunManager == nil {
+		// Send domain notifications to virt-handler
+		startDomainEventMonitoring(notifier, domainConn, events, vmi, domainName, &agentStore, *qemuAgentSysInterval, *qemuAgentFileInterval, *qemuAgentUserInterval, *qemuAgentVersionInterval, *qemuAgentFSFreezeStatusInterval, metadataCache)
+	}
 
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt,
@@ -480,8 +611,17 @@ func main() {
 	// managing virtual machines.
 	markReady()
 
-	domain := waitForDomainUUID(*qemuTimeout, events, signalStopChan, domainManager)
-	if domain != nil {
+	var domain *api.Domain
+	if *reattach && dryRunManager == nil {
+		domain = detectDomainWithUUID(domainManager)
+	}
+	if domain == nil {
+		domain = waitForDomainUUID(*qemuTimeout, events, signalStopChan, domainManager)
+	}
+	if domain != nil && dryRunManager != nil {
+		// There is no qemu pid to monitor, wait for the placeholder domain to stop instead
+		waitForDryRunDomain(dryRunManager, *gracePeriodSeconds, signalStopChan, gracefulShutdownCallback, finalShutdownCallback)
+	} else if domain != nil {
 		var pidDir string
 		if *runWithNonRoot {
 			pidDir = "/run/libvirt/qemu/run"
@@ -497,7 +637,9 @@ func main() {
 		// This is a wait loop that monitors the qemu pid. When the pid
 		// exits, the wait loop breaks.
 		mon.RunForever(*qemuTimeout, signalStopChan)
+	}
 
+	if domain != nil {
 		// Allow hooks to gracefully shutdown
 		hookManager.Shutdown()
 
@@ -24,6 +24,8 @@ import (
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	_ "kubevirt.io/kubevirt/pkg/util/fips" // Import to restrict TLS to FIPS approved settings in BoringCrypto builds.
+
 	"kubevirt.io/kubevirt/pkg/virtctl" // Import to initialize client auth plugins.
 )
 
@@ -58,6 +58,8 @@ case ${ARCHITECTURE} in
         winrmcli
         network-slirp-binding
         network-passt-binding
+        network-vdpa-binding
+        network-vhostuser-binding
     "
     ;;
 esac
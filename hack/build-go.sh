@@ -56,6 +56,12 @@ s390x)
     ;;
 esac
 
+# FIPS builds link the FIPS validated BoringCrypto module, which requires cgo
+if [ "${KUBEVIRT_FIPS_BUILD}" = "true" ]; then
+    export GOEXPERIMENT=boringcrypto
+    export CGO_ENABLED=1
+fi
+
 # forward all commands to all packages if no specific one was requested
 # TODO finetune this a little bit more
 if [ $# -eq 0 ]; then
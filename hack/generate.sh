@@ -21,6 +21,12 @@ swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/migrations/v1alpha1/
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/export/v1alpha1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/export/v1beta1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/clone/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/guestagent/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/notifications/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/timeline/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/diskcheck/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/breakglass/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/hostdevices/v1alpha1/types.go
 
 deepcopy-gen \
     --bounding-dirs kubevirt.io/api \
@@ -36,6 +42,12 @@ deepcopy-gen \
     kubevirt.io/api/pool/v1alpha1 \
     kubevirt.io/api/migrations/v1alpha1 \
     kubevirt.io/api/clone/v1alpha1 \
+    kubevirt.io/api/guestagent/v1alpha1 \
+    kubevirt.io/api/notifications/v1alpha1 \
+    kubevirt.io/api/timeline/v1alpha1 \
+    kubevirt.io/api/diskcheck/v1alpha1 \
+    kubevirt.io/api/breakglass/v1alpha1 \
+    kubevirt.io/api/hostdevices/v1alpha1 \
     kubevirt.io/api/core/v1
 
 defaulter-gen \
@@ -55,16 +67,22 @@ openapi-gen \
     k8s.io/apimachinery/pkg/runtime \
     k8s.io/apimachinery/pkg/util/intstr \
     kubevirt.io/api/core/v1 \
+    kubevirt.io/api/breakglass/v1alpha1 \
     kubevirt.io/api/clone/v1alpha1 \
+    kubevirt.io/api/diskcheck/v1alpha1 \
     kubevirt.io/api/export/v1alpha1 \
     kubevirt.io/api/export/v1beta1 \
+    kubevirt.io/api/guestagent/v1alpha1 \
+    kubevirt.io/api/hostdevices/v1alpha1 \
     kubevirt.io/api/instancetype/v1alpha1 \
     kubevirt.io/api/instancetype/v1alpha2 \
     kubevirt.io/api/instancetype/v1beta1 \
     kubevirt.io/api/migrations/v1alpha1 \
+    kubevirt.io/api/notifications/v1alpha1 \
     kubevirt.io/api/pool/v1alpha1 \
     kubevirt.io/api/snapshot/v1alpha1 \
     kubevirt.io/api/snapshot/v1beta1 \
+    kubevirt.io/api/timeline/v1alpha1 \
     kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1
 
 conversion-gen \
@@ -85,7 +103,7 @@ fi
 
 client-gen --clientset-name kubevirt \
     --input-base kubevirt.io/api \
-    --input core/v1,export/v1alpha1,export/v1beta1,snapshot/v1alpha1,snapshot/v1beta1,instancetype/v1alpha1,instancetype/v1alpha2,instancetype/v1beta1,pool/v1alpha1,migrations/v1alpha1,clone/v1alpha1 \
+    --input core/v1,export/v1alpha1,export/v1beta1,snapshot/v1alpha1,snapshot/v1beta1,instancetype/v1alpha1,instancetype/v1alpha2,instancetype/v1beta1,pool/v1alpha1,migrations/v1alpha1,clone/v1alpha1,guestagent/v1alpha1,notifications/v1alpha1,timeline/v1alpha1,diskcheck/v1alpha1,breakglass/v1alpha1,hostdevices/v1alpha1 \
     --output-dir ${KUBEVIRT_DIR}/staging/src/kubevirt.io/client-go \
     --output-pkg ${CLIENT_GEN_BASE} \
     --go-header-file ${KUBEVIRT_DIR}/hack/boilerplate/boilerplate.go.txt
@@ -155,6 +173,24 @@ deepcopy-gen \
     #include clone
     GOFLAGS= controller-gen crd paths=../api/clone/v1alpha1/
 
+    #include guestagent
+    GOFLAGS= controller-gen crd paths=../api/guestagent/v1alpha1/
+
+    #include notifications
+    GOFLAGS= controller-gen crd paths=../api/notifications/v1alpha1/
+
+    #include timeline
+    GOFLAGS= controller-gen crd paths=../api/timeline/v1alpha1/
+
+    #include diskcheck
+    GOFLAGS= controller-gen crd paths=../api/diskcheck/v1alpha1/
+
+    #include breakglass
+    GOFLAGS= controller-gen crd paths=../api/breakglass/v1alpha1/
+
+    #include hostdevices
+    GOFLAGS= controller-gen crd paths=../api/hostdevices/v1alpha1/
+
     #remove some weird stuff from controller-gen
     cd config/crd
     for file in *; do
@@ -0,0 +1,60 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package breakglass
+
+import (
+	"slices"
+	"time"
+
+	breakglassv1alpha1 "kubevirt.io/api/breakglass/v1alpha1"
+)
+
+const (
+	// MaxDuration is the longest time an access can be granted for
+	MaxDuration = 24 * time.Hour
+
+	SubresourceConsole = "console"
+	SubresourceVNC     = "vnc"
+)
+
+// GrantableSubresources are the subresources a ConsoleAccessGrant can grant the access to
+var GrantableSubresources = []string{SubresourceConsole, SubresourceVNC}
+
+// Subresources returns the subresources granted by a grant, the console by default
+func Subresources(grant *breakglassv1alpha1.ConsoleAccessGrant) []string {
+	if len(grant.Spec.Subresources) == 0 {
+		return []string{SubresourceConsole}
+	}
+	return grant.Spec.Subresources
+}
+
+// Grants returns whether a grant gives the access to the subresource of the VM to the user at now
+func Grants(grant *breakglassv1alpha1.ConsoleAccessGrant, user, vmName, subresource string, now time.Time) bool {
+	return grant.Spec.User == user &&
+		grant.Spec.VirtualMachineName == vmName &&
+		slices.Contains(Subresources(grant), subresource) &&
+		grant.Status.Phase == breakglassv1alpha1.ConsoleAccessGrantActive &&
+		grant.Status.ExpiresAt != nil && now.Before(grant.Status.ExpiresAt.Time)
+}
+
+// expiresAt returns the time the access granted by a grant expires at
+func expiresAt(grant *breakglassv1alpha1.ConsoleAccessGrant) time.Time {
+	return grant.CreationTimestamp.Add(grant.Spec.Duration.Duration)
+}
@@ -0,0 +1,270 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package breakglass
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	breakglassv1alpha1 "kubevirt.io/api/breakglass/v1alpha1"
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// ConsoleAccessGrantedReason is the reason of the event recorded once the access of a grant is granted
+	ConsoleAccessGrantedReason = "ConsoleAccessGranted"
+	// ConsoleAccessExpiredReason is the reason of the event recorded once the access of a grant is revoked
+	ConsoleAccessExpiredReason = "ConsoleAccessExpired"
+
+	roleNamePrefix = "kubevirt-console-access-"
+)
+
+// Controller grants the access of the ConsoleAccessGrants with a Role and a RoleBinding owned by
+// the grant, and revokes it by removing them once the grant expires.
+type Controller struct {
+	clientset  kubecli.KubevirtClient
+	queue      workqueue.TypedRateLimitingInterface[string]
+	grantStore cache.Store
+	hasSynced  func() bool
+	recorder   record.EventRecorder
+	now        func() time.Time
+}
+
+// NewController creates a new instance of the ConsoleAccessGrant controller.
+func NewController(clientset kubecli.KubevirtClient, grantInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-console-access-grant"},
+		),
+		grantStore: grantInformer.GetStore(),
+		hasSynced:  grantInformer.HasSynced,
+		recorder:   recorder,
+		now:        time.Now,
+	}
+
+	_, err := grantInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueGrant,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueGrant(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueGrant(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract the key of a ConsoleAccessGrant")
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run runs the passed in ConsoleAccessGrant controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting console access grant controller.")
+
+	// Wait for cache sync before we start the console access grant controller
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping console access grant controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute grants or revokes the access of a ConsoleAccessGrant from the queue, if there is an error
+// it requeues the grant. Returns false if the queue is shut down.
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing ConsoleAccessGrant %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed ConsoleAccessGrant %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.grantStore.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// The Role and the RoleBinding are owned by the grant and garbage collected with it
+		return nil
+	}
+	grant := obj.(*breakglassv1alpha1.ConsoleAccessGrant)
+
+	expiry := expiresAt(grant)
+	if remaining := expiry.Sub(c.now()); remaining > 0 {
+		if err := c.grant(grant, expiry); err != nil {
+			return err
+		}
+		c.queue.AddAfter(key, remaining)
+		return nil
+	}
+	return c.revoke(grant)
+}
+
+func (c *Controller) grant(grant *breakglassv1alpha1.ConsoleAccessGrant, expiry time.Time) error {
+	if grant.Status.Phase == breakglassv1alpha1.ConsoleAccessGrantActive {
+		return nil
+	}
+
+	role := newRole(grant)
+	_, err := c.clientset.RbacV1().Roles(grant.Namespace).Create(context.Background(), role, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create the role of the grant: %v", err)
+	}
+	_, err = c.clientset.RbacV1().RoleBindings(grant.Namespace).Create(context.Background(), newRoleBinding(grant, role), metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create the role binding of the grant: %v", err)
+	}
+
+	grantCopy := grant.DeepCopy()
+	grantCopy.Status.Phase = breakglassv1alpha1.ConsoleAccessGrantActive
+	grantCopy.Status.ExpiresAt = &metav1.Time{Time: expiry}
+	if _, err := c.clientset.GeneratedKubeVirtClient().BreakglassV1alpha1().ConsoleAccessGrants(grant.Namespace).UpdateStatus(context.Background(), grantCopy, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	log.Log.Object(grant).Infof("Granted the access to %v of VirtualMachine %s to user %s until %s, granted by %s: %s",
+		Subresources(grant), grant.Spec.VirtualMachineName, grant.Spec.User, expiry.UTC().Format(time.RFC3339), grant.Spec.GrantedBy, grant.Spec.Reason)
+	c.recorder.Eventf(grant, k8sv1.EventTypeNormal, ConsoleAccessGrantedReason,
+		"Granted the access to %v of VirtualMachine %s to user %s until %s", Subresources(grant), grant.Spec.VirtualMachineName, grant.Spec.User, expiry.UTC().Format(time.RFC3339))
+	return nil
+}
+
+func (c *Controller) revoke(grant *breakglassv1alpha1.ConsoleAccessGrant) error {
+	if grant.Status.Phase == breakglassv1alpha1.ConsoleAccessGrantExpired {
+		return nil
+	}
+
+	name := roleName(grant)
+	err := c.clientset.RbacV1().RoleBindings(grant.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete the role binding of the grant: %v", err)
+	}
+	err = c.clientset.RbacV1().Roles(grant.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete the role of the grant: %v", err)
+	}
+
+	grantCopy := grant.DeepCopy()
+	grantCopy.Status.Phase = breakglassv1alpha1.ConsoleAccessGrantExpired
+	if grantCopy.Status.ExpiresAt == nil {
+		grantCopy.Status.ExpiresAt = &metav1.Time{Time: expiresAt(grant)}
+	}
+	if _, err := c.clientset.GeneratedKubeVirtClient().BreakglassV1alpha1().ConsoleAccessGrants(grant.Namespace).UpdateStatus(context.Background(), grantCopy, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	log.Log.Object(grant).Infof("Revoked the access to %v of VirtualMachine %s of user %s, %d accesses were made",
+		Subresources(grant), grant.Spec.VirtualMachineName, grant.Spec.User, len(grant.Status.Accesses))
+	c.recorder.Eventf(grant, k8sv1.EventTypeNormal, ConsoleAccessExpiredReason,
+		"Revoked the access to %v of VirtualMachine %s of user %s", Subresources(grant), grant.Spec.VirtualMachineName, grant.Spec.User)
+	return nil
+}
+
+func roleName(grant *breakglassv1alpha1.ConsoleAccessGrant) string {
+	return roleNamePrefix + grant.Name
+}
+
+func newRole(grant *breakglassv1alpha1.ConsoleAccessGrant) *rbacv1.Role {
+	resources := []string{}
+	for _, subresource := range Subresources(grant) {
+		resources = append(resources, "virtualmachineinstances/"+subresource)
+	}
+	return &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      roleName(grant),
+			Namespace: grant.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(grant, breakglassv1alpha1.ConsoleAccessGrantKind),
+			},
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{virtv1.SubresourceGroupName},
+				Resources:     resources,
+				ResourceNames: []string{grant.Spec.VirtualMachineName},
+				Verbs:         []string{"get"},
+			},
+		},
+	}
+}
+
+func newRoleBinding(grant *breakglassv1alpha1.ConsoleAccessGrant, role *rbacv1.Role) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            role.Name,
+			Namespace:       grant.Namespace,
+			OwnerReferences: role.OwnerReferences,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     role.Name,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				APIGroup: rbacv1.GroupName,
+				Kind:     rbacv1.UserKind,
+				Name:     grant.Spec.User,
+			},
+		},
+	}
+}
@@ -0,0 +1,212 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package breakglass
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	breakglassv1alpha1 "kubevirt.io/api/breakglass/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("ConsoleAccessGrant controller", func() {
+	var (
+		client        *kubevirtfake.Clientset
+		k8sClient     *k8sfake.Clientset
+		grantInformer cache.SharedIndexInformer
+		recorder      *record.FakeRecorder
+		controller    *Controller
+		now           time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		client = kubevirtfake.NewSimpleClientset()
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().GeneratedKubeVirtClient().Return(client).AnyTimes()
+		virtClient.EXPECT().RbacV1().Return(k8sClient.RbacV1()).AnyTimes()
+
+		grantInformer, _ = testutils.NewFakeInformerFor(&breakglassv1alpha1.ConsoleAccessGrant{})
+		recorder = record.NewFakeRecorder(10)
+		recorder.IncludeObject = true
+
+		var err error
+		controller, err = NewController(virtClient, grantInformer, recorder)
+		Expect(err).ToNot(HaveOccurred())
+
+		now = time.Now().Truncate(time.Second)
+		controller.now = func() time.Time { return now }
+	})
+
+	addGrant := func(grant *breakglassv1alpha1.ConsoleAccessGrant) {
+		_, err := client.BreakglassV1alpha1().ConsoleAccessGrants(grant.Namespace).Create(context.Background(), grant, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(grantInformer.GetStore().Add(grant)).To(Succeed())
+	}
+
+	getGrant := func(grant *breakglassv1alpha1.ConsoleAccessGrant) *breakglassv1alpha1.ConsoleAccessGrant {
+		grant, err := client.BreakglassV1alpha1().ConsoleAccessGrants(grant.Namespace).Get(context.Background(), grant.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return grant
+	}
+
+	newGrant := func(subresources ...string) *breakglassv1alpha1.ConsoleAccessGrant {
+		return &breakglassv1alpha1.ConsoleAccessGrant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "incident-42",
+				Namespace:         metav1.NamespaceDefault,
+				UID:               "grant-uid",
+				CreationTimestamp: metav1.NewTime(now.Add(-10 * time.Minute)),
+			},
+			Spec: breakglassv1alpha1.ConsoleAccessGrantSpec{
+				User:               "oncall",
+				VirtualMachineName: "database",
+				Subresources:       subresources,
+				Duration:           metav1.Duration{Duration: time.Hour},
+				Reason:             "INC-42",
+				GrantedBy:          "admin",
+			},
+		}
+	}
+
+	It("should grant the access with a role and a role binding owned by the grant", func() {
+		grant := newGrant()
+		addGrant(grant)
+
+		Expect(controller.execute("default/incident-42")).To(Succeed())
+
+		role, err := k8sClient.RbacV1().Roles(grant.Namespace).Get(context.Background(), "kubevirt-console-access-incident-42", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(role.OwnerReferences).To(ConsistOf(HaveField("UID", grant.UID)))
+		Expect(role.Rules).To(ConsistOf(rbacv1.PolicyRule{
+			APIGroups:     []string{"subresources.kubevirt.io"},
+			Resources:     []string{"virtualmachineinstances/console"},
+			ResourceNames: []string{"database"},
+			Verbs:         []string{"get"},
+		}))
+
+		binding, err := k8sClient.RbacV1().RoleBindings(grant.Namespace).Get(context.Background(), role.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(binding.OwnerReferences).To(ConsistOf(HaveField("UID", grant.UID)))
+		Expect(binding.RoleRef.Name).To(Equal(role.Name))
+		Expect(binding.Subjects).To(ConsistOf(HaveField("Name", "oncall")))
+
+		grant = getGrant(grant)
+		Expect(grant.Status.Phase).To(Equal(breakglassv1alpha1.ConsoleAccessGrantActive))
+		Expect(grant.Status.ExpiresAt.Time).To(BeTemporally("==", now.Add(50*time.Minute)))
+		testutils.ExpectEvent(recorder, ConsoleAccessGrantedReason)
+	})
+
+	It("should grant the access to all the subresources of the grant", func() {
+		addGrant(newGrant(SubresourceConsole, SubresourceVNC))
+
+		Expect(controller.execute("default/incident-42")).To(Succeed())
+
+		role, err := k8sClient.RbacV1().Roles(metav1.NamespaceDefault).Get(context.Background(), "kubevirt-console-access-incident-42", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(role.Rules[0].Resources).To(ConsistOf("virtualmachineinstances/console", "virtualmachineinstances/vnc"))
+	})
+
+	It("should not grant the access of an expired grant", func() {
+		grant := newGrant()
+		grant.CreationTimestamp = metav1.NewTime(now.Add(-2 * time.Hour))
+		addGrant(grant)
+
+		Expect(controller.execute("default/incident-42")).To(Succeed())
+
+		_, err := k8sClient.RbacV1().Roles(grant.Namespace).Get(context.Background(), "kubevirt-console-access-incident-42", metav1.GetOptions{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		grant = getGrant(grant)
+		Expect(grant.Status.Phase).To(Equal(breakglassv1alpha1.ConsoleAccessGrantExpired))
+		Expect(grant.Status.ExpiresAt.Time).To(BeTemporally("==", now.Add(-time.Hour)))
+	})
+
+	It("should revoke the access once the grant expires", func() {
+		grant := newGrant()
+		addGrant(grant)
+		Expect(controller.execute("default/incident-42")).To(Succeed())
+		Expect(grantInformer.GetStore().Update(getGrant(grant))).To(Succeed())
+		testutils.ExpectEvent(recorder, ConsoleAccessGrantedReason)
+
+		now = now.Add(time.Hour)
+		Expect(controller.execute("default/incident-42")).To(Succeed())
+
+		_, err := k8sClient.RbacV1().Roles(grant.Namespace).Get(context.Background(), "kubevirt-console-access-incident-42", metav1.GetOptions{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		_, err = k8sClient.RbacV1().RoleBindings(grant.Namespace).Get(context.Background(), "kubevirt-console-access-incident-42", metav1.GetOptions{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		Expect(getGrant(grant).Status.Phase).To(Equal(breakglassv1alpha1.ConsoleAccessGrantExpired))
+		testutils.ExpectEvent(recorder, ConsoleAccessExpiredReason)
+	})
+
+	It("should not update an active grant again", func() {
+		grant := newGrant()
+		grant.Status.Phase = breakglassv1alpha1.ConsoleAccessGrantActive
+		addGrant(grant)
+		client.ClearActions()
+
+		Expect(controller.execute("default/incident-42")).To(Succeed())
+
+		Expect(client.Actions()).To(BeEmpty())
+		Expect(k8sClient.Actions()).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	Context("Grants", func() {
+		var grant *breakglassv1alpha1.ConsoleAccessGrant
+
+		BeforeEach(func() {
+			grant = newGrant()
+			grant.Status.Phase = breakglassv1alpha1.ConsoleAccessGrantActive
+			grant.Status.ExpiresAt = &metav1.Time{Time: now.Add(time.Minute)}
+		})
+
+		DescribeTable("should tell whether the access is granted", func(user, vmName, subresource string, at time.Duration, expected bool) {
+			Expect(Grants(grant, user, vmName, subresource, now.Add(at))).To(Equal(expected))
+		},
+			Entry("to the console of the VM", "oncall", "database", SubresourceConsole, time.Duration(0), true),
+			Entry("not to another user", "intruder", "database", SubresourceConsole, time.Duration(0), false),
+			Entry("not to another VM", "oncall", "frontend", SubresourceConsole, time.Duration(0), false),
+			Entry("not to a subresource not granted", "oncall", "database", SubresourceVNC, time.Duration(0), false),
+			Entry("not once the grant expired", "oncall", "database", SubresourceConsole, time.Minute, false),
+		)
+
+		It("should not grant the access of an expired grant", func() {
+			grant.Status.Phase = breakglassv1alpha1.ConsoleAccessGrantExpired
+			Expect(Grants(grant, "oncall", "database", SubresourceConsole, now)).To(BeFalse())
+		})
+	})
+})
@@ -28,8 +28,12 @@ import (
 
 	"kubevirt.io/api/snapshot"
 
+	"kubevirt.io/api/breakglass"
+	breakglassv1alpha1 "kubevirt.io/api/breakglass/v1alpha1"
 	"kubevirt.io/api/clone"
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+	"kubevirt.io/api/diskcheck"
+	diskcheckv1alpha1 "kubevirt.io/api/diskcheck/v1alpha1"
 
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	routev1 "github.com/openshift/api/route/v1"
@@ -39,6 +43,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -57,12 +62,18 @@ import (
 	"kubevirt.io/api/core"
 	kubev1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/api/guestagent"
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
 	instancetypeapi "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	"kubevirt.io/api/migrations"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/api/notifications"
+	notificationsv1alpha1 "kubevirt.io/api/notifications/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/api/timeline"
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
@@ -102,6 +113,9 @@ type KubeInformerFactory interface {
 	// as a migration target
 	VMITargetHost(hostName string) cache.SharedIndexInformer
 
+	// Watches for vmi objects not assigned to any host yet
+	VMIUnscheduled() cache.SharedIndexInformer
+
 	// Watches for VirtualMachineInstanceReplicaSet objects
 	VMIReplicaSet() cache.SharedIndexInformer
 
@@ -138,6 +152,24 @@ type KubeInformerFactory interface {
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
+	// Watches GuestAgentPolicy objects
+	GuestAgentPolicy() cache.SharedIndexInformer
+
+	// Watches LifecycleNotification objects
+	LifecycleNotification() cache.SharedIndexInformer
+
+	// Watches VirtualMachineTimeline objects
+	VirtualMachineTimeline() cache.SharedIndexInformer
+
+	// Watches VirtualMachineDiskCheck objects
+	VirtualMachineDiskCheck() cache.SharedIndexInformer
+
+	// Watches ConsoleAccessGrant objects
+	ConsoleAccessGrant() cache.SharedIndexInformer
+
+	// Watches the events of the kubevirt.io/v1 objects
+	KubeVirtEvent() cache.SharedIndexInformer
+
 	// Watches VirtualMachineClone objects
 	VirtualMachineClone() cache.SharedIndexInformer
 
@@ -315,6 +347,12 @@ type KubeInformerFactory interface {
 	// Pod returns an informer for ALL Pods in the system
 	Pod() cache.SharedIndexInformer
 
+	// Services whose endpoints are published from the addresses of VMIs on a secondary network
+	ServiceNetworkService() cache.SharedIndexInformer
+
+	// EndpointSlices published by virt-controller for the Services of secondary networks
+	ServiceNetworkEndpointSlice() cache.SharedIndexInformer
+
 	ResourceQuota() cache.SharedIndexInformer
 
 	K8SInformerFactory() informers.SharedInformerFactory
@@ -489,6 +527,18 @@ func (f *kubeInformerFactory) VMITargetHost(hostName string) cache.SharedIndexIn
 	})
 }
 
+func (f *kubeInformerFactory) VMIUnscheduled() cache.SharedIndexInformer {
+	labelSelector, err := labels.Parse("!" + kubev1.NodeNameLabel)
+	if err != nil {
+		panic(err)
+	}
+
+	return f.getInformer("vmiInformer-unscheduled", func() cache.SharedIndexInformer {
+		lw := NewListWatchFromClient(f.restClient, "virtualmachineinstances", k8sv1.NamespaceAll, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &kubev1.VirtualMachineInstance{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) VMIReplicaSet() cache.SharedIndexInformer {
 	return f.getInformer("vmirsInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.restClient, "virtualmachineinstancereplicasets", k8sv1.NamespaceAll, fields.Everything())
@@ -716,6 +766,49 @@ func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) GuestAgentPolicy() cache.SharedIndexInformer {
+	return f.getInformer("guestAgentPolicyInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().GuestagentV1alpha1().RESTClient(), guestagent.ResourceGuestAgentPolicies, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &guestagentv1alpha1.GuestAgentPolicy{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) LifecycleNotification() cache.SharedIndexInformer {
+	return f.getInformer("lifecycleNotificationInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().NotificationsV1alpha1().RESTClient(), notifications.ResourceLifecycleNotifications, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &notificationsv1alpha1.LifecycleNotification{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineTimeline() cache.SharedIndexInformer {
+	return f.getInformer("virtualMachineTimelineInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().TimelineV1alpha1().RESTClient(), timeline.ResourceVirtualMachineTimelines, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &timelinev1alpha1.VirtualMachineTimeline{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineDiskCheck() cache.SharedIndexInformer {
+	return f.getInformer("virtualMachineDiskCheckInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().DiskcheckV1alpha1().RESTClient(), diskcheck.ResourceVirtualMachineDiskChecks, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &diskcheckv1alpha1.VirtualMachineDiskCheck{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) ConsoleAccessGrant() cache.SharedIndexInformer {
+	return f.getInformer("consoleAccessGrantInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().BreakglassV1alpha1().RESTClient(), breakglass.ResourceConsoleAccessGrants, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &breakglassv1alpha1.ConsoleAccessGrant{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) KubeVirtEvent() cache.SharedIndexInformer {
+	return f.getInformer("kubeVirtEventInformer", func() cache.SharedIndexInformer {
+		fieldSelector := fields.OneTermEqualSelector("involvedObject.apiVersion", kubev1.SchemeGroupVersion.String())
+		lw := cache.NewListWatchFromClient(f.clientSet.CoreV1().RESTClient(), "events", k8sv1.NamespaceAll, fieldSelector)
+		return cache.NewSharedIndexInformer(lw, &k8sv1.Event{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 	getkey := func(vmClone *clonev1alpha1.VirtualMachineClone, resourceName string) string {
 		return fmt.Sprintf("%s/%s", vmClone.Namespace, resourceName)
@@ -1387,6 +1480,32 @@ func (f *kubeInformerFactory) Pod() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) ServiceNetworkService() cache.SharedIndexInformer {
+	return f.getInformer("serviceNetworkServiceInformer", func() cache.SharedIndexInformer {
+		// Watch all services with the service network label
+		labelSelector, err := labels.Parse(kubev1.ServiceNetworkLabel)
+		if err != nil {
+			panic(err)
+		}
+
+		lw := NewListWatchFromClient(f.clientSet.CoreV1().RESTClient(), "services", k8sv1.NamespaceAll, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &k8sv1.Service{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) ServiceNetworkEndpointSlice() cache.SharedIndexInformer {
+	return f.getInformer("serviceNetworkEndpointSliceInformer", func() cache.SharedIndexInformer {
+		// Watch all endpointslices managed by virt-controller
+		labelSelector, err := labels.Parse(fmt.Sprintf("%s=%s", discoveryv1.LabelManagedBy, kubev1.EndpointSliceManagedByVirtController))
+		if err != nil {
+			panic(err)
+		}
+
+		lw := NewListWatchFromClient(f.clientSet.DiscoveryV1().RESTClient(), "endpointslices", k8sv1.NamespaceAll, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &discoveryv1.EndpointSlice{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) ResourceQuota() cache.SharedIndexInformer {
 	return f.getInformer("resourceQuotaInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.CoreV1().RESTClient(), "resourcequotas", k8sv1.NamespaceAll, fields.Everything())
@@ -54,8 +54,8 @@ const (
 // (will also fail for `maxRequestsBurst` > 256)
 const _ = uint8(maxRequestsBurst - 1)
 
-func RunDownwardMetricsVirtioServer(ctx context.Context, nodeName, channelSocketPath, launcherSocketPath string) error {
-	report, err := newMetricsReporter(nodeName, launcherSocketPath)
+func RunDownwardMetricsVirtioServer(ctx context.Context, nodeName, channelSocketPath, launcherSocketPath string, launcherPid int) error {
+	report, err := newMetricsReporter(nodeName, launcherSocketPath, launcherPid)
 	if err != nil {
 		return err
 	}
@@ -72,7 +72,7 @@ func RunDownwardMetricsVirtioServer(ctx context.Context, nodeName, channelSocket
 
 type metricsReporter func() (*api.Metrics, error)
 
-func newMetricsReporter(nodeName, launcherSocketPath string) (metricsReporter, error) {
+func newMetricsReporter(nodeName, launcherSocketPath string, launcherPid int) (metricsReporter, error) {
 	exists, err := diskutils.FileExists(launcherSocketPath)
 	if err != nil {
 		return nil, err
@@ -84,7 +84,7 @@ func newMetricsReporter(nodeName, launcherSocketPath string) (metricsReporter, e
 	scraper := metricsScraper.NewReporter(nodeName)
 
 	return func() (*api.Metrics, error) {
-		return scraper.Report(launcherSocketPath)
+		return scraper.Report(launcherSocketPath, launcherPid)
 	}, nil
 }
 
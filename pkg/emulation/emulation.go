@@ -0,0 +1,56 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package emulation
+
+import (
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// NodeArchitectureAnnotation requests to run the guest of a VMI emulated with TCG on nodes of
+// the given architecture, which differs from the architecture of the VMI.
+// It is only honored when the CrossArchitectureEmulation feature gate is enabled.
+const NodeArchitectureAnnotation = "emulation.kubevirt.io/node-architecture"
+
+// TranslationCacheMiB bounds the memory QEMU uses to cache the translated code of an emulated
+// guest, it is accounted in the memory overhead of the virt-launcher pod.
+const TranslationCacheMiB = 256
+
+// NodeArchitectures are the architectures of the nodes able to emulate a guest
+var NodeArchitectures = []string{"amd64", "arm64", "s390x"}
+
+// NodeArchitecture returns the architecture of the nodes the VMI is emulated on, or an empty
+// string if the VMI is not emulated.
+func NodeArchitecture(vmi *v1.VirtualMachineInstance) string {
+	return vmi.Annotations[NodeArchitectureAnnotation]
+}
+
+// IsCrossArchitecture returns true if the guest of the VMI is emulated on a node of the given
+// architecture.
+func IsCrossArchitecture(vmi *v1.VirtualMachineInstance, nodeArch string) bool {
+	return NodeArchitecture(vmi) == nodeArch && vmi.Spec.Architecture != "" && vmi.Spec.Architecture != nodeArch
+}
+
+// Warning describes the performance penalty of emulating the guest of the VMI
+func Warning(vmi *v1.VirtualMachineInstance) string {
+	return fmt.Sprintf("The %s guest is emulated with TCG on an %s node, it runs an order of magnitude slower than with KVM and is not suited for production workloads",
+		vmi.Spec.Architecture, NodeArchitecture(vmi))
+}
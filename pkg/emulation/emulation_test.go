@@ -0,0 +1,56 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package emulation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/emulation"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Cross-architecture emulation", func() {
+	DescribeTable("should tell if the guest is emulated on the node", func(vmiArch string, annotations map[string]string, expected bool) {
+		vmi := libvmi.New(libvmi.WithArchitecture(vmiArch))
+		vmi.Annotations = annotations
+		Expect(emulation.IsCrossArchitecture(vmi, "amd64")).To(Equal(expected))
+	},
+		Entry("with an arm64 guest emulated on amd64", "arm64", map[string]string{emulation.NodeArchitectureAnnotation: "amd64"}, true),
+		Entry("not with an arm64 guest emulated on s390x", "arm64", map[string]string{emulation.NodeArchitectureAnnotation: "s390x"}, false),
+		Entry("not with an amd64 guest", "amd64", map[string]string{emulation.NodeArchitectureAnnotation: "amd64"}, false),
+		Entry("not without the annotation", "arm64", nil, false),
+		Entry("not without an architecture", "", map[string]string{emulation.NodeArchitectureAnnotation: "amd64"}, false),
+	)
+
+	It("should warn about the performance of the guest", func() {
+		vmi := libvmi.New(
+			libvmi.WithArchitecture("arm64"),
+			libvmi.WithAnnotation(emulation.NodeArchitectureAnnotation, "amd64"),
+		)
+		Expect(emulation.Warning(vmi)).To(HavePrefix("The arm64 guest is emulated with TCG on an amd64 node"))
+	})
+
+	It("should not be requested by a VMI with no annotation", func() {
+		Expect(emulation.NodeArchitecture(&v1.VirtualMachineInstance{})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,11 @@
+package externalpolicy_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestExternalPolicy(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
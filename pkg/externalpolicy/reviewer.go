@@ -0,0 +1,216 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package externalpolicy
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	DefaultTimeoutSeconds int32 = 5
+	MaxTimeoutSeconds     int32 = 10
+
+	maxResponseBytes = 1 << 20
+)
+
+// ReviewRequest is the payload POSTed to an external policy service.
+type ReviewRequest struct {
+	Hook v1.ExternalPolicyHook `json:"hook"`
+	// Operation is CREATE or UPDATE for the VirtualMachineAdmission hook
+	Operation              string                              `json:"operation,omitempty"`
+	VirtualMachine         *v1.VirtualMachine                  `json:"virtualMachine,omitempty"`
+	VirtualMachineInstance *v1.VirtualMachineInstance          `json:"virtualMachineInstance,omitempty"`
+	Migration              *v1.VirtualMachineInstanceMigration `json:"migration,omitempty"`
+}
+
+// ReviewResponse is the answer expected from an external policy service.
+type ReviewResponse struct {
+	Allowed bool   `json:"allowed"`
+	Message string `json:"message,omitempty"`
+	// AllowedNodes restricts the nodes a migration target pod may be scheduled to.
+	// It is only taken into account for the MigrationTargetSelection hook, an empty list means no restriction.
+	AllowedNodes []string `json:"allowedNodes,omitempty"`
+}
+
+// DeniedError is returned when an external policy service explicitly denied a request.
+type DeniedError struct {
+	Service string
+	Message string
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("external policy service %s denied the request: %s", e.Service, e.Message)
+}
+
+type Reviewer struct {
+	clusterConfig *virtconfig.ClusterConfig
+}
+
+func NewReviewer(clusterConfig *virtconfig.ClusterConfig) *Reviewer {
+	return &Reviewer{clusterConfig: clusterConfig}
+}
+
+// ReviewVirtualMachine consults all services registered for the VirtualMachineAdmission hook.
+func (r *Reviewer) ReviewVirtualMachine(ctx context.Context, operation string, vm *v1.VirtualMachine) error {
+	_, err := r.review(ctx, &ReviewRequest{
+		Hook:           v1.ExternalPolicyHookVirtualMachineAdmission,
+		Operation:      operation,
+		VirtualMachine: vm,
+	})
+	return err
+}
+
+// MigrationTargetNodes consults all services registered for the MigrationTargetSelection hook and
+// returns the nodes the migration target may run on. A nil result means that no service restricted the nodes.
+func (r *Reviewer) MigrationTargetNodes(ctx context.Context, migration *v1.VirtualMachineInstanceMigration, vmi *v1.VirtualMachineInstance) ([]string, error) {
+	nodes, err := r.review(ctx, &ReviewRequest{
+		Hook:                   v1.ExternalPolicyHookMigrationTargetSelection,
+		VirtualMachineInstance: vmi,
+		Migration:              migration,
+	})
+	if err != nil || nodes == nil {
+		return nil, err
+	}
+	if nodes.Len() == 0 {
+		return nil, fmt.Errorf("the external policy services do not permit any common migration target node")
+	}
+	return sets.List(nodes), nil
+}
+
+func (r *Reviewer) review(ctx context.Context, request *ReviewRequest) (sets.Set[string], error) {
+	if !r.clusterConfig.ExternalPolicyEnabled() {
+		return nil, nil
+	}
+
+	var allowedNodes sets.Set[string]
+	for _, service := range r.clusterConfig.GetConfig().ExternalPolicyServices {
+		if !hasHook(service, request.Hook) {
+			continue
+		}
+
+		response, err := call(ctx, service, request)
+		if err != nil {
+			if isFailOpen(service) {
+				log.Log.Reason(err).Warningf("ignoring failure of external policy service %s", service.Name)
+				continue
+			}
+			return nil, err
+		}
+		if !response.Allowed {
+			return nil, &DeniedError{Service: service.Name, Message: response.Message}
+		}
+
+		if len(response.AllowedNodes) == 0 {
+			continue
+		}
+		if allowedNodes == nil {
+			allowedNodes = sets.New(response.AllowedNodes...)
+		} else {
+			allowedNodes = allowedNodes.Intersection(sets.New(response.AllowedNodes...))
+		}
+	}
+	return allowedNodes, nil
+}
+
+func call(ctx context.Context, service v1.ExternalPolicyService, request *ReviewRequest) (*ReviewResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newHTTPClient(service)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure external policy service %s: %v", service.Name, err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, service.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed calling external policy service %s: %v", service.Name, err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := client.Do(httpRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed calling external policy service %s: %v", service.Name, err)
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("external policy service %s responded with status %d", service.Name, httpResponse.StatusCode)
+	}
+
+	response := &ReviewResponse{}
+	if err := json.NewDecoder(io.LimitReader(httpResponse.Body, maxResponseBytes)).Decode(response); err != nil {
+		return nil, fmt.Errorf("failed to decode the answer of external policy service %s: %v", service.Name, err)
+	}
+	return response, nil
+}
+
+func newHTTPClient(service v1.ExternalPolicyService) (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(service.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(service.CABundle) {
+			return nil, fmt.Errorf("caBundle does not contain any valid certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	timeout := DefaultTimeoutSeconds
+	if service.TimeoutSeconds != nil {
+		timeout = *service.TimeoutSeconds
+	}
+
+	return &http.Client{
+		Timeout: time.Duration(timeout) * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig:   tlsConfig,
+			DisableKeepAlives: true,
+		},
+	}, nil
+}
+
+func hasHook(service v1.ExternalPolicyService, hook v1.ExternalPolicyHook) bool {
+	for _, h := range service.Hooks {
+		if h == hook {
+			return true
+		}
+	}
+	return false
+}
+
+func isFailOpen(service v1.ExternalPolicyService) bool {
+	return service.FailurePolicy != nil && *service.FailurePolicy == v1.ExternalPolicyFailurePolicyIgnore
+}
@@ -0,0 +1,181 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package externalpolicy_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/externalpolicy"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("External policy reviewer", func() {
+	newPolicyServer := func(response externalpolicy.ReviewResponse, requests *[]externalpolicy.ReviewRequest) *httptest.Server {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			request := externalpolicy.ReviewRequest{}
+			Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+			if requests != nil {
+				*requests = append(*requests, request)
+			}
+			Expect(json.NewEncoder(w).Encode(response)).To(Succeed())
+		}))
+		DeferCleanup(server.Close)
+		return server
+	}
+
+	newService := func(name string, server *httptest.Server, hooks ...v1.ExternalPolicyHook) v1.ExternalPolicyService {
+		return v1.ExternalPolicyService{
+			Name:     name,
+			URL:      server.URL,
+			CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+			Hooks:    hooks,
+		}
+	}
+
+	newReviewer := func(featureGate bool, services ...v1.ExternalPolicyService) *externalpolicy.Reviewer {
+		config := &v1.KubeVirtConfiguration{
+			ExternalPolicyServices: services,
+			DeveloperConfiguration: &v1.DeveloperConfiguration{},
+		}
+		if featureGate {
+			config.DeveloperConfiguration.FeatureGates = []string{virtconfig.ExternalPolicyGate}
+		}
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(config)
+		return externalpolicy.NewReviewer(clusterConfig)
+	}
+
+	Context("VirtualMachine admission", func() {
+		It("should send the VirtualMachine to the services registered for the hook", func() {
+			var requests []externalpolicy.ReviewRequest
+			server := newPolicyServer(externalpolicy.ReviewResponse{Allowed: true}, &requests)
+			reviewer := newReviewer(true, newService("zones", server, v1.ExternalPolicyHookVirtualMachineAdmission))
+
+			vm := &v1.VirtualMachine{}
+			vm.Name = "testvm"
+			Expect(reviewer.ReviewVirtualMachine(context.Background(), "CREATE", vm)).To(Succeed())
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Hook).To(Equal(v1.ExternalPolicyHookVirtualMachineAdmission))
+			Expect(requests[0].Operation).To(Equal("CREATE"))
+			Expect(requests[0].VirtualMachine.Name).To(Equal("testvm"))
+		})
+
+		It("should return a DeniedError when a service denies the VirtualMachine", func() {
+			server := newPolicyServer(externalpolicy.ReviewResponse{Allowed: false, Message: "zone not permitted"}, nil)
+			reviewer := newReviewer(true, newService("zones", server, v1.ExternalPolicyHookVirtualMachineAdmission))
+
+			err := reviewer.ReviewVirtualMachine(context.Background(), "CREATE", &v1.VirtualMachine{})
+			var deniedErr *externalpolicy.DeniedError
+			Expect(err).To(BeAssignableToTypeOf(deniedErr))
+			Expect(err).To(MatchError("external policy service zones denied the request: zone not permitted"))
+		})
+
+		It("should not consult services when the feature gate is disabled", func() {
+			var requests []externalpolicy.ReviewRequest
+			server := newPolicyServer(externalpolicy.ReviewResponse{Allowed: false}, &requests)
+			reviewer := newReviewer(false, newService("zones", server, v1.ExternalPolicyHookVirtualMachineAdmission))
+
+			Expect(reviewer.ReviewVirtualMachine(context.Background(), "CREATE", &v1.VirtualMachine{})).To(Succeed())
+			Expect(requests).To(BeEmpty())
+		})
+
+		It("should not consult services registered for other hooks", func() {
+			var requests []externalpolicy.ReviewRequest
+			server := newPolicyServer(externalpolicy.ReviewResponse{Allowed: false}, &requests)
+			reviewer := newReviewer(true, newService("licensing", server, v1.ExternalPolicyHookMigrationTargetSelection))
+
+			Expect(reviewer.ReviewVirtualMachine(context.Background(), "CREATE", &v1.VirtualMachine{})).To(Succeed())
+			Expect(requests).To(BeEmpty())
+		})
+
+		DescribeTable("with an unreachable service", func(failurePolicy *v1.ExternalPolicyFailurePolicy, expectErr bool) {
+			server := newPolicyServer(externalpolicy.ReviewResponse{Allowed: true}, nil)
+			service := newService("zones", server, v1.ExternalPolicyHookVirtualMachineAdmission)
+			service.FailurePolicy = failurePolicy
+			server.Close()
+
+			err := newReviewer(true, service).ReviewVirtualMachine(context.Background(), "CREATE", &v1.VirtualMachine{})
+			if expectErr {
+				Expect(err).To(MatchError(ContainSubstring("failed calling external policy service zones")))
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+			}
+		},
+			Entry("should fail closed by default", nil, true),
+			Entry("should fail closed with the Fail policy", pointer.P(v1.ExternalPolicyFailurePolicyFail), true),
+			Entry("should fail open with the Ignore policy", pointer.P(v1.ExternalPolicyFailurePolicyIgnore), false),
+		)
+
+		It("should fail when the service certificate can't be verified", func() {
+			server := newPolicyServer(externalpolicy.ReviewResponse{Allowed: true}, nil)
+			service := newService("zones", server, v1.ExternalPolicyHookVirtualMachineAdmission)
+			service.CABundle = nil
+
+			err := newReviewer(true, service).ReviewVirtualMachine(context.Background(), "CREATE", &v1.VirtualMachine{})
+			Expect(err).To(MatchError(ContainSubstring("certificate")))
+		})
+	})
+
+	Context("migration target selection", func() {
+		It("should not restrict the nodes when no service returns allowed nodes", func() {
+			server := newPolicyServer(externalpolicy.ReviewResponse{Allowed: true}, nil)
+			reviewer := newReviewer(true, newService("licensing", server, v1.ExternalPolicyHookMigrationTargetSelection))
+
+			nodes, err := reviewer.MigrationTargetNodes(context.Background(), &v1.VirtualMachineInstanceMigration{}, &v1.VirtualMachineInstance{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nodes).To(BeNil())
+		})
+
+		It("should intersect the nodes allowed by all services", func() {
+			licensing := newPolicyServer(externalpolicy.ReviewResponse{Allowed: true, AllowedNodes: []string{"node01", "node02", "node03"}}, nil)
+			zones := newPolicyServer(externalpolicy.ReviewResponse{Allowed: true, AllowedNodes: []string{"node02", "node03", "node04"}}, nil)
+			reviewer := newReviewer(true,
+				newService("licensing", licensing, v1.ExternalPolicyHookMigrationTargetSelection),
+				newService("zones", zones, v1.ExternalPolicyHookMigrationTargetSelection),
+			)
+
+			nodes, err := reviewer.MigrationTargetNodes(context.Background(), &v1.VirtualMachineInstanceMigration{}, &v1.VirtualMachineInstance{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nodes).To(Equal([]string{"node02", "node03"}))
+		})
+
+		It("should fail when the services do not have any node in common", func() {
+			licensing := newPolicyServer(externalpolicy.ReviewResponse{Allowed: true, AllowedNodes: []string{"node01"}}, nil)
+			zones := newPolicyServer(externalpolicy.ReviewResponse{Allowed: true, AllowedNodes: []string{"node02"}}, nil)
+			reviewer := newReviewer(true,
+				newService("licensing", licensing, v1.ExternalPolicyHookMigrationTargetSelection),
+				newService("zones", zones, v1.ExternalPolicyHookMigrationTargetSelection),
+			)
+
+			_, err := reviewer.MigrationTargetNodes(context.Background(), &v1.VirtualMachineInstanceMigration{}, &v1.VirtualMachineInstance{})
+			Expect(err).To(MatchError(ContainSubstring("do not permit any common migration target node")))
+		})
+	})
+})
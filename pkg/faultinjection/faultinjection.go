@@ -0,0 +1,188 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package faultinjection
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// Annotation requests the faults injected in a VMI, as a JSON Config.
+// It is only honored when the FaultInjection feature gate is enabled.
+const Annotation = "faultinjection.kubevirt.io/faults"
+
+const (
+	maxHookDelay     = 5 * time.Minute
+	minMigrationStep = 1
+	maxMigrationStep = 99
+)
+
+// Config lists the faults injected in a VMI
+type Config struct {
+	// OnDefineDomain delays or fails the OnDefineDomain hook point
+	OnDefineDomain *HookFault `json:"onDefineDomain,omitempty"`
+	// Migration drops the migrations of the VMI
+	Migration *MigrationFault `json:"migration,omitempty"`
+	// Storage throttles the disks of the VMI
+	Storage *StorageFault `json:"storage,omitempty"`
+}
+
+// HookFault is injected before the hook sidecars are called
+type HookFault struct {
+	// Delay is waited before calling the hook sidecars, up to 5 minutes
+	Delay metav1.Duration `json:"delay,omitempty"`
+	// Fail fails the hook point, after the delay
+	Fail bool `json:"fail,omitempty"`
+}
+
+// MigrationFault is injected on the source of a migration
+type MigrationFault struct {
+	// DropConnectionAtProgress is the percentage of the memory transferred at which the
+	// migration connection is dropped, between 1 and 99
+	DropConnectionAtProgress uint32 `json:"dropConnectionAtProgress"`
+}
+
+// StorageFault throttles the I/O of disks
+type StorageFault struct {
+	// Disks are the names of the throttled disks, all the disks when empty
+	Disks []string `json:"disks,omitempty"`
+	// IOPS limits the read and write operations per second of each disk
+	IOPS uint64 `json:"iops,omitempty"`
+	// BytesPerSecond limits the read and write throughput of each disk
+	BytesPerSecond uint64 `json:"bytesPerSecond,omitempty"`
+}
+
+var enabled atomic.Bool
+
+// Enable makes the faults requested by the VMIs injected in this process.
+// virt-launcher enables it when the FaultInjection feature gate is enabled.
+func Enable() {
+	enabled.Store(true)
+}
+
+// Parse reads and validates the faults of the VMI from its annotation.
+// It returns nil when the annotation is not set.
+func Parse(vmi *v1.VirtualMachineInstance) (*Config, error) {
+	value, exists := vmi.Annotations[Annotation]
+	if !exists {
+		return nil, nil
+	}
+
+	config := &Config{}
+	if err := json.Unmarshal([]byte(value), config); err != nil {
+		return nil, fmt.Errorf("invalid fault injection: %v", err)
+	}
+
+	if hook := config.OnDefineDomain; hook != nil {
+		if hook.Delay.Duration < 0 || hook.Delay.Duration > maxHookDelay {
+			return nil, fmt.Errorf("invalid fault injection: onDefineDomain delay %s is not between 0 and %s",
+				hook.Delay.Duration, maxHookDelay)
+		}
+	}
+
+	if migration := config.Migration; migration != nil {
+		if migration.DropConnectionAtProgress < minMigrationStep || migration.DropConnectionAtProgress > maxMigrationStep {
+			return nil, fmt.Errorf("invalid fault injection: migration dropConnectionAtProgress %d is not between %d and %d",
+				migration.DropConnectionAtProgress, minMigrationStep, maxMigrationStep)
+		}
+	}
+
+	if storage := config.Storage; storage != nil {
+		if storage.IOPS == 0 && storage.BytesPerSecond == 0 {
+			return nil, fmt.Errorf("invalid fault injection: storage requires iops or bytesPerSecond")
+		}
+		for _, name := range storage.Disks {
+			if !hasDisk(vmi, name) {
+				return nil, fmt.Errorf("invalid fault injection: disk %q does not exist", name)
+			}
+		}
+	}
+
+	return config, nil
+}
+
+// ForVMI returns the faults to inject in the VMI, or nil when fault injection is not enabled
+// in this process or no valid fault is requested.
+func ForVMI(vmi *v1.VirtualMachineInstance) *Config {
+	if !enabled.Load() {
+		return nil
+	}
+	config, err := Parse(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("Ignoring the faults of the VMI")
+		return nil
+	}
+	return config
+}
+
+// InjectOnDefineDomain waits for the delay of the OnDefineDomain fault and fails if requested
+func (c *Config) InjectOnDefineDomain() error {
+	if c == nil || c.OnDefineDomain == nil {
+		return nil
+	}
+	if c.OnDefineDomain.Delay.Duration > 0 {
+		time.Sleep(c.OnDefineDomain.Delay.Duration)
+	}
+	if c.OnDefineDomain.Fail {
+		return fmt.Errorf("OnDefineDomain hook point failed by fault injection")
+	}
+	return nil
+}
+
+// DropsMigration tells if the migration connection has to be dropped, given the amount of data
+// the migration has left to transfer.
+func (c *Config) DropsMigration(remaining, total uint64) bool {
+	if c == nil || c.Migration == nil || total == 0 || remaining > total {
+		return false
+	}
+	return (total-remaining)*100/total >= uint64(c.Migration.DropConnectionAtProgress)
+}
+
+// ThrottledDisk returns the throttling of the disk, or nil if it is not throttled
+func (c *Config) ThrottledDisk(name string) *StorageFault {
+	if c == nil || c.Storage == nil {
+		return nil
+	}
+	if len(c.Storage.Disks) == 0 {
+		return c.Storage
+	}
+	for _, disk := range c.Storage.Disks {
+		if disk == name {
+			return c.Storage
+		}
+	}
+	return nil
+}
+
+func hasDisk(vmi *v1.VirtualMachineInstance, name string) bool {
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.Name == name {
+			return true
+		}
+	}
+	return false
+}
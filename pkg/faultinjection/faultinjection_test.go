@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package faultinjection_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/faultinjection"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Fault injection", func() {
+	newVMI := func(faults string) *v1.VirtualMachineInstance {
+		return libvmi.New(
+			libvmi.WithContainerDisk("rootdisk", "fedora"),
+			libvmi.WithAnnotation(faultinjection.Annotation, faults),
+		)
+	}
+
+	It("should not request faults without annotation", func() {
+		Expect(faultinjection.Parse(libvmi.New())).To(BeNil())
+	})
+
+	It("should parse the faults", func() {
+		config, err := faultinjection.Parse(newVMI(`{
+			"onDefineDomain": {"delay": "30s", "fail": true},
+			"migration": {"dropConnectionAtProgress": 50},
+			"storage": {"disks": ["rootdisk"], "iops": 100, "bytesPerSecond": 1048576}
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(Equal(&faultinjection.Config{
+			OnDefineDomain: &faultinjection.HookFault{Delay: metav1.Duration{Duration: 30 * time.Second}, Fail: true},
+			Migration:      &faultinjection.MigrationFault{DropConnectionAtProgress: 50},
+			Storage:        &faultinjection.StorageFault{Disks: []string{"rootdisk"}, IOPS: 100, BytesPerSecond: 1048576},
+		}))
+	})
+
+	DescribeTable("should reject invalid faults", func(faults, expectedError string) {
+		_, err := faultinjection.Parse(newVMI(faults))
+		Expect(err).To(MatchError(expectedError))
+	},
+		Entry("malformed", `{"migration": 50}`,
+			"invalid fault injection: json: cannot unmarshal number into Go struct field Config.migration of type faultinjection.MigrationFault"),
+		Entry("hook delay too long", `{"onDefineDomain": {"delay": "1h"}}`,
+			"invalid fault injection: onDefineDomain delay 1h0m0s is not between 0 and 5m0s"),
+		Entry("migration progress missing", `{"migration": {}}`,
+			"invalid fault injection: migration dropConnectionAtProgress 0 is not between 1 and 99"),
+		Entry("migration progress too high", `{"migration": {"dropConnectionAtProgress": 100}}`,
+			"invalid fault injection: migration dropConnectionAtProgress 100 is not between 1 and 99"),
+		Entry("storage without limit", `{"storage": {}}`,
+			"invalid fault injection: storage requires iops or bytesPerSecond"),
+		Entry("storage of an unknown disk", `{"storage": {"disks": ["datadisk"], "iops": 10}}`,
+			`invalid fault injection: disk "datadisk" does not exist`),
+	)
+
+	It("should only inject faults once enabled", func() {
+		vmi := newVMI(`{"migration": {"dropConnectionAtProgress": 50}}`)
+		Expect(faultinjection.ForVMI(vmi)).To(BeNil())
+
+		faultinjection.Enable()
+		Expect(faultinjection.ForVMI(vmi)).ToNot(BeNil())
+		Expect(faultinjection.ForVMI(newVMI(`{"migration": {}}`))).To(BeNil())
+	})
+
+	Context("OnDefineDomain", func() {
+		It("should not fail without fault", func() {
+			var config *faultinjection.Config
+			Expect(config.InjectOnDefineDomain()).To(Succeed())
+		})
+
+		It("should delay the hook point", func() {
+			config := &faultinjection.Config{
+				OnDefineDomain: &faultinjection.HookFault{Delay: metav1.Duration{Duration: 50 * time.Millisecond}},
+			}
+			start := time.Now()
+			Expect(config.InjectOnDefineDomain()).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+		})
+
+		It("should fail the hook point", func() {
+			config := &faultinjection.Config{OnDefineDomain: &faultinjection.HookFault{Fail: true}}
+			Expect(config.InjectOnDefineDomain()).To(MatchError("OnDefineDomain hook point failed by fault injection"))
+		})
+	})
+
+	DescribeTable("should drop the migration", func(config *faultinjection.Config, remaining, total uint64, expected bool) {
+		Expect(config.DropsMigration(remaining, total)).To(Equal(expected))
+	},
+		Entry("not without fault", nil, uint64(0), uint64(100), false),
+		Entry("not before the progress", &faultinjection.Config{Migration: &faultinjection.MigrationFault{DropConnectionAtProgress: 50}},
+			uint64(51), uint64(100), false),
+		Entry("at the progress", &faultinjection.Config{Migration: &faultinjection.MigrationFault{DropConnectionAtProgress: 50}},
+			uint64(50), uint64(100), true),
+		Entry("not before the total is known", &faultinjection.Config{Migration: &faultinjection.MigrationFault{DropConnectionAtProgress: 50}},
+			uint64(0), uint64(0), false),
+	)
+
+	It("should throttle all the disks when none is listed", func() {
+		config := &faultinjection.Config{Storage: &faultinjection.StorageFault{IOPS: 10}}
+		Expect(config.ThrottledDisk("rootdisk")).To(Equal(config.Storage))
+	})
+
+	It("should only throttle the listed disks", func() {
+		config := &faultinjection.Config{Storage: &faultinjection.StorageFault{Disks: []string{"datadisk"}, IOPS: 10}}
+		Expect(config.ThrottledDisk("datadisk")).To(Equal(config.Storage))
+		Expect(config.ThrottledDisk("rootdisk")).To(BeNil())
+	})
+})
@@ -41,6 +41,7 @@ It has these top-level messages:
 	SEVInfoResponse
 	LaunchMeasurementResponse
 	InjectLaunchSecretRequest
+	FlattenRequest
 */
 package v1
 
@@ -430,6 +431,7 @@ type VirtualMachineOptions struct {
 	ClusterConfig             *ClusterConfig                        `protobuf:"bytes,7,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
 	InterfaceDomainAttachment map[string]string                     `protobuf:"bytes,8,rep,name=interfaceDomainAttachment" json:"interfaceDomainAttachment,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	InterfaceMigration        map[string]*InterfaceBindingMigration `protobuf:"bytes,9,rep,name=interfaceMigration" json:"interfaceMigration,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DeniedGuestAgentCommands  []string                              `protobuf:"bytes,10,rep,name=deniedGuestAgentCommands" json:"deniedGuestAgentCommands,omitempty"`
 }
 
 func (m *VirtualMachineOptions) Reset()                    { *m = VirtualMachineOptions{} }
@@ -500,6 +502,13 @@ func (m *VirtualMachineOptions) GetInterfaceMigration() map[string]*InterfaceBin
 	return nil
 }
 
+func (m *VirtualMachineOptions) GetDeniedGuestAgentCommands() []string {
+	if m != nil {
+		return m.DeniedGuestAgentCommands
+	}
+	return nil
+}
+
 type VMIRequest struct {
 	Vmi     *VMI                   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
 	Options *VirtualMachineOptions `protobuf:"bytes,2,opt,name=options" json:"options,omitempty"`
@@ -932,6 +941,30 @@ func (m *InjectLaunchSecretRequest) GetOptions() []byte {
 	return nil
 }
 
+type FlattenRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *FlattenRequest) Reset()                    { *m = FlattenRequest{} }
+func (m *FlattenRequest) String() string            { return proto.CompactTextString(m) }
+func (*FlattenRequest) ProtoMessage()               {}
+func (*FlattenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *FlattenRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *FlattenRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -964,6 +997,7 @@ func init() {
 	proto.RegisterType((*SEVInfoResponse)(nil), "kubevirt.cmd.v1.SEVInfoResponse")
 	proto.RegisterType((*LaunchMeasurementResponse)(nil), "kubevirt.cmd.v1.LaunchMeasurementResponse")
 	proto.RegisterType((*InjectLaunchSecretRequest)(nil), "kubevirt.cmd.v1.InjectLaunchSecretRequest")
+	proto.RegisterType((*FlattenRequest)(nil), "kubevirt.cmd.v1.FlattenRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1007,6 +1041,7 @@ type CmdClient interface {
 	GetSEVInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*SEVInfoResponse, error)
 	GetLaunchMeasurement(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecretRequest, opts ...grpc.CallOption) (*Response, error)
+	FlattenVirtualMachineVolumes(ctx context.Context, in *FlattenRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1287,6 +1322,15 @@ func (c *cmdClient) InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecr
 	return out, nil
 }
 
+func (c *cmdClient) FlattenVirtualMachineVolumes(ctx context.Context, in *FlattenRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/FlattenVirtualMachineVolumes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1320,6 +1364,7 @@ type CmdServer interface {
 	GetSEVInfo(context.Context, *EmptyRequest) (*SEVInfoResponse, error)
 	GetLaunchMeasurement(context.Context, *VMIRequest) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(context.Context, *InjectLaunchSecretRequest) (*Response, error)
+	FlattenVirtualMachineVolumes(context.Context, *FlattenRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -1866,6 +1911,24 @@ func _Cmd_InjectLaunchSecret_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_FlattenVirtualMachineVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlattenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).FlattenVirtualMachineVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/FlattenVirtualMachineVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).FlattenVirtualMachineVolumes(ctx, req.(*FlattenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -1990,6 +2053,10 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InjectLaunchSecret",
 			Handler:    _Cmd_InjectLaunchSecret_Handler,
 		},
+		{
+			MethodName: "FlattenVirtualMachineVolumes",
+			Handler:    _Cmd_FlattenVirtualMachineVolumes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -1998,118 +2065,120 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1802 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x6f, 0x23, 0xb7,
-	0x11, 0x3f, 0x59, 0xb2, 0x2d, 0x8d, 0xff, 0xe4, 0x8e, 0xfe, 0x93, 0xb5, 0xdb, 0xbb, 0x73, 0x89,
-	0xe2, 0xe0, 0x14, 0x89, 0xdd, 0xbb, 0x5c, 0x82, 0xe2, 0x50, 0x04, 0x17, 0xcb, 0xb2, 0xe3, 0xe4,
-	0x7c, 0xa7, 0xac, 0x6c, 0x1f, 0x9a, 0x36, 0x08, 0xe8, 0x5d, 0x5a, 0x66, 0xbd, 0x4b, 0x2a, 0x4b,
-	0xae, 0x7a, 0xca, 0x53, 0x81, 0x14, 0x7d, 0x28, 0xd0, 0xcf, 0xd7, 0xb7, 0x7e, 0x8b, 0x02, 0x7d,
-	0x0c, 0xc8, 0xe5, 0xca, 0x2b, 0xed, 0xae, 0x7d, 0x86, 0xf4, 0x64, 0x92, 0x33, 0xf3, 0x9b, 0x21,
-	0x39, 0x33, 0xfc, 0x69, 0x0d, 0x1f, 0xf5, 0xae, 0xba, 0xbb, 0x97, 0x84, 0xfb, 0x01, 0x8d, 0x3e,
-	0x09, 0x48, 0xcc, 0xbd, 0x4b, 0x1a, 0x7d, 0xe2, 0x89, 0x70, 0xd7, 0x0b, 0xfd, 0xdd, 0xfe, 0x53,
-	0xfd, 0x67, 0xa7, 0x17, 0x09, 0x25, 0xd0, 0x07, 0x57, 0xf1, 0x39, 0xed, 0xb3, 0x48, 0xed, 0xe8,
-	0xb5, 0xfe, 0x53, 0x7c, 0x01, 0x2b, 0xdf, 0xd2, 0x30, 0x3e, 0xa3, 0x91, 0x64, 0x82, 0xbb, 0x54,
-	0xf6, 0x04, 0x97, 0x14, 0x7d, 0x06, 0xf5, 0xc8, 0x8e, 0x9d, 0xca, 0x56, 0x65, 0x7b, 0xe1, 0xd9,
-	0xc6, 0xce, 0x98, 0xe9, 0x4e, 0xaa, 0xec, 0x0e, 0x55, 0x91, 0x03, 0xf3, 0xfd, 0x04, 0xc9, 0x99,
-	0xd9, 0xaa, 0x6c, 0x37, 0xdc, 0x74, 0x8a, 0x1f, 0x43, 0xf5, 0xec, 0xf8, 0xc8, 0x28, 0x84, 0xec,
-	0x6b, 0x29, 0xb8, 0x81, 0x5d, 0x74, 0xd3, 0x29, 0x7e, 0x0a, 0xd5, 0x66, 0xfb, 0x14, 0x2d, 0xc3,
-	0x0c, 0xf3, 0x8d, 0x6c, 0xc9, 0x9d, 0x61, 0x3e, 0xda, 0x84, 0xba, 0x64, 0xe7, 0x01, 0xe3, 0x5d,
-	0xe9, 0xcc, 0x6c, 0x55, 0xb7, 0x97, 0xdc, 0xe1, 0x1c, 0xef, 0xc2, 0x7c, 0x27, 0x19, 0xe7, 0xcc,
-	0x56, 0x61, 0xb6, 0x4f, 0x82, 0x98, 0x9a, 0x30, 0x6a, 0x6e, 0x32, 0xc1, 0x2d, 0x98, 0x6d, 0x93,
-	0x2e, 0x95, 0x5a, 0xec, 0x89, 0x98, 0x2b, 0x63, 0x51, 0x73, 0x93, 0x09, 0x42, 0x50, 0x8b, 0x39,
-	0x53, 0x36, 0x74, 0x33, 0xd6, 0x6b, 0x92, 0xfd, 0x44, 0x9d, 0xaa, 0x81, 0x36, 0x63, 0xfc, 0x1c,
-	0xe6, 0x8e, 0x69, 0x28, 0xa2, 0x01, 0x5a, 0x87, 0x39, 0x12, 0x66, 0x80, 0xec, 0xac, 0x08, 0x09,
-	0xff, 0xa7, 0x02, 0xb5, 0x26, 0x0d, 0x82, 0x5c, 0xac, 0xbb, 0x30, 0x17, 0x1a, 0x38, 0xa3, 0xbe,
-	0xf0, 0xec, 0xc3, 0xdc, 0x49, 0x27, 0xde, 0x5c, 0xab, 0x86, 0x3e, 0x86, 0xd9, 0x9e, 0xde, 0x86,
-	0x53, 0xdd, 0xaa, 0x6e, 0x2f, 0x3c, 0x5b, 0xcf, 0xe9, 0x9b, 0x4d, 0xba, 0x89, 0x12, 0xfa, 0x1c,
-	0x1a, 0x3e, 0x93, 0x8a, 0x70, 0x8f, 0x4a, 0xa7, 0x66, 0x2c, 0x9c, 0x9c, 0x85, 0x3d, 0x47, 0xf7,
-	0x5a, 0x15, 0x6d, 0x43, 0xcd, 0xeb, 0xc5, 0xd2, 0x99, 0x35, 0x26, 0xab, 0x39, 0x93, 0x66, 0xfb,
-	0xd4, 0x35, 0x1a, 0xf8, 0x25, 0xd4, 0x4f, 0x44, 0x4f, 0x04, 0xa2, 0x3b, 0x40, 0xcf, 0x01, 0x78,
-	0x1c, 0x92, 0x1f, 0x3c, 0x1a, 0x04, 0xd2, 0xa9, 0x18, 0xdb, 0xb5, 0xbc, 0x2d, 0x0d, 0x02, 0xb7,
-	0xa1, 0x15, 0xf5, 0x48, 0xe2, 0x7f, 0x55, 0x60, 0xae, 0x73, 0xbc, 0xc7, 0x84, 0x44, 0x18, 0x16,
-	0x43, 0xc2, 0xe3, 0x0b, 0xe2, 0xa9, 0x38, 0xa2, 0x91, 0x39, 0xa7, 0x86, 0x3b, 0xb2, 0xa6, 0xb3,
-	0xa8, 0x17, 0x09, 0x3f, 0xf6, 0xd2, 0x13, 0x4e, 0xa7, 0xd9, 0x04, 0xac, 0x8e, 0x24, 0x20, 0xba,
-	0x0f, 0x55, 0x79, 0x15, 0x3b, 0x35, 0xb3, 0xaa, 0x87, 0xfa, 0xf2, 0x2e, 0x48, 0xc8, 0x82, 0x81,
-	0x33, 0x6b, 0x16, 0xed, 0x0c, 0xff, 0xb3, 0x02, 0xf5, 0x7d, 0x26, 0xaf, 0x8e, 0xf8, 0x85, 0x30,
-	0x4a, 0x22, 0x0a, 0x89, 0xb2, 0x81, 0xd8, 0x19, 0xda, 0x82, 0x85, 0x73, 0xe2, 0x5d, 0x31, 0xde,
-	0x3d, 0x60, 0x01, 0xb5, 0x61, 0x64, 0x97, 0xd0, 0x23, 0x00, 0x1d, 0x2f, 0x09, 0x3a, 0x69, 0xfe,
-	0xd4, 0xdc, 0xcc, 0x8a, 0x46, 0xd0, 0x47, 0x92, 0x2a, 0xd4, 0x8c, 0x42, 0x76, 0x09, 0xff, 0xaf,
-	0x02, 0x4b, 0xcd, 0x20, 0x96, 0x8a, 0x46, 0x4d, 0xc1, 0x2f, 0x58, 0x17, 0xed, 0x00, 0x6a, 0xbd,
-	0xeb, 0x11, 0xee, 0xeb, 0xf8, 0x64, 0x8b, 0x93, 0xf3, 0x80, 0x26, 0xa9, 0x54, 0x77, 0x0b, 0x24,
-	0xe8, 0x8f, 0xb0, 0x71, 0x10, 0x51, 0xaa, 0xf3, 0xc1, 0xa5, 0x3d, 0x11, 0x29, 0xc6, 0xbb, 0xfb,
-	0x4c, 0x26, 0x66, 0x33, 0xc6, 0xac, 0x5c, 0x01, 0xbd, 0x00, 0x67, 0x4f, 0x78, 0x97, 0x72, 0x9f,
-	0xc9, 0x5e, 0x40, 0x06, 0x07, 0x22, 0x6a, 0x1d, 0x1c, 0x1d, 0xc6, 0x54, 0x2a, 0x69, 0xf6, 0x53,
-	0x77, 0x4b, 0xe5, 0xda, 0xb6, 0x43, 0x23, 0x46, 0x82, 0xa6, 0xe0, 0x52, 0x04, 0xf4, 0x95, 0xb8,
-	0x76, 0x5c, 0x4b, 0x6c, 0xcb, 0xe4, 0xf8, 0x53, 0xd8, 0x38, 0xe2, 0x8a, 0x46, 0x17, 0xc4, 0xa3,
-	0x7b, 0x8c, 0xfb, 0x8c, 0x77, 0x8f, 0x59, 0x37, 0x22, 0x4a, 0xdf, 0xe3, 0xba, 0x2e, 0x3e, 0x75,
-	0x29, 0xfc, 0xf4, 0x42, 0x92, 0x19, 0xfe, 0xef, 0x3c, 0xac, 0x9d, 0x25, 0x87, 0x77, 0x4c, 0xbc,
-	0x4b, 0xc6, 0xe9, 0x9b, 0x9e, 0x36, 0x90, 0xe8, 0x1b, 0x58, 0x1d, 0x15, 0x24, 0x99, 0x66, 0xfb,
-	0x5a, 0xbe, 0xda, 0x12, 0xb1, 0x5b, 0x68, 0x84, 0x9e, 0xc3, 0xda, 0x31, 0x0d, 0xf7, 0x48, 0x10,
-	0x08, 0xc1, 0x3b, 0x8a, 0x28, 0xd9, 0xa6, 0x11, 0x13, 0xc9, 0x69, 0x2e, 0xb9, 0xc5, 0x42, 0xf4,
-	0x7b, 0x58, 0x69, 0x47, 0x54, 0xaf, 0x7b, 0x44, 0x51, 0xff, 0x4c, 0x04, 0x71, 0x68, 0xeb, 0xb7,
-	0xe1, 0x16, 0x89, 0x74, 0x03, 0x56, 0xb6, 0xa6, 0xcc, 0x79, 0x15, 0x35, 0xe0, 0xb4, 0xe8, 0xdc,
-	0xa1, 0x2a, 0xea, 0x40, 0xc3, 0x24, 0x80, 0xce, 0x5d, 0x5b, 0xb9, 0x9f, 0xe5, 0xec, 0x0a, 0x8f,
-	0x69, 0x67, 0x68, 0xd7, 0xe2, 0x2a, 0x1a, 0xb8, 0xd7, 0x38, 0x25, 0x59, 0x37, 0x57, 0x9a, 0x75,
-	0xfb, 0xb0, 0xe4, 0x65, 0xd3, 0xd6, 0x99, 0x37, 0x1b, 0x78, 0x94, 0x6f, 0x03, 0x59, 0x2d, 0x77,
-	0xd4, 0x08, 0xfd, 0x5c, 0x81, 0x0d, 0x96, 0xa6, 0xc1, 0xbe, 0x08, 0x09, 0xe3, 0x5f, 0x2a, 0x45,
-	0xbc, 0xcb, 0x90, 0x72, 0xe5, 0xd4, 0xcd, 0xde, 0x5a, 0xef, 0xb9, 0xb7, 0xa3, 0x32, 0x9c, 0x64,
-	0xaf, 0xe5, 0x7e, 0x10, 0x07, 0x34, 0x14, 0x0e, 0x93, 0xd0, 0x69, 0x18, 0xef, 0x5f, 0xdc, 0xd5,
-	0xfb, 0x10, 0x20, 0x71, 0x5b, 0x80, 0xbc, 0xf9, 0x16, 0x96, 0x47, 0x2f, 0x42, 0x37, 0xae, 0x2b,
-	0x3a, 0xb0, 0xd9, 0xae, 0x87, 0x68, 0x37, 0xfb, 0xb8, 0x15, 0x25, 0x46, 0xda, 0xbd, 0xec, 0xbb,
-	0xf7, 0x62, 0xe6, 0x0f, 0x95, 0xcd, 0x57, 0xf0, 0xe8, 0xe6, 0x53, 0x28, 0x70, 0x34, 0xf2, 0x8a,
-	0x36, 0xb2, 0x68, 0x3f, 0xc2, 0x87, 0x25, 0xbb, 0x2a, 0x80, 0x79, 0x39, 0x1a, 0xef, 0xef, 0x72,
-	0xf1, 0x96, 0x56, 0x7b, 0xc6, 0x25, 0xee, 0x03, 0x9c, 0x1d, 0x1f, 0xb9, 0xf4, 0x47, 0xdd, 0x60,
-	0xd0, 0x13, 0xa8, 0xf6, 0x43, 0x66, 0x6b, 0x38, 0xff, 0x38, 0x69, 0x4d, 0xad, 0x80, 0x5e, 0xc2,
-	0xbc, 0x48, 0xae, 0xc1, 0x7a, 0x7f, 0xf2, 0x7e, 0x97, 0xe6, 0xa6, 0x66, 0xf8, 0x04, 0xee, 0x5f,
-	0xc7, 0x73, 0x47, 0xef, 0xce, 0xa8, 0xf7, 0xc5, 0x6b, 0xd4, 0x9f, 0x2b, 0xb0, 0xd0, 0x7a, 0x47,
-	0xbd, 0x14, 0xf1, 0x11, 0x80, 0x6f, 0x6e, 0xe5, 0x35, 0x09, 0xa9, 0x3d, 0xbc, 0xcc, 0x8a, 0x46,
-	0x6a, 0x8a, 0x30, 0x24, 0xdc, 0x4f, 0x9f, 0x3c, 0x3b, 0xd5, 0x5c, 0xe3, 0xcb, 0xa8, 0x9b, 0x36,
-	0x13, 0x33, 0x46, 0x4f, 0x60, 0x59, 0xb1, 0x90, 0x8a, 0x58, 0x75, 0xa8, 0x27, 0xb8, 0x2f, 0x4d,
-	0x0f, 0x99, 0x75, 0xc7, 0x56, 0xf1, 0x32, 0x2c, 0xb6, 0xc2, 0x9e, 0x1a, 0xd8, 0x28, 0xf0, 0x17,
-	0x50, 0x77, 0x33, 0x5c, 0x4e, 0xc6, 0x9e, 0x47, 0xa5, 0xb4, 0x0f, 0x4c, 0x3a, 0xd5, 0x92, 0x90,
-	0x4a, 0x49, 0xba, 0x69, 0x62, 0xa4, 0x53, 0xfc, 0x03, 0x2c, 0x27, 0xb9, 0x35, 0x29, 0x91, 0x5c,
-	0x87, 0xb9, 0x64, 0xf3, 0xd6, 0x83, 0x9d, 0x61, 0x0e, 0x2b, 0x89, 0x03, 0xd3, 0x5d, 0x27, 0xf5,
-	0xb2, 0x05, 0x0b, 0xfe, 0x35, 0x5a, 0xfa, 0x88, 0x67, 0x96, 0xf0, 0x3b, 0x78, 0x60, 0x1e, 0x34,
-	0x53, 0x4d, 0x13, 0x7a, 0xfb, 0x18, 0x1e, 0x74, 0xc7, 0xb1, 0xac, 0xcf, 0xbc, 0x00, 0xff, 0xa3,
-	0x02, 0x6b, 0xc6, 0xf5, 0xa9, 0xa4, 0xd1, 0x2b, 0x26, 0xd5, 0xa4, 0xee, 0x9f, 0xc3, 0x5a, 0xb7,
-	0x08, 0xcf, 0x86, 0x50, 0x2c, 0xc4, 0xff, 0xae, 0x80, 0x63, 0xc2, 0xd0, 0x9c, 0x46, 0x0e, 0xa4,
-	0xa2, 0xe1, 0xc4, 0xc7, 0xfe, 0x02, 0x9c, 0x6e, 0x09, 0xa4, 0x0d, 0xa6, 0x54, 0x8e, 0x07, 0xb0,
-	0x98, 0x94, 0xcd, 0x64, 0x21, 0x6c, 0x42, 0x9d, 0xbe, 0x63, 0xaa, 0x29, 0xfc, 0xc4, 0xe5, 0xac,
-	0x3b, 0x9c, 0xeb, 0xdc, 0x93, 0xca, 0x7f, 0x13, 0x2b, 0x4b, 0x21, 0xed, 0x0c, 0x7f, 0x07, 0xf7,
-	0xcd, 0x49, 0xb4, 0x35, 0x51, 0x7e, 0xcf, 0xb2, 0xcd, 0x17, 0xe2, 0x4c, 0x61, 0x21, 0x7e, 0x6d,
-	0xf3, 0x2c, 0xc1, 0x9e, 0x68, 0x6f, 0x58, 0xc0, 0x92, 0xe6, 0x74, 0x3f, 0xd1, 0xbb, 0x76, 0xab,
-	0xcf, 0x61, 0x3d, 0xe6, 0x17, 0xc6, 0xf4, 0xa4, 0x28, 0xe8, 0x12, 0x29, 0x7e, 0x0b, 0x0f, 0x92,
-	0x5f, 0x28, 0xfb, 0x71, 0xd8, 0xbb, 0xab, 0xd3, 0x4d, 0xa8, 0xfb, 0x71, 0xd8, 0x6b, 0x13, 0x75,
-	0x69, 0x2f, 0x7f, 0x38, 0xc7, 0xe7, 0xf0, 0x41, 0xa7, 0x75, 0x36, 0x8d, 0xda, 0xd3, 0xcd, 0x8c,
-	0xf6, 0x0d, 0x2b, 0xb2, 0x8d, 0xd8, 0x4e, 0xf1, 0xdf, 0x2b, 0xb0, 0xf1, 0xca, 0xfc, 0x66, 0x3e,
-	0xa6, 0x44, 0xc6, 0x11, 0xd5, 0x0f, 0xe2, 0x14, 0x4a, 0x3d, 0x18, 0xc7, 0xb4, 0x8e, 0xf3, 0x02,
-	0xfc, 0xbd, 0xe6, 0xbb, 0x7f, 0xa5, 0x9e, 0x4a, 0xe2, 0xe8, 0x50, 0x2f, 0xa2, 0x6a, 0x6a, 0x4f,
-	0xcd, 0xb3, 0xff, 0xaf, 0x40, 0xb5, 0x19, 0xfa, 0xe8, 0x35, 0xa0, 0xce, 0x80, 0x7b, 0xa3, 0xcf,
-	0x1d, 0xfa, 0x55, 0x21, 0x64, 0xe2, 0x7c, 0xb3, 0x7c, 0xb3, 0xf8, 0x1e, 0x7a, 0x03, 0x2b, 0x6d,
-	0x12, 0x4b, 0x3a, 0x35, 0xc0, 0x6f, 0x61, 0xed, 0x94, 0xf7, 0xa6, 0x0a, 0xd9, 0x81, 0xd5, 0xa4,
-	0x16, 0xc6, 0x10, 0xf3, 0x5c, 0x74, 0xa4, 0x64, 0x6e, 0x06, 0x75, 0x61, 0xfd, 0xd4, 0x56, 0xc2,
-	0xd4, 0x02, 0x3d, 0x01, 0xa7, 0x23, 0x2e, 0x94, 0x4b, 0xcf, 0x85, 0x50, 0x53, 0x43, 0x75, 0x61,
-	0xbd, 0x73, 0x19, 0x2b, 0x5f, 0xfc, 0x8d, 0x4f, 0x0d, 0xf3, 0x35, 0xa0, 0x6f, 0x58, 0x10, 0x4c,
-	0x0d, 0xaf, 0x0d, 0xab, 0xfb, 0x34, 0xa0, 0x6a, 0x7a, 0x67, 0xf9, 0x16, 0xd6, 0x12, 0xc6, 0x36,
-	0x0e, 0xf9, 0x9b, 0xfc, 0x97, 0x95, 0x31, 0x66, 0x77, 0x6b, 0xc6, 0xeb, 0x0a, 0x1a, 0x1a, 0x9d,
-	0x90, 0xa8, 0x4b, 0xd5, 0x04, 0x91, 0xfe, 0x09, 0x1e, 0x36, 0x09, 0xf7, 0xe8, 0xd8, 0x69, 0x5e,
-	0xff, 0xda, 0x9d, 0xec, 0xea, 0x59, 0x97, 0x93, 0x20, 0x09, 0xb2, 0x2d, 0xfc, 0x66, 0x40, 0x09,
-	0x8f, 0x7b, 0x13, 0x60, 0xfe, 0x19, 0x1e, 0x1f, 0x30, 0x4e, 0x02, 0x36, 0x9e, 0xf8, 0xd3, 0x08,
-	0xf8, 0x35, 0xa0, 0xaf, 0x84, 0xea, 0x05, 0x71, 0xf7, 0x2b, 0x21, 0xd5, 0x3e, 0xed, 0x33, 0x8f,
-	0xca, 0x09, 0xf0, 0x8e, 0xa1, 0x71, 0x48, 0x55, 0xc2, 0x16, 0xd1, 0xc3, 0x9c, 0x66, 0x96, 0xf7,
-	0x6e, 0x3e, 0xce, 0xff, 0x84, 0x1a, 0xa1, 0xb1, 0x26, 0xa9, 0x96, 0x87, 0x70, 0x86, 0x1b, 0xde,
-	0x86, 0xf9, 0xdb, 0x12, 0xcc, 0x11, 0xe6, 0x6a, 0x5a, 0xd4, 0xe2, 0x21, 0x55, 0x43, 0x96, 0x79,
-	0x1b, 0x2c, 0xce, 0x89, 0x73, 0x04, 0xd5, 0x80, 0xd6, 0x0f, 0xa9, 0x61, 0x73, 0xb7, 0xc6, 0xf9,
-	0xa4, 0x18, 0x30, 0xc7, 0x04, 0xef, 0xa1, 0xbf, 0x98, 0x23, 0xc8, 0xb0, 0xb2, 0xdb, 0xa0, 0x3f,
-	0x2a, 0x86, 0x2e, 0xe2, 0x75, 0xf7, 0xd0, 0x1e, 0xd4, 0x34, 0xfb, 0xb9, 0x0d, 0xf3, 0xc6, 0x3b,
-	0x6f, 0x41, 0x4d, 0xb3, 0x43, 0xf4, 0xeb, 0x3c, 0xc6, 0xf5, 0x6f, 0xad, 0xcd, 0x87, 0x25, 0xd2,
-	0x4c, 0x33, 0x6e, 0x0c, 0xd9, 0x58, 0x41, 0xd3, 0x18, 0x67, 0x81, 0x65, 0x77, 0x92, 0x25, 0x73,
-	0xa6, 0x7a, 0x9c, 0xb1, 0xaa, 0x19, 0x92, 0x26, 0x84, 0x4b, 0xbe, 0xf9, 0x66, 0x18, 0xd5, 0x6d,
-	0x3d, 0x4f, 0xdf, 0x4d, 0xe6, 0x53, 0xfe, 0xdd, 0xd3, 0xb3, 0xe0, 0xff, 0x00, 0xb6, 0x8f, 0xe4,
-	0x58, 0x43, 0xb3, 0x7d, 0x2a, 0x27, 0x7c, 0xec, 0x72, 0x98, 0xf6, 0x93, 0xfa, 0x24, 0x7c, 0x04,
-	0x0e, 0xa9, 0xb2, 0x84, 0xf1, 0xb6, 0xed, 0x6f, 0xe5, 0x3f, 0xfb, 0x8d, 0x32, 0x4d, 0x7c, 0x0f,
-	0x11, 0x58, 0x3d, 0xa4, 0x2a, 0x47, 0x0e, 0x6f, 0x0e, 0x31, 0xff, 0x75, 0xa3, 0x94, 0x5d, 0xe2,
-	0x7b, 0xe8, 0x7b, 0x40, 0x79, 0xea, 0x87, 0x8a, 0xbe, 0x90, 0x94, 0xf0, 0xc3, 0x1b, 0x8f, 0x64,
-	0xaf, 0xf6, 0xdd, 0x4c, 0xff, 0xe9, 0xf9, 0x9c, 0xf9, 0xdf, 0xcf, 0xa7, 0xbf, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x7a, 0xa3, 0xf8, 0x64, 0x28, 0x1a, 0x00, 0x00,
+	// 1833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x59, 0x5f, 0x73, 0xdb, 0x44,
+	0x10, 0xaf, 0x13, 0x27, 0xb5, 0xb7, 0x49, 0xda, 0x5e, 0x93, 0xe0, 0x06, 0xfa, 0x87, 0x1b, 0xa6,
+	0xd3, 0x32, 0x90, 0xd0, 0x02, 0x1d, 0xa6, 0xc3, 0x30, 0x34, 0x89, 0x43, 0x03, 0x4d, 0xeb, 0xca,
+	0x49, 0x3a, 0x14, 0x18, 0x46, 0x91, 0x2e, 0x8e, 0x88, 0xa4, 0x73, 0x75, 0xa7, 0x50, 0xf7, 0x89,
+	0x19, 0x18, 0x1e, 0x98, 0xe1, 0x0b, 0xf0, 0xc1, 0xe0, 0x93, 0xf0, 0xce, 0xde, 0xe9, 0xe4, 0xc8,
+	0x96, 0x94, 0x34, 0xd8, 0x4f, 0xd6, 0xdd, 0xed, 0xfe, 0x76, 0x6f, 0x6f, 0x77, 0xef, 0x27, 0x19,
+	0xee, 0x74, 0x0f, 0x3b, 0x2b, 0x07, 0x76, 0xe8, 0xfa, 0x2c, 0xfa, 0xd0, 0xb7, 0xe3, 0xd0, 0x39,
+	0xc0, 0x07, 0x87, 0x07, 0x2b, 0x4e, 0xe0, 0xae, 0x1c, 0xdd, 0x55, 0x3f, 0xcb, 0xdd, 0x88, 0x4b,
+	0x4e, 0x2e, 0x1e, 0xc6, 0x7b, 0xec, 0xc8, 0x8b, 0xe4, 0xb2, 0x9a, 0x3b, 0xba, 0x4b, 0xf7, 0xe1,
+	0xca, 0x33, 0x16, 0xc4, 0xbb, 0x2c, 0x12, 0x1e, 0x0f, 0x2d, 0x26, 0xba, 0x3c, 0x14, 0x8c, 0x7c,
+	0x0a, 0xb5, 0xc8, 0x3c, 0x37, 0x2a, 0x37, 0x2b, 0xb7, 0x2f, 0xdc, 0xbb, 0xba, 0x3c, 0xa4, 0xba,
+	0x9c, 0x0a, 0x5b, 0x7d, 0x51, 0xd2, 0x80, 0xf3, 0x47, 0x09, 0x52, 0x63, 0x02, 0xb5, 0xea, 0x56,
+	0x3a, 0xa4, 0x37, 0x60, 0x72, 0x77, 0x6b, 0x53, 0x0b, 0x04, 0xde, 0xd7, 0x02, 0x05, 0x14, 0xec,
+	0x8c, 0x95, 0x0e, 0xe9, 0x5d, 0x98, 0x5c, 0x6b, 0xed, 0x90, 0x39, 0x98, 0xf0, 0x5c, 0xbd, 0x36,
+	0x6b, 0xe1, 0x13, 0x59, 0x82, 0x9a, 0xf0, 0xf6, 0x7c, 0x2f, 0xec, 0x08, 0x84, 0x9c, 0xc4, 0xd9,
+	0xfe, 0x98, 0xae, 0xc0, 0xf9, 0x76, 0xf2, 0x9c, 0x53, 0x9b, 0x87, 0xa9, 0x23, 0xdb, 0x8f, 0x99,
+	0x76, 0xa3, 0x6a, 0x25, 0x03, 0xda, 0x84, 0xa9, 0x96, 0xdd, 0x61, 0x42, 0x2d, 0x3b, 0x3c, 0x0e,
+	0xa5, 0xd6, 0xc0, 0x65, 0x3d, 0x20, 0x04, 0xaa, 0x71, 0xe8, 0x49, 0xe3, 0xba, 0x7e, 0x56, 0x73,
+	0xc2, 0x7b, 0xcd, 0x1a, 0x93, 0x1a, 0x5a, 0x3f, 0xd3, 0x4f, 0x60, 0x7a, 0x8b, 0x05, 0x3c, 0xea,
+	0x91, 0x45, 0x98, 0xb6, 0x83, 0x0c, 0x90, 0x19, 0x15, 0x21, 0xd1, 0x7f, 0x2a, 0x50, 0x5d, 0x63,
+	0xbe, 0x9f, 0xf3, 0x75, 0x05, 0xa6, 0x03, 0x0d, 0xa7, 0xc5, 0x2f, 0xdc, 0x7b, 0x2b, 0x17, 0xe9,
+	0xc4, 0x9a, 0x65, 0xc4, 0xc8, 0x07, 0x30, 0xd5, 0x55, 0xdb, 0x40, 0xa7, 0x26, 0x51, 0x7e, 0x31,
+	0x27, 0xaf, 0x37, 0x69, 0x25, 0x42, 0xe4, 0x3e, 0xd4, 0x5d, 0x4f, 0x48, 0x3b, 0x74, 0x50, 0xa3,
+	0xaa, 0x35, 0x1a, 0x39, 0x0d, 0x13, 0x47, 0xeb, 0x58, 0x94, 0xdc, 0x86, 0xaa, 0xd3, 0x8d, 0x45,
+	0x63, 0x4a, 0xab, 0xcc, 0xe7, 0x54, 0xf0, 0xb4, 0x2c, 0x2d, 0x41, 0xbf, 0x84, 0xda, 0x36, 0xef,
+	0x72, 0x9f, 0x77, 0x7a, 0xe4, 0x13, 0x80, 0x30, 0x0e, 0xec, 0x1f, 0x1d, 0xdc, 0xa9, 0xc0, 0x4d,
+	0x2a, 0xdd, 0x85, 0xbc, 0x2e, 0xae, 0x5a, 0x75, 0x25, 0xa8, 0x9e, 0x04, 0xfd, 0xa3, 0x02, 0xd3,
+	0xed, 0xad, 0x55, 0x8f, 0x0b, 0x42, 0x61, 0x26, 0xb0, 0xc3, 0x78, 0xdf, 0x76, 0x64, 0x1c, 0xb1,
+	0x48, 0xc7, 0xa9, 0x6e, 0x0d, 0xcc, 0xa9, 0x2c, 0xc2, 0x74, 0x76, 0x63, 0x27, 0x8d, 0x70, 0x3a,
+	0xcc, 0x26, 0xe0, 0xe4, 0x40, 0x02, 0x92, 0x4b, 0x30, 0x29, 0x0e, 0x63, 0x0c, 0x80, 0x9a, 0x55,
+	0x8f, 0xea, 0xf0, 0xf6, 0xed, 0xc0, 0xf3, 0x7b, 0xb8, 0x45, 0x35, 0x69, 0x46, 0xf4, 0xf7, 0x0a,
+	0xd4, 0xd6, 0x3d, 0x71, 0xb8, 0x19, 0xee, 0x73, 0x2d, 0xc4, 0xa3, 0xc0, 0x96, 0xc6, 0x11, 0x33,
+	0x22, 0x37, 0xe1, 0xc2, 0x9e, 0xed, 0x1c, 0x62, 0xcc, 0x36, 0x3c, 0x9f, 0x19, 0x37, 0xb2, 0x53,
+	0xe4, 0x3a, 0x80, 0xf2, 0xd7, 0xf6, 0xdb, 0x69, 0xfe, 0x54, 0xad, 0xcc, 0x8c, 0x42, 0x50, 0x21,
+	0x49, 0x05, 0xaa, 0x5a, 0x20, 0x3b, 0x45, 0xff, 0xad, 0xc0, 0xec, 0x9a, 0x1f, 0x0b, 0xc9, 0xa2,
+	0x35, 0x1e, 0xee, 0x7b, 0x1d, 0xb2, 0x0c, 0xa4, 0xf9, 0xaa, 0x8b, 0x95, 0xae, 0xfc, 0x13, 0xcd,
+	0xd0, 0xde, 0xf3, 0x59, 0x92, 0x4a, 0x35, 0xab, 0x60, 0x85, 0x7c, 0x0e, 0x57, 0x37, 0x22, 0xc6,
+	0x54, 0x3e, 0x58, 0xac, 0xcb, 0x23, 0x89, 0xce, 0xa1, 0x40, 0xa2, 0x36, 0xa1, 0xd5, 0xca, 0x05,
+	0xc8, 0x03, 0x68, 0xac, 0x72, 0xe7, 0x40, 0xe0, 0x44, 0xd7, 0xb7, 0x7b, 0x1b, 0x3c, 0x6a, 0x6e,
+	0x6c, 0x7e, 0x15, 0x33, 0x21, 0x85, 0xde, 0x4f, 0xcd, 0x2a, 0x5d, 0x57, 0xba, 0x6d, 0x16, 0x79,
+	0xb6, 0x8f, 0x9e, 0x0b, 0xee, 0xb3, 0xc7, 0xfc, 0xd8, 0x70, 0x35, 0xd1, 0x2d, 0x5b, 0xa7, 0x1f,
+	0xc3, 0xd5, 0xcd, 0x10, 0x37, 0x8d, 0xe7, 0xcd, 0x56, 0xbd, 0xd0, 0x45, 0x9f, 0xb6, 0xbc, 0x4e,
+	0x64, 0x4b, 0x75, 0x8e, 0x8b, 0xaa, 0xf8, 0xe4, 0x01, 0x77, 0xd3, 0x03, 0x49, 0x46, 0xf4, 0xaf,
+	0x1a, 0x2c, 0xec, 0x26, 0xc1, 0xdb, 0xb2, 0x9d, 0x03, 0x2f, 0x64, 0x4f, 0xbb, 0x4a, 0x41, 0x90,
+	0x6f, 0x60, 0x7e, 0x70, 0x21, 0xc9, 0x34, 0xd3, 0xd7, 0xf2, 0xd5, 0x96, 0x2c, 0x5b, 0x85, 0x4a,
+	0x98, 0xdf, 0x0b, 0x58, 0x8d, 0xab, 0xb6, 0xef, 0x73, 0x1e, 0xb6, 0xa5, 0x2d, 0x45, 0x0b, 0xb7,
+	0xc1, 0x93, 0x68, 0xce, 0x5a, 0xc5, 0x8b, 0xe4, 0x23, 0xb8, 0xd2, 0x8a, 0x98, 0x9a, 0x77, 0x6c,
+	0xc9, 0xdc, 0x5d, 0xee, 0xc7, 0x81, 0xa9, 0xdf, 0xba, 0x55, 0xb4, 0xa4, 0x1a, 0xb0, 0x34, 0x35,
+	0xa5, 0xe3, 0x55, 0xd4, 0x80, 0xd3, 0xa2, 0xb3, 0xfa, 0xa2, 0xa4, 0x0d, 0x75, 0x9d, 0x00, 0x2a,
+	0x77, 0x4d, 0xe5, 0x7e, 0x9a, 0xd3, 0x2b, 0x0c, 0xd3, 0x72, 0x5f, 0xaf, 0x19, 0x4a, 0x6c, 0x36,
+	0xc7, 0x38, 0x25, 0x59, 0x37, 0x5d, 0x9a, 0x75, 0xeb, 0x30, 0xeb, 0x64, 0xd3, 0xb6, 0x71, 0x5e,
+	0x6f, 0xe0, 0x7a, 0xbe, 0x0d, 0x64, 0xa5, 0xac, 0x41, 0x25, 0xf2, 0x6b, 0x05, 0xae, 0x7a, 0x69,
+	0x1a, 0xac, 0xf3, 0xc0, 0xf6, 0xc2, 0x87, 0x52, 0xa2, 0xcf, 0x01, 0xc3, 0x7e, 0x5b, 0xd3, 0x7b,
+	0x6b, 0xbe, 0xe1, 0xde, 0x36, 0xcb, 0x70, 0x92, 0xbd, 0x96, 0xdb, 0x21, 0x21, 0x90, 0xfe, 0x62,
+	0x3f, 0x09, 0x1b, 0x75, 0x6d, 0xfd, 0x8b, 0xb3, 0x5a, 0xef, 0x03, 0x24, 0x66, 0x0b, 0x90, 0x55,
+	0xdd, 0xb8, 0x2c, 0xf4, 0x98, 0xab, 0xeb, 0xe8, 0x61, 0x07, 0x7d, 0x58, 0xe3, 0x01, 0x76, 0x3f,
+	0x57, 0x34, 0x40, 0xa7, 0x4b, 0xe9, 0xfa, 0xd2, 0x73, 0x98, 0x1b, 0x3c, 0x44, 0xd5, 0xf4, 0x0e,
+	0x59, 0xcf, 0x54, 0x8a, 0x7a, 0xc4, 0xcb, 0x26, 0x73, 0x31, 0x16, 0x25, 0x55, 0xda, 0xf9, 0xcc,
+	0x9d, 0xf9, 0x60, 0xe2, 0xb3, 0xca, 0xd2, 0x63, 0xb8, 0x7e, 0x72, 0x04, 0x0b, 0x0c, 0x0d, 0xdc,
+	0xc0, 0xf5, 0x2c, 0xda, 0x4b, 0x78, 0xab, 0x24, 0x22, 0x05, 0x30, 0x5f, 0x0e, 0xfa, 0xfb, 0x7e,
+	0xce, 0xdf, 0xd2, 0x4e, 0x91, 0x31, 0x49, 0x8f, 0x00, 0x90, 0x7d, 0x58, 0xec, 0xa5, 0x0a, 0x1a,
+	0xb9, 0x05, 0x93, 0xc8, 0x3a, 0x4c, 0xfd, 0xe7, 0x2f, 0x36, 0x25, 0xa9, 0x04, 0xd0, 0xf6, 0x79,
+	0x9e, 0x1c, 0xa1, 0xb1, 0x7e, 0xeb, 0xcd, 0x0e, 0xdc, 0x4a, 0xd5, 0xe8, 0x36, 0x5c, 0x3a, 0xf6,
+	0xe7, 0x8c, 0xd6, 0x1b, 0x83, 0xd6, 0x67, 0x8e, 0x51, 0xb1, 0x32, 0x2e, 0x34, 0x5f, 0x31, 0x27,
+	0x45, 0xc4, 0x9b, 0xc6, 0xd5, 0xa7, 0xf2, 0xc4, 0x0e, 0x98, 0x09, 0x5e, 0x66, 0x46, 0x21, 0x99,
+	0x1c, 0x49, 0xaf, 0x4b, 0x33, 0x54, 0x3c, 0xe5, 0x61, 0xd4, 0x49, 0x1b, 0x91, 0x7e, 0x46, 0xff,
+	0xe6, 0xa4, 0x17, 0x30, 0x1e, 0xcb, 0x36, 0x73, 0xb8, 0xca, 0x3b, 0xd5, 0x7f, 0xa6, 0xac, 0xa1,
+	0x59, 0x3a, 0x07, 0x33, 0xcd, 0xa0, 0x2b, 0x7b, 0xc6, 0x0b, 0xfa, 0x05, 0xd4, 0xac, 0x0c, 0x0f,
+	0x14, 0xb1, 0x83, 0x2c, 0x42, 0x98, 0xcb, 0x29, 0x1d, 0xaa, 0x15, 0x6c, 0x6f, 0x02, 0xef, 0x9b,
+	0xd4, 0x17, 0x33, 0xa4, 0x3f, 0x62, 0xf6, 0x6a, 0x9f, 0x47, 0x25, 0xa1, 0x78, 0x43, 0x24, 0x9b,
+	0x37, 0x16, 0xcc, 0x88, 0x86, 0x70, 0x25, 0x31, 0xa0, 0x3b, 0xf3, 0xa8, 0x56, 0xf0, 0xfa, 0x76,
+	0x8f, 0xd1, 0x52, 0x02, 0x90, 0x99, 0xa2, 0xaf, 0xe0, 0xb2, 0x2e, 0x52, 0x5d, 0x4d, 0x23, 0x5a,
+	0xfb, 0x00, 0x2e, 0x77, 0x86, 0xb1, 0x8c, 0xcd, 0xfc, 0x02, 0xfd, 0xad, 0x02, 0x0b, 0xda, 0xf4,
+	0x8e, 0x60, 0xd1, 0x63, 0x64, 0x74, 0xa3, 0x9a, 0xc7, 0x5b, 0xaf, 0x53, 0x84, 0x67, 0x5c, 0x28,
+	0x5e, 0xa4, 0x7f, 0x56, 0xa0, 0xa1, 0xdd, 0x50, 0x7c, 0x48, 0xf4, 0xb0, 0xb7, 0x07, 0x23, 0x87,
+	0x1d, 0xfb, 0x63, 0xa7, 0x04, 0xd2, 0x38, 0x53, 0xba, 0x4e, 0x7b, 0x98, 0xb1, 0xba, 0x6c, 0x46,
+	0x73, 0x01, 0x5f, 0x49, 0xd8, 0x2b, 0x0f, 0xdb, 0xae, 0x9b, 0x98, 0x9c, 0xb2, 0xfa, 0x63, 0x95,
+	0x7b, 0x42, 0xba, 0x4f, 0x63, 0x69, 0xe8, 0xa7, 0x19, 0xd1, 0x17, 0x70, 0x49, 0x47, 0xa2, 0xa5,
+	0x48, 0xf6, 0x1b, 0x96, 0x6d, 0xbe, 0x10, 0x27, 0x0a, 0x0b, 0xf1, 0x6b, 0x93, 0x67, 0x09, 0xf6,
+	0x48, 0x7b, 0xa3, 0x1c, 0x66, 0x15, 0x1f, 0x7c, 0xcd, 0xce, 0xda, 0xad, 0xee, 0xc3, 0x62, 0x1c,
+	0xee, 0x6b, 0xd5, 0xed, 0x22, 0xa7, 0x4b, 0x56, 0xe9, 0x73, 0xb8, 0x9c, 0xbc, 0xdd, 0xac, 0xc7,
+	0x41, 0xf7, 0xac, 0x46, 0xf1, 0x24, 0x5c, 0x54, 0x6b, 0xd9, 0xf2, 0xc0, 0x1c, 0x7e, 0x7f, 0x4c,
+	0xf7, 0xe0, 0x62, 0xbb, 0xb9, 0x3b, 0x8e, 0xda, 0x53, 0xcd, 0x8c, 0x1d, 0x69, 0x46, 0x65, 0x1a,
+	0xb1, 0x19, 0xd2, 0x5f, 0x90, 0xa2, 0x3c, 0xd6, 0xef, 0xdb, 0x5b, 0xcc, 0x16, 0xf8, 0x6a, 0xa2,
+	0x2e, 0xc4, 0x31, 0x94, 0xba, 0x3f, 0x8c, 0x69, 0x0c, 0xe7, 0x17, 0xe8, 0x0f, 0x8a, 0x2b, 0xff,
+	0xc4, 0x1c, 0x99, 0xf8, 0x81, 0x61, 0x8d, 0x98, 0x1c, 0xdf, 0x55, 0x63, 0xc1, 0xdc, 0x86, 0x6f,
+	0x4b, 0xc9, 0xc6, 0x77, 0x7d, 0xdd, 0xfb, 0x7b, 0x1e, 0x5f, 0xf5, 0x03, 0x97, 0x3c, 0x01, 0xd2,
+	0xee, 0x85, 0xce, 0xe0, 0x15, 0x4a, 0xde, 0x2e, 0x84, 0x4c, 0x8c, 0x2f, 0x95, 0x07, 0x90, 0x9e,
+	0x23, 0x4f, 0x91, 0x64, 0xdb, 0xb1, 0x60, 0x63, 0x03, 0x7c, 0x06, 0x0b, 0x3b, 0x61, 0x77, 0xac,
+	0x90, 0x6d, 0x98, 0x4f, 0xea, 0x6b, 0x08, 0x31, 0xcf, 0x8d, 0x07, 0xca, 0xf0, 0x64, 0x50, 0x0b,
+	0x16, 0x77, 0x4c, 0x75, 0x8d, 0xcd, 0xd1, 0x6d, 0x7c, 0x7f, 0xe3, 0xfb, 0x98, 0x49, 0x7b, 0x9c,
+	0xcb, 0xb1, 0xa1, 0xa2, 0xa7, 0xed, 0x83, 0x58, 0xba, 0xfc, 0xe7, 0x70, 0x6c, 0x98, 0x98, 0x46,
+	0xdf, 0x78, 0xbe, 0x3f, 0x36, 0xbc, 0x16, 0xcc, 0xaf, 0x33, 0x9f, 0xc9, 0xf1, 0xc5, 0xf2, 0x39,
+	0xbe, 0x33, 0x6a, 0x16, 0x38, 0x0c, 0xf9, 0x6e, 0xfe, 0x4b, 0xcf, 0x10, 0x5b, 0x3c, 0x35, 0xe3,
+	0x55, 0x05, 0xf5, 0x95, 0xb6, 0xed, 0xa8, 0xc3, 0xe4, 0x08, 0x9e, 0x7e, 0x0b, 0xd7, 0xd6, 0xd4,
+	0xd7, 0x9f, 0xa1, 0x68, 0x1e, 0xbf, 0x9e, 0x8c, 0x76, 0xf4, 0x5e, 0x27, 0xb4, 0xfd, 0xc4, 0xc9,
+	0x16, 0x77, 0xd7, 0x7c, 0x66, 0x87, 0x71, 0x77, 0x04, 0xcc, 0xef, 0xe0, 0xc6, 0x86, 0x87, 0x90,
+	0xde, 0x70, 0xe2, 0x8f, 0xc3, 0x61, 0xcc, 0xab, 0x47, 0x5c, 0x76, 0xfd, 0xb8, 0xf3, 0x88, 0x0b,
+	0xb9, 0x8e, 0x62, 0xea, 0xab, 0xd8, 0xff, 0xc7, 0xdb, 0x82, 0xfa, 0x57, 0x4c, 0x26, 0x0c, 0x94,
+	0x5c, 0xcb, 0x49, 0x66, 0xb9, 0xf4, 0xd2, 0x8d, 0xfc, 0x6b, 0xd9, 0x00, 0x35, 0xd6, 0x49, 0x35,
+	0xd7, 0x87, 0xd3, 0x7c, 0xf3, 0x34, 0xcc, 0xf7, 0x4a, 0x30, 0x07, 0xd8, 0xb0, 0x6e, 0x51, 0x33,
+	0x08, 0xdc, 0x67, 0xae, 0xa7, 0xc1, 0xd2, 0xdc, 0x72, 0x8e, 0xf4, 0x6a, 0xd0, 0x1a, 0x82, 0x2a,
+	0x86, 0x78, 0xaa, 0x9f, 0xb7, 0x8a, 0x01, 0x73, 0xec, 0xf2, 0x1c, 0xf9, 0x5e, 0x87, 0x20, 0xc3,
+	0xf4, 0x4e, 0x83, 0xbe, 0x53, 0x0c, 0x5d, 0xc4, 0x15, 0xcf, 0x91, 0x55, 0xa8, 0x2a, 0x46, 0x75,
+	0x1a, 0xe6, 0x89, 0x67, 0xde, 0x84, 0xaa, 0x62, 0x9c, 0xe4, 0x9d, 0x3c, 0xc6, 0xf1, 0xfb, 0xdb,
+	0xd2, 0xb5, 0x92, 0xd5, 0x4c, 0x33, 0xae, 0xf7, 0x19, 0x5e, 0x41, 0xd3, 0x18, 0x66, 0x96, 0x65,
+	0x67, 0x92, 0x25, 0x88, 0xba, 0x7a, 0x1a, 0x43, 0x55, 0xd3, 0x27, 0x62, 0x84, 0x96, 0x7c, 0x83,
+	0xce, 0xb0, 0xb4, 0xd3, 0x7a, 0x9e, 0x3a, 0x9b, 0xcc, 0x5f, 0x0b, 0x67, 0x4f, 0xcf, 0x82, 0xff,
+	0x25, 0x4c, 0x1f, 0xc9, 0xb1, 0x86, 0xb5, 0xd6, 0x8e, 0x18, 0xf1, 0xb2, 0xcb, 0x61, 0x9a, 0x4f,
+	0xfc, 0xa3, 0xf0, 0x11, 0xc0, 0x10, 0x18, 0x12, 0x7a, 0xda, 0xf6, 0x6f, 0xe6, 0x3f, 0x43, 0x0e,
+	0xb2, 0x57, 0x04, 0xb4, 0x61, 0x1e, 0x01, 0x73, 0x84, 0xf3, 0x64, 0x17, 0xf3, 0x5f, 0x4c, 0x4a,
+	0x19, 0x2b, 0x9a, 0xf8, 0x01, 0x48, 0x9e, 0x4e, 0x92, 0xa2, 0xaf, 0x2e, 0x25, 0x9c, 0xf3, 0xe4,
+	0x90, 0x7c, 0x0f, 0xef, 0x18, 0x3a, 0x39, 0x18, 0xeb, 0xf4, 0xab, 0x67, 0xbe, 0xef, 0x0d, 0xb2,
+	0xcf, 0x13, 0xd1, 0x57, 0xab, 0x2f, 0x26, 0x8e, 0xee, 0xee, 0x4d, 0xeb, 0x7f, 0xba, 0x3e, 0xfe,
+	0x0f, 0xd2, 0x40, 0xd4, 0xd5, 0x16, 0x1b, 0x00, 0x00,
 }
@@ -0,0 +1,208 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hooks
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
+	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// domainPatchOperation is a JSON patch operation returned by a hook sidecar
+type domainPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// domainPatcher applies the patches of the OnDefineDomain hook sidecars to the domain, in the order of the
+// sidecars. It remembers which sidecar patched which path, so that a sidecar cannot silently override the
+// changes of another one.
+type domainPatcher struct {
+	patchedPaths map[string]patchedPath
+}
+
+type patchedPath struct {
+	sidecar string
+	op      string
+}
+
+func newDomainPatcher() *domainPatcher {
+	return &domainPatcher{patchedPaths: map[string]patchedPath{}}
+}
+
+// apply applies the patches of the sidecar to the domain XML. The paths of the patches are JSON pointers to
+// the fields of the domain specification, e.g. /Devices/Interfaces/0/MTU.
+func (p *domainPatcher) apply(sidecar string, domainSpecXML []byte, patches []*hooksV1alpha4.DomainPatch) ([]byte, error) {
+	operations := make([]domainPatchOperation, 0, len(patches))
+	for _, patch := range patches {
+		operation := domainPatchOperation{Op: patch.GetOp(), Path: patch.GetPath()}
+		if err := p.checkConflict(sidecar, operation); err != nil {
+			return nil, err
+		}
+		if patch.GetValue() != "" {
+			operation.Value = json.RawMessage(patch.GetValue())
+		}
+		operations = append(operations, operation)
+	}
+
+	domainSpecJSON, err := domainSpecXMLToJSON(domainSpecXML)
+	if err != nil {
+		return nil, err
+	}
+
+	operationsJSON, err := json.Marshal(operations)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.DecodePatch(operationsJSON)
+	if err != nil {
+		return nil, fmt.Errorf("invalid domain patches of hook sidecar %s: %v", sidecar, err)
+	}
+	patchedJSON, err := patch.Apply(domainSpecJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply the domain patches of hook sidecar %s: %v", sidecar, err)
+	}
+
+	patchedSpec := &virtwrapApi.DomainSpec{}
+	if err := json.Unmarshal(patchedJSON, patchedSpec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the domain spec patched by hook sidecar %s: %v", sidecar, err)
+	}
+	for _, operation := range operations {
+		p.patchedPaths[operation.Path] = patchedPath{sidecar: sidecar, op: operation.Op}
+	}
+	return xml.MarshalIndent(patchedSpec, "", "\t")
+}
+
+// checkDomain fails when a sidecar returning the whole domain XML changed a path patched by an earlier
+// sidecar. The paths are compared in the domain specification, so that formatting changes of the XML are
+// not conflicts.
+func (p *domainPatcher) checkDomain(sidecar string, domainSpecXML, newDomainSpecXML []byte) error {
+	if len(p.patchedPaths) == 0 {
+		return nil
+	}
+
+	domainSpec, err := domainSpecXMLToValue(domainSpecXML)
+	if err != nil {
+		return err
+	}
+	newDomainSpec, err := domainSpecXMLToValue(newDomainSpecXML)
+	if err != nil {
+		return fmt.Errorf("invalid domain of hook sidecar %s: %v", sidecar, err)
+	}
+	for path, patched := range p.patchedPaths {
+		if !reflect.DeepEqual(valueAt(domainSpec, path), valueAt(newDomainSpec, path)) {
+			return fmt.Errorf("domain of hook sidecar %s changes %s patched by hook sidecar %s", sidecar, path, patched.sidecar)
+		}
+	}
+	return nil
+}
+
+// checkConflict fails when another sidecar already patched the path, one of its parents or one of its
+// children. Adding or removing an element of an array shifts the elements after it, so it also conflicts
+// with the patches of the other elements of the array.
+func (p *domainPatcher) checkConflict(sidecar string, operation domainPatchOperation) error {
+	for path, patched := range p.patchedPaths {
+		if patched.sidecar == sidecar {
+			continue
+		}
+		if operation.Path == path || strings.HasPrefix(operation.Path, path+"/") || strings.HasPrefix(path, operation.Path+"/") ||
+			shiftsElement(operation.Op, operation.Path, path) || shiftsElement(patched.op, path, operation.Path) {
+			return fmt.Errorf("domain patch %s of hook sidecar %s conflicts with patch %s of hook sidecar %s", operation.Path, sidecar, path, patched.sidecar)
+		}
+	}
+	return nil
+}
+
+// shiftsElement tells whether the operation adds or removes an element of an array holding the other path
+func shiftsElement(op, path, otherPath string) bool {
+	if op != "add" && op != "remove" {
+		return false
+	}
+	i := strings.LastIndex(path, "/")
+	if i < 0 || !isArrayIndex(path[i+1:]) {
+		return false
+	}
+	array := path[:i+1]
+	if !strings.HasPrefix(otherPath, array) {
+		return false
+	}
+	return isArrayIndex(strings.SplitN(strings.TrimPrefix(otherPath, array), "/", 2)[0])
+}
+
+func isArrayIndex(token string) bool {
+	if token == "-" {
+		return true
+	}
+	_, err := strconv.Atoi(token)
+	return err == nil
+}
+
+func domainSpecXMLToJSON(domainSpecXML []byte) ([]byte, error) {
+	domainSpec := &virtwrapApi.DomainSpec{}
+	if err := xml.Unmarshal(domainSpecXML, domainSpec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the domain spec: %v", err)
+	}
+	domainSpecJSON, err := json.Marshal(domainSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the domain spec: %v", err)
+	}
+	return domainSpecJSON, nil
+}
+
+func domainSpecXMLToValue(domainSpecXML []byte) (interface{}, error) {
+	domainSpecJSON, err := domainSpecXMLToJSON(domainSpecXML)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(domainSpecJSON, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// valueAt returns the value at the JSON pointer, or nil when the path does not exist
+func valueAt(value interface{}, path string) interface{} {
+	for _, token := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch v := value.(type) {
+		case map[string]interface{}:
+			value = v[token]
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil
+			}
+			value = v[index]
+		default:
+			return nil
+		}
+	}
+	return value
+}
@@ -0,0 +1,188 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hooks
+
+import (
+	"encoding/xml"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
+	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Domain patches", func() {
+	var (
+		patcher       *domainPatcher
+		domainSpecXML []byte
+	)
+
+	BeforeEach(func() {
+		patcher = newDomainPatcher()
+
+		var err error
+		domainSpecXML, err = xml.Marshal(&virtwrapApi.DomainSpec{
+			Name:   "default_testvmi",
+			Memory: virtwrapApi.Memory{Value: 1024, Unit: "MiB"},
+			Devices: virtwrapApi.Devices{
+				Interfaces: []virtwrapApi.Interface{{Type: "ethernet", MTU: &virtwrapApi.MTU{Size: "1500"}}},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	patchedSpec := func(domainSpecXML []byte) *virtwrapApi.DomainSpec {
+		domainSpec := &virtwrapApi.DomainSpec{}
+		Expect(xml.Unmarshal(domainSpecXML, domainSpec)).To(Succeed())
+		return domainSpec
+	}
+
+	It("should apply the patches to the domain spec", func() {
+		result, err := patcher.apply("first.sock", domainSpecXML, []*hooksV1alpha4.DomainPatch{
+			{Op: "replace", Path: "/Memory/Value", Value: "2048"},
+			{Op: "replace", Path: "/Devices/Interfaces/0/MTU/Size", Value: `"9000"`},
+			{Op: "remove", Path: "/Devices/Interfaces/0/Type"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		domainSpec := patchedSpec(result)
+		Expect(domainSpec.Name).To(Equal("default_testvmi"))
+		Expect(domainSpec.Memory).To(Equal(virtwrapApi.Memory{Value: 2048, Unit: "MiB"}))
+		Expect(domainSpec.Devices.Interfaces[0].MTU.Size).To(Equal("9000"))
+		Expect(domainSpec.Devices.Interfaces[0].Type).To(BeEmpty())
+	})
+
+	It("should let the patches of different sidecars touch different paths", func() {
+		result, err := patcher.apply("first.sock", domainSpecXML, []*hooksV1alpha4.DomainPatch{
+			{Op: "replace", Path: "/Memory/Value", Value: "2048"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		result, err = patcher.apply("second.sock", result, []*hooksV1alpha4.DomainPatch{
+			{Op: "replace", Path: "/Memory/Unit", Value: `"KiB"`},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(patchedSpec(result).Memory).To(Equal(virtwrapApi.Memory{Value: 2048, Unit: "KiB"}))
+	})
+
+	DescribeTable("should reject patches conflicting with the patches of an earlier sidecar", func(path string) {
+		result, err := patcher.apply("first.sock", domainSpecXML, []*hooksV1alpha4.DomainPatch{
+			{Op: "replace", Path: "/Devices/Interfaces/0/MTU", Value: `{"Size":"9000"}`},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = patcher.apply("second.sock", result, []*hooksV1alpha4.DomainPatch{
+			{Op: "remove", Path: path},
+		})
+		Expect(err).To(MatchError(ContainSubstring("conflicts with patch /Devices/Interfaces/0/MTU of hook sidecar first.sock")))
+	},
+		Entry("on the same path", "/Devices/Interfaces/0/MTU"),
+		Entry("on a parent path", "/Devices/Interfaces/0"),
+		Entry("on a child path", "/Devices/Interfaces/0/MTU/Size"),
+	)
+
+	DescribeTable("should reject patches shifting the elements of an array patched by an earlier sidecar", func(firstPatch, secondPatch *hooksV1alpha4.DomainPatch) {
+		result, err := patcher.apply("first.sock", domainSpecXML, []*hooksV1alpha4.DomainPatch{firstPatch})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = patcher.apply("second.sock", result, []*hooksV1alpha4.DomainPatch{secondPatch})
+		Expect(err).To(MatchError(ContainSubstring("conflicts with patch %s of hook sidecar first.sock", firstPatch.Path)))
+	},
+		Entry("when an element was removed",
+			&hooksV1alpha4.DomainPatch{Op: "remove", Path: "/Devices/Interfaces/0"},
+			&hooksV1alpha4.DomainPatch{Op: "replace", Path: "/Devices/Interfaces/1/MTU/Size", Value: `"9000"`},
+		),
+		Entry("when an element was added",
+			&hooksV1alpha4.DomainPatch{Op: "add", Path: "/Devices/Interfaces/-", Value: `{"Type":"ethernet"}`},
+			&hooksV1alpha4.DomainPatch{Op: "remove", Path: "/Devices/Interfaces/0/MTU"},
+		),
+		Entry("when adding an element",
+			&hooksV1alpha4.DomainPatch{Op: "replace", Path: "/Devices/Interfaces/0/MTU/Size", Value: `"9000"`},
+			&hooksV1alpha4.DomainPatch{Op: "add", Path: "/Devices/Interfaces/0", Value: `{"Type":"ethernet"}`},
+		),
+	)
+
+	It("should let the patches of different sidecars replace different elements of an array", func() {
+		domainSpecXML, err := xml.Marshal(&virtwrapApi.DomainSpec{
+			Devices: virtwrapApi.Devices{
+				Interfaces: []virtwrapApi.Interface{{Type: "ethernet"}, {Type: "ethernet"}},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		result, err := patcher.apply("first.sock", domainSpecXML, []*hooksV1alpha4.DomainPatch{
+			{Op: "replace", Path: "/Devices/Interfaces/0/Type", Value: `"bridge"`},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = patcher.apply("second.sock", result, []*hooksV1alpha4.DomainPatch{
+			{Op: "replace", Path: "/Devices/Interfaces/1/Type", Value: `"vhostuser"`},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("with a sidecar returning the whole domain XML", func() {
+		withMemory := func(domainSpecXML []byte, value uint64) []byte {
+			domainSpec := patchedSpec(domainSpecXML)
+			domainSpec.Memory.Value = value
+			result, err := xml.Marshal(domainSpec)
+			Expect(err).ToNot(HaveOccurred())
+			return result
+		}
+
+		It("should reject a domain changing a path patched by an earlier sidecar", func() {
+			result, err := patcher.apply("first.sock", domainSpecXML, []*hooksV1alpha4.DomainPatch{
+				{Op: "replace", Path: "/Memory/Value", Value: "2048"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = patcher.checkDomain("second.sock", result, withMemory(result, 4096))
+			Expect(err).To(MatchError("domain of hook sidecar second.sock changes /Memory/Value patched by hook sidecar first.sock"))
+		})
+
+		It("should accept a domain keeping the paths patched by earlier sidecars", func() {
+			result, err := patcher.apply("first.sock", domainSpecXML, []*hooksV1alpha4.DomainPatch{
+				{Op: "replace", Path: "/Devices/Interfaces/0/MTU/Size", Value: `"9000"`},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(patcher.checkDomain("second.sock", result, withMemory(result, 4096))).To(Succeed())
+		})
+
+		It("should accept any domain without earlier patches", func() {
+			Expect(patcher.checkDomain("second.sock", domainSpecXML, withMemory(domainSpecXML, 4096))).To(Succeed())
+		})
+	})
+
+	It("should let a sidecar patch a path twice", func() {
+		_, err := patcher.apply("first.sock", domainSpecXML, []*hooksV1alpha4.DomainPatch{
+			{Op: "replace", Path: "/Memory/Value", Value: "2048"},
+			{Op: "replace", Path: "/Memory/Value", Value: "4096"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should fail on patches of missing paths", func() {
+		_, err := patcher.apply("first.sock", domainSpecXML, []*hooksV1alpha4.DomainPatch{
+			{Op: "replace", Path: "/Devices/Disks/0/Type", Value: `"file"`},
+		})
+		Expect(err).To(MatchError(ContainSubstring("failed to apply the domain patches of hook sidecar first.sock")))
+	})
+})
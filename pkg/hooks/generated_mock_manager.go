@@ -75,3 +75,54 @@ func (_m *MockManager) Shutdown() error {
 func (_mr *_MockManagerRecorder) Shutdown() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Shutdown")
 }
+
+func (_m *MockManager) HasHookPoint(_param0 string) bool {
+	ret := _m.ctrl.Call(_m, "HasHookPoint", _param0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) HasHookPoint(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "HasHookPoint", arg0)
+}
+
+func (_m *MockManager) OnGuestBoot(_param0 *v1.VirtualMachineInstance, _param1 *v1.VirtualMachineInstanceGuestOSInfo) error {
+	ret := _m.ctrl.Call(_m, "OnGuestBoot", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) OnGuestBoot(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "OnGuestBoot", arg0, arg1)
+}
+
+func (_m *MockManager) OnMigrationSource(_param0 string, _param1 *v1.VirtualMachineInstance) (string, error) {
+	ret := _m.ctrl.Call(_m, "OnMigrationSource", _param0, _param1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockManagerRecorder) OnMigrationSource(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "OnMigrationSource", arg0, arg1)
+}
+
+func (_m *MockManager) OnMigrationTarget(_param0 *api.DomainSpec, _param1 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "OnMigrationTarget", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) OnMigrationTarget(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "OnMigrationTarget", arg0, arg1)
+}
+
+func (_m *MockManager) PostDomainStart(_param0 string, _param1 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "PostDomainStart", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) PostDomainStart(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PostDomainStart", arg0, arg1)
+}
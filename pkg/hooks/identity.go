@@ -0,0 +1,208 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// HookIdentityDirectory holds one directory per hook sidecar in the compute container, and the
+	// directory of the sidecar itself in the sidecar containers.
+	HookIdentityDirectory = "/var/run/kubevirt-hooks-identity"
+	// HookIdentityTokenFile is the file in the identity directory holding the token minted by virt-launcher.
+	HookIdentityTokenFile = "token"
+	// HookIdentityTokenMetadataKey is the gRPC header carrying the token in every response of a hook sidecar.
+	HookIdentityTokenMetadataKey = "kubevirt-hook-token"
+
+	identityTokenBytes = 32
+)
+
+// ErrUnverifiedHookSidecar is returned when a hook socket is not served by a sidecar with a token minted by virt-launcher.
+var ErrUnverifiedHookSidecar = errors.New("unverified hook sidecar")
+
+type sidecarIdentity struct {
+	uid uint32
+	// tokens maps the minted tokens to the name of their sidecar
+	tokens map[string]string
+	// registered holds the tokens already used by a registered socket
+	registered map[string]bool
+}
+
+// EnableIdentityVerification mints a token for every hook sidecar and requires the hook sockets
+// to be served by a process of the same user, answering with the token of a sidecar.
+func EnableIdentityVerification() error {
+	m, ok := GetManager().(*hookManager)
+	if !ok {
+		return fmt.Errorf("hook manager does not support identity verification")
+	}
+	return m.enableIdentityVerification(HookIdentityDirectory)
+}
+
+func (m *hookManager) enableIdentityVerification(identityDirectory string) error {
+	entries, err := os.ReadDir(identityDirectory)
+	if err != nil {
+		return fmt.Errorf("failed to read hook identity directory: %v", err)
+	}
+
+	identity := &sidecarIdentity{
+		uid:        uint32(os.Getuid()),
+		tokens:     make(map[string]string),
+		registered: make(map[string]bool),
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		token, err := mintIdentityToken(filepath.Join(identityDirectory, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to mint the token of hook sidecar %s: %v", entry.Name(), err)
+		}
+		identity.tokens[token] = entry.Name()
+	}
+
+	m.identity = identity
+	return nil
+}
+
+func mintIdentityToken(sidecarDirectory string) (string, error) {
+	buf := make([]byte, identityTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(buf)
+	if err := os.WriteFile(filepath.Join(sidecarDirectory, HookIdentityTokenFile), []byte(token), 0600); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// verifyPeer checks that the socket is served by a process of the same user as virt-launcher.
+func (i *sidecarIdentity) verifyPeer(socketPath string, timeout time.Duration) error {
+	conn, err := net.DialTimeout("unix", socketPath, timeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return i.verifyPeerConn(conn.(*net.UnixConn))
+}
+
+func (i *sidecarIdentity) verifyPeerConn(conn *net.UnixConn) error {
+	ucred, err := peerCredentials(conn)
+	if err != nil {
+		return err
+	}
+
+	if ucred.Uid != i.uid {
+		return fmt.Errorf("%w: socket is served by uid %d, expected uid %d", ErrUnverifiedHookSidecar, ucred.Uid, i.uid)
+	}
+	return nil
+}
+
+// register claims the token of a sidecar. Every token can be claimed by a single socket.
+func (i *sidecarIdentity) register(header metadata.MD) (string, error) {
+	token := tokenFromHeader(header)
+	sidecarName, minted := i.tokens[token]
+	if token == "" || !minted {
+		return "", fmt.Errorf("%w: no valid token", ErrUnverifiedHookSidecar)
+	}
+	if i.registered[token] {
+		return "", fmt.Errorf("%w: token of sidecar %s is already registered", ErrUnverifiedHookSidecar, sidecarName)
+	}
+	i.registered[token] = true
+	return sidecarName, nil
+}
+
+// dialOptions verify the peer of every connection and the token of every response.
+func (i *sidecarIdentity) dialOptions(token string) []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			var dialer net.Dialer
+			conn, err := dialer.DialContext(ctx, "unix", addr)
+			if err != nil {
+				return nil, err
+			}
+			if err := i.verifyPeerConn(conn.(*net.UnixConn)); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			return conn, nil
+		}),
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			var header metadata.MD
+			if err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...); err != nil {
+				return err
+			}
+			if tokenFromHeader(header) != token {
+				return fmt.Errorf("%w: token changed since registration", ErrUnverifiedHookSidecar)
+			}
+			return nil
+		}),
+	}
+}
+
+func tokenFromHeader(header metadata.MD) string {
+	values := header.Get(HookIdentityTokenMetadataKey)
+	if len(values) != 1 {
+		return ""
+	}
+	return values[0]
+}
+
+// IdentityServerOptions make a hook sidecar answer with the token minted by virt-launcher,
+// when identity verification is enabled for the VMI.
+func IdentityServerOptions() []grpc.ServerOption {
+	return identityServerOptions(HookIdentityDirectory)
+}
+
+func identityServerOptions(identityDirectory string) []grpc.ServerOption {
+	if _, err := os.Stat(identityDirectory); err != nil {
+		return nil
+	}
+
+	// The token is minted by virt-launcher once the sidecar is running, so it is read on every call.
+	tokenPath := filepath.Join(identityDirectory, HookIdentityTokenFile)
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			token, err := os.ReadFile(tokenPath)
+			if err != nil {
+				log.Log.Reason(err).Error("Failed to read the hook identity token")
+			} else if err := grpc.SetHeader(ctx, metadata.Pairs(HookIdentityTokenMetadataKey, strings.TrimSpace(string(token)))); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+	}
+}
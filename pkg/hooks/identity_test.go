@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hooks
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/grpc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+)
+
+var _ = Describe("Hook sidecar identity", func() {
+	const hookPointName = hooksInfo.OnDefineDomainHookPointName
+
+	var socketDir, identityDir string
+	var manager *hookManager
+
+	BeforeEach(func() {
+		socketDir = GinkgoT().TempDir()
+		identityDir = GinkgoT().TempDir()
+		manager = newManager(socketDir)
+	})
+
+	serve := func(hookName string, options ...grpc.ServerOption) {
+		socket, err := net.Listen("unix", filepath.Join(socketDir, hookName+".sock"))
+		Expect(err).ToNot(HaveOccurred())
+
+		server := grpc.NewServer(options...)
+		hooksInfo.RegisterInfoServer(server, dynamicInfoServer{
+			hookName:      hookName,
+			hookPointName: hookPointName,
+		})
+		go server.Serve(socket)
+		// Stopping the server closes the socket
+		DeferCleanup(server.Stop)
+	}
+
+	addSidecar := func(name string) string {
+		sidecarDir := filepath.Join(identityDir, name)
+		Expect(os.Mkdir(sidecarDir, 0700)).To(Succeed())
+		return sidecarDir
+	}
+
+	It("should mint a token for every sidecar", func() {
+		addSidecar("hook-sidecar-0")
+		addSidecar("hook-sidecar-1")
+
+		Expect(manager.enableIdentityVerification(identityDir)).To(Succeed())
+		Expect(manager.identity.tokens).To(HaveLen(2))
+		for token, sidecarName := range manager.identity.tokens {
+			content, err := os.ReadFile(filepath.Join(identityDir, sidecarName, HookIdentityTokenFile))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(Equal(token))
+		}
+	})
+
+	It("should register a sidecar answering with its token", func() {
+		sidecarDir := addSidecar("hook-sidecar-0")
+		Expect(manager.enableIdentityVerification(identityDir)).To(Succeed())
+		serve("hook1", identityServerOptions(sidecarDir)...)
+
+		Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+		Expect(manager.CallbacksPerHookPoint[hookPointName]).To(HaveLen(1))
+		Expect(manager.CallbacksPerHookPoint[hookPointName][0].token).ToNot(BeEmpty())
+	})
+
+	It("should not count a socket without token", func() {
+		sidecarDir := addSidecar("hook-sidecar-0")
+		Expect(manager.enableIdentityVerification(identityDir)).To(Succeed())
+		serve("impostor")
+		serve("hook1", identityServerOptions(sidecarDir)...)
+
+		Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+		Expect(manager.CallbacksPerHookPoint[hookPointName]).To(HaveLen(1))
+		Expect(manager.CallbacksPerHookPoint[hookPointName][0].SocketPath).To(HaveSuffix("hook1.sock"))
+	})
+
+	It("should not register a token twice", func() {
+		sidecarDir := addSidecar("hook-sidecar-0")
+		Expect(manager.enableIdentityVerification(identityDir)).To(Succeed())
+		serve("hook1", identityServerOptions(sidecarDir)...)
+		serve("hook2", identityServerOptions(sidecarDir)...)
+
+		Expect(manager.Collect(2, 3*time.Second)).To(MatchError(ContainSubstring("within given timeout")))
+	})
+
+	It("should reject sockets served by another user", func() {
+		serve("hook1")
+		identity := &sidecarIdentity{uid: uint32(os.Getuid()) + 1}
+
+		err := identity.verifyPeer(filepath.Join(socketDir, "hook1.sock"), time.Second)
+		Expect(err).To(MatchError(ErrUnverifiedHookSidecar))
+	})
+
+	It("should not change sidecars without identity directory", func() {
+		Expect(identityServerOptions(filepath.Join(identityDir, "missing"))).To(BeEmpty())
+	})
+})
@@ -22,3 +22,7 @@ package info
 const OnDefineDomainHookPointName = "OnDefineDomain"
 const PreCloudInitIsoHookPointName = "PreCloudInitIso"
 const ShutdownHookPointName = "Shutdown"
+const OnGuestBootHookPointName = "OnGuestBoot"
+const OnMigrationSourceHookPointName = "OnMigrationSource"
+const OnMigrationTargetHookPointName = "OnMigrationTarget"
+const PostDomainStartHookPointName = "PostDomainStart"
@@ -0,0 +1,83 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/client-go/log"
+)
+
+type loggerContextKey struct{}
+
+// vmiParams are the parameters of the hook calls carrying the VMI
+type vmiParams interface {
+	GetVmi() []byte
+}
+
+// vmiLogging remembers the VMI served by a hook sidecar, so that the calls which don't carry it,
+// e.g. Shutdown, are logged with it as well
+type vmiLogging struct {
+	lock   sync.Mutex
+	base   *log.FilteredLogger
+	logger *log.FilteredLogger
+}
+
+// LoggingServerOptions make the logger of every call of a hook sidecar carry the namespace, the
+// name and the UID of the VMI it serves. The handlers get it with Logger.
+func LoggingServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{grpc.ChainUnaryInterceptor(newVMILogging(log.Log).intercept)}
+}
+
+// Logger returns the logger of a hook call, or the default one outside of a hook call
+func Logger(ctx context.Context) *log.FilteredLogger {
+	if logger, ok := ctx.Value(loggerContextKey{}).(*log.FilteredLogger); ok {
+		return logger
+	}
+	return log.Log
+}
+
+func newVMILogging(base *log.FilteredLogger) *vmiLogging {
+	return &vmiLogging{base: base, logger: base}
+}
+
+func (l *vmiLogging) intercept(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(context.WithValue(ctx, loggerContextKey{}, l.loggerFor(req)), req)
+}
+
+func (l *vmiLogging) loggerFor(req interface{}) *log.FilteredLogger {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if params, ok := req.(vmiParams); ok && len(params.GetVmi()) > 0 {
+		vmi := &metav1.PartialObjectMetadata{}
+		if err := json.Unmarshal(params.GetVmi(), vmi); err != nil {
+			l.base.Reason(err).Warning("Failed to read the VMI of the hook call")
+		} else {
+			l.logger = l.base.With("namespace", vmi.Namespace, "name", vmi.Name, "uid", vmi.UID)
+		}
+	}
+	return l.logger
+}
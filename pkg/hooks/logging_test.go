@@ -0,0 +1,124 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package hooks
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+
+	klog "github.com/go-kit/kit/log"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+)
+
+var _ = Describe("Hook sidecar logging", func() {
+	var (
+		buffer  *bytes.Buffer
+		logging *vmiLogging
+	)
+
+	// call runs a hook call through the interceptor, logging a line with the logger of the call
+	call := func(req interface{}) {
+		_, err := logging.intercept(context.Background(), req, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			Logger(ctx).Info("hook called")
+			return nil, nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	loggedLines := func() []map[string]string {
+		var lines []map[string]string
+		scanner := bufio.NewScanner(buffer)
+		for scanner.Scan() {
+			entry := map[string]string{}
+			Expect(json.Unmarshal(scanner.Bytes(), &entry)).To(Succeed())
+			lines = append(lines, entry)
+		}
+		Expect(scanner.Err()).ToNot(HaveOccurred())
+		return lines
+	}
+
+	vmiJSON := func() []byte {
+		vmi := v1.NewVMIReferenceFromNameWithNS("default", "testvmi")
+		vmi.UID = "1234"
+		vmi.TypeMeta = metav1.TypeMeta{}
+		data, err := json.Marshal(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		return data
+	}
+
+	BeforeEach(func() {
+		buffer = bytes.NewBuffer(nil)
+		logging = newVMILogging(log.MakeLogger(klog.NewJSONLogger(buffer)))
+	})
+
+	It("should log the calls with the VMI they carry", func() {
+		call(&hooksV1alpha3.OnDefineDomainParams{Vmi: vmiJSON()})
+
+		lines := loggedLines()
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).To(HaveKeyWithValue("namespace", "default"))
+		Expect(lines[0]).To(HaveKeyWithValue("name", "testvmi"))
+		Expect(lines[0]).To(HaveKeyWithValue("uid", "1234"))
+	})
+
+	It("should log the calls without VMI with the last VMI", func() {
+		call(&hooksV1alpha3.OnDefineDomainParams{Vmi: vmiJSON()})
+		call(&hooksV1alpha3.ShutdownParams{})
+
+		lines := loggedLines()
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[1]).To(HaveKeyWithValue("msg", "hook called"))
+		Expect(lines[1]).To(HaveKeyWithValue("name", "testvmi"))
+		Expect(lines[1]).To(HaveKeyWithValue("uid", "1234"))
+	})
+
+	It("should log the calls before the VMI is known without it", func() {
+		call(&hooksV1alpha3.ShutdownParams{})
+
+		lines := loggedLines()
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).ToNot(HaveKey("uid"))
+	})
+
+	It("should keep the last VMI when the VMI of a call can't be read", func() {
+		call(&hooksV1alpha3.OnDefineDomainParams{Vmi: vmiJSON()})
+		call(&hooksV1alpha3.PreCloudInitIsoParams{Vmi: []byte("{")})
+
+		lines := loggedLines()
+		Expect(lines).To(HaveLen(3))
+		Expect(lines[1]).To(HaveKeyWithValue("level", "warning"))
+		Expect(lines[2]).To(HaveKeyWithValue("uid", "1234"))
+	})
+
+	It("should return the default logger outside of a hook call", func() {
+		Expect(Logger(context.Background())).To(Equal(log.Log))
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -31,14 +32,22 @@ import (
 	"sync"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
+	"kubevirt.io/kubevirt/pkg/faultinjection"
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	hooksV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/v1alpha1"
 	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
@@ -51,6 +60,7 @@ type callBackClient struct {
 	SocketPath           string
 	Version              string
 	subscribedHookPoints []*hooksInfo.HookPoint
+	token                string
 }
 
 var manager Manager
@@ -62,10 +72,16 @@ type (
 		OnDefineDomain(*virtwrapApi.DomainSpec, *v1.VirtualMachineInstance) (string, error)
 		PreCloudInitIso(*v1.VirtualMachineInstance, *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error)
 		Shutdown() error
+		HasHookPoint(string) bool
+		OnGuestBoot(*v1.VirtualMachineInstance, *v1.VirtualMachineInstanceGuestOSInfo) error
+		OnMigrationSource(string, *v1.VirtualMachineInstance) (string, error)
+		OnMigrationTarget(*virtwrapApi.DomainSpec, *v1.VirtualMachineInstance) error
+		PostDomainStart(string, *v1.VirtualMachineInstance) error
 	}
 	hookManager struct {
 		CallbacksPerHookPoint     map[string][]*callBackClient
 		hookSocketSharedDirectory string
+		identity                  *sidecarIdentity
 	}
 )
 
@@ -99,6 +115,7 @@ func (m *hookManager) Collect(numberOfRequestedHookSidecars uint, timeout time.D
 func (m *hookManager) collectSideCarSockets(numberOfRequestedHookSidecars uint, timeout time.Duration) (map[string][]*callBackClient, error) {
 	callbacksPerHookPoint := make(map[string][]*callBackClient)
 	processedSockets := make(map[string]bool)
+	rejectedSockets := make(map[string]bool)
 
 	timeoutCh := time.After(timeout)
 
@@ -113,14 +130,18 @@ func (m *hookManager) collectSideCarSockets(numberOfRequestedHookSidecars uint,
 			case <-timeoutCh:
 				return nil, fmt.Errorf("Failed to collect all expected sidecar hook sockets within given timeout")
 			default:
-				if _, processed := processedSockets[socket.Name()]; processed {
+				if processedSockets[socket.Name()] || rejectedSockets[socket.Name()] {
 					continue
 				}
 
-				callBackClient, notReady, err := processSideCarSocket(filepath.Join(m.hookSocketSharedDirectory, socket.Name()))
+				callBackClient, notReady, err := m.processSideCarSocket(filepath.Join(m.hookSocketSharedDirectory, socket.Name()))
 				if notReady {
 					log.Log.Info("Sidecar server might not be ready yet, retrying in the next iteration")
 					continue
+				} else if errors.Is(err, ErrUnverifiedHookSidecar) {
+					log.Log.Reason(err).Warningf("Ignoring sidecar socket: %s", socket.Name())
+					rejectedSockets[socket.Name()] = true
+					continue
 				} else if err != nil {
 					log.Log.Reason(err).Infof("Failed to process sidecar socket: %s", socket.Name())
 					return nil, err
@@ -140,7 +161,16 @@ func (m *hookManager) collectSideCarSockets(numberOfRequestedHookSidecars uint,
 	return callbacksPerHookPoint, nil
 }
 
-func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
+func (m *hookManager) processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
+	if m.identity != nil {
+		if err := m.identity.verifyPeer(socketPath, time.Second); errors.Is(err, ErrUnverifiedHookSidecar) {
+			return nil, false, err
+		} else if err != nil {
+			log.Log.Reason(err).Infof(dialSockErr, socketPath)
+			return nil, true, nil
+		}
+	}
+
 	conn, err := grpcutil.DialSocketWithTimeout(socketPath, 1)
 	if err != nil {
 		log.Log.Reason(err).Infof(dialSockErr, socketPath)
@@ -148,14 +178,29 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 	}
 	defer conn.Close()
 
+	if !isSideCarServing(conn, socketPath) {
+		return nil, true, nil
+	}
+
 	infoClient := hooksInfo.NewInfoClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	info, err := infoClient.Info(ctx, &hooksInfo.InfoParams{})
+	var header metadata.MD
+	info, err := infoClient.Info(ctx, &hooksInfo.InfoParams{}, grpc.Header(&header))
 	if err != nil {
 		return nil, false, err
 	}
 
+	var token string
+	if m.identity != nil {
+		sidecarName, err := m.identity.register(header)
+		if err != nil {
+			return nil, false, err
+		}
+		log.Log.Infof("Verified hook sidecar %s on socket %s", sidecarName, socketPath)
+		token = tokenFromHeader(header)
+	}
+
 	versionsSet := make(map[string]bool)
 	for _, version := range info.GetVersions() {
 		versionsSet[version] = true
@@ -163,6 +208,7 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 
 	// The order matters. We should match newer versions first.
 	supportedVersions := []string{
+		hooksV1alpha4.Version,
 		hooksV1alpha3.Version,
 		hooksV1alpha2.Version,
 		hooksV1alpha1.Version,
@@ -174,6 +220,7 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 				SocketPath:           socketPath,
 				Version:              version,
 				subscribedHookPoints: info.GetHookPoints(),
+				token:                token,
 			}, false, nil
 		}
 	}
@@ -183,6 +230,34 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 			info.GetVersions(), supportedVersions)
 }
 
+// isSideCarServing tells whether the sidecar reports SERVING on the gRPC health service, so that
+// its socket is only registered once the sidecar is ready. Sidecars without the health service
+// are ready as soon as they answer.
+func isSideCarServing(conn *grpc.ClientConn, socketPath string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	response, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return true
+	} else if err != nil {
+		log.Log.Reason(err).Infof("Failed to check the health of hook socket: %s", socketPath)
+		return false
+	}
+	if response.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		log.Log.Infof("Hook sidecar on socket %s is %s", socketPath, response.GetStatus())
+		return false
+	}
+	return true
+}
+
+func (m *hookManager) dialCallback(callback *callBackClient) (*grpc.ClientConn, error) {
+	var options []grpc.DialOption
+	if m.identity != nil {
+		options = m.identity.dialOptions(callback.token)
+	}
+	return grpcutil.DialSocketWithTimeout(callback.SocketPath, 1, options...)
+}
+
 func sortCallbacksPerHookPoint(callbacksPerHookPoint map[string][]*callBackClient) {
 	for _, callbacks := range callbacksPerHookPoint {
 		for _, callback := range callbacks {
@@ -198,6 +273,10 @@ func sortCallbacksPerHookPoint(callbacksPerHookPoint map[string][]*callBackClien
 }
 
 func (m *hookManager) OnDefineDomain(domainSpec *virtwrapApi.DomainSpec, vmi *v1.VirtualMachineInstance) (string, error) {
+	if err := faultinjection.ForVMI(vmi).InjectOnDefineDomain(); err != nil {
+		return "", err
+	}
+
 	domainSpecXML, err := xml.MarshalIndent(domainSpec, "", "\t")
 	if err != nil {
 		return "", fmt.Errorf("Failed to marshal domain spec: %v", domainSpec)
@@ -213,8 +292,9 @@ func (m *hookManager) OnDefineDomain(domainSpec *virtwrapApi.DomainSpec, vmi *v1
 		return "", fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
 	}
 
+	patcher := newDomainPatcher()
 	for _, callback := range callbacks {
-		domainSpecXML, err = m.onDefineDomainCallback(callback, domainSpecXML, vmiJSON)
+		domainSpecXML, err = m.onDefineDomainCallback(callback, domainSpecXML, vmiJSON, patcher)
 		if err != nil {
 			return "", err
 		}
@@ -223,8 +303,8 @@ func (m *hookManager) OnDefineDomain(domainSpec *virtwrapApi.DomainSpec, vmi *v1
 	return string(domainSpecXML), nil
 }
 
-func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpecXML, vmiJSON []byte) ([]byte, error) {
-	conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpecXML, vmiJSON []byte, patcher *domainPatcher) ([]byte, error) {
+	conn, err := m.dialCallback(callback)
 	if err != nil {
 		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
 		return nil, err
@@ -234,6 +314,7 @@ func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpe
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer cancel()
 
+	previousDomainSpecXML := domainSpecXML
 	switch callback.Version {
 	case hooksV1alpha1.Version:
 		client := hooksV1alpha1.NewCallbacksClient(conn)
@@ -268,10 +349,34 @@ func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpe
 			return nil, err
 		}
 		domainSpecXML = result.GetDomainXML()
+	case hooksV1alpha4.Version:
+		client := hooksV1alpha4.NewCallbacksClient(conn)
+		result, err := client.OnDefineDomain(ctx, &hooksV1alpha4.OnDefineDomainParams{
+			DomainXML: domainSpecXML,
+			Vmi:       vmiJSON,
+		})
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to call OnDefineDomain")
+			return nil, err
+		}
+		if len(result.GetPatches()) == 0 {
+			domainSpecXML = result.GetDomainXML()
+			break
+		}
+		domainSpecXML, err = patcher.apply(callback.SocketPath, domainSpecXML, result.GetPatches())
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to apply the OnDefineDomain patches")
+			return nil, err
+		}
+		return domainSpecXML, nil
 	default:
 		log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 	}
 
+	if err := patcher.checkDomain(callback.SocketPath, previousDomainSpecXML, domainSpecXML); err != nil {
+		log.Log.Reason(err).Error("Failed to check the OnDefineDomain domain")
+		return nil, err
+	}
 	return domainSpecXML, nil
 }
 
@@ -340,7 +445,7 @@ func (m *hookManager) PreCloudInitIso(vmi *v1.VirtualMachineInstance, cloudInitD
 	for _, callback := range callbacks {
 		switch callback.Version {
 		case hooksV1alpha2.Version:
-			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+			conn, err := m.dialCallback(callback)
 			if err != nil {
 				log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
 				return cloudInitData, err
@@ -362,7 +467,7 @@ func (m *hookManager) PreCloudInitIso(vmi *v1.VirtualMachineInstance, cloudInitD
 			}
 			return preCloudInitIsoValidateResult(cloudInitData.DataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
 		case hooksV1alpha3.Version:
-			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+			conn, err := m.dialCallback(callback)
 			if err != nil {
 				log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
 				return cloudInitData, err
@@ -383,6 +488,28 @@ func (m *hookManager) PreCloudInitIso(vmi *v1.VirtualMachineInstance, cloudInitD
 				return cloudInitData, err
 			}
 			return preCloudInitIsoValidateResult(cloudInitData.DataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
+		case hooksV1alpha4.Version:
+			conn, err := m.dialCallback(callback)
+			if err != nil {
+				log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+				return cloudInitData, err
+			}
+			defer conn.Close()
+
+			client := hooksV1alpha4.NewCallbacksClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			result, err := client.PreCloudInitIso(ctx, &hooksV1alpha4.PreCloudInitIsoParams{
+				CloudInitData:          cloudInitDataJSON,
+				CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
+				Vmi:                    vmiJSON,
+			})
+			if err != nil {
+				log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
+				return cloudInitData, err
+			}
+			return preCloudInitIsoValidateResult(cloudInitData.DataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
 		default:
 			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 		}
@@ -398,7 +525,7 @@ func (m *hookManager) Shutdown() error {
 	for _, callback := range callbacks {
 		switch callback.Version {
 		case hooksV1alpha3.Version:
-			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+			conn, err := m.dialCallback(callback)
 			if err != nil {
 				log.Log.Reason(err).Error("Failed to run Shutdown")
 				return err
@@ -413,9 +540,232 @@ func (m *hookManager) Shutdown() error {
 				log.Log.Reason(err).Error("Failed to run Shutdown")
 				return err
 			}
+		case hooksV1alpha4.Version:
+			conn, err := m.dialCallback(callback)
+			if err != nil {
+				log.Log.Reason(err).Error("Failed to run Shutdown")
+				return err
+			}
+			defer conn.Close()
+
+			client := hooksV1alpha4.NewCallbacksClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			if _, err := client.Shutdown(ctx, &hooksV1alpha4.ShutdownParams{}); err != nil {
+				log.Log.Reason(err).Error("Failed to run Shutdown")
+				return err
+			}
 		default:
 			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 		}
 	}
 	return nil
 }
+
+// HasHookPoint returns whether any of the collected hook sidecars subscribed to the hook point
+func (m *hookManager) HasHookPoint(hookPointName string) bool {
+	return len(m.CallbacksPerHookPoint[hookPointName]) > 0
+}
+
+// OnGuestBoot passes the guest OS info to the hook sidecars once the guest agent connected after boot
+func (m *hookManager) OnGuestBoot(vmi *v1.VirtualMachineInstance, guestOSInfo *v1.VirtualMachineInstanceGuestOSInfo) error {
+	callbacks, found := m.CallbacksPerHookPoint[hooksInfo.OnGuestBootHookPointName]
+	if !found {
+		return nil
+	}
+
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+	guestOSInfoJSON, err := json.Marshal(guestOSInfo)
+	if err != nil {
+		return fmt.Errorf("failed to marshal guest OS info: %v, err: %v", guestOSInfo, err)
+	}
+
+	for _, callback := range callbacks {
+		switch callback.Version {
+		case hooksV1alpha4.Version:
+			if err := m.onGuestBootCallback(callback, vmiJSON, guestOSInfoJSON); err != nil {
+				return err
+			}
+		default:
+			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
+		}
+	}
+	return nil
+}
+
+func (m *hookManager) onGuestBootCallback(callback *callBackClient, vmiJSON, guestOSInfoJSON []byte) error {
+	conn, err := m.dialCallback(callback)
+	if err != nil {
+		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+		return err
+	}
+	defer conn.Close()
+
+	client := hooksV1alpha4.NewCallbacksClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if _, err := client.OnGuestBoot(ctx, &hooksV1alpha4.OnGuestBootParams{
+		Vmi:         vmiJSON,
+		GuestOSInfo: guestOSInfoJSON,
+	}); err != nil {
+		log.Log.Reason(err).Error("Failed to call OnGuestBoot")
+		return err
+	}
+	return nil
+}
+
+// OnMigrationSource passes the domain the migration target is going to run to the hook sidecars on the
+// migration source, which may rewrite it for the target node. It returns the domain XML to send to the target.
+func (m *hookManager) OnMigrationSource(domainXML string, vmi *v1.VirtualMachineInstance) (string, error) {
+	callbacks, found := m.CallbacksPerHookPoint[hooksInfo.OnMigrationSourceHookPointName]
+	if !found {
+		return domainXML, nil
+	}
+
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	result := []byte(domainXML)
+	for _, callback := range callbacks {
+		switch callback.Version {
+		case hooksV1alpha4.Version:
+			result, err = m.onMigrationSourceCallback(callback, result, vmiJSON)
+			if err != nil {
+				return "", err
+			}
+		default:
+			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
+		}
+	}
+	return string(result), nil
+}
+
+func (m *hookManager) onMigrationSourceCallback(callback *callBackClient, domainXML, vmiJSON []byte) ([]byte, error) {
+	conn, err := m.dialCallback(callback)
+	if err != nil {
+		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := hooksV1alpha4.NewCallbacksClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	result, err := client.OnMigrationSource(ctx, &hooksV1alpha4.OnMigrationSourceParams{
+		DomainXML: domainXML,
+		Vmi:       vmiJSON,
+	})
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to call OnMigrationSource")
+		return nil, err
+	}
+	return result.GetDomainXML(), nil
+}
+
+// OnMigrationTarget passes the domain of the incoming migration to the hook sidecars on the migration target,
+// before the migration starts, so that they can set up the target node for it
+func (m *hookManager) OnMigrationTarget(domainSpec *virtwrapApi.DomainSpec, vmi *v1.VirtualMachineInstance) error {
+	callbacks, found := m.CallbacksPerHookPoint[hooksInfo.OnMigrationTargetHookPointName]
+	if !found {
+		return nil
+	}
+
+	domainSpecXML, err := xml.MarshalIndent(domainSpec, "", "\t")
+	if err != nil {
+		return fmt.Errorf("Failed to marshal domain spec: %v", domainSpec)
+	}
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	for _, callback := range callbacks {
+		switch callback.Version {
+		case hooksV1alpha4.Version:
+			if err := m.onMigrationTargetCallback(callback, domainSpecXML, vmiJSON); err != nil {
+				return err
+			}
+		default:
+			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
+		}
+	}
+	return nil
+}
+
+func (m *hookManager) onMigrationTargetCallback(callback *callBackClient, domainSpecXML, vmiJSON []byte) error {
+	conn, err := m.dialCallback(callback)
+	if err != nil {
+		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+		return err
+	}
+	defer conn.Close()
+
+	client := hooksV1alpha4.NewCallbacksClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if _, err := client.OnMigrationTarget(ctx, &hooksV1alpha4.OnMigrationTargetParams{
+		DomainXML: domainSpecXML,
+		Vmi:       vmiJSON,
+	}); err != nil {
+		log.Log.Reason(err).Error("Failed to call OnMigrationTarget")
+		return err
+	}
+	return nil
+}
+
+// PostDomainStart passes the domain XML reported by libvirt to the hook sidecars once the domain started,
+// so that they can perform post-boot actions on the node for it
+func (m *hookManager) PostDomainStart(domainXML string, vmi *v1.VirtualMachineInstance) error {
+	callbacks, found := m.CallbacksPerHookPoint[hooksInfo.PostDomainStartHookPointName]
+	if !found {
+		return nil
+	}
+
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	for _, callback := range callbacks {
+		switch callback.Version {
+		case hooksV1alpha4.Version:
+			if err := m.postDomainStartCallback(callback, []byte(domainXML), vmiJSON); err != nil {
+				return err
+			}
+		default:
+			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
+		}
+	}
+	return nil
+}
+
+func (m *hookManager) postDomainStartCallback(callback *callBackClient, domainXML, vmiJSON []byte) error {
+	conn, err := m.dialCallback(callback)
+	if err != nil {
+		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+		return err
+	}
+	defer conn.Close()
+
+	client := hooksV1alpha4.NewCallbacksClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if _, err := client.PostDomainStart(ctx, &hooksV1alpha4.PostDomainStartParams{
+		DomainXML: domainXML,
+		Vmi:       vmiJSON,
+	}); err != nil {
+		log.Log.Reason(err).Error("Failed to call PostDomainStart")
+		return err
+	}
+	return nil
+}
@@ -21,19 +21,29 @@ package hooks
 
 import (
 	"context"
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
 
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
+	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 type dynamicInfoServer struct {
@@ -78,6 +88,104 @@ func hookListenAndServe(socketPath string, hookName string, hookPointName string
 	return socket, nil
 }
 
+type onGuestBootServer struct {
+	calls chan *hooksV1alpha4.OnGuestBootParams
+}
+
+func (s onGuestBootServer) Info(_ context.Context, _ *hooksInfo.InfoParams) (*hooksInfo.InfoResult, error) {
+	return &hooksInfo.InfoResult{
+		Name:     "guestboot",
+		Versions: []string{hooksV1alpha4.Version},
+		HookPoints: []*hooksInfo.HookPoint{
+			{Name: hooksInfo.OnGuestBootHookPointName},
+		},
+	}, nil
+}
+
+func (s onGuestBootServer) OnDefineDomain(_ context.Context, params *hooksV1alpha4.OnDefineDomainParams) (*hooksV1alpha4.OnDefineDomainResult, error) {
+	return &hooksV1alpha4.OnDefineDomainResult{DomainXML: params.GetDomainXML()}, nil
+}
+
+func (s onGuestBootServer) PreCloudInitIso(_ context.Context, params *hooksV1alpha4.PreCloudInitIsoParams) (*hooksV1alpha4.PreCloudInitIsoResult, error) {
+	return &hooksV1alpha4.PreCloudInitIsoResult{CloudInitData: params.GetCloudInitData()}, nil
+}
+
+func (s onGuestBootServer) Shutdown(_ context.Context, _ *hooksV1alpha4.ShutdownParams) (*hooksV1alpha4.ShutdownResult, error) {
+	return &hooksV1alpha4.ShutdownResult{}, nil
+}
+
+func (s onGuestBootServer) OnGuestBoot(_ context.Context, params *hooksV1alpha4.OnGuestBootParams) (*hooksV1alpha4.OnGuestBootResult, error) {
+	s.calls <- params
+	return &hooksV1alpha4.OnGuestBootResult{}, nil
+}
+
+func (s onGuestBootServer) OnMigrationSource(_ context.Context, params *hooksV1alpha4.OnMigrationSourceParams) (*hooksV1alpha4.OnMigrationSourceResult, error) {
+	return &hooksV1alpha4.OnMigrationSourceResult{DomainXML: params.GetDomainXML()}, nil
+}
+
+func (s onGuestBootServer) OnMigrationTarget(_ context.Context, _ *hooksV1alpha4.OnMigrationTargetParams) (*hooksV1alpha4.OnMigrationTargetResult, error) {
+	return &hooksV1alpha4.OnMigrationTargetResult{}, nil
+}
+
+func (s onGuestBootServer) PostDomainStart(_ context.Context, _ *hooksV1alpha4.PostDomainStartParams) (*hooksV1alpha4.PostDomainStartResult, error) {
+	return &hooksV1alpha4.PostDomainStartResult{}, nil
+}
+
+type v1alpha4Server struct {
+	targetCalls    chan *hooksV1alpha4.OnMigrationTargetParams
+	postStartCalls chan *hooksV1alpha4.PostDomainStartParams
+}
+
+func (s v1alpha4Server) Info(_ context.Context, _ *hooksInfo.InfoParams) (*hooksInfo.InfoResult, error) {
+	return &hooksInfo.InfoResult{
+		Name:     "v1alpha4",
+		Versions: []string{hooksV1alpha3.Version, hooksV1alpha4.Version},
+		HookPoints: []*hooksInfo.HookPoint{
+			{Name: hooksInfo.OnDefineDomainHookPointName},
+			{Name: hooksInfo.OnMigrationSourceHookPointName},
+			{Name: hooksInfo.OnMigrationTargetHookPointName},
+			{Name: hooksInfo.PostDomainStartHookPointName},
+		},
+	}, nil
+}
+
+func (s v1alpha4Server) OnDefineDomain(_ context.Context, _ *hooksV1alpha4.OnDefineDomainParams) (*hooksV1alpha4.OnDefineDomainResult, error) {
+	return &hooksV1alpha4.OnDefineDomainResult{
+		Patches: []*hooksV1alpha4.DomainPatch{{Op: "replace", Path: "/Memory/Value", Value: "2048"}},
+	}, nil
+}
+
+func (s v1alpha4Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha4.PreCloudInitIsoParams) (*hooksV1alpha4.PreCloudInitIsoResult, error) {
+	return &hooksV1alpha4.PreCloudInitIsoResult{CloudInitData: params.GetCloudInitData()}, nil
+}
+
+func (s v1alpha4Server) Shutdown(_ context.Context, _ *hooksV1alpha4.ShutdownParams) (*hooksV1alpha4.ShutdownResult, error) {
+	return &hooksV1alpha4.ShutdownResult{}, nil
+}
+
+func (s v1alpha4Server) OnGuestBoot(_ context.Context, _ *hooksV1alpha4.OnGuestBootParams) (*hooksV1alpha4.OnGuestBootResult, error) {
+	return &hooksV1alpha4.OnGuestBootResult{}, nil
+}
+
+func (s v1alpha4Server) OnMigrationSource(_ context.Context, params *hooksV1alpha4.OnMigrationSourceParams) (*hooksV1alpha4.OnMigrationSourceResult, error) {
+	vmi := &v1.VirtualMachineInstance{}
+	if err := json.Unmarshal(params.GetVmi(), vmi); err != nil {
+		return nil, err
+	}
+	domainXML := strings.ReplaceAll(string(params.GetDomainXML()), "br-source", "br-"+vmi.Status.MigrationState.TargetNode)
+	return &hooksV1alpha4.OnMigrationSourceResult{DomainXML: []byte(domainXML)}, nil
+}
+
+func (s v1alpha4Server) OnMigrationTarget(_ context.Context, params *hooksV1alpha4.OnMigrationTargetParams) (*hooksV1alpha4.OnMigrationTargetResult, error) {
+	s.targetCalls <- params
+	return &hooksV1alpha4.OnMigrationTargetResult{}, nil
+}
+
+func (s v1alpha4Server) PostDomainStart(_ context.Context, params *hooksV1alpha4.PostDomainStartParams) (*hooksV1alpha4.PostDomainStartResult, error) {
+	s.postStartCalls <- params
+	return &hooksV1alpha4.PostDomainStartResult{}, nil
+}
+
 var _ = Describe("HooksManager", func() {
 	Context("With existing sockets", func() {
 		var socketDir string
@@ -153,6 +261,143 @@ var _ = Describe("HooksManager", func() {
 			}
 		})
 
+		It("Should wait for the sidecar to report it is serving", func() {
+			hookPointName := hooksInfo.OnDefineDomainHookPointName
+
+			socketPath := filepath.Join(socketDir, "hook1.sock")
+			socket, err := net.Listen("unix", socketPath)
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Remove(socketPath)
+
+			server := grpc.NewServer()
+			hooksInfo.RegisterInfoServer(server, dynamicInfoServer{hookName: "hook1", hookPointName: hookPointName})
+			healthServer := health.NewServer()
+			healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+			healthpb.RegisterHealthServer(server, healthServer)
+			go server.Serve(socket)
+			defer server.Stop()
+
+			manager := newManager(socketDir)
+			Expect(manager.Collect(1, 2*time.Second)).To(MatchError(ContainSubstring("within given timeout")))
+
+			healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+			Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+			Expect(manager.CallbacksPerHookPoint).To(HaveKeyWithValue(hookPointName, HaveLen(1)))
+		})
+
+		It("Should pass the guest OS info to OnGuestBoot sidecars", func() {
+			socketPath := filepath.Join(socketDir, "guestboot.sock")
+			socket, err := net.Listen("unix", socketPath)
+			Expect(err).ToNot(HaveOccurred())
+			defer socket.Close()
+
+			hookServer := onGuestBootServer{calls: make(chan *hooksV1alpha4.OnGuestBootParams, 1)}
+			server := grpc.NewServer()
+			hooksInfo.RegisterInfoServer(server, hookServer)
+			hooksV1alpha4.RegisterCallbacksServer(server, hookServer)
+			go server.Serve(socket)
+			defer server.Stop()
+
+			manager := newManager(socketDir)
+			Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+			Expect(manager.HasHookPoint(hooksInfo.OnGuestBootHookPointName)).To(BeTrue())
+			Expect(manager.HasHookPoint(hooksInfo.OnDefineDomainHookPointName)).To(BeFalse())
+
+			vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi"}}
+			guestOSInfo := &v1.VirtualMachineInstanceGuestOSInfo{Name: "Fedora Linux", VersionID: "40"}
+			Expect(manager.OnGuestBoot(vmi, guestOSInfo)).To(Succeed())
+
+			var params *hooksV1alpha4.OnGuestBootParams
+			Eventually(hookServer.calls).Should(Receive(&params))
+			receivedVMI := &v1.VirtualMachineInstance{}
+			Expect(json.Unmarshal(params.GetVmi(), receivedVMI)).To(Succeed())
+			Expect(receivedVMI.Name).To(Equal("testvmi"))
+			receivedGuestOSInfo := &v1.VirtualMachineInstanceGuestOSInfo{}
+			Expect(json.Unmarshal(params.GetGuestOSInfo(), receivedGuestOSInfo)).To(Succeed())
+			Expect(receivedGuestOSInfo).To(Equal(guestOSInfo))
+		})
+
+		Context("with a v1alpha4 hook sidecar", func() {
+			var (
+				manager    *hookManager
+				hookServer v1alpha4Server
+			)
+
+			BeforeEach(func() {
+				socket, err := net.Listen("unix", filepath.Join(socketDir, "v1alpha4.sock"))
+				Expect(err).ToNot(HaveOccurred())
+
+				hookServer = v1alpha4Server{
+					targetCalls:    make(chan *hooksV1alpha4.OnMigrationTargetParams, 1),
+					postStartCalls: make(chan *hooksV1alpha4.PostDomainStartParams, 1),
+				}
+				server := grpc.NewServer()
+				hooksInfo.RegisterInfoServer(server, hookServer)
+				hooksV1alpha4.RegisterCallbacksServer(server, hookServer)
+				go server.Serve(socket)
+				DeferCleanup(server.Stop)
+
+				manager = newManager(socketDir)
+				Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+			})
+
+			It("should prefer the v1alpha4 version", func() {
+				Expect(manager.CallbacksPerHookPoint[hooksInfo.OnMigrationSourceHookPointName][0].Version).To(Equal(hooksV1alpha4.Version))
+			})
+
+			It("should apply the domain patches returned by OnDefineDomain sidecars", func() {
+				domainSpec := &virtwrapApi.DomainSpec{Name: "default_testvmi", Memory: virtwrapApi.Memory{Value: 1024, Unit: "MiB"}}
+				domainXML, err := manager.OnDefineDomain(domainSpec, &v1.VirtualMachineInstance{})
+				Expect(err).ToNot(HaveOccurred())
+
+				patchedSpec := &virtwrapApi.DomainSpec{}
+				Expect(xml.Unmarshal([]byte(domainXML), patchedSpec)).To(Succeed())
+				Expect(patchedSpec.Name).To(Equal("default_testvmi"))
+				Expect(patchedSpec.Memory).To(Equal(virtwrapApi.Memory{Value: 2048, Unit: "MiB"}))
+			})
+
+			It("should let OnMigrationSource sidecars rewrite the domain sent to the target", func() {
+				vmi := &v1.VirtualMachineInstance{
+					Status: v1.VirtualMachineInstanceStatus{
+						MigrationState: &v1.VirtualMachineInstanceMigrationState{TargetNode: "node02"},
+					},
+				}
+
+				domainXML, err := manager.OnMigrationSource(`<domain><interface><source bridge="br-source"/></interface></domain>`, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(domainXML).To(Equal(`<domain><interface><source bridge="br-node02"/></interface></domain>`))
+			})
+
+			It("should pass the domain of the incoming migration to OnMigrationTarget sidecars", func() {
+				vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi"}}
+				Expect(manager.OnMigrationTarget(&virtwrapApi.DomainSpec{Name: "default_testvmi"}, vmi)).To(Succeed())
+
+				var params *hooksV1alpha4.OnMigrationTargetParams
+				Eventually(hookServer.targetCalls).Should(Receive(&params))
+				Expect(string(params.GetDomainXML())).To(ContainSubstring("<name>default_testvmi</name>"))
+				receivedVMI := &v1.VirtualMachineInstance{}
+				Expect(json.Unmarshal(params.GetVmi(), receivedVMI)).To(Succeed())
+				Expect(receivedVMI.Name).To(Equal("testvmi"))
+			})
+
+			It("should pass the domain of the started VMI to PostDomainStart sidecars", func() {
+				vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi"}}
+				Expect(manager.PostDomainStart("<domain><name>default_testvmi</name></domain>", vmi)).To(Succeed())
+
+				var params *hooksV1alpha4.PostDomainStartParams
+				Eventually(hookServer.postStartCalls).Should(Receive(&params))
+				Expect(string(params.GetDomainXML())).To(Equal("<domain><name>default_testvmi</name></domain>"))
+				receivedVMI := &v1.VirtualMachineInstance{}
+				Expect(json.Unmarshal(params.GetVmi(), receivedVMI)).To(Succeed())
+				Expect(receivedVMI.Name).To(Equal("testvmi"))
+			})
+		})
+
+		It("should leave the domain sent to the target alone without OnMigrationSource sidecars", func() {
+			manager := newManager(socketDir)
+			Expect(manager.OnMigrationSource("<domain/>", &v1.VirtualMachineInstance{})).To(Equal("<domain/>"))
+		})
+
 		AfterEach(func() {
 			os.RemoveAll(socketDir)
 		})
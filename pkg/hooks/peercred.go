@@ -0,0 +1,128 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"kubevirt.io/client-go/log"
+)
+
+const peerCredentialsProtocol = "peercred"
+
+// ErrUnverifiedHookCaller is returned when a hook sidecar is called by a process of another user
+// than virt-launcher.
+var ErrUnverifiedHookCaller = errors.New("unverified hook caller")
+
+// PeerAuthInfo holds the peer credentials of the caller of a hook sidecar
+type PeerAuthInfo struct {
+	credentials.CommonAuthInfo
+	PID int32
+	UID uint32
+}
+
+func (PeerAuthInfo) AuthType() string {
+	return peerCredentialsProtocol
+}
+
+// PeerCredentialsServerOptions make a hook sidecar only accept the connections of processes of
+// its own user, which is the user virt-launcher runs as. Other containers sharing the hook socket
+// directory then can't call the hooks, e.g. to inject mutations of the domain. Sidecars only run
+// as the user of virt-launcher with the hook sidecar identity, so the check is only enabled when
+// the identity directory is mounted.
+func PeerCredentialsServerOptions() []grpc.ServerOption {
+	return peerCredentialsServerOptions(HookIdentityDirectory, uint32(os.Getuid()))
+}
+
+func peerCredentialsServerOptions(identityDirectory string, uid uint32) []grpc.ServerOption {
+	if _, err := os.Stat(identityDirectory); err != nil {
+		return nil
+	}
+	return []grpc.ServerOption{grpc.Creds(&peerCredentialsVerifier{uid: uid})}
+}
+
+// peerCredentialsVerifier checks the peer credentials (SO_PEERCRED) of the unix socket
+// connections. No bytes are exchanged, the callers connect without transport security.
+type peerCredentialsVerifier struct {
+	uid uint32
+}
+
+func (v *peerCredentialsVerifier) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: not a unix socket connection", ErrUnverifiedHookCaller)
+	}
+	ucred, err := peerCredentials(unixConn)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ucred.Uid != v.uid {
+		log.Log.Warningf("Rejected the hook call of pid %d: uid %d, expected uid %d", ucred.Pid, ucred.Uid, v.uid)
+		return nil, nil, fmt.Errorf("%w: called by uid %d, expected uid %d", ErrUnverifiedHookCaller, ucred.Uid, v.uid)
+	}
+
+	return conn, PeerAuthInfo{
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.NoSecurity},
+		PID:            ucred.Pid,
+		UID:            ucred.Uid,
+	}, nil
+}
+
+func (v *peerCredentialsVerifier) ClientHandshake(_ context.Context, _ string, _ net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, fmt.Errorf("peer credentials only verify the clients")
+}
+
+func (v *peerCredentialsVerifier) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: peerCredentialsProtocol}
+}
+
+func (v *peerCredentialsVerifier) Clone() credentials.TransportCredentials {
+	return &peerCredentialsVerifier{uid: v.uid}
+}
+
+func (v *peerCredentialsVerifier) OverrideServerName(_ string) error {
+	return nil
+}
+
+// peerCredentials returns the credentials of the process at the other end of the connection
+func peerCredentials(conn *net.UnixConn) (*syscall.Ucred, error) {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var ucred *syscall.Ucred
+	var credErr error
+	err = rawConn.Control(func(fd uintptr) {
+		ucred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ucred, credErr
+}
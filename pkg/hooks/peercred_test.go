@@ -0,0 +1,97 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package hooks
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+)
+
+var _ = Describe("Hook caller peer credentials", func() {
+	var socketPath string
+	var callerAuthInfo chan PeerAuthInfo
+
+	serve := func(uid uint32) {
+		socketPath = filepath.Join(GinkgoT().TempDir(), "hook.sock")
+		socket, err := net.Listen("unix", socketPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		callerAuthInfo = make(chan PeerAuthInfo, 1)
+		options := append(peerCredentialsServerOptions(GinkgoT().TempDir(), uid), grpc.UnaryInterceptor(
+			func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				if p, ok := peer.FromContext(ctx); ok {
+					callerAuthInfo <- p.AuthInfo.(PeerAuthInfo)
+				}
+				return handler(ctx, req)
+			}))
+		server := grpc.NewServer(options...)
+		hooksInfo.RegisterInfoServer(server, dynamicInfoServer{
+			hookName:      "hook",
+			hookPointName: hooksInfo.OnDefineDomainHookPointName,
+		})
+		go server.Serve(socket)
+		DeferCleanup(server.Stop)
+	}
+
+	callInfo := func() error {
+		conn, err := grpc.NewClient("unix://"+socketPath, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err = hooksInfo.NewInfoClient(conn).Info(ctx, &hooksInfo.InfoParams{})
+		return err
+	}
+
+	It("should accept the calls of processes of the same user", func() {
+		serve(uint32(os.Getuid()))
+
+		Expect(callInfo()).To(Succeed())
+		var authInfo PeerAuthInfo
+		Expect(callerAuthInfo).To(Receive(&authInfo))
+		Expect(authInfo.UID).To(Equal(uint32(os.Getuid())))
+		Expect(authInfo.SecurityLevel).To(Equal(credentials.NoSecurity))
+	})
+
+	It("should reject the calls of processes of another user", func() {
+		serve(uint32(os.Getuid()) + 1)
+
+		Expect(callInfo()).ToNot(Succeed())
+		Expect(callerAuthInfo).ToNot(Receive())
+	})
+
+	It("should not check the callers without the hook identity directory", func() {
+		Expect(peerCredentialsServerOptions(filepath.Join(GinkgoT().TempDir(), "missing"), uint32(os.Getuid()))).To(BeEmpty())
+	})
+})
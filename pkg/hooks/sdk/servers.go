@@ -0,0 +1,142 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
+	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// TODO: move to Kubevirt domain API package
+const libvirtDomainQemuSchema = "http://libvirt.org/schemas/domain/qemu/1.0"
+
+type infoServer struct {
+	name string
+}
+
+func (s infoServer) Info(_ context.Context, _ *hooksInfo.InfoParams) (*hooksInfo.InfoResult, error) {
+	return &hooksInfo.InfoResult{
+		Name: s.name,
+		Versions: []string{
+			hooksV1alpha3.Version,
+			hooksV1alpha2.Version,
+		},
+		HookPoints: []*hooksInfo.HookPoint{
+			{
+				Name:     hooksInfo.OnDefineDomainHookPointName,
+				Priority: 0,
+			},
+			{
+				Name:     hooksInfo.ShutdownHookPointName,
+				Priority: 0,
+			},
+		},
+	}, nil
+}
+
+type v1alpha2Server struct {
+	mutator DomainMutator
+}
+
+func (s v1alpha2Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha2.OnDefineDomainParams) (*hooksV1alpha2.OnDefineDomainResult, error) {
+	domainXML, err := onDefineDomain(ctx, params.GetVmi(), params.GetDomainXML(), s.mutator)
+	if err != nil {
+		return nil, err
+	}
+	return &hooksV1alpha2.OnDefineDomainResult{
+		DomainXML: domainXML,
+	}, nil
+}
+
+func (s v1alpha2Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha2.PreCloudInitIsoParams) (*hooksV1alpha2.PreCloudInitIsoResult, error) {
+	return &hooksV1alpha2.PreCloudInitIsoResult{
+		CloudInitData: params.GetCloudInitData(),
+	}, nil
+}
+
+type v1alpha3Server struct {
+	mutator DomainMutator
+	done    chan struct{}
+	// shutdownTimeout bounds the Shutdown hook call
+	shutdownTimeout time.Duration
+}
+
+func (s v1alpha3Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha3.OnDefineDomainParams) (*hooksV1alpha3.OnDefineDomainResult, error) {
+	domainXML, err := onDefineDomain(ctx, params.GetVmi(), params.GetDomainXML(), s.mutator)
+	if err != nil {
+		return nil, err
+	}
+	return &hooksV1alpha3.OnDefineDomainResult{
+		DomainXML: domainXML,
+	}, nil
+}
+
+func (s v1alpha3Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha3.PreCloudInitIsoParams) (*hooksV1alpha3.PreCloudInitIsoResult, error) {
+	return &hooksV1alpha3.PreCloudInitIsoResult{
+		CloudInitData: params.GetCloudInitData(),
+	}, nil
+}
+
+func (s v1alpha3Server) Shutdown(ctx context.Context, _ *hooksV1alpha3.ShutdownParams) (*hooksV1alpha3.ShutdownResult, error) {
+	hooks.Logger(ctx).Info("Hook's Shutdown callback method has been called")
+	if err := hooks.NotifyShutdown(ctx, s.done, s.shutdownTimeout); err != nil {
+		return nil, err
+	}
+	return &hooksV1alpha3.ShutdownResult{}, nil
+}
+
+func onDefineDomain(ctx context.Context, vmiJSON []byte, domainXML []byte, mutator DomainMutator) ([]byte, error) {
+	vmi := &v1.VirtualMachineInstance{}
+	if err := json.Unmarshal(vmiJSON, vmi); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal VMI: %v", err)
+	}
+
+	domainSpec := &api.DomainSpec{
+		// Unmarshalling domain spec makes the XML namespace attribute empty.
+		// Some domain parameters requires namespace to be defined.
+		// e.g: https://libvirt.org/drvqemu.html#pass-through-of-arbitrary-qemu-commands
+		XmlNS: libvirtDomainQemuSchema,
+	}
+	if err := xml.Unmarshal(domainXML, domainSpec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal given domain spec: %v", err)
+	}
+
+	updatedDomainSpec, err := mutator(ctx, vmi, domainSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedDomainSpecXML, err := xml.Marshal(updatedDomainSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal updated domain spec: %v", err)
+	}
+	return updatedDomainSpecXML, nil
+}
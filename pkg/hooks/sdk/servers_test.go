@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+
+	domainschema "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("OnDefineDomain", func() {
+	var vmiJSON []byte
+
+	BeforeEach(func() {
+		var err error
+		vmiJSON, err = json.Marshal(api.NewMinimalVMI("testvmi"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	mutatorStub := func(domainSpec *domainschema.DomainSpec, err error) DomainMutator {
+		return func(_ context.Context, _ *v1.VirtualMachineInstance, _ *domainschema.DomainSpec) (*domainschema.DomainSpec, error) {
+			return domainSpec, err
+		}
+	}
+
+	It("should fail given empty byte slice stream", func() {
+		_, err := onDefineDomain(context.Background(), vmiJSON, []byte{}, mutatorStub(nil, nil))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail given invalid domain XML", func() {
+		_, err := onDefineDomain(context.Background(), vmiJSON, []byte("invalid-domain-xml"), mutatorStub(nil, nil))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail given invalid VMI", func() {
+		domain := domainschema.NewMinimalDomain("test")
+		domainXML, err := xml.Marshal(domain.Spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = onDefineDomain(context.Background(), []byte("invalid-vmi"), domainXML, mutatorStub(&domain.Spec, nil))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail when domain spec mutator fails", func() {
+		domain := domainschema.NewMinimalDomain("test")
+		domainXML, err := xml.Marshal(domain.Spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		expectedErr := fmt.Errorf("test error")
+		_, err = onDefineDomain(context.Background(), vmiJSON, domainXML, mutatorStub(nil, expectedErr))
+		Expect(err).To(Equal(expectedErr))
+	})
+
+	It("given no-op mutator, domain spec should not change", func() {
+		domain := domainschema.NewMinimalDomain("test")
+		domainSpecXML, err := xml.Marshal(domain.Spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(onDefineDomain(context.Background(), vmiJSON, domainSpecXML, mutatorStub(&domain.Spec, nil))).To(Equal(domainSpecXML))
+	})
+
+	It("domain spec should mutate successfully", func() {
+		domain := domainschema.NewMinimalDomain("test")
+		domainSpecXML, err := xml.Marshal(domain.Spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		mutatedDomainSpec := domain.Spec.DeepCopy()
+		mutatedDomainSpec.Devices.Interfaces = append(mutatedDomainSpec.Devices.Interfaces,
+			domainschema.Interface{Alias: domainschema.NewUserDefinedAlias("test")})
+
+		mutatedDomainSpecXML, err := xml.Marshal(mutatedDomainSpec)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(onDefineDomain(context.Background(), vmiJSON, domainSpecXML, mutatorStub(mutatedDomainSpec, nil))).To(Equal(mutatedDomainSpecXML))
+	})
+
+	It("should pass the VMI to the mutator", func() {
+		domain := domainschema.NewMinimalDomain("test")
+		domainSpecXML, err := xml.Marshal(domain.Spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		var vmiName string
+		_, err = onDefineDomain(context.Background(), vmiJSON, domainSpecXML,
+			func(_ context.Context, vmi *v1.VirtualMachineInstance, domainSpec *domainschema.DomainSpec) (*domainschema.DomainSpec, error) {
+				vmiName = vmi.Name
+				return domainSpec, nil
+			})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmiName).To(Equal("testvmi"))
+	})
+})
@@ -0,0 +1,166 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+// Package sdk implements the boilerplate of the hook sidecars: the socket, the Info server, the
+// hook API versions, the health service and the shutdown on a signal or on the Shutdown hook.
+// A sidecar only supplies the mutation of the domain:
+//
+//	func main() {
+//		sidecar := sdk.NewSidecar("my-binding", func(ctx context.Context, vmi *v1.VirtualMachineInstance, domain *api.DomainSpec) (*api.DomainSpec, error) {
+//			domain.Devices.Interfaces = append(domain.Devices.Interfaces, api.Interface{...})
+//			return domain, nil
+//		})
+//		if err := sidecar.Run(); err != nil {
+//			os.Exit(1)
+//		}
+//	}
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
+	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// DomainMutator mutates the domain of a VMI before virt-launcher defines it. It returns the
+// mutated domain, which may be the one it was given.
+type DomainMutator func(ctx context.Context, vmi *v1.VirtualMachineInstance, domain *api.DomainSpec) (*api.DomainSpec, error)
+
+// Sidecar serves the OnDefineDomain and Shutdown hooks on a socket of the hook socket directory
+type Sidecar struct {
+	name            string
+	mutator         DomainMutator
+	socketDirectory string
+	socketName      string
+	shutdownTimeout time.Duration
+	serverOptions   []grpc.ServerOption
+}
+
+// NewSidecar returns a sidecar named name, serving the socket <name>.sock. The sidecar serves the
+// v1alpha3 and v1alpha2 hook APIs, virt-launcher calls the newest one it supports.
+func NewSidecar(name string, mutator DomainMutator) *Sidecar {
+	return &Sidecar{
+		name:            name,
+		mutator:         mutator,
+		socketDirectory: hooks.HookSocketsSharedDirectory,
+		socketName:      name + ".sock",
+		shutdownTimeout: hooks.ShutdownTimeout(),
+	}
+}
+
+// WithSocketName sets the name of the socket of the sidecar
+func (s *Sidecar) WithSocketName(socketName string) *Sidecar {
+	s.socketName = socketName
+	return s
+}
+
+// WithSocketDirectory sets the directory of the socket, the hook socket directory by default
+func (s *Sidecar) WithSocketDirectory(socketDirectory string) *Sidecar {
+	s.socketDirectory = socketDirectory
+	return s
+}
+
+// WithShutdownTimeout sets the time given to the hook calls in flight to complete on shutdown,
+// before the sidecar stops forcibly
+func (s *Sidecar) WithShutdownTimeout(timeout time.Duration) *Sidecar {
+	s.shutdownTimeout = timeout
+	return s
+}
+
+// WithServerOptions adds options to the gRPC server, e.g. interceptors. The server always logs the
+// VMI of the calls, and with the hook sidecar identity, verifies the identity of the sidecar and the
+// peer credentials of its callers.
+func (s *Sidecar) WithServerOptions(options ...grpc.ServerOption) *Sidecar {
+	s.serverOptions = append(s.serverOptions, options...)
+	return s
+}
+
+// Run serves the hooks until the sidecar receives a signal or the Shutdown hook. On shutdown, the
+// calls in flight are given the shutdown timeout to complete.
+func (s *Sidecar) Run() error {
+	socketPath := filepath.Join(s.socketDirectory, s.socketName)
+	socket, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("failed to listen on socket %s: %v", socketPath, err)
+	}
+	defer os.Remove(socketPath)
+
+	serverOptions := append(hooks.IdentityServerOptions(), hooks.LoggingServerOptions()...)
+	serverOptions = append(serverOptions, hooks.PeerCredentialsServerOptions()...)
+	server := grpc.NewServer(append(serverOptions, s.serverOptions...)...)
+
+	shutdownChan := make(chan struct{})
+	hooksInfo.RegisterInfoServer(server, infoServer{name: s.name})
+	hooksV1alpha2.RegisterCallbacksServer(server, v1alpha2Server{mutator: s.mutator})
+	hooksV1alpha3.RegisterCallbacksServer(server, v1alpha3Server{
+		mutator:         s.mutator,
+		done:            shutdownChan,
+		shutdownTimeout: s.shutdownTimeout,
+	})
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	// Handle signals to properly shutdown process
+	signalStopChan := make(chan os.Signal, 1)
+	signal.Notify(signalStopChan, os.Interrupt,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT,
+	)
+	defer signal.Stop(signalStopChan)
+
+	log.Log.Infof("%s sidecar is now exposing its services on socket %s", s.name, socketPath)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- server.Serve(socket)
+	}()
+
+	select {
+	case sig := <-signalStopChan:
+		log.Log.Infof("%s sidecar received signal: %s", s.name, sig.String())
+	case err = <-errChan:
+		healthServer.Shutdown()
+		return fmt.Errorf("failed to run grpc server: %v", err)
+	case <-shutdownChan:
+		log.Log.Info("Exiting")
+	}
+
+	healthServer.Shutdown()
+	hooks.StopServer(server, s.shutdownTimeout)
+	return nil
+}
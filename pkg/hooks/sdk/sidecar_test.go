@@ -0,0 +1,114 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package sdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+	"kubevirt.io/kubevirt/pkg/hooks/sdk"
+	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	domainschema "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Sidecar", func() {
+	var conn *grpc.ClientConn
+	var runErr chan error
+
+	BeforeEach(func() {
+		socketDir := GinkgoT().TempDir()
+		sidecar := sdk.NewSidecar("test-binding", func(_ context.Context, vmi *v1.VirtualMachineInstance, domain *domainschema.DomainSpec) (*domainschema.DomainSpec, error) {
+			domain.Devices.Interfaces = append(domain.Devices.Interfaces, domainschema.Interface{
+				Alias: domainschema.NewUserDefinedAlias(vmi.Name),
+			})
+			return domain, nil
+		}).WithSocketDirectory(socketDir).WithShutdownTimeout(time.Second)
+
+		runErr = make(chan error, 1)
+		go func() {
+			runErr <- sidecar.Run()
+		}()
+
+		socketPath := filepath.Join(socketDir, "test-binding.sock")
+		Eventually(socketPath).Should(BeAnExistingFile())
+		var err error
+		conn, err = grpc.NewClient("unix://"+socketPath, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(conn.Close)
+	})
+
+	shutdown := func() {
+		_, err := hooksV1alpha3.NewCallbacksClient(conn).Shutdown(context.Background(), &hooksV1alpha3.ShutdownParams{})
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(runErr).Should(Receive(BeNil()))
+	}
+
+	It("should expose its hook points and versions", func() {
+		info, err := hooksInfo.NewInfoClient(conn).Info(context.Background(), &hooksInfo.InfoParams{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Name).To(Equal("test-binding"))
+		Expect(info.Versions).To(Equal([]string{"v1alpha3", "v1alpha2"}))
+		Expect(info.HookPoints).To(HaveLen(2))
+
+		shutdown()
+	})
+
+	It("should serve the health service", func() {
+		health, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health.Status).To(Equal(healthpb.HealthCheckResponse_SERVING))
+
+		shutdown()
+	})
+
+	It("should mutate the domain with the VMI", func() {
+		vmiJSON, err := json.Marshal(api.NewMinimalVMI("testvmi"))
+		Expect(err).ToNot(HaveOccurred())
+		domainXML, err := xml.Marshal(domainschema.NewMinimalDomain("test").Spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		result, err := hooksV1alpha3.NewCallbacksClient(conn).OnDefineDomain(context.Background(), &hooksV1alpha3.OnDefineDomainParams{
+			Vmi:       vmiJSON,
+			DomainXML: domainXML,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		domain := &domainschema.DomainSpec{}
+		Expect(xml.Unmarshal(result.DomainXML, domain)).To(Succeed())
+		Expect(domain.Devices.Interfaces).To(HaveLen(1))
+		Expect(domain.Devices.Interfaces[0].Alias.GetName()).To(Equal("testvmi"))
+
+		shutdown()
+	})
+})
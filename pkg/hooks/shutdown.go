@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// ShutdownTimeoutEnvVar overrides how long the hook sidecars wait for the calls in flight to
+	// complete when they shut down, e.g. "10s"
+	ShutdownTimeoutEnvVar = "HOOK_SIDECAR_SHUTDOWN_TIMEOUT"
+
+	DefaultShutdownTimeout = 30 * time.Second
+)
+
+// ShutdownTimeout returns the shutdown timeout of the hook sidecars set in the environment, or
+// the default one
+func ShutdownTimeout() time.Duration {
+	value, exists := os.LookupEnv(ShutdownTimeoutEnvVar)
+	if !exists {
+		return DefaultShutdownTimeout
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		log.Log.Warningf("Ignoring invalid %s %q, using %s", ShutdownTimeoutEnvVar, value, DefaultShutdownTimeout)
+		return DefaultShutdownTimeout
+	}
+	return timeout
+}
+
+// StopServer stops the gRPC server of a hook sidecar gracefully, and forcibly once the timeout
+// expired, so that a stuck hook call cannot keep the sidecar from exiting
+func StopServer(server *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		log.Log.Warningf("Hook calls still in flight after %s, stopping the server", timeout)
+		server.Stop()
+		<-stopped
+	}
+}
+
+// NotifyShutdown hands the Shutdown hook call over to the sidecar waiting for it to exit. It gives
+// up once the timeout expired, e.g. when the sidecar is already shutting down on a signal.
+func NotifyShutdown(ctx context.Context, done chan<- struct{}, timeout time.Duration) error {
+	select {
+	case done <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(timeout):
+		return fmt.Errorf("sidecar did not start shutting down within %s", timeout)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hooks
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/grpc"
+
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
+	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
+)
+
+// stuckInfoServer never answers the Info calls until released
+type stuckInfoServer struct {
+	called  chan struct{}
+	release chan struct{}
+}
+
+func (s stuckInfoServer) Info(ctx context.Context, _ *hooksInfo.InfoParams) (*hooksInfo.InfoResult, error) {
+	close(s.called)
+	select {
+	case <-s.release:
+	case <-ctx.Done():
+	}
+	return &hooksInfo.InfoResult{}, nil
+}
+
+var _ = Describe("Hook sidecar shutdown", func() {
+	Context("shutdown timeout", func() {
+		It("should default without environment variable", func() {
+			Expect(ShutdownTimeout()).To(Equal(DefaultShutdownTimeout))
+		})
+
+		It("should be set by the environment variable", func() {
+			GinkgoT().Setenv(ShutdownTimeoutEnvVar, "5s")
+			Expect(ShutdownTimeout()).To(Equal(5 * time.Second))
+		})
+
+		DescribeTable("should ignore an invalid environment variable", func(value string) {
+			GinkgoT().Setenv(ShutdownTimeoutEnvVar, value)
+			Expect(ShutdownTimeout()).To(Equal(DefaultShutdownTimeout))
+		},
+			Entry("which is not a duration", "soon"),
+			Entry("which is not positive", "0s"),
+		)
+	})
+
+	Context("StopServer", func() {
+		var (
+			server     *grpc.Server
+			infoServer stuckInfoServer
+			conn       *grpc.ClientConn
+		)
+
+		BeforeEach(func() {
+			socketPath := filepath.Join(GinkgoT().TempDir(), "hook.sock")
+			listener, err := net.Listen("unix", socketPath)
+			Expect(err).ToNot(HaveOccurred())
+
+			infoServer = stuckInfoServer{called: make(chan struct{}), release: make(chan struct{})}
+			server = grpc.NewServer()
+			hooksInfo.RegisterInfoServer(server, infoServer)
+			go func() { _ = server.Serve(listener) }()
+
+			conn, err = grpcutil.DialSocket(socketPath)
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(func() {
+				conn.Close()
+				os.Remove(socketPath)
+			})
+		})
+
+		callInfo := func() <-chan error {
+			errChan := make(chan error, 1)
+			go func() {
+				_, err := hooksInfo.NewInfoClient(conn).Info(context.Background(), &hooksInfo.InfoParams{})
+				errChan <- err
+			}()
+			Eventually(infoServer.called).Should(BeClosed())
+			return errChan
+		}
+
+		It("should wait for the calls in flight to complete", func() {
+			errChan := callInfo()
+			close(infoServer.release)
+
+			StopServer(server, time.Minute)
+
+			Expect(<-errChan).ToNot(HaveOccurred())
+		})
+
+		It("should stop the server once the timeout expired with a stuck call", func() {
+			errChan := callInfo()
+
+			start := time.Now()
+			StopServer(server, 100*time.Millisecond)
+
+			Expect(time.Since(start)).To(BeNumerically("<", time.Minute))
+			Expect(<-errChan).To(HaveOccurred())
+		})
+	})
+
+	Context("NotifyShutdown", func() {
+		It("should hand the call over to the sidecar", func() {
+			done := make(chan struct{}, 1)
+			Expect(NotifyShutdown(context.Background(), done, time.Minute)).To(Succeed())
+			Expect(done).To(Receive())
+		})
+
+		It("should give up once the timeout expired", func() {
+			Expect(NotifyShutdown(context.Background(), make(chan struct{}), 10*time.Millisecond)).ToNot(Succeed())
+		})
+
+		It("should give up once the call is canceled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			Expect(NotifyShutdown(ctx, make(chan struct{}), time.Minute)).To(MatchError(context.Canceled))
+		})
+	})
+})
@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package identitydocument
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// KeySecretName is the secret in the KubeVirt namespace holding the key the identity documents
+	// are signed with
+	KeySecretName = "kubevirt-vm-identity-key"
+	// KeySecretKey is the key of the signing key in the key secret
+	KeySecretKey = "key"
+	// DocumentSecretKey is the key of the signed identity document in the secret of a VMI
+	DocumentSecretKey = "document"
+	// VolumeName is the name of the volume the secret of a VMI is mounted from in virt-launcher
+	VolumeName = "identity-document"
+	// FwCfgName is the fw_cfg entry the guest reads the identity document it booted with from
+	FwCfgName = "opt/io.kubevirt/identity-document"
+
+	// Validity is how long an identity document is valid, it is rotated halfway through
+	Validity = time.Hour
+
+	documentSecretPrefix = "kubevirt-identity-document-"
+)
+
+var (
+	ErrInvalidSignature = errors.New("the signature of the identity document is invalid")
+	ErrExpired          = errors.New("the identity document expired")
+)
+
+// SecretName returns the name of the secret in the namespace of the VMI holding its signed identity document
+func SecretName(vmi *v1.VirtualMachineInstance) string {
+	return documentSecretPrefix + string(vmi.UID)
+}
+
+// Sign encodes the identity and signs it with the HMAC-SHA256 of the key
+func Sign(key []byte, identity *v1.VirtualMachineInstanceIdentity) (*v1.SignedIdentityDocument, error) {
+	document, err := json.Marshal(identity)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SignedIdentityDocument{
+		Document:  base64.StdEncoding.EncodeToString(document),
+		Signature: base64.StdEncoding.EncodeToString(mac(key, document)),
+	}, nil
+}
+
+// Verify checks the signature and the expiry of a signed identity document, and returns the identity it attests
+func Verify(key []byte, signed *v1.SignedIdentityDocument, now time.Time) (*v1.VirtualMachineInstanceIdentity, error) {
+	document, err := base64.StdEncoding.DecodeString(signed.Document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the identity document: %v", err)
+	}
+	signature, err := base64.StdEncoding.DecodeString(signed.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the signature of the identity document: %v", err)
+	}
+	if !hmac.Equal(signature, mac(key, document)) {
+		return nil, ErrInvalidSignature
+	}
+
+	identity := &v1.VirtualMachineInstanceIdentity{}
+	if err := json.Unmarshal(document, identity); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the identity document: %v", err)
+	}
+	if !now.Before(identity.ExpiresAt.Time) {
+		return nil, ErrExpired
+	}
+	return identity, nil
+}
+
+func mac(key, document []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(document)
+	return h.Sum(nil)
+}
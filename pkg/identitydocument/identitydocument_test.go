@@ -0,0 +1,117 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package identitydocument_test
+
+import (
+	"encoding/base64"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/identitydocument"
+)
+
+var _ = Describe("Identity documents", func() {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	var identity *v1.VirtualMachineInstanceIdentity
+
+	BeforeEach(func() {
+		identity = &v1.VirtualMachineInstanceIdentity{
+			UID:             "1234",
+			Name:            "testvmi",
+			Namespace:       "default",
+			Labels:          map[string]string{"app": "db"},
+			NamespaceLabels: map[string]string{"project": "billing"},
+			IssuedAt:        metav1.NewTime(now),
+			ExpiresAt:       metav1.NewTime(now.Add(identitydocument.Validity)),
+		}
+	})
+
+	It("should verify the documents it signed", func() {
+		signed, err := identitydocument.Sign(key, identity)
+		Expect(err).ToNot(HaveOccurred())
+
+		verified, err := identitydocument.Verify(key, signed, now.Add(time.Minute))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(verified.UID).To(Equal(identity.UID))
+		Expect(verified.Labels).To(Equal(identity.Labels))
+		Expect(verified.NamespaceLabels).To(Equal(identity.NamespaceLabels))
+		Expect(verified.ExpiresAt.Time).To(BeTemporally("==", identity.ExpiresAt.Time))
+	})
+
+	It("should reject documents signed with another key", func() {
+		signed, err := identitydocument.Sign([]byte("another key"), identity)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = identitydocument.Verify(key, signed, now)
+		Expect(err).To(MatchError(identitydocument.ErrInvalidSignature))
+	})
+
+	It("should reject tampered documents", func() {
+		signed, err := identitydocument.Sign(key, identity)
+		Expect(err).ToNot(HaveOccurred())
+
+		identity.Namespace = "kube-system"
+		tampered, err := identitydocument.Sign([]byte("another key"), identity)
+		Expect(err).ToNot(HaveOccurred())
+		signed.Document = tampered.Document
+
+		_, err = identitydocument.Verify(key, signed, now)
+		Expect(err).To(MatchError(identitydocument.ErrInvalidSignature))
+	})
+
+	It("should reject expired documents", func() {
+		signed, err := identitydocument.Sign(key, identity)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = identitydocument.Verify(key, signed, now.Add(identitydocument.Validity))
+		Expect(err).To(MatchError(identitydocument.ErrExpired))
+	})
+
+	It("should reject documents which are not base64 encoded", func() {
+		signed, err := identitydocument.Sign(key, identity)
+		Expect(err).ToNot(HaveOccurred())
+		signed.Signature = "not base64!"
+
+		_, err = identitydocument.Verify(key, signed, now)
+		Expect(err).To(MatchError(ContainSubstring("failed to decode the signature")))
+	})
+
+	It("should encode the document as base64 encoded JSON", func() {
+		signed, err := identitydocument.Sign(key, identity)
+		Expect(err).ToNot(HaveOccurred())
+
+		document, err := base64.StdEncoding.DecodeString(signed.Document)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(document)).To(ContainSubstring(`"namespaceLabels":{"project":"billing"}`))
+	})
+
+	It("should name the secret of a VMI after its UID", func() {
+		vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{UID: "1234"}}
+		Expect(identitydocument.SecretName(vmi)).To(Equal("kubevirt-identity-document-1234"))
+	})
+})
@@ -106,3 +106,28 @@ func (h *handler) Preference(vm *virtv1.VirtualMachine) error {
 	}
 	return nil
 }
+
+// PreferenceFromBootVolume infers the preference of a VM which doesn't set one from the labels of
+// its boot volume, and returns the name of the volume. Unlike inferFromVolume, which the user asked
+// for, any failure to infer is ignored and leaves the VM without preference.
+func (h *handler) PreferenceFromBootVolume(vm *virtv1.VirtualMachine) string {
+	if vm.Spec.Preference != nil {
+		return ""
+	}
+	volumeName := bootVolumeName(&vm.Spec.Template.Spec)
+	if volumeName == "" {
+		return ""
+	}
+
+	defaultName, defaultKind, err := h.fromVolumes(vm, volumeName, api.DefaultPreferenceLabel, api.DefaultPreferenceKindLabel)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).V(logVerbosityLevel).Infof("Unable to infer the preference from boot volume %s", volumeName)
+		return ""
+	}
+
+	vm.Spec.Preference = &virtv1.PreferenceMatcher{
+		Name: defaultName,
+		Kind: defaultKind,
+	}
+	return volumeName
+}
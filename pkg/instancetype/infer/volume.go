@@ -158,3 +158,24 @@ func (h *handler) fromDataVolumeSourceRef(
 	}
 	return "", "", instancetypeErrors.NewIgnoreableInferenceError(fmt.Errorf(unsupportedDataVolumeSourceRefFmt, sourceRef.Kind))
 }
+
+// bootVolumeName returns the volume of the disk with the lowest boot order, or of the first disk
+// when none sets one, as libvirt boots from it. The disks are only defaulted from the volumes once
+// the VMI is created, the first volume is used when there are none yet.
+func bootVolumeName(vmiSpec *virtv1.VirtualMachineInstanceSpec) string {
+	disks := vmiSpec.Domain.Devices.Disks
+	if len(disks) == 0 {
+		if len(vmiSpec.Volumes) == 0 {
+			return ""
+		}
+		return vmiSpec.Volumes[0].Name
+	}
+
+	bootDisk := &disks[0]
+	for i := range disks {
+		if disks[i].BootOrder != nil && (bootDisk.BootOrder == nil || *disks[i].BootOrder < *bootDisk.BootOrder) {
+			bootDisk = &disks[i]
+		}
+	}
+	return bootDisk.Name
+}
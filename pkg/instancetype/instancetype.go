@@ -48,6 +48,7 @@ type Methods interface {
 	StoreControllerRevisions(vm *virtv1.VirtualMachine) error
 	InferDefaultInstancetype(vm *virtv1.VirtualMachine) error
 	InferDefaultPreference(vm *virtv1.VirtualMachine) error
+	InferPreferenceFromBootVolume(vm *virtv1.VirtualMachine) string
 	CheckPreferenceRequirements(instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) (Conflicts, error)
 	ApplyToVM(vm *virtv1.VirtualMachine) error
 	Expand(vm *virtv1.VirtualMachine, clusterConfig *virtconfig.ClusterConfig) (*virtv1.VirtualMachine, error)
@@ -145,6 +146,10 @@ func (m *InstancetypeMethods) InferDefaultPreference(vm *virtv1.VirtualMachine)
 	return infer.New(m.Clientset).Preference(vm)
 }
 
+func (m *InstancetypeMethods) InferPreferenceFromBootVolume(vm *virtv1.VirtualMachine) string {
+	return infer.New(m.Clientset).PreferenceFromBootVolume(vm)
+}
+
 func AddInstancetypeNameAnnotations(vm *virtv1.VirtualMachine, target metav1.Object) {
 	annotations.Set(vm, target)
 }
@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package maintenancenotifications
+
+import (
+	"path/filepath"
+	"strconv"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	SerialDeviceName = "org.kubevirt.maintenance.0"
+	ChannelDir       = util.VirtPrivateDir + "/maintenance-channel"
+	ChannelSocket    = ChannelDir + "/maintenance.sock"
+
+	DefaultLeadTimeSeconds = 30
+	MaxLeadTimeSeconds     = 600
+)
+
+type Event string
+
+const (
+	// EventHostMaintenance is sent once the VMI has to be evacuated from its node
+	EventHostMaintenance Event = "HostMaintenance"
+	// EventMigration is sent before a migration of the VMI starts, which waits for the guest to
+	// acknowledge it for up to the lead time
+	EventMigration Event = "Migration"
+	// EventMigrationCompleted is sent once the VMI runs on the target of a migration
+	EventMigrationCompleted Event = "MigrationCompleted"
+	// EventReady is sent by the guest to acknowledge a notification
+	EventReady Event = "Ready"
+)
+
+// Message is a line of JSON sent on the channel, by the host or the guest
+type Message struct {
+	Event           Event      `json:"event"`
+	ID              string     `json:"id"`
+	LeadTimeSeconds int32      `json:"leadTimeSeconds,omitempty"`
+	Deadline        *time.Time `json:"deadline,omitempty"`
+}
+
+func HasDevice(spec *v1.VirtualMachineInstanceSpec) bool {
+	return spec.Domain.Devices.MaintenanceNotifications != nil
+}
+
+// LeadTime returns how long the migrations of the VMI wait for the guest to acknowledge them
+func LeadTime(spec *v1.VirtualMachineInstanceSpec) time.Duration {
+	leadTimeSeconds := int32(DefaultLeadTimeSeconds)
+	if notifications := spec.Domain.Devices.MaintenanceNotifications; notifications != nil && notifications.LeadTimeSeconds != nil {
+		leadTimeSeconds = *notifications.LeadTimeSeconds
+	}
+	return time.Duration(leadTimeSeconds) * time.Second
+}
+
+func ChannelSocketPathOnHost(pid int) string {
+	return filepath.Join("/proc", strconv.Itoa(pid), "root", ChannelSocket)
+}
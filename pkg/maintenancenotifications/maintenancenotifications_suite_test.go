@@ -0,0 +1,11 @@
+package maintenancenotifications_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestMaintenanceNotifications(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
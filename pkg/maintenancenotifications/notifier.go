@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package maintenancenotifications
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// the notifications are small, a write only blocks when QEMU stops reading the socket
+const writeTimeout = time.Second
+
+// Notifier sends the notifications to the guests of the VMIs of the node. QEMU listens on the
+// socket of the channel, the notifier connects to it on the first notification of a VMI and keeps
+// the connection until the domain goes away.
+type Notifier struct {
+	lock           sync.Mutex
+	channels       map[types.UID]*channel
+	onAcknowledged func(vmi *v1.VirtualMachineInstance)
+}
+
+type channel struct {
+	lock         sync.Mutex
+	conn         net.Conn
+	sent         map[string]time.Time
+	acknowledged map[string]bool
+}
+
+// NewNotifier returns a notifier calling onAcknowledged when the guest of a VMI acknowledges a
+// notification
+func NewNotifier(onAcknowledged func(vmi *v1.VirtualMachineInstance)) *Notifier {
+	return &Notifier{
+		channels:       make(map[types.UID]*channel),
+		onAcknowledged: onAcknowledged,
+	}
+}
+
+// Notify sends the message to the guest of the VMI, unless a message with the same ID was already
+// sent, and returns when it was first sent and whether the guest acknowledged it.
+func (n *Notifier) Notify(vmi *v1.VirtualMachineInstance, socketPath string, message Message) (time.Time, bool, error) {
+	ch, err := n.channel(vmi, socketPath)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	ch.lock.Lock()
+	defer ch.lock.Unlock()
+
+	if sentAt, sent := ch.sent[message.ID]; sent {
+		return sentAt, ch.acknowledged[message.ID], nil
+	}
+
+	line, err := json.Marshal(message)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if err := ch.conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return time.Time{}, false, err
+	}
+	if _, err := ch.conn.Write(append(line, '\n')); err != nil {
+		n.forget(vmi.UID, ch)
+		return time.Time{}, false, fmt.Errorf("failed to send the %s notification: %v", message.Event, err)
+	}
+
+	sentAt := time.Now()
+	ch.sent[message.ID] = sentAt
+	return sentAt, false, nil
+}
+
+// Forget closes the channel of the VMI
+func (n *Notifier) Forget(vmi *v1.VirtualMachineInstance) {
+	n.forget(vmi.UID, nil)
+}
+
+func (n *Notifier) channel(vmi *v1.VirtualMachineInstance, socketPath string) (*channel, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if ch, exists := n.channels[vmi.UID]; exists {
+		return ch, nil
+	}
+
+	conn, err := net.Dial("unix", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the maintenance channel: %v", err)
+	}
+	ch := &channel{
+		conn:         conn,
+		sent:         make(map[string]time.Time),
+		acknowledged: make(map[string]bool),
+	}
+	n.channels[vmi.UID] = ch
+
+	owner := &v1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: vmi.Name, Namespace: vmi.Namespace, UID: vmi.UID},
+	}
+	go n.readAcknowledgements(owner, ch)
+
+	return ch, nil
+}
+
+// readAcknowledgements reads the messages of the guest until QEMU or the notifier closes the
+// connection
+func (n *Notifier) readAcknowledgements(vmi *v1.VirtualMachineInstance, ch *channel) {
+	defer n.forget(vmi.UID, ch)
+
+	scanner := bufio.NewScanner(ch.conn)
+	for scanner.Scan() {
+		var message Message
+		if err := json.Unmarshal(scanner.Bytes(), &message); err != nil || message.Event != EventReady {
+			log.Log.Object(vmi).V(4).Infof("Ignoring the invalid message %q of the guest", scanner.Text())
+			continue
+		}
+
+		ch.lock.Lock()
+		_, sent := ch.sent[message.ID]
+		if sent {
+			ch.acknowledged[message.ID] = true
+		}
+		ch.lock.Unlock()
+
+		if sent && n.onAcknowledged != nil {
+			log.Log.Object(vmi).V(3).Infof("The guest acknowledged the notification %s", message.ID)
+			n.onAcknowledged(vmi)
+		}
+	}
+}
+
+// forget closes the channel of the VMI, if it is still ch when set
+func (n *Notifier) forget(uid types.UID, ch *channel) {
+	n.lock.Lock()
+	current, exists := n.channels[uid]
+	if !exists || (ch != nil && current != ch) {
+		n.lock.Unlock()
+		if ch != nil {
+			ch.conn.Close()
+		}
+		return
+	}
+	delete(n.channels, uid)
+	n.lock.Unlock()
+
+	current.conn.Close()
+}
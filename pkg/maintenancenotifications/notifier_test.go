@@ -0,0 +1,142 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package maintenancenotifications_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/maintenancenotifications"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Notifier", func() {
+	var (
+		socketPath   string
+		listener     net.Listener
+		acknowledged chan string
+		notifier     *maintenancenotifications.Notifier
+		vmi          *v1.VirtualMachineInstance
+	)
+
+	// accept plays QEMU, which listens on the socket of the channel
+	accept := func() (net.Conn, *bufio.Reader) {
+		conn, err := listener.Accept()
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(conn.Close)
+		return conn, bufio.NewReader(conn)
+	}
+
+	readMessage := func(reader *bufio.Reader) maintenancenotifications.Message {
+		line, err := reader.ReadBytes('\n')
+		Expect(err).ToNot(HaveOccurred())
+		var message maintenancenotifications.Message
+		Expect(json.Unmarshal(line, &message)).To(Succeed())
+		return message
+	}
+
+	BeforeEach(func() {
+		var err error
+		socketPath = filepath.Join(GinkgoT().TempDir(), "maintenance.sock")
+		listener, err = net.Listen("unix", socketPath)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(listener.Close)
+
+		acknowledged = make(chan string, 1)
+		notifier = maintenancenotifications.NewNotifier(func(vmi *v1.VirtualMachineInstance) {
+			acknowledged <- vmi.Name
+		})
+		vmi = &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default", UID: "1234"}}
+		DeferCleanup(notifier.Forget, vmi)
+	})
+
+	It("should send a notification once and report its acknowledgement", func() {
+		message := maintenancenotifications.Message{Event: maintenancenotifications.EventMigration, ID: "migration-1", LeadTimeSeconds: 30}
+		firstSentAt, ready, err := notifier.Notify(vmi, socketPath, message)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ready).To(BeFalse())
+
+		conn, reader := accept()
+		Expect(readMessage(reader)).To(Equal(message))
+
+		sentAt, ready, err := notifier.Notify(vmi, socketPath, message)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sentAt).To(Equal(firstSentAt))
+		Expect(ready).To(BeFalse())
+
+		_, err = conn.Write([]byte("not json\n{\"event\":\"Ready\",\"id\":\"migration-1\"}\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(acknowledged).Should(Receive(Equal("testvmi")))
+
+		_, ready, err = notifier.Notify(vmi, socketPath, message)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ready).To(BeTrue())
+	})
+
+	It("should ignore the acknowledgements of unknown notifications", func() {
+		_, _, err := notifier.Notify(vmi, socketPath, maintenancenotifications.Message{Event: maintenancenotifications.EventHostMaintenance, ID: "host-maintenance"})
+		Expect(err).ToNot(HaveOccurred())
+
+		conn, reader := accept()
+		readMessage(reader)
+		_, err = conn.Write([]byte("{\"event\":\"Ready\",\"id\":\"other\"}\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Consistently(acknowledged, 200*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("should reconnect once QEMU closed the channel", func() {
+		_, _, err := notifier.Notify(vmi, socketPath, maintenancenotifications.Message{Event: maintenancenotifications.EventMigration, ID: "migration-1"})
+		Expect(err).ToNot(HaveOccurred())
+		conn, err := listener.Accept()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conn.Close()).To(Succeed())
+
+		Eventually(func() error {
+			_, _, err := notifier.Notify(vmi, socketPath, maintenancenotifications.Message{Event: maintenancenotifications.EventMigration, ID: "migration-2"})
+			return err
+		}).Should(Succeed())
+		_, reader := accept()
+		Expect(readMessage(reader).ID).To(Equal("migration-2"))
+	})
+
+	It("should fail when the channel does not exist", func() {
+		_, _, err := notifier.Notify(vmi, filepath.Join(GinkgoT().TempDir(), "missing.sock"), maintenancenotifications.Message{Event: maintenancenotifications.EventMigration, ID: "migration-1"})
+		Expect(err).To(MatchError(ContainSubstring("failed to connect to the maintenance channel")))
+	})
+
+	DescribeTable("should compute the lead time", func(notifications *v1.MaintenanceNotifications, expected time.Duration) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.MaintenanceNotifications = notifications
+		Expect(maintenancenotifications.LeadTime(spec)).To(Equal(expected))
+	},
+		Entry("defaulting to 30 seconds", &v1.MaintenanceNotifications{}, 30*time.Second),
+		Entry("from the spec", &v1.MaintenanceNotifications{LeadTimeSeconds: pointer.P(int32(120))}, 120*time.Second),
+	)
+})
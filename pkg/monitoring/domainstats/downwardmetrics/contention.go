@@ -0,0 +1,148 @@
+package downwardmetrics
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	runc_cgroups "github.com/opencontainers/runc/libcontainer/cgroups"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd/api"
+	metricspkg "kubevirt.io/kubevirt/pkg/downwardmetrics/vhostmd/metrics"
+	cgroupconsts "kubevirt.io/kubevirt/pkg/virt-handler/cgroup/constants"
+)
+
+// contentionMetrics tell cooperative guests how much CPU they are entitled to and how contended
+// the host is, read from the cgroup v2 of the compute container
+type contentionMetrics struct {
+	cgroupPath string
+}
+
+func newContentionMetrics(launcherPid int) (*contentionMetrics, error) {
+	if !runc_cgroups.IsCgroup2UnifiedMode() {
+		return nil, fmt.Errorf("contention metrics require cgroup v2")
+	}
+	controllerPaths, err := runc_cgroups.ParseCgroupFile(filepath.Join(cgroupconsts.ProcMountPoint, strconv.Itoa(launcherPid), cgroupconsts.CgroupStr))
+	if err != nil {
+		return nil, err
+	}
+	return &contentionMetrics{cgroupPath: filepath.Join(cgroupconsts.CgroupBasePath, controllerPaths[""])}, nil
+}
+
+func (c *contentionMetrics) Collect() (metrics []api.Metric) {
+	if weight, err := c.readUint("cpu.weight"); err == nil {
+		metrics = append(metrics, metricspkg.MustToVMMetric(cpuWeightToCPUs(weight), "CPUEntitlement", ""))
+	} else {
+		log.Log.Reason(err).V(4).Info("failed to read the cpu weight of the compute container")
+	}
+
+	if quota, err := c.readCPUQuota(); err == nil {
+		if quota > 0 {
+			metrics = append(metrics, metricspkg.MustToVMMetric(quota, "CPUQuota", ""))
+		}
+	} else {
+		log.Log.Reason(err).V(4).Info("failed to read the cpu quota of the compute container")
+	}
+
+	if stat, err := c.readKeyedValues("cpu.stat"); err == nil {
+		metrics = append(metrics, metricspkg.MustToVMMetric(float64(stat["throttled_usec"])/1000000, "CPUThrottledTime", "s"))
+	} else {
+		log.Log.Reason(err).V(4).Info("failed to read the cpu stat of the compute container")
+	}
+
+	for _, resource := range []struct{ file, name string }{
+		{"cpu.pressure", "CPUPressure"},
+		{"memory.pressure", "MemoryPressure"},
+	} {
+		if pressure, err := c.readPressure(resource.file); err == nil {
+			metrics = append(metrics, metricspkg.MustToVMMetric(pressure, resource.name, "%"))
+		} else {
+			log.Log.Reason(err).V(4).Infof("failed to read the %s of the compute container", resource.file)
+		}
+	}
+	return metrics
+}
+
+// cpuWeightToCPUs reverts the conversion of the CPU request of the container to its cgroup v2 weight by the kubelet
+func cpuWeightToCPUs(weight uint64) float64 {
+	shares := 2 + (float64(weight)-1)*262142/9999
+	return shares / 1024
+}
+
+// readCPUQuota returns the CPUs of cpu.max, 0 when it is not limited
+func (c *contentionMetrics) readCPUQuota() (float64, error) {
+	content, err := os.ReadFile(filepath.Join(c.cgroupPath, "cpu.max"))
+	if err != nil {
+		return 0, err
+	}
+	fields := strings.Fields(string(content))
+	if len(fields) != 2 {
+		return 0, fmt.Errorf("unexpected cpu.max content %q", content)
+	}
+	if fields[0] == "max" {
+		return 0, nil
+	}
+	quota, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, err
+	}
+	period, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return 0, err
+	}
+	return quota / period, nil
+}
+
+// readPressure returns the share of the last ten seconds some tasks of the cgroup were stalled on the resource
+func (c *contentionMetrics) readPressure(file string) (float64, error) {
+	content, err := os.ReadFile(filepath.Join(c.cgroupPath, file))
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != "some" {
+			continue
+		}
+		for _, field := range fields[1:] {
+			if value, found := strings.CutPrefix(field, "avg10="); found {
+				return strconv.ParseFloat(value, 64)
+			}
+		}
+	}
+	return 0, fmt.Errorf("no pressure average in %s", file)
+}
+
+func (c *contentionMetrics) readUint(file string) (uint64, error) {
+	content, err := os.ReadFile(filepath.Join(c.cgroupPath, file))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+}
+
+func (c *contentionMetrics) readKeyedValues(file string) (map[string]uint64, error) {
+	f, err := os.Open(filepath.Join(c.cgroupPath, file))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := map[string]uint64{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		if value, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[fields[0]] = value
+		}
+	}
+	return values, scanner.Err()
+}
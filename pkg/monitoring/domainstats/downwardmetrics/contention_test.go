@@ -0,0 +1,51 @@
+package downwardmetrics
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Contention metrics", func() {
+	var contention *contentionMetrics
+
+	writeCgroupFile := func(file, content string) {
+		ExpectWithOffset(1, os.WriteFile(filepath.Join(contention.cgroupPath, file), []byte(content), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		contention = &contentionMetrics{cgroupPath: GinkgoT().TempDir()}
+		writeCgroupFile("cpu.weight", "79\n")
+		writeCgroupFile("cpu.max", "150000 100000\n")
+		writeCgroupFile("cpu.stat", "usage_usec 8000000\nnr_throttled 12\nthrottled_usec 2500000\n")
+		writeCgroupFile("cpu.pressure", "some avg10=12.50 avg60=3.00 avg300=1.00 total=123\nfull avg10=2.00 avg60=0.00 avg300=0.00 total=12\n")
+		writeCgroupFile("memory.pressure", "some avg10=0.25 avg60=0.00 avg300=0.00 total=0\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=0\n")
+	})
+
+	metric := func(name, value, unit string) gstruct.Fields {
+		return gstruct.Fields{"Name": Equal(name), "Value": Equal(value), "Unit": Equal(unit)}
+	}
+
+	It("should report the entitlement and the pressure of the compute container", func() {
+		Expect(contention.Collect()).To(ConsistOf(
+			gstruct.MatchFields(gstruct.IgnoreExtras, metric("CPUEntitlement", "1.998938", "")),
+			gstruct.MatchFields(gstruct.IgnoreExtras, metric("CPUQuota", "1.500000", "")),
+			gstruct.MatchFields(gstruct.IgnoreExtras, metric("CPUThrottledTime", "2.500000", "s")),
+			gstruct.MatchFields(gstruct.IgnoreExtras, metric("CPUPressure", "12.500000", "%")),
+			gstruct.MatchFields(gstruct.IgnoreExtras, metric("MemoryPressure", "0.250000", "%")),
+		))
+	})
+
+	It("should not report the quota of an unlimited container", func() {
+		writeCgroupFile("cpu.max", "max 100000\n")
+		Expect(contention.Collect()).ToNot(ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{"Name": Equal("CPUQuota")})))
+	})
+
+	It("should skip the metrics it fails to read", func() {
+		Expect(os.Remove(filepath.Join(contention.cgroupPath, "memory.pressure"))).To(Succeed())
+		Expect(contention.Collect()).To(HaveLen(4))
+	})
+})
@@ -42,15 +42,15 @@ func (s *Scraper) Scrape(socketFile string, vmi *k6sv1.VirtualMachineInstance) {
 		return
 	}
 
-	metrics, err := s.reporter.Report(socketFile)
+	res, err := s.isolation.Detect(vmi)
 	if err != nil {
-		log.Log.Reason(err).Infof("failed to collect the metrics")
+		log.Log.Reason(err).Infof("failed to detect root directory of the vmi pod")
 		return
 	}
 
-	res, err := s.isolation.Detect(vmi)
+	metrics, err := s.reporter.Report(socketFile, res.Pid())
 	if err != nil {
-		log.Log.Reason(err).Infof("failed to detect root directory of the vmi pod")
+		log.Log.Reason(err).Infof("failed to collect the metrics")
 		return
 	}
 
@@ -67,7 +67,8 @@ type DownwardMetricsReporter struct {
 	hostMetricsCollector *hostMetricsCollector
 }
 
-func (r *DownwardMetricsReporter) Report(socketFile string) (*api.Metrics, error) {
+// Report collects the metrics of the VMI served by the virt-launcher listening on socketFile, whose process is launcherPid
+func (r *DownwardMetricsReporter) Report(socketFile string, launcherPid int) (*api.Metrics, error) {
 	ts := time.Now()
 	cli, err := cmdclient.NewClient(socketFile)
 	if err != nil {
@@ -119,6 +120,12 @@ func (r *DownwardMetricsReporter) Report(socketFile string) (*api.Metrics, error
 	metrics.Metrics = append(metrics.Metrics, guestMemoryMetrics(vmStats)...)
 	metrics.Metrics = append(metrics.Metrics, r.hostMetricsCollector.Collect()...)
 
+	if contention, err := newContentionMetrics(launcherPid); err == nil {
+		metrics.Metrics = append(metrics.Metrics, contention.Collect()...)
+	} else {
+		log.Log.Reason(err).V(4).Info("failed to collect the contention metrics")
+	}
+
 	return metrics, nil
 }
 
@@ -0,0 +1,83 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package hook_sidecar
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+)
+
+var (
+	callMetrics = []operatormetrics.Metric{
+		calls,
+		callErrors,
+		callDuration,
+	}
+
+	hookLabels = []string{"hook"}
+
+	calls = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hook_sidecar_calls_total",
+			Help: "The number of calls of the hook sidecar by virt-launcher, broken down by hook, e.g. OnDefineDomain.",
+		},
+		hookLabels,
+	)
+
+	callErrors = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hook_sidecar_call_errors_total",
+			Help: "The number of calls of the hook sidecar by virt-launcher which failed, broken down by hook.",
+		},
+		hookLabels,
+	)
+
+	callDuration = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hook_sidecar_call_duration_seconds",
+			Help: "Histogram of the duration of the calls of the hook sidecar by virt-launcher, broken down by hook, in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60},
+		},
+		hookLabels,
+	)
+)
+
+// UnaryServerInterceptor records the calls of the hooks served by the gRPC server of the sidecar,
+// named after their method, e.g. OnDefineDomain
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		hook := path.Base(info.FullMethod)
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		calls.WithLabelValues(hook).Inc()
+		callDuration.WithLabelValues(hook).Observe(time.Since(start).Seconds())
+		if err != nil {
+			callErrors.WithLabelValues(hook).Inc()
+		}
+		return resp, err
+	}
+}
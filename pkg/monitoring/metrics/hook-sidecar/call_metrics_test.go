@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package hook_sidecar
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/prometheus/client_golang/prometheus"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("Hook sidecar call metrics", func() {
+	const method = "/kubevirt.hooks.v1alpha3.Callbacks/OnDefineDomain"
+
+	counterValue := func(counter *operatormetrics.CounterVec) float64 {
+		metric := &ioprometheusclient.Metric{}
+		Expect(counter.WithLabelValues("OnDefineDomain").Write(metric)).To(Succeed())
+		return metric.GetCounter().GetValue()
+	}
+
+	sampleCount := func() uint64 {
+		histogram, err := callDuration.GetMetricWithLabelValues("OnDefineDomain")
+		Expect(err).ToNot(HaveOccurred())
+		metric := &ioprometheusclient.Metric{}
+		Expect(histogram.(prometheus.Metric).Write(metric)).To(Succeed())
+		return metric.GetHistogram().GetSampleCount()
+	}
+
+	intercept := func(handlerErr error) error {
+		_, err := UnaryServerInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, handlerErr
+			})
+		return err
+	}
+
+	It("should record the calls of a hook and their duration", func() {
+		initialCalls, initialErrors, initialSamples := counterValue(calls), counterValue(callErrors), sampleCount()
+
+		Expect(intercept(nil)).To(Succeed())
+
+		Expect(counterValue(calls)).To(Equal(initialCalls + 1))
+		Expect(counterValue(callErrors)).To(Equal(initialErrors))
+		Expect(sampleCount()).To(Equal(initialSamples + 1))
+	})
+
+	It("should record the calls of a hook which failed", func() {
+		initialCalls, initialErrors := counterValue(calls), counterValue(callErrors)
+
+		Expect(intercept(errors.New("failed"))).To(MatchError("failed"))
+
+		Expect(counterValue(calls)).To(Equal(initialCalls + 1))
+		Expect(counterValue(callErrors)).To(Equal(initialErrors + 1))
+	})
+})
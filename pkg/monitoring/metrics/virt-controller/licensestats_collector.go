@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"strconv"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+var (
+	licenseStatsCollector = operatormetrics.Collector{
+		Metrics: []operatormetrics.Metric{
+			vmiLicenseHostCores,
+		},
+		CollectCallback: licenseStatsCollectorCallback,
+	}
+
+	vmiLicenseHostCores = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_license_host_cores",
+			Help: "The number of physical cores of the node a VirtualMachineInstance of a license group runs on. " +
+				"Falls back to the CPU capacity of the node if its physical cores are unknown.",
+		},
+		[]string{"node", "namespace", "name", "license_group"},
+	)
+)
+
+func licenseStatsCollectorCallback() []operatormetrics.CollectorResult {
+	if clusterConfig == nil || !clusterConfig.LicenseTrackingEnabled() {
+		return []operatormetrics.CollectorResult{}
+	}
+
+	cachedObjs := vmiInformer.GetIndexer().List()
+	vmis := make([]*k6tv1.VirtualMachineInstance, len(cachedObjs))
+	for i, obj := range cachedObjs {
+		vmis[i] = obj.(*k6tv1.VirtualMachineInstance)
+	}
+
+	return reportLicenseStats(vmis, getNode)
+}
+
+func reportLicenseStats(vmis []*k6tv1.VirtualMachineInstance, nodeByName func(string) *k8sv1.Node) []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+
+	for _, vmi := range vmis {
+		group := vmi.Labels[k6tv1.LicenseGroupLabel]
+		if group == "" || vmi.Status.NodeName == "" || vmi.IsFinal() {
+			continue
+		}
+
+		node := nodeByName(vmi.Status.NodeName)
+		if node == nil {
+			continue
+		}
+
+		crs = append(crs, operatormetrics.CollectorResult{
+			Metric: vmiLicenseHostCores,
+			Labels: []string{node.Name, vmi.Namespace, vmi.Name, group},
+			Value:  float64(getNodePhysicalCores(node)),
+		})
+	}
+
+	return crs
+}
+
+func getNode(name string) *k8sv1.Node {
+	obj, exists, err := nodeInformer.GetStore().GetByKey(name)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to get node %s", name)
+		return nil
+	}
+	if !exists {
+		return nil
+	}
+	return obj.(*k8sv1.Node)
+}
+
+func getNodePhysicalCores(node *k8sv1.Node) int64 {
+	if cores, err := strconv.ParseInt(node.Labels[k6tv1.HostPhysicalCoresLabel], 10, 64); err == nil {
+		return cores
+	}
+	return node.Status.Capacity.Cpu().Value()
+}
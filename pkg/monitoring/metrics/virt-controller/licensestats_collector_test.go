@@ -0,0 +1,87 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("License Stats Collector", func() {
+	newVMI := func(name, nodeName, licenseGroup string, phase k6tv1.VirtualMachineInstancePhase) *k6tv1.VirtualMachineInstance {
+		vmi := &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{}},
+			Status:     k6tv1.VirtualMachineInstanceStatus{NodeName: nodeName, Phase: phase},
+		}
+		if licenseGroup != "" {
+			vmi.Labels[k6tv1.LicenseGroupLabel] = licenseGroup
+		}
+		return vmi
+	}
+
+	nodes := map[string]*k8sv1.Node{
+		"node01": {
+			ObjectMeta: metav1.ObjectMeta{Name: "node01", Labels: map[string]string{k6tv1.HostPhysicalCoresLabel: "16"}},
+			Status:     k8sv1.NodeStatus{Capacity: k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("32")}},
+		},
+		"node02": {
+			ObjectMeta: metav1.ObjectMeta{Name: "node02"},
+			Status:     k8sv1.NodeStatus{Capacity: k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("8")}},
+		},
+	}
+	nodeByName := func(name string) *k8sv1.Node {
+		return nodes[name]
+	}
+
+	It("should report the physical cores of the node running a VMI of a license group", func() {
+		crs := reportLicenseStats([]*k6tv1.VirtualMachineInstance{
+			newVMI("sql", "node01", "sql-enterprise", k6tv1.Running),
+		}, nodeByName)
+
+		Expect(crs).To(HaveLen(1))
+		Expect(crs[0].Metric).To(Equal(vmiLicenseHostCores))
+		Expect(crs[0].Labels).To(Equal([]string{"node01", "default", "sql", "sql-enterprise"}))
+		Expect(crs[0].Value).To(Equal(16.0))
+	})
+
+	It("should fall back to the CPU capacity when the physical cores of the node are unknown", func() {
+		crs := reportLicenseStats([]*k6tv1.VirtualMachineInstance{
+			newVMI("windows", "node02", "windows-datacenter", k6tv1.Running),
+		}, nodeByName)
+
+		Expect(crs).To(HaveLen(1))
+		Expect(crs[0].Value).To(Equal(8.0))
+	})
+
+	DescribeTable("should not report", func(vmi *k6tv1.VirtualMachineInstance) {
+		Expect(reportLicenseStats([]*k6tv1.VirtualMachineInstance{vmi}, nodeByName)).To(BeEmpty())
+	},
+		Entry("a VMI without license group", newVMI("vmi", "node01", "", k6tv1.Running)),
+		Entry("a VMI which is not scheduled yet", newVMI("vmi", "", "sql-enterprise", k6tv1.Pending)),
+		Entry("a VMI in a final phase", newVMI("vmi", "node01", "sql-enterprise", k6tv1.Succeeded)),
+		Entry("a VMI running on an unknown node", newVMI("vmi", "node03", "sql-enterprise", k6tv1.Running)),
+	)
+})
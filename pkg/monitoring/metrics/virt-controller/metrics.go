@@ -50,6 +50,7 @@ var (
 	preferenceInformer            cache.SharedIndexInformer
 	vmiMigrationInformer          cache.SharedIndexInformer
 	kvPodInformer                 cache.SharedIndexInformer
+	nodeInformer                  cache.SharedIndexInformer
 	clusterConfig                 *virtconfig.ClusterConfig
 )
 
@@ -63,6 +64,7 @@ func SetupMetrics(
 	preference cache.SharedIndexInformer,
 	vmiMigration cache.SharedIndexInformer,
 	pod cache.SharedIndexInformer,
+	node cache.SharedIndexInformer,
 	virtClusterConfig *virtconfig.ClusterConfig,
 ) error {
 	vmInformer = vm
@@ -74,6 +76,7 @@ func SetupMetrics(
 	preferenceInformer = preference
 	vmiMigrationInformer = vmiMigration
 	kvPodInformer = pod
+	nodeInformer = node
 	clusterConfig = virtClusterConfig
 
 	if err := client.SetupMetrics(); err != nil {
@@ -89,6 +92,7 @@ func SetupMetrics(
 	}
 
 	return operatormetrics.RegisterCollector(
+		licenseStatsCollector,
 		migrationStatsCollector,
 		vmiStatsCollector,
 		vmStatsCollector,
@@ -134,6 +138,23 @@ func PhaseTransitionTimeBuckets() []float64 {
 	}
 }
 
+func MigrationDowntimeBuckets() []float64 {
+	return []float64{
+		(10 * time.Millisecond).Seconds(),
+		(25 * time.Millisecond).Seconds(),
+		(50 * time.Millisecond).Seconds(),
+		(100 * time.Millisecond).Seconds(),
+		(200 * time.Millisecond).Seconds(),
+		(300 * time.Millisecond).Seconds(),
+		(500 * time.Millisecond).Seconds(),
+		(1 * time.Second).Seconds(),
+		(2 * time.Second).Seconds(),
+		(5 * time.Second).Seconds(),
+		(10 * time.Second).Seconds(),
+		(30 * time.Second).Seconds(),
+	}
+}
+
 func getTransitionTimeSeconds(oldTime *metav1.Time, newTime *metav1.Time) (float64, error) {
 	if newTime == nil || oldTime == nil {
 		// no phase transition timestamp found
@@ -31,11 +31,13 @@ import (
 const (
 	migrationTransTimeErrFmt = "Error encountered during VMI migration transition time histogram calculation: %v"
 	migrationTransTimeFail   = "Failed to get a histogram for a VMI migration lifecycle transition times"
+	migrationDowntimeFail    = "Failed to get a histogram for a VMI migration downtime"
 )
 
 var (
 	migrationMetrics = []operatormetrics.Metric{
 		vmiMigrationPhaseTransitionTimeFromCreation,
+		vmiMigrationDowntime,
 	}
 
 	vmiMigrationPhaseTransitionTimeFromCreation = operatormetrics.NewHistogramVec(
@@ -51,12 +53,29 @@ var (
 			"phase",
 		},
 	)
+
+	vmiMigrationDowntime = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_downtime_seconds",
+			Help: "Histogram of the downtime of the guest at the switchover of the succeeded VM migrations, measured by QEMU, in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: MigrationDowntimeBuckets(),
+		},
+		[]string{
+			// namespace of the vmi migration
+			"namespace",
+			// mode of the vmi migration, pre or post copy
+			"mode",
+		},
+	)
 )
 
 func CreateVMIMigrationHandler(informer cache.SharedIndexInformer) error {
 	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		UpdateFunc: func(oldVMIMigration, newVMIMigration interface{}) {
 			updateVMIMigrationPhaseTransitionTimeFromCreationTime(oldVMIMigration.(*v1.VirtualMachineInstanceMigration), newVMIMigration.(*v1.VirtualMachineInstanceMigration))
+			updateVMIMigrationDowntime(oldVMIMigration.(*v1.VirtualMachineInstanceMigration), newVMIMigration.(*v1.VirtualMachineInstanceMigration))
 		},
 	})
 
@@ -84,6 +103,27 @@ func updateVMIMigrationPhaseTransitionTimeFromCreationTime(oldVMIMigration *v1.V
 	histogram.Observe(diffSeconds)
 }
 
+func updateVMIMigrationDowntime(oldVMIMigration *v1.VirtualMachineInstanceMigration, newVMIMigration *v1.VirtualMachineInstanceMigration) {
+	if oldVMIMigration == nil || oldVMIMigration.Status.Phase == newVMIMigration.Status.Phase ||
+		newVMIMigration.Status.Phase != v1.MigrationSucceeded {
+		return
+	}
+
+	migrationState := newVMIMigration.Status.MigrationState
+	if migrationState == nil || migrationState.ObservedDowntime == nil {
+		return
+	}
+
+	labels := []string{newVMIMigration.Namespace, string(migrationState.Mode)}
+	histogram, err := vmiMigrationDowntime.GetMetricWithLabelValues(labels...)
+	if err != nil {
+		log.Log.Reason(err).Error(migrationDowntimeFail)
+		return
+	}
+
+	histogram.Observe(migrationState.ObservedDowntime.Seconds())
+}
+
 func getVMIMigrationTransitionTimeSeconds(newVMIMigration *v1.VirtualMachineInstanceMigration) (float64, error) {
 	var oldTime *metav1.Time
 	var newTime *metav1.Time
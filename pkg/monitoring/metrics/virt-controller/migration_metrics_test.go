@@ -26,6 +26,8 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	ioprometheusclient "github.com/prometheus/client_model/go"
 
 	v1 "kubevirt.io/api/core/v1"
 )
@@ -55,6 +57,57 @@ var _ = Describe("VMI migration phase transition time histogram", func() {
 	})
 })
 
+var _ = Describe("VMI migration downtime histogram", func() {
+	sampleCount := func(namespace string, mode v1.MigrationMode) uint64 {
+		histogram, err := vmiMigrationDowntime.GetMetricWithLabelValues(namespace, string(mode))
+		Expect(err).ToNot(HaveOccurred())
+		metric := &ioprometheusclient.Metric{}
+		Expect(histogram.(prometheus.Metric).Write(metric)).To(Succeed())
+		return metric.GetHistogram().GetSampleCount()
+	}
+
+	newMigration := func(namespace string, phase v1.VirtualMachineInstanceMigrationPhase, downtime *metav1.Duration) *v1.VirtualMachineInstanceMigration {
+		return &v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "testvmimigration",
+			},
+			Status: v1.VirtualMachineInstanceMigrationStatus{
+				Phase: phase,
+				MigrationState: &v1.VirtualMachineInstanceMigrationState{
+					Mode:             v1.MigrationPreCopy,
+					ObservedDowntime: downtime,
+				},
+			},
+		}
+	}
+
+	It("should observe the downtime of a succeeded migration", func() {
+		migration := newMigration("downtime-succeeded", v1.MigrationSucceeded, &metav1.Duration{Duration: 87 * time.Millisecond})
+		oldMigration := migration.DeepCopy()
+		oldMigration.Status.Phase = v1.MigrationRunning
+
+		updateVMIMigrationDowntime(oldMigration, migration)
+		Expect(sampleCount("downtime-succeeded", v1.MigrationPreCopy)).To(Equal(uint64(1)))
+
+		By("not observing it again while the migration stays succeeded")
+		updateVMIMigrationDowntime(migration, migration)
+		Expect(sampleCount("downtime-succeeded", v1.MigrationPreCopy)).To(Equal(uint64(1)))
+	})
+
+	DescribeTable("should not observe", func(namespace string, phase v1.VirtualMachineInstanceMigrationPhase, downtime *metav1.Duration) {
+		migration := newMigration(namespace, phase, downtime)
+		oldMigration := migration.DeepCopy()
+		oldMigration.Status.Phase = v1.MigrationRunning
+
+		updateVMIMigrationDowntime(oldMigration, migration)
+		Expect(sampleCount(namespace, v1.MigrationPreCopy)).To(BeZero())
+	},
+		Entry("a failed migration", "downtime-failed", v1.MigrationFailed, &metav1.Duration{Duration: 87 * time.Millisecond}),
+		Entry("a succeeded migration without a measured downtime", "downtime-unset", v1.MigrationSucceeded, nil),
+	)
+})
+
 func createVMIMigrationSForPhaseTransitionTime(phase v1.VirtualMachineInstanceMigrationPhase, offset float64) *v1.VirtualMachineInstanceMigration {
 	now := metav1.NewTime(time.Now())
 	old := metav1.NewTime(now.Time.Add(-time.Duration(int64(offset)) * time.Millisecond))
@@ -43,7 +43,7 @@ var (
 	}
 
 	Collector = operatormetrics.Collector{
-		Metrics:         domainStatsMetrics(rms...),
+		Metrics:         append(domainStatsMetrics(rms...), nodeMemoryMetrics...),
 		CollectCallback: domainStatsCollectorCallback,
 	}
 
@@ -103,12 +103,14 @@ func execCollector(concCollector collector.Collector, vmis []*k6tv1.VirtualMachi
 	go concCollector.Collect(vmis, scraper, PrometheusCollectionTimeout)
 
 	var crs []operatormetrics.CollectorResult
+	nodeMemory := &nodeMemoryUsage{}
 
 	for vmiReport := range scraper.ch {
 		for _, rm := range rms {
 			crs = append(crs, rm.Collect(vmiReport)...)
 		}
+		nodeMemory.add(vmiReport)
 	}
 
-	return crs
+	return append(crs, nodeMemory.collect()...)
 }
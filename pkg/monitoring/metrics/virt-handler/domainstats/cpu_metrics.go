@@ -22,6 +22,8 @@ package domainstats
 import (
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
 	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 var (
@@ -45,6 +47,13 @@ var (
 			Help: "Total CPU time spent in system mode.",
 		},
 	)
+
+	cpuInfraUsageSeconds = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_cpu_infra_usage_seconds_total",
+			Help: "Total CPU time spent by the hypervisor threads, emulator threads and iothreads, excluding vcpu time.",
+		},
+	)
 )
 
 type cpuMetrics struct{}
@@ -54,6 +63,7 @@ func (cpuMetrics) Describe() []operatormetrics.Metric {
 		cpuUsageSeconds,
 		cpuUserUsageSeconds,
 		cpuSystemUsageSeconds,
+		cpuInfraUsageSeconds,
 	}
 }
 
@@ -82,5 +92,27 @@ func (cpuMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormet
 		crs = append(crs, vmiReport.newCollectorResult(cpuSystemUsageSeconds, nanosecondsToSeconds(cpu.System)))
 	}
 
+	if infraSeconds, ok := infraCPUSeconds(vmiReport.vmiStats.DomainStats); ok {
+		crs = append(crs, vmiReport.newCollectorResult(cpuInfraUsageSeconds, infraSeconds))
+	}
+
 	return crs
 }
+
+// infraCPUSeconds is the CPU time of the domain not spent by its vcpus, in the units of
+// kubevirt_vmi_cpu_usage_seconds_total and kubevirt_vmi_vcpu_seconds_total
+func infraCPUSeconds(domainStats *stats.DomainStats) (float64, bool) {
+	if !domainStats.Cpu.TimeSet || len(domainStats.Vcpu) == 0 {
+		return 0, false
+	}
+
+	vcpuSeconds := 0.0
+	for _, vcpu := range domainStats.Vcpu {
+		if !vcpu.TimeSet {
+			return 0, false
+		}
+		vcpuSeconds += microsecondsToSeconds(vcpu.Time)
+	}
+
+	return max(nanosecondsToSeconds(domainStats.Cpu.Time)-vcpuSeconds, 0), true
+}
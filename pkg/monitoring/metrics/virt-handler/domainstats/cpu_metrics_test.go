@@ -64,6 +64,31 @@ var _ = Describe("cpu metrics", func() {
 			Entry("kubevirt_vmi_cpu_system_usage_seconds_total", cpuSystemUsageSeconds, nanosecondsToSeconds(3)),
 		)
 
+		It("should collect the CPU time out of the vcpus", func() {
+			infraStats := &VirtualMachineInstanceStats{
+				DomainStats: &stats.DomainStats{
+					Cpu: &stats.DomainStatsCPU{TimeSet: true, Time: 5000000000},
+					Vcpu: []stats.DomainStatsVcpu{
+						{TimeSet: true, Time: 1000000},
+						{TimeSet: true, Time: 2000000},
+					},
+				},
+			}
+			crs := cpuMetrics{}.Collect(newVirtualMachineInstanceReport(vmi, infraStats))
+			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(cpuInfraUsageSeconds, 2)))
+		})
+
+		It("should not collect the CPU time out of the vcpus if a vcpu time is not set", func() {
+			infraStats := &VirtualMachineInstanceStats{
+				DomainStats: &stats.DomainStats{
+					Cpu:  &stats.DomainStatsCPU{TimeSet: true, Time: 5000000000},
+					Vcpu: []stats.DomainStatsVcpu{{TimeSet: true, Time: 1000000}, {}},
+				},
+			}
+			crs := cpuMetrics{}.Collect(newVirtualMachineInstanceReport(vmi, infraStats))
+			Expect(crs).To(ConsistOf(testing.GomegaContainsCollectorResultMatcher(cpuUsageSeconds, nanosecondsToSeconds(5000000000))))
+		})
+
 		It("result should be empty if stat not populated or set is false", func() {
 			vmiStats.DomainStats.Cpu = &stats.DomainStatsCPU{
 				TimeSet: false,
@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/prometheus/procfs"
+	"kubevirt.io/client-go/log"
+)
+
+var (
+	// This is a var so it can be changed by the unit tests
+	procPath = "/proc"
+
+	nodeMemoryMetrics = []operatormetrics.Metric{
+		nodeMemoryCommitted,
+		nodeMemoryBalloonReclaimed,
+		nodeMemoryPhysical,
+		nodeMemorySwapUsed,
+		nodeMemoryOvercommitRatio,
+		nodeMemoryPressureRatio,
+	}
+
+	nodeMemoryCommitted = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_memory_committed_bytes",
+			Help: "The sum of the memory allocated to the domains of the VMIs running on the node.",
+		},
+	)
+
+	nodeMemoryBalloonReclaimed = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_memory_balloon_reclaimed_bytes",
+			Help: "The sum of the memory reclaimed by the balloon drivers from the domains of the VMIs running on the node.",
+		},
+	)
+
+	nodeMemoryPhysical = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_memory_physical_bytes",
+			Help: "The physical memory of the node running VMIs, corresponds to 'MemTotal' in /proc/meminfo.",
+		},
+	)
+
+	nodeMemorySwapUsed = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_memory_swap_used_bytes",
+			Help: "The swap space in use on the node running VMIs.",
+		},
+	)
+
+	nodeMemoryOvercommitRatio = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_memory_overcommit_ratio",
+			Help: "The memory committed to the VMIs running on the node, minus the memory reclaimed by the balloon drivers, over the physical memory of the node. Above 1 when the memory is overcommitted.",
+		},
+	)
+
+	nodeMemoryPressureRatio = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_memory_pressure_ratio",
+			Help: "The memory in use on the node running VMIs, including the swap space in use, over its physical memory. The node is under memory pressure when it approaches 1.",
+		},
+	)
+)
+
+// nodeMemoryUsage sums the memory of the domains of the node, to reconcile it with the memory of the node
+type nodeMemoryUsage struct {
+	nodeName  string
+	committed uint64
+	reclaimed uint64
+	reported  bool
+}
+
+func (u *nodeMemoryUsage) add(vmiReport *VirtualMachineInstanceReport) {
+	if vmiReport.vmiStats.DomainStats == nil || vmiReport.vmiStats.DomainStats.Memory == nil {
+		return
+	}
+	mem := vmiReport.vmiStats.DomainStats.Memory
+	if !mem.TotalSet {
+		return
+	}
+
+	u.nodeName = vmiReport.vmi.Status.NodeName
+	u.reported = true
+	u.committed += mem.Total
+	if mem.ActualBalloonSet && mem.ActualBalloon < mem.Total {
+		u.reclaimed += mem.Total - mem.ActualBalloon
+	}
+}
+
+// collect reports the memory of the node, only once a domain reported its memory
+func (u *nodeMemoryUsage) collect() []operatormetrics.CollectorResult {
+	if !u.reported {
+		return nil
+	}
+
+	labels := map[string]string{"node": u.nodeName}
+	crs := []operatormetrics.CollectorResult{
+		{Metric: nodeMemoryCommitted, ConstLabels: labels, Value: kibibytesToBytes(u.committed)},
+		{Metric: nodeMemoryBalloonReclaimed, ConstLabels: labels, Value: kibibytesToBytes(u.reclaimed)},
+	}
+
+	fs, err := procfs.NewFS(procPath)
+	if err != nil {
+		log.Log.Reason(err).Info("failed to access /proc")
+		return crs
+	}
+	memInfo, err := fs.Meminfo()
+	if err != nil || memInfo.MemTotal == nil || memInfo.MemAvailable == nil || *memInfo.MemTotal == 0 {
+		log.Log.Reason(err).Info("failed to collect meminfo on the node")
+		return crs
+	}
+
+	total := *memInfo.MemTotal
+	var swapUsed uint64
+	if memInfo.SwapTotal != nil && memInfo.SwapFree != nil && *memInfo.SwapTotal > *memInfo.SwapFree {
+		swapUsed = *memInfo.SwapTotal - *memInfo.SwapFree
+	}
+	used := swapUsed
+	if total > *memInfo.MemAvailable {
+		used += total - *memInfo.MemAvailable
+	}
+
+	return append(crs,
+		operatormetrics.CollectorResult{Metric: nodeMemoryPhysical, ConstLabels: labels, Value: kibibytesToBytes(total)},
+		operatormetrics.CollectorResult{Metric: nodeMemorySwapUsed, ConstLabels: labels, Value: kibibytesToBytes(swapUsed)},
+		operatormetrics.CollectorResult{Metric: nodeMemoryOvercommitRatio, ConstLabels: labels, Value: (float64(u.committed) - float64(u.reclaimed)) / float64(total)},
+		operatormetrics.CollectorResult{Metric: nodeMemoryPressureRatio, ConstLabels: labels, Value: float64(used) / float64(total)},
+	)
+}
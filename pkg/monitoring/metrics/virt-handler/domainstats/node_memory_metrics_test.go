@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/testing"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("node memory metrics", func() {
+	newReport := func(name string, memory *stats.DomainStatsMemory) *VirtualMachineInstanceReport {
+		vmi := &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns-1"},
+			Status:     k6tv1.VirtualMachineInstanceStatus{NodeName: "node01"},
+		}
+		return newVirtualMachineInstanceReport(vmi, &VirtualMachineInstanceStats{
+			DomainStats: &stats.DomainStats{Memory: memory},
+		})
+	}
+
+	BeforeEach(func() {
+		procPath = GinkgoT().TempDir()
+		DeferCleanup(func() { procPath = "/proc" })
+		Expect(os.WriteFile(filepath.Join(procPath, "meminfo"), []byte(
+			"MemTotal:        8388608 kB\n"+
+				"MemAvailable:    2097152 kB\n"+
+				"SwapTotal:       4194304 kB\n"+
+				"SwapFree:        3145728 kB\n"), 0644)).To(Succeed())
+	})
+
+	Context("on collect", func() {
+		var usage *nodeMemoryUsage
+
+		BeforeEach(func() {
+			usage = &nodeMemoryUsage{}
+			usage.add(newReport("test-vmi-1", &stats.DomainStatsMemory{
+				TotalSet:         true,
+				Total:            6291456,
+				ActualBalloonSet: true,
+				ActualBalloon:    4194304,
+			}))
+			usage.add(newReport("test-vmi-2", &stats.DomainStatsMemory{
+				TotalSet: true,
+				Total:    4194304,
+			}))
+		})
+
+		DescribeTable("should collect metrics values", func(metric operatormetrics.Metric, expectedValue float64) {
+			crs := usage.collect()
+			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(metric, expectedValue)))
+		},
+			Entry("kubevirt_node_memory_committed_bytes", nodeMemoryCommitted, kibibytesToBytes(10485760)),
+			Entry("kubevirt_node_memory_balloon_reclaimed_bytes", nodeMemoryBalloonReclaimed, kibibytesToBytes(2097152)),
+			Entry("kubevirt_node_memory_physical_bytes", nodeMemoryPhysical, kibibytesToBytes(8388608)),
+			Entry("kubevirt_node_memory_swap_used_bytes", nodeMemorySwapUsed, kibibytesToBytes(1048576)),
+			Entry("kubevirt_node_memory_overcommit_ratio", nodeMemoryOvercommitRatio, 1.0),
+			Entry("kubevirt_node_memory_pressure_ratio", nodeMemoryPressureRatio, 0.875),
+		)
+
+		It("should label the metrics with the node", func() {
+			for _, cr := range usage.collect() {
+				Expect(cr.ConstLabels).To(Equal(map[string]string{"node": "node01"}))
+			}
+		})
+
+		It("should only report the domain memory when the node memory can't be read", func() {
+			procPath = GinkgoT().TempDir()
+			crs := usage.collect()
+			Expect(crs).To(HaveLen(2))
+			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(nodeMemoryCommitted, kibibytesToBytes(10485760))))
+		})
+	})
+
+	It("should be empty if no domain reported its memory", func() {
+		usage := &nodeMemoryUsage{}
+		usage.add(newReport("test-vmi-1", &stats.DomainStatsMemory{RSSSet: true, RSS: 1}))
+		usage.add(newReport("test-vmi-2", nil))
+		Expect(usage.collect()).To(BeEmpty())
+	})
+})
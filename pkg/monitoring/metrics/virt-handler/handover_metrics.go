@@ -0,0 +1,76 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"sync"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+)
+
+const (
+	HandoverItemMigrationListener  = "migration_listener"
+	HandoverItemDevicePlugin       = "device_plugin"
+	HandoverItemHotplugMountRecord = "hotplug_mount_record"
+	handoverOutcomeAdopted         = "adopted"
+	handoverOutcomeDropped         = "dropped"
+)
+
+var (
+	handoverMetrics = []operatormetrics.Metric{
+		handoverItems,
+		handoverCompleteness,
+	}
+
+	handoverItems = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_virt_handler_handover_items_total",
+			Help: "The number of items of the state handed over by the outgoing virt-handler, by whether the incoming virt-handler adopted or dropped them.",
+		},
+		[]string{"item", "outcome"},
+	)
+
+	handoverCompleteness = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_virt_handler_handover_completeness",
+			Help: "The ratio of the items handed over by the outgoing virt-handler which were adopted, 1 when the handover was complete.",
+		},
+	)
+
+	handoverLock    sync.Mutex
+	handoverAdopted int
+	handoverTotal   int
+)
+
+// RecordHandoverItems records the items of an item type the incoming virt-handler adopted and dropped
+func RecordHandoverItems(item string, adopted, dropped int) {
+	handoverItems.WithLabelValues(item, handoverOutcomeAdopted).Add(float64(adopted))
+	handoverItems.WithLabelValues(item, handoverOutcomeDropped).Add(float64(dropped))
+
+	handoverLock.Lock()
+	defer handoverLock.Unlock()
+	handoverAdopted += adopted
+	handoverTotal += adopted + dropped
+	if handoverTotal == 0 {
+		handoverCompleteness.Set(1)
+		return
+	}
+	handoverCompleteness.Set(float64(handoverAdopted) / float64(handoverTotal))
+}
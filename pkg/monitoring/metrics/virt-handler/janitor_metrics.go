@@ -0,0 +1,54 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ */
+
+package virt_handler
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+)
+
+const (
+	OrphanGhostRecord        = "ghost_record"
+	OrphanContainerDiskMount = "container_disk_mount"
+	OrphanHotplugVolumeMount = "hotplug_volume_mount"
+	orphanOutcomeReclaimed   = "reclaimed"
+	orphanOutcomeFailed      = "failed"
+)
+
+var (
+	janitorMetrics = []operatormetrics.Metric{
+		orphans,
+	}
+
+	orphans = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_virt_handler_orphans_total",
+			Help: "The number of resources left behind on the node for VMIs which are gone, found by virt-handler, by whether it reclaimed them or failed to.",
+		},
+		[]string{"resource", "outcome"},
+	)
+)
+
+// RecordOrphan records an orphaned resource of a resource type, and whether it was reclaimed
+func RecordOrphan(resource string, reclaimed bool) {
+	outcome := orphanOutcomeReclaimed
+	if !reclaimed {
+		outcome = orphanOutcomeFailed
+	}
+	orphans.WithLabelValues(resource, outcome).Inc()
+}
@@ -37,7 +37,7 @@ func SetupMetrics(virtShareDir, nodeName string, MaxRequestsInFlight int, vmiInf
 		return err
 	}
 
-	if err := operatormetrics.RegisterMetrics(versionMetrics); err != nil {
+	if err := operatormetrics.RegisterMetrics(versionMetrics, handoverMetrics, janitorMetrics); err != nil {
 		return err
 	}
 	SetVersionInfo()
@@ -43,14 +43,7 @@ const (
 )
 
 func Register(namespace string) error {
-	alerts := [][]promv1.Rule{
-		systemAlerts(namespace),
-		virtApiAlerts(namespace),
-		virtControllerAlerts(namespace),
-		virtHandlerAlerts(namespace),
-		virtOperatorAlerts(namespace),
-		vmsAlerts,
-	}
+	alerts := alertGroups(namespace)
 
 	runbookURLTemplate := getRunbookURLTemplate()
 	for _, alertGroup := range alerts {
@@ -65,6 +58,17 @@ func Register(namespace string) error {
 	return operatorrules.RegisterAlerts(alerts...)
 }
 
+func alertGroups(namespace string) [][]promv1.Rule {
+	return [][]promv1.Rule{
+		systemAlerts(namespace),
+		virtApiAlerts(namespace),
+		virtControllerAlerts(namespace),
+		virtHandlerAlerts(namespace),
+		virtOperatorAlerts(namespace),
+		vmsAlerts,
+	}
+}
+
 func getRunbookURLTemplate() string {
 	runbookURLTemplate, exists := os.LookupEnv(runbookURLTemplateEnv)
 	if !exists {
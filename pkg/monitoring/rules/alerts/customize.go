@@ -0,0 +1,129 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerts
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var (
+	comparisonRegex         = regexp.MustCompile(`(==|!=|>=|<=|>|<)(\s*)(-?[0-9]+(?:\.[0-9]+)?)`)
+	trailingComparisonRegex = regexp.MustCompile(`(?:==|!=|>=|<=|>|<)\s*(-?[0-9]+(?:\.[0-9]+)?)[\s)]*$`)
+)
+
+// Find returns the bundled alert with the given name
+func Find(name string) (*promv1.Rule, bool) {
+	for _, alertGroup := range alertGroups("") {
+		for i := range alertGroup {
+			if alertGroup[i].Alert == name {
+				alert := alertGroup[i].DeepCopy()
+				return alert, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Override applies an override of the KubeVirt CR to an alert.
+// The threshold replaces the value the expression of the alert is compared with, which
+// requires the expression to end with a comparison with a number.
+func Override(alert *promv1.Rule, override v1.AlertOverride) error {
+	if override.Threshold != nil {
+		expr, err := replaceThreshold(alert.Expr.String(), *override.Threshold)
+		if err != nil {
+			return fmt.Errorf("alert %s: %v", alert.Alert, err)
+		}
+		alert.Expr = intstr.FromString(expr)
+	}
+	if override.For != nil {
+		alert.For = ptr.To(promv1.Duration(*override.For))
+	}
+	if override.Severity != "" {
+		if alert.Labels == nil {
+			alert.Labels = map[string]string{}
+		}
+		alert.Labels[severityAlertLabelKey] = string(override.Severity)
+	}
+	return nil
+}
+
+// replaceThreshold replaces the number the expression ends comparing with, ignoring the closing
+// parentheses, in all the comparisons of the expression with that number
+func replaceThreshold(expr, threshold string) (string, error) {
+	if _, err := strconv.ParseFloat(threshold, 64); err != nil {
+		return "", fmt.Errorf("threshold %q is not a number", threshold)
+	}
+	match := trailingComparisonRegex.FindStringSubmatch(expr)
+	if match == nil {
+		return "", fmt.Errorf("the threshold of the alert can't be overridden")
+	}
+	current := match[1]
+
+	return comparisonRegex.ReplaceAllStringFunc(expr, func(comparison string) string {
+		parts := comparisonRegex.FindStringSubmatch(comparison)
+		if parts[3] != current {
+			return comparison
+		}
+		return parts[1] + parts[2] + strings.TrimSpace(threshold)
+	}), nil
+}
+
+// Customize removes the disabled alerts from the PrometheusRule and applies the overrides
+// of the KubeVirt CR to the others. Recording rules are kept as they are.
+func Customize(rule *promv1.PrometheusRule, config *v1.AlertsConfiguration) error {
+	if config == nil {
+		return nil
+	}
+
+	disabled := map[string]bool{}
+	for _, name := range config.Disabled {
+		disabled[name] = true
+	}
+	overrides := map[string]v1.AlertOverride{}
+	for _, override := range config.Overrides {
+		overrides[override.Alert] = override
+	}
+
+	for i := range rule.Spec.Groups {
+		group := &rule.Spec.Groups[i]
+		rules := make([]promv1.Rule, 0, len(group.Rules))
+		for _, r := range group.Rules {
+			if r.Alert != "" {
+				if disabled[r.Alert] {
+					continue
+				}
+				if override, exists := overrides[r.Alert]; exists {
+					if err := Override(&r, override); err != nil {
+						return err
+					}
+				}
+			}
+			rules = append(rules, r)
+		}
+		group.Rules = rules
+	}
+	return nil
+}
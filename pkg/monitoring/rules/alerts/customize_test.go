@@ -0,0 +1,122 @@
+package alerts_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/rules/alerts"
+)
+
+var _ = Describe("Alerts customization", func() {
+	newAlert := func(name, expr string) promv1.Rule {
+		return promv1.Rule{
+			Alert:  name,
+			Expr:   intstr.FromString(expr),
+			For:    ptr.To(promv1.Duration("5m")),
+			Labels: map[string]string{"severity": "warning"},
+		}
+	}
+
+	Context("Find", func() {
+		It("should find a bundled alert", func() {
+			alert, exists := alerts.Find("OrphanedVirtualMachineInstances")
+			Expect(exists).To(BeTrue())
+			Expect(alert.Alert).To(Equal("OrphanedVirtualMachineInstances"))
+		})
+
+		It("should not find an unknown alert", func() {
+			_, exists := alerts.Find("UnknownAlert")
+			Expect(exists).To(BeFalse())
+		})
+	})
+
+	Context("Override", func() {
+		It("should override the duration and the severity", func() {
+			alert := newAlert("VirtAPIDown", "kubevirt_virt_api_up == 0")
+			Expect(alerts.Override(&alert, v1.AlertOverride{
+				Alert:    "VirtAPIDown",
+				For:      ptr.To("15m"),
+				Severity: v1.AlertSeverityWarning,
+			})).To(Succeed())
+			Expect(*alert.For).To(Equal(promv1.Duration("15m")))
+			Expect(alert.Labels).To(HaveKeyWithValue("severity", "warning"))
+			Expect(alert.Expr.String()).To(Equal("kubevirt_virt_api_up == 0"))
+		})
+
+		DescribeTable("should override the threshold", func(expr, expected string) {
+			alert := newAlert("TestAlert", expr)
+			Expect(alerts.Override(&alert, v1.AlertOverride{Alert: "TestAlert", Threshold: ptr.To("0.1")})).To(Succeed())
+			Expect(alert.Expr.String()).To(Equal(expected))
+		},
+			Entry("of a single comparison", "errors >= 0.05", "errors >= 0.1"),
+			Entry("of all the comparisons with the threshold",
+				"free_ws < 52428800 or free_rss < 52428800", "free_ws < 0.1 or free_rss < 0.1"),
+			Entry("but not of other comparisons", "(nodes > 1) and (up < 2)", "(nodes > 1) and (up < 0.1)"),
+		)
+
+		It("should fail to override the threshold of an alert not ending with a comparison", func() {
+			alert := newAlert("TestAlert", "sum(rate(errors[5m]))")
+			Expect(alerts.Override(&alert, v1.AlertOverride{Alert: "TestAlert", Threshold: ptr.To("1")})).ToNot(Succeed())
+		})
+
+		It("should fail to override the threshold with a value which is not a number", func() {
+			alert := newAlert("TestAlert", "errors >= 0.05")
+			Expect(alerts.Override(&alert, v1.AlertOverride{Alert: "TestAlert", Threshold: ptr.To("high")})).ToNot(Succeed())
+		})
+	})
+
+	Context("Customize", func() {
+		var rule *promv1.PrometheusRule
+
+		BeforeEach(func() {
+			rule = &promv1.PrometheusRule{
+				Spec: promv1.PrometheusRuleSpec{
+					Groups: []promv1.RuleGroup{
+						{
+							Name: "alerts",
+							Rules: []promv1.Rule{
+								newAlert("VirtAPIDown", "kubevirt_virt_api_up == 0"),
+								newAlert("OrphanedVirtualMachineInstances", "orphaned == 0"),
+								{Record: "kubevirt_virt_api_up", Expr: intstr.FromString("sum(up)")},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should leave the rule untouched without configuration", func() {
+			expected := rule.DeepCopy()
+			Expect(alerts.Customize(rule, nil)).To(Succeed())
+			Expect(rule).To(Equal(expected))
+		})
+
+		It("should remove the disabled alerts and override the others", func() {
+			Expect(alerts.Customize(rule, &v1.AlertsConfiguration{
+				Disabled: []string{"OrphanedVirtualMachineInstances"},
+				Overrides: []v1.AlertOverride{
+					{Alert: "VirtAPIDown", For: ptr.To("1m"), Severity: v1.AlertSeverityInfo},
+				},
+			})).To(Succeed())
+
+			rules := rule.Spec.Groups[0].Rules
+			Expect(rules).To(HaveLen(2))
+			Expect(rules[0].Alert).To(Equal("VirtAPIDown"))
+			Expect(*rules[0].For).To(Equal(promv1.Duration("1m")))
+			Expect(rules[0].Labels).To(HaveKeyWithValue("severity", "info"))
+			Expect(rules[1].Record).To(Equal("kubevirt_virt_api_up"))
+		})
+
+		It("should fail with an override which can't be applied", func() {
+			Expect(alerts.Customize(rule, &v1.AlertsConfiguration{
+				Overrides: []v1.AlertOverride{{Alert: "VirtAPIDown", Threshold: ptr.To("high")}},
+			})).ToNot(Succeed())
+		})
+	})
+})
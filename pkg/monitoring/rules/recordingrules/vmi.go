@@ -39,4 +39,12 @@ var vmiRecordingRules = []operatorrules.RecordingRule{
 		MetricType: operatormetrics.GaugeType,
 		Expr:       intstr.FromString("kubevirt_vmi_memory_available_bytes-kubevirt_vmi_memory_usable_bytes"),
 	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "kubevirt_license_group_host_cores",
+			Help: "Sum of the physical cores of the nodes running VMIs of a license group.",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr:       intstr.FromString("sum by (license_group) (max by (license_group, node) (kubevirt_vmi_license_host_cores))"),
+	},
 }
@@ -95,5 +95,54 @@ func validateBridgeBinding(
 			Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
 		}}
 	}
-	return nil
+	return validateBridgeBindingOptions(fieldPath, idx, iface)
+}
+
+func validateBridgeBindingOptions(fieldPath *field.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	bridge := iface.InterfaceBindingMethod.Bridge
+	if bridge == nil {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	bridgeFieldPath := fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("bridge")
+	if bridge.MACLearning != nil && !*bridge.MACLearning && bridge.UnicastFlood != nil && !*bridge.UnicastFlood {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "unicastFlood cannot be disabled when macLearning is disabled, traffic to the guest would be dropped",
+			Field:   bridgeFieldPath.Child("unicastFlood").String(),
+		})
+	}
+	if bridge.Tap != nil {
+		causes = append(causes, validateTapOptions(bridgeFieldPath.Child("tap"), iface.Model, bridge.Tap)...)
+	}
+	return causes
+}
+
+func validateTapOptions(tapFieldPath *field.Path, model string, tap *v1.InterfaceTap) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if tap.TxQueueLength != nil && *tap.TxQueueLength <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("txQueueLength %d must be greater than 0", *tap.TxQueueLength),
+			Field:   tapFieldPath.Child("txQueueLength").String(),
+		})
+	}
+	if model == "" || model == v1.VirtIO {
+		return causes
+	}
+	if tap.DisableOffloads != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("disableOffloads is only supported with the %s interface model", v1.VirtIO),
+			Field:   tapFieldPath.Child("disableOffloads").String(),
+		})
+	}
+	if tap.Offload != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("offload is only supported with the %s interface model", v1.VirtIO),
+			Field:   tapFieldPath.Child("offload").String(),
+		})
+	}
+	return causes
 }
@@ -31,6 +31,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Validating network binding combinations", func() {
@@ -135,4 +136,107 @@ var _ = Describe("Validating core binding", func() {
 			Field:   "fake.domain.devices.interfaces[0].name",
 		}))
 	})
+
+	DescribeTable("should reject a bridge interface with", func(iface v1.Interface, expCause metav1.StatusCause) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		clusterConfig := stubClusterConfigChecker{bridgeBindingOnPodNetEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+
+		Expect(validator.Validate()).To(ConsistOf(expCause))
+	},
+		Entry("both MAC learning and unicast flooding disabled",
+			v1.Interface{
+				Name: "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{
+					Bridge: &v1.InterfaceBridge{MACLearning: pointer.P(false), UnicastFlood: pointer.P(false)},
+				},
+			},
+			metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "unicastFlood cannot be disabled when macLearning is disabled, traffic to the guest would be dropped",
+				Field:   "fake.domain.devices.interfaces[0].bridge.unicastFlood",
+			},
+		),
+		Entry("a tap transmit queue length of 0",
+			v1.Interface{
+				Name: "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{
+					Bridge: &v1.InterfaceBridge{Tap: &v1.InterfaceTap{TxQueueLength: pointer.P(int32(0))}},
+				},
+			},
+			metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "txQueueLength 0 must be greater than 0",
+				Field:   "fake.domain.devices.interfaces[0].bridge.tap.txQueueLength",
+			},
+		),
+		Entry("the offloads disabled on a non virtio model",
+			v1.Interface{
+				Name:  "default",
+				Model: "e1000",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{
+					Bridge: &v1.InterfaceBridge{Tap: &v1.InterfaceTap{DisableOffloads: pointer.P(true)}},
+				},
+			},
+			metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "disableOffloads is only supported with the virtio interface model",
+				Field:   "fake.domain.devices.interfaces[0].bridge.tap.disableOffloads",
+			},
+		),
+		Entry("tap offloads on a non virtio model",
+			v1.Interface{
+				Name:  "default",
+				Model: "e1000",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{
+					Bridge: &v1.InterfaceBridge{Tap: &v1.InterfaceTap{Offload: &v1.InterfaceTapOffload{TSO: pointer.P(false)}}},
+				},
+			},
+			metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "offload is only supported with the virtio interface model",
+				Field:   "fake.domain.devices.interfaces[0].bridge.tap.offload",
+			},
+		),
+	)
+
+	It("should accept a bridge interface with tap options", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name: "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{
+				Bridge: &v1.InterfaceBridge{Tap: &v1.InterfaceTap{
+					TxQueueLength:   pointer.P(int32(5000)),
+					DisableOffloads: pointer.P(false),
+					Offload:         &v1.InterfaceTapOffload{TSO: pointer.P(false), GSO: pointer.P(false), UFO: pointer.P(false)},
+				}},
+			},
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		clusterConfig := stubClusterConfigChecker{bridgeBindingOnPodNetEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should accept a bridge interface with MAC learning disabled", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:  "default",
+			Model: v1.VirtIO,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{
+				Bridge: &v1.InterfaceBridge{MACLearning: pointer.P(false)},
+			},
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		clusterConfig := stubClusterConfigChecker{bridgeBindingOnPodNetEnabled: true}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, clusterConfig)
+
+		Expect(validator.Validate()).To(BeEmpty())
+	})
 })
@@ -0,0 +1,223 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package announce
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// FrameWriter sends raw Ethernet frames out of a pod interface
+type FrameWriter interface {
+	Write(frame []byte) error
+	Close() error
+}
+
+// OpenFunc opens a FrameWriter on the pod interface the bridge of the given network is connected to
+type OpenFunc func(vmi *v1.VirtualMachineInstance, launcherPid int, network v1.Network) (FrameWriter, error)
+
+type announcementState struct {
+	migrationUID types.UID
+	done         bool
+	results      []v1.MigrationNetworkAnnouncement
+}
+
+type target struct {
+	index  int
+	writer FrameWriter
+	frames [][]byte
+}
+
+// Announcer sends the network announcements of the bridge bound interfaces of the VMIs
+// migrated to the node, so that the switches of their networks learn the new location of
+// the guests without waiting for them to send traffic.
+// The announcements are sent in their own goroutine, the results are reported once all
+// the rounds are sent.
+type Announcer struct {
+	lock   sync.Mutex
+	open   OpenFunc
+	clock  clock.Clock
+	onDone func(vmi *v1.VirtualMachineInstance)
+	vmis   map[types.UID]*announcementState
+}
+
+func New(open OpenFunc, clk clock.Clock, onDone func(vmi *v1.VirtualMachineInstance)) *Announcer {
+	return &Announcer{
+		open:   open,
+		clock:  clk,
+		onDone: onDone,
+		vmis:   map[types.UID]*announcementState{},
+	}
+}
+
+// Start sends the announcements of the current migration of the VMI, if its annotation requests them.
+// It does nothing when the announcements of the migration were already started.
+func (a *Announcer) Start(vmi *v1.VirtualMachineInstance, launcherPid int) error {
+	if vmi.Status.MigrationState == nil {
+		return nil
+	}
+	config, err := ConfigFromVMI(vmi)
+	if err != nil || config == nil {
+		return err
+	}
+
+	migrationUID := vmi.Status.MigrationState.MigrationUID
+	a.lock.Lock()
+	if state, exists := a.vmis[vmi.UID]; exists && state.migrationUID == migrationUID {
+		a.lock.Unlock()
+		return nil
+	}
+	state := &announcementState{migrationUID: migrationUID}
+	a.vmis[vmi.UID] = state
+	a.lock.Unlock()
+
+	var results []v1.MigrationNetworkAnnouncement
+	var targets []target
+	for _, iface := range bridgeInterfaces(vmi.Spec.Domain.Devices.Interfaces) {
+		network := vmispec.LookupNetworkByName(vmi.Spec.Networks, iface.Name)
+		if network == nil {
+			continue
+		}
+		results = append(results, v1.MigrationNetworkAnnouncement{Name: iface.Name})
+
+		mac, ips := guestAddresses(vmi, iface)
+		if mac == nil {
+			results[len(results)-1].Message = "the MAC address of the interface is unknown"
+			continue
+		}
+		writer, err := a.open(vmi, launcherPid, *network)
+		if err != nil {
+			results[len(results)-1].Message = err.Error()
+			continue
+		}
+		targets = append(targets, target{
+			index:  len(results) - 1,
+			writer: writer,
+			frames: Frames(mac, ips, config.VLANs[iface.Name]),
+		})
+	}
+
+	go a.announce(vmi.DeepCopy(), state, config, targets, results)
+	return nil
+}
+
+// Result returns the results of the announcements of the current migration of the VMI,
+// once they are all sent.
+func (a *Announcer) Result(vmi *v1.VirtualMachineInstance) ([]v1.MigrationNetworkAnnouncement, bool) {
+	if vmi.Status.MigrationState == nil {
+		return nil, false
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	state, exists := a.vmis[vmi.UID]
+	if !exists || !state.done || state.migrationUID != vmi.Status.MigrationState.MigrationUID {
+		return nil, false
+	}
+	return append([]v1.MigrationNetworkAnnouncement{}, state.results...), true
+}
+
+// Forget drops the state kept for the VMI, announcements still in flight are not reported
+func (a *Announcer) Forget(uid types.UID) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	delete(a.vmis, uid)
+}
+
+func (a *Announcer) announce(vmi *v1.VirtualMachineInstance, state *announcementState, config *Config,
+	targets []target, results []v1.MigrationNetworkAnnouncement) {
+	errs := make([]error, len(targets))
+	for round := uint32(0); round < config.Count; round++ {
+		if round > 0 {
+			a.clock.Sleep(config.Interval.Duration)
+		}
+		for i, t := range targets {
+			if errs[i] != nil {
+				continue
+			}
+			for _, frame := range t.frames {
+				if err := t.writer.Write(frame); err != nil {
+					errs[i] = err
+					break
+				}
+			}
+			if errs[i] == nil {
+				results[t.index].Rounds++
+			}
+		}
+	}
+
+	for i, t := range targets {
+		if errs[i] != nil {
+			results[t.index].Message = fmt.Sprintf("sent %d of %d rounds: %v", results[t.index].Rounds, config.Count, errs[i])
+		} else {
+			results[t.index].Succeeded = true
+		}
+		t.writer.Close()
+	}
+
+	a.lock.Lock()
+	if a.vmis[vmi.UID] != state {
+		a.lock.Unlock()
+		return
+	}
+	state.results = results
+	state.done = true
+	a.lock.Unlock()
+
+	if a.onDone != nil {
+		a.onDone(vmi)
+	}
+}
+
+func bridgeInterfaces(ifaces []v1.Interface) []v1.Interface {
+	return vmispec.FilterInterfacesSpec(ifaces, func(iface v1.Interface) bool {
+		return iface.Bridge != nil && iface.State != v1.InterfaceStateAbsent
+	})
+}
+
+// guestAddresses returns the MAC and IP addresses of the guest interface, as reported in the VMI status.
+// The MAC address of the spec is used when the status does not report it.
+func guestAddresses(vmi *v1.VirtualMachineInstance, iface v1.Interface) (net.HardwareAddr, []string) {
+	macAddress := iface.MacAddress
+	var ips []string
+	if ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, iface.Name); ifaceStatus != nil {
+		if ifaceStatus.MAC != "" {
+			macAddress = ifaceStatus.MAC
+		}
+		ips = ifaceStatus.IPs
+	}
+
+	mac, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return nil, nil
+	}
+	return mac, ips
+}
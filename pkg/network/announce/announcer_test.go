@@ -0,0 +1,192 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package announce_test
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/announce"
+)
+
+var _ = Describe("Announcer", func() {
+	var (
+		writers   map[string]*writerStub
+		openErr   error
+		announcer *announce.Announcer
+		done      chan struct{}
+	)
+
+	open := func(_ *v1.VirtualMachineInstance, _ int, network v1.Network) (announce.FrameWriter, error) {
+		if openErr != nil {
+			return nil, openErr
+		}
+		return writers[network.Name], nil
+	}
+
+	newVMI := func(annotation string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{UID: "vmi-uid", Annotations: map[string]string{announce.Annotation: annotation}},
+		}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+			{Name: "default", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+			{Name: "bridged", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+		}
+		vmi.Spec.Networks = []v1.Network{
+			*v1.DefaultPodNetwork(),
+			{Name: "bridged", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net"}}},
+		}
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+			{Name: "bridged", MAC: "02:00:00:00:00:05", IPs: []string{"10.0.0.5"}},
+		}
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{MigrationUID: "migration-uid"}
+		return vmi
+	}
+
+	BeforeEach(func() {
+		writers = map[string]*writerStub{"bridged": {}}
+		openErr = nil
+		done = make(chan struct{}, 1)
+		announcer = announce.New(open, testingclock.NewFakeClock(time.Now()), func(*v1.VirtualMachineInstance) {
+			done <- struct{}{}
+		})
+	})
+
+	It("should do nothing without annotation", func() {
+		vmi := newVMI("")
+		delete(vmi.Annotations, announce.Annotation)
+		Expect(announcer.Start(vmi, 1)).To(Succeed())
+		Consistently(done).ShouldNot(Receive())
+		_, exists := announcer.Result(vmi)
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should fail on an invalid annotation", func() {
+		Expect(announcer.Start(newVMI(`{"count": 100}`), 1)).ToNot(Succeed())
+	})
+
+	It("should send every round on the bridge bound interfaces", func() {
+		vmi := newVMI(`{"count": 2, "vlans": {"bridged": [10]}}`)
+		Expect(announcer.Start(vmi, 1)).To(Succeed())
+		Eventually(done).Should(Receive())
+
+		results, exists := announcer.Result(vmi)
+		Expect(exists).To(BeTrue())
+		Expect(results).To(Equal([]v1.MigrationNetworkAnnouncement{
+			{Name: "bridged", Rounds: 2, Succeeded: true},
+		}))
+		Expect(writers["bridged"].frameCount()).To(Equal(2 * 3))
+		Expect(writers["bridged"].closed).To(BeTrue())
+	})
+
+	It("should start the announcements of a migration once", func() {
+		vmi := newVMI(`{"count": 1}`)
+		Expect(announcer.Start(vmi, 1)).To(Succeed())
+		Eventually(done).Should(Receive())
+		Expect(announcer.Start(vmi, 1)).To(Succeed())
+		Consistently(done).ShouldNot(Receive())
+		Expect(writers["bridged"].frameCount()).To(Equal(2))
+	})
+
+	It("should report the failure to open an interface", func() {
+		openErr = errors.New("no such device")
+		vmi := newVMI(`{}`)
+		Expect(announcer.Start(vmi, 1)).To(Succeed())
+		Eventually(done).Should(Receive())
+
+		results, exists := announcer.Result(vmi)
+		Expect(exists).To(BeTrue())
+		Expect(results).To(Equal([]v1.MigrationNetworkAnnouncement{
+			{Name: "bridged", Message: "no such device"},
+		}))
+	})
+
+	It("should report the rounds sent before a failure", func() {
+		writers["bridged"].failAfter = 2
+		vmi := newVMI(`{"count": 3}`)
+		Expect(announcer.Start(vmi, 1)).To(Succeed())
+		Eventually(done).Should(Receive())
+
+		results, exists := announcer.Result(vmi)
+		Expect(exists).To(BeTrue())
+		Expect(results).To(Equal([]v1.MigrationNetworkAnnouncement{
+			{Name: "bridged", Rounds: 1, Message: "sent 1 of 3 rounds: network is down"},
+		}))
+	})
+
+	It("should not report the results of another migration", func() {
+		vmi := newVMI(`{}`)
+		Expect(announcer.Start(vmi, 1)).To(Succeed())
+		Eventually(done).Should(Receive())
+
+		vmi.Status.MigrationState.MigrationUID = "another-migration-uid"
+		_, exists := announcer.Result(vmi)
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should forget the results", func() {
+		vmi := newVMI(`{}`)
+		Expect(announcer.Start(vmi, 1)).To(Succeed())
+		Eventually(done).Should(Receive())
+
+		announcer.Forget(vmi.UID)
+		_, exists := announcer.Result(vmi)
+		Expect(exists).To(BeFalse())
+	})
+})
+
+type writerStub struct {
+	lock      sync.Mutex
+	frames    [][]byte
+	failAfter int
+	closed    bool
+}
+
+func (w *writerStub) Write(frame []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.failAfter > 0 && len(w.frames) >= w.failAfter {
+		return errors.New("network is down")
+	}
+	w.frames = append(w.frames, frame)
+	return nil
+}
+
+func (w *writerStub) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.closed = true
+	return nil
+}
+
+func (w *writerStub) frameCount() int {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return len(w.frames)
+}
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package announce
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// Annotation requests the network announcements of the bridge bound interfaces of a VMI
+// on the target node of its migrations, as a JSON Config.
+const Annotation = "network.kubevirt.io/migration-announcements"
+
+const (
+	DefaultCount    = 3
+	DefaultInterval = 200 * time.Millisecond
+
+	maxCount    = 10
+	minInterval = 10 * time.Millisecond
+	maxInterval = 5 * time.Second
+	minVLAN     = 1
+	maxVLAN     = 4094
+)
+
+// Config tunes the announcements sent after a migration
+type Config struct {
+	// Count is the number of announcement rounds, 3 by default
+	Count uint32 `json:"count,omitempty"`
+	// Interval is the delay between two rounds, 200ms by default
+	Interval metav1.Duration `json:"interval,omitempty"`
+	// VLANs maps the interface names to the VLAN IDs their announcements are repeated on, 802.1Q tagged
+	VLANs map[string][]uint16 `json:"vlans,omitempty"`
+}
+
+// ConfigFromVMI reads the announcements configuration of the VMI from its annotation.
+// It returns nil when the annotation is not set.
+func ConfigFromVMI(vmi *v1.VirtualMachineInstance) (*Config, error) {
+	value, exists := vmi.Annotations[Annotation]
+	if !exists {
+		return nil, nil
+	}
+
+	config := &Config{}
+	if err := json.Unmarshal([]byte(value), config); err != nil {
+		return nil, fmt.Errorf("invalid migration announcements: %v", err)
+	}
+
+	if config.Count == 0 {
+		config.Count = DefaultCount
+	}
+	if config.Count > maxCount {
+		return nil, fmt.Errorf("invalid migration announcements: count %d is above %d", config.Count, maxCount)
+	}
+
+	if config.Interval.Duration == 0 {
+		config.Interval.Duration = DefaultInterval
+	}
+	if config.Interval.Duration < minInterval || config.Interval.Duration > maxInterval {
+		return nil, fmt.Errorf("invalid migration announcements: interval %s is not between %s and %s",
+			config.Interval.Duration, minInterval, maxInterval)
+	}
+
+	for ifaceName, vlans := range config.VLANs {
+		iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, ifaceName)
+		if iface == nil {
+			return nil, fmt.Errorf("invalid migration announcements: interface %q does not exist", ifaceName)
+		}
+		if iface.Bridge == nil {
+			return nil, fmt.Errorf("invalid migration announcements: interface %q is not bound with bridge", ifaceName)
+		}
+		for _, vlan := range vlans {
+			if vlan < minVLAN || vlan > maxVLAN {
+				return nil, fmt.Errorf("invalid migration announcements: VLAN %d of interface %q is not between %d and %d",
+					vlan, ifaceName, minVLAN, maxVLAN)
+			}
+		}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package announce_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/announce"
+)
+
+var _ = Describe("Migration announcements configuration", func() {
+	newVMI := func(annotations map[string]string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+		}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+			{Name: "default", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+			{Name: "bridged", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+		}
+		return vmi
+	}
+
+	It("should return no configuration without annotation", func() {
+		Expect(announce.ConfigFromVMI(newVMI(nil))).To(BeNil())
+	})
+
+	It("should set the defaults", func() {
+		Expect(announce.ConfigFromVMI(newVMI(map[string]string{announce.Annotation: `{}`}))).To(Equal(&announce.Config{
+			Count:    announce.DefaultCount,
+			Interval: metav1.Duration{Duration: announce.DefaultInterval},
+		}))
+	})
+
+	It("should read the configuration", func() {
+		Expect(announce.ConfigFromVMI(newVMI(map[string]string{
+			announce.Annotation: `{"count": 5, "interval": "1s", "vlans": {"bridged": [10, 20]}}`,
+		}))).To(Equal(&announce.Config{
+			Count:    5,
+			Interval: metav1.Duration{Duration: time.Second},
+			VLANs:    map[string][]uint16{"bridged": {10, 20}},
+		}))
+	})
+
+	DescribeTable("should reject", func(value string) {
+		_, err := announce.ConfigFromVMI(newVMI(map[string]string{announce.Annotation: value}))
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("malformed JSON", `{"count": "many"}`),
+		Entry("too many rounds", `{"count": 11}`),
+		Entry("a too short interval", `{"interval": "1ms"}`),
+		Entry("a too long interval", `{"interval": "1m"}`),
+		Entry("an unknown interface", `{"vlans": {"unknown": [10]}}`),
+		Entry("an interface not bound with bridge", `{"vlans": {"default": [10]}}`),
+		Entry("a VLAN out of range", `{"vlans": {"bridged": [4095]}}`),
+	)
+})
@@ -0,0 +1,173 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package announce
+
+import (
+	"encoding/binary"
+	"net"
+)
+
+const (
+	etherTypeIPv4 = 0x0800
+	etherTypeARP  = 0x0806
+	etherTypeRARP = 0x8035
+	etherTypeVLAN = 0x8100
+	etherTypeIPv6 = 0x86dd
+
+	arpRequest        = 1
+	rarpRequest       = 3
+	icmpv6NeighborAdv = 136
+	naFlagOverride    = 0x20
+	ndOptTargetLLAddr = 2
+	protocolICMPv6    = 58
+
+	// minFrameLength is the minimal length of an Ethernet frame, without its FCS
+	minFrameLength = 60
+
+	ethernetHeaderLen = 14
+	ipv6HeaderLen     = 40
+	neighborAdvLen    = 24
+	llAddrOptionLen   = 8
+	arpPayloadLen     = 28
+)
+
+var (
+	broadcastMAC = net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	allNodesMAC  = net.HardwareAddr{0x33, 0x33, 0x00, 0x00, 0x00, 0x01}
+	allNodesIPv6 = net.ParseIP("ff02::1")
+	zeroMAC      = make(net.HardwareAddr, 6)
+	zeroIPv4     = net.IPv4zero.To4()
+)
+
+// Frames builds the announcements of a guest interface: a gratuitous ARP for each of its IPv4
+// addresses, an unsolicited neighbor advertisement for each of its IPv6 addresses and a RARP.
+// Only the RARP is repeated on the VLANs, tagged, as the addresses of the guest are not known there.
+func Frames(mac net.HardwareAddr, ips []string, vlans []uint16) [][]byte {
+	var frames [][]byte
+	for _, ipString := range ips {
+		ip := net.ParseIP(ipString)
+		switch {
+		case ip == nil:
+			continue
+		case ip.To4() != nil:
+			frames = append(frames, GratuitousARP(mac, ip))
+		default:
+			frames = append(frames, UnsolicitedNA(mac, ip))
+		}
+	}
+
+	rarp := RARP(mac)
+	frames = append(frames, rarp)
+	for _, vlan := range vlans {
+		frames = append(frames, Tag(rarp, vlan))
+	}
+	return frames
+}
+
+// GratuitousARP builds a broadcast ARP request for the given address, sent from and to itself
+func GratuitousARP(mac net.HardwareAddr, ip net.IP) []byte {
+	return pad(append(ethernetHeader(broadcastMAC, mac, etherTypeARP), arpPayload(arpRequest, etherTypeIPv4, mac, ip.To4(), zeroMAC, ip.To4())...))
+}
+
+// RARP builds a broadcast reverse ARP request, the announcement QEMU sends after a migration
+func RARP(mac net.HardwareAddr) []byte {
+	return pad(append(ethernetHeader(broadcastMAC, mac, etherTypeRARP), arpPayload(rarpRequest, etherTypeIPv4, mac, zeroIPv4, mac, zeroIPv4)...))
+}
+
+// UnsolicitedNA builds a neighbor advertisement of the given address to all the nodes,
+// with the override flag set so that the neighbors update their caches.
+func UnsolicitedNA(mac net.HardwareAddr, ip net.IP) []byte {
+	icmp := make([]byte, neighborAdvLen+llAddrOptionLen)
+	icmp[0] = icmpv6NeighborAdv
+	icmp[4] = naFlagOverride
+	copy(icmp[8:24], ip.To16())
+	icmp[24] = ndOptTargetLLAddr
+	icmp[25] = 1
+	copy(icmp[26:32], mac)
+	binary.BigEndian.PutUint16(icmp[2:4], icmpv6Checksum(ip.To16(), allNodesIPv6, icmp))
+
+	ipHdr := make([]byte, ipv6HeaderLen)
+	ipHdr[0] = 6 << 4
+	binary.BigEndian.PutUint16(ipHdr[4:6], uint16(len(icmp)))
+	ipHdr[6] = protocolICMPv6
+	ipHdr[7] = 255
+	copy(ipHdr[8:24], ip.To16())
+	copy(ipHdr[24:40], allNodesIPv6)
+
+	frame := ethernetHeader(allNodesMAC, mac, etherTypeIPv6)
+	frame = append(frame, ipHdr...)
+	return append(frame, icmp...)
+}
+
+// Tag inserts an 802.1Q header with the given VLAN ID in the frame
+func Tag(frame []byte, vlan uint16) []byte {
+	tagged := make([]byte, 0, len(frame)+4)
+	tagged = append(tagged, frame[:12]...)
+	tagged = binary.BigEndian.AppendUint16(tagged, etherTypeVLAN)
+	tagged = binary.BigEndian.AppendUint16(tagged, vlan&0x0fff)
+	return append(tagged, frame[12:]...)
+}
+
+func ethernetHeader(dst, src net.HardwareAddr, etherType uint16) []byte {
+	header := make([]byte, 0, ethernetHeaderLen)
+	header = append(header, dst...)
+	header = append(header, src...)
+	return binary.BigEndian.AppendUint16(header, etherType)
+}
+
+func arpPayload(op, protocol uint16, senderMAC net.HardwareAddr, senderIP net.IP, targetMAC net.HardwareAddr, targetIP net.IP) []byte {
+	payload := make([]byte, 0, arpPayloadLen)
+	payload = binary.BigEndian.AppendUint16(payload, 1) // Ethernet
+	payload = binary.BigEndian.AppendUint16(payload, protocol)
+	payload = append(payload, 6, 4)
+	payload = binary.BigEndian.AppendUint16(payload, op)
+	payload = append(payload, senderMAC...)
+	payload = append(payload, senderIP...)
+	payload = append(payload, targetMAC...)
+	return append(payload, targetIP...)
+}
+
+func icmpv6Checksum(src, dst net.IP, icmp []byte) uint16 {
+	pseudoHeader := make([]byte, 0, 40+len(icmp))
+	pseudoHeader = append(pseudoHeader, src...)
+	pseudoHeader = append(pseudoHeader, dst...)
+	pseudoHeader = binary.BigEndian.AppendUint32(pseudoHeader, uint32(len(icmp)))
+	pseudoHeader = append(pseudoHeader, 0, 0, 0, protocolICMPv6)
+	pseudoHeader = append(pseudoHeader, icmp...)
+
+	var sum uint32
+	for i := 0; i+1 < len(pseudoHeader); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(pseudoHeader[i:]))
+	}
+	if len(pseudoHeader)%2 == 1 {
+		sum += uint32(pseudoHeader[len(pseudoHeader)-1]) << 8
+	}
+	for sum > 0xffff {
+		sum = (sum >> 16) + (sum & 0xffff)
+	}
+	return ^uint16(sum)
+}
+
+func pad(frame []byte) []byte {
+	if len(frame) >= minFrameLength {
+		return frame
+	}
+	return append(frame, make([]byte, minFrameLength-len(frame))...)
+}
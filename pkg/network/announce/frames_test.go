@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package announce_test
+
+import (
+	"encoding/binary"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/announce"
+)
+
+var _ = Describe("Announcement frames", func() {
+	var mac net.HardwareAddr
+
+	BeforeEach(func() {
+		var err error
+		mac, err = net.ParseMAC("02:00:00:00:00:05")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should build a gratuitous ARP", func() {
+		frame := announce.GratuitousARP(mac, net.ParseIP("10.0.0.5"))
+		Expect(frame).To(HaveLen(60))
+		Expect(frame[:14]).To(Equal([]byte{
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0x02, 0x00, 0x00, 0x00, 0x00, 0x05,
+			0x08, 0x06,
+		}))
+		Expect(frame[14:42]).To(Equal([]byte{
+			0x00, 0x01, 0x08, 0x00, 6, 4, 0x00, 0x01,
+			0x02, 0x00, 0x00, 0x00, 0x00, 0x05, 10, 0, 0, 5,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 10, 0, 0, 5,
+		}))
+	})
+
+	It("should build a RARP", func() {
+		frame := announce.RARP(mac)
+		Expect(frame).To(HaveLen(60))
+		Expect(frame[12:14]).To(Equal([]byte{0x80, 0x35}))
+		Expect(frame[14:42]).To(Equal([]byte{
+			0x00, 0x01, 0x08, 0x00, 6, 4, 0x00, 0x03,
+			0x02, 0x00, 0x00, 0x00, 0x00, 0x05, 0, 0, 0, 0,
+			0x02, 0x00, 0x00, 0x00, 0x00, 0x05, 0, 0, 0, 0,
+		}))
+	})
+
+	It("should build an unsolicited neighbor advertisement", func() {
+		ip := net.ParseIP("fd00::5")
+		frame := announce.UnsolicitedNA(mac, ip)
+		Expect(frame).To(HaveLen(14 + 40 + 32))
+		Expect(frame[:14]).To(Equal([]byte{
+			0x33, 0x33, 0x00, 0x00, 0x00, 0x01,
+			0x02, 0x00, 0x00, 0x00, 0x00, 0x05,
+			0x86, 0xdd,
+		}))
+
+		ipHeader, icmp := frame[14:54], frame[54:]
+		Expect(ipHeader[6]).To(Equal(byte(58)), "next header should be ICMPv6")
+		Expect(ipHeader[7]).To(Equal(byte(255)), "hop limit should be 255")
+		Expect(net.IP(ipHeader[8:24]).Equal(ip)).To(BeTrue())
+		Expect(net.IP(ipHeader[24:40]).Equal(net.ParseIP("ff02::1"))).To(BeTrue())
+
+		Expect(icmp[0]).To(Equal(byte(136)))
+		Expect(icmp[4]).To(Equal(byte(0x20)), "only the override flag should be set")
+		Expect(net.IP(icmp[8:24]).Equal(ip)).To(BeTrue())
+		Expect(icmp[24:]).To(Equal([]byte{2, 1, 0x02, 0x00, 0x00, 0x00, 0x00, 0x05}))
+		Expect(checksum(append(pseudoHeader(ipHeader), icmp...))).To(Equal(uint16(0)), "checksum should be valid")
+	})
+
+	It("should tag a frame", func() {
+		frame := announce.Tag(announce.RARP(mac), 10)
+		Expect(frame).To(HaveLen(64))
+		Expect(frame[12:18]).To(Equal([]byte{0x81, 0x00, 0x00, 0x0a, 0x80, 0x35}))
+	})
+
+	It("should announce every address and tag the RARP on the VLANs", func() {
+		frames := announce.Frames(mac, []string{"10.0.0.5", "fd00::5", "invalid"}, []uint16{10, 20})
+		Expect(frames).To(Equal([][]byte{
+			announce.GratuitousARP(mac, net.ParseIP("10.0.0.5")),
+			announce.UnsolicitedNA(mac, net.ParseIP("fd00::5")),
+			announce.RARP(mac),
+			announce.Tag(announce.RARP(mac), 10),
+			announce.Tag(announce.RARP(mac), 20),
+		}))
+	})
+})
+
+func pseudoHeader(ipHeader []byte) []byte {
+	header := append([]byte{}, ipHeader[8:40]...)
+	header = binary.BigEndian.AppendUint32(header, uint32(binary.BigEndian.Uint16(ipHeader[4:6])))
+	return append(header, 0, 0, 0, ipHeader[6])
+}
+
+func checksum(data []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(data); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(data[i:]))
+	}
+	for sum > 0xffff {
+		sum = (sum >> 16) + (sum & 0xffff)
+	}
+	return ^uint16(sum)
+}
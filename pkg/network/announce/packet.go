@@ -0,0 +1,88 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package announce
+
+import (
+	"fmt"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver"
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/netns"
+)
+
+// packetWriter sends the frames through a raw packet socket bound to an interface.
+// The socket is bound to the network namespace it is created in, it can be written from any other.
+type packetWriter struct {
+	fd   int
+	addr unix.SockaddrLinklayer
+}
+
+// OpenBridgePort opens a raw packet socket on the pod interface connected to the bridge of the
+// network, in the network namespace of the virt-launcher pod.
+func OpenBridgePort(vmi *v1.VirtualMachineInstance, launcherPid int, network v1.Network) (FrameWriter, error) {
+	var writer *packetWriter
+	err := netns.New(launcherPid).Do(func() error {
+		podIfaceLink, err := link.DiscoverByNetwork(&driver.NetworkUtilsHandler{}, vmi.Spec.Networks, network, vmi.Status.Interfaces)
+		if err != nil {
+			return err
+		}
+		if podIfaceLink == nil {
+			return fmt.Errorf("the pod interface of network %q is not found", network.Name)
+		}
+
+		port, err := netlink.LinkByName(link.GenerateNewBridgedVmiInterfaceName(podIfaceLink.Attrs().Name))
+		if err != nil {
+			return err
+		}
+
+		writer, err = newPacketWriter(port.Attrs().Index)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the bridge port of network %q: %v", network.Name, err)
+	}
+	return writer, nil
+}
+
+func newPacketWriter(ifIndex int) (*packetWriter, error) {
+	// no protocol is set, the socket sends only
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &packetWriter{
+		fd:   fd,
+		addr: unix.SockaddrLinklayer{Ifindex: ifIndex, Halen: 6},
+	}, nil
+}
+
+func (w *packetWriter) Write(frame []byte) error {
+	copy(w.addr.Addr[:], frame[:6])
+	return unix.Sendto(w.fd, frame, 0, &w.addr)
+}
+
+func (w *packetWriter) Close() error {
+	return unix.Close(w.fd)
+}
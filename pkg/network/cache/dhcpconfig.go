@@ -99,6 +99,7 @@ type DHCPConfig struct {
 	IPAMDisabled        bool
 	Gateway             net.IP
 	Subdomain           string
+	DNSForwarder        bool
 }
 
 func (d DHCPConfig) String() string {
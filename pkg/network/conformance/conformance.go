@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+// Package conformance exercises a network binding plugin against a matrix of scenarios on a
+// running KubeVirt cluster, so that the authors of binding plugins can check their compatibility.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	DefaultImage   = "quay.io/kubevirt/cirros-container-disk-demo:latest"
+	DefaultTimeout = 5 * time.Minute
+)
+
+// Config describes the binding plugin under test and the environment of the scenarios
+type Config struct {
+	// Namespace the scenarios create their VMs in
+	Namespace string
+	// Binding is the name of the binding plugin, as registered in the KubeVirt CR
+	Binding string
+	// NetworkName is the network attachment definition the tested interface is connected to,
+	// the pod network is used when empty
+	NetworkName string
+	// Image is the container disk the VMs boot from
+	Image string
+	// Timeout bounds each step of a scenario, e.g. the start of a VMI or a migration
+	Timeout time.Duration
+}
+
+// Scenario exercises the binding plugin, it returns an error when the plugin does not conform
+type Scenario struct {
+	Name        string
+	Description string
+	Run         func(ctx context.Context, env *Env) error
+}
+
+type skipError string
+
+func (e skipError) Error() string {
+	return string(e)
+}
+
+// Skip returns an error which reports the scenario as skipped, for the given reason
+func Skip(format string, args ...interface{}) error {
+	return skipError(fmt.Sprintf(format, args...))
+}
+
+// Run runs the scenarios in order and reports their results.
+// A failing scenario does not prevent the next ones from running.
+func Run(ctx context.Context, env *Env, scenarios []Scenario) *Report {
+	report := &Report{
+		Binding: env.Config.Binding,
+		Network: env.Config.NetworkName,
+		Results: []Result{},
+	}
+	for _, scenario := range scenarios {
+		start := time.Now()
+		err := scenario.Run(ctx, env)
+		result := Result{
+			Scenario: scenario.Name,
+			Status:   StatusPassed,
+			Duration: metav1.Duration{Duration: time.Since(start).Round(time.Second)},
+		}
+
+		var skipErr skipError
+		switch {
+		case errors.As(err, &skipErr):
+			result.Status = StatusSkipped
+			result.Message = skipErr.Error()
+		case err != nil:
+			result.Status = StatusFailed
+			result.Message = err.Error()
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report
+}
+
+// SelectScenarios returns the scenarios with the given names, in the order they are given.
+// All the scenarios are returned when no name is given.
+func SelectScenarios(scenarios []Scenario, names []string) ([]Scenario, error) {
+	if len(names) == 0 {
+		return scenarios, nil
+	}
+
+	byName := map[string]Scenario{}
+	for _, scenario := range scenarios {
+		byName[scenario.Name] = scenario
+	}
+
+	var selected []Scenario
+	for _, name := range names {
+		scenario, exists := byName[name]
+		if !exists {
+			return nil, fmt.Errorf("unknown scenario %q", name)
+		}
+		selected = append(selected, scenario)
+	}
+	return selected, nil
+}
@@ -0,0 +1,114 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package conformance_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/conformance"
+)
+
+var _ = Describe("Binding conformance", func() {
+	newScenario := func(name string, err error) conformance.Scenario {
+		return conformance.Scenario{
+			Name: name,
+			Run: func(context.Context, *conformance.Env) error {
+				return err
+			},
+		}
+	}
+
+	scenarios := []conformance.Scenario{
+		newScenario("passing", nil),
+		newScenario("failing", errors.New("the interface is missing")),
+		newScenario("skipped", conformance.Skip("not supported on %s", "pod network")),
+	}
+
+	It("should report the result of every scenario", func() {
+		env := &conformance.Env{Config: conformance.Config{Binding: "passt"}}
+		report := conformance.Run(context.Background(), env, scenarios)
+
+		Expect(report.Binding).To(Equal("passt"))
+		Expect(report.Results).To(HaveLen(3))
+		Expect(report.Results[0].Status).To(Equal(conformance.StatusPassed))
+		Expect(report.Results[0].Message).To(BeEmpty())
+		Expect(report.Results[1].Status).To(Equal(conformance.StatusFailed))
+		Expect(report.Results[1].Message).To(Equal("the interface is missing"))
+		Expect(report.Results[2].Status).To(Equal(conformance.StatusSkipped))
+		Expect(report.Results[2].Message).To(Equal("not supported on pod network"))
+		Expect(report.Count(conformance.StatusFailed)).To(Equal(1))
+	})
+
+	It("should select the scenarios by name", func() {
+		selected, err := conformance.SelectScenarios(scenarios, []string{"skipped", "passing"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(selected).To(HaveLen(2))
+		Expect(selected[0].Name).To(Equal("skipped"))
+		Expect(selected[1].Name).To(Equal("passing"))
+	})
+
+	It("should select all the scenarios when no name is given", func() {
+		Expect(conformance.SelectScenarios(scenarios, nil)).To(HaveLen(3))
+	})
+
+	It("should reject an unknown scenario", func() {
+		_, err := conformance.SelectScenarios(scenarios, []string{"unknown"})
+		Expect(err).To(MatchError(`unknown scenario "unknown"`))
+	})
+
+	It("should name the scenarios uniquely", func() {
+		names := map[string]struct{}{}
+		for _, scenario := range conformance.Scenarios() {
+			Expect(names).ToNot(HaveKey(scenario.Name))
+			names[scenario.Name] = struct{}{}
+		}
+	})
+
+	Context("report", func() {
+		var report *conformance.Report
+
+		BeforeEach(func() {
+			report = conformance.Run(context.Background(), &conformance.Env{Config: conformance.Config{Binding: "passt"}}, scenarios)
+		})
+
+		It("should be written as text", func() {
+			var buf bytes.Buffer
+			Expect(report.WriteText(&buf)).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("Binding passt on pod network"))
+			Expect(buf.String()).To(MatchRegexp(`failing\s+Failed\s+0s\s+the interface is missing`))
+			Expect(buf.String()).To(HaveSuffix("1 passed, 1 failed, 1 skipped\n"))
+		})
+
+		It("should be written as JSON", func() {
+			var buf bytes.Buffer
+			Expect(report.WriteJSON(&buf)).To(Succeed())
+
+			var decoded conformance.Report
+			Expect(json.Unmarshal(buf.Bytes(), &decoded)).To(Succeed())
+			Expect(decoded).To(Equal(*report))
+		})
+	})
+})
@@ -0,0 +1,216 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	// TestedInterfaceName is the name of the interface bound with the plugin under test, and of its network
+	TestedInterfaceName = "tested"
+
+	namePrefix       = "binding-conformance-"
+	pollInterval     = 2 * time.Second
+	computeContainer = "compute"
+	guestMemory      = "128Mi"
+)
+
+// ExecFunc runs a command in a container of a pod and returns its output
+type ExecFunc func(ctx context.Context, pod *k8sv1.Pod, container string, command []string) (string, error)
+
+// Env gives the scenarios access to the cluster
+type Env struct {
+	Client kubecli.KubevirtClient
+	Config Config
+	Exec   ExecFunc
+}
+
+// NewEnv returns an environment for the given configuration, with its defaults set.
+// The commands are run in the pods through the exec subresource.
+func NewEnv(client kubecli.KubevirtClient, config Config) *Env {
+	if config.Image == "" {
+		config.Image = DefaultImage
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultTimeout
+	}
+	return &Env{
+		Client: client,
+		Config: config,
+		Exec:   newPodExec(client),
+	}
+}
+
+// NewVMI returns a VMI booting from the configured image, with no interface
+func (e *Env) NewVMI(opts ...libvmi.Option) *v1.VirtualMachineInstance {
+	opts = append([]libvmi.Option{
+		libvmi.WithName(namePrefix + rand.String(5)),
+		libvmi.WithNamespace(e.Config.Namespace),
+		libvmi.WithResourceMemory(guestMemory),
+		libvmi.WithContainerDisk("disk0", e.Config.Image),
+	}, opts...)
+	return libvmi.New(opts...)
+}
+
+// WithTestedInterface adds the interface bound with the plugin under test, connected to the configured network
+func (e *Env) WithTestedInterface() libvmi.Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin(TestedInterfaceName, v1.PluginBinding{Name: e.Config.Binding}))(vmi)
+		libvmi.WithNetwork(e.TestedNetwork())(vmi)
+	}
+}
+
+// TestedNetwork returns the network of the tested interface
+func (e *Env) TestedNetwork() *v1.Network {
+	if e.Config.NetworkName == "" {
+		return &v1.Network{
+			Name:          TestedInterfaceName,
+			NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}},
+		}
+	}
+	return libvmi.MultusNetwork(TestedInterfaceName, e.Config.NetworkName)
+}
+
+// StartVMI creates the VMI and waits for it to run with the tested interface reported in its status
+func (e *Env) StartVMI(ctx context.Context, vmi *v1.VirtualMachineInstance) (*v1.VirtualMachineInstance, error) {
+	vmi, err := e.Client.VirtualMachineInstance(vmi.Namespace).Create(ctx, vmi, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the VMI: %v", err)
+	}
+	return e.WaitForVMI(ctx, vmi, "run with its interface reported", func(vmi *v1.VirtualMachineInstance) (bool, error) {
+		if vmi.IsFinal() {
+			return false, fmt.Errorf("the VMI stopped in phase %s: %s", vmi.Status.Phase, vmi.Status.Reason)
+		}
+		return vmi.IsRunning() && testedInterfaceStatus(vmi) != nil, nil
+	})
+}
+
+// DeleteVMI deletes the VMI, the error is ignored as the VMIs are cleaned up on a best effort basis
+func (e *Env) DeleteVMI(vmi *v1.VirtualMachineInstance) {
+	_ = e.Client.VirtualMachineInstance(vmi.Namespace).Delete(context.Background(), vmi.Name, metav1.DeleteOptions{})
+}
+
+// WaitForVMI polls the VMI until the condition is met, fails or the configured timeout expires
+func (e *Env) WaitForVMI(ctx context.Context, vmi *v1.VirtualMachineInstance, description string,
+	condition func(vmi *v1.VirtualMachineInstance) (bool, error)) (*v1.VirtualMachineInstance, error) {
+	var current *v1.VirtualMachineInstance
+	err := wait.PollUntilContextTimeout(ctx, pollInterval, e.Config.Timeout, true, func(ctx context.Context) (bool, error) {
+		var err error
+		current, err = e.Client.VirtualMachineInstance(vmi.Namespace).Get(ctx, vmi.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, nil
+		}
+		return condition(current)
+	})
+	if wait.Interrupted(err) {
+		return nil, fmt.Errorf("timed out after %s waiting for the VMI to %s", e.Config.Timeout, description)
+	}
+	return current, err
+}
+
+// Domain returns the libvirt domain of the running VMI
+func (e *Env) Domain(ctx context.Context, vmi *v1.VirtualMachineInstance) (*api.DomainSpec, error) {
+	command := []string{"virsh"}
+	if util.IsNonRootVMI(vmi) {
+		command = append(command, "-c", "qemu+unix:///session?socket=/var/run/libvirt/virtqemud-sock")
+	}
+	command = append(command, "dumpxml", vmi.Namespace+"_"+vmi.Name)
+
+	output, err := e.ExecOnLauncher(ctx, vmi, command)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the domain: %v", err)
+	}
+
+	domain := &api.DomainSpec{}
+	if err := xml.Unmarshal([]byte(output), domain); err != nil {
+		return nil, fmt.Errorf("failed to parse the domain: %v", err)
+	}
+	return domain, nil
+}
+
+// ExecOnLauncher runs a command in the compute container of the active virt-launcher pod of the VMI
+func (e *Env) ExecOnLauncher(ctx context.Context, vmi *v1.VirtualMachineInstance, command []string) (string, error) {
+	pods, err := e.Client.CoreV1().Pods(vmi.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: v1.CreatedByLabel + "=" + string(vmi.UID),
+	})
+	if err != nil {
+		return "", err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == k8sv1.PodRunning && vmi.Status.ActivePods[pod.UID] == vmi.Status.NodeName {
+			return e.Exec(ctx, pod, computeContainer, command)
+		}
+	}
+	return "", errors.New("the virt-launcher pod of the VMI is not found")
+}
+
+func newPodExec(client kubecli.KubevirtClient) ExecFunc {
+	return func(ctx context.Context, pod *k8sv1.Pod, container string, command []string) (string, error) {
+		req := client.CoreV1().RESTClient().Post().
+			Resource("pods").
+			Name(pod.Name).
+			Namespace(pod.Namespace).
+			SubResource("exec").
+			VersionedParams(&k8sv1.PodExecOptions{
+				Container: container,
+				Command:   command,
+				Stdout:    true,
+				Stderr:    true,
+			}, scheme.ParameterCodec)
+
+		executor, err := remotecommand.NewSPDYExecutor(client.Config(), "POST", req.URL())
+		if err != nil {
+			return "", err
+		}
+
+		var stdout, stderr bytes.Buffer
+		if err := executor.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: &stdout, Stderr: &stderr}); err != nil {
+			return "", fmt.Errorf("%v: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return stdout.String(), nil
+	}
+}
+
+func testedInterfaceStatus(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstanceNetworkInterface {
+	return vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, TestedInterfaceName)
+}
@@ -0,0 +1,120 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package conformance_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/network/conformance"
+)
+
+const domainXML = `<domain type="kvm">
+  <name>default_vmi</name>
+  <devices>
+    <interface type="ethernet">
+      <mtu size="1400"></mtu>
+      <alias name="ua-tested"></alias>
+      <driver name="vhost" queues="4"></driver>
+    </interface>
+  </devices>
+</domain>`
+
+var _ = Describe("Conformance environment", func() {
+	var (
+		env *conformance.Env
+		vmi *v1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		vmi = &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "vmi", Namespace: "default", UID: "vmi-uid"}}
+		vmi.Status.NodeName = "node01"
+		vmi.Status.ActivePods = map[types.UID]string{"active-pod-uid": "node01"}
+
+		newPod := func(name string, uid types.UID) *k8sv1.Pod {
+			return &k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "default",
+					UID:       uid,
+					Labels:    map[string]string{v1.CreatedByLabel: "vmi-uid"},
+				},
+				Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+			}
+		}
+
+		client := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		client.EXPECT().CoreV1().Return(fake.NewSimpleClientset(
+			newPod("source-pod", "source-pod-uid"),
+			newPod("active-pod", "active-pod-uid"),
+		).CoreV1()).AnyTimes()
+
+		env = conformance.NewEnv(client, conformance.Config{Namespace: "default", Binding: "passt"})
+		env.Exec = func(_ context.Context, pod *k8sv1.Pod, container string, command []string) (string, error) {
+			Expect(pod.Name).To(Equal("active-pod"))
+			Expect(container).To(Equal("compute"))
+			Expect(command).To(Equal([]string{"virsh", "dumpxml", "default_vmi"}))
+			return domainXML, nil
+		}
+	})
+
+	It("should set the defaults", func() {
+		Expect(env.Config.Image).To(Equal(conformance.DefaultImage))
+		Expect(env.Config.Timeout).To(Equal(conformance.DefaultTimeout))
+	})
+
+	It("should read the domain from the active virt-launcher pod", func() {
+		domain, err := env.Domain(context.Background(), vmi)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domain.Devices.Interfaces).To(HaveLen(1))
+		Expect(domain.Devices.Interfaces[0].Alias.GetName()).To(Equal(conformance.TestedInterfaceName))
+		Expect(domain.Devices.Interfaces[0].MTU.Size).To(Equal("1400"))
+		Expect(*domain.Devices.Interfaces[0].Driver.Queues).To(Equal(uint(4)))
+	})
+
+	It("should bind the tested interface to the plugin on the pod network", func() {
+		vmi := env.NewVMI(env.WithTestedInterface())
+		Expect(vmi.Namespace).To(Equal("default"))
+		Expect(vmi.Spec.Domain.Devices.Interfaces).To(Equal([]v1.Interface{{
+			Name:    conformance.TestedInterfaceName,
+			Binding: &v1.PluginBinding{Name: "passt"},
+		}}))
+		Expect(vmi.Spec.Networks).To(Equal([]v1.Network{{
+			Name:          conformance.TestedInterfaceName,
+			NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}},
+		}}))
+	})
+
+	It("should connect the tested interface to the configured network", func() {
+		env.Config.NetworkName = "isolated"
+		Expect(env.TestedNetwork().Multus).To(Equal(&v1.MultusNetwork{NetworkName: "isolated"}))
+	})
+})
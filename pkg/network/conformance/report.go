@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Status string
+
+const (
+	StatusPassed  Status = "Passed"
+	StatusFailed  Status = "Failed"
+	StatusSkipped Status = "Skipped"
+)
+
+// Result is the outcome of a scenario
+type Result struct {
+	Scenario string          `json:"scenario"`
+	Status   Status          `json:"status"`
+	Message  string          `json:"message,omitempty"`
+	Duration metav1.Duration `json:"duration"`
+}
+
+// Report holds the results of the scenarios run against a binding plugin
+type Report struct {
+	Binding string   `json:"binding"`
+	Network string   `json:"network,omitempty"`
+	Results []Result `json:"results"`
+}
+
+// Count returns the number of scenarios which ended with the given status
+func (r *Report) Count(status Status) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// WriteText writes the report as a table, followed by a summary
+func (r *Report) WriteText(w io.Writer) error {
+	network := r.Network
+	if network == "" {
+		network = "pod network"
+	}
+	if _, err := fmt.Fprintf(w, "Binding %s on %s\n\n", r.Binding, network); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "SCENARIO\tSTATUS\tDURATION\tMESSAGE")
+	for _, result := range r.Results {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", result.Scenario, result.Status, result.Duration.Duration, result.Message)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d passed, %d failed, %d skipped\n",
+		r.Count(StatusPassed), r.Count(StatusFailed), r.Count(StatusSkipped))
+	return err
+}
+
+// WriteJSON writes the report as an indented JSON document
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
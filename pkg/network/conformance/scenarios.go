@@ -0,0 +1,289 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	multiQueueCPUs = 4
+	defaultMTU     = 1500
+)
+
+// Scenarios returns all the scenarios of the conformance suite
+func Scenarios() []Scenario {
+	return []Scenario{
+		{
+			Name:        "boot",
+			Description: "the VMI starts and its interface is defined in the domain and reported in its status",
+			Run:         runBoot,
+		},
+		{
+			Name:        "multiqueue",
+			Description: "the interface of a VMI requesting multi-queue gets a queue per vCPU",
+			Run:         runMultiQueue,
+		},
+		{
+			Name:        "mtu",
+			Description: "the MTU of the interface matches the MTU of the pod interface",
+			Run:         runMTU,
+		},
+		{
+			Name:        "migration",
+			Description: "the VMI live migrates and keeps its interface",
+			Run:         runMigration,
+		},
+		{
+			Name:        "hotplug",
+			Description: "the interface is plugged to a running VM, secondary networks only",
+			Run:         runHotplug,
+		},
+	}
+}
+
+func runBoot(ctx context.Context, env *Env) error {
+	vmi, err := env.StartVMI(ctx, env.NewVMI(env.WithTestedInterface()))
+	if vmi != nil {
+		defer env.DeleteVMI(vmi)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = testedDomainInterface(ctx, env, vmi)
+	return err
+}
+
+func runMultiQueue(ctx context.Context, env *Env) error {
+	vmi := env.NewVMI(env.WithTestedInterface(), libvmi.WithCPUCount(multiQueueCPUs, 1, 1))
+	vmi.Spec.Domain.Devices.NetworkInterfaceMultiQueue = pointer.P(true)
+
+	vmi, err := env.StartVMI(ctx, vmi)
+	if vmi != nil {
+		defer env.DeleteVMI(vmi)
+	}
+	if err != nil {
+		return err
+	}
+
+	iface, err := testedDomainInterface(ctx, env, vmi)
+	if err != nil {
+		return err
+	}
+	return CheckQueues(iface, multiQueueCPUs)
+}
+
+func runMTU(ctx context.Context, env *Env) error {
+	vmi, err := env.StartVMI(ctx, env.NewVMI(env.WithTestedInterface()))
+	if vmi != nil {
+		defer env.DeleteVMI(vmi)
+	}
+	if err != nil {
+		return err
+	}
+
+	iface, err := testedDomainInterface(ctx, env, vmi)
+	if err != nil {
+		return err
+	}
+
+	podIfaceName := namescheme.HashedPodInterfaceName(*env.TestedNetwork(), vmi.Status.Interfaces)
+	output, err := env.ExecOnLauncher(ctx, vmi, []string{"cat", fmt.Sprintf("/sys/class/net/%s/mtu", podIfaceName)})
+	if err != nil {
+		return fmt.Errorf("failed to read the MTU of the pod interface %s: %v", podIfaceName, err)
+	}
+	podMTU, err := strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return fmt.Errorf("failed to parse the MTU of the pod interface %s: %v", podIfaceName, err)
+	}
+	return CheckMTU(iface, podMTU)
+}
+
+func runMigration(ctx context.Context, env *Env) error {
+	vmi, err := env.StartVMI(ctx, env.NewVMI(env.WithTestedInterface()))
+	if vmi != nil {
+		defer env.DeleteVMI(vmi)
+	}
+	if err != nil {
+		return err
+	}
+
+	vmi, err = env.WaitForVMI(ctx, vmi, "report whether it is live migratable", func(vmi *v1.VirtualMachineInstance) (bool, error) {
+		return migratableCondition(vmi) != nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	if condition := migratableCondition(vmi); condition.Status != k8sv1.ConditionTrue {
+		return Skip("the VMI is not live migratable: %s", condition.Message)
+	}
+
+	migration := &v1.VirtualMachineInstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: namePrefix, Namespace: vmi.Namespace},
+		Spec:       v1.VirtualMachineInstanceMigrationSpec{VMIName: vmi.Name},
+	}
+	migration, err = env.Client.VirtualMachineInstanceMigration(vmi.Namespace).Create(ctx, migration, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create the migration: %v", err)
+	}
+	defer func() {
+		_ = env.Client.VirtualMachineInstanceMigration(migration.Namespace).Delete(context.Background(), migration.Name, metav1.DeleteOptions{})
+	}()
+
+	sourceNode := vmi.Status.NodeName
+	vmi, err = env.WaitForVMI(ctx, vmi, "migrate", func(vmi *v1.VirtualMachineInstance) (bool, error) {
+		migrationState := vmi.Status.MigrationState
+		if migrationState == nil || migrationState.MigrationUID != migration.UID || !migrationState.Completed {
+			return false, nil
+		}
+		if migrationState.Failed {
+			return false, fmt.Errorf("the migration failed: %s", migrationState.FailureReason)
+		}
+		return vmi.Status.NodeName != sourceNode && testedInterfaceStatus(vmi) != nil, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = testedDomainInterface(ctx, env, vmi)
+	return err
+}
+
+func runHotplug(ctx context.Context, env *Env) error {
+	if env.Config.NetworkName == "" {
+		return Skip("interfaces of the pod network can not be plugged")
+	}
+
+	vmi := env.NewVMI(
+		libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+		libvmi.WithNetwork(v1.DefaultPodNetwork()),
+	)
+	vm := libvmi.NewVirtualMachine(vmi, libvmi.WithRunStrategy(v1.RunStrategyAlways))
+	vm, err := env.Client.VirtualMachine(vm.Namespace).Create(ctx, vm, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create the VM: %v", err)
+	}
+	defer func() {
+		_ = env.Client.VirtualMachine(vm.Namespace).Delete(context.Background(), vm.Name, metav1.DeleteOptions{})
+	}()
+
+	_, err = env.WaitForVMI(ctx, vmi, "run", func(vmi *v1.VirtualMachineInstance) (bool, error) {
+		return vmi.IsRunning(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	hotplugged := env.NewVMI(env.WithTestedInterface())
+	payload, err := patch.New(
+		patch.WithAdd("/spec/template/spec/domain/devices/interfaces/-", hotplugged.Spec.Domain.Devices.Interfaces[0]),
+		patch.WithAdd("/spec/template/spec/networks/-", hotplugged.Spec.Networks[0]),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := env.Client.VirtualMachine(vm.Namespace).Patch(ctx, vm.Name, types.JSONPatchType, payload, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to add the interface to the VM: %v", err)
+	}
+
+	running, err := env.WaitForVMI(ctx, vmi, "report the plugged interface", func(vmi *v1.VirtualMachineInstance) (bool, error) {
+		ifaceStatus := testedInterfaceStatus(vmi)
+		return ifaceStatus != nil && vmispec.ContainsInfoSource(ifaceStatus.InfoSource, vmispec.InfoSourceDomain), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = testedDomainInterface(ctx, env, running)
+	return err
+}
+
+func testedDomainInterface(ctx context.Context, env *Env, vmi *v1.VirtualMachineInstance) (*api.Interface, error) {
+	domain, err := env.Domain(ctx, vmi)
+	if err != nil {
+		return nil, err
+	}
+	return LookupDomainInterface(domain, TestedInterfaceName)
+}
+
+// LookupDomainInterface returns the interface of the domain with the given name
+func LookupDomainInterface(domain *api.DomainSpec, name string) (*api.Interface, error) {
+	for i := range domain.Devices.Interfaces {
+		iface := &domain.Devices.Interfaces[i]
+		if iface.Alias != nil && iface.Alias.GetName() == name {
+			return iface, nil
+		}
+	}
+	return nil, fmt.Errorf("the interface %q is not defined in the domain", name)
+}
+
+// CheckQueues checks that the interface of the domain has the expected number of queues
+func CheckQueues(iface *api.Interface, expected uint) error {
+	queues := uint(1)
+	if iface.Driver != nil && iface.Driver.Queues != nil {
+		queues = *iface.Driver.Queues
+	}
+	if queues != expected {
+		return fmt.Errorf("the interface has %d queue(s), expected %d", queues, expected)
+	}
+	return nil
+}
+
+// CheckMTU checks that the MTU of the interface of the domain matches the MTU of its pod interface.
+// The guest uses the default MTU when the domain does not set it.
+func CheckMTU(iface *api.Interface, podMTU int) error {
+	mtu := defaultMTU
+	if iface.MTU != nil {
+		var err error
+		if mtu, err = strconv.Atoi(iface.MTU.Size); err != nil {
+			return fmt.Errorf("invalid MTU %q of the interface: %v", iface.MTU.Size, err)
+		}
+	}
+	if mtu != podMTU {
+		return fmt.Errorf("the MTU of the interface is %d, the MTU of the pod interface is %d", mtu, podMTU)
+	}
+	return nil
+}
+
+func migratableCondition(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstanceCondition {
+	for i := range vmi.Status.Conditions {
+		if vmi.Status.Conditions[i].Type == v1.VirtualMachineInstanceIsMigratable {
+			return &vmi.Status.Conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package conformance_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/conformance"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Conformance checks", func() {
+	It("should find the interface in the domain by its alias", func() {
+		domain := &api.DomainSpec{}
+		domain.Devices.Interfaces = []api.Interface{
+			{Alias: api.NewUserDefinedAlias("other")},
+			{Alias: api.NewUserDefinedAlias(conformance.TestedInterfaceName), Model: &api.Model{Type: "virtio"}},
+		}
+		Expect(conformance.LookupDomainInterface(domain, conformance.TestedInterfaceName)).To(Equal(&domain.Devices.Interfaces[1]))
+
+		_, err := conformance.LookupDomainInterface(domain, "missing")
+		Expect(err).To(MatchError(`the interface "missing" is not defined in the domain`))
+	})
+
+	DescribeTable("should check the queues", func(driver *api.InterfaceDriver, expectedErr string) {
+		err := conformance.CheckQueues(&api.Interface{Driver: driver}, 4)
+		if expectedErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(expectedErr))
+		}
+	},
+		Entry("with a queue per vCPU", &api.InterfaceDriver{Queues: pointer.P(uint(4))}, ""),
+		Entry("with less queues", &api.InterfaceDriver{Queues: pointer.P(uint(2))}, "the interface has 2 queue(s), expected 4"),
+		Entry("without driver", nil, "the interface has 1 queue(s), expected 4"),
+	)
+
+	DescribeTable("should check the MTU", func(mtu *api.MTU, podMTU int, expectedErr string) {
+		err := conformance.CheckMTU(&api.Interface{MTU: mtu}, podMTU)
+		if expectedErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(expectedErr))
+		}
+	},
+		Entry("matching the pod interface", &api.MTU{Size: "1400"}, 1400, ""),
+		Entry("not matching the pod interface", &api.MTU{Size: "1500"}, 1400,
+			"the MTU of the interface is 1500, the MTU of the pod interface is 1400"),
+		Entry("unset on a pod interface with the default MTU", nil, 1500, ""),
+		Entry("unset on a pod interface with a lower MTU", nil, 1400,
+			"the MTU of the interface is 1500, the MTU of the pod interface is 1400"),
+	)
+})
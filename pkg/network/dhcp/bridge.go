@@ -22,6 +22,7 @@ type BridgeConfigGenerator struct {
 	vmiSpecIfaces    []v1.Interface
 	vmiSpecIface     *v1.Interface
 	subdomain        string
+	dnsForwarder     bool
 }
 
 func (d *BridgeConfigGenerator) Generate() (*cache.DHCPConfig, error) {
@@ -47,6 +48,7 @@ func (d *BridgeConfigGenerator) Generate() (*cache.DHCPConfig, error) {
 	}
 	dhcpConfig.Mtu = uint16(podNicLink.Attrs().MTU)
 	dhcpConfig.Subdomain = d.subdomain
+	dhcpConfig.DNSForwarder = d.dnsForwarder
 
 	return dhcpConfig, nil
 }
@@ -54,7 +54,7 @@ type ConfigGenerator interface {
 }
 
 func NewBridgeConfigurator(cacheCreator cacheCreator, launcherPID string, advertisingIfaceName string, handler netdriver.NetworkHandler, podInterfaceName string,
-	vmiSpecIfaces []v1.Interface, vmiSpecIface *v1.Interface, subdomain string) *configurator {
+	vmiSpecIfaces []v1.Interface, vmiSpecIface *v1.Interface, subdomain string, dnsForwarder bool) *configurator {
 	return &configurator{
 		podInterfaceName:     podInterfaceName,
 		advertisingIfaceName: advertisingIfaceName,
@@ -68,6 +68,7 @@ func NewBridgeConfigurator(cacheCreator cacheCreator, launcherPID string, advert
 			vmiSpecIfaces:    vmiSpecIfaces,
 			vmiSpecIface:     vmiSpecIface,
 			subdomain:        subdomain,
+			dnsForwarder:     dnsForwarder,
 		},
 	}
 }
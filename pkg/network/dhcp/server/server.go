@@ -194,7 +194,7 @@ type DHCPHandler struct {
 	options       dhcp.Options
 }
 
-func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, _ dhcp.Options) (d dhcp.Packet) {
+func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, options dhcp.Options) (d dhcp.Packet) {
 	log.Log.V(4).Info("Serving a new request")
 	if len(h.clientMAC) != 0 {
 		if mac := p.CHAddr(); !bytes.Equal(mac, h.clientMAC) {
@@ -212,6 +212,16 @@ func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, _ dhcp.
 
 	case dhcp.Request:
 		log.Log.V(4).Info("The request has message type REQUEST")
+		if serverID, exists := options[dhcp.OptionServerIdentifier]; exists && !net.IP(serverID).Equal(h.serverIP) {
+			log.Log.V(4).Info("The request is for another server")
+			return nil
+		}
+		// The address of the client is kept in the DHCP cache of the interface, a client renewing
+		// an address it was not given, e.g. before the pod was recreated, has to start over
+		if requestedIP := requestedAddress(p, options); requestedIP != nil && !requestedIP.Equal(h.clientIP) {
+			log.Log.V(4).Infof("The request is for address %s instead of %s, replying NAK", requestedIP, h.clientIP)
+			return dhcp.ReplyPacket(p, dhcp.NAK, h.serverIP, nil, 0, nil)
+		}
 		return dhcp.ReplyPacket(p, dhcp.ACK, h.serverIP, h.clientIP, h.leaseDuration,
 			h.options.SelectOrderOrAll(nil))
 
@@ -222,6 +232,18 @@ func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, _ dhcp.
 	}
 }
 
+// requestedAddress returns the address requested by the client, from the requested IP address
+// option when selecting or rebooting, or from the client address when renewing or rebinding
+func requestedAddress(p dhcp.Packet, options dhcp.Options) net.IP {
+	if requestedIP := net.IP(options[dhcp.OptionRequestedIPAddress]).To4(); requestedIP != nil {
+		return requestedIP
+	}
+	if clientIP := p.CIAddr().To4(); clientIP != nil && !clientIP.IsUnspecified() {
+		return clientIP
+	}
+	return nil
+}
+
 func sortRoutes(routes []netlink.Route) []netlink.Route {
 	// Default route must come last, otherwise it may not get applied
 	// because there is no route to its gateway yet
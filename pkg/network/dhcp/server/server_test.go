@@ -249,4 +249,77 @@ var _ = Describe("DHCP Server", func() {
 			})
 		})
 	})
+
+	Context("DHCPHandler", func() {
+		var (
+			clientMAC = net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01}
+			clientIP  = net.ParseIP("10.0.0.10")
+			serverIP  = net.ParseIP("169.254.75.10").To4()
+			handler   *DHCPHandler
+		)
+
+		BeforeEach(func() {
+			handler = &DHCPHandler{
+				clientIP:      clientIP,
+				clientMAC:     clientMAC,
+				serverIP:      serverIP,
+				leaseDuration: infiniteLease,
+				options:       dhcp4.Options{},
+			}
+		})
+
+		serve := func(msgType dhcp4.MessageType, cIAddr net.IP, options ...dhcp4.Option) dhcp4.Packet {
+			request := dhcp4.RequestPacket(msgType, clientMAC, cIAddr, []byte{1, 2, 3, 4}, false, options)
+			return handler.ServeDHCP(request, msgType, request.ParseOptions())
+		}
+
+		messageType := func(reply dhcp4.Packet) dhcp4.MessageType {
+			return dhcp4.MessageType(reply.ParseOptions()[dhcp4.OptionDHCPMessageType][0])
+		}
+
+		It("should offer the address of the client", func() {
+			reply := serve(dhcp4.Discover, nil)
+			Expect(messageType(reply)).To(Equal(dhcp4.Offer))
+			Expect(reply.YIAddr().Equal(clientIP)).To(BeTrue())
+		})
+
+		DescribeTable("should acknowledge a request", func(cIAddr net.IP, options ...dhcp4.Option) {
+			reply := serve(dhcp4.Request, cIAddr, options...)
+			Expect(messageType(reply)).To(Equal(dhcp4.ACK))
+			Expect(reply.YIAddr().Equal(clientIP)).To(BeTrue())
+		},
+			Entry("when selecting the offer of the server", nil,
+				dhcp4.Option{Code: dhcp4.OptionServerIdentifier, Value: serverIP},
+				dhcp4.Option{Code: dhcp4.OptionRequestedIPAddress, Value: clientIP.To4()},
+			),
+			Entry("when rebooting with the address of the client", nil,
+				dhcp4.Option{Code: dhcp4.OptionRequestedIPAddress, Value: clientIP.To4()},
+			),
+			Entry("when renewing the address of the client", clientIP),
+			Entry("when no address is requested", nil),
+		)
+
+		DescribeTable("should not acknowledge a request for another address", func(cIAddr net.IP, options ...dhcp4.Option) {
+			reply := serve(dhcp4.Request, cIAddr, options...)
+			Expect(messageType(reply)).To(Equal(dhcp4.NAK))
+			Expect(reply.YIAddr().Equal(net.IPv4zero)).To(BeTrue())
+		},
+			Entry("when rebooting", nil,
+				dhcp4.Option{Code: dhcp4.OptionRequestedIPAddress, Value: net.ParseIP("10.0.0.20").To4()},
+			),
+			Entry("when renewing", net.ParseIP("10.0.0.20")),
+		)
+
+		It("should ignore a request for another server", func() {
+			Expect(serve(dhcp4.Request, nil,
+				dhcp4.Option{Code: dhcp4.OptionServerIdentifier, Value: net.ParseIP("10.0.0.1").To4()},
+				dhcp4.Option{Code: dhcp4.OptionRequestedIPAddress, Value: clientIP.To4()},
+			)).To(BeNil())
+		})
+
+		It("should ignore the requests of another client", func() {
+			request := dhcp4.RequestPacket(dhcp4.Request, net.HardwareAddr{0x02, 0, 0, 0, 0, 0x02}, clientIP, []byte{1, 2, 3, 4}, false, nil)
+			Expect(handler.ServeDHCP(request, dhcp4.Request, request.ParseOptions())).To(BeNil())
+		})
+	})
 })
@@ -0,0 +1,256 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package dns
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// ForwarderAnnotation enables the DNS forwarder of the bridge bound interfaces of a VMI
+	ForwarderAnnotation = "network.kubevirt.io/bridge-dns-forwarder"
+
+	// ForwarderPort is the port the DNS forwarder listens on
+	ForwarderPort = 53
+
+	forwarderTimeout = 5 * time.Second
+	maxMessageSize   = 65535
+	headerSize       = 12
+	rcodeServFail    = 2
+)
+
+// IsForwarderEnabled returns true if the VMI requests a DNS forwarder on its bridge bound interfaces
+func IsForwarderEnabled(vmi *v1.VirtualMachineInstance) bool {
+	return strings.EqualFold(vmi.Annotations[ForwarderAnnotation], "true")
+}
+
+// Forwarder relays the DNS queries of a guest to the nameservers of the pod, so that guests on
+// networks which can't reach the cluster DNS can resolve the cluster services and external names.
+type Forwarder struct {
+	upstreams   []string
+	udpConn     *net.UDPConn
+	tcpListener *net.TCPListener
+	closeOnce   sync.Once
+}
+
+// ListenForwarder binds the forwarder on ip:port, over UDP and TCP, relaying the queries to
+// the nameservers
+func ListenForwarder(ip net.IP, port int, nameservers []net.IP) (*Forwarder, error) {
+	udpConn, err := net.ListenUDP("udp", &net.UDPAddr{IP: ip, Port: port})
+	if err != nil {
+		return nil, err
+	}
+	tcpListener, err := net.ListenTCP("tcp", &net.TCPAddr{IP: ip, Port: port})
+	if err != nil {
+		udpConn.Close()
+		return nil, err
+	}
+
+	upstreams := make([]string, 0, len(nameservers))
+	for _, nameserver := range nameservers {
+		upstreams = append(upstreams, net.JoinHostPort(nameserver.String(), strconv.Itoa(ForwarderPort)))
+	}
+	return newForwarder(udpConn, tcpListener, upstreams), nil
+}
+
+func newForwarder(udpConn *net.UDPConn, tcpListener *net.TCPListener, upstreams []string) *Forwarder {
+	return &Forwarder{
+		upstreams:   upstreams,
+		udpConn:     udpConn,
+		tcpListener: tcpListener,
+	}
+}
+
+// UDPAddr returns the UDP address the forwarder listens on
+func (f *Forwarder) UDPAddr() net.Addr {
+	return f.udpConn.LocalAddr()
+}
+
+// TCPAddr returns the TCP address the forwarder listens on
+func (f *Forwarder) TCPAddr() net.Addr {
+	return f.tcpListener.Addr()
+}
+
+// Serve relays the queries until the forwarder is closed
+func (f *Forwarder) Serve() error {
+	errChan := make(chan error, 2)
+	go func() { errChan <- f.serveUDP() }()
+	go func() { errChan <- f.serveTCP() }()
+
+	err := <-errChan
+	f.Close()
+	if errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+// Close stops the forwarder
+func (f *Forwarder) Close() {
+	f.closeOnce.Do(func() {
+		f.udpConn.Close()
+		f.tcpListener.Close()
+	})
+}
+
+func (f *Forwarder) serveUDP() error {
+	buf := make([]byte, maxMessageSize)
+	for {
+		n, client, err := f.udpConn.ReadFromUDP(buf)
+		if err != nil {
+			return err
+		}
+		if n < headerSize {
+			continue
+		}
+		query := make([]byte, n)
+		copy(query, buf[:n])
+
+		go func() {
+			response, err := f.exchange("udp", query)
+			if err != nil {
+				log.Log.Reason(err).V(4).Info("failed to forward a DNS query")
+				response = servFail(query)
+			}
+			if _, err := f.udpConn.WriteToUDP(response, client); err != nil {
+				log.Log.Reason(err).V(4).Info("failed to send a DNS response")
+			}
+		}()
+	}
+}
+
+func (f *Forwarder) serveTCP() error {
+	for {
+		conn, err := f.tcpListener.AcceptTCP()
+		if err != nil {
+			return err
+		}
+		go f.handleTCP(conn)
+	}
+}
+
+func (f *Forwarder) handleTCP(conn *net.TCPConn) {
+	defer conn.Close()
+	for {
+		if err := conn.SetDeadline(time.Now().Add(forwarderTimeout)); err != nil {
+			return
+		}
+		query, err := readTCPMessage(conn)
+		if err != nil {
+			return
+		}
+		response, err := f.exchange("tcp", query)
+		if err != nil {
+			log.Log.Reason(err).V(4).Info("failed to forward a DNS query")
+			response = servFail(query)
+		}
+		if err := writeTCPMessage(conn, response); err != nil {
+			return
+		}
+	}
+}
+
+// exchange sends the query to the nameservers in turn, until one of them responds
+func (f *Forwarder) exchange(network string, query []byte) ([]byte, error) {
+	err := errors.New("no nameserver")
+	for _, upstream := range f.upstreams {
+		var response []byte
+		response, err = exchangeWith(network, upstream, query)
+		if err == nil {
+			return response, nil
+		}
+	}
+	return nil, err
+}
+
+func exchangeWith(network, upstream string, query []byte) ([]byte, error) {
+	conn, err := net.DialTimeout(network, upstream, forwarderTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(forwarderTimeout)); err != nil {
+		return nil, err
+	}
+
+	if network == "tcp" {
+		if err := writeTCPMessage(conn, query); err != nil {
+			return nil, err
+		}
+		return readTCPMessage(conn)
+	}
+
+	if _, err := conn.Write(query); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, maxMessageSize)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		// Ignore the responses to other queries
+		if n >= headerSize && buf[0] == query[0] && buf[1] == query[1] {
+			return buf[:n], nil
+		}
+	}
+}
+
+// readTCPMessage reads a DNS message prefixed with its length
+func readTCPMessage(r io.Reader) ([]byte, error) {
+	var length uint16
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	if length < headerSize {
+		return nil, errors.New("DNS message too short")
+	}
+	message := make([]byte, length)
+	if _, err := io.ReadFull(r, message); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+func writeTCPMessage(w io.Writer, message []byte) error {
+	b := binary.BigEndian.AppendUint16(make([]byte, 0, 2+len(message)), uint16(len(message)))
+	_, err := w.Write(append(b, message...))
+	return err
+}
+
+// servFail returns a SERVFAIL response to the query, without its question
+func servFail(query []byte) []byte {
+	response := make([]byte, headerSize)
+	copy(response, query[:2])
+	// QR set, opcode and RD kept from the query
+	response[2] = 0x80 | query[2]&0x79
+	response[3] = 0x80 | rcodeServFail
+	return response
+}
@@ -0,0 +1,129 @@
+package dns
+
+import (
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("DNS forwarder", func() {
+	query := []byte{0x12, 0x34, 0x01, 0x00, 0, 1, 0, 0, 0, 0, 0, 0, 3, 'f', 'o', 'o', 0, 0, 1, 0, 1}
+
+	respond := func(query []byte) []byte {
+		response := append([]byte{}, query...)
+		response[2] |= 0x80
+		return response
+	}
+
+	startUDPUpstream := func() string {
+		conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(conn.Close)
+		go func() {
+			buf := make([]byte, maxMessageSize)
+			for {
+				n, client, err := conn.ReadFromUDP(buf)
+				if err != nil {
+					return
+				}
+				_, _ = conn.WriteToUDP(respond(buf[:n]), client)
+			}
+		}()
+		return conn.LocalAddr().String()
+	}
+
+	startTCPUpstream := func() string {
+		listener, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(listener.Close)
+		go func() {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				message, err := readTCPMessage(conn)
+				if err == nil {
+					_ = writeTCPMessage(conn, respond(message))
+				}
+				conn.Close()
+			}
+		}()
+		return listener.Addr().String()
+	}
+
+	startForwarder := func(upstreams ...string) *Forwarder {
+		forwarder, err := ListenForwarder(net.IPv4(127, 0, 0, 1), 0, nil)
+		Expect(err).ToNot(HaveOccurred())
+		forwarder.upstreams = upstreams
+		go func() {
+			defer GinkgoRecover()
+			Expect(forwarder.Serve()).To(Succeed())
+		}()
+		DeferCleanup(forwarder.Close)
+		return forwarder
+	}
+
+	exchangeUDP := func(addr net.Addr) []byte {
+		conn, err := net.Dial("udp", addr.String())
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+		Expect(conn.SetDeadline(time.Now().Add(10 * time.Second))).To(Succeed())
+		_, err = conn.Write(query)
+		Expect(err).ToNot(HaveOccurred())
+		buf := make([]byte, maxMessageSize)
+		n, err := conn.Read(buf)
+		Expect(err).ToNot(HaveOccurred())
+		return buf[:n]
+	}
+
+	It("should forward the UDP queries", func() {
+		forwarder := startForwarder(startUDPUpstream())
+		Expect(exchangeUDP(forwarder.UDPAddr())).To(Equal(respond(query)))
+	})
+
+	It("should forward the TCP queries", func() {
+		forwarder := startForwarder(startTCPUpstream())
+
+		conn, err := net.Dial("tcp", forwarder.TCPAddr().String())
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+		Expect(conn.SetDeadline(time.Now().Add(10 * time.Second))).To(Succeed())
+		Expect(writeTCPMessage(conn, query)).To(Succeed())
+		Expect(readTCPMessage(conn)).To(Equal(respond(query)))
+	})
+
+	It("should fall back to the next nameserver", func() {
+		unreachable, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		Expect(err).ToNot(HaveOccurred())
+		unreachableAddr := unreachable.LocalAddr().String()
+		Expect(unreachable.Close()).To(Succeed())
+
+		forwarder := startForwarder(unreachableAddr, startUDPUpstream())
+		Expect(exchangeUDP(forwarder.UDPAddr())).To(Equal(respond(query)))
+	})
+
+	It("should respond SERVFAIL without nameserver", func() {
+		forwarder := startForwarder()
+		response := exchangeUDP(forwarder.UDPAddr())
+		Expect(response).To(HaveLen(headerSize))
+		Expect(response[:2]).To(Equal(query[:2]))
+		Expect(response[2]).To(Equal(byte(0x81)))
+		Expect(response[3] & 0x0f).To(Equal(byte(rcodeServFail)))
+	})
+
+	DescribeTable("IsForwarderEnabled", func(annotations map[string]string, expected bool) {
+		vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+		Expect(IsForwarderEnabled(vmi)).To(Equal(expected))
+	},
+		Entry("without annotation", nil, false),
+		Entry("with the annotation set to true", map[string]string{ForwarderAnnotation: "true"}, true),
+		Entry("with the annotation set to false", map[string]string{ForwarderAnnotation: "false"}, false),
+	)
+})
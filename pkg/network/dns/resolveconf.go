@@ -16,6 +16,7 @@ const (
 	nameserverPrefix    = "nameserver"
 	defaultDNS          = "8.8.8.8"
 	defaultSearchDomain = "cluster.local"
+	resolvConf          = "/etc/resolv.conf"
 )
 
 func ParseNameservers(content string) ([][]byte, error) {
@@ -32,8 +33,9 @@ func ParseNameservers(content string) ([][]byte, error) {
 		line := scanner.Text()
 		if strings.HasPrefix(line, nameserverPrefix) {
 			nameserver := re.FindString(line)
-			if nameserver != "" {
-				nameservers = append(nameservers, net.ParseIP(nameserver).To4())
+			// IPv6 nameservers may partially match the expression as well
+			if ip := net.ParseIP(nameserver).To4(); ip != nil {
+				nameservers = append(nameservers, ip)
 			}
 		}
 	}
@@ -50,6 +52,30 @@ func ParseNameservers(content string) ([][]byte, error) {
 	return nameservers, nil
 }
 
+// ParseIPv6Nameservers returns the IPv6 nameservers of the resolver configuration.
+// Unlike ParseNameservers, it doesn't apply a default when none is found.
+func ParseIPv6Nameservers(content string) ([]net.IP, error) {
+	var nameservers []net.IP
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != nameserverPrefix {
+			continue
+		}
+		if ip := net.ParseIP(fields[1]); ip != nil && ip.To4() == nil {
+			nameservers = append(nameservers, ip)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nameservers, nil
+}
+
 func ParseSearchDomains(content string) ([]string, error) {
 	var searchDomains []string
 
@@ -129,10 +155,7 @@ func DomainNameWithSubdomain(searchDomains []string, subdomain string) string {
 
 // GetResolvConfDetailsFromPod reads and parses the DNS resolver's configuration file.
 func GetResolvConfDetailsFromPod() ([][]byte, []string, error) {
-	// #nosec No risk for path injection. resolvConf is static "/etc/resolve.conf"
-	const resolvConf = "/etc/resolv.conf"
-
-	b, err := os.ReadFile(resolvConf)
+	b, err := readResolvConf()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -152,3 +175,25 @@ func GetResolvConfDetailsFromPod() ([][]byte, []string, error) {
 
 	return nameservers, searchDomains, err
 }
+
+// GetIPv6NameserversFromPod reads the IPv6 nameservers from the DNS resolver's configuration file.
+func GetIPv6NameserversFromPod() ([]net.IP, error) {
+	b, err := readResolvConf()
+	if err != nil {
+		return nil, err
+	}
+
+	nameservers, err := ParseIPv6Nameservers(string(b))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Log.Infof("Found IPv6 nameservers in %s: %v", resolvConf, nameservers)
+
+	return nameservers, nil
+}
+
+func readResolvConf() ([]byte, error) {
+	// #nosec No risk for path injection. resolvConf is static "/etc/resolve.conf"
+	return os.ReadFile(resolvConf)
+}
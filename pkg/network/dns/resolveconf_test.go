@@ -34,6 +34,30 @@ var _ = Describe("Resolveconf", func() {
 		})
 	})
 
+	Context("Function ParseNameservers() on IPv6 clusters", func() {
+		It("should ignore IPv6 nameservers", func() {
+			resolvConf := "nameserver 2001:4860:4860::8888\nnameserver 8.8.4.4\n"
+			nameservers, err := ParseNameservers(resolvConf)
+			Expect(nameservers).To(Equal([][]byte{{8, 8, 4, 4}}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("Function ParseIPv6Nameservers()", func() {
+		It("should return only the IPv6 nameservers", func() {
+			resolvConf := "search example.com\nnameserver fd00:10:96::a\nnameserver 8.8.8.8\nnameserver 2001:4860:4860::8888\n"
+			nameservers, err := ParseIPv6Nameservers(resolvConf)
+			Expect(nameservers).To(Equal([]net.IP{net.ParseIP("fd00:10:96::a"), net.ParseIP("2001:4860:4860::8888")}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not return a default nameserver", func() {
+			nameservers, err := ParseIPv6Nameservers("nameserver 8.8.8.8\n")
+			Expect(nameservers).To(BeEmpty())
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
 	Context("Function ParseSearchDomains()", func() {
 		It("should return a string of search domains", func() {
 			resolvConf := "search cluster.local svc.cluster.local example.com\nnameserver 8.8.8.8\n"
@@ -23,6 +23,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -86,3 +87,24 @@ func BindingMigrationByInterfaceName(vmiSpecIfaces []v1.Interface,
 	}
 	return bindingMigrationByInterfaceName
 }
+
+// InterfaceMigrationByInterfaceName adds the bridge interface on the pod network of the VMIs allowed to migrate with it
+// to the interfaces bound by a migratable plugin: the target pod has another IP, refreshing the link makes the guest
+// renew its DHCP lease to get it
+func InterfaceMigrationByInterfaceName(vmi *v1.VirtualMachineInstance,
+	networkBindings map[string]v1.InterfaceBindingPlugin,
+) map[string]*cmdv1.InterfaceBindingMigration {
+	vmiSpecIfaces := vmi.Spec.Domain.Devices.Interfaces
+	interfaceMigrationByInterfaceName := BindingMigrationByInterfaceName(vmiSpecIfaces, networkBindings)
+	if _, allowPodBridgeNetworkLiveMigration := vmi.Annotations[v1.AllowPodBridgeNetworkLiveMigrationAnnotation]; !allowPodBridgeNetworkLiveMigration {
+		return interfaceMigrationByInterfaceName
+	}
+	if podNetwork := vmispec.LookupPodNetwork(vmi.Spec.Networks); podNetwork != nil {
+		if podIface := vmispec.LookupInterfaceByName(vmiSpecIfaces, podNetwork.Name); podIface != nil && podIface.Bridge != nil {
+			interfaceMigrationByInterfaceName[podIface.Name] = &cmdv1.InterfaceBindingMigration{
+				Method: string(v1.LinkRefresh),
+			}
+		}
+	}
+	return interfaceMigrationByInterfaceName
+}
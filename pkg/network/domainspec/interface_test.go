@@ -128,4 +128,42 @@ var _ = Describe("VMI interfaces", func() {
 			Expect(domainspec.BindingMigrationByInterfaceName(vmiSpecIfaces, networkBindings)).To(Equal(expectedMap))
 		})
 	})
+
+	Context("InterfaceMigrationByInterfaceName", func() {
+		newVMI := func(networks []v1.Network, annotations map[string]string) *v1.VirtualMachineInstance {
+			vmi := &v1.VirtualMachineInstance{}
+			vmi.Annotations = annotations
+			vmi.Spec.Networks = networks
+			vmi.Spec.Domain.Devices.Interfaces = vmiSpecIfaces
+			return vmi
+		}
+		allowPodBridgeNetworkLiveMigration := map[string]string{v1.AllowPodBridgeNetworkLiveMigrationAnnotation: ""}
+
+		It("should refresh the link of the bridge interface on the pod network when allowed to migrate", func() {
+			networks := []v1.Network{
+				{Name: iface2, NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+				{Name: iface7, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net7"}}},
+			}
+			expectedMap := map[string]*cmdv1.InterfaceBindingMigration{
+				iface2: {
+					Method: string(v1.LinkRefresh),
+				},
+				iface5: {},
+				iface7: {
+					Method: string(v1.LinkRefresh),
+				},
+			}
+			Expect(domainspec.InterfaceMigrationByInterfaceName(newVMI(networks, allowPodBridgeNetworkLiveMigration), networkBindings)).To(Equal(expectedMap))
+		})
+
+		It("should not refresh the link of the bridge interface on the pod network without the annotation", func() {
+			networks := []v1.Network{{Name: iface2, NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+			Expect(domainspec.InterfaceMigrationByInterfaceName(newVMI(networks, nil), networkBindings)).ToNot(HaveKey(iface2))
+		})
+
+		It("should not refresh the link of the masquerade interface on the pod network", func() {
+			networks := []v1.Network{{Name: iface1, NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+			Expect(domainspec.InterfaceMigrationByInterfaceName(newVMI(networks, allowPodBridgeNetworkLiveMigration), networkBindings)).ToNot(HaveKey(iface1))
+		})
+	})
 })
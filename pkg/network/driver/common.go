@@ -23,6 +23,7 @@ package driver
 
 import (
 	"fmt"
+	"net"
 	"os"
 
 	"github.com/vishvananda/netlink"
@@ -171,6 +172,15 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 	}
 
 	if nic.IP.IPNet != nil {
+		routes := nic.Routes
+		if nic.DNSForwarder {
+			if err := startDNSForwarder(nic.AdvertisingIPAddr, nameservers); err != nil {
+				return fmt.Errorf("failed to start the DNS forwarder: %v", err)
+			}
+			nameservers = [][]byte{nic.AdvertisingIPAddr.To4()}
+			routes = dnsForwarderRoutes(nic)
+		}
+
 		// panic in case the DHCP server failed during the vm creation
 		// but ignore dhcp errors when the vm is destroyed or shutting down
 		go func() {
@@ -182,7 +192,7 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 				nic.AdvertisingIPAddr,
 				nic.Gateway,
 				nameservers,
-				nic.Routes,
+				routes,
 				searchDomains,
 				nic.Mtu,
 				dhcpOptions,
@@ -194,10 +204,16 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 	}
 
 	if nic.IPv6.IPNet != nil {
+		ipv6Nameservers, err := dns.GetIPv6NameserversFromPod()
+		if err != nil {
+			return fmt.Errorf("Failed to get IPv6 DNS servers from resolv.conf: %v", err)
+		}
 		go func() {
 			if err = DHCPv6Server(
 				nic.IPv6.IP,
 				bridgeInterfaceName,
+				ipv6Nameservers,
+				searchDomains,
 			); err != nil {
 				log.Log.Reason(err).Error("failed to run DHCPv6 Server")
 				panic(err)
@@ -208,6 +224,42 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 	return nil
 }
 
+// startDNSForwarder relays the DNS queries sent to the advertising address of the DHCP server
+// to the nameservers of the pod
+func startDNSForwarder(advertisingIP net.IP, nameservers [][]byte) error {
+	upstreams := make([]net.IP, 0, len(nameservers))
+	for _, nameserver := range nameservers {
+		upstreams = append(upstreams, net.IP(nameserver))
+	}
+	forwarder, err := dns.ListenForwarder(advertisingIP, dns.ForwarderPort, upstreams)
+	if err != nil {
+		return err
+	}
+	go func() {
+		if err := forwarder.Serve(); err != nil {
+			log.Log.Reason(err).Error("DNS forwarder failed")
+		}
+	}()
+	return nil
+}
+
+// dnsForwarderRoutes adds an on-link route to the advertising address, which is only reachable
+// on the bridge. The classless routes replace the router option, the default route is therefore
+// advertised with them.
+func dnsForwarderRoutes(nic *cache.DHCPConfig) *[]netlink.Route {
+	var routes []netlink.Route
+	if nic.Routes != nil {
+		routes = append(routes, *nic.Routes...)
+	}
+	if len(routes) == 0 && nic.Gateway != nil {
+		routes = append(routes, netlink.Route{Gw: nic.Gateway})
+	}
+	routes = append(routes, netlink.Route{
+		Dst: &net.IPNet{IP: nic.AdvertisingIPAddr.To4(), Mask: net.CIDRMask(32, 32)},
+	})
+	return &routes
+}
+
 // Allow mocking for tests
 var DHCPServer = dhcpserver.SingleClientDHCPServer
 var DHCPv6Server = dhcpserverv6.SingleClientDHCPv6Server
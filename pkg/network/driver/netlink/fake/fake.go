@@ -20,9 +20,11 @@
 package fake
 
 import (
+	"errors"
 	"net"
 
 	vishnetlink "github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 )
 
 type NetLink struct {
@@ -147,6 +149,27 @@ func (n *NetLink) LinkSetLearningOff(link vishnetlink.Link) error {
 	return nil
 }
 
+func (n *NetLink) LinkSetFloodOff(link vishnetlink.Link) error {
+	l := n.lookupLinkByName(link.Attrs().Name)
+	if l == nil {
+		return vishnetlink.LinkNotFoundError{}
+	}
+	if l.Attrs().Protinfo == nil {
+		l.Attrs().Protinfo = &vishnetlink.Protinfo{}
+	}
+	l.Attrs().Protinfo.Flood = false
+	return nil
+}
+
+func (n *NetLink) LinkSetTxQLen(link vishnetlink.Link, qlen int) error {
+	l := n.lookupLinkByName(link.Attrs().Name)
+	if l == nil {
+		return vishnetlink.LinkNotFoundError{}
+	}
+	l.Attrs().TxQLen = qlen
+	return nil
+}
+
 func (n *NetLink) LinkGetProtinfo(link vishnetlink.Link) (vishnetlink.Protinfo, error) {
 	l := n.lookupLinkByName(link.Attrs().Name)
 	if l == nil {
@@ -232,6 +255,27 @@ func (n *NetLink) RouteAdd(route *vishnetlink.Route) error {
 	return nil
 }
 
+func (n *NetLink) RouteReplace(route *vishnetlink.Route) error {
+	if err := n.RouteDel(route); err != nil && !errors.Is(err, unix.ESRCH) {
+		return err
+	}
+	return n.RouteAdd(route)
+}
+
+func (n *NetLink) RouteDel(route *vishnetlink.Route) error {
+	routes := &n.routes4
+	if route.Dst != nil && ipFamily(route.Dst.IP) == vishnetlink.FAMILY_V6 {
+		routes = &n.routes6
+	}
+	for i, r := range *routes {
+		if r.LinkIndex == route.LinkIndex && r.Table == route.Table && r.Dst.String() == route.Dst.String() {
+			*routes = append((*routes)[:i], (*routes)[i+1:]...)
+			return nil
+		}
+	}
+	return unix.ESRCH
+}
+
 func (n *NetLink) lookupLinkByName(name string) vishnetlink.Link {
 	for i, l := range n.links {
 		if l.Attrs().Name == name {
@@ -70,6 +70,14 @@ func (n NetLink) LinkSetLearningOff(link netlink.Link) error {
 	return withErrDescr(netlink.LinkSetLearning(link, false), "LinkSetLearningOff")
 }
 
+func (n NetLink) LinkSetFloodOff(link netlink.Link) error {
+	return withErrDescr(netlink.LinkSetFlood(link, false), "LinkSetFloodOff")
+}
+
+func (n NetLink) LinkSetTxQLen(link netlink.Link, qlen int) error {
+	return withErrDescr(netlink.LinkSetTxQLen(link, qlen), "LinkSetTxQLen")
+}
+
 func (n NetLink) LinkGetProtinfo(link netlink.Link) (netlink.Protinfo, error) {
 	return netlink.LinkGetProtinfo(link)
 }
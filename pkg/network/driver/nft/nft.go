@@ -22,6 +22,7 @@ package nft
 import (
 	"fmt"
 	"os/exec"
+	"strings"
 )
 
 type NFTBin struct{}
@@ -54,6 +55,13 @@ func (n NFTBin) AddRule(family IPFamily, table, chain string, rulespec ...string
 	return execute(cmd)
 }
 
+// LoadRuleset loads the ruleset in a single transaction
+func (n NFTBin) LoadRuleset(ruleset string) error {
+	cmd := exec.Command(nftBin, "-f", "-")
+	cmd.Stdin = strings.NewReader(ruleset)
+	return execute(cmd)
+}
+
 func execute(cmd *exec.Cmd) error {
 	if output, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("%s, error: %v", string(output), err)
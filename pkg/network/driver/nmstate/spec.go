@@ -72,6 +72,10 @@ func (n NMState) Apply(spec *Spec) error {
 		}
 	}
 
+	if err := n.setupRoutes(spec.Routes.Config); err != nil {
+		return err
+	}
+
 	err := n.setupLinuxStack(spec.LinuxStack)
 
 	return err
@@ -194,6 +198,12 @@ func (n NMState) setupInterface(iface Interface, link vishnetlink.Link) error {
 		}
 	}
 
+	if iface.Tap != nil && iface.Tap.TxQueueLength > 0 && iface.Tap.TxQueueLength != link.Attrs().TxQLen {
+		if err := n.adapter.LinkSetTxQLen(link, iface.Tap.TxQueueLength); err != nil {
+			return err
+		}
+	}
+
 	if iface.Ethtool.Feature.TxChecksum != nil && !(*iface.Ethtool.Feature.TxChecksum) {
 		if err := n.adapter.TXChecksumOff(iface.Name); err != nil {
 			return err
@@ -229,6 +239,12 @@ func (n NMState) setupInterface(iface Interface, link vishnetlink.Link) error {
 			return err
 		}
 	}
+
+	if val := iface.LinuxStack.PortFlood; val != nil && !*val {
+		if err := n.adapter.LinkSetFloodOff(link); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -287,6 +303,37 @@ func initLink(ifaceName string, link vishnetlink.Link) (vishnetlink.Link, error)
 	return link, nil
 }
 
+func (n NMState) setupRoutes(routes []Route) error {
+	for _, route := range routes {
+		link, err := n.adapter.LinkByName(route.NextHopInterface)
+		if err != nil {
+			return fmt.Errorf("unable to find the next hop link of route [%s]: %v", route.Destination, err)
+		}
+		_, dst, err := net.ParseCIDR(route.Destination)
+		if err != nil {
+			return err
+		}
+		netlinkRoute := &vishnetlink.Route{
+			LinkIndex: link.Attrs().Index,
+			Dst:       dst,
+			Table:     route.TableID,
+		}
+
+		if route.State == RouteStateAbsent {
+			// Any route to the destination through the link is removed, whatever its scope is.
+			netlinkRoute.Scope = vishnetlink.SCOPE_NOWHERE
+			if err := n.adapter.RouteDel(netlinkRoute); err != nil && !errors.Is(err, unix.ESRCH) {
+				return fmt.Errorf("failed to delete route [%s]: %v", route.Destination, err)
+			}
+			continue
+		}
+		if err := n.adapter.RouteReplace(netlinkRoute); err != nil {
+			return fmt.Errorf("failed to setup route [%s]: %v", route.Destination, err)
+		}
+	}
+	return nil
+}
+
 func (n NMState) setupLinuxStack(linuxStack LinuxStack) error {
 	if val := linuxStack.IPv4.Forwarding; val != nil && *val {
 		if err := n.adapter.IPv4EnableForwarding(); err != nil {
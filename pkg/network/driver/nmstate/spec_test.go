@@ -556,3 +556,63 @@ var _ = Describe("NMState Spec Linux Stack", func() {
 		),
 	)
 })
+
+var _ = Describe("NMState Spec routes", func() {
+	const routeDestination = "10.10.10.20/32"
+
+	var nmState nmstate.NMState
+
+	BeforeEach(func() {
+		nmState = nmstate.New(nmstate.WithAdapter(newTestAdapter()))
+
+		Expect(nmState.Apply(&nmstate.Spec{
+			Interfaces: []nmstate.Interface{{
+				Name:       dummyName,
+				TypeName:   nmstate.TypeDummy,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: macAddress0,
+				MTU:        defaultMTU,
+			}},
+			Routes: nmstate.Routes{Config: []nmstate.Route{{
+				Destination:      routeDestination,
+				NextHopInterface: dummyName,
+				TableID:          nmstate.RouteTableLocal,
+			}}},
+		})).To(Succeed())
+	})
+
+	It("adds a route", func() {
+		status, err := nmState.Read()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Routes.Running).To(ConsistOf(nmstate.Route{
+			Destination:      routeDestination,
+			NextHopInterface: dummyName,
+			TableID:          nmstate.RouteTableLocal,
+		}))
+	})
+
+	It("deletes a route", func() {
+		Expect(nmState.Apply(&nmstate.Spec{
+			Routes: nmstate.Routes{Config: []nmstate.Route{{
+				Destination:      routeDestination,
+				NextHopInterface: dummyName,
+				TableID:          nmstate.RouteTableLocal,
+				State:            nmstate.RouteStateAbsent,
+			}}},
+		})).To(Succeed())
+
+		status, err := nmState.Read()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Routes.Running).To(BeEmpty())
+	})
+
+	It("ignores the deletion of a missing route", func() {
+		Expect(nmState.Apply(&nmstate.Spec{
+			Routes: nmstate.Routes{Config: []nmstate.Route{{
+				Destination:      "10.10.10.30/32",
+				NextHopInterface: dummyName,
+				State:            nmstate.RouteStateAbsent,
+			}}},
+		})).To(Succeed())
+	})
+})
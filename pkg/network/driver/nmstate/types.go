@@ -22,6 +22,8 @@ package nmstate
 import (
 	"net"
 
+	"golang.org/x/sys/unix"
+
 	vishnetlink "github.com/vishvananda/netlink"
 
 	"kubevirt.io/kubevirt/pkg/network/driver/ethtool"
@@ -32,6 +34,7 @@ import (
 
 type Spec struct {
 	Interfaces []Interface `json:"interfaces,omitempty"`
+	Routes     Routes      `json:"routes,omitempty"`
 	LinuxStack LinuxStack  `json:"linux-stack,omitempty"`
 }
 
@@ -73,6 +76,7 @@ type IPAddress struct {
 }
 
 type Routes struct {
+	Config  []Route `json:"config,omitempty"`
 	Running []Route `json:"running,omitempty"`
 }
 
@@ -81,6 +85,7 @@ type Route struct {
 	NextHopInterface string `json:"next-hop-interface,omitempty"`
 	NextHopAddress   string `json:"next-hop-address,omitempty"`
 	TableID          int    `json:"table-id,omitempty"`
+	State            string `json:"state,omitempty"`
 }
 
 type Ethtool struct {
@@ -92,14 +97,16 @@ type Feature struct {
 }
 
 type TapDevice struct {
-	Queues int `json:"queues,omitempty"`
-	UID    int `json:"UID,omitempty"`
-	GID    int `json:"GID,omitempty"`
+	Queues        int `json:"queues,omitempty"`
+	UID           int `json:"UID,omitempty"`
+	GID           int `json:"GID,omitempty"`
+	TxQueueLength int `json:"tx-queue-length,omitempty"`
 }
 
 type LinuxIfaceStack struct {
 	IP4RouteLocalNet *bool `json:"ip4-route-local-net,omitempty"`
 	PortLearning     *bool `json:"port-learning,omitempty"`
+	PortFlood        *bool `json:"port-flood,omitempty"`
 }
 
 type LinuxStack struct {
@@ -140,6 +147,8 @@ type adapter interface {
 	LinkSetMaster(vishnetlink.Link, *vishnetlink.Bridge) error
 	LinkSetName(vishnetlink.Link, string) error
 	LinkSetLearningOff(vishnetlink.Link) error
+	LinkSetFloodOff(vishnetlink.Link) error
+	LinkSetTxQLen(vishnetlink.Link, int) error
 	ReadTXChecksum(string) (bool, error)
 	TXChecksumOff(name string) error
 
@@ -148,6 +157,8 @@ type adapter interface {
 	AddrDel(vishnetlink.Link, *vishnetlink.Addr) error
 	ParseAddr(string) (*vishnetlink.Addr, error)
 	RouteList(vishnetlink.Link, int) ([]vishnetlink.Route, error)
+	RouteReplace(*vishnetlink.Route) error
+	RouteDel(*vishnetlink.Route) error
 
 	IPv4GetForwarding() (bool, error)
 	IPv4EnableForwarding() error
@@ -208,6 +219,12 @@ const (
 	IfaceStateAbsent  = "absent"
 )
 
+const (
+	RouteStateAbsent = "absent"
+
+	RouteTableLocal = unix.RT_TABLE_LOCAL
+)
+
 func AnyInterface(ifaces []Interface, predicate func(Interface) bool) bool {
 	return LookupInterface(ifaces, predicate) != nil
 }
@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package firewall
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// Annotation holds the ingress firewall of the interfaces of a VMI, as a JSON object
+// mapping the interface names to their InterfaceFirewall.
+// The interfaces which are not listed are not filtered.
+const Annotation = "network.kubevirt.io/ingress-firewall"
+
+const (
+	ProtocolTCP = "TCP"
+	ProtocolUDP = "UDP"
+)
+
+// Port is a port of the guest new connections are accepted on
+type Port struct {
+	// Protocol is TCP or UDP, TCP by default
+	Protocol string `json:"protocol,omitempty"`
+	Port     int32  `json:"port"`
+}
+
+// InterfaceFirewall is the ingress firewall of an interface.
+// An interface with no allowed ports accepts no new connections.
+type InterfaceFirewall struct {
+	AllowedPorts []Port `json:"allowedPorts,omitempty"`
+}
+
+// Config maps the interface names to their firewall
+type Config map[string]InterfaceFirewall
+
+// ConfigFromVMI reads the ingress firewall of the interfaces of the VMI from its annotation.
+// It returns an empty configuration when the annotation is not set.
+func ConfigFromVMI(vmi *v1.VirtualMachineInstance) (Config, error) {
+	value, exists := vmi.Annotations[Annotation]
+	if !exists {
+		return Config{}, nil
+	}
+
+	var config Config
+	if err := json.Unmarshal([]byte(value), &config); err != nil {
+		return nil, fmt.Errorf("invalid ingress firewall: %v", err)
+	}
+
+	for ifaceName, ifaceFirewall := range config {
+		if vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, ifaceName) == nil {
+			return nil, fmt.Errorf("invalid ingress firewall: interface %q does not exist", ifaceName)
+		}
+		for i, port := range ifaceFirewall.AllowedPorts {
+			switch protocol := strings.ToUpper(port.Protocol); protocol {
+			case "":
+				ifaceFirewall.AllowedPorts[i].Protocol = ProtocolTCP
+			case ProtocolTCP, ProtocolUDP:
+				ifaceFirewall.AllowedPorts[i].Protocol = protocol
+			default:
+				return nil, fmt.Errorf("invalid ingress firewall of interface %q: unsupported protocol %q", ifaceName, port.Protocol)
+			}
+			if port.Port < 1 || port.Port > 65535 {
+				return nil, fmt.Errorf("invalid ingress firewall of interface %q: invalid port %d", ifaceName, port.Port)
+			}
+		}
+	}
+	return config, nil
+}
@@ -0,0 +1,70 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package firewall_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/firewall"
+)
+
+var _ = Describe("Ingress firewall configuration", func() {
+	newVMI := func(annotations map[string]string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+		}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default"}, {Name: "isolated"}}
+		return vmi
+	}
+
+	It("should return an empty configuration without annotation", func() {
+		Expect(firewall.ConfigFromVMI(newVMI(nil))).To(BeEmpty())
+	})
+
+	It("should default the protocol to TCP", func() {
+		config, err := firewall.ConfigFromVMI(newVMI(map[string]string{
+			firewall.Annotation: `{"default": {"allowedPorts": [{"port": 22}, {"protocol": "udp", "port": 53}]}, "isolated": {}}`,
+		}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(Equal(firewall.Config{
+			"default": {AllowedPorts: []firewall.Port{
+				{Protocol: firewall.ProtocolTCP, Port: 22},
+				{Protocol: firewall.ProtocolUDP, Port: 53},
+			}},
+			"isolated": {},
+		}))
+	})
+
+	DescribeTable("should reject", func(value string) {
+		_, err := firewall.ConfigFromVMI(newVMI(map[string]string{firewall.Annotation: value}))
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("malformed JSON", `{"default": [22]}`),
+		Entry("an unknown interface", `{"unknown": {}}`),
+		Entry("an unsupported protocol", `{"default": {"allowedPorts": [{"protocol": "SCTP", "port": 22}]}}`),
+		Entry("a port out of range", `{"default": {"allowedPorts": [{"port": 65536}]}}`),
+		Entry("a missing port", `{"default": {"allowedPorts": [{"protocol": "TCP"}]}}`),
+	)
+})
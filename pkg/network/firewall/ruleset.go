@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package firewall
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const tableName = "kubevirt_firewall"
+
+// Device is a pod device the ingress traffic of an interface is filtered on
+type Device struct {
+	Name string
+	// Bridged is set when the traffic is bridged to the guest through the device (a tap device).
+	// Otherwise, the traffic is terminated in the pod by a user space forwarder (e.g. passt)
+	// and filtered on the pod interface it is received on.
+	Bridged  bool
+	Firewall InterfaceFirewall
+}
+
+// Ruleset returns the nftables ruleset enforcing the firewall of the devices.
+// The ruleset replaces the one previously loaded in a single transaction,
+// an empty list of devices removes it.
+func Ruleset(devices []Device) string {
+	devices = append([]Device{}, devices...)
+	sort.Slice(devices, func(i, j int) bool { return devices[i].Name < devices[j].Name })
+
+	var bridged, terminated []Device
+	for _, device := range devices {
+		if device.Bridged {
+			bridged = append(bridged, device)
+		} else {
+			terminated = append(terminated, device)
+		}
+	}
+
+	var ruleset strings.Builder
+	writeTable(&ruleset, "bridge", "forward", "oifname", bridged)
+	writeTable(&ruleset, "inet", "input", "iifname", terminated)
+	return ruleset.String()
+}
+
+func writeTable(ruleset *strings.Builder, family, hook, deviceMatch string, devices []Device) {
+	// Declaring the table first makes its deletion succeed when it does not exist yet
+	fmt.Fprintf(ruleset, "table %s %s\n", family, tableName)
+	fmt.Fprintf(ruleset, "delete table %s %s\n", family, tableName)
+	if len(devices) == 0 {
+		return
+	}
+
+	fmt.Fprintf(ruleset, "table %s %s {\n", family, tableName)
+	fmt.Fprintf(ruleset, "\tchain %s {\n", hook)
+	fmt.Fprintf(ruleset, "\t\ttype filter hook %s priority 0; policy accept;\n", hook)
+	for i, device := range devices {
+		fmt.Fprintf(ruleset, "\t\t%s %q jump ingress_%d\n", deviceMatch, device.Name, i)
+	}
+	fmt.Fprint(ruleset, "\t}\n")
+
+	for i, device := range devices {
+		fmt.Fprintf(ruleset, "\tchain ingress_%d {\n", i)
+		if family == "bridge" {
+			// ARP and the other non IP protocols
+			fmt.Fprint(ruleset, "\t\tether type != { ip, ip6 } accept\n")
+		}
+		fmt.Fprint(ruleset, "\t\tct state established,related accept\n")
+		fmt.Fprint(ruleset, "\t\tmeta l4proto { icmp, ipv6-icmp } accept\n")
+		if family == "bridge" {
+			// The replies of DHCP servers are broadcast and not tracked
+			fmt.Fprint(ruleset, "\t\tudp dport { 68, 546 } accept\n")
+		}
+		for _, protocol := range []string{ProtocolTCP, ProtocolUDP} {
+			if ports := portsByProtocol(device.Firewall.AllowedPorts, protocol); len(ports) > 0 {
+				fmt.Fprintf(ruleset, "\t\t%s dport { %s } accept\n", strings.ToLower(protocol), strings.Join(ports, ", "))
+			}
+		}
+		fmt.Fprint(ruleset, "\t\tdrop\n")
+		fmt.Fprint(ruleset, "\t}\n")
+	}
+	fmt.Fprint(ruleset, "}\n")
+}
+
+func portsByProtocol(allowedPorts []Port, protocol string) []string {
+	var ports []int
+	seen := map[int32]bool{}
+	for _, port := range allowedPorts {
+		if port.Protocol == protocol && !seen[port.Port] {
+			seen[port.Port] = true
+			ports = append(ports, int(port.Port))
+		}
+	}
+	sort.Ints(ports)
+
+	portStrings := make([]string, 0, len(ports))
+	for _, port := range ports {
+		portStrings = append(portStrings, strconv.Itoa(port))
+	}
+	return portStrings
+}
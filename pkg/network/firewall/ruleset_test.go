@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package firewall_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/firewall"
+)
+
+var _ = Describe("Ingress firewall ruleset", func() {
+	It("should remove the tables when there are no devices", func() {
+		Expect(firewall.Ruleset(nil)).To(Equal(
+			"table bridge kubevirt_firewall\n" +
+				"delete table bridge kubevirt_firewall\n" +
+				"table inet kubevirt_firewall\n" +
+				"delete table inet kubevirt_firewall\n",
+		))
+	})
+
+	It("should filter the bridged and the terminated traffic", func() {
+		Expect(firewall.Ruleset([]firewall.Device{
+			{
+				Name:     "eth0",
+				Firewall: firewall.InterfaceFirewall{AllowedPorts: []firewall.Port{{Protocol: firewall.ProtocolTCP, Port: 22}}},
+			},
+			{
+				Name:    "tap914f438d88d",
+				Bridged: true,
+			},
+			{
+				Name:    "tap0",
+				Bridged: true,
+				Firewall: firewall.InterfaceFirewall{AllowedPorts: []firewall.Port{
+					{Protocol: firewall.ProtocolTCP, Port: 80},
+					{Protocol: firewall.ProtocolUDP, Port: 53},
+					{Protocol: firewall.ProtocolTCP, Port: 443},
+					{Protocol: firewall.ProtocolTCP, Port: 80},
+				}},
+			},
+		})).To(Equal(`table bridge kubevirt_firewall
+delete table bridge kubevirt_firewall
+table bridge kubevirt_firewall {
+	chain forward {
+		type filter hook forward priority 0; policy accept;
+		oifname "tap0" jump ingress_0
+		oifname "tap914f438d88d" jump ingress_1
+	}
+	chain ingress_0 {
+		ether type != { ip, ip6 } accept
+		ct state established,related accept
+		meta l4proto { icmp, ipv6-icmp } accept
+		udp dport { 68, 546 } accept
+		tcp dport { 80, 443 } accept
+		udp dport { 53 } accept
+		drop
+	}
+	chain ingress_1 {
+		ether type != { ip, ip6 } accept
+		ct state established,related accept
+		meta l4proto { icmp, ipv6-icmp } accept
+		udp dport { 68, 546 } accept
+		drop
+	}
+}
+table inet kubevirt_firewall
+delete table inet kubevirt_firewall
+table inet kubevirt_firewall {
+	chain input {
+		type filter hook input priority 0; policy accept;
+		iifname "eth0" jump ingress_0
+	}
+	chain ingress_0 {
+		ct state established,related accept
+		meta l4proto { icmp, ipv6-icmp } accept
+		tcp dport { 22 } accept
+		drop
+	}
+}
+`))
+	})
+})
@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package flowexporter
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	flowLabels = []string{"namespace", "name", "protocol", "src_ip", "src_port", "dst_ip", "dst_port"}
+
+	flowBytesDesc = prometheus.NewDesc(
+		"kubevirt_vmi_network_flow_bytes_total",
+		"Total number of bytes of a connection of the VMI, in one direction.",
+		flowLabels, nil,
+	)
+	flowPacketsDesc = prometheus.NewDesc(
+		"kubevirt_vmi_network_flow_packets_total",
+		"Total number of packets of a connection of the VMI, in one direction.",
+		flowLabels, nil,
+	)
+)
+
+// Collector exposes the last collected flows as Prometheus metrics
+type Collector struct {
+	namespace string
+	name      string
+
+	lock  sync.Mutex
+	flows []Flow
+}
+
+func NewCollector(namespace, name string) *Collector {
+	return &Collector{
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+// Update replaces the exposed flows
+func (c *Collector) Update(flows []Flow) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.flows = flows
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- flowBytesDesc
+	ch <- flowPacketsDesc
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.Lock()
+	flows := c.flows
+	c.lock.Unlock()
+
+	// The same connection can be listed twice while the kernel updates its table
+	seen := map[string]bool{}
+	for _, flow := range flows {
+		labels := []string{
+			c.namespace,
+			c.name,
+			flow.ProtocolName(),
+			flow.SrcIP.String(),
+			strconv.Itoa(int(flow.SrcPort)),
+			flow.DstIP.String(),
+			strconv.Itoa(int(flow.DstPort)),
+		}
+		key := labels[2] + "/" + labels[3] + "/" + labels[4] + "/" + labels[5] + "/" + labels[6]
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		ch <- prometheus.MustNewConstMetric(flowBytesDesc, prometheus.CounterValue, float64(flow.Bytes), labels...)
+		ch <- prometheus.MustNewConstMetric(flowPacketsDesc, prometheus.CounterValue, float64(flow.Packets), labels...)
+	}
+}
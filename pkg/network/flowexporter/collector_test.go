@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package flowexporter_test
+
+import (
+	"net"
+	"syscall"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+
+	"kubevirt.io/kubevirt/pkg/network/flowexporter"
+)
+
+var _ = Describe("Flow collector", func() {
+	gather := func(collector *flowexporter.Collector) map[string][]*ioprometheusclient.Metric {
+		registry := prometheus.NewRegistry()
+		Expect(registry.Register(collector)).To(Succeed())
+		families, err := registry.Gather()
+		Expect(err).ToNot(HaveOccurred())
+
+		metrics := map[string][]*ioprometheusclient.Metric{}
+		for _, family := range families {
+			metrics[family.GetName()] = family.GetMetric()
+		}
+		return metrics
+	}
+
+	labels := func(metric *ioprometheusclient.Metric) map[string]string {
+		result := map[string]string{}
+		for _, label := range metric.GetLabel() {
+			result[label.GetName()] = label.GetValue()
+		}
+		return result
+	}
+
+	It("should expose the bytes and packets of the flows", func() {
+		collector := flowexporter.NewCollector("default", "testvmi")
+		flow := flowexporter.Flow{
+			Protocol: syscall.IPPROTO_UDP,
+			SrcIP:    net.ParseIP("10.0.2.2"),
+			DstIP:    net.ParseIP("192.0.2.1"),
+			SrcPort:  5353,
+			DstPort:  53,
+			Bytes:    300,
+			Packets:  3,
+		}
+		collector.Update([]flowexporter.Flow{flow, flow})
+
+		metrics := gather(collector)
+		Expect(metrics["kubevirt_vmi_network_flow_bytes_total"]).To(HaveLen(1))
+		bytes := metrics["kubevirt_vmi_network_flow_bytes_total"][0]
+		Expect(bytes.GetCounter().GetValue()).To(Equal(float64(300)))
+		Expect(labels(bytes)).To(Equal(map[string]string{
+			"namespace": "default",
+			"name":      "testvmi",
+			"protocol":  "udp",
+			"src_ip":    "10.0.2.2",
+			"src_port":  "5353",
+			"dst_ip":    "192.0.2.1",
+			"dst_port":  "53",
+		}))
+		Expect(metrics["kubevirt_vmi_network_flow_packets_total"]).To(HaveLen(1))
+		Expect(metrics["kubevirt_vmi_network_flow_packets_total"][0].GetCounter().GetValue()).To(Equal(float64(3)))
+	})
+
+	It("should replace the flows on update", func() {
+		collector := flowexporter.NewCollector("default", "testvmi")
+		collector.Update([]flowexporter.Flow{{Protocol: syscall.IPPROTO_TCP, SrcIP: net.ParseIP("10.0.2.2"), DstIP: net.ParseIP("192.0.2.1")}})
+		collector.Update(nil)
+		Expect(gather(collector)).To(BeEmpty())
+	})
+})
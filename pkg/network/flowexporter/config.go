@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package flowexporter
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// EnabledAnnotation enables the flow exporter of the network binding sidecars of a VMI
+	EnabledAnnotation = "network.kubevirt.io/flow-exporter"
+	// IPFIXCollectorAnnotation is the host:port of an IPFIX collector the flows are exported to over UDP
+	IPFIXCollectorAnnotation = "network.kubevirt.io/flow-exporter-ipfix-collector"
+	// IntervalAnnotation overrides the interval the flows are collected at
+	IntervalAnnotation = "network.kubevirt.io/flow-exporter-interval"
+
+	// MetricsPort is the port the flow metrics are served on in the virt-launcher pod
+	MetricsPort = 9445
+	// MetricsPath is the path the flow metrics are served on
+	MetricsPath = "/metrics"
+
+	defaultInterval = 30 * time.Second
+	minInterval     = 5 * time.Second
+)
+
+// Config is the flow exporter configuration of a VMI
+type Config struct {
+	Namespace      string
+	Name           string
+	UID            string
+	IPFIXCollector string
+	Interval       time.Duration
+}
+
+// IsEnabled returns true if the VMI requests the flow exporter of its network binding sidecars
+func IsEnabled(vmi *v1.VirtualMachineInstance) bool {
+	return strings.EqualFold(vmi.Annotations[EnabledAnnotation], "true")
+}
+
+// ConfigFromVMI reads the flow exporter configuration from the annotations of the VMI
+func ConfigFromVMI(vmi *v1.VirtualMachineInstance) (Config, error) {
+	config := Config{
+		Namespace: vmi.Namespace,
+		Name:      vmi.Name,
+		UID:       string(vmi.UID),
+		Interval:  defaultInterval,
+	}
+
+	if collector, exists := vmi.Annotations[IPFIXCollectorAnnotation]; exists {
+		if _, _, err := net.SplitHostPort(collector); err != nil {
+			return Config{}, fmt.Errorf("invalid IPFIX collector %q: %v", collector, err)
+		}
+		config.IPFIXCollector = collector
+	}
+
+	if interval, exists := vmi.Annotations[IntervalAnnotation]; exists {
+		duration, err := time.ParseDuration(interval)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid flow exporter interval %q: %v", interval, err)
+		}
+		if duration < minInterval {
+			return Config{}, fmt.Errorf("flow exporter interval %s is shorter than %s", duration, minInterval)
+		}
+		config.Interval = duration
+	}
+
+	return config, nil
+}
@@ -0,0 +1,84 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package flowexporter_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/flowexporter"
+)
+
+var _ = Describe("Flow exporter configuration", func() {
+	newVMI := func(annotations map[string]string) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "testvmi",
+				Namespace:   "default",
+				UID:         "1234",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	DescribeTable("IsEnabled", func(annotations map[string]string, expected bool) {
+		Expect(flowexporter.IsEnabled(newVMI(annotations))).To(Equal(expected))
+	},
+		Entry("without annotation", nil, false),
+		Entry("with the annotation set to true", map[string]string{flowexporter.EnabledAnnotation: "True"}, true),
+		Entry("with the annotation set to false", map[string]string{flowexporter.EnabledAnnotation: "false"}, false),
+	)
+
+	It("should default the configuration", func() {
+		config, err := flowexporter.ConfigFromVMI(newVMI(map[string]string{flowexporter.EnabledAnnotation: "true"}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(Equal(flowexporter.Config{
+			Namespace: "default",
+			Name:      "testvmi",
+			UID:       "1234",
+			Interval:  30 * time.Second,
+		}))
+	})
+
+	It("should read the IPFIX collector and the interval", func() {
+		config, err := flowexporter.ConfigFromVMI(newVMI(map[string]string{
+			flowexporter.IPFIXCollectorAnnotation: "collector.example.com:4739",
+			flowexporter.IntervalAnnotation:       "1m",
+		}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.IPFIXCollector).To(Equal("collector.example.com:4739"))
+		Expect(config.Interval).To(Equal(time.Minute))
+	})
+
+	DescribeTable("should reject", func(annotations map[string]string) {
+		_, err := flowexporter.ConfigFromVMI(newVMI(annotations))
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("an IPFIX collector without port", map[string]string{flowexporter.IPFIXCollectorAnnotation: "collector.example.com"}),
+		Entry("an invalid interval", map[string]string{flowexporter.IntervalAnnotation: "often"}),
+		Entry("a too short interval", map[string]string{flowexporter.IntervalAnnotation: "1s"}),
+	)
+})
@@ -0,0 +1,157 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package flowexporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// Exporter periodically collects the flows of a Source, exposes them as metrics and exports
+// them to the optional IPFIX collector
+type Exporter struct {
+	config    Config
+	source    Source
+	collector *Collector
+	encoder   *IPFIXEncoder
+	ipfixConn net.Conn
+}
+
+func NewExporter(config Config, source Source) *Exporter {
+	return &Exporter{
+		config:    config,
+		source:    source,
+		collector: NewCollector(config.Namespace, config.Name),
+		encoder:   NewIPFIXEncoder(ObservationDomainID(config.UID)),
+	}
+}
+
+// ObservationDomainID returns the IPFIX observation domain of a VMI, derived from its UID
+func ObservationDomainID(uid string) uint32 {
+	hash := fnv.New32a()
+	hash.Write([]byte(uid))
+	return hash.Sum32()
+}
+
+// Run serves the metrics and collects the flows until stop is closed
+func (e *Exporter) Run(stop <-chan struct{}) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(e.collector)
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", MetricsPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Log.Reason(err).Error("flow exporter failed to serve the metrics")
+		}
+	}()
+	defer server.Shutdown(context.Background())
+
+	if e.config.IPFIXCollector != "" {
+		conn, err := net.Dial("udp", e.config.IPFIXCollector)
+		if err != nil {
+			log.Log.Reason(err).Errorf("flow exporter failed to connect to the IPFIX collector %s", e.config.IPFIXCollector)
+		} else {
+			e.ipfixConn = conn
+			defer conn.Close()
+		}
+	}
+
+	log.Log.Infof("flow exporter of VMI %s/%s started, IPFIX observation domain %d",
+		e.config.Namespace, e.config.Name, ObservationDomainID(e.config.UID))
+
+	ticker := time.NewTicker(e.config.Interval)
+	defer ticker.Stop()
+	for {
+		e.export()
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Exporter) export() {
+	flows, err := e.source.Flows()
+	if err != nil {
+		log.Log.Reason(err).Warning("flow exporter failed to list the flows")
+		return
+	}
+	flows = topFlows(flows)
+	e.collector.Update(flows)
+
+	if e.ipfixConn == nil {
+		return
+	}
+	for _, message := range e.encoder.Encode(flows, time.Now()) {
+		if _, err := e.ipfixConn.Write(message); err != nil {
+			log.Log.Reason(err).Warning("flow exporter failed to send the flows to the IPFIX collector")
+			return
+		}
+	}
+}
+
+// Launcher starts the flow exporter of a network binding sidecar once, when the sidecar first
+// learns about its VMI
+type Launcher struct {
+	once sync.Once
+	stop chan struct{}
+}
+
+func NewLauncher() *Launcher {
+	return &Launcher{stop: make(chan struct{})}
+}
+
+// Start runs the flow exporter in the background if the VMI enables it
+func (l *Launcher) Start(vmi *v1.VirtualMachineInstance) error {
+	if !IsEnabled(vmi) {
+		return nil
+	}
+	config, err := ConfigFromVMI(vmi)
+	if err != nil {
+		return err
+	}
+	l.once.Do(func() {
+		go NewExporter(config, NewConntrackSource()).Run(l.stop)
+	})
+	return nil
+}
+
+// Stop stops the flow exporter
+func (l *Launcher) Stop() {
+	close(l.stop)
+}
@@ -0,0 +1,114 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package flowexporter
+
+import (
+	"net"
+	"sort"
+	"strconv"
+	"syscall"
+
+	"github.com/vishvananda/netlink"
+)
+
+// MaxFlows is the maximum number of flows exported at every interval, the flows with the
+// most bytes are kept
+const MaxFlows = 500
+
+// Flow is the accounting of one direction of a connection
+type Flow struct {
+	Protocol uint8
+	SrcIP    net.IP
+	DstIP    net.IP
+	SrcPort  uint16
+	DstPort  uint16
+	Bytes    uint64
+	Packets  uint64
+}
+
+// ProtocolName returns the name of the IP protocol of the flow
+func (f Flow) ProtocolName() string {
+	switch f.Protocol {
+	case syscall.IPPROTO_ICMP:
+		return "icmp"
+	case syscall.IPPROTO_TCP:
+		return "tcp"
+	case syscall.IPPROTO_UDP:
+		return "udp"
+	case syscall.IPPROTO_SCTP:
+		return "sctp"
+	case syscall.IPPROTO_ICMPV6:
+		return "icmpv6"
+	}
+	return strconv.Itoa(int(f.Protocol))
+}
+
+// Source lists the flows of the network namespace of the sidecar
+type Source interface {
+	Flows() ([]Flow, error)
+}
+
+type conntrackSource struct{}
+
+// NewConntrackSource returns a Source listing the connections tracked by netfilter, which
+// requires CAP_NET_ADMIN. The byte and packet counters are only maintained by the kernel when
+// the net.netfilter.nf_conntrack_acct sysctl is set.
+func NewConntrackSource() Source {
+	return conntrackSource{}
+}
+
+func (conntrackSource) Flows() ([]Flow, error) {
+	var flows []Flow
+	for _, family := range []netlink.InetFamily{netlink.FAMILY_V4, netlink.FAMILY_V6} {
+		connections, err := netlink.ConntrackTableList(netlink.ConntrackTable, family)
+		if err != nil {
+			return nil, err
+		}
+		for _, connection := range connections {
+			flows = append(flows, flowFromTuple(connection.Forward), flowFromTuple(connection.Reverse))
+		}
+	}
+	return flows, nil
+}
+
+func flowFromTuple(tuple netlink.IPTuple) Flow {
+	return Flow{
+		Protocol: tuple.Protocol,
+		SrcIP:    tuple.SrcIP,
+		DstIP:    tuple.DstIP,
+		SrcPort:  tuple.SrcPort,
+		DstPort:  tuple.DstPort,
+		Bytes:    tuple.Bytes,
+		Packets:  tuple.Packets,
+	}
+}
+
+// topFlows returns the MaxFlows flows with the most bytes
+func topFlows(flows []Flow) []Flow {
+	if len(flows) <= MaxFlows {
+		return flows
+	}
+	sorted := make([]Flow, len(flows))
+	copy(sorted, flows)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Bytes > sorted[j].Bytes
+	})
+	return sorted[:MaxFlows]
+}
@@ -0,0 +1,196 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package flowexporter
+
+import (
+	"encoding/binary"
+	"net"
+	"time"
+)
+
+// IPFIX (RFC 7011) encoding of the flows. Every message carries the templates of the data sets
+// it holds, so that collectors can decode the messages whatever the order they receive them in.
+
+const (
+	ipfixVersion      = 10
+	ipfixHeaderLength = 16
+	ipfixSetHeaderLen = 4
+	ipfixTemplateSet  = 2
+
+	ipv4TemplateID = 256
+	ipv6TemplateID = 257
+
+	// maxMessageLength keeps the messages under the usual MTU, UDP datagrams can't be fragmented
+	// by the collectors
+	maxMessageLength = 1400
+)
+
+// Information elements of the IANA IPFIX registry
+const (
+	ieProtocolIdentifier       = 4
+	ieSourceTransportPort      = 7
+	ieSourceIPv4Address        = 8
+	ieDestinationTransportPort = 11
+	ieDestinationIPv4Address   = 12
+	ieSourceIPv6Address        = 27
+	ieDestinationIPv6Address   = 28
+	ieOctetTotalCount          = 85
+	iePacketTotalCount         = 86
+)
+
+type templateField struct {
+	id     uint16
+	length uint16
+}
+
+type template struct {
+	id     uint16
+	fields []templateField
+}
+
+var (
+	ipv4Template = template{
+		id: ipv4TemplateID,
+		fields: []templateField{
+			{ieSourceIPv4Address, net.IPv4len},
+			{ieDestinationIPv4Address, net.IPv4len},
+			{ieSourceTransportPort, 2},
+			{ieDestinationTransportPort, 2},
+			{ieProtocolIdentifier, 1},
+			{ieOctetTotalCount, 8},
+			{iePacketTotalCount, 8},
+		},
+	}
+	ipv6Template = template{
+		id: ipv6TemplateID,
+		fields: []templateField{
+			{ieSourceIPv6Address, net.IPv6len},
+			{ieDestinationIPv6Address, net.IPv6len},
+			{ieSourceTransportPort, 2},
+			{ieDestinationTransportPort, 2},
+			{ieProtocolIdentifier, 1},
+			{ieOctetTotalCount, 8},
+			{iePacketTotalCount, 8},
+		},
+	}
+)
+
+func (t template) recordLength() int {
+	length := 0
+	for _, field := range t.fields {
+		length += int(field.length)
+	}
+	return length
+}
+
+func (t template) setLength() int {
+	return ipfixSetHeaderLen + 4 + 4*len(t.fields)
+}
+
+func (t template) appendSet(b []byte) []byte {
+	b = binary.BigEndian.AppendUint16(b, ipfixTemplateSet)
+	b = binary.BigEndian.AppendUint16(b, uint16(t.setLength()))
+	b = binary.BigEndian.AppendUint16(b, t.id)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(t.fields)))
+	for _, field := range t.fields {
+		b = binary.BigEndian.AppendUint16(b, field.id)
+		b = binary.BigEndian.AppendUint16(b, field.length)
+	}
+	return b
+}
+
+func (t template) appendRecord(b []byte, flow Flow) []byte {
+	if t.id == ipv4TemplateID {
+		b = append(b, flow.SrcIP.To4()...)
+		b = append(b, flow.DstIP.To4()...)
+	} else {
+		b = append(b, flow.SrcIP.To16()...)
+		b = append(b, flow.DstIP.To16()...)
+	}
+	b = binary.BigEndian.AppendUint16(b, flow.SrcPort)
+	b = binary.BigEndian.AppendUint16(b, flow.DstPort)
+	b = append(b, flow.Protocol)
+	b = binary.BigEndian.AppendUint64(b, flow.Bytes)
+	b = binary.BigEndian.AppendUint64(b, flow.Packets)
+	return b
+}
+
+// IPFIXEncoder encodes flows into IPFIX messages of an observation domain
+type IPFIXEncoder struct {
+	observationDomainID uint32
+	sequenceNumber      uint32
+}
+
+func NewIPFIXEncoder(observationDomainID uint32) *IPFIXEncoder {
+	return &IPFIXEncoder{observationDomainID: observationDomainID}
+}
+
+// Encode returns the IPFIX messages holding the flows
+func (e *IPFIXEncoder) Encode(flows []Flow, exportTime time.Time) [][]byte {
+	var ipv4Flows, ipv6Flows []Flow
+	for _, flow := range flows {
+		if flow.SrcIP.To4() != nil && flow.DstIP.To4() != nil {
+			ipv4Flows = append(ipv4Flows, flow)
+		} else if flow.SrcIP.To16() != nil && flow.DstIP.To16() != nil {
+			ipv6Flows = append(ipv6Flows, flow)
+		}
+	}
+
+	var messages [][]byte
+	messages = append(messages, e.encodeTemplate(ipv4Template, ipv4Flows, exportTime)...)
+	messages = append(messages, e.encodeTemplate(ipv6Template, ipv6Flows, exportTime)...)
+	return messages
+}
+
+func (e *IPFIXEncoder) encodeTemplate(t template, flows []Flow, exportTime time.Time) [][]byte {
+	recordsPerMessage := (maxMessageLength - ipfixHeaderLength - t.setLength() - ipfixSetHeaderLen) / t.recordLength()
+
+	var messages [][]byte
+	for len(flows) > 0 {
+		count := min(len(flows), recordsPerMessage)
+		messages = append(messages, e.encodeMessage(t, flows[:count], exportTime))
+		flows = flows[count:]
+	}
+	return messages
+}
+
+func (e *IPFIXEncoder) encodeMessage(t template, flows []Flow, exportTime time.Time) []byte {
+	dataSetLength := ipfixSetHeaderLen + len(flows)*t.recordLength()
+	length := ipfixHeaderLength + t.setLength() + dataSetLength
+
+	b := make([]byte, 0, length)
+	b = binary.BigEndian.AppendUint16(b, ipfixVersion)
+	b = binary.BigEndian.AppendUint16(b, uint16(length))
+	b = binary.BigEndian.AppendUint32(b, uint32(exportTime.Unix()))
+	b = binary.BigEndian.AppendUint32(b, e.sequenceNumber)
+	b = binary.BigEndian.AppendUint32(b, e.observationDomainID)
+
+	b = t.appendSet(b)
+
+	b = binary.BigEndian.AppendUint16(b, t.id)
+	b = binary.BigEndian.AppendUint16(b, uint16(dataSetLength))
+	for _, flow := range flows {
+		b = t.appendRecord(b, flow)
+	}
+
+	// The sequence number counts the data records sent in the observation domain
+	e.sequenceNumber += uint32(len(flows))
+	return b
+}
@@ -0,0 +1,114 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package flowexporter_test
+
+import (
+	"encoding/binary"
+	"net"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/flowexporter"
+)
+
+var _ = Describe("IPFIX encoder", func() {
+	exportTime := time.Unix(1700000000, 0)
+
+	newFlow := func(src, dst string, bytes uint64) flowexporter.Flow {
+		return flowexporter.Flow{
+			Protocol: syscall.IPPROTO_TCP,
+			SrcIP:    net.ParseIP(src),
+			DstIP:    net.ParseIP(dst),
+			SrcPort:  40000,
+			DstPort:  443,
+			Bytes:    bytes,
+			Packets:  10,
+		}
+	}
+
+	It("should encode IPv4 flows with their template", func() {
+		encoder := flowexporter.NewIPFIXEncoder(42)
+		messages := encoder.Encode([]flowexporter.Flow{newFlow("10.0.2.2", "192.0.2.1", 1500)}, exportTime)
+		Expect(messages).To(HaveLen(1))
+		message := messages[0]
+
+		By("checking the header")
+		Expect(binary.BigEndian.Uint16(message[0:])).To(Equal(uint16(10)))
+		Expect(binary.BigEndian.Uint16(message[2:])).To(Equal(uint16(len(message))))
+		Expect(binary.BigEndian.Uint32(message[4:])).To(Equal(uint32(1700000000)))
+		Expect(binary.BigEndian.Uint32(message[8:])).To(Equal(uint32(0)))
+		Expect(binary.BigEndian.Uint32(message[12:])).To(Equal(uint32(42)))
+
+		By("checking the template set")
+		templateSet := message[16:]
+		Expect(binary.BigEndian.Uint16(templateSet[0:])).To(Equal(uint16(2)))
+		templateSetLength := binary.BigEndian.Uint16(templateSet[2:])
+		Expect(binary.BigEndian.Uint16(templateSet[4:])).To(Equal(uint16(256)))
+		Expect(binary.BigEndian.Uint16(templateSet[6:])).To(Equal(uint16(7)))
+
+		By("checking the data set")
+		dataSet := templateSet[templateSetLength:]
+		Expect(binary.BigEndian.Uint16(dataSet[0:])).To(Equal(uint16(256)))
+		Expect(binary.BigEndian.Uint16(dataSet[2:])).To(Equal(uint16(len(dataSet))))
+		record := dataSet[4:]
+		Expect(net.IP(record[0:4]).String()).To(Equal("10.0.2.2"))
+		Expect(net.IP(record[4:8]).String()).To(Equal("192.0.2.1"))
+		Expect(binary.BigEndian.Uint16(record[8:])).To(Equal(uint16(40000)))
+		Expect(binary.BigEndian.Uint16(record[10:])).To(Equal(uint16(443)))
+		Expect(record[12]).To(Equal(uint8(syscall.IPPROTO_TCP)))
+		Expect(binary.BigEndian.Uint64(record[13:])).To(Equal(uint64(1500)))
+		Expect(binary.BigEndian.Uint64(record[21:])).To(Equal(uint64(10)))
+	})
+
+	It("should encode IPv6 flows with their own template", func() {
+		encoder := flowexporter.NewIPFIXEncoder(42)
+		messages := encoder.Encode([]flowexporter.Flow{
+			newFlow("10.0.2.2", "192.0.2.1", 1500),
+			newFlow("fd10:0:2::2", "2001:db8::1", 1500),
+		}, exportTime)
+		Expect(messages).To(HaveLen(2))
+		Expect(binary.BigEndian.Uint16(messages[1][20:])).To(Equal(uint16(257)))
+	})
+
+	It("should split the flows over several messages and count them in the sequence number", func() {
+		encoder := flowexporter.NewIPFIXEncoder(42)
+		flows := make([]flowexporter.Flow, 100)
+		for i := range flows {
+			flows[i] = newFlow("10.0.2.2", "192.0.2.1", uint64(i))
+		}
+
+		messages := encoder.Encode(flows, exportTime)
+		Expect(len(messages)).To(BeNumerically(">", 1))
+		for _, message := range messages {
+			Expect(len(message)).To(BeNumerically("<=", 1400))
+		}
+		Expect(binary.BigEndian.Uint32(messages[1][8:])).To(BeNumerically(">", 0))
+
+		messages = encoder.Encode(flows[:1], exportTime)
+		Expect(binary.BigEndian.Uint32(messages[0][8:])).To(Equal(uint32(100)))
+	})
+
+	It("should encode no message without flows", func() {
+		Expect(flowexporter.NewIPFIXEncoder(42).Encode(nil, exportTime)).To(BeEmpty())
+	})
+})
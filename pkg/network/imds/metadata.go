@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package imds
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
+	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/util/net/dns"
+)
+
+const (
+	// Annotation enables the metadata service of a VMI
+	Annotation = "network.kubevirt.io/metadata-service"
+
+	// Address is the link-local address the guest reaches the metadata service on
+	Address = "169.254.169.254"
+	// Port is the port the metadata service listens on
+	Port = 80
+)
+
+// IsEnabled returns true if the VMI requests a metadata service
+func IsEnabled(vmi *v1.VirtualMachineInstance) bool {
+	return strings.EqualFold(vmi.Annotations[Annotation], "true")
+}
+
+// GatewayAddress returns the IPv4 gateway of the masquerade interface of the pod network, which the
+// requests of the guest to the metadata service are redirected to
+func GatewayAddress(vmi *v1.VirtualMachineInstance) (string, error) {
+	podNetwork := vmispec.LookupPodNetwork(vmi.Spec.Networks)
+	if podNetwork == nil {
+		return "", errors.New("the metadata service requires the pod network")
+	}
+	iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, podNetwork.Name)
+	if iface == nil || iface.Masquerade == nil {
+		return "", errors.New("the metadata service requires the masquerade binding on the pod network")
+	}
+	gateway, _, err := link.GenerateMasqueradeGatewayAndVmIPAddrs(podNetwork, netdriver.IPv4)
+	if err != nil {
+		return "", err
+	}
+	return gateway.IP.String(), nil
+}
+
+// Metadata is what the metadata service serves to the guest
+type Metadata struct {
+	InstanceID   string
+	InstanceType string
+	Hostname     string
+	// PublicKeys are the SSH public keys of the guest by name
+	PublicKeys map[string]string
+	// Tags are the labels of the VMI, the keys with a prefix are left out as they can't be part of a path
+	Tags     map[string]string
+	UserData string
+}
+
+// NewMetadata collects the metadata of the VMI from its cloud-init data, which may be nil
+func NewMetadata(vmi *v1.VirtualMachineInstance, cloudInitData *cloudinit.CloudInitData) *Metadata {
+	metadata := &Metadata{
+		InstanceID: string(vmi.UID),
+		Hostname:   dns.SanitizeHostname(vmi),
+		Tags:       map[string]string{},
+	}
+	if vmi.Spec.Domain.Firmware != nil && vmi.Spec.Domain.Firmware.UUID != "" {
+		metadata.InstanceID = string(vmi.Spec.Domain.Firmware.UUID)
+	}
+	for key, value := range vmi.Labels {
+		if !strings.Contains(key, "/") {
+			metadata.Tags[key] = value
+		}
+	}
+
+	if cloudInitData == nil {
+		return metadata
+	}
+	metadata.UserData = cloudInitData.UserData
+	if noCloud := cloudInitData.NoCloudMetaData; noCloud != nil {
+		metadata.InstanceID = noCloud.InstanceID
+		metadata.InstanceType = noCloud.InstanceType
+		metadata.PublicKeys = noCloud.PublicSSHKeys
+	}
+	if configDrive := cloudInitData.ConfigDriveMetaData; configDrive != nil {
+		metadata.InstanceID = configDrive.InstanceID
+		metadata.InstanceType = configDrive.InstanceType
+		metadata.PublicKeys = configDrive.PublicSSHKeys
+	}
+	return metadata
+}
+
+// publicKeyNames returns the names of the public keys, the index of a name is the index of its key
+// in the metadata tree
+func (m *Metadata) publicKeyNames() []string {
+	names := make([]string, 0, len(m.PublicKeys))
+	for name := range m.PublicKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
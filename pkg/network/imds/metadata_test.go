@@ -0,0 +1,102 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package imds
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
+)
+
+var _ = Describe("Metadata", func() {
+	var vmi *v1.VirtualMachineInstance
+
+	BeforeEach(func() {
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testvmi",
+				Namespace: "default",
+				UID:       "1234",
+				Labels: map[string]string{
+					"app":                "web",
+					"kubevirt.io/domain": "testvmi",
+				},
+			},
+		}
+	})
+
+	It("should be enabled by the annotation only", func() {
+		Expect(IsEnabled(vmi)).To(BeFalse())
+		vmi.Annotations = map[string]string{Annotation: "true"}
+		Expect(IsEnabled(vmi)).To(BeTrue())
+	})
+
+	It("should redirect to the gateway of the masquerade interface of the pod network", func() {
+		vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		Expect(GatewayAddress(vmi)).To(Equal("10.0.2.1"))
+
+		vmi.Spec.Networks[0].Pod.VMNetworkCIDR = "10.10.10.0/24"
+		Expect(GatewayAddress(vmi)).To(Equal("10.10.10.1"))
+	})
+
+	It("should require the masquerade binding on the pod network", func() {
+		vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+		_, err := GatewayAddress(vmi)
+		Expect(err).To(MatchError(ContainSubstring("requires the masquerade binding")))
+	})
+
+	It("should describe a VMI without cloud-init data", func() {
+		vmi.Spec.Domain.Firmware = &v1.Firmware{UUID: "5678"}
+
+		Expect(NewMetadata(vmi, nil)).To(Equal(&Metadata{
+			InstanceID: "5678",
+			Hostname:   "testvmi",
+			Tags:       map[string]string{"app": "web"},
+		}))
+	})
+
+	It("should take the instance, the keys and the user data from cloud-init", func() {
+		cloudInitData := &cloudinit.CloudInitData{
+			DataSource: cloudinit.DataSourceNoCloud,
+			UserData:   "#cloud-config",
+			NoCloudMetaData: &cloudinit.NoCloudMetadata{
+				InstanceID:    "5678",
+				InstanceType:  "u1.small",
+				PublicSSHKeys: map[string]string{"key": "ssh-rsa AAAA"},
+			},
+		}
+
+		Expect(NewMetadata(vmi, cloudInitData)).To(Equal(&Metadata{
+			InstanceID:   "5678",
+			InstanceType: "u1.small",
+			Hostname:     "testvmi",
+			PublicKeys:   map[string]string{"key": "ssh-rsa AAAA"},
+			Tags:         map[string]string{"app": "web"},
+			UserData:     "#cloud-config",
+		}))
+	})
+})
@@ -0,0 +1,248 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package imds
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	tokenPath      = "/latest/api/token"
+	tokenHeader    = "X-aws-ec2-metadata-token"
+	tokenTTLHeader = "X-aws-ec2-metadata-token-ttl-seconds"
+	maxTokenTTL    = 6 * time.Hour
+	// maxTokens bounds the tokens kept for a guest issuing tokens in a loop
+	maxTokens = 1024
+
+	readHeaderTimeout = 10 * time.Second
+)
+
+// Server serves the metadata of a VMI to its guest, laid out like the EC2 instance metadata service.
+// Every request needs a session token obtained with a PUT request to /latest/api/token, as with
+// IMDSv2, so that a guest service tricked into fetching a URL can't leak the metadata.
+type Server struct {
+	metadata *Metadata
+
+	lock   sync.Mutex
+	tokens map[string]time.Time
+	now    func() time.Time
+}
+
+func NewServer(metadata *Metadata) *Server {
+	return &Server{
+		metadata: metadata,
+		tokens:   map[string]time.Time{},
+		now:      time.Now,
+	}
+}
+
+// Start listens on the given address and serves the metadata in the background
+func (s *Server) Start(address string) error {
+	listener, err := net.Listen("tcp", net.JoinHostPort(address, strconv.Itoa(Port)))
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Log.Reason(err).Error("metadata service failed")
+		}
+	}()
+	return nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Requests relayed by a proxy in the guest are not trusted
+	if r.Header.Get("X-Forwarded-For") != "" {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	if r.URL.Path == tokenPath {
+		if r.Method != http.MethodPut {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		s.issueToken(w, r)
+		return
+	}
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.isValidToken(r.Header.Get(tokenHeader)) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	content, found := s.lookup(r.URL.Path)
+	if !found {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte(content))
+}
+
+func (s *Server) issueToken(w http.ResponseWriter, r *http.Request) {
+	ttlSeconds, err := strconv.Atoi(r.Header.Get(tokenTTLHeader))
+	if err != nil || ttlSeconds < 1 || time.Duration(ttlSeconds)*time.Second > maxTokenTTL {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	token := base64.RawURLEncoding.EncodeToString(tokenBytes)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := s.now()
+	for t, expiry := range s.tokens {
+		if !now.Before(expiry) {
+			delete(s.tokens, t)
+		}
+	}
+	if len(s.tokens) >= maxTokens {
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+		return
+	}
+	s.tokens[token] = now.Add(time.Duration(ttlSeconds) * time.Second)
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.Header().Set(tokenTTLHeader, strconv.Itoa(ttlSeconds))
+	_, _ = w.Write([]byte(token))
+}
+
+func (s *Server) isValidToken(token string) bool {
+	if token == "" {
+		return false
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	expiry, exists := s.tokens[token]
+	return exists && s.now().Before(expiry)
+}
+
+// lookup returns the content of a path of the metadata tree, directories list their entries
+func (s *Server) lookup(path string) (string, bool) {
+	path = strings.TrimSuffix(path, "/")
+	switch path {
+	case "/latest":
+		if s.metadata.UserData == "" {
+			return "meta-data/", true
+		}
+		return "meta-data/\nuser-data", true
+	case "/latest/user-data":
+		return s.metadata.UserData, s.metadata.UserData != ""
+	case "/latest/meta-data":
+		entries := []string{"hostname", "instance-id"}
+		if s.metadata.InstanceType != "" {
+			entries = append(entries, "instance-type")
+		}
+		entries = append(entries, "local-hostname")
+		if len(s.metadata.PublicKeys) > 0 {
+			entries = append(entries, "public-keys/")
+		}
+		entries = append(entries, "tags/")
+		return strings.Join(entries, "\n"), true
+	}
+
+	metadataPath, isMetadata := strings.CutPrefix(path, "/latest/meta-data/")
+	if !isMetadata {
+		return "", false
+	}
+	parts := strings.Split(metadataPath, "/")
+	switch parts[0] {
+	case "instance-id":
+		return s.metadata.InstanceID, len(parts) == 1
+	case "instance-type":
+		return s.metadata.InstanceType, len(parts) == 1 && s.metadata.InstanceType != ""
+	case "hostname", "local-hostname":
+		return s.metadata.Hostname, len(parts) == 1
+	case "public-keys":
+		return s.lookupPublicKey(parts[1:])
+	case "tags":
+		return s.lookupTag(parts[1:])
+	}
+	return "", false
+}
+
+func (s *Server) lookupPublicKey(parts []string) (string, bool) {
+	names := s.metadata.publicKeyNames()
+	if len(names) == 0 {
+		return "", false
+	}
+	if len(parts) == 0 {
+		entries := make([]string, 0, len(names))
+		for index, name := range names {
+			entries = append(entries, strconv.Itoa(index)+"="+name)
+		}
+		return strings.Join(entries, "\n"), true
+	}
+
+	index, err := strconv.Atoi(parts[0])
+	if err != nil || index < 0 || index >= len(names) {
+		return "", false
+	}
+	switch {
+	case len(parts) == 1:
+		return "openssh-key", true
+	case len(parts) == 2 && parts[1] == "openssh-key":
+		return s.metadata.PublicKeys[names[index]], true
+	}
+	return "", false
+}
+
+func (s *Server) lookupTag(parts []string) (string, bool) {
+	switch {
+	case len(parts) == 0:
+		return "instance/", true
+	case parts[0] != "instance":
+		return "", false
+	case len(parts) == 1:
+		keys := make([]string, 0, len(s.metadata.Tags))
+		for key := range s.metadata.Tags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return strings.Join(keys, "\n"), true
+	case len(parts) == 2:
+		value, exists := s.metadata.Tags[parts[1]]
+		return value, exists
+	}
+	return "", false
+}
@@ -0,0 +1,126 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package imds
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metadata service", func() {
+	var (
+		server *Server
+		now    time.Time
+	)
+
+	BeforeEach(func() {
+		server = NewServer(&Metadata{
+			InstanceID: "5678",
+			Hostname:   "testvmi",
+			PublicKeys: map[string]string{"second": "ssh-rsa BBBB", "first": "ssh-rsa AAAA"},
+			Tags:       map[string]string{"app": "web"},
+			UserData:   "#cloud-config",
+		})
+		now = time.Now()
+		server.now = func() time.Time { return now }
+	})
+
+	serve := func(method, path string, headers map[string]string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, path, nil)
+		for name, value := range headers {
+			request.Header.Set(name, value)
+		}
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	issueToken := func(ttl string) string {
+		recorder := serve(http.MethodPut, tokenPath, map[string]string{tokenTTLHeader: ttl})
+		ExpectWithOffset(1, recorder.Code).To(Equal(http.StatusOK))
+		return recorder.Body.String()
+	}
+
+	DescribeTable("should serve the metadata tree", func(path, expected string) {
+		token := issueToken("60")
+		recorder := serve(http.MethodGet, path, map[string]string{tokenHeader: token})
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(Equal(expected))
+	},
+		Entry("with the index", "/latest/", "meta-data/\nuser-data"),
+		Entry("with the metadata", "/latest/meta-data/", "hostname\ninstance-id\nlocal-hostname\npublic-keys/\ntags/"),
+		Entry("with the instance id", "/latest/meta-data/instance-id", "5678"),
+		Entry("with the hostname", "/latest/meta-data/local-hostname", "testvmi"),
+		Entry("with the public keys sorted by name", "/latest/meta-data/public-keys/", "0=first\n1=second"),
+		Entry("with a public key", "/latest/meta-data/public-keys/1/openssh-key", "ssh-rsa BBBB"),
+		Entry("with the tags", "/latest/meta-data/tags/instance/", "app"),
+		Entry("with a tag", "/latest/meta-data/tags/instance/app", "web"),
+		Entry("with the user data", "/latest/user-data", "#cloud-config"),
+	)
+
+	DescribeTable("should not find", func(path string) {
+		token := issueToken("60")
+		Expect(serve(http.MethodGet, path, map[string]string{tokenHeader: token}).Code).To(Equal(http.StatusNotFound))
+	},
+		Entry("an unknown path", "/latest/meta-data/ami-id"),
+		Entry("a public key out of range", "/latest/meta-data/public-keys/2/openssh-key"),
+		Entry("an unknown tag", "/latest/meta-data/tags/instance/tier"),
+		Entry("a path outside of the tree", "/openstack/latest/meta_data.json"),
+	)
+
+	It("should reject requests without a token", func() {
+		Expect(serve(http.MethodGet, "/latest/meta-data/instance-id", nil).Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("should reject requests with an unknown token", func() {
+		recorder := serve(http.MethodGet, "/latest/meta-data/instance-id", map[string]string{tokenHeader: "unknown"})
+		Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("should reject requests with an expired token", func() {
+		token := issueToken("60")
+		now = now.Add(time.Minute)
+		recorder := serve(http.MethodGet, "/latest/meta-data/instance-id", map[string]string{tokenHeader: token})
+		Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	DescribeTable("should refuse to issue a token", func(method string, headers map[string]string, expectedCode int) {
+		Expect(serve(method, tokenPath, headers).Code).To(Equal(expectedCode))
+	},
+		Entry("with GET", http.MethodGet, map[string]string{tokenTTLHeader: "60"}, http.StatusMethodNotAllowed),
+		Entry("without a TTL", http.MethodPut, nil, http.StatusBadRequest),
+		Entry("with a TTL above six hours", http.MethodPut, map[string]string{tokenTTLHeader: "21601"}, http.StatusBadRequest),
+		Entry("to a forwarded request", http.MethodPut, map[string]string{tokenTTLHeader: "60", "X-Forwarded-For": "10.0.2.2"}, http.StatusForbidden),
+	)
+
+	It("should bound the number of tokens", func() {
+		for range maxTokens {
+			issueToken("60")
+		}
+		Expect(serve(http.MethodPut, tokenPath, map[string]string{tokenTTLHeader: "60"}).Code).To(Equal(http.StatusTooManyRequests))
+
+		now = now.Add(time.Minute)
+		issueToken("60")
+	})
+})
@@ -0,0 +1,76 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package macgen
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// OUIAnnotation sets the prefix, e.g. "02:00:5e", of the MAC addresses the network binding sidecars
+// generate for the interfaces of a VMI without one
+const OUIAnnotation = "network.kubevirt.io/mac-oui"
+
+// OUIFromVMI reads the OUI of the generated MAC addresses from the annotations of the VMI. It is nil
+// when the VMI has no OUI annotation.
+func OUIFromVMI(vmi *v1.VirtualMachineInstance) (net.HardwareAddr, error) {
+	oui, exists := vmi.Annotations[OUIAnnotation]
+	if !exists {
+		return nil, nil
+	}
+	parsed, err := ParseOUI(oui)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %v", OUIAnnotation, err)
+	}
+	return parsed, nil
+}
+
+// ParseOUI returns the three first octets of a MAC address. The OUI of a multicast address is
+// rejected, it can't be used by an interface.
+func ParseOUI(oui string) (net.HardwareAddr, error) {
+	mac, err := net.ParseMAC(oui + ":00:00:00")
+	if err != nil || len(mac) != 6 {
+		return nil, fmt.Errorf("OUI %q is not made of three octets, e.g. \"02:00:5e\"", oui)
+	}
+	if mac[0]&1 != 0 {
+		return nil, fmt.Errorf("OUI %q is a multicast prefix", oui)
+	}
+	return mac[:3], nil
+}
+
+// SetMACAddresses sets a MAC address with the OUI to the interfaces of the VMI without one. The
+// address is derived from the namespace and the name of the VMI and from the name of the
+// interface, so that it is kept when the VMI is restarted or migrated. Nothing is set without OUI.
+func SetMACAddresses(vmi *v1.VirtualMachineInstance, oui net.HardwareAddr) {
+	if oui == nil {
+		return
+	}
+	for i, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.MacAddress != "" {
+			continue
+		}
+		hash := sha256.Sum256([]byte(vmi.Namespace + "/" + vmi.Name + "/" + iface.Name))
+		mac := net.HardwareAddr{oui[0], oui[1], oui[2], hash[0], hash[1], hash[2]}
+		vmi.Spec.Domain.Devices.Interfaces[i].MacAddress = mac.String()
+	}
+}
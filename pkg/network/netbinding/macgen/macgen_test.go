@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package macgen_test
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/netbinding/macgen"
+)
+
+var _ = Describe("MAC address generation", func() {
+	newVMI := func(opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		return libvmi.New(append([]libvmi.Option{
+			libvmi.WithNamespace("default"),
+			libvmi.WithName("testvmi"),
+			libvmi.WithInterface(v1.Interface{Name: "default"}),
+			libvmi.WithInterface(v1.Interface{Name: "secondary", MacAddress: "02:00:00:00:00:01"}),
+		}, opts...)...)
+	}
+
+	Context("OUI", func() {
+		It("should be nil without annotation", func() {
+			Expect(macgen.OUIFromVMI(newVMI())).To(BeNil())
+		})
+
+		It("should be read from the annotation", func() {
+			Expect(macgen.OUIFromVMI(newVMI(libvmi.WithAnnotation(macgen.OUIAnnotation, "02:00:5e")))).
+				To(Equal(net.HardwareAddr{0x02, 0x00, 0x5e}))
+		})
+
+		DescribeTable("should reject an invalid annotation", func(oui, expectedError string) {
+			_, err := macgen.OUIFromVMI(newVMI(libvmi.WithAnnotation(macgen.OUIAnnotation, oui)))
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+		},
+			Entry("with too many octets", "02:00:5e:01", "is not made of three octets"),
+			Entry("with an invalid octet", "02:00:xx", "is not made of three octets"),
+			Entry("with a multicast prefix", "01:00:5e", "is a multicast prefix"),
+		)
+	})
+
+	Context("MAC addresses", func() {
+		oui := net.HardwareAddr{0x02, 0x00, 0x5e}
+
+		It("should be generated with the OUI for the interfaces without one", func() {
+			vmi := newVMI()
+			macgen.SetMACAddresses(vmi, oui)
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("02:00:5e:4d:22:e4"))
+			Expect(vmi.Spec.Domain.Devices.Interfaces[1].MacAddress).To(Equal("02:00:00:00:00:01"))
+		})
+
+		It("should be the same for the same VMI and interface", func() {
+			vmi, otherVMI := newVMI(), newVMI()
+			macgen.SetMACAddresses(vmi, oui)
+			macgen.SetMACAddresses(otherVMI, oui)
+			Expect(otherVMI.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress))
+		})
+
+		It("should differ between VMIs", func() {
+			vmi, otherVMI := newVMI(), newVMI(libvmi.WithName("othervmi"))
+			macgen.SetMACAddresses(vmi, oui)
+			macgen.SetMACAddresses(otherVMI, oui)
+			Expect(otherVMI.Spec.Domain.Devices.Interfaces[0].MacAddress).ToNot(Equal(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress))
+		})
+
+		It("should not be generated without OUI", func() {
+			vmi := newVMI()
+			macgen.SetMACAddresses(vmi, nil)
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+		})
+	})
+})
@@ -25,6 +25,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/network/flowexporter"
+	"kubevirt.io/kubevirt/pkg/network/netbinding/sidecarconfig"
 )
 
 func NetBindingPluginSidecarList(vmi *v1.VirtualMachineInstance, config *v1.KubeVirtConfiguration) (hooks.HookSidecarList, error) {
@@ -63,6 +65,9 @@ func netBindingPluginSidecar(vmi *v1.VirtualMachineInstance, config *v1.KubeVirt
 				Image:           pluginInfo.SidecarImage,
 				ImagePullPolicy: config.ImagePullPolicy,
 				DownwardAPI:     pluginInfo.DownwardAPI,
+				// The flow exporter lists the connections tracked by netfilter
+				NetAdmin:  flowexporter.IsEnabled(vmi),
+				ConfigMap: sidecarConfigMap(pluginInfo.SidecarConfigMap),
 			})
 		}
 	}
@@ -70,6 +75,22 @@ func netBindingPluginSidecar(vmi *v1.VirtualMachineInstance, config *v1.KubeVirt
 	return pluginSidecars, nil
 }
 
+// sidecarConfigMap mounts the configuration file of a binding plugin sidecar from its ConfigMap
+func sidecarConfigMap(configMap *v1.InterfaceBindingSidecarConfigMap) *hooks.ConfigMap {
+	if configMap == nil {
+		return nil
+	}
+	key := configMap.Key
+	if key == "" {
+		key = sidecarconfig.DefaultConfigMapKey
+	}
+	return &hooks.ConfigMap{
+		Name:     configMap.Name,
+		Key:      key,
+		HookPath: sidecarconfig.DefaultPath,
+	}
+}
+
 func ReadNetBindingPluginConfiguration(kvConfig *v1.KubeVirtConfiguration, pluginName string) *v1.InterfaceBindingPlugin {
 	if kvConfig != nil && kvConfig.NetworkConfiguration != nil && kvConfig.NetworkConfiguration.Binding != nil {
 		if plugin, exist := kvConfig.NetworkConfiguration.Binding[pluginName]; exist {
@@ -27,7 +27,9 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/flowexporter"
 	"kubevirt.io/kubevirt/pkg/network/netbinding"
+	"kubevirt.io/kubevirt/pkg/network/netbinding/sidecarconfig"
 )
 
 var _ = Describe("Network Binding", func() {
@@ -98,6 +100,35 @@ var _ = Describe("Network Binding", func() {
 				),
 				map[string]v1.InterfaceBindingPlugin{testBindingName1: {SidecarImage: testSidecarImage1}},
 				hooks.HookSidecarList{{Image: testSidecarImage1}}),
+			Entry("VMI enabling the flow exporter",
+				libvmi.New(libvmi.WithInterface(v1.Interface{Name: testNetworkName1, Binding: &v1.PluginBinding{Name: testBindingName1}}),
+					libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+					libvmi.WithAnnotation(flowexporter.EnabledAnnotation, "true"),
+				),
+				map[string]v1.InterfaceBindingPlugin{testBindingName1: {SidecarImage: testSidecarImage1}},
+				hooks.HookSidecarList{{Image: testSidecarImage1, NetAdmin: true}}),
+			Entry("VMI has binding plugin with a sidecar ConfigMap",
+				libvmi.New(libvmi.WithInterface(v1.Interface{Name: testNetworkName1, Binding: &v1.PluginBinding{Name: testBindingName1}}),
+					libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+				),
+				map[string]v1.InterfaceBindingPlugin{testBindingName1: {
+					SidecarImage:     testSidecarImage1,
+					SidecarConfigMap: &v1.InterfaceBindingSidecarConfigMap{Name: "binding-config"},
+				}},
+				hooks.HookSidecarList{{Image: testSidecarImage1, ConfigMap: &hooks.ConfigMap{
+					Name: "binding-config", Key: sidecarconfig.DefaultConfigMapKey, HookPath: sidecarconfig.DefaultPath,
+				}}}),
+			Entry("VMI has binding plugin with a key of a sidecar ConfigMap",
+				libvmi.New(libvmi.WithInterface(v1.Interface{Name: testNetworkName1, Binding: &v1.PluginBinding{Name: testBindingName1}}),
+					libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+				),
+				map[string]v1.InterfaceBindingPlugin{testBindingName1: {
+					SidecarImage:     testSidecarImage1,
+					SidecarConfigMap: &v1.InterfaceBindingSidecarConfigMap{Name: "binding-config", Key: "passt.yaml"},
+				}},
+				hooks.HookSidecarList{{Image: testSidecarImage1, ConfigMap: &hooks.ConfigMap{
+					Name: "binding-config", Key: "passt.yaml", HookPath: sidecarconfig.DefaultPath,
+				}}}),
 		)
 
 		It("should retrun an error when VMI has binding plugin but config doesn't exist", func() {
@@ -0,0 +1,124 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package sidecarconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/netbinding/macgen"
+)
+
+const (
+	// DefaultPath is where the binding plugin sidecars read their configuration file, mounted from
+	// the sidecar ConfigMap of the binding plugin
+	DefaultPath = "/etc/kubevirt-binding/config.yaml"
+	// DefaultConfigMapKey is the key of the sidecar ConfigMap holding the configuration file
+	DefaultConfigMapKey = "config.yaml"
+
+	maxLogVerbosity = 9
+)
+
+// Config is the configuration file of a binding plugin sidecar
+type Config struct {
+	// LogVerbosity sets the verbosity of the logs of the sidecar, from 0 to 9
+	LogVerbosity *int `json:"logVerbosity,omitempty"`
+	// MACOUI is the prefix, e.g. "02:00:5e", of the MAC addresses generated for the interfaces
+	// without one, when the VMI has no OUI annotation
+	MACOUI string `json:"macOUI,omitempty"`
+	// DHCP sets the options given to the guest over DHCP
+	DHCP *DHCPConfig `json:"dhcp,omitempty"`
+}
+
+type DHCPConfig struct {
+	// DNSSearchDomains replace the search domains of the pod
+	DNSSearchDomains []string `json:"dnsSearchDomains,omitempty"`
+}
+
+// Load reads the configuration file of a binding plugin sidecar. A missing file is an empty
+// configuration, the sidecar keeps its defaults.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("invalid configuration file %s: %v", path, err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration file %s: %v", path, err)
+	}
+	return config, nil
+}
+
+func (c *Config) Validate() error {
+	if c.LogVerbosity != nil && (*c.LogVerbosity < 0 || *c.LogVerbosity > maxLogVerbosity) {
+		return fmt.Errorf("logVerbosity %d is not between 0 and %d", *c.LogVerbosity, maxLogVerbosity)
+	}
+	if c.MACOUI != "" {
+		if _, err := macgen.ParseOUI(c.MACOUI); err != nil {
+			return fmt.Errorf("invalid macOUI: %v", err)
+		}
+	}
+	if c.DHCP != nil {
+		for _, domain := range c.DHCP.DNSSearchDomains {
+			if errs := validation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+				return fmt.Errorf("dhcp dnsSearchDomains %q is not a valid domain: %v", domain, errs)
+			}
+		}
+	}
+	return nil
+}
+
+// SetLogVerbosity sets the verbosity of the logs of the sidecar, when configured
+func (c *Config) SetLogVerbosity() error {
+	if c.LogVerbosity == nil {
+		return nil
+	}
+	return log.Log.SetVerbosityLevel(*c.LogVerbosity)
+}
+
+// SetMACAddresses sets a generated MAC address to the interfaces of the VMI without one. The OUI
+// annotation of the VMI takes precedence over the OUI of the configuration.
+func (c *Config) SetMACAddresses(vmi *v1.VirtualMachineInstance) error {
+	oui, err := macgen.OUIFromVMI(vmi)
+	if err != nil {
+		return err
+	}
+	if oui == nil && c.MACOUI != "" {
+		if oui, err = macgen.ParseOUI(c.MACOUI); err != nil {
+			return err
+		}
+	}
+	macgen.SetMACAddresses(vmi, oui)
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package sidecarconfig_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/netbinding/macgen"
+	"kubevirt.io/kubevirt/pkg/network/netbinding/sidecarconfig"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Binding plugin sidecar configuration", func() {
+	writeConfig := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+		return path
+	}
+
+	It("should be empty without configuration file", func() {
+		config, err := sidecarconfig.Load(filepath.Join(GinkgoT().TempDir(), "config.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(Equal(&sidecarconfig.Config{}))
+	})
+
+	It("should load the configuration file", func() {
+		config, err := sidecarconfig.Load(writeConfig(`
+logVerbosity: 4
+macOUI: "02:00:5e"
+dhcp:
+  dnsSearchDomains:
+  - example.com
+`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(Equal(&sidecarconfig.Config{
+			LogVerbosity: pointer.P(4),
+			MACOUI:       "02:00:5e",
+			DHCP:         &sidecarconfig.DHCPConfig{DNSSearchDomains: []string{"example.com"}},
+		}))
+	})
+
+	DescribeTable("should reject an invalid configuration file", func(content, expectedError string) {
+		_, err := sidecarconfig.Load(writeConfig(content))
+		Expect(err).To(MatchError(ContainSubstring(expectedError)))
+	},
+		Entry("with an unknown field", "bridgeName: br0", `unknown field "bridgeName"`),
+		Entry("with a field of the wrong type", "logVerbosity: high", "cannot unmarshal"),
+		Entry("with a log verbosity out of range", "logVerbosity: 10", "is not between 0 and 9"),
+		Entry("with an OUI too long", `macOUI: "02:00:5e:01"`, "is not made of three octets"),
+		Entry("with a multicast OUI", `macOUI: "01:00:5e"`, "is a multicast prefix"),
+		Entry("with an invalid search domain", "dhcp: {dnsSearchDomains: [Example_com]}", "is not a valid domain"),
+	)
+
+	Context("MAC addresses", func() {
+		newVMI := func() *v1.VirtualMachineInstance {
+			return libvmi.New(
+				libvmi.WithNamespace("default"),
+				libvmi.WithName("testvmi"),
+				libvmi.WithInterface(v1.Interface{Name: "default"}),
+				libvmi.WithInterface(v1.Interface{Name: "secondary", MacAddress: "02:00:00:00:00:01"}),
+			)
+		}
+
+		It("should be generated with the OUI of the configuration for the interfaces without one", func() {
+			vmi := newVMI()
+			config := &sidecarconfig.Config{MACOUI: "02:00:5e"}
+			Expect(config.SetMACAddresses(vmi)).To(Succeed())
+
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("02:00:5e:4d:22:e4"))
+			Expect(vmi.Spec.Domain.Devices.Interfaces[1].MacAddress).To(Equal("02:00:00:00:00:01"))
+		})
+
+		It("should be generated with the OUI annotation of the VMI over the OUI of the configuration", func() {
+			vmi := newVMI()
+			vmi.Annotations = map[string]string{macgen.OUIAnnotation: "0a:58:0a"}
+			config := &sidecarconfig.Config{MACOUI: "02:00:5e"}
+			Expect(config.SetMACAddresses(vmi)).To(Succeed())
+
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("0a:58:0a:4d:22:e4"))
+		})
+
+		It("should fail with an invalid OUI annotation", func() {
+			vmi := newVMI()
+			vmi.Annotations = map[string]string{macgen.OUIAnnotation: "01:00:5e"}
+			config := &sidecarconfig.Config{MACOUI: "02:00:5e"}
+			Expect(config.SetMACAddresses(vmi)).To(MatchError(ContainSubstring("is a multicast prefix")))
+		})
+
+		It("should not be generated without OUI", func() {
+			vmi := newVMI()
+			Expect((&sidecarconfig.Config{}).SetMACAddresses(vmi)).To(Succeed())
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+		})
+	})
+})
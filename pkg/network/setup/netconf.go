@@ -34,7 +34,10 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/dns"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+	"kubevirt.io/kubevirt/pkg/network/firewall"
+	"kubevirt.io/kubevirt/pkg/network/imds"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/netns"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
@@ -55,6 +58,8 @@ type NetConf struct {
 	nsFactory        nsFactory
 	state            map[string]*netpod.State
 	configStateMutex *sync.RWMutex
+	// loadedFirewalls holds the ingress firewall annotation loaded in the pod of each VMI, prefixed by the pod PID
+	loadedFirewalls map[string]string
 
 	clusterConfigurer clusterConfigurer
 }
@@ -76,6 +81,7 @@ func NewNetConfWithCustomFactoryAndConfigState(nsFactory nsFactory, cacheCreator
 	return &NetConf{
 		state:             state,
 		configStateMutex:  &sync.RWMutex{},
+		loadedFirewalls:   map[string]string{},
 		cacheCreator:      cacheCreator,
 		nsFactory:         nsFactory,
 		clusterConfigurer: clusterConfigurer,
@@ -122,6 +128,7 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 		netpod.WithBindingPlugins(c.clusterConfigurer.GetNetworkBindings()),
 		netpod.WithLogger(log.Log.Object(vmi)),
 		netpod.WithVMIIfaceStatuses(vmi.Status.Interfaces),
+		netpod.WithDNSForwarder(dns.IsForwarderEnabled(vmi)),
 	)
 
 	if err := netpod.Setup(); err != nil {
@@ -130,6 +137,52 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 	return nil
 }
 
+// SetupFirewall loads the ingress firewall of the VMI interfaces in the virt-launcher pod, when its annotation changed.
+// The pod network has to be setup first.
+func (c *NetConf) SetupFirewall(vmi *v1.VirtualMachineInstance, launcherPid int) error {
+	value, exists := vmi.Annotations[firewall.Annotation]
+
+	c.configStateMutex.RLock()
+	state, stateExists := c.state[string(vmi.UID)]
+	loadedFirewall, loaded := c.loadedFirewalls[string(vmi.UID)]
+	c.configStateMutex.RUnlock()
+
+	firewallKey := strconv.Itoa(launcherPid) + "/" + value
+	if !stateExists || (!exists && !loaded) || (loaded && loadedFirewall == firewallKey) {
+		return nil
+	}
+
+	config, err := firewall.ConfigFromVMI(vmi)
+	if err != nil {
+		return err
+	}
+
+	netpod := netpod.NewNetPod(
+		vmi.Spec.Networks,
+		vmi.Spec.Domain.Devices.Interfaces,
+		string(vmi.UID),
+		launcherPid,
+		0,
+		0,
+		state,
+		netpod.WithBindingPlugins(c.clusterConfigurer.GetNetworkBindings()),
+		netpod.WithLogger(log.Log.Object(vmi)),
+		netpod.WithVMIIfaceStatuses(vmi.Status.Interfaces),
+	)
+	if err := netpod.SetupFirewall(config); err != nil {
+		return fmt.Errorf("ingress firewall setup failed, err: %w", err)
+	}
+
+	c.configStateMutex.Lock()
+	if exists {
+		c.loadedFirewalls[string(vmi.UID)] = firewallKey
+	} else {
+		delete(c.loadedFirewalls, string(vmi.UID))
+	}
+	c.configStateMutex.Unlock()
+	return nil
+}
+
 func upgradeConfigStateCache(stateCache *ConfigStateCache, networks []v1.Network, cacheCreator cacheCreator, vmiUID string) (*ConfigStateCache, error) {
 	for networkName, podIfaceName := range namescheme.CreateOrdinalNetworkNameScheme(networks) {
 		exists, err := stateCache.Exists(podIfaceName)
@@ -158,6 +211,7 @@ func upgradeConfigStateCache(stateCache *ConfigStateCache, networks []v1.Network
 func (c *NetConf) Teardown(vmi *v1.VirtualMachineInstance) error {
 	c.configStateMutex.Lock()
 	delete(c.state, string(vmi.UID))
+	delete(c.loadedFirewalls, string(vmi.UID))
 	c.configStateMutex.Unlock()
 	podCache := cache.NewPodInterfaceCache(c.cacheCreator, string(vmi.UID))
 	if err := podCache.Remove(); err != nil {
@@ -169,11 +223,15 @@ func (c *NetConf) Teardown(vmi *v1.VirtualMachineInstance) error {
 
 func newMasqueradeAdapter(vmi *v1.VirtualMachineInstance) masquerade.MasqPod {
 	if vmi.Status.MigrationTransport == v1.MigrationTransportUnix {
-		return masquerade.New(masquerade.WithIstio(istio.ProxyInjectionEnabled(vmi)))
+		return masquerade.New(
+			masquerade.WithIstio(istio.ProxyInjectionEnabled(vmi)),
+			masquerade.WithMetadataService(imds.IsEnabled(vmi)),
+		)
 	} else {
 		return masquerade.New(
 			masquerade.WithIstio(istio.ProxyInjectionEnabled(vmi)),
 			masquerade.WithLegacyMigrationPorts(),
+			masquerade.WithMetadataService(imds.IsEnabled(vmi)),
 		)
 	}
 }
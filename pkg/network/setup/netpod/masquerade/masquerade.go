@@ -29,6 +29,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/network/driver/nft"
 	"kubevirt.io/kubevirt/pkg/network/driver/nmstate"
+	"kubevirt.io/kubevirt/pkg/network/imds"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/netmachinery"
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
@@ -41,9 +42,10 @@ type nftable interface {
 }
 
 type MasqPod struct {
-	nftable        nftable
-	istioEnabled   bool
-	migrationPorts []int
+	nftable         nftable
+	istioEnabled    bool
+	migrationPorts  []int
+	metadataService bool
 }
 
 const (
@@ -73,6 +75,14 @@ func WithIstio(enabled bool) option {
 	}
 }
 
+// WithMetadataService redirects the requests of the guest to the metadata service address to the
+// gateway, where virt-launcher serves them.
+func WithMetadataService(enabled bool) option {
+	return func(m *MasqPod) {
+		m.metadataService = enabled
+	}
+}
+
 func WithNftableAdapter(h nftable) option {
 	return func(m *MasqPod) {
 		m.nftable = h
@@ -138,6 +148,13 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 		return err
 	}
 
+	if m.metadataService && family == nft.IPv4 {
+		gw := guestIPGateway(family, *bridgeIfaceSpec).String()
+		if err := m.nftable.AddRule(family, natTable, preroutingChain, "iifname", bridgeIfaceSpec.Name, string(family), "daddr", imds.Address, "tcp", "dport", strconv.Itoa(imds.Port), "counter", "dnat", "to", gw); err != nil {
+			return err
+		}
+	}
+
 	if len(m.migrationPorts) > 0 {
 		if err := m.skipForwardPorts(family, m.migrationPorts...); err != nil {
 			return err
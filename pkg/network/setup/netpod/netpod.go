@@ -30,9 +30,11 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
 	"kubevirt.io/kubevirt/pkg/network/driver/nmstate"
 	"kubevirt.io/kubevirt/pkg/network/driver/procsys"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
+	"kubevirt.io/kubevirt/pkg/network/firewall"
 	"kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/netmachinery"
@@ -55,6 +57,10 @@ type masqueradeAdapter interface {
 	Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error
 }
 
+type firewallAdapter interface {
+	LoadRuleset(ruleset string) error
+}
+
 type cacheCreator interface {
 	New(filePath string) *cache.Cache
 }
@@ -74,12 +80,15 @@ type NetPod struct {
 
 	nmstateAdapter    nmstateAdapter
 	masqueradeAdapter masqueradeAdapter
+	firewallAdapter   firewallAdapter
 
 	cacheCreator cacheCreator
 	state        *State
 
 	bindingPluginsByName map[string]v1.InterfaceBindingPlugin
 
+	dnsForwarder bool
+
 	log *log.FilteredLogger
 }
 
@@ -97,6 +106,7 @@ func NewNetPod(vmiNetworks []v1.Network, vmiIfaces []v1.Interface, vmiUID string
 
 		nmstateAdapter:    nmstate.New(),
 		masqueradeAdapter: masquerade.New(),
+		firewallAdapter:   nft.NFTBin{},
 
 		cacheCreator:         cache.CacheCreator{},
 		bindingPluginsByName: map[string]v1.InterfaceBindingPlugin{},
@@ -121,6 +131,12 @@ func WithMasqueradeAdapter(h masqueradeAdapter) option {
 	}
 }
 
+func WithFirewallAdapter(h firewallAdapter) option {
+	return func(n *NetPod) {
+		n.firewallAdapter = h
+	}
+}
+
 func WithCacheCreator(c cacheCreator) option {
 	return func(n *NetPod) {
 		n.cacheCreator = c
@@ -145,6 +161,14 @@ func WithVMIIfaceStatuses(vmiIfaceStatuses []v1.VirtualMachineInstanceNetworkInt
 	}
 }
 
+// WithDNSForwarder routes the guests of the secondary bridge binding networks through their bridge,
+// so that the DNS forwarder serving on the bridge can reach them.
+func WithDNSForwarder(enabled bool) option {
+	return func(n *NetPod) {
+		n.dnsForwarder = enabled
+	}
+}
+
 func (n NetPod) Setup() error {
 	// Not all network bindings are processed in the network setup.
 	filteredNets, err := filterSupportedBindingNetworks(n.vmiSpecNets, n.vmiSpecIfaces)
@@ -215,6 +239,57 @@ func (n NetPod) Setup() error {
 	return nil
 }
 
+// SetupFirewall loads the ingress firewall of the interfaces in the pod, replacing the previous one.
+func (n NetPod) SetupFirewall(config firewall.Config) error {
+	return n.state.NSExec.Do(func() error {
+		currentStatus, err := n.nmstateAdapter.Read()
+		if err != nil {
+			return err
+		}
+		devices, err := n.firewallDevices(config, currentStatus)
+		if err != nil {
+			return err
+		}
+		return n.firewallAdapter.LoadRuleset(firewall.Ruleset(devices))
+	})
+}
+
+func (n NetPod) firewallDevices(config firewall.Config, currentStatus *nmstate.Status) ([]firewall.Device, error) {
+	podIfaceNameByVMINetwork := createNetworkNameScheme(n.vmiSpecNets, n.vmiIfaceStatuses, currentStatus.Interfaces)
+
+	var devices []firewall.Device
+	for ifaceName, ifaceFirewall := range config {
+		iface := vmispec.LookupInterfaceByName(n.vmiSpecIfaces, ifaceName)
+		vmiNetwork := vmispec.LookupNetworkByName(n.vmiSpecNets, ifaceName)
+		if iface == nil || vmiNetwork == nil || iface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		podIfaceName := podIfaceNameByVMINetwork[ifaceName]
+
+		switch {
+		case iface.Bridge != nil:
+			devices = append(devices, firewall.Device{
+				Name:     link.GenerateTapDeviceName(podIfaceName, *vmiNetwork),
+				Bridged:  true,
+				Firewall: ifaceFirewall,
+			})
+		case iface.Binding != nil:
+			// The binding plugins with no managed tap, like passt, forward the traffic from the pod interface.
+			bindingPlugin, exists := n.bindingPluginsByName[iface.Binding.Name]
+			if !exists || bindingPlugin.DomainAttachmentType == v1.ManagedTap {
+				return nil, fmt.Errorf("ingress firewall is not supported by the binding plugin of interface %s", ifaceName)
+			}
+			devices = append(devices, firewall.Device{
+				Name:     podIfaceName,
+				Firewall: ifaceFirewall,
+			})
+		default:
+			return nil, fmt.Errorf("ingress firewall is not supported by the binding of interface %s", ifaceName)
+		}
+	}
+	return devices, nil
+}
+
 func (n NetPod) validateNoNetworkReconfigured(startedNets []v1.Network) error {
 	if len(startedNets) > 0 {
 		for _, net := range startedNets {
@@ -276,6 +351,12 @@ func (n NetPod) composeDesiredSpec(currentStatus *nmstate.Status) (*nmstate.Spec
 				spec.LinuxStack.IPv4.ArpIgnore = pointer.P(procsys.ARPReplyMode1)
 			}
 
+			if n.dnsForwarder && iface.State != v1.InterfaceStateAbsent {
+				if vmiNetwork := vmispec.LookupNetworkByName(n.vmiSpecNets, iface.Name); vmiNetwork != nil && vmiNetwork.Pod == nil {
+					spec.Routes.Config = append(spec.Routes.Config, dnsForwarderRoutes(ifacesSpec)...)
+				}
+			}
+
 			if iface.State == v1.InterfaceStateAbsent {
 				var filteredIfacesSpec []nmstate.Interface
 				for _, ifaceSpec := range ifacesSpec {
@@ -387,11 +468,13 @@ func (n NetPod) bridgeBindingSpec(podIfaceName string, vmiIfaceIndex int, ifaceS
 		MTU:        podStatusIface.MTU,
 		Controller: bridgeIface.Name,
 		Tap: &nmstate.TapDevice{
-			Queues: n.networkQueues(vmiIfaceIndex),
-			UID:    n.ownerID,
-			GID:    n.ownerID,
+			Queues:        n.networkQueues(vmiIfaceIndex),
+			UID:           n.ownerID,
+			GID:           n.ownerID,
+			TxQueueLength: tapTxQueueLength(n.vmiSpecIfaces[vmiIfaceIndex].Bridge.Tap),
 		},
-		Metadata: &nmstate.IfaceMetadata{Pid: n.podPID, NetworkName: vmiNetworkName},
+		LinuxStack: bridgePortLinuxStack(n.vmiSpecIfaces[vmiIfaceIndex].Bridge),
+		Metadata:   &nmstate.IfaceMetadata{Pid: n.podPID, NetworkName: vmiNetworkName},
 	}
 
 	dummyIface := nmstate.Interface{
@@ -407,6 +490,65 @@ func (n NetPod) bridgeBindingSpec(podIfaceName string, vmiIfaceIndex int, ifaceS
 	return []nmstate.Interface{bridgeIface, podIface, tapIface, dummyIface}, nil
 }
 
+// bridgePortLinuxStack sets the MAC learning and the unicast flooding of the bridge port of the
+// guest, left to the kernel defaults, both enabled, unless disabled in the bridge binding
+func bridgePortLinuxStack(bridge *v1.InterfaceBridge) nmstate.LinuxIfaceStack {
+	var linuxStack nmstate.LinuxIfaceStack
+	if bridge.MACLearning != nil && !*bridge.MACLearning {
+		linuxStack.PortLearning = pointer.P(false)
+	}
+	if bridge.UnicastFlood != nil && !*bridge.UnicastFlood {
+		linuxStack.PortFlood = pointer.P(false)
+	}
+	return linuxStack
+}
+
+// tapTxQueueLength returns the transmit queue length requested for the tap device, zero keeping
+// the kernel default
+func tapTxQueueLength(tap *v1.InterfaceTap) int {
+	if tap == nil || tap.TxQueueLength == nil {
+		return 0
+	}
+	return int(*tap.TxQueueLength)
+}
+
+// dnsForwarderRoutes routes the IPv4 addresses of the guest, which are kept on the dummy interface,
+// through the bridge instead of delivering them locally.
+// Without it, the replies of the DNS forwarder never reach the guest and its queries are dropped
+// as martians.
+func dnsForwarderRoutes(bridgeBindingIfacesSpec []nmstate.Interface) []nmstate.Route {
+	bridgeIface := nmstate.LookupInterface(bridgeBindingIfacesSpec, func(i nmstate.Interface) bool {
+		return i.TypeName == nmstate.TypeBridge
+	})
+	dummyIface := nmstate.LookupInterface(bridgeBindingIfacesSpec, func(i nmstate.Interface) bool {
+		return i.TypeName == nmstate.TypeDummy
+	})
+	if bridgeIface == nil || dummyIface == nil {
+		return nil
+	}
+
+	var routes []nmstate.Route
+	for _, address := range dummyIface.IPv4.Address {
+		if !net.ParseIP(address.IP).IsGlobalUnicast() {
+			continue
+		}
+		destination := address.IP + "/32"
+		routes = append(routes,
+			nmstate.Route{
+				Destination:      destination,
+				NextHopInterface: dummyIface.Name,
+				TableID:          nmstate.RouteTableLocal,
+				State:            nmstate.RouteStateAbsent,
+			},
+			nmstate.Route{
+				Destination:      destination,
+				NextHopInterface: bridgeIface.Name,
+			},
+		)
+	}
+	return routes
+}
+
 func (n NetPod) networkQueues(vmiIfaceIndex int) int {
 	if ifaceModel := n.vmiSpecIfaces[vmiIfaceIndex].Model; ifaceModel == "" || ifaceModel == v1.VirtIO {
 		return n.queuesCap
@@ -42,6 +42,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/driver/nmstate"
 	"kubevirt.io/kubevirt/pkg/network/driver/procsys"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
+	"kubevirt.io/kubevirt/pkg/network/firewall"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
 )
 
@@ -682,6 +683,86 @@ var _ = Describe("netpod", func() {
 		}))
 	})
 
+	DescribeTable("setup bridge binding tap port", func(bridge v1.InterfaceBridge, expLinuxStack nmstate.LinuxIfaceStack) {
+		nmstatestub := nmstateStub{status: nmstate.Status{
+			Interfaces: []nmstate.Interface{{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "12:34:56:78:90:ab",
+				MTU:        1500,
+				IPv4:       ipDisabled,
+				IPv6:       ipDisabled,
+			}},
+		}}
+
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{{
+				Name:                   defaultPodNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &bridge},
+			}},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithCacheCreator(&baseCacheCreator),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+		Expect(nmstatestub.spec.Interfaces).To(ContainElement(nmstate.Interface{
+			Name:       "tap0",
+			TypeName:   nmstate.TypeTap,
+			State:      nmstate.IfaceStateUp,
+			MTU:        1500,
+			Controller: "k6t-eth0",
+			Tap:        &nmstate.TapDevice{Queues: 0, UID: 0, GID: 0},
+			LinuxStack: expLinuxStack,
+			Metadata:   &nmstate.IfaceMetadata{Pid: 0, NetworkName: defaultPodNetworkName},
+		}))
+	},
+		Entry("with the kernel defaults",
+			v1.InterfaceBridge{MACLearning: pointer.P(true), UnicastFlood: pointer.P(true)},
+			nmstate.LinuxIfaceStack{},
+		),
+		Entry("with MAC learning disabled",
+			v1.InterfaceBridge{MACLearning: pointer.P(false)},
+			nmstate.LinuxIfaceStack{PortLearning: pointer.P(false)},
+		),
+		Entry("with unicast flooding disabled",
+			v1.InterfaceBridge{UnicastFlood: pointer.P(false)},
+			nmstate.LinuxIfaceStack{PortFlood: pointer.P(false)},
+		),
+	)
+
+	It("setup bridge binding tap device with a transmit queue length", func() {
+		nmstatestub := nmstateStub{status: nmstate.Status{
+			Interfaces: []nmstate.Interface{{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "12:34:56:78:90:ab",
+				MTU:        1500,
+				IPv4:       ipDisabled,
+				IPv6:       ipDisabled,
+			}},
+		}}
+
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{{
+				Name: defaultPodNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{
+					Bridge: &v1.InterfaceBridge{Tap: &v1.InterfaceTap{TxQueueLength: pointer.P(int32(5000))}},
+				},
+			}},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithCacheCreator(&baseCacheCreator),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+		Expect(nmstatestub.spec.Interfaces).To(ContainElement(HaveField("Tap", Equal(&nmstate.TapDevice{TxQueueLength: 5000}))))
+	})
+
 	When("using secondary network", func() {
 
 		const (
@@ -1064,6 +1145,74 @@ var _ = Describe("netpod", func() {
 			Expect(masqstub.podIfaceSpec.Name).To(Equal("eth0"))
 			Expect(masqstub.vmiIfaceSpec.Name).To(Equal(defaultPodNetworkName))
 		})
+
+		It("setup the ingress firewall of the secondary bridge binding", func() {
+			var firewallstub firewallStub
+			netPod := netpod.NewNetPod(
+				specNetworks,
+				specInterfaces,
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithFirewallAdapter(&firewallstub),
+			)
+			config := firewall.Config{secondaryNetworkName: {AllowedPorts: []firewall.Port{{Protocol: firewall.ProtocolTCP, Port: 22}}}}
+			Expect(netPod.SetupFirewall(config)).To(Succeed())
+
+			Expect(firewallstub.ruleset).To(Equal(firewall.Ruleset([]firewall.Device{{
+				Name:     "tap914f438d88d",
+				Bridged:  true,
+				Firewall: config[secondaryNetworkName],
+			}})))
+		})
+
+		It("fails to setup the ingress firewall of the masquerade binding", func() {
+			var firewallstub firewallStub
+			netPod := netpod.NewNetPod(
+				specNetworks,
+				specInterfaces,
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithFirewallAdapter(&firewallstub),
+			)
+			Expect(netPod.SetupFirewall(firewall.Config{defaultPodNetworkName: {}})).NotTo(Succeed())
+			Expect(firewallstub.ruleset).To(BeEmpty())
+		})
+
+		It("setup secondary bridge binding with the DNS forwarder routes the guest through the bridge", func() {
+			const secondaryIPv4Address = "192.168.1.10"
+			nmstatestub.status.Interfaces[1].IPv4 = nmstate.IP{
+				Enabled: pointer.P(true),
+				Address: []nmstate.IPAddress{{IP: secondaryIPv4Address, PrefixLen: 24}},
+			}
+			nmstatestub.status.Routes.Running = []nmstate.Route{{
+				Destination:      "0.0.0.0/0",
+				NextHopInterface: secondaryPodInterfaceName,
+				NextHopAddress:   "192.168.1.1",
+			}}
+			netPod := netpod.NewNetPod(
+				specNetworks,
+				specInterfaces,
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithMasqueradeAdapter(&masqstub),
+				netpod.WithCacheCreator(&baseCacheCreator),
+				netpod.WithDNSForwarder(true),
+			)
+			Expect(netPod.Setup()).To(Succeed())
+
+			Expect(nmstatestub.spec.Routes).To(Equal(nmstate.Routes{Config: []nmstate.Route{
+				{
+					Destination:      secondaryIPv4Address + "/32",
+					NextHopInterface: secondaryPodInterfaceName,
+					TableID:          nmstate.RouteTableLocal,
+					State:            nmstate.RouteStateAbsent,
+				},
+				{
+					Destination:      secondaryIPv4Address + "/32",
+					NextHopInterface: "k6t-914f438d88d",
+				},
+			}}))
+		})
 	})
 
 	It("setup Passt binding", func() {
@@ -1922,6 +2071,15 @@ func (n *nmstateStub) Read() (*nmstate.Status, error) {
 	return &n.status, n.readErr
 }
 
+type firewallStub struct {
+	ruleset string
+}
+
+func (f *firewallStub) LoadRuleset(ruleset string) error {
+	f.ruleset = ruleset
+	return nil
+}
+
 type masqueradeStub struct {
 	setupErr        error
 	bridgeIfaceSpec *nmstate.Interface
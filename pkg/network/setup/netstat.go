@@ -123,6 +123,7 @@ func (c *NetStat) UpdateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domai
 	interfacesStatus = ifacesStatusFromMultus(interfacesStatus, multusStatusNetworksByName, vmiInterfacesSpecByName)
 
 	vmi.Status.Interfaces = interfacesStatus
+	vmi.Status.GuestDNS = guestDNSFromGuestAgent(domain.Status.GuestDNS)
 
 	c.removeAbsentIfacesFromVolatileCache(vmi)
 
@@ -312,6 +313,8 @@ func updateVMIIfaceStatusWithGuestAgentData(ifaceStatus *v1.VirtualMachineInstan
 		ifaceStatus.IP = guestAgentIface.Ip
 		ifaceStatus.IPs = guestAgentIface.IPs
 	}
+	ifaceStatus.IPsWithPrefix = guestAgentIface.IPsWithPrefix
+	ifaceStatus.DefaultRoutes = defaultRoutesFromGuestAgent(guestAgentIface.DefaultRoutes)
 }
 
 func newVMIIfaceStatusFromGuestAgentData(guestAgentInterface api.InterfaceStatus) v1.VirtualMachineInstanceNetworkInterface {
@@ -319,7 +322,30 @@ func newVMIIfaceStatusFromGuestAgentData(guestAgentInterface api.InterfaceStatus
 		MAC:           guestAgentInterface.Mac,
 		IP:            guestAgentInterface.Ip,
 		IPs:           guestAgentInterface.IPs,
+		IPsWithPrefix: guestAgentInterface.IPsWithPrefix,
 		InterfaceName: guestAgentInterface.InterfaceName,
+		DefaultRoutes: defaultRoutesFromGuestAgent(guestAgentInterface.DefaultRoutes),
+	}
+}
+
+func defaultRoutesFromGuestAgent(guestAgentRoutes []api.InterfaceRoute) []v1.VirtualMachineInstanceNetworkInterfaceRoute {
+	var routes []v1.VirtualMachineInstanceNetworkInterfaceRoute
+	for _, route := range guestAgentRoutes {
+		routes = append(routes, v1.VirtualMachineInstanceNetworkInterfaceRoute{
+			Gateway: route.Gateway,
+			Metric:  route.Metric,
+		})
+	}
+	return routes
+}
+
+func guestDNSFromGuestAgent(guestDNS api.GuestDNS) *v1.VirtualMachineInstanceGuestDNS {
+	if len(guestDNS.Nameservers) == 0 && len(guestDNS.Searches) == 0 {
+		return nil
+	}
+	return &v1.VirtualMachineInstanceGuestDNS{
+		Nameservers: guestDNS.Nameservers,
+		Searches:    guestDNS.Searches,
 	}
 }
 
@@ -368,6 +368,44 @@ var _ = Describe("netstat", func() {
 		}), "the pod IP/s should be reported in the status")
 	})
 
+	It("should report the IPs with prefix, the default routes and the DNS of the guest-agent", func() {
+		const (
+			primaryNetworkName = "primary"
+			primaryIfaceName   = "eth0"
+
+			podIPv4    = "1.1.1.1"
+			origMAC    = "C0:01:BE:E7:15:G0:0D"
+			gateway    = "1.1.1.254"
+			nameserver = "1.1.1.53"
+		)
+
+		Expect(
+			setup.addNetworkInterface(
+				newVMISpecIfaceWithBridgeBinding(primaryNetworkName),
+				newVMISpecPodNetwork(primaryNetworkName),
+				newDomainSpecIface(primaryNetworkName, origMAC),
+				podIPv4,
+			),
+		).To(Succeed())
+
+		guestAgentIface := newDomainStatusIface([]string{podIPv4}, origMAC, primaryIfaceName)
+		guestAgentIface.IPsWithPrefix = []string{podIPv4 + "/24"}
+		guestAgentIface.DefaultRoutes = []api.InterfaceRoute{{InterfaceName: primaryIfaceName, Gateway: gateway, Metric: 100}}
+		setup.addGuestAgentInterfaces(guestAgentIface)
+		setup.Domain.Status.GuestDNS = api.GuestDNS{Nameservers: []string{nameserver}, Searches: []string{"example.com"}}
+
+		Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+		expectedIface := newVMIStatusIface(primaryNetworkName, "", []string{podIPv4}, origMAC, primaryIfaceName, netvmispec.InfoSourceDomainAndGA, netsetup.DefaultInterfaceQueueCount)
+		expectedIface.IPsWithPrefix = []string{podIPv4 + "/24"}
+		expectedIface.DefaultRoutes = []v1.VirtualMachineInstanceNetworkInterfaceRoute{{Gateway: gateway, Metric: 100}}
+		Expect(setup.Vmi.Status.Interfaces).To(Equal([]v1.VirtualMachineInstanceNetworkInterface{expectedIface}))
+		Expect(setup.Vmi.Status.GuestDNS).To(Equal(&v1.VirtualMachineInstanceGuestDNS{
+			Nameservers: []string{nameserver},
+			Searches:    []string{"example.com"},
+		}))
+	})
+
 	It("should report SR-IOV interface when guest-agent is inactive and no other interface exists", func() {
 		const (
 			networkName = "sriov-network"
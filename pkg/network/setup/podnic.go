@@ -31,6 +31,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	dhcpconfigurator "kubevirt.io/kubevirt/pkg/network/dhcp"
+	"kubevirt.io/kubevirt/pkg/network/dns"
 	"kubevirt.io/kubevirt/pkg/network/domainspec"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
 	"kubevirt.io/kubevirt/pkg/network/link"
@@ -122,7 +123,8 @@ func (l *podNIC) newDHCPConfigurator() dhcpconfigurator.Configurator {
 			l.podInterfaceName,
 			l.vmi.Spec.Domain.Devices.Interfaces,
 			l.vmiSpecIface,
-			l.vmi.Spec.Subdomain)
+			l.vmi.Spec.Subdomain,
+			dns.IsForwarderEnabled(l.vmi) && l.vmiSpecNetwork.Pod == nil)
 	} else if l.vmiSpecIface.Masquerade != nil {
 		dhcpConfigurator = dhcpconfigurator.NewMasqueradeConfigurator(
 			link.GenerateBridgeName(l.podInterfaceName),
@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package redaction
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+type recorder struct {
+	recorder         record.EventRecorder
+	redactedRecorder record.EventRecorder
+	clusterConfig    *virtconfig.ClusterConfig
+	clientset        kubecli.KubevirtClient
+	vmiStore         cache.Store
+}
+
+// NewRecorder returns an event recorder redacting the infrastructure details from the events of VMIs
+// when the InfraDetailsRedaction feature gate is enabled. The full message of a redacted event is
+// stored in the InfraDetailsAnnotation of the latest version of the VMI found in vmiStore.
+// Redacted events are emitted through redactedRecorder, which is expected to leave out the host from
+// the event source.
+func NewRecorder(eventRecorder, redactedRecorder record.EventRecorder, clusterConfig *virtconfig.ClusterConfig, clientset kubecli.KubevirtClient, vmiStore cache.Store) record.EventRecorder {
+	return &recorder{
+		recorder:         eventRecorder,
+		redactedRecorder: redactedRecorder,
+		clusterConfig:    clusterConfig,
+		clientset:        clientset,
+		vmiStore:         vmiStore,
+	}
+}
+
+func (r *recorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.AnnotatedEventf(object, nil, eventtype, reason, "%s", message)
+}
+
+func (r *recorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.AnnotatedEventf(object, nil, eventtype, reason, messageFmt, args...)
+}
+
+func (r *recorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	vmi, isVMI := object.(*v1.VirtualMachineInstance)
+	if !isVMI || !r.clusterConfig.InfraDetailsRedactionEnabled() {
+		r.recorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+		return
+	}
+
+	message := fmt.Sprintf(messageFmt, args...)
+	redacted := Redact(vmi, message)
+	if redacted != message {
+		if err := r.storeEventDetails(vmi, reason, message); err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to store the details of the %s event", reason)
+		}
+	}
+	r.redactedRecorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", redacted)
+}
+
+func (r *recorder) storeEventDetails(vmi *v1.VirtualMachineInstance, reason, message string) error {
+	// The object passed to the recorder is often outdated, don't override the details stored since
+	obj, exists, err := r.vmiStore.GetByKey(controller.NamespacedKey(vmi.Namespace, vmi.Name))
+	if err != nil {
+		return err
+	} else if !exists {
+		return fmt.Errorf("VMI %s/%s does not exist", vmi.Namespace, vmi.Name)
+	}
+	current := obj.(*v1.VirtualMachineInstance).DeepCopy()
+
+	stored := getStoredDetails(current)
+	stored[eventKeyPrefix+reason] = message
+	setStoredDetails(current, stored)
+
+	_, err = r.clientset.VirtualMachineInstance(current.Namespace).Update(context.Background(), current, metav1.UpdateOptions{})
+	return err
+}
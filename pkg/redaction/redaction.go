@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package redaction
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	Placeholder = "<redacted>"
+
+	conditionKeyPrefix = "condition/"
+	eventKeyPrefix     = "event/"
+)
+
+// infraDetails returns the infrastructure details of the VMI which must not show up in the messages
+// visible to its owner, longest first so that a name is never partially replaced by one of its prefixes.
+func infraDetails(vmi *v1.VirtualMachineInstance) []string {
+	details := map[string]struct{}{}
+	add := func(detail string) {
+		if detail != "" {
+			details[detail] = struct{}{}
+		}
+	}
+
+	add(vmi.Status.NodeName)
+	for _, node := range vmi.Status.ActivePods {
+		add(node)
+	}
+	if migrationState := vmi.Status.MigrationState; migrationState != nil {
+		add(migrationState.SourceNode)
+		add(migrationState.TargetNode)
+		add(migrationState.SourcePod)
+		add(migrationState.TargetPod)
+	}
+	for _, iface := range vmi.Status.Interfaces {
+		add(iface.PodInterfaceName)
+	}
+
+	sorted := make([]string, 0, len(details))
+	for detail := range details {
+		sorted = append(sorted, detail)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if len(sorted[i]) != len(sorted[j]) {
+			return len(sorted[i]) > len(sorted[j])
+		}
+		return sorted[i] < sorted[j]
+	})
+	return sorted
+}
+
+// Redact replaces the infrastructure details of the VMI in the message
+func Redact(vmi *v1.VirtualMachineInstance, message string) string {
+	for _, detail := range infraDetails(vmi) {
+		message = strings.ReplaceAll(message, detail, Placeholder)
+	}
+	return message
+}
+
+// RedactConditions redacts the messages of the VMI conditions in place. The original message of
+// every redacted condition is kept in the InfraDetailsAnnotation, the entries of conditions which
+// are gone or don't need redaction anymore are dropped.
+func RedactConditions(vmi *v1.VirtualMachineInstance) {
+	previous := getStoredDetails(vmi)
+	stored := map[string]string{}
+	for key, message := range previous {
+		if !strings.HasPrefix(key, conditionKeyPrefix) {
+			stored[key] = message
+		}
+	}
+
+	for i := range vmi.Status.Conditions {
+		condition := &vmi.Status.Conditions[i]
+		key := conditionKeyPrefix + string(condition.Type)
+		if redacted := Redact(vmi, condition.Message); redacted != condition.Message {
+			stored[key] = condition.Message
+			condition.Message = redacted
+		} else if message, exists := previous[key]; exists && Redact(vmi, message) == condition.Message {
+			// The condition was redacted in a previous round and didn't change since
+			stored[key] = message
+		}
+	}
+
+	setStoredDetails(vmi, stored)
+}
+
+func getStoredDetails(vmi *v1.VirtualMachineInstance) map[string]string {
+	stored := map[string]string{}
+	value, exists := vmi.Annotations[v1.InfraDetailsAnnotation]
+	if !exists {
+		return stored
+	}
+	if err := json.Unmarshal([]byte(value), &stored); err != nil {
+		log.Log.Object(vmi).Reason(err).Warningf("ignoring malformed %s annotation", v1.InfraDetailsAnnotation)
+		return map[string]string{}
+	}
+	return stored
+}
+
+func setStoredDetails(vmi *v1.VirtualMachineInstance, stored map[string]string) {
+	if len(stored) == 0 {
+		delete(vmi.Annotations, v1.InfraDetailsAnnotation)
+		return
+	}
+	value, err := json.Marshal(stored)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("failed to marshal the %s annotation", v1.InfraDetailsAnnotation)
+		return
+	}
+	if vmi.Annotations == nil {
+		vmi.Annotations = map[string]string{}
+	}
+	vmi.Annotations[v1.InfraDetailsAnnotation] = string(value)
+}
@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package redaction
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Infrastructure details redaction", func() {
+	newVMI := func() *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Status.NodeName = "node01"
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			SourceNode: "node01",
+			TargetNode: "node012",
+		}
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{PodInterfaceName: "eth0"}}
+		return vmi
+	}
+
+	It("should replace the node and host interface names", func() {
+		Expect(Redact(newVMI(), "migrating from node01 to node012 over eth0")).
+			To(Equal("migrating from <redacted> to <redacted> over <redacted>"))
+	})
+
+	It("should keep the messages without infrastructure details", func() {
+		Expect(Redact(newVMI(), "guest agent connected")).To(Equal("guest agent connected"))
+	})
+
+	Context("conditions", func() {
+		It("should redact the message and store the original in the annotation", func() {
+			vmi := newVMI()
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{Type: v1.VirtualMachineInstanceIsMigratable, Message: "node01 is cordoned"},
+				{Type: v1.VirtualMachineInstanceReady},
+			}
+
+			RedactConditions(vmi)
+
+			Expect(vmi.Status.Conditions[0].Message).To(Equal("<redacted> is cordoned"))
+			Expect(vmi.Annotations).To(HaveKeyWithValue(v1.InfraDetailsAnnotation,
+				`{"condition/LiveMigratable":"node01 is cordoned"}`))
+		})
+
+		It("should keep the stored message of an unchanged redacted condition", func() {
+			vmi := newVMI()
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{Type: v1.VirtualMachineInstanceIsMigratable, Message: "node01 is cordoned"},
+			}
+			RedactConditions(vmi)
+			RedactConditions(vmi)
+
+			Expect(vmi.Annotations).To(HaveKeyWithValue(v1.InfraDetailsAnnotation,
+				`{"condition/LiveMigratable":"node01 is cordoned"}`))
+		})
+
+		It("should drop the stored message of a removed condition but keep the events", func() {
+			vmi := newVMI()
+			vmi.Annotations = map[string]string{
+				v1.InfraDetailsAnnotation: `{"condition/LiveMigratable":"node01 is cordoned","event/Started":"started on node01"}`,
+			}
+
+			RedactConditions(vmi)
+
+			Expect(vmi.Annotations).To(HaveKeyWithValue(v1.InfraDetailsAnnotation, `{"event/Started":"started on node01"}`))
+		})
+
+		It("should remove the annotation when nothing is redacted", func() {
+			vmi := newVMI()
+			vmi.Annotations = map[string]string{v1.InfraDetailsAnnotation: `{"condition/Ready":"node01"}`}
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{Type: v1.VirtualMachineInstanceReady}}
+
+			RedactConditions(vmi)
+
+			Expect(vmi.Annotations).ToNot(HaveKey(v1.InfraDetailsAnnotation))
+		})
+	})
+})
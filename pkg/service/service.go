@@ -21,12 +21,15 @@ package service
 
 import (
 	goflag "flag"
-	"fmt"
+	"net"
 	"strconv"
 
 	flag "github.com/spf13/pflag"
 
 	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/fips"
 )
 
 func init() {
@@ -49,7 +52,7 @@ type ServiceLibvirt struct {
 }
 
 func (service *ServiceListen) Address() string {
-	return fmt.Sprintf("%s:%s", service.BindAddress, strconv.Itoa(service.Port))
+	return net.JoinHostPort(service.BindAddress, strconv.Itoa(service.Port))
 }
 
 func (service *ServiceListen) InitFlags() {
@@ -75,4 +78,6 @@ func Setup(service Service) {
 	flag.Set("v", "2")
 
 	flag.Parse()
+
+	log.Log.Infof("FIPS validated crypto module in use: %t", fips.Enabled())
 }
@@ -0,0 +1,311 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package checker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+
+	diskcheckv1alpha1 "kubevirt.io/api/diskcheck/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	QEMUIMGPath = "/usr/bin/qemu-img"
+
+	// VolumesEnv holds the JSON encoded volumes to check
+	VolumesEnv = "DISK_CHECK_VOLUMES"
+	// SparsifyEnv holds the JSON encoded options of the rewrite of the images, when requested
+	SparsifyEnv = "DISK_CHECK_SPARSIFY"
+
+	rawFormat   = "raw"
+	qcow2Format = "qcow2"
+
+	// Exit codes of qemu-img check
+	checkCorruptionsExitCode  = 2
+	checkLeaksExitCode        = 3
+	checkNotSupportedExitCode = 63
+
+	scanChunkSize = 1024 * 1024
+	// maxMessageLength keeps the results within the 4096 bytes of a termination message
+	maxMessageLength = 256
+)
+
+// Volume is a volume to check, as passed by the disk check controller
+type Volume struct {
+	Name      string `json:"name"`
+	ClaimName string `json:"claimName"`
+	Path      string `json:"path"`
+}
+
+type imageInfo struct {
+	Format          string `json:"format"`
+	ActualSize      int64  `json:"actual-size"`
+	ClusterSize     int64  `json:"cluster-size"`
+	BackingFilename string `json:"backing-filename"`
+}
+
+type checkReport struct {
+	CheckErrors int64 `json:"check-errors"`
+	Corruptions int64 `json:"corruptions"`
+	Leaks       int64 `json:"leaks"`
+}
+
+// QEMUImgFunc runs qemu-img with the given arguments, and returns its standard output and exit code
+type QEMUImgFunc func(args ...string) ([]byte, int, error)
+
+type Checker struct {
+	qemuImg   QEMUImgFunc
+	open      func(path string) (io.ReaderAt, int64, error)
+	freeSpace func(path string) (int64, error)
+	sparsify  *diskcheckv1alpha1.DiskSparsifyOptions
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		qemuImg:   runQEMUImg,
+		open:      openImage,
+		freeSpace: freeSpace,
+	}
+}
+
+func NewCheckerWithFuncs(qemuImg QEMUImgFunc, open func(path string) (io.ReaderAt, int64, error)) *Checker {
+	return &Checker{
+		qemuImg:   qemuImg,
+		open:      open,
+		freeSpace: freeSpace,
+	}
+}
+
+// WithSparsify makes the checker rewrite the images found consistent
+func (c *Checker) WithSparsify(options *diskcheckv1alpha1.DiskSparsifyOptions) *Checker {
+	c.sparsify = options
+	return c
+}
+
+// VolumesFromEnv returns the volumes to check from the environment
+func VolumesFromEnv() ([]Volume, error) {
+	var volumes []Volume
+	if err := json.Unmarshal([]byte(os.Getenv(VolumesEnv)), &volumes); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", VolumesEnv, err)
+	}
+	return volumes, nil
+}
+
+// SparsifyFromEnv returns the options of the rewrite of the images from the environment, nil if not requested
+func SparsifyFromEnv() (*diskcheckv1alpha1.DiskSparsifyOptions, error) {
+	value, exists := os.LookupEnv(SparsifyEnv)
+	if !exists {
+		return nil, nil
+	}
+	options := &diskcheckv1alpha1.DiskSparsifyOptions{}
+	if err := json.Unmarshal([]byte(value), options); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", SparsifyEnv, err)
+	}
+	return options, nil
+}
+
+// CheckVolumes checks each volume in turn, a volume failing to be checked doesn't prevent the next ones from being checked
+func (c *Checker) CheckVolumes(volumes []Volume) []diskcheckv1alpha1.VolumeCheckResult {
+	results := make([]diskcheckv1alpha1.VolumeCheckResult, 0, len(volumes))
+	for _, volume := range volumes {
+		log.Log.Infof("Checking volume %s, PVC %s", volume.Name, volume.ClaimName)
+		result := c.checkVolume(volume)
+		log.Log.Infof("Volume %s: %s %s", volume.Name, result.Result, result.Message)
+		if c.sparsify != nil {
+			result.Sparsify = c.sparsifyVolume(volume, result)
+			if result.Sparsify != nil {
+				log.Log.Infof("Volume %s: %s %s", volume.Name, result.Sparsify.Result, result.Sparsify.Message)
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func (c *Checker) checkVolume(volume Volume) diskcheckv1alpha1.VolumeCheckResult {
+	result := diskcheckv1alpha1.VolumeCheckResult{
+		Name:      volume.Name,
+		ClaimName: volume.ClaimName,
+	}
+
+	info, err := c.imageInfo(volume.Path)
+	if err != nil {
+		return checkError(result, err)
+	}
+	result.Format = info.Format
+
+	if info.Format != rawFormat {
+		report, supported, err := c.checkImage(volume.Path, info.Format)
+		if err != nil {
+			return checkError(result, err)
+		}
+		if supported {
+			result.Corruptions = report.Corruptions
+			result.Leaks = report.Leaks
+			switch {
+			case report.Corruptions > 0:
+				result.Result = diskcheckv1alpha1.VolumeCorrupted
+			case report.CheckErrors > 0:
+				return checkError(result, fmt.Errorf("%d errors occurred during the check", report.CheckErrors))
+			case report.Leaks > 0:
+				result.Result = diskcheckv1alpha1.VolumeLeaked
+			default:
+				result.Result = diskcheckv1alpha1.VolumeClean
+			}
+			return result
+		}
+		log.Log.Infof("The %s format doesn't support checks, reading the whole image instead", info.Format)
+	}
+
+	unreadable, err := c.scanImage(volume.Path)
+	if err != nil {
+		return checkError(result, err)
+	}
+	if unreadable > 0 {
+		result.Corruptions = unreadable
+		result.Result = diskcheckv1alpha1.VolumeCorrupted
+		result.Message = fmt.Sprintf("%d unreadable blocks of %d bytes", unreadable, scanChunkSize)
+		return result
+	}
+	result.Result = diskcheckv1alpha1.VolumeClean
+	return result
+}
+
+func (c *Checker) imageInfo(path string) (*imageInfo, error) {
+	out, exitCode, err := c.qemuImg("info", "--output=json", path)
+	if err != nil {
+		return nil, err
+	}
+	if exitCode != 0 {
+		return nil, fmt.Errorf("qemu-img info failed with exit code %d", exitCode)
+	}
+	info := &imageInfo{}
+	if err := json.Unmarshal(out, info); err != nil {
+		return nil, fmt.Errorf("failed to parse the image info: %v", err)
+	}
+	return info, nil
+}
+
+// checkImage runs qemu-img check, it returns false when the format doesn't support checks
+func (c *Checker) checkImage(path, format string) (*checkReport, bool, error) {
+	out, exitCode, err := c.qemuImg("check", "--output=json", "-f", format, path)
+	if err != nil {
+		return nil, false, err
+	}
+	switch exitCode {
+	case 0, checkCorruptionsExitCode, checkLeaksExitCode:
+	case checkNotSupportedExitCode:
+		return nil, false, nil
+	default:
+		return nil, false, fmt.Errorf("qemu-img check failed with exit code %d", exitCode)
+	}
+	report := &checkReport{}
+	if err := json.Unmarshal(out, report); err != nil {
+		return nil, false, fmt.Errorf("failed to parse the check report: %v", err)
+	}
+	return report, true, nil
+}
+
+// scanImage reads the whole image, and returns the number of chunks that couldn't be read
+func (c *Checker) scanImage(path string) (int64, error) {
+	image, size, err := c.open(path)
+	if err != nil {
+		return 0, err
+	}
+	if closer, ok := image.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	var unreadable int64
+	buf := make([]byte, scanChunkSize)
+	for offset := int64(0); offset < size; offset += scanChunkSize {
+		length := min(scanChunkSize, size-offset)
+		if _, err := image.ReadAt(buf[:length], offset); err != nil && !errors.Is(err, io.EOF) {
+			log.Log.Reason(err).Warningf("Failed to read %d bytes at offset %d", length, offset)
+			unreadable++
+		}
+	}
+	return unreadable, nil
+}
+
+func checkError(result diskcheckv1alpha1.VolumeCheckResult, err error) diskcheckv1alpha1.VolumeCheckResult {
+	result.Result = diskcheckv1alpha1.VolumeCheckError
+	result.Message = err.Error()
+	return result
+}
+
+// EncodeResults encodes the results for the termination message of the pod, truncating
+// the messages for the results of many volumes to fit in it
+func EncodeResults(results []diskcheckv1alpha1.VolumeCheckResult) ([]byte, error) {
+	for i := range results {
+		if len(results[i].Message) > maxMessageLength {
+			results[i].Message = results[i].Message[:maxMessageLength]
+		}
+		if results[i].Sparsify != nil && len(results[i].Sparsify.Message) > maxMessageLength {
+			results[i].Sparsify.Message = results[i].Sparsify.Message[:maxMessageLength]
+		}
+	}
+	return json.Marshal(results)
+}
+
+// DecodeResults decodes the results from the termination message of the pod
+func DecodeResults(message string) ([]diskcheckv1alpha1.VolumeCheckResult, error) {
+	var results []diskcheckv1alpha1.VolumeCheckResult
+	if err := json.Unmarshal([]byte(message), &results); err != nil {
+		return nil, fmt.Errorf("failed to parse the results of the check: %v", err)
+	}
+	return results, nil
+}
+
+func runQEMUImg(args ...string) ([]byte, int, error) {
+	// #nosec No risk for attacker injection. The arguments are the paths of the volumes mounted by the controller
+	cmd := exec.Command(QEMUIMGPath, args...)
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			if len(exitErr.Stderr) > 0 {
+				log.Log.Infof("qemu-img %s: %s", args[0], exitErr.Stderr)
+			}
+			return out, exitErr.ExitCode(), nil
+		}
+		return nil, 0, fmt.Errorf("failed to invoke qemu-img: %v", err)
+	}
+	return out, 0, nil
+}
+
+// openImage opens a disk image file or a block device, and returns its size
+func openImage(path string) (io.ReaderAt, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	return f, size, nil
+}
@@ -0,0 +1,250 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package checker
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	diskcheckv1alpha1 "kubevirt.io/api/diskcheck/v1alpha1"
+)
+
+type fakeQEMUImg struct {
+	info          string
+	check         string
+	checkExitCode int
+	// rewriteInfo is the info of the rewritten image
+	rewriteInfo     string
+	compareExitCode int
+	convertArgs     []string
+}
+
+func (f *fakeQEMUImg) run(args ...string) ([]byte, int, error) {
+	switch args[0] {
+	case "info":
+		if strings.HasSuffix(args[len(args)-1], sparsifySuffix) {
+			return []byte(f.rewriteInfo), 0, nil
+		}
+		return []byte(f.info), 0, nil
+	case "check":
+		return []byte(f.check), f.checkExitCode, nil
+	case "convert":
+		f.convertArgs = args
+		return nil, 0, os.WriteFile(args[len(args)-1], []byte("rewritten"), 0600)
+	case "compare":
+		return nil, f.compareExitCode, nil
+	}
+	return nil, 0, fmt.Errorf("unexpected command %v", args)
+}
+
+// failingReaderAt fails to read the chunks at the given offsets
+type failingReaderAt struct {
+	io.ReaderAt
+	failing map[int64]bool
+}
+
+func (r *failingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if r.failing[off] {
+		return 0, fmt.Errorf("input/output error")
+	}
+	return r.ReaderAt.ReadAt(p, off)
+}
+
+var _ = Describe("Disk checker", func() {
+	var volume = Volume{Name: "disk0", ClaimName: "pvc0", Path: "/disk-check-volumes/disk0/disk.img"}
+
+	newChecker := func(qemuImg *fakeQEMUImg, image io.ReaderAt, size int64) *Checker {
+		return NewCheckerWithFuncs(qemuImg.run, func(path string) (io.ReaderAt, int64, error) {
+			Expect(path).To(Equal(volume.Path))
+			return image, size, nil
+		})
+	}
+
+	DescribeTable("should report the findings of qemu-img check", func(check string, exitCode int, expected diskcheckv1alpha1.VolumeCheckResult) {
+		qemuImg := &fakeQEMUImg{info: `{"format": "qcow2"}`, check: check, checkExitCode: exitCode}
+		results := newChecker(qemuImg, nil, 0).CheckVolumes([]Volume{volume})
+		Expect(results).To(ConsistOf(expected))
+	},
+		Entry("when the image is clean", `{"check-errors": 0}`, 0, diskcheckv1alpha1.VolumeCheckResult{
+			Name: "disk0", ClaimName: "pvc0", Format: "qcow2", Result: diskcheckv1alpha1.VolumeClean,
+		}),
+		Entry("when the image has leaks", `{"check-errors": 0, "leaks": 12}`, checkLeaksExitCode, diskcheckv1alpha1.VolumeCheckResult{
+			Name: "disk0", ClaimName: "pvc0", Format: "qcow2", Result: diskcheckv1alpha1.VolumeLeaked, Leaks: 12,
+		}),
+		Entry("when the image is corrupted", `{"check-errors": 0, "corruptions": 3, "leaks": 1}`, checkCorruptionsExitCode, diskcheckv1alpha1.VolumeCheckResult{
+			Name: "disk0", ClaimName: "pvc0", Format: "qcow2", Result: diskcheckv1alpha1.VolumeCorrupted, Corruptions: 3, Leaks: 1,
+		}),
+		Entry("when the check has errors", `{"check-errors": 2}`, 0, diskcheckv1alpha1.VolumeCheckResult{
+			Name: "disk0", ClaimName: "pvc0", Format: "qcow2", Result: diskcheckv1alpha1.VolumeCheckError, Message: "2 errors occurred during the check",
+		}),
+		Entry("when qemu-img check fails", "", 1, diskcheckv1alpha1.VolumeCheckResult{
+			Name: "disk0", ClaimName: "pvc0", Format: "qcow2", Result: diskcheckv1alpha1.VolumeCheckError, Message: "qemu-img check failed with exit code 1",
+		}),
+	)
+
+	It("should read the whole raw images", func() {
+		image := &failingReaderAt{
+			ReaderAt: bytes.NewReader(make([]byte, 3*scanChunkSize+10)),
+			failing:  map[int64]bool{scanChunkSize: true},
+		}
+		results := newChecker(&fakeQEMUImg{info: `{"format": "raw"}`}, image, 3*scanChunkSize+10).CheckVolumes([]Volume{volume})
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Result).To(Equal(diskcheckv1alpha1.VolumeCorrupted))
+		Expect(results[0].Corruptions).To(Equal(int64(1)))
+	})
+
+	It("should read the whole image when its format doesn't support checks", func() {
+		qemuImg := &fakeQEMUImg{info: `{"format": "vpc"}`, checkExitCode: checkNotSupportedExitCode}
+		results := newChecker(qemuImg, bytes.NewReader(make([]byte, 10)), 10).CheckVolumes([]Volume{volume})
+		Expect(results).To(ConsistOf(diskcheckv1alpha1.VolumeCheckResult{
+			Name: "disk0", ClaimName: "pvc0", Format: "vpc", Result: diskcheckv1alpha1.VolumeClean,
+		}))
+	})
+
+	It("should check the next volumes when a volume fails to be checked", func() {
+		qemuImg := &fakeQEMUImg{info: "not json"}
+		results := newChecker(qemuImg, nil, 0).CheckVolumes([]Volume{volume, {Name: "disk1", ClaimName: "pvc1"}})
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Result).To(Equal(diskcheckv1alpha1.VolumeCheckError))
+		Expect(results[1].Result).To(Equal(diskcheckv1alpha1.VolumeCheckError))
+	})
+
+	It("should truncate the messages to fit the termination message", func() {
+		results := make([]diskcheckv1alpha1.VolumeCheckResult, 10)
+		for i := range results {
+			results[i] = diskcheckv1alpha1.VolumeCheckResult{
+				Name:      fmt.Sprintf("disk%d", i),
+				ClaimName: fmt.Sprintf("pvc%d", i),
+				Result:    diskcheckv1alpha1.VolumeCheckError,
+				Message:   strings.Repeat("x", 1000),
+			}
+		}
+		encoded, err := EncodeResults(results)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(len(encoded)).To(BeNumerically("<", 4096))
+
+		decoded, err := DecodeResults(string(encoded))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded).To(HaveLen(10))
+		Expect(decoded[0].Message).To(HaveLen(maxMessageLength))
+	})
+
+	Context("with sparsify", func() {
+		var (
+			imagePath string
+			qemuImg   *fakeQEMUImg
+		)
+
+		BeforeEach(func() {
+			imagePath = filepath.Join(GinkgoT().TempDir(), "disk.img")
+			Expect(os.WriteFile(imagePath, []byte("original"), 0660)).To(Succeed())
+			Expect(os.Chmod(imagePath, 0660)).To(Succeed())
+			qemuImg = &fakeQEMUImg{
+				info:        `{"format": "qcow2", "actual-size": 1000, "cluster-size": 65536}`,
+				check:       `{"check-errors": 0}`,
+				rewriteInfo: `{"format": "qcow2", "actual-size": 400}`,
+			}
+		})
+
+		sparsify := func(compress bool) *diskcheckv1alpha1.VolumeSparsifyResult {
+			checker := NewCheckerWithFuncs(qemuImg.run, nil).WithSparsify(&diskcheckv1alpha1.DiskSparsifyOptions{Compress: compress})
+			results := checker.CheckVolumes([]Volume{{Name: "disk0", ClaimName: "pvc0", Path: imagePath}})
+			Expect(results).To(HaveLen(1))
+			Expect(imagePath + sparsifySuffix).ToNot(BeAnExistingFile())
+			return results[0].Sparsify
+		}
+
+		expectImage := func(content string) {
+			data, err := os.ReadFile(imagePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(content))
+		}
+
+		It("should replace the image by its smaller rewrite", func() {
+			Expect(sparsify(false)).To(Equal(&diskcheckv1alpha1.VolumeSparsifyResult{
+				Result: diskcheckv1alpha1.VolumeSparsified, SizeBefore: 1000, SizeAfter: 400,
+			}))
+			expectImage("rewritten")
+			stat, err := os.Stat(imagePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stat.Mode().Perm()).To(Equal(os.FileMode(0660)))
+			Expect(qemuImg.convertArgs).To(ContainElement("cluster_size=65536"))
+			Expect(qemuImg.convertArgs).ToNot(ContainElement("-c"))
+		})
+
+		It("should compress the rewritten image", func() {
+			Expect(sparsify(true).Result).To(Equal(diskcheckv1alpha1.VolumeSparsified))
+			Expect(qemuImg.convertArgs).To(ContainElement("-c"))
+		})
+
+		It("should keep the image when its rewrite is not smaller", func() {
+			qemuImg.rewriteInfo = `{"format": "qcow2", "actual-size": 1000}`
+			result := sparsify(false)
+			Expect(result.Result).To(Equal(diskcheckv1alpha1.VolumeSparsifySkipped))
+			Expect(result.SizeAfter).To(Equal(int64(1000)))
+			expectImage("original")
+		})
+
+		It("should keep the image when its rewrite differs", func() {
+			qemuImg.compareExitCode = 1
+			result := sparsify(false)
+			Expect(result.Result).To(Equal(diskcheckv1alpha1.VolumeSparsifyError))
+			Expect(result.Message).To(ContainSubstring("the rewritten image differs"))
+			expectImage("original")
+		})
+
+		DescribeTable("should not rewrite", func(info, check string, checkExitCode int) {
+			qemuImg.info = info
+			qemuImg.check = check
+			qemuImg.checkExitCode = checkExitCode
+			Expect(sparsify(false).Result).To(Equal(diskcheckv1alpha1.VolumeSparsifySkipped))
+			Expect(qemuImg.convertArgs).To(BeNil())
+			expectImage("original")
+		},
+			Entry("corrupted images", `{"format": "qcow2"}`, `{"corruptions": 1}`, checkCorruptionsExitCode),
+			Entry("images with a backing file", `{"format": "qcow2", "backing-filename": "base.qcow2"}`, `{}`, 0),
+		)
+
+		It("should not rewrite the images of other formats", func() {
+			qemuImg.info = `{"format": "raw"}`
+			checker := NewCheckerWithFuncs(qemuImg.run, func(path string) (io.ReaderAt, int64, error) {
+				return bytes.NewReader(make([]byte, 10)), 10, nil
+			}).WithSparsify(&diskcheckv1alpha1.DiskSparsifyOptions{})
+			results := checker.CheckVolumes([]Volume{{Name: "disk0", ClaimName: "pvc0", Path: imagePath}})
+			Expect(results[0].Sparsify.Result).To(Equal(diskcheckv1alpha1.VolumeSparsifySkipped))
+			expectImage("original")
+		})
+
+		It("should not rewrite the image without enough free space", func() {
+			checker := NewCheckerWithFuncs(qemuImg.run, nil).WithSparsify(&diskcheckv1alpha1.DiskSparsifyOptions{})
+			checker.freeSpace = func(string) (int64, error) { return 10, nil }
+			results := checker.CheckVolumes([]Volume{{Name: "disk0", ClaimName: "pvc0", Path: imagePath}})
+			Expect(results[0].Sparsify.Result).To(Equal(diskcheckv1alpha1.VolumeSparsifySkipped))
+			Expect(qemuImg.convertArgs).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,174 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package checker
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	diskcheckv1alpha1 "kubevirt.io/api/diskcheck/v1alpha1"
+)
+
+// sparsifySuffix is appended to the path of an image to name its rewrite
+const sparsifySuffix = ".sparsify"
+
+// sparsifyVolume rewrites the qcow2 image of a volume found consistent, dropping its unused and zeroed
+// clusters, and replaces the image by its rewrite when it is smaller. The image is left untouched otherwise.
+func (c *Checker) sparsifyVolume(volume Volume, check diskcheckv1alpha1.VolumeCheckResult) *diskcheckv1alpha1.VolumeSparsifyResult {
+	switch check.Result {
+	case diskcheckv1alpha1.VolumeClean, diskcheckv1alpha1.VolumeLeaked:
+	case diskcheckv1alpha1.VolumeCorrupted:
+		return sparsifySkipped(0, "Corrupted images are not rewritten")
+	default:
+		return nil
+	}
+	if check.Format != qcow2Format {
+		return sparsifySkipped(0, fmt.Sprintf("Only qcow2 images are rewritten, not %s ones", check.Format))
+	}
+
+	stat, err := os.Stat(volume.Path)
+	if err != nil {
+		return sparsifyError(0, err)
+	}
+	if !stat.Mode().IsRegular() {
+		return sparsifySkipped(0, "The images of block volumes are not rewritten")
+	}
+
+	info, err := c.imageInfo(volume.Path)
+	if err != nil {
+		return sparsifyError(0, err)
+	}
+	sizeBefore := info.ActualSize
+	if info.BackingFilename != "" {
+		return sparsifySkipped(sizeBefore, "Images with a backing file are not rewritten")
+	}
+
+	// The rewrite takes at most the space of the image
+	free, err := c.freeSpace(filepath.Dir(volume.Path))
+	if err != nil {
+		return sparsifyError(sizeBefore, err)
+	}
+	if free < sizeBefore {
+		return sparsifySkipped(sizeBefore, fmt.Sprintf("%d bytes are free on the volume, the rewrite of the image needs up to %d", free, sizeBefore))
+	}
+
+	rewritePath := volume.Path + sparsifySuffix
+	// Remove the rewrite left by an interrupted check, and the one not replacing the image
+	os.Remove(rewritePath)
+	defer os.Remove(rewritePath)
+
+	sizeAfter, err := c.rewriteImage(volume.Path, rewritePath, info)
+	if err != nil {
+		return sparsifyError(sizeBefore, err)
+	}
+	if sizeAfter >= sizeBefore {
+		return sparsifySkipped(sizeBefore, fmt.Sprintf("The rewritten image is not smaller, it takes %d bytes", sizeAfter))
+	}
+
+	if err := copyOwnership(stat, rewritePath); err != nil {
+		return sparsifyError(sizeBefore, err)
+	}
+	if err := os.Rename(rewritePath, volume.Path); err != nil {
+		return sparsifyError(sizeBefore, err)
+	}
+	return &diskcheckv1alpha1.VolumeSparsifyResult{
+		Result:     diskcheckv1alpha1.VolumeSparsified,
+		SizeBefore: sizeBefore,
+		SizeAfter:  sizeAfter,
+	}
+}
+
+// rewriteImage converts the image to a new qcow2 image, verifies both have the same content, and returns
+// the space allocated to the new one
+func (c *Checker) rewriteImage(path, rewritePath string, info *imageInfo) (int64, error) {
+	args := []string{"convert", "-f", qcow2Format, "-O", qcow2Format}
+	if info.ClusterSize > 0 {
+		args = append(args, "-o", "cluster_size="+strconv.FormatInt(info.ClusterSize, 10))
+	}
+	if c.sparsify.Compress {
+		args = append(args, "-c")
+	}
+	args = append(args, path, rewritePath)
+	if _, exitCode, err := c.qemuImg(args...); err != nil {
+		return 0, err
+	} else if exitCode != 0 {
+		return 0, fmt.Errorf("qemu-img convert failed with exit code %d", exitCode)
+	}
+
+	if _, exitCode, err := c.qemuImg("compare", "-f", qcow2Format, "-F", qcow2Format, path, rewritePath); err != nil {
+		return 0, err
+	} else if exitCode != 0 {
+		return 0, fmt.Errorf("qemu-img compare failed with exit code %d, the rewritten image differs", exitCode)
+	}
+
+	rewriteInfo, err := c.imageInfo(rewritePath)
+	if err != nil {
+		return 0, err
+	}
+	return rewriteInfo.ActualSize, nil
+}
+
+// copyOwnership gives the rewritten image the mode and the owner of the image it replaces
+func copyOwnership(stat os.FileInfo, path string) error {
+	if err := os.Chmod(path, stat.Mode().Perm()); err != nil {
+		return err
+	}
+	sys, ok := stat.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+	rewriteStat, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if rewriteSys, ok := rewriteStat.Sys().(*syscall.Stat_t); ok && rewriteSys.Uid == sys.Uid && rewriteSys.Gid == sys.Gid {
+		return nil
+	}
+	return os.Chown(path, int(sys.Uid), int(sys.Gid))
+}
+
+func freeSpace(path string) (int64, error) {
+	var statfs syscall.Statfs_t
+	if err := syscall.Statfs(path, &statfs); err != nil {
+		return 0, err
+	}
+	return int64(statfs.Bavail) * statfs.Bsize, nil
+}
+
+func sparsifySkipped(sizeBefore int64, message string) *diskcheckv1alpha1.VolumeSparsifyResult {
+	return &diskcheckv1alpha1.VolumeSparsifyResult{
+		Result:     diskcheckv1alpha1.VolumeSparsifySkipped,
+		SizeBefore: sizeBefore,
+		SizeAfter:  sizeBefore,
+		Message:    message,
+	}
+}
+
+func sparsifyError(sizeBefore int64, err error) *diskcheckv1alpha1.VolumeSparsifyResult {
+	return &diskcheckv1alpha1.VolumeSparsifyResult{
+		Result:     diskcheckv1alpha1.VolumeSparsifyError,
+		SizeBefore: sizeBefore,
+		SizeAfter:  sizeBefore,
+		Message:    err.Error(),
+	}
+}
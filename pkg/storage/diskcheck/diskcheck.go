@@ -0,0 +1,797 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package diskcheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	diskcheckv1alpha1 "kubevirt.io/api/diskcheck/v1alpha1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/storage/diskcheck/checker"
+	"kubevirt.io/kubevirt/pkg/storage/snapshot"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+)
+
+const (
+	diskCheckPrefix = "virt-disk-check"
+
+	fileSystemMountPath  = "/disk-check-volumes"
+	blockVolumeMountPath = "/dev/disk-check-volumes"
+
+	sourceNotFoundReason     = "SourceNotFound"
+	sourceNotReadyReason     = "SourceNotReady"
+	vmRunningReason          = "VMRunning"
+	vmNotHaltedReason        = "VMNotHalted"
+	unsupportedSourceReason  = "UnsupportedSource"
+	volumeNotFoundReason     = "VolumeNotFound"
+	noVolumesReason          = "NoVolumes"
+	checkRunningReason       = "CheckRunning"
+	checkCompletedReason     = "CheckCompleted"
+	checkFailedReason        = "CheckFailed"
+	corruptionsFoundReason   = "CorruptionsFound"
+	noCorruptionsFoundReason = "NoCorruptionsFound"
+	sparsifyFailedReason     = "SparsifyFailed"
+	spaceReclaimedReason     = "SpaceReclaimed"
+
+	// requeueTime is the time after which the checks waiting for their source are reconciled again
+	requeueTime = 10 * time.Second
+)
+
+var currentTime = func() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
+
+// VMDiskCheckController is responsible for checking the disks of VMs and VM snapshots
+type VMDiskCheckController struct {
+	Client kubecli.KubevirtClient
+
+	TemplateService services.TemplateService
+
+	DiskCheckInformer         cache.SharedIndexInformer
+	VMInformer                cache.SharedIndexInformer
+	VMIInformer               cache.SharedIndexInformer
+	PodInformer               cache.SharedIndexInformer
+	PVCInformer               cache.SharedIndexInformer
+	DataVolumeInformer        cache.SharedIndexInformer
+	VMSnapshotInformer        cache.SharedIndexInformer
+	VMSnapshotContentInformer cache.SharedIndexInformer
+	VolumeSnapshotProvider    snapshot.VolumeSnapshotProvider
+
+	Recorder record.EventRecorder
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// sourceVolume is a volume of the source to check, with its PVC
+type sourceVolume struct {
+	name string
+	pvc  *corev1.PersistentVolumeClaim
+}
+
+// Init initializes the disk check controller
+func (ctrl *VMDiskCheckController) Init() error {
+	ctrl.queue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-diskcheck"},
+	)
+
+	_, err := ctrl.DiskCheckInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleDiskCheck,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleDiskCheck(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// The pods and the PVCs restored from snapshots are owned by their disk check
+	for _, informer := range []cache.SharedIndexInformer{ctrl.PodInformer, ctrl.PVCInformer} {
+		_, err = informer.AddEventHandler(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    ctrl.handleOwnedObject,
+				UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleOwnedObject(newObj) },
+				DeleteFunc: ctrl.handleOwnedObject,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The checks waiting for their source are reconciled on the changes of the sources in their namespace
+	for _, informer := range []cache.SharedIndexInformer{ctrl.VMInformer, ctrl.VMIInformer, ctrl.DataVolumeInformer, ctrl.VMSnapshotInformer} {
+		_, err = informer.AddEventHandler(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    ctrl.handleSource,
+				UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleSource(newObj) },
+				DeleteFunc: ctrl.handleSource,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *VMDiskCheckController) handleDiskCheck(obj interface{}) {
+	if diskCheck, ok := obj.(*diskcheckv1alpha1.VirtualMachineDiskCheck); ok {
+		key, _ := cache.MetaNamespaceKeyFunc(diskCheck)
+		ctrl.queue.Add(key)
+	}
+}
+
+func (ctrl *VMDiskCheckController) handleOwnedObject(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	object, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	owner := metav1.GetControllerOf(object)
+	if owner == nil || owner.Kind != diskcheckv1alpha1.VirtualMachineDiskCheckKind.Kind {
+		return
+	}
+	ctrl.queue.Add(controller.NamespacedKey(object.GetNamespace(), owner.Name))
+}
+
+func (ctrl *VMDiskCheckController) handleSource(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	object, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	objs, err := ctrl.DiskCheckInformer.GetIndexer().ByIndex(cache.NamespaceIndex, object.GetNamespace())
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	for _, obj := range objs {
+		diskCheck := obj.(*diskcheckv1alpha1.VirtualMachineDiskCheck)
+		if !isFinished(diskCheck) {
+			ctrl.handleDiskCheck(diskCheck)
+		}
+	}
+}
+
+// Run runs the passed in VMDiskCheckController.
+func (ctrl *VMDiskCheckController) Run(threadiness int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer ctrl.queue.ShutDown()
+
+	log.Log.Info("Starting disk check controller.")
+	defer log.Log.Info("Shutting down disk check controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.DiskCheckInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+		ctrl.PodInformer.HasSynced,
+		ctrl.PVCInformer.HasSynced,
+		ctrl.DataVolumeInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMSnapshotContentInformer.HasSynced,
+	) {
+		return
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+func (ctrl *VMDiskCheckController) runWorker() {
+	for ctrl.Execute() {
+	}
+}
+
+// Execute reconciles a disk check from the queue, if there is an error it requeues it.
+// Returns false if the queue is shut down.
+func (ctrl *VMDiskCheckController) Execute() bool {
+	key, quit := ctrl.queue.Get()
+	if quit {
+		return false
+	}
+	defer ctrl.queue.Done(key)
+
+	if requeue, err := ctrl.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineDiskCheck %v", key)
+		ctrl.queue.AddRateLimited(key)
+	} else if requeue > 0 {
+		ctrl.queue.AddAfter(key, requeue)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineDiskCheck %v", key)
+		ctrl.queue.Forget(key)
+	}
+	return true
+}
+
+func (ctrl *VMDiskCheckController) execute(key string) (time.Duration, error) {
+	obj, exists, err := ctrl.DiskCheckInformer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return 0, err
+	}
+	diskCheck := obj.(*diskcheckv1alpha1.VirtualMachineDiskCheck)
+	if diskCheck.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	if isFinished(diskCheck) {
+		return 0, ctrl.cleanup(diskCheck)
+	}
+
+	diskCheckCopy := diskCheck.DeepCopy()
+	requeue, err := ctrl.reconcile(diskCheckCopy)
+	if err != nil {
+		return 0, err
+	}
+	return requeue, ctrl.updateStatus(diskCheck, diskCheckCopy)
+}
+
+func (ctrl *VMDiskCheckController) reconcile(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck) (time.Duration, error) {
+	pod, podExists, err := ctrl.getPod(diskCheck)
+	if err != nil {
+		return 0, err
+	}
+	if podExists && pod.DeletionTimestamp != nil {
+		// The pod of an interrupted check is being deleted, wait for it to be gone
+		return requeueTime, nil
+	}
+	if podExists && isPodFinished(pod) {
+		ctrl.recordResults(diskCheck, pod)
+		return 0, nil
+	}
+
+	volumes, reason, message, err := ctrl.getSourceVolumes(diskCheck)
+	if err != nil {
+		return 0, err
+	}
+	switch reason {
+	case "":
+	case unsupportedSourceReason, volumeNotFoundReason, noVolumesReason:
+		setFailed(diskCheck, reason, message)
+		return 0, nil
+	default:
+		// The source is not ready to be checked, the check starts over once it is
+		if podExists {
+			if err := ctrl.deletePod(pod); err != nil {
+				return 0, err
+			}
+		}
+		diskCheck.Status.Phase = diskcheckv1alpha1.Pending
+		diskCheck.Status.StartTime = nil
+		diskCheck.Status.Conditions = updateCondition(diskCheck.Status.Conditions, newProgressingCondition(corev1.ConditionFalse, reason, message))
+		return requeueTime, nil
+	}
+
+	if !podExists {
+		if err := ctrl.createPod(diskCheck, volumes); err != nil {
+			return 0, err
+		}
+	}
+	if diskCheck.Status.Phase != diskcheckv1alpha1.Running {
+		diskCheck.Status.Phase = diskcheckv1alpha1.Running
+		diskCheck.Status.StartTime = currentTime()
+	}
+	diskCheck.Status.Conditions = updateCondition(diskCheck.Status.Conditions, newProgressingCondition(corev1.ConditionTrue, checkRunningReason, ""))
+	return 0, nil
+}
+
+// getSourceVolumes returns the volumes of the source to check, or the reason why they can't be checked yet
+func (ctrl *VMDiskCheckController) getSourceVolumes(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck) ([]sourceVolume, string, string, error) {
+	source := diskCheck.Spec.Source
+	switch {
+	case isSourceVM(source):
+		return ctrl.getVMVolumes(diskCheck)
+	case isSourceVMSnapshot(source) && diskCheck.Spec.Sparsify != nil:
+		return nil, unsupportedSourceReason, "The images of a VirtualMachineSnapshot can't be sparsified", nil
+	case isSourceVMSnapshot(source):
+		return ctrl.getVMSnapshotVolumes(diskCheck)
+	}
+	return nil, unsupportedSourceReason, fmt.Sprintf("Source %s is not supported", source.Kind), nil
+}
+
+func (ctrl *VMDiskCheckController) getVMVolumes(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck) ([]sourceVolume, string, string, error) {
+	namespace, name := diskCheck.Namespace, diskCheck.Spec.Source.Name
+	obj, exists, err := ctrl.VMInformer.GetStore().GetByKey(controller.NamespacedKey(namespace, name))
+	if err != nil {
+		return nil, "", "", err
+	}
+	if !exists {
+		return nil, sourceNotFoundReason, fmt.Sprintf("VirtualMachine %s/%s does not exist", namespace, name), nil
+	}
+	vm := obj.(*virtv1.VirtualMachine)
+
+	obj, exists, err = ctrl.VMIInformer.GetStore().GetByKey(controller.NamespacedKey(namespace, name))
+	if err != nil {
+		return nil, "", "", err
+	}
+	if exists && !obj.(*virtv1.VirtualMachineInstance).IsFinal() {
+		return nil, vmRunningReason, fmt.Sprintf("VirtualMachine %s/%s is running, its disks are checked once it is stopped", namespace, name), nil
+	}
+	if diskCheck.Spec.Sparsify != nil {
+		// The images are replaced by their rewrite, the VM must not be started meanwhile
+		if runStrategy, err := vm.RunStrategy(); err != nil || runStrategy != virtv1.RunStrategyHalted {
+			return nil, vmNotHaltedReason, fmt.Sprintf("VirtualMachine %s/%s has to be stopped with the %s run strategy for its images to be sparsified", namespace, name, virtv1.RunStrategyHalted), nil
+		}
+	}
+
+	var vmVolumes []virtv1.Volume
+	if vm.Spec.Template != nil {
+		vmVolumes = vm.Spec.Template.Spec.Volumes
+	}
+	var volumes []sourceVolume
+	for _, volume := range vmVolumes {
+		if volume.PersistentVolumeClaim == nil && volume.DataVolume == nil || !isVolumeSelected(diskCheck, volume.Name) {
+			continue
+		}
+		claimName := storagetypes.PVCNameFromVirtVolume(&volume)
+		pvc, exists, err := ctrl.getPVC(namespace, claimName)
+		if err != nil {
+			return nil, "", "", err
+		}
+		if !exists {
+			return nil, sourceNotReadyReason, fmt.Sprintf("PVC %s/%s of volume %s does not exist", namespace, claimName, volume.Name), nil
+		}
+		populated, err := ctrl.isPVCPopulated(pvc)
+		if err != nil {
+			return nil, "", "", err
+		}
+		if !populated {
+			return nil, sourceNotReadyReason, fmt.Sprintf("PVC %s/%s of volume %s is not populated", namespace, claimName, volume.Name), nil
+		}
+		volumes = append(volumes, sourceVolume{name: volume.Name, pvc: pvc})
+	}
+	return checkSelectedVolumes(diskCheck, volumes)
+}
+
+func (ctrl *VMDiskCheckController) getVMSnapshotVolumes(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck) ([]sourceVolume, string, string, error) {
+	namespace, name := diskCheck.Namespace, diskCheck.Spec.Source.Name
+	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(controller.NamespacedKey(namespace, name))
+	if err != nil {
+		return nil, "", "", err
+	}
+	if !exists {
+		return nil, sourceNotFoundReason, fmt.Sprintf("VirtualMachineSnapshot %s/%s does not exist", namespace, name), nil
+	}
+	vmSnapshot := obj.(*snapshotv1.VirtualMachineSnapshot)
+	if vmSnapshot.Status == nil || vmSnapshot.Status.ReadyToUse == nil || !*vmSnapshot.Status.ReadyToUse ||
+		vmSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+		return nil, sourceNotReadyReason, fmt.Sprintf("VirtualMachineSnapshot %s/%s is not ready to use", namespace, name), nil
+	}
+
+	obj, exists, err = ctrl.VMSnapshotContentInformer.GetStore().GetByKey(controller.NamespacedKey(namespace, *vmSnapshot.Status.VirtualMachineSnapshotContentName))
+	if err != nil {
+		return nil, "", "", err
+	}
+	if !exists {
+		return nil, sourceNotReadyReason, fmt.Sprintf("VirtualMachineSnapshotContent %s/%s does not exist", namespace, *vmSnapshot.Status.VirtualMachineSnapshotContentName), nil
+	}
+	content := obj.(*snapshotv1.VirtualMachineSnapshotContent)
+
+	var volumes []sourceVolume
+	for i := range content.Spec.VolumeBackups {
+		volumeBackup := &content.Spec.VolumeBackups[i]
+		if volumeBackup.VolumeSnapshotName == nil || !isVolumeSelected(diskCheck, volumeBackup.VolumeName) {
+			continue
+		}
+		pvc, err := ctrl.getOrCreatePVCFromSnapshot(diskCheck, volumeBackup)
+		if err != nil {
+			return nil, "", "", err
+		}
+		volumes = append(volumes, sourceVolume{name: volumeBackup.VolumeName, pvc: pvc})
+	}
+	return checkSelectedVolumes(diskCheck, volumes)
+}
+
+// checkSelectedVolumes verifies the source has all the volumes selected by the disk check
+func checkSelectedVolumes(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck, volumes []sourceVolume) ([]sourceVolume, string, string, error) {
+	for _, name := range diskCheck.Spec.Volumes {
+		found := false
+		for _, volume := range volumes {
+			if volume.name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, volumeNotFoundReason, fmt.Sprintf("Volume %s is not a volume of the source backed by a PVC", name), nil
+		}
+	}
+	if len(volumes) == 0 {
+		return nil, noVolumesReason, "The source has no volume backed by a PVC", nil
+	}
+	return volumes, "", "", nil
+}
+
+func isVolumeSelected(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck, name string) bool {
+	if len(diskCheck.Spec.Volumes) == 0 {
+		return true
+	}
+	for _, volume := range diskCheck.Spec.Volumes {
+		if volume == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (ctrl *VMDiskCheckController) getOrCreatePVCFromSnapshot(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck, volumeBackup *snapshotv1.VolumeBackup) (*corev1.PersistentVolumeClaim, error) {
+	restorePVCName := restorePVCName(diskCheck, volumeBackup)
+	if pvc, exists, err := ctrl.getPVC(diskCheck.Namespace, restorePVCName); err != nil {
+		return nil, err
+	} else if exists {
+		return pvc, nil
+	}
+
+	volumeSnapshot, err := ctrl.VolumeSnapshotProvider.GetVolumeSnapshot(diskCheck.Namespace, *volumeBackup.VolumeSnapshotName)
+	if err != nil {
+		return nil, err
+	}
+	pvc, err := snapshot.CreateRestorePVCDef(restorePVCName, volumeSnapshot, volumeBackup)
+	if err != nil {
+		return nil, err
+	}
+	pvc.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(diskCheck, diskcheckv1alpha1.VirtualMachineDiskCheckKind),
+	})
+
+	pvc, err = ctrl.Client.CoreV1().PersistentVolumeClaims(diskCheck.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return nil, err
+	}
+	return pvc, nil
+}
+
+func restorePVCName(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck, volumeBackup *snapshotv1.VolumeBackup) string {
+	return fmt.Sprintf("%s-%s", diskCheck.Name, volumeBackup.PersistentVolumeClaim.Name)
+}
+
+func (ctrl *VMDiskCheckController) createPod(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck, volumes []sourceVolume) error {
+	pod, err := ctrl.renderPod(diskCheck, volumes)
+	if err != nil {
+		return err
+	}
+	_, err = ctrl.Client.CoreV1().Pods(diskCheck.Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	log.Log.Object(diskCheck).V(3).Infof("Created disk check pod %s", pod.Name)
+	return nil
+}
+
+func (ctrl *VMDiskCheckController) renderPod(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck, volumes []sourceVolume) (*corev1.Pod, error) {
+	pod := ctrl.TemplateService.RenderDiskCheckManifest(diskCheck, diskCheckPrefix)
+	container := &pod.Spec.Containers[0]
+	// The images are only written to when they are sparsified
+	readOnly := diskCheck.Spec.Sparsify == nil
+
+	var checkedVolumes []checker.Volume
+	for i, volume := range volumes {
+		podVolumeName := fmt.Sprintf("volume%d", i)
+		var imagePath string
+		if storagetypes.IsPVCBlock(volume.pvc.Spec.VolumeMode) {
+			imagePath = fmt.Sprintf("%s/%s", blockVolumeMountPath, podVolumeName)
+			container.VolumeDevices = append(container.VolumeDevices, corev1.VolumeDevice{
+				Name:       podVolumeName,
+				DevicePath: imagePath,
+			})
+		} else {
+			mountPath := fmt.Sprintf("%s/%s", fileSystemMountPath, podVolumeName)
+			imagePath = mountPath + "/disk.img"
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      podVolumeName,
+				ReadOnly:  readOnly,
+				MountPath: mountPath,
+			})
+		}
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: podVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: volume.pvc.Name,
+					ReadOnly:  readOnly,
+				},
+			},
+		})
+		checkedVolumes = append(checkedVolumes, checker.Volume{
+			Name:      volume.name,
+			ClaimName: volume.pvc.Name,
+			Path:      imagePath,
+		})
+	}
+
+	encoded, err := json.Marshal(checkedVolumes)
+	if err != nil {
+		return nil, err
+	}
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  checker.VolumesEnv,
+		Value: string(encoded),
+	})
+
+	if diskCheck.Spec.Sparsify != nil {
+		encoded, err := json.Marshal(diskCheck.Spec.Sparsify)
+		if err != nil {
+			return nil, err
+		}
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  checker.SparsifyEnv,
+			Value: string(encoded),
+		})
+	}
+	return pod, nil
+}
+
+// recordResults records the results of the finished pod in the status
+func (ctrl *VMDiskCheckController) recordResults(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck, pod *corev1.Pod) {
+	message := terminationMessage(pod)
+	results, err := checker.DecodeResults(message)
+	if err != nil {
+		if message == "" {
+			message = fmt.Sprintf("Pod %s failed: %s", pod.Name, pod.Status.Message)
+		}
+		setFailed(diskCheck, checkFailedReason, message)
+		ctrl.Recorder.Eventf(diskCheck, corev1.EventTypeWarning, checkFailedReason, "Failed to check the volumes: %s", message)
+		return
+	}
+
+	diskCheck.Status.Volumes = results
+	diskCheck.Status.CompletionTime = currentTime()
+	if diskCheck.Status.StartTime == nil {
+		diskCheck.Status.StartTime = diskCheck.Status.CompletionTime
+	}
+
+	var corrupted, failed, sparsified, sparsifyFailed []string
+	var reclaimed int64
+	for _, result := range results {
+		switch result.Result {
+		case diskcheckv1alpha1.VolumeCorrupted:
+			corrupted = append(corrupted, result.Name)
+		case diskcheckv1alpha1.VolumeCheckError:
+			failed = append(failed, result.Name)
+		}
+		if result.Sparsify == nil {
+			continue
+		}
+		switch result.Sparsify.Result {
+		case diskcheckv1alpha1.VolumeSparsified:
+			sparsified = append(sparsified, result.Name)
+			reclaimed += result.Sparsify.SizeBefore - result.Sparsify.SizeAfter
+		case diskcheckv1alpha1.VolumeSparsifyError:
+			sparsifyFailed = append(sparsifyFailed, result.Name)
+		}
+	}
+
+	if len(corrupted) > 0 {
+		message := fmt.Sprintf("Corruptions found in volumes %s", strings.Join(corrupted, ", "))
+		diskCheck.Status.Conditions = updateCondition(diskCheck.Status.Conditions, newCorruptedCondition(corev1.ConditionTrue, corruptionsFoundReason, message))
+		ctrl.Recorder.Event(diskCheck, corev1.EventTypeWarning, corruptionsFoundReason, message)
+	} else {
+		diskCheck.Status.Conditions = updateCondition(diskCheck.Status.Conditions, newCorruptedCondition(corev1.ConditionFalse, noCorruptionsFoundReason, ""))
+	}
+
+	if len(sparsified) > 0 {
+		ctrl.Recorder.Eventf(diskCheck, corev1.EventTypeNormal, spaceReclaimedReason, "Reclaimed %d bytes by sparsifying volumes %s", reclaimed, strings.Join(sparsified, ", "))
+	}
+
+	if len(failed) > 0 {
+		message := fmt.Sprintf("Failed to check volumes %s", strings.Join(failed, ", "))
+		diskCheck.Status.Phase = diskcheckv1alpha1.Failed
+		diskCheck.Status.Conditions = updateCondition(diskCheck.Status.Conditions, newProgressingCondition(corev1.ConditionFalse, checkFailedReason, message))
+		ctrl.Recorder.Event(diskCheck, corev1.EventTypeWarning, checkFailedReason, message)
+		return
+	}
+	if len(sparsifyFailed) > 0 {
+		message := fmt.Sprintf("Failed to sparsify volumes %s", strings.Join(sparsifyFailed, ", "))
+		diskCheck.Status.Phase = diskcheckv1alpha1.Failed
+		diskCheck.Status.Conditions = updateCondition(diskCheck.Status.Conditions, newProgressingCondition(corev1.ConditionFalse, sparsifyFailedReason, message))
+		ctrl.Recorder.Event(diskCheck, corev1.EventTypeWarning, sparsifyFailedReason, message)
+		return
+	}
+	diskCheck.Status.Phase = diskcheckv1alpha1.Succeeded
+	diskCheck.Status.Conditions = updateCondition(diskCheck.Status.Conditions, newProgressingCondition(corev1.ConditionFalse, checkCompletedReason, ""))
+	ctrl.Recorder.Eventf(diskCheck, corev1.EventTypeNormal, checkCompletedReason, "Checked %d volumes", len(results))
+}
+
+func terminationMessage(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil {
+			return status.State.Terminated.Message
+		}
+	}
+	return ""
+}
+
+// cleanup removes the pod and the PVCs restored from snapshots of a finished disk check
+func (ctrl *VMDiskCheckController) cleanup(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck) error {
+	pod, exists, err := ctrl.getPod(diskCheck)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if err := ctrl.deletePod(pod); err != nil {
+			return err
+		}
+	}
+
+	objs, err := ctrl.PVCInformer.GetIndexer().ByIndex(cache.NamespaceIndex, diskCheck.Namespace)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		pvc := obj.(*corev1.PersistentVolumeClaim)
+		if !metav1.IsControlledBy(pvc, diskCheck) || pvc.DeletionTimestamp != nil {
+			continue
+		}
+		err := ctrl.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Delete(context.Background(), pvc.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctrl *VMDiskCheckController) deletePod(pod *corev1.Pod) error {
+	if pod.DeletionTimestamp != nil {
+		return nil
+	}
+	err := ctrl.Client.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (ctrl *VMDiskCheckController) updateStatus(diskCheck, diskCheckCopy *diskcheckv1alpha1.VirtualMachineDiskCheck) error {
+	if equality.Semantic.DeepEqual(diskCheck.Status, diskCheckCopy.Status) {
+		return nil
+	}
+	_, err := ctrl.Client.GeneratedKubeVirtClient().DiskcheckV1alpha1().VirtualMachineDiskChecks(diskCheckCopy.Namespace).UpdateStatus(context.Background(), diskCheckCopy, metav1.UpdateOptions{})
+	return err
+}
+
+func (ctrl *VMDiskCheckController) getPod(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck) (*corev1.Pod, bool, error) {
+	obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(controller.NamespacedKey(diskCheck.Namespace, podName(diskCheck)))
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+	pod := obj.(*corev1.Pod)
+	if !metav1.IsControlledBy(pod, diskCheck) {
+		return nil, false, fmt.Errorf("pod %s/%s is not owned by VirtualMachineDiskCheck %s", pod.Namespace, pod.Name, diskCheck.Name)
+	}
+	return pod, true, nil
+}
+
+func (ctrl *VMDiskCheckController) getPVC(namespace, name string) (*corev1.PersistentVolumeClaim, bool, error) {
+	obj, exists, err := ctrl.PVCInformer.GetStore().GetByKey(controller.NamespacedKey(namespace, name))
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+	return obj.(*corev1.PersistentVolumeClaim), true, nil
+}
+
+func (ctrl *VMDiskCheckController) isPVCPopulated(pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	return cdiv1.IsPopulated(pvc, func(name, namespace string) (*cdiv1.DataVolume, error) {
+		obj, exists, err := ctrl.DataVolumeInformer.GetStore().GetByKey(controller.NamespacedKey(namespace, name))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			if dv, ok := obj.(*cdiv1.DataVolume); ok {
+				return dv, nil
+			}
+		}
+		return nil, fmt.Errorf("datavolume %s/%s not found", namespace, name)
+	})
+}
+
+func podName(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck) string {
+	// Use of DNS1035LabelMaxLength here to align with templateService.RenderDiskCheckManifest
+	return naming.GetName(diskCheckPrefix, diskCheck.Name, validation.DNS1035LabelMaxLength)
+}
+
+func isSourceVM(source corev1.TypedLocalObjectReference) bool {
+	return source.APIGroup != nil && *source.APIGroup == virtv1.SchemeGroupVersion.Group && source.Kind == virtv1.VirtualMachineGroupVersionKind.Kind
+}
+
+func isSourceVMSnapshot(source corev1.TypedLocalObjectReference) bool {
+	return source.APIGroup != nil && *source.APIGroup == snapshotv1.SchemeGroupVersion.Group && source.Kind == "VirtualMachineSnapshot"
+}
+
+func isFinished(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck) bool {
+	return diskCheck.Status.Phase == diskcheckv1alpha1.Succeeded || diskCheck.Status.Phase == diskcheckv1alpha1.Failed
+}
+
+func isPodFinished(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
+
+func setFailed(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck, reason, message string) {
+	diskCheck.Status.Phase = diskcheckv1alpha1.Failed
+	diskCheck.Status.CompletionTime = currentTime()
+	diskCheck.Status.Conditions = updateCondition(diskCheck.Status.Conditions, newProgressingCondition(corev1.ConditionFalse, reason, message))
+}
+
+func newProgressingCondition(status corev1.ConditionStatus, reason, message string) diskcheckv1alpha1.Condition {
+	return diskcheckv1alpha1.Condition{
+		Type:               diskcheckv1alpha1.ConditionProgressing,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func newCorruptedCondition(status corev1.ConditionStatus, reason, message string) diskcheckv1alpha1.Condition {
+	return diskcheckv1alpha1.Condition{
+		Type:               diskcheckv1alpha1.ConditionCorrupted,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func updateCondition(conditions []diskcheckv1alpha1.Condition, c diskcheckv1alpha1.Condition) []diskcheckv1alpha1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == c.Type {
+			if conditions[i].Status != c.Status || conditions[i].Reason != c.Reason || conditions[i].Message != c.Message {
+				conditions[i] = c
+			}
+			return conditions
+		}
+	}
+	return append(conditions, c)
+}
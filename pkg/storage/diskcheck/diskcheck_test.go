@@ -0,0 +1,458 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package diskcheck
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	diskcheckv1alpha1 "kubevirt.io/api/diskcheck/v1alpha1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/diskcheck/checker"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+)
+
+const testNamespace = "default"
+
+type fakeVolumeSnapshotProvider struct{}
+
+func (fakeVolumeSnapshotProvider) GetVolumeSnapshot(namespace, name string) (*vsv1.VolumeSnapshot, error) {
+	return &vsv1.VolumeSnapshot{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}, nil
+}
+
+var _ = Describe("VirtualMachineDiskCheck controller", func() {
+	var (
+		k8sClient          *k8sfake.Clientset
+		client             *kubevirtfake.Clientset
+		recorder           *record.FakeRecorder
+		diskCheckInformer  cache.SharedIndexInformer
+		vmInformer         cache.SharedIndexInformer
+		vmiInformer        cache.SharedIndexInformer
+		podInformer        cache.SharedIndexInformer
+		pvcInformer        cache.SharedIndexInformer
+		vmSnapshotInformer cache.SharedIndexInformer
+		contentInformer    cache.SharedIndexInformer
+		controller         *VMDiskCheckController
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		k8sClient = k8sfake.NewSimpleClientset()
+		client = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().GeneratedKubeVirtClient().Return(client).AnyTimes()
+
+		diskCheckInformer, _ = testutils.NewFakeInformerFor(&diskcheckv1alpha1.VirtualMachineDiskCheck{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		pvcInformer, _ = testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		contentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		rqInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
+		nsInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{})
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &VMDiskCheckController{
+			Client:                    virtClient,
+			TemplateService:           services.NewTemplateService("launcher-image", 240, "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), virtClient, config, 107, "h", rqInformer.GetStore(), nsInformer.GetStore()),
+			DiskCheckInformer:         diskCheckInformer,
+			VMInformer:                vmInformer,
+			VMIInformer:               vmiInformer,
+			PodInformer:               podInformer,
+			PVCInformer:               pvcInformer,
+			DataVolumeInformer:        dvInformer,
+			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotContentInformer: contentInformer,
+			VolumeSnapshotProvider:    fakeVolumeSnapshotProvider{},
+			Recorder:                  recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	newDiskCheck := func(apiGroup, kind string, volumes ...string) *diskcheckv1alpha1.VirtualMachineDiskCheck {
+		diskCheck := &diskcheckv1alpha1.VirtualMachineDiskCheck{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "check",
+				Namespace: testNamespace,
+				UID:       "check-uid",
+			},
+			Spec: diskcheckv1alpha1.VirtualMachineDiskCheckSpec{
+				Source: k8sv1.TypedLocalObjectReference{
+					APIGroup: pointer.P(apiGroup),
+					Kind:     kind,
+					Name:     "source",
+				},
+				Volumes: volumes,
+			},
+		}
+		Expect(diskCheckInformer.GetStore().Add(diskCheck)).To(Succeed())
+		_, err := client.DiskcheckV1alpha1().VirtualMachineDiskChecks(testNamespace).Create(context.Background(), diskCheck, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return diskCheck
+	}
+
+	newVMDiskCheck := func(volumes ...string) *diskcheckv1alpha1.VirtualMachineDiskCheck {
+		return newDiskCheck(virtv1.SchemeGroupVersion.Group, "VirtualMachine", volumes...)
+	}
+
+	addPVC := func(name string, volumeMode k8sv1.PersistentVolumeMode) {
+		pvc := &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+			Spec:       k8sv1.PersistentVolumeClaimSpec{VolumeMode: &volumeMode},
+			Status:     k8sv1.PersistentVolumeClaimStatus{Phase: k8sv1.ClaimBound},
+		}
+		Expect(pvcInformer.GetStore().Add(pvc)).To(Succeed())
+	}
+
+	addVM := func() {
+		vm := &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "source", Namespace: testNamespace},
+			Spec: virtv1.VirtualMachineSpec{
+				Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+					Spec: virtv1.VirtualMachineInstanceSpec{
+						Volumes: []virtv1.Volume{
+							{Name: "rootdisk", VolumeSource: virtv1.VolumeSource{PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "root-pvc"},
+							}}},
+							{Name: "datadisk", VolumeSource: virtv1.VolumeSource{PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "data-pvc"},
+							}}},
+							{Name: "cloudinit", VolumeSource: virtv1.VolumeSource{CloudInitNoCloud: &virtv1.CloudInitNoCloudSource{}}},
+						},
+					},
+				},
+			},
+		}
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		addPVC("root-pvc", k8sv1.PersistentVolumeFilesystem)
+		addPVC("data-pvc", k8sv1.PersistentVolumeBlock)
+	}
+
+	addVMI := func(phase virtv1.VirtualMachineInstancePhase) {
+		vmi := &virtv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "source", Namespace: testNamespace},
+			Status:     virtv1.VirtualMachineInstanceStatus{Phase: phase},
+		}
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+	}
+
+	execute := func(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck) *diskcheckv1alpha1.VirtualMachineDiskCheck {
+		key, err := cache.MetaNamespaceKeyFunc(diskCheck)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = controller.execute(key)
+		Expect(err).ToNot(HaveOccurred())
+		diskCheck, err = client.DiskcheckV1alpha1().VirtualMachineDiskChecks(testNamespace).Get(context.Background(), diskCheck.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return diskCheck
+	}
+
+	getPod := func(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck) *k8sv1.Pod {
+		pod, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), podName(diskCheck), metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pod
+	}
+
+	finishPod := func(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck, results ...diskcheckv1alpha1.VolumeCheckResult) {
+		message, err := checker.EncodeResults(results)
+		Expect(err).ToNot(HaveOccurred())
+		pod := getPod(diskCheck)
+		pod.Status.Phase = k8sv1.PodSucceeded
+		pod.Status.ContainerStatuses = []k8sv1.ContainerStatus{{
+			State: k8sv1.ContainerState{Terminated: &k8sv1.ContainerStateTerminated{Message: string(message)}},
+		}}
+		Expect(podInformer.GetStore().Add(pod)).To(Succeed())
+	}
+
+	getCondition := func(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck, conditionType diskcheckv1alpha1.ConditionType) *diskcheckv1alpha1.Condition {
+		for i := range diskCheck.Status.Conditions {
+			if diskCheck.Status.Conditions[i].Type == conditionType {
+				return &diskCheck.Status.Conditions[i]
+			}
+		}
+		return nil
+	}
+
+	It("should check the PVCs of a stopped VM", func() {
+		addVM()
+		diskCheck := execute(newVMDiskCheck())
+
+		Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Running))
+		Expect(diskCheck.Status.StartTime).ToNot(BeNil())
+		Expect(getCondition(diskCheck, diskcheckv1alpha1.ConditionProgressing).Status).To(Equal(k8sv1.ConditionTrue))
+
+		pod := getPod(diskCheck)
+		Expect(metav1.IsControlledBy(pod, diskCheck)).To(BeTrue())
+		Expect(pod.Spec.Containers[0].Image).To(Equal("launcher-image"))
+		Expect(pod.Spec.Volumes).To(HaveLen(2))
+		Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("root-pvc"))
+		Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ReadOnly).To(BeTrue())
+		Expect(pod.Spec.Containers[0].VolumeMounts).To(ConsistOf(k8sv1.VolumeMount{Name: "volume0", ReadOnly: true, MountPath: "/disk-check-volumes/volume0"}))
+		Expect(pod.Spec.Containers[0].VolumeDevices).To(ConsistOf(k8sv1.VolumeDevice{Name: "volume1", DevicePath: "/dev/disk-check-volumes/volume1"}))
+		Expect(pod.Spec.Containers[0].Env).To(ContainElement(k8sv1.EnvVar{
+			Name:  checker.VolumesEnv,
+			Value: `[{"name":"rootdisk","claimName":"root-pvc","path":"/disk-check-volumes/volume0/disk.img"},{"name":"datadisk","claimName":"data-pvc","path":"/dev/disk-check-volumes/volume1"}]`,
+		}))
+	})
+
+	It("should only check the selected volumes", func() {
+		addVM()
+		diskCheck := execute(newVMDiskCheck("datadisk"))
+
+		pod := getPod(diskCheck)
+		Expect(pod.Spec.Volumes).To(HaveLen(1))
+		Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("data-pvc"))
+	})
+
+	It("should fail when a selected volume is not a volume of the VM", func() {
+		addVM()
+		diskCheck := execute(newVMDiskCheck("cloudinit"))
+
+		Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Failed))
+		Expect(getCondition(diskCheck, diskcheckv1alpha1.ConditionProgressing).Reason).To(Equal(volumeNotFoundReason))
+	})
+
+	It("should fail when the source is not supported", func() {
+		diskCheck := execute(newDiskCheck("", "PersistentVolumeClaim"))
+
+		Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Failed))
+		Expect(getCondition(diskCheck, diskcheckv1alpha1.ConditionProgressing).Reason).To(Equal(unsupportedSourceReason))
+	})
+
+	It("should wait for the VM to be stopped", func() {
+		addVM()
+		addVMI(virtv1.Running)
+		diskCheck := execute(newVMDiskCheck())
+
+		Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Pending))
+		condition := getCondition(diskCheck, diskcheckv1alpha1.ConditionProgressing)
+		Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(vmRunningReason))
+		_, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), podName(diskCheck), metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should interrupt the check when the VM is started", func() {
+		addVM()
+		diskCheck := execute(newVMDiskCheck())
+		Expect(podInformer.GetStore().Add(getPod(diskCheck))).To(Succeed())
+		Expect(diskCheckInformer.GetStore().Update(diskCheck)).To(Succeed())
+
+		addVMI(virtv1.Scheduling)
+		diskCheck = execute(diskCheck)
+		Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Pending))
+		_, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), podName(diskCheck), metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should report clean volumes", func() {
+		addVM()
+		diskCheck := execute(newVMDiskCheck())
+		Expect(diskCheckInformer.GetStore().Update(diskCheck)).To(Succeed())
+		finishPod(diskCheck,
+			diskcheckv1alpha1.VolumeCheckResult{Name: "rootdisk", ClaimName: "root-pvc", Format: "qcow2", Result: diskcheckv1alpha1.VolumeClean},
+			diskcheckv1alpha1.VolumeCheckResult{Name: "datadisk", ClaimName: "data-pvc", Format: "raw", Result: diskcheckv1alpha1.VolumeClean},
+		)
+
+		diskCheck = execute(diskCheck)
+		Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Succeeded))
+		Expect(diskCheck.Status.CompletionTime).ToNot(BeNil())
+		Expect(diskCheck.Status.Volumes).To(HaveLen(2))
+		condition := getCondition(diskCheck, diskcheckv1alpha1.ConditionCorrupted)
+		Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(noCorruptionsFoundReason))
+		testutils.ExpectEvent(recorder, checkCompletedReason)
+	})
+
+	It("should report corrupted volumes", func() {
+		addVM()
+		diskCheck := execute(newVMDiskCheck())
+		Expect(diskCheckInformer.GetStore().Update(diskCheck)).To(Succeed())
+		finishPod(diskCheck,
+			diskcheckv1alpha1.VolumeCheckResult{Name: "rootdisk", ClaimName: "root-pvc", Format: "qcow2", Result: diskcheckv1alpha1.VolumeCorrupted, Corruptions: 4},
+			diskcheckv1alpha1.VolumeCheckResult{Name: "datadisk", ClaimName: "data-pvc", Format: "raw", Result: diskcheckv1alpha1.VolumeClean},
+		)
+
+		diskCheck = execute(diskCheck)
+		Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Succeeded))
+		condition := getCondition(diskCheck, diskcheckv1alpha1.ConditionCorrupted)
+		Expect(condition.Status).To(Equal(k8sv1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(corruptionsFoundReason))
+		Expect(condition.Message).To(ContainSubstring("rootdisk"))
+		testutils.ExpectEvent(recorder, corruptionsFoundReason)
+	})
+
+	It("should fail when a volume could not be checked", func() {
+		addVM()
+		diskCheck := execute(newVMDiskCheck())
+		Expect(diskCheckInformer.GetStore().Update(diskCheck)).To(Succeed())
+		finishPod(diskCheck,
+			diskcheckv1alpha1.VolumeCheckResult{Name: "rootdisk", ClaimName: "root-pvc", Result: diskcheckv1alpha1.VolumeCheckError, Message: "failed"},
+			diskcheckv1alpha1.VolumeCheckResult{Name: "datadisk", ClaimName: "data-pvc", Format: "raw", Result: diskcheckv1alpha1.VolumeClean},
+		)
+
+		diskCheck = execute(diskCheck)
+		Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Failed))
+		Expect(getCondition(diskCheck, diskcheckv1alpha1.ConditionProgressing).Reason).To(Equal(checkFailedReason))
+		Expect(getCondition(diskCheck, diskcheckv1alpha1.ConditionCorrupted).Status).To(Equal(k8sv1.ConditionFalse))
+	})
+
+	Context("with sparsify", func() {
+		newSparsifyDiskCheck := func(apiGroup, kind string) *diskcheckv1alpha1.VirtualMachineDiskCheck {
+			diskCheck := newDiskCheck(apiGroup, kind)
+			diskCheck.Spec.Sparsify = &diskcheckv1alpha1.DiskSparsifyOptions{Compress: true}
+			Expect(diskCheckInformer.GetStore().Update(diskCheck)).To(Succeed())
+			_, err := client.DiskcheckV1alpha1().VirtualMachineDiskChecks(testNamespace).Update(context.Background(), diskCheck, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return diskCheck
+		}
+
+		It("should mount the PVCs read-write", func() {
+			addVM()
+			diskCheck := execute(newSparsifyDiskCheck(virtv1.SchemeGroupVersion.Group, "VirtualMachine"))
+			Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Running))
+
+			pod := getPod(diskCheck)
+			Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ReadOnly).To(BeFalse())
+			Expect(pod.Spec.Containers[0].VolumeMounts).To(ConsistOf(k8sv1.VolumeMount{Name: "volume0", MountPath: "/disk-check-volumes/volume0"}))
+			Expect(pod.Spec.Containers[0].Env).To(ContainElement(k8sv1.EnvVar{Name: checker.SparsifyEnv, Value: `{"compress":true}`}))
+		})
+
+		It("should wait for the VM to be halted", func() {
+			addVM()
+			obj, _, err := vmInformer.GetStore().GetByKey(testNamespace + "/source")
+			Expect(err).ToNot(HaveOccurred())
+			vm := obj.(*virtv1.VirtualMachine)
+			vm.Spec.RunStrategy = pointer.P(virtv1.RunStrategyManual)
+			Expect(vmInformer.GetStore().Update(vm)).To(Succeed())
+
+			diskCheck := execute(newSparsifyDiskCheck(virtv1.SchemeGroupVersion.Group, "VirtualMachine"))
+			Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Pending))
+			Expect(getCondition(diskCheck, diskcheckv1alpha1.ConditionProgressing).Reason).To(Equal(vmNotHaltedReason))
+			_, err = k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), podName(diskCheck), metav1.GetOptions{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail with a VM snapshot source", func() {
+			diskCheck := execute(newSparsifyDiskCheck(snapshotv1.SchemeGroupVersion.Group, "VirtualMachineSnapshot"))
+			Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Failed))
+			Expect(getCondition(diskCheck, diskcheckv1alpha1.ConditionProgressing).Reason).To(Equal(unsupportedSourceReason))
+		})
+
+		It("should report the reclaimed space", func() {
+			addVM()
+			diskCheck := execute(newSparsifyDiskCheck(virtv1.SchemeGroupVersion.Group, "VirtualMachine"))
+			Expect(diskCheckInformer.GetStore().Update(diskCheck)).To(Succeed())
+			finishPod(diskCheck,
+				diskcheckv1alpha1.VolumeCheckResult{Name: "rootdisk", ClaimName: "root-pvc", Format: "qcow2", Result: diskcheckv1alpha1.VolumeClean,
+					Sparsify: &diskcheckv1alpha1.VolumeSparsifyResult{Result: diskcheckv1alpha1.VolumeSparsified, SizeBefore: 1000, SizeAfter: 400}},
+				diskcheckv1alpha1.VolumeCheckResult{Name: "datadisk", ClaimName: "data-pvc", Format: "raw", Result: diskcheckv1alpha1.VolumeClean,
+					Sparsify: &diskcheckv1alpha1.VolumeSparsifyResult{Result: diskcheckv1alpha1.VolumeSparsifySkipped}},
+			)
+
+			diskCheck = execute(diskCheck)
+			Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Succeeded))
+			Expect(diskCheck.Status.Volumes[0].Sparsify.SizeAfter).To(Equal(int64(400)))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Reclaimed 600 bytes by sparsifying volumes rootdisk")))
+		})
+
+		It("should fail when a volume could not be sparsified", func() {
+			addVM()
+			diskCheck := execute(newSparsifyDiskCheck(virtv1.SchemeGroupVersion.Group, "VirtualMachine"))
+			Expect(diskCheckInformer.GetStore().Update(diskCheck)).To(Succeed())
+			finishPod(diskCheck,
+				diskcheckv1alpha1.VolumeCheckResult{Name: "rootdisk", ClaimName: "root-pvc", Format: "qcow2", Result: diskcheckv1alpha1.VolumeClean,
+					Sparsify: &diskcheckv1alpha1.VolumeSparsifyResult{Result: diskcheckv1alpha1.VolumeSparsifyError, Message: "failed"}},
+			)
+
+			diskCheck = execute(diskCheck)
+			Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Failed))
+			Expect(getCondition(diskCheck, diskcheckv1alpha1.ConditionProgressing).Reason).To(Equal(sparsifyFailedReason))
+			testutils.ExpectEvent(recorder, sparsifyFailedReason)
+		})
+	})
+
+	It("should check the volumes restored from a VM snapshot, and remove them once done", func() {
+		vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "source", Namespace: testNamespace},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				ReadyToUse:                        pointer.P(true),
+				VirtualMachineSnapshotContentName: pointer.P("content"),
+			},
+		}
+		Expect(vmSnapshotInformer.GetStore().Add(vmSnapshot)).To(Succeed())
+		content := &snapshotv1.VirtualMachineSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{Name: "content", Namespace: testNamespace},
+			Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+				VolumeBackups: []snapshotv1.VolumeBackup{{
+					VolumeName: "rootdisk",
+					PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{Name: "root-pvc"},
+						Spec:       k8sv1.PersistentVolumeClaimSpec{Resources: k8sv1.VolumeResourceRequirements{Requests: k8sv1.ResourceList{}}},
+					},
+					VolumeSnapshotName: pointer.P("root-snapshot"),
+				}},
+			},
+		}
+		Expect(contentInformer.GetStore().Add(content)).To(Succeed())
+
+		diskCheck := execute(newDiskCheck(snapshotv1.SchemeGroupVersion.Group, "VirtualMachineSnapshot"))
+		Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Running))
+
+		pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Get(context.Background(), "check-root-pvc", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(metav1.IsControlledBy(pvc, diskCheck)).To(BeTrue())
+		Expect(pvc.Spec.DataSource.Name).To(Equal("root-snapshot"))
+		Expect(getPod(diskCheck).Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("check-root-pvc"))
+
+		Expect(pvcInformer.GetStore().Add(pvc)).To(Succeed())
+		Expect(diskCheckInformer.GetStore().Update(diskCheck)).To(Succeed())
+		finishPod(diskCheck, diskcheckv1alpha1.VolumeCheckResult{Name: "rootdisk", ClaimName: "check-root-pvc", Format: "raw", Result: diskcheckv1alpha1.VolumeClean})
+		diskCheck = execute(diskCheck)
+		Expect(diskCheck.Status.Phase).To(Equal(diskcheckv1alpha1.Succeeded))
+
+		Expect(diskCheckInformer.GetStore().Update(diskCheck)).To(Succeed())
+		execute(diskCheck)
+		_, err = k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), podName(diskCheck), metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+		_, err = k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Get(context.Background(), "check-root-pvc", metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -851,6 +851,11 @@ func (ctrl *VMExportController) createServiceManifest(vmExport *exportv1.Virtual
 			},
 		},
 	}
+	if family := ctrl.clusterConfig.GetPreferredIPFamily(); family != "" {
+		// The ClusterIP of a dual-stack service belongs to its first IP family
+		service.Spec.IPFamilyPolicy = pointer.P(corev1.IPFamilyPolicyPreferDualStack)
+		service.Spec.IPFamilies = []corev1.IPFamily{family}
+	}
 	return service
 }
 
@@ -966,6 +971,12 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 		Name:  "EXPORT_SECRET_DEF_URI",
 		Value: secretManifestPath,
 	})
+	if ctrl.clusterConfig.FIPSModeEnabled() {
+		podManifest.Spec.Containers[0].Env = append(podManifest.Spec.Containers[0].Env, corev1.EnvVar{
+			Name:  "FIPS_MODE",
+			Value: "true",
+		})
+	}
 
 	tokenSecretRef := ""
 	if vmExport.Status != nil && vmExport.Status.TokenSecretRef != nil {
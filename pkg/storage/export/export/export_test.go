@@ -784,6 +784,35 @@ var _ = Describe("Export controller", func() {
 		Expect(service.Status.Conditions[0].Type).To(Equal("test2"))
 	})
 
+	It("Should create a service of the preferred IP family", func() {
+		controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			NetworkConfiguration: &virtv1.NetworkConfiguration{PreferredIPFamily: pointer.P(k8sv1.IPv6Protocol)},
+		})
+
+		service := controller.createServiceManifest(createPVCVMExport())
+		Expect(service.Spec.IPFamilyPolicy).To(HaveValue(Equal(k8sv1.IPFamilyPolicyPreferDualStack)))
+		Expect(service.Spec.IPFamilies).To(Equal([]k8sv1.IPFamily{k8sv1.IPv6Protocol}))
+	})
+
+	It("Should restrict the exporter pod to FIPS approved TLS settings in FIPS mode", func() {
+		controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			TLSConfiguration: &virtv1.TLSConfiguration{FIPSMode: true},
+		})
+		testVMExport := createPVCVMExport()
+		populateInitialVMExportStatus(testVMExport)
+		pvc := createPVC("pvc", string(cdiv1.DataVolumeKubeVirt))
+
+		pod, err := controller.createExporterPodManifest(testVMExport, &k8sv1.Service{}, []*k8sv1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.Containers[0].Env).To(ContainElement(k8sv1.EnvVar{Name: "FIPS_MODE", Value: "true"}))
+	})
+
+	It("Should leave the IP families of the service to the cluster by default", func() {
+		service := controller.createServiceManifest(createPVCVMExport())
+		Expect(service.Spec.IPFamilyPolicy).To(BeNil())
+		Expect(service.Spec.IPFamilies).To(BeEmpty())
+	})
+
 	populateVmExportVM := func() *exportv1.VirtualMachineExport {
 		testVMExport := createVMVMExport()
 		vm := &virtv1.VirtualMachine{
@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	// wantDigestHeader is the header a client requests the digest of a file with, see RFC 3230
+	wantDigestHeader = "Want-Digest"
+	// digestHeader is the header the digest of a file is returned in
+	digestHeader = "Digest"
+	// sha256DigestAlgorithm is the only supported digest algorithm
+	sha256DigestAlgorithm = "sha-256"
+)
+
+type fileDigest struct {
+	once   sync.Once
+	digest string
+	err    error
+}
+
+// digestCache computes the digest of each exported file once: the files don't
+// change while they are exported, and hashing a whole disk takes minutes.
+type digestCache struct {
+	lock    sync.Mutex
+	digests map[string]*fileDigest
+}
+
+var fileDigests = &digestCache{digests: map[string]*fileDigest{}}
+
+// get returns the base64 encoded SHA-256 digest of a file, waiting for
+// its computation when another request already started it
+func (c *digestCache) get(filePath string) (string, error) {
+	c.lock.Lock()
+	d, exists := c.digests[filePath]
+	if !exists {
+		d = &fileDigest{}
+		c.digests[filePath] = d
+	}
+	c.lock.Unlock()
+
+	d.once.Do(func() {
+		d.digest, d.err = sha256File(filePath)
+	})
+	if d.err != nil {
+		// let the next request try again
+		c.lock.Lock()
+		if c.digests[filePath] == d {
+			delete(c.digests, filePath)
+		}
+		c.lock.Unlock()
+	}
+	return d.digest, d.err
+}
+
+func sha256File(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+// wantsSHA256Digest tells whether the request asks for the SHA-256 digest,
+// e.g. with "Want-Digest: sha-256" or "Want-Digest: SHA-256;q=0.3, md5;q=1"
+func wantsSHA256Digest(req *http.Request) bool {
+	for _, value := range req.Header.Values(wantDigestHeader) {
+		for _, algorithm := range strings.Split(value, ",") {
+			algorithm, _, _ = strings.Cut(algorithm, ";")
+			if strings.EqualFold(strings.TrimSpace(algorithm), sha256DigestAlgorithm) {
+				return true
+			}
+		}
+	}
+	return false
+}
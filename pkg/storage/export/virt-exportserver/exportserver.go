@@ -52,6 +52,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/service"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
 	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
+	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 )
 
 const (
@@ -79,6 +80,9 @@ type ExportServerConfig struct {
 
 	TokenFile string
 
+	// FIPSMode restricts the server to FIPS approved TLS settings
+	FIPSMode bool
+
 	Paths *export.ServerPaths
 
 	// unit testing helpers
@@ -197,6 +201,10 @@ func (s *exportServer) Run() {
 		// See CVE-2023-44487
 		TLSNextProto: map[string]func(*http.Server, *tls.Conn, http.Handler){},
 	}
+	if s.FIPSMode {
+		srv.TLSConfig = &tls.Config{}
+		kvtls.ApplyFIPSMode(srv.TLSConfig)
+	}
 
 	ch := make(chan error)
 
@@ -708,6 +716,16 @@ func fileHandler(file string) http.Handler {
 			return
 		}
 		defer f.Close()
+		if wantsSHA256Digest(r) {
+			digest, err := fileDigests.get(file)
+			if err != nil {
+				log.Log.Reason(err).Errorf("error computing the digest of %s", file)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set(digestHeader, sha256DigestAlgorithm+"="+digest)
+		}
+		// ServeContent serves the range requests, e.g. of parallel or resumed downloads
 		http.ServeContent(w, r, "disk.img", time.Time{}, f)
 	})
 }
@@ -20,11 +20,15 @@
 package virtexportserver
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -770,4 +774,55 @@ var _ = Describe("exportserver", func() {
 			verifySecret(string(list.Items[0].Raw))
 		})
 	})
+
+	Context("File handler", func() {
+		var (
+			filePath string
+			data     []byte
+		)
+
+		BeforeEach(func() {
+			data = []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+			filePath = filepath.Join(GinkgoT().TempDir(), "disk.img")
+			Expect(os.WriteFile(filePath, data, 0644)).To(Succeed())
+		})
+
+		It("Should serve a range of the file", func() {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/test/disk.img", nil)
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("Range", "bytes=10-19")
+			resp := httptest.NewRecorder()
+			fileHandler(filePath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusPartialContent))
+			Expect(resp.Header().Get("Content-Range")).To(Equal(fmt.Sprintf("bytes 10-19/%d", len(data))))
+			Expect(resp.Body.Bytes()).To(Equal(data[10:20]))
+			Expect(resp.Header().Get(digestHeader)).To(BeEmpty())
+		})
+
+		DescribeTable("Should return the SHA-256 digest of the file when requested", func(wantDigest string) {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/test/disk.img", nil)
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("Range", "bytes=0-0")
+			req.Header.Set(wantDigestHeader, wantDigest)
+			resp := httptest.NewRecorder()
+			fileHandler(filePath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusPartialContent))
+			sum := sha256.Sum256(data)
+			Expect(resp.Header().Get(digestHeader)).To(Equal("sha-256=" + base64.StdEncoding.EncodeToString(sum[:])))
+		},
+			Entry("alone", "sha-256"),
+			Entry("among other algorithms", "md5;q=1, SHA-256;q=0.5"),
+		)
+
+		It("Should not return a digest of an unsupported algorithm", func() {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/test/disk.img", nil)
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set(wantDigestHeader, "md5")
+			resp := httptest.NewRecorder()
+			fileHandler(filePath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Body.Bytes()).To(Equal(data))
+			Expect(resp.Header().Get(digestHeader)).To(BeEmpty())
+		})
+	})
 })
@@ -12,16 +12,17 @@ import (
 )
 
 type MockInstancetypeMethods struct {
-	FindInstancetypeSpecFunc        func(vm *v1.VirtualMachine) (*instancetypev1beta1.VirtualMachineInstancetypeSpec, error)
-	ApplyToVmiFunc                  func(field *k8sfield.Path, instancetypespec *instancetypev1beta1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, vmiSpec *v1.VirtualMachineInstanceSpec, vmiMetadata *metav1.ObjectMeta) instancetype.Conflicts
-	FindPreferenceSpecFunc          func(vm *v1.VirtualMachine) (*instancetypev1beta1.VirtualMachinePreferenceSpec, error)
-	StoreControllerRevisionsFunc    func(vm *v1.VirtualMachine) error
-	InferDefaultInstancetypeFunc    func(vm *v1.VirtualMachine) error
-	InferDefaultPreferenceFunc      func(vm *v1.VirtualMachine) error
-	CheckPreferenceRequirementsFunc func(instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, vmiSpec *v1.VirtualMachineInstanceSpec) (instancetype.Conflicts, error)
-	UpgradeFunc                     func(vm *v1.VirtualMachine) error
-	ApplyToVMFunc                   func(vm *v1.VirtualMachine) error
-	ExpandFunc                      func(vm *v1.VirtualMachine, clusterConfig *virtconfig.ClusterConfig) (*v1.VirtualMachine, error)
+	FindInstancetypeSpecFunc          func(vm *v1.VirtualMachine) (*instancetypev1beta1.VirtualMachineInstancetypeSpec, error)
+	ApplyToVmiFunc                    func(field *k8sfield.Path, instancetypespec *instancetypev1beta1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, vmiSpec *v1.VirtualMachineInstanceSpec, vmiMetadata *metav1.ObjectMeta) instancetype.Conflicts
+	FindPreferenceSpecFunc            func(vm *v1.VirtualMachine) (*instancetypev1beta1.VirtualMachinePreferenceSpec, error)
+	StoreControllerRevisionsFunc      func(vm *v1.VirtualMachine) error
+	InferDefaultInstancetypeFunc      func(vm *v1.VirtualMachine) error
+	InferDefaultPreferenceFunc        func(vm *v1.VirtualMachine) error
+	InferPreferenceFromBootVolumeFunc func(vm *v1.VirtualMachine) string
+	CheckPreferenceRequirementsFunc   func(instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, vmiSpec *v1.VirtualMachineInstanceSpec) (instancetype.Conflicts, error)
+	UpgradeFunc                       func(vm *v1.VirtualMachine) error
+	ApplyToVMFunc                     func(vm *v1.VirtualMachine) error
+	ExpandFunc                        func(vm *v1.VirtualMachine, clusterConfig *virtconfig.ClusterConfig) (*v1.VirtualMachine, error)
 }
 
 var _ instancetype.Methods = &MockInstancetypeMethods{}
@@ -50,6 +51,10 @@ func (m *MockInstancetypeMethods) InferDefaultPreference(vm *v1.VirtualMachine)
 	return m.InferDefaultPreferenceFunc(vm)
 }
 
+func (m *MockInstancetypeMethods) InferPreferenceFromBootVolume(vm *v1.VirtualMachine) string {
+	return m.InferPreferenceFromBootVolumeFunc(vm)
+}
+
 func (m *MockInstancetypeMethods) CheckPreferenceRequirements(instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, vmiSpec *v1.VirtualMachineInstanceSpec) (instancetype.Conflicts, error) {
 	return m.CheckPreferenceRequirementsFunc(instancetypeSpec, preferenceSpec, vmiSpec)
 }
@@ -86,6 +91,9 @@ func NewMockInstancetypeMethods() *MockInstancetypeMethods {
 		InferDefaultPreferenceFunc: func(_ *v1.VirtualMachine) error {
 			return nil
 		},
+		InferPreferenceFromBootVolumeFunc: func(_ *v1.VirtualMachine) string {
+			return ""
+		},
 		CheckPreferenceRequirementsFunc: func(_ *instancetypev1beta1.VirtualMachineInstancetypeSpec, _ *instancetypev1beta1.VirtualMachinePreferenceSpec, _ *v1.VirtualMachineInstanceSpec) (instancetype.Conflicts, error) {
 			return nil, nil
 		},
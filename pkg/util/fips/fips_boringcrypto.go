@@ -0,0 +1,34 @@
+//go:build boringcrypto
+
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package fips
+
+import (
+	"crypto/boring"
+	// Restricts every TLS configuration of the process, clients included, to FIPS approved settings
+	_ "crypto/tls/fipsonly"
+)
+
+// Enabled returns true when the binary uses the FIPS validated BoringCrypto module.
+// Such binaries are built with GOEXPERIMENT=boringcrypto.
+func Enabled() bool {
+	return boring.Enabled()
+}
@@ -40,7 +40,9 @@ func DialSocket(socketPath string) (*grpc.ClientConn, error) {
 	return DialSocketWithTimeout(socketPath, 0)
 }
 
-func DialSocketWithTimeout(socketPath string, timeout int) (*grpc.ClientConn, error) {
+// DialSocketWithTimeout dials the unix socket. The given options are applied
+// after the default ones, and may override them.
+func DialSocketWithTimeout(socketPath string, timeout int, extraOptions ...grpc.DialOption) (*grpc.ClientConn, error) {
 
 	options := []grpc.DialOption{
 		grpc.WithAuthority("localhost"),
@@ -56,6 +58,7 @@ func DialSocketWithTimeout(socketPath string, timeout int) (*grpc.ClientConn, er
 			grpc.WithTimeout(time.Duration(timeout+CONNECT_TIMEOUT_SECONDS)*time.Second),
 		)
 	}
+	options = append(options, extraOptions...)
 
 	// Combined with the Block option, this context controls how long to wait for establishing the connection.
 	// The dial timeout used above, controls the overall duration of the connection (including RCP calls).
@@ -23,6 +23,7 @@ import (
 	"os"
 	"path/filepath"
 
+	k8sv1 "k8s.io/api/core/v1"
 	netutils "k8s.io/utils/net"
 )
 
@@ -75,6 +76,21 @@ func NormalizeIPAddress(ipAddress string) string {
 	return ipAddress
 }
 
+// SelectByFamily returns the first address of the given IP family. It falls back to the first
+// address when the family is empty or none of the addresses belongs to it.
+func SelectByFamily(ipAddresses []string, family k8sv1.IPFamily) string {
+	if len(ipAddresses) == 0 {
+		return ""
+	}
+	for _, ipAddress := range ipAddresses {
+		if family == k8sv1.IPv6Protocol && netutils.IsIPv6String(ipAddress) ||
+			family == k8sv1.IPv4Protocol && netutils.IsIPv4String(ipAddress) {
+			return ipAddress
+		}
+	}
+	return ipAddresses[0]
+}
+
 func isIPv6Disabled(filename string) bool {
 	data, err := os.ReadFile(filepath.Clean(filename))
 	if err != nil {
@@ -24,6 +24,8 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
 )
 
 var _ = Describe("IP utils test", func() {
@@ -99,4 +101,21 @@ var _ = Describe("IP utils test", func() {
 			Expect(address).To(Equal("::1"))
 		})
 	})
+
+	Context("SelectByFamily", func() {
+		const (
+			ipv4Address = "10.244.0.5"
+			ipv6Address = "fd00:10:244::5"
+		)
+
+		DescribeTable("should select", func(addresses []string, family k8sv1.IPFamily, expected string) {
+			Expect(SelectByFamily(addresses, family)).To(Equal(expected))
+		},
+			Entry("the first address without a preferred family", []string{ipv6Address, ipv4Address}, k8sv1.IPFamily(""), ipv6Address),
+			Entry("the IPv4 address when preferred", []string{ipv6Address, ipv4Address}, k8sv1.IPv4Protocol, ipv4Address),
+			Entry("the IPv6 address when preferred", []string{ipv4Address, ipv6Address}, k8sv1.IPv6Protocol, ipv6Address),
+			Entry("the first address when the preferred family is missing", []string{ipv4Address}, k8sv1.IPv6Protocol, ipv4Address),
+			Entry("nothing without addresses", nil, k8sv1.IPv4Protocol, ""),
+		)
+	})
 })
@@ -21,6 +21,12 @@ const noSrvCertMessage = "No server certificate, server is not yet ready to rece
 var (
 	cipherSuites         = tls.CipherSuites()
 	insecureCipherSuites = tls.InsecureCipherSuites()
+	fipsCipherSuites     = []uint16{
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	}
 )
 
 func SetupPromTLS(certManager certificate.Manager, clusterConfig *virtconfig.ClusterConfig) *tls.Config {
@@ -39,16 +45,11 @@ func SetupPromTLS(certManager certificate.Manager, clusterConfig *virtconfig.Clu
 				return nil, fmt.Errorf(noSrvCertMessage)
 			}
 
-			kv := clusterConfig.GetConfigFromKubeVirtCR()
-			tlsConfig := getTLSConfiguration(kv)
-			ciphers := CipherSuiteIds(tlsConfig.Ciphers)
-			minTLSVersion := TLSVersion(tlsConfig.MinTLSVersion)
 			config := &tls.Config{
-				CipherSuites: ciphers,
-				MinVersion:   minTLSVersion,
 				Certificates: []tls.Certificate{*crt},
 				ClientAuth:   tls.VerifyClientCertIfGiven,
 			}
+			applyTLSConfiguration(config, clusterConfig.GetConfigFromKubeVirtCR())
 
 			config.BuildNameToCertificate()
 			return config, nil
@@ -74,15 +75,10 @@ func SetupExportProxyTLS(certManager certificate.Manager, kubeVirtStore cache.St
 				return nil, fmt.Errorf(noSrvCertMessage)
 			}
 
-			kv := getKubevirt(kubeVirtStore)
-			tlsConfig := getTLSConfiguration(kv)
-			ciphers := CipherSuiteIds(tlsConfig.Ciphers)
-			minTLSVersion := TLSVersion(tlsConfig.MinTLSVersion)
 			config := &tls.Config{
-				CipherSuites: ciphers,
-				MinVersion:   minTLSVersion,
 				Certificates: []tls.Certificate{*crt},
 			}
+			applyTLSConfiguration(config, getKubevirt(kubeVirtStore))
 
 			config.BuildNameToCertificate()
 			return config, nil
@@ -112,17 +108,12 @@ func SetupTLSWithCertManager(caManager ClientCAManager, certManager certificate.
 				return nil, err
 			}
 
-			kv := clusterConfig.GetConfigFromKubeVirtCR()
-			tlsConfig := getTLSConfiguration(kv)
-			ciphers := CipherSuiteIds(tlsConfig.Ciphers)
-			minTLSVersion := TLSVersion(tlsConfig.MinTLSVersion)
 			config := &tls.Config{
-				CipherSuites: ciphers,
-				MinVersion:   minTLSVersion,
 				Certificates: []tls.Certificate{*cert},
 				ClientCAs:    clientCAPool,
 				ClientAuth:   clientAuth,
 			}
+			applyTLSConfiguration(config, clusterConfig.GetConfigFromKubeVirtCR())
 
 			config.BuildNameToCertificate()
 			return config, nil
@@ -159,14 +150,8 @@ func SetupTLSForVirtHandlerServer(caManager ClientCAManager, certManager certifi
 				return nil, fmt.Errorf(noSrvCertMessage)
 			}
 
-			kv := clusterConfig.GetConfigFromKubeVirtCR()
-			tlsConfig := getTLSConfiguration(kv)
-			ciphers := CipherSuiteIds(tlsConfig.Ciphers)
-			minTLSVersion := TLSVersion(tlsConfig.MinTLSVersion)
 			config = &tls.Config{
-				CipherSuites: ciphers,
-				MinVersion:   minTLSVersion,
-				ClientCAs:    certPool,
+				ClientCAs: certPool,
 				GetCertificate: func(info *tls.ClientHelloInfo) (i *tls.Certificate, e error) {
 					return cert, nil
 				},
@@ -178,6 +163,7 @@ func SetupTLSForVirtHandlerServer(caManager ClientCAManager, certManager certifi
 				},
 				ClientAuth: tls.RequireAndVerifyClientCert,
 			}
+			applyTLSConfiguration(config, clusterConfig.GetConfigFromKubeVirtCR())
 			return config, nil
 		},
 	}
@@ -227,6 +213,44 @@ func getTLSConfiguration(kubevirt *v1.KubeVirt) *v1.TLSConfiguration {
 	return tlsConfiguration
 }
 
+// applyTLSConfiguration sets the protocol versions and cipher suites configured in the KubeVirt CR
+func applyTLSConfiguration(config *tls.Config, kubevirt *v1.KubeVirt) {
+	tlsConfiguration := getTLSConfiguration(kubevirt)
+	config.CipherSuites = CipherSuiteIds(tlsConfiguration.Ciphers)
+	config.MinVersion = TLSVersion(tlsConfiguration.MinTLSVersion)
+	if tlsConfiguration.FIPSMode {
+		ApplyFIPSMode(config)
+	}
+}
+
+// ApplyFIPSMode restricts config to TLS 1.2 with the FIPS approved cipher suites and curves.
+// TLS 1.3 is excluded because Go doesn't allow to restrict its cipher suites.
+func ApplyFIPSMode(config *tls.Config) {
+	var ciphers []uint16
+	for _, id := range config.CipherSuites {
+		if IsFIPSApprovedCipherSuite(id) {
+			ciphers = append(ciphers, id)
+		}
+	}
+	if len(ciphers) == 0 {
+		ciphers = fipsCipherSuites
+	}
+	config.CipherSuites = ciphers
+	config.MinVersion = tls.VersionTLS12
+	config.MaxVersion = tls.VersionTLS12
+	config.CurvePreferences = []tls.CurveID{tls.CurveP256, tls.CurveP384}
+}
+
+// IsFIPSApprovedCipherSuite returns true if the cipher suite is approved for TLS 1.2 in FIPS mode
+func IsFIPSApprovedCipherSuite(id uint16) bool {
+	for _, fipsID := range fipsCipherSuites {
+		if id == fipsID {
+			return true
+		}
+	}
+	return false
+}
+
 func CipherSuiteIds(names []string) []uint16 {
 	var idByName = CipherSuiteNameMap()
 	var ids []uint16
@@ -363,4 +363,76 @@ var _ = Describe("TLS", func() {
 			},
 		),
 	)
+
+	DescribeTable("should only negotiate FIPS approved settings in FIPS mode", func(serverTLSConfigFunc configFunc) {
+		kvConfig := clusterConfig.GetConfigFromKubeVirtCR().DeepCopy()
+		kvConfig.Spec.Configuration.TLSConfiguration = &v12.TLSConfiguration{
+			FIPSMode: true,
+		}
+		testutils.UpdateFakeKubeVirtClusterConfig(kubeVirtStore, kvConfig)
+
+		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, "hello")
+		}))
+		srv.TLS = serverTLSConfigFunc()
+		srv.StartTLS()
+		defer srv.Close()
+
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+		resp, err := client.Get(srv.URL)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.TLS.Version).To(Equal(uint16(tls.VersionTLS12)))
+		Expect(kvtls.IsFIPSApprovedCipherSuite(resp.TLS.CipherSuite)).To(BeTrue())
+
+		client = &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: true,
+			MinVersion:         tls.VersionTLS13,
+		}}}
+		_, err = client.Get(srv.URL)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("remote error: tls: protocol version not supported"))
+
+		client = &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: true,
+			MaxVersion:         tls.VersionTLS12,
+			CipherSuites:       []uint16{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305},
+		}}}
+		_, err = client.Get(srv.URL)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("remote error: tls: handshake failure"))
+	},
+		Entry("on prometheus endpoint",
+			func() *tls.Config {
+				return kvtls.SetupPromTLS(certmanagers[components.VirtHandlerServerCertSecretName], clusterConfig)
+			},
+		),
+		Entry("on exportproxy endpoint",
+			func() *tls.Config {
+				return kvtls.SetupExportProxyTLS(certmanagers[components.VirtHandlerServerCertSecretName], kubeVirtStore)
+			},
+		),
+	)
+
+	Context("ApplyFIPSMode", func() {
+		It("should drop cipher suites which are not FIPS approved", func() {
+			config := &tls.Config{
+				CipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305, tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384},
+				MinVersion:   tls.VersionTLS10,
+			}
+			kvtls.ApplyFIPSMode(config)
+			Expect(config.CipherSuites).To(ConsistOf(uint16(tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384)))
+			Expect(config.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+			Expect(config.MaxVersion).To(Equal(uint16(tls.VersionTLS12)))
+			Expect(config.CurvePreferences).To(ConsistOf(tls.CurveP256, tls.CurveP384))
+		})
+
+		It("should use all FIPS approved cipher suites when none is configured", func() {
+			config := &tls.Config{}
+			kvtls.ApplyFIPSMode(config)
+			Expect(config.CipherSuites).To(HaveLen(4))
+			for _, id := range config.CipherSuites {
+				Expect(kvtls.IsFIPSApprovedCipherSuite(id)).To(BeTrue())
+			}
+		})
+	})
 })
@@ -24,9 +24,11 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"sync"
 	"syscall"
 	"time"
@@ -50,6 +52,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/util/ratelimiter"
 
 	v1 "kubevirt.io/api/core/v1"
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 	clientutil "kubevirt.io/client-go/util"
@@ -232,6 +235,7 @@ func (app *virtAPIApp) composeSubresources() {
 		subresourcesvmGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachines"}
 		subresourcesvmiGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachineinstances"}
 		expandvmspecGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "expand-vm-spec"}
+		searchGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "search"}
 
 		subws := new(restful.WebService)
 		subws.Doc(fmt.Sprintf("KubeVirt \"%s\" Subresource API.", version.Version))
@@ -287,6 +291,31 @@ func (app *virtAPIApp) composeSubresources() {
 		stopRouteBuilder.ParameterNamed("body").Required(false)
 		subws.Route(stopRouteBuilder)
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("renewlease")).
+			To(subresourceApp.RenewVMLeaseRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.RenewLeaseOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-RenewLease").
+			Doc("Renew the lease of a VirtualMachine.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("modernize")).
+			To(subresourceApp.ModernizeVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.ModernizeOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-Modernize").
+			Produces(restful.MIME_JSON).
+			Doc("Rewrite the deprecated API usages of a VirtualMachine into their current equivalents.").
+			Writes(v1.VirtualMachineModernization{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineModernization{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("expand-spec")).
 			To(subresourceApp.ExpandSpecVMRequestHandler).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
@@ -297,6 +326,39 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("portalview")).
+			To(subresourceApp.VMPortalView).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-PortalView").
+			Produces(restful.MIME_JSON).
+			Doc("Get a sanitized view of the VirtualMachine and its VirtualMachineInstance for end user portals.").
+			Writes(v1.VirtualMachinePortalView{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachinePortalView{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("timeline")).
+			To(subresourceApp.VMTimeline).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-Timeline").
+			Produces(restful.MIME_JSON).
+			Doc("Get the timeline of the VirtualMachine, with the deduplicated events, condition transitions and migrations of its VMIs.").
+			Writes(timelinev1alpha1.VirtualMachineTimeline{}).
+			Returns(http.StatusOK, "OK", timelinev1alpha1.VirtualMachineTimeline{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("portalview")).
+			To(subresourceApp.VMIPortalView).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-PortalView").
+			Produces(restful.MIME_JSON).
+			Doc("Get a sanitized view of the VirtualMachineInstance for end user portals.").
+			Writes(v1.VirtualMachinePortalView{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachinePortalView{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("freeze")).
 			To(subresourceApp.FreezeVMIRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -436,6 +498,32 @@ func (app *virtAPIApp) composeSubresources() {
 			Operation(version.Version+"Guestfs").
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+		subws.Route(subws.GET(definitions.SubResourcePath("capabilities")).Produces(restful.MIME_JSON).
+			To(subresourceApp.ClusterCapabilitiesHandler).
+			Operation(version.Version+"Capabilities").
+			Doc("Summarize the feature gates, machine types, CPU models, network bindings, host devices and storage profiles available in the cluster.").
+			Writes(v1.ClusterCapabilities{}).
+			Returns(http.StatusOK, "OK", v1.ClusterCapabilities{}).
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+		subws.Route(addSearchParams(subws, subws.GET(definitions.SubResourcePath("search"))).
+			To(subresourceApp.SearchRequestHandler).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"Search").
+			Doc("Search the VMIs of the cluster by label, guest OS, IP and MAC address. The VMIs match any of the IP and MAC addresses.").
+			Writes(v1.VirtualMachineInstanceList{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceList{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusServiceUnavailable, "Service Unavailable", ""))
+		subws.Route(addSearchParams(subws, subws.GET(definitions.NamespacedResourceBasePath(searchGVR))).
+			To(subresourceApp.SearchRequestHandler).
+			Param(definitions.NamespaceParam(subws)).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"NamespacedSearch").
+			Doc("Search the VMIs of a namespace by label, guest OS, IP and MAC address. The VMIs match any of the IP and MAC addresses.").
+			Writes(v1.VirtualMachineInstanceList{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceList{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusServiceUnavailable, "Service Unavailable", ""))
 		subws.Route(subws.GET(definitions.SubResourcePath("healthz")).
 			To(healthz.KubeConnectionHealthzFuncFactory(app.clusterConfig, apiHealthVersion)).
 			Consumes(restful.MIME_JSON).
@@ -474,6 +562,17 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("devices")).
+			To(subresourceApp.DeviceList).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Devices").
+			Doc("Get the devices attached to the domain of a running Virtual Machine Instance, as reported by libvirt").
+			Writes(v1.VirtualMachineInstanceDeviceList{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceDeviceList{}).
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMIAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -494,6 +593,26 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addchannel")).
+			To(subresourceApp.VMIAddChannelRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.AddChannelOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-addchannel").
+			Doc("Add a channel to a running Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("flatten")).
+			To(subresourceApp.VMIFlattenRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.FlattenOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-flatten").
+			Doc("Flatten the backing chains of the volumes of a running Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -573,6 +692,19 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("verifyidentity")).
+			To(subresourceApp.VerifyIdentityRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.SignedIdentityDocument{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-VerifyIdentity").
+			Produces(restful.MIME_JSON).
+			Doc("Verify the identity document presented on behalf of a Virtual Machine Instance").
+			Writes(v1.VirtualMachineInstanceIdentity{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceIdentity{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		// Return empty api resource list.
 		// K8s expects to be able to retrieve a resource list for each aggregated
 		// app in order to discover what resources it provides. Without returning
@@ -639,18 +771,42 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachines/migrate",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachines/renewlease",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/modernize",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachines/expand-spec",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachines/portalview",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/timeline",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/guestosinfo",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/portalview",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/userlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/devices",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
@@ -663,6 +819,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/removevolume",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/addchannel",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/flatten",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/sev/fetchcertchain",
 						Namespaced: true,
@@ -679,6 +843,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/sev/injectlaunchsecret",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/verifyidentity",
+						Namespaced: true,
+					},
 				}
 
 				response.WriteAsJson(list)
@@ -763,6 +931,14 @@ func (app *virtAPIApp) composeSubresources() {
 	restful.Add(ws)
 }
 
+func addSearchParams(ws *restful.WebService, builder *restful.RouteBuilder) *restful.RouteBuilder {
+	return builder.
+		Param(ws.QueryParameter("labelSelector", "A selector to restrict the returned VMIs by their labels. Defaults to everything")).
+		Param(ws.QueryParameter("guestOS", "The ID or the name of the OS reported by the guest agent, ignoring the case")).
+		Param(ws.QueryParameter("ip", "An IP address reported on an interface, may be repeated").AllowMultiple(true)).
+		Param(ws.QueryParameter("mac", "A MAC address reported on an interface, may be repeated").AllowMultiple(true))
+}
+
 func (app *virtAPIApp) Compose() {
 
 	app.composeSubresources()
@@ -901,6 +1077,9 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMExportValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMExports(w, r, app.clusterConfig)
 	})
+	http.HandleFunc(components.ConsoleAccessGrantValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeConsoleAccessGrants(w, r)
+	})
 	http.HandleFunc(components.VMInstancetypeValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVmInstancetypes(w, r)
 	})
@@ -987,7 +1166,7 @@ func (app *virtAPIApp) startTLS(informerFactory controller.KubeInformerFactory)
 
 	http.Handle("/metrics", promhttp.Handler())
 	server := &http.Server{
-		Addr:      fmt.Sprintf("%s:%d", app.BindAddress, app.Port),
+		Addr:      net.JoinHostPort(app.BindAddress, strconv.Itoa(app.Port)),
 		TLSConfig: app.tlsConfig,
 		// Disable HTTP/2
 		// See CVE-2023-44487
@@ -25,8 +25,12 @@ import (
 	"path"
 	"reflect"
 
+	"kubevirt.io/api/breakglass"
+	breakglassv1alpha1 "kubevirt.io/api/breakglass/v1alpha1"
 	"kubevirt.io/api/clone"
 	clonev1lpha1 "kubevirt.io/api/clone/v1alpha1"
+	"kubevirt.io/api/diskcheck"
+	diskcheckv1alpha1 "kubevirt.io/api/diskcheck/v1alpha1"
 
 	"kubevirt.io/api/instancetype"
 
@@ -41,9 +45,17 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/api/guestagent"
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
+	"kubevirt.io/api/hostdevices"
+	hostdevicesv1alpha1 "kubevirt.io/api/hostdevices/v1alpha1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/api/notifications"
+	notificationsv1alpha1 "kubevirt.io/api/notifications/v1alpha1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/api/timeline"
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
 
 	mime "kubevirt.io/kubevirt/pkg/rest"
 )
@@ -62,6 +74,12 @@ func ComposeAPIDefinitions() []*restful.WebService {
 		exportApiServiceDefinitions,
 		instancetypeApiServiceDefinitions,
 		migrationPoliciesApiServiceDefinitions,
+		guestAgentPoliciesApiServiceDefinitions,
+		lifecycleNotificationsApiServiceDefinitions,
+		timelineApiServiceDefinitions,
+		diskCheckApiServiceDefinitions,
+		consoleAccessGrantApiServiceDefinitions,
+		hostDeviceInventoryApiServiceDefinitions,
 		poolApiServiceDefinitions,
 		vmCloneDefinitions,
 	} {
@@ -193,6 +211,126 @@ func migrationPoliciesApiServiceDefinitions() []*restful.WebService {
 	return []*restful.WebService{ws, ws2}
 }
 
+func guestAgentPoliciesApiServiceDefinitions() []*restful.WebService {
+	gapGVR := guestagentv1alpha1.SchemeGroupVersion.WithResource(guestagent.ResourceGuestAgentPolicies)
+
+	ws, err := groupVersionProxyBase(guestagentv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, gapGVR, &guestagentv1alpha1.GuestAgentPolicy{}, guestagentv1alpha1.GuestAgentPolicyKind.Kind, &guestagentv1alpha1.GuestAgentPolicyList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(gapGVR)
+	if err != nil {
+		panic(err)
+	}
+	return []*restful.WebService{ws, ws2}
+}
+
+func lifecycleNotificationsApiServiceDefinitions() []*restful.WebService {
+	lnGVR := notificationsv1alpha1.SchemeGroupVersion.WithResource(notifications.ResourceLifecycleNotifications)
+
+	ws, err := groupVersionProxyBase(notificationsv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, lnGVR, &notificationsv1alpha1.LifecycleNotification{}, notificationsv1alpha1.LifecycleNotificationKind.Kind, &notificationsv1alpha1.LifecycleNotificationList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(lnGVR)
+	if err != nil {
+		panic(err)
+	}
+	return []*restful.WebService{ws, ws2}
+}
+
+func timelineApiServiceDefinitions() []*restful.WebService {
+	timelineGVR := timelinev1alpha1.SchemeGroupVersion.WithResource(timeline.ResourceVirtualMachineTimelines)
+
+	ws, err := groupVersionProxyBase(timelinev1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, timelineGVR, &timelinev1alpha1.VirtualMachineTimeline{}, timelinev1alpha1.VirtualMachineTimelineKind.Kind, &timelinev1alpha1.VirtualMachineTimelineList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(timelineGVR)
+	if err != nil {
+		panic(err)
+	}
+	return []*restful.WebService{ws, ws2}
+}
+
+func diskCheckApiServiceDefinitions() []*restful.WebService {
+	diskCheckGVR := diskcheckv1alpha1.SchemeGroupVersion.WithResource(diskcheck.ResourceVirtualMachineDiskChecks)
+
+	ws, err := groupVersionProxyBase(diskcheckv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, diskCheckGVR, &diskcheckv1alpha1.VirtualMachineDiskCheck{}, diskcheckv1alpha1.VirtualMachineDiskCheckKind.Kind, &diskcheckv1alpha1.VirtualMachineDiskCheckList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(diskCheckGVR)
+	if err != nil {
+		panic(err)
+	}
+	return []*restful.WebService{ws, ws2}
+}
+
+func consoleAccessGrantApiServiceDefinitions() []*restful.WebService {
+	consoleAccessGrantGVR := breakglassv1alpha1.SchemeGroupVersion.WithResource(breakglass.ResourceConsoleAccessGrants)
+
+	ws, err := groupVersionProxyBase(breakglassv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, consoleAccessGrantGVR, &breakglassv1alpha1.ConsoleAccessGrant{}, breakglassv1alpha1.ConsoleAccessGrantKind.Kind, &breakglassv1alpha1.ConsoleAccessGrantList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(consoleAccessGrantGVR)
+	if err != nil {
+		panic(err)
+	}
+	return []*restful.WebService{ws, ws2}
+}
+
+func hostDeviceInventoryApiServiceDefinitions() []*restful.WebService {
+	hostDeviceInventoryGVR := hostdevicesv1alpha1.SchemeGroupVersion.WithResource(hostdevices.ResourceHostDeviceInventories)
+
+	ws, err := groupVersionProxyBase(hostdevicesv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericClusterResourceProxy(ws, hostDeviceInventoryGVR, &hostdevicesv1alpha1.HostDeviceInventory{}, hostdevicesv1alpha1.HostDeviceInventoryKind.Kind, &hostdevicesv1alpha1.HostDeviceInventoryList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(hostDeviceInventoryGVR)
+	if err != nil {
+		panic(err)
+	}
+	return []*restful.WebService{ws, ws2}
+}
+
 func instancetypeApiServiceDefinitions() []*restful.WebService {
 	instancetypeGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.PluralResourceName)
 	clusterInstancetypeGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.ClusterPluralResourceName)
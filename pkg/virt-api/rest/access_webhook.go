@@ -0,0 +1,157 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	authv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	defaultSubresourceAccessWebhookTimeout = 10 * time.Second
+	subresourceAccessReviewKind            = "SubresourceAccessReview"
+)
+
+// withSubresourceAccessReview extends validate to have the access to the subresource of the VMI
+// authorized by the subresource access webhook, if one is configured
+func (app *SubresourceAPIApp) withSubresourceAccessReview(request *restful.Request, subresource string, validate validator) validator {
+	return func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if statusErr := validate(vmi); statusErr != nil {
+			return statusErr
+		}
+		return app.reviewSubresourceAccess(request, subresource, vmi)
+	}
+}
+
+func (app *SubresourceAPIApp) reviewSubresourceAccess(request *restful.Request, subresource string, vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	webhook := app.clusterConfig.GetSubresourceAccessWebhook()
+	if webhook == nil || (len(webhook.Subresources) > 0 && !slices.Contains(webhook.Subresources, subresource)) {
+		return nil
+	}
+
+	review, err := app.newSubresourceAccessReview(request, subresource, vmi)
+	if err == nil {
+		review, err = sendSubresourceAccessReview(webhook, review)
+	}
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to review the access to the %s of the VMI", subresource)
+		if webhook.FailurePolicy != nil && *webhook.FailurePolicy == v1.SubresourceAccessFailurePolicyIgnore {
+			return nil
+		}
+		return errors.NewInternalError(fmt.Errorf("failed to review the access: %v", err))
+	}
+
+	if !review.Status.Allowed {
+		return errors.NewForbidden(v1.Resource("virtualmachineinstances/"+subresource), vmi.Name, fmt.Errorf("denied by the subresource access webhook: %s", review.Status.Reason))
+	}
+	return nil
+}
+
+func (app *SubresourceAPIApp) newSubresourceAccessReview(request *restful.Request, subresource string, vmi *v1.VirtualMachineInstance) (*v1.SubresourceAccessReview, error) {
+	subject, ok := request.Attribute(subjectAttribute).(authv1.SubjectAccessReviewSpec)
+	if !ok {
+		return nil, fmt.Errorf("the user of the request is unknown")
+	}
+
+	review := &v1.SubresourceAccessReview{
+		TypeMeta: k8smetav1.TypeMeta{
+			APIVersion: v1.SubresourceStorageGroupVersion.String(),
+			Kind:       subresourceAccessReviewKind,
+		},
+		Spec: v1.SubresourceAccessReviewSpec{
+			User:        subject.User,
+			Groups:      subject.Groups,
+			Namespace:   vmi.Namespace,
+			Name:        vmi.Name,
+			Subresource: subresource,
+			VMILabels:   vmi.Labels,
+			RequestTime: k8smetav1.Now(),
+		},
+	}
+	if len(subject.Extra) > 0 {
+		review.Spec.Extra = map[string][]string{}
+		for key, value := range subject.Extra {
+			review.Spec.Extra[key] = value
+		}
+	}
+
+	if owner := k8smetav1.GetControllerOf(vmi); owner != nil && owner.Kind == v1.VirtualMachineGroupVersionKind.Kind {
+		vm, err := app.virtCli.VirtualMachine(vmi.Namespace).Get(context.Background(), owner.Name, k8smetav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		review.Spec.VMLabels = vm.Labels
+	}
+
+	return review, nil
+}
+
+func sendSubresourceAccessReview(webhook *v1.SubresourceAccessWebhook, review *v1.SubresourceAccessReview) (*v1.SubresourceAccessReview, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(webhook.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(webhook.CABundle) {
+			return nil, fmt.Errorf("no certificate could be parsed from the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	timeout := defaultSubresourceAccessWebhookTimeout
+	if webhook.TimeoutSeconds != nil {
+		timeout = time.Duration(*webhook.TimeoutSeconds) * time.Second
+	}
+	client := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		Timeout:   timeout,
+	}
+
+	body, err := json.Marshal(review)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Post(webhook.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the webhook answered with the status %s", response.Status)
+	}
+
+	result := &v1.SubresourceAccessReview{}
+	if err := json.NewDecoder(response.Body).Decode(result); err != nil {
+		return nil, fmt.Errorf("failed to decode the answer of the webhook: %v", err)
+	}
+	return result, nil
+}
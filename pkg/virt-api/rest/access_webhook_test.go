@@ -0,0 +1,208 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authv1 "k8s.io/api/authorization/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Subresource access webhook", func() {
+	const (
+		vmiName      = "testvmi"
+		vmiNamespace = "default"
+	)
+
+	var (
+		vmClient   *kubecli.MockVirtualMachineInterface
+		virtClient *kubecli.MockKubevirtClient
+		app        *SubresourceAPIApp
+		request    *restful.Request
+		vmi        *v1.VirtualMachineInstance
+
+		server   *httptest.Server
+		reviews  []*v1.SubresourceAccessReview
+		decision v1.SubresourceAccessReviewStatus
+	)
+
+	configureWebhook := func(webhook *v1.SubresourceAccessWebhook) {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: "kubevirt", Namespace: "kubevirt"},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{SubresourceAccessWebhook: webhook},
+			},
+			Status: v1.KubeVirtStatus{Phase: v1.KubeVirtPhaseDeployed},
+		})
+		app.clusterConfig = config
+	}
+
+	webhookFor := func(server *httptest.Server) *v1.SubresourceAccessWebhook {
+		return &v1.SubresourceAccessWebhook{
+			URL:      server.URL,
+			CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		virtClient.EXPECT().VirtualMachine(vmiNamespace).Return(vmClient).AnyTimes()
+		app = NewSubresourceAPIApp(virtClient, 0, nil, nil)
+		configureWebhook(nil)
+
+		request = restful.NewRequest(&http.Request{})
+		request.SetAttribute(subjectAttribute, authv1.SubjectAccessReviewSpec{
+			User:   "jdoe",
+			Groups: []string{"developers"},
+			Extra:  map[string]authv1.ExtraValue{"ticket": {"INC-42"}},
+		})
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:      vmiName,
+				Namespace: vmiNamespace,
+				Labels:    map[string]string{"tier": "database"},
+			},
+		}
+
+		reviews = nil
+		decision = v1.SubresourceAccessReviewStatus{Allowed: true}
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			review := &v1.SubresourceAccessReview{}
+			Expect(json.NewDecoder(r.Body).Decode(review)).To(Succeed())
+			reviews = append(reviews, review)
+			review.Status = decision
+			Expect(json.NewEncoder(w).Encode(review)).To(Succeed())
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	It("should allow the access without a webhook", func() {
+		Expect(app.reviewSubresourceAccess(request, "console", vmi)).To(BeNil())
+	})
+
+	It("should send the user, the VMI and the labels of its VM to the webhook", func() {
+		configureWebhook(webhookFor(server))
+		vmi.OwnerReferences = []k8smetav1.OwnerReference{{
+			APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+			Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+			Name:       "testvm",
+			Controller: pointer.P(true),
+		}}
+		vmClient.EXPECT().Get(context.Background(), "testvm", k8smetav1.GetOptions{}).Return(&v1.VirtualMachine{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: "testvm", Labels: map[string]string{"owner": "team-a"}},
+		}, nil)
+
+		Expect(app.reviewSubresourceAccess(request, "console", vmi)).To(BeNil())
+
+		Expect(reviews).To(HaveLen(1))
+		Expect(reviews[0].Kind).To(Equal("SubresourceAccessReview"))
+		Expect(reviews[0].Spec.User).To(Equal("jdoe"))
+		Expect(reviews[0].Spec.Groups).To(ConsistOf("developers"))
+		Expect(reviews[0].Spec.Extra).To(HaveKeyWithValue("ticket", []string{"INC-42"}))
+		Expect(reviews[0].Spec.Namespace).To(Equal(vmiNamespace))
+		Expect(reviews[0].Spec.Name).To(Equal(vmiName))
+		Expect(reviews[0].Spec.Subresource).To(Equal("console"))
+		Expect(reviews[0].Spec.VMILabels).To(HaveKeyWithValue("tier", "database"))
+		Expect(reviews[0].Spec.VMLabels).To(HaveKeyWithValue("owner", "team-a"))
+		Expect(reviews[0].Spec.RequestTime.IsZero()).To(BeFalse())
+	})
+
+	It("should deny the access denied by the webhook", func() {
+		configureWebhook(webhookFor(server))
+		decision = v1.SubresourceAccessReviewStatus{Reason: "no approved ticket"}
+
+		statusErr := app.reviewSubresourceAccess(request, "vnc", vmi)
+
+		Expect(statusErr).ToNot(BeNil())
+		Expect(statusErr.Status().Code).To(BeEquivalentTo(http.StatusForbidden))
+		Expect(statusErr.Error()).To(ContainSubstring("no approved ticket"))
+	})
+
+	It("should not consult the webhook for the subresources it isn't configured for", func() {
+		webhook := webhookFor(server)
+		webhook.Subresources = []string{"console"}
+		configureWebhook(webhook)
+		decision = v1.SubresourceAccessReviewStatus{}
+
+		Expect(app.reviewSubresourceAccess(request, "portforward", vmi)).To(BeNil())
+		Expect(reviews).To(BeEmpty())
+	})
+
+	It("should validate the VMI before consulting the webhook", func() {
+		configureWebhook(webhookFor(server))
+
+		validate := app.withSubresourceAccessReview(request, "console", validateVMIForConsole)
+
+		Expect(validate(vmi)).ToNot(BeNil())
+		Expect(reviews).To(BeEmpty())
+	})
+
+	DescribeTable("when the webhook fails", func(failurePolicy *v1.SubresourceAccessFailurePolicy, expectedCode int) {
+		failing := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		DeferCleanup(failing.Close)
+		webhook := webhookFor(failing)
+		webhook.FailurePolicy = failurePolicy
+		configureWebhook(webhook)
+
+		statusErr := app.reviewSubresourceAccess(request, "console", vmi)
+
+		if expectedCode == http.StatusOK {
+			Expect(statusErr).To(BeNil())
+		} else {
+			Expect(statusErr).ToNot(BeNil())
+			Expect(statusErr.Status().Code).To(BeEquivalentTo(expectedCode))
+		}
+	},
+		Entry("should deny the access by default", nil, http.StatusInternalServerError),
+		Entry("should deny the access with the Fail policy", pointer.P(v1.SubresourceAccessFailurePolicyFail), http.StatusInternalServerError),
+		Entry("should allow the access with the Ignore policy", pointer.P(v1.SubresourceAccessFailurePolicyIgnore), http.StatusOK),
+	)
+
+	It("should deny the access when the certificate of the webhook isn't trusted", func() {
+		webhook := webhookFor(server)
+		webhook.CABundle = nil
+		configureWebhook(webhook)
+
+		statusErr := app.reviewSubresourceAccess(request, "console", vmi)
+
+		Expect(statusErr).ToNot(BeNil())
+		Expect(reviews).To(BeEmpty())
+	})
+})
@@ -42,8 +42,12 @@ const (
 	groupHeader           = "X-Remote-Group"
 	userExtraHeaderPrefix = "X-Remote-Extra-"
 
+	// subjectAttribute holds the subject of an authorized request, as authenticated by the API server
+	subjectAttribute = "kubevirt.io/subject"
+
 	namespacedResourceAttributesMinParts  = 9
 	namespacedResourceBaseAttributesParts = 7
+	clusterResourceAttributesParts        = 5
 )
 
 var noAuthEndpoints = map[string]struct{}{
@@ -195,6 +199,7 @@ func (a *authorizor) generateAccessReview(req *restful.Request) (*authv1.Subject
 	// URL examples
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvmi/console
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/expand-vm-spec
+	// /apis/subresources.kubevirt.io/v1/search
 	pathSplit := strings.Split(req.Request.URL.Path, "/")
 	if len(pathSplit) >= namespacedResourceAttributesMinParts {
 		if err := addNamespacedResourceAttributes(pathSplit, req.Request.Method, r); err != nil {
@@ -204,6 +209,10 @@ func (a *authorizor) generateAccessReview(req *restful.Request) (*authv1.Subject
 		if err := addNamespacedResourceBaseAttributes(pathSplit, req.Request.Method, r); err != nil {
 			return nil, err
 		}
+	} else if len(pathSplit) == clusterResourceAttributesParts {
+		if err := addClusterResourceAttributes(pathSplit, req.Request.Method, r); err != nil {
+			return nil, err
+		}
 	} else {
 		return nil, fmt.Errorf("unknown api endpoint: %s", req.Request.URL.Path)
 	}
@@ -251,7 +260,7 @@ func addNamespacedResourceBaseAttributes(pathSplit []string, requestMethod strin
 	namespace := pathSplit[5]
 	resource := pathSplit[6]
 
-	if resource != "expand-vm-spec" {
+	if resource != "expand-vm-spec" && resource != "search" {
 		return fmt.Errorf("unknown resource type %s", resource)
 	}
 
@@ -271,6 +280,32 @@ func addNamespacedResourceBaseAttributes(pathSplit []string, requestMethod strin
 	return nil
 }
 
+func addClusterResourceAttributes(pathSplit []string, requestMethod string, r *authv1.SubjectAccessReview) error {
+	// URL example
+	// /apis/subresources.kubevirt.io/v1/search
+	group := pathSplit[2]
+	version := pathSplit[3]
+	resource := pathSplit[4]
+
+	if resource != "capabilities" && resource != "search" {
+		return fmt.Errorf("unknown resource type %s", resource)
+	}
+
+	verb, err := mapHttpVerbToRbacVerb(requestMethod, "")
+	if err != nil {
+		return err
+	}
+
+	r.Spec.ResourceAttributes = &authv1.ResourceAttributes{
+		Verb:     verb,
+		Group:    group,
+		Version:  version,
+		Resource: resource,
+	}
+
+	return nil
+}
+
 func mapHttpVerbToRbacVerb(httpVerb string, name string) (string, error) {
 	// see https://kubernetes.io/docs/reference/access-authn-authz/authorization/#determine-the-request-verb
 	// if name is empty, we assume plural verbs
@@ -345,6 +380,7 @@ func (a *authorizor) Authorize(req *restful.Request) (bool, string, error) {
 	}
 
 	if result.Status.Allowed {
+		req.SetAttribute(subjectAttribute, r.Spec)
 		return true, "", nil
 	}
 
@@ -44,8 +44,7 @@ var _ = Describe("Authorizer", func() {
 		)
 
 		BeforeEach(func() {
-			req = &restful.Request{}
-			req.Request = &http.Request{}
+			req = restful.NewRequest(&http.Request{})
 			req.Request.URL = &url.URL{}
 			req.Request.Header = make(map[string][]string)
 			req.Request.Header[userHeader] = []string{"user"}
@@ -131,6 +130,7 @@ var _ = Describe("Authorizer", func() {
 					result, _, err := app.Authorize(req)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(result).To(BeTrue())
+					Expect(req.Attribute(subjectAttribute)).To(HaveField("User", "user"))
 				})
 			})
 
@@ -187,10 +187,34 @@ var _ = Describe("Authorizer", func() {
 					result, _, err := app.Authorize(req)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(result).To(BeTrue())
+					Expect(req.Attribute(subjectAttribute)).To(HaveField("User", "user"))
 				})
 
 			})
 
+			DescribeTable("should review the access to cluster resources", func(resource string) {
+				allowedFn = func(sar *authv1.SubjectAccessReview) (*authv1.SubjectAccessReview, error) {
+					Expect(sar.Spec.NonResourceAttributes).To(BeNil())
+					Expect(sar.Spec.ResourceAttributes).To(Equal(&authv1.ResourceAttributes{
+						Verb:     "list",
+						Group:    "subresources.kubevirt.io",
+						Version:  "v1",
+						Resource: resource,
+					}))
+					sar.Status.Allowed = true
+					return sar, nil
+				}
+				req.Request.Method = http.MethodGet
+				req.Request.URL.Path = "/apis/subresources.kubevirt.io/v1/" + resource
+
+				result, _, err := app.Authorize(req)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(BeTrue())
+			},
+				Entry("capabilities", "capabilities"),
+				Entry("search", "search"),
+			)
+
 			DescribeTable("should allow all users for info endpoints", func(path string) {
 				req.Request.TLS = nil
 				req.Request.URL.Path = path
@@ -0,0 +1,174 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	restful "github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// ClusterCapabilitiesHandler handles the subresource summarizing what the cluster supports: the
+// feature gates, the machine types and CPU models of the architectures of the schedulable nodes,
+// the network binding plugins, the host devices and the storage profiles
+func (app *SubresourceAPIApp) ClusterCapabilitiesHandler(_ *restful.Request, response *restful.Response) {
+	nodes, err := app.virtCli.CoreV1().Nodes().List(context.Background(), k8smetav1.ListOptions{
+		LabelSelector: v1.NodeSchedulable + "=true",
+	})
+	if err != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("unable to list the schedulable nodes: %v", err)), response)
+		return
+	}
+	storageProfiles, statusErr := app.fetchStorageProfileCapabilities()
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	capabilities := &v1.ClusterCapabilities{
+		FeatureGates:        sortedUnique(app.clusterConfig.GetConfig().DeveloperConfiguration.FeatureGates),
+		DefaultArchitecture: app.clusterConfig.GetDefaultArchitecture(),
+		Architectures:       app.architectureCapabilities(nodes.Items),
+		HostDevices:         hostDeviceCapabilities(app.clusterConfig.GetPermittedHostDevices(), nodes.Items),
+		StorageProfiles:     storageProfiles,
+	}
+	for name := range app.clusterConfig.GetNetworkBindings() {
+		capabilities.NetworkBindings = append(capabilities.NetworkBindings, name)
+	}
+	slices.Sort(capabilities.NetworkBindings)
+
+	response.WriteEntity(capabilities)
+}
+
+// architectureCapabilities groups the nodes by architecture, sorted by name
+func (app *SubresourceAPIApp) architectureCapabilities(nodes []k8sv1.Node) []v1.ArchitectureCapabilities {
+	nodesPerArch := map[string]int32{}
+	cpuModelsPerArch := map[string][]string{}
+	for _, node := range nodes {
+		arch := node.Labels[k8sv1.LabelArchStable]
+		if arch == "" {
+			continue
+		}
+		nodesPerArch[arch]++
+		for label := range node.Labels {
+			if model, found := strings.CutPrefix(label, v1.CPUModelLabel); found {
+				cpuModelsPerArch[arch] = append(cpuModelsPerArch[arch], model)
+			}
+		}
+	}
+
+	var architectures []v1.ArchitectureCapabilities
+	for arch, count := range nodesPerArch {
+		architectures = append(architectures, v1.ArchitectureCapabilities{
+			Name:               arch,
+			Nodes:              count,
+			DefaultMachineType: app.clusterConfig.GetMachineType(arch),
+			MachineTypes:       app.clusterConfig.GetEmulatedMachines(arch),
+			CPUModels:          sortedUnique(cpuModelsPerArch[arch]),
+		})
+	}
+	slices.SortFunc(architectures, func(a, b v1.ArchitectureCapabilities) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return architectures
+}
+
+// hostDeviceCapabilities lists the resource names of the permitted host devices, with the number
+// of devices allocatable on the nodes
+func hostDeviceCapabilities(hostDevs *v1.PermittedHostDevices, nodes []k8sv1.Node) []v1.HostDeviceCapability {
+	if hostDevs == nil {
+		return nil
+	}
+
+	var capabilities []v1.HostDeviceCapability
+	add := func(resourceName string, deviceType v1.HostDeviceCapabilityType) {
+		if slices.ContainsFunc(capabilities, func(c v1.HostDeviceCapability) bool { return c.ResourceName == resourceName }) {
+			return
+		}
+		capability := v1.HostDeviceCapability{ResourceName: resourceName, Type: deviceType}
+		for _, node := range nodes {
+			if quantity, ok := node.Status.Allocatable[k8sv1.ResourceName(resourceName)]; ok {
+				capability.Allocatable += quantity.Value()
+			}
+		}
+		capabilities = append(capabilities, capability)
+	}
+	for _, pciDev := range hostDevs.PciHostDevices {
+		add(pciDev.ResourceName, v1.HostDeviceCapabilityPCI)
+	}
+	for _, mdevDev := range hostDevs.MediatedDevices {
+		add(mdevDev.ResourceName, v1.HostDeviceCapabilityMediated)
+	}
+	for _, usbDev := range hostDevs.USB {
+		add(usbDev.ResourceName, v1.HostDeviceCapabilityUSB)
+	}
+	return capabilities
+}
+
+// fetchStorageProfileCapabilities summarizes the storage profiles of CDI, nil when CDI is not installed
+func (app *SubresourceAPIApp) fetchStorageProfileCapabilities() ([]v1.StorageProfileCapability, *errors.StatusError) {
+	storageProfiles, err := app.virtCli.CdiClient().CdiV1beta1().StorageProfiles().List(context.Background(), k8smetav1.ListOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.NewInternalError(fmt.Errorf("unable to list the storage profiles: %v", err))
+	}
+
+	var capabilities []v1.StorageProfileCapability
+	for _, storageProfile := range storageProfiles.Items {
+		capability := v1.StorageProfileCapability{StorageClass: storageProfile.Name}
+		if storageProfile.Status.StorageClass != nil {
+			capability.StorageClass = *storageProfile.Status.StorageClass
+		}
+		for _, claimPropertySet := range storageProfile.Status.ClaimPropertySets {
+			for _, accessMode := range claimPropertySet.AccessModes {
+				if !slices.Contains(capability.AccessModes, accessMode) {
+					capability.AccessModes = append(capability.AccessModes, accessMode)
+				}
+			}
+			if volumeMode := claimPropertySet.VolumeMode; volumeMode != nil && !slices.Contains(capability.VolumeModes, *volumeMode) {
+				capability.VolumeModes = append(capability.VolumeModes, *volumeMode)
+			}
+		}
+		capabilities = append(capabilities, capability)
+	}
+	slices.SortFunc(capabilities, func(a, b v1.StorageProfileCapability) int {
+		return strings.Compare(a.StorageClass, b.StorageClass)
+	})
+	return capabilities, nil
+}
+
+func sortedUnique(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
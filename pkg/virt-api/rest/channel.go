@@ -0,0 +1,136 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMIAddChannelRequestHandler handles the subresource for hot plugging a channel into a running VMI.
+func (app *SubresourceAPIApp) VMIAddChannelRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.ChannelHotplugEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, virtconfig.ChannelHotplugGate)), response)
+		return
+	}
+
+	opts := &v1.AddChannelOptions{}
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, a channel is expected as the request body"), response)
+		return
+	}
+	defer request.Request.Body.Close()
+	switch err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts); err {
+	case io.EOF, nil:
+	default:
+		writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("AddChannelOptions requires name to be set"), response)
+		return
+	} else if opts.Target == "" {
+		writeError(errors.NewBadRequest("AddChannelOptions requires target to be set"), response)
+		return
+	}
+
+	vmi, statErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	if !vmi.IsRunning() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf(vmiNotRunning)), response)
+		return
+	}
+
+	if err := verifyChannelOption(vmi.Spec.Domain.Devices.Channels, opts); err != nil {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, err), response)
+		return
+	}
+
+	patchBytes, err := generateVMIChannelPatch(vmi, opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	var dryRunOption []string
+	if len(opts.DryRun) > 0 && opts.DryRun[0] == k8smetav1.DryRunAll {
+		dryRunOption = opts.DryRun
+	}
+
+	log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", string(patchBytes))
+	if _, err := app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, k8smetav1.PatchOptions{DryRun: dryRunOption}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi: %v", err)
+		if statErr, ok := err.(*errors.StatusError); ok && errors.IsInvalid(err) {
+			writeError(statErr, response)
+			return
+		}
+		writeError(errors.NewInternalError(fmt.Errorf("unable to patch vmi: %v", err)), response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func verifyChannelOption(channels []v1.Channel, opts *v1.AddChannelOptions) error {
+	for _, channel := range channels {
+		if channel.Name == opts.Name {
+			return fmt.Errorf("Unable to add channel [%s] because a channel with that name already exists", opts.Name)
+		}
+		if channel.Target == opts.Target {
+			return fmt.Errorf("Unable to add channel [%s] because target [%s] is already in use", opts.Name, opts.Target)
+		}
+	}
+	return nil
+}
+
+func generateVMIChannelPatch(vmi *v1.VirtualMachineInstance, opts *v1.AddChannelOptions) ([]byte, error) {
+	channels := append([]v1.Channel{}, vmi.Spec.Domain.Devices.Channels...)
+	channels = append(channels, v1.Channel{Name: opts.Name, Target: opts.Target})
+
+	patchSet := patch.New(patch.WithTest("/spec/domain/devices/channels", vmi.Spec.Domain.Devices.Channels))
+	if len(vmi.Spec.Domain.Devices.Channels) > 0 {
+		patchSet.AddOption(patch.WithReplace("/spec/domain/devices/channels", channels))
+	} else {
+		patchSet.AddOption(patch.WithAdd("/spec/domain/devices/channels", channels))
+	}
+	return patchSet.GeneratePayload()
+}
@@ -19,9 +19,14 @@ func (app *SubresourceAPIApp) ConsoleRequestHandler(request *restful.Request, re
 
 	defer apimetrics.SetVMILastConnectionTimestamp(request.PathParameter("namespace"), request.PathParameter("name"))
 
+	if request.QueryParameter(sharedConsoleParam) == "true" {
+		app.sharedConsoleRequestHandler(request, response)
+		return
+	}
+
 	streamer := NewRawStreamer(
 		app.FetchVirtualMachineInstance,
-		validateVMIForConsole,
+		app.withConsoleAccessGrant(request, "console", app.withSubresourceAccessReview(request, "console", validateVMIForConsole)),
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 			return conn.ConsoleURI(vmi)
 		}),
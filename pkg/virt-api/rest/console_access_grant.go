@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	authv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	breakglassv1alpha1 "kubevirt.io/api/breakglass/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/breakglass"
+)
+
+// withConsoleAccessGrant extends validate to record the accesses to the subresource of the VMI made
+// through a ConsoleAccessGrant, and to close the connection once the grant expires
+func (app *SubresourceAPIApp) withConsoleAccessGrant(request *restful.Request, subresource string, validate validator) validator {
+	return func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if statusErr := validate(vmi); statusErr != nil {
+			return statusErr
+		}
+		return app.recordConsoleAccessGrantAccess(request, subresource, vmi)
+	}
+}
+
+func (app *SubresourceAPIApp) recordConsoleAccessGrantAccess(request *restful.Request, subresource string, vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	subject, ok := request.Attribute(subjectAttribute).(authv1.SubjectAccessReviewSpec)
+	if !ok {
+		return nil
+	}
+
+	grant, err := app.findConsoleAccessGrant(subject.User, subresource, vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to look up the console access grants of the VMI")
+		return errors.NewInternalError(fmt.Errorf("failed to look up the console access grants: %v", err))
+	}
+	if grant == nil {
+		// The access is granted by RBAC alone
+		return nil
+	}
+
+	if err := app.appendConsoleAccessRecord(grant, subresource); err != nil {
+		log.Log.Object(grant).Reason(err).Errorf("Failed to record the access to the %s of the VMI", subresource)
+		return errors.NewInternalError(fmt.Errorf("failed to record the access granted by %s: %v", grant.Name, err))
+	}
+	log.Log.Object(grant).Infof("User %s accessed the %s of VirtualMachineInstance %s/%s", subject.User, subresource, vmi.Namespace, vmi.Name)
+
+	ctx, cancel := context.WithDeadline(request.Request.Context(), grant.Status.ExpiresAt.Time)
+	context.AfterFunc(ctx, cancel)
+	request.Request = request.Request.WithContext(ctx)
+	return nil
+}
+
+func (app *SubresourceAPIApp) findConsoleAccessGrant(user, subresource string, vmi *v1.VirtualMachineInstance) (*breakglassv1alpha1.ConsoleAccessGrant, error) {
+	grants, err := app.virtCli.GeneratedKubeVirtClient().BreakglassV1alpha1().ConsoleAccessGrants(vmi.Namespace).List(context.Background(), k8smetav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for i := range grants.Items {
+		if breakglass.Grants(&grants.Items[i], user, vmi.Name, subresource, now) {
+			return &grants.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (app *SubresourceAPIApp) appendConsoleAccessRecord(grant *breakglassv1alpha1.ConsoleAccessGrant, subresource string) error {
+	client := app.virtCli.GeneratedKubeVirtClient().BreakglassV1alpha1().ConsoleAccessGrants(grant.Namespace)
+	record := breakglassv1alpha1.ConsoleAccessRecord{
+		Subresource: subresource,
+		Timestamp:   k8smetav1.Now(),
+	}
+	current := grant.DeepCopy()
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current.Status.Accesses = append(current.Status.Accesses, record)
+		_, err := client.UpdateStatus(context.Background(), current, k8smetav1.UpdateOptions{})
+		if errors.IsConflict(err) {
+			latest, getErr := client.Get(context.Background(), grant.Name, k8smetav1.GetOptions{})
+			if getErr != nil {
+				return getErr
+			}
+			current = latest
+		}
+		return err
+	})
+}
@@ -0,0 +1,128 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	breakglassv1alpha1 "kubevirt.io/api/breakglass/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+)
+
+var _ = Describe("Console access grants", func() {
+	const (
+		vmiName      = "testvmi"
+		vmiNamespace = "default"
+	)
+
+	var (
+		grantClient *kubevirtfake.Clientset
+		app         *SubresourceAPIApp
+		request     *restful.Request
+		vmi         *v1.VirtualMachineInstance
+	)
+
+	newGrant := func(name, user string, subresources []string, expiresAt time.Time) *breakglassv1alpha1.ConsoleAccessGrant {
+		return &breakglassv1alpha1.ConsoleAccessGrant{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: name, Namespace: vmiNamespace},
+			Spec: breakglassv1alpha1.ConsoleAccessGrantSpec{
+				User:               user,
+				VirtualMachineName: vmiName,
+				Subresources:       subresources,
+			},
+			Status: breakglassv1alpha1.ConsoleAccessGrantStatus{
+				Phase:     breakglassv1alpha1.ConsoleAccessGrantActive,
+				ExpiresAt: &k8smetav1.Time{Time: expiresAt},
+			},
+		}
+	}
+
+	getGrant := func(name string) *breakglassv1alpha1.ConsoleAccessGrant {
+		grant, err := grantClient.BreakglassV1alpha1().ConsoleAccessGrants(vmiNamespace).Get(context.Background(), name, k8smetav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return grant
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		grantClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().GeneratedKubeVirtClient().Return(grantClient).AnyTimes()
+		app = NewSubresourceAPIApp(virtClient, 0, nil, nil)
+
+		request = restful.NewRequest(&http.Request{})
+		request.SetAttribute(subjectAttribute, authv1.SubjectAccessReviewSpec{User: "jdoe"})
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: vmiName, Namespace: vmiNamespace},
+		}
+	})
+
+	It("should allow the access granted by RBAC alone", func() {
+		validate := app.withConsoleAccessGrant(request, "console", func(*v1.VirtualMachineInstance) *errors.StatusError { return nil })
+
+		Expect(validate(vmi)).To(BeNil())
+		_, hasDeadline := request.Request.Context().Deadline()
+		Expect(hasDeadline).To(BeFalse())
+	})
+
+	It("should record the access and close the connection once the grant expires", func() {
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+		grant := newGrant("grant", "jdoe", []string{"console", "vnc"}, expiresAt)
+		_, err := grantClient.BreakglassV1alpha1().ConsoleAccessGrants(vmiNamespace).Create(context.Background(), grant, k8smetav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(app.recordConsoleAccessGrantAccess(request, "vnc", vmi)).To(BeNil())
+
+		accesses := getGrant("grant").Status.Accesses
+		Expect(accesses).To(HaveLen(1))
+		Expect(accesses[0].Subresource).To(Equal("vnc"))
+		Expect(accesses[0].Timestamp.IsZero()).To(BeFalse())
+		deadline, hasDeadline := request.Request.Context().Deadline()
+		Expect(hasDeadline).To(BeTrue())
+		Expect(deadline).To(BeTemporally("==", expiresAt))
+	})
+
+	DescribeTable("should not use a grant", func(grant *breakglassv1alpha1.ConsoleAccessGrant) {
+		_, err := grantClient.BreakglassV1alpha1().ConsoleAccessGrants(vmiNamespace).Create(context.Background(), grant, k8smetav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(app.recordConsoleAccessGrantAccess(request, "console", vmi)).To(BeNil())
+
+		Expect(getGrant(grant.Name).Status.Accesses).To(BeEmpty())
+		_, hasDeadline := request.Request.Context().Deadline()
+		Expect(hasDeadline).To(BeFalse())
+	},
+		Entry("of another user", newGrant("grant", "other", nil, time.Now().Add(time.Hour))),
+		Entry("not granting the subresource", newGrant("grant", "jdoe", []string{"vnc"}, time.Now().Add(time.Hour))),
+		Entry("which expired", newGrant("grant", "jdoe", nil, time.Now().Add(-time.Minute))),
+	)
+})
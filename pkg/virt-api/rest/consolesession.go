@@ -0,0 +1,323 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorilla/websocket"
+	authv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	sharedConsoleParam = "shared"
+	consoleRoleParam   = "role"
+
+	consoleRoleReadWrite = "read-write"
+	consoleRoleReadOnly  = "read-only"
+
+	// consoleWriteTimeout bounds the writes to the participants of a shared console, so that a
+	// participant not reading doesn't block the others
+	consoleWriteTimeout = 10 * time.Second
+)
+
+// consoleSessions holds the serial consoles shared by several users, one session per VMI. A session
+// holds a single connection to the console: the output of the console is sent to all the
+// participants, and the input of the read-write participants to the console.
+type consoleSessions struct {
+	lock     sync.Mutex
+	sessions map[string]*consoleSession
+}
+
+type consoleSession struct {
+	key     string
+	console *websocket.Conn
+	// refs counts the requests holding the session, guarded by the lock of the sessions
+	refs int
+	done chan struct{}
+
+	consoleWriteLock sync.Mutex
+
+	lock         sync.Mutex
+	participants []*consoleParticipant
+}
+
+type consoleParticipant struct {
+	user string
+	role string
+	conn *websocket.Conn
+
+	writeLock sync.Mutex
+}
+
+// acquire returns the session of the console, dialing the console when the console isn't shared yet
+func (c *consoleSessions) acquire(key string, dial func() (*websocket.Conn, *errors.StatusError)) (*consoleSession, *errors.StatusError) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.sessions == nil {
+		c.sessions = map[string]*consoleSession{}
+	}
+	if session, exists := c.sessions[key]; exists {
+		session.refs++
+		return session, nil
+	}
+
+	console, statusErr := dial()
+	if statusErr != nil {
+		return nil, statusErr
+	}
+	session := &consoleSession{
+		key:     key,
+		console: console,
+		refs:    1,
+		done:    make(chan struct{}),
+	}
+	c.sessions[key] = session
+	go c.serve(session)
+	return session, nil
+}
+
+// release closes the session once no request holds it anymore
+func (c *consoleSessions) release(session *consoleSession) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	session.refs--
+	if session.refs == 0 {
+		c.remove(session)
+		session.console.Close()
+	}
+}
+
+func (c *consoleSessions) remove(session *consoleSession) {
+	if c.sessions[session.key] == session {
+		delete(c.sessions, session.key)
+	}
+}
+
+// serve sends the output of the console to the participants until the console is closed
+func (c *consoleSessions) serve(session *consoleSession) {
+	_, err := kvcorev1.CopyFrom(consoleOutput{session}, session.console)
+	if err != nil && err != io.EOF {
+		log.Log.Reason(err).V(3).Infof("The shared console of %s was closed", session.key)
+	}
+
+	c.lock.Lock()
+	c.remove(session)
+	c.lock.Unlock()
+
+	close(session.done)
+	session.lock.Lock()
+	defer session.lock.Unlock()
+	for _, participant := range session.participants {
+		participant.conn.Close()
+	}
+}
+
+// attach adds the participant to the session and tells the other participants
+func (s *consoleSession) attach(participant *consoleParticipant) {
+	s.lock.Lock()
+	s.participants = append(s.participants, participant)
+	presence := s.presence()
+	s.lock.Unlock()
+
+	log.Log.Infof("User %s attached to the shared console of %s as %s", participant.user, s.key, participant.role)
+	s.notify(fmt.Sprintf("%s attached as %s, attached: %s", participant.user, participant.role, presence))
+}
+
+// detach removes the participant from the session and tells the other participants
+func (s *consoleSession) detach(participant *consoleParticipant) {
+	s.lock.Lock()
+	for i := range s.participants {
+		if s.participants[i] == participant {
+			s.participants = append(s.participants[:i], s.participants[i+1:]...)
+			break
+		}
+	}
+	presence := s.presence()
+	s.lock.Unlock()
+
+	log.Log.Infof("User %s detached from the shared console of %s", participant.user, s.key)
+	if presence != "" {
+		s.notify(fmt.Sprintf("%s detached, attached: %s", participant.user, presence))
+	}
+}
+
+// presence lists the participants with their roles, the lock of the session must be held
+func (s *consoleSession) presence() string {
+	var users []string
+	for _, participant := range s.participants {
+		users = append(users, fmt.Sprintf("%s (%s)", participant.user, participant.role))
+	}
+	return strings.Join(users, ", ")
+}
+
+// notify writes a notice to the participants, in the output of the console
+func (s *consoleSession) notify(notice string) {
+	s.broadcast([]byte("\r\n[shared console: " + notice + "]\r\n"))
+}
+
+func (s *consoleSession) broadcast(data []byte) {
+	s.lock.Lock()
+	participants := append([]*consoleParticipant(nil), s.participants...)
+	s.lock.Unlock()
+
+	for _, participant := range participants {
+		if err := participant.write(data); err != nil {
+			log.Log.Reason(err).Warningf("Failed to write the shared console of %s to user %s, detaching it", s.key, participant.user)
+			participant.conn.Close()
+		}
+	}
+}
+
+func (s *consoleSession) write(data []byte) error {
+	s.consoleWriteLock.Lock()
+	defer s.consoleWriteLock.Unlock()
+	return s.console.WriteMessage(websocket.BinaryMessage, data)
+}
+
+func (p *consoleParticipant) write(data []byte) error {
+	p.writeLock.Lock()
+	defer p.writeLock.Unlock()
+	if err := p.conn.SetWriteDeadline(time.Now().Add(consoleWriteTimeout)); err != nil {
+		return err
+	}
+	return p.conn.WriteMessage(websocket.BinaryMessage, data)
+}
+
+// consoleOutput writes the output of the console to the participants
+type consoleOutput struct {
+	session *consoleSession
+}
+
+func (o consoleOutput) Write(data []byte) (int, error) {
+	o.session.broadcast(data)
+	return len(data), nil
+}
+
+// consoleInput writes the input of a read-write participant to the console
+type consoleInput struct {
+	session *consoleSession
+}
+
+func (i consoleInput) Write(data []byte) (int, error) {
+	if err := i.session.write(data); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// sharedConsoleRequestHandler attaches the client to the shared console of the VMI, in the role
+// requested by the client, read-write by default
+func (app *SubresourceAPIApp) sharedConsoleRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.SharedConsoleEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, virtconfig.SharedConsoleGate)), response)
+		return
+	}
+
+	role := request.QueryParameter(consoleRoleParam)
+	switch role {
+	case "":
+		role = consoleRoleReadWrite
+	case consoleRoleReadWrite, consoleRoleReadOnly:
+	default:
+		writeError(errors.NewBadRequest(fmt.Sprintf("invalid console role %q, expected %s or %s", role, consoleRoleReadWrite, consoleRoleReadOnly)), response)
+		return
+	}
+
+	namespace := request.PathParameter(definitions.NamespaceParamName)
+	name := request.PathParameter(definitions.NameParamName)
+	dialer := NewDirectDialer(
+		app.FetchVirtualMachineInstance,
+		app.withConsoleAccessGrant(request, "console", app.withSubresourceAccessReview(request, "console", validateVMIForConsole)),
+		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.ConsoleURI(vmi)
+		}),
+	)
+	// The VMI is validated for every participant, joining an existing session too
+	vmi, statusErr := dialer.fetchAndValidateVMI(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	session, statusErr := app.consoleSessions.acquire(namespace+"/"+name, func() (*websocket.Conn, *errors.StatusError) {
+		return dialer.dial.Dial(vmi)
+	})
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	defer app.consoleSessions.release(session)
+
+	clientConn, err := clientConnectionUpgrade(request, response)
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	participant := &consoleParticipant{
+		user: consoleUser(request),
+		role: role,
+		conn: clientConn,
+	}
+	session.attach(participant)
+	defer session.detach(participant)
+
+	ctx, cancel := context.WithCancel(request.Request.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-session.done:
+		}
+		clientConn.Close()
+	}()
+	go keepAliveClientStream(ctx, clientConn, cancel)
+
+	// The input of the read-only participants is dropped, it is still read for the control messages
+	var input io.Writer = io.Discard
+	if role == consoleRoleReadWrite {
+		input = consoleInput{session}
+	}
+	kvcorev1.CopyFrom(input, clientConn)
+}
+
+func consoleUser(request *restful.Request) string {
+	if subject, ok := request.Attribute(subjectAttribute).(authv1.SubjectAccessReviewSpec); ok && subject.User != "" {
+		return subject.User
+	}
+	return "unknown"
+}
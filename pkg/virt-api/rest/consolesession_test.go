@@ -0,0 +1,160 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+var _ = Describe("Shared console sessions", func() {
+	var (
+		sessions     *consoleSessions
+		server       *httptest.Server
+		serverConns  chan *websocket.Conn
+		console      *websocket.Conn
+		consoleDials int
+	)
+
+	// connect returns both ends of a websocket connection, the server end first
+	connect := func() (*websocket.Conn, *websocket.Conn) {
+		client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() { client.Close() })
+		var serverConn *websocket.Conn
+		Eventually(serverConns).Should(Receive(&serverConn))
+		DeferCleanup(func() { serverConn.Close() })
+		return serverConn, client
+	}
+
+	dial := func() (*websocket.Conn, *errors.StatusError) {
+		consoleDials++
+		var conn *websocket.Conn
+		conn, console = connect()
+		return conn, nil
+	}
+
+	readUntil := func(conn *websocket.Conn, substr string) string {
+		var output string
+		ExpectWithOffset(1, conn.SetReadDeadline(time.Now().Add(5*time.Second))).To(Succeed())
+		for !strings.Contains(output, substr) {
+			_, data, err := conn.ReadMessage()
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			output += string(data)
+		}
+		return output
+	}
+
+	attach := func(session *consoleSession, user, role string) *websocket.Conn {
+		conn, client := connect()
+		session.attach(&consoleParticipant{user: user, role: role, conn: conn})
+		return client
+	}
+
+	BeforeEach(func() {
+		sessions = &consoleSessions{}
+		consoleDials = 0
+		serverConns = make(chan *websocket.Conn, 1)
+		upgrader := websocket.Upgrader{}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err == nil {
+				serverConns <- conn
+			}
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	It("should share the console between the requests and close it on the last release", func() {
+		first, statusErr := sessions.acquire("default/testvmi", dial)
+		Expect(statusErr).ToNot(HaveOccurred())
+		second, statusErr := sessions.acquire("default/testvmi", dial)
+		Expect(statusErr).ToNot(HaveOccurred())
+		Expect(second).To(BeIdenticalTo(first))
+		Expect(consoleDials).To(Equal(1))
+
+		sessions.release(first)
+		Expect(sessions.sessions).To(HaveKey("default/testvmi"))
+		sessions.release(second)
+		Expect(sessions.sessions).To(BeEmpty())
+		Eventually(first.done).Should(BeClosed())
+	})
+
+	It("should send the console output and the presence of the users to all the participants", func() {
+		session, statusErr := sessions.acquire("default/testvmi", dial)
+		Expect(statusErr).ToNot(HaveOccurred())
+		DeferCleanup(sessions.release, session)
+
+		alice := attach(session, "alice", consoleRoleReadWrite)
+		readUntil(alice, "alice attached as read-write, attached: alice (read-write)")
+		bob := attach(session, "bob", consoleRoleReadOnly)
+		readUntil(alice, "bob attached as read-only, attached: alice (read-write), bob (read-only)")
+		readUntil(bob, "bob attached as read-only")
+
+		Expect(console.WriteMessage(websocket.BinaryMessage, []byte("login: "))).To(Succeed())
+		readUntil(alice, "login: ")
+		readUntil(bob, "login: ")
+	})
+
+	It("should write the input to the console", func() {
+		session, statusErr := sessions.acquire("default/testvmi", dial)
+		Expect(statusErr).ToNot(HaveOccurred())
+		DeferCleanup(sessions.release, session)
+
+		_, err := consoleInput{session}.Write([]byte("root\n"))
+		Expect(err).ToNot(HaveOccurred())
+		readUntil(console, "root\n")
+	})
+
+	It("should tell the remaining participants when a user detaches", func() {
+		session, statusErr := sessions.acquire("default/testvmi", dial)
+		Expect(statusErr).ToNot(HaveOccurred())
+		DeferCleanup(sessions.release, session)
+
+		alice := attach(session, "alice", consoleRoleReadWrite)
+		bobConn, _ := connect()
+		bob := &consoleParticipant{user: "bob", role: consoleRoleReadOnly, conn: bobConn}
+		session.attach(bob)
+		session.detach(bob)
+		readUntil(alice, "bob detached, attached: alice (read-write)")
+	})
+
+	It("should close the participants when the console is closed", func() {
+		session, statusErr := sessions.acquire("default/testvmi", dial)
+		Expect(statusErr).ToNot(HaveOccurred())
+		DeferCleanup(sessions.release, session)
+
+		alice := attach(session, "alice", consoleRoleReadWrite)
+		readUntil(alice, "alice attached")
+
+		Expect(console.Close()).To(Succeed())
+		Eventually(session.done).Should(BeClosed())
+		Expect(sessions.sessions).To(BeEmpty())
+		_, _, err := alice.ReadMessage()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -8,7 +8,6 @@ import (
 
 	restful "github.com/emicklei/go-restful/v3"
 	"k8s.io/apimachinery/pkg/api/errors"
-	netutils "k8s.io/utils/net"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -70,10 +69,7 @@ func (n netDial) DialUnderlying(vmi *v1.VirtualMachineInstance) (net.Conn, *erro
 		protocol = protocolParam
 	}
 
-	addr := fmt.Sprintf("%s:%s", targetIP, port)
-	if netutils.IsIPv6String(targetIP) {
-		addr = fmt.Sprintf("[%s]:%s", targetIP, port)
-	}
+	addr := net.JoinHostPort(targetIP, port)
 	conn, err := net.Dial(protocol, addr)
 	if err != nil {
 		logger.Reason(err).Errorf("Can't dial %s %s", protocol, addr)
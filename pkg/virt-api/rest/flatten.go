@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMIFlattenRequestHandler handles the subresource for flattening the backing chains of the volumes of a running VMI.
+func (app *SubresourceAPIApp) VMIFlattenRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.VolumeFlattenEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, virtconfig.VolumeFlattenGate)), response)
+		return
+	}
+
+	opts := &v1.FlattenOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		switch err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts); err {
+		case io.EOF, nil:
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+			return
+		}
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if !vmi.IsRunning() {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		if vmi.Status.MigrationState != nil && !vmi.Status.MigrationState.Completed {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is migrating"))
+		}
+		return verifyFlattenVolumes(vmi, opts.Volumes)
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.FlattenURI(vmi)
+	}
+
+	// The body was consumed, forward the decoded options to virt-handler
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	request.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	dryRun := len(opts.DryRun) > 0 && opts.DryRun[0] == k8smetav1.DryRunAll
+	app.putRequestHandler(request, response, validate, getURL, dryRun)
+}
+
+func verifyFlattenVolumes(vmi *v1.VirtualMachineInstance, volumes []string) *errors.StatusError {
+	specVolumes := map[string]bool{}
+	for _, volume := range vmi.Spec.Volumes {
+		specVolumes[volume.Name] = true
+	}
+	for _, volume := range volumes {
+		if !specVolumes[volume] {
+			return errors.NewBadRequest(fmt.Sprintf("volume %s does not exist in VMI %s", volume, vmi.Name))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	clientutil "kubevirt.io/client-go/util"
+
+	"kubevirt.io/kubevirt/pkg/identitydocument"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VerifyIdentityRequestHandler handles the subresource verifying an identity document presented on behalf
+// of a VMI, and returns the identity it attests
+func (app *SubresourceAPIApp) VerifyIdentityRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.VMIdentityDocumentsEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, virtconfig.VMIdentityDocumentsGate)), response)
+		return
+	}
+
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	signed := &v1.SignedIdentityDocument{}
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("request body is empty"), response)
+		return
+	}
+	defer request.Request.Body.Close()
+	switch err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(signed); err {
+	case io.EOF:
+		writeError(errors.NewBadRequest("request body is empty"), response)
+		return
+	case nil:
+	default:
+		writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+		return
+	}
+
+	key, statusErr := app.getIdentityDocumentKey()
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	identity, err := identitydocument.Verify(key, signed, time.Now())
+	if err != nil {
+		writeError(errors.NewBadRequest(fmt.Sprintf("invalid identity document: %v", err)), response)
+		return
+	}
+	if identity.Namespace != namespace || identity.Name != name {
+		writeError(errors.NewBadRequest(fmt.Sprintf("the identity document was issued to VirtualMachineInstance %s/%s", identity.Namespace, identity.Name)), response)
+		return
+	}
+
+	// The document stays valid until it expires, make sure the VMI it was issued to still runs
+	vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if vmi.UID != identity.UID || vmi.IsFinal() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf("the VirtualMachineInstance the identity document was issued to does not run anymore")), response)
+		return
+	}
+
+	response.WriteEntity(identity)
+}
+
+// getIdentityDocumentKey returns the key the identity documents are signed with, which virt-controller generates
+func (app *SubresourceAPIApp) getIdentityDocumentKey() ([]byte, *errors.StatusError) {
+	app.identityKeyLock.Lock()
+	defer app.identityKeyLock.Unlock()
+
+	if app.identityKey != nil {
+		return app.identityKey, nil
+	}
+
+	namespace, err := clientutil.GetNamespace()
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	secret, err := app.virtCli.CoreV1().Secrets(namespace).Get(context.Background(), identitydocument.KeySecretName, k8smetav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, errors.NewBadRequest("invalid identity document: no identity document was issued yet")
+	}
+	if err != nil {
+		return nil, errors.NewInternalError(fmt.Errorf("unable to retrieve the identity document signing key: %v", err))
+	}
+
+	key := secret.Data[identitydocument.KeySecretKey]
+	if len(key) == 0 {
+		return nil, errors.NewInternalError(fmt.Errorf("secret %s/%s holds no signing key", namespace, identitydocument.KeySecretName))
+	}
+	app.identityKey = key
+	return key, nil
+}
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// RenewVMLeaseRequestHandler handles the subresource for renewing the lease of a VM
+func (app *SubresourceAPIApp) RenewVMLeaseRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.VMLeaseEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, virtconfig.VMLeaseGate)), response)
+		return
+	}
+
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	opts := &v1.RenewLeaseOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		switch err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts); err {
+		case io.EOF, nil:
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+			return
+		}
+	}
+	if opts.Duration.Duration <= 0 {
+		writeError(errors.NewBadRequest("duration has to be greater than zero"), response)
+		return
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if vm.Spec.Lease == nil {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("VM has no lease")), response)
+		return
+	}
+
+	expiresAt := k8smetav1.NewTime(time.Now().Add(opts.Duration.Duration)).Rfc3339Copy()
+	patchBytes, err := patch.New(
+		patch.WithTest("/spec/lease/expiresAt", vm.Spec.Lease.ExpiresAt),
+		patch.WithReplace("/spec/lease/expiresAt", expiresAt),
+	).GeneratePayload()
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
+	_, err = app.virtCli.VirtualMachine(namespace).Patch(context.Background(), name, types.JSONPatchType, patchBytes, k8smetav1.PatchOptions{DryRun: opts.DryRun})
+	if err != nil {
+		if strings.Contains(err.Error(), jsonpatchTestErr) {
+			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, err), response)
+		} else {
+			writeError(errors.NewInternalError(err), response)
+		}
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,152 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	macvtapBindingName = "macvtap"
+	passtBindingName   = "passt"
+)
+
+// ModernizeVMRequestHandler handles the subresource rewriting the deprecated API usages of a VM
+func (app *SubresourceAPIApp) ModernizeVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	opts := &v1.ModernizeOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		switch err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts); err {
+		case io.EOF, nil:
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+			return
+		}
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	modernized := vm.DeepCopy()
+	changes, unsupported := modernizeVirtualMachine(modernized, app.clusterConfig)
+	if len(changes) > 0 {
+		// The update carries the resourceVersion of the fetched VM, a VM changed meanwhile is a conflict
+		updated, err := app.virtCli.VirtualMachine(namespace).Update(context.Background(), modernized, k8smetav1.UpdateOptions{DryRun: opts.DryRun})
+		if err != nil {
+			if statErr, ok := err.(*errors.StatusError); ok && (errors.IsConflict(err) || errors.IsInvalid(err)) {
+				writeError(statErr, response)
+			} else {
+				writeError(errors.NewInternalError(err), response)
+			}
+			return
+		}
+		modernized = updated
+	}
+
+	response.WriteEntity(&v1.VirtualMachineModernization{
+		Changes:        changes,
+		Unsupported:    unsupported,
+		VirtualMachine: modernized,
+	})
+}
+
+// modernizeVirtualMachine rewrites the deprecated API usages of vm in place into their current
+// equivalents, and reports the usages having none in the cluster as unsupported
+func modernizeVirtualMachine(vm *v1.VirtualMachine, clusterConfig *virtconfig.ClusterConfig) (changes, unsupported []v1.ModernizeChange) {
+	if vm.Spec.Running != nil {
+		runStrategy := v1.RunStrategyHalted
+		if *vm.Spec.Running {
+			runStrategy = v1.RunStrategyAlways
+		}
+		vm.Spec.Running = nil
+		vm.Spec.RunStrategy = &runStrategy
+		changes = append(changes, v1.ModernizeChange{
+			Path:    "spec.running",
+			Message: fmt.Sprintf("replaced by runStrategy %s", runStrategy),
+		})
+	}
+
+	if vm.Spec.Template == nil {
+		return changes, unsupported
+	}
+
+	if _, exists := vm.Spec.Template.ObjectMeta.Annotations[v1.DeprecatedNonRootVMIAnnotation]; exists {
+		delete(vm.Spec.Template.ObjectMeta.Annotations, v1.DeprecatedNonRootVMIAnnotation)
+		changes = append(changes, v1.ModernizeChange{
+			Path:    fmt.Sprintf("spec.template.metadata.annotations[%s]", v1.DeprecatedNonRootVMIAnnotation),
+			Message: "removed, VMIs always run as non-root",
+		})
+	}
+
+	bindings := clusterConfig.GetNetworkBindings()
+	for i := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		iface := &vm.Spec.Template.Spec.Domain.Devices.Interfaces[i]
+		path := fmt.Sprintf("spec.template.spec.domain.devices.interfaces[%d]", i)
+		switch {
+		case iface.DeprecatedMacvtap != nil:
+			if change, ok := modernizeBinding(iface, macvtapBindingName, bindings); ok {
+				changes = append(changes, v1.ModernizeChange{Path: path + ".macvtap", Message: change})
+			} else {
+				unsupported = append(unsupported, v1.ModernizeChange{Path: path + ".macvtap", Message: change})
+			}
+		case iface.DeprecatedPasst != nil:
+			if change, ok := modernizeBinding(iface, passtBindingName, bindings); ok {
+				changes = append(changes, v1.ModernizeChange{Path: path + ".passt", Message: change})
+			} else {
+				unsupported = append(unsupported, v1.ModernizeChange{Path: path + ".passt", Message: change})
+			}
+		case iface.DeprecatedSlirp != nil:
+			unsupported = append(unsupported, v1.ModernizeChange{
+				Path:    path + ".slirp",
+				Message: "slirp has no equivalent, use masquerade or a network binding plugin",
+			})
+		}
+	}
+
+	return changes, unsupported
+}
+
+// modernizeBinding replaces the deprecated binding method of iface by the network binding plugin
+// named name, provided the plugin is registered in the cluster
+func modernizeBinding(iface *v1.Interface, name string, bindings map[string]v1.InterfaceBindingPlugin) (string, bool) {
+	if _, exists := bindings[name]; !exists {
+		return fmt.Sprintf("the %s network binding plugin is not registered in the cluster", name), false
+	}
+	iface.InterfaceBindingMethod = v1.InterfaceBindingMethod{}
+	iface.Binding = &v1.PluginBinding{Name: name}
+	return fmt.Sprintf("replaced by the %s network binding plugin", name), true
+}
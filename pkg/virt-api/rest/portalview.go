@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"encoding/json"
+
+	"github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// VMPortalView handles the subresource providing a sanitized view of a VirtualMachine and its VirtualMachineInstance
+func (app *SubresourceAPIApp) VMPortalView(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	view := &v1.VirtualMachinePortalView{
+		Name:            vm.Name,
+		Namespace:       vm.Namespace,
+		PrintableStatus: vm.Status.PrintableStatus,
+	}
+	if vm.Status.Created {
+		vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+		if statusErr != nil && !errors.IsNotFound(statusErr) {
+			writeError(statusErr, response)
+			return
+		}
+		if vmi != nil {
+			app.addVMIToPortalView(view, vmi)
+		}
+	}
+
+	response.WriteEntity(view)
+}
+
+// VMIPortalView handles the subresource providing a sanitized view of a VirtualMachineInstance
+func (app *SubresourceAPIApp) VMIPortalView(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	view := &v1.VirtualMachinePortalView{
+		Name:      vmi.Name,
+		Namespace: vmi.Namespace,
+	}
+	app.addVMIToPortalView(view, vmi)
+
+	response.WriteEntity(view)
+}
+
+func (app *SubresourceAPIApp) addVMIToPortalView(view *v1.VirtualMachinePortalView, vmi *v1.VirtualMachineInstance) {
+	view.Phase = vmi.Status.Phase
+	view.Ready = controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, v1.VirtualMachineInstanceReady, k8sv1.ConditionTrue)
+
+	for _, condition := range vmi.Status.Conditions {
+		view.Conditions = append(view.Conditions, v1.PortalViewCondition{
+			Type:               condition.Type,
+			Status:             condition.Status,
+			Reason:             condition.Reason,
+			LastTransitionTime: condition.LastTransitionTime,
+		})
+	}
+	for _, iface := range vmi.Status.Interfaces {
+		view.Interfaces = append(view.Interfaces, v1.PortalViewInterface{
+			Name:        iface.Name,
+			MAC:         iface.MAC,
+			IPAddresses: iface.IPs,
+		})
+	}
+	if vmi.Status.GuestOSInfo.Name != "" {
+		view.GuestOSInfo = vmi.Status.GuestOSInfo.DeepCopy()
+	}
+
+	if vmi.IsRunning() {
+		// The metrics are a best effort, the rest of the view is still useful without them
+		metrics, err := app.fetchMetricsSnapshot(vmi)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warning("Failed to retrieve the metrics snapshot")
+		}
+		view.Metrics = metrics
+	}
+}
+
+func (app *SubresourceAPIApp) fetchMetricsSnapshot(vmi *v1.VirtualMachineInstance) (*v1.VirtualMachineInstanceMetricsSnapshot, error) {
+	url, conn, statusErr := app.getVirtHandlerFor(vmi, func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.MetricsSnapshotURI(vmi)
+	})
+	if statusErr != nil {
+		return nil, statusErr
+	}
+
+	resp, err := conn.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := &v1.VirtualMachineInstanceMetricsSnapshot{}
+	if err := json.Unmarshal([]byte(resp), metrics); err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
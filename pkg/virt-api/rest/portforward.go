@@ -21,7 +21,7 @@ func (app *SubresourceAPIApp) PortForwardRequestHandler(fetcher vmiFetcher) rest
 
 		streamer := NewWebsocketStreamer(
 			fetcher,
-			validateVMIForPortForward,
+			app.withSubresourceAccessReview(request, "portforward", validateVMIForPortForward),
 			netDial{request: request},
 		)
 
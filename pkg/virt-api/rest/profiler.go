@@ -39,6 +39,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 	clientutil "kubevirt.io/client-go/util"
+
+	netip "kubevirt.io/kubevirt/pkg/util/net/ip"
 )
 
 const (
@@ -178,7 +180,7 @@ func (app *SubresourceAPIApp) stopStartHandler(command string, request *restful.
 			log.Log.Infof("Executing Cluster Profiler %s on Pod %s", command, name)
 			go func(ip string, name string) {
 				defer wg.Done()
-				url := fmt.Sprintf("https://%s:%d/%s-profiler", ip, app.profilerComponentPort, command)
+				url := fmt.Sprintf("https://%s:%d/%s-profiler", netip.NormalizeIPAddress(ip), app.profilerComponentPort, command)
 				req, _ := http.NewRequest("GET", url, nil)
 				resp, err := client.Do(req)
 				if err != nil {
@@ -279,7 +281,7 @@ func (app *SubresourceAPIApp) DumpClusterProfilerHandler(request *restful.Reques
 		log.Log.Infof("Executing Cluster Profiler %s on Pod %s", command, name)
 		go func(ip string, name string) {
 			defer wg.Done()
-			url := fmt.Sprintf("https://%s:%d/%s-profiler", ip, app.profilerComponentPort, command)
+			url := fmt.Sprintf("https://%s:%d/%s-profiler", netip.NormalizeIPAddress(ip), app.profilerComponentPort, command)
 			req, _ := http.NewRequest("GET", url, nil)
 			resp, err := client.Do(req)
 			if err != nil {
@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/vmsearch"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// searchSyncTimeout bounds the wait of a search for the VMI index, which is only built on the
+// first search
+const searchSyncTimeout = 30 * time.Second
+
+// SearchRequestHandler handles the subresource searching the VMIs, of a namespace or of the cluster,
+// by label, guest OS, IP and MAC address
+func (app *SubresourceAPIApp) SearchRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.VMSearchEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, virtconfig.VMSearchGate)), response)
+		return
+	}
+
+	query, err := parseSearchQuery(request)
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(request.Request.Context(), searchSyncTimeout)
+	defer cancel()
+	vmis, err := app.searchIndex().Search(ctx, query)
+	if err == vmsearch.ErrNotSynced {
+		writeError(errors.NewServiceUnavailable(err.Error()), response)
+		return
+	}
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	list := &v1.VirtualMachineInstanceList{}
+	list.SetGroupVersionKind(v1.VirtualMachineInstanceGroupVersionKind.GroupVersion().WithKind("VirtualMachineInstanceList"))
+	for _, vmi := range vmis {
+		list.Items = append(list.Items, *vmi)
+	}
+	response.WriteEntity(list)
+}
+
+func parseSearchQuery(request *restful.Request) (*vmsearch.Query, error) {
+	query := &vmsearch.Query{
+		Namespace: request.PathParameter("namespace"),
+		GuestOS:   request.QueryParameter("guestOS"),
+	}
+	if selector := request.QueryParameter("labelSelector"); selector != "" {
+		parsed, err := labels.Parse(selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector %q: %v", selector, err)
+		}
+		query.Selector = parsed
+	}
+	for _, address := range request.QueryParameters("ip") {
+		ip, err := vmsearch.CanonicalIP(address)
+		if err != nil {
+			return nil, err
+		}
+		query.IPs = append(query.IPs, ip)
+	}
+	for _, address := range request.QueryParameters("mac") {
+		mac, err := vmsearch.CanonicalMAC(address)
+		if err != nil {
+			return nil, err
+		}
+		query.MACs = append(query.MACs, mac)
+	}
+	return query, nil
+}
+
+// searchIndex returns the VMI index, created on the first search
+func (app *SubresourceAPIApp) searchIndex() *vmsearch.Index {
+	app.searchIndexLock.Lock()
+	defer app.searchIndexLock.Unlock()
+
+	if app.vmSearchIndex == nil {
+		lw := cache.NewListWatchFromClient(app.virtCli.RestClient(), "virtualmachineinstances", k8sv1.NamespaceAll, fields.Everything())
+		app.vmSearchIndex = vmsearch.NewIndex(lw, wait.NeverStop)
+	}
+	return app.vmSearchIndex
+}
@@ -29,6 +29,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -49,21 +50,27 @@ import (
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
 	"kubevirt.io/client-go/api"
 	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/identitydocument"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/vmsearch"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -155,7 +162,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Timeout: 10 * time.Second,
 		}
 
-		request = restful.NewRequest(&http.Request{})
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
 		recorder = httptest.NewRecorder()
 		response = restful.NewResponse(recorder)
 		// Make sure that any unexpected call to the client will fail
@@ -432,6 +439,41 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 				ExpectStatusErrorWithCode(recorder, http.StatusConflict)
 			})
 
+			Context("shared", func() {
+				BeforeEach(func() {
+					request.PathParameters()["name"] = testVMIName
+					request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+					request.Request.URL.RawQuery = "shared=true"
+				})
+
+				It("should fail if the SharedConsole feature gate is disabled", func() {
+					disableFeatureGates()
+
+					app.ConsoleRequestHandler(request, response)
+					statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+					Expect(statusErr.Error()).To(ContainSubstring(virtconfig.SharedConsoleGate))
+				})
+
+				It("should fail with an invalid role", func() {
+					enableFeatureGate(virtconfig.SharedConsoleGate)
+					request.Request.URL.RawQuery = "shared=true&role=owner"
+
+					app.ConsoleRequestHandler(request, response)
+					statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+					Expect(statusErr.Error()).To(ContainSubstring("invalid console role"))
+				})
+
+				It("should validate the VMI before attaching", func() {
+					enableFeatureGate(virtconfig.SharedConsoleGate)
+					request.Request.URL.RawQuery = "shared=true&role=read-only"
+
+					expectVMI(NotRunning, UnPaused)
+
+					app.ConsoleRequestHandler(request, response)
+					ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+				})
+			})
+
 		})
 
 		Context("restart", func() {
@@ -728,6 +770,69 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		)
 	})
 
+	Context("Add Channel Subresource api", func() {
+
+		newAddChannelBody := func(opts *v1.AddChannelOptions) io.ReadCloser {
+			optsJson, _ := json.Marshal(opts)
+			return &readCloserWrapper{bytes.NewReader(optsJson)}
+		}
+
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = testVMIName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+
+			vmi = api.NewMinimalVMI(testVMIName)
+			vmi.Namespace = k8smetav1.NamespaceDefault
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Domain.Devices.Channels = []v1.Channel{{Name: "existing", Target: "org.example.existing.0"}}
+		})
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		It("should patch the VMI with the new channel", func() {
+			enableFeatureGate(virtconfig.ChannelHotplugGate)
+			opts := &v1.AddChannelOptions{Name: "agent", Target: "org.example.agent.0", DryRun: []string{k8smetav1.DryRunAll}}
+			request.Request.Body = newAddChannelBody(opts)
+
+			vmiClient.EXPECT().Get(context.Background(), vmi.Name, k8smetav1.GetOptions{}).Return(vmi, nil)
+			vmiClient.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, _ types.PatchType, body []byte, patchOpts k8smetav1.PatchOptions, _ ...string) (*v1.VirtualMachineInstance, error) {
+					Expect(patchOpts.DryRun).To(Equal(opts.DryRun))
+					Expect(string(body)).To(Equal(`[{"op":"test","path":"/spec/domain/devices/channels","value":[{"name":"existing","target":"org.example.existing.0"}]},` +
+						`{"op":"replace","path":"/spec/domain/devices/channels","value":[{"name":"existing","target":"org.example.existing.0"},{"name":"agent","target":"org.example.agent.0"}]}]`))
+					return vmi, nil
+				})
+
+			app.VMIAddChannelRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		DescribeTable("should reject the request", func(opts *v1.AddChannelOptions, running, enableGate bool, code int) {
+			if enableGate {
+				enableFeatureGate(virtconfig.ChannelHotplugGate)
+			}
+			if !running {
+				vmi.Status.Phase = v1.Scheduled
+			}
+			request.Request.Body = newAddChannelBody(opts)
+			vmiClient.EXPECT().Get(context.Background(), vmi.Name, k8smetav1.GetOptions{}).Return(vmi, nil).AnyTimes()
+
+			app.VMIAddChannelRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(code))
+		},
+			Entry("without the feature gate", &v1.AddChannelOptions{Name: "agent", Target: "org.example.agent.0"}, true, false, http.StatusBadRequest),
+			Entry("without a name", &v1.AddChannelOptions{Target: "org.example.agent.0"}, true, true, http.StatusBadRequest),
+			Entry("without a target", &v1.AddChannelOptions{Name: "agent"}, true, true, http.StatusBadRequest),
+			Entry("when the VMI is not running", &v1.AddChannelOptions{Name: "agent", Target: "org.example.agent.0"}, false, true, http.StatusConflict),
+			Entry("when the name is in use", &v1.AddChannelOptions{Name: "existing", Target: "org.example.agent.0"}, true, true, http.StatusConflict),
+			Entry("when the target is in use", &v1.AddChannelOptions{Name: "agent", Target: "org.example.existing.0"}, true, true, http.StatusConflict),
+		)
+	})
+
 	Context("Add/Remove Volume Subresource api", func() {
 
 		newAddVolumeBody := func(opts *v1.AddVolumeOptions) io.ReadCloser {
@@ -1947,6 +2052,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for Filesystem", app.FilesystemList),
+			Entry("for DeviceList", app.DeviceList),
 		)
 
 		DescribeTable("should fail when the VMI is not running", func(fn subRes) {
@@ -1966,6 +2072,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for FilesystemList", app.FilesystemList),
+			Entry("for DeviceList", app.DeviceList),
 		)
 
 		DescribeTable("should fail when VMI does not have agent connected", func(fn subRes) {
@@ -1993,6 +2100,388 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		)
 	})
 
+	Context("Subresource api - devices", func() {
+		It("should return the devices attached to the domain without the guest agent", func() {
+			devices := v1.VirtualMachineInstanceDeviceList{
+				Disks: []v1.AttachedDisk{{Name: "rootdisk", Target: "vda", Bus: v1.DiskBusVirtio, Address: "0000:07:00.0"}},
+			}
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/devices"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, devices),
+				),
+			)
+			response.SetRequestAccepts(restful.MIME_JSON)
+			expectVMI(Running, UnPaused)
+
+			app.DeviceList(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			result := v1.VirtualMachineInstanceDeviceList{}
+			Expect(json.NewDecoder(recorder.Body).Decode(&result)).To(Succeed())
+			Expect(result).To(Equal(devices))
+		})
+	})
+
+	Context("Subresource api - portal view", func() {
+		withInfraDetails := func(vmi *v1.VirtualMachineInstance) {
+			vmi.Status.NodeName = "node01"
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+				Type:    v1.VirtualMachineInstanceReady,
+				Status:  k8sv1.ConditionTrue,
+				Message: "running on node01",
+			})
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{
+				Name:             "default",
+				MAC:              "de:ad:00:00:be:af",
+				IPs:              []string{"10.10.10.10"},
+				InterfaceName:    "eth0",
+				PodInterfaceName: "pod7e0055a6880",
+			}}
+			vmi.Status.GuestOSInfo = v1.VirtualMachineInstanceGuestOSInfo{Name: "Fedora Linux"}
+		}
+
+		BeforeEach(func() {
+			response.SetRequestAccepts(restful.MIME_JSON)
+		})
+
+		decodeView := func() *v1.VirtualMachinePortalView {
+			view := &v1.VirtualMachinePortalView{}
+			Expect(json.NewDecoder(recorder.Body).Decode(view)).To(Succeed())
+			return view
+		}
+
+		It("should return a sanitized view of a running VMI with its metrics", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/metricssnapshot"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, v1.VirtualMachineInstanceMetricsSnapshot{CPUTimeNanoseconds: 42}),
+				),
+			)
+			expectVMI(Running, UnPaused, withInfraDetails)
+
+			app.VMIPortalView(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).ToNot(ContainSubstring("node01"))
+			Expect(recorder.Body.String()).ToNot(ContainSubstring("pod7e0055a6880"))
+			view := decodeView()
+			Expect(view.Name).To(Equal(testVMIName))
+			Expect(view.Phase).To(Equal(v1.Running))
+			Expect(view.Ready).To(BeTrue())
+			Expect(view.Conditions).To(ConsistOf(v1.PortalViewCondition{Type: v1.VirtualMachineInstanceReady, Status: k8sv1.ConditionTrue}))
+			Expect(view.Interfaces).To(ConsistOf(v1.PortalViewInterface{Name: "default", MAC: "de:ad:00:00:be:af", IPAddresses: []string{"10.10.10.10"}}))
+			Expect(view.GuestOSInfo.Name).To(Equal("Fedora Linux"))
+			Expect(view.Metrics.CPUTimeNanoseconds).To(BeEquivalentTo(42))
+		})
+
+		It("should return the view without metrics when virt-handler fails to provide them", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/metricssnapshot"),
+					ghttp.RespondWith(http.StatusInternalServerError, ""),
+				),
+			)
+			expectVMI(Running, UnPaused)
+
+			app.VMIPortalView(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(decodeView().Metrics).To(BeNil())
+		})
+
+		It("should return the printable status of a stopped VM", func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+
+			vm := newMinimalVM(testVMName)
+			vm.Namespace = k8smetav1.NamespaceDefault
+			vm.Status.PrintableStatus = v1.VirtualMachineStatusStopped
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(vm, nil)
+
+			app.VMPortalView(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			view := decodeView()
+			Expect(view.PrintableStatus).To(Equal(v1.VirtualMachineStatusStopped))
+			Expect(view.Phase).To(BeEmpty())
+		})
+
+		It("should fail when the VM does not exist", func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachine"), testVMName))
+
+			app.VMPortalView(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusNotFound)
+		})
+	})
+
+	Context("Subresource api - capabilities", func() {
+		var cdiClient *cdifake.Clientset
+		var nodes []k8sv1.Node
+
+		newNode := func(name, arch string, labels map[string]string, allocatable k8sv1.ResourceList) *k8sv1.Node {
+			node := &k8sv1.Node{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{v1.NodeSchedulable: "true", k8sv1.LabelArchStable: arch},
+				},
+				Status: k8sv1.NodeStatus{Allocatable: allocatable},
+			}
+			for key, value := range labels {
+				node.Labels[key] = value
+			}
+			return node
+		}
+
+		decodeCapabilities := func() *v1.ClusterCapabilities {
+			capabilities := &v1.ClusterCapabilities{}
+			Expect(json.NewDecoder(recorder.Body).Decode(capabilities)).To(Succeed())
+			return capabilities
+		}
+
+		BeforeEach(func() {
+			response.SetRequestAccepts(restful.MIME_JSON)
+			cdiClient = cdifake.NewSimpleClientset()
+			virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+			nodes = nil
+			kubeClient.Fake.PrependReactor("list", "nodes", func(action testing.Action) (bool, runtime.Object, error) {
+				Expect(action.(testing.ListAction).GetListRestrictions().Labels.String()).To(Equal(v1.NodeSchedulable + "=true"))
+				return true, &k8sv1.NodeList{Items: nodes}, nil
+			})
+
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{"Snapshot", "HostDevices", "Snapshot"}
+			kvConfig.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{"passt": {}, "macvtap": {}},
+			}
+			kvConfig.Spec.Configuration.PermittedHostDevices = &v1.PermittedHostDevices{
+				PciHostDevices: []v1.PciHostDevice{
+					{PCIVendorSelector: "10DE:1EB8", ResourceName: "nvidia.com/TU104GL_Tesla_T4"},
+				},
+				MediatedDevices: []v1.MediatedHostDevice{
+					{MDEVNameSelector: "GRID T4-1Q", ResourceName: "nvidia.com/GRID_T4-1Q"},
+				},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			DeferCleanup(testutils.UpdateFakeKubeVirtClusterConfig, kvStore, kv)
+		})
+
+		It("should summarize the capabilities of the schedulable nodes and the configuration", func() {
+			gpu := k8sv1.ResourceList{"nvidia.com/TU104GL_Tesla_T4": resource.MustParse("2")}
+			nodes = []k8sv1.Node{
+				*newNode("node01", "amd64", map[string]string{
+					v1.CPUModelLabel + "Skylake-Client-IBRS": "true",
+					v1.CPUModelLabel + "Haswell-noTSX":       "true",
+				}, gpu),
+				*newNode("node02", "amd64", map[string]string{
+					v1.CPUModelLabel + "Haswell-noTSX": "true",
+				}, gpu),
+			}
+
+			block := k8sv1.PersistentVolumeBlock
+			_, err := cdiClient.CdiV1beta1().StorageProfiles().Create(context.Background(), &cdiv1.StorageProfile{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: "ceph-rbd"},
+				Status: cdiv1.StorageProfileStatus{
+					StorageClass: pointer.P("ceph-rbd"),
+					ClaimPropertySets: []cdiv1.ClaimPropertySet{
+						{AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany}, VolumeMode: &block},
+						{AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce}, VolumeMode: &block},
+					},
+				},
+			}, k8smetav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			app.ClusterCapabilitiesHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			capabilities := decodeCapabilities()
+			Expect(capabilities.FeatureGates).To(Equal([]string{"HostDevices", "Snapshot"}))
+			Expect(capabilities.NetworkBindings).To(Equal([]string{"macvtap", "passt"}))
+			Expect(capabilities.Architectures).To(ConsistOf(v1.ArchitectureCapabilities{
+				Name:               "amd64",
+				Nodes:              2,
+				DefaultMachineType: virtconfig.DefaultAMD64MachineType,
+				MachineTypes:       strings.Split(virtconfig.DefaultAMD64EmulatedMachines, ","),
+				CPUModels:          []string{"Haswell-noTSX", "Skylake-Client-IBRS"},
+			}))
+			Expect(capabilities.HostDevices).To(ConsistOf(
+				v1.HostDeviceCapability{ResourceName: "nvidia.com/TU104GL_Tesla_T4", Type: v1.HostDeviceCapabilityPCI, Allocatable: 4},
+				v1.HostDeviceCapability{ResourceName: "nvidia.com/GRID_T4-1Q", Type: v1.HostDeviceCapabilityMediated},
+			))
+			Expect(capabilities.StorageProfiles).To(ConsistOf(v1.StorageProfileCapability{
+				StorageClass: "ceph-rbd",
+				AccessModes:  []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany, k8sv1.ReadWriteOnce},
+				VolumeModes:  []k8sv1.PersistentVolumeMode{k8sv1.PersistentVolumeBlock},
+			}))
+		})
+
+		It("should leave out the storage profiles when CDI is not installed", func() {
+			cdiClient.Fake.PrependReactor("list", "storageprofiles", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.NewNotFound(cdiv1.Resource("storageprofiles"), "")
+			})
+
+			app.ClusterCapabilitiesHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(decodeCapabilities().StorageProfiles).To(BeEmpty())
+		})
+
+		It("should fail when the nodes can't be listed", func() {
+			kubeClient.Fake.PrependReactor("list", "nodes", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, nil, fmt.Errorf("connection refused")
+			})
+
+			app.ClusterCapabilitiesHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusInternalServerError)
+		})
+	})
+
+	Context("Subresource api - search", func() {
+		newVMI := func(namespace, name, ip string) v1.VirtualMachineInstance {
+			vmi := api.NewMinimalVMIWithNS(namespace, name)
+			vmi.Status.GuestOSInfo.ID = "mswindows"
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{IP: ip}}
+			return *vmi
+		}
+
+		decodeVMIs := func() []string {
+			list := &v1.VirtualMachineInstanceList{}
+			Expect(json.NewDecoder(recorder.Body).Decode(list)).To(Succeed())
+			Expect(list.Kind).To(Equal("VirtualMachineInstanceList"))
+			var names []string
+			for _, vmi := range list.Items {
+				names = append(names, vmi.Namespace+"/"+vmi.Name)
+			}
+			return names
+		}
+
+		BeforeEach(func() {
+			response.SetRequestAccepts(restful.MIME_JSON)
+			request.Request.URL = &url.URL{}
+			vmis := []v1.VirtualMachineInstance{
+				newVMI(k8smetav1.NamespaceDefault, "web", "10.2.3.4"),
+				newVMI("other", "db", "10.2.3.5"),
+			}
+			stopCh := make(chan struct{})
+			DeferCleanup(func() { close(stopCh) })
+			app.vmSearchIndex = vmsearch.NewIndex(&cache.ListWatch{
+				ListFunc: func(k8smetav1.ListOptions) (runtime.Object, error) {
+					return &v1.VirtualMachineInstanceList{Items: vmis}, nil
+				},
+				WatchFunc: func(k8smetav1.ListOptions) (watch.Interface, error) {
+					return watch.NewFake(), nil
+				},
+			}, stopCh)
+		})
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		It("should search the VMIs of the cluster", func() {
+			enableFeatureGate(virtconfig.VMSearchGate)
+			request.Request.URL.RawQuery = "guestOS=MSWindows&ip=10.2.3.4&ip=10.2.3.5"
+
+			app.SearchRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(decodeVMIs()).To(Equal([]string{"default/web", "other/db"}))
+		})
+
+		It("should search the VMIs of a namespace", func() {
+			enableFeatureGate(virtconfig.VMSearchGate)
+			request.PathParameters()["namespace"] = "other"
+
+			app.SearchRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(decodeVMIs()).To(Equal([]string{"other/db"}))
+		})
+
+		DescribeTable("should reject an invalid query", func(query string) {
+			enableFeatureGate(virtconfig.VMSearchGate)
+			request.Request.URL.RawQuery = query
+
+			app.SearchRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			Entry("with an invalid label selector", "labelSelector=app%20in%20("),
+			Entry("with an invalid IP", "ip=10.2.3"),
+			Entry("with an invalid MAC", "mac=02:00"),
+		)
+
+		It("should fail when the feature gate is disabled", func() {
+			app.SearchRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+	})
+
+	Context("Subresource api - timeline", func() {
+		var timelineClient *kubevirtfake.Clientset
+
+		BeforeEach(func() {
+			response.SetRequestAccepts(restful.MIME_JSON)
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+
+			timelineClient = kubevirtfake.NewSimpleClientset()
+			virtClient.EXPECT().GeneratedKubeVirtClient().Return(timelineClient).AnyTimes()
+		})
+
+		decodeTimeline := func() *timelinev1alpha1.VirtualMachineTimeline {
+			timeline := &timelinev1alpha1.VirtualMachineTimeline{}
+			Expect(json.NewDecoder(recorder.Body).Decode(timeline)).To(Succeed())
+			return timeline
+		}
+
+		It("should return the timeline of the VM", func() {
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(newMinimalVM(testVMName), nil)
+			_, err := timelineClient.TimelineV1alpha1().VirtualMachineTimelines(k8smetav1.NamespaceDefault).Create(context.Background(), &timelinev1alpha1.VirtualMachineTimeline{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: testVMName, Namespace: k8smetav1.NamespaceDefault},
+				Entries: []timelinev1alpha1.VirtualMachineTimelineEntry{{
+					Type:   timelinev1alpha1.VirtualMachineTimelineEntryPhase,
+					Reason: string(v1.Running),
+					Count:  1,
+				}},
+			}, k8smetav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			app.VMTimeline(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			timeline := decodeTimeline()
+			Expect(timeline.Kind).To(Equal(timelinev1alpha1.VirtualMachineTimelineKind.Kind))
+			Expect(timeline.Entries).To(HaveLen(1))
+			Expect(timeline.Entries[0].Reason).To(Equal(string(v1.Running)))
+		})
+
+		It("should return an empty timeline when the VM has none yet", func() {
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(newMinimalVM(testVMName), nil)
+
+			app.VMTimeline(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			timeline := decodeTimeline()
+			Expect(timeline.Name).To(Equal(testVMName))
+			Expect(timeline.Entries).To(BeEmpty())
+		})
+
+		It("should fail when the VM does not exist", func() {
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachine"), testVMName))
+
+			app.VMTimeline(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusNotFound)
+		})
+	})
+
 	Context("StateChange JSON", func() {
 		It("should create a stop request if status exists", func() {
 			uid := uuid.NewUUID()
@@ -2218,6 +2707,407 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("Flattening", func() {
+		newFlattenBody := func(opts *v1.FlattenOptions) io.ReadCloser {
+			optsJson, _ := json.Marshal(opts)
+			return &readCloserWrapper{bytes.NewReader(optsJson)}
+		}
+
+		withContainerDisk := func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Volumes = []v1.Volume{{
+				Name:         "containerdisk",
+				VolumeSource: v1.VolumeSource{ContainerDisk: &v1.ContainerDiskSource{Image: "example.io/disk"}},
+			}}
+		}
+
+		withMigrationInProgress := func(vmi *v1.VirtualMachineInstance) {
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{}
+		}
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		It("should forward the flatten options to virt-handler", func() {
+			enableFeatureGate(virtconfig.VolumeFlattenGate)
+			request.Request.Body = newFlattenBody(&v1.FlattenOptions{Volumes: []string{"containerdisk"}})
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/flatten"),
+					ghttp.VerifyBody([]byte(`{"volumes":["containerdisk"]}`)),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMI(Running, UnPaused, withContainerDisk)
+
+			app.VMIFlattenRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("should not forward a dry run request to virt-handler", func() {
+			enableFeatureGate(virtconfig.VolumeFlattenGate)
+			request.Request.Body = newFlattenBody(&v1.FlattenOptions{DryRun: []string{k8smetav1.DryRunAll}})
+			expectVMI(Running, UnPaused, withContainerDisk)
+
+			app.VMIFlattenRequestHandler(request, response)
+
+			Expect(backend.ReceivedRequests()).To(BeEmpty())
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("should reject the request without the feature gate", func() {
+			request.Request.Body = newFlattenBody(&v1.FlattenOptions{})
+
+			app.VMIFlattenRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		DescribeTable("should reject the request", func(opts *v1.FlattenOptions, running bool, vmiWrapFunc func(vmi *v1.VirtualMachineInstance), code int) {
+			enableFeatureGate(virtconfig.VolumeFlattenGate)
+			request.Request.Body = newFlattenBody(opts)
+			expectVMI(running, UnPaused, withContainerDisk, vmiWrapFunc)
+
+			app.VMIFlattenRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, code)
+		},
+			Entry("when the VMI is not running", &v1.FlattenOptions{}, NotRunning, func(*v1.VirtualMachineInstance) {}, http.StatusConflict),
+			Entry("when the VMI is migrating", &v1.FlattenOptions{}, Running, withMigrationInProgress, http.StatusConflict),
+			Entry("when a volume does not exist", &v1.FlattenOptions{Volumes: []string{"missing"}}, Running, func(*v1.VirtualMachineInstance) {}, http.StatusBadRequest),
+		)
+	})
+
+	Context("Renewing leases", func() {
+		newRenewLeaseBody := func(opts *v1.RenewLeaseOptions) io.ReadCloser {
+			optsJson, _ := json.Marshal(opts)
+			return &readCloserWrapper{bytes.NewReader(optsJson)}
+		}
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+		})
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		It("should extend the lease by the duration", func() {
+			enableFeatureGate(virtconfig.VMLeaseGate)
+			request.Request.Body = newRenewLeaseBody(&v1.RenewLeaseOptions{Duration: k8smetav1.Duration{Duration: 2 * time.Hour}, DryRun: getDryRunOption()})
+			vm := newVirtualMachineWithRunStrategy(v1.RunStrategyAlways)
+			vm.Spec.Lease = &v1.VirtualMachineLease{ExpiresAt: k8smetav1.NewTime(time.Now().Add(time.Minute)).Rfc3339Copy()}
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmClient.EXPECT().Patch(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, name string, patchType types.PatchType, body []byte, opts k8smetav1.PatchOptions, _ ...string) (*v1.VirtualMachine, error) {
+					Expect(opts.DryRun).To(Equal(getDryRunOption()))
+					var ops []map[string]interface{}
+					Expect(json.Unmarshal(body, &ops)).To(Succeed())
+					Expect(ops).To(HaveLen(2))
+					Expect(ops[1]["op"]).To(Equal("replace"))
+					Expect(ops[1]["path"]).To(Equal("/spec/lease/expiresAt"))
+					expiresAt, err := time.Parse(time.RFC3339, ops[1]["value"].(string))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(expiresAt).To(BeTemporally("~", time.Now().Add(2*time.Hour), time.Minute))
+					return vm, nil
+				})
+
+			app.RenewVMLeaseRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should reject the request without the feature gate", func() {
+			request.Request.Body = newRenewLeaseBody(&v1.RenewLeaseOptions{Duration: k8smetav1.Duration{Duration: time.Hour}})
+
+			app.RenewVMLeaseRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should reject the request without a duration", func() {
+			enableFeatureGate(virtconfig.VMLeaseGate)
+			request.Request.Body = newRenewLeaseBody(&v1.RenewLeaseOptions{})
+
+			app.RenewVMLeaseRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should reject the request if the VM has no lease", func() {
+			enableFeatureGate(virtconfig.VMLeaseGate)
+			request.Request.Body = newRenewLeaseBody(&v1.RenewLeaseOptions{Duration: k8smetav1.Duration{Duration: time.Hour}})
+			vm := newVirtualMachineWithRunStrategy(v1.RunStrategyAlways)
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+
+			app.RenewVMLeaseRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+	})
+
+	Context("Verifying identity documents", func() {
+		identityKey := []byte("identity key")
+
+		newIdentity := func() *v1.VirtualMachineInstanceIdentity {
+			return &v1.VirtualMachineInstanceIdentity{
+				UID:       "vmi-uid",
+				Name:      testVMIName,
+				Namespace: k8smetav1.NamespaceDefault,
+				IssuedAt:  k8smetav1.Now(),
+				ExpiresAt: k8smetav1.NewTime(time.Now().Add(identitydocument.Validity)),
+			}
+		}
+
+		newVerifyIdentityBody := func(key []byte, identity *v1.VirtualMachineInstanceIdentity) io.ReadCloser {
+			signed, err := identitydocument.Sign(key, identity)
+			Expect(err).ToNot(HaveOccurred())
+			signedJson, _ := json.Marshal(signed)
+			return &readCloserWrapper{bytes.NewReader(signedJson)}
+		}
+
+		expectRunningVMI := func(uid types.UID) {
+			vmi := api.NewMinimalVMI(testVMIName)
+			vmi.UID = uid
+			vmi.Status.Phase = v1.Running
+			vmiClient.EXPECT().Get(context.Background(), testVMIName, k8smetav1.GetOptions{}).Return(vmi, nil)
+		}
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = testVMIName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+			app.identityKey = nil
+
+			kubeClient.Fake.PrependReactor("get", "secrets", func(action testing.Action) (bool, runtime.Object, error) {
+				get := action.(testing.GetAction)
+				Expect(get.GetName()).To(Equal(identitydocument.KeySecretName))
+				return true, &k8sv1.Secret{
+					ObjectMeta: k8smetav1.ObjectMeta{Name: identitydocument.KeySecretName, Namespace: get.GetNamespace()},
+					Data:       map[string][]byte{identitydocument.KeySecretKey: identityKey},
+				}, nil
+			})
+		})
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		It("should return the identity attested by a valid document", func() {
+			enableFeatureGate(virtconfig.VMIdentityDocumentsGate)
+			request.Request.Body = newVerifyIdentityBody(identityKey, newIdentity())
+			expectRunningVMI("vmi-uid")
+			response.SetRequestAccepts(restful.MIME_JSON)
+
+			app.VerifyIdentityRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			identity := &v1.VirtualMachineInstanceIdentity{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), identity)).To(Succeed())
+			Expect(identity.UID).To(Equal(types.UID("vmi-uid")))
+			Expect(identity.Name).To(Equal(testVMIName))
+		})
+
+		It("should reject the request without the feature gate", func() {
+			request.Request.Body = newVerifyIdentityBody(identityKey, newIdentity())
+
+			app.VerifyIdentityRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should reject a document signed with another key", func() {
+			enableFeatureGate(virtconfig.VMIdentityDocumentsGate)
+			request.Request.Body = newVerifyIdentityBody([]byte("another key"), newIdentity())
+
+			app.VerifyIdentityRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should reject an expired document", func() {
+			enableFeatureGate(virtconfig.VMIdentityDocumentsGate)
+			identity := newIdentity()
+			identity.ExpiresAt = k8smetav1.NewTime(time.Now().Add(-time.Minute))
+			request.Request.Body = newVerifyIdentityBody(identityKey, identity)
+
+			app.VerifyIdentityRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should reject a document issued to another VMI", func() {
+			enableFeatureGate(virtconfig.VMIdentityDocumentsGate)
+			identity := newIdentity()
+			identity.Name = "othervmi"
+			request.Request.Body = newVerifyIdentityBody(identityKey, identity)
+
+			app.VerifyIdentityRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should reject a document issued to a VMI which does not run anymore", func() {
+			enableFeatureGate(virtconfig.VMIdentityDocumentsGate)
+			request.Request.Body = newVerifyIdentityBody(identityKey, newIdentity())
+			expectRunningVMI("recreated-vmi-uid")
+
+			app.VerifyIdentityRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+	})
+
+	Context("Modernizing VMs", func() {
+		newModernizeBody := func(opts *v1.ModernizeOptions) io.ReadCloser {
+			optsJson, _ := json.Marshal(opts)
+			return &readCloserWrapper{bytes.NewReader(optsJson)}
+		}
+
+		registerNetworkBinding := func(name string) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{name: {}},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+		}
+
+		modernization := func() *v1.VirtualMachineModernization {
+			result := &v1.VirtualMachineModernization{}
+			Expect(json.NewDecoder(recorder.Body).Decode(result)).To(Succeed())
+			return result
+		}
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+			response.SetRequestAccepts(restful.MIME_JSON)
+		})
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		DescribeTable("should replace spec.running by a run strategy", func(running bool, expectedRunStrategy v1.VirtualMachineRunStrategy) {
+			request.Request.Body = newModernizeBody(&v1.ModernizeOptions{DryRun: getDryRunOption()})
+			vm := newVirtualMachineWithRunning(&running)
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmClient.EXPECT().Update(context.Background(), gomock.Any(), k8smetav1.UpdateOptions{DryRun: getDryRunOption()}).DoAndReturn(
+				func(ctx context.Context, vm *v1.VirtualMachine, opts k8smetav1.UpdateOptions) (*v1.VirtualMachine, error) {
+					Expect(vm.Spec.Running).To(BeNil())
+					Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(expectedRunStrategy)))
+					return vm, nil
+				})
+
+			app.ModernizeVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			result := modernization()
+			Expect(result.Changes).To(ConsistOf(HaveField("Path", "spec.running")))
+			Expect(result.Unsupported).To(BeEmpty())
+			Expect(result.VirtualMachine.Spec.RunStrategy).To(HaveValue(Equal(expectedRunStrategy)))
+		},
+			Entry("when running", true, v1.RunStrategyAlways),
+			Entry("when not running", false, v1.RunStrategyHalted),
+		)
+
+		It("should not update a VM without deprecated API usages", func() {
+			vm := newVirtualMachineWithRunStrategy(v1.RunStrategyAlways)
+			vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+
+			app.ModernizeVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			result := modernization()
+			Expect(result.Changes).To(BeEmpty())
+			Expect(result.VirtualMachine).To(Equal(vm))
+		})
+
+		It("should remove the non-root annotation", func() {
+			vm := newVirtualMachineWithRunStrategy(v1.RunStrategyAlways)
+			vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{
+				ObjectMeta: k8smetav1.ObjectMeta{Annotations: map[string]string{v1.DeprecatedNonRootVMIAnnotation: ""}},
+			}
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmClient.EXPECT().Update(context.Background(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, vm *v1.VirtualMachine, opts k8smetav1.UpdateOptions) (*v1.VirtualMachine, error) {
+					Expect(vm.Spec.Template.ObjectMeta.Annotations).ToNot(HaveKey(v1.DeprecatedNonRootVMIAnnotation))
+					return vm, nil
+				})
+
+			app.ModernizeVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(modernization().Changes).To(HaveLen(1))
+		})
+
+		It("should replace a deprecated binding method by its registered network binding plugin", func() {
+			registerNetworkBinding("passt")
+			vm := newVirtualMachineWithRunStrategy(v1.RunStrategyAlways)
+			vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{DeprecatedPasst: &v1.DeprecatedInterfacePasst{}},
+			}}
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmClient.EXPECT().Update(context.Background(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, vm *v1.VirtualMachine, opts k8smetav1.UpdateOptions) (*v1.VirtualMachine, error) {
+					iface := vm.Spec.Template.Spec.Domain.Devices.Interfaces[0]
+					Expect(iface.DeprecatedPasst).To(BeNil())
+					Expect(iface.Binding).To(Equal(&v1.PluginBinding{Name: "passt"}))
+					return vm, nil
+				})
+
+			app.ModernizeVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(modernization().Changes).To(ConsistOf(HaveField("Path", "spec.template.spec.domain.devices.interfaces[0].passt")))
+		})
+
+		DescribeTable("should report a deprecated binding method without equivalent as unsupported", func(iface v1.Interface, expectedPath string) {
+			vm := newVirtualMachineWithRunStrategy(v1.RunStrategyAlways)
+			vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+
+			app.ModernizeVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			result := modernization()
+			Expect(result.Changes).To(BeEmpty())
+			Expect(result.Unsupported).To(ConsistOf(HaveField("Path", expectedPath)))
+		},
+			Entry("with an unregistered plugin", v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{DeprecatedMacvtap: &v1.DeprecatedInterfaceMacvtap{}},
+			}, "spec.template.spec.domain.devices.interfaces[0].macvtap"),
+			Entry("with slirp", v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{DeprecatedSlirp: &v1.DeprecatedInterfaceSlirp{}},
+			}, "spec.template.spec.domain.devices.interfaces[0].slirp"),
+		)
+
+		It("should fail on a conflict", func() {
+			running := true
+			vm := newVirtualMachineWithRunning(&running)
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmClient.EXPECT().Update(context.Background(), gomock.Any(), gomock.Any()).Return(nil, errors.NewConflict(v1.Resource("virtualmachine"), vm.Name, fmt.Errorf("changed")))
+
+			app.ModernizeVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+	})
+
 	Context("SoftReboot", func() {
 		It("Should soft reboot a running VMI", func() {
 			backend.AppendHandlers(
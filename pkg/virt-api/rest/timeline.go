@@ -0,0 +1,62 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
+)
+
+// VMTimeline handles the subresource providing the timeline of a VirtualMachine. A VirtualMachine
+// which has no timeline yet gets an empty one.
+func (app *SubresourceAPIApp) VMTimeline(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if _, statusErr := app.fetchVirtualMachine(name, namespace); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	timeline, err := app.virtCli.GeneratedKubeVirtClient().TimelineV1alpha1().VirtualMachineTimelines(namespace).Get(context.Background(), name, k8smetav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		timeline = &timelinev1alpha1.VirtualMachineTimeline{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+		}
+	} else if err != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("unable to retrieve the timeline of vm [%s]: %v", name, err)), response)
+		return
+	}
+	timeline.TypeMeta = k8smetav1.TypeMeta{
+		APIVersion: timelinev1alpha1.SchemeGroupVersion.String(),
+		Kind:       timelinev1alpha1.VirtualMachineTimelineKind.Kind,
+	}
+
+	response.WriteEntity(timeline)
+}
@@ -20,7 +20,7 @@ func (app *SubresourceAPIApp) USBRedirRequestHandler(request *restful.Request, r
 
 	streamer := NewRawStreamer(
 		app.FetchVirtualMachineInstance,
-		validateVMIForUSBRedir,
+		app.withSubresourceAccessReview(request, "usbredir", validateVMIForUSBRedir),
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 			return conn.USBRedirURI(vmi)
 		}),
@@ -31,7 +31,7 @@ func (app *SubresourceAPIApp) VNCRequestHandler(request *restful.Request, respon
 
 	streamer := NewRawStreamer(
 		app.FetchVirtualMachineInstance,
-		validateVMIForVNC,
+		app.withConsoleAccessGrant(request, "vnc", app.withSubresourceAccessReview(request, "vnc", validateVMIForVNC)),
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 			return conn.VNCURI(vmi)
 		}),
@@ -51,7 +51,7 @@ func (app *SubresourceAPIApp) VNCScreenshotRequestHandler(request *restful.Reque
 
 	dialer := NewDirectDialer(
 		app.FetchVirtualMachineInstance,
-		validateVMIForVNC,
+		app.withConsoleAccessGrant(request, "vnc", app.withSubresourceAccessReview(request, "vnc", validateVMIForVNC)),
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 			return conn.VNCURI(vmi)
 		}),
@@ -16,7 +16,7 @@ import (
 func (app *SubresourceAPIApp) VSOCKRequestHandler(request *restful.Request, response *restful.Response) {
 	streamer := NewRawStreamer(
 		app.FetchVirtualMachineInstance,
-		validateVMIForVSOCK,
+		app.withSubresourceAccessReview(request, "vsock", validateVMIForVSOCK),
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 			tls := "true"
 			if request.QueryParameter("tls") != "" {
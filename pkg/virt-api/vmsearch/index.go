@@ -0,0 +1,229 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package vmsearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	ipIndex      = "ip"
+	macIndex     = "mac"
+	guestOSIndex = "guestOS"
+)
+
+// ErrNotSynced is returned by searches made before the index holds all the VMIs of the cluster
+var ErrNotSynced = errors.New("the VMI index is not synced yet")
+
+// Query selects VMIs. The VMIs match when they match all the set fields, and any of the IPs and
+// MAC addresses when both are set.
+type Query struct {
+	// Namespace restricts the search to a namespace, all the namespaces when empty
+	Namespace string
+	// Selector matches the labels of the VMIs
+	Selector labels.Selector
+	// GuestOS matches the ID or the name of the OS reported by the guest agent, ignoring the case
+	GuestOS string
+	// IPs match the IP addresses reported on the interfaces
+	IPs []string
+	// MACs match the MAC addresses reported on the interfaces
+	MACs []string
+}
+
+// Index indexes the VMIs of the cluster by namespace, IP and MAC address and guest OS. It only
+// watches the VMIs from the first search on, so that clusters not searching don't pay for the index.
+type Index struct {
+	informer  cache.SharedIndexInformer
+	stopCh    <-chan struct{}
+	startOnce sync.Once
+}
+
+func NewIndex(lw cache.ListerWatcher, stopCh <-chan struct{}) *Index {
+	return &Index{
+		informer: cache.NewSharedIndexInformer(lw, &v1.VirtualMachineInstance{}, 0, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+			ipIndex:              indexByIP,
+			macIndex:             indexByMAC,
+			guestOSIndex:         indexByGuestOS,
+		}),
+		stopCh: stopCh,
+	}
+}
+
+// Search returns the VMIs matching the query, sorted by namespace and name. It waits for the
+// index to be synced until the context is done.
+func (i *Index) Search(ctx context.Context, query *Query) ([]*v1.VirtualMachineInstance, error) {
+	i.startOnce.Do(func() {
+		go i.informer.Run(i.stopCh)
+	})
+	if !cache.WaitForCacheSync(ctx.Done(), i.informer.HasSynced) {
+		return nil, ErrNotSynced
+	}
+
+	candidates, err := i.candidates(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var vmis []*v1.VirtualMachineInstance
+	for _, obj := range candidates {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if query.matches(vmi) {
+			vmis = append(vmis, vmi)
+		}
+	}
+	slices.SortFunc(vmis, func(a, b *v1.VirtualMachineInstance) int {
+		if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return vmis, nil
+}
+
+// candidates looks up the VMIs in the most selective index the query uses, the other fields are
+// matched afterwards
+func (i *Index) candidates(query *Query) ([]interface{}, error) {
+	indexer := i.informer.GetIndexer()
+	switch {
+	case len(query.IPs) > 0 || len(query.MACs) > 0:
+		seen := map[string]struct{}{}
+		var candidates []interface{}
+		add := func(indexName string, values []string) error {
+			for _, value := range values {
+				objs, err := indexer.ByIndex(indexName, value)
+				if err != nil {
+					return err
+				}
+				for _, obj := range objs {
+					key, _ := cache.MetaNamespaceKeyFunc(obj)
+					if _, exists := seen[key]; !exists {
+						seen[key] = struct{}{}
+						candidates = append(candidates, obj)
+					}
+				}
+			}
+			return nil
+		}
+		if err := add(ipIndex, query.IPs); err != nil {
+			return nil, err
+		}
+		if err := add(macIndex, query.MACs); err != nil {
+			return nil, err
+		}
+		return candidates, nil
+	case query.GuestOS != "":
+		return indexer.ByIndex(guestOSIndex, strings.ToLower(query.GuestOS))
+	case query.Namespace != "":
+		return indexer.ByIndex(cache.NamespaceIndex, query.Namespace)
+	default:
+		return indexer.List(), nil
+	}
+}
+
+func (q *Query) matches(vmi *v1.VirtualMachineInstance) bool {
+	if q.Namespace != "" && vmi.Namespace != q.Namespace {
+		return false
+	}
+	if q.Selector != nil && !q.Selector.Matches(labels.Set(vmi.Labels)) {
+		return false
+	}
+	if q.GuestOS != "" && !slices.Contains(guestOSKeys(vmi), strings.ToLower(q.GuestOS)) {
+		return false
+	}
+	if len(q.IPs) > 0 || len(q.MACs) > 0 {
+		return slices.ContainsFunc(q.IPs, func(ip string) bool { return slices.Contains(ipKeys(vmi), ip) }) ||
+			slices.ContainsFunc(q.MACs, func(mac string) bool { return slices.Contains(macKeys(vmi), mac) })
+	}
+	return true
+}
+
+// CanonicalIP returns the canonical form of an IP address, under which the index holds it
+func CanonicalIP(address string) (string, error) {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return "", fmt.Errorf("invalid IP address %q", address)
+	}
+	return ip.String(), nil
+}
+
+// CanonicalMAC returns the canonical form of a MAC address, under which the index holds it
+func CanonicalMAC(address string) (string, error) {
+	mac, err := net.ParseMAC(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid MAC address %q", address)
+	}
+	return mac.String(), nil
+}
+
+func indexByIP(obj interface{}) ([]string, error) {
+	return ipKeys(obj.(*v1.VirtualMachineInstance)), nil
+}
+
+func indexByMAC(obj interface{}) ([]string, error) {
+	return macKeys(obj.(*v1.VirtualMachineInstance)), nil
+}
+
+func indexByGuestOS(obj interface{}) ([]string, error) {
+	return guestOSKeys(obj.(*v1.VirtualMachineInstance)), nil
+}
+
+func ipKeys(vmi *v1.VirtualMachineInstance) []string {
+	var keys []string
+	for _, iface := range vmi.Status.Interfaces {
+		for _, address := range append([]string{iface.IP}, iface.IPs...) {
+			if ip, err := CanonicalIP(address); err == nil && !slices.Contains(keys, ip) {
+				keys = append(keys, ip)
+			}
+		}
+	}
+	return keys
+}
+
+func macKeys(vmi *v1.VirtualMachineInstance) []string {
+	var keys []string
+	for _, iface := range vmi.Status.Interfaces {
+		if mac, err := CanonicalMAC(iface.MAC); err == nil && !slices.Contains(keys, mac) {
+			keys = append(keys, mac)
+		}
+	}
+	return keys
+}
+
+func guestOSKeys(vmi *v1.VirtualMachineInstance) []string {
+	var keys []string
+	for _, value := range []string{vmi.Status.GuestOSInfo.ID, vmi.Status.GuestOSInfo.Name} {
+		if key := strings.ToLower(value); key != "" && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
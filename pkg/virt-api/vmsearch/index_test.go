@@ -0,0 +1,136 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package vmsearch_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/vmsearch"
+)
+
+var _ = Describe("VMI index", func() {
+	newVMI := func(namespace, name, osID string, ifaces ...v1.VirtualMachineInstanceNetworkInterface) v1.VirtualMachineInstance {
+		return v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: map[string]string{"app": name}},
+			Status: v1.VirtualMachineInstanceStatus{
+				GuestOSInfo: v1.VirtualMachineInstanceGuestOSInfo{ID: osID},
+				Interfaces:  ifaces,
+			},
+		}
+	}
+
+	newIndex := func(vmis ...v1.VirtualMachineInstance) *vmsearch.Index {
+		stopCh := make(chan struct{})
+		DeferCleanup(func() { close(stopCh) })
+		return vmsearch.NewIndex(&cache.ListWatch{
+			ListFunc: func(metav1.ListOptions) (runtime.Object, error) {
+				return &v1.VirtualMachineInstanceList{Items: vmis}, nil
+			},
+			WatchFunc: func(metav1.ListOptions) (watch.Interface, error) {
+				return watch.NewFake(), nil
+			},
+		}, stopCh)
+	}
+
+	names := func(vmis []*v1.VirtualMachineInstance) []string {
+		var names []string
+		for _, vmi := range vmis {
+			names = append(names, vmi.Namespace+"/"+vmi.Name)
+		}
+		return names
+	}
+
+	var index *vmsearch.Index
+
+	BeforeEach(func() {
+		index = newIndex(
+			newVMI("ns1", "web", "mswindows",
+				v1.VirtualMachineInstanceNetworkInterface{IP: "10.2.3.4", IPs: []string{"10.2.3.4", "fd10::4"}, MAC: "02:00:00:00:00:01"}),
+			newVMI("ns2", "db", "mswindows",
+				v1.VirtualMachineInstanceNetworkInterface{IP: "10.2.3.5", MAC: "02:00:00:00:00:02"}),
+			newVMI("ns1", "cache", "fedora",
+				v1.VirtualMachineInstanceNetworkInterface{IP: "10.2.3.6", MAC: "02:00:00:00:00:03"}),
+			newVMI("ns2", "idle", ""),
+		)
+	})
+
+	DescribeTable("should search the VMIs", func(query *vmsearch.Query, expected []string) {
+		vmis, err := index.Search(context.Background(), query)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names(vmis)).To(Equal(expected))
+	},
+		Entry("with an empty query", &vmsearch.Query{}, []string{"ns1/cache", "ns1/web", "ns2/db", "ns2/idle"}),
+		Entry("by namespace", &vmsearch.Query{Namespace: "ns2"}, []string{"ns2/db", "ns2/idle"}),
+		Entry("by label", &vmsearch.Query{Selector: labels.SelectorFromSet(labels.Set{"app": "db"})}, []string{"ns2/db"}),
+		Entry("by guest OS ignoring the case", &vmsearch.Query{GuestOS: "MSWindows"}, []string{"ns1/web", "ns2/db"}),
+		Entry("by IP", &vmsearch.Query{IPs: []string{"fd10::4"}}, []string{"ns1/web"}),
+		Entry("by MAC", &vmsearch.Query{MACs: []string{"02:00:00:00:00:03"}}, []string{"ns1/cache"}),
+		Entry("by IP or MAC", &vmsearch.Query{IPs: []string{"10.2.3.4"}, MACs: []string{"02:00:00:00:00:02"}}, []string{"ns1/web", "ns2/db"}),
+		Entry("by guest OS and IP or MAC",
+			&vmsearch.Query{GuestOS: "mswindows", IPs: []string{"10.2.3.6"}, MACs: []string{"02:00:00:00:00:02"}}, []string{"ns2/db"}),
+		Entry("by namespace and guest OS", &vmsearch.Query{Namespace: "ns1", GuestOS: "mswindows"}, []string{"ns1/web"}),
+		Entry("without match", &vmsearch.Query{IPs: []string{"10.9.9.9"}}, nil),
+	)
+
+	It("should fail when the index is not synced in time", func() {
+		stopCh := make(chan struct{})
+		defer close(stopCh)
+		index := vmsearch.NewIndex(&cache.ListWatch{
+			ListFunc: func(metav1.ListOptions) (runtime.Object, error) {
+				return nil, context.DeadlineExceeded
+			},
+			WatchFunc: func(metav1.ListOptions) (watch.Interface, error) {
+				return watch.NewFake(), nil
+			},
+		}, stopCh)
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		_, err := index.Search(ctx, &vmsearch.Query{})
+		Expect(err).To(MatchError(vmsearch.ErrNotSynced))
+	})
+
+	DescribeTable("should canonicalize the addresses", func(canonicalize func(string) (string, error), address, expected string) {
+		Expect(canonicalize(address)).To(Equal(expected))
+	},
+		Entry("IPv4", vmsearch.CanonicalIP, "10.2.3.4", "10.2.3.4"),
+		Entry("IPv6", vmsearch.CanonicalIP, "FD10:0::4", "fd10::4"),
+		Entry("MAC", vmsearch.CanonicalMAC, "02-00-00-00-00-0A", "02:00:00:00:00:0a"),
+	)
+
+	It("should reject invalid addresses", func() {
+		_, err := vmsearch.CanonicalIP("10.2.3")
+		Expect(err).To(HaveOccurred())
+		_, err = vmsearch.CanonicalMAC("02:00")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -29,6 +29,7 @@ import (
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
+	apiinstancetype "kubevirt.io/api/instancetype"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
@@ -116,6 +117,10 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 		}
 	}
 
+	if ar.Request.Operation == admissionv1.Create {
+		mutator.inferPreferenceFromBootVolume(&vm)
+	}
+
 	// FIXME(lyarwood): Handle err here
 	preferenceSpec, _ := mutator.InstancetypeMethods.FindPreferenceSpec(&vm)
 	defaults.SetVirtualMachineDefaults(&vm, mutator.ClusterConfig, preferenceSpec)
@@ -143,6 +148,23 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 	}
 }
 
+// inferPreferenceFromBootVolume infers the preference of a new VM which doesn't set one, unless
+// disabled on the VM, and records the volume it was inferred from
+func (mutator *VMsMutator) inferPreferenceFromBootVolume(vm *v1.VirtualMachine) {
+	if !mutator.ClusterConfig.AutoInferPreferenceEnabled() || vm.Annotations[apiinstancetype.InferPreferenceAnnotation] == "false" {
+		return
+	}
+	volumeName := mutator.InstancetypeMethods.InferPreferenceFromBootVolume(vm)
+	if volumeName == "" {
+		return
+	}
+	if vm.Annotations == nil {
+		vm.Annotations = map[string]string{}
+	}
+	vm.Annotations[apiinstancetype.InferredPreferenceFromVolumeAnnotation] = volumeName
+	log.Log.Object(vm).V(4).Infof("Inferred preference %s from boot volume %s", vm.Spec.Preference.Name, volumeName)
+}
+
 func validateInstancetypeMatcherUpdate(oldInstancetypeMatcher *v1.InstancetypeMatcher, newInstancetypeMatcher *v1.InstancetypeMatcher) []metav1.StatusCause {
 	// Allow updates introducing or removing the matchers
 	if oldInstancetypeMatcher == nil || newInstancetypeMatcher == nil {
@@ -50,8 +50,10 @@ import (
 	instancetypeclientset "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 var _ = Describe("VirtualMachine Mutator", func() {
@@ -1868,6 +1870,141 @@ var _ = Describe("VirtualMachine Mutator", func() {
 		)
 	})
 
+	Context("with AutoInferPreference enabled", func() {
+		const (
+			bootVolumeName    = "rootdisk"
+			otherVolumeName   = "datadisk"
+			inferedPreference = "windows.11"
+		)
+
+		admitVMCreate := func() *admissionv1.AdmissionResponse {
+			vmBytes, err := json.Marshal(vm)
+			Expect(err).ToNot(HaveOccurred())
+			return mutator.Mutate(&admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Resource:  k8smetav1.GroupVersionResource{Group: v1.VirtualMachineGroupVersionKind.Group, Version: v1.VirtualMachineGroupVersionKind.Version, Resource: "virtualmachines"},
+					Object: runtime.RawExtension{
+						Raw: vmBytes,
+					},
+				},
+			})
+		}
+
+		getCreatedVMSpecMeta := func() (*v1.VirtualMachineSpec, *k8smetav1.ObjectMeta) {
+			resp := admitVMCreate()
+			Expect(resp.Allowed).To(BeTrue())
+			vmSpec := &v1.VirtualMachineSpec{}
+			vmMeta := &k8smetav1.ObjectMeta{}
+			Expect(json.Unmarshal(resp.Patch, &[]patch.PatchOperation{{Value: vmSpec}, {Value: vmMeta}})).To(Succeed())
+			return vmSpec, vmMeta
+		}
+
+		pvcVolume := func(name, claimName string) v1.Volume {
+			return v1.Volume{
+				Name: name,
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{virtconfig.AutoInferPreferenceGate},
+						},
+					},
+				},
+			})
+
+			_, err := k8sClient.CoreV1().PersistentVolumeClaims(vm.Namespace).Create(context.Background(), &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:   bootVolumeName,
+					Labels: map[string]string{apiinstancetype.DefaultPreferenceLabel: inferedPreference},
+				},
+			}, k8smetav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = k8sClient.CoreV1().PersistentVolumeClaims(vm.Namespace).Create(context.Background(), &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: otherVolumeName},
+			}, k8smetav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			vm.Spec.Template.Spec.Volumes = []v1.Volume{
+				pvcVolume(otherVolumeName, otherVolumeName),
+				pvcVolume(bootVolumeName, bootVolumeName),
+			}
+			vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{
+				{Name: otherVolumeName},
+				{Name: bootVolumeName, BootOrder: pointer.P(uint(1))},
+			}
+		})
+
+		It("should infer the preference from the boot volume and record it", func() {
+			vmSpec, vmMeta := getCreatedVMSpecMeta()
+			Expect(vmSpec.Preference).To(Equal(&v1.PreferenceMatcher{Name: inferedPreference, Kind: apiinstancetype.ClusterSingularPreferenceResourceName}))
+			Expect(vmMeta.Annotations).To(HaveKeyWithValue(apiinstancetype.InferredPreferenceFromVolumeAnnotation, bootVolumeName))
+		})
+
+		It("should infer the preference from the first disk when none sets a boot order", func() {
+			vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{{Name: bootVolumeName}, {Name: otherVolumeName}}
+
+			vmSpec, _ := getCreatedVMSpecMeta()
+			Expect(vmSpec.Preference).To(Equal(&v1.PreferenceMatcher{Name: inferedPreference, Kind: apiinstancetype.ClusterSingularPreferenceResourceName}))
+		})
+
+		DescribeTable("should leave the VM without preference", func(mutate func()) {
+			mutate()
+
+			vmSpec, vmMeta := getCreatedVMSpecMeta()
+			Expect(vmSpec.Preference).To(BeNil())
+			Expect(vmMeta.Annotations).ToNot(HaveKey(apiinstancetype.InferredPreferenceFromVolumeAnnotation))
+		},
+			Entry("when the boot volume has no default preference", func() {
+				vm.Spec.Template.Spec.Domain.Devices.Disks[0].BootOrder = pointer.P(uint(0))
+			}),
+			Entry("when the boot volume doesn't exist", func() {
+				vm.Spec.Template.Spec.Volumes[1] = pvcVolume(bootVolumeName, "unknown")
+			}),
+			Entry("when the boot volume is not supported", func() {
+				vm.Spec.Template.Spec.Volumes[1] = v1.Volume{
+					Name:         bootVolumeName,
+					VolumeSource: v1.VolumeSource{ContainerDisk: &v1.ContainerDiskSource{Image: "quay.io/containerdisks/fedora"}},
+				}
+			}),
+			Entry("when disabled on the VM", func() {
+				vm.Annotations = map[string]string{apiinstancetype.InferPreferenceAnnotation: "false"}
+			}),
+			Entry("when the feature gate is disabled", func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+			}),
+		)
+
+		It("should not replace the preference of the VM", func() {
+			vm.Spec.Preference = &v1.PreferenceMatcher{Name: "rhel.9"}
+
+			vmSpec, vmMeta := getCreatedVMSpecMeta()
+			Expect(vmSpec.Preference.Name).To(Equal("rhel.9"))
+			Expect(vmMeta.Annotations).ToNot(HaveKey(apiinstancetype.InferredPreferenceFromVolumeAnnotation))
+		})
+
+		It("should not infer the preference on update", func() {
+			oldVM := vm.DeepCopy()
+			newVM := vm.DeepCopy()
+			newVM.Labels["updated"] = "true"
+
+			resp := getResponseFromVMUpdate(oldVM, newVM)
+			Expect(resp.Allowed).To(BeTrue())
+			vmSpec := &v1.VirtualMachineSpec{}
+			Expect(json.Unmarshal(resp.Patch, &[]patch.PatchOperation{{Value: vmSpec}})).To(Succeed())
+			Expect(vmSpec.Preference).To(BeNil())
+		})
+	})
+
 	It("should default architecture to compiled architecture when not provided", func() {
 		// provide empty string for architecture so that default will apply
 		vmSpec, _ := getVMSpecMetaFromResponse("")
@@ -86,6 +86,10 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			return webhookutils.ToAdmissionResponseError(err)
 		}
 
+		if pinning := mutator.ClusterConfig.GetInfraThreadsPinning(); pinning != nil && newVMI.IsCPUDedicated() {
+			applyInfraThreadsPinning(newVMI, pinning)
+		}
+
 		if newVMI.Spec.Domain.CPU.IsolateEmulatorThread {
 			_, emulatorThreadCompleteToEvenParityAnnotationExists := mutator.ClusterConfig.GetConfigFromKubeVirtCR().Annotations[v1.EmulatorThreadCompleteToEvenParity]
 			if emulatorThreadCompleteToEvenParityAnnotationExists &&
@@ -158,3 +162,18 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 
 	return response
 }
+
+// applyInfraThreadsPinning applies the cluster wide pinning policy of the infrastructure threads to a VMI with
+// dedicated CPUs. The iothreads policy is carried by an annotation, so that the VMI keeps it for its lifetime.
+func applyInfraThreadsPinning(vmi *v1.VirtualMachineInstance, pinning *v1.InfraThreadsPinning) {
+	if pinning.IsolateEmulatorThread && !vmi.Spec.Domain.CPU.IsolateEmulatorThread {
+		log.Log.Object(vmi).V(4).Info("Isolate the emulator thread as required by the cluster")
+		vmi.Spec.Domain.CPU.IsolateEmulatorThread = true
+	}
+	if pinning.IOThreads != "" && vmi.Spec.Domain.CPU.IsolateEmulatorThread {
+		if vmi.Annotations == nil {
+			vmi.Annotations = map[string]string{}
+		}
+		vmi.Annotations[v1.IOThreadsPinningAnnotation] = string(pinning.IOThreads)
+	}
+}
@@ -614,6 +614,35 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Expect(exist).To(BeTrue())
 	})
 
+	DescribeTable("should apply the cluster wide infra threads pinning policy",
+		func(featureGates []string, cpu *v1.CPU, expectIsolated bool, expectedIOThreadsPinning string) {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: featureGates,
+						},
+						InfraThreadsPinning: &v1.InfraThreadsPinning{
+							IsolateEmulatorThread: true,
+							IOThreads:             v1.IOThreadsPinningEmulatorThread,
+						},
+					},
+				},
+			})
+			vmi.Spec.Domain.CPU = cpu
+
+			vmiMeta, vmiSpec, _ := getMetaSpecStatusFromAdmit(vmi.Spec.Architecture)
+			Expect(vmiSpec.Domain.CPU.IsolateEmulatorThread).To(Equal(expectIsolated))
+			Expect(vmiMeta.Annotations[v1.IOThreadsPinningAnnotation]).To(Equal(expectedIOThreadsPinning))
+		},
+		Entry("on a VMI with dedicated CPUs", []string{virtconfig.InfraThreadsPinningGate},
+			&v1.CPU{DedicatedCPUPlacement: true}, true, string(v1.IOThreadsPinningEmulatorThread)),
+		Entry("not when the InfraThreadsPinning featureGate is disabled", nil,
+			&v1.CPU{DedicatedCPUPlacement: true}, false, ""),
+		Entry("not on a VMI without dedicated CPUs", []string{virtconfig.InfraThreadsPinningGate},
+			&v1.CPU{}, false, ""),
+	)
+
 	It("should convert CPU requests to sockets", func() {
 		vmi.Spec.Domain.CPU = &v1.CPU{Model: "EPYC"}
 		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
@@ -0,0 +1,83 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"fmt"
+
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// bestPractice checks a VMI spec for a suboptimal configuration, which is allowed but warned of
+type bestPractice struct {
+	warning v1.AdmissionWarning
+	check   func(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []string
+}
+
+var bestPractices = []bestPractice{
+	{warning: v1.AdmissionWarningSATABus, check: checkSATABus},
+	{warning: v1.AdmissionWarningDedicatedCPUsWithoutEvictionStrategy, check: checkDedicatedCPUsEvictionStrategy},
+}
+
+// warnBestPractices returns the warnings of the best practices the spec doesn't follow, but those
+// disabled in the cluster configuration.
+func warnBestPractices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []string {
+	var warnings []string
+	for _, practice := range bestPractices {
+		if !config.AdmissionWarningEnabled(practice.warning) {
+			continue
+		}
+		for _, message := range practice.check(field, spec, config) {
+			warnings = append(warnings, fmt.Sprintf("%s [%s]", message, practice.warning))
+		}
+	}
+	return warnings
+}
+
+func checkSATABus(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, _ *virtconfig.ClusterConfig) []string {
+	var warnings []string
+	for i, disk := range spec.Domain.Devices.Disks {
+		// CD-ROMs can't use the virtio bus
+		if disk.Disk != nil && disk.Disk.Bus == v1.DiskBusSATA {
+			warnings = append(warnings, fmt.Sprintf("%s: the sata bus is slower than virtio, prefer virtio for guests with virtio drivers",
+				field.Child("domain", "devices", "disks").Index(i).Child("disk", "bus").String()))
+		}
+	}
+	return warnings
+}
+
+func checkDedicatedCPUsEvictionStrategy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []string {
+	if spec.Domain.CPU == nil || !spec.Domain.CPU.DedicatedCPUPlacement {
+		return nil
+	}
+	strategy := spec.EvictionStrategy
+	if strategy == nil {
+		strategy = config.GetConfig().EvictionStrategy
+	}
+	if strategy != nil && *strategy != v1.EvictionStrategyNone {
+		return nil
+	}
+	return []string{fmt.Sprintf("%s: the VMI has dedicated CPUs but no eviction strategy, it is shut down when its node is drained, set %s, e.g. to LiveMigrate",
+		field.Child("domain", "cpu", "dedicatedCpuPlacement").String(), field.Child("evictionStrategy").String())}
+}
@@ -0,0 +1,66 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Best practices warnings", func() {
+	DescribeTable("should warn", func(kvConfig *v1.KubeVirtConfiguration, vmi *v1.VirtualMachineInstance, expected []string) {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(kvConfig)
+		Expect(warnBestPractices(k8sfield.NewPath("spec"), &vmi.Spec, config)).To(Equal(expected))
+	},
+		Entry("of nothing on a VMI following the best practices", &v1.KubeVirtConfiguration{},
+			libvmi.New(libvmi.WithContainerDisk("disk0", "image"), libvmi.WithDedicatedCPUPlacement(), libvmi.WithEvictionStrategy(v1.EvictionStrategyLiveMigrate)),
+			nil),
+		Entry("of disks on the sata bus", &v1.KubeVirtConfiguration{},
+			libvmi.New(libvmi.WithContainerDisk("disk0", "image"), libvmi.WithContainerSATADisk("disk1", "image")),
+			[]string{"spec.domain.devices.disks[1].disk.bus: the sata bus is slower than virtio, prefer virtio for guests with virtio drivers [SATABus]"}),
+		Entry("of nothing on CD-ROMs on the sata bus", &v1.KubeVirtConfiguration{},
+			libvmi.New(libvmi.WithCDRom("cdrom0", v1.DiskBusSATA, "claim")),
+			nil),
+		Entry("of dedicated CPUs without eviction strategy", &v1.KubeVirtConfiguration{},
+			libvmi.New(libvmi.WithDedicatedCPUPlacement()),
+			[]string{"spec.domain.cpu.dedicatedCpuPlacement: the VMI has dedicated CPUs but no eviction strategy, it is shut down when its node is drained, set spec.evictionStrategy, e.g. to LiveMigrate [DedicatedCPUsWithoutEvictionStrategy]"}),
+		Entry("of dedicated CPUs with the None eviction strategy", &v1.KubeVirtConfiguration{},
+			libvmi.New(libvmi.WithDedicatedCPUPlacement(), libvmi.WithEvictionStrategy(v1.EvictionStrategyNone)),
+			[]string{"spec.domain.cpu.dedicatedCpuPlacement: the VMI has dedicated CPUs but no eviction strategy, it is shut down when its node is drained, set spec.evictionStrategy, e.g. to LiveMigrate [DedicatedCPUsWithoutEvictionStrategy]"}),
+		Entry("of nothing on dedicated CPUs with the eviction strategy of the cluster",
+			&v1.KubeVirtConfiguration{EvictionStrategy: pointer.P(v1.EvictionStrategyLiveMigrate)},
+			libvmi.New(libvmi.WithDedicatedCPUPlacement()),
+			nil),
+		Entry("of nothing disabled in the cluster configuration",
+			&v1.KubeVirtConfiguration{AdmissionWarnings: &v1.AdmissionWarningsConfiguration{
+				Disabled: []v1.AdmissionWarning{v1.AdmissionWarningSATABus, v1.AdmissionWarningDedicatedCPUsWithoutEvictionStrategy},
+			}},
+			libvmi.New(libvmi.WithContainerSATADisk("disk0", "image"), libvmi.WithDedicatedCPUPlacement()),
+			nil),
+	)
+})
@@ -0,0 +1,149 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	breakglassapi "kubevirt.io/api/breakglass"
+	breakglassv1alpha1 "kubevirt.io/api/breakglass/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/breakglass"
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+)
+
+// ConsoleAccessGrantAdmitter validates ConsoleAccessGrants
+type ConsoleAccessGrantAdmitter struct{}
+
+// NewConsoleAccessGrantAdmitter creates a ConsoleAccessGrantAdmitter
+func NewConsoleAccessGrantAdmitter() *ConsoleAccessGrantAdmitter {
+	return &ConsoleAccessGrantAdmitter{}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *ConsoleAccessGrantAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != breakglassv1alpha1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != breakglassapi.ResourceConsoleAccessGrants {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	grant := &breakglassv1alpha1.ConsoleAccessGrant{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, grant); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		causes = validateConsoleAccessGrantSpec(k8sfield.NewPath("spec"), &grant.Spec, ar.Request.UserInfo.Username)
+	case admissionv1.Update:
+		prevObj := &breakglassv1alpha1.ConsoleAccessGrant{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, prevObj); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec, grant.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec is immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateConsoleAccessGrantSpec(field *k8sfield.Path, spec *breakglassv1alpha1.ConsoleAccessGrantSpec, requester string) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.GrantedBy != requester {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("grantedBy must be the user creating the grant, %s", requester),
+			Field:   field.Child("grantedBy").String(),
+		})
+	}
+	if spec.User == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "user must not be empty",
+			Field:   field.Child("user").String(),
+		})
+	} else if spec.User == requester {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "the access can't be granted to the user creating the grant",
+			Field:   field.Child("user").String(),
+		})
+	}
+	if spec.VirtualMachineName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "virtualMachineName must not be empty",
+			Field:   field.Child("virtualMachineName").String(),
+		})
+	}
+	for i, subresource := range spec.Subresources {
+		if !slices.Contains(breakglass.GrantableSubresources, subresource) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("subresource %s can't be granted, supported subresources are %v", subresource, breakglass.GrantableSubresources),
+				Field:   field.Child("subresources").Index(i).String(),
+			})
+		}
+	}
+	if spec.Duration.Duration <= 0 || spec.Duration.Duration > breakglass.MaxDuration {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("duration must be positive and no longer than %s", breakglass.MaxDuration),
+			Field:   field.Child("duration").String(),
+		})
+	}
+	if spec.Reason == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "reason must not be empty",
+			Field:   field.Child("reason").String(),
+		})
+	}
+
+	return causes
+}
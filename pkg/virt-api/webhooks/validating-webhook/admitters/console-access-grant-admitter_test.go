@@ -0,0 +1,140 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	breakglassv1alpha1 "kubevirt.io/api/breakglass/v1alpha1"
+)
+
+var _ = Describe("Validating ConsoleAccessGrant Admitter", func() {
+	const requester = "security-officer"
+
+	newGrant := func() *breakglassv1alpha1.ConsoleAccessGrant {
+		return &breakglassv1alpha1.ConsoleAccessGrant{
+			ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "production"},
+			Spec: breakglassv1alpha1.ConsoleAccessGrantSpec{
+				User:               "jdoe",
+				VirtualMachineName: "database",
+				Subresources:       []string{"console", "vnc"},
+				Duration:           metav1.Duration{Duration: time.Hour},
+				Reason:             "INC-42",
+				GrantedBy:          requester,
+			},
+		}
+	}
+
+	It("should accept a valid grant", func() {
+		resp := NewConsoleAccessGrantAdmitter().Admit(context.Background(), createConsoleAccessGrantAdmissionReview(nil, newGrant()))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	DescribeTable("should reject a grant", func(mutate func(*breakglassv1alpha1.ConsoleAccessGrant), field string) {
+		grant := newGrant()
+		mutate(grant)
+
+		resp := NewConsoleAccessGrantAdmitter().Admit(context.Background(), createConsoleAccessGrantAdmissionReview(nil, grant))
+
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("granted by another user", func(grant *breakglassv1alpha1.ConsoleAccessGrant) {
+			grant.Spec.GrantedBy = "someone-else"
+		}, "spec.grantedBy"),
+		Entry("without a user", func(grant *breakglassv1alpha1.ConsoleAccessGrant) {
+			grant.Spec.User = ""
+		}, "spec.user"),
+		Entry("granting the access to its creator", func(grant *breakglassv1alpha1.ConsoleAccessGrant) {
+			grant.Spec.User = requester
+		}, "spec.user"),
+		Entry("without a VM", func(grant *breakglassv1alpha1.ConsoleAccessGrant) {
+			grant.Spec.VirtualMachineName = ""
+		}, "spec.virtualMachineName"),
+		Entry("with an unsupported subresource", func(grant *breakglassv1alpha1.ConsoleAccessGrant) {
+			grant.Spec.Subresources = []string{"console", "portforward"}
+		}, "spec.subresources[1]"),
+		Entry("without a duration", func(grant *breakglassv1alpha1.ConsoleAccessGrant) {
+			grant.Spec.Duration = metav1.Duration{}
+		}, "spec.duration"),
+		Entry("longer than a day", func(grant *breakglassv1alpha1.ConsoleAccessGrant) {
+			grant.Spec.Duration = metav1.Duration{Duration: 25 * time.Hour}
+		}, "spec.duration"),
+		Entry("without a reason", func(grant *breakglassv1alpha1.ConsoleAccessGrant) {
+			grant.Spec.Reason = ""
+		}, "spec.reason"),
+	)
+
+	It("should reject a change of the spec", func() {
+		grant := newGrant()
+		grant.Spec.Duration = metav1.Duration{Duration: 2 * time.Hour}
+
+		resp := NewConsoleAccessGrantAdmitter().Admit(context.Background(), createConsoleAccessGrantAdmissionReview(newGrant(), grant))
+
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+	})
+
+	It("should accept a change of the metadata", func() {
+		grant := newGrant()
+		grant.Labels = map[string]string{"ticket": "INC-42"}
+
+		resp := NewConsoleAccessGrantAdmitter().Admit(context.Background(), createConsoleAccessGrantAdmissionReview(newGrant(), grant))
+
+		Expect(resp.Allowed).To(BeTrue())
+	})
+})
+
+func createConsoleAccessGrantAdmissionReview(old, current *breakglassv1alpha1.ConsoleAccessGrant) *admissionv1.AdmissionReview {
+	currentBytes, _ := json.Marshal(current)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: current.Namespace,
+			Resource: metav1.GroupVersionResource{
+				Group:    "breakglass.kubevirt.io",
+				Resource: "consoleaccessgrants",
+			},
+			UserInfo: authv1.UserInfo{Username: "security-officer"},
+			Object: runtime.RawExtension{
+				Raw: currentBytes,
+			},
+		},
+	}
+	if old != nil {
+		oldBytes, _ := json.Marshal(old)
+		ar.Request.Operation = admissionv1.Update
+		ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+	}
+
+	return ar
+}
@@ -17,6 +17,7 @@ import (
 	kubevirt "kubevirt.io/client-go/kubevirt"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -73,7 +74,7 @@ func (admitter *PodEvictionAdmitter) Admit(ctx context.Context, ar *admissionv1.
 	evictionStrategy := migrations.VMIEvictionStrategy(admitter.clusterConfig, vmi)
 	if evictionStrategy == nil {
 		// we don't act on VMIs without an eviction strategy
-		return validating_webhooks.NewPassingAdmissionResponse()
+		return admitShutdown(vmi)
 	}
 
 	markForEviction := false
@@ -104,6 +105,21 @@ func (admitter *PodEvictionAdmitter) Admit(ctx context.Context, ar *admissionv1.
 
 	// We can let the request go through because the pod is protected by a PDB if the VMI wants to be live-migrated on
 	// eviction. Otherwise, we can just evict it.
+	if markForEviction {
+		return validating_webhooks.NewPassingAdmissionResponse()
+	}
+	return admitShutdown(vmi)
+}
+
+// admitShutdown refuses the evictions shutting down a VMI with pending Windows updates when its shutdown policy
+// reschedules them, the drains retry the evictions until the updates are installed
+func admitShutdown(vmi *virtv1.VirtualMachineInstance) *admissionv1.AdmissionResponse {
+	if vmi.Spec.ShutdownPolicy != nil && vmi.Spec.ShutdownPolicy.WindowsUpdates == virtv1.WindowsUpdatesReschedule {
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if condition := condManager.GetCondition(vmi, virtv1.VirtualMachineInstanceWindowsUpdatesPending); condition != nil && condition.Status == k8scorev1.ConditionTrue {
+			return denied(fmt.Sprintf("Eviction of VMI \"%s/%s\" rescheduled: %s", vmi.Namespace, vmi.Name, condition.Message))
+		}
+	}
 	return validating_webhooks.NewPassingAdmissionResponse()
 }
 
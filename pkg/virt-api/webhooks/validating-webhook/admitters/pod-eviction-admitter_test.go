@@ -296,6 +296,46 @@ var _ = Describe("Pod eviction admitter", func() {
 		),
 	)
 
+	DescribeTable("with pending Windows updates", func(action virtv1.WindowsUpdatesAction, clusterWideEvictionStrategy *virtv1.EvictionStrategy, rescheduled bool) {
+		vmi := libvmi.New(defaultVMIOptions...)
+		vmi.Spec.ShutdownPolicy = &virtv1.ShutdownPolicy{WindowsUpdates: action}
+		vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+			Type:    virtv1.VirtualMachineInstanceWindowsUpdatesPending,
+			Status:  k8sv1.ConditionTrue,
+			Reason:  virtv1.VirtualMachineInstanceReasonWindowsUpdatesInstalling,
+			Message: "Windows updates are being installed in the guest",
+		})
+		virtClient := kubevirtfake.NewSimpleClientset(vmi)
+
+		evictedVirtLauncherPod := newVirtLauncherPod(vmi.Namespace, vmi.Name, vmi.Status.NodeName)
+		kubeClient := fake.NewSimpleClientset(evictedVirtLauncherPod)
+
+		admitter := admitters.NewPodEvictionAdmitter(
+			newClusterConfig(clusterWideEvictionStrategy),
+			kubeClient,
+			virtClient,
+		)
+
+		actualAdmissionResponse := admitter.Admit(
+			context.Background(),
+			newAdmissionReview(evictedVirtLauncherPod.Namespace, evictedVirtLauncherPod.Name, &dryRunOptions{}),
+		)
+
+		if rescheduled {
+			Expect(actualAdmissionResponse).To(Equal(newDeniedAdmissionResponse(
+				fmt.Sprintf("Eviction of VMI \"%s/%s\" rescheduled: Windows updates are being installed in the guest", vmi.Namespace, vmi.Name),
+			)))
+		} else {
+			Expect(actualAdmissionResponse).To(Equal(allowedAdmissionResponse()))
+		}
+	},
+		Entry("should reschedule the eviction shutting the VMI down", virtv1.WindowsUpdatesReschedule, nil, true),
+		Entry("should reschedule the eviction shutting a non migratable VMI down",
+			virtv1.WindowsUpdatesReschedule, pointer.P(virtv1.EvictionStrategyLiveMigrateIfPossible), true),
+		Entry("should allow the eviction when the shutdown waits for the updates", virtv1.WindowsUpdatesWait, nil, false),
+		Entry("should allow the eviction when the updates are ignored", virtv1.WindowsUpdatesForce, nil, false),
+	)
+
 	It("should deny the request when the admitter fails to fetch the VMI", func() {
 		vmi := libvmi.New(defaultVMIOptions...)
 		virtClient := kubevirtfake.NewSimpleClientset(vmi)
@@ -27,6 +27,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"slices"
 	"strings"
 
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
@@ -41,7 +42,10 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
+	"kubevirt.io/kubevirt/pkg/emulation"
+	"kubevirt.io/kubevirt/pkg/faultinjection"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/maintenancenotifications"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
@@ -71,6 +75,10 @@ const (
 	maxDNSNameservers     = 3
 	maxDNSSearchPaths     = 6
 	maxDNSSearchListChars = 256
+
+	// The range of the cgroup v2 cpu.weight and io.weight
+	minCgroupWeight = 1
+	maxCgroupWeight = 10000
 )
 
 var validIOThreadsPolicies = []v1.IOThreadsPolicy{v1.IOThreadsPolicyShared, v1.IOThreadsPolicyAuto}
@@ -93,6 +101,8 @@ const (
 
 var isValidExpression = regexp.MustCompile(`^[A-Za-z0-9_.+-]+$`).MatchString
 
+var isValidChannelTarget = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`).MatchString
+
 type VMICreateAdmitter struct {
 	ClusterConfig *virtconfig.ClusterConfig
 }
@@ -122,6 +132,9 @@ func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 	causes = append(causes, validateVirtualMachineInstanceSpecVolumeDisks(k8sfield.NewPath("spec"), &vmi.Spec)...)
 	causes = append(causes, ValidateVirtualMachineInstanceMandatoryFields(k8sfield.NewPath("spec"), &vmi.Spec)...)
 	causes = append(causes, ValidateVirtualMachineInstanceMetadata(k8sfield.NewPath("metadata"), &vmi.ObjectMeta, admitter.ClusterConfig, accountName)...)
+	causes = append(causes, validateFaultInjection(k8sfield.NewPath("metadata"), &vmi.ObjectMeta, &vmi.Spec, admitter.ClusterConfig)...)
+	causes = append(causes, validateCrossArchitectureEmulation(k8sfield.NewPath("metadata"), k8sfield.NewPath("spec"), &vmi.ObjectMeta, &vmi.Spec, admitter.ClusterConfig)...)
+	causes = append(causes, validateFork(k8sfield.NewPath("metadata"), k8sfield.NewPath("spec"), &vmi.ObjectMeta, &vmi.Spec, admitter.ClusterConfig)...)
 	causes = append(causes, webhooks.ValidateVirtualMachineInstanceHyperv(k8sfield.NewPath("spec").Child("domain").Child("features").Child("hyperv"), &vmi.Spec)...)
 	if webhooks.IsARM64(&vmi.Spec) {
 		// Check if there is any unsupported setting if the arch is Arm64
@@ -131,9 +144,15 @@ func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	warnings := warnDeprecatedAPIs(&vmi.Spec, admitter.ClusterConfig)
+	if emulation.NodeArchitecture(vmi) != "" {
+		warnings = append(warnings, emulation.Warning(vmi))
+	}
+	warnings = append(warnings, warnBestPractices(k8sfield.NewPath("spec"), &vmi.Spec, admitter.ClusterConfig)...)
+
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
-		Warnings: warnDeprecatedAPIs(&vmi.Spec, admitter.ClusterConfig),
+		Warnings: warnings,
 	}
 }
 
@@ -207,9 +226,14 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateSoundDevices(field, spec)...)
 	causes = append(causes, validateLaunchSecurity(field, spec, config)...)
 	causes = append(causes, validateVSOCK(field, spec, config)...)
+	causes = append(causes, validateChannels(field, spec, config)...)
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
 	causes = append(causes, validatePersistentState(field, spec, config)...)
+	causes = append(causes, validateCgroupWeights(field.Child("domain", "resources", "cgroupWeights"), spec, config)...)
 	causes = append(causes, validateDownwardMetrics(field, spec, config)...)
+	causes = append(causes, validateMaintenanceNotifications(field.Child("domain", "devices", "maintenanceNotifications"), spec, config)...)
+	causes = append(causes, validateShutdownPolicy(field, spec, config)...)
+	causes = append(causes, validateIdentityDocument(field.Child("identityDocument"), spec, config)...)
 
 	return causes
 }
@@ -229,6 +253,129 @@ func validateDownwardMetrics(field *k8sfield.Path, spec *v1.VirtualMachineInstan
 	return causes
 }
 
+func validateMaintenanceNotifications(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	notifications := spec.Domain.Devices.MaintenanceNotifications
+	if notifications == nil {
+		return nil
+	}
+
+	if !config.MaintenanceNotificationsEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maintenanceNotifications virtio serial is not allowed: MaintenanceNotifications feature gate is not enabled",
+			Field:   field.String(),
+		}}
+	}
+
+	if leadTime := notifications.LeadTimeSeconds; leadTime != nil && (*leadTime < 1 || *leadTime > maintenancenotifications.MaxLeadTimeSeconds) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be between 1 and %d", field.Child("leadTimeSeconds").String(), maintenancenotifications.MaxLeadTimeSeconds),
+			Field:   field.Child("leadTimeSeconds").String(),
+		}}
+	}
+
+	return nil
+}
+
+func validateIdentityDocument(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	identityDocument := spec.IdentityDocument
+	if identityDocument == nil {
+		return nil
+	}
+
+	if !config.VMIdentityDocumentsEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.VMIdentityDocumentsGate),
+			Field:   field.String(),
+		}}
+	}
+
+	if guestAgentPath := identityDocument.GuestAgentPath; guestAgentPath != "" && !filepath.IsAbs(guestAgentPath) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be an absolute path", field.Child("guestAgentPath").String()),
+			Field:   field.Child("guestAgentPath").String(),
+		}}
+	}
+
+	return nil
+}
+
+func validateShutdownPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	policy := spec.ShutdownPolicy
+	if policy == nil {
+		return nil
+	}
+	field = field.Child("shutdownPolicy")
+
+	if !config.ShutdownPolicyEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.ShutdownPolicyGate),
+			Field:   field.String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	// The phases of the shutdown have to fit in the termination grace period, the guest is forced off once it expires
+	var phasesSeconds int64
+	if preShutdown := policy.PreShutdown; preShutdown != nil {
+		if len(preShutdown.Command) == 0 || preShutdown.Command[0] == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must name the command to run in the guest", field.Child("preShutdown", "command").String()),
+				Field:   field.Child("preShutdown", "command").String(),
+			})
+		}
+		if timeout := preShutdown.TimeoutSeconds; timeout != nil {
+			if *timeout < 1 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must be greater than 0", field.Child("preShutdown", "timeoutSeconds").String()),
+					Field:   field.Child("preShutdown", "timeoutSeconds").String(),
+				})
+			}
+			phasesSeconds += int64(*timeout)
+		}
+	}
+	if timeout := policy.SignalTimeoutSeconds; timeout != nil {
+		if *timeout < 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than 0", field.Child("signalTimeoutSeconds").String()),
+				Field:   field.Child("signalTimeoutSeconds").String(),
+			})
+		}
+		phasesSeconds += *timeout
+	}
+	switch policy.WindowsUpdates {
+	case "", v1.WindowsUpdatesWait, v1.WindowsUpdatesForce, v1.WindowsUpdatesReschedule:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be one of %s, %s or %s", field.Child("windowsUpdates").String(),
+				v1.WindowsUpdatesWait, v1.WindowsUpdatesForce, v1.WindowsUpdatesReschedule),
+			Field: field.Child("windowsUpdates").String(),
+		})
+	}
+
+	gracePeriod := v1.DefaultGracePeriodSeconds
+	if spec.TerminationGracePeriodSeconds != nil {
+		gracePeriod = *spec.TerminationGracePeriodSeconds
+	}
+	if phasesSeconds > gracePeriod {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("the timeouts of %s add up to %d seconds, more than the termination grace period of %d seconds", field.String(), phasesSeconds, gracePeriod),
+			Field:   field.String(),
+		})
+	}
+
+	return causes
+}
+
 func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -1224,6 +1371,13 @@ func ValidateVirtualMachineInstanceMetadata(field *k8sfield.Path, metadata *meta
 				Field:   field.Child("labels").String(),
 			})
 		}
+		if _, exists := annotations[v1.InfraDetailsAnnotation]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("creation of the %s annotation on a VMI object is prohibited", v1.InfraDetailsAnnotation),
+				Field:   field.Child("annotations").String(),
+			})
+		}
 	}
 
 	// Validate ignition feature gate if set when the corresponding annotation is found
@@ -1249,6 +1403,171 @@ func ValidateVirtualMachineInstanceMetadata(field *k8sfield.Path, metadata *meta
 	return causes
 }
 
+// validateFaultInjection validates the faults requested by the metadata of a VMI, the spec is
+// needed to look up the disks they refer to.
+func validateFaultInjection(field *k8sfield.Path, metadata *metav1.ObjectMeta, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if _, exists := metadata.Annotations[faultinjection.Annotation]; !exists {
+		return nil
+	}
+
+	annotationField := field.Child("annotations", faultinjection.Annotation).String()
+	if !config.FaultInjectionEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config, invalid entry %s", virtconfig.FaultInjectionGate, annotationField),
+			Field:   field.Child("annotations").String(),
+		}}
+	}
+
+	vmi := &v1.VirtualMachineInstance{ObjectMeta: *metadata, Spec: *spec}
+	if _, err := faultinjection.Parse(vmi); err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: err.Error(),
+			Field:   annotationField,
+		}}
+	}
+	return nil
+}
+
+// validateCrossArchitectureEmulation validates the node architecture requested by the metadata of
+// a VMI against the architecture of its spec.
+func validateCrossArchitectureEmulation(field, specField *k8sfield.Path, metadata *metav1.ObjectMeta, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	nodeArch, exists := metadata.Annotations[emulation.NodeArchitectureAnnotation]
+	if !exists {
+		return nil
+	}
+
+	annotationField := field.Child("annotations", emulation.NodeArchitectureAnnotation).String()
+	if !config.CrossArchitectureEmulationEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config, invalid entry %s", virtconfig.CrossArchitectureEmulationGate, annotationField),
+			Field:   field.Child("annotations").String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	if !slices.Contains(emulation.NodeArchitectures, nodeArch) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be one of %s, got %q", annotationField, strings.Join(emulation.NodeArchitectures, ", "), nodeArch),
+			Field:   annotationField,
+		})
+	}
+	if spec.Architecture == "" || spec.Architecture == nodeArch {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("the architecture of an emulated guest must differ from the node architecture %q requested by %s", nodeArch, annotationField),
+			Field:   specField.Child("architecture").String(),
+		})
+	}
+	if spec.Domain.LaunchSecurity != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "launch security is not supported with cross-architecture emulation",
+			Field:   specField.Child("domain", "launchSecurity").String(),
+		})
+	}
+	return causes
+}
+
+// validateFork validates the fork annotations of a VMI: the volume of a fork parent holds its fork
+// image, the ephemeral volume of a forked VMI is backed by the one of its parent.
+func validateFork(field, specField *k8sfield.Path, metadata *metav1.ObjectMeta, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	parentVolume, isForkParent := metadata.Annotations[v1.ForkParentAnnotation]
+	forkVolume, isForked := metadata.Annotations[v1.ForkFromAnnotation]
+	if !isForkParent && !isForked {
+		return nil
+	}
+
+	if !config.VMForkEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.VMForkGate),
+			Field:   field.Child("annotations").String(),
+		}}
+	}
+	if isForkParent && isForked {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("a forked VMI can't be a fork parent, %s and %s are exclusive", v1.ForkFromAnnotation, v1.ForkParentAnnotation),
+			Field:   field.Child("annotations").String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	lookupVolume := func(annotation, name string) *v1.Volume {
+		for i := range spec.Volumes {
+			if spec.Volumes[i].Name == name {
+				return &spec.Volumes[i]
+			}
+		}
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s refers to the missing volume %q", field.Child("annotations", annotation).String(), name),
+			Field:   field.Child("annotations", annotation).String(),
+		})
+		return nil
+	}
+
+	autoattachField := specField.Child("domain", "devices", "autoattachPodInterface")
+	if autoattach := spec.Domain.Devices.AutoattachPodInterface; autoattach == nil || *autoattach {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be false with forks, the pod network can't be hotplugged", autoattachField.String()),
+			Field:   autoattachField.String(),
+		})
+	}
+
+	if isForkParent {
+		if volume := lookupVolume(v1.ForkParentAnnotation, parentVolume); volume != nil &&
+			volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("the fork image of a fork parent is saved to a persistentVolumeClaim or dataVolume volume, volume %s is neither", parentVolume),
+				Field:   field.Child("annotations", v1.ForkParentAnnotation).String(),
+			})
+		}
+		if len(spec.Domain.Devices.Interfaces) > 0 || len(spec.Networks) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "a fork parent can't have networks, the interfaces of the forked VMIs are hotplugged",
+				Field:   specField.Child("networks").String(),
+			})
+		}
+		return causes
+	}
+
+	if volume := lookupVolume(v1.ForkFromAnnotation, forkVolume); volume != nil && volume.Ephemeral == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("a forked VMI is restored from an ephemeral volume, volume %s is not", forkVolume),
+			Field:   field.Child("annotations", v1.ForkFromAnnotation).String(),
+		})
+	}
+	for i, network := range spec.Networks {
+		if network.Pod != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("the pod network %s can't be hotplugged into a forked VMI", network.Name),
+				Field:   specField.Child("networks").Index(i).String(),
+			})
+		}
+	}
+	for i, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Bridge == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("interface %s can't be hotplugged into a forked VMI, only bridge interfaces can", iface.Name),
+				Field:   specField.Child("domain", "devices", "interfaces").Index(i).String(),
+			})
+		}
+	}
+
+	return causes
+}
+
 // Copied from kubernetes/pkg/apis/core/validation/validation.go
 func validatePodDNSConfig(dnsConfig *k8sv1.PodDNSConfig, dnsPolicy *k8sv1.DNSPolicy, field *k8sfield.Path) []metav1.StatusCause {
 	var causes []metav1.StatusCause
@@ -2242,6 +2561,72 @@ func validateVSOCK(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, co
 	return causes
 }
 
+func validateChannels(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if len(spec.Domain.Devices.Channels) == 0 {
+		return causes
+	}
+
+	channelsField := field.Child("domain", "devices", "channels")
+	if !config.ChannelHotplugEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.ChannelHotplugGate),
+			Field:   channelsField.String(),
+		})
+	}
+
+	// The guest agent and downward metrics channels are managed by KubeVirt
+	reservedTargets := map[string]struct{}{
+		"org.qemu.guest_agent.0":                        {},
+		downwardmetrics.DownwardMetricsSerialDeviceName: {},
+	}
+	names := map[string]struct{}{}
+	targets := map[string]struct{}{}
+	for idx, channel := range spec.Domain.Devices.Channels {
+		for _, err := range validation.IsDNS1123Label(channel.Name) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s: %s", channelsField.Index(idx).Child("name").String(), err),
+				Field:   channelsField.Index(idx).Child("name").String(),
+			})
+		}
+		if _, exists := names[channel.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s '%s' is specified more than once", channelsField.Index(idx).Child("name").String(), channel.Name),
+				Field:   channelsField.Index(idx).Child("name").String(),
+			})
+		}
+		names[channel.Name] = struct{}{}
+
+		targetField := channelsField.Index(idx).Child("target").String()
+		if !isValidChannelTarget(channel.Target) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s '%s' may only contain alphanumeric characters, '.', '_' and '-'", targetField, channel.Target),
+				Field:   targetField,
+			})
+		} else if _, reserved := reservedTargets[channel.Target]; reserved {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s '%s' is reserved", targetField, channel.Target),
+				Field:   targetField,
+			})
+		}
+		if _, exists := targets[channel.Target]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s '%s' is specified more than once", targetField, channel.Target),
+				Field:   targetField,
+			})
+		}
+		targets[channel.Target] = struct{}{}
+	}
+
+	return causes
+}
+
 func validatePersistentReservation(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if !reservation.HasVMISpecPersistentReservation(spec) {
@@ -2285,6 +2670,73 @@ func validatePersistentState(field *k8sfield.Path, spec *v1.VirtualMachineInstan
 	return causes
 }
 
+func validateCgroupWeights(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	weights := spec.Domain.Resources.CgroupWeights
+	if weights == nil {
+		return causes
+	}
+
+	if !config.CgroupWeightsEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.CgroupWeightsGate),
+			Field:   field.String(),
+		})
+	}
+
+	validateWeight := func(field *k8sfield.Path, weight uint32) {
+		if weight < minCgroupWeight || weight > maxCgroupWeight {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be between %d and %d", field.String(), minCgroupWeight, maxCgroupWeight),
+				Field:   field.String(),
+			})
+		}
+	}
+	if weights.CPU != nil {
+		validateWeight(field.Child("cpu"), *weights.CPU)
+	}
+	if weights.IO != nil {
+		validateWeight(field.Child("io"), *weights.IO)
+	}
+
+	volumes := map[string]*v1.Volume{}
+	for i := range spec.Volumes {
+		volumes[spec.Volumes[i].Name] = &spec.Volumes[i]
+	}
+	weighted := map[string]struct{}{}
+	for i, volumeIO := range weights.VolumeIO {
+		f := field.Child("volumeIO").Index(i)
+		volume, exists := volumes[volumeIO.Volume]
+		switch {
+		case !exists:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must reference a volume of the VMI", f.Child("volume").String()),
+				Field:   f.Child("volume").String(),
+			})
+		case volume.PersistentVolumeClaim == nil && volume.DataVolume == nil:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must reference a persistentVolumeClaim or dataVolume volume", f.Child("volume").String()),
+				Field:   f.Child("volume").String(),
+			})
+		}
+		if _, duplicate := weighted[volumeIO.Volume]; duplicate {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s is weighted more than once", f.Child("volume").String()),
+				Field:   f.Child("volume").String(),
+			})
+		}
+		weighted[volumeIO.Volume] = struct{}{}
+		validateWeight(f.Child("weight"), volumeIO.Weight)
+	}
+
+	return causes
+}
+
 func validateCPUHotplug(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.CPU != nil && spec.Domain.CPU.MaxSockets != 0 {
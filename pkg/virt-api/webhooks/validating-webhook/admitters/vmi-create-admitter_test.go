@@ -47,6 +47,8 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/emulation"
+	"kubevirt.io/kubevirt/pkg/faultinjection"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -77,9 +79,9 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 	vmiCreateAdmitter := &VMICreateAdmitter{ClusterConfig: config}
 
 	dnsConfigTestOption := "test"
-	enableFeatureGate := func(featureGate string) {
+	enableFeatureGate := func(featureGates ...string) {
 		kvConfig := kv.DeepCopy()
-		kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featureGate}
+		kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = featureGates
 		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
 	}
 	disableFeatureGates := func() {
@@ -410,6 +412,18 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(resp.Result.Details.Causes).To(HaveLen(1))
 			Expect(resp.Result.Details.Causes[0].Message).To(Equal("creation of the following reserved kubevirt.io/ labels on a VMI object is prohibited"))
 		})
+		It("should reject the infra details annotation by non kubevirt user", func() {
+			vmi := newBaseVmi(libvmi.WithAnnotation(v1.InfraDetailsAnnotation, "{}"))
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo = authv1.UserInfo{Username: "system:serviceaccount:fake:" + "user-account"}
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("metadata.annotations"))
+		})
 		DescribeTable("should reject annotations which require feature gate enabled", func(annotations map[string]string, expectedMsg string) {
 			vmi := newBaseVmi()
 			vmi.Annotations = annotations
@@ -432,6 +446,14 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				map[string]string{hooks.HookSidecarListAnnotationName: "[{'image': 'fake-image'}]"},
 				fmt.Sprintf("invalid entry metadata.annotations.%s", hooks.HookSidecarListAnnotationName),
 			),
+			Entry("without FaultInjection feature gate enabled",
+				map[string]string{faultinjection.Annotation: `{"onDefineDomain": {"fail": true}}`},
+				fmt.Sprintf("invalid entry metadata.annotations.%s", faultinjection.Annotation),
+			),
+			Entry("without CrossArchitectureEmulation feature gate enabled",
+				map[string]string{emulation.NodeArchitectureAnnotation: "amd64"},
+				fmt.Sprintf("invalid entry metadata.annotations.%s", emulation.NodeArchitectureAnnotation),
+			),
 		)
 		DescribeTable("should accept annotations which require feature gate enabled", func(annotations map[string]string, featureGate string) {
 			enableFeatureGate(featureGate)
@@ -454,6 +476,141 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				map[string]string{hooks.HookSidecarListAnnotationName: "[{'image': 'fake-image'}]"},
 				virtconfig.SidecarGate,
 			),
+			Entry("with FaultInjection feature gate enabled",
+				map[string]string{faultinjection.Annotation: `{"onDefineDomain": {"fail": true}}`},
+				virtconfig.FaultInjectionGate,
+			),
+		)
+		It("should reject invalid faults", func() {
+			enableFeatureGate(virtconfig.FaultInjectionGate)
+			vmi := newBaseVmi()
+			vmi.Annotations = map[string]string{faultinjection.Annotation: `{"storage": {"disks": ["missing"], "iops": 10}}`}
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: `invalid fault injection: disk "missing" does not exist`,
+				Field:   fmt.Sprintf("metadata.annotations.%s", faultinjection.Annotation),
+			}))
+		})
+		It("should accept a guest emulated on another architecture with a warning", func() {
+			enableFeatureGate(virtconfig.CrossArchitectureEmulationGate, virtconfig.MultiArchitecture)
+			vmi := newBaseVmi(
+				libvmi.WithArchitecture("s390x"),
+				libvmi.WithAnnotation(emulation.NodeArchitectureAnnotation, "amd64"),
+			)
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(resp.Warnings).To(ContainElement(emulation.Warning(vmi)))
+		})
+		DescribeTable("should reject invalid cross-architecture emulation", func(vmiArch, nodeArch string, expectedCause metav1.StatusCause) {
+			enableFeatureGate(virtconfig.CrossArchitectureEmulationGate, virtconfig.MultiArchitecture)
+			vmi := newBaseVmi(
+				libvmi.WithArchitecture(vmiArch),
+				libvmi.WithAnnotation(emulation.NodeArchitectureAnnotation, nodeArch),
+			)
+
+			causes := validateCrossArchitectureEmulation(k8sfield.NewPath("metadata"), k8sfield.NewPath("spec"), &vmi.ObjectMeta, &vmi.Spec, config)
+			Expect(causes).To(ConsistOf(expectedCause))
+		},
+			Entry("with an unsupported node architecture", "s390x", "riscv64", metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf(`metadata.annotations.%s must be one of amd64, arm64, s390x, got "riscv64"`, emulation.NodeArchitectureAnnotation),
+				Field:   fmt.Sprintf("metadata.annotations.%s", emulation.NodeArchitectureAnnotation),
+			}),
+			Entry("with the architecture of the guest", "arm64", "arm64", metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf(`the architecture of an emulated guest must differ from the node architecture "arm64" requested by metadata.annotations.%s`, emulation.NodeArchitectureAnnotation),
+				Field:   "spec.architecture",
+			}),
+		)
+		It("should reject launch security with cross-architecture emulation", func() {
+			enableFeatureGate(virtconfig.CrossArchitectureEmulationGate)
+			vmi := newBaseVmi(
+				libvmi.WithArchitecture("arm64"),
+				libvmi.WithAnnotation(emulation.NodeArchitectureAnnotation, "amd64"),
+			)
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{SEV: &v1.SEV{}}
+
+			causes := validateCrossArchitectureEmulation(k8sfield.NewPath("metadata"), k8sfield.NewPath("spec"), &vmi.ObjectMeta, &vmi.Spec, config)
+			Expect(causes).To(ConsistOf(HaveField("Field", "spec.domain.launchSecurity")))
+		})
+		It("should reject the fork annotations when the feature gate is disabled", func() {
+			vmi := newBaseVmi(
+				libvmi.WithAnnotation(v1.ForkParentAnnotation, "rootdisk"),
+				libvmi.WithPersistentVolumeClaim("rootdisk", "parent"),
+				libvmi.WithAutoAttachPodInterface(false),
+			)
+
+			causes := validateFork(k8sfield.NewPath("metadata"), k8sfield.NewPath("spec"), &vmi.ObjectMeta, &vmi.Spec, config)
+			Expect(causes).To(ConsistOf(HaveField("Message", ContainSubstring(virtconfig.VMForkGate))))
+		})
+		DescribeTable("should validate the fork annotations", func(expectedFields []string, opts ...libvmi.Option) {
+			enableFeatureGate(virtconfig.VMForkGate)
+			vmi := newBaseVmi(opts...)
+
+			causes := validateFork(k8sfield.NewPath("metadata"), k8sfield.NewPath("spec"), &vmi.ObjectMeta, &vmi.Spec, config)
+			fields := []string{}
+			for _, cause := range causes {
+				fields = append(fields, cause.Field)
+			}
+			Expect(fields).To(ConsistOf(expectedFields))
+		},
+			Entry("accepting a fork parent", []string{},
+				libvmi.WithAnnotation(v1.ForkParentAnnotation, "rootdisk"),
+				libvmi.WithPersistentVolumeClaim("rootdisk", "parent"),
+				libvmi.WithAutoAttachPodInterface(false),
+			),
+			Entry("accepting a forked VMI with a bridge interface", []string{},
+				libvmi.WithAnnotation(v1.ForkFromAnnotation, "rootdisk"),
+				libvmi.WithEphemeralPersistentVolumeClaim("rootdisk", "parent"),
+				libvmi.WithAutoAttachPodInterface(false),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding("red")),
+				libvmi.WithNetwork(libvmi.MultusNetwork("red", "red-net")),
+			),
+			Entry("rejecting both annotations", []string{"metadata.annotations"},
+				libvmi.WithAnnotation(v1.ForkParentAnnotation, "rootdisk"),
+				libvmi.WithAnnotation(v1.ForkFromAnnotation, "rootdisk"),
+			),
+			Entry("rejecting a missing volume", []string{fmt.Sprintf("metadata.annotations.%s", v1.ForkParentAnnotation)},
+				libvmi.WithAnnotation(v1.ForkParentAnnotation, "missing"),
+				libvmi.WithAutoAttachPodInterface(false),
+			),
+			Entry("rejecting a fork parent saved to an ephemeral volume", []string{fmt.Sprintf("metadata.annotations.%s", v1.ForkParentAnnotation)},
+				libvmi.WithAnnotation(v1.ForkParentAnnotation, "rootdisk"),
+				libvmi.WithEphemeralPersistentVolumeClaim("rootdisk", "parent"),
+				libvmi.WithAutoAttachPodInterface(false),
+			),
+			Entry("rejecting a fork parent with networks", []string{"spec.networks"},
+				libvmi.WithAnnotation(v1.ForkParentAnnotation, "rootdisk"),
+				libvmi.WithPersistentVolumeClaim("rootdisk", "parent"),
+				libvmi.WithAutoAttachPodInterface(false),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding("red")),
+				libvmi.WithNetwork(libvmi.MultusNetwork("red", "red-net")),
+			),
+			Entry("rejecting a forked VMI restored from a persistent volume", []string{fmt.Sprintf("metadata.annotations.%s", v1.ForkFromAnnotation)},
+				libvmi.WithAnnotation(v1.ForkFromAnnotation, "rootdisk"),
+				libvmi.WithPersistentVolumeClaim("rootdisk", "parent"),
+				libvmi.WithAutoAttachPodInterface(false),
+			),
+			Entry("rejecting a forked VMI on the pod network", []string{
+				"spec.domain.devices.autoattachPodInterface",
+				"spec.networks[0]",
+				"spec.domain.devices.interfaces[0]",
+			},
+				libvmi.WithAnnotation(v1.ForkFromAnnotation, "rootdisk"),
+				libvmi.WithEphemeralPersistentVolumeClaim("rootdisk", "parent"),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			),
 		)
 	})
 
@@ -2556,6 +2713,170 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with maintenance notifications", func() {
+		var vmi *v1.VirtualMachineInstance
+		validate := func() []metav1.StatusCause {
+			return validateMaintenanceNotifications(k8sfield.NewPath("fake"), &vmi.Spec, config)
+		}
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.MaintenanceNotifications = &v1.MaintenanceNotifications{}
+		})
+
+		It("should reject if feature gate is not enabled", func() {
+			Expect(validate()).To(ConsistOf(metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   "fake",
+				Message: "maintenanceNotifications virtio serial is not allowed: MaintenanceNotifications feature gate is not enabled"}))
+		})
+
+		DescribeTable("should validate the lead time", func(leadTimeSeconds *int32, valid bool) {
+			enableFeatureGate(virtconfig.MaintenanceNotificationsGate)
+			vmi.Spec.Domain.Devices.MaintenanceNotifications.LeadTimeSeconds = leadTimeSeconds
+			if valid {
+				Expect(validate()).To(BeEmpty())
+			} else {
+				Expect(validate()).To(ConsistOf(metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+					Field:   "fake.leadTimeSeconds",
+					Message: "fake.leadTimeSeconds must be between 1 and 600"}))
+			}
+		},
+			Entry("accepting the default", nil, true),
+			Entry("accepting 600 seconds", pointer.P(int32(600)), true),
+			Entry("rejecting 0 seconds", pointer.P(int32(0)), false),
+			Entry("rejecting more than 600 seconds", pointer.P(int32(601)), false),
+		)
+	})
+
+	Context("with a shutdown policy", func() {
+		var vmi *v1.VirtualMachineInstance
+		validate := func() []metav1.StatusCause {
+			return validateShutdownPolicy(k8sfield.NewPath("fake"), &vmi.Spec, config)
+		}
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.ShutdownPolicy = &v1.ShutdownPolicy{
+				PreShutdown: &v1.PreShutdownCommand{
+					Command:        []string{"/usr/bin/systemctl", "stop", "postgresql"},
+					TimeoutSeconds: pointer.P(int32(20)),
+				},
+				SignalTimeoutSeconds: pointer.P(int64(10)),
+			}
+		})
+
+		It("should reject if feature gate is not enabled", func() {
+			Expect(validate()).To(ConsistOf(metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   "fake.shutdownPolicy",
+				Message: "ShutdownPolicy feature gate is not enabled in kubevirt-config"}))
+		})
+
+		It("should accept timeouts fitting in the termination grace period", func() {
+			enableFeatureGate(virtconfig.ShutdownPolicyGate)
+			Expect(validate()).To(BeEmpty())
+		})
+
+		It("should accept a pre-shutdown command with the default timeout", func() {
+			enableFeatureGate(virtconfig.ShutdownPolicyGate)
+			vmi.Spec.ShutdownPolicy.PreShutdown.TimeoutSeconds = nil
+			vmi.Spec.ShutdownPolicy.SignalTimeoutSeconds = nil
+			Expect(validate()).To(BeEmpty())
+		})
+
+		DescribeTable("should reject a pre-shutdown command without command", func(command []string) {
+			enableFeatureGate(virtconfig.ShutdownPolicyGate)
+			vmi.Spec.ShutdownPolicy.PreShutdown.Command = command
+			Expect(validate()).To(ConsistOf(metav1.StatusCause{Type: metav1.CauseTypeFieldValueRequired,
+				Field:   "fake.shutdownPolicy.preShutdown.command",
+				Message: "fake.shutdownPolicy.preShutdown.command must name the command to run in the guest"}))
+		},
+			Entry("when empty", []string{}),
+			Entry("when the command is empty", []string{"", "stop"}),
+		)
+
+		It("should reject timeouts lower than 1 second", func() {
+			enableFeatureGate(virtconfig.ShutdownPolicyGate)
+			vmi.Spec.ShutdownPolicy.PreShutdown.TimeoutSeconds = pointer.P(int32(0))
+			vmi.Spec.ShutdownPolicy.SignalTimeoutSeconds = pointer.P(int64(-1))
+			Expect(validate()).To(ConsistOf(
+				metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+					Field:   "fake.shutdownPolicy.preShutdown.timeoutSeconds",
+					Message: "fake.shutdownPolicy.preShutdown.timeoutSeconds must be greater than 0"},
+				metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+					Field:   "fake.shutdownPolicy.signalTimeoutSeconds",
+					Message: "fake.shutdownPolicy.signalTimeoutSeconds must be greater than 0"},
+			))
+		})
+
+		DescribeTable("should reject timeouts exceeding the termination grace period", func(gracePeriod *int64, expectedGracePeriod int) {
+			enableFeatureGate(virtconfig.ShutdownPolicyGate)
+			vmi.Spec.TerminationGracePeriodSeconds = gracePeriod
+			vmi.Spec.ShutdownPolicy.SignalTimeoutSeconds = pointer.P(int64(20))
+			Expect(validate()).To(ConsistOf(metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field: "fake.shutdownPolicy",
+				Message: fmt.Sprintf("the timeouts of fake.shutdownPolicy add up to 40 seconds, more than the termination grace period of %d seconds",
+					expectedGracePeriod)}))
+		},
+			Entry("with the default grace period", nil, 30),
+			Entry("with an explicit grace period", pointer.P(int64(35)), 35),
+		)
+
+		DescribeTable("should validate the handling of Windows updates", func(action v1.WindowsUpdatesAction, valid bool) {
+			enableFeatureGate(virtconfig.ShutdownPolicyGate)
+			vmi.Spec.ShutdownPolicy.WindowsUpdates = action
+			if valid {
+				Expect(validate()).To(BeEmpty())
+			} else {
+				Expect(validate()).To(ConsistOf(metav1.StatusCause{Type: metav1.CauseTypeFieldValueNotSupported,
+					Field:   "fake.shutdownPolicy.windowsUpdates",
+					Message: "fake.shutdownPolicy.windowsUpdates must be one of Wait, Force or Reschedule"}))
+			}
+		},
+			Entry("accepting the default", v1.WindowsUpdatesAction(""), true),
+			Entry("accepting Wait", v1.WindowsUpdatesWait, true),
+			Entry("accepting Force", v1.WindowsUpdatesForce, true),
+			Entry("accepting Reschedule", v1.WindowsUpdatesReschedule, true),
+			Entry("rejecting an unknown action", v1.WindowsUpdatesAction("Postpone"), false),
+		)
+	})
+
+	Context("with an identity document", func() {
+		var vmi *v1.VirtualMachineInstance
+		validate := func() []metav1.StatusCause {
+			return validateIdentityDocument(k8sfield.NewPath("fake", "identityDocument"), &vmi.Spec, config)
+		}
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.IdentityDocument = &v1.IdentityDocument{GuestAgentPath: "/run/kubevirt/identity-document"}
+		})
+
+		It("should reject if feature gate is not enabled", func() {
+			Expect(validate()).To(ConsistOf(metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   "fake.identityDocument",
+				Message: "VMIdentityDocuments feature gate is not enabled in kubevirt-config"}))
+		})
+
+		It("should accept a document without guest agent path", func() {
+			enableFeatureGate(virtconfig.VMIdentityDocumentsGate)
+			vmi.Spec.IdentityDocument.GuestAgentPath = ""
+			Expect(validate()).To(BeEmpty())
+		})
+
+		It("should accept an absolute guest agent path", func() {
+			enableFeatureGate(virtconfig.VMIdentityDocumentsGate)
+			Expect(validate()).To(BeEmpty())
+		})
+
+		It("should reject a relative guest agent path", func() {
+			enableFeatureGate(virtconfig.VMIdentityDocumentsGate)
+			vmi.Spec.IdentityDocument.GuestAgentPath = "identity-document"
+			Expect(validate()).To(ConsistOf(metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   "fake.identityDocument.guestAgentPath",
+				Message: "fake.identityDocument.guestAgentPath must be an absolute path"}))
+		})
+	})
+
 	Context("with volume", func() {
 		It("should accept a single downwardmetrics volume", func() {
 			enableFeatureGate(virtconfig.DownwardMetricsFeatureGate)
@@ -3097,6 +3418,54 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with channels defined", func() {
+		var vmi *v1.VirtualMachineInstance
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			enableFeatureGate(virtconfig.ChannelHotplugGate)
+		})
+
+		It("should accept valid channels", func() {
+			vmi.Spec.Domain.Devices.Channels = []v1.Channel{
+				{Name: "agent", Target: "org.example.agent.0"},
+				{Name: "logs", Target: "org.example.logs.0"},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject channels when the feature gate is disabled", func() {
+			disableFeatureGates()
+			vmi.Spec.Domain.Devices.Channels = []v1.Channel{{Name: "agent", Target: "org.example.agent.0"}}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", virtconfig.ChannelHotplugGate)))
+		})
+
+		DescribeTable("should reject", func(channels []v1.Channel, field string) {
+			vmi.Spec.Domain.Devices.Channels = channels
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(field))
+		},
+			Entry("a name which is not a DNS label",
+				[]v1.Channel{{Name: "Agent_0", Target: "org.example.agent.0"}},
+				"fake.domain.devices.channels[0].name"),
+			Entry("a duplicate name",
+				[]v1.Channel{{Name: "agent", Target: "org.example.agent.0"}, {Name: "agent", Target: "org.example.agent.1"}},
+				"fake.domain.devices.channels[1].name"),
+			Entry("a target with invalid characters",
+				[]v1.Channel{{Name: "agent", Target: "org/example"}},
+				"fake.domain.devices.channels[0].target"),
+			Entry("a duplicate target",
+				[]v1.Channel{{Name: "agent", Target: "org.example.agent.0"}, {Name: "other", Target: "org.example.agent.0"}},
+				"fake.domain.devices.channels[1].target"),
+			Entry("the guest agent target",
+				[]v1.Channel{{Name: "agent", Target: "org.qemu.guest_agent.0"}},
+				"fake.domain.devices.channels[0].target"),
+		)
+	})
+
 	Context("with affinity checks", func() {
 		var vmi *v1.VirtualMachineInstance
 		BeforeEach(func() {
@@ -3885,6 +4254,70 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with cgroup weights defined", func() {
+		var vmi *v1.VirtualMachineInstance
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = []v1.Volume{{
+				Name: "pvcdisk",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: testutils.NewFakePersistentVolumeSource(),
+				},
+			}, {
+				Name: "cloudinit",
+				VolumeSource: v1.VolumeSource{
+					CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "fake"},
+				},
+			}}
+			enableFeatureGate(virtconfig.CgroupWeightsGate)
+		})
+
+		It("should accept valid weights", func() {
+			vmi.Spec.Domain.Resources.CgroupWeights = &v1.CgroupWeights{
+				CPU:      pointer.P(uint32(200)),
+				IO:       pointer.P(uint32(50)),
+				VolumeIO: []v1.VolumeIOWeight{{Volume: "pvcdisk", Weight: 1000}},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject when the feature gate is disabled", func() {
+			disableFeatureGates()
+			vmi.Spec.Domain.Resources.CgroupWeights = &v1.CgroupWeights{CPU: pointer.P(uint32(200))}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", virtconfig.CgroupWeightsGate)))
+		})
+
+		DescribeTable("should reject", func(weights *v1.CgroupWeights, expectedFields ...string) {
+			vmi.Spec.Domain.Resources.CgroupWeights = weights
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			fields := []string{}
+			for _, cause := range causes {
+				fields = append(fields, cause.Field)
+			}
+			Expect(fields).To(ConsistOf(expectedFields))
+		},
+			Entry("weights out of range", &v1.CgroupWeights{
+				CPU: pointer.P(uint32(0)),
+				IO:  pointer.P(uint32(10001)),
+			}, "fake.domain.resources.cgroupWeights.cpu", "fake.domain.resources.cgroupWeights.io"),
+			Entry("an unknown volume", &v1.CgroupWeights{
+				VolumeIO: []v1.VolumeIOWeight{{Volume: "unknown", Weight: 100}},
+			}, "fake.domain.resources.cgroupWeights.volumeIO[0].volume"),
+			Entry("a volume which is not a PVC", &v1.CgroupWeights{
+				VolumeIO: []v1.VolumeIOWeight{{Volume: "cloudinit", Weight: 100}},
+			}, "fake.domain.resources.cgroupWeights.volumeIO[0].volume"),
+			Entry("a volume weighted twice", &v1.CgroupWeights{
+				VolumeIO: []v1.VolumeIOWeight{{Volume: "pvcdisk", Weight: 100}, {Volume: "pvcdisk", Weight: 200}},
+			}, "fake.domain.resources.cgroupWeights.volumeIO[1].volume"),
+			Entry("a volume weight out of range", &v1.CgroupWeights{
+				VolumeIO: []v1.VolumeIOWeight{{Volume: "pvcdisk", Weight: 0}},
+			}, "fake.domain.resources.cgroupWeights.volumeIO[0].weight"),
+		)
+	})
+
 	Context("with VM persistent state defined", func() {
 		var vmi *v1.VirtualMachineInstance
 		addPersistentTPM := func() {
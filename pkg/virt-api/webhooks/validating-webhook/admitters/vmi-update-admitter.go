@@ -122,6 +122,10 @@ func (admitter *VMIUpdateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 		return reviewResponse
 	}
 
+	if reviewResponse := admitVMIInfraDetailsUpdate(newVMI, oldVMI, ar); reviewResponse != nil {
+		return reviewResponse
+	}
+
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
 		Warnings: warnDeprecatedAPIs(&newVMI.Spec, admitter.clusterConfig),
@@ -389,6 +393,27 @@ func admitVMILabelsUpdate(
 	return nil
 }
 
+func admitVMIInfraDetailsUpdate(
+	newVMI *v1.VirtualMachineInstance,
+	oldVMI *v1.VirtualMachineInstance,
+	ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+
+	if webhooks.IsKubeVirtServiceAccount(ar.Request.UserInfo.Username) {
+		return nil
+	}
+
+	if newVMI.Annotations[v1.InfraDetailsAnnotation] != oldVMI.Annotations[v1.InfraDetailsAnnotation] {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("modification of the %s annotation on a VMI object is prohibited", v1.InfraDetailsAnnotation),
+			},
+		})
+	}
+
+	return nil
+}
+
 func filterKubevirtLabels(labels map[string]string) map[string]string {
 	m := make(map[string]string)
 	if len(labels) == 0 {
@@ -417,6 +442,14 @@ func admitHotplug(
 		return response
 	}
 
+	if response := admitCPUResourcesUpdate(oldVMI, newVMI); response != nil {
+		return response
+	}
+
+	if response := admitHotplugChannels(oldVMI.Spec.Domain.Devices.Channels, newVMI.Spec.Domain.Devices.Channels); response != nil {
+		return response
+	}
+
 	return admitStorageUpdate(
 		newVMI.Spec.Volumes,
 		oldVMI.Spec.Volumes,
@@ -442,6 +475,24 @@ func admitHotplugCPU(oldCPUTopology, newCPUTopology *v1.CPU) *admissionv1.Admiss
 	return nil
 }
 
+func admitCPUResourcesUpdate(oldVMI, newVMI *v1.VirtualMachineInstance) *admissionv1.AdmissionResponse {
+	if !oldVMI.IsCPUDedicated() {
+		return nil
+	}
+
+	if !oldVMI.Spec.Domain.Resources.Requests.Cpu().Equal(*newVMI.Spec.Domain.Resources.Requests.Cpu()) ||
+		!oldVMI.Spec.Domain.Resources.Limits.Cpu().Equal(*newVMI.Spec.Domain.Resources.Limits.Cpu()) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "CPU resources of a VMI with dedicated CPUs changed",
+			},
+		})
+	}
+
+	return nil
+}
+
 func admitHotplugMemory(oldMemory, newMemory *v1.Memory) *admissionv1.AdmissionResponse {
 	if oldMemory == nil ||
 		oldMemory.MaxGuest == nil ||
@@ -462,6 +513,20 @@ func admitHotplugMemory(oldMemory, newMemory *v1.Memory) *admissionv1.AdmissionR
 	return nil
 }
 
+// admitHotplugChannels only allows new channels to be appended, unplugging channels is not supported
+func admitHotplugChannels(oldChannels, newChannels []v1.Channel) *admissionv1.AdmissionResponse {
+	if len(newChannels) < len(oldChannels) || !equality.Semantic.DeepEqual(oldChannels, newChannels[:len(oldChannels)]) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Channels of a running VMI can only be appended, existing channels can not be changed or removed",
+			},
+		})
+	}
+
+	return nil
+}
+
 func hasRequestOriginatedFromVirtHandler(requestUsername string, kubeVirtServiceAccounts map[string]struct{}) bool {
 	if _, isKubeVirtServiceAccount := kubeVirtServiceAccounts[requestUsername]; isKubeVirtServiceAccount {
 		return strings.HasSuffix(requestUsername, components.HandlerServiceAccountName)
@@ -425,6 +425,33 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		),
 	)
 
+	DescribeTable("Should restrict the modification of the infra details annotation to kubevirt service accounts",
+		func(username string, expectAllowed bool) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Annotations = map[string]string{v1.InfraDetailsAnnotation: `{"event/Started":"started on node01"}`}
+			updateVmi := vmi.DeepCopy()
+			updateVmi.Annotations[v1.InfraDetailsAnnotation] = "{}"
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					UserInfo:  authv1.UserInfo{Username: username},
+					Resource:  webhooks.VirtualMachineInstanceGroupVersionResource,
+					Operation: admissionv1.Update,
+				},
+			}
+			resp := admitVMIInfraDetailsUpdate(updateVmi, vmi, ar)
+			if expectAllowed {
+				Expect(resp).To(BeNil())
+			} else {
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring(v1.InfraDetailsAnnotation))
+			}
+		},
+		Entry("allow the handler", "system:serviceaccount:kubevirt:"+components.HandlerServiceAccountName, true),
+		Entry("allow the controller", "system:serviceaccount:kubevirt:"+components.ControllerServiceAccountName, true),
+		Entry("reject other users", "system:serviceaccount:someNamespace:someUser", false),
+	)
+
 	emptyResult := func() map[string]v1.Volume {
 		return make(map[string]v1.Volume, 0)
 	}
@@ -944,6 +971,79 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		Expect(resp.Allowed).To(BeFalse())
 	})
 
+	DescribeTable("Updates of CPU resources", func(dedicatedCPUPlacement bool, expected types.GomegaMatcher) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{DedicatedCPUPlacement: dedicatedCPUPlacement}
+		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("1")}
+		updateVmi := vmi.DeepCopy()
+		updateVmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("2")}
+
+		newVMIBytes, _ := json.Marshal(&updateVmi)
+		oldVMIBytes, _ := json.Marshal(&vmi)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				UserInfo: authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName},
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: newVMIBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldVMIBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+		resp := vmiUpdateAdmitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(expected)
+	},
+		Entry("allow without dedicated CPUs", false, BeTrue()),
+		Entry("deny with dedicated CPUs", true, BeFalse()),
+	)
+
+	DescribeTable("Updates of channels", func(oldChannels, newChannels []v1.Channel, expected types.GomegaMatcher) {
+		enableFeatureGate(virtconfig.ChannelHotplugGate)
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{}
+		vmi.Spec.Domain.Devices.Channels = oldChannels
+		updateVmi := vmi.DeepCopy()
+		updateVmi.Spec.Domain.Devices.Channels = newChannels
+
+		newVMIBytes, _ := json.Marshal(&updateVmi)
+		oldVMIBytes, _ := json.Marshal(&vmi)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				UserInfo: authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ApiServiceAccountName},
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: newVMIBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldVMIBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+		resp := vmiUpdateAdmitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(expected)
+	},
+		Entry("allow adding the first channel",
+			nil,
+			[]v1.Channel{{Name: "a", Target: "org.example.a.0"}},
+			BeTrue()),
+		Entry("allow appending a channel",
+			[]v1.Channel{{Name: "a", Target: "org.example.a.0"}},
+			[]v1.Channel{{Name: "a", Target: "org.example.a.0"}, {Name: "b", Target: "org.example.b.0"}},
+			BeTrue()),
+		Entry("deny removing a channel",
+			[]v1.Channel{{Name: "a", Target: "org.example.a.0"}, {Name: "b", Target: "org.example.b.0"}},
+			[]v1.Channel{{Name: "a", Target: "org.example.a.0"}},
+			BeFalse()),
+		Entry("deny changing the target of a channel",
+			[]v1.Channel{{Name: "a", Target: "org.example.a.0"}},
+			[]v1.Channel{{Name: "a", Target: "org.example.a.1"}},
+			BeFalse()),
+	)
+
 	DescribeTable("should allow change for a persistent volume if it is a migrated volume", func(hotpluggable bool) {
 		disks := []v1.Disk{
 			{
@@ -131,6 +131,10 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 		})
 	}
 
+	if spec.WarmStandby != nil {
+		causes = append(causes, validateWarmStandby(field, spec)...)
+	}
+
 	if ar.Request.Operation == admissionv1.Update {
 		oldPool := &poolv1.VirtualMachinePool{}
 		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldPool); err != nil {
@@ -150,3 +154,60 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 	}
 	return causes
 }
+
+// validateWarmStandby checks that the VMs of the pool can run as warm standby VMs: they boot
+// without networks, which are hotplugged once claimed.
+func validateWarmStandby(field *k8sfield.Path, spec *poolv1.VirtualMachinePoolSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	warmStandbyField := field.Child("warmStandby")
+	if spec.WarmStandby.Replicas < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be negative", warmStandbyField.Child("replicas").String()),
+			Field:   warmStandbyField.Child("replicas").String(),
+		})
+	}
+	if len(spec.WarmStandby.ClaimCommand) > 0 && spec.WarmStandby.ClaimCommand[0] == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must start with the path of the command", warmStandbyField.Child("claimCommand").String()),
+			Field:   warmStandbyField.Child("claimCommand").Index(0).String(),
+		})
+	}
+
+	if spec.VirtualMachineTemplate.Spec.Template == nil {
+		return causes
+	}
+	vmiSpecField := field.Child("virtualMachineTemplate", "spec", "template", "spec")
+	vmiSpec := &spec.VirtualMachineTemplate.Spec.Template.Spec
+
+	if autoattach := vmiSpec.Domain.Devices.AutoattachPodInterface; autoattach == nil || *autoattach {
+		autoattachField := vmiSpecField.Child("domain", "devices", "autoattachPodInterface")
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be false with warm standby VMs, the pod network can't be hotplugged", autoattachField.String()),
+			Field:   autoattachField.String(),
+		})
+	}
+	for i, network := range vmiSpec.Networks {
+		if network.Pod != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("the pod network %s can't be hotplugged into a claimed warm standby VM", network.Name),
+				Field:   vmiSpecField.Child("networks").Index(i).String(),
+			})
+		}
+	}
+	for i, iface := range vmiSpec.Domain.Devices.Interfaces {
+		if iface.Bridge == nil && iface.SRIOV == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("interface %s can't be hotplugged into a claimed warm standby VM, only bridge and SR-IOV interfaces can", iface.Name),
+				Field:   vmiSpecField.Child("domain", "devices", "interfaces").Index(i).String(),
+			})
+		}
+	}
+
+	return causes
+}
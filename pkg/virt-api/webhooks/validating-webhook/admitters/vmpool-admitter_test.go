@@ -33,6 +33,7 @@ import (
 	virtv1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 )
@@ -175,4 +176,92 @@ var _ = Describe("Validating Pool Admitter", func() {
 		resp := poolAdmitter.Admit(context.Background(), ar)
 		Expect(resp.Allowed).To(BeTrue())
 	})
+
+	Context("with warm standby VMs", func() {
+		newWarmStandbyPool := func() *poolv1.VirtualMachinePool {
+			template := newVirtualMachineBuilder().
+				WithDisk(v1.Disk{
+					Name: "testdisk",
+				}).
+				WithVolume(v1.Volume{
+					Name: "testdisk",
+					VolumeSource: v1.VolumeSource{
+						ContainerDisk: testutils.NewFakeContainerDiskSource(),
+					},
+				}).
+				WithLabel("match", "me").
+				BuildTemplate()
+			template.Spec.Networks = []v1.Network{{
+				Name:          "red",
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}},
+			}}
+			template.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "red",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}}
+			template.Spec.Domain.Devices.AutoattachPodInterface = pointer.P(false)
+
+			return &poolv1.VirtualMachinePool{
+				Spec: poolv1.VirtualMachinePoolSpec{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"match": "me"},
+					},
+					VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"match": "me"},
+						},
+						Spec: v1.VirtualMachineSpec{
+							RunStrategy: &always,
+							Template:    template,
+						},
+					},
+					WarmStandby: &poolv1.VirtualMachinePoolWarmStandby{
+						Replicas:     2,
+						ClaimCommand: []string{"/usr/local/bin/claimed.sh"},
+					},
+				},
+			}
+		}
+
+		admit := func(pool *poolv1.VirtualMachinePool) *admissionv1.AdmissionResponse {
+			poolBytes, _ := json.Marshal(&pool)
+			return poolAdmitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.VirtualMachinePoolGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: poolBytes,
+					},
+				},
+			})
+		}
+
+		It("should accept warm standby VMs with hotpluggable networks", func() {
+			Expect(admit(newWarmStandbyPool()).Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject", func(mutate func(*poolv1.VirtualMachinePool), field string) {
+			pool := newWarmStandbyPool()
+			mutate(pool)
+
+			resp := admit(pool)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", field)))
+		},
+			Entry("negative replicas", func(pool *poolv1.VirtualMachinePool) {
+				pool.Spec.WarmStandby.Replicas = -1
+			}, "spec.warmStandby.replicas"),
+			Entry("a claim command without a path", func(pool *poolv1.VirtualMachinePool) {
+				pool.Spec.WarmStandby.ClaimCommand = []string{"", "--hostname"}
+			}, "spec.warmStandby.claimCommand[0]"),
+			Entry("the pod interface attached automatically", func(pool *poolv1.VirtualMachinePool) {
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Domain.Devices.AutoattachPodInterface = nil
+			}, "spec.virtualMachineTemplate.spec.template.spec.domain.devices.autoattachPodInterface"),
+			Entry("the pod network", func(pool *poolv1.VirtualMachinePool) {
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Networks[0].NetworkSource = v1.NetworkSource{Pod: &v1.PodNetwork{}}
+			}, "spec.virtualMachineTemplate.spec.template.spec.networks[0]"),
+			Entry("a masquerade interface", func(pool *poolv1.VirtualMachinePool) {
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+			}, "spec.virtualMachineTemplate.spec.template.spec.domain.devices.interfaces[0]"),
+		)
+	})
 })
@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"kubevirt.io/kubevirt/pkg/defaults"
 	"kubevirt.io/kubevirt/pkg/virt-config/deprecation"
@@ -44,6 +45,8 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/emulation"
+	"kubevirt.io/kubevirt/pkg/externalpolicy"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	migrationutil "kubevirt.io/kubevirt/pkg/util/migrations"
 
@@ -167,6 +170,11 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes = admitter.validateExternalPolicy(ctx, ar.Request, &vm)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	isDryRun := ar.Request.DryRun != nil && *ar.Request.DryRun
 	if !isDryRun && ar.Request.Operation == admissionv1.Create {
 		metrics.NewVMCreated(&vm)
@@ -176,6 +184,13 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 	if vm.Spec.Running != nil {
 		warnings = append(warnings, "spec.running is deprecated, please use spec.runStrategy instead.")
 	}
+	if template := vm.Spec.Template; template != nil {
+		templateVMI := &v1.VirtualMachineInstance{ObjectMeta: template.ObjectMeta, Spec: template.Spec}
+		if emulation.NodeArchitecture(templateVMI) != "" {
+			warnings = append(warnings, emulation.Warning(templateVMI))
+		}
+		warnings = append(warnings, warnBestPractices(k8sfield.NewPath("spec", "template", "spec"), &template.Spec, admitter.ClusterConfig)...)
+	}
 
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
@@ -342,11 +357,87 @@ func ValidateVirtualMachineSpec(field *k8sfield.Path, spec *v1.VirtualMachineSpe
 	}
 
 	causes = append(causes, ValidateVirtualMachineInstanceMetadata(field.Child("template", "metadata"), &spec.Template.ObjectMeta, config, accountName)...)
+	causes = append(causes, validateFaultInjection(field.Child("template", "metadata"), &spec.Template.ObjectMeta, &spec.Template.Spec, config)...)
+	causes = append(causes, validateCrossArchitectureEmulation(field.Child("template", "metadata"), field.Child("template", "spec"), &spec.Template.ObjectMeta, &spec.Template.Spec, config)...)
 	causes = append(causes, ValidateVirtualMachineInstanceSpec(field.Child("template", "spec"), &spec.Template.Spec, config)...)
 
 	causes = append(causes, validateDataVolumeTemplate(field, spec)...)
 	causes = append(causes, validateRunStrategy(field, spec)...)
 	causes = append(causes, validateLiveUpdateFeatures(field, spec, config)...)
+	causes = append(causes, validateStartDependencies(field.Child("startDependencies"), spec.StartDependencies, config)...)
+	causes = append(causes, validateLease(field.Child("lease"), spec.Lease, config)...)
+
+	return causes
+}
+
+func validateLease(field *k8sfield.Path, lease *v1.VirtualMachineLease, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if lease == nil {
+		return causes
+	}
+
+	if !config.VMLeaseEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.VMLeaseGate),
+			Field:   field.String(),
+		})
+	}
+
+	switch lease.Action {
+	case "", v1.VirtualMachineLeaseActionStop, v1.VirtualMachineLeaseActionDelete:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be one of %s or %s", field.Child("action").String(), v1.VirtualMachineLeaseActionStop, v1.VirtualMachineLeaseActionDelete),
+			Field:   field.Child("action").String(),
+		})
+	}
+	if lease.WarningPeriod != nil && lease.WarningPeriod.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than zero", field.Child("warningPeriod").String()),
+			Field:   field.Child("warningPeriod").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateStartDependencies(field *k8sfield.Path, dependencies []v1.StartDependency, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if len(dependencies) == 0 {
+		return causes
+	}
+
+	if !config.VMStartDependenciesEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.VMStartDependenciesGate),
+			Field:   field.String(),
+		})
+	}
+
+	for idx, dependency := range dependencies {
+		name := dependency.Name
+		if dependency.Kind == v1.StartDependencyNetworkAttachmentDefinition {
+			if parts := strings.Split(name, "/"); len(parts) == 2 {
+				name = parts[1]
+			}
+		}
+		if name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must not be empty", field.Index(idx).Child("name").String()),
+				Field:   field.Index(idx).Child("name").String(),
+			})
+		}
+		if dependency.Timeout != nil && dependency.Timeout.Duration <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than zero", field.Index(idx).Child("timeout").String()),
+				Field:   field.Index(idx).Child("timeout").String(),
+			})
+		}
+	}
 
 	return causes
 }
@@ -733,6 +824,31 @@ func validateDiskConfiguration(disk *v1.Disk, name string) []metav1.StatusCause
 	return nil
 }
 
+// validateExternalPolicy consults the external policy services on creation and on changes of the VM spec.
+func (admitter *VMsAdmitter) validateExternalPolicy(ctx context.Context, ar *admissionv1.AdmissionRequest, vm *v1.VirtualMachine) []metav1.StatusCause {
+	if ar.Operation == admissionv1.Update {
+		oldVM := &v1.VirtualMachine{}
+		if err := json.Unmarshal(ar.OldObject.Raw, oldVM); err != nil {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeUnexpectedServerResponse,
+				Message: "Could not fetch old VM",
+			}}
+		}
+		if equality.Semantic.DeepEqual(oldVM.Spec, vm.Spec) {
+			return nil
+		}
+	}
+
+	if err := externalpolicy.NewReviewer(admitter.ClusterConfig).ReviewVirtualMachine(ctx, string(ar.Operation), vm); err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: err.Error(),
+			Field:   k8sfield.NewPath("spec").String(),
+		}}
+	}
+	return nil
+}
+
 func validateRestoreStatus(ar *admissionv1.AdmissionRequest, vm *v1.VirtualMachine) []metav1.StatusCause {
 	if ar.Operation != admissionv1.Update || vm.Status.RestoreInProgress == nil {
 		return nil
@@ -23,9 +23,13 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	rt "runtime"
 	"strings"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -49,7 +53,9 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/externalpolicy"
 	"kubevirt.io/kubevirt/pkg/instancetype"
+	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -2308,6 +2314,193 @@ var _ = Describe("Validating VM Admitter", func() {
 		})
 	})
 
+	Context("with start dependencies", func() {
+		var vm *v1.VirtualMachine
+
+		BeforeEach(func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vm = &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: &runStrategyHalted,
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		It("should reject the VM if the feature gate isn't enabled", func() {
+			vm.Spec.StartDependencies = []v1.StartDependency{{Kind: v1.StartDependencyService, Name: "license-server"}}
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   "spec.startDependencies",
+				Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.VMStartDependenciesGate),
+			}))
+		})
+
+		It("should accept valid dependencies", func() {
+			enableFeatureGate(virtconfig.VMStartDependenciesGate)
+			vm.Spec.StartDependencies = []v1.StartDependency{
+				{Kind: v1.StartDependencyPersistentVolumeClaim, Name: "data", Timeout: &metav1.Duration{Duration: time.Minute}},
+				{Kind: v1.StartDependencyNetworkAttachmentDefinition, Name: "default/bridge"},
+				{Kind: v1.StartDependencyService, Name: "license-server"},
+			}
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject", func(dependency v1.StartDependency, field string) {
+			enableFeatureGate(virtconfig.VMStartDependenciesGate)
+			vm.Spec.StartDependencies = []v1.StartDependency{dependency}
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("an empty name", v1.StartDependency{Kind: v1.StartDependencyPersistentVolumeClaim}, "spec.startDependencies[0].name"),
+			Entry("a NetworkAttachmentDefinition without name", v1.StartDependency{Kind: v1.StartDependencyNetworkAttachmentDefinition, Name: "default/"}, "spec.startDependencies[0].name"),
+			Entry("a negative timeout", v1.StartDependency{Kind: v1.StartDependencyService, Name: "svc", Timeout: &metav1.Duration{Duration: -time.Second}}, "spec.startDependencies[0].timeout"),
+		)
+	})
+
+	Context("with a lease", func() {
+		var vm *v1.VirtualMachine
+
+		BeforeEach(func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vm = &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: &runStrategyHalted,
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		It("should reject the VM if the feature gate isn't enabled", func() {
+			vm.Spec.Lease = &v1.VirtualMachineLease{ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour))}
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   "spec.lease",
+				Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.VMLeaseGate),
+			}))
+		})
+
+		It("should accept a valid lease", func() {
+			enableFeatureGate(virtconfig.VMLeaseGate)
+			vm.Spec.Lease = &v1.VirtualMachineLease{
+				ExpiresAt:     metav1.NewTime(time.Now().Add(time.Hour)),
+				Action:        v1.VirtualMachineLeaseActionDelete,
+				WarningPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+			}
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject", func(lease v1.VirtualMachineLease, field string) {
+			enableFeatureGate(virtconfig.VMLeaseGate)
+			vm.Spec.Lease = &lease
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("an unknown action", v1.VirtualMachineLease{ExpiresAt: metav1.Now(), Action: "Pause"}, "spec.lease.action"),
+			Entry("a negative warning period", v1.VirtualMachineLease{ExpiresAt: metav1.Now(), WarningPeriod: &metav1.Duration{Duration: -time.Minute}}, "spec.lease.warningPeriod"),
+		)
+	})
+
+	Context("with external policy services", func() {
+		var vm *v1.VirtualMachine
+		var reviews int
+
+		setPolicyService := func(response externalpolicy.ReviewResponse) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				reviews++
+				Expect(json.NewEncoder(w).Encode(response)).To(Succeed())
+			}))
+			DeferCleanup(server.Close)
+
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kv.Spec.Configuration.ExternalPolicyServices = []v1.ExternalPolicyService{{
+				Name:     "zones",
+				URL:      server.URL,
+				CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+				Hooks:    []v1.ExternalPolicyHook{v1.ExternalPolicyHookVirtualMachineAdmission},
+			}}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+			enableFeatureGate(virtconfig.ExternalPolicyGate)
+		}
+
+		BeforeEach(func() {
+			reviews = 0
+			vmi := api.NewMinimalVMI("testvmi")
+			vm = &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: &runStrategyHalted,
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kv.Spec.Configuration.ExternalPolicyServices = nil
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+			disableFeatureGates()
+		})
+
+		It("should accept the VM if the service allows it", func() {
+			setPolicyService(externalpolicy.ReviewResponse{Allowed: true})
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(reviews).To(Equal(1))
+		})
+
+		It("should reject the VM if the service denies it", func() {
+			setPolicyService(externalpolicy.ReviewResponse{Allowed: false, Message: "zone not permitted"})
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   "spec",
+				Message: "external policy service zones denied the request: zone not permitted",
+			}))
+		})
+
+		It("should not consult the service if an update doesn't change the spec", func() {
+			setPolicyService(externalpolicy.ReviewResponse{Allowed: false})
+			vmBytes, err := json.Marshal(vm)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmsAdmitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: admissionv1.Update,
+					Resource:  webhooks.VirtualMachineGroupVersionResource,
+					Object:    runtime.RawExtension{Raw: vmBytes},
+					OldObject: runtime.RawExtension{Raw: vmBytes},
+				},
+			})
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(reviews).To(BeZero())
+		})
+	})
+
 	It("should raise a warning when Deprecated API is used", func() {
 		const testsFGName = "test-deprecated"
 		deprecation.RegisterFeatureGate(deprecation.FeatureGate{
@@ -2336,6 +2529,15 @@ var _ = Describe("Validating VM Admitter", func() {
 			HavePrefix("feature gate test-deprecated is deprecated"),
 			HavePrefix("spec.running is deprecated, please use spec.runStrategy instead.")))
 	})
+
+	It("should raise a warning when best practices are not followed", func() {
+		vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithContainerSATADisk("disk0", "image")))
+
+		resp := admitVm(vmsAdmitter, vm)
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Warnings).To(ConsistOf(
+			HavePrefix("spec.template.spec.domain.devices.disks[0].disk.bus: the sata bus is slower than virtio")))
+	})
 })
 
 func admitVm(admitter *VMsAdmitter, vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
@@ -75,6 +75,10 @@ func ServeVMExports(resp http.ResponseWriter, req *http.Request, clusterConfig *
 	validating_webhooks.Serve(resp, req, admitters.NewVMExportAdmitter(clusterConfig))
 }
 
+func ServeConsoleAccessGrants(resp http.ResponseWriter, req *http.Request) {
+	validating_webhooks.Serve(resp, req, admitters.NewConsoleAccessGrantAdmitter())
+}
+
 func ServeVmInstancetypes(resp http.ResponseWriter, req *http.Request) {
 	validating_webhooks.Serve(resp, req, &admitters.InstancetypeAdmitter{})
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strings"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -348,6 +349,29 @@ var _ = Describe("test configuration", func() {
 		Entry("is unset, GetMaxHotplugRatio should return the default", 0, virtconfig.DefaultMaxHotplugRatio),
 	)
 
+	It("should default unset storage health check fields", func() {
+		policy := v1.StorageDegradedPolicyPause
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			StorageHealthCheck: &v1.StorageHealthCheckConfiguration{
+				Interval:       &metav1.Duration{Duration: 5 * time.Second},
+				DegradedPolicy: &policy,
+			},
+		})
+		config := clusterConfig.GetStorageHealthCheckConfiguration()
+		Expect(config.Interval.Duration).To(Equal(5 * time.Second))
+		Expect(config.Timeout.Duration).To(Equal(virtconfig.DefaultStorageHealthCheckTimeout))
+		Expect(config.LatencyThreshold.Duration).To(Equal(virtconfig.DefaultStorageHealthCheckLatencyThreshold))
+		Expect(*config.FailureThreshold).To(Equal(virtconfig.DefaultStorageHealthCheckFailureThreshold))
+		Expect(*config.DegradedPolicy).To(Equal(v1.StorageDegradedPolicyPause))
+	})
+
+	It("should return the default storage health check configuration when unset", func() {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		config := clusterConfig.GetStorageHealthCheckConfiguration()
+		Expect(config.Interval.Duration).To(Equal(virtconfig.DefaultStorageHealthCheckInterval))
+		Expect(*config.DegradedPolicy).To(Equal(v1.StorageDegradedPolicyNone))
+	})
+
 	// deprecated
 	DescribeTable(" when supportedGuestAgentVersions", func(value []string, result []string) {
 		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
@@ -809,4 +833,95 @@ var _ = Describe("test configuration", func() {
 		Entry("reference when FG set andInstancetypeConfiguration.ReferencePolicy is reference", &v1.InstancetypeConfiguration{ReferencePolicy: pointer.P(v1.Reference)}, enableInstancetypeReferencePolicyFG, v1.Reference),
 		Entry("expand when FG set andInstancetypeConfiguration.ReferencePolicy is expand", &v1.InstancetypeConfiguration{ReferencePolicy: pointer.P(v1.Expand)}, enableInstancetypeReferencePolicyFG, v1.Expand),
 	)
+
+	DescribeTable("GetHousekeepingCPUs should return", func(featureGates []string, nodeLabels map[string]string, expectedCPUs string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+			VirtualizationInfraReservation: &v1.VirtualizationInfraReservation{CPUs: "0-1"},
+			InfraThreadsPinning: &v1.InfraThreadsPinning{
+				HousekeepingCPUs: []v1.HousekeepingCPUPool{
+					{Name: "infra", NodeSelector: map[string]string{"pool": "infra"}, CPUs: "2-3"},
+					{Name: "any-infra", NodeSelector: map[string]string{"role": "infra"}, CPUs: "4-5"},
+				},
+			},
+		})
+		Expect(clusterConfig.GetHousekeepingCPUs(nodeLabels)).To(Equal(expectedCPUs))
+	},
+		Entry("the CPUs of the first matching pool",
+			[]string{virtconfig.InfraThreadsPinningGate, virtconfig.VirtualizationInfraReservationGate},
+			map[string]string{"pool": "infra", "role": "infra"}, "2-3"),
+		Entry("the reserved CPUs when no pool matches",
+			[]string{virtconfig.InfraThreadsPinningGate, virtconfig.VirtualizationInfraReservationGate},
+			map[string]string{"pool": "other"}, "0-1"),
+		Entry("the reserved CPUs when the InfraThreadsPinning FG is unset",
+			[]string{virtconfig.VirtualizationInfraReservationGate},
+			map[string]string{"pool": "infra"}, "0-1"),
+		Entry("no CPUs when no FG is set", nil, map[string]string{"pool": "infra"}, ""),
+	)
+
+	DescribeTable("GetHugepagesPools should return", func(featureGates []string, nodeLabels map[string]string, expectedPools []string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+			HugepagesPools: []v1.HugepagesPool{
+				{Name: "large-1g", NodeSelector: map[string]string{"pool": "large"}, PageSize: "1Gi", Max: 64},
+				{Name: "default-1g", PageSize: "1Gi", Max: 8},
+				{Name: "default-2m", PageSize: "2Mi", Min: 512, Max: 4096},
+			},
+		})
+		var pools []string
+		for _, pool := range clusterConfig.GetHugepagesPools(nodeLabels) {
+			pools = append(pools, pool.Name)
+		}
+		Expect(pools).To(Equal(expectedPools))
+	},
+		Entry("the first matching pool of each page size",
+			[]string{virtconfig.HugepagesPoolManagementGate}, map[string]string{"pool": "large"}, []string{"large-1g", "default-2m"}),
+		Entry("the pools selecting all the nodes",
+			[]string{virtconfig.HugepagesPoolManagementGate}, map[string]string{"pool": "small"}, []string{"default-1g", "default-2m"}),
+		Entry("no pool when the FG is unset", nil, map[string]string{"pool": "large"}, nil),
+	)
+
+	DescribeTable("GetLauncherImageOverride should return", func(featureGates []string, podNodeSelector map[string]string, expectedImage string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+			LauncherImageOverrides: []v1.LauncherImageOverride{
+				{Name: "canary", NodeSelector: map[string]string{"pool": "large", "canary": "true"}, Image: "registry:5000/kubevirt/virt-launcher:canary"},
+				{Name: "large", NodeSelector: map[string]string{"pool": "large"}, Image: "registry:5000/kubevirt/virt-launcher:pinned"},
+			},
+		})
+		image := ""
+		if override := clusterConfig.GetLauncherImageOverride(podNodeSelector); override != nil {
+			image = override.Image
+		}
+		Expect(image).To(Equal(expectedImage))
+	},
+		Entry("the first override the node selector includes",
+			[]string{virtconfig.LauncherImageOverridesGate}, map[string]string{"pool": "large", "canary": "true", "cpu": "x86"}, "registry:5000/kubevirt/virt-launcher:canary"),
+		Entry("an override with less labels",
+			[]string{virtconfig.LauncherImageOverridesGate}, map[string]string{"pool": "large"}, "registry:5000/kubevirt/virt-launcher:pinned"),
+		Entry("no override when the node selector doesn't include any",
+			[]string{virtconfig.LauncherImageOverridesGate}, map[string]string{"pool": "small"}, ""),
+		Entry("no override when the FG is unset", nil, map[string]string{"pool": "large"}, ""),
+	)
+
+	DescribeTable("AdmissionWarningEnabled should return", func(config *v1.AdmissionWarningsConfiguration, expected bool) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			AdmissionWarnings: config,
+		})
+		Expect(clusterConfig.AdmissionWarningEnabled(v1.AdmissionWarningSATABus)).To(Equal(expected))
+	},
+		Entry("true without configuration", nil, true),
+		Entry("true when other warnings are disabled", &v1.AdmissionWarningsConfiguration{
+			Disabled: []v1.AdmissionWarning{v1.AdmissionWarningDedicatedCPUsWithoutEvictionStrategy},
+		}, true),
+		Entry("false when the warning is disabled", &v1.AdmissionWarningsConfiguration{
+			Disabled: []v1.AdmissionWarning{v1.AdmissionWarningSATABus},
+		}, false),
+	)
 })
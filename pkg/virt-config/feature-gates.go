@@ -80,6 +80,129 @@ const (
 	// InstancetypeReferencePolicy allows a cluster admin to control how a VirtualMachine references instance types and preferences
 	// through the kv.spec.configuration.instancetype.referencePolicy configurable.
 	InstancetypeReferencePolicy = "InstancetypeReferencePolicy"
+
+	// StorageHealthCheckGate enables virt-handler to periodically probe the storage backing the volumes of
+	// running VMIs and to report degraded backends on the VMI status.
+	StorageHealthCheckGate = "StorageHealthCheck"
+
+	// ChannelHotplugGate allows to define additional virtio-serial channels on VMIs and to hot plug
+	// them into running VMIs through the addchannel subresource.
+	ChannelHotplugGate = "ChannelHotplug"
+
+	// VMStartDependenciesGate allows VirtualMachines to delay their start until the resources listed
+	// in spec.startDependencies are ready.
+	VMStartDependenciesGate = "VMStartDependencies"
+
+	// ExternalPolicyGate enables the consultation of the external policy services registered in the
+	// KubeVirt configuration at VM admission and migration target selection.
+	ExternalPolicyGate = "ExternalPolicy"
+
+	// LicenseTrackingGate enables the host core accounting of VMIs labeled with kubevirt.io/license-group
+	// and pins them to the nodes of their license group.
+	LicenseTrackingGate = "LicenseTracking"
+
+	// TenantNodePoolsGate enables the enforcement of the tenant node pools defined in the KubeVirt
+	// configuration on the launcher pods.
+	TenantNodePoolsGate = "TenantNodePools"
+
+	// InfraDetailsRedactionGate redacts node names and host interface names from the events and
+	// conditions of VMIs, keeping the full messages in the kubevirt.io/infra-details annotation.
+	InfraDetailsRedactionGate = "InfraDetailsRedaction"
+
+	// HookSidecarIdentityGate makes virt-launcher verify the identity of the hook sidecars with the
+	// peer credentials of their sockets and a token minted for every sidecar.
+	HookSidecarIdentityGate = "HookSidecarIdentity"
+
+	// FaultInjectionGate allows VMIs to request injected faults, in their hook sidecars, migrations
+	// and storage, with the faultinjection.kubevirt.io/faults annotation.
+	FaultInjectionGate = "FaultInjection"
+
+	// DryRunVirtualizationGate makes virt-launcher run no guest but a placeholder domain going
+	// through the lifecycle of a real one, so that KubeVirt can run on nodes without /dev/kvm.
+	DryRunVirtualizationGate = "DryRunVirtualization"
+
+	// CrossArchitectureEmulationGate allows running the guest of a VMI emulated with TCG on nodes of
+	// another architecture, e.g. arm64 guests on amd64 nodes
+	CrossArchitectureEmulationGate = "CrossArchitectureEmulation"
+
+	// VirtualizationInfraReservationGate enforces the node CPUs and memory reserved for the emulator
+	// threads, iothreads and vhost kernel threads of the VMIs in the KubeVirt CR
+	VirtualizationInfraReservationGate = "VirtualizationInfraReservation"
+
+	// InfraThreadsPinningGate enforces the cluster wide pinning policy of the emulator threads and
+	// iothreads of the VMIs in the KubeVirt CR
+	InfraThreadsPinningGate = "InfraThreadsPinning"
+
+	// CgroupWeightsGate allows setting the cgroup v2 CPU and IO weights of the virt-launcher pods
+	// in the VMI spec
+	CgroupWeightsGate = "CgroupWeights"
+
+	// HugepagesPoolManagementGate lets virt-handler resize the hugepage pools of the nodes, within
+	// the bounds set in the KubeVirt CR, to fit the pending VMIs requesting hugepages
+	HugepagesPoolManagementGate = "HugepagesPoolManagement"
+
+	// VMForkGate allows forking VMIs: restoring them from the memory and disks saved from a paused
+	// parent VMI, in place of booting them
+	VMForkGate = "VMFork"
+
+	// LauncherReattachGate restarts a crashed virt-launcher, which reattaches to the domain still
+	// running in its pod instead of the VMI failing
+	LauncherReattachGate = "LauncherReattach"
+
+	// VirtHandlerHandoverGate rolls virt-handler out by surging: the outgoing virt-handler of a node
+	// hands its state over to the incoming one instead of stopping first
+	VirtHandlerHandoverGate = "VirtHandlerHandover"
+
+	// LauncherImageOverridesGate pins the virt-launcher image of the VMIs placed on node pools to the
+	// images set in the KubeVirt CR, to roll QEMU and libvirt out pool by pool
+	LauncherImageOverridesGate = "LauncherImageOverrides"
+
+	// MaintenanceNotificationsGate allows VMIs to get notified of their migrations ahead of them,
+	// through a virtio serial port
+	MaintenanceNotificationsGate = "MaintenanceNotifications"
+
+	// VolumeFlattenGate allows flattening the backing chains of the ephemeral volumes of running VMIs
+	// through the flatten subresource
+	VolumeFlattenGate = "VolumeFlatten"
+
+	// ShutdownPolicyGate allows VMIs to run a pre-shutdown command in the guest and to bound the phases
+	// of their graceful shutdown
+	ShutdownPolicyGate = "ShutdownPolicy"
+
+	// VMLeaseGate allows VMs to be stopped or deleted once their lease expired
+	VMLeaseGate = "VMLease"
+
+	// HostDeviceInventoryGate makes virt-handler report the host devices of its node in a
+	// HostDeviceInventory
+	HostDeviceInventoryGate = "HostDeviceInventory"
+
+	// AutoInferPreferenceGate makes virt-api infer the preference of the VMs created without one
+	// from the labels of their boot volume
+	AutoInferPreferenceGate = "AutoInferPreference"
+
+	// VMIdentityDocumentsGate makes KubeVirt issue signed identity documents to the VMIs asking for
+	// them, which virt-api verifies on behalf of external services
+	VMIdentityDocumentsGate = "VMIdentityDocuments"
+
+	// SecondaryNetworkServicesGate makes virt-controller publish the addresses of the VMIs on a
+	// secondary network as the endpoints of the Services asking for them
+	SecondaryNetworkServicesGate = "SecondaryNetworkServices"
+
+	// VMGatewayRoutesGate makes virt-controller expose the guest ports of the VMs asking for it
+	// through Gateway API routes of the configured Gateway
+	VMGatewayRoutesGate = "VMGatewayRoutes"
+
+	// LiveCPUResourcesGate lets the CPU requests and limits of running VMs without dedicated CPUs be
+	// changed without a restart, virt-handler applies them to the cgroups of the virt-launcher pod
+	LiveCPUResourcesGate = "LiveCPUResources"
+
+	// VMSearchGate makes virt-api serve the search subresource, looking up the VMIs by label, guest
+	// OS, IP and MAC address in an index of the VMIs of the cluster
+	VMSearchGate = "VMSearch"
+
+	// SharedConsoleGate lets several users attach to the same serial console of a VMI, in read-only
+	// or read-write roles, through a session multiplexed by virt-api
+	SharedConsoleGate = "SharedConsole"
 )
 
 func (config *ClusterConfig) isFeatureGateEnabled(featureGate string) bool {
@@ -239,3 +362,127 @@ func (config *ClusterConfig) VolumeMigrationEnabled() bool {
 func (config *ClusterConfig) NodeRestrictionEnabled() bool {
 	return config.isFeatureGateEnabled(NodeRestrictionGate)
 }
+
+func (config *ClusterConfig) StorageHealthCheckEnabled() bool {
+	return config.isFeatureGateEnabled(StorageHealthCheckGate)
+}
+
+func (config *ClusterConfig) ChannelHotplugEnabled() bool {
+	return config.isFeatureGateEnabled(ChannelHotplugGate)
+}
+
+func (config *ClusterConfig) VMStartDependenciesEnabled() bool {
+	return config.isFeatureGateEnabled(VMStartDependenciesGate)
+}
+
+func (config *ClusterConfig) ExternalPolicyEnabled() bool {
+	return config.isFeatureGateEnabled(ExternalPolicyGate)
+}
+
+func (config *ClusterConfig) LicenseTrackingEnabled() bool {
+	return config.isFeatureGateEnabled(LicenseTrackingGate)
+}
+
+func (config *ClusterConfig) TenantNodePoolsEnabled() bool {
+	return config.isFeatureGateEnabled(TenantNodePoolsGate)
+}
+
+func (config *ClusterConfig) InfraDetailsRedactionEnabled() bool {
+	return config.isFeatureGateEnabled(InfraDetailsRedactionGate)
+}
+
+func (config *ClusterConfig) HookSidecarIdentityEnabled() bool {
+	return config.isFeatureGateEnabled(HookSidecarIdentityGate)
+}
+
+func (config *ClusterConfig) FaultInjectionEnabled() bool {
+	return config.isFeatureGateEnabled(FaultInjectionGate)
+}
+
+func (config *ClusterConfig) DryRunVirtualizationEnabled() bool {
+	return config.isFeatureGateEnabled(DryRunVirtualizationGate)
+}
+
+func (config *ClusterConfig) CrossArchitectureEmulationEnabled() bool {
+	return config.isFeatureGateEnabled(CrossArchitectureEmulationGate)
+}
+
+func (config *ClusterConfig) VirtualizationInfraReservationEnabled() bool {
+	return config.isFeatureGateEnabled(VirtualizationInfraReservationGate)
+}
+
+func (config *ClusterConfig) InfraThreadsPinningEnabled() bool {
+	return config.isFeatureGateEnabled(InfraThreadsPinningGate)
+}
+
+func (config *ClusterConfig) CgroupWeightsEnabled() bool {
+	return config.isFeatureGateEnabled(CgroupWeightsGate)
+}
+
+func (config *ClusterConfig) HugepagesPoolManagementEnabled() bool {
+	return config.isFeatureGateEnabled(HugepagesPoolManagementGate)
+}
+
+func (config *ClusterConfig) VMForkEnabled() bool {
+	return config.isFeatureGateEnabled(VMForkGate)
+}
+
+func (config *ClusterConfig) LauncherReattachEnabled() bool {
+	return config.isFeatureGateEnabled(LauncherReattachGate)
+}
+
+func (config *ClusterConfig) VirtHandlerHandoverEnabled() bool {
+	return config.isFeatureGateEnabled(VirtHandlerHandoverGate)
+}
+
+func (config *ClusterConfig) LauncherImageOverridesEnabled() bool {
+	return config.isFeatureGateEnabled(LauncherImageOverridesGate)
+}
+
+func (config *ClusterConfig) MaintenanceNotificationsEnabled() bool {
+	return config.isFeatureGateEnabled(MaintenanceNotificationsGate)
+}
+
+func (config *ClusterConfig) VolumeFlattenEnabled() bool {
+	return config.isFeatureGateEnabled(VolumeFlattenGate)
+}
+
+func (config *ClusterConfig) ShutdownPolicyEnabled() bool {
+	return config.isFeatureGateEnabled(ShutdownPolicyGate)
+}
+
+func (config *ClusterConfig) VMLeaseEnabled() bool {
+	return config.isFeatureGateEnabled(VMLeaseGate)
+}
+
+func (config *ClusterConfig) HostDeviceInventoryEnabled() bool {
+	return config.isFeatureGateEnabled(HostDeviceInventoryGate)
+}
+
+func (config *ClusterConfig) AutoInferPreferenceEnabled() bool {
+	return config.isFeatureGateEnabled(AutoInferPreferenceGate)
+}
+
+func (config *ClusterConfig) VMIdentityDocumentsEnabled() bool {
+	return config.isFeatureGateEnabled(VMIdentityDocumentsGate)
+}
+
+func (config *ClusterConfig) SecondaryNetworkServicesEnabled() bool {
+	return config.isFeatureGateEnabled(SecondaryNetworkServicesGate)
+}
+
+func (config *ClusterConfig) VMGatewayRoutesEnabled() bool {
+	return config.isFeatureGateEnabled(VMGatewayRoutesGate)
+}
+
+func (config *ClusterConfig) LiveCPUResourcesEnabled() bool {
+	return config.isFeatureGateEnabled(LiveCPUResourcesGate)
+}
+
+func (config *ClusterConfig) VMSearchEnabled() bool {
+	return config.isFeatureGateEnabled(VMSearchGate)
+}
+
+func (config *ClusterConfig) SharedConsoleEnabled() bool {
+	return config.isFeatureGateEnabled(SharedConsoleGate)
+}
@@ -24,12 +24,16 @@ package virtconfig
 */
 
 import (
+	"slices"
 	"strings"
+	"time"
 
 	"kubevirt.io/client-go/log"
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	v1 "kubevirt.io/api/core/v1"
 )
@@ -89,6 +93,14 @@ const (
 
 	DefaultMaxHotplugRatio   = 4
 	DefaultVMRolloutStrategy = v1.VMRolloutStrategyStage
+
+	DefaultStorageHealthCheckInterval                = 30 * time.Second
+	DefaultStorageHealthCheckTimeout                 = 10 * time.Second
+	DefaultStorageHealthCheckLatencyThreshold        = 2 * time.Second
+	DefaultStorageHealthCheckFailureThreshold uint32 = 3
+
+	// MaxVirtualizationInfraReservedCPUs is the maximum number of CPUs reserved for the virtualization infrastructure
+	MaxVirtualizationInfraReservedCPUs = 1024
 )
 
 func IsAMD64(arch string) bool {
@@ -222,6 +234,26 @@ func (c *ClusterConfig) IsBridgeInterfaceOnPodNetworkEnabled() bool {
 	return *c.GetConfig().NetworkConfiguration.PermitBridgeInterfaceOnPodNetwork
 }
 
+// GetPreferredIPFamily returns the IP family preferred for the migration and export endpoints,
+// or an empty family when the primary IP family of the cluster should be used
+func (c *ClusterConfig) GetPreferredIPFamily() k8sv1.IPFamily {
+	if family := c.GetConfig().NetworkConfiguration.PreferredIPFamily; family != nil {
+		return *family
+	}
+	return ""
+}
+
+// GetGateway returns the Gateway the routes of the VMs are bound to, or nil when none is configured
+func (c *ClusterConfig) GetGateway() *v1.GatewayReference {
+	return c.GetConfig().NetworkConfiguration.Gateway
+}
+
+// FIPSModeEnabled returns true when the TLS endpoints are restricted to FIPS approved settings
+func (c *ClusterConfig) FIPSModeEnabled() bool {
+	tlsConfiguration := c.GetConfig().TLSConfiguration
+	return tlsConfiguration != nil && tlsConfiguration.FIPSMode
+}
+
 func (c *ClusterConfig) GetDefaultClusterConfig() *v1.KubeVirtConfiguration {
 	return c.defaultConfig
 }
@@ -493,3 +525,140 @@ func (c *ClusterConfig) GetInstancetypeReferencePolicy() v1.InstancetypeReferenc
 	}
 	return policy
 }
+
+// GetLicenseGroup returns the license group with the given name, or nil if it isn't configured or license tracking is disabled
+func (c *ClusterConfig) GetLicenseGroup(name string) *v1.LicenseGroup {
+	if name == "" || !c.LicenseTrackingEnabled() {
+		return nil
+	}
+	for _, group := range c.GetConfig().LicenseGroups {
+		if group.Name == name {
+			return group.DeepCopy()
+		}
+	}
+	return nil
+}
+
+// GetTenantNodePools returns the tenant node pools, or nil if their enforcement is disabled
+func (c *ClusterConfig) GetTenantNodePools() []v1.TenantNodePool {
+	if !c.TenantNodePoolsEnabled() {
+		return nil
+	}
+	return c.GetConfig().TenantNodePools
+}
+
+// GetStorageHealthCheckConfiguration returns the storage heartbeat configuration with all unset fields defaulted
+func (c *ClusterConfig) GetStorageHealthCheckConfiguration() *v1.StorageHealthCheckConfiguration {
+	config := &v1.StorageHealthCheckConfiguration{}
+	if current := c.GetConfig().StorageHealthCheck; current != nil {
+		config = current.DeepCopy()
+	}
+	if config.Interval == nil {
+		config.Interval = &metav1.Duration{Duration: DefaultStorageHealthCheckInterval}
+	}
+	if config.Timeout == nil {
+		config.Timeout = &metav1.Duration{Duration: DefaultStorageHealthCheckTimeout}
+	}
+	if config.LatencyThreshold == nil {
+		config.LatencyThreshold = &metav1.Duration{Duration: DefaultStorageHealthCheckLatencyThreshold}
+	}
+	if config.FailureThreshold == nil {
+		failureThreshold := DefaultStorageHealthCheckFailureThreshold
+		config.FailureThreshold = &failureThreshold
+	}
+	if config.DegradedPolicy == nil {
+		policy := v1.StorageDegradedPolicyNone
+		config.DegradedPolicy = &policy
+	}
+	return config
+}
+
+// GetVirtualizationInfraReservation returns the node resources reserved for the virtualization infrastructure,
+// or nil if none are reserved or their enforcement is disabled
+func (c *ClusterConfig) GetVirtualizationInfraReservation() *v1.VirtualizationInfraReservation {
+	if !c.VirtualizationInfraReservationEnabled() {
+		return nil
+	}
+	return c.GetConfig().VirtualizationInfraReservation
+}
+
+// GetInfraThreadsPinning returns the cluster wide pinning policy of the infrastructure threads,
+// or nil if none is set or its enforcement is disabled
+func (c *ClusterConfig) GetInfraThreadsPinning() *v1.InfraThreadsPinning {
+	if !c.InfraThreadsPinningEnabled() {
+		return nil
+	}
+	return c.GetConfig().InfraThreadsPinning
+}
+
+// GetHousekeepingCPUs returns the CPUs the infrastructure threads of the VMIs without dedicated CPUs
+// are confined to on a node with the given labels: the ones of the first matching housekeeping pool,
+// else the reserved ones. It returns an empty string if no CPU applies.
+func (c *ClusterConfig) GetHousekeepingCPUs(nodeLabels map[string]string) string {
+	if pinning := c.GetInfraThreadsPinning(); pinning != nil {
+		for _, pool := range pinning.HousekeepingCPUs {
+			if labels.SelectorFromSet(pool.NodeSelector).Matches(labels.Set(nodeLabels)) {
+				return pool.CPUs
+			}
+		}
+	}
+	if reservation := c.GetVirtualizationInfraReservation(); reservation != nil {
+		return reservation.CPUs
+	}
+	return ""
+}
+
+// GetHugepagesPools returns the hugepage pools virt-handler manages on a node with the given labels,
+// the first matching pool of each page size. It returns nil if their management is disabled.
+func (c *ClusterConfig) GetHugepagesPools(nodeLabels map[string]string) []v1.HugepagesPool {
+	if !c.HugepagesPoolManagementEnabled() {
+		return nil
+	}
+	var pools []v1.HugepagesPool
+	pageSizes := map[string]bool{}
+	for _, pool := range c.GetConfig().HugepagesPools {
+		if pageSizes[pool.PageSize] || !labels.SelectorFromSet(pool.NodeSelector).Matches(labels.Set(nodeLabels)) {
+			continue
+		}
+		pageSizes[pool.PageSize] = true
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+// GetLauncherImageOverride returns the override pinning the virt-launcher image of the pods with the
+// given node selector, the first one whose node selector it includes. It returns nil if there is none
+// or the overrides are disabled.
+func (c *ClusterConfig) GetLauncherImageOverride(podNodeSelector map[string]string) *v1.LauncherImageOverride {
+	if !c.LauncherImageOverridesEnabled() {
+		return nil
+	}
+	for i, override := range c.GetConfig().LauncherImageOverrides {
+		if len(override.NodeSelector) > 0 && labels.SelectorFromSet(override.NodeSelector).Matches(labels.Set(podNodeSelector)) {
+			return &c.GetConfig().LauncherImageOverrides[i]
+		}
+	}
+	return nil
+}
+
+// GetLauncherImageOverrides returns the overrides pinning the virt-launcher image of node pools, nil
+// if they are disabled.
+func (c *ClusterConfig) GetLauncherImageOverrides() []v1.LauncherImageOverride {
+	if !c.LauncherImageOverridesEnabled() {
+		return nil
+	}
+	return c.GetConfig().LauncherImageOverrides
+}
+
+// AdmissionWarningEnabled returns true if the given best-practice warning is returned on the admission
+// of VMs and VMIs, i.e. it is not disabled.
+func (c *ClusterConfig) AdmissionWarningEnabled(warning v1.AdmissionWarning) bool {
+	config := c.GetConfig().AdmissionWarnings
+	return config == nil || !slices.Contains(config.Disabled, warning)
+}
+
+// GetSubresourceAccessWebhook returns the webhook authorizing the interactive accesses to VMIs, nil if
+// none is configured.
+func (c *ClusterConfig) GetSubresourceAccessWebhook() *v1.SubresourceAccessWebhook {
+	return c.GetConfig().SubresourceAccessWebhook
+}
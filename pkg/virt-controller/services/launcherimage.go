@@ -0,0 +1,97 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package services
+
+import (
+	"slices"
+	"sort"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// launcherImageOverrideNodeSelectors returns the node selectors of the node pools with a pinned
+// virt-launcher image
+func launcherImageOverrideNodeSelectors(overrides []v1.LauncherImageOverride) []map[string]string {
+	var nodeSelectors []map[string]string
+	for _, override := range overrides {
+		if len(override.NodeSelector) > 0 {
+			nodeSelectors = append(nodeSelectors, override.NodeSelector)
+		}
+	}
+	return nodeSelectors
+}
+
+// modifyNodeAffinityToRejectNodeSelectors requires the nodes of the pod to match none of the node
+// selectors. A node doesn't match a selector when one of its labels differs, so each term of the
+// affinity is expanded in one term per choice of a differing label in each selector.
+func modifyNodeAffinityToRejectNodeSelectors(origAffinity *k8sv1.Affinity, nodeSelectors []map[string]string) *k8sv1.Affinity {
+	if len(nodeSelectors) == 0 {
+		return origAffinity
+	}
+
+	rejections := [][]k8sv1.NodeSelectorRequirement{{}}
+	for _, nodeSelector := range nodeSelectors {
+		keys := make([]string, 0, len(nodeSelector))
+		for key := range nodeSelector {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var expanded [][]k8sv1.NodeSelectorRequirement
+		for _, rejection := range rejections {
+			for _, key := range keys {
+				expanded = append(expanded, append(slices.Clone(rejection), k8sv1.NodeSelectorRequirement{
+					Key:      key,
+					Operator: k8sv1.NodeSelectorOpNotIn,
+					Values:   []string{nodeSelector[key]},
+				}))
+			}
+		}
+		rejections = expanded
+	}
+
+	affinity := origAffinity.DeepCopy()
+	if affinity == nil {
+		affinity = &k8sv1.Affinity{}
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &k8sv1.NodeAffinity{}
+	}
+	origTerms := []k8sv1.NodeSelectorTerm{{}}
+	if required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil && len(required.NodeSelectorTerms) > 0 {
+		origTerms = required.NodeSelectorTerms
+	}
+
+	// Since NodeSelectorTerms are ORed, each term is combined with each rejection
+	var terms []k8sv1.NodeSelectorTerm
+	for _, origTerm := range origTerms {
+		for _, rejection := range rejections {
+			term := origTerm.DeepCopy()
+			term.MatchExpressions = append(term.MatchExpressions, rejection...)
+			terms = append(terms, *term)
+		}
+	}
+	affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &k8sv1.NodeSelector{
+		NodeSelectorTerms: terms,
+	}
+	return affinity
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Launcher image overrides", func() {
+	notIn := func(key, value string) k8sv1.NodeSelectorRequirement {
+		return k8sv1.NodeSelectorRequirement{Key: key, Operator: k8sv1.NodeSelectorOpNotIn, Values: []string{value}}
+	}
+
+	It("should leave the affinity untouched without node selectors", func() {
+		affinity := &k8sv1.Affinity{PodAntiAffinity: &k8sv1.PodAntiAffinity{}}
+		Expect(modifyNodeAffinityToRejectNodeSelectors(affinity, nil)).To(BeIdenticalTo(affinity))
+	})
+
+	It("should reject a node when any of the labels of a selector differs", func() {
+		affinity := modifyNodeAffinityToRejectNodeSelectors(nil, []map[string]string{
+			{"pool": "canary", "zone": "a"},
+			{"pool": "edge"},
+		})
+		Expect(affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(
+			k8sv1.NodeSelectorTerm{MatchExpressions: []k8sv1.NodeSelectorRequirement{notIn("pool", "canary"), notIn("pool", "edge")}},
+			k8sv1.NodeSelectorTerm{MatchExpressions: []k8sv1.NodeSelectorRequirement{notIn("zone", "a"), notIn("pool", "edge")}},
+		))
+	})
+
+	It("should combine the rejections with each term of the affinity", func() {
+		origAffinity := &k8sv1.Affinity{NodeAffinity: &k8sv1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+				NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+					{MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "gpu", Operator: k8sv1.NodeSelectorOpExists}}},
+					{MatchFields: []k8sv1.NodeSelectorRequirement{{Key: "metadata.name", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"node01"}}}},
+				},
+			},
+		}}
+		affinity := modifyNodeAffinityToRejectNodeSelectors(origAffinity, []map[string]string{{"pool": "canary"}})
+		Expect(affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(
+			k8sv1.NodeSelectorTerm{MatchExpressions: []k8sv1.NodeSelectorRequirement{
+				{Key: "gpu", Operator: k8sv1.NodeSelectorOpExists}, notIn("pool", "canary"),
+			}},
+			k8sv1.NodeSelectorTerm{
+				MatchExpressions: []k8sv1.NodeSelectorRequirement{notIn("pool", "canary")},
+				MatchFields:      []k8sv1.NodeSelectorRequirement{{Key: "metadata.name", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"node01"}}},
+			},
+		))
+		Expect(origAffinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(HaveLen(1))
+	})
+})
@@ -22,6 +22,8 @@ type NodeSelectorRenderer struct {
 	realtimeEnabled  bool
 	sevEnabled       bool
 	sevESEnabled     bool
+	licenseSelectors map[string]string
+	tenantSelectors  map[string]string
 }
 
 type NodeSelectorRendererOption func(renderer *NodeSelectorRenderer)
@@ -73,6 +75,8 @@ func (nsr *NodeSelectorRenderer) Render() map[string]string {
 	if nsr.sevESEnabled {
 		nsr.enableSelectorLabel(v1.SEVESLabel)
 	}
+	maps.Copy(nsr.podNodeSelectors, nsr.licenseSelectors)
+	maps.Copy(nsr.podNodeSelectors, nsr.tenantSelectors)
 
 	return nsr.podNodeSelectors
 }
@@ -121,6 +125,18 @@ func WithModelAndFeatureLabels(modelLabel string, cpuFeatureLabels ...string) No
 	}
 }
 
+func WithLicenseGroup(group *v1.LicenseGroup) NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.licenseSelectors = group.NodeSelector
+	}
+}
+
+func WithTenantNodePool(pool *v1.TenantNodePool) NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.tenantSelectors = pool.NodeSelector
+	}
+}
+
 func WithTSCTimer(tscFrequency *int64) NodeSelectorRendererOption {
 	return func(renderer *NodeSelectorRenderer) {
 		renderer.tscFrequency = tscFrequency
@@ -126,6 +126,42 @@ var _ = Describe("Node Selector Renderer", func() {
 			})
 		})
 
+		When("a license group is defined", func() {
+			BeforeEach(func() {
+				nsr = NewNodeSelectorRenderer(
+					selectors(selector{key: "licensed", value: "false"}),
+					emptySelectors(),
+					"",
+					WithLicenseGroup(&v1.LicenseGroup{Name: "sql", NodeSelector: map[string]string{"licensed": "sql"}}))
+			})
+
+			It("the node selector of the license group takes precedence over the user defined selector", func() {
+				Expect(nsr.Render()).To(
+					Equal(map[string]string{
+						"kubevirt.io/schedulable": "true",
+						"licensed":                "sql",
+					}))
+			})
+		})
+
+		When("a tenant node pool applies", func() {
+			BeforeEach(func() {
+				nsr = NewNodeSelectorRenderer(
+					selectors(selector{key: "pool", value: "shared"}),
+					emptySelectors(),
+					"",
+					WithTenantNodePool(&v1.TenantNodePool{Name: "tenant-a", NodeSelector: map[string]string{"pool": "tenant-a"}}))
+			})
+
+			It("the node selector of the pool takes precedence over the user defined selector", func() {
+				Expect(nsr.Render()).To(
+					Equal(map[string]string{
+						"kubevirt.io/schedulable": "true",
+						"pool":                    "tenant-a",
+					}))
+			})
+		})
+
 		When("cluster-wide selectors are present", func() {
 			BeforeEach(func() {
 				nsr = NewNodeSelectorRenderer(
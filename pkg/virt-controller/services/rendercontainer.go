@@ -98,6 +98,15 @@ func WithCapabilities(vmi *v1.VirtualMachineInstance) Option {
 	}
 }
 
+func WithAddedCapabilities(capabilities ...k8sv1.Capability) Option {
+	return func(renderer *ContainerSpecRenderer) {
+		if renderer.capabilities == nil {
+			renderer.capabilities = &k8sv1.Capabilities{}
+		}
+		renderer.capabilities.Add = append(renderer.capabilities.Add, capabilities...)
+	}
+}
+
 func WithDropALLCapabilities() Option {
 	return func(renderer *ContainerSpecRenderer) {
 		if renderer.capabilities == nil {
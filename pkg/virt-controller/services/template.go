@@ -25,6 +25,7 @@ import (
 	"maps"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -38,6 +39,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/kubectl/pkg/cmd/util/podcmd"
 	v1 "kubevirt.io/api/core/v1"
+	diskcheckv1alpha1 "kubevirt.io/api/diskcheck/v1alpha1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -46,6 +48,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
+	"kubevirt.io/kubevirt/pkg/emulation"
+	"kubevirt.io/kubevirt/pkg/faultinjection"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
@@ -72,6 +76,7 @@ const (
 	virtBinDir       = "virt-bin-share-dir"
 	hotplugDisk      = "hotplug-disk"
 	virtExporter     = "virt-exporter"
+	virtDiskCheck    = "virt-disk-check"
 )
 
 const KvmDevice = "devices.kubevirt.io/kvm"
@@ -88,6 +93,7 @@ const virtiofsDebugLogs = "virtiofsdDebugLogs"
 const qemuTimeoutJitterRange = 120
 
 const (
+	CAP_NET_ADMIN        = "NET_ADMIN"
 	CAP_NET_BIND_SERVICE = "NET_BIND_SERVICE"
 	CAP_SYS_NICE         = "SYS_NICE"
 )
@@ -140,7 +146,9 @@ type TemplateService interface {
 	RenderHotplugAttachmentTriggerPodTemplate(volume *v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, pvcName string, isBlock bool, tempPod bool) (*k8sv1.Pod, error)
 	RenderLaunchManifestNoVm(*v1.VirtualMachineInstance) (*k8sv1.Pod, error)
 	RenderExporterManifest(vmExport *exportv1.VirtualMachineExport, namePrefix string) *k8sv1.Pod
+	RenderDiskCheckManifest(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck, namePrefix string) *k8sv1.Pod
 	GetLauncherImage() string
+	GetLauncherImageForPod(pod *k8sv1.Pod) string
 	IsPPC64() bool
 	IsARM64() bool
 	IsS390X() bool
@@ -261,6 +269,15 @@ func (t *templateService) GetLauncherImage() string {
 	return t.launcherImage
 }
 
+// GetLauncherImageForPod returns the virt-launcher image of a pod, pinned by the override its node
+// selector includes if any
+func (t *templateService) GetLauncherImageForPod(pod *k8sv1.Pod) string {
+	if override := t.clusterConfig.GetLauncherImageOverride(pod.Spec.NodeSelector); override != nil {
+		return override.Image
+	}
+	return t.launcherImage
+}
+
 func (t *templateService) RenderLaunchManifestNoVm(vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error) {
 	backendStoragePVCName := ""
 	if backendstorage.IsBackendStorageNeededForVMI(&vmi.Spec) {
@@ -376,6 +393,18 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	gracePeriodSeconds = gracePeriodSeconds + int64(15)
 	gracePeriodKillAfter := gracePeriodSeconds + int64(15)
 
+	tenantNodePool, err := t.tenantNodePool(vmi)
+	if err != nil {
+		return nil, err
+	}
+	nodeSelector := t.newNodeSelectorRenderer(vmi, tenantNodePool).Render()
+	launcherImageOverride := t.clusterConfig.GetLauncherImageOverride(nodeSelector)
+	launcherImage := t.launcherImage
+	if launcherImageOverride != nil {
+		log.Log.Object(vmi).V(4).Infof("Use the virt-launcher image %s of the override %s", launcherImageOverride.Image, launcherImageOverride.Name)
+		launcherImage = launcherImageOverride.Image
+	}
+
 	networkToResourceMap, err := multus.NetworkToResource(t.virtClient, vmi)
 	if err != nil {
 		return nil, err
@@ -430,10 +459,27 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		command = append(command, "--allow-emulation")
 	}
 
+	if t.clusterConfig.DryRunVirtualizationEnabled() {
+		command = append(command, "--dry-run-virtualization")
+	}
+
 	if checkForKeepLauncherAfterFailure(vmi) {
 		command = append(command, "--keep-after-failure")
 	}
 
+	if t.clusterConfig.LauncherReattachEnabled() {
+		command = append(command, "--reattach-after-crash")
+	}
+
+	hookSidecarIdentity := t.clusterConfig.HookSidecarIdentityEnabled() && len(requestedHookSidecarList) > 0
+	if hookSidecarIdentity {
+		command = append(command, "--hook-sidecar-identity")
+	}
+
+	if _, exists := vmi.Annotations[faultinjection.Annotation]; exists && t.clusterConfig.FaultInjectionEnabled() {
+		command = append(command, "--fault-injection")
+	}
+
 	_, ok := vmi.Annotations[v1.FuncTestLauncherFailFastAnnotation]
 	if ok {
 		command = append(command, "--simulate-crash")
@@ -444,7 +490,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		return nil, err
 	}
 
-	compute := t.newContainerSpecRenderer(vmi, volumeRenderer, resources, userId).Render(command)
+	compute := t.newContainerSpecRenderer(vmi, launcherImage, volumeRenderer, resources, userId).Render(command)
 
 	for networkName, resourceName := range networkToResourceMap {
 		varName := fmt.Sprintf("KUBEVIRT_RESOURCE_NAME_%s", networkName)
@@ -497,12 +543,12 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		containers = append(containers, *kernelBootContainer)
 	}
 
-	virtiofsContainers := generateVirtioFSContainers(vmi, t.launcherImage, t.clusterConfig)
+	virtiofsContainers := generateVirtioFSContainers(vmi, launcherImage, t.clusterConfig)
 	if virtiofsContainers != nil {
 		containers = append(containers, virtiofsContainers...)
 	}
 
-	sconsolelogContainer := generateSerialConsoleLogContainer(vmi, t.launcherImage, t.clusterConfig, virtLauncherLogVerbosity)
+	sconsolelogContainer := generateSerialConsoleLogContainer(vmi, launcherImage, t.clusterConfig, virtLauncherLogVerbosity)
 	if sconsolelogContainer != nil {
 		containers = append(containers, *sconsolelogContainer)
 	}
@@ -548,6 +594,24 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 					})
 			}
 		}
+		if hookSidecarIdentity {
+			// Every sidecar only sees its own token, virt-launcher sees all of them
+			identityVolumeName := sidecarIdentityVolumeName(i)
+			sidecarVolumes = append(sidecarVolumes, k8sv1.Volume{
+				Name: identityVolumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					EmptyDir: &k8sv1.EmptyDirVolumeSource{Medium: k8sv1.StorageMediumMemory},
+				},
+			})
+			containers[0].VolumeMounts = append(containers[0].VolumeMounts, k8sv1.VolumeMount{
+				Name:      identityVolumeName,
+				MountPath: filepath.Join(hooks.HookIdentityDirectory, sidecarContainerName(i)),
+			})
+			sidecarContainer.VolumeMounts = append(sidecarContainer.VolumeMounts, k8sv1.VolumeMount{
+				Name:      identityVolumeName,
+				MountPath: hooks.HookIdentityDirectory,
+			})
+		}
 		containers = append(containers, sidecarContainer)
 	}
 
@@ -571,6 +635,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		initContainers = append(
 			initContainers,
 			t.newInitContainerRenderer(vmi,
+				launcherImage,
 				initContainerVolumeMount(),
 				initContainerResourceRequirementsForVMI(vmi, v1.ContainerDisk, t.clusterConfig),
 				userId).Render(initContainerCommand))
@@ -621,7 +686,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 			RestartPolicy:                 k8sv1.RestartPolicyNever,
 			Containers:                    containers,
 			InitContainers:                initContainers,
-			NodeSelector:                  t.newNodeSelectorRenderer(vmi).Render(),
+			NodeSelector:                  nodeSelector,
 			Volumes:                       volumeRenderer.Volumes(),
 			ImagePullSecrets:              imagePullSecrets,
 			DNSConfig:                     vmi.Spec.DNSConfig,
@@ -629,7 +694,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 			ReadinessGates:                readinessGates(),
 			EnableServiceLinks:            &enableServiceLinks,
 			SchedulerName:                 vmi.Spec.SchedulerName,
-			Tolerations:                   vmi.Spec.Tolerations,
+			Tolerations:                   podTolerations(vmi, tenantNodePool),
 			TopologySpreadConstraints:     vmi.Spec.TopologySpreadConstraints,
 		},
 	}
@@ -651,6 +716,10 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	}
 
 	setNodeAffinityForPod(vmi, &pod)
+	if launcherImageOverride == nil {
+		// keep the pods with the default image off the node pools with a pinned image
+		pod.Spec.Affinity = modifyNodeAffinityToRejectNodeSelectors(pod.Spec.Affinity, launcherImageOverrideNodeSelectors(t.clusterConfig.GetLauncherImageOverrides()))
+	}
 
 	serviceAccountName := serviceAccount(vmi.Spec.Volumes...)
 	if len(serviceAccountName) > 0 {
@@ -670,7 +739,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	return &pod, nil
 }
 
-func (t *templateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance) *NodeSelectorRenderer {
+func (t *templateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance, tenantNodePool *v1.TenantNodePool) *NodeSelectorRenderer {
 	var opts []NodeSelectorRendererOption
 	if vmi.IsCPUDedicated() {
 		opts = append(opts, WithDedicatedCPU())
@@ -702,15 +771,33 @@ func (t *templateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 		log.Log.V(4).Info("Add SEV-ES node label selector")
 		opts = append(opts, WithSEVESSelector())
 	}
+	if group := t.clusterConfig.GetLicenseGroup(vmi.Labels[v1.LicenseGroupLabel]); group != nil {
+		log.Log.V(4).Infof("Add node selector of license group %s", group.Name)
+		opts = append(opts, WithLicenseGroup(group))
+	}
+	if tenantNodePool != nil {
+		log.Log.V(4).Infof("Add node selector of tenant node pool %s", tenantNodePool.Name)
+		opts = append(opts, WithTenantNodePool(tenantNodePool))
+	}
+
+	nodeArch := vmi.Spec.Architecture
+	if t.isCrossArchitectureEmulated(vmi) {
+		nodeArch = emulation.NodeArchitecture(vmi)
+	}
 
 	return NewNodeSelectorRenderer(
 		vmi.Spec.NodeSelector,
 		t.clusterConfig.GetNodeSelectors(),
-		vmi.Spec.Architecture,
+		nodeArch,
 		opts...,
 	)
 }
 
+// isCrossArchitectureEmulated returns true if the guest of the VMI is emulated on nodes of another architecture
+func (t *templateService) isCrossArchitectureEmulated(vmi *v1.VirtualMachineInstance) bool {
+	return t.clusterConfig.CrossArchitectureEmulationEnabled() && emulation.NodeArchitecture(vmi) != ""
+}
+
 func initContainerVolumeMount() k8sv1.VolumeMount {
 	return k8sv1.VolumeMount{
 		Name:      virtBinDir,
@@ -740,6 +827,8 @@ func newSidecarContainerRenderer(sidecarName string, vmiSpec *v1.VirtualMachineI
 	if util.IsNonRootVMI(vmiSpec) {
 		sidecarOpts = append(sidecarOpts, WithNonRoot(userId))
 		sidecarOpts = append(sidecarOpts, WithDropALLCapabilities())
+	} else if requestedHookSidecar.NetAdmin {
+		sidecarOpts = append(sidecarOpts, WithAddedCapabilities(CAP_NET_ADMIN))
 	}
 	if requestedHookSidecar.Image == "" {
 		requestedHookSidecar.Image = os.Getenv(operatorutil.SidecarShimImageEnvName)
@@ -752,7 +841,7 @@ func newSidecarContainerRenderer(sidecarName string, vmiSpec *v1.VirtualMachineI
 		sidecarOpts...)
 }
 
-func (t *templateService) newInitContainerRenderer(vmiSpec *v1.VirtualMachineInstance, initContainerVolumeMount k8sv1.VolumeMount, initContainerResources k8sv1.ResourceRequirements, userId int64) *ContainerSpecRenderer {
+func (t *templateService) newInitContainerRenderer(vmiSpec *v1.VirtualMachineInstance, launcherImage string, initContainerVolumeMount k8sv1.VolumeMount, initContainerResources k8sv1.ResourceRequirements, userId int64) *ContainerSpecRenderer {
 	const containerDisk = "container-disk-binary"
 	cpInitContainerOpts := []Option{
 		WithVolumeMounts(initContainerVolumeMount),
@@ -767,10 +856,10 @@ func (t *templateService) newInitContainerRenderer(vmiSpec *v1.VirtualMachineIns
 		cpInitContainerOpts = append(cpInitContainerOpts, WithPrivileged())
 	}
 
-	return NewContainerSpecRenderer(containerDisk, t.launcherImage, t.clusterConfig.GetImagePullPolicy(), cpInitContainerOpts...)
+	return NewContainerSpecRenderer(containerDisk, launcherImage, t.clusterConfig.GetImagePullPolicy(), cpInitContainerOpts...)
 }
 
-func (t *templateService) newContainerSpecRenderer(vmi *v1.VirtualMachineInstance, volumeRenderer *VolumeRenderer, resources k8sv1.ResourceRequirements, userId int64) *ContainerSpecRenderer {
+func (t *templateService) newContainerSpecRenderer(vmi *v1.VirtualMachineInstance, launcherImage string, volumeRenderer *VolumeRenderer, resources k8sv1.ResourceRequirements, userId int64) *ContainerSpecRenderer {
 	computeContainerOpts := []Option{
 		WithVolumeDevices(volumeRenderer.VolumeDevices()...),
 		WithVolumeMounts(volumeRenderer.Mounts()...),
@@ -795,7 +884,7 @@ func (t *templateService) newContainerSpecRenderer(vmi *v1.VirtualMachineInstanc
 
 	const computeContainerName = "compute"
 	containerRenderer := NewContainerSpecRenderer(
-		computeContainerName, t.launcherImage, t.clusterConfig.GetImagePullPolicy(), computeContainerOpts...)
+		computeContainerName, launcherImage, t.clusterConfig.GetImagePullPolicy(), computeContainerOpts...)
 	return containerRenderer
 }
 
@@ -806,6 +895,9 @@ func (t *templateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, name
 		withAccessCredentials(vmi.Spec.AccessCredentials),
 		withBackendStorage(vmi, backendStoragePVCName),
 	}
+	if vmi.Spec.IdentityDocument != nil {
+		volumeOpts = append(volumeOpts, withIdentityDocument(vmi))
+	}
 	if len(requestedHookSidecarList) != 0 {
 		volumeOpts = append(volumeOpts, withSidecarVolumes(requestedHookSidecarList))
 	}
@@ -848,7 +940,8 @@ func (t *templateService) newResourceRenderer(vmi *v1.VirtualMachineInstance, ne
 	vmiResources := vmi.Spec.Domain.Resources
 	baseOptions := []ResourceRendererOption{
 		WithEphemeralStorageRequest(),
-		WithVirtualizationResources(getRequiredResources(vmi, t.clusterConfig.AllowEmulation())),
+		// The KVM devices are not needed when no guest runs in dry-run virtualization mode, or when the guest is emulated
+		WithVirtualizationResources(getRequiredResources(vmi, t.clusterConfig.AllowEmulation() || t.clusterConfig.DryRunVirtualizationEnabled() || t.isCrossArchitectureEmulated(vmi))),
 	}
 
 	if err := validatePermittedHostDevices(&vmi.Spec, t.clusterConfig); err != nil {
@@ -892,6 +985,10 @@ func sidecarContainerName(i int) string {
 	return fmt.Sprintf("hook-sidecar-%d", i)
 }
 
+func sidecarIdentityVolumeName(i int) string {
+	return fmt.Sprintf("%s-identity", sidecarContainerName(i))
+}
+
 func (t *templateService) RenderHotplugAttachmentPodTemplate(volumes []*v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, claimMap map[string]*k8sv1.PersistentVolumeClaim) (*k8sv1.Pod, error) {
 	zero := int64(0)
 	runUser := int64(util.NonRootUID)
@@ -919,7 +1016,7 @@ func (t *templateService) RenderHotplugAttachmentPodTemplate(volumes []*v1.Volum
 			Containers: []k8sv1.Container{
 				{
 					Name:      hotplugDisk,
-					Image:     t.launcherImage,
+					Image:     t.GetLauncherImageForPod(ownerPod),
 					Command:   command,
 					Resources: hotplugContainerResourceRequirementsForVMI(vmi, t.clusterConfig),
 					SecurityContext: &k8sv1.SecurityContext{
@@ -1062,7 +1159,7 @@ func (t *templateService) RenderHotplugAttachmentTriggerPodTemplate(volume *v1.V
 			Containers: []k8sv1.Container{
 				{
 					Name:      hotplugDisk,
-					Image:     t.launcherImage,
+					Image:     t.GetLauncherImageForPod(ownerPod),
 					Command:   command,
 					Resources: hotplugContainerResourceRequirementsForVMI(vmi, t.clusterConfig),
 					SecurityContext: &k8sv1.SecurityContext{
@@ -1188,6 +1285,56 @@ func (t *templateService) RenderExporterManifest(vmExport *exportv1.VirtualMachi
 	return exporterPod
 }
 
+// RenderDiskCheckManifest renders the pod checking the volumes of a VirtualMachineDiskCheck, the volumes
+// to check are added by the disk check controller. The pod runs virt-disk-check from the launcher image.
+func (t *templateService) RenderDiskCheckManifest(diskCheck *diskcheckv1alpha1.VirtualMachineDiskCheck, namePrefix string) *k8sv1.Pod {
+	name := naming.GetName(namePrefix, diskCheck.Name, validation.DNS1035LabelMaxLength)
+	return &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: diskCheck.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(diskCheck, diskcheckv1alpha1.VirtualMachineDiskCheckKind),
+			},
+			Labels: map[string]string{
+				v1.AppLabel: virtDiskCheck,
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			RestartPolicy: k8sv1.RestartPolicyNever,
+			SecurityContext: &k8sv1.PodSecurityContext{
+				RunAsNonRoot:   pointer.P(true),
+				RunAsUser:      pointer.P(int64(util.NonRootUID)),
+				FSGroup:        pointer.P(int64(util.NonRootUID)),
+				SeccompProfile: &k8sv1.SeccompProfile{Type: k8sv1.SeccompProfileTypeRuntimeDefault},
+			},
+			Containers: []k8sv1.Container{
+				{
+					Name:            virtDiskCheck,
+					Image:           t.launcherImage,
+					ImagePullPolicy: t.clusterConfig.GetImagePullPolicy(),
+					Command:         []string{"/usr/bin/virt-disk-check"},
+					SecurityContext: &k8sv1.SecurityContext{
+						AllowPrivilegeEscalation: pointer.P(false),
+						Capabilities:             &k8sv1.Capabilities{Drop: []k8sv1.Capability{"ALL"}},
+					},
+					TerminationMessagePolicy: k8sv1.TerminationMessageReadFile,
+					Resources: k8sv1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceCPU:    resource.MustParse("100m"),
+							k8sv1.ResourceMemory: resource.MustParse("256Mi"),
+						},
+						Limits: k8sv1.ResourceList{
+							k8sv1.ResourceCPU:    resource.MustParse("1"),
+							k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func appendUniqueImagePullSecret(secrets []k8sv1.LocalObjectReference, newsecret k8sv1.LocalObjectReference) []k8sv1.LocalObjectReference {
 	for _, oldsecret := range secrets {
 		if oldsecret == newsecret {
@@ -1472,6 +1619,10 @@ func (t *templateService) VMIResourcePredicates(vmi *v1.VirtualMachineInstance,
 		)
 	}
 
+	if t.isCrossArchitectureEmulated(vmi) {
+		memoryOverhead.Add(*resource.NewQuantity(emulation.TranslationCacheMiB<<20, resource.BinarySI))
+	}
+
 	metrics.SetVmiLaucherMemoryOverhead(vmi, memoryOverhead)
 	withCPULimits := t.doesVMIRequireAutoCPULimits(vmi)
 	return VMIResourcePredicates{
@@ -34,6 +34,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -50,7 +51,10 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 
 	k6tconfig "kubevirt.io/kubevirt/pkg/config"
+	"kubevirt.io/kubevirt/pkg/emulation"
+	"kubevirt.io/kubevirt/pkg/faultinjection"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/identitydocument"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
@@ -477,6 +481,141 @@ var _ = Describe("Template", func() {
 				Entry("on arm64", "arm64", "/usr/share/AAVMF"),
 				Entry("on ppc64le", "ppc64le", "/usr/share/OVMF"),
 			)
+
+			It("should share a token volume between virt-launcher and every sidecar with the HookSidecarIdentity gate", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				enableFeatureGate(virtconfig.HookSidecarIdentityGate)
+
+				pod, err := svc.RenderLaunchManifest(&v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "testns",
+						UID:       "1234",
+						Annotations: map[string]string{
+							hooks.HookSidecarListAnnotationName: `[{"image": "some-image:v1"}, {"image": "other-image:v1"}]`,
+						},
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{}},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Containers[0].Command).To(ContainElement("--hook-sidecar-identity"))
+				Expect(pod.Spec.Volumes).To(ContainElements(
+					k8sv1.Volume{Name: "hook-sidecar-0-identity", VolumeSource: k8sv1.VolumeSource{EmptyDir: &k8sv1.EmptyDirVolumeSource{Medium: k8sv1.StorageMediumMemory}}},
+					k8sv1.Volume{Name: "hook-sidecar-1-identity", VolumeSource: k8sv1.VolumeSource{EmptyDir: &k8sv1.EmptyDirVolumeSource{Medium: k8sv1.StorageMediumMemory}}},
+				))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElements(
+					k8sv1.VolumeMount{Name: "hook-sidecar-0-identity", MountPath: "/var/run/kubevirt-hooks-identity/hook-sidecar-0"},
+					k8sv1.VolumeMount{Name: "hook-sidecar-1-identity", MountPath: "/var/run/kubevirt-hooks-identity/hook-sidecar-1"},
+				))
+				Expect(pod.Spec.Containers[1].VolumeMounts).To(ContainElement(
+					k8sv1.VolumeMount{Name: "hook-sidecar-0-identity", MountPath: hooks.HookIdentityDirectory},
+				))
+				Expect(pod.Spec.Containers[1].VolumeMounts).ToNot(ContainElement(HaveField("Name", "hook-sidecar-1-identity")))
+			})
+
+			DescribeTable("should enable fault injection in virt-launcher", func(gateEnabled bool, matcher gomegatypes.GomegaMatcher) {
+				config, kvStore, svc = configFactory(defaultArch)
+				if gateEnabled {
+					enableFeatureGate(virtconfig.FaultInjectionGate)
+				}
+
+				pod, err := svc.RenderLaunchManifest(&v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "testvmi",
+						Namespace:   "testns",
+						UID:         "1234",
+						Annotations: map[string]string{faultinjection.Annotation: `{"onDefineDomain": {"fail": true}}`},
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{}},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Command).To(matcher)
+			},
+				Entry("with the FaultInjection gate", true, ContainElement("--fault-injection")),
+				Entry("not without the FaultInjection gate", false, Not(ContainElement("--fault-injection"))),
+			)
+
+			DescribeTable("should let virt-launcher reattach after a crash", func(gateEnabled bool, matcher gomegatypes.GomegaMatcher) {
+				config, kvStore, svc = configFactory(defaultArch)
+				if gateEnabled {
+					enableFeatureGate(virtconfig.LauncherReattachGate)
+				}
+
+				pod, err := svc.RenderLaunchManifest(&v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "testns", UID: "1234"},
+					Spec:       v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{}},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Command).To(matcher)
+			},
+				Entry("with the LauncherReattach gate", true, ContainElement("--reattach-after-crash")),
+				Entry("not without the LauncherReattach gate", false, Not(ContainElement("--reattach-after-crash"))),
+			)
+
+			DescribeTable("should run virt-launcher in dry-run virtualization mode", func(gateEnabled bool, matcher gomegatypes.GomegaMatcher) {
+				config, kvStore, svc = configFactory(defaultArch)
+				if gateEnabled {
+					enableFeatureGate(virtconfig.DryRunVirtualizationGate)
+				}
+
+				pod, err := svc.RenderLaunchManifest(&v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "testns", UID: "1234"},
+					Spec:       v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{}},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Command).To(matcher)
+				if gateEnabled {
+					Expect(pod.Spec.Containers[0].Resources.Limits).ToNot(HaveKey(k8sv1.ResourceName(KvmDevice)))
+				} else {
+					Expect(pod.Spec.Containers[0].Resources.Limits).To(HaveKey(k8sv1.ResourceName(KvmDevice)))
+				}
+			},
+				Entry("with the DryRunVirtualization gate", true, ContainElement("--dry-run-virtualization")),
+				Entry("not without the DryRunVirtualization gate", false, Not(ContainElement("--dry-run-virtualization"))),
+			)
+
+			DescribeTable("should schedule a guest emulated on another architecture", func(gateEnabled bool, expectedNodeArch string) {
+				config, kvStore, svc = configFactory(defaultArch)
+				if gateEnabled {
+					enableFeatureGate(virtconfig.CrossArchitectureEmulationGate)
+				}
+
+				vmi := libvmi.New(
+					libvmi.WithNamespace("testns"),
+					libvmi.WithArchitecture("arm64"),
+					libvmi.WithAnnotation(emulation.NodeArchitectureAnnotation, "amd64"),
+				)
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(k8sv1.LabelArchStable, expectedNodeArch))
+				if gateEnabled {
+					Expect(pod.Spec.Containers[0].Resources.Limits).ToNot(HaveKey(k8sv1.ResourceName(KvmDevice)))
+				} else {
+					Expect(pod.Spec.Containers[0].Resources.Limits).To(HaveKey(k8sv1.ResourceName(KvmDevice)))
+				}
+			},
+				Entry("on nodes of the requested architecture with the CrossArchitectureEmulation gate", true, "amd64"),
+				Entry("on nodes of its own architecture without the CrossArchitectureEmulation gate", false, "arm64"),
+			)
+
+			It("should account for the translation cache of a guest emulated on another architecture", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				enableFeatureGate(virtconfig.CrossArchitectureEmulationGate)
+
+				vmi := libvmi.New(libvmi.WithNamespace("testns"), libvmi.WithArchitecture("arm64"), libvmi.WithResourceMemory("1Gi"))
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				nativeMemory := pod.Spec.Containers[0].Resources.Requests.Memory().DeepCopy()
+
+				vmi.Annotations = map[string]string{emulation.NodeArchitectureAnnotation: "amd64"}
+				pod, err = svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				emulatedMemory := pod.Spec.Containers[0].Resources.Requests.Memory().DeepCopy()
+
+				emulatedMemory.Sub(nativeMemory)
+				Expect(emulatedMemory.Value()).To(BeEquivalentTo(emulation.TranslationCacheMiB << 20))
+			})
 		})
 		Context("with SELinux types", func() {
 			It("should be nil if no SELinux type is specified and none is needed", func() {
@@ -796,6 +935,57 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		Context("with an identity document", func() {
+			It("should mount the secret holding the identity document of the VMI", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "default",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						IdentityDocument: &v1.IdentityDocument{},
+					},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+					Name: identitydocument.VolumeName,
+					VolumeSource: k8sv1.VolumeSource{
+						Secret: &k8sv1.SecretVolumeSource{
+							SecretName: "kubevirt-identity-document-1234",
+						},
+					},
+				}))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+					Name:      identitydocument.VolumeName,
+					MountPath: "/var/run/kubevirt-private/secret/identity-document",
+					ReadOnly:  true,
+				}))
+			})
+
+			It("should not mount an identity document when the VMI does not request one", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "default",
+						UID:       "1234",
+					},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				for _, volume := range pod.Spec.Volumes {
+					Expect(volume.Name).ToNot(Equal(identitydocument.VolumeName))
+				}
+			})
+		})
+
 		Context("with cloud-init user secret", func() {
 			It("should add volume with secret referenced by cloud-init user secret ref", func() {
 				config, kvStore, svc = configFactory(defaultArch)
@@ -1123,6 +1313,167 @@ var _ = Describe("Template", func() {
 				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue("node-role.kubernetes.io/compute", "true"))
 			})
 
+			DescribeTable("should add the node selector of the license group", func(featureGate bool, matcher gomegatypes.GomegaMatcher) {
+				config, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				if featureGate {
+					kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = append(kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates, virtconfig.LicenseTrackingGate)
+				}
+				kvConfig.Spec.Configuration.LicenseGroups = []v1.LicenseGroup{{
+					Name:         "sql",
+					NodeSelector: map[string]string{"licensing.example.com/sql": "true"},
+				}}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+						Labels: map[string]string{v1.LicenseGroupLabel: "sql"},
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Volumes: []v1.Volume{}, Domain: v1.DomainSpec{
+						Devices: v1.Devices{
+							DisableHotplug: true,
+						},
+					}},
+				}
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.NodeSelector).To(matcher)
+			},
+				Entry("when license tracking is enabled", true, HaveKeyWithValue("licensing.example.com/sql", "true")),
+				Entry("but not when license tracking is disabled", false, Not(HaveKey("licensing.example.com/sql"))),
+			)
+
+			Context("with tenant node pools", func() {
+				newTenantVMI := func(namespace string) *v1.VirtualMachineInstance {
+					return &v1.VirtualMachineInstance{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "testvmi", Namespace: namespace, UID: "1234",
+						},
+						Spec: v1.VirtualMachineInstanceSpec{
+							Volumes:      []v1.Volume{},
+							NodeSelector: map[string]string{"pool": "shared"},
+							Tolerations:  []k8sv1.Toleration{{Key: "user", Operator: k8sv1.TolerationOpExists}},
+							Domain: v1.DomainSpec{
+								Devices: v1.Devices{
+									DisableHotplug: true,
+								},
+							},
+						},
+					}
+				}
+
+				BeforeEach(func() {
+					config, kvStore, svc = configFactory(defaultArch)
+					kvConfig := kv.DeepCopy()
+					kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = append(kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates, virtconfig.TenantNodePoolsGate)
+					kvConfig.Spec.Configuration.TenantNodePools = []v1.TenantNodePool{{
+						Name: "tenant-a",
+						NamespaceSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{"tenant": "a"},
+						},
+						NodeSelector: map[string]string{"pool": "tenant-a"},
+						Tolerations:  []k8sv1.Toleration{{Key: "tenant", Value: "a", Effect: k8sv1.TaintEffectNoSchedule}},
+					}}
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+					for _, namespace := range []*k8sv1.Namespace{
+						{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a-ns", Labels: map[string]string{"tenant": "a"}}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "other-ns"}},
+					} {
+						Expect(namespaceStore.Add(namespace)).To(Succeed())
+						DeferCleanup(namespaceStore.Delete, namespace)
+					}
+				})
+
+				It("should enforce the node selector and tolerations of the pool selecting the namespace", func() {
+					pod, err := svc.RenderLaunchManifest(newTenantVMI("tenant-a-ns"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue("pool", "tenant-a"))
+					Expect(pod.Spec.Tolerations).To(ConsistOf(
+						k8sv1.Toleration{Key: "user", Operator: k8sv1.TolerationOpExists},
+						k8sv1.Toleration{Key: "tenant", Value: "a", Effect: k8sv1.TaintEffectNoSchedule},
+					))
+				})
+
+				It("should not alter the pods of namespaces which are not selected", func() {
+					pod, err := svc.RenderLaunchManifest(newTenantVMI("other-ns"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue("pool", "shared"))
+					Expect(pod.Spec.Tolerations).To(ConsistOf(k8sv1.Toleration{Key: "user", Operator: k8sv1.TolerationOpExists}))
+				})
+
+				It("should fail when the namespace of the VMI is unknown", func() {
+					_, err := svc.RenderLaunchManifest(newTenantVMI("unknown-ns"))
+					Expect(err).To(MatchError("namespace unknown-ns does not exist"))
+				})
+			})
+
+			Context("with launcher image overrides", func() {
+				const canaryImage = "kubevirt/virt-launcher:canary"
+
+				newVMI := func(nodeSelector map[string]string) *v1.VirtualMachineInstance {
+					vmi := api.NewMinimalVMI("testvmi")
+					vmi.Spec.NodeSelector = nodeSelector
+					return vmi
+				}
+
+				rejectedPools := func(pod *k8sv1.Pod) []k8sv1.NodeSelectorRequirement {
+					var requirements []k8sv1.NodeSelectorRequirement
+					for _, term := range pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+						for _, requirement := range term.MatchExpressions {
+							if requirement.Key == "pool" {
+								requirements = append(requirements, requirement)
+							}
+						}
+					}
+					return requirements
+				}
+
+				enableOverrides := func(featureGates ...string) {
+					config, kvStore, svc = configFactory(defaultArch)
+					kvConfig := kv.DeepCopy()
+					kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = append(kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates, featureGates...)
+					kvConfig.Spec.Configuration.LauncherImageOverrides = []v1.LauncherImageOverride{{
+						Name:         "canary",
+						NodeSelector: map[string]string{"pool": "canary"},
+						Image:        canaryImage,
+					}}
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+				}
+
+				It("should use the image of the override the node selector includes", func() {
+					enableOverrides(virtconfig.LauncherImageOverridesGate)
+
+					pod, err := svc.RenderLaunchManifest(newVMI(map[string]string{"pool": "canary", "zone": "a"}))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.Containers[0].Image).To(Equal(canaryImage))
+					Expect(svc.GetLauncherImageForPod(pod)).To(Equal(canaryImage))
+					Expect(rejectedPools(pod)).To(BeEmpty())
+				})
+
+				It("should keep the default image off the node pools with a pinned image", func() {
+					enableOverrides(virtconfig.LauncherImageOverridesGate)
+
+					pod, err := svc.RenderLaunchManifest(newVMI(map[string]string{"zone": "a"}))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.Containers[0].Image).To(Equal("kubevirt/virt-launcher"))
+					Expect(svc.GetLauncherImageForPod(pod)).To(Equal("kubevirt/virt-launcher"))
+					Expect(rejectedPools(pod)).To(ConsistOf(
+						k8sv1.NodeSelectorRequirement{Key: "pool", Operator: k8sv1.NodeSelectorOpNotIn, Values: []string{"canary"}},
+					))
+				})
+
+				It("should ignore the overrides when the feature gate is disabled", func() {
+					enableOverrides()
+
+					pod, err := svc.RenderLaunchManifest(newVMI(map[string]string{"pool": "canary"}))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.Containers[0].Image).To(Equal("kubevirt/virt-launcher"))
+					Expect(rejectedPools(pod)).To(BeEmpty())
+				})
+			})
+
 			It("should add realtime node label selector with realtime workload", func() {
 				config, kvStore, svc = configFactory(defaultArch)
 				vmi := v1.VirtualMachineInstance{
@@ -2797,6 +3148,47 @@ var _ = Describe("Template", func() {
 			Expect(pod.Spec.Containers[1].ImagePullPolicy).To(Equal(testHookSidecar.ImagePullPolicy))
 		})
 
+		DescribeTable("should grant NET_ADMIN to a sidecar requesting it", func(runtimeUser uint64, addedCaps []k8sv1.Capability) {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVWithCPUArch(kv, defaultArch)
+			netAdminSidecarCreator := func(*v1.VirtualMachineInstance, *v1.KubeVirtConfiguration) (hooks.HookSidecarList, error) {
+				return hooks.HookSidecarList{{Image: "test-image", NetAdmin: true}}, nil
+			}
+			svc = NewTemplateService("kubevirt/virt-launcher",
+				240,
+				"/var/run/kubevirt",
+				"/var/lib/kubevirt",
+				"/var/run/kubevirt-ephemeral-disks",
+				"/var/run/kubevirt/container-disks",
+				v1.HotplugDiskDir,
+				"pull-secret-1",
+				pvcCache,
+				virtClient,
+				config,
+				qemuGid,
+				"kubevirt/vmexport",
+				resourceQuotaStore,
+				namespaceStore,
+				WithSidecarCreator(netAdminSidecarCreator),
+				WithNetBindingPluginMemoryCalculator(&stubNetBindingPluginMemoryCalculator{}),
+			)
+			vmi := v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default", UID: "1234"},
+				Status:     v1.VirtualMachineInstanceStatus{RuntimeUser: runtimeUser},
+			}
+			pod, err := svc.RenderLaunchManifest(&vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pod.Spec.Containers).To(HaveLen(2))
+			var added []k8sv1.Capability
+			if pod.Spec.Containers[1].SecurityContext.Capabilities != nil {
+				added = pod.Spec.Containers[1].SecurityContext.Capabilities.Add
+			}
+			Expect(added).To(Equal(addedCaps))
+		},
+			Entry("on a root virt-launcher", uint64(0), []k8sv1.Capability{CAP_NET_ADMIN}),
+			Entry("but not on a non-root virt-launcher", uint64(util.NonRootUID), nil),
+		)
+
 		Context("with pod networking", func() {
 			It("Should require tun device by default", func() {
 				config, kvStore, svc = configFactory(defaultArch)
@@ -0,0 +1,75 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package services
+
+import (
+	"fmt"
+	"slices"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// tenantNodePool returns the first tenant node pool selecting the namespace of the VMI, or nil if there is none.
+// Since the pools isolate tenants, an error is returned rather than rendering an unrestricted pod
+// when the namespace can't be looked up.
+func (t *templateService) tenantNodePool(vmi *v1.VirtualMachineInstance) (*v1.TenantNodePool, error) {
+	pools := t.clusterConfig.GetTenantNodePools()
+	if len(pools) == 0 {
+		return nil, nil
+	}
+
+	if t.namespaceStore == nil {
+		return nil, fmt.Errorf("cannot match tenant node pools without namespace informer")
+	}
+	obj, exists, err := t.namespaceStore.GetByKey(vmi.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve namespace %s: %v", vmi.Namespace, err)
+	} else if !exists {
+		return nil, fmt.Errorf("namespace %s does not exist", vmi.Namespace)
+	}
+	namespace, ok := obj.(*k8sv1.Namespace)
+	if !ok {
+		return nil, fmt.Errorf("couldn't cast object to Namespace: %+v", obj)
+	}
+
+	for i := range pools {
+		selector, err := metav1.LabelSelectorAsSelector(&pools[i].NamespaceSelector)
+		if err != nil {
+			log.Log.Reason(err).Warningf("invalid namespace selector in tenant node pool %s, ignoring it", pools[i].Name)
+			continue
+		}
+		if selector.Matches(labels.Set(namespace.Labels)) {
+			return pools[i].DeepCopy(), nil
+		}
+	}
+	return nil, nil
+}
+
+func podTolerations(vmi *v1.VirtualMachineInstance, tenantNodePool *v1.TenantNodePool) []k8sv1.Toleration {
+	if tenantNodePool == nil || len(tenantNodePool.Tolerations) == 0 {
+		return vmi.Spec.Tolerations
+	}
+	return append(slices.Clone(vmi.Spec.Tolerations), tenantNodePool.Tolerations...)
+}
@@ -38,10 +38,15 @@ import (
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/gatewayroute"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/identitydocument"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/lifecyclenotification"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/pool"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/servicenetwork"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/timeline"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmi"
 
@@ -68,14 +73,17 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/healthz"
 	"kubevirt.io/kubevirt/pkg/monitoring/profiler"
+	"kubevirt.io/kubevirt/pkg/redaction"
 
 	exportv1 "kubevirt.io/api/export/v1beta1"
+	notificationsv1alpha1 "kubevirt.io/api/notifications/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 	clientutil "kubevirt.io/client-go/util"
 
+	"kubevirt.io/kubevirt/pkg/breakglass"
 	"kubevirt.io/kubevirt/pkg/certificates/bootstrap"
 	"kubevirt.io/kubevirt/pkg/controller"
 	clusterutil "kubevirt.io/kubevirt/pkg/util/cluster"
@@ -83,6 +91,7 @@ import (
 	clientmetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/common/client"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/service"
+	"kubevirt.io/kubevirt/pkg/storage/diskcheck"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -211,6 +220,27 @@ type VirtControllerApp struct {
 	vmCloneInformer   cache.SharedIndexInformer
 	vmCloneController *clone.VMCloneController
 
+	lifecycleNotificationInformer   cache.SharedIndexInformer
+	lifecycleNotificationController *lifecyclenotification.Controller
+
+	timelineInformer      cache.SharedIndexInformer
+	kubeVirtEventInformer cache.SharedIndexInformer
+	timelineController    *timeline.Controller
+
+	diskCheckInformer   cache.SharedIndexInformer
+	diskCheckController *diskcheck.VMDiskCheckController
+
+	consoleAccessGrantInformer   cache.SharedIndexInformer
+	consoleAccessGrantController *breakglass.Controller
+
+	identityDocumentController *identitydocument.Controller
+
+	serviceNetworkServiceInformer       cache.SharedIndexInformer
+	serviceNetworkEndpointSliceInformer cache.SharedIndexInformer
+	serviceNetworkController            *servicenetwork.Controller
+
+	gatewayRouteController *gatewayroute.Controller
+
 	instancetypeInformer        cache.SharedIndexInformer
 	clusterInstancetypeInformer cache.SharedIndexInformer
 	preferenceInformer          cache.SharedIndexInformer
@@ -255,6 +285,13 @@ type VirtControllerApp struct {
 	restoreControllerThreads          int
 	snapshotControllerResyncPeriod    time.Duration
 	cloneControllerThreads            int
+	lifecycleNotificationThreads      int
+	timelineControllerThreads         int
+	diskCheckControllerThreads        int
+	consoleAccessGrantThreads         int
+	identityDocumentThreads           int
+	serviceNetworkThreads             int
+	gatewayRouteThreads               int
 
 	caConfigMapName          string
 	promCertFilePath         string
@@ -275,6 +312,7 @@ func init() {
 	utilruntime.Must(exportv1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(poolv1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(clonev1alpha1.AddToScheme(scheme.Scheme))
+	utilruntime.Must(notificationsv1alpha1.AddToScheme(scheme.Scheme))
 }
 
 func Execute() {
@@ -362,7 +400,7 @@ func Execute() {
 	app.namespaceStore = app.informerFactory.Namespace().GetStore()
 	app.namespaceInformer = app.informerFactory.Namespace()
 	app.vmiCache = app.vmiInformer.GetStore()
-	app.vmiRecorder = app.newRecorder(k8sv1.NamespaceAll, "virtualmachine-controller")
+	app.vmiRecorder = app.newRedactingRecorder("virtualmachine-controller")
 
 	app.rsInformer = app.informerFactory.VMIReplicaSet()
 	app.poolInformer = app.informerFactory.VMPool()
@@ -389,6 +427,8 @@ func Execute() {
 	app.allPodInformer = app.informerFactory.Pod()
 	app.exportServiceInformer = app.informerFactory.ExportService()
 	app.resourceQuotaInformer = app.informerFactory.ResourceQuota()
+	app.serviceNetworkServiceInformer = app.informerFactory.ServiceNetworkService()
+	app.serviceNetworkEndpointSliceInformer = app.informerFactory.ServiceNetworkEndpointSlice()
 
 	if app.hasCDI {
 		app.dataVolumeInformer = app.informerFactory.DataVolume()
@@ -425,6 +465,15 @@ func Execute() {
 
 	app.vmCloneInformer = app.informerFactory.VirtualMachineClone()
 
+	app.lifecycleNotificationInformer = app.informerFactory.LifecycleNotification()
+
+	app.timelineInformer = app.informerFactory.VirtualMachineTimeline()
+	app.kubeVirtEventInformer = app.informerFactory.KubeVirtEvent()
+
+	app.diskCheckInformer = app.informerFactory.VirtualMachineDiskCheck()
+
+	app.consoleAccessGrantInformer = app.informerFactory.ConsoleAccessGrant()
+
 	app.instancetypeInformer = app.informerFactory.VirtualMachineInstancetype()
 	app.clusterInstancetypeInformer = app.informerFactory.VirtualMachineClusterInstancetype()
 	app.preferenceInformer = app.informerFactory.VirtualMachinePreference()
@@ -442,6 +491,7 @@ func Execute() {
 		app.preferenceInformer,
 		app.migrationInformer,
 		app.kvPodInformer,
+		app.nodeInformer,
 		app.clusterConfig,
 	); err != nil {
 		golog.Fatal(err)
@@ -458,6 +508,13 @@ func Execute() {
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
+	app.initLifecycleNotificationController()
+	app.initTimelineController()
+	app.initDiskCheckController()
+	app.initConsoleAccessGrantController()
+	app.initIdentityDocumentController()
+	app.initServiceNetworkController()
+	app.initGatewayRouteController()
 	go app.Run()
 
 	<-app.reInitChan
@@ -590,6 +647,13 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the clone controller: %v", err)
 			}
 		}()
+		go vca.lifecycleNotificationController.Run(vca.lifecycleNotificationThreads, stop)
+		go vca.timelineController.Run(vca.timelineControllerThreads, stop)
+		go vca.diskCheckController.Run(vca.diskCheckControllerThreads, stop)
+		go vca.consoleAccessGrantController.Run(vca.consoleAccessGrantThreads, stop)
+		go vca.identityDocumentController.Run(vca.identityDocumentThreads, stop)
+		go vca.serviceNetworkController.Run(vca.serviceNetworkThreads, stop)
+		go vca.gatewayRouteController.Run(vca.gatewayRouteThreads, stop)
 
 		cache.WaitForCacheSync(stop, vca.persistentVolumeClaimInformer.HasSynced, vca.namespaceInformer.HasSynced, vca.resourceQuotaInformer.HasSynced)
 		close(vca.readyChan)
@@ -603,6 +667,12 @@ func (vca *VirtControllerApp) newRecorder(namespace string, componentName string
 	return eventBroadcaster.NewRecorder(scheme.Scheme, k8sv1.EventSource{Component: componentName})
 }
 
+func (vca *VirtControllerApp) newRedactingRecorder(componentName string) record.EventRecorder {
+	// The events of virt-controller don't carry the host in their source, no need for a separate redacted recorder
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, componentName)
+	return redaction.NewRecorder(recorder, recorder, vca.clusterConfig, vca.clientSet, vca.vmiCache)
+}
+
 func (vca *VirtControllerApp) initCommon() {
 	var err error
 
@@ -663,7 +733,7 @@ func (vca *VirtControllerApp) initCommon() {
 		panic(err)
 	}
 
-	recorder := vca.newRecorder(k8sv1.NamespaceAll, "node-controller")
+	recorder := vca.newRedactingRecorder("node-controller")
 	vca.nodeController, err = node.NewController(vca.clientSet, vca.nodeInformer, vca.vmiInformer, recorder)
 	if err != nil {
 		panic(err)
@@ -896,6 +966,86 @@ func (vca *VirtControllerApp) initCloneController() {
 	}
 }
 
+func (vca *VirtControllerApp) initLifecycleNotificationController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "lifecyclenotification-controller")
+	vca.lifecycleNotificationController, err = lifecyclenotification.NewController(
+		vca.clientSet, vca.lifecycleNotificationInformer, vca.vmiInformer, recorder, vca.kubevirtNamespace,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initTimelineController() {
+	var err error
+	vca.timelineController, err = timeline.NewController(
+		vca.clientSet, vca.timelineInformer, vca.vmInformer, vca.vmiInformer, vca.kubeVirtEventInformer,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initDiskCheckController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "disk-check-controller")
+	vca.diskCheckController = &diskcheck.VMDiskCheckController{
+		Client:                    vca.clientSet,
+		TemplateService:           vca.templateService,
+		DiskCheckInformer:         vca.diskCheckInformer,
+		VMInformer:                vca.vmInformer,
+		VMIInformer:               vca.vmiInformer,
+		PodInformer:               vca.allPodInformer,
+		PVCInformer:               vca.persistentVolumeClaimInformer,
+		DataVolumeInformer:        vca.dataVolumeInformer,
+		VMSnapshotInformer:        vca.vmSnapshotInformer,
+		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
+		VolumeSnapshotProvider:    vca.snapshotController,
+		Recorder:                  recorder,
+	}
+	if err := vca.diskCheckController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initConsoleAccessGrantController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "console-access-grant-controller")
+	var err error
+	vca.consoleAccessGrantController, err = breakglass.NewController(vca.clientSet, vca.consoleAccessGrantInformer, recorder)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initIdentityDocumentController() {
+	var err error
+	vca.identityDocumentController, err = identitydocument.NewController(
+		vca.clientSet, vca.vmiInformer, vca.namespaceInformer, vca.clusterConfig, vca.kubevirtNamespace,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initServiceNetworkController() {
+	var err error
+	vca.serviceNetworkController, err = servicenetwork.NewController(
+		vca.clientSet, vca.vmiInformer, vca.serviceNetworkServiceInformer, vca.serviceNetworkEndpointSliceInformer, vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initGatewayRouteController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "gateway-route-controller")
+	var err error
+	vca.gatewayRouteController, err = gatewayroute.NewController(vca.clientSet, vca.vmInformer, recorder, vca.clusterConfig)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) leaderProbe(_ *restful.Request, response *restful.Response) {
 	res := map[string]interface{}{}
 
@@ -1004,6 +1154,27 @@ func (vca *VirtControllerApp) AddFlags() {
 
 	flag.IntVar(&vca.cloneControllerThreads, "clone-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for clone controller")
+
+	flag.IntVar(&vca.lifecycleNotificationThreads, "lifecycle-notification-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for lifecycle notification controller")
+
+	flag.IntVar(&vca.timelineControllerThreads, "timeline-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for timeline controller")
+
+	flag.IntVar(&vca.diskCheckControllerThreads, "disk-check-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for disk check controller")
+
+	flag.IntVar(&vca.consoleAccessGrantThreads, "console-access-grant-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for console access grant controller")
+
+	flag.IntVar(&vca.identityDocumentThreads, "identity-document-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for identity document controller")
+
+	flag.IntVar(&vca.serviceNetworkThreads, "service-network-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for service network controller")
+
+	flag.IntVar(&vca.gatewayRouteThreads, "gateway-route-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for gateway route controller")
 }
 
 func (vca *VirtControllerApp) setupLeaderElector() (err error) {
@@ -41,6 +41,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	v1 "kubevirt.io/api/core/v1"
+	diskcheckv1alpha1 "kubevirt.io/api/diskcheck/v1alpha1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
@@ -51,6 +52,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/controller"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/rest"
+	"kubevirt.io/kubevirt/pkg/storage/diskcheck"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -111,6 +113,7 @@ var _ = Describe("Application", func() {
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
 		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
+		diskCheckInformer, _ := testutils.NewFakeInformerFor(&diskcheckv1alpha1.VirtualMachineDiskCheck{})
 		configMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		routeConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
@@ -232,6 +235,20 @@ var _ = Describe("Application", func() {
 			ControllerRevisionInformer:  controllerRevisionInformer,
 		}
 		_ = app.exportController.Init()
+		app.diskCheckController = &diskcheck.VMDiskCheckController{
+			Client:                    virtClient,
+			TemplateService:           services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), virtClient, config, qemuGid, "h", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
+			DiskCheckInformer:         diskCheckInformer,
+			VMInformer:                vmInformer,
+			VMIInformer:               vmiInformer,
+			PodInformer:               podInformer,
+			PVCInformer:               pvcInformer,
+			DataVolumeInformer:        dataVolumeInformer,
+			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			Recorder:                  recorder,
+		}
+		_ = app.diskCheckController.Init()
 		app.persistentVolumeClaimInformer = pvcInformer
 		app.nodeInformer = nodeInformer
 		app.resourceQuotaInformer = resourceQuotaInformer
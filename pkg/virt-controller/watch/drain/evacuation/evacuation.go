@@ -390,7 +390,7 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 		Effect: k8sv1.TaintEffectNoSchedule,
 	}
 
-	vmisToMigrate := vmisToMigrate(node, vmisOnNode, taint)
+	vmisToMigrate := vmisToMigrate(node, vmisOnNode, taint, c.migrateOnStorageDegraded())
 	if len(vmisToMigrate) == 0 {
 		return nil
 	}
@@ -475,13 +475,36 @@ func hasMigratedOnEviction(vmi *virtv1.VirtualMachineInstance) bool {
 	return vmi.Status.NodeName != vmi.Status.EvacuationNodeName
 }
 
-func vmisToMigrate(node *k8sv1.Node, vmisOnNode []*virtv1.VirtualMachineInstance, taint *k8sv1.Taint) []*virtv1.VirtualMachineInstance {
+func getStorageDegradedVMIs(vmis []*virtv1.VirtualMachineInstance) []*virtv1.VirtualMachineInstance {
+	var candidates []*virtv1.VirtualMachineInstance
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	for _, vmi := range vmis {
+		// VMIs marked for eviction are already taken into account
+		if vmi.IsMarkedForEviction() || migrationutils.IsMigrating(vmi) {
+			continue
+		}
+		if condManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceStorageDegraded, k8sv1.ConditionTrue) {
+			candidates = append(candidates, vmi)
+		}
+	}
+	return candidates
+}
+
+func (c *EvacuationController) migrateOnStorageDegraded() bool {
+	return c.clusterConfig.StorageHealthCheckEnabled() &&
+		*c.clusterConfig.GetStorageHealthCheckConfiguration().DegradedPolicy == virtv1.StorageDegradedPolicyLiveMigrate
+}
+
+func vmisToMigrate(node *k8sv1.Node, vmisOnNode []*virtv1.VirtualMachineInstance, taint *k8sv1.Taint, migrateStorageDegraded bool) []*virtv1.VirtualMachineInstance {
 	var vmisToMigrate []*virtv1.VirtualMachineInstance
 	if nodeHasTaint(taint, node) {
-		vmisToMigrate = vmisOnNode
+		return vmisOnNode
 	} else if evictedVMIs := getMarkedForEvictionVMIs(vmisOnNode); len(evictedVMIs) > 0 {
 		vmisToMigrate = evictedVMIs
 	}
+	if migrateStorageDegraded {
+		vmisToMigrate = append(vmisToMigrate, getStorageDegradedVMIs(vmisOnNode)...)
+	}
 	return vmisToMigrate
 }
 
@@ -27,6 +27,7 @@ import (
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 var _ = Describe("Evacuation", func() {
@@ -544,6 +545,51 @@ var _ = Describe("Evacuation", func() {
 		// Ensure that we add checks for expected events to every test
 		Expect(recorder.Events).To(BeEmpty())
 	})
+
+	Context("VMIs with degraded storage", func() {
+		newControllerWithDegradedPolicy := func(policy v1.StorageDegradedPolicy) {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: []string{virtconfig.StorageHealthCheckGate},
+				},
+				StorageHealthCheck: &v1.StorageHealthCheckConfiguration{
+					DegradedPolicy: &policy,
+				},
+			})
+			controller, _ = NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
+		}
+
+		newVirtualMachineWithDegradedStorage := func(name string, nodeName string) *v1.VirtualMachineInstance {
+			vmi := newVirtualMachine(name, nodeName)
+			vmi.Spec.EvictionStrategy = newEvictionStrategyLiveMigrate()
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceStorageDegraded,
+				Status: k8sv1.ConditionTrue,
+			})
+			return vmi
+		}
+
+		It("should migrate the VMI if the degraded policy is LiveMigrate", func() {
+			newControllerWithDegradedPolicy(v1.StorageDegradedPolicyLiveMigrate)
+			node := newNode("foo")
+			addNode(node)
+			vmiFeeder.Add(newVirtualMachineWithDegradedStorage("testvm", node.Name))
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreation()
+		})
+
+		It("should do nothing if the degraded policy is not LiveMigrate", func() {
+			newControllerWithDegradedPolicy(v1.StorageDegradedPolicyPause)
+			node := newNode("foo")
+			addNode(node)
+			vmiFeeder.Add(newVirtualMachineWithDegradedStorage("testvm", node.Name))
+
+			sanityExecute()
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
 })
 
 func newNode(name string) *k8sv1.Node {
@@ -0,0 +1,430 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package gatewayroute
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/net/dns"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// InvalidRouteReason is the reason of the event recorded on a VirtualMachine whose route
+	// annotations are invalid
+	InvalidRouteReason = "InvalidRoute"
+
+	// ProtocolHTTP exposes the guest port through an HTTPRoute
+	ProtocolHTTP = "HTTP"
+	// ProtocolTCP exposes the guest port through a TCPRoute
+	ProtocolTCP = "TCP"
+
+	gatewayGroup = "gateway.networking.k8s.io"
+	servicePort  = "route"
+)
+
+var (
+	httpRouteGVR = schema.GroupVersionResource{Group: gatewayGroup, Version: "v1", Resource: "httproutes"}
+	tcpRouteGVR  = schema.GroupVersionResource{Group: gatewayGroup, Version: "v1alpha2", Resource: "tcproutes"}
+)
+
+// Controller exposes a guest port of the VMs asking for it through their annotations with a
+// Service and a Gateway API route bound to the configured Gateway
+type Controller struct {
+	clientset     kubecli.KubevirtClient
+	queue         workqueue.TypedRateLimitingInterface[string]
+	vmStore       cache.Store
+	recorder      record.EventRecorder
+	clusterConfig *virtconfig.ClusterConfig
+	hasSynced     func() bool
+}
+
+// route is the guest port a VM exposes, read from its annotations
+type route struct {
+	port     int32
+	protocol string
+	hostname string
+}
+
+// NewController creates a new instance of the gateway route controller.
+func NewController(clientset kubecli.KubevirtClient, vmInformer cache.SharedIndexInformer, recorder record.EventRecorder, clusterConfig *virtconfig.ClusterConfig) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-gatewayroute"},
+		),
+		vmStore:       vmInformer.GetStore(),
+		recorder:      recorder,
+		clusterConfig: clusterConfig,
+		hasSynced:     vmInformer.HasSynced,
+	}
+
+	_, err := vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addVirtualMachine,
+		UpdateFunc: c.updateVirtualMachine,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) addVirtualMachine(obj interface{}) {
+	vm := obj.(*virtv1.VirtualMachine)
+	if hasRoute(vm) {
+		c.enqueueVirtualMachine(vm)
+	}
+}
+
+// updateVirtualMachine enqueues the VMs asking for a route, and the VMs which no longer ask for
+// one to remove their route. The objects of the deleted VMs are garbage collected with them.
+func (c *Controller) updateVirtualMachine(old, curr interface{}) {
+	oldVM := old.(*virtv1.VirtualMachine)
+	currVM := curr.(*virtv1.VirtualMachine)
+	if hasRoute(oldVM) || hasRoute(currVM) {
+		c.enqueueVirtualMachine(currVM)
+	}
+}
+
+func (c *Controller) enqueueVirtualMachine(vm *virtv1.VirtualMachine) {
+	key, err := controller.KeyFunc(vm)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to extract key from virtualmachine.")
+		return
+	}
+	c.queue.Add(key)
+}
+
+func hasRoute(vm *virtv1.VirtualMachine) bool {
+	_, exists := vm.Annotations[virtv1.RoutePortAnnotation]
+	return exists
+}
+
+// Run runs the passed in gateway route controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting gateway route controller.")
+
+	// Wait for cache sync before we start the gateway route controller
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping gateway route controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute exposes the route of a VM from the queue, if there is an error it requeues the VM.
+// Returns false if the queue is shut down.
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.sync(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing route of VirtualMachine %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed route of VirtualMachine %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) sync(key string) error {
+	gateway := c.clusterConfig.GetGateway()
+	if !c.clusterConfig.VMGatewayRoutesEnabled() || gateway == nil {
+		return nil
+	}
+
+	obj, exists, err := c.vmStore.GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	vm := obj.(*virtv1.VirtualMachine)
+	if vm.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if !hasRoute(vm) {
+		return c.removeRoute(vm)
+	}
+	r, err := parseRoute(vm)
+	if err != nil {
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, InvalidRouteReason, "Invalid route annotations: %v", err)
+		return nil
+	}
+
+	if err := c.syncService(vm, r); err != nil {
+		return err
+	}
+
+	// A VM changing the protocol of its route drops the route of the former protocol
+	routeGVR, staleGVR := httpRouteGVR, tcpRouteGVR
+	if r.protocol == ProtocolTCP {
+		routeGVR, staleGVR = tcpRouteGVR, httpRouteGVR
+	}
+	if err := c.syncRoute(vm, routeGVR, newRoute(vm, r, gateway, routeGVR)); err != nil {
+		return err
+	}
+	return c.deleteRoute(vm, staleGVR)
+}
+
+func parseRoute(vm *virtv1.VirtualMachine) (*route, error) {
+	port, err := strconv.ParseInt(vm.Annotations[virtv1.RoutePortAnnotation], 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		return nil, fmt.Errorf("%s must be a port number", virtv1.RoutePortAnnotation)
+	}
+
+	protocol := strings.ToUpper(vm.Annotations[virtv1.RouteProtocolAnnotation])
+	switch protocol {
+	case "":
+		protocol = ProtocolHTTP
+	case ProtocolHTTP, ProtocolTCP:
+	default:
+		return nil, fmt.Errorf("%s must be %s or %s", virtv1.RouteProtocolAnnotation, ProtocolHTTP, ProtocolTCP)
+	}
+
+	hostname := vm.Annotations[virtv1.RouteHostnameAnnotation]
+	if hostname != "" && protocol != ProtocolHTTP {
+		return nil, fmt.Errorf("%s is only supported with the %s protocol", virtv1.RouteHostnameAnnotation, ProtocolHTTP)
+	}
+
+	return &route{port: int32(port), protocol: protocol, hostname: hostname}, nil
+}
+
+// routeName returns the name of the Service and of the route of a VM. The prefix keeps the name
+// of the Service a valid DNS label.
+func routeName(vm *virtv1.VirtualMachine) string {
+	return "route-" + vm.Name
+}
+
+// syncService creates or updates the Service selecting the virt-launcher pod of a VM, which is the
+// backend of its route
+func (c *Controller) syncService(vm *virtv1.VirtualMachine, r *route) error {
+	services := c.clientset.CoreV1().Services(vm.Namespace)
+	service, err := services.Get(context.Background(), routeName(vm), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		service = &k8sv1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      routeName(vm),
+				Namespace: vm.Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind),
+				},
+			},
+		}
+		setServiceSpec(service, vm, r)
+		if _, err := services.Create(context.Background(), service, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create the service of the route: %v", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(service, vm) {
+		return fmt.Errorf("service %s exists and is not owned by the VirtualMachine", service.Name)
+	}
+
+	updated := service.DeepCopy()
+	setServiceSpec(updated, vm, r)
+	if equality.Semantic.DeepEqual(service.Spec, updated.Spec) {
+		return nil
+	}
+	if _, err := services.Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update the service of the route: %v", err)
+	}
+	return nil
+}
+
+func setServiceSpec(service *k8sv1.Service, vm *virtv1.VirtualMachine, r *route) {
+	// virt-launcher labels its pod with the hostname of the guest, which is the name of the VM
+	// unless the VM sets a hostname
+	vmi := &virtv1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: vm.Name}}
+	if vm.Spec.Template != nil {
+		vmi.Spec.Hostname = vm.Spec.Template.Spec.Hostname
+	}
+	service.Spec.Selector = map[string]string{virtv1.VirtualMachineNameLabel: dns.SanitizeHostname(vmi)}
+	service.Spec.Ports = []k8sv1.ServicePort{{
+		Name:       servicePort,
+		Protocol:   k8sv1.ProtocolTCP,
+		Port:       r.port,
+		TargetPort: intstr.FromInt32(r.port),
+	}}
+}
+
+// newRoute returns the HTTPRoute or the TCPRoute of a VM, with the fields the API server
+// defaults set, so that it is not updated on every sync
+func newRoute(vm *virtv1.VirtualMachine, r *route, gateway *virtv1.GatewayReference, gvr schema.GroupVersionResource) *unstructured.Unstructured {
+	backendRef := map[string]interface{}{
+		"group":  "",
+		"kind":   "Service",
+		"name":   routeName(vm),
+		"port":   int64(r.port),
+		"weight": int64(1),
+	}
+	rule := map[string]interface{}{
+		"backendRefs": []interface{}{backendRef},
+	}
+	spec := map[string]interface{}{
+		"parentRefs": []interface{}{
+			map[string]interface{}{
+				"group":     gatewayGroup,
+				"kind":      "Gateway",
+				"namespace": gateway.Namespace,
+				"name":      gateway.Name,
+			},
+		},
+		"rules": []interface{}{rule},
+	}
+
+	kind := "TCPRoute"
+	if gvr == httpRouteGVR {
+		kind = "HTTPRoute"
+		rule["matches"] = []interface{}{
+			map[string]interface{}{
+				"path": map[string]interface{}{
+					"type":  "PathPrefix",
+					"value": "/",
+				},
+			},
+		}
+		if r.hostname != "" {
+			spec["hostnames"] = []interface{}{r.hostname}
+		}
+	}
+
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	obj.SetAPIVersion(gvr.GroupVersion().String())
+	obj.SetKind(kind)
+	obj.SetName(routeName(vm))
+	obj.SetNamespace(vm.Namespace)
+	obj.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind),
+	})
+	return obj
+}
+
+func (c *Controller) syncRoute(vm *virtv1.VirtualMachine, gvr schema.GroupVersionResource, desired *unstructured.Unstructured) error {
+	routes := c.clientset.DynamicClient().Resource(gvr).Namespace(vm.Namespace)
+	current, err := routes.Get(context.Background(), desired.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if _, err := routes.Create(context.Background(), desired, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create the %s of the VirtualMachine: %v", desired.GetKind(), err)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(current, vm) {
+		return fmt.Errorf("%s %s exists and is not owned by the VirtualMachine", desired.GetKind(), current.GetName())
+	}
+
+	if equality.Semantic.DeepEqual(current.Object["spec"], desired.Object["spec"]) {
+		return nil
+	}
+	current.Object["spec"] = desired.Object["spec"]
+	if _, err := routes.Update(context.Background(), current, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update the %s of the VirtualMachine: %v", desired.GetKind(), err)
+	}
+	return nil
+}
+
+// removeRoute deletes the route and the Service of a VM which no longer asks for a route
+func (c *Controller) removeRoute(vm *virtv1.VirtualMachine) error {
+	for _, gvr := range []schema.GroupVersionResource{httpRouteGVR, tcpRouteGVR} {
+		if err := c.deleteRoute(vm, gvr); err != nil {
+			return err
+		}
+	}
+
+	services := c.clientset.CoreV1().Services(vm.Namespace)
+	service, err := services.Get(context.Background(), routeName(vm), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(service, vm) {
+		return nil
+	}
+	err = services.Delete(context.Background(), service.Name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete the service of the route: %v", err)
+	}
+	return nil
+}
+
+// deleteRoute deletes the route of a VM of the given kind, if any
+func (c *Controller) deleteRoute(vm *virtv1.VirtualMachine, gvr schema.GroupVersionResource) error {
+	routes := c.clientset.DynamicClient().Resource(gvr).Namespace(vm.Namespace)
+	current, err := routes.Get(context.Background(), routeName(vm), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(current, vm) {
+		return nil
+	}
+	err = routes.Delete(context.Background(), current.GetName(), metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete the %s of the VirtualMachine: %v", gvr.Resource, err)
+	}
+	return nil
+}
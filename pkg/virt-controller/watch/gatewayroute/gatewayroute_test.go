@@ -0,0 +1,344 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package gatewayroute
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	vmKey      = "default/testvm"
+	objectName = "route-testvm"
+)
+
+var _ = Describe("Gateway route controller", func() {
+	var (
+		kubeClient    *fake.Clientset
+		dynamicClient *fakeDynamicClient
+		vmInformer    cache.SharedIndexInformer
+		recorder      *record.FakeRecorder
+		controller    *Controller
+		vm            *v1.VirtualMachine
+	)
+
+	newController := func(gateway *v1.GatewayReference, featureGates ...string) {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().DynamicClient().Return(dynamicClient).AnyTimes()
+
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+			NetworkConfiguration:   &v1.NetworkConfiguration{Gateway: gateway},
+		})
+
+		var err error
+		controller, err = NewController(virtClient, vmInformer, recorder, clusterConfig)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		kubeClient = fake.NewSimpleClientset()
+		dynamicClient = newFakeDynamicClient()
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		recorder = record.NewFakeRecorder(100)
+
+		vm = &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testvm",
+				Namespace: metav1.NamespaceDefault,
+				UID:       "vm-uid",
+				Annotations: map[string]string{
+					v1.RoutePortAnnotation:     "8080",
+					v1.RouteHostnameAnnotation: "web.example.com",
+				},
+			},
+			Spec: v1.VirtualMachineSpec{
+				Template: &v1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+
+		newController(&v1.GatewayReference{Namespace: "infra", Name: "public"}, virtconfig.VMGatewayRoutesGate)
+	})
+
+	execute := func() {
+		controller.queue.Add(vmKey)
+		Expect(controller.Execute()).To(BeTrue())
+		Expect(controller.queue.NumRequeues(vmKey)).To(BeZero())
+	}
+
+	updateVM := func(annotations map[string]string) {
+		vm.Annotations = annotations
+		Expect(vmInformer.GetStore().Update(vm)).To(Succeed())
+	}
+
+	getService := func() *k8sv1.Service {
+		service, err := kubeClient.CoreV1().Services(metav1.NamespaceDefault).Get(context.Background(), objectName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return service
+	}
+
+	It("should expose the guest port through a service and an HTTPRoute of the gateway", func() {
+		execute()
+
+		service := getService()
+		Expect(service.OwnerReferences).To(ConsistOf(HaveField("UID", vm.UID)))
+		Expect(service.Spec.Selector).To(Equal(map[string]string{v1.VirtualMachineNameLabel: "testvm"}))
+		Expect(service.Spec.Ports).To(ConsistOf(And(
+			HaveField("Port", int32(8080)),
+			HaveField("TargetPort.IntVal", int32(8080)),
+		)))
+
+		route := dynamicClient.objects(httpRouteGVR)[objectName]
+		Expect(route).ToNot(BeNil())
+		Expect(route.GetKind()).To(Equal("HTTPRoute"))
+		Expect(route.GetOwnerReferences()).To(ConsistOf(HaveField("UID", vm.UID)))
+		hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+		Expect(hostnames).To(Equal([]string{"web.example.com"}))
+		parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+		Expect(parentRefs).To(ConsistOf(And(
+			HaveKeyWithValue("namespace", "infra"),
+			HaveKeyWithValue("name", "public"),
+		)))
+		rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+		Expect(rules).To(HaveLen(1))
+		Expect(rules[0]).To(HaveKeyWithValue("backendRefs", ConsistOf(And(
+			HaveKeyWithValue("name", objectName),
+			HaveKeyWithValue("port", int64(8080)),
+		))))
+	})
+
+	It("should select the pod by the hostname of the VM", func() {
+		vm.Spec.Template.Spec.Hostname = "web"
+		Expect(vmInformer.GetStore().Update(vm)).To(Succeed())
+
+		execute()
+
+		Expect(getService().Spec.Selector).To(Equal(map[string]string{v1.VirtualMachineNameLabel: "web"}))
+	})
+
+	It("should expose the guest port through a TCPRoute", func() {
+		updateVM(map[string]string{
+			v1.RoutePortAnnotation:     "5432",
+			v1.RouteProtocolAnnotation: "tcp",
+		})
+
+		execute()
+
+		route := dynamicClient.objects(tcpRouteGVR)[objectName]
+		Expect(route).ToNot(BeNil())
+		Expect(route.GetKind()).To(Equal("TCPRoute"))
+		Expect(dynamicClient.objects(httpRouteGVR)).To(BeEmpty())
+	})
+
+	It("should replace the HTTPRoute when the protocol changes", func() {
+		execute()
+		updateVM(map[string]string{
+			v1.RoutePortAnnotation:     "8080",
+			v1.RouteProtocolAnnotation: ProtocolTCP,
+		})
+
+		execute()
+
+		Expect(dynamicClient.objects(httpRouteGVR)).To(BeEmpty())
+		Expect(dynamicClient.objects(tcpRouteGVR)).To(HaveKey(objectName))
+	})
+
+	It("should update the service and the route when the port changes", func() {
+		execute()
+		updateVM(map[string]string{v1.RoutePortAnnotation: "9090"})
+
+		execute()
+
+		Expect(getService().Spec.Ports[0].Port).To(Equal(int32(9090)))
+		rules, _, _ := unstructured.NestedSlice(dynamicClient.objects(httpRouteGVR)[objectName].Object, "spec", "rules")
+		Expect(rules[0]).To(HaveKeyWithValue("backendRefs", ConsistOf(HaveKeyWithValue("port", int64(9090)))))
+	})
+
+	It("should not update the route which is up to date", func() {
+		execute()
+		kubeClient.ClearActions()
+		dynamicClient.updates = 0
+
+		execute()
+
+		Expect(dynamicClient.updates).To(BeZero())
+		for _, action := range kubeClient.Actions() {
+			Expect(action.GetVerb()).To(Equal("get"))
+		}
+	})
+
+	It("should remove the service and the route when the VM no longer asks for a route", func() {
+		execute()
+		updateVM(nil)
+
+		execute()
+
+		_, err := kubeClient.CoreV1().Services(metav1.NamespaceDefault).Get(context.Background(), objectName, metav1.GetOptions{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		Expect(dynamicClient.objects(httpRouteGVR)).To(BeEmpty())
+	})
+
+	It("should not take over a service it does not own", func() {
+		_, err := kubeClient.CoreV1().Services(metav1.NamespaceDefault).Create(context.Background(), &k8sv1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: objectName, Namespace: metav1.NamespaceDefault},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		controller.queue.Add(vmKey)
+		Expect(controller.Execute()).To(BeTrue())
+
+		Expect(controller.queue.NumRequeues(vmKey)).To(Equal(1))
+		Expect(getService().Spec.Ports).To(BeEmpty())
+	})
+
+	DescribeTable("should record an event for invalid annotations", func(annotations map[string]string) {
+		updateVM(annotations)
+
+		execute()
+
+		testutils.ExpectEvent(recorder, InvalidRouteReason)
+		Expect(dynamicClient.objects(httpRouteGVR)).To(BeEmpty())
+	},
+		Entry("with a port which is not a number", map[string]string{v1.RoutePortAnnotation: "http"}),
+		Entry("with a port out of range", map[string]string{v1.RoutePortAnnotation: "70000"}),
+		Entry("with an unknown protocol", map[string]string{v1.RoutePortAnnotation: "80", v1.RouteProtocolAnnotation: "UDP"}),
+		Entry("with a hostname on a TCP route", map[string]string{
+			v1.RoutePortAnnotation:     "80",
+			v1.RouteProtocolAnnotation: ProtocolTCP,
+			v1.RouteHostnameAnnotation: "web.example.com",
+		}),
+	)
+
+	It("should do nothing without the feature gate", func() {
+		newController(&v1.GatewayReference{Namespace: "infra", Name: "public"})
+
+		execute()
+
+		Expect(kubeClient.Actions()).To(BeEmpty())
+		Expect(dynamicClient.objects(httpRouteGVR)).To(BeEmpty())
+	})
+
+	It("should do nothing without gateway", func() {
+		newController(nil, virtconfig.VMGatewayRoutesGate)
+
+		execute()
+
+		Expect(kubeClient.Actions()).To(BeEmpty())
+	})
+
+	It("should only enqueue the VMs asking or which asked for a route", func() {
+		plain := &v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "plain", Namespace: metav1.NamespaceDefault}}
+		controller.addVirtualMachine(plain)
+		controller.updateVirtualMachine(plain, plain)
+		Expect(controller.queue.Len()).To(BeZero())
+
+		controller.updateVirtualMachine(vm, plain)
+		Expect(controller.queue.Len()).To(Equal(1))
+	})
+})
+
+// fakeDynamicClient stores the routes of a single namespace, as the dynamic fake client of
+// client-go is not vendored
+type fakeDynamicClient struct {
+	dynamic.Interface
+	resources map[schema.GroupVersionResource]*fakeResource
+	updates   int
+}
+
+type fakeResource struct {
+	dynamic.NamespaceableResourceInterface
+	client  *fakeDynamicClient
+	gvr     schema.GroupVersionResource
+	objects map[string]*unstructured.Unstructured
+}
+
+func newFakeDynamicClient() *fakeDynamicClient {
+	return &fakeDynamicClient{resources: map[schema.GroupVersionResource]*fakeResource{}}
+}
+
+func (c *fakeDynamicClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	if _, exists := c.resources[gvr]; !exists {
+		c.resources[gvr] = &fakeResource{client: c, gvr: gvr, objects: map[string]*unstructured.Unstructured{}}
+	}
+	return c.resources[gvr]
+}
+
+func (c *fakeDynamicClient) objects(gvr schema.GroupVersionResource) map[string]*unstructured.Unstructured {
+	return c.Resource(gvr).(*fakeResource).objects
+}
+
+func (r *fakeResource) Namespace(string) dynamic.ResourceInterface {
+	return r
+}
+
+func (r *fakeResource) Get(_ context.Context, name string, _ metav1.GetOptions, _ ...string) (*unstructured.Unstructured, error) {
+	obj, exists := r.objects[name]
+	if !exists {
+		return nil, errors.NewNotFound(r.gvr.GroupResource(), name)
+	}
+	return obj.DeepCopy(), nil
+}
+
+func (r *fakeResource) Create(_ context.Context, obj *unstructured.Unstructured, _ metav1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+	if _, exists := r.objects[obj.GetName()]; exists {
+		return nil, errors.NewAlreadyExists(r.gvr.GroupResource(), obj.GetName())
+	}
+	r.objects[obj.GetName()] = obj.DeepCopy()
+	return obj, nil
+}
+
+func (r *fakeResource) Update(_ context.Context, obj *unstructured.Unstructured, _ metav1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+	if _, exists := r.objects[obj.GetName()]; !exists {
+		return nil, errors.NewNotFound(r.gvr.GroupResource(), obj.GetName())
+	}
+	r.client.updates++
+	r.objects[obj.GetName()] = obj.DeepCopy()
+	return obj, nil
+}
+
+func (r *fakeResource) Delete(_ context.Context, name string, _ metav1.DeleteOptions, _ ...string) error {
+	if _, exists := r.objects[name]; !exists {
+		return errors.NewNotFound(r.gvr.GroupResource(), name)
+	}
+	delete(r.objects, name)
+	return nil
+}
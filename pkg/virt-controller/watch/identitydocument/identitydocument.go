@@ -0,0 +1,370 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package identitydocument
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/identitydocument"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const keyLength = 32
+
+// Controller issues the signed identity documents of the VMIs asking for one into a secret in their
+// namespace, which virt-launcher hands to the guest, and rotates them before they expire.
+type Controller struct {
+	clientset         kubecli.KubevirtClient
+	queue             workqueue.TypedRateLimitingInterface[string]
+	vmiStore          cache.Indexer
+	namespaceStore    cache.Store
+	clusterConfig     *virtconfig.ClusterConfig
+	kubevirtNamespace string
+	hasSynced         func() bool
+
+	keyLock sync.Mutex
+	key     []byte
+
+	// issued holds the last identity issued to every VMI, to tell when its document has to be
+	// issued again. It is lost on restart, so that all the documents are issued again then.
+	issuedLock sync.Mutex
+	issued     map[string]*virtv1.VirtualMachineInstanceIdentity
+
+	now func() time.Time
+}
+
+// NewController creates a new instance of the identity document controller.
+func NewController(clientset kubecli.KubevirtClient, vmiInformer cache.SharedIndexInformer, namespaceInformer cache.SharedIndexInformer, clusterConfig *virtconfig.ClusterConfig, kubevirtNamespace string) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-identitydocument"},
+		),
+		vmiStore:          vmiInformer.GetIndexer(),
+		namespaceStore:    namespaceInformer.GetStore(),
+		clusterConfig:     clusterConfig,
+		kubevirtNamespace: kubevirtNamespace,
+		issued:            map[string]*virtv1.VirtualMachineInstanceIdentity{},
+		now:               time.Now,
+	}
+
+	c.hasSynced = func() bool {
+		return vmiInformer.HasSynced() && namespaceInformer.HasSynced()
+	}
+
+	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVirtualMachineInstance,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueVirtualMachineInstance(curr) },
+		DeleteFunc: c.deleteVirtualMachineInstance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = namespaceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.updateNamespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueVirtualMachineInstance(obj interface{}) {
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if vmi.Spec.IdentityDocument == nil {
+		return
+	}
+	key, err := controller.KeyFunc(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to extract key from virtualmachineinstance.")
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *Controller) deleteVirtualMachineInstance(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from deleted virtualmachineinstance.")
+		return
+	}
+	c.forget(key)
+}
+
+// updateNamespace enqueues the VMIs of a namespace whose labels changed, as their documents
+// carry the labels of their namespace
+func (c *Controller) updateNamespace(old, curr interface{}) {
+	oldNamespace := old.(*k8sv1.Namespace)
+	currNamespace := curr.(*k8sv1.Namespace)
+	if equality.Semantic.DeepEqual(oldNamespace.Labels, currNamespace.Labels) {
+		return
+	}
+
+	objs, err := c.vmiStore.ByIndex(cache.NamespaceIndex, currNamespace.Name)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to list the virtualmachineinstances of namespace %s", currNamespace.Name)
+		return
+	}
+	for _, obj := range objs {
+		c.enqueueVirtualMachineInstance(obj)
+	}
+}
+
+// Run runs the passed in identity document controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting identity document controller.")
+
+	// Wait for cache sync before we start the identity document controller
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping identity document controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute issues the identity document of a VMI from the queue, if there is an error it requeues
+// the VMI. Returns false if the queue is shut down.
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.sync(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing identity document of VirtualMachineInstance %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed identity document of VirtualMachineInstance %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) sync(key string) error {
+	obj, exists, err := c.vmiStore.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// The secret is owned by the VMI and garbage collected with it
+		c.forget(key)
+		return nil
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if vmi.Spec.IdentityDocument == nil || vmi.IsFinal() || vmi.DeletionTimestamp != nil || !c.clusterConfig.VMIdentityDocumentsEnabled() {
+		c.forget(key)
+		return nil
+	}
+
+	identity, err := c.newIdentity(vmi)
+	if err != nil {
+		return err
+	}
+
+	now := c.now()
+	rotation := identitydocument.Validity / 2
+	if issued := c.getIssued(key); issued != nil && !needsReissue(issued, identity, now) {
+		c.queue.AddAfter(key, issued.ExpiresAt.Sub(now)-rotation)
+		return nil
+	}
+
+	identity.IssuedAt = metav1.NewTime(now)
+	identity.ExpiresAt = metav1.NewTime(now.Add(identitydocument.Validity))
+	if err := c.issue(vmi, identity); err != nil {
+		return err
+	}
+	c.setIssued(key, identity)
+	log.Log.Object(vmi).V(3).Infof("Issued an identity document valid until %s", identity.ExpiresAt)
+
+	// Rotate the document halfway through its validity, so that the guest gets the new one in time
+	c.queue.AddAfter(key, rotation)
+	return nil
+}
+
+// newIdentity returns the identity of a VMI, without the validity of its document
+func (c *Controller) newIdentity(vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachineInstanceIdentity, error) {
+	obj, exists, err := c.namespaceStore.GetByKey(vmi.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("namespace %s of the VirtualMachineInstance does not exist", vmi.Namespace)
+	}
+	namespace := obj.(*k8sv1.Namespace)
+
+	identity := &virtv1.VirtualMachineInstanceIdentity{
+		UID:             vmi.UID,
+		Name:            vmi.Name,
+		Namespace:       vmi.Namespace,
+		Labels:          vmi.Labels,
+		NamespaceLabels: namespace.Labels,
+	}
+	for _, transition := range vmi.Status.PhaseTransitionTimestamps {
+		if transition.Phase == virtv1.Running {
+			bootTime := transition.PhaseTransitionTimestamp
+			identity.BootTime = &bootTime
+			break
+		}
+	}
+	return identity, nil
+}
+
+// needsReissue tells whether the document of a VMI has to be issued again, because it is due for
+// rotation or because the identity of the VMI changed since it was issued
+func needsReissue(issued, identity *virtv1.VirtualMachineInstanceIdentity, now time.Time) bool {
+	if !now.Before(issued.ExpiresAt.Add(-identitydocument.Validity / 2)) {
+		return true
+	}
+	return issued.UID != identity.UID ||
+		(issued.BootTime == nil) != (identity.BootTime == nil) ||
+		!equality.Semantic.DeepEqual(issued.Labels, identity.Labels) ||
+		!equality.Semantic.DeepEqual(issued.NamespaceLabels, identity.NamespaceLabels)
+}
+
+// issue signs the identity of a VMI and stores the document in the secret of the VMI, which is
+// owned by the VMI and garbage collected with it
+func (c *Controller) issue(vmi *virtv1.VirtualMachineInstance, identity *virtv1.VirtualMachineInstanceIdentity) error {
+	key, err := c.getKey()
+	if err != nil {
+		return err
+	}
+	signed, err := identitydocument.Sign(key, identity)
+	if err != nil {
+		return err
+	}
+	document, err := json.Marshal(signed)
+	if err != nil {
+		return err
+	}
+
+	secret := &k8sv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      identitydocument.SecretName(vmi),
+			Namespace: vmi.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vmi, virtv1.VirtualMachineInstanceGroupVersionKind),
+			},
+		},
+		Data: map[string][]byte{
+			identitydocument.DocumentSecretKey: document,
+		},
+	}
+	secrets := c.clientset.CoreV1().Secrets(vmi.Namespace)
+	_, err = secrets.Create(context.Background(), secret, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		_, err = secrets.Update(context.Background(), secret, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to store the identity document: %v", err)
+	}
+	return nil
+}
+
+// getKey returns the key the identity documents are signed with, and generates it on first use
+func (c *Controller) getKey() ([]byte, error) {
+	c.keyLock.Lock()
+	defer c.keyLock.Unlock()
+
+	if c.key != nil {
+		return c.key, nil
+	}
+
+	secrets := c.clientset.CoreV1().Secrets(c.kubevirtNamespace)
+	secret, err := secrets.Get(context.Background(), identitydocument.KeySecretName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		key := make([]byte, keyLength)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		secret, err = secrets.Create(context.Background(), &k8sv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      identitydocument.KeySecretName,
+				Namespace: c.kubevirtNamespace,
+			},
+			Data: map[string][]byte{
+				identitydocument.KeySecretKey: key,
+			},
+		}, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) {
+			secret, err = secrets.Get(context.Background(), identitydocument.KeySecretName, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the identity document signing key: %v", err)
+	}
+
+	key := secret.Data[identitydocument.KeySecretKey]
+	if len(key) == 0 {
+		return nil, fmt.Errorf("secret %s/%s holds no signing key", c.kubevirtNamespace, identitydocument.KeySecretName)
+	}
+	c.key = key
+	return key, nil
+}
+
+func (c *Controller) getIssued(key string) *virtv1.VirtualMachineInstanceIdentity {
+	c.issuedLock.Lock()
+	defer c.issuedLock.Unlock()
+	return c.issued[key]
+}
+
+func (c *Controller) setIssued(key string, identity *virtv1.VirtualMachineInstanceIdentity) {
+	c.issuedLock.Lock()
+	defer c.issuedLock.Unlock()
+	c.issued[key] = identity
+}
+
+func (c *Controller) forget(key string) {
+	c.issuedLock.Lock()
+	defer c.issuedLock.Unlock()
+	delete(c.issued, key)
+}
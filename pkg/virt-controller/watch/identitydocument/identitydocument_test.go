@@ -0,0 +1,219 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package identitydocument
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/identitydocument"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	kubevirtNamespace = "kubevirt"
+	vmiKey            = "default/testvmi"
+)
+
+var _ = Describe("Identity document controller", func() {
+	var (
+		kubeClient        *fake.Clientset
+		vmiInformer       cache.SharedIndexInformer
+		namespaceInformer cache.SharedIndexInformer
+		controller        *Controller
+		now               time.Time
+		vmi               *v1.VirtualMachineInstance
+	)
+
+	newController := func(featureGates ...string) {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+
+		var err error
+		controller, err = NewController(virtClient, vmiInformer, namespaceInformer, clusterConfig, kubevirtNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		controller.now = func() time.Time { return now }
+	}
+
+	BeforeEach(func() {
+		kubeClient = fake.NewSimpleClientset()
+		vmiInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+		namespaceInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		now = time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+		Expect(namespaceInformer.GetStore().Add(&k8sv1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   metav1.NamespaceDefault,
+				Labels: map[string]string{"project": "billing"},
+			},
+		})).To(Succeed())
+
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testvmi",
+				Namespace: metav1.NamespaceDefault,
+				UID:       "vmi-uid",
+				Labels:    map[string]string{"app": "db"},
+			},
+			Spec: v1.VirtualMachineInstanceSpec{
+				IdentityDocument: &v1.IdentityDocument{},
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase: v1.Scheduled,
+			},
+		}
+		Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+
+		newController(virtconfig.VMIdentityDocumentsGate)
+	})
+
+	execute := func() {
+		controller.queue.Add(vmiKey)
+		Expect(controller.Execute()).To(BeTrue())
+		Expect(controller.queue.NumRequeues(vmiKey)).To(BeZero())
+	}
+
+	getKey := func() []byte {
+		secret, err := kubeClient.CoreV1().Secrets(kubevirtNamespace).Get(context.Background(), identitydocument.KeySecretName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return secret.Data[identitydocument.KeySecretKey]
+	}
+
+	getIdentity := func() *v1.VirtualMachineInstanceIdentity {
+		secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), identitydocument.SecretName(vmi), metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.OwnerReferences).To(ConsistOf(HaveField("UID", vmi.UID)))
+
+		signed := &v1.SignedIdentityDocument{}
+		Expect(json.Unmarshal(secret.Data[identitydocument.DocumentSecretKey], signed)).To(Succeed())
+		identity, err := identitydocument.Verify(getKey(), signed, now)
+		Expect(err).ToNot(HaveOccurred())
+		return identity
+	}
+
+	It("should issue a signed identity document into the secret of the VMI", func() {
+		execute()
+
+		identity := getIdentity()
+		Expect(identity.UID).To(Equal(vmi.UID))
+		Expect(identity.Name).To(Equal("testvmi"))
+		Expect(identity.Namespace).To(Equal(metav1.NamespaceDefault))
+		Expect(identity.Labels).To(Equal(map[string]string{"app": "db"}))
+		Expect(identity.NamespaceLabels).To(Equal(map[string]string{"project": "billing"}))
+		Expect(identity.BootTime).To(BeNil())
+		Expect(identity.ExpiresAt.Time).To(BeTemporally("==", now.Add(identitydocument.Validity)))
+	})
+
+	It("should sign the documents with the existing key", func() {
+		_, err := kubeClient.CoreV1().Secrets(kubevirtNamespace).Create(context.Background(), &k8sv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: identitydocument.KeySecretName, Namespace: kubevirtNamespace},
+			Data:       map[string][]byte{identitydocument.KeySecretKey: []byte("existing key")},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		execute()
+
+		Expect(getKey()).To(Equal([]byte("existing key")))
+		Expect(getIdentity().UID).To(Equal(vmi.UID))
+	})
+
+	It("should not issue the document again before it is due for rotation", func() {
+		execute()
+		issuedAt := getIdentity().IssuedAt
+
+		now = now.Add(identitydocument.Validity/2 - time.Minute)
+		execute()
+		Expect(getIdentity().IssuedAt.Time).To(BeTemporally("==", issuedAt.Time))
+	})
+
+	It("should rotate the document halfway through its validity", func() {
+		execute()
+
+		now = now.Add(identitydocument.Validity / 2)
+		execute()
+		Expect(getIdentity().IssuedAt.Time).To(BeTemporally("==", now))
+	})
+
+	It("should issue the document again once the VMI booted", func() {
+		execute()
+
+		bootTime := metav1.NewTime(now.Add(time.Minute))
+		vmi.Status.Phase = v1.Running
+		vmi.Status.PhaseTransitionTimestamps = []v1.VirtualMachineInstancePhaseTransitionTimestamp{
+			{Phase: v1.Scheduled, PhaseTransitionTimestamp: metav1.NewTime(now)},
+			{Phase: v1.Running, PhaseTransitionTimestamp: bootTime},
+		}
+		Expect(vmiInformer.GetIndexer().Update(vmi)).To(Succeed())
+		execute()
+
+		identity := getIdentity()
+		Expect(identity.BootTime).ToNot(BeNil())
+		Expect(identity.BootTime.Time).To(BeTemporally("==", bootTime.Time))
+	})
+
+	It("should issue the document again once the labels of the namespace changed", func() {
+		execute()
+
+		oldNamespace, _, err := namespaceInformer.GetStore().GetByKey(metav1.NamespaceDefault)
+		Expect(err).ToNot(HaveOccurred())
+		namespace := oldNamespace.(*k8sv1.Namespace).DeepCopy()
+		namespace.Labels = map[string]string{"project": "payroll"}
+		Expect(namespaceInformer.GetStore().Update(namespace)).To(Succeed())
+
+		controller.updateNamespace(oldNamespace, namespace)
+		Expect(controller.queue.Len()).To(Equal(1))
+		Expect(controller.Execute()).To(BeTrue())
+
+		Expect(getIdentity().NamespaceLabels).To(Equal(map[string]string{"project": "payroll"}))
+	})
+
+	DescribeTable("should not issue a document", func(modify func()) {
+		modify()
+		execute()
+
+		_, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), identitydocument.SecretName(vmi), metav1.GetOptions{})
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+	},
+		Entry("when the feature gate is disabled", func() { newController() }),
+		Entry("when the VMI does not ask for one", func() { vmi.Spec.IdentityDocument = nil }),
+		Entry("when the VMI is final", func() { vmi.Status.Phase = v1.Succeeded }),
+	)
+})
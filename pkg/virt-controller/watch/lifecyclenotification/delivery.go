@@ -0,0 +1,213 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package lifecyclenotification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	notificationsv1alpha1 "kubevirt.io/api/notifications/v1alpha1"
+)
+
+const (
+	// SigningKeySecretName is the secret in the KubeVirt namespace holding the key
+	// the signing keys of all the LifecycleNotifications are derived from.
+	SigningKeySecretName = "kubevirt-lifecycle-notification-key"
+	// SigningKeySecretSuffix is appended to the name of a LifecycleNotification to get the name of
+	// the secret in its namespace holding the key its notifications are signed with.
+	SigningKeySecretSuffix = "-signing-key"
+	// SigningKeySecretKey is the key of the signing key in the secrets
+	SigningKeySecretKey = "key"
+
+	// EventHeader carries the lifecycle event of a notification
+	EventHeader = "X-KubeVirt-Event"
+	// DeliveryHeader carries the unique ID of a delivery, which is kept across the retries
+	DeliveryHeader = "X-KubeVirt-Delivery"
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the body, prefixed with "sha256="
+	SignatureHeader = "X-KubeVirt-Signature"
+
+	signingKeyLength = 32
+)
+
+func newHTTPClient(notification *notificationsv1alpha1.LifecycleNotification) (*http.Client, error) {
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if len(notification.Spec.CABundle) > 0 && !rootCAs.AppendCertsFromPEM(notification.Spec.CABundle) {
+		return nil, fmt.Errorf("no valid certificate in the CA bundle")
+	}
+
+	return &http.Client{
+		Timeout: deliveryTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs:    rootCAs,
+				MinVersion: tls.VersionTLS12,
+			},
+			DisableKeepAlives: true,
+		},
+		// Redirects could forward the signed notifications to another endpoint
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}, nil
+}
+
+// deliver posts the signed payload of the delivery to the endpoint of the LifecycleNotification
+func (c *Controller) deliver(notification *notificationsv1alpha1.LifecycleNotification, d delivery) error {
+	key, err := c.notificationSigningKey(notification)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.newHTTPClient(notification)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, notification.Spec.URL, bytes.NewBufferString(d.payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(d.event))
+	req.Header.Set(DeliveryHeader, d.id)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(key, []byte(d.payload)))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("endpoint responded with status %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the payload
+func Sign(key, payload []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// notificationSigningKey derives the key the notifications of a LifecycleNotification are signed with
+// from the signing key of KubeVirt and the UID of the LifecycleNotification
+func (c *Controller) notificationSigningKey(notification *notificationsv1alpha1.LifecycleNotification) ([]byte, error) {
+	key, err := c.getSigningKey()
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(notification.UID))
+	return mac.Sum(nil), nil
+}
+
+// getSigningKey returns the signing key of KubeVirt, and generates it on first use
+func (c *Controller) getSigningKey() ([]byte, error) {
+	c.signingKeyLock.Lock()
+	defer c.signingKeyLock.Unlock()
+
+	if c.signingKey != nil {
+		return c.signingKey, nil
+	}
+
+	secrets := c.clientset.CoreV1().Secrets(c.kubevirtNamespace)
+	secret, err := secrets.Get(context.Background(), SigningKeySecretName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		key := make([]byte, signingKeyLength)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		secret, err = secrets.Create(context.Background(), &k8sv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      SigningKeySecretName,
+				Namespace: c.kubevirtNamespace,
+			},
+			Data: map[string][]byte{
+				SigningKeySecretKey: key,
+			},
+		}, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) {
+			secret, err = secrets.Get(context.Background(), SigningKeySecretName, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the lifecycle notification signing key: %v", err)
+	}
+
+	key := secret.Data[SigningKeySecretKey]
+	if len(key) == 0 {
+		return nil, fmt.Errorf("secret %s/%s holds no signing key", c.kubevirtNamespace, SigningKeySecretName)
+	}
+	c.signingKey = key
+	return key, nil
+}
+
+// publishKey creates the secret holding the signing key of a LifecycleNotification in its namespace,
+// so that its endpoint can be given the key to verify the notifications.
+func (c *Controller) publishKey(key string) error {
+	obj, exists, err := c.notificationStore.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// The secret is owned by the LifecycleNotification and garbage collected with it
+		return nil
+	}
+	notification := obj.(*notificationsv1alpha1.LifecycleNotification)
+
+	signingKey, err := c.notificationSigningKey(notification)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.clientset.CoreV1().Secrets(notification.Namespace).Create(context.Background(), &k8sv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      notification.Name + SigningKeySecretSuffix,
+			Namespace: notification.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(notification, notificationsv1alpha1.LifecycleNotificationKind),
+			},
+		},
+		Data: map[string][]byte{
+			SigningKeySecretKey: signingKey,
+		},
+	}, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,348 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package lifecyclenotification
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	notificationsv1alpha1 "kubevirt.io/api/notifications/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// DeliveryFailedReason is the reason of the event recorded on a LifecycleNotification when a notification
+	// could not be delivered after all the retries.
+	DeliveryFailedReason = "LifecycleNotificationDeliveryFailed"
+
+	defaultMaxRetries = 5
+	deliveryTimeout   = 10 * time.Second
+)
+
+// Notification is the JSON payload posted to the endpoint of a LifecycleNotification
+type Notification struct {
+	Event      notificationsv1alpha1.LifecycleEvent `json:"event"`
+	Timestamp  metav1.Time                          `json:"timestamp"`
+	Namespace  string                               `json:"namespace"`
+	Name       string                               `json:"name"`
+	UID        types.UID                            `json:"uid"`
+	NodeName   string                               `json:"nodeName,omitempty"`
+	SourceNode string                               `json:"sourceNode,omitempty"`
+	TargetNode string                               `json:"targetNode,omitempty"`
+	Reason     string                               `json:"reason,omitempty"`
+}
+
+// delivery is a notification which has to be posted to the endpoint of a LifecycleNotification
+type delivery struct {
+	notificationKey string
+	id              string
+	event           notificationsv1alpha1.LifecycleEvent
+	vmiName         string
+	payload         string
+}
+
+// Controller posts the lifecycle events of the VMIs to the endpoints registered by the LifecycleNotifications
+// of their namespace, and publishes the keys the notifications are signed with.
+type Controller struct {
+	clientset         kubecli.KubevirtClient
+	deliveryQueue     workqueue.TypedRateLimitingInterface[delivery]
+	keyQueue          workqueue.TypedRateLimitingInterface[string]
+	notificationStore cache.Indexer
+	recorder          record.EventRecorder
+	kubevirtNamespace string
+	hasSynced         func() bool
+
+	signingKeyLock sync.Mutex
+	signingKey     []byte
+
+	newHTTPClient func(notification *notificationsv1alpha1.LifecycleNotification) (*http.Client, error)
+}
+
+// NewController creates a new instance of the LifecycleNotification controller.
+func NewController(clientset kubecli.KubevirtClient, notificationInformer cache.SharedIndexInformer, vmiInformer cache.SharedIndexInformer, recorder record.EventRecorder, kubevirtNamespace string) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		deliveryQueue: workqueue.NewTypedRateLimitingQueueWithConfig[delivery](
+			workqueue.NewTypedItemExponentialFailureRateLimiter[delivery](time.Second, 5*time.Minute),
+			workqueue.TypedRateLimitingQueueConfig[delivery]{Name: "virt-controller-lifecyclenotification-delivery"},
+		),
+		keyQueue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-lifecyclenotification-key"},
+		),
+		notificationStore: notificationInformer.GetIndexer(),
+		recorder:          recorder,
+		kubevirtNamespace: kubevirtNamespace,
+		newHTTPClient:     newHTTPClient,
+	}
+
+	c.hasSynced = func() bool {
+		return notificationInformer.HasSynced() && vmiInformer.HasSynced()
+	}
+
+	_, err := notificationInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addNotification,
+		UpdateFunc: c.updateNotification,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.updateVirtualMachineInstance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) addNotification(obj interface{}) {
+	c.enqueueNotification(obj)
+}
+
+func (c *Controller) updateNotification(_, curr interface{}) {
+	c.enqueueNotification(curr)
+}
+
+func (c *Controller) enqueueNotification(obj interface{}) {
+	notification := obj.(*notificationsv1alpha1.LifecycleNotification)
+	key, err := controller.KeyFunc(notification)
+	if err != nil {
+		log.Log.Object(notification).Reason(err).Error("Failed to extract key from lifecycle notification.")
+		return
+	}
+	c.keyQueue.Add(key)
+}
+
+func (c *Controller) updateVirtualMachineInstance(old, curr interface{}) {
+	oldVMI := old.(*virtv1.VirtualMachineInstance)
+	currVMI := curr.(*virtv1.VirtualMachineInstance)
+
+	for _, notification := range lifecycleNotifications(oldVMI, currVMI) {
+		c.enqueueDeliveries(notification)
+	}
+}
+
+// lifecycleNotifications returns the notifications for the lifecycle events between two revisions of a VMI
+func lifecycleNotifications(oldVMI, currVMI *virtv1.VirtualMachineInstance) []*Notification {
+	newNotification := func(event notificationsv1alpha1.LifecycleEvent) *Notification {
+		return &Notification{
+			Event:     event,
+			Timestamp: metav1.Now(),
+			Namespace: currVMI.Namespace,
+			Name:      currVMI.Name,
+			UID:       currVMI.UID,
+			NodeName:  currVMI.Status.NodeName,
+		}
+	}
+
+	var notifications []*Notification
+	if oldVMI.Status.Phase != currVMI.Status.Phase {
+		switch currVMI.Status.Phase {
+		case virtv1.Running:
+			notifications = append(notifications, newNotification(notificationsv1alpha1.LifecycleEventStarted))
+		case virtv1.Succeeded:
+			notifications = append(notifications, newNotification(notificationsv1alpha1.LifecycleEventStopped))
+		case virtv1.Failed:
+			notification := newNotification(notificationsv1alpha1.LifecycleEventCrashed)
+			notification.Reason = currVMI.Status.Reason
+			notifications = append(notifications, notification)
+		}
+	}
+
+	if migrationCompleted(currVMI.Status.MigrationState) && !sameMigration(oldVMI.Status.MigrationState, currVMI.Status.MigrationState) {
+		notification := newNotification(notificationsv1alpha1.LifecycleEventMigrated)
+		notification.SourceNode = currVMI.Status.MigrationState.SourceNode
+		notification.TargetNode = currVMI.Status.MigrationState.TargetNode
+		notifications = append(notifications, notification)
+	}
+
+	return notifications
+}
+
+func migrationCompleted(state *virtv1.VirtualMachineInstanceMigrationState) bool {
+	return state != nil && state.Completed && !state.Failed
+}
+
+func sameMigration(old, curr *virtv1.VirtualMachineInstanceMigrationState) bool {
+	return migrationCompleted(old) && old.MigrationUID == curr.MigrationUID
+}
+
+// enqueueDeliveries enqueues a delivery of the notification for every LifecycleNotification
+// of the namespace subscribed to its event
+func (c *Controller) enqueueDeliveries(notification *Notification) {
+	objs, err := c.notificationStore.ByIndex(cache.NamespaceIndex, notification.Namespace)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to list the lifecycle notifications of namespace %s", notification.Namespace)
+		return
+	}
+	if len(objs) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to marshal the lifecycle notification")
+		return
+	}
+
+	for _, obj := range objs {
+		lifecycleNotification := obj.(*notificationsv1alpha1.LifecycleNotification)
+		if !subscribed(lifecycleNotification, notification.Event) {
+			continue
+		}
+		key, err := controller.KeyFunc(lifecycleNotification)
+		if err != nil {
+			log.Log.Object(lifecycleNotification).Reason(err).Error("Failed to extract key from lifecycle notification.")
+			continue
+		}
+		c.deliveryQueue.Add(delivery{
+			notificationKey: key,
+			id:              string(uuid.NewUUID()),
+			event:           notification.Event,
+			vmiName:         notification.Name,
+			payload:         string(payload),
+		})
+	}
+}
+
+func subscribed(notification *notificationsv1alpha1.LifecycleNotification, event notificationsv1alpha1.LifecycleEvent) bool {
+	if len(notification.Spec.Events) == 0 {
+		return true
+	}
+	for _, subscribedEvent := range notification.Spec.Events {
+		if subscribedEvent == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Run runs the passed in LifecycleNotification controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.deliveryQueue.ShutDown()
+	defer c.keyQueue.ShutDown()
+	log.Log.Info("Starting lifecycle notification controller.")
+
+	// Wait for cache sync before we start the lifecycle notification controller
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runDeliveryWorker, time.Second, stopCh)
+	}
+	go wait.Until(c.runKeyWorker, time.Second, stopCh)
+
+	<-stopCh
+	log.Log.Info("Stopping lifecycle notification controller.")
+}
+
+func (c *Controller) runDeliveryWorker() {
+	for c.ExecuteDelivery() {
+	}
+}
+
+func (c *Controller) runKeyWorker() {
+	for c.ExecuteKey() {
+	}
+}
+
+// ExecuteDelivery posts a notification from the delivery queue. Failed deliveries are retried
+// with an exponential backoff up to the MaxRetries of the LifecycleNotification.
+// Returns false if the queue is shut down.
+func (c *Controller) ExecuteDelivery() bool {
+	d, quit := c.deliveryQueue.Get()
+	if quit {
+		return false
+	}
+	defer c.deliveryQueue.Done(d)
+
+	obj, exists, err := c.notificationStore.GetByKey(d.notificationKey)
+	if err != nil {
+		log.Log.Reason(err).Infof("reenqueuing lifecycle notification delivery %s", d.id)
+		c.deliveryQueue.AddRateLimited(d)
+		return true
+	}
+	if !exists {
+		// The LifecycleNotification was removed, nobody is waiting for the delivery anymore
+		c.deliveryQueue.Forget(d)
+		return true
+	}
+	notification := obj.(*notificationsv1alpha1.LifecycleNotification)
+
+	if err := c.deliver(notification, d); err != nil {
+		if c.deliveryQueue.NumRequeues(d) < maxRetries(notification) {
+			log.Log.Object(notification).Reason(err).Infof("reenqueuing lifecycle notification delivery %s", d.id)
+			c.deliveryQueue.AddRateLimited(d)
+			return true
+		}
+		log.Log.Object(notification).Reason(err).Errorf("Giving up on lifecycle notification delivery %s", d.id)
+		c.recorder.Eventf(notification, k8sv1.EventTypeWarning, DeliveryFailedReason,
+			"Failed to deliver the %s notification of VMI %s: %v", d.event, d.vmiName, err)
+	} else {
+		log.Log.Object(notification).V(4).Infof("delivered lifecycle notification %s", d.id)
+	}
+	c.deliveryQueue.Forget(d)
+	return true
+}
+
+// ExecuteKey publishes the signing key of a LifecycleNotification from the key queue, if there
+// is an error it requeues the LifecycleNotification. Returns false if the queue is shut down.
+func (c *Controller) ExecuteKey() bool {
+	key, quit := c.keyQueue.Get()
+	if quit {
+		return false
+	}
+	defer c.keyQueue.Done(key)
+
+	if err := c.publishKey(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing lifecycle notification %v", key)
+		c.keyQueue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed lifecycle notification %v", key)
+		c.keyQueue.Forget(key)
+	}
+	return true
+}
+
+func maxRetries(notification *notificationsv1alpha1.LifecycleNotification) int {
+	if notification.Spec.MaxRetries == nil {
+		return defaultMaxRetries
+	}
+	return int(*notification.Spec.MaxRetries)
+}
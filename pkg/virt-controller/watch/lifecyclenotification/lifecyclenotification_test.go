@@ -0,0 +1,286 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package lifecyclenotification
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	notificationsv1alpha1 "kubevirt.io/api/notifications/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const kubevirtNamespace = "kubevirt"
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+var _ = Describe("LifecycleNotification controller", func() {
+	var (
+		ctrl                 *gomock.Controller
+		kubeClient           *fake.Clientset
+		notificationInformer cache.SharedIndexInformer
+		recorder             *record.FakeRecorder
+		controller           *Controller
+
+		server     *httptest.Server
+		status     int
+		requestsMu sync.Mutex
+		requests   []receivedRequest
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubeClient = fake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+		notificationInformer, _ = testutils.NewFakeInformerWithIndexersFor(&notificationsv1alpha1.LifecycleNotification{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		recorder = record.NewFakeRecorder(100)
+
+		var err error
+		controller, err = NewController(virtClient, notificationInformer, vmiInformer, recorder, kubevirtNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		controller.deliveryQueue = workqueue.NewTypedRateLimitingQueue[delivery](
+			workqueue.NewTypedItemExponentialFailureRateLimiter[delivery](time.Millisecond, time.Millisecond),
+		)
+
+		status = http.StatusOK
+		requests = nil
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			Expect(err).ToNot(HaveOccurred())
+			requestsMu.Lock()
+			requests = append(requests, receivedRequest{header: r.Header, body: body})
+			requestsMu.Unlock()
+			w.WriteHeader(status)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	newNotification := func(events ...notificationsv1alpha1.LifecycleEvent) *notificationsv1alpha1.LifecycleNotification {
+		notification := &notificationsv1alpha1.LifecycleNotification{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "hook",
+				Namespace: metav1.NamespaceDefault,
+				UID:       "notification-uid",
+			},
+			Spec: notificationsv1alpha1.LifecycleNotificationSpec{
+				URL:      server.URL,
+				Events:   events,
+				CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+			},
+		}
+		Expect(notificationInformer.GetIndexer().Add(notification)).To(Succeed())
+		return notification
+	}
+
+	newVMI := func(phase v1.VirtualMachineInstancePhase) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testvmi",
+				Namespace: metav1.NamespaceDefault,
+				UID:       "vmi-uid",
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:    phase,
+				NodeName: "node01",
+			},
+		}
+	}
+
+	startVMI := func() {
+		controller.updateVirtualMachineInstance(newVMI(v1.Scheduled), newVMI(v1.Running))
+	}
+
+	Context("lifecycle events", func() {
+		migrated := func(migrationUID string) *v1.VirtualMachineInstance {
+			vmi := newVMI(v1.Running)
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: types.UID("migration-" + migrationUID),
+				SourceNode:   "node01",
+				TargetNode:   "node02",
+				Completed:    true,
+			}
+			return vmi
+		}
+
+		crashed := func() *v1.VirtualMachineInstance {
+			vmi := newVMI(v1.Failed)
+			vmi.Status.Reason = "NodeUnresponsive"
+			return vmi
+		}
+
+		DescribeTable("should be detected", func(old, curr *v1.VirtualMachineInstance, expected []notificationsv1alpha1.LifecycleEvent) {
+			var events []notificationsv1alpha1.LifecycleEvent
+			for _, notification := range lifecycleNotifications(old, curr) {
+				Expect(notification.Name).To(Equal("testvmi"))
+				Expect(notification.UID).To(BeEquivalentTo("vmi-uid"))
+				events = append(events, notification.Event)
+			}
+			Expect(events).To(Equal(expected))
+		},
+			Entry("when the VMI starts running", newVMI(v1.Scheduled), newVMI(v1.Running), []notificationsv1alpha1.LifecycleEvent{notificationsv1alpha1.LifecycleEventStarted}),
+			Entry("when the VMI succeeds", newVMI(v1.Running), newVMI(v1.Succeeded), []notificationsv1alpha1.LifecycleEvent{notificationsv1alpha1.LifecycleEventStopped}),
+			Entry("when the VMI fails", newVMI(v1.Running), crashed(), []notificationsv1alpha1.LifecycleEvent{notificationsv1alpha1.LifecycleEventCrashed}),
+			Entry("when a migration completes", newVMI(v1.Running), migrated("a"), []notificationsv1alpha1.LifecycleEvent{notificationsv1alpha1.LifecycleEventMigrated}),
+			Entry("when another migration completes", migrated("a"), migrated("b"), []notificationsv1alpha1.LifecycleEvent{notificationsv1alpha1.LifecycleEventMigrated}),
+			Entry("not when the phase doesn't change", newVMI(v1.Running), newVMI(v1.Running), nil),
+			Entry("not when the completed migration doesn't change", migrated("a"), migrated("a"), nil),
+		)
+
+		It("should carry the reason of a crash and the nodes of a migration", func() {
+			notifications := lifecycleNotifications(newVMI(v1.Running), crashed())
+			Expect(notifications).To(HaveLen(1))
+			Expect(notifications[0].Reason).To(Equal("NodeUnresponsive"))
+
+			notifications = lifecycleNotifications(newVMI(v1.Running), migrated("a"))
+			Expect(notifications).To(HaveLen(1))
+			Expect(notifications[0].SourceNode).To(Equal("node01"))
+			Expect(notifications[0].TargetNode).To(Equal("node02"))
+		})
+	})
+
+	Context("delivery", func() {
+		It("should post the signed notification to the endpoint", func() {
+			notification := newNotification()
+			startVMI()
+			Expect(controller.deliveryQueue.Len()).To(Equal(1))
+
+			Expect(controller.ExecuteDelivery()).To(BeTrue())
+			Expect(requests).To(HaveLen(1))
+
+			request := requests[0]
+			Expect(request.header.Get(EventHeader)).To(Equal(string(notificationsv1alpha1.LifecycleEventStarted)))
+			Expect(request.header.Get(DeliveryHeader)).ToNot(BeEmpty())
+
+			key, err := controller.notificationSigningKey(notification)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(request.header.Get(SignatureHeader)).To(Equal("sha256=" + Sign(key, request.body)))
+
+			payload := &Notification{}
+			Expect(json.Unmarshal(request.body, payload)).To(Succeed())
+			Expect(payload.Event).To(Equal(notificationsv1alpha1.LifecycleEventStarted))
+			Expect(payload.Namespace).To(Equal(metav1.NamespaceDefault))
+			Expect(payload.Name).To(Equal("testvmi"))
+			Expect(payload.NodeName).To(Equal("node01"))
+		})
+
+		It("should only post the events the notification is subscribed to", func() {
+			newNotification(notificationsv1alpha1.LifecycleEventStopped)
+			startVMI()
+			Expect(controller.deliveryQueue.Len()).To(BeZero())
+
+			controller.updateVirtualMachineInstance(newVMI(v1.Running), newVMI(v1.Succeeded))
+			Expect(controller.deliveryQueue.Len()).To(Equal(1))
+		})
+
+		It("should retry failed deliveries with the same delivery ID and give up after MaxRetries", func() {
+			notification := newNotification()
+			notification.Spec.MaxRetries = pointer.P(int32(2))
+			status = http.StatusServiceUnavailable
+			startVMI()
+
+			for i := 0; i < 3; i++ {
+				Expect(controller.ExecuteDelivery()).To(BeTrue())
+			}
+			Expect(requests).To(HaveLen(3))
+			Expect(requests[1].header.Get(DeliveryHeader)).To(Equal(requests[0].header.Get(DeliveryHeader)))
+			Expect(requests[2].header.Get(DeliveryHeader)).To(Equal(requests[0].header.Get(DeliveryHeader)))
+			Expect(controller.deliveryQueue.Len()).To(BeZero())
+			testutils.ExpectEvent(recorder, DeliveryFailedReason)
+		})
+
+		It("should drop the deliveries of removed notifications", func() {
+			notification := newNotification()
+			startVMI()
+			Expect(notificationInformer.GetIndexer().Delete(notification)).To(Succeed())
+
+			Expect(controller.ExecuteDelivery()).To(BeTrue())
+			Expect(requests).To(BeEmpty())
+			Expect(controller.deliveryQueue.Len()).To(BeZero())
+		})
+	})
+
+	Context("signing key", func() {
+		It("should be generated once and published in the namespace of the notification", func() {
+			notification := newNotification()
+			controller.enqueueNotification(notification)
+			Expect(controller.ExecuteKey()).To(BeTrue())
+
+			master, err := kubeClient.CoreV1().Secrets(kubevirtNamespace).Get(context.Background(), SigningKeySecretName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(master.Data[SigningKeySecretKey]).To(HaveLen(signingKeyLength))
+
+			secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), "hook"+SigningKeySecretSuffix, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret.OwnerReferences).To(HaveLen(1))
+			Expect(secret.OwnerReferences[0].UID).To(Equal(notification.UID))
+
+			key, err := controller.notificationSigningKey(notification)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret.Data[SigningKeySecretKey]).To(Equal(key))
+
+			// Publishing again keeps the existing secret
+			controller.enqueueNotification(notification)
+			Expect(controller.ExecuteKey()).To(BeTrue())
+			Expect(controller.keyQueue.Len()).To(BeZero())
+		})
+
+		It("should reuse the existing signing key of KubeVirt", func() {
+			_, err := kubeClient.CoreV1().Secrets(kubevirtNamespace).Create(context.Background(), &k8sv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: SigningKeySecretName, Namespace: kubevirtNamespace},
+				Data:       map[string][]byte{SigningKeySecretKey: []byte("existing")},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			key, err := controller.getSigningKey()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key).To(Equal([]byte("existing")))
+		})
+	})
+})
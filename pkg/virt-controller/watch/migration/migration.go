@@ -61,6 +61,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/externalpolicy"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
@@ -71,6 +72,7 @@ const (
 	successfulUpdatePodDisruptionBudgetReason = "SuccessfulUpdate"
 	failedUpdatePodDisruptionBudgetReason     = "FailedUpdate"
 	failedGetAttractionPodsFmt                = "failed to get attachment pods: %v"
+	failedExternalPolicyReviewReason          = "FailedExternalPolicyReview"
 )
 
 // This is the timeout used when a target pod is stuck in
@@ -757,6 +759,15 @@ func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMig
 		}
 	}
 
+	targetNodes, err := externalpolicy.NewReviewer(c.clusterConfig).MigrationTargetNodes(context.Background(), migration, vmi)
+	if err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, failedExternalPolicyReviewReason, "Migration target selection rejected: %v", err)
+		return err
+	}
+	if targetNodes != nil {
+		restrictPodToNodes(templatePod, targetNodes)
+	}
+
 	matchLevelOnTarget := c.clusterConfig.GetMigrationConfiguration().MatchSELinuxLevelOnMigration
 	if matchLevelOnTarget == nil || *matchLevelOnTarget {
 		err = setTargetPodSELinuxLevel(templatePod, vmi.Status.SelinuxContext)
@@ -2094,6 +2105,35 @@ func prepareNodeSelectorForHostCpuModel(node *k8sv1.Node, pod *k8sv1.Pod, source
 	return nil
 }
 
+// restrictPodToNodes adds the given nodes as requirement to every required node affinity term of the pod
+func restrictPodToNodes(pod *k8sv1.Pod, nodes []string) {
+	requirement := k8sv1.NodeSelectorRequirement{
+		Key:      k8sv1.LabelHostname,
+		Operator: k8sv1.NodeSelectorOpIn,
+		Values:   nodes,
+	}
+
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &k8sv1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &k8sv1.NodeAffinity{}
+	}
+	nodeAffinity := pod.Spec.Affinity.NodeAffinity
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &k8sv1.NodeSelector{}
+	}
+
+	terms := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) == 0 {
+		terms = []k8sv1.NodeSelectorTerm{{}}
+	}
+	for i := range terms {
+		terms[i].MatchExpressions = append(terms[i].MatchExpressions, requirement)
+	}
+	nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = terms
+}
+
 func isNodeSuitableForHostModelMigration(node *k8sv1.Node, requiredNodeLabels map[string]string) bool {
 	for key, value := range requiredNodeLabels {
 		nodeValue, ok := node.Labels[key]
@@ -21,7 +21,11 @@ package migration
 
 import (
 	"context"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"time"
 
@@ -56,6 +60,7 @@ import (
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
+	"kubevirt.io/kubevirt/pkg/externalpolicy"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -2227,6 +2232,94 @@ var _ = Describe("Migration watcher", func() {
 			expectTargetPodWithSELinuxLevel(vmi.Namespace, vmi.UID, migration.UID, "")
 		})
 	})
+
+	Context("Migration target selection by external policy services", func() {
+		setPolicyService := func(response externalpolicy.ReviewResponse) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				Expect(json.NewEncoder(w).Encode(response)).To(Succeed())
+			}))
+			DeferCleanup(server.Close)
+
+			setConfig(&virtv1.KubeVirtConfiguration{
+				DeveloperConfiguration: &virtv1.DeveloperConfiguration{
+					FeatureGates: []string{virtconfig.ExternalPolicyGate},
+				},
+				ExternalPolicyServices: []virtv1.ExternalPolicyService{{
+					Name:     "licensing",
+					URL:      server.URL,
+					CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+					Hooks:    []virtv1.ExternalPolicyHook{virtv1.ExternalPolicyHookMigrationTargetSelection},
+				}},
+			})
+		}
+
+		It("should restrict the target pod to the nodes allowed by the service", func() {
+			setPolicyService(externalpolicy.ReviewResponse{Allowed: true, AllowedNodes: []string{"node01", "node02"}})
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 1)
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items[0].Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(ContainElement(
+				k8sv1.NodeSelectorRequirement{
+					Key:      k8sv1.LabelHostname,
+					Operator: k8sv1.NodeSelectorOpIn,
+					Values:   []string{"node01", "node02"},
+				},
+			))
+		})
+
+		It("should not create the target pod if the service denies the migration", func() {
+			setPolicyService(externalpolicy.ReviewResponse{Allowed: false, Message: "no licensed host available"})
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, failedExternalPolicyReviewReason)
+			expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+		})
+	})
+
+	DescribeTable("restrictPodToNodes", func(affinity *k8sv1.Affinity, expectedTerms int) {
+		pod := &k8sv1.Pod{Spec: k8sv1.PodSpec{Affinity: affinity}}
+		restrictPodToNodes(pod, []string{"node01"})
+
+		terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+		Expect(terms).To(HaveLen(expectedTerms))
+		for _, term := range terms {
+			Expect(term.MatchExpressions).To(ContainElement(k8sv1.NodeSelectorRequirement{
+				Key:      k8sv1.LabelHostname,
+				Operator: k8sv1.NodeSelectorOpIn,
+				Values:   []string{"node01"},
+			}))
+		}
+	},
+		Entry("should add a term if the pod has no affinity", nil, 1),
+		Entry("should add a term if the pod has no node affinity", &k8sv1.Affinity{PodAntiAffinity: &k8sv1.PodAntiAffinity{}}, 1),
+		Entry("should extend all existing terms", &k8sv1.Affinity{
+			NodeAffinity: &k8sv1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+					NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+						{MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"a"}}}},
+						{MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"b"}}}},
+					},
+				},
+			},
+		}, 2),
+	)
 })
 
 func newPDB(name string, vmi *virtv1.VirtualMachineInstance, pods int) *policyv1.PodDisruptionBudget {
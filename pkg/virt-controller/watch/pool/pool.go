@@ -192,12 +192,14 @@ func (c *Controller) addVMIHandler(obj interface{}) {
 
 	vmRevisionName, vmOk := vm.Spec.Template.ObjectMeta.Labels[virtv1.VirtualMachinePoolRevisionName]
 	vmiRevisionName, vmiOk := vmi.Labels[virtv1.VirtualMachinePoolRevisionName]
-	if vmOk && vmiOk && vmRevisionName == vmiRevisionName {
+	_, warmStandby := vmi.Labels[virtv1.VirtualMachinePoolWarmStandbyLabel]
+	if vmOk && vmiOk && vmRevisionName == vmiRevisionName && !warmStandby {
 		// nothing to do here, VMI is up-to-date with VM's Template
 		return
 	}
 
-	// enqueue the Pool due to a VMI detected that isn't up to date
+	// enqueue the Pool due to a VMI detected that isn't up to date,
+	// or a warm standby VMI which has to be paused or claimed
 	c.enqueuePool(pool)
 
 }
@@ -304,6 +306,12 @@ func (c *Controller) updateVMHandler(old, cur interface{}) {
 		if pool == nil {
 			return
 		}
+		if isWarmStandbyVM(oldVM) && !isWarmStandbyVM(curVM) {
+			// a claimed warm standby VM counts as a created VM
+			if poolKey, err := controller.KeyFunc(pool); err == nil {
+				c.expectations.CreationObserved(poolKey)
+			}
+		}
 		log.Log.V(4).Object(curVM).Infof("VirtualMachine updated")
 		c.enqueuePool(pool)
 		return
@@ -510,11 +518,6 @@ func filterVMs(vms []*virtv1.VirtualMachine, f func(vmi *virtv1.VirtualMachine)
 
 func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, count int) error {
 
-	poolKey, err := controller.KeyFunc(pool)
-	if err != nil {
-		return err
-	}
-
 	elgibleVMs := filterDeletingVMs(vms)
 
 	// make sure we count already deleting VMs here during scale in.
@@ -533,9 +536,17 @@ func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virt
 
 	log.Log.Object(pool).Infof("Removing %d VMs from pool", count)
 
+	return c.deleteVMs(pool, elgibleVMs[0:count])
+}
+
+func (c *Controller) deleteVMs(pool *poolv1.VirtualMachinePool, deleteList []*virtv1.VirtualMachine) error {
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return err
+	}
+
 	var wg sync.WaitGroup
 
-	deleteList := elgibleVMs[0:count]
 	c.expectations.ExpectDeletions(poolKey, controller.VirtualMachineKeys(deleteList))
 	wg.Add(len(deleteList))
 	errChan := make(chan error, len(deleteList))
@@ -663,6 +674,14 @@ func injectPoolRevisionLabelsIntoVM(vm *virtv1.VirtualMachine, revisionName stri
 	return vm
 }
 
+// applyPoolTemplate sets the labels, annotations and spec of a VM from the template of its pool.
+func applyPoolTemplate(vm *virtv1.VirtualMachine, pool *poolv1.VirtualMachinePool, index int, revisionName string) *virtv1.VirtualMachine {
+	vm.Labels = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
+	vm.Annotations = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
+	vm.Spec = *indexVMSpec(pool.Spec.VirtualMachineTemplate.Spec.DeepCopy(), index)
+	return injectPoolRevisionLabelsIntoVM(vm, revisionName)
+}
+
 func getRevisionName(pool *poolv1.VirtualMachinePool) string {
 	return fmt.Sprintf("%s-%d", pool.Name, pool.Generation)
 }
@@ -731,7 +750,7 @@ func (c *Controller) getControllerRevision(namespace, name string) (*poolv1.Virt
 
 }
 
-func (c *Controller) scaleOut(pool *poolv1.VirtualMachinePool, count int) error {
+func (c *Controller) scaleOut(pool *poolv1.VirtualMachinePool, count int, warmStandby bool) error {
 
 	var wg sync.WaitGroup
 
@@ -742,7 +761,11 @@ func (c *Controller) scaleOut(pool *poolv1.VirtualMachinePool, count int) error
 		return err
 	}
 
-	log.Log.Object(pool).Infof("Adding %d VMs to pool", len(newNames))
+	if warmStandby {
+		log.Log.Object(pool).Infof("Adding %d warm standby VMs to pool", len(newNames))
+	} else {
+		log.Log.Object(pool).Infof("Adding %d VMs to pool", len(newNames))
+	}
 	poolKey, err := controller.KeyFunc(pool)
 	if err != nil {
 		return err
@@ -763,12 +786,10 @@ func (c *Controller) scaleOut(pool *poolv1.VirtualMachinePool, count int) error
 				return
 			}
 
-			vm := virtv1.NewVMReferenceFromNameWithNS(pool.Namespace, name)
-
-			vm.Labels = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
-			vm.Annotations = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
-			vm.Spec = *indexVMSpec(pool.Spec.VirtualMachineTemplate.Spec.DeepCopy(), index)
-			vm = injectPoolRevisionLabelsIntoVM(vm, revisionName)
+			vm := applyPoolTemplate(virtv1.NewVMReferenceFromNameWithNS(pool.Namespace, name), pool, index, revisionName)
+			if warmStandby {
+				vm = toWarmStandbyVM(vm)
+			}
 
 			vm.ObjectMeta.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
 
@@ -797,7 +818,7 @@ func (c *Controller) scaleOut(pool *poolv1.VirtualMachinePool, count int) error
 	return nil
 }
 
-func (c *Controller) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (common.SyncError, bool) {
+func (c *Controller) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, warmVMs []*virtv1.VirtualMachine) (common.SyncError, bool) {
 	diff := c.calcDiff(pool, vms)
 	if diff == 0 {
 		// nothing to do
@@ -806,7 +827,11 @@ func (c *Controller) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtua
 
 	maxDiff := int(math.Min(math.Abs(float64(diff)), float64(c.burstReplicas)))
 	if diff < 0 {
-		err := c.scaleOut(pool, maxDiff)
+		// warm standby VMs are claimed first, only the remaining VMs are created
+		claimed, err := c.claimWarmStandbyVMs(pool, warmVMs, maxDiff)
+		if err == nil && claimed < maxDiff {
+			err = c.scaleOut(pool, maxDiff-claimed, false)
+		}
 		if err != nil {
 			return common.NewSyncError(fmt.Errorf("Error during scale out: %v", err), FailedScaleOutReason), false
 		}
@@ -845,12 +870,7 @@ func (c *Controller) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vmOutd
 				return
 			}
 
-			vmCopy := vm.DeepCopy()
-
-			vmCopy.Labels = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
-			vmCopy.Annotations = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
-			vmCopy.Spec = *indexVMSpec(pool.Spec.VirtualMachineTemplate.Spec.DeepCopy(), index)
-			vmCopy = injectPoolRevisionLabelsIntoVM(vmCopy, revisionName)
+			vmCopy := applyPoolTemplate(vm.DeepCopy(), pool, index, revisionName)
 
 			_, err = c.clientset.VirtualMachine(vmCopy.Namespace).Update(context.Background(), vmCopy, metav1.UpdateOptions{})
 			if err != nil {
@@ -1194,7 +1214,7 @@ func (c *Controller) Execute() bool {
 	return true
 }
 
-func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, syncErr common.SyncError) error {
+func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, warmVMs []*virtv1.VirtualMachine, syncErr common.SyncError) error {
 
 	key, err := controller.KeyFunc(origPool)
 	if err != nil {
@@ -1245,6 +1265,7 @@ func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*vi
 
 	pool.Status.Replicas = int32(len(vms))
 	pool.Status.ReadyReplicas = int32(len(c.filterReadyVMs(vms)))
+	pool.Status.WarmReplicas = int32(len(c.filterClaimableVMs(warmVMs)))
 
 	if !equality.Semantic.DeepEqual(pool.Status, origPool.Status) || pool.Status.Replicas != pool.Status.ReadyReplicas {
 		_, err := c.clientset.VirtualMachinePool(pool.Namespace).UpdateStatus(context.Background(), pool, metav1.UpdateOptions{})
@@ -1309,13 +1330,16 @@ func (c *Controller) execute(key string) error {
 	if err != nil {
 		return err
 	}
+	// warm standby VMs are not part of the replicas until they are claimed
+	vms, warmVMs := splitWarmStandbyVMs(vms)
 
 	needsSync := c.expectations.SatisfiedExpectations(key)
 	if needsSync && !pool.Spec.Paused && pool.DeletionTimestamp == nil {
 		scaleIsStable := false
 		updateIsStable := false
+		warmStandbyIsStable := false
 
-		syncErr, scaleIsStable = c.scale(pool, vms)
+		syncErr, scaleIsStable = c.scale(pool, vms, warmVMs)
 		if syncErr != nil {
 			logger.Reason(err).Error("Scaling the pool failed.")
 		}
@@ -1328,15 +1352,21 @@ func (c *Controller) execute(key string) error {
 
 		needsSync = c.expectations.SatisfiedExpectations(key)
 		if needsSync && syncErr == nil && scaleIsStable && updateIsStable {
+			// Handle the warm standby VMs once the replicas are settled.
+			syncErr, warmStandbyIsStable = c.syncWarmStandby(pool, vms, warmVMs)
+		}
+
+		needsSync = c.expectations.SatisfiedExpectations(key)
+		if needsSync && syncErr == nil && scaleIsStable && updateIsStable && warmStandbyIsStable {
 			// handle pruning revisions after scale and update operations are satisfied
-			syncErr = c.pruneUnusedRevisions(pool, vms)
+			syncErr = c.pruneUnusedRevisions(pool, append(vms, warmVMs...))
 		}
 		virtControllerPoolWorkQueueTracer.StepTrace(key, "sync", trace.Field{Key: "VMPool Name", Value: pool.Name})
 	} else if pool.DeletionTimestamp != nil {
-		syncErr = c.pruneUnusedRevisions(pool, vms)
+		syncErr = c.pruneUnusedRevisions(pool, append(vms, warmVMs...))
 	}
 
-	err = c.updateStatus(pool, vms, syncErr)
+	err = c.updateStatus(pool, vms, warmVMs, syncErr)
 	if err != nil {
 		return err
 	}
@@ -707,6 +707,151 @@ var _ = Describe("Pool", func() {
 			testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
 			Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(3))
 		})
+
+		Context("with warm standby VMs", func() {
+
+			newPoolVM := func(pool *poolv1.VirtualMachinePool, index int) *v1.VirtualMachine {
+				vm := applyPoolTemplate(v1.NewVMReferenceFromNameWithNS(pool.Namespace, generateVMName(index, pool.Name)), pool, index, getRevisionName(pool))
+				vm.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
+				return vm
+			}
+
+			newRunningVMI := func(vm *v1.VirtualMachine, conditions ...v1.VirtualMachineInstanceConditionType) *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI(vm.Name)
+				vmi.Namespace = vm.Namespace
+				vmi.Labels = maps.Clone(vm.Spec.Template.ObjectMeta.Labels)
+				vmi.OwnerReferences = []metav1.OwnerReference{{
+					APIVersion:         v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+					Kind:               v1.VirtualMachineGroupVersionKind.Kind,
+					Name:               vm.ObjectMeta.Name,
+					UID:                vm.ObjectMeta.UID,
+					Controller:         pointer.P(true),
+					BlockOwnerDeletion: pointer.P(true),
+				}}
+				watchtesting.MarkAsReady(vmi)
+				for _, condition := range conditions {
+					vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{Type: condition, Status: k8sv1.ConditionTrue})
+				}
+				return vmi
+			}
+
+			newWarmStandbyPool := func(replicas, warmReplicas int32) *poolv1.VirtualMachinePool {
+				pool, _ := DefaultPool(replicas)
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Networks = []v1.Network{{
+					Name:          "red",
+					NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-net"}},
+				}}
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+					Name:                   "red",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				}}
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Domain.Devices.AutoattachPodInterface = pointer.P(false)
+				pool.Spec.WarmStandby = &poolv1.VirtualMachinePoolWarmStandby{Replicas: warmReplicas}
+				return pool
+			}
+
+			It("should create warm standby VMs without networks once the replicas are ready", func() {
+				pool := newWarmStandbyPool(1, 2)
+				pool.Status.Replicas = 1
+				pool.Status.ReadyReplicas = 1
+				vm := newPoolVM(pool, 0)
+				markVmAsReady(vm)
+
+				addPool(pool)
+				addVM(vm)
+				addCR(createPoolRevision(pool))
+
+				fakeVirtClient.Fake.PrependReactor("create", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					createObj := action.(k8stesting.CreateAction).GetObject().(*v1.VirtualMachine)
+					Expect(createObj.Name).To(BeElementOf("my-pool-1", "my-pool-2"))
+					Expect(createObj.Labels).To(HaveKey(v1.VirtualMachinePoolWarmStandbyLabel))
+					Expect(createObj.Spec.Template.ObjectMeta.Labels).To(HaveKey(v1.VirtualMachinePoolWarmStandbyLabel))
+					Expect(createObj.Spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyAlways)))
+					Expect(createObj.Spec.Template.Spec.Networks).To(BeEmpty())
+					Expect(createObj.Spec.Template.Spec.Domain.Devices.Interfaces).To(BeEmpty())
+					return true, createObj, nil
+				})
+
+				sanityExecute()
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(2))
+			})
+
+			It("should pause the warm standby VMIs once their guest agent is connected", func() {
+				pool := newWarmStandbyPool(0, 1)
+				vm := toWarmStandbyVM(newPoolVM(pool, 0))
+
+				addPool(pool)
+				addVM(vm)
+				addVMI(newRunningVMI(vm, v1.VirtualMachineInstanceAgentConnected))
+				addCR(createPoolRevision(pool))
+
+				fakeVirtClient.Fake.PrependReactor("put", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					Expect(action.GetSubresource()).To(Equal("pause"))
+					return true, nil, nil
+				})
+
+				sanityExecute()
+				testutils.ExpectEvent(recorder, SuccessfulPauseWarmStandbyReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "put", "virtualmachineinstances")).To(HaveLen(1))
+			})
+
+			It("should claim paused warm standby VMs instead of creating VMs on scale out", func() {
+				pool := newWarmStandbyPool(1, 1)
+				pool.Status.WarmReplicas = 1
+				vm := toWarmStandbyVM(newPoolVM(pool, 0))
+
+				addPool(pool)
+				addVM(vm)
+				addVMI(newRunningVMI(vm, v1.VirtualMachineInstanceAgentConnected, v1.VirtualMachineInstancePaused))
+				addCR(createPoolRevision(pool))
+
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					updateObj := action.(k8stesting.UpdateAction).GetObject().(*v1.VirtualMachine)
+					Expect(updateObj.Name).To(Equal(vm.Name))
+					Expect(updateObj.Labels).ToNot(HaveKey(v1.VirtualMachinePoolWarmStandbyLabel))
+					Expect(updateObj.Labels).To(HaveKeyWithValue(v1.VirtualMachinePoolRevisionName, getRevisionName(pool)))
+					Expect(updateObj.Spec.Template.Spec.Networks).To(Equal(pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Networks))
+					return true, updateObj, nil
+				})
+
+				sanityExecute()
+				testutils.ExpectEvent(recorder, SuccessfulClaimVirtualMachineReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "update", "virtualmachines")).To(HaveLen(1))
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(BeEmpty())
+			})
+
+			It("should unpause the VMIs of the claimed VMs and hand them the claim command", func() {
+				pool := newWarmStandbyPool(1, 0)
+				pool.Spec.WarmStandby.ClaimCommand = []string{"/usr/local/bin/claimed.sh", "--hostname"}
+				pool.Status.Replicas = 1
+				pool.Status.ReadyReplicas = 1
+				vm := newPoolVM(pool, 0)
+				markVmAsReady(vm)
+				vmi := newRunningVMI(toWarmStandbyVM(vm.DeepCopy()), v1.VirtualMachineInstanceAgentConnected, v1.VirtualMachineInstancePaused)
+
+				addPool(pool)
+				addVM(vm)
+				addVMI(vmi)
+				addCR(createPoolRevision(pool))
+
+				fakeVirtClient.Fake.PrependReactor("put", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					Expect(action.GetSubresource()).To(Equal("unpause"))
+					return true, nil, nil
+				})
+				fakeVirtClient.Fake.PrependReactor("patch", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					patchBytes := string(action.(k8stesting.PatchAction).GetPatch())
+					Expect(patchBytes).To(ContainSubstring(`{"op":"remove","path":"/metadata/labels/kubevirt.io~1vm-pool-warm-standby"}`))
+					Expect(patchBytes).To(ContainSubstring(`"value":{"kubevirt.io/vm-pool-claim-command":"[\"/usr/local/bin/claimed.sh\",\"--hostname\"]"}`))
+					return true, vmi, nil
+				})
+
+				sanityExecute()
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "put", "virtualmachineinstances")).To(HaveLen(1))
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+			})
+		})
 	})
 })
 
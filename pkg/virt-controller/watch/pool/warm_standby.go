@@ -0,0 +1,308 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package pool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	k8score "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
+)
+
+const (
+	FailedWarmStandbyReason = "FailedWarmStandby"
+	FailedClaimReason       = "FailedClaim"
+
+	SuccessfulClaimVirtualMachineReason = "SuccessfulClaim"
+	SuccessfulPauseWarmStandbyReason    = "SuccessfulPauseWarmStandby"
+)
+
+func isWarmStandbyVM(vm *virtv1.VirtualMachine) bool {
+	_, exists := vm.Labels[virtv1.VirtualMachinePoolWarmStandbyLabel]
+	return exists
+}
+
+// splitWarmStandbyVMs separates the VMs of a pool from its warm standby VMs.
+func splitWarmStandbyVMs(vms []*virtv1.VirtualMachine) (activeVMs []*virtv1.VirtualMachine, warmVMs []*virtv1.VirtualMachine) {
+	for _, vm := range vms {
+		if isWarmStandbyVM(vm) {
+			warmVMs = append(warmVMs, vm)
+		} else {
+			activeVMs = append(activeVMs, vm)
+		}
+	}
+	return activeVMs, warmVMs
+}
+
+// toWarmStandbyVM turns a VM of the pool into a warm standby VM. It is started right away,
+// without its networks which are only hotplugged once it is claimed.
+func toWarmStandbyVM(vm *virtv1.VirtualMachine) *virtv1.VirtualMachine {
+	vm.Labels[virtv1.VirtualMachinePoolWarmStandbyLabel] = ""
+	vm.Spec.Template.ObjectMeta.Labels[virtv1.VirtualMachinePoolWarmStandbyLabel] = ""
+
+	vm.Spec.Running = nil
+	vm.Spec.RunStrategy = pointer.P(virtv1.RunStrategyAlways)
+
+	vmiSpec := &vm.Spec.Template.Spec
+	vmiSpec.Networks = nil
+	vmiSpec.Domain.Devices.Interfaces = nil
+	vmiSpec.Domain.Devices.AutoattachPodInterface = pointer.P(false)
+	return vm
+}
+
+func (c *Controller) getVMI(vm *virtv1.VirtualMachine) *virtv1.VirtualMachineInstance {
+	obj, exists, _ := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if !exists {
+		return nil
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if vmi.DeletionTimestamp != nil {
+		return nil
+	}
+	return vmi
+}
+
+// filterClaimableVMs takes a list of warm standby VMs and returns the ones whose VMI is booted and paused.
+func (c *Controller) filterClaimableVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
+	return filterVMs(filterDeletingVMs(vms), func(vm *virtv1.VirtualMachine) bool {
+		vmi := c.getVMI(vm)
+		return vmi != nil && controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstancePaused, k8score.ConditionTrue)
+	})
+}
+
+// claimWarmStandbyVMs updates up to count claimable warm standby VMs to the template of the pool.
+// It returns the number of VMs claimed.
+func (c *Controller) claimWarmStandbyVMs(pool *poolv1.VirtualMachinePool, warmVMs []*virtv1.VirtualMachine, count int) (int, error) {
+	var claimList []*virtv1.VirtualMachine
+	for _, vm := range c.filterClaimableVMs(warmVMs) {
+		if len(claimList) == count {
+			break
+		}
+		outdated, err := c.isOutdatedVM(pool, vm)
+		if err != nil {
+			return 0, err
+		}
+		if !outdated {
+			claimList = append(claimList, vm)
+		}
+	}
+	if len(claimList) == 0 {
+		return 0, nil
+	}
+
+	revisionName, err := c.ensureControllerRevision(pool)
+	if err != nil {
+		return 0, err
+	}
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Log.Object(pool).Infof("Claiming %d warm standby VMs", len(claimList))
+
+	// a claim is observed once the VM is seen without the warm standby label
+	c.expectations.RaiseExpectations(poolKey, len(claimList), 0)
+
+	var wg sync.WaitGroup
+	wg.Add(len(claimList))
+	errChan := make(chan error, len(claimList))
+	for _, vm := range claimList {
+		go func(vm *virtv1.VirtualMachine) {
+			defer wg.Done()
+
+			index, err := indexFromName(vm.Name)
+			if err != nil {
+				c.expectations.CreationObserved(poolKey)
+				errChan <- err
+				return
+			}
+
+			vmCopy := applyPoolTemplate(vm.DeepCopy(), pool, index, revisionName)
+			_, err = c.clientset.VirtualMachine(vmCopy.Namespace).Update(context.Background(), vmCopy, metav1.UpdateOptions{})
+			if err != nil {
+				c.expectations.CreationObserved(poolKey)
+				c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedClaimReason, "Error claiming warm standby VM %s/%s: %v", vm.Namespace, vm.Name, err)
+				errChan <- err
+				return
+			}
+			c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulClaimVirtualMachineReason, "Claimed warm standby VM %s/%s", vm.Namespace, vm.Name)
+			log.Log.Object(pool).Infof("Claimed warm standby vm %s/%s", vm.Namespace, vm.Name)
+		}(vm)
+	}
+	wg.Wait()
+
+	select {
+	case err := <-errChan:
+		// Only return the first error which occurred. We log the rest
+		return 0, err
+	default:
+	}
+
+	return len(claimList), nil
+}
+
+// finishWarmStandbyClaims unpauses the VMIs of the claimed VMs, and hands them the claim command to run.
+func (c *Controller) finishWarmStandbyClaims(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) error {
+	var claimCommand string
+	if pool.Spec.WarmStandby != nil && len(pool.Spec.WarmStandby.ClaimCommand) > 0 {
+		bytes, err := json.Marshal(pool.Spec.WarmStandby.ClaimCommand)
+		if err != nil {
+			return err
+		}
+		claimCommand = string(bytes)
+	}
+
+	for _, vm := range filterDeletingVMs(vms) {
+		vmi := c.getVMI(vm)
+		if vmi == nil {
+			continue
+		}
+		if _, warmStandby := vmi.Labels[virtv1.VirtualMachinePoolWarmStandbyLabel]; !warmStandby {
+			continue
+		}
+
+		if controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstancePaused, k8score.ConditionTrue) {
+			err := c.clientset.VirtualMachineInstance(vmi.Namespace).Unpause(context.Background(), vmi.Name, &virtv1.UnpauseOptions{})
+			if err != nil {
+				return fmt.Errorf("unpausing claimed vmi %s/%s: %v", vmi.Namespace, vmi.Name, err)
+			}
+		}
+
+		patchSet := patch.New(
+			patch.WithTest("/metadata/labels", vmi.Labels),
+			patch.WithRemove("/metadata/labels/"+patch.EscapeJSONPointer(virtv1.VirtualMachinePoolWarmStandbyLabel)),
+		)
+		if claimCommand != "" {
+			if vmi.Annotations == nil {
+				patchSet.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{virtv1.VirtualMachinePoolClaimCommandAnnotation: claimCommand}))
+			} else {
+				patchSet.AddOption(patch.WithAdd("/metadata/annotations/"+patch.EscapeJSONPointer(virtv1.VirtualMachinePoolClaimCommandAnnotation), claimCommand))
+			}
+		}
+		patchBytes, err := patchSet.GeneratePayload()
+		if err != nil {
+			return err
+		}
+		_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+		if err != nil {
+			return fmt.Errorf("patching claimed vmi %s/%s: %v", vmi.Namespace, vmi.Name, err)
+		}
+		log.Log.Object(pool).Infof("Unpaused claimed vmi %s/%s", vmi.Namespace, vmi.Name)
+	}
+
+	return nil
+}
+
+// pauseWarmStandbyVMIs pauses the VMIs of the warm standby VMs once their guest is booted,
+// which is when the guest agent connects.
+func (c *Controller) pauseWarmStandbyVMIs(pool *poolv1.VirtualMachinePool, warmVMs []*virtv1.VirtualMachine) error {
+	cm := controller.NewVirtualMachineInstanceConditionManager()
+	for _, vm := range warmVMs {
+		vmi := c.getVMI(vm)
+		if vmi == nil || vmi.Status.Phase != virtv1.Running ||
+			!cm.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceAgentConnected, k8score.ConditionTrue) ||
+			cm.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstancePaused, k8score.ConditionTrue) {
+			continue
+		}
+
+		err := c.clientset.VirtualMachineInstance(vmi.Namespace).Pause(context.Background(), vmi.Name, &virtv1.PauseOptions{})
+		if err != nil {
+			return fmt.Errorf("pausing warm standby vmi %s/%s: %v", vmi.Namespace, vmi.Name, err)
+		}
+		c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulPauseWarmStandbyReason, "Paused warm standby VM %s/%s", vm.Namespace, vm.Name)
+	}
+	return nil
+}
+
+// syncWarmStandby keeps the number of warm standby VMs requested by the pool, and finishes the
+// claims of the VMs taken from them.
+func (c *Controller) syncWarmStandby(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, warmVMs []*virtv1.VirtualMachine) (common.SyncError, bool) {
+	if err := c.finishWarmStandbyClaims(pool, vms); err != nil {
+		return common.NewSyncError(fmt.Errorf("Error while claiming warm standby VMs: %v", err), FailedClaimReason), false
+	}
+
+	wantedReplicas := 0
+	if pool.Spec.WarmStandby != nil {
+		wantedReplicas = int(pool.Spec.WarmStandby.Replicas)
+	}
+
+	var outdatedVMs, currentVMs []*virtv1.VirtualMachine
+	for _, vm := range filterDeletingVMs(warmVMs) {
+		outdated, err := c.isOutdatedVM(pool, vm)
+		if err != nil {
+			return common.NewSyncError(fmt.Errorf("Error while detecting outdated warm standby VMs: %v", err), FailedWarmStandbyReason), false
+		}
+		if outdated {
+			outdatedVMs = append(outdatedVMs, vm)
+		} else {
+			currentVMs = append(currentVMs, vm)
+		}
+	}
+
+	// Outdated warm standby VMs are replaced rather than updated, their VMI would have to be
+	// restarted anyway.
+	if len(outdatedVMs) > 0 {
+		if err := c.deleteVMs(pool, outdatedVMs[:min(len(outdatedVMs), int(c.burstReplicas))]); err != nil {
+			return common.NewSyncError(fmt.Errorf("Error while replacing outdated warm standby VMs: %v", err), FailedWarmStandbyReason), false
+		}
+		return nil, false
+	}
+
+	diff := len(currentVMs) - wantedReplicas
+	if diff < 0 {
+		if err := c.scaleOut(pool, min(-diff, int(c.burstReplicas)), true); err != nil {
+			return common.NewSyncError(fmt.Errorf("Error while creating warm standby VMs: %v", err), FailedWarmStandbyReason), false
+		}
+		return nil, false
+	} else if diff > 0 {
+		// remove the warm standby VMs which are not ready to be claimed first
+		claimable := map[string]bool{}
+		for _, vm := range c.filterClaimableVMs(currentVMs) {
+			claimable[vm.Name] = true
+		}
+		sort.SliceStable(currentVMs, func(i, j int) bool {
+			return !claimable[currentVMs[i].Name] && claimable[currentVMs[j].Name]
+		})
+		if err := c.deleteVMs(pool, currentVMs[:min(diff, int(c.burstReplicas))]); err != nil {
+			return common.NewSyncError(fmt.Errorf("Error while removing warm standby VMs: %v", err), FailedWarmStandbyReason), false
+		}
+		return nil, false
+	}
+
+	if err := c.pauseWarmStandbyVMIs(pool, currentVMs); err != nil {
+		return common.NewSyncError(fmt.Errorf("Error while pausing warm standby VMs: %v", err), FailedWarmStandbyReason), false
+	}
+	return nil, true
+}
@@ -0,0 +1,467 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package servicenetwork
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// Controller publishes the addresses of the VMIs on a secondary network as the EndpointSlices of
+// the Services asking for them, which the endpointslice controller of Kubernetes cannot do as it
+// only knows the addresses of the pods.
+type Controller struct {
+	clientset          kubecli.KubevirtClient
+	queue              workqueue.TypedRateLimitingInterface[string]
+	vmiStore           cache.Indexer
+	serviceStore       cache.Indexer
+	endpointSliceStore cache.Indexer
+	clusterConfig      *virtconfig.ClusterConfig
+	hasSynced          func() bool
+}
+
+// NewController creates a new instance of the service network controller.
+func NewController(clientset kubecli.KubevirtClient, vmiInformer cache.SharedIndexInformer, serviceInformer cache.SharedIndexInformer, endpointSliceInformer cache.SharedIndexInformer, clusterConfig *virtconfig.ClusterConfig) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-servicenetwork"},
+		),
+		vmiStore:           vmiInformer.GetIndexer(),
+		serviceStore:       serviceInformer.GetIndexer(),
+		endpointSliceStore: endpointSliceInformer.GetIndexer(),
+		clusterConfig:      clusterConfig,
+	}
+
+	c.hasSynced = func() bool {
+		return vmiInformer.HasSynced() && serviceInformer.HasSynced() && endpointSliceInformer.HasSynced()
+	}
+
+	_, err := serviceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueService,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueService(curr) },
+		DeleteFunc: c.enqueueService,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addVirtualMachineInstance,
+		UpdateFunc: c.updateVirtualMachineInstance,
+		DeleteFunc: c.deleteVirtualMachineInstance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = endpointSliceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, curr interface{}) { c.enqueueEndpointSliceService(curr) },
+		DeleteFunc: c.enqueueEndpointSliceService,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueService(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from service.")
+		return
+	}
+	c.queue.Add(key)
+}
+
+// enqueueEndpointSliceService enqueues the Service of an EndpointSlice changed or deleted behind
+// the back of the controller, to publish it again
+func (c *Controller) enqueueEndpointSliceService(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	endpointSlice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return
+	}
+	serviceName := endpointSlice.Labels[discoveryv1.LabelServiceName]
+	if serviceName == "" {
+		return
+	}
+	c.queue.Add(endpointSlice.Namespace + "/" + serviceName)
+}
+
+func (c *Controller) addVirtualMachineInstance(obj interface{}) {
+	c.enqueueSelectingServices(obj.(*virtv1.VirtualMachineInstance))
+}
+
+// updateVirtualMachineInstance enqueues the Services selecting a VMI before and after the update,
+// as the change of its labels can move it from a Service to another one
+func (c *Controller) updateVirtualMachineInstance(old, curr interface{}) {
+	oldVMI := old.(*virtv1.VirtualMachineInstance)
+	currVMI := curr.(*virtv1.VirtualMachineInstance)
+	if oldVMI.ResourceVersion == currVMI.ResourceVersion {
+		return
+	}
+	c.enqueueSelectingServices(currVMI)
+	if !equality.Semantic.DeepEqual(oldVMI.Labels, currVMI.Labels) {
+		c.enqueueSelectingServices(oldVMI)
+	}
+}
+
+func (c *Controller) deleteVirtualMachineInstance(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		return
+	}
+	c.enqueueSelectingServices(vmi)
+}
+
+func (c *Controller) enqueueSelectingServices(vmi *virtv1.VirtualMachineInstance) {
+	objs, err := c.serviceStore.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to list the services of namespace %s", vmi.Namespace)
+		return
+	}
+	for _, obj := range objs {
+		service := obj.(*k8sv1.Service)
+		selector, err := vmiSelector(service)
+		if err != nil || !selector.Matches(labels.Set(vmi.Labels)) {
+			continue
+		}
+		c.enqueueService(service)
+	}
+}
+
+// Run runs the passed in service network controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting service network controller.")
+
+	// Wait for cache sync before we start the service network controller
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping service network controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute publishes the EndpointSlices of a Service from the queue, if there is an error it
+// requeues the Service. Returns false if the queue is shut down.
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.sync(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing endpoint slices of Service %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed endpoint slices of Service %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) sync(key string) error {
+	if !c.clusterConfig.SecondaryNetworkServicesEnabled() {
+		return nil
+	}
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	obj, exists, err := c.serviceStore.GetByKey(key)
+	if err != nil {
+		return err
+	}
+
+	// Without the Service, or when the Service is no longer for a secondary network, the
+	// EndpointSlices left are removed
+	desired := map[string]*discoveryv1.EndpointSlice{}
+	if exists {
+		service := obj.(*k8sv1.Service)
+		if service.DeletionTimestamp == nil && len(service.Spec.Selector) == 0 {
+			desired, err = c.desiredEndpointSlices(service)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	existing, err := c.existingEndpointSlices(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	endpointSlices := c.clientset.DiscoveryV1().EndpointSlices(namespace)
+	for sliceName, endpointSlice := range desired {
+		current, exists := existing[sliceName]
+		if !exists {
+			if _, err := endpointSlices.Create(context.Background(), endpointSlice, metav1.CreateOptions{}); err != nil {
+				return fmt.Errorf("failed to create endpoint slice %s: %v", sliceName, err)
+			}
+			continue
+		}
+		if endpointSliceUpToDate(current, endpointSlice) {
+			continue
+		}
+		endpointSlice.ResourceVersion = current.ResourceVersion
+		if _, err := endpointSlices.Update(context.Background(), endpointSlice, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update endpoint slice %s: %v", sliceName, err)
+		}
+	}
+	for sliceName := range existing {
+		if _, exists := desired[sliceName]; exists {
+			continue
+		}
+		err := endpointSlices.Delete(context.Background(), sliceName, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete endpoint slice %s: %v", sliceName, err)
+		}
+	}
+	return nil
+}
+
+func (c *Controller) existingEndpointSlices(namespace, serviceName string) (map[string]*discoveryv1.EndpointSlice, error) {
+	objs, err := c.endpointSliceStore.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string]*discoveryv1.EndpointSlice{}
+	for _, obj := range objs {
+		endpointSlice := obj.(*discoveryv1.EndpointSlice)
+		if endpointSlice.Labels[discoveryv1.LabelServiceName] == serviceName {
+			existing[endpointSlice.Name] = endpointSlice
+		}
+	}
+	return existing, nil
+}
+
+// desiredEndpointSlices returns an EndpointSlice for every IP family of a Service, with the
+// addresses of the selected VMIs on the network of the Service
+func (c *Controller) desiredEndpointSlices(service *k8sv1.Service) (map[string]*discoveryv1.EndpointSlice, error) {
+	vmis, err := c.selectedVMIs(service)
+	if err != nil {
+		return nil, err
+	}
+
+	ipFamilies := service.Spec.IPFamilies
+	if len(ipFamilies) == 0 {
+		ipFamilies = []k8sv1.IPFamily{k8sv1.IPv4Protocol}
+	}
+
+	network := service.Labels[virtv1.ServiceNetworkLabel]
+	ports := endpointPorts(service)
+	desired := map[string]*discoveryv1.EndpointSlice{}
+	for _, ipFamily := range ipFamilies {
+		endpointSlice := newEndpointSlice(service, ipFamily)
+		endpointSlice.Ports = ports
+		for _, vmi := range vmis {
+			if endpoint := newEndpoint(vmi, network, ipFamily); endpoint != nil {
+				endpointSlice.Endpoints = append(endpointSlice.Endpoints, *endpoint)
+			}
+		}
+		desired[endpointSlice.Name] = endpointSlice
+	}
+	return desired, nil
+}
+
+// selectedVMIs returns the VMIs selected by a Service, sorted by name
+func (c *Controller) selectedVMIs(service *k8sv1.Service) ([]*virtv1.VirtualMachineInstance, error) {
+	selector, err := vmiSelector(service)
+	if err != nil {
+		log.Log.Object(service).Reason(err).Warning("Failed to parse the virtualmachineinstance selector of the service")
+		return nil, nil
+	}
+
+	objs, err := c.vmiStore.ByIndex(cache.NamespaceIndex, service.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var vmis []*virtv1.VirtualMachineInstance
+	for _, obj := range objs {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if selector.Matches(labels.Set(vmi.Labels)) {
+			vmis = append(vmis, vmi)
+		}
+	}
+	sort.Slice(vmis, func(i, j int) bool { return vmis[i].Name < vmis[j].Name })
+	return vmis, nil
+}
+
+// vmiSelector returns the selector of the VMIs of a Service, which selects none when the Service
+// has no selector annotation
+func vmiSelector(service *k8sv1.Service) (labels.Selector, error) {
+	selector, exists := service.Annotations[virtv1.ServiceVMISelectorAnnotation]
+	if !exists || strings.TrimSpace(selector) == "" {
+		return labels.Nothing(), nil
+	}
+	return labels.Parse(selector)
+}
+
+func newEndpointSlice(service *k8sv1.Service, ipFamily k8sv1.IPFamily) *discoveryv1.EndpointSlice {
+	addressType := discoveryv1.AddressTypeIPv4
+	if ipFamily == k8sv1.IPv6Protocol {
+		addressType = discoveryv1.AddressTypeIPv6
+	}
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-kubevirt-%s", service.Name, strings.ToLower(string(addressType))),
+			Namespace: service.Namespace,
+			Labels: map[string]string{
+				discoveryv1.LabelServiceName: service.Name,
+				discoveryv1.LabelManagedBy:   virtv1.EndpointSliceManagedByVirtController,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(service, k8sv1.SchemeGroupVersion.WithKind("Service")),
+			},
+		},
+		AddressType: addressType,
+	}
+}
+
+// endpointPorts returns the ports of the EndpointSlices of a Service. Named target ports are
+// skipped, as they name ports of pods.
+func endpointPorts(service *k8sv1.Service) []discoveryv1.EndpointPort {
+	var ports []discoveryv1.EndpointPort
+	for _, servicePort := range service.Spec.Ports {
+		port := servicePort.Port
+		switch {
+		case servicePort.TargetPort.Type == intstr.String:
+			log.Log.Object(service).Warningf("Skipping port %s of the service with named target port %s", servicePort.Name, servicePort.TargetPort.StrVal)
+			continue
+		case servicePort.TargetPort.IntVal != 0:
+			port = servicePort.TargetPort.IntVal
+		}
+		ports = append(ports, discoveryv1.EndpointPort{
+			Name:        pointer.P(servicePort.Name),
+			Protocol:    pointer.P(servicePort.Protocol),
+			Port:        pointer.P(port),
+			AppProtocol: servicePort.AppProtocol,
+		})
+	}
+	return ports
+}
+
+// newEndpoint returns the endpoint of a VMI with its address of the given IP family on the
+// network, or nil when the guest reports no such address. The endpoint follows the VMI to its
+// new node once migrated.
+func newEndpoint(vmi *virtv1.VirtualMachineInstance, network string, ipFamily k8sv1.IPFamily) *discoveryv1.Endpoint {
+	if vmi.IsFinal() || vmi.Status.NodeName == "" {
+		return nil
+	}
+	address := interfaceAddress(vmi, network, ipFamily)
+	if address == "" {
+		return nil
+	}
+
+	terminating := vmi.DeletionTimestamp != nil
+	serving := vmi.IsRunning() &&
+		controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceReady, k8sv1.ConditionTrue)
+	return &discoveryv1.Endpoint{
+		Addresses: []string{address},
+		Conditions: discoveryv1.EndpointConditions{
+			Ready:       pointer.P(serving && !terminating),
+			Serving:     pointer.P(serving),
+			Terminating: pointer.P(terminating),
+		},
+		NodeName: pointer.P(vmi.Status.NodeName),
+		TargetRef: &k8sv1.ObjectReference{
+			APIVersion: virtv1.GroupVersion.String(),
+			Kind:       virtv1.VirtualMachineInstanceGroupVersionKind.Kind,
+			Namespace:  vmi.Namespace,
+			Name:       vmi.Name,
+			UID:        vmi.UID,
+		},
+	}
+}
+
+// interfaceAddress returns the first global address of the given IP family the guest reports on
+// the interface of a network
+func interfaceAddress(vmi *virtv1.VirtualMachineInstance, network string, ipFamily k8sv1.IPFamily) string {
+	for _, iface := range vmi.Status.Interfaces {
+		if iface.Name != network {
+			continue
+		}
+		ips := iface.IPs
+		if len(ips) == 0 && iface.IP != "" {
+			ips = []string{iface.IP}
+		}
+		for _, ip := range ips {
+			addr, err := netip.ParseAddr(ip)
+			if err != nil || addr.IsLinkLocalUnicast() || addr.Is4() != (ipFamily == k8sv1.IPv4Protocol) {
+				continue
+			}
+			return addr.String()
+		}
+	}
+	return ""
+}
+
+func endpointSliceUpToDate(current, desired *discoveryv1.EndpointSlice) bool {
+	return current.AddressType == desired.AddressType &&
+		equality.Semantic.DeepEqual(current.Labels, desired.Labels) &&
+		equality.Semantic.DeepEqual(current.OwnerReferences, desired.OwnerReferences) &&
+		equality.Semantic.DeepEqual(current.Endpoints, desired.Endpoints) &&
+		equality.Semantic.DeepEqual(current.Ports, desired.Ports)
+}
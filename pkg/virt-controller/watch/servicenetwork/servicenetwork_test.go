@@ -0,0 +1,319 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package servicenetwork
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	serviceKey   = "default/web"
+	ipv4Slice    = "web-kubevirt-ipv4"
+	ipv6Slice    = "web-kubevirt-ipv6"
+	networkName  = "blue"
+	nodeName     = "node01"
+	migratedNode = "node02"
+)
+
+var _ = Describe("Service network controller", func() {
+	var (
+		kubeClient            *fake.Clientset
+		vmiInformer           cache.SharedIndexInformer
+		serviceInformer       cache.SharedIndexInformer
+		endpointSliceInformer cache.SharedIndexInformer
+		controller            *Controller
+		service               *k8sv1.Service
+	)
+
+	newController := func(featureGates ...string) {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient.EXPECT().DiscoveryV1().Return(kubeClient.DiscoveryV1()).AnyTimes()
+
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+
+		var err error
+		controller, err = NewController(virtClient, vmiInformer, serviceInformer, endpointSliceInformer, clusterConfig)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	newVMI := func(name, ipv4, ipv6 string) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID("uid-" + name),
+				Labels:    map[string]string{"app": "web"},
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:    v1.Running,
+				NodeName: nodeName,
+				Conditions: []v1.VirtualMachineInstanceCondition{{
+					Type:   v1.VirtualMachineInstanceReady,
+					Status: k8sv1.ConditionTrue,
+				}},
+				Interfaces: []v1.VirtualMachineInstanceNetworkInterface{
+					{Name: "default", IP: "10.244.0.10", IPs: []string{"10.244.0.10"}},
+					{Name: networkName, IP: ipv4, IPs: []string{ipv4, "fe80::1", ipv6}},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		kubeClient = fake.NewSimpleClientset()
+		vmiInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+		serviceInformer, _ = testutils.NewFakeInformerWithIndexersFor(&k8sv1.Service{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+		endpointSliceInformer, _ = testutils.NewFakeInformerWithIndexersFor(&discoveryv1.EndpointSlice{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+
+		service = &k8sv1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "web",
+				Namespace:   metav1.NamespaceDefault,
+				UID:         "service-uid",
+				Labels:      map[string]string{v1.ServiceNetworkLabel: networkName},
+				Annotations: map[string]string{v1.ServiceVMISelectorAnnotation: "app=web"},
+			},
+			Spec: k8sv1.ServiceSpec{
+				Type:       k8sv1.ServiceTypeLoadBalancer,
+				IPFamilies: []k8sv1.IPFamily{k8sv1.IPv4Protocol},
+				Ports: []k8sv1.ServicePort{{
+					Name:       "http",
+					Protocol:   k8sv1.ProtocolTCP,
+					Port:       80,
+					TargetPort: intstr.FromInt32(8080),
+				}},
+			},
+		}
+		Expect(serviceInformer.GetIndexer().Add(service)).To(Succeed())
+		Expect(vmiInformer.GetIndexer().Add(newVMI("vmi-b", "192.168.1.11", "fd00::11"))).To(Succeed())
+		Expect(vmiInformer.GetIndexer().Add(newVMI("vmi-a", "192.168.1.10", "fd00::10"))).To(Succeed())
+
+		newController(virtconfig.SecondaryNetworkServicesGate)
+	})
+
+	execute := func() {
+		controller.queue.Add(serviceKey)
+		Expect(controller.Execute()).To(BeTrue())
+		Expect(controller.queue.NumRequeues(serviceKey)).To(BeZero())
+	}
+
+	getEndpointSlice := func(name string) *discoveryv1.EndpointSlice {
+		endpointSlice, err := kubeClient.DiscoveryV1().EndpointSlices(metav1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return endpointSlice
+	}
+
+	listEndpointSlices := func() []discoveryv1.EndpointSlice {
+		list, err := kubeClient.DiscoveryV1().EndpointSlices(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return list.Items
+	}
+
+	// publish executes the controller and feeds the EndpointSlices it created back to its informer
+	publish := func() {
+		execute()
+		for _, endpointSlice := range listEndpointSlices() {
+			Expect(endpointSliceInformer.GetIndexer().Add(endpointSlice.DeepCopy())).To(Succeed())
+		}
+	}
+
+	addresses := func(endpointSlice *discoveryv1.EndpointSlice) []string {
+		var addresses []string
+		for _, endpoint := range endpointSlice.Endpoints {
+			addresses = append(addresses, endpoint.Addresses...)
+		}
+		return addresses
+	}
+
+	It("should publish the addresses of the selected VMIs on the network of the service", func() {
+		execute()
+
+		endpointSlice := getEndpointSlice(ipv4Slice)
+		Expect(endpointSlice.AddressType).To(Equal(discoveryv1.AddressTypeIPv4))
+		Expect(endpointSlice.Labels).To(Equal(map[string]string{
+			discoveryv1.LabelServiceName: "web",
+			discoveryv1.LabelManagedBy:   v1.EndpointSliceManagedByVirtController,
+		}))
+		Expect(endpointSlice.OwnerReferences).To(ConsistOf(HaveField("UID", service.UID)))
+		Expect(addresses(endpointSlice)).To(Equal([]string{"192.168.1.10", "192.168.1.11"}))
+		Expect(endpointSlice.Ports).To(Equal([]discoveryv1.EndpointPort{{
+			Name:     pointer.P("http"),
+			Protocol: pointer.P(k8sv1.ProtocolTCP),
+			Port:     pointer.P(int32(8080)),
+		}}))
+
+		endpoint := endpointSlice.Endpoints[0]
+		Expect(endpoint.Conditions.Ready).To(HaveValue(BeTrue()))
+		Expect(endpoint.NodeName).To(HaveValue(Equal(nodeName)))
+		Expect(endpoint.TargetRef.Kind).To(Equal("VirtualMachineInstance"))
+		Expect(endpoint.TargetRef.Name).To(Equal("vmi-a"))
+	})
+
+	It("should publish a slice for every IP family of the service", func() {
+		service.Spec.IPFamilies = []k8sv1.IPFamily{k8sv1.IPv4Protocol, k8sv1.IPv6Protocol}
+		Expect(serviceInformer.GetIndexer().Update(service)).To(Succeed())
+
+		execute()
+
+		endpointSlice := getEndpointSlice(ipv6Slice)
+		Expect(endpointSlice.AddressType).To(Equal(discoveryv1.AddressTypeIPv6))
+		Expect(addresses(endpointSlice)).To(Equal([]string{"fd00::10", "fd00::11"}))
+	})
+
+	It("should fall back to the port of the service without target port", func() {
+		service.Spec.Ports = []k8sv1.ServicePort{
+			{Name: "ssh", Protocol: k8sv1.ProtocolTCP, Port: 22},
+			{Name: "named", Protocol: k8sv1.ProtocolTCP, Port: 443, TargetPort: intstr.FromString("https")},
+		}
+		Expect(serviceInformer.GetIndexer().Update(service)).To(Succeed())
+
+		execute()
+
+		Expect(getEndpointSlice(ipv4Slice).Ports).To(ConsistOf(HaveField("Port", HaveValue(Equal(int32(22))))))
+	})
+
+	It("should report the VMIs which are not ready as not ready", func() {
+		vmi := newVMI("vmi-a", "192.168.1.10", "fd00::10")
+		vmi.Status.Conditions = nil
+		Expect(vmiInformer.GetIndexer().Update(vmi)).To(Succeed())
+
+		execute()
+
+		endpoint := getEndpointSlice(ipv4Slice).Endpoints[0]
+		Expect(endpoint.Conditions.Ready).To(HaveValue(BeFalse()))
+		Expect(endpoint.Conditions.Serving).To(HaveValue(BeFalse()))
+	})
+
+	It("should skip the VMIs without address on the network", func() {
+		vmi := newVMI("vmi-a", "192.168.1.10", "fd00::10")
+		vmi.Status.Interfaces = vmi.Status.Interfaces[:1]
+		Expect(vmiInformer.GetIndexer().Update(vmi)).To(Succeed())
+
+		execute()
+
+		Expect(addresses(getEndpointSlice(ipv4Slice))).To(Equal([]string{"192.168.1.11"}))
+	})
+
+	It("should follow a VMI to its node once migrated", func() {
+		publish()
+
+		vmi := newVMI("vmi-a", "192.168.1.10", "fd00::10")
+		vmi.Status.NodeName = migratedNode
+		Expect(vmiInformer.GetIndexer().Update(vmi)).To(Succeed())
+		execute()
+
+		Expect(getEndpointSlice(ipv4Slice).Endpoints[0].NodeName).To(HaveValue(Equal(migratedNode)))
+	})
+
+	It("should not update the slices which are up to date", func() {
+		publish()
+		kubeClient.ClearActions()
+
+		execute()
+
+		Expect(kubeClient.Actions()).To(BeEmpty())
+	})
+
+	It("should remove the slices of a service which is no longer for a secondary network", func() {
+		publish()
+		Expect(serviceInformer.GetIndexer().Delete(service)).To(Succeed())
+
+		execute()
+
+		Expect(listEndpointSlices()).To(BeEmpty())
+	})
+
+	It("should leave the services with a selector to Kubernetes", func() {
+		service.Spec.Selector = map[string]string{"app": "web"}
+		Expect(serviceInformer.GetIndexer().Update(service)).To(Succeed())
+
+		execute()
+
+		Expect(listEndpointSlices()).To(BeEmpty())
+	})
+
+	It("should publish no endpoints without VMI selector", func() {
+		service.Annotations = nil
+		Expect(serviceInformer.GetIndexer().Update(service)).To(Succeed())
+
+		execute()
+
+		Expect(getEndpointSlice(ipv4Slice).Endpoints).To(BeEmpty())
+	})
+
+	It("should do nothing without the feature gate", func() {
+		newController()
+
+		execute()
+
+		Expect(listEndpointSlices()).To(BeEmpty())
+	})
+
+	It("should enqueue the services selecting a VMI when it changes", func() {
+		vmi := newVMI("vmi-a", "192.168.1.10", "fd00::10")
+		updated := vmi.DeepCopy()
+		updated.ResourceVersion = "2"
+
+		controller.updateVirtualMachineInstance(vmi, updated)
+
+		Expect(controller.queue.Len()).To(Equal(1))
+		key, _ := controller.queue.Get()
+		Expect(key).To(Equal(serviceKey))
+	})
+
+	It("should enqueue the services which selected a VMI before its labels changed", func() {
+		vmi := newVMI("vmi-a", "192.168.1.10", "fd00::10")
+		updated := vmi.DeepCopy()
+		updated.ResourceVersion = "2"
+		updated.Labels = map[string]string{"app": "db"}
+
+		controller.updateVirtualMachineInstance(vmi, updated)
+
+		Expect(controller.queue.Len()).To(Equal(1))
+	})
+})
@@ -0,0 +1,183 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package timeline
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
+)
+
+// MaxEntries is the number of entries kept in a VirtualMachineTimeline, the oldest ones are dropped first
+const MaxEntries = 200
+
+// eventEntry returns the timeline entry of an event. If the event was updated because it
+// occurred again, only the new occurrences are counted.
+func eventEntry(oldEvent, event *k8sv1.Event) timelinev1alpha1.VirtualMachineTimelineEntry {
+	count := event.Count
+	if oldEvent != nil && oldEvent.Count > 0 {
+		count -= oldEvent.Count
+	}
+	if count < 1 {
+		count = 1
+	}
+
+	firstTimestamp, lastTimestamp := event.FirstTimestamp, event.LastTimestamp
+	if lastTimestamp.IsZero() {
+		// Events of the events.k8s.io API only have an event time
+		lastTimestamp = metav1.NewTime(event.EventTime.Time)
+	}
+	if firstTimestamp.IsZero() {
+		firstTimestamp = lastTimestamp
+	}
+
+	entry := timelinev1alpha1.VirtualMachineTimelineEntry{
+		Type:           timelinev1alpha1.VirtualMachineTimelineEntryEvent,
+		Reason:         event.Reason,
+		Message:        event.Message,
+		Warning:        event.Type == k8sv1.EventTypeWarning,
+		FirstTimestamp: firstTimestamp,
+		LastTimestamp:  lastTimestamp,
+		Count:          count,
+	}
+	if event.InvolvedObject.Kind == virtv1.VirtualMachineInstanceGroupVersionKind.Kind {
+		entry.VMIUID = event.InvolvedObject.UID
+	}
+	return entry
+}
+
+// vmiEntries returns the timeline entries for the phase, condition and migration transitions
+// between two revisions of a VMI
+func vmiEntries(oldVMI, vmi *virtv1.VirtualMachineInstance) []timelinev1alpha1.VirtualMachineTimelineEntry {
+	newEntry := func(entryType timelinev1alpha1.VirtualMachineTimelineEntryType, reason, message string, timestamp *metav1.Time) timelinev1alpha1.VirtualMachineTimelineEntry {
+		t := metav1.Now()
+		if timestamp != nil && !timestamp.IsZero() {
+			t = *timestamp
+		}
+		return timelinev1alpha1.VirtualMachineTimelineEntry{
+			Type:           entryType,
+			Reason:         reason,
+			Message:        message,
+			VMIUID:         vmi.UID,
+			FirstTimestamp: t,
+			LastTimestamp:  t,
+			Count:          1,
+		}
+	}
+
+	var entries []timelinev1alpha1.VirtualMachineTimelineEntry
+
+	if oldVMI.Status.Phase != vmi.Status.Phase && vmi.Status.Phase != "" {
+		entry := newEntry(timelinev1alpha1.VirtualMachineTimelineEntryPhase, string(vmi.Status.Phase), vmi.Status.Reason,
+			phaseTransitionTimestamp(vmi, vmi.Status.Phase))
+		entry.Warning = vmi.Status.Phase == virtv1.Failed
+		entries = append(entries, entry)
+	}
+
+	for _, cond := range vmi.Status.Conditions {
+		if oldCond := findCondition(oldVMI, cond.Type); oldCond != nil && oldCond.Status == cond.Status {
+			continue
+		}
+		entries = append(entries, newEntry(timelinev1alpha1.VirtualMachineTimelineEntryCondition, string(cond.Type), string(cond.Status),
+			&cond.LastTransitionTime))
+	}
+
+	if phase, timestamp := migrationPhase(vmi.Status.MigrationState); phase != "" {
+		if oldPhase, _ := migrationPhase(oldVMI.Status.MigrationState); oldPhase != phase ||
+			oldVMI.Status.MigrationState.MigrationUID != vmi.Status.MigrationState.MigrationUID {
+			state := vmi.Status.MigrationState
+			entry := newEntry(timelinev1alpha1.VirtualMachineTimelineEntryMigration, string(phase),
+				"from "+state.SourceNode+" to "+state.TargetNode, timestamp)
+			entry.Warning = phase == virtv1.MigrationFailed
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+func phaseTransitionTimestamp(vmi *virtv1.VirtualMachineInstance, phase virtv1.VirtualMachineInstancePhase) *metav1.Time {
+	for i := range vmi.Status.PhaseTransitionTimestamps {
+		if vmi.Status.PhaseTransitionTimestamps[i].Phase == phase {
+			return &vmi.Status.PhaseTransitionTimestamps[i].PhaseTransitionTimestamp
+		}
+	}
+	return nil
+}
+
+func findCondition(vmi *virtv1.VirtualMachineInstance, condType virtv1.VirtualMachineInstanceConditionType) *virtv1.VirtualMachineInstanceCondition {
+	for i := range vmi.Status.Conditions {
+		if vmi.Status.Conditions[i].Type == condType {
+			return &vmi.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// migrationPhase returns the phase of the migration of a VMI and when it was entered
+func migrationPhase(state *virtv1.VirtualMachineInstanceMigrationState) (virtv1.VirtualMachineInstanceMigrationPhase, *metav1.Time) {
+	switch {
+	case state == nil || state.StartTimestamp == nil:
+		return "", nil
+	case state.Failed:
+		return virtv1.MigrationFailed, state.EndTimestamp
+	case state.Completed:
+		return virtv1.MigrationSucceeded, state.EndTimestamp
+	default:
+		return virtv1.MigrationRunning, state.StartTimestamp
+	}
+}
+
+// mergeEntries adds the entries to the timeline. An entry identical to a recorded one only bumps
+// its count and last timestamp, entries which are not newer than the recorded one were already
+// counted and are ignored. Returns whether the timeline changed.
+func mergeEntries(timeline *timelinev1alpha1.VirtualMachineTimeline, entries []timelinev1alpha1.VirtualMachineTimelineEntry) bool {
+	changed := false
+	for _, entry := range entries {
+		if mergeEntry(timeline, entry) {
+			changed = true
+		}
+	}
+	if len(timeline.Entries) > MaxEntries {
+		timeline.Entries = timeline.Entries[len(timeline.Entries)-MaxEntries:]
+	}
+	return changed
+}
+
+func mergeEntry(timeline *timelinev1alpha1.VirtualMachineTimeline, entry timelinev1alpha1.VirtualMachineTimelineEntry) bool {
+	for i := range timeline.Entries {
+		recorded := &timeline.Entries[i]
+		if recorded.Type != entry.Type || recorded.Reason != entry.Reason ||
+			recorded.Message != entry.Message || recorded.VMIUID != entry.VMIUID {
+			continue
+		}
+		if !entry.LastTimestamp.After(recorded.LastTimestamp.Time) {
+			return false
+		}
+		recorded.Count += entry.Count
+		recorded.LastTimestamp = entry.LastTimestamp
+		recorded.Warning = entry.Warning
+		return true
+	}
+	timeline.Entries = append(timeline.Entries, entry)
+	return true
+}
@@ -0,0 +1,238 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package timeline
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// Controller records the events, phase and condition transitions and migrations of the VMs
+// and of their VMIs in a VirtualMachineTimeline per VM, which outlives the events and the VMIs.
+type Controller struct {
+	clientset     kubecli.KubevirtClient
+	queue         workqueue.TypedRateLimitingInterface[string]
+	vmStore       cache.Store
+	timelineStore cache.Store
+	hasSynced     func() bool
+
+	pendingLock sync.Mutex
+	pending     map[string][]timelinev1alpha1.VirtualMachineTimelineEntry
+}
+
+// NewController creates a new instance of the VirtualMachineTimeline controller.
+func NewController(clientset kubecli.KubevirtClient, timelineInformer, vmInformer, vmiInformer, eventInformer cache.SharedIndexInformer) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-timeline"},
+		),
+		vmStore:       vmInformer.GetStore(),
+		timelineStore: timelineInformer.GetStore(),
+		pending:       map[string][]timelinev1alpha1.VirtualMachineTimelineEntry{},
+	}
+
+	c.hasSynced = func() bool {
+		return timelineInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && eventInformer.HasSynced()
+	}
+
+	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.updateVirtualMachineInstance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = eventInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addEvent,
+		UpdateFunc: c.updateEvent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) updateVirtualMachineInstance(old, curr interface{}) {
+	oldVMI := old.(*virtv1.VirtualMachineInstance)
+	currVMI := curr.(*virtv1.VirtualMachineInstance)
+
+	if !isOwnedByVM(currVMI) {
+		return
+	}
+	c.record(currVMI.Namespace, currVMI.Name, vmiEntries(oldVMI, currVMI)...)
+}
+
+func isOwnedByVM(vmi *virtv1.VirtualMachineInstance) bool {
+	owner := metav1.GetControllerOf(vmi)
+	return owner != nil && owner.Kind == virtv1.VirtualMachineGroupVersionKind.Kind
+}
+
+func (c *Controller) addEvent(obj interface{}) {
+	c.recordEvent(nil, obj.(*k8sv1.Event))
+}
+
+func (c *Controller) updateEvent(old, curr interface{}) {
+	oldEvent := old.(*k8sv1.Event)
+	currEvent := curr.(*k8sv1.Event)
+	if oldEvent.ResourceVersion == currEvent.ResourceVersion {
+		return
+	}
+	c.recordEvent(oldEvent, currEvent)
+}
+
+func (c *Controller) recordEvent(oldEvent, event *k8sv1.Event) {
+	switch event.InvolvedObject.Kind {
+	case virtv1.VirtualMachineGroupVersionKind.Kind, virtv1.VirtualMachineInstanceGroupVersionKind.Kind:
+		// A VMI has the name of its VM
+		c.record(event.InvolvedObject.Namespace, event.InvolvedObject.Name, eventEntry(oldEvent, event))
+	}
+}
+
+// record queues the entries to be added to the timeline of a VM
+func (c *Controller) record(namespace, name string, entries ...timelinev1alpha1.VirtualMachineTimelineEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	key := controller.NamespacedKey(namespace, name)
+	if _, exists, err := c.vmStore.GetByKey(key); err != nil || !exists {
+		return
+	}
+
+	c.pendingLock.Lock()
+	c.pending[key] = append(c.pending[key], entries...)
+	c.pendingLock.Unlock()
+	c.queue.Add(key)
+}
+
+// Run runs the passed in VirtualMachineTimeline controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting timeline controller.")
+
+	// Wait for cache sync before we start the timeline controller
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping timeline controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute adds the pending entries of a VM from the queue to its timeline, if there is an error
+// it requeues the VM. Returns false if the queue is shut down.
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	c.pendingLock.Lock()
+	entries := c.pending[key]
+	delete(c.pending, key)
+	c.pendingLock.Unlock()
+
+	if err := c.execute(key, entries); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing timeline of VirtualMachine %v", key)
+		c.pendingLock.Lock()
+		c.pending[key] = append(entries, c.pending[key]...)
+		c.pendingLock.Unlock()
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed timeline of VirtualMachine %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string, entries []timelinev1alpha1.VirtualMachineTimelineEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	obj, exists, err := c.vmStore.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// The timeline is owned by the VM and garbage collected with it
+		return nil
+	}
+	vm := obj.(*virtv1.VirtualMachine)
+
+	obj, exists, err = c.timelineStore.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	timelines := c.clientset.GeneratedKubeVirtClient().TimelineV1alpha1().VirtualMachineTimelines(vm.Namespace)
+
+	if !exists {
+		timeline := &timelinev1alpha1.VirtualMachineTimeline{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vm.Name,
+				Namespace: vm.Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind),
+				},
+			},
+		}
+		mergeEntries(timeline, entries)
+		_, err = timelines.Create(context.Background(), timeline, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) {
+			return fmt.Errorf("timeline of VirtualMachine %s is not in the cache yet", key)
+		}
+		return err
+	}
+
+	timeline := obj.(*timelinev1alpha1.VirtualMachineTimeline).DeepCopy()
+	if !mergeEntries(timeline, entries) {
+		return nil
+	}
+	_, err = timelines.Update(context.Background(), timeline, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,255 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package timeline
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("VirtualMachineTimeline controller", func() {
+	var (
+		client           *kubevirtfake.Clientset
+		timelineInformer cache.SharedIndexInformer
+		vmInformer       cache.SharedIndexInformer
+		controller       *Controller
+		vm               *v1.VirtualMachine
+		now              metav1.Time
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		client = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().GeneratedKubeVirtClient().Return(client).AnyTimes()
+
+		timelineInformer, _ = testutils.NewFakeInformerFor(&timelinev1alpha1.VirtualMachineTimeline{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		eventInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Event{})
+
+		var err error
+		controller, err = NewController(virtClient, timelineInformer, vmInformer, vmiInformer, eventInformer)
+		Expect(err).ToNot(HaveOccurred())
+
+		vm = &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testvm",
+				Namespace: metav1.NamespaceDefault,
+				UID:       "vm-uid",
+			},
+		}
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		now = metav1.NewTime(time.Now().Truncate(time.Second))
+	})
+
+	newVMI := func(phase v1.VirtualMachineInstancePhase) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            vm.Name,
+				Namespace:       vm.Namespace,
+				UID:             "vmi-uid",
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)},
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase: phase,
+				PhaseTransitionTimestamps: []v1.VirtualMachineInstancePhaseTransitionTimestamp{
+					{Phase: phase, PhaseTransitionTimestamp: now},
+				},
+			},
+		}
+	}
+
+	newEvent := func(eventType, reason string, count int32, lastTimestamp metav1.Time) *k8sv1.Event {
+		return &k8sv1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "testvm.event",
+				Namespace:       vm.Namespace,
+				ResourceVersion: reason + lastTimestamp.String(),
+			},
+			InvolvedObject: k8sv1.ObjectReference{
+				Kind:       "VirtualMachineInstance",
+				APIVersion: v1.SchemeGroupVersion.String(),
+				Name:       vm.Name,
+				Namespace:  vm.Namespace,
+				UID:        "vmi-uid",
+			},
+			Type:           eventType,
+			Reason:         reason,
+			Message:        "a message",
+			Count:          count,
+			FirstTimestamp: now,
+			LastTimestamp:  lastTimestamp,
+		}
+	}
+
+	getTimeline := func() *timelinev1alpha1.VirtualMachineTimeline {
+		timeline, err := client.TimelineV1alpha1().VirtualMachineTimelines(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(timelineInformer.GetStore().Update(timeline)).To(Succeed())
+		return timeline
+	}
+
+	execute := func() {
+		Expect(controller.queue.Len()).To(Equal(1))
+		Expect(controller.Execute()).To(BeTrue())
+	}
+
+	It("should create the timeline owned by the VM", func() {
+		controller.updateVirtualMachineInstance(newVMI(v1.Scheduled), newVMI(v1.Running))
+		execute()
+
+		timeline := getTimeline()
+		Expect(metav1.IsControlledBy(timeline, vm)).To(BeTrue())
+		Expect(timeline.Entries).To(HaveLen(1))
+		Expect(timeline.Entries[0].Type).To(Equal(timelinev1alpha1.VirtualMachineTimelineEntryPhase))
+		Expect(timeline.Entries[0].Reason).To(Equal(string(v1.Running)))
+		Expect(timeline.Entries[0].VMIUID).To(BeEquivalentTo("vmi-uid"))
+		Expect(timeline.Entries[0].Count).To(BeEquivalentTo(1))
+	})
+
+	It("should ignore VMIs without a VM", func() {
+		vmi := newVMI(v1.Running)
+		vmi.OwnerReferences = nil
+		controller.updateVirtualMachineInstance(newVMI(v1.Scheduled), vmi)
+		Expect(controller.queue.Len()).To(BeZero())
+	})
+
+	It("should ignore the events of unknown VMs", func() {
+		event := newEvent(k8sv1.EventTypeNormal, "Created", 1, now)
+		event.InvolvedObject.Name = "unknown"
+		controller.addEvent(event)
+		Expect(controller.queue.Len()).To(BeZero())
+	})
+
+	It("should deduplicate repeated events", func() {
+		first := newEvent(k8sv1.EventTypeWarning, "SyncFailed", 1, now)
+		controller.addEvent(first)
+		execute()
+		getTimeline()
+
+		later := metav1.NewTime(now.Add(time.Minute))
+		controller.updateEvent(first, newEvent(k8sv1.EventTypeWarning, "SyncFailed", 3, later))
+		execute()
+
+		timeline := getTimeline()
+		Expect(timeline.Entries).To(HaveLen(1))
+		Expect(timeline.Entries[0].Type).To(Equal(timelinev1alpha1.VirtualMachineTimelineEntryEvent))
+		Expect(timeline.Entries[0].Warning).To(BeTrue())
+		Expect(timeline.Entries[0].Count).To(BeEquivalentTo(3))
+		Expect(timeline.Entries[0].FirstTimestamp.Equal(&now)).To(BeTrue())
+		Expect(timeline.Entries[0].LastTimestamp.Equal(&later)).To(BeTrue())
+	})
+
+	It("should not count replayed events twice", func() {
+		event := newEvent(k8sv1.EventTypeNormal, "Created", 1, now)
+		controller.addEvent(event)
+		execute()
+		getTimeline()
+
+		// e.g. after a restart of virt-controller
+		controller.addEvent(event)
+		execute()
+
+		Expect(getTimeline().Entries[0].Count).To(BeEquivalentTo(1))
+	})
+
+	It("should record condition transitions and migrations", func() {
+		oldVMI := newVMI(v1.Running)
+		vmi := newVMI(v1.Running)
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+			{Type: v1.VirtualMachineInstanceReady, Status: k8sv1.ConditionFalse, LastTransitionTime: now},
+		}
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			MigrationUID:   "migration-uid",
+			StartTimestamp: &now,
+			EndTimestamp:   &now,
+			SourceNode:     "node01",
+			TargetNode:     "node02",
+			Failed:         true,
+		}
+		controller.updateVirtualMachineInstance(oldVMI, vmi)
+		execute()
+
+		timeline := getTimeline()
+		Expect(timeline.Entries).To(HaveLen(2))
+		Expect(timeline.Entries[0].Type).To(Equal(timelinev1alpha1.VirtualMachineTimelineEntryCondition))
+		Expect(timeline.Entries[0].Reason).To(Equal(string(v1.VirtualMachineInstanceReady)))
+		Expect(timeline.Entries[0].Message).To(Equal(string(k8sv1.ConditionFalse)))
+		Expect(timeline.Entries[1].Type).To(Equal(timelinev1alpha1.VirtualMachineTimelineEntryMigration))
+		Expect(timeline.Entries[1].Reason).To(Equal(string(v1.MigrationFailed)))
+		Expect(timeline.Entries[1].Message).To(Equal("from node01 to node02"))
+		Expect(timeline.Entries[1].Warning).To(BeTrue())
+
+		// Unchanged conditions are not recorded again
+		controller.updateVirtualMachineInstance(vmi, vmi.DeepCopy())
+		Expect(controller.queue.Len()).To(BeZero())
+	})
+
+	It("should keep the entries of previous VMIs", func() {
+		controller.updateVirtualMachineInstance(newVMI(v1.Scheduled), newVMI(v1.Running))
+		execute()
+		getTimeline()
+
+		oldVMI := newVMI(v1.Scheduled)
+		oldVMI.UID = "restarted-vmi-uid"
+		vmi := newVMI(v1.Running)
+		vmi.UID = "restarted-vmi-uid"
+		controller.updateVirtualMachineInstance(oldVMI, vmi)
+		execute()
+
+		timeline := getTimeline()
+		Expect(timeline.Entries).To(HaveLen(2))
+		Expect(timeline.Entries[0].VMIUID).To(BeEquivalentTo("vmi-uid"))
+		Expect(timeline.Entries[1].VMIUID).To(BeEquivalentTo("restarted-vmi-uid"))
+	})
+
+	It("should drop the oldest entries", func() {
+		timeline := &timelinev1alpha1.VirtualMachineTimeline{}
+		var entries []timelinev1alpha1.VirtualMachineTimelineEntry
+		for i := 0; i < MaxEntries+10; i++ {
+			entries = append(entries, timelinev1alpha1.VirtualMachineTimelineEntry{
+				Type:          timelinev1alpha1.VirtualMachineTimelineEntryEvent,
+				Reason:        "Reason",
+				Message:       string(rune('a'+i%26)) + string(rune('a'+i/26)),
+				LastTimestamp: now,
+				Count:         1,
+			})
+		}
+		Expect(mergeEntries(timeline, entries)).To(BeTrue())
+		Expect(timeline.Entries).To(HaveLen(MaxEntries))
+		Expect(timeline.Entries[0].Message).To(Equal(entries[10].Message))
+	})
+})
@@ -0,0 +1,155 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	defaultLeaseWarningPeriod = time.Hour
+
+	leaseExpiringEventReason = "LeaseExpiring"
+	leaseExpiredEventReason  = "LeaseExpired"
+)
+
+// syncLease stops or deletes the VM once its lease expired, and sets the LeaseExpiring condition
+// during the warning period before. It returns true if the VM was deleted.
+func (c *Controller) syncLease(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine, bool, error) {
+	conditionManager := controller.NewVirtualMachineConditionManager()
+	lease := vm.Spec.Lease
+	if lease == nil || !c.clusterConfig.VMLeaseEnabled() || vm.Labels[virtv1.LeaseExemptLabel] == "true" {
+		conditionManager.RemoveCondition(vm, virtv1.VirtualMachineLeaseExpiring)
+		return vm, false, nil
+	}
+
+	warningPeriod := defaultLeaseWarningPeriod
+	if lease.WarningPeriod != nil {
+		warningPeriod = lease.WarningPeriod.Duration
+	}
+	action := lease.Action
+	if action == "" {
+		action = virtv1.VirtualMachineLeaseActionStop
+	}
+	expiresAt := lease.ExpiresAt.UTC().Format(time.RFC3339)
+
+	remaining := time.Until(lease.ExpiresAt.Time)
+	if remaining > warningPeriod {
+		conditionManager.RemoveCondition(vm, virtv1.VirtualMachineLeaseExpiring)
+		c.Queue.AddAfter(controller.VirtualMachineKey(vm), remaining-warningPeriod)
+		return vm, false, nil
+	}
+
+	if remaining > 0 {
+		if c.updateLeaseCondition(vm, virtv1.VirtualMachineReasonLeaseExpiresSoon, fmt.Sprintf("The lease expires at %s, the VM is then %s", expiresAt, leaseActionVerb(action))) {
+			c.recorder.Eventf(vm, k8score.EventTypeWarning, leaseExpiringEventReason, "The lease of the VirtualMachine expires at %s, it is then %s", expiresAt, leaseActionVerb(action))
+		}
+		c.Queue.AddAfter(controller.VirtualMachineKey(vm), remaining)
+		return vm, false, nil
+	}
+
+	if action == virtv1.VirtualMachineLeaseActionDelete {
+		log.Log.Object(vm).Infof("Deleting the VM, its lease expired at %s", expiresAt)
+		err := c.clientset.VirtualMachine(vm.Namespace).Delete(context.Background(), vm.Name, metav1.DeleteOptions{})
+		if err != nil && !apiErrors.IsNotFound(err) {
+			return vm, false, err
+		}
+		c.recorder.Eventf(vm, k8score.EventTypeNormal, leaseExpiredEventReason, "The lease of the VirtualMachine expired at %s, it was deleted", expiresAt)
+		return vm, true, nil
+	}
+
+	runStrategy, err := vm.RunStrategy()
+	if err != nil {
+		return vm, false, err
+	}
+	if runStrategy != virtv1.RunStrategyHalted {
+		log.Log.Object(vm).Infof("Stopping the VM, its lease expired at %s", expiresAt)
+		vm, err = c.haltVM(vm)
+		if err != nil {
+			return vm, false, err
+		}
+	}
+	if c.updateLeaseCondition(vm, virtv1.VirtualMachineReasonLeaseExpired, fmt.Sprintf("The lease expired at %s, the VM is stopped until the lease is renewed", expiresAt)) {
+		c.recorder.Eventf(vm, k8score.EventTypeNormal, leaseExpiredEventReason, "The lease of the VirtualMachine expired at %s, it was stopped", expiresAt)
+	}
+	return vm, false, nil
+}
+
+// updateLeaseCondition sets the LeaseExpiring condition, it returns true if its reason changed
+func (c *Controller) updateLeaseCondition(vm *virtv1.VirtualMachine, reason, message string) bool {
+	conditionManager := controller.NewVirtualMachineConditionManager()
+	transitionTime := metav1.Now()
+	previous := conditionManager.GetCondition(vm, virtv1.VirtualMachineLeaseExpiring)
+	changed := previous == nil || previous.Reason != reason
+	if !changed {
+		transitionTime = previous.LastTransitionTime
+	}
+
+	conditionManager.UpdateCondition(vm, &virtv1.VirtualMachineCondition{
+		Type:               virtv1.VirtualMachineLeaseExpiring,
+		Status:             k8score.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: transitionTime,
+	})
+	return changed
+}
+
+// haltVM sets the run strategy of the VM to Halted, or running to false with the deprecated field
+func (c *Controller) haltVM(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine, error) {
+	patchSet := patch.New()
+	if vm.Spec.Running != nil {
+		patchSet.AddOption(
+			patch.WithTest("/spec/running", *vm.Spec.Running),
+			patch.WithReplace("/spec/running", false),
+		)
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/spec/runStrategy", vm.Spec.RunStrategy),
+			patch.WithReplace("/spec/runStrategy", virtv1.RunStrategyHalted),
+		)
+	}
+	payload, err := patchSet.GeneratePayload()
+	if err != nil {
+		return vm, err
+	}
+
+	return c.clientset.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, payload, metav1.PatchOptions{})
+}
+
+func leaseActionVerb(action virtv1.VirtualMachineLeaseAction) string {
+	if action == virtv1.VirtualMachineLeaseActionDelete {
+		return "deleted"
+	}
+	return "stopped"
+}
@@ -0,0 +1,145 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/network/multus"
+)
+
+const (
+	startDependencyPollInterval         = 5 * time.Second
+	startDependencyTimedOutPollInterval = time.Minute
+
+	startDependencyTimedOutEventReason = "StartDependencyTimedOut"
+)
+
+// syncStartDependencies returns true once all start dependencies of the VM are ready.
+// While waiting, the WaitingForDependencies condition describes the missing dependencies
+// and the VM is re-enqueued to poll them again.
+func (c *Controller) syncStartDependencies(vm *virtv1.VirtualMachine) (bool, error) {
+	conditionManager := controller.NewVirtualMachineConditionManager()
+	if len(vm.Spec.StartDependencies) == 0 || !c.clusterConfig.VMStartDependenciesEnabled() {
+		conditionManager.RemoveCondition(vm, virtv1.VirtualMachineWaitingForDependencies)
+		return true, nil
+	}
+
+	waitingSince := metav1.Now()
+	previous := conditionManager.GetCondition(vm, virtv1.VirtualMachineWaitingForDependencies)
+	if previous != nil {
+		waitingSince = previous.LastTransitionTime
+	}
+
+	var notReady []string
+	timedOut := false
+	for _, dependency := range vm.Spec.StartDependencies {
+		reason, err := c.checkStartDependency(vm.Namespace, dependency)
+		if err != nil {
+			return false, err
+		}
+		if reason == "" {
+			continue
+		}
+		if dependency.Timeout != nil && time.Since(waitingSince.Time) > dependency.Timeout.Duration {
+			timedOut = true
+			reason = fmt.Sprintf("%s (timed out after %s)", reason, dependency.Timeout.Duration)
+		}
+		notReady = append(notReady, reason)
+	}
+
+	if len(notReady) == 0 {
+		conditionManager.RemoveCondition(vm, virtv1.VirtualMachineWaitingForDependencies)
+		return true, nil
+	}
+
+	reason := virtv1.VirtualMachineReasonDependenciesNotReady
+	interval := startDependencyPollInterval
+	if timedOut {
+		reason = virtv1.VirtualMachineReasonDependencyTimedOut
+		interval = startDependencyTimedOutPollInterval
+		if previous == nil || previous.Reason != reason {
+			c.recorder.Eventf(vm, k8score.EventTypeWarning, startDependencyTimedOutEventReason, "Start dependencies are not ready: %s", strings.Join(notReady, ", "))
+		}
+	}
+
+	conditionManager.UpdateCondition(vm, &virtv1.VirtualMachineCondition{
+		Type:               virtv1.VirtualMachineWaitingForDependencies,
+		Status:             k8score.ConditionTrue,
+		Reason:             reason,
+		Message:            strings.Join(notReady, ", "),
+		LastTransitionTime: waitingSince,
+	})
+
+	log.Log.Object(vm).V(4).Infof("Waiting for start dependencies: %s", strings.Join(notReady, ", "))
+	c.Queue.AddAfter(controller.VirtualMachineKey(vm), interval)
+	return false, nil
+}
+
+// checkStartDependency returns an empty string if the dependency is ready, otherwise the reason why it isn't
+func (c *Controller) checkStartDependency(namespace string, dependency virtv1.StartDependency) (string, error) {
+	switch dependency.Kind {
+	case virtv1.StartDependencyPersistentVolumeClaim:
+		obj, exists, err := c.pvcStore.GetByKey(controller.NamespacedKey(namespace, dependency.Name))
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return fmt.Sprintf("PersistentVolumeClaim %s does not exist", dependency.Name), nil
+		}
+		if pvc := obj.(*k8score.PersistentVolumeClaim); pvc.Status.Phase != k8score.ClaimBound {
+			return fmt.Sprintf("PersistentVolumeClaim %s is not bound", dependency.Name), nil
+		}
+		return "", nil
+	case virtv1.StartDependencyNetworkAttachmentDefinition:
+		nadName := multus.NetAttachDefNamespacedName(namespace, dependency.Name)
+		_, err := c.clientset.NetworkClient().K8sCniCncfIoV1().NetworkAttachmentDefinitions(nadName.Namespace).Get(context.Background(), nadName.Name, metav1.GetOptions{})
+		if apiErrors.IsNotFound(err) {
+			return fmt.Sprintf("NetworkAttachmentDefinition %s does not exist", nadName.String()), nil
+		}
+		return "", err
+	case virtv1.StartDependencyService:
+		endpoints, err := c.clientset.CoreV1().Endpoints(namespace).Get(context.Background(), dependency.Name, metav1.GetOptions{})
+		if apiErrors.IsNotFound(err) {
+			return fmt.Sprintf("Service %s does not exist", dependency.Name), nil
+		} else if err != nil {
+			return "", err
+		}
+		for _, subset := range endpoints.Subsets {
+			if len(subset.Addresses) > 0 {
+				return "", nil
+			}
+		}
+		return fmt.Sprintf("Service %s has no ready endpoints", dependency.Name), nil
+	default:
+		return fmt.Sprintf("unknown dependency kind %s", dependency.Kind), nil
+	}
+}
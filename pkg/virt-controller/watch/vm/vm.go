@@ -109,16 +109,18 @@ const (
 )
 
 const (
-	hotplugVolumeErrorReason     = "HotPlugVolumeError"
-	hotplugCPUErrorReason        = "HotPlugCPUError"
-	memoryDumpErrorReason        = "MemoryDumpError"
-	failedUpdateErrorReason      = "FailedUpdateError"
-	failedCreateReason           = "FailedCreate"
-	vmiFailedDeleteReason        = "FailedDelete"
-	affinityChangeErrorReason    = "AffinityChangeError"
-	hotplugMemoryErrorReason     = "HotPlugMemoryError"
-	volumesUpdateErrorReason     = "VolumesUpdateError"
-	tolerationsChangeErrorReason = "TolerationsChangeError"
+	hotplugVolumeErrorReason      = "HotPlugVolumeError"
+	hotplugCPUErrorReason         = "HotPlugCPUError"
+	memoryDumpErrorReason         = "MemoryDumpError"
+	failedUpdateErrorReason       = "FailedUpdateError"
+	failedCreateReason            = "FailedCreate"
+	vmiFailedDeleteReason         = "FailedDelete"
+	affinityChangeErrorReason     = "AffinityChangeError"
+	hotplugMemoryErrorReason      = "HotPlugMemoryError"
+	volumesUpdateErrorReason      = "VolumesUpdateError"
+	tolerationsChangeErrorReason  = "TolerationsChangeError"
+	cpuResourcesChangeErrorReason = "CPUResourcesChangeError"
+	leaseErrorReason              = "LeaseError"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -841,6 +843,69 @@ func (c *Controller) handleTolerationsChangeRequest(vm *virtv1.VirtualMachine, v
 	return nil
 }
 
+func (c *Controller) handleCPUResourcesChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if !c.clusterConfig.LiveCPUResourcesEnabled() || vmi == nil || vmi.DeletionTimestamp != nil || vmi.IsCPUDedicated() {
+		return nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeMethods.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return err
+	}
+
+	resources := vmCopyWithInstancetype.Spec.Template.Spec.Domain.Resources
+	patchset := patch.New()
+	patchset.AddOption(cpuResourcePatchOptions("/spec/domain/resources/requests/cpu", resources.Requests, vmi.Spec.Domain.Resources.Requests)...)
+	patchset.AddOption(cpuResourcePatchOptions("/spec/domain/resources/limits/cpu", resources.Limits, vmi.Spec.Domain.Resources.Limits)...)
+	if patchset.IsEmpty() {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("CPU resources should not be changed during VMI migration")
+	}
+
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update CPU resources: %v", err)
+		return err
+	}
+	return nil
+}
+
+// cpuResourcePatchOptions replaces the CPU quantity of the VMI resources with the one of the VM. Adding or removing
+// it changes the QoS class of the virt-launcher pod and requires a restart.
+func cpuResourcePatchOptions(path string, vmResources, vmiResources k8score.ResourceList) []patch.PatchOption {
+	desired, desiredExists := vmResources[k8score.ResourceCPU]
+	current, currentExists := vmiResources[k8score.ResourceCPU]
+	if !desiredExists || !currentExists || desired.Equal(current) {
+		return nil
+	}
+	return []patch.PatchOption{patch.WithTest(path, current), patch.WithReplace(path, desired)}
+}
+
+func hasDedicatedCPUPlacement(vm *virtv1.VirtualMachine) bool {
+	cpu := vm.Spec.Template.Spec.Domain.CPU
+	return cpu != nil && cpu.DedicatedCPUPlacement
+}
+
+// copyLiveUpdatableCPUResources copies the CPU request and limit of src to dst when both of them have it
+func copyLiveUpdatableCPUResources(dst *virtv1.ResourceRequirements, src virtv1.ResourceRequirements) {
+	if quantity, exists := src.Requests[k8score.ResourceCPU]; exists {
+		if _, exists := dst.Requests[k8score.ResourceCPU]; exists {
+			dst.Requests[k8score.ResourceCPU] = quantity
+		}
+	}
+	if quantity, exists := src.Limits[k8score.ResourceCPU]; exists {
+		if _, exists := dst.Limits[k8score.ResourceCPU]; exists {
+			dst.Limits[k8score.ResourceCPU] = quantity
+		}
+	}
+}
+
 func (c *Controller) handleAffinityChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		return nil
@@ -1344,6 +1409,15 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 		return vm, nil
 	}
 
+	ready, err = c.syncStartDependencies(vm)
+	if err != nil {
+		return vm, err
+	}
+
+	if !ready {
+		return vm, nil
+	}
+
 	if controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineManualRecoveryRequired, k8score.ConditionTrue) {
 		log.Log.Object(vm).Reason(err).Error(failedManualRecoveryRequiredCondSetErrMsg)
 		return vm, nil
@@ -2641,6 +2715,7 @@ func (c *Controller) setPrintableStatus(vm *virtv1.VirtualMachine, vmi *virtv1.V
 		{virtv1.VirtualMachineStatusUnschedulable, c.isVirtualMachineStatusUnschedulable},
 		{virtv1.VirtualMachineStatusProvisioning, c.isVirtualMachineStatusProvisioning},
 		{virtv1.VirtualMachineStatusWaitingForVolumeBinding, c.isVirtualMachineStatusWaitingForVolumeBinding},
+		{virtv1.VirtualMachineStatusWaitingForDependencies, c.isVirtualMachineStatusWaitingForDependencies},
 		{virtv1.VirtualMachineStatusErrImagePull, c.isVirtualMachineStatusErrImagePull},
 		{virtv1.VirtualMachineStatusImagePullBackOff, c.isVirtualMachineStatusImagePullBackOff},
 		{virtv1.VirtualMachineStatusStarting, c.isVirtualMachineStatusStarting},
@@ -2704,6 +2779,11 @@ func (c *Controller) isVirtualMachineStatusWaitingForVolumeBinding(vm *virtv1.Vi
 	return storagetypes.HasUnboundPVC(vm.Namespace, vm.Spec.Template.Spec.Volumes, c.pvcStore)
 }
 
+// isVirtualMachineStatusWaitingForDependencies determines whether the VM status field should be set to "WaitingForDependencies".
+func (c *Controller) isVirtualMachineStatusWaitingForDependencies(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi == nil && controller.NewVirtualMachineConditionManager().HasCondition(vm, virtv1.VirtualMachineWaitingForDependencies)
+}
+
 // isVirtualMachineStatusStarting determines whether the VM status field should be set to "Starting".
 func (c *Controller) isVirtualMachineStatusStarting(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi == nil {
@@ -2836,6 +2916,10 @@ func syncConditions(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstanc
 	syncReadyConditionFromVMI(vm, vmi)
 	processFailureCondition(vm, syncErr)
 
+	if !isSetToStart(vm, vmi) {
+		cm.RemoveCondition(vm, virtv1.VirtualMachineWaitingForDependencies)
+	}
+
 	// nothing to do if vmi hasn't been created yet.
 	if vmi == nil {
 		return
@@ -2846,6 +2930,7 @@ func syncConditions(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstanc
 		string(virtv1.VirtualMachineReady):           nil,
 		string(virtv1.VirtualMachineFailure):         nil,
 		string(virtv1.VirtualMachineRestartRequired): nil,
+		string(virtv1.VirtualMachineLeaseExpiring):   nil,
 	}
 	vmiCondMap := make(map[string]interface{})
 
@@ -3197,6 +3282,10 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 		lastSeenVM.Spec.Template.Spec.NodeSelector = currentVM.Spec.Template.Spec.NodeSelector
 		lastSeenVM.Spec.Template.Spec.Affinity = currentVM.Spec.Template.Spec.Affinity
 		lastSeenVM.Spec.Template.Spec.Tolerations = currentVM.Spec.Template.Spec.Tolerations
+
+		if c.clusterConfig.LiveCPUResourcesEnabled() && !hasDedicatedCPUPlacement(currentVM) {
+			copyLiveUpdatableCPUResources(&lastSeenVM.Spec.Template.Spec.Domain.Resources, currentVM.Spec.Template.Spec.Domain.Resources)
+		}
 	} else {
 		// In the case live-updates aren't enable the volume set of the VM can be still changed by volume hotplugging.
 		// For imperative volume hotplug, first the VM status with the request AND the VMI spec are updated, then in the
@@ -3267,6 +3356,14 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 		return nil, vmi, nil, err
 	}
 
+	vm, deleted, err := c.syncLease(vm)
+	if err != nil {
+		return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling the lease: %v", err), leaseErrorReason), nil
+	}
+	if deleted {
+		return vm, vmi, nil, nil
+	}
+
 	// Scale up or down, if all expected creates and deletes were report by the listener
 	runStrategy, err := vm.RunStrategy()
 	if err != nil {
@@ -3344,6 +3441,10 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 			return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling tolerations change request: %v", err), tolerationsChangeErrorReason), nil
 		}
 
+		if err := c.handleCPUResourcesChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling CPU resources change request: %v", err), cpuResourcesChangeErrorReason), nil
+		}
+
 		if err := c.handleMemoryHotplugRequest(vmCopy, vmi); err != nil {
 			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling memory hotplug requests: %v", err), hotplugMemoryErrorReason), nil
 		}
@@ -6555,6 +6555,84 @@ var _ = Describe("VirtualMachine", func() {
 				)
 			})
 
+			Context("CPU resources", func() {
+				BeforeEach(func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								DeveloperConfiguration: &v1.DeveloperConfiguration{
+									FeatureGates: []string{virtconfig.LiveCPUResourcesGate},
+								},
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+				})
+
+				newVMWithCPUResources := func(existing, updated v1.ResourceRequirements) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Resources = updated
+					vmi.Spec.Domain.Resources = existing
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+					return vm, vmi
+				}
+
+				cpuResources := func(request, limit string) v1.ResourceRequirements {
+					return v1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse(request)},
+						Limits:   k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse(limit)},
+					}
+				}
+
+				It("should be live-updated", func() {
+					vm, vmi := newVMWithCPUResources(cpuResources("500m", "1"), cpuResources("1", "2"))
+
+					Expect(controller.handleCPUResourcesChangeRequest(vm, vmi)).To(Succeed())
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Resources.Requests.Cpu().String()).To(Equal("1"))
+					Expect(vmi.Spec.Domain.Resources.Limits.Cpu().String()).To(Equal("2"))
+				})
+
+				It("should not be live-updated with dedicated CPUs", func() {
+					vm, vmi := newVMWithCPUResources(cpuResources("1", "1"), cpuResources("2", "2"))
+					vmi.Spec.Domain.CPU = &v1.CPU{DedicatedCPUPlacement: true}
+
+					Expect(controller.handleCPUResourcesChangeRequest(vm, vmi)).To(Succeed())
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				It("should not add a CPU limit", func() {
+					existing := cpuResources("500m", "1")
+					delete(existing.Limits, k8sv1.ResourceCPU)
+					vm, vmi := newVMWithCPUResources(existing, cpuResources("500m", "2"))
+
+					Expect(controller.handleCPUResourcesChangeRequest(vm, vmi)).To(Succeed())
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				DescribeTable("should require a restart", func(lastSeen, current v1.ResourceRequirements, expectRestart bool) {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					lastSeenVMSpec := vm.Spec.DeepCopy()
+					lastSeenVMSpec.Template.Spec.Domain.Resources = lastSeen
+					vm.Spec.Template.Spec.Domain.Resources = current
+
+					Expect(controller.addRestartRequiredIfNeeded(lastSeenVMSpec, vm, vmi)).To(Equal(expectRestart))
+				},
+					Entry("not when changing the CPU request and limit", cpuResources("500m", "1"), cpuResources("1", "2"), false),
+					Entry("when adding a CPU limit", v1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("500m")},
+					}, cpuResources("500m", "1"), true),
+				)
+			})
+
 			Context("Affinity", func() {
 				It("should be live-updated", func() {
 					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
@@ -7395,6 +7473,275 @@ var _ = Describe("VirtualMachine", func() {
 			})
 		})
 
+		Context("start dependencies", func() {
+			BeforeEach(func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DeveloperConfiguration: &v1.DeveloperConfiguration{
+								FeatureGates: []string{virtconfig.VMStartDependenciesGate},
+							},
+						},
+					},
+				})
+			})
+
+			newPVC := func(namespace, name string, phase k8sv1.PersistentVolumeClaimPhase) *k8sv1.PersistentVolumeClaim {
+				return &k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+					Status:     k8sv1.PersistentVolumeClaimStatus{Phase: phase},
+				}
+			}
+
+			getWaitingCondition := func(vm *v1.VirtualMachine) *v1.VirtualMachineCondition {
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				return virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineWaitingForDependencies)
+			}
+
+			It("should not create the VMI while a PersistentVolumeClaim dependency is not bound", func() {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.StartDependencies = []v1.StartDependency{{Kind: v1.StartDependencyPersistentVolumeClaim, Name: "data"}}
+				Expect(controller.pvcStore.Add(newPVC(vm.Namespace, "data", k8sv1.ClaimPending))).To(Succeed())
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+				cond := getWaitingCondition(vm)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Reason).To(Equal(v1.VirtualMachineReasonDependenciesNotReady))
+				Expect(cond.Message).To(ContainSubstring("PersistentVolumeClaim data is not bound"))
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusWaitingForDependencies))
+			})
+
+			It("should report a missing Service dependency", func() {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.StartDependencies = []v1.StartDependency{{Kind: v1.StartDependencyService, Name: "database"}}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+				cond := getWaitingCondition(vm)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Message).To(ContainSubstring("Service database does not exist"))
+			})
+
+			It("should create the VMI once all dependencies are ready", func() {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.StartDependencies = []v1.StartDependency{
+					{Kind: v1.StartDependencyPersistentVolumeClaim, Name: "data"},
+					{Kind: v1.StartDependencyService, Name: "database"},
+				}
+				Expect(controller.pvcStore.Add(newPVC(vm.Namespace, "data", k8sv1.ClaimBound))).To(Succeed())
+				_, err := k8sClient.CoreV1().Endpoints(vm.Namespace).Create(context.TODO(), &k8sv1.Endpoints{
+					ObjectMeta: metav1.ObjectMeta{Namespace: vm.Namespace, Name: "database"},
+					Subsets:    []k8sv1.EndpointSubset{{Addresses: []k8sv1.EndpointAddress{{IP: "10.0.0.1"}}}},
+				}, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				Expect(getWaitingCondition(vm)).To(BeNil())
+			})
+
+			It("should mark the dependencies as timed out and keep waiting", func() {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.StartDependencies = []v1.StartDependency{{
+					Kind:    v1.StartDependencyPersistentVolumeClaim,
+					Name:    "data",
+					Timeout: &metav1.Duration{Duration: time.Minute},
+				}}
+				vm.Status.Conditions = []v1.VirtualMachineCondition{{
+					Type:               v1.VirtualMachineWaitingForDependencies,
+					Status:             k8sv1.ConditionTrue,
+					Reason:             v1.VirtualMachineReasonDependenciesNotReady,
+					LastTransitionTime: metav1.NewTime(time.Now().Add(-10 * time.Minute)),
+				}}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+				testutils.ExpectEvent(recorder, startDependencyTimedOutEventReason)
+				cond := getWaitingCondition(vm)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Reason).To(Equal(v1.VirtualMachineReasonDependencyTimedOut))
+				Expect(cond.Message).To(ContainSubstring("timed out after 1m0s"))
+			})
+
+			It("should remove the condition when the VM is not set to start", func() {
+				vm, _ := watchtesting.DefaultVirtualMachine(false)
+				vm.Spec.StartDependencies = []v1.StartDependency{{Kind: v1.StartDependencyPersistentVolumeClaim, Name: "data"}}
+				vm.Status.Conditions = []v1.VirtualMachineCondition{{
+					Type:   v1.VirtualMachineWaitingForDependencies,
+					Status: k8sv1.ConditionTrue,
+					Reason: v1.VirtualMachineReasonDependenciesNotReady,
+				}}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				Expect(getWaitingCondition(vm)).To(BeNil())
+			})
+		})
+
+		Context("with a lease", func() {
+			BeforeEach(func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DeveloperConfiguration: &v1.DeveloperConfiguration{
+								FeatureGates: []string{virtconfig.VMLeaseGate},
+							},
+						},
+					},
+				})
+			})
+
+			newLeasedVM := func(expiresIn time.Duration, action v1.VirtualMachineLeaseAction) *v1.VirtualMachine {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Lease = &v1.VirtualMachineLease{
+					ExpiresAt: metav1.NewTime(time.Now().Add(expiresIn)),
+					Action:    action,
+				}
+				return vm
+			}
+
+			createVM := func(vm *v1.VirtualMachine) *v1.VirtualMachine {
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				addVirtualMachine(vm)
+				return vm
+			}
+
+			getLeaseCondition := func(vm *v1.VirtualMachine) *v1.VirtualMachineCondition {
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				return virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineLeaseExpiring)
+			}
+
+			It("should start the VM before the warning period", func() {
+				vm := createVM(newLeasedVM(2*time.Hour, ""))
+
+				sanityExecute(vm)
+
+				_, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				Expect(getLeaseCondition(vm)).To(BeNil())
+			})
+
+			It("should warn during the warning period", func() {
+				vm := createVM(newLeasedVM(10*time.Minute, ""))
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, leaseExpiringEventReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				cond := getLeaseCondition(vm)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+				Expect(cond.Reason).To(Equal(v1.VirtualMachineReasonLeaseExpiresSoon))
+				Expect(cond.Message).To(ContainSubstring("the VM is then stopped"))
+			})
+
+			It("should not warn again during the warning period", func() {
+				vm := newLeasedVM(10*time.Minute, "")
+				vm.Status.Conditions = []v1.VirtualMachineCondition{{
+					Type:   v1.VirtualMachineLeaseExpiring,
+					Status: k8sv1.ConditionTrue,
+					Reason: v1.VirtualMachineReasonLeaseExpiresSoon,
+				}}
+				vm = createVM(vm)
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				Expect(getLeaseCondition(vm)).ToNot(BeNil())
+			})
+
+			It("should stop the VM once the lease expired", func() {
+				vm := createVM(newLeasedVM(-time.Minute, ""))
+
+				sanityExecute(vm)
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.RunStrategy()).To(Equal(v1.RunStrategyHalted))
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+				testutils.ExpectEvent(recorder, leaseExpiredEventReason)
+				cond := getLeaseCondition(vm)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Reason).To(Equal(v1.VirtualMachineReasonLeaseExpired))
+			})
+
+			It("should delete the VM once the lease expired with the Delete action", func() {
+				vm := createVM(newLeasedVM(-time.Minute, v1.VirtualMachineLeaseActionDelete))
+
+				sanityExecute(vm)
+
+				_, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+				testutils.ExpectEvent(recorder, leaseExpiredEventReason)
+			})
+
+			It("should not expire an exempt VM", func() {
+				vm := newLeasedVM(-time.Minute, v1.VirtualMachineLeaseActionDelete)
+				vm.Labels = map[string]string{v1.LeaseExemptLabel: "true"}
+				vm = createVM(vm)
+
+				sanityExecute(vm)
+
+				_, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				Expect(getLeaseCondition(vm)).To(BeNil())
+			})
+
+			It("should remove the condition once the lease was renewed", func() {
+				vm := newLeasedVM(2*time.Hour, "")
+				vm.Status.Conditions = []v1.VirtualMachineCondition{{
+					Type:   v1.VirtualMachineLeaseExpiring,
+					Status: k8sv1.ConditionTrue,
+					Reason: v1.VirtualMachineReasonLeaseExpiresSoon,
+				}}
+				vm = createVM(vm)
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				Expect(getLeaseCondition(vm)).To(BeNil())
+			})
+		})
+
 	})
 	Context("syncConditions", func() {
 		var vm *v1.VirtualMachine
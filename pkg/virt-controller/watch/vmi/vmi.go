@@ -53,6 +53,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/redaction"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
@@ -412,9 +413,9 @@ func (c *Controller) syncPodAnnotations(pod *k8sv1.Pod, newAnnotations map[strin
 	return patchedPod, nil
 }
 
-func (c *Controller) setLauncherContainerInfo(vmi *virtv1.VirtualMachineInstance, curPodImage string) *virtv1.VirtualMachineInstance {
+func (c *Controller) setLauncherContainerInfo(vmi *virtv1.VirtualMachineInstance, curPodImage string, expectedPodImage string) *virtv1.VirtualMachineInstance {
 
-	if curPodImage != "" && curPodImage != c.templateService.GetLauncherImage() {
+	if curPodImage != "" && curPodImage != expectedPodImage {
 		if vmi.Labels == nil {
 			vmi.Labels = map[string]string{}
 		}
@@ -478,7 +479,7 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 				break
 			}
 		}
-		vmiCopy = c.setLauncherContainerInfo(vmiCopy, foundImage)
+		vmiCopy = c.setLauncherContainerInfo(vmiCopy, foundImage, c.templateService.GetLauncherImageForPod(pod))
 
 		if err := c.syncPausedConditionToPod(vmiCopy, pod); err != nil {
 			return fmt.Errorf("error syncing paused condition to pod: %v", err)
@@ -709,6 +710,10 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 
 	controller.SetVMIPhaseTransitionTimestamp(vmi, vmiCopy)
 
+	if c.clusterConfig.InfraDetailsRedactionEnabled() {
+		redaction.RedactConditions(vmiCopy)
+	}
+
 	// If we detect a change on the vmi we update the vmi
 	vmiChanged := !equality.Semantic.DeepEqual(vmi.Status, vmiCopy.Status) || !equality.Semantic.DeepEqual(vmi.Finalizers, vmiCopy.Finalizers) || !equality.Semantic.DeepEqual(vmi.Annotations, vmiCopy.Annotations) || !equality.Semantic.DeepEqual(vmi.Labels, vmiCopy.Labels)
 	if vmiChanged {
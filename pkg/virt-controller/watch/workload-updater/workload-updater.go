@@ -309,6 +309,11 @@ func (c *WorkloadUpdateController) isOutdated(vmi *virtv1.VirtualMachineInstance
 	// either the VMI is either running or done migrating.
 	if vmi.Status.LauncherContainerImageVersion == "" {
 		return false
+	} else if c.clusterConfig.LauncherImageOverridesEnabled() {
+		// the expected image depends on the node selector of the pod,
+		// virt-controller labels the VMIs whose pod runs another image.
+		_, outdated := vmi.Labels[virtv1.OutdatedLauncherImageLabel]
+		return outdated
 	} else if vmi.Status.LauncherContainerImageVersion != c.launcherImage {
 		return true
 	}
@@ -494,6 +494,27 @@ var _ = Describe("Workload Updater", func() {
 
 	})
 
+	Context("with launcher image overrides", func() {
+		BeforeEach(func() {
+			controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: []string{virtconfig.LauncherImageOverridesGate},
+				},
+			})
+		})
+
+		DescribeTable("should consider a VMI outdated only when it is labeled so", func(labeled bool) {
+			vmi := newVirtualMachineInstance("testvm", true, "pinned-image")
+			if labeled {
+				vmi.Labels = map[string]string{v1.OutdatedLauncherImageLabel: ""}
+			}
+			Expect(controller.isOutdated(vmi)).To(Equal(labeled))
+		},
+			Entry("with the label", true),
+			Entry("without the label", false),
+		)
+	})
+
 	Context("LiveUpdate features", func() {
 		It("VMI needs to be migrated when memory hotplug is requested", func() {
 			condition := v1.VirtualMachineInstanceCondition{
@@ -131,6 +131,19 @@ func getGhostRecords() ([]ghostRecord, error) {
 	return records, nil
 }
 
+// ListGhostRecordUIDs returns the namespace/name keys of the VMIs with a ghost record, by their UID
+func ListGhostRecordUIDs() map[types.UID]string {
+	ghostRecordGlobalMutex.Lock()
+	defer ghostRecordGlobalMutex.Unlock()
+
+	uids := make(map[types.UID]string, len(ghostRecordGlobalCache))
+	for key, record := range ghostRecordGlobalCache {
+		uids[record.UID] = key
+	}
+
+	return uids
+}
+
 func findGhostRecordBySocket(socketFile string) (ghostRecord, bool) {
 	ghostRecordGlobalMutex.Lock()
 	defer ghostRecordGlobalMutex.Unlock()
@@ -211,6 +211,7 @@ var _ = Describe("Domain informer", func() {
 			domainManager.EXPECT().ListAllDomains().Return(list, nil)
 			domainManager.EXPECT().GetGuestOSInfo().Return(&api.GuestOSInfo{})
 			domainManager.EXPECT().InterfacesStatus().Return([]api.InterfaceStatus{})
+			domainManager.EXPECT().GuestDNS().Return(&api.GuestDNS{})
 
 			runCMDServer(wg, socketPath, domainManager, stopChan, nil)
 
@@ -238,6 +239,7 @@ var _ = Describe("Domain informer", func() {
 			domainManager.EXPECT().ListAllDomains().Return(list, nil)
 			domainManager.EXPECT().GetGuestOSInfo().Return(&api.GuestOSInfo{})
 			domainManager.EXPECT().InterfacesStatus().Return([]api.InterfaceStatus{})
+			domainManager.EXPECT().GuestDNS().Return(&api.GuestDNS{})
 
 			err := AddGhostRecord("test1-namespace", "test1", "somefile1", "1234-1")
 			Expect(err).ToNot(HaveOccurred())
@@ -268,6 +270,7 @@ var _ = Describe("Domain informer", func() {
 			domainManager.EXPECT().ListAllDomains().Return(list, nil)
 			domainManager.EXPECT().GetGuestOSInfo().Return(&api.GuestOSInfo{})
 			domainManager.EXPECT().InterfacesStatus().Return([]api.InterfaceStatus{})
+			domainManager.EXPECT().GuestDNS().Return(&api.GuestDNS{})
 
 			runCMDServer(wg, socketPath, domainManager, stopChan, nil)
 
@@ -288,6 +291,7 @@ var _ = Describe("Domain informer", func() {
 			domainManager.EXPECT().ListAllDomains().Return([]*api.Domain{domain}, nil)
 			domainManager.EXPECT().GetGuestOSInfo().Return(&api.GuestOSInfo{})
 			domainManager.EXPECT().InterfacesStatus().Return([]api.InterfaceStatus{})
+			domainManager.EXPECT().GuestDNS().Return(&api.GuestDNS{})
 			// now prove if we make a change, like adding a label, that the resync
 			// will pick that change up automatically
 			newDomain := domain.DeepCopy()
@@ -296,6 +300,7 @@ var _ = Describe("Domain informer", func() {
 			domainManager.EXPECT().ListAllDomains().Return([]*api.Domain{newDomain}, nil)
 			domainManager.EXPECT().GetGuestOSInfo().Return(nil)
 			domainManager.EXPECT().InterfacesStatus().Return(nil)
+			domainManager.EXPECT().GuestDNS().Return(nil)
 
 			runCMDServer(wg, socketPath, domainManager, stopChan, nil)
 
@@ -409,6 +414,7 @@ var _ = Describe("Domain informer", func() {
 			domainManager.EXPECT().ListAllDomains().Return(list, nil)
 			domainManager.EXPECT().GetGuestOSInfo().Return(&api.GuestOSInfo{})
 			domainManager.EXPECT().InterfacesStatus().Return([]api.InterfaceStatus{})
+			domainManager.EXPECT().GuestDNS().Return(&api.GuestDNS{})
 
 			runCMDServer(wg, socketPath, domainManager, stopChan, nil)
 			// ensure we can connect to the server first.
@@ -0,0 +1,120 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	runc_cgroups "github.com/opencontainers/runc/libcontainer/cgroups"
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+// This is a var so it can be changed by the unit tests
+var sysDevBlockPath = "/sys/dev/block"
+
+// applyCgroupWeights sets the cgroup v2 weights of a VMI on the cgroup of its virt-launcher pod. The pods,
+// rather than their containers, are the siblings sharing the CPUs and the devices of the node.
+func applyCgroupWeights(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager, launcherRoot *safepath.Path) error {
+	weights := vmi.Spec.Domain.Resources.CgroupWeights
+	if cgroupManager.GetCgroupVersion() != cgroup.V2 {
+		return fmt.Errorf("cgroup weights require cgroup v2")
+	}
+	containerPath, err := cgroupManager.GetBasePathToHostSubsystem("")
+	if err != nil {
+		return err
+	}
+	podPath := filepath.Dir(containerPath)
+
+	if weights.CPU != nil {
+		if err := runc_cgroups.WriteFile(podPath, "cpu.weight", strconv.FormatUint(uint64(*weights.CPU), 10)); err != nil {
+			return err
+		}
+	}
+
+	var ioWeights []string
+	if weights.IO != nil {
+		ioWeights = append(ioWeights, fmt.Sprintf("default %d", *weights.IO))
+	}
+	for _, volumeIO := range weights.VolumeIO {
+		device, err := volumeBackingDevice(launcherRoot, volumeIO.Volume)
+		if err != nil {
+			return fmt.Errorf("failed to weight the IO of volume %s: %w", volumeIO.Volume, err)
+		}
+		ioWeights = append(ioWeights, fmt.Sprintf("%s %d", device, volumeIO.Weight))
+	}
+	// io.weight takes a single entry per write
+	for _, ioWeight := range ioWeights {
+		if err := runc_cgroups.WriteFile(podPath, "io.weight", ioWeight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// volumeBackingDevice returns the MAJ:MIN of the disk backing a volume of the virt-launcher pod: its block
+// device, or the filesystem holding its disk image.
+func volumeBackingDevice(launcherRoot *safepath.Path, volumeName string) (string, error) {
+	var dev uint64
+	if blockPath, err := launcherRoot.AppendAndResolveWithRelativeRoot("dev", volumeName); err == nil {
+		if info, err := safepath.StatAtNoFollow(blockPath); err == nil && info.Mode()&os.ModeDevice != 0 && info.Mode()&os.ModeCharDevice == 0 {
+			dev = uint64(info.Sys().(*syscall.Stat_t).Rdev)
+		}
+	}
+	if dev == 0 {
+		imagePath, err := launcherRoot.AppendAndResolveWithRelativeRoot("var", "run", "kubevirt-private", "vmi-disks", volumeName, "disk.img")
+		if err != nil {
+			return "", err
+		}
+		info, err := safepath.StatAtNoFollow(imagePath)
+		if err != nil {
+			return "", err
+		}
+		dev = uint64(info.Sys().(*syscall.Stat_t).Dev)
+	}
+	if unix.Major(dev) == 0 {
+		return "", fmt.Errorf("volume %s is not backed by a block device", volumeName)
+	}
+	return wholeDisk(unix.Major(dev), unix.Minor(dev)), nil
+}
+
+// wholeDisk returns the MAJ:MIN of the disk of a partition, the IO of partitions can't be weighted
+func wholeDisk(major, minor uint32) string {
+	device := fmt.Sprintf("%d:%d", major, minor)
+	if _, err := os.Stat(filepath.Join(sysDevBlockPath, device, "partition")); err != nil {
+		return device
+	}
+	// The sysfs entry of a partition is a child of the one of its disk,
+	// the path is not cleaned so that the kernel follows the link first.
+	disk, err := os.ReadFile(sysDevBlockPath + "/" + device + "/../dev")
+	if err != nil {
+		return device
+	}
+	return strings.TrimSpace(string(disk))
+}
@@ -0,0 +1,108 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virthandler
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	runc_cgroups "github.com/opencontainers/runc/libcontainer/cgroups"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+var _ = Describe("Cgroup weights", func() {
+	var cgroupManager *cgroup.MockManager
+	var podPath string
+	var launcherRoot *safepath.Path
+
+	BeforeEach(func() {
+		cgroupManager = cgroup.NewMockManager(gomock.NewController(GinkgoT()))
+		podPath = GinkgoT().TempDir()
+		// emulate the cgroup filesystem in the temporary directory
+		runc_cgroups.TestMode = true
+		DeferCleanup(func() { runc_cgroups.TestMode = false })
+		var err error
+		launcherRoot, err = safepath.JoinAndResolveWithRelativeRoot(GinkgoT().TempDir())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	newVMIWithWeights := func(weights *v1.CgroupWeights) *v1.VirtualMachineInstance {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Resources.CgroupWeights = weights
+		return vmi
+	}
+
+	It("should set the weights on the cgroup of the pod", func() {
+		cgroupManager.EXPECT().GetCgroupVersion().Return(cgroup.V2)
+		cgroupManager.EXPECT().GetBasePathToHostSubsystem("").Return(filepath.Join(podPath, "compute.scope"), nil)
+
+		vmi := newVMIWithWeights(&v1.CgroupWeights{CPU: pointer.P(uint32(200)), IO: pointer.P(uint32(50))})
+		Expect(applyCgroupWeights(vmi, cgroupManager, launcherRoot)).To(Succeed())
+		Expect(os.ReadFile(filepath.Join(podPath, "cpu.weight"))).To(BeEquivalentTo("200"))
+		Expect(os.ReadFile(filepath.Join(podPath, "io.weight"))).To(BeEquivalentTo("default 50"))
+	})
+
+	It("should fail on cgroup v1", func() {
+		cgroupManager.EXPECT().GetCgroupVersion().Return(cgroup.V1)
+
+		vmi := newVMIWithWeights(&v1.CgroupWeights{CPU: pointer.P(uint32(200))})
+		Expect(applyCgroupWeights(vmi, cgroupManager, launcherRoot)).To(MatchError(ContainSubstring("require cgroup v2")))
+	})
+
+	It("should fail when the device of a volume is not found", func() {
+		cgroupManager.EXPECT().GetCgroupVersion().Return(cgroup.V2)
+		cgroupManager.EXPECT().GetBasePathToHostSubsystem("").Return(filepath.Join(podPath, "compute.scope"), nil)
+
+		vmi := newVMIWithWeights(&v1.CgroupWeights{VolumeIO: []v1.VolumeIOWeight{{Volume: "disk0", Weight: 100}}})
+		Expect(applyCgroupWeights(vmi, cgroupManager, launcherRoot)).To(MatchError(ContainSubstring("failed to weight the IO of volume disk0")))
+	})
+
+	Context("with the sysfs of the block devices", func() {
+		BeforeEach(func() {
+			sysfs := GinkgoT().TempDir()
+			Expect(os.MkdirAll(filepath.Join(sysfs, "devices", "sda", "sda1"), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(sysfs, "devices", "sda", "dev"), []byte("8:0\n"), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(sysfs, "devices", "sda", "sda1", "partition"), []byte("1\n"), 0644)).To(Succeed())
+			Expect(os.MkdirAll(filepath.Join(sysfs, "block"), 0755)).To(Succeed())
+			Expect(os.Symlink("../devices/sda", filepath.Join(sysfs, "block", "8:0"))).To(Succeed())
+			Expect(os.Symlink("../devices/sda/sda1", filepath.Join(sysfs, "block", "8:1"))).To(Succeed())
+
+			origSysDevBlockPath := sysDevBlockPath
+			sysDevBlockPath = filepath.Join(sysfs, "block")
+			DeferCleanup(func() { sysDevBlockPath = origSysDevBlockPath })
+		})
+
+		DescribeTable("should weight the IO of", func(major, minor uint32, expected string) {
+			Expect(wholeDisk(major, minor)).To(Equal(expected))
+		},
+			Entry("a disk on the disk", uint32(8), uint32(0), "8:0"),
+			Entry("a partition on its disk", uint32(8), uint32(1), "8:0"),
+		)
+	})
+})
@@ -343,6 +343,16 @@ func (_mr *_MockLauncherClientRecorder) InjectLaunchSecret(arg0, arg1 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "InjectLaunchSecret", arg0, arg1)
 }
 
+func (_m *MockLauncherClient) FlattenVolumes(_param0 *v1.VirtualMachineInstance, _param1 *v1.FlattenOptions) error {
+	ret := _m.ctrl.Call(_m, "FlattenVolumes", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) FlattenVolumes(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "FlattenVolumes", arg0, arg1)
+}
+
 func (_m *MockLauncherClient) SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance, options *v10.VirtualMachineOptions) error {
 	ret := _m.ctrl.Call(_m, "SyncVirtualMachineMemory", vmi, options)
 	ret0, _ := ret[0].(error)
@@ -0,0 +1,200 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	runc_cgroups "github.com/opencontainers/runc/libcontainer/cgroups"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+const (
+	minCPUShares = 2
+	maxCPUShares = 262144
+	minCPUQuota  = 1000
+	maxCPUWeight = 10000
+)
+
+// cpuResources is the CPU request and limit applied to the cgroups of a virt-launcher pod
+type cpuResources struct {
+	request *resource.Quantity
+	limit   *resource.Quantity
+}
+
+// applyCPUResources sets the cpu.weight and the cpu.max of the compute container of a VMI from its CPU request and
+// limit, the way the kubelet does. The cgroup of the pod, which the kubelet sized for all its containers, is moved
+// by the same amount as the one of the compute container.
+func applyCPUResources(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager) (cpuResources, error) {
+	var applied cpuResources
+	if cgroupManager.GetCgroupVersion() != cgroup.V2 {
+		return applied, fmt.Errorf("live CPU resources require cgroup v2")
+	}
+	containerPath, err := cgroupManager.GetBasePathToHostSubsystem("")
+	if err != nil {
+		return applied, err
+	}
+	podPath := filepath.Dir(containerPath)
+
+	if request, exists := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceCPU]; exists {
+		// The CPU weight of the pod is owned by the cgroup weights when they set it
+		weights := vmi.Spec.Domain.Resources.CgroupWeights
+		adjustPod := weights == nil || weights.CPU == nil
+		if err := updateCPUWeight(containerPath, podPath, milliCPUToWeight(request.MilliValue()), adjustPod); err != nil {
+			return applied, fmt.Errorf("failed to apply the CPU request: %w", err)
+		}
+		applied.request = &request
+	}
+	if limit, exists := vmi.Spec.Domain.Resources.Limits[k8sv1.ResourceCPU]; exists {
+		if err := updateCPUMax(containerPath, podPath, limit.MilliValue()); err != nil {
+			return applied, fmt.Errorf("failed to apply the CPU limit: %w", err)
+		}
+		applied.limit = &limit
+	}
+	return applied, nil
+}
+
+func updateCPUWeight(containerPath, podPath string, weight uint64, adjustPod bool) error {
+	current, err := readCgroupUint(containerPath, "cpu.weight")
+	if err != nil {
+		return err
+	}
+	if current == weight {
+		return nil
+	}
+	if err := runc_cgroups.WriteFile(containerPath, "cpu.weight", strconv.FormatUint(weight, 10)); err != nil {
+		return err
+	}
+	if !adjustPod {
+		return nil
+	}
+
+	podWeight, err := readCgroupUint(podPath, "cpu.weight")
+	if err != nil {
+		return err
+	}
+	newPodWeight := int64(podWeight) + int64(weight) - int64(current)
+	newPodWeight = max(1, min(newPodWeight, maxCPUWeight))
+	return runc_cgroups.WriteFile(podPath, "cpu.weight", strconv.FormatInt(newPodWeight, 10))
+}
+
+func updateCPUMax(containerPath, podPath string, milliCPU int64) error {
+	quota, period, err := readCPUMax(containerPath)
+	if err != nil {
+		return err
+	}
+	newQuota := max(milliCPU*period/1000, minCPUQuota)
+	if quota == newQuota {
+		return nil
+	}
+	if err := runc_cgroups.WriteFile(containerPath, "cpu.max", fmt.Sprintf("%d %d", newQuota, period)); err != nil {
+		return err
+	}
+
+	podQuota, podPeriod, err := readCPUMax(podPath)
+	if err != nil {
+		return err
+	}
+	// Nothing to move when either the pod or the container was not limited
+	if podQuota < 0 || quota < 0 {
+		return nil
+	}
+	newPodQuota := max(podQuota+(newQuota-quota)*podPeriod/period, minCPUQuota)
+	return runc_cgroups.WriteFile(podPath, "cpu.max", fmt.Sprintf("%d %d", newPodQuota, podPeriod))
+}
+
+// milliCPUToWeight converts a CPU request to cgroup v2 weight through the cgroup v1 shares, as the kubelet does
+func milliCPUToWeight(milliCPU int64) uint64 {
+	shares := max(minCPUShares, min(milliCPU*1024/1000, maxCPUShares))
+	return runc_cgroups.ConvertCPUSharesToCgroupV2Value(uint64(shares))
+}
+
+func readCgroupUint(path, file string) (uint64, error) {
+	value, err := runc_cgroups.ReadFile(path, file)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+}
+
+// readCPUMax returns the quota and the period of cpu.max, the quota is -1 when it is not limited
+func readCPUMax(path string) (int64, int64, error) {
+	value, err := runc_cgroups.ReadFile(path, "cpu.max")
+	if err != nil {
+		return 0, 0, err
+	}
+	fields := strings.Fields(value)
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("unexpected cpu.max content %q", value)
+	}
+	period, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	if fields[0] == "max" {
+		return -1, period, nil
+	}
+	quota, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return quota, period, nil
+}
+
+func (d *VirtualMachineController) setCPUResources(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager) error {
+	applied, err := applyCPUResources(vmi, cgroupManager)
+	if err != nil {
+		return err
+	}
+	d.appliedCPUResources.Store(vmi.UID, applied)
+	return nil
+}
+
+// updateCPUResourcesStatus reports the CPU request and limit of the VMI spec and the ones last applied to its cgroups
+func (d *VirtualMachineController) updateCPUResourcesStatus(vmi *v1.VirtualMachineInstance) {
+	if !d.clusterConfig.LiveCPUResourcesEnabled() || vmi.IsCPUDedicated() {
+		return
+	}
+	if vmi.Status.CPUResources == nil {
+		vmi.Status.CPUResources = &v1.CPUResourcesStatus{}
+	}
+	status := vmi.Status.CPUResources
+	status.DesiredRequest = cpuQuantity(vmi.Spec.Domain.Resources.Requests)
+	status.DesiredLimit = cpuQuantity(vmi.Spec.Domain.Resources.Limits)
+	if applied, ok := d.appliedCPUResources.Load(vmi.UID); ok {
+		status.AppliedRequest = applied.(cpuResources).request
+		status.AppliedLimit = applied.(cpuResources).limit
+	}
+}
+
+func cpuQuantity(resources k8sv1.ResourceList) *resource.Quantity {
+	if quantity, exists := resources[k8sv1.ResourceCPU]; exists {
+		return &quantity
+	}
+	return nil
+}
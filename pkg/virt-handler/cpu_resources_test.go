@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+package virthandler
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	runc_cgroups "github.com/opencontainers/runc/libcontainer/cgroups"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+var _ = Describe("Live CPU resources", func() {
+	var cgroupManager *cgroup.MockManager
+	var podPath, containerPath string
+
+	writeCgroupFile := func(path, file, content string) {
+		ExpectWithOffset(1, os.WriteFile(filepath.Join(path, file), []byte(content), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		cgroupManager = cgroup.NewMockManager(gomock.NewController(GinkgoT()))
+		podPath = GinkgoT().TempDir()
+		containerPath = filepath.Join(podPath, "compute.scope")
+		Expect(os.Mkdir(containerPath, 0755)).To(Succeed())
+		// emulate the cgroup filesystem in the temporary directory
+		runc_cgroups.TestMode = true
+		DeferCleanup(func() { runc_cgroups.TestMode = false })
+
+		// A pod of a compute container with 1 CPU and a sidecar with 2 CPUs
+		writeCgroupFile(podPath, "cpu.weight", "118")
+		writeCgroupFile(podPath, "cpu.max", "300000 100000")
+		writeCgroupFile(containerPath, "cpu.weight", "39")
+		writeCgroupFile(containerPath, "cpu.max", "100000 100000")
+	})
+
+	newVMIWithCPUResources := func(request, limit string) *v1.VirtualMachineInstance {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse(request)}
+		vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse(limit)}
+		return vmi
+	}
+
+	It("should move the cgroups of the container and of the pod", func() {
+		cgroupManager.EXPECT().GetCgroupVersion().Return(cgroup.V2)
+		cgroupManager.EXPECT().GetBasePathToHostSubsystem("").Return(containerPath, nil)
+
+		applied, err := applyCPUResources(newVMIWithCPUResources("2", "2"), cgroupManager)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(applied.request.String()).To(Equal("2"))
+		Expect(applied.limit.String()).To(Equal("2"))
+
+		Expect(os.ReadFile(filepath.Join(containerPath, "cpu.weight"))).To(BeEquivalentTo("79"))
+		Expect(os.ReadFile(filepath.Join(containerPath, "cpu.max"))).To(BeEquivalentTo("200000 100000"))
+		Expect(os.ReadFile(filepath.Join(podPath, "cpu.weight"))).To(BeEquivalentTo("158"))
+		Expect(os.ReadFile(filepath.Join(podPath, "cpu.max"))).To(BeEquivalentTo("400000 100000"))
+	})
+
+	It("should leave the cgroups untouched when they already match", func() {
+		cgroupManager.EXPECT().GetCgroupVersion().Return(cgroup.V2)
+		cgroupManager.EXPECT().GetBasePathToHostSubsystem("").Return(containerPath, nil)
+
+		_, err := applyCPUResources(newVMIWithCPUResources("1", "1"), cgroupManager)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.ReadFile(filepath.Join(podPath, "cpu.weight"))).To(BeEquivalentTo("118"))
+		Expect(os.ReadFile(filepath.Join(podPath, "cpu.max"))).To(BeEquivalentTo("300000 100000"))
+	})
+
+	It("should leave the CPU weight of the pod to the cgroup weights", func() {
+		cgroupManager.EXPECT().GetCgroupVersion().Return(cgroup.V2)
+		cgroupManager.EXPECT().GetBasePathToHostSubsystem("").Return(containerPath, nil)
+
+		vmi := newVMIWithCPUResources("2", "2")
+		vmi.Spec.Domain.Resources.CgroupWeights = &v1.CgroupWeights{CPU: pointer.P(uint32(500))}
+		_, err := applyCPUResources(vmi, cgroupManager)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.ReadFile(filepath.Join(containerPath, "cpu.weight"))).To(BeEquivalentTo("79"))
+		Expect(os.ReadFile(filepath.Join(podPath, "cpu.weight"))).To(BeEquivalentTo("118"))
+	})
+
+	It("should not limit an unlimited pod", func() {
+		cgroupManager.EXPECT().GetCgroupVersion().Return(cgroup.V2)
+		cgroupManager.EXPECT().GetBasePathToHostSubsystem("").Return(containerPath, nil)
+		writeCgroupFile(podPath, "cpu.max", "max 100000")
+
+		_, err := applyCPUResources(newVMIWithCPUResources("1", "500m"), cgroupManager)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.ReadFile(filepath.Join(containerPath, "cpu.max"))).To(BeEquivalentTo("50000 100000"))
+		Expect(os.ReadFile(filepath.Join(podPath, "cpu.max"))).To(BeEquivalentTo("max 100000"))
+	})
+
+	It("should fail on cgroup v1", func() {
+		cgroupManager.EXPECT().GetCgroupVersion().Return(cgroup.V1)
+
+		_, err := applyCPUResources(newVMIWithCPUResources("1", "1"), cgroupManager)
+		Expect(err).To(MatchError(ContainSubstring("require cgroup v2")))
+	})
+
+	DescribeTable("should convert the CPU request to a weight", func(milliCPU int64, expected uint64) {
+		Expect(milliCPUToWeight(milliCPU)).To(Equal(expected))
+	},
+		Entry("for no request", int64(0), uint64(1)),
+		Entry("for one CPU", int64(1000), uint64(39)),
+		Entry("for the maximum of the shares", int64(1000000), uint64(10000)),
+	)
+})
@@ -0,0 +1,219 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package deviceinventory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	hostdevicesv1alpha1 "kubevirt.io/api/hostdevices/v1alpha1"
+	hostdevicescli "kubevirt.io/client-go/kubevirt/typed/hostdevices/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// InventoryReporter maintains the HostDeviceInventory of the node, named after it. The inventory is
+// owned by the node, and is garbage collected with it. Its status is updated only when the devices,
+// or their claims, change.
+type InventoryReporter struct {
+	clientset     k8scli.CoreV1Interface
+	client        hostdevicescli.HostdevicesV1alpha1Interface
+	clusterConfig *virtconfig.ClusterConfig
+	host          string
+	domainStore   cache.Store
+}
+
+func NewInventoryReporter(clientset k8scli.CoreV1Interface, client hostdevicescli.HostdevicesV1alpha1Interface, clusterConfig *virtconfig.ClusterConfig, host string, domainStore cache.Store) *InventoryReporter {
+	return &InventoryReporter{
+		clientset:     clientset,
+		client:        client,
+		clusterConfig: clusterConfig,
+		host:          host,
+		domainStore:   domainStore,
+	}
+}
+
+func (r *InventoryReporter) Run(interval time.Duration, stopCh chan struct{}) {
+	wait.JitterUntil(r.sync, interval, 1.2, true, stopCh)
+}
+
+func (r *InventoryReporter) sync() {
+	if !r.clusterConfig.HostDeviceInventoryEnabled() {
+		return
+	}
+
+	status := r.discover()
+	if err := r.report(status); err != nil {
+		log.DefaultLogger().Reason(err).Errorf("Can't report the host device inventory of node %s", r.host)
+	}
+}
+
+// discover lists the devices of the node, and completes them with the resource names they are
+// permitted as and the VMIs they are assigned to
+func (r *InventoryReporter) discover() hostdevicesv1alpha1.HostDeviceInventoryStatus {
+	pciResourceNames, mdevResourceNames := r.permittedResourceNames()
+	pciClaims, mdevClaims := r.claims()
+
+	status := hostdevicesv1alpha1.HostDeviceInventoryStatus{}
+	pciDevices, err := discoverPCIDevices()
+	if err != nil {
+		log.DefaultLogger().Reason(err).Error("Failed to discover the PCI devices")
+	}
+	for _, device := range pciDevices {
+		device.ResourceName = pciResourceNames[device.PCIVendorSelector]
+		device.ClaimedBy = pciClaims[device.Address]
+		status.PCIDevices = append(status.PCIDevices, device)
+	}
+
+	mdevs, err := discoverMediatedDevices()
+	if err != nil {
+		log.DefaultLogger().Reason(err).Error("Failed to discover the mediated devices")
+	}
+	for _, mdev := range mdevs {
+		mdev.ResourceName = mdevResourceNames[mdevSelector(mdev.Type)]
+		mdev.ClaimedBy = mdevClaims[mdev.UUID]
+		status.MediatedDevices = append(status.MediatedDevices, mdev)
+	}
+	return status
+}
+
+// permittedResourceNames maps the PCI vendor selectors and the mediated device names permitted in
+// the KubeVirt CR to their resource names
+func (r *InventoryReporter) permittedResourceNames() (pci map[string]string, mdev map[string]string) {
+	pci, mdev = map[string]string{}, map[string]string{}
+	hostDevs := r.clusterConfig.GetPermittedHostDevices()
+	if hostDevs == nil {
+		return pci, mdev
+	}
+	for _, pciDev := range hostDevs.PciHostDevices {
+		pci[strings.ToLower(pciDev.PCIVendorSelector)] = pciDev.ResourceName
+	}
+	for _, mdevDev := range hostDevs.MediatedDevices {
+		mdev[mdevSelector(mdevDev.MDEVNameSelector)] = mdevDev.ResourceName
+	}
+	return pci, mdev
+}
+
+// claims maps the PCI addresses and the mediated device UUIDs assigned to the domains of the node
+// to their VMIs
+func (r *InventoryReporter) claims() (pci map[string]*hostdevicesv1alpha1.DeviceClaim, mdev map[string]*hostdevicesv1alpha1.DeviceClaim) {
+	pci, mdev = map[string]*hostdevicesv1alpha1.DeviceClaim{}, map[string]*hostdevicesv1alpha1.DeviceClaim{}
+	for _, obj := range r.domainStore.List() {
+		domain := obj.(*api.Domain)
+		for _, hostDev := range domain.Spec.Devices.HostDevices {
+			address := hostDev.Source.Address
+			if address == nil {
+				continue
+			}
+			claim := &hostdevicesv1alpha1.DeviceClaim{
+				Namespace: domain.ObjectMeta.Namespace,
+				Name:      domain.ObjectMeta.Name,
+			}
+			switch hostDev.Type {
+			case api.HostDevicePCI:
+				pciAddress, err := formatPCIAddress(address)
+				if err != nil {
+					log.DefaultLogger().Reason(err).Warningf("Ignoring a host device of domain %s/%s", claim.Namespace, claim.Name)
+					continue
+				}
+				pci[pciAddress] = claim
+			case api.HostDeviceMDev:
+				mdev[address.UUID] = claim
+			}
+		}
+	}
+	return pci, mdev
+}
+
+// report creates the inventory of the node if missing, and updates its status when it changed
+func (r *InventoryReporter) report(status hostdevicesv1alpha1.HostDeviceInventoryStatus) error {
+	inventory, err := r.client.HostDeviceInventories().Get(context.Background(), r.host, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		inventory, err = r.create()
+	}
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(inventory.Status.PCIDevices, status.PCIDevices) &&
+		equality.Semantic.DeepEqual(inventory.Status.MediatedDevices, status.MediatedDevices) {
+		return nil
+	}
+
+	now := metav1.Now()
+	status.LastChangeTime = &now
+	inventory = inventory.DeepCopy()
+	inventory.Status = status
+	_, err = r.client.HostDeviceInventories().UpdateStatus(context.Background(), inventory, metav1.UpdateOptions{})
+	return err
+}
+
+func (r *InventoryReporter) create() (*hostdevicesv1alpha1.HostDeviceInventory, error) {
+	node, err := r.clientset.Nodes().Get(context.Background(), r.host, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := &hostdevicesv1alpha1.HostDeviceInventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: r.host,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: k8sv1.SchemeGroupVersion.String(),
+				Kind:       "Node",
+				Name:       node.Name,
+				UID:        node.UID,
+			}},
+		},
+	}
+	log.DefaultLogger().Infof("Creating the host device inventory of node %s", r.host)
+	return r.client.HostDeviceInventories().Create(context.Background(), inventory, metav1.CreateOptions{})
+}
+
+// formatPCIAddress formats the PCI address of a domain host device, e.g. 0000:3b:00.0
+func formatPCIAddress(address *api.Address) (string, error) {
+	var fields [4]uint64
+	for i, field := range []string{address.Domain, address.Bus, address.Slot, address.Function} {
+		value, err := strconv.ParseUint(field, 0, 32)
+		if err != nil {
+			return "", fmt.Errorf("invalid PCI address field %q: %v", field, err)
+		}
+		fields[i] = value
+	}
+	return fmt.Sprintf("%04x:%02x:%02x.%x", fields[0], fields[1], fields[2], fields[3]), nil
+}
+
+// mdevSelector normalizes the name of a mediated device type the way the device manager matches
+// it against the mdevNameSelector of the permitted host devices
+func mdevSelector(name string) string {
+	return strings.ReplaceAll(strings.TrimSpace(name), " ", "_")
+}
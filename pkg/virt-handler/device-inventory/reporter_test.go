@@ -0,0 +1,263 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package deviceinventory
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	hostdevicesv1alpha1 "kubevirt.io/api/hostdevices/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Host device inventory reporter", func() {
+	const (
+		host     = "node01"
+		nicPF    = "0000:3b:00.0"
+		nicVF    = "0000:3b:02.0"
+		gpu      = "0000:5e:00.0"
+		mdevUUID = "53764d0e-85a0-42b4-af5c-2046b460b1dc"
+	)
+
+	var (
+		sysRoot     string
+		virtClient  *kubevirtfake.Clientset
+		domainStore cache.Store
+		reporter    *InventoryReporter
+	)
+
+	writeFile := func(path, content string) {
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content+"\n"), 0644)).To(Succeed())
+	}
+
+	symlink := func(target, path string) {
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(os.Symlink(target, path)).To(Succeed())
+	}
+
+	addPCIDevice := func(address, class, pciID string, attributes map[string]string, links map[string]string) {
+		devicePath := filepath.Join(pciBasePath, address)
+		writeFile(filepath.Join(devicePath, "class"), class)
+		writeFile(filepath.Join(devicePath, "uevent"), "DRIVER=test\nPCI_CLASS=0\nPCI_ID="+pciID)
+		for name, value := range attributes {
+			writeFile(filepath.Join(devicePath, name), value)
+		}
+		for name, target := range links {
+			symlink(target, filepath.Join(devicePath, name))
+		}
+	}
+
+	newClusterConfig := func(featureGates ...string) *virtconfig.ClusterConfig {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+			PermittedHostDevices: &v1.PermittedHostDevices{
+				PciHostDevices: []v1.PciHostDevice{
+					{PCIVendorSelector: "10DE:1EB8", ResourceName: "nvidia.com/TU104GL_Tesla_T4"},
+				},
+				MediatedDevices: []v1.MediatedHostDevice{
+					{MDEVNameSelector: "GRID T4-1Q", ResourceName: "nvidia.com/GRID_T4-1Q"},
+				},
+			},
+		})
+		return clusterConfig
+	}
+
+	getInventory := func() *hostdevicesv1alpha1.HostDeviceInventory {
+		inventory, err := virtClient.HostdevicesV1alpha1().HostDeviceInventories().Get(context.Background(), host, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return inventory
+	}
+
+	findPCIDevice := func(inventory *hostdevicesv1alpha1.HostDeviceInventory, address string) *hostdevicesv1alpha1.PCIDevice {
+		for i := range inventory.Status.PCIDevices {
+			if inventory.Status.PCIDevices[i].Address == address {
+				return &inventory.Status.PCIDevices[i]
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		sysRoot = GinkgoT().TempDir()
+		pciBasePath = filepath.Join(sysRoot, "bus", "pci", "devices")
+		mdevBasePath = filepath.Join(sysRoot, "bus", "mdev", "devices")
+		DeferCleanup(func() {
+			pciBasePath = "/sys/bus/pci/devices"
+			mdevBasePath = "/sys/bus/mdev/devices"
+		})
+
+		addPCIDevice("0000:00:01.0", "0x060400", "8086:2030", nil, nil)
+		addPCIDevice(nicPF, "0x020000", "8086:158B",
+			map[string]string{"numa_node": "1", "sriov_totalvfs": "64", "sriov_numvfs": "2"},
+			map[string]string{"driver": "../../../bus/pci/drivers/i40e", "iommu_group": "../../../kernel/iommu_groups/12"})
+		addPCIDevice(nicVF, "0x020000", "8086:154C",
+			map[string]string{"numa_node": "-1"},
+			map[string]string{"driver": "../../../bus/pci/drivers/vfio-pci", "physfn": "../" + nicPF})
+		addPCIDevice(gpu, "0x030200", "10DE:1EB8",
+			map[string]string{
+				"numa_node":                            "0",
+				"mdev_supported_types/nvidia-222/name": "GRID T4-1Q",
+				"mdev_supported_types/nvidia-222/available_instances": "15",
+			},
+			map[string]string{"driver": "../../../bus/pci/drivers/nvidia"})
+
+		mdevPath := filepath.Join(sysRoot, "devices", "pci0000:5d", gpu, mdevUUID)
+		writeFile(filepath.Join(mdevPath, "mdev_type", "name"), "GRID T4-1Q")
+		symlink(mdevPath, filepath.Join(mdevBasePath, mdevUUID))
+
+		virtClient = kubevirtfake.NewSimpleClientset()
+		k8sClient := k8sfake.NewSimpleClientset(&k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: host, UID: types.UID("node-uid")},
+		})
+		domainStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		reporter = NewInventoryReporter(k8sClient.CoreV1(), virtClient.HostdevicesV1alpha1(), newClusterConfig(virtconfig.HostDeviceInventoryGate), host, domainStore)
+	})
+
+	It("should not report the devices when the feature gate is disabled", func() {
+		reporter.clusterConfig = newClusterConfig()
+		reporter.sync()
+
+		_, err := virtClient.HostdevicesV1alpha1().HostDeviceInventories().Get(context.Background(), host, metav1.GetOptions{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should create the inventory of the node, owned by the node", func() {
+		reporter.sync()
+
+		inventory := getInventory()
+		Expect(inventory.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+			APIVersion: "v1",
+			Kind:       "Node",
+			Name:       host,
+			UID:        types.UID("node-uid"),
+		}))
+		Expect(inventory.Status.LastChangeTime).ToNot(BeNil())
+	})
+
+	It("should report the PCI devices except the bridges", func() {
+		reporter.sync()
+
+		inventory := getInventory()
+		Expect(inventory.Status.PCIDevices).To(HaveLen(3))
+		Expect(findPCIDevice(inventory, nicPF)).To(Equal(&hostdevicesv1alpha1.PCIDevice{
+			Address:           nicPF,
+			PCIVendorSelector: "8086:158b",
+			Class:             "0x020000",
+			Driver:            "i40e",
+			IOMMUGroup:        "12",
+			NUMANode:          pointer.Int32(1),
+			SRIOV:             &hostdevicesv1alpha1.SRIOVCapacity{TotalVFs: 64, NumVFs: 2},
+		}))
+
+		vf := findPCIDevice(inventory, nicVF)
+		Expect(vf).ToNot(BeNil())
+		Expect(vf.PhysicalFunction).To(Equal(nicPF))
+		Expect(vf.Driver).To(Equal("vfio-pci"))
+		Expect(vf.NUMANode).To(BeNil())
+		Expect(vf.SRIOV).To(BeNil())
+	})
+
+	It("should report the mediated device types and the resource names permitted", func() {
+		reporter.sync()
+
+		inventory := getInventory()
+		device := findPCIDevice(inventory, gpu)
+		Expect(device).ToNot(BeNil())
+		Expect(device.ResourceName).To(Equal("nvidia.com/TU104GL_Tesla_T4"))
+		Expect(device.MediatedDeviceTypes).To(ConsistOf(hostdevicesv1alpha1.MediatedDeviceType{
+			ID:                 "nvidia-222",
+			Name:               "GRID T4-1Q",
+			AvailableInstances: 15,
+		}))
+		Expect(inventory.Status.MediatedDevices).To(ConsistOf(hostdevicesv1alpha1.MediatedDevice{
+			UUID:          mdevUUID,
+			Type:          "GRID T4-1Q",
+			ParentAddress: gpu,
+			ResourceName:  "nvidia.com/GRID_T4-1Q",
+		}))
+	})
+
+	It("should report the devices assigned to the domains of the node", func() {
+		Expect(domainStore.Add(&api.Domain{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "testvmi"},
+			Spec: api.DomainSpec{
+				Devices: api.Devices{
+					HostDevices: []api.HostDevice{
+						{
+							Type:   api.HostDevicePCI,
+							Source: api.HostDeviceSource{Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x3b", Slot: "0x02", Function: "0x0"}},
+						},
+						{
+							Type:   api.HostDeviceMDev,
+							Source: api.HostDeviceSource{Address: &api.Address{UUID: mdevUUID}},
+						},
+					},
+				},
+			},
+		})).To(Succeed())
+		reporter.sync()
+
+		claim := &hostdevicesv1alpha1.DeviceClaim{Namespace: metav1.NamespaceDefault, Name: "testvmi"}
+		inventory := getInventory()
+		Expect(findPCIDevice(inventory, nicVF).ClaimedBy).To(Equal(claim))
+		Expect(findPCIDevice(inventory, nicPF).ClaimedBy).To(BeNil())
+		Expect(inventory.Status.MediatedDevices).To(HaveLen(1))
+		Expect(inventory.Status.MediatedDevices[0].ClaimedBy).To(Equal(claim))
+	})
+
+	It("should update the inventory only when the devices change", func() {
+		reporter.sync()
+		inventory := getInventory()
+		lastChangeTime := metav1.NewTime(inventory.Status.LastChangeTime.Add(-time.Hour))
+		inventory.Status.LastChangeTime = &lastChangeTime
+		_, err := virtClient.HostdevicesV1alpha1().HostDeviceInventories().UpdateStatus(context.Background(), inventory, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		reporter.sync()
+		Expect(getInventory().Status.LastChangeTime.Equal(&lastChangeTime)).To(BeTrue())
+
+		writeFile(filepath.Join(pciBasePath, nicPF, "sriov_numvfs"), "4")
+		reporter.sync()
+		inventory = getInventory()
+		Expect(inventory.Status.LastChangeTime.After(lastChangeTime.Time)).To(BeTrue())
+		Expect(findPCIDevice(inventory, nicPF).SRIOV.NumVFs).To(BeEquivalentTo(4))
+	})
+})
@@ -0,0 +1,250 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package deviceinventory
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	hostdevicesv1alpha1 "kubevirt.io/api/hostdevices/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+// pciBridgeClassPrefix is the PCI class of the bridges, which can't be assigned to VMIs
+const pciBridgeClassPrefix = "0x06"
+
+var (
+	pciBasePath  = "/sys/bus/pci/devices"
+	mdevBasePath = "/sys/bus/mdev/devices"
+)
+
+// discoverPCIDevices reads the PCI devices of the node from sysfs, sorted by address. A device
+// which can't be read is skipped.
+func discoverPCIDevices() ([]hostdevicesv1alpha1.PCIDevice, error) {
+	entries, err := os.ReadDir(pciBasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []hostdevicesv1alpha1.PCIDevice
+	for _, entry := range entries {
+		device, err := readPCIDevice(entry.Name())
+		if err != nil {
+			log.DefaultLogger().Reason(err).Warningf("Failed to read PCI device %s", entry.Name())
+			continue
+		}
+		if device != nil {
+			devices = append(devices, *device)
+		}
+	}
+	return devices, nil
+}
+
+// readPCIDevice reads a PCI device from sysfs, nil for a PCI bridge
+func readPCIDevice(address string) (*hostdevicesv1alpha1.PCIDevice, error) {
+	devicePath := filepath.Join(pciBasePath, address)
+	class, err := readString(devicePath, "class")
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(class, pciBridgeClassPrefix) {
+		return nil, nil
+	}
+	pciID, err := readPCIID(devicePath)
+	if err != nil {
+		return nil, err
+	}
+
+	device := &hostdevicesv1alpha1.PCIDevice{
+		Address:           address,
+		PCIVendorSelector: pciID,
+		Class:             class,
+	}
+	if device.Driver, err = readLinkBase(devicePath, "driver"); err != nil {
+		return nil, err
+	}
+	if device.IOMMUGroup, err = readLinkBase(devicePath, "iommu_group"); err != nil {
+		return nil, err
+	}
+	if device.PhysicalFunction, err = readLinkBase(devicePath, "physfn"); err != nil {
+		return nil, err
+	}
+	if numaNode, err := readInt(devicePath, "numa_node"); err != nil {
+		return nil, err
+	} else if numaNode != nil && *numaNode >= 0 {
+		device.NUMANode = numaNode
+	}
+
+	totalVFs, err := readInt(devicePath, "sriov_totalvfs")
+	if err != nil {
+		return nil, err
+	}
+	if totalVFs != nil && *totalVFs > 0 {
+		numVFs, err := readInt(devicePath, "sriov_numvfs")
+		if err != nil {
+			return nil, err
+		}
+		device.SRIOV = &hostdevicesv1alpha1.SRIOVCapacity{TotalVFs: *totalVFs}
+		if numVFs != nil {
+			device.SRIOV.NumVFs = *numVFs
+		}
+	}
+
+	if device.MediatedDeviceTypes, err = readMediatedDeviceTypes(devicePath); err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
+// readMediatedDeviceTypes reads the mediated device types supported by a PCI device, sorted by ID
+func readMediatedDeviceTypes(devicePath string) ([]hostdevicesv1alpha1.MediatedDeviceType, error) {
+	typesPath := filepath.Join(devicePath, "mdev_supported_types")
+	entries, err := os.ReadDir(typesPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var types []hostdevicesv1alpha1.MediatedDeviceType
+	for _, entry := range entries {
+		typePath := filepath.Join(typesPath, entry.Name())
+		name, err := readString(typePath, "name")
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		available, err := readInt(typePath, "available_instances")
+		if err != nil {
+			return nil, err
+		}
+		mdevType := hostdevicesv1alpha1.MediatedDeviceType{ID: entry.Name(), Name: name}
+		if available != nil {
+			mdevType.AvailableInstances = *available
+		}
+		types = append(types, mdevType)
+	}
+	return types, nil
+}
+
+// discoverMediatedDevices reads the mediated devices of the node from sysfs, sorted by UUID. A
+// mediated device which can't be read is skipped.
+func discoverMediatedDevices() ([]hostdevicesv1alpha1.MediatedDevice, error) {
+	entries, err := os.ReadDir(mdevBasePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var mdevs []hostdevicesv1alpha1.MediatedDevice
+	for _, entry := range entries {
+		mdev, err := readMediatedDevice(entry.Name())
+		if err != nil {
+			log.DefaultLogger().Reason(err).Warningf("Failed to read mediated device %s", entry.Name())
+			continue
+		}
+		mdevs = append(mdevs, *mdev)
+	}
+	return mdevs, nil
+}
+
+// readMediatedDevice reads a mediated device from sysfs. The device links to its path under its
+// parent, e.g. ../../../devices/pci0000:00/0000:00:03.0/53764d0e-85a0-42b4-af5c-2046b460b1dc
+func readMediatedDevice(uuid string) (*hostdevicesv1alpha1.MediatedDevice, error) {
+	mdevPath := filepath.Join(mdevBasePath, uuid)
+	link, err := os.Readlink(mdevPath)
+	if err != nil {
+		return nil, err
+	}
+	typeName, err := readString(mdevPath, filepath.Join("mdev_type", "name"))
+	if errors.Is(err, os.ErrNotExist) {
+		typeName, err = readLinkBase(mdevPath, "mdev_type")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &hostdevicesv1alpha1.MediatedDevice{
+		UUID:          uuid,
+		Type:          typeName,
+		ParentAddress: filepath.Base(filepath.Dir(link)),
+	}, nil
+}
+
+// readPCIID reads the vendor and device IDs of a PCI device from its uevent, e.g. 10de:1eb8
+func readPCIID(devicePath string) (string, error) {
+	// #nosec No risk for path injection. Reading static path of PCI data
+	file, err := os.Open(filepath.Join(devicePath, "uevent"))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if value, found := strings.CutPrefix(scanner.Text(), "PCI_ID="); found {
+			return strings.ToLower(strings.TrimSpace(value)), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no PCI_ID in the uevent of %s", devicePath)
+}
+
+func readString(dir, name string) (string, error) {
+	// #nosec No risk for path injection. Reading static sysfs paths
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// readInt reads an integer attribute, nil when the attribute doesn't exist
+func readInt(dir, name string) (*int32, error) {
+	value, err := readString(dir, name)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	parsed, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s in %s: %v", name, dir, err)
+	}
+	result := int32(parsed)
+	return &result, nil
+}
+
+// readLinkBase reads the last element of the target of a link, empty when the link doesn't exist
+func readLinkBase(dir, name string) (string, error) {
+	target, err := os.Readlink(filepath.Join(dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return filepath.Base(target), nil
+}
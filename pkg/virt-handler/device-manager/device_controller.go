@@ -23,6 +23,7 @@ import (
 	"context"
 	"math"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -42,6 +43,7 @@ type controlledDevice struct {
 	devicePlugin Device
 	started      bool
 	stopChan     chan struct{}
+	done         chan struct{}
 	backoff      []time.Duration
 }
 
@@ -51,6 +53,7 @@ func (c *controlledDevice) Start() {
 	}
 
 	stop := make(chan struct{})
+	done := make(chan struct{})
 
 	logger := log.DefaultLogger()
 	dev := c.devicePlugin
@@ -64,6 +67,7 @@ func (c *controlledDevice) Start() {
 	}
 
 	go func() {
+		defer close(done)
 		for {
 			err := dev.Start(stop)
 			if err != nil {
@@ -85,6 +89,7 @@ func (c *controlledDevice) Start() {
 	}()
 
 	c.stopChan = stop
+	c.done = done
 	c.started = true
 }
 
@@ -393,17 +398,37 @@ func (c *DeviceController) Run(stop chan struct{}) error {
 	<-stop
 
 	// stop all device plugins
+	var stopped []chan struct{}
 	func() {
 		c.startedPluginsMutex.Lock()
 		defer c.startedPluginsMutex.Unlock()
-		for name := range c.startedPlugins {
+		for name, dev := range c.startedPlugins {
+			stopped = append(stopped, dev.done)
 			c.stopDevice(name)
 		}
 	}()
+	// wait for the device plugins to remove their sockets, an incoming virt-handler reuses them
+	for _, done := range stopped {
+		<-done
+	}
 	logger.Info("Shutting down device plugin controller")
 	return nil
 }
 
+// RegisteredDevicePlugins returns the names of the device plugins registered with the kubelet
+func (c *DeviceController) RegisteredDevicePlugins() []string {
+	c.startedPluginsMutex.Lock()
+	defer c.startedPluginsMutex.Unlock()
+	var names []string
+	for _, dev := range c.startedPlugins {
+		if dev.devicePlugin.GetInitialized() {
+			names = append(names, dev.GetName())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 func (c *DeviceController) Initialized() bool {
 	c.startedPluginsMutex.Lock()
 	defer c.startedPluginsMutex.Unlock()
@@ -231,5 +231,22 @@ var _ = Describe("Device Controller", func() {
 				return exists1 && exists2
 			}, 5*time.Second).Should(BeTrue())
 		})
+
+		It("should report the registered device plugins and wait for them to stop", func() {
+			permanentPlugins := []Device{plugin2, plugin1}
+			deviceController := NewDeviceController(host, maxDevices, permissions, permanentPlugins, fakeConfigMap, clientTest.CoreV1())
+
+			runStop := make(chan struct{})
+			runDone := make(chan struct{})
+			go func() {
+				defer close(runDone)
+				deviceController.Run(runStop)
+			}()
+
+			Eventually(deviceController.RegisteredDevicePlugins, 5*time.Second).Should(Equal([]string{deviceName1, deviceName2}))
+			close(runStop)
+			Eventually(runDone, 5*time.Second).Should(BeClosed())
+			Expect(deviceController.RegisteredDevicePlugins()).To(BeEmpty())
+		})
 	})
 })
@@ -0,0 +1,89 @@
+/*
+Copyright 2024 The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virthandler
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+var guestAgentCommands = []guestagentv1alpha1.GuestAgentCommand{
+	guestagentv1alpha1.GuestAgentCommandExec,
+	guestagentv1alpha1.GuestAgentCommandFileWrite,
+	guestagentv1alpha1.GuestAgentCommandSetUserPassword,
+	guestagentv1alpha1.GuestAgentCommandSSHAuthorizedKeys,
+}
+
+// deniedGuestAgentCommands returns the guest agent commands virt-launcher must not invoke for the VMIs of the namespace.
+// Without GuestAgentPolicy every command is allowed, otherwise a command has to be allowed by every policy of the namespace.
+func deniedGuestAgentCommands(policyStore cache.Store, namespace string) []string {
+	var denied []string
+	for _, command := range guestAgentCommands {
+		for _, obj := range policyStore.List() {
+			policy := obj.(*guestagentv1alpha1.GuestAgentPolicy)
+			if policy.Namespace == namespace && !allowsGuestAgentCommand(policy, command) {
+				denied = append(denied, string(command))
+				break
+			}
+		}
+	}
+	return denied
+}
+
+func allowsGuestAgentCommand(policy *guestagentv1alpha1.GuestAgentPolicy, command guestagentv1alpha1.GuestAgentCommand) bool {
+	for _, allowed := range policy.Spec.AllowedCommands {
+		if allowed == command {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *VirtualMachineController) addGuestAgentPolicyFunc(obj interface{}) {
+	c.enqueueGuestAgentPolicyNamespace(obj)
+}
+
+func (c *VirtualMachineController) updateGuestAgentPolicyFunc(_, obj interface{}) {
+	c.enqueueGuestAgentPolicyNamespace(obj)
+}
+
+func (c *VirtualMachineController) deleteGuestAgentPolicyFunc(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	c.enqueueGuestAgentPolicyNamespace(obj)
+}
+
+// enqueueGuestAgentPolicyNamespace resyncs the VMIs of the namespace of the policy, to send them the new denied commands
+func (c *VirtualMachineController) enqueueGuestAgentPolicyNamespace(obj interface{}) {
+	policy, ok := obj.(*guestagentv1alpha1.GuestAgentPolicy)
+	if !ok {
+		log.Log.Errorf("unexpected object in the GuestAgentPolicy informer: %T", obj)
+		return
+	}
+	for _, vmiObj := range c.vmiSourceStore.List() {
+		vmi := vmiObj.(*v1.VirtualMachineInstance)
+		if vmi.Namespace == policy.Namespace {
+			c.queue.Add(controller.VirtualMachineInstanceKey(vmi))
+		}
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virthandler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
+)
+
+var _ = Describe("Guest agent policy", func() {
+	var store cache.Store
+
+	newPolicy := func(namespace, name string, allowed ...guestagentv1alpha1.GuestAgentCommand) *guestagentv1alpha1.GuestAgentPolicy {
+		return &guestagentv1alpha1.GuestAgentPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       guestagentv1alpha1.GuestAgentPolicySpec{AllowedCommands: allowed},
+		}
+	}
+
+	BeforeEach(func() {
+		store = cache.NewStore(cache.MetaNamespaceKeyFunc)
+	})
+
+	It("should allow every command without policy", func() {
+		Expect(store.Add(newPolicy("other", "deny-all"))).To(Succeed())
+
+		Expect(deniedGuestAgentCommands(store, "default")).To(BeEmpty())
+	})
+
+	It("should deny the commands which are not allowed", func() {
+		Expect(store.Add(newPolicy("default", "exec-only", guestagentv1alpha1.GuestAgentCommandExec))).To(Succeed())
+
+		Expect(deniedGuestAgentCommands(store, "default")).To(ConsistOf(
+			string(guestagentv1alpha1.GuestAgentCommandFileWrite),
+			string(guestagentv1alpha1.GuestAgentCommandSetUserPassword),
+			string(guestagentv1alpha1.GuestAgentCommandSSHAuthorizedKeys),
+		))
+	})
+
+	It("should only allow the commands allowed by every policy", func() {
+		Expect(store.Add(newPolicy("default", "credentials",
+			guestagentv1alpha1.GuestAgentCommandSetUserPassword,
+			guestagentv1alpha1.GuestAgentCommandSSHAuthorizedKeys,
+		))).To(Succeed())
+		Expect(store.Add(newPolicy("default", "password", guestagentv1alpha1.GuestAgentCommandSetUserPassword))).To(Succeed())
+
+		Expect(deniedGuestAgentCommands(store, "default")).To(ConsistOf(
+			string(guestagentv1alpha1.GuestAgentCommandExec),
+			string(guestagentv1alpha1.GuestAgentCommandFileWrite),
+			string(guestagentv1alpha1.GuestAgentCommandSSHAuthorizedKeys),
+		))
+	})
+})
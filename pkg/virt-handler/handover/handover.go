@@ -0,0 +1,272 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+// Package handover hands the state of an outgoing virt-handler over to the incoming one on the
+// same node, so that a virt-handler rollout doesn't disturb the device plugins, the hotplug mounts
+// and the migrations of the node.
+package handover
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"kubevirt.io/client-go/log"
+
+	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
+)
+
+const (
+	protocolVersion = 1
+	socketName      = "handover.sock"
+
+	// maxFiles is the number of file descriptors the kernel lets pass in a single message
+	maxFiles       = 253
+	maxMessageSize = 1 << 20
+)
+
+// State is the state the outgoing virt-handler hands over
+type State struct {
+	// MigrationListeners are the listeners of the migration proxies, with their sockets
+	MigrationListeners []migrationproxy.HandedOverListener `json:"migrationListeners,omitempty"`
+	// DevicePlugins are the resource names of the device plugins which were registered
+	DevicePlugins []string `json:"devicePlugins,omitempty"`
+	// HotplugMountRecords are the UIDs of the VMIs with hotplug volumes mounted
+	HotplugMountRecords []string `json:"hotplugMountRecords,omitempty"`
+}
+
+type request struct {
+	Version int `json:"version"`
+}
+
+type response struct {
+	State *State `json:"state,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// SocketPath returns the path of the socket the outgoing virt-handler serves its state on
+func SocketPath(virtShareDir string) string {
+	return filepath.Join(virtShareDir, socketName)
+}
+
+// Serve serves the state of the outgoing virt-handler on socketPath until a successor asks for it
+// or stop is closed. release stops the components of virt-handler and returns their state, it is
+// called once. The sockets of the state are closed once they were passed.
+func Serve(socketPath string, release func() (*State, error), stop <-chan struct{}) error {
+	if err := os.Remove(socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	listener, err := net.ListenUnix("unixpacket", &net.UnixAddr{Name: socketPath, Net: "unixpacket"})
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %v", socketPath, err)
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-stop:
+		case <-done:
+		}
+		listener.Close()
+	}()
+
+	for {
+		conn, err := listener.AcceptUnix()
+		if err != nil {
+			select {
+			case <-stop:
+				return nil
+			default:
+				return err
+			}
+		}
+		handedOver, err := serveConn(conn, listener, release)
+		conn.Close()
+		if handedOver || err != nil {
+			return err
+		}
+	}
+}
+
+func serveConn(conn *net.UnixConn, listener *net.UnixListener, release func() (*State, error)) (bool, error) {
+	buf := make([]byte, 1024)
+	n, err := conn.Read(buf)
+	if err != nil {
+		log.Log.Reason(err).Warning("failed to read the handover request")
+		return false, nil
+	}
+	req := request{}
+	if err := json.Unmarshal(buf[:n], &req); err != nil {
+		log.Log.Reason(err).Warning("failed to decode the handover request")
+		return false, nil
+	}
+	if req.Version != protocolVersion {
+		log.Log.Warningf("refusing the handover to a virt-handler with protocol version %d", req.Version)
+		writeResponse(conn, &response{Error: fmt.Sprintf("unsupported protocol version %d, expected %d", req.Version, protocolVersion)}, nil)
+		return false, nil
+	}
+
+	// No other successor can ask for the state once it was released
+	listener.Close()
+	state, err := release()
+	if err != nil {
+		writeResponse(conn, &response{Error: err.Error()}, nil)
+		return true, fmt.Errorf("failed to release the state: %v", err)
+	}
+
+	files := collectFiles(state)
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+	if err := writeResponse(conn, &response{State: state}, files); err != nil {
+		return true, fmt.Errorf("failed to hand the state over: %v", err)
+	}
+	log.Log.Infof("handed over %d migration listeners, %d device plugins and %d hotplug mount records",
+		len(state.MigrationListeners), len(state.DevicePlugins), len(state.HotplugMountRecords))
+	return true, nil
+}
+
+// collectFiles returns the files of the migration listeners, and drops the listeners beyond the
+// number of files a message can hold
+func collectFiles(state *State) []*os.File {
+	var files []*os.File
+	var listeners []migrationproxy.HandedOverListener
+	for _, l := range state.MigrationListeners {
+		if l.File == nil {
+			continue
+		}
+		if len(files) == maxFiles {
+			log.Log.Warningf("dropping the migration listener %s, too many listeners to hand over", l.Key)
+			l.File.Close()
+			continue
+		}
+		files = append(files, l.File)
+		listeners = append(listeners, l)
+	}
+	state.MigrationListeners = listeners
+	return files
+}
+
+func writeResponse(conn *net.UnixConn, resp *response, files []*os.File) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	var oob []byte
+	if len(files) > 0 {
+		fds := make([]int, 0, len(files))
+		for _, f := range files {
+			fds = append(fds, int(f.Fd()))
+		}
+		oob = unix.UnixRights(fds...)
+	}
+	_, _, err = conn.WriteMsgUnix(data, oob, nil)
+	return err
+}
+
+// Receive asks the outgoing virt-handler serving on socketPath for its state. It returns nil when
+// there is no outgoing virt-handler.
+func Receive(socketPath string, timeout time.Duration) (*State, error) {
+	conn, err := net.DialUnix("unixpacket", nil, &net.UnixAddr{Name: socketPath, Net: "unixpacket"})
+	if err != nil {
+		if errors.Is(err, syscall.ENOENT) || errors.Is(err, syscall.ECONNREFUSED) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to connect to %s: %v", socketPath, err)
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(&request{Version: protocolVersion})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to request the state: %v", err)
+	}
+
+	buf := make([]byte, maxMessageSize)
+	oob := make([]byte, unix.CmsgSpace(maxFiles*4))
+	n, oobn, _, _, err := conn.ReadMsgUnix(buf, oob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive the state: %v", err)
+	}
+	files, err := parseFiles(oob[:oobn])
+	if err != nil {
+		return nil, err
+	}
+
+	resp := response{}
+	if err := json.Unmarshal(buf[:n], &resp); err != nil {
+		closeFiles(files)
+		return nil, fmt.Errorf("failed to decode the state: %v", err)
+	}
+	if resp.Error != "" {
+		closeFiles(files)
+		return nil, fmt.Errorf("the outgoing virt-handler failed to hand its state over: %s", resp.Error)
+	}
+	if resp.State == nil {
+		closeFiles(files)
+		return nil, fmt.Errorf("the outgoing virt-handler handed no state over")
+	}
+	if len(files) != len(resp.State.MigrationListeners) {
+		closeFiles(files)
+		return nil, fmt.Errorf("received %d sockets for %d migration listeners", len(files), len(resp.State.MigrationListeners))
+	}
+	for i := range resp.State.MigrationListeners {
+		resp.State.MigrationListeners[i].File = files[i]
+	}
+	return resp.State, nil
+}
+
+func parseFiles(oob []byte) ([]*os.File, error) {
+	msgs, err := unix.ParseSocketControlMessage(oob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the control messages: %v", err)
+	}
+	var files []*os.File
+	for i := range msgs {
+		fds, err := unix.ParseUnixRights(&msgs[i])
+		if err != nil {
+			closeFiles(files)
+			return nil, fmt.Errorf("failed to parse the passed sockets: %v", err)
+		}
+		for _, fd := range fds {
+			files = append(files, os.NewFile(uintptr(fd), "handover"))
+		}
+	}
+	return files, nil
+}
+
+func closeFiles(files []*os.File) {
+	for _, f := range files {
+		f.Close()
+	}
+}
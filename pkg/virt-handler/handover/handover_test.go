@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package handover_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-handler/handover"
+	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
+)
+
+var _ = Describe("Handover", func() {
+	var socketPath string
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		socketPath = handover.SocketPath(GinkgoT().TempDir())
+		stop = make(chan struct{})
+	})
+
+	serve := func(release func() (*handover.State, error)) chan error {
+		served := make(chan error, 1)
+		go func() {
+			served <- handover.Serve(socketPath, release, stop)
+		}()
+		Eventually(func() error {
+			_, err := os.Stat(socketPath)
+			return err
+		}).Should(Succeed())
+		return served
+	}
+
+	It("should hand the state and its sockets over", func() {
+		reader, writer, err := os.Pipe()
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+
+		served := serve(func() (*handover.State, error) {
+			return &handover.State{
+				MigrationListeners: []migrationproxy.HandedOverListener{
+					{Key: "vmi-uid", TCPBindPort: 49152, TargetAddress: "/var/run/virtqemud-sock", File: writer},
+				},
+				DevicePlugins:       []string{"devices.kubevirt.io/kvm"},
+				HotplugMountRecords: []string{"vmi-uid"},
+			}, nil
+		})
+
+		state, err := handover.Receive(socketPath, 5*time.Second)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(<-served).To(Succeed())
+		Expect(state.DevicePlugins).To(ConsistOf("devices.kubevirt.io/kvm"))
+		Expect(state.HotplugMountRecords).To(ConsistOf("vmi-uid"))
+		Expect(state.MigrationListeners).To(HaveLen(1))
+		listener := state.MigrationListeners[0]
+		Expect(listener.Key).To(Equal("vmi-uid"))
+		Expect(listener.TCPBindPort).To(Equal(49152))
+		Expect(listener.TargetAddress).To(Equal("/var/run/virtqemud-sock"))
+
+		// The passed descriptor is the last open end of the pipe
+		_, err = fmt.Fprint(listener.File, "handed over")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listener.File.Close()).To(Succeed())
+		data, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("handed over"))
+	})
+
+	It("should receive nothing when no virt-handler serves its state", func() {
+		state, err := handover.Receive(socketPath, time.Second)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(BeNil())
+	})
+
+	It("should fail when the state can't be released", func() {
+		served := serve(func() (*handover.State, error) {
+			return nil, fmt.Errorf("controllers didn't stop")
+		})
+
+		_, err := handover.Receive(socketPath, 5*time.Second)
+		Expect(err).To(MatchError(ContainSubstring("controllers didn't stop")))
+		Expect(<-served).To(MatchError(ContainSubstring("controllers didn't stop")))
+	})
+
+	It("should stop serving without releasing the state when stopped", func() {
+		served := serve(func() (*handover.State, error) {
+			Fail("the state should not be released")
+			return nil, nil
+		})
+
+		close(stop)
+		Expect(<-served).To(Succeed())
+	})
+})
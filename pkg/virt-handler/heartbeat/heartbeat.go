@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -31,6 +34,9 @@ type HeartBeat struct {
 	cpuManagerPaths           []string
 	devicePluginPollIntervall time.Duration
 	devicePluginWaitTimeout   time.Duration
+	housekeepingCPUs          string
+	housekeepingCPUsLock      sync.Mutex
+	keepSchedulable           atomic.Bool
 }
 
 func NewHeartBeat(clientset k8scli.CoreV1Interface, deviceManager device_manager.DeviceControllerInterface, clusterConfig *virtconfig.ClusterConfig, host string) *HeartBeat {
@@ -50,6 +56,11 @@ func (h *HeartBeat) Run(heartBeatInterval time.Duration, stopCh chan struct{}) (
 	done = make(chan struct{})
 	go func() {
 		h.heartBeat(heartBeatInterval, stopCh)
+		if h.keepSchedulable.Load() {
+			log.DefaultLogger().Infof("Leaving node %s schedulable for the incoming virt-handler", h.host)
+			close(done)
+			return
+		}
 		//ensure that the node is getting marked as unschedulable when removed
 		labelNodeDone := h.labelNodeUnschedulable()
 		<-labelNodeDone
@@ -58,6 +69,12 @@ func (h *HeartBeat) Run(heartBeatInterval time.Duration, stopCh chan struct{}) (
 	return done
 }
 
+// KeepSchedulable leaves the node schedulable once the heartbeat stops, when virt-handler hands
+// over to its successor
+func (h *HeartBeat) KeepSchedulable() {
+	h.keepSchedulable.Store(true)
+}
+
 func (h *HeartBeat) heartBeat(heartBeatInterval time.Duration, stopCh chan struct{}) {
 	// ensure that the node is synchronized with the actual state
 	// especially setting the node to unschedulable if device plugins are not yet ready is very important
@@ -124,7 +141,7 @@ func (h *HeartBeat) do() {
 	}
 
 	kubevirtSchedulable := "true"
-	if !h.deviceManagerController.Initialized() {
+	if !h.deviceManagerController.Initialized() || !h.isInfraMemoryAvailable() {
 		kubevirtSchedulable = "false"
 	}
 
@@ -143,18 +160,30 @@ func (h *HeartBeat) do() {
 	}
 	ksmEnabled, ksmEnabledByUs := handleKSM(node, h.clusterConfig)
 
-	data = []byte(fmt.Sprintf(`{"metadata": { "labels": {"%s": "%s", "%s": "%t", "%s": "%t"}, "annotations": {"%s": %s, "%s": "%t"}}}`,
+	// A null value removes the annotation once the conflict is solved
+	housekeepingCPUsConflict := []byte("null")
+	if conflict := h.updateHousekeepingCPUs(node.Labels); conflict != "" {
+		housekeepingCPUsConflict, err = json.Marshal(conflict)
+		if err != nil {
+			log.DefaultLogger().Reason(err).Errorf("Can't marshal the housekeeping CPUs conflict")
+			return
+		}
+	}
+
+	data = []byte(fmt.Sprintf(`{"metadata": { "labels": {"%s": "%s", "%s": "%t", "%s": "%t"}, "annotations": {"%s": %s, "%s": "%t", "%s": %s}}}`,
 		v1.NodeSchedulable, kubevirtSchedulable,
 		v1.CPUManager, cpuManagerEnabled,
 		v1.KSMEnabledLabel, ksmEnabled,
 		v1.VirtHandlerHeartbeat, string(now),
 		v1.KSMHandlerManagedAnnotation, ksmEnabledByUs,
+		v1.HousekeepingCPUsConflictAnnotation, string(housekeepingCPUsConflict),
 	))
 	_, err = h.clientset.Nodes().Patch(context.Background(), h.host, types.StrategicMergePatchType, data, metav1.PatchOptions{})
 	if err != nil {
 		log.DefaultLogger().Reason(err).Errorf("Can't patch node %s", h.host)
 		return
 	}
+	h.updateMemoryPressureCondition(node)
 
 	// A configuration of mediated devices types on this node depends on the existing node labels
 	// and a MediatedDevicesConfiguration in KubeVirt CR.
@@ -167,6 +196,27 @@ func (h *HeartBeat) do() {
 	log.DefaultLogger().V(4).Infof("Heartbeat sent")
 }
 
+// isInfraMemoryAvailable tells whether the memory available on the node is above the memory reserved
+// for the virtualization infrastructure. The node accepts no new VMIs otherwise.
+func (h *HeartBeat) isInfraMemoryAvailable() bool {
+	reservation := h.clusterConfig.GetVirtualizationInfraReservation()
+	if reservation == nil || reservation.Memory == nil {
+		return true
+	}
+	_, availableKiB, err := getTotalAndAvailableMem()
+	if err != nil {
+		log.DefaultLogger().Reason(err).Error("Can't determine the available memory of the node")
+		return true
+	}
+	available := resource.NewQuantity(int64(availableKiB)*1024, resource.BinarySI)
+	if available.Cmp(*reservation.Memory) < 0 {
+		log.DefaultLogger().Warningf("The available memory %s is below the %s reserved for the virtualization infrastructure, marking the node as unschedulable",
+			available.String(), reservation.Memory.String())
+		return false
+	}
+	return true
+}
+
 func (h *HeartBeat) isCPUManagerEnabled(cpuManagerPaths []string) bool {
 	var cpuManagerOptions map[string]interface{}
 	cpuManagerPath, err := detectCPUManagerFile(cpuManagerPaths)
@@ -2,17 +2,21 @@ package heartbeat
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
 	virtv1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
@@ -54,6 +58,23 @@ var _ = Describe("Heartbeat", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(node.Labels).To(HaveKeyWithValue(virtv1.NodeSchedulable, "false"))
 		})
+
+		It("should leave the node schedulable when handing over to the incoming virt-handler", func() {
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), config(), "mynode")
+			stopChan := make(chan struct{})
+			done := heartbeat.Run(30*time.Second, stopChan)
+			Eventually(func() map[string]string {
+				node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				return node.Labels
+			}).Should(HaveKeyWithValue(virtv1.NodeSchedulable, "true"))
+			heartbeat.KeepSchedulable()
+			close(stopChan)
+			<-done
+			node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(node.Labels).To(HaveKeyWithValue(virtv1.NodeSchedulable, "true"))
+		})
 	})
 
 	DescribeTable("with cpumanager featuregate should set the node to", func(deviceController device_manager.DeviceControllerInterface, cpuManagerPaths []string, schedulable string, cpumanager string) {
@@ -109,6 +130,136 @@ var _ = Describe("Heartbeat", func() {
 		),
 	)
 
+	DescribeTable("with a virtualization infrastructure memory reservation should set the node to", func(reserved string, schedulable string) {
+		fakeMemInfo := filepath.Join(GinkgoT().TempDir(), "meminfo")
+		Expect(os.WriteFile(fakeMemInfo, []byte("MemTotal:       8388608 kB\nMemAvailable:   1048576 kB\n"), 0644)).To(Succeed())
+		origMemInfoPath := memInfoPath
+		memInfoPath = fakeMemInfo
+		DeferCleanup(func() { memInfoPath = origMemInfoPath })
+
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			DeveloperConfiguration: &virtv1.DeveloperConfiguration{
+				FeatureGates: []string{virtconfig.VirtualizationInfraReservationGate},
+			},
+			VirtualizationInfraReservation: &virtv1.VirtualizationInfraReservation{
+				Memory: pointer.P(resource.MustParse(reserved)),
+			},
+		})
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), clusterConfig, "mynode")
+		heartbeat.do()
+		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Labels).To(HaveKeyWithValue(virtv1.NodeSchedulable, schedulable))
+	},
+		Entry("schedulable when the available memory is above the reservation", "512Mi", "true"),
+		Entry("not schedulable when the available memory is below the reservation", "2Gi", "false"),
+	)
+
+	Context("with memory overcommit pressure", func() {
+		writeMemInfo := func(availableKiB, swapFreeKiB string) {
+			Expect(os.WriteFile(memInfoPath, []byte("MemTotal:       8388608 kB\nMemAvailable:   "+availableKiB+" kB\n"+
+				"SwapTotal:      4194304 kB\nSwapFree:       "+swapFreeKiB+" kB\n"), 0644)).To(Succeed())
+		}
+
+		getCondition := func() *v1.NodeCondition {
+			node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			for i := range node.Status.Conditions {
+				if node.Status.Conditions[i].Type == virtv1.NodeMemoryOvercommitPressure {
+					return &node.Status.Conditions[i]
+				}
+			}
+			return nil
+		}
+
+		BeforeEach(func() {
+			origMemInfoPath := memInfoPath
+			memInfoPath = filepath.Join(GinkgoT().TempDir(), "meminfo")
+			DeferCleanup(func() { memInfoPath = origMemInfoPath })
+		})
+
+		DescribeTable("should set the condition of the node to", func(availableKiB, swapFreeKiB string, status v1.ConditionStatus, message string) {
+			writeMemInfo(availableKiB, swapFreeKiB)
+			NewHeartBeat(fakeClient.CoreV1(), deviceController(true), config(), "mynode").do()
+
+			condition := getCondition()
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(status))
+			Expect(condition.Message).To(Equal(message))
+		},
+			Entry("false when the memory is available", "4194304", "4194304", v1.ConditionFalse,
+				"50% of the physical memory is in use, including the swap space in use"),
+			Entry("true when the memory in use approaches the physical memory", "524288", "4194304", v1.ConditionTrue,
+				"94% of the physical memory is in use, including the swap space in use"),
+			Entry("true when the swap space in use adds to the memory in use", "2097152", "2097152", v1.ConditionTrue,
+				"100% of the physical memory is in use, including the swap space in use"),
+		)
+
+		It("should keep the transition time while the status doesn't change", func() {
+			transitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+			node.Status.Conditions = []v1.NodeCondition{{
+				Type:               virtv1.NodeMemoryOvercommitPressure,
+				Status:             v1.ConditionFalse,
+				LastTransitionTime: transitionTime,
+			}}
+			_, err := fakeClient.CoreV1().Nodes().UpdateStatus(context.Background(), node, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), config(), "mynode")
+
+			writeMemInfo("4194304", "4194304")
+			heartbeat.do()
+			Expect(getCondition().LastTransitionTime.Equal(&transitionTime)).To(BeTrue())
+
+			writeMemInfo("524288", "4194304")
+			heartbeat.do()
+			Expect(getCondition().Status).To(Equal(v1.ConditionTrue))
+			Expect(getCondition().LastTransitionTime.After(transitionTime.Time)).To(BeTrue())
+		})
+	})
+
+	DescribeTable("with housekeeping CPUs", func(cpus string, reservedSystemCPUs string, cpuManagerPaths []string, expectedCPUs string, expectedConflict string) {
+		fakeKubeletConfig := filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		Expect(os.WriteFile(fakeKubeletConfig, []byte("kind: KubeletConfiguration\nreservedSystemCPUs: "+reservedSystemCPUs+"\n"), 0644)).To(Succeed())
+		origKubeletConfigPath := kubeletConfigPath
+		kubeletConfigPath = fakeKubeletConfig
+		DeferCleanup(func() { kubeletConfigPath = origKubeletConfigPath })
+
+		node.Labels = map[string]string{"pool": "infra"}
+		node.Annotations = map[string]string{virtv1.HousekeepingCPUsConflictAnnotation: "stale conflict"}
+		_, err := fakeClient.CoreV1().Nodes().Update(context.Background(), node, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			DeveloperConfiguration: &virtv1.DeveloperConfiguration{
+				FeatureGates: []string{virtconfig.InfraThreadsPinningGate},
+			},
+			InfraThreadsPinning: &virtv1.InfraThreadsPinning{
+				HousekeepingCPUs: []virtv1.HousekeepingCPUPool{
+					{Name: "other", NodeSelector: map[string]string{"pool": "other"}, CPUs: "8"},
+					{Name: "infra", NodeSelector: map[string]string{"pool": "infra"}, CPUs: cpus},
+				},
+			},
+		})
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), clusterConfig, "mynode")
+		heartbeat.cpuManagerPaths = cpuManagerPaths
+		heartbeat.do()
+		Expect(heartbeat.HousekeepingCPUs()).To(Equal(expectedCPUs))
+		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		if expectedConflict == "" {
+			Expect(node.Annotations).ToNot(HaveKey(virtv1.HousekeepingCPUsConflictAnnotation))
+		} else {
+			Expect(node.Annotations).To(HaveKeyWithValue(virtv1.HousekeepingCPUsConflictAnnotation, expectedConflict))
+		}
+	},
+		Entry("should enforce the CPUs of the matching pool", "2-3", "0-1", []string{"non/existent/cpumanager/statefile"}, "2-3", ""),
+		Entry("should not enforce CPUs reserved for the system by the kubelet", "1-2", "0-1", []string{"non/existent/cpumanager/statefile"},
+			"", "CPUs 1 are reserved for the system by the kubelet"),
+		Entry("should enforce CPUs of the shared pool of the CPU manager", "4-5", "", []string{cpu_manager_static_path}, "4-5", ""),
+		Entry("should not enforce CPUs allocated exclusively by the CPU manager", "4-7", "", []string{cpu_manager_static_path},
+			"", "CPUs 6,7 are allocated exclusively to containers by the kubelet CPU manager"),
+	)
+
 	DescribeTable("without deviceplugin and", func(deviceController device_manager.DeviceControllerInterface, initiallySchedulable string, finallySchedulable string) {
 		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController, config(), "mynode")
 		heartbeat.devicePluginWaitTimeout = 2 * time.Second
@@ -0,0 +1,168 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package heartbeat
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"kubevirt.io/client-go/log"
+
+	virtutil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// This is a var so it can be changed by the unit tests
+var kubeletConfigPath = virtutil.KubeletRoot + "/config.yaml"
+
+type kubeletConfig struct {
+	ReservedSystemCPUs string `json:"reservedSystemCPUs,omitempty"`
+}
+
+type cpuManagerState struct {
+	PolicyName    string `json:"policyName"`
+	DefaultCPUSet string `json:"defaultCpuSet"`
+}
+
+// HousekeepingCPUs returns the CPUs the infrastructure threads of the VMIs without dedicated CPUs are
+// confined to on the node, or an empty string if none apply or they conflict with the CPUs of the kubelet.
+func (h *HeartBeat) HousekeepingCPUs() string {
+	h.housekeepingCPUsLock.Lock()
+	defer h.housekeepingCPUsLock.Unlock()
+	return h.housekeepingCPUs
+}
+
+// updateHousekeepingCPUs resolves the housekeeping CPUs of the node from its labels. It returns why they
+// are not enforced when they conflict with the CPUs of the kubelet.
+func (h *HeartBeat) updateHousekeepingCPUs(nodeLabels map[string]string) (conflict string) {
+	cpus := h.clusterConfig.GetHousekeepingCPUs(nodeLabels)
+	if cpus != "" {
+		conflict = h.housekeepingCPUsConflict(cpus)
+		if conflict != "" {
+			log.DefaultLogger().Warningf("Not confining the infrastructure threads to the housekeeping CPUs %s: %s", cpus, conflict)
+			cpus = ""
+		}
+	}
+
+	h.housekeepingCPUsLock.Lock()
+	defer h.housekeepingCPUsLock.Unlock()
+	h.housekeepingCPUs = cpus
+	return conflict
+}
+
+// housekeepingCPUsConflict checks the housekeeping CPUs against the CPUs the kubelet reserves for the system
+// and the ones its static CPU manager allocates exclusively to containers.
+func (h *HeartBeat) housekeepingCPUsConflict(cpus string) string {
+	housekeeping, err := hardware.ParseCPUSetLine(cpus, virtconfig.MaxVirtualizationInfraReservedCPUs)
+	if err != nil {
+		return fmt.Sprintf("invalid CPUs %s: %v", cpus, err)
+	}
+
+	if reserved := kubeletReservedSystemCPUs(); len(reserved) > 0 {
+		if overlap := cpusIn(housekeeping, reserved, true); len(overlap) > 0 {
+			return fmt.Sprintf("CPUs %s are reserved for the system by the kubelet", formatCPUs(overlap))
+		}
+	}
+
+	if shared := h.cpuManagerSharedCPUs(); len(shared) > 0 {
+		if exclusive := cpusIn(housekeeping, shared, false); len(exclusive) > 0 {
+			return fmt.Sprintf("CPUs %s are allocated exclusively to containers by the kubelet CPU manager", formatCPUs(exclusive))
+		}
+	}
+	return ""
+}
+
+// kubeletReservedSystemCPUs returns the reservedSystemCPUs of the kubelet configuration, if it can be read
+func kubeletReservedSystemCPUs() []int {
+	// #nosec No risk for path injection. kubeletConfigPath is composed of static values from pkg/util
+	content, err := os.ReadFile(kubeletConfigPath)
+	if err != nil {
+		log.DefaultLogger().Reason(err).V(4).Info("Can't read the kubelet configuration")
+		return nil
+	}
+	config := kubeletConfig{}
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		log.DefaultLogger().Reason(err).Warning("Can't parse the kubelet configuration")
+		return nil
+	}
+	if config.ReservedSystemCPUs == "" {
+		return nil
+	}
+	reserved, err := hardware.ParseCPUSetLine(config.ReservedSystemCPUs, virtconfig.MaxVirtualizationInfraReservedCPUs)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Warning("Can't parse the reserved system CPUs of the kubelet")
+		return nil
+	}
+	return reserved
+}
+
+// cpuManagerSharedCPUs returns the shared pool of the static CPU manager, if it runs on the node
+func (h *HeartBeat) cpuManagerSharedCPUs() []int {
+	cpuManagerPath, err := detectCPUManagerFile(h.cpuManagerPaths)
+	if err != nil {
+		return nil
+	}
+	// #nosec No risk for path injection. cpuManagerPath is composed of static values from pkg/util
+	content, err := os.ReadFile(cpuManagerPath)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Warning("Can't read the CPU manager state")
+		return nil
+	}
+	state := cpuManagerState{}
+	if err := json.Unmarshal(content, &state); err != nil {
+		log.DefaultLogger().Reason(err).Warning("Can't parse the CPU manager state")
+		return nil
+	}
+	if state.PolicyName != "static" || state.DefaultCPUSet == "" {
+		return nil
+	}
+	shared, err := hardware.ParseCPUSetLine(state.DefaultCPUSet, virtconfig.MaxVirtualizationInfraReservedCPUs)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Warning("Can't parse the shared CPUs of the CPU manager")
+		return nil
+	}
+	return shared
+}
+
+// cpusIn returns the CPUs which are in the set, or not in it
+func cpusIn(cpus, set []int, in bool) []int {
+	var filtered []int
+	for _, cpu := range cpus {
+		if slices.Contains(set, cpu) == in {
+			filtered = append(filtered, cpu)
+		}
+	}
+	return filtered
+}
+
+func formatCPUs(cpus []int) string {
+	formatted := make([]string, 0, len(cpus))
+	for _, cpu := range cpus {
+		formatted = append(formatted, strconv.Itoa(cpu))
+	}
+	return strings.Join(formatted, ",")
+}
@@ -0,0 +1,140 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package heartbeat
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// memoryPressureThreshold is the share of the physical memory in use, including the swap space in use,
+	// from which the node is under memory overcommit pressure
+	memoryPressureThreshold = 0.9
+
+	memoryPressureReason  = "MemoryOvercommitPressure"
+	memoryAvailableReason = "MemoryAvailable"
+	memoryPressureMessage = "%.0f%% of the physical memory is in use, including the swap space in use"
+	memInfoTotalField     = "MemTotal"
+	memInfoAvailableField = "MemAvailable"
+	memInfoSwapTotalField = "SwapTotal"
+	memInfoSwapFreeField  = "SwapFree"
+)
+
+// getMemoryPressure returns the memory in use on the node, including the swap space in use, over its
+// physical memory. It matches the kubevirt_node_memory_pressure_ratio metric.
+func getMemoryPressure() (float64, error) {
+	memInfo, err := readMemInfo()
+	if err != nil {
+		return 0, err
+	}
+	total, available := memInfo[memInfoTotalField], memInfo[memInfoAvailableField]
+	if total == 0 {
+		return 0, fmt.Errorf("failed to find the total memory")
+	}
+
+	var used uint64
+	if total > available {
+		used = total - available
+	}
+	if swapTotal, swapFree := memInfo[memInfoSwapTotalField], memInfo[memInfoSwapFreeField]; swapTotal > swapFree {
+		used += swapTotal - swapFree
+	}
+	return float64(used) / float64(total), nil
+}
+
+// readMemInfo reads the fields of /proc/meminfo used for the memory pressure, in KiB
+func readMemInfo() (map[string]uint64, error) {
+	f, err := os.Open(memInfoPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	memInfo := map[string]uint64{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		field, value, found := strings.Cut(s.Text(), ":")
+		if !found {
+			continue
+		}
+		switch field {
+		case memInfoTotalField, memInfoAvailableField, memInfoSwapTotalField, memInfoSwapFreeField:
+			var kib uint64
+			if _, err := fmt.Sscanf(strings.TrimSpace(value), "%d", &kib); err != nil {
+				return nil, fmt.Errorf("invalid %s in %s: %v", field, memInfoPath, err)
+			}
+			memInfo[field] = kib
+		}
+	}
+	return memInfo, s.Err()
+}
+
+// updateMemoryPressureCondition sets the memory overcommit pressure condition of the node, keeping its
+// transition time while its status doesn't change
+func (h *HeartBeat) updateMemoryPressureCondition(node *k8sv1.Node) {
+	pressure, err := getMemoryPressure()
+	if err != nil {
+		log.DefaultLogger().Reason(err).Error("Can't determine the memory pressure of the node")
+		return
+	}
+
+	now := metav1.Now()
+	condition := k8sv1.NodeCondition{
+		Type:               v1.NodeMemoryOvercommitPressure,
+		Status:             k8sv1.ConditionFalse,
+		LastHeartbeatTime:  now,
+		LastTransitionTime: now,
+		Reason:             memoryAvailableReason,
+		Message:            fmt.Sprintf(memoryPressureMessage, pressure*100),
+	}
+	if pressure >= memoryPressureThreshold {
+		condition.Status = k8sv1.ConditionTrue
+		condition.Reason = memoryPressureReason
+	}
+	for _, existing := range node.Status.Conditions {
+		if existing.Type == condition.Type && existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": []k8sv1.NodeCondition{condition},
+		},
+	})
+	if err != nil {
+		log.DefaultLogger().Reason(err).Errorf("Can't marshal the memory pressure condition")
+		return
+	}
+	if _, err = h.clientset.Nodes().PatchStatus(context.Background(), h.host, data); err != nil {
+		log.DefaultLogger().Reason(err).Errorf("Can't patch the status of node %s", h.host)
+	}
+}
@@ -0,0 +1,280 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package hugepages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// ConditionTypePrefix prefixes the node conditions reporting the hugepage pools, e.g. HugepagesPool1Gi
+	ConditionTypePrefix = "HugepagesPool"
+
+	ReasonPoolResized    = "PoolResized"
+	ReasonPoolFragmented = "MemoryFragmented"
+	ReasonPoolFailed     = "ResizeFailed"
+)
+
+var sysHugepagesPath = "/sys/kernel/mm/hugepages"
+
+// ConditionType returns the type of the node condition reporting the hugepage pool of a page size
+func ConditionType(pageSize string) k8sv1.NodeConditionType {
+	return k8sv1.NodeConditionType(ConditionTypePrefix + pageSize)
+}
+
+// PoolManager grows and shrinks the hugepage pools of the node, within the bounds set in the
+// KubeVirt CR, to fit the hugepages of the VMIs of the node and of the pending VMIs it could host.
+// The pools are allocated at runtime, which succeeds only as long as the memory of the node is not
+// too fragmented to find contiguous free pages. The outcome is reported in a node condition per pool.
+type PoolManager struct {
+	clientset       k8scli.CoreV1Interface
+	clusterConfig   *virtconfig.ClusterConfig
+	host            string
+	vmiStore        cache.Store
+	pendingVMIStore cache.Store
+}
+
+func NewPoolManager(clientset k8scli.CoreV1Interface, clusterConfig *virtconfig.ClusterConfig, host string, vmiStore, pendingVMIStore cache.Store) *PoolManager {
+	return &PoolManager{
+		clientset:       clientset,
+		clusterConfig:   clusterConfig,
+		host:            host,
+		vmiStore:        vmiStore,
+		pendingVMIStore: pendingVMIStore,
+	}
+}
+
+func (m *PoolManager) Run(interval time.Duration, stopCh chan struct{}) {
+	wait.JitterUntil(m.sync, interval, 1.2, true, stopCh)
+}
+
+func (m *PoolManager) sync() {
+	if !m.clusterConfig.HugepagesPoolManagementEnabled() {
+		return
+	}
+
+	node, err := m.clientset.Nodes().Get(context.Background(), m.host, metav1.GetOptions{})
+	if err != nil {
+		log.DefaultLogger().Reason(err).Errorf("Can't get node %s", m.host)
+		return
+	}
+
+	var conditions []k8sv1.NodeCondition
+	for _, pool := range m.clusterConfig.GetHugepagesPools(node.Labels) {
+		conditions = append(conditions, m.syncPool(node, pool))
+	}
+
+	if err := m.patchConditions(node, conditions); err != nil {
+		log.DefaultLogger().Reason(err).Errorf("Can't patch the hugepages pool conditions of node %s", m.host)
+	}
+}
+
+// syncPool resizes the pool of a page size to the hugepages in use or requested by the VMIs of the
+// node, plus the ones requested by the pending VMIs, within the bounds of the pool. It never shrinks
+// the pool below the hugepages in use.
+func (m *PoolManager) syncPool(node *k8sv1.Node, pool v1.HugepagesPool) k8sv1.NodeCondition {
+	condition := k8sv1.NodeCondition{
+		Type:   ConditionType(pool.PageSize),
+		Status: k8sv1.ConditionFalse,
+		Reason: ReasonPoolFailed,
+	}
+
+	pageSize, err := resource.ParseQuantity(pool.PageSize)
+	if err != nil {
+		condition.Message = fmt.Sprintf("invalid page size %s: %v", pool.PageSize, err)
+		return condition
+	}
+	poolPath := filepath.Join(sysHugepagesPath, fmt.Sprintf("hugepages-%dkB", pageSize.Value()/1024))
+
+	total, err := readPages(poolPath, "nr_hugepages")
+	if err != nil {
+		condition.Message = err.Error()
+		return condition
+	}
+	free, err := readPages(poolPath, "free_hugepages")
+	if err != nil {
+		condition.Message = err.Error()
+		return condition
+	}
+	reserved, err := readPages(poolPath, "resv_hugepages")
+	if err != nil {
+		condition.Message = err.Error()
+		return condition
+	}
+	inUse := total - free + reserved
+
+	var assigned, pending uint64
+	for _, obj := range m.vmiStore.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if !vmi.IsFinal() {
+			assigned += requestedPages(vmi, pool.PageSize, pageSize)
+		}
+	}
+	if !node.Spec.Unschedulable {
+		for _, obj := range m.pendingVMIStore.List() {
+			vmi := obj.(*v1.VirtualMachineInstance)
+			if isPendingOn(vmi, node) {
+				pending += requestedPages(vmi, pool.PageSize, pageSize)
+			}
+		}
+	}
+
+	target := max(inUse, assigned) + pending
+	target = min(max(target, uint64(pool.Min)), uint64(pool.Max))
+	target = max(target, inUse)
+
+	if target != total {
+		log.DefaultLogger().Infof("Resizing the %s hugepages pool from %d to %d pages", pool.PageSize, total, target)
+		if err := writePages(poolPath, "nr_hugepages", target); err != nil {
+			condition.Message = err.Error()
+			return condition
+		}
+		if total, err = readPages(poolPath, "nr_hugepages"); err != nil {
+			condition.Message = err.Error()
+			return condition
+		}
+	}
+
+	if total < target {
+		condition.Reason = ReasonPoolFragmented
+		condition.Message = fmt.Sprintf("%d of the %d %s hugepages needed could be allocated, the memory of the node is too fragmented",
+			total, target, pool.PageSize)
+		return condition
+	}
+	condition.Status = k8sv1.ConditionTrue
+	condition.Reason = ReasonPoolResized
+	condition.Message = fmt.Sprintf("%d %s hugepages allocated", total, pool.PageSize)
+	return condition
+}
+
+// isPendingOn tells whether the VMI waits to be scheduled and could be scheduled on the node.
+// Only its node selector is considered.
+func isPendingOn(vmi *v1.VirtualMachineInstance, node *k8sv1.Node) bool {
+	if vmi.IsMarkedForDeletion() || (!vmi.IsUnprocessed() && vmi.Status.Phase != v1.Scheduling) {
+		return false
+	}
+	return labels.SelectorFromSet(vmi.Spec.NodeSelector).Matches(labels.Set(node.Labels))
+}
+
+// requestedPages returns the hugepages of a size the launcher pod of the VMI requests
+func requestedPages(vmi *v1.VirtualMachineInstance, pageSizeName string, pageSize resource.Quantity) uint64 {
+	memory := vmi.Spec.Domain.Memory
+	if memory == nil || memory.Hugepages == nil || memory.Hugepages.PageSize != pageSizeName {
+		return 0
+	}
+	request := vmi.Spec.Domain.Resources.Requests.Memory().Value()
+	if memory.Guest != nil && (request == 0 || request > memory.Guest.Value()) {
+		request = memory.Guest.Value()
+	}
+	if request <= 0 {
+		return 0
+	}
+	return uint64((request + pageSize.Value() - 1) / pageSize.Value())
+}
+
+// patchConditions sets the conditions of the managed pools on the node and removes the ones of the
+// pools which are no longer managed. The node is patched only when a condition changes.
+func (m *PoolManager) patchConditions(node *k8sv1.Node, conditions []k8sv1.NodeCondition) error {
+	now := metav1.Now()
+	managed := map[k8sv1.NodeConditionType]bool{}
+	var patch []interface{}
+	for _, condition := range conditions {
+		managed[condition.Type] = true
+		existing := findCondition(node, condition.Type)
+		if existing != nil && existing.Status == condition.Status &&
+			existing.Reason == condition.Reason && existing.Message == condition.Message {
+			continue
+		}
+		condition.LastHeartbeatTime = now
+		condition.LastTransitionTime = now
+		if existing != nil && existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		patch = append(patch, condition)
+	}
+	for _, condition := range node.Status.Conditions {
+		if strings.HasPrefix(string(condition.Type), ConditionTypePrefix) && !managed[condition.Type] {
+			patch = append(patch, map[string]string{"type": string(condition.Type), "$patch": "delete"})
+		}
+	}
+	if len(patch) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{"conditions": patch},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = m.clientset.Nodes().PatchStatus(context.Background(), m.host, data)
+	return err
+}
+
+func findCondition(node *k8sv1.Node, conditionType k8sv1.NodeConditionType) *k8sv1.NodeCondition {
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == conditionType {
+			return &node.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func readPages(poolPath, name string) (uint64, error) {
+	// #nosec No risk for path injection. poolPath is composed of the sysfs path and a parsed page size
+	content, err := os.ReadFile(filepath.Join(poolPath, name))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the hugepages pool: %v", err)
+	}
+	pages, err := strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s of the hugepages pool: %v", name, err)
+	}
+	return pages, nil
+}
+
+// writePages is a variable so that tests can simulate a fragmented memory
+var writePages = func(poolPath, name string, pages uint64) error {
+	// #nosec No risk for path injection. poolPath is composed of the sysfs path and a parsed page size
+	if err := os.WriteFile(filepath.Join(poolPath, name), []byte(strconv.FormatUint(pages, 10)), 0644); err != nil {
+		return fmt.Errorf("failed to resize the hugepages pool: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package hugepages
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Hugepages pool manager", func() {
+	const host = "node01"
+
+	var (
+		fakeClient      *fake.Clientset
+		vmiStore        cache.Store
+		pendingVMIStore cache.Store
+		poolPath        string
+		manager         *PoolManager
+	)
+
+	writePool := func(total, free, reserved uint64) {
+		for name, pages := range map[string]uint64{"nr_hugepages": total, "free_hugepages": free, "resv_hugepages": reserved} {
+			Expect(os.WriteFile(filepath.Join(poolPath, name), []byte(strconv.FormatUint(pages, 10)+"\n"), 0644)).To(Succeed())
+		}
+	}
+
+	poolSize := func() uint64 {
+		pages, err := readPages(poolPath, "nr_hugepages")
+		Expect(err).ToNot(HaveOccurred())
+		return pages
+	}
+
+	nodeCondition := func() *k8sv1.NodeCondition {
+		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), host, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return findCondition(node, ConditionType("1Gi"))
+	}
+
+	newHugepagesVMI := func(name, memory string, phase v1.VirtualMachineInstancePhase, opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		opts = append(opts, libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithResourceMemory(memory), libvmi.WithHugepages("1Gi"))
+		vmi := libvmi.New(append(opts, libvmi.WithName(name))...)
+		vmi.Status.Phase = phase
+		return vmi
+	}
+
+	BeforeEach(func() {
+		sysHugepagesPath = GinkgoT().TempDir()
+		poolPath = filepath.Join(sysHugepagesPath, "hugepages-1048576kB")
+		Expect(os.Mkdir(poolPath, 0755)).To(Succeed())
+		DeferCleanup(func() { sysHugepagesPath = "/sys/kernel/mm/hugepages" })
+
+		fakeClient = fake.NewSimpleClientset(&k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: host, Labels: map[string]string{"pool": "large"}},
+		})
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		pendingVMIStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{virtconfig.HugepagesPoolManagementGate},
+			},
+			HugepagesPools: []v1.HugepagesPool{{Name: "large", PageSize: "1Gi", Min: 2, Max: 16}},
+		})
+		manager = NewPoolManager(fakeClient.CoreV1(), clusterConfig, host, vmiStore, pendingVMIStore)
+	})
+
+	It("should keep the minimum of pages allocated", func() {
+		writePool(0, 0, 0)
+		manager.sync()
+		Expect(poolSize()).To(BeEquivalentTo(2))
+
+		condition := nodeCondition()
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(k8sv1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(ReasonPoolResized))
+	})
+
+	It("should grow the pool for the VMIs of the node and the pending VMIs it can host", func() {
+		writePool(2, 2, 0)
+		Expect(vmiStore.Add(newHugepagesVMI("scheduled", "4Gi", v1.Scheduled))).To(Succeed())
+		Expect(pendingVMIStore.Add(newHugepagesVMI("pending", "3Gi", v1.Pending))).To(Succeed())
+		Expect(pendingVMIStore.Add(newHugepagesVMI("scheduling", "1536Mi", v1.Scheduling))).To(Succeed())
+		Expect(pendingVMIStore.Add(newHugepagesVMI("elsewhere", "4Gi", v1.Pending,
+			libvmi.WithNodeSelectorFor("node02")))).To(Succeed())
+		Expect(pendingVMIStore.Add(newHugepagesVMI("failed", "4Gi", v1.Failed))).To(Succeed())
+
+		manager.sync()
+		Expect(poolSize()).To(BeEquivalentTo(9))
+	})
+
+	It("should not grow the pool beyond its maximum", func() {
+		writePool(2, 2, 0)
+		Expect(pendingVMIStore.Add(newHugepagesVMI("pending", "32Gi", v1.Pending))).To(Succeed())
+
+		manager.sync()
+		Expect(poolSize()).To(BeEquivalentTo(16))
+	})
+
+	It("should shrink the pool without freeing the pages in use", func() {
+		writePool(12, 6, 1)
+		manager.sync()
+		Expect(poolSize()).To(BeEquivalentTo(7))
+	})
+
+	It("should report a fragmented memory", func() {
+		writePool(2, 2, 0)
+		Expect(pendingVMIStore.Add(newHugepagesVMI("pending", "8Gi", v1.Pending))).To(Succeed())
+		write := writePages
+		writePages = func(poolPath, name string, pages uint64) error {
+			return write(poolPath, name, pages-3)
+		}
+		DeferCleanup(func() { writePages = write })
+
+		manager.sync()
+		Expect(poolSize()).To(BeEquivalentTo(5))
+
+		condition := nodeCondition()
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(ReasonPoolFragmented))
+		Expect(condition.Message).To(ContainSubstring("5 of the 8 1Gi hugepages"))
+	})
+
+	It("should remove the condition of a pool which is no longer managed", func() {
+		writePool(0, 0, 0)
+		manager.sync()
+		Expect(nodeCondition()).ToNot(BeNil())
+
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{virtconfig.HugepagesPoolManagementGate},
+			},
+			HugepagesPools: []v1.HugepagesPool{{Name: "small", NodeSelector: map[string]string{"pool": "small"}, PageSize: "1Gi", Max: 4}},
+		})
+		manager.clusterConfig = clusterConfig
+		manager.sync()
+		Expect(nodeCondition()).To(BeNil())
+	})
+
+	It("should count the guest memory when it is below the requested memory", func() {
+		vmi := newHugepagesVMI("guest", "4Gi", v1.Pending)
+		guest := resource.MustParse("2Gi")
+		vmi.Spec.Domain.Memory.Guest = &guest
+		Expect(requestedPages(vmi, "1Gi", resource.MustParse("1Gi"))).To(BeEquivalentTo(2))
+		Expect(requestedPages(vmi, "2Mi", resource.MustParse("2Mi"))).To(BeZero())
+	})
+})
@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"strings"
+
+	ps "github.com/mitchellh/go-ps"
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+// confineInfraThreads confines the emulator threads, iothreads and vhost kernel threads of a VMI
+// without dedicated CPUs to the host CPUs reserved for the virtualization infrastructure, in the
+// housekeeping cgroup of the VMI, and keeps its vCPU threads off these CPUs.
+func confineInfraThreads(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager, reservedCPUs string) error {
+	cpus, err := hardware.ParseCPUSetLine(reservedCPUs, virtconfig.MaxVirtualizationInfraReservedCPUs)
+	if err != nil {
+		return fmt.Errorf("invalid reserved CPUs %q: %w", reservedCPUs, err)
+	}
+
+	if err := cgroupManager.CreateChildCgroup("housekeeping", "cpuset"); err != nil {
+		return err
+	}
+	if err := cgroupManager.SetCpuSet("housekeeping", cpus); err != nil {
+		return err
+	}
+
+	// The threads already confined are in the housekeeping cgroup, only the new
+	// threads and the vCPUs are left in the cgroup of the VMI.
+	tids, err := cgroupManager.GetCgroupThreads()
+	if err != nil {
+		return err
+	}
+	infraTIDs := 0
+	for _, tid := range tids {
+		proc, err := ps.FindProcess(tid)
+		if err != nil {
+			return err
+		}
+		if proc == nil {
+			// The thread exited since the cgroup was read
+			continue
+		}
+		if isVCPUThread(proc.Executable()) {
+			if err := excludeCPUs(tid, cpus); err != nil {
+				return fmt.Errorf("failed to keep vCPU thread %d off the reserved CPUs: %w", tid, err)
+			}
+			continue
+		}
+		if err := cgroupManager.AttachTID("cpuset", "housekeeping", tid); err != nil {
+			return fmt.Errorf("failed to confine thread %d to the reserved CPUs: %w", tid, err)
+		}
+		infraTIDs++
+	}
+
+	if infraTIDs > 0 {
+		log.Log.V(3).Object(vmi).Infof("confined %d threads to the reserved CPUs %s", infraTIDs, reservedCPUs)
+	}
+	return nil
+}
+
+// isVCPUThread tells from its command name whether a QEMU thread runs a vCPU
+func isVCPUThread(comm string) bool {
+	return strings.Contains(comm, "CPU ") && strings.Contains(comm, "KVM")
+}
+
+// excludeCPUs removes cpus from the affinity of a thread, unless no CPU would be left to run it
+func excludeCPUs(tid int, cpus []int) error {
+	var mask unix.CPUSet
+	if err := unix.SchedGetaffinity(tid, &mask); err != nil {
+		return err
+	}
+	for _, cpu := range cpus {
+		mask.Clear(cpu)
+	}
+	if mask.Count() == 0 {
+		return nil
+	}
+	return unix.SchedSetaffinity(tid, &mask)
+}
@@ -0,0 +1,73 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virthandler
+
+import (
+	"errors"
+	"os"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+var _ = Describe("Virtualization infrastructure reservation", func() {
+	var cgroupManager *cgroup.MockManager
+
+	BeforeEach(func() {
+		cgroupManager = cgroup.NewMockManager(gomock.NewController(GinkgoT()))
+	})
+
+	It("should confine the threads which are not vCPUs to the reserved CPUs", func() {
+		tid := os.Getpid()
+		cgroupManager.EXPECT().CreateChildCgroup("housekeeping", "cpuset").Return(nil)
+		cgroupManager.EXPECT().SetCpuSet("housekeeping", []int{0, 1, 8}).Return(nil)
+		cgroupManager.EXPECT().GetCgroupThreads().Return([]int{tid}, nil)
+		cgroupManager.EXPECT().AttachTID("cpuset", "housekeeping", tid).Return(nil)
+
+		Expect(confineInfraThreads(libvmi.New(), cgroupManager, "0-1,8")).To(Succeed())
+	})
+
+	It("should fail when a thread can't be confined", func() {
+		tid := os.Getpid()
+		cgroupManager.EXPECT().CreateChildCgroup("housekeeping", "cpuset").Return(nil)
+		cgroupManager.EXPECT().SetCpuSet("housekeeping", []int{2}).Return(nil)
+		cgroupManager.EXPECT().GetCgroupThreads().Return([]int{tid}, nil)
+		cgroupManager.EXPECT().AttachTID("cpuset", "housekeeping", tid).Return(errors.New("permission denied"))
+
+		Expect(confineInfraThreads(libvmi.New(), cgroupManager, "2")).To(MatchError(ContainSubstring("permission denied")))
+	})
+
+	It("should reject a malformed CPU list", func() {
+		Expect(confineInfraThreads(libvmi.New(), cgroupManager, "0-a")).To(MatchError(ContainSubstring("invalid reserved CPUs")))
+	})
+
+	DescribeTable("should recognize the vCPU threads", func(comm string, expected bool) {
+		Expect(isVCPUThread(comm)).To(Equal(expected))
+	},
+		Entry("vCPU", "CPU 0/KVM", true),
+		Entry("emulator", "qemu-kvm", false),
+		Entry("iothread", "IO iothread1", false),
+		Entry("vhost worker", "vhost-1234", false),
+	)
+})
@@ -0,0 +1,155 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	janitorInterval = 10 * time.Minute
+	// the mount records younger than the grace period are left alone, their VMI may still be starting
+	orphanGracePeriod = 10 * time.Minute
+
+	OrphanReclaimedReason      = "OrphanReclaimed"
+	OrphanReclaimFailureReason = "OrphanReclaimFailed"
+)
+
+// runJanitor periodically reclaims the node state left behind for the VMIs which are gone from the node,
+// e.g. when virt-handler crashed while cleaning them up
+func (c *VirtualMachineController) runJanitor(stopCh <-chan struct{}) {
+	wait.Until(func() { c.reclaimOrphans(time.Now()) }, janitorInterval, stopCh)
+}
+
+func (c *VirtualMachineController) reclaimOrphans(now time.Time) {
+	known := c.knownVMIUIDs()
+
+	ghostRecords := virtcache.ListGhostRecordUIDs()
+	for uid, key := range ghostRecords {
+		if known[uid] {
+			continue
+		}
+		if _, queued := c.orphanedGhostRecords.LoadOrStore(key, uid); queued {
+			continue
+		}
+		// the cleanup of the VMI reclaims its ghost record along with everything else it left behind, its
+		// outcome is recorded once the VMI is synced
+		log.Log.Infof("Found the orphaned ghost record of VMI %s (%s), cleaning it up", key, uid)
+		c.queue.Add(key)
+	}
+
+	for uid := range ghostRecords {
+		known[uid] = true
+	}
+
+	c.reclaimOrphanedMountRecords(known, filepath.Join(c.virtPrivateDir, containerDiskMountStateDir), now, metrics.OrphanContainerDiskMount, func(vmi *v1.VirtualMachineInstance) error {
+		return c.containerDiskMounter.Unmount(vmi)
+	})
+	c.reclaimOrphanedMountRecords(known, filepath.Join(c.virtPrivateDir, hotplugVolumeMountStateDir), now, metrics.OrphanHotplugVolumeMount, func(vmi *v1.VirtualMachineInstance) error {
+		// the cgroup of the VMI is gone along with it, there are no device rules to remove
+		return c.hotplugVolumeMounter.UnmountAll(vmi, nil)
+	})
+}
+
+// knownVMIUIDs returns the UIDs of the VMIs running on or migrating to the node, and of the domains on the node
+func (c *VirtualMachineController) knownVMIUIDs() map[types.UID]bool {
+	known := map[types.UID]bool{}
+	for _, store := range []interface{ List() []interface{} }{c.vmiSourceStore, c.vmiTargetStore} {
+		for _, obj := range store.List() {
+			known[obj.(*v1.VirtualMachineInstance).UID] = true
+		}
+	}
+	for _, obj := range c.domainStore.List() {
+		known[obj.(*api.Domain).Spec.Metadata.KubeVirt.UID] = true
+	}
+	return known
+}
+
+// reclaimOrphanedMountRecords unmounts what the mount records of the VMIs which are gone still reference, the
+// mount records are named after the UID of their VMI
+func (c *VirtualMachineController) reclaimOrphanedMountRecords(known map[types.UID]bool, dir string, now time.Time, resource string, unmount func(vmi *v1.VirtualMachineInstance) error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		log.Log.Reason(err).Warningf("Failed to list the mount records in %s", dir)
+		return
+	}
+
+	for _, entry := range entries {
+		uid := types.UID(entry.Name())
+		if entry.IsDir() || known[uid] {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || now.Sub(info.ModTime()) < orphanGracePeriod {
+			continue
+		}
+
+		vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{UID: uid}}
+		err = unmount(vmi)
+		if err != nil {
+			log.Log.Reason(err).Warningf("Failed to reclaim the orphaned %s of VMI %s", resource, uid)
+		}
+		c.recordOrphan(resource, uid, err)
+	}
+}
+
+// recordGhostRecordReclaim records the outcome of the sync of a VMI whose orphaned ghost record was queued by
+// the janitor
+func (c *VirtualMachineController) recordGhostRecordReclaim(key string, syncErr error) {
+	value, orphaned := c.orphanedGhostRecords.LoadAndDelete(key)
+	if !orphaned {
+		return
+	}
+	uid := value.(types.UID)
+
+	if _, exists := virtcache.ListGhostRecordUIDs()[uid]; syncErr == nil && exists {
+		syncErr = fmt.Errorf("the ghost record was not removed")
+	}
+	if syncErr != nil {
+		log.Log.Reason(syncErr).Warningf("Failed to reclaim the orphaned %s of VMI %s", metrics.OrphanGhostRecord, uid)
+	}
+	c.recordOrphan(metrics.OrphanGhostRecord, uid, syncErr)
+}
+
+func (c *VirtualMachineController) recordOrphan(resource string, uid types.UID, err error) {
+	metrics.RecordOrphan(resource, err == nil)
+
+	node := &k8sv1.ObjectReference{Kind: "Node", Name: c.host, UID: types.UID(c.host)}
+	if err != nil {
+		c.recorder.Event(node, k8sv1.EventTypeWarning, OrphanReclaimFailureReason, fmt.Sprintf("Failed to reclaim the orphaned %s of VMI %s: %v", resource, uid, err))
+		return
+	}
+	c.recorder.Event(node, k8sv1.EventTypeNormal, OrphanReclaimedReason, fmt.Sprintf("Reclaimed the orphaned %s of VMI %s", resource, uid))
+}
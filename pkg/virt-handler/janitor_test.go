@@ -0,0 +1,187 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
+	containerdisk "kubevirt.io/kubevirt/pkg/virt-handler/container-disk"
+	hotplugvolume "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-disk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Orphan janitor", func() {
+	var (
+		controller               *VirtualMachineController
+		recorder                 *record.FakeRecorder
+		mockContainerDiskMounter *containerdisk.MockMounter
+		mockHotplugVolumeMounter *hotplugvolume.MockVolumeMounter
+		now                      time.Time
+	)
+
+	addMountRecord := func(dir string, uid types.UID, age time.Duration) {
+		path := filepath.Join(controller.virtPrivateDir, dir, string(uid))
+		Expect(os.WriteFile(path, []byte("{}"), 0600)).To(Succeed())
+		Expect(os.Chtimes(path, now.Add(-age), now.Add(-age))).To(Succeed())
+	}
+
+	vmiWithUID := func(uid types.UID) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{UID: uid}}
+	}
+
+	BeforeEach(func() {
+		virtPrivateDir := GinkgoT().TempDir()
+		Expect(virtcache.InitializeGhostRecordCache(GinkgoT().TempDir())).To(Succeed())
+		Expect(os.Mkdir(filepath.Join(virtPrivateDir, containerDiskMountStateDir), 0700)).To(Succeed())
+		Expect(os.Mkdir(filepath.Join(virtPrivateDir, hotplugVolumeMountStateDir), 0700)).To(Succeed())
+
+		ctrl := gomock.NewController(GinkgoT())
+		mockContainerDiskMounter = containerdisk.NewMockMounter(ctrl)
+		mockHotplugVolumeMounter = hotplugvolume.NewMockVolumeMounter(ctrl)
+		recorder = record.NewFakeRecorder(10)
+		now = time.Now()
+
+		controller = &VirtualMachineController{
+			recorder:             recorder,
+			host:                 "node01",
+			virtPrivateDir:       virtPrivateDir,
+			queue:                workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]()),
+			vmiSourceStore:       cache.NewStore(cache.MetaNamespaceKeyFunc),
+			vmiTargetStore:       cache.NewStore(cache.MetaNamespaceKeyFunc),
+			domainStore:          cache.NewStore(cache.MetaNamespaceKeyFunc),
+			containerDiskMounter: mockContainerDiskMounter,
+			hotplugVolumeMounter: mockHotplugVolumeMounter,
+		}
+	})
+
+	AfterEach(func() {
+		controller.queue.ShutDown()
+	})
+
+	It("should enqueue the orphaned ghost records for cleanup", func() {
+		Expect(virtcache.AddGhostRecord("default", "orphan", "/tmp/orphan.sock", "orphan-uid")).To(Succeed())
+		Expect(virtcache.AddGhostRecord("default", "running", "/tmp/running.sock", "running-uid")).To(Succeed())
+		vmi := vmiWithUID("running-uid")
+		vmi.Namespace, vmi.Name = "default", "running"
+		Expect(controller.vmiSourceStore.Add(vmi)).To(Succeed())
+
+		controller.reclaimOrphans(now)
+
+		Expect(controller.queue.Len()).To(Equal(1))
+		key, _ := controller.queue.Get()
+		Expect(key).To(Equal("default/orphan"))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should not enqueue an orphaned ghost record again before its cleanup", func() {
+		Expect(virtcache.AddGhostRecord("default", "orphan", "/tmp/orphan.sock", "orphan-uid")).To(Succeed())
+
+		controller.reclaimOrphans(now)
+		key, _ := controller.queue.Get()
+		controller.queue.Done(key)
+		controller.reclaimOrphans(now)
+
+		Expect(controller.queue.Len()).To(BeZero())
+	})
+
+	It("should record the orphaned ghost records once their cleanup succeeded", func() {
+		Expect(virtcache.AddGhostRecord("default", "orphan", "/tmp/orphan.sock", "orphan-uid")).To(Succeed())
+		controller.reclaimOrphans(now)
+
+		Expect(virtcache.DeleteGhostRecord("default", "orphan")).To(Succeed())
+		controller.recordGhostRecordReclaim("default/orphan", nil)
+		controller.recordGhostRecordReclaim("default/orphan", nil)
+
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(recorder.Events).To(Receive(ContainSubstring(OrphanReclaimedReason)))
+	})
+
+	DescribeTable("should record the orphaned ghost records whose cleanup failed", func(syncErr error, reason string) {
+		Expect(virtcache.AddGhostRecord("default", "orphan", "/tmp/orphan.sock", "orphan-uid")).To(Succeed())
+		controller.reclaimOrphans(now)
+
+		controller.recordGhostRecordReclaim("default/orphan", syncErr)
+
+		Expect(recorder.Events).To(Receive(And(
+			ContainSubstring(OrphanReclaimFailureReason),
+			ContainSubstring(reason),
+		)))
+	},
+		Entry("when the sync failed", fmt.Errorf("launcher unresponsive"), "launcher unresponsive"),
+		Entry("when the sync left the ghost record", nil, "the ghost record was not removed"),
+	)
+
+	It("should not record the syncs of VMIs without orphaned ghost record", func() {
+		controller.recordGhostRecordReclaim("default/vmi", nil)
+
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should unmount the orphaned mount records past the grace period", func() {
+		addMountRecord(containerDiskMountStateDir, "orphan-uid", orphanGracePeriod+time.Minute)
+		addMountRecord(hotplugVolumeMountStateDir, "orphan-uid", orphanGracePeriod+time.Minute)
+		addMountRecord(containerDiskMountStateDir, "recent-uid", time.Minute)
+		addMountRecord(containerDiskMountStateDir, "domain-uid", orphanGracePeriod+time.Minute)
+		addMountRecord(hotplugVolumeMountStateDir, "target-uid", orphanGracePeriod+time.Minute)
+
+		domain := api.NewMinimalDomain("domain")
+		domain.Spec.Metadata.KubeVirt.UID = "domain-uid"
+		Expect(controller.domainStore.Add(domain)).To(Succeed())
+		target := vmiWithUID("target-uid")
+		target.Namespace, target.Name = "default", "target"
+		Expect(controller.vmiTargetStore.Add(target)).To(Succeed())
+
+		mockContainerDiskMounter.EXPECT().Unmount(vmiWithUID("orphan-uid")).Return(nil)
+		mockHotplugVolumeMounter.EXPECT().UnmountAll(vmiWithUID("orphan-uid"), nil).Return(nil)
+
+		controller.reclaimOrphans(now)
+
+		Expect(recorder.Events).To(HaveLen(2))
+		Expect(controller.queue.Len()).To(BeZero())
+	})
+
+	It("should report the orphans it failed to reclaim", func() {
+		addMountRecord(containerDiskMountStateDir, "orphan-uid", orphanGracePeriod+time.Minute)
+		mockContainerDiskMounter.EXPECT().Unmount(vmiWithUID("orphan-uid")).Return(fmt.Errorf("device or resource busy"))
+
+		controller.reclaimOrphans(now)
+
+		Expect(recorder.Events).To(Receive(And(
+			ContainSubstring(OrphanReclaimFailureReason),
+			ContainSubstring("device or resource busy"),
+		)))
+	})
+})
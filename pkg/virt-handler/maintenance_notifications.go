@@ -0,0 +1,109 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/maintenancenotifications"
+)
+
+// a VMI is evacuated from a node once, the notification is sent once per channel
+const hostMaintenanceNotificationID = "host-maintenance"
+
+type maintenanceNotifier interface {
+	Notify(vmi *v1.VirtualMachineInstance, socketPath string, message maintenancenotifications.Message) (time.Time, bool, error)
+	Forget(vmi *v1.VirtualMachineInstance)
+}
+
+type notifyGuestFunc func(message maintenancenotifications.Message) (time.Time, bool, error)
+
+// delayMigrationForGuest notifies the guest of the migration about to start from this node, and
+// returns how long the migration still has to wait for the guest to acknowledge it.
+func (d *VirtualMachineController) delayMigrationForGuest(vmi *v1.VirtualMachineInstance) time.Duration {
+	if !maintenancenotifications.HasDevice(&vmi.Spec) {
+		return 0
+	}
+
+	delay, err := migrationNotificationDelay(vmi, func(message maintenancenotifications.Message) (time.Time, bool, error) {
+		isolationRes, err := d.podIsolationDetector.Detect(vmi)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf(failedDetectIsolationFmt, err)
+		}
+		return d.maintenanceNotifier.Notify(vmi, maintenancenotifications.ChannelSocketPathOnHost(isolationRes.Pid()), message)
+	})
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("Failed to notify the guest of the migration, starting it right away")
+		return 0
+	}
+	return delay
+}
+
+func migrationNotificationDelay(vmi *v1.VirtualMachineInstance, notify notifyGuestFunc) (time.Duration, error) {
+	leadTime := maintenancenotifications.LeadTime(&vmi.Spec)
+	deadline := time.Now().Add(leadTime).UTC()
+	sentAt, acknowledged, err := notify(maintenancenotifications.Message{
+		Event:           maintenancenotifications.EventMigration,
+		ID:              string(vmi.Status.MigrationState.MigrationUID),
+		LeadTimeSeconds: int32(leadTime / time.Second),
+		Deadline:        &deadline,
+	})
+	if err != nil || acknowledged {
+		return 0, err
+	}
+	return max(time.Until(sentAt.Add(leadTime)), 0), nil
+}
+
+// notifyGuestOfMaintenance notifies the guest of a VMI running on this node once it has to be
+// evacuated from it, and once it was migrated to it.
+func (d *VirtualMachineController) notifyGuestOfMaintenance(vmi *v1.VirtualMachineInstance, pid int) {
+	if !maintenancenotifications.HasDevice(&vmi.Spec) {
+		return
+	}
+
+	message, needed := maintenanceNotification(vmi, d.host)
+	if !needed {
+		return
+	}
+	if _, _, err := d.maintenanceNotifier.Notify(vmi, maintenancenotifications.ChannelSocketPathOnHost(pid), message); err != nil {
+		log.Log.Object(vmi).Reason(err).V(3).Infof("Failed to send the %s notification to the guest", message.Event)
+	}
+}
+
+func maintenanceNotification(vmi *v1.VirtualMachineInstance, host string) (maintenancenotifications.Message, bool) {
+	migrationState := vmi.Status.MigrationState
+	switch {
+	case vmi.Status.EvacuationNodeName == host:
+		return maintenancenotifications.Message{
+			Event: maintenancenotifications.EventHostMaintenance,
+			ID:    hostMaintenanceNotificationID,
+		}, true
+	case migrationState != nil && migrationState.Completed && !migrationState.Failed && migrationState.TargetNode == host:
+		return maintenancenotifications.Message{
+			Event: maintenancenotifications.EventMigrationCompleted,
+			ID:    string(migrationState.MigrationUID),
+		}, true
+	}
+	return maintenancenotifications.Message{}, false
+}
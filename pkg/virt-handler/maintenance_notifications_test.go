@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virthandler
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/maintenancenotifications"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Maintenance notifications", func() {
+	const host = "node01"
+
+	newMigratingVMI := func() *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Domain.Devices.MaintenanceNotifications = &v1.MaintenanceNotifications{LeadTimeSeconds: pointer.P(int32(60))}
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{MigrationUID: "migration-1"}
+		return vmi
+	}
+
+	Context("before a migration", func() {
+		It("should notify the guest of the migration and its lead time", func() {
+			var sent maintenancenotifications.Message
+			delay, err := migrationNotificationDelay(newMigratingVMI(), func(message maintenancenotifications.Message) (time.Time, bool, error) {
+				sent = message
+				return time.Now(), false, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delay).To(BeNumerically("~", time.Minute, time.Second))
+			Expect(sent.Event).To(Equal(maintenancenotifications.EventMigration))
+			Expect(sent.ID).To(Equal("migration-1"))
+			Expect(sent.LeadTimeSeconds).To(BeEquivalentTo(60))
+			Expect(*sent.Deadline).To(BeTemporally("~", time.Now().Add(time.Minute), time.Second))
+		})
+
+		DescribeTable("should not delay the migration", func(sentAt time.Time, acknowledged bool, notifyErr error) {
+			delay, err := migrationNotificationDelay(newMigratingVMI(), func(maintenancenotifications.Message) (time.Time, bool, error) {
+				return sentAt, acknowledged, notifyErr
+			})
+			if notifyErr != nil {
+				Expect(err).To(MatchError(notifyErr))
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(delay).To(BeZero())
+		},
+			Entry("once the guest acknowledged it", time.Now(), true, nil),
+			Entry("once the lead time elapsed", time.Now().Add(-2*time.Minute), false, nil),
+			Entry("when the guest can't be notified", time.Time{}, false, errors.New("failed to connect to the maintenance channel")),
+		)
+	})
+
+	DescribeTable("while the VMI runs", func(status v1.VirtualMachineInstanceStatus, expectedEvent maintenancenotifications.Event, expectedID string) {
+		message, needed := maintenanceNotification(&v1.VirtualMachineInstance{Status: status}, host)
+		if expectedEvent == "" {
+			Expect(needed).To(BeFalse())
+			return
+		}
+		Expect(needed).To(BeTrue())
+		Expect(message.Event).To(Equal(expectedEvent))
+		Expect(message.ID).To(Equal(expectedID))
+	},
+		Entry("should notify the guest of the evacuation of its node",
+			v1.VirtualMachineInstanceStatus{EvacuationNodeName: host},
+			maintenancenotifications.EventHostMaintenance, hostMaintenanceNotificationID),
+		Entry("should notify the guest once it was migrated to the node",
+			v1.VirtualMachineInstanceStatus{MigrationState: &v1.VirtualMachineInstanceMigrationState{MigrationUID: "migration-1", Completed: true, TargetNode: host}},
+			maintenancenotifications.EventMigrationCompleted, "migration-1"),
+		Entry("should not notify the guest of a failed migration",
+			v1.VirtualMachineInstanceStatus{MigrationState: &v1.VirtualMachineInstanceMigrationState{MigrationUID: "migration-1", Completed: true, Failed: true, TargetNode: host}},
+			maintenancenotifications.Event(""), ""),
+		Entry("should not notify the guest otherwise",
+			v1.VirtualMachineInstanceStatus{},
+			maintenancenotifications.Event(""), ""),
+	)
+})
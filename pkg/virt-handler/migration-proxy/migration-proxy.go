@@ -29,6 +29,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"kubevirt.io/client-go/log"
 
@@ -57,6 +58,22 @@ type ProxyManager interface {
 	OpenListenerCount() int
 
 	InitiateGracefulShutdown()
+
+	HandOverListeners() ([]HandedOverListener, error)
+	AdoptListeners(listeners []HandedOverListener) int
+}
+
+// HandedOverListener is the listener of a migration proxy handed over to another virt-handler. The
+// listening socket is passed along, the connections it already accepted stay with the outgoing proxy.
+type HandedOverListener struct {
+	Key            string `json:"key"`
+	Source         bool   `json:"source,omitempty"`
+	UnixSocketPath string `json:"unixSocketPath,omitempty"`
+	TCPBindPort    int    `json:"tcpBindPort,omitempty"`
+	TargetAddress  string `json:"targetAddress"`
+
+	// File is the listening socket
+	File *os.File `json:"-"`
 }
 
 type migrationProxyManager struct {
@@ -68,6 +85,9 @@ type migrationProxyManager struct {
 
 	isShuttingDown bool
 	config         *virtconfig.ClusterConfig
+
+	// releasedProxies were handed over, they still proxy the connections they accepted before
+	releasedProxies []*migrationProxy
 }
 
 type MigrationProxyListener interface {
@@ -85,7 +105,10 @@ type migrationProxy struct {
 	listenErrChan  chan error
 	fdChan         chan net.Conn
 
+	// socket is the listening socket, listener wraps it with TLS when enabled
+	socket          net.Listener
 	listener        net.Listener
+	connections     atomic.Int32
 	serverTLSConfig *tls.Config
 	clientTLSConfig *tls.Config
 
@@ -103,7 +126,96 @@ func (m *migrationProxyManager) OpenListenerCount() int {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
-	return len(m.sourceProxies) + len(m.targetProxies)
+	count := len(m.sourceProxies) + len(m.targetProxies)
+	for _, proxy := range m.releasedProxies {
+		if proxy.connections.Load() > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// HandOverListeners releases the listeners of all the migration proxies, for another virt-handler to
+// adopt them. The proxies stop accepting connections, without removing their sockets, and keep
+// proxying the connections they accepted.
+func (m *migrationProxyManager) HandOverListeners() ([]HandedOverListener, error) {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	var listeners []HandedOverListener
+	release := func(key string, proxies []*migrationProxy, source bool) error {
+		for _, proxy := range proxies {
+			file, err := proxy.release()
+			if err != nil {
+				return err
+			}
+			listeners = append(listeners, HandedOverListener{
+				Key:            key,
+				Source:         source,
+				UnixSocketPath: proxy.unixSocketPath,
+				TCPBindPort:    proxy.tcpBindPort,
+				TargetAddress:  proxy.targetAddress,
+				File:           file,
+			})
+			m.releasedProxies = append(m.releasedProxies, proxy)
+		}
+		return nil
+	}
+
+	for key, proxies := range m.sourceProxies {
+		if err := release(key, proxies, true); err != nil {
+			return nil, err
+		}
+		delete(m.sourceProxies, key)
+	}
+	for key, proxies := range m.targetProxies {
+		if err := release(key, proxies, false); err != nil {
+			return nil, err
+		}
+		delete(m.targetProxies, key)
+	}
+	return listeners, nil
+}
+
+// AdoptListeners starts migration proxies on the listeners handed over by another virt-handler, and
+// returns how many were adopted. The files of the listeners are closed.
+func (m *migrationProxyManager) AdoptListeners(listeners []HandedOverListener) int {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	serverTLSConfig, clientTLSConfig := m.tlsConfigs()
+	adopted := 0
+	for _, listener := range listeners {
+		var proxy *migrationProxy
+		if listener.Source {
+			proxy = NewSourceProxy(listener.UnixSocketPath, listener.TargetAddress, serverTLSConfig, clientTLSConfig, listener.Key)
+		} else {
+			proxy = NewTargetProxy(ip.GetIPZeroAddress(), listener.TCPBindPort, serverTLSConfig, clientTLSConfig, listener.TargetAddress, listener.Key)
+		}
+
+		err := proxy.adopt(listener.File)
+		listener.File.Close()
+		if err != nil {
+			proxy.logger.Reason(err).Error("Manager failed to adopt the proxy handed over")
+			continue
+		}
+
+		if listener.Source {
+			m.sourceProxies[listener.Key] = append(m.sourceProxies[listener.Key], proxy)
+		} else {
+			m.targetProxies[listener.Key] = append(m.targetProxies[listener.Key], proxy)
+		}
+		proxy.logger.Infof("Manager adopted the proxy handed over")
+		adopted++
+	}
+	return adopted
+}
+
+func (m *migrationProxyManager) tlsConfigs() (serverTLSConfig *tls.Config, clientTLSConfig *tls.Config) {
+	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
+		return nil, nil
+	}
+	return m.serverTLSConfig, m.clientTLSConfig
 }
 
 func GetMigrationPortsList(isBlockMigration bool) (ports []int) {
@@ -169,12 +281,7 @@ func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles
 
 	zeroAddress := ip.GetIPZeroAddress()
 	proxiesList := []*migrationProxy{}
-	serverTLSConfig := m.serverTLSConfig
-	clientTLSConfig := m.clientTLSConfig
-	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
-		serverTLSConfig = nil
-		clientTLSConfig = nil
-	}
+	serverTLSConfig, clientTLSConfig := m.tlsConfigs()
 	for _, targetUnixFile := range targetUnixFiles {
 		// 0 means random port is used
 		proxy := NewTargetProxy(zeroAddress, 0, serverTLSConfig, clientTLSConfig, targetUnixFile, key)
@@ -296,12 +403,7 @@ func (m *migrationProxyManager) StartSourceListener(key string, targetAddress st
 			}
 		}
 	}
-	serverTLSConfig := m.serverTLSConfig
-	clientTLSConfig := m.clientTLSConfig
-	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
-		serverTLSConfig = nil
-		clientTLSConfig = nil
-	}
+	serverTLSConfig, clientTLSConfig := m.tlsConfigs()
 	proxiesList := []*migrationProxy{}
 	for destPort, srcPort := range destSrcPortMap {
 		proxyKey := ConstructProxyKey(key, srcPort)
@@ -382,21 +484,21 @@ func (m *migrationProxy) createTcpListener() error {
 	var err error
 
 	laddr := net.JoinHostPort(m.tcpBindAddress, strconv.Itoa(m.tcpBindPort))
-	if m.serverTLSConfig != nil {
-		listener, err = tls.Listen("tcp", laddr, m.serverTLSConfig)
-	} else {
-		listener, err = net.Listen("tcp", laddr)
-	}
+	listener, err = net.Listen("tcp", laddr)
 	if err != nil {
 		m.logger.Reason(err).Error("failed to create unix socket for proxy service")
 		return err
 	}
+	m.socket = listener
+	if m.serverTLSConfig != nil {
+		listener = tls.NewListener(listener, m.serverTLSConfig)
+	}
 
 	if m.tcpBindPort == 0 {
 		// update the random port that was selected
 		m.tcpBindPort = listener.Addr().(*net.TCPAddr).Port
 		// Add the listener to the log output once we know the port
-		m.logger = m.logger.With("listening", fmt.Sprintf("%s:%d", m.tcpBindAddress, m.tcpBindPort))
+		m.logger = m.logger.With("listening", laddr)
 	}
 
 	m.listener = listener
@@ -422,6 +524,7 @@ func (m *migrationProxy) createUnixListener() error {
 		return err
 	}
 
+	m.socket = listener
 	m.listener = listener
 	return nil
 
@@ -436,8 +539,46 @@ func (m *migrationProxy) Stop() {
 	}
 }
 
+// release stops accepting connections and returns the listening socket, which is left open for the
+// proxy adopting it. The connections already accepted are still proxied.
+func (m *migrationProxy) release() (*os.File, error) {
+	socket, ok := m.socket.(interface{ File() (*os.File, error) })
+	if !ok {
+		return nil, fmt.Errorf("the listener of the proxy can't be handed over")
+	}
+	file, err := socket.File()
+	if err != nil {
+		return nil, err
+	}
+
+	if unixListener, ok := m.socket.(*net.UnixListener); ok {
+		unixListener.SetUnlinkOnClose(false)
+	}
+	m.listener.Close()
+	m.logger.Infof("proxy handed over its listener")
+	return file, nil
+}
+
+// adopt starts the proxy on a listening socket handed over by another proxy
+func (m *migrationProxy) adopt(file *os.File) error {
+	listener, err := net.FileListener(file)
+	if err != nil {
+		return err
+	}
+
+	m.socket = listener
+	if m.unixSocketPath == "" && m.serverTLSConfig != nil {
+		listener = tls.NewListener(listener, m.serverTLSConfig)
+	}
+	m.listener = listener
+	m.serve()
+	return nil
+}
+
 func (m *migrationProxy) handleConnection(fd net.Conn) {
 	defer fd.Close()
+	m.connections.Add(1)
+	defer m.connections.Add(-1)
 
 	outBoundErr := make(chan error, 1)
 	inBoundErr := make(chan error, 1)
@@ -495,6 +636,11 @@ func (m *migrationProxy) Start() error {
 		}
 	}
 
+	m.serve()
+	return nil
+}
+
+func (m *migrationProxy) serve() {
 	go func(ln net.Listener, fdChan chan net.Conn, listenErr chan error, stopChan chan struct{}) {
 		for {
 			fd, err := ln.Accept()
@@ -530,5 +676,4 @@ func (m *migrationProxy) Start() error {
 	}(m)
 
 	m.logger.Infof("proxy started listening")
-	return nil
 }
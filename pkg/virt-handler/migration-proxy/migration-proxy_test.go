@@ -212,6 +212,65 @@ var _ = Describe("MigrationProxy", func() {
 				Entry("with TLS disabled", &v1.MigrationConfiguration{DisableTLS: pointer.P(true)}),
 			)
 
+			DescribeTable("by handing the listeners over to another manager", func(migrationConfig *v1.MigrationConfiguration) {
+				directMigrationPort := "49152"
+				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
+				virtqemudListener, err := net.Listen("unix", virtqemudSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer virtqemudListener.Close()
+				directSock := filepath.Join(tmpDir, "mykey-"+directMigrationPort)
+				directListener, err := net.Listen("unix", directSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer directListener.Close()
+
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+					MigrationConfiguration: migrationConfig,
+				})
+				outgoing := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+				Expect(outgoing.StartTargetListener("mykey", []string{virtqemudSock, directSock})).To(Succeed())
+				destSrcPortMap := outgoing.GetTargetListenerPorts("mykey")
+				Expect(outgoing.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir)).To(Succeed())
+				sourceFiles := outgoing.GetSourceListenerFiles("mykey")
+
+				listeners, err := outgoing.HandOverListeners()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(listeners).To(HaveLen(4))
+				Expect(outgoing.OpenListenerCount()).To(BeZero())
+
+				incoming := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+				Expect(incoming.AdoptListeners(listeners)).To(Equal(4))
+				defer incoming.StopTargetListener("mykey")
+				defer incoming.StopSourceListener("mykey")
+				Expect(incoming.GetTargetListenerPorts("mykey")).To(Equal(destSrcPortMap))
+				Expect(incoming.GetSourceListenerFiles("mykey")).To(ConsistOf(sourceFiles))
+
+				received := make(chan int)
+				go func() {
+					fd, err := directListener.Accept()
+					Expect(err).ShouldNot(HaveOccurred())
+					var bytes [1024]byte
+					n, err := fd.Read(bytes[0:])
+					Expect(err).ShouldNot(HaveOccurred())
+					received <- n
+				}()
+
+				for _, sockFile := range sourceFiles {
+					if !strings.Contains(sockFile, directMigrationPort) {
+						continue
+					}
+					conn, err := net.Dial("unix", sockFile)
+					Expect(err).ShouldNot(HaveOccurred())
+					defer conn.Close()
+					message := []byte("some direct message")
+					_, err = conn.Write(message)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(<-received).To(Equal(len(message)))
+				}
+			},
+				Entry("with TLS enabled", &v1.MigrationConfiguration{DisableTLS: pointer.P(false)}),
+				Entry("with TLS disabled", &v1.MigrationConfiguration{DisableTLS: pointer.P(true)}),
+			)
+
 			DescribeTable("by ensuring no new listeners can be created after shutdown", func(migrationConfig *v1.MigrationConfiguration) {
 
 				key1 := "key1"
@@ -26,16 +26,17 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 )
 
-// FindMigrationIP looks for dedicated migration network migration0. If found, sets migration IP to it
-func FindMigrationIP(migrationIp string) (string, error) {
+// FindMigrationIPs looks for dedicated migration network migration0. If found, returns its IPs instead of the pod IPs
+func FindMigrationIPs(podIPs []string) ([]string, error) {
 	ief, err := net.InterfaceByName(v1.MigrationInterfaceName)
 	if err != nil {
-		return migrationIp, nil
+		return podIPs, nil
 	}
 	addrs, err := ief.Addrs()
 	if err != nil { // get addresses
-		return migrationIp, fmt.Errorf("%s present but doesn't have an IP", v1.MigrationInterfaceName)
+		return podIPs, fmt.Errorf("%s present but doesn't have an IP", v1.MigrationInterfaceName)
 	}
+	var migrationIPs []string
 	for _, addr := range addrs {
 		if !addr.(*net.IPNet).IP.IsGlobalUnicast() {
 			// skip local/multicast IPs
@@ -43,9 +44,12 @@ func FindMigrationIP(migrationIp string) (string, error) {
 		}
 		ip := addr.(*net.IPNet).IP.To16()
 		if ip != nil {
-			return ip.String(), nil
+			migrationIPs = append(migrationIPs, ip.String())
 		}
 	}
+	if len(migrationIPs) == 0 {
+		return podIPs, fmt.Errorf("no IP found on %s", v1.MigrationInterfaceName)
+	}
 
-	return migrationIp, fmt.Errorf("no IP found on %s", v1.MigrationInterfaceName)
+	return migrationIPs, nil
 }
@@ -31,9 +31,9 @@ const (
 var _ = Describe("virt-handler", func() {
 	Context("findMigrationIp", func() {
 		It("Should return the IP passed to it when no migration0 interface exists", func() {
-			newIp, err := FindMigrationIP(originalIP)
+			newIps, err := FindMigrationIPs([]string{originalIP})
 			Expect(err).NotTo(HaveOccurred())
-			Expect(newIp).To(Equal(originalIP))
+			Expect(newIps).To(ConsistOf(originalIP))
 		})
 	})
 })
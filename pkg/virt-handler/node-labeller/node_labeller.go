@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"os/exec"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
@@ -57,6 +58,7 @@ var nodeLabellerLabels = []string{
 	kubevirtv1.HostModelCPULabel,
 	kubevirtv1.HostModelRequiredFeaturesLabel,
 	kubevirtv1.NodeHostModelIsObsoleteLabel,
+	kubevirtv1.HostPhysicalCoresLabel,
 }
 
 // NodeLabeller struct holds information needed to run node-labeller
@@ -74,16 +76,17 @@ type NodeLabeller struct {
 	volumePath              string
 	domCapabilitiesFileName string
 	cpuCounter              *libvirtxml.CapsHostCPUCounter
+	physicalCores           int
 	hostCPUModel            hostCPUModel
 	SEV                     SEVConfiguration
 	arch                    string
 }
 
-func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, host string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter) (*NodeLabeller, error) {
-	return newNodeLabeller(clusterConfig, nodeClient, host, NodeLabellerVolumePath, recorder, cpuCounter)
+func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, host string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, physicalCores int) (*NodeLabeller, error) {
+	return newNodeLabeller(clusterConfig, nodeClient, host, NodeLabellerVolumePath, recorder, cpuCounter, physicalCores)
 
 }
-func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, host, volumePath string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter) (*NodeLabeller, error) {
+func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, host, volumePath string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, physicalCores int) (*NodeLabeller, error) {
 	n := &NodeLabeller{
 		recorder:      recorder,
 		nodeClient:    nodeClient,
@@ -97,6 +100,7 @@ func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.
 		volumePath:              volumePath,
 		domCapabilitiesFileName: "virsh_domcapabilities.xml",
 		cpuCounter:              cpuCounter,
+		physicalCores:           physicalCores,
 		hostCPUModel:            hostCPUModel{requiredFeatures: make(map[string]bool, 0)},
 		arch:                    runtime.GOARCH,
 	}
@@ -267,6 +271,10 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node, cpuModels []string, cpuFeatu
 		}
 	}
 
+	if n.physicalCores > 0 {
+		newLabels[kubevirtv1.HostPhysicalCoresLabel] = strconv.Itoa(n.physicalCores)
+	}
+
 	newLabels[kubevirtv1.CPUModelVendorLabel+n.cpuModelVendor] = "true"
 	newLabels[kubevirtv1.HostModelCPULabel+hostCpuModel.Name] = "true"
 
@@ -340,3 +348,30 @@ func (n *NodeLabeller) alertIfHostModelIsObsolete(originalNode *v1.Node, hostMod
 func (n *NodeLabeller) hasTSCCounter() bool {
 	return n.cpuCounter != nil && n.cpuCounter.Name == "tsc"
 }
+
+// CountPhysicalCores returns the number of physical CPU cores listed in the host capabilities,
+// SMT siblings being accounted once. It returns 0 if the capabilities don't expose the CPU topology.
+func CountPhysicalCores(host *libvirtxml.CapsHost) int {
+	if host.NUMA == nil || host.NUMA.Cells == nil {
+		return 0
+	}
+
+	type core struct{ socket, die, id int }
+	cores := map[core]struct{}{}
+	for _, cell := range host.NUMA.Cells.Cells {
+		if cell.CPUS == nil {
+			continue
+		}
+		for _, cpu := range cell.CPUS.CPUs {
+			if cpu.SocketID == nil || cpu.CoreID == nil {
+				return 0
+			}
+			c := core{socket: *cpu.SocketID, id: *cpu.CoreID}
+			if cpu.DieID != nil {
+				c.die = *cpu.DieID
+			}
+			cores[c] = struct{}{}
+		}
+	}
+	return len(cores)
+}
@@ -55,7 +55,7 @@ var _ = Describe("Node-labeller ", func() {
 		recorder.IncludeObject = true
 
 		var err error
-		nlController, err = newNodeLabeller(config, kubeClient.CoreV1().Nodes(), nodeName, "testdata", recorder, cpuCounter)
+		nlController, err = newNodeLabeller(config, kubeClient.CoreV1().Nodes(), nodeName, "testdata", recorder, cpuCounter, 4)
 		Expect(err).ToNot(HaveOccurred())
 	}
 
@@ -199,6 +199,35 @@ var _ = Describe("Node-labeller ", func() {
 		Entry("cpuCounter is nil", nil),
 	)
 
+	It("should add the host physical cores label", func() {
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(HaveKeyWithValue(v1.HostPhysicalCoresLabel, "4"))
+	})
+
+	It("should not add the host physical cores label if the topology is unknown", func() {
+		nlController.physicalCores = 0
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).ToNot(HaveKey(v1.HostPhysicalCoresLabel))
+	})
+
+	DescribeTable("should count the physical cores of the host", func(host *libvirtxml.CapsHost, expected int) {
+		Expect(CountPhysicalCores(host)).To(Equal(expected))
+	},
+		Entry("without NUMA topology", &libvirtxml.CapsHost{}, 0),
+		Entry("with SMT siblings accounted once", newCapsHost(
+			newCapsCPU(0, 0, 0), newCapsCPU(1, 0, 1), newCapsCPU(2, 0, 0), newCapsCPU(3, 0, 1),
+		), 2),
+		Entry("with cores spread over several sockets", newCapsHost(
+			newCapsCPU(0, 0, 0), newCapsCPU(1, 0, 1), newCapsCPU(2, 1, 0), newCapsCPU(3, 1, 1),
+		), 4),
+	)
+
 	It("should remove not found cpu model and migration model", func() {
 		node := retrieveNode(kubeClient)
 		node.Labels[v1.CPUModelLabel+"Cascadelake-Server"] = "true"
@@ -283,6 +312,22 @@ func newNode(name string) *k8sv1.Node {
 	}
 }
 
+func newCapsCPU(id, socket, core int) libvirtxml.CapsHostNUMACPU {
+	return libvirtxml.CapsHostNUMACPU{ID: id, SocketID: &socket, CoreID: &core}
+}
+
+func newCapsHost(cpus ...libvirtxml.CapsHostNUMACPU) *libvirtxml.CapsHost {
+	return &libvirtxml.CapsHost{
+		NUMA: &libvirtxml.CapsHostNUMATopology{
+			Cells: &libvirtxml.CapsHostNUMACells{
+				Cells: []libvirtxml.CapsHostNUMACell{{
+					CPUS: &libvirtxml.CapsHostNUMACPUs{CPUs: cpus},
+				}},
+			},
+		},
+	}
+}
+
 func retrieveNode(kubeClient *fake.Clientset) *k8sv1.Node {
 	node, err := kubeClient.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
 	ExpectWithOffset(1, err).ToNot(HaveOccurred())
@@ -0,0 +1,140 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// deviceList converts the live domain spec, as reported by libvirt, into the devices attached to the VMI
+func deviceList(domain *api.Domain) *v1.VirtualMachineInstanceDeviceList {
+	devices := &v1.VirtualMachineInstanceDeviceList{}
+	for _, disk := range domain.Spec.Devices.Disks {
+		devices.Disks = append(devices.Disks, attachedDisk(disk))
+	}
+	for _, iface := range domain.Spec.Devices.Interfaces {
+		devices.Interfaces = append(devices.Interfaces, attachedInterface(iface))
+	}
+	for _, hostDev := range domain.Spec.Devices.HostDevices {
+		devices.HostDevices = append(devices.HostDevices, attachedHostDevice(hostDev))
+	}
+	return devices
+}
+
+func attachedDisk(disk api.Disk) v1.AttachedDisk {
+	attached := v1.AttachedDisk{
+		Name:     userDefinedAlias(disk.Alias),
+		Target:   disk.Target.Device,
+		Device:   disk.Device,
+		Bus:      disk.Target.Bus,
+		Source:   disk.Source.File,
+		ReadOnly: disk.ReadOnly != nil,
+		Address:  formatAddress(disk.Address),
+	}
+	if attached.Source == "" {
+		attached.Source = disk.Source.Dev
+	}
+	if disk.Driver != nil {
+		attached.Queues = queues(disk.Driver.Queues)
+	}
+	return attached
+}
+
+func attachedInterface(iface api.Interface) v1.AttachedInterface {
+	attached := v1.AttachedInterface{
+		Name:    userDefinedAlias(iface.Alias),
+		Address: formatAddress(iface.Address),
+	}
+	if iface.Model != nil {
+		attached.Model = iface.Model.Type
+	}
+	if iface.MAC != nil {
+		attached.MAC = iface.MAC.MAC
+	}
+	if iface.Target != nil {
+		attached.Target = iface.Target.Device
+	}
+	// libvirt leaves the link state out while the link is up
+	attached.LinkState = "up"
+	if iface.LinkState != nil && iface.LinkState.State != "" {
+		attached.LinkState = iface.LinkState.State
+	}
+	if iface.Driver != nil {
+		attached.Queues = queues(iface.Driver.Queues)
+	}
+	return attached
+}
+
+func attachedHostDevice(hostDev api.HostDevice) v1.AttachedHostDevice {
+	attached := v1.AttachedHostDevice{
+		Name:    userDefinedAlias(hostDev.Alias),
+		Type:    hostDev.Type,
+		Address: formatAddress(hostDev.Address),
+	}
+	if source := hostDev.Source.Address; source != nil {
+		switch hostDev.Type {
+		case api.HostDeviceMDev:
+			attached.Source = source.UUID
+		case api.HostDeviceUSB:
+			attached.Source = fmt.Sprintf("%s:%s", trimHex(source.Bus), trimHex(source.Device))
+		default:
+			attached.Source = formatAddress(source)
+		}
+	}
+	return attached
+}
+
+// userDefinedAlias returns the name of the device in the VMI spec, KubeVirt sets it as the alias of the devices it defines
+func userDefinedAlias(alias *api.Alias) string {
+	if alias == nil || !alias.IsUserDefined() {
+		return ""
+	}
+	return alias.GetName()
+}
+
+func queues(count *uint) *int32 {
+	if count == nil {
+		return nil
+	}
+	q := int32(*count)
+	return &q
+}
+
+func formatAddress(address *api.Address) string {
+	if address == nil {
+		return ""
+	}
+	switch address.Type {
+	case api.AddressPCI:
+		return fmt.Sprintf("%s:%s:%s.%s", trimHex(address.Domain), trimHex(address.Bus), trimHex(address.Slot), trimHex(address.Function))
+	case "drive":
+		return fmt.Sprintf("%s:%s:%s:%s", address.Controller, address.Bus, address.Target, address.Unit)
+	}
+	return address.Type
+}
+
+func trimHex(value string) string {
+	return strings.TrimPrefix(value, "0x")
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/emicklei/go-restful/v3"
 
 	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -34,6 +35,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 const (
@@ -220,6 +222,69 @@ func (lh *LifecycleHandler) GetFilesystems(request *restful.Request, response *r
 	response.WriteEntity(fsList)
 }
 
+func (lh *LifecycleHandler) GetMetricsSnapshot(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain stats")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	if !exists || domainStats == nil {
+		response.WriteError(http.StatusNotFound, fmt.Errorf("no domain stats available for %s", vmi.Name))
+		return
+	}
+
+	response.WriteEntity(metricsSnapshot(domainStats, metav1.Now()))
+}
+
+func (lh *LifecycleHandler) GetDevices(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	domain, exists, err := client.GetDomain()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get the domain")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	if !exists || domain == nil {
+		response.WriteError(http.StatusNotFound, fmt.Errorf("no domain available for %s", vmi.Name))
+		return
+	}
+
+	response.WriteEntity(deviceList(domain))
+}
+
+func metricsSnapshot(domainStats *stats.DomainStats, timestamp metav1.Time) *v1.VirtualMachineInstanceMetricsSnapshot {
+	snapshot := &v1.VirtualMachineInstanceMetricsSnapshot{Timestamp: timestamp}
+	if domainStats.Cpu != nil && domainStats.Cpu.TimeSet {
+		snapshot.CPUTimeNanoseconds = int64(domainStats.Cpu.Time)
+	}
+	if mem := domainStats.Memory; mem != nil && mem.AvailableSet {
+		// libvirt reports the memory stats in KiB
+		snapshot.MemoryAvailableBytes = int64(mem.Available) * 1024
+		if mem.UsableSet {
+			snapshot.MemoryUsedBytes = int64(mem.Available-mem.Usable) * 1024
+		}
+	}
+	for _, net := range domainStats.Net {
+		snapshot.NetworkReceivedBytes += int64(net.RxBytes)
+		snapshot.NetworkTransmittedBytes += int64(net.TxBytes)
+	}
+	for _, block := range domainStats.Block {
+		snapshot.StorageReadBytes += int64(block.RdBytes)
+		snapshot.StorageWrittenBytes += int64(block.WrBytes)
+	}
+	return snapshot
+}
+
 func (lh *LifecycleHandler) getVMILauncherClient(request *restful.Request, response *restful.Response) (*v1.VirtualMachineInstance, cmdclient.LauncherClient, error) {
 	vmi, code, err := getVMI(request, lh.vmiStore)
 	if err != nil {
@@ -313,3 +378,33 @@ func (lh *LifecycleHandler) SEVInjectLaunchSecretHandler(request *restful.Reques
 
 	response.WriteHeader(http.StatusAccepted)
 }
+
+func (lh *LifecycleHandler) FlattenHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	opts := &v1.FlattenOptions{}
+	if request.Request.Body != nil {
+		err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			log.Log.Object(vmi).Reason(err).Error("Failed to decode flatten parameters")
+			response.WriteError(http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	log.Log.Object(vmi).Infof("Flattening the volumes %v", opts.Volumes)
+
+	if err := client.FlattenVolumes(vmi, opts); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to flatten the volumes")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,198 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storagehealth
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	ReasonProbeSucceeded = "ProbeSucceeded"
+	ReasonProbeFailed    = "ProbeFailed"
+	ReasonProbeTimedOut  = "ProbeTimedOut"
+	ReasonProbeSlow      = "ProbeSlow"
+)
+
+// ProbeFunc performs a single probe of the storage backing a volume of the VMI.
+// It may block for as long as the storage is unresponsive.
+type ProbeFunc func(vmi *v1.VirtualMachineInstance, volumeStatus *v1.VolumeStatus) error
+
+type volumeState struct {
+	// generation identifies the probe in flight, results of outdated probes are dropped
+	generation uint64
+	pending    bool
+	lastStart  time.Time
+	failures   uint32
+	health     v1.VolumeHealth
+}
+
+// Monitor keeps track of the health of the volumes of the VMIs running on the node.
+// Probes run in their own goroutine so that an unresponsive storage backend never
+// blocks the caller, a probe which does not return within the timeout is accounted as failed.
+type Monitor struct {
+	lock     sync.Mutex
+	probe    ProbeFunc
+	clock    clock.Clock
+	onChange func(vmi *v1.VirtualMachineInstance)
+	vmis     map[types.UID]map[string]*volumeState
+}
+
+func NewMonitor(probe ProbeFunc, clk clock.Clock, onChange func(vmi *v1.VirtualMachineInstance)) *Monitor {
+	return &Monitor{
+		probe:    probe,
+		clock:    clk,
+		onChange: onChange,
+		vmis:     map[types.UID]map[string]*volumeState{},
+	}
+}
+
+// IsProbeable returns true for the volumes whose storage is probed by the monitor
+func IsProbeable(volumeStatus *v1.VolumeStatus) bool {
+	return volumeStatus.PersistentVolumeClaimInfo != nil &&
+		volumeStatus.HotplugVolume == nil &&
+		volumeStatus.MemoryDumpVolume == nil
+}
+
+// Check schedules the probes which are due for the volumes of the VMI, accounts
+// for the probes exceeding the timeout and returns the last known health of the volumes.
+// Volumes which did not yet reach a verdict are not part of the result.
+func (m *Monitor) Check(vmi *v1.VirtualMachineInstance, config *v1.StorageHealthCheckConfiguration) map[string]v1.VolumeHealth {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	states, exists := m.vmis[vmi.UID]
+	if !exists {
+		states = map[string]*volumeState{}
+		m.vmis[vmi.UID] = states
+	}
+
+	now := m.clock.Now()
+	seen := map[string]struct{}{}
+	result := map[string]v1.VolumeHealth{}
+	for i := range vmi.Status.VolumeStatus {
+		volumeStatus := &vmi.Status.VolumeStatus[i]
+		if !IsProbeable(volumeStatus) {
+			continue
+		}
+		seen[volumeStatus.Name] = struct{}{}
+
+		state, exists := states[volumeStatus.Name]
+		if !exists {
+			state = &volumeState{}
+			states[volumeStatus.Name] = state
+		}
+
+		if state.pending && now.Sub(state.lastStart) > config.Timeout.Duration {
+			state.pending = false
+			state.generation++
+			recordFailure(state, config, now, ReasonProbeTimedOut,
+				fmt.Sprintf("probe did not complete within %s", config.Timeout.Duration))
+		}
+		if !state.pending && (state.lastStart.IsZero() || now.Sub(state.lastStart) >= config.Interval.Duration) {
+			m.startProbe(vmi.DeepCopy(), volumeStatus.DeepCopy(), state, config.DeepCopy())
+		}
+
+		if state.health.Status != "" {
+			result[volumeStatus.Name] = state.health
+		}
+	}
+
+	for name, state := range states {
+		if _, exists := seen[name]; !exists {
+			state.generation++
+			delete(states, name)
+		}
+	}
+
+	return result
+}
+
+// Forget drops the state kept for the VMI, results of probes still in flight are discarded
+func (m *Monitor) Forget(uid types.UID) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, state := range m.vmis[uid] {
+		state.generation++
+	}
+	delete(m.vmis, uid)
+}
+
+func (m *Monitor) startProbe(vmi *v1.VirtualMachineInstance, volumeStatus *v1.VolumeStatus, state *volumeState, config *v1.StorageHealthCheckConfiguration) {
+	state.generation++
+	state.pending = true
+	state.lastStart = m.clock.Now()
+
+	generation := state.generation
+	start := state.lastStart
+	go func() {
+		err := m.probe(vmi, volumeStatus)
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		if state.generation != generation {
+			return
+		}
+		state.pending = false
+
+		previous := state.health.Status
+		now := m.clock.Now()
+		latency := now.Sub(start)
+		switch {
+		case err != nil:
+			recordFailure(state, config, now, ReasonProbeFailed, err.Error())
+		case latency > config.LatencyThreshold.Duration:
+			recordFailure(state, config, now, ReasonProbeSlow,
+				fmt.Sprintf("probe took %s, exceeding the latency threshold of %s", latency, config.LatencyThreshold.Duration))
+		default:
+			state.failures = 0
+			setHealth(state, v1.VolumeHealthy, ReasonProbeSucceeded, "", now)
+		}
+
+		if previous != state.health.Status && m.onChange != nil {
+			m.onChange(vmi)
+		}
+	}()
+}
+
+func recordFailure(state *volumeState, config *v1.StorageHealthCheckConfiguration, now time.Time, reason, message string) {
+	state.failures++
+	if state.failures >= *config.FailureThreshold {
+		setHealth(state, v1.VolumeDegraded, reason, message, now)
+	}
+}
+
+func setHealth(state *volumeState, status v1.VolumeHealthStatus, reason, message string, now time.Time) {
+	if state.health.Status != status {
+		state.health.LastTransitionTime = metav1.NewTime(now)
+	}
+	state.health.Status = status
+	state.health.Reason = reason
+	state.health.Message = message
+}
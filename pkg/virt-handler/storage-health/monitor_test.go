@@ -0,0 +1,191 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storagehealth_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagehealth "kubevirt.io/kubevirt/pkg/virt-handler/storage-health"
+)
+
+var _ = Describe("Storage health monitor", func() {
+	const volumeName = "rootdisk"
+
+	var (
+		clock   *clocktesting.FakeClock
+		config  *v1.StorageHealthCheckConfiguration
+		vmi     *v1.VirtualMachineInstance
+		probed  *atomic.Int32
+		changed *atomic.Int32
+	)
+
+	newMonitor := func(probe storagehealth.ProbeFunc) *storagehealth.Monitor {
+		probed, changed := probed, changed
+		return storagehealth.NewMonitor(func(vmi *v1.VirtualMachineInstance, volumeStatus *v1.VolumeStatus) error {
+			defer probed.Add(1)
+			return probe(vmi, volumeStatus)
+		}, clock, func(_ *v1.VirtualMachineInstance) {
+			changed.Add(1)
+		})
+	}
+
+	// checkAndWait triggers the due probes and waits for them to complete
+	checkAndWait := func(monitor *storagehealth.Monitor) map[string]v1.VolumeHealth {
+		expected := probed.Load() + 1
+		monitor.Check(vmi, config)
+		Eventually(probed.Load).Should(Equal(expected))
+		return monitor.Check(vmi, config)
+	}
+
+	BeforeEach(func() {
+		// Counters are renewed for every test, as probes hung by a previous test may still complete
+		probed = &atomic.Int32{}
+		changed = &atomic.Int32{}
+		clock = clocktesting.NewFakeClock(time.Now())
+		config = &v1.StorageHealthCheckConfiguration{
+			Interval:         &metav1.Duration{Duration: 30 * time.Second},
+			Timeout:          &metav1.Duration{Duration: 10 * time.Second},
+			LatencyThreshold: &metav1.Duration{Duration: 2 * time.Second},
+			FailureThreshold: pointer.P(uint32(2)),
+			DegradedPolicy:   pointer.P(v1.StorageDegradedPolicyNone),
+		}
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{UID: "1234"},
+			Status: v1.VirtualMachineInstanceStatus{
+				VolumeStatus: []v1.VolumeStatus{
+					{Name: volumeName, PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{}},
+					{Name: "hotplug", PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{}, HotplugVolume: &v1.HotplugVolumeStatus{}},
+					{Name: "cloudinit"},
+				},
+			},
+		}
+	})
+
+	It("should only probe persistent non-hotplug volumes", func() {
+		var names []string
+		monitor := newMonitor(func(_ *v1.VirtualMachineInstance, volumeStatus *v1.VolumeStatus) error {
+			names = append(names, volumeStatus.Name)
+			return nil
+		})
+		health := checkAndWait(monitor)
+		Expect(names).To(ConsistOf(volumeName))
+		Expect(health).To(HaveLen(1))
+		Expect(health[volumeName].Status).To(Equal(v1.VolumeHealthy))
+		Expect(health[volumeName].Reason).To(Equal(storagehealth.ReasonProbeSucceeded))
+		Expect(changed.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("should not probe again before the interval elapsed", func() {
+		monitor := newMonitor(func(_ *v1.VirtualMachineInstance, _ *v1.VolumeStatus) error { return nil })
+		checkAndWait(monitor)
+		monitor.Check(vmi, config)
+		Consistently(probed.Load).Should(BeEquivalentTo(1))
+
+		clock.Step(config.Interval.Duration)
+		checkAndWait(monitor)
+		Expect(probed.Load()).To(BeEquivalentTo(2))
+	})
+
+	It("should report the volume as degraded once the failure threshold is reached", func() {
+		monitor := newMonitor(func(_ *v1.VirtualMachineInstance, _ *v1.VolumeStatus) error {
+			return fmt.Errorf("input/output error")
+		})
+		Expect(checkAndWait(monitor)).To(BeEmpty())
+
+		clock.Step(config.Interval.Duration)
+		health := checkAndWait(monitor)
+		Expect(health[volumeName].Status).To(Equal(v1.VolumeDegraded))
+		Expect(health[volumeName].Reason).To(Equal(storagehealth.ReasonProbeFailed))
+		Expect(health[volumeName].Message).To(Equal("input/output error"))
+		Expect(health[volumeName].LastTransitionTime.Time).To(BeTemporally("==", clock.Now()))
+	})
+
+	It("should account slow probes as failures", func() {
+		monitor := newMonitor(func(_ *v1.VirtualMachineInstance, _ *v1.VolumeStatus) error {
+			clock.Step(3 * time.Second)
+			return nil
+		})
+		*config.FailureThreshold = 1
+		health := checkAndWait(monitor)
+		Expect(health[volumeName].Status).To(Equal(v1.VolumeDegraded))
+		Expect(health[volumeName].Reason).To(Equal(storagehealth.ReasonProbeSlow))
+	})
+
+	It("should recover once a probe succeeds again", func() {
+		var failing atomic.Bool
+		failing.Store(true)
+		monitor := newMonitor(func(_ *v1.VirtualMachineInstance, _ *v1.VolumeStatus) error {
+			if failing.Load() {
+				return fmt.Errorf("stale file handle")
+			}
+			return nil
+		})
+		*config.FailureThreshold = 1
+		Expect(checkAndWait(monitor)[volumeName].Status).To(Equal(v1.VolumeDegraded))
+
+		failing.Store(false)
+		clock.Step(config.Interval.Duration)
+		Expect(checkAndWait(monitor)[volumeName].Status).To(Equal(v1.VolumeHealthy))
+		Expect(changed.Load()).To(BeEquivalentTo(2))
+	})
+
+	It("should not block on hung probes and account them as timed out", func() {
+		release := make(chan struct{})
+		defer close(release)
+		monitor := newMonitor(func(_ *v1.VirtualMachineInstance, _ *v1.VolumeStatus) error {
+			<-release
+			return nil
+		})
+		*config.FailureThreshold = 1
+		Expect(monitor.Check(vmi, config)).To(BeEmpty())
+
+		clock.Step(config.Timeout.Duration + time.Second)
+		health := monitor.Check(vmi, config)
+		Expect(health[volumeName].Status).To(Equal(v1.VolumeDegraded))
+		Expect(health[volumeName].Reason).To(Equal(storagehealth.ReasonProbeTimedOut))
+	})
+
+	It("should drop the state of forgotten VMIs", func() {
+		monitor := newMonitor(func(_ *v1.VirtualMachineInstance, _ *v1.VolumeStatus) error { return nil })
+		checkAndWait(monitor)
+		monitor.Forget(vmi.UID)
+		Expect(checkAndWait(monitor)[volumeName].Status).To(Equal(v1.VolumeHealthy))
+		Expect(probed.Load()).To(BeEquivalentTo(2))
+	})
+
+	It("should read the first block of a file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "disk.img")
+		Expect(os.WriteFile(path, make([]byte, 8192), 0600)).To(Succeed())
+		Expect(storagehealth.ReadFirstBlock(path)).To(Succeed())
+		Expect(storagehealth.ReadFirstBlock(filepath.Join(filepath.Dir(path), "missing"))).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storagehealth
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+)
+
+const probeBlockSize = 4096
+
+// NewIsolatedProbe returns a probe reading the first block of the volume
+// as seen from within the mount namespace of the virt-launcher pod
+func NewIsolatedProbe(detector isolation.PodIsolationDetector) ProbeFunc {
+	return func(vmi *v1.VirtualMachineInstance, volumeStatus *v1.VolumeStatus) error {
+		res, err := detector.Detect(vmi)
+		if err != nil {
+			return fmt.Errorf("failed to detect the virt-launcher pod: %v", err)
+		}
+		mountRoot, err := res.MountRoot()
+		if err != nil {
+			return err
+		}
+		volumePath, err := mountRoot.AppendAndResolveWithRelativeRoot(volumeDevicePath(volumeStatus))
+		if err != nil {
+			return err
+		}
+		file, err := safepath.OpenAtNoFollow(volumePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		return ReadFirstBlock(file.SafePath())
+	}
+}
+
+func volumeDevicePath(volumeStatus *v1.VolumeStatus) string {
+	if volumeStatus.PersistentVolumeClaimInfo.VolumeMode != nil &&
+		*volumeStatus.PersistentVolumeClaimInfo.VolumeMode == k8sv1.PersistentVolumeBlock {
+		return filepath.Join("dev", volumeStatus.Name)
+	}
+	return hostdisk.GetMountedHostDiskPath(volumeStatus.Name, "disk.img")
+}
+
+// ReadFirstBlock reads the first block of the given file bypassing the page cache,
+// so that the read actually reaches the storage backend.
+func ReadFirstBlock(path string) error {
+	file, err := os.OpenFile(path, os.O_RDONLY|unix.O_DIRECT, 0)
+	if errors.Is(err, unix.EINVAL) {
+		// Not every filesystem supports direct IO
+		file, err = os.OpenFile(path, os.O_RDONLY, 0)
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Direct IO requires an aligned buffer, anonymous mappings are page aligned
+	buf, err := unix.Mmap(-1, 0, probeBlockSize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		return err
+	}
+	defer unix.Munmap(buf)
+
+	if _, err := file.ReadAt(buf, 0); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
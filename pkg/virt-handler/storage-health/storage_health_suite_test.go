@@ -0,0 +1,11 @@
+package storagehealth_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestStorageHealth(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -34,6 +34,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"libvirt.org/go/libvirtxml"
@@ -44,12 +45,16 @@ import (
 	"golang.org/x/sys/unix"
 	"k8s.io/apimachinery/pkg/util/errors"
 
+	"kubevirt.io/kubevirt/pkg/maintenancenotifications"
+	"kubevirt.io/kubevirt/pkg/network/announce"
 	"kubevirt.io/kubevirt/pkg/network/domainspec"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/redaction"
 
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-handler/selinux"
+	storagehealth "kubevirt.io/kubevirt/pkg/virt-handler/storage-health"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 
 	"kubevirt.io/kubevirt/pkg/config"
@@ -68,6 +73,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	netcache "kubevirt.io/kubevirt/pkg/network/cache"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
@@ -77,6 +83,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/util/net/ip"
 
 	container_disk "kubevirt.io/kubevirt/pkg/virt-handler/container-disk"
 	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
@@ -90,6 +97,7 @@ import (
 
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/emulation"
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	"kubevirt.io/kubevirt/pkg/executor"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
@@ -106,6 +114,7 @@ import (
 
 type netconf interface {
 	Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, launcherPid int, preSetup func() error) error
+	SetupFirewall(vmi *v1.VirtualMachineInstance, launcherPid int) error
 	Teardown(vmi *v1.VirtualMachineInstance) error
 }
 
@@ -127,6 +136,9 @@ const (
 	unableCreateVirtLauncherConnectionFmt = "unable to create virt-launcher client connection: %v"
 	// This value was determined after consulting with libvirt developers and performing extensive testing.
 	parallelMultifdMigrationThreads = uint(8)
+
+	containerDiskMountStateDir = "container-disk-mount-state"
+	hotplugVolumeMountStateDir = "hotplug-volume-mount-state"
 )
 
 const (
@@ -159,8 +171,14 @@ const (
 	//VMISignalDeletion is the reason set when the VMI has signal deletion
 	VMISignalDeletion = "Signaled Deletion"
 
+	//VMIPausedOnStorageDegraded is the reason set when the VMI is paused because its storage is degraded
+	VMIPausedOnStorageDegraded = "VirtualMachineInstance paused due to degraded storage"
+
 	// MemoryHotplugFailedReason is the reason set when the VM cannot hotplug memory
 	memoryHotplugFailedReason = "Memory Hotplug Failed"
+
+	// shutdownSignalTimeoutReason is the reason set when the guest didn't shut down within the signal timeout of its shutdown policy
+	shutdownSignalTimeoutReason = "ShutdownSignalTimeout"
 )
 
 var RequiredGuestAgentCommands = []string{
@@ -203,13 +221,14 @@ func NewController(
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	host string,
-	migrationIpAddress string,
+	migrationIpAddresses []string,
 	virtShareDir string,
 	virtPrivateDir string,
 	kubeletPodsDir string,
 	vmiSourceInformer cache.SharedIndexInformer,
 	vmiTargetInformer cache.SharedIndexInformer,
 	domainInformer cache.SharedInformer,
+	guestAgentPolicyInformer cache.SharedIndexInformer,
 	maxDevices int,
 	clusterConfig *virtconfig.ClusterConfig,
 	podIsolationDetector isolation.PodIsolationDetector,
@@ -227,12 +246,12 @@ func NewController(
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-handler-vm"},
 	)
 
-	containerDiskState := filepath.Join(virtPrivateDir, "container-disk-mount-state")
+	containerDiskState := filepath.Join(virtPrivateDir, containerDiskMountStateDir)
 	if err := os.MkdirAll(containerDiskState, 0700); err != nil {
 		return nil, err
 	}
 
-	hotplugState := filepath.Join(virtPrivateDir, "hotplug-volume-mount-state")
+	hotplugState := filepath.Join(virtPrivateDir, hotplugVolumeMountStateDir)
 	if err := os.MkdirAll(hotplugState, 0700); err != nil {
 		return nil, err
 	}
@@ -242,11 +261,12 @@ func NewController(
 		recorder:                         recorder,
 		clientset:                        clientset,
 		host:                             host,
-		migrationIpAddress:               migrationIpAddress,
+		migrationIpAddresses:             migrationIpAddresses,
 		virtShareDir:                     virtShareDir,
 		vmiSourceStore:                   vmiSourceInformer.GetStore(),
 		vmiTargetStore:                   vmiTargetInformer.GetStore(),
 		domainStore:                      domainInformer.GetStore(),
+		guestAgentPolicyStore:            guestAgentPolicyInformer.GetStore(),
 		heartBeatInterval:                1 * time.Minute,
 		migrationProxy:                   migrationProxy,
 		podIsolationDetector:             podIsolationDetector,
@@ -264,8 +284,16 @@ func NewController(
 		netBindingPluginMemoryCalculator: netBindingPluginMemoryCalculator,
 	}
 
+	c.storageHealthMonitor = storagehealth.NewMonitor(storagehealth.NewIsolatedProbe(podIsolationDetector), clock.RealClock{}, func(vmi *v1.VirtualMachineInstance) {
+		c.queue.Add(controller.VirtualMachineInstanceKey(vmi))
+	})
+
+	c.migrationAnnouncer = announce.New(announce.OpenBridgePort, clock.RealClock{}, func(vmi *v1.VirtualMachineInstance) {
+		c.queue.Add(controller.VirtualMachineInstanceKey(vmi))
+	})
+
 	c.hasSynced = func() bool {
-		return domainInformer.HasSynced() && vmiSourceInformer.HasSynced() && vmiTargetInformer.HasSynced()
+		return domainInformer.HasSynced() && vmiSourceInformer.HasSynced() && vmiTargetInformer.HasSynced() && guestAgentPolicyInformer.HasSynced()
 	}
 
 	_, err := vmiSourceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -295,9 +323,21 @@ func NewController(
 		return nil, err
 	}
 
+	_, err = guestAgentPolicyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addGuestAgentPolicyFunc,
+		DeleteFunc: c.deleteGuestAgentPolicyFunc,
+		UpdateFunc: c.updateGuestAgentPolicyFunc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	c.launcherClients = virtcache.LauncherClientInfoByVMI{}
 
 	c.downwardMetricsManager = downwardMetricsManager
+	c.maintenanceNotifier = maintenancenotifications.NewNotifier(func(vmi *v1.VirtualMachineInstance) {
+		c.queue.Add(controller.VirtualMachineInstanceKey(vmi))
+	})
 
 	c.domainNotifyPipes = make(map[string]string)
 
@@ -333,13 +373,14 @@ type VirtualMachineController struct {
 	recorder                 record.EventRecorder
 	clientset                kubecli.KubevirtClient
 	host                     string
-	migrationIpAddress       string
+	migrationIpAddresses     []string
 	virtShareDir             string
 	virtPrivateDir           string
 	queue                    workqueue.TypedRateLimitingInterface[string]
 	vmiSourceStore           cache.Store
 	vmiTargetStore           cache.Store
 	domainStore              cache.Store
+	guestAgentPolicyStore    cache.Store
 	launcherClients          virtcache.LauncherClientInfoByVMI
 	heartBeatInterval        time.Duration
 	deviceManagerController  *device_manager.DeviceController
@@ -350,6 +391,12 @@ type VirtualMachineController struct {
 	clusterConfig            *virtconfig.ClusterConfig
 	sriovHotplugExecutorPool *executor.RateLimitedExecutorPool
 	downwardMetricsManager   downwardMetricsManager
+	maintenanceNotifier      maintenanceNotifier
+	// appliedCPUResources holds the cpuResources last applied to the VMIs, by UID
+	appliedCPUResources sync.Map
+	// orphanedGhostRecords holds the UIDs of the orphaned ghost records queued by the janitor whose cleanup
+	// outcome is not recorded yet, by VMI key
+	orphanedGhostRecords sync.Map
 
 	netConf                          netconf
 	netStat                          netstat
@@ -362,6 +409,8 @@ type VirtualMachineController struct {
 	hostCpuModel                string
 	vmiExpectations             *controller.UIDTrackingControllerExpectations
 	ioErrorRetryManager         *FailRetryManager
+	storageHealthMonitor        *storagehealth.Monitor
+	migrationAnnouncer          *announce.Announcer
 	hasSynced                   func() bool
 }
 
@@ -671,6 +720,11 @@ func (d *VirtualMachineController) setMigrationProgressStatus(vmi *v1.VirtualMac
 	vmi.Status.MigrationState.Completed = migrationMetadata.Completed
 	vmi.Status.MigrationState.Failed = migrationMetadata.Failed
 	vmi.Status.MigrationState.Mode = migrationMetadata.Mode
+	if migrationMetadata.DowntimeMilliseconds != nil {
+		vmi.Status.MigrationState.ObservedDowntime = &metav1.Duration{
+			Duration: time.Duration(*migrationMetadata.DowntimeMilliseconds) * time.Millisecond,
+		}
+	}
 }
 
 func (d *VirtualMachineController) migrationSourceUpdateVMIStatus(origVMI *v1.VirtualMachineInstance, domain *api.Domain) error {
@@ -815,7 +869,9 @@ func (d *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 		now := metav1.Now()
 		vmiCopy.Status.MigrationState.TargetNodeDomainReadyTimestamp = &now
 		d.finalizeMigration(vmiCopy)
+		d.startMigrationAnnouncements(vmiCopy)
 	}
+	d.updateMigrationAnnouncementsStatus(vmiCopy)
 
 	if !migrations.IsMigrating(vmi) {
 		destSrcPortsMap := d.migrationProxy.GetTargetListenerPorts(string(vmi.UID))
@@ -830,15 +886,16 @@ func (d *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 		if vmi.Status.MigrationState != nil {
 			hostAddress = vmi.Status.MigrationState.TargetNodeAddress
 		}
-		if hostAddress != d.migrationIpAddress {
+		migrationIpAddress := ip.SelectByFamily(d.migrationIpAddresses, d.clusterConfig.GetPreferredIPFamily())
+		if hostAddress != migrationIpAddress {
 			portsList := make([]string, 0, len(destSrcPortsMap))
 
 			for k := range destSrcPortsMap {
 				portsList = append(portsList, k)
 			}
 			portsStrList := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(portsList)), ","), "[]")
-			d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.PreparingTarget.String(), fmt.Sprintf("Migration Target is listening at %s, on ports: %s", d.migrationIpAddress, portsStrList))
-			vmiCopy.Status.MigrationState.TargetNodeAddress = d.migrationIpAddress
+			d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.PreparingTarget.String(), fmt.Sprintf("Migration Target is listening at %s, on ports: %s", migrationIpAddress, portsStrList))
+			vmiCopy.Status.MigrationState.TargetNodeAddress = migrationIpAddress
 			vmiCopy.Status.MigrationState.TargetDirectMigrationNodePorts = destSrcPortsMap
 		}
 
@@ -1055,6 +1112,9 @@ func (d *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 				volumeStatus, tmpNeedsRefresh = d.updateMemoryDumpInfo(vmi, volumeStatus, domain)
 				needsRefresh = needsRefresh || tmpNeedsRefresh
 			}
+			if flattenStatus := volumeFlattenStatus(volumeStatus.Name, domain); flattenStatus != nil {
+				volumeStatus.Flatten = flattenStatus
+			}
 			newStatuses = append(newStatuses, volumeStatus)
 			newStatusMap[volumeStatus.Name] = volumeStatus
 		}
@@ -1100,6 +1160,9 @@ func (d *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.Virt
 		status = k8sv1.ConditionTrue
 	}
 
+	// LastProbeTime reflects the last time changed credentials were applied to the guest
+	syncTimestamp := domain.Spec.Metadata.KubeVirt.AccessCredential.SyncTimestamp
+
 	add := false
 	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceAccessCredentialsSynchronized)
 	if condition == nil {
@@ -1108,6 +1171,12 @@ func (d *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.Virt
 		// if not as expected, remove, then add.
 		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceAccessCredentialsSynchronized)
 		add = true
+	} else if syncTimestamp != nil && !condition.LastProbeTime.Equal(syncTimestamp) {
+		for i := range vmi.Status.Conditions {
+			if vmi.Status.Conditions[i].Type == v1.VirtualMachineInstanceAccessCredentialsSynchronized {
+				vmi.Status.Conditions[i].LastProbeTime = *syncTimestamp
+			}
+		}
 	}
 	if add {
 		newCondition := v1.VirtualMachineInstanceCondition{
@@ -1116,6 +1185,9 @@ func (d *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.Virt
 			Status:             status,
 			Message:            message,
 		}
+		if syncTimestamp != nil {
+			newCondition.LastProbeTime = *syncTimestamp
+		}
 		vmi.Status.Conditions = append(vmi.Status.Conditions, newCondition)
 		if status == k8sv1.ConditionTrue {
 			d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.AccessCredentialsSyncSuccess.String(), message)
@@ -1125,6 +1197,31 @@ func (d *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.Virt
 	}
 }
 
+func (d *VirtualMachineController) updateGuestBootHooksConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.GuestBootHooks == nil {
+		return
+	}
+
+	newCondition := v1.VirtualMachineInstanceCondition{
+		Type:   v1.VirtualMachineInstanceGuestBootHooksCompleted,
+		Status: k8sv1.ConditionTrue,
+	}
+	if !domain.Spec.Metadata.KubeVirt.GuestBootHooks.Succeeded {
+		newCondition.Status = k8sv1.ConditionFalse
+		newCondition.Reason = v1.VirtualMachineInstanceReasonGuestBootHookFailed
+		newCondition.Message = domain.Spec.Metadata.KubeVirt.GuestBootHooks.Message
+	}
+
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGuestBootHooksCompleted)
+	if condition != nil && condition.Status == newCondition.Status && condition.Message == newCondition.Message {
+		return
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestBootHooksCompleted)
+	newCondition.LastProbeTime = metav1.Now()
+	newCondition.LastTransitionTime = metav1.Now()
+	vmi.Status.Conditions = append(vmi.Status.Conditions, newCondition)
+}
+
 func (d *VirtualMachineController) updateLiveMigrationConditions(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
 	// Calculate whether the VM is migratable
 	liveMigrationCondition, isBlockMigration := d.calculateLiveMigrationCondition(vmi)
@@ -1288,6 +1385,34 @@ func (d *VirtualMachineController) updateMemoryDumpInfo(vmi *v1.VirtualMachineIn
 	return volumeStatus, needsRefresh
 }
 
+// volumeFlattenStatus returns the status of the flatten of a volume reported by virt-launcher,
+// nil when the volume was never flattened
+func volumeFlattenStatus(volumeName string, domain *api.Domain) *v1.VolumeFlattenStatus {
+	flattenMetadata := domain.Spec.Metadata.KubeVirt.Flatten
+	if flattenMetadata == nil {
+		return nil
+	}
+	for _, volumeFlatten := range flattenMetadata.Volumes {
+		if volumeFlatten.Name != volumeName {
+			continue
+		}
+		flattenStatus := &v1.VolumeFlattenStatus{
+			Phase:          v1.VolumeFlattenInProgress,
+			Progress:       volumeFlatten.Progress,
+			StartTimestamp: volumeFlatten.StartTimestamp,
+			EndTimestamp:   volumeFlatten.EndTimestamp,
+		}
+		if volumeFlatten.Failed {
+			flattenStatus.Phase = v1.VolumeFlattenFailed
+			flattenStatus.Message = volumeFlatten.FailureReason
+		} else if volumeFlatten.Completed {
+			flattenStatus.Phase = v1.VolumeFlattenCompleted
+		}
+		return flattenStatus
+	}
+	return nil
+}
+
 func (d *VirtualMachineController) updateFSFreezeStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 
 	if domain == nil || domain.Status.FSFreezeStatus.Status == "" {
@@ -1417,27 +1542,204 @@ func (d *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 	d.setMigrationProgressStatus(vmi, domain)
 	d.updateGuestInfoFromDomain(vmi, domain)
 	d.updateVolumeStatusesFromDomain(vmi, domain)
+	d.updateVolumeHealthStatus(vmi)
+	d.updateMigrationAnnouncementsStatus(vmi)
 	d.updateFSFreezeStatus(vmi, domain)
+	d.updateChannelStatus(vmi, domain)
+	d.updateShutdownStatus(vmi, domain)
 	d.updateMachineType(vmi, domain)
 	if err = d.updateMemoryInfo(vmi, domain); err != nil {
 		return err
 	}
+	d.updateCPUResourcesStatus(vmi)
 	err = d.netStat.UpdateStatus(vmi, domain)
 	return err
 }
 
 func (d *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) error {
 	d.updateAccessCredentialConditions(vmi, domain, condManager)
+	d.updateGuestBootHooksConditions(vmi, domain, condManager)
 	d.updateLiveMigrationConditions(vmi, condManager)
 	err := d.updateGuestAgentConditions(vmi, domain, condManager)
 	if err != nil {
 		return err
 	}
 	d.updatePausedConditions(vmi, domain, condManager)
+	d.updateStorageDegradedCondition(vmi, condManager)
+	d.updateEmulatedCondition(vmi, domain, condManager)
+	d.updateWarmStandbyConditions(vmi, domain, condManager)
+	d.updateWindowsUpdatesCondition(vmi, domain, condManager)
 
 	return nil
 }
 
+func (d *VirtualMachineController) updateEmulatedCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil || !emulation.IsCrossArchitecture(vmi, runtime.GOARCH) || condManager.HasCondition(vmi, v1.VirtualMachineInstanceEmulated) {
+		return
+	}
+
+	message := emulation.Warning(vmi)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceEmulated,
+		Status:             k8sv1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             v1.VirtualMachineInstanceReasonCrossArchitectureEmulation,
+		Message:            message,
+	})
+	d.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.VirtualMachineInstanceReasonCrossArchitectureEmulation, message)
+}
+
+func (d *VirtualMachineController) updateChannelStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || len(vmi.Spec.Domain.Devices.Channels) == 0 {
+		vmi.Status.ChannelStatus = nil
+		return
+	}
+
+	domainChannels := map[string]*api.ChannelTarget{}
+	for _, channel := range domain.Spec.Devices.Channels {
+		if channel.Target != nil {
+			domainChannels[channel.Target.Name] = channel.Target
+		}
+	}
+
+	var channelStatus []v1.ChannelStatus
+	for _, channel := range vmi.Spec.Domain.Devices.Channels {
+		status := v1.ChannelStatus{
+			Name:   channel.Name,
+			Target: channel.Target,
+			Phase:  v1.ChannelPending,
+		}
+		if target, exists := domainChannels[channel.Target]; exists {
+			status.Phase = v1.ChannelAttached
+			status.GuestConnected = target.State == "connected"
+		}
+		channelStatus = append(channelStatus, status)
+	}
+	vmi.Status.ChannelStatus = channelStatus
+}
+
+// updateShutdownStatus reports the progress of the graceful shutdown of a VMI with a shutdown policy,
+// recorded by virt-launcher in the grace period metadata of the domain
+func (d *VirtualMachineController) updateShutdownStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if vmi.Spec.ShutdownPolicy == nil || domain == nil || domain.Spec.Metadata.KubeVirt.GracePeriod == nil {
+		return
+	}
+
+	gracePeriod := domain.Spec.Metadata.KubeVirt.GracePeriod
+	status := &v1.ShutdownStatus{
+		PreShutdownStartTimestamp: gracePeriod.PreShutdownStartTimestamp,
+		PreShutdownEndTimestamp:   gracePeriod.PreShutdownEndTimestamp,
+		PreShutdownFailureReason:  gracePeriod.PreShutdownFailureReason,
+		SignalTimestamp:           gracePeriod.ShutdownSignalTimestamp,
+	}
+	switch {
+	case status.SignalTimestamp != nil:
+		status.Phase = v1.ShutdownPhaseSignaled
+	case status.PreShutdownStartTimestamp != nil:
+		status.Phase = v1.ShutdownPhasePreShutdown
+	default:
+		return
+	}
+	vmi.Status.ShutdownStatus = status
+}
+
+func (d *VirtualMachineController) updateVolumeHealthStatus(vmi *v1.VirtualMachineInstance) {
+	if !d.clusterConfig.StorageHealthCheckEnabled() || !vmi.IsRunning() {
+		return
+	}
+
+	config := d.clusterConfig.GetStorageHealthCheckConfiguration()
+	health := d.storageHealthMonitor.Check(vmi, config)
+	for i := range vmi.Status.VolumeStatus {
+		volumeStatus := &vmi.Status.VolumeStatus[i]
+		if volumeHealth, exists := health[volumeStatus.Name]; exists {
+			volumeStatus.Health = &volumeHealth
+		} else {
+			volumeStatus.Health = nil
+		}
+	}
+
+	// Probes are scheduled on sync, make sure the next one is due in time
+	d.queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), config.Interval.Duration)
+}
+
+// startMigrationAnnouncements sends the network announcements of the bridge bound interfaces
+// the VMI requested, once its domain runs on the target node.
+func (d *VirtualMachineController) startMigrationAnnouncements(vmi *v1.VirtualMachineInstance) {
+	if _, exists := vmi.Annotations[announce.Annotation]; !exists {
+		return
+	}
+
+	isolationRes, err := d.podIsolationDetector.Detect(vmi)
+	if err == nil {
+		err = d.migrationAnnouncer.Start(vmi, isolationRes.Pid())
+	}
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to send the network announcements after migration")
+		d.recorder.Event(vmi, k8sv1.EventTypeWarning, "MigrationAnnouncements", err.Error())
+	}
+}
+
+func (d *VirtualMachineController) updateMigrationAnnouncementsStatus(vmi *v1.VirtualMachineInstance) {
+	migrationState := vmi.Status.MigrationState
+	if migrationState == nil || migrationState.TargetNode != d.host || migrationState.TargetNetworkAnnouncements != nil {
+		return
+	}
+	if announcements, done := d.migrationAnnouncer.Result(vmi); done {
+		migrationState.TargetNetworkAnnouncements = announcements
+	}
+}
+
+func (d *VirtualMachineController) updateStorageDegradedCondition(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
+	var degraded []string
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.Health != nil && volumeStatus.Health.Status == v1.VolumeDegraded {
+			degraded = append(degraded, volumeStatus.Name)
+		}
+	}
+
+	if len(degraded) == 0 {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceStorageDegraded)
+		return
+	}
+
+	message := fmt.Sprintf("The storage backing volumes %s is degraded", strings.Join(degraded, ", "))
+	for i := range vmi.Status.Conditions {
+		if vmi.Status.Conditions[i].Type == v1.VirtualMachineInstanceStorageDegraded {
+			vmi.Status.Conditions[i].Message = message
+			return
+		}
+	}
+
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceStorageDegraded,
+		Status:             k8sv1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             v1.VirtualMachineInstanceReasonVolumeProbeFailed,
+		Message:            message,
+	})
+
+	if *d.clusterConfig.GetStorageHealthCheckConfiguration().DegradedPolicy == v1.StorageDegradedPolicyPause {
+		d.pauseOnStorageDegraded(vmi, condManager)
+	}
+}
+
+func (d *VirtualMachineController) pauseOnStorageDegraded(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if condManager.HasCondition(vmi, v1.VirtualMachineInstancePaused) {
+		return
+	}
+	client, err := d.getLauncherClient(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to pause VMI with degraded storage")
+		return
+	}
+	if err := client.PauseVirtualMachine(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to pause VMI with degraded storage")
+		return
+	}
+	d.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.VirtualMachineInstanceReasonVolumeProbeFailed, VMIPausedOnStorageDegraded)
+}
+
 func (d *VirtualMachineController) updateVMIStatus(origVMI *v1.VirtualMachineInstance, domain *api.Domain, syncError error) (err error) {
 	condManager := controller.NewVirtualMachineInstanceConditionManager()
 
@@ -1483,6 +1785,10 @@ func (d *VirtualMachineController) updateVMIStatus(origVMI *v1.VirtualMachineIns
 
 	controller.SetVMIPhaseTransitionTimestamp(origVMI, vmi)
 
+	if d.clusterConfig.InfraDetailsRedactionEnabled() {
+		redaction.RedactConditions(vmi)
+	}
+
 	// Only issue vmi update if status has changed
 	if !equality.Semantic.DeepEqual(oldStatus, vmi.Status) {
 		key := controller.VirtualMachineInstanceKey(vmi)
@@ -1769,7 +2075,11 @@ func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 	defer c.queue.ShutDown()
 	log.Log.Info("Starting virt-handler controller.")
 
-	go c.deviceManagerController.Run(stopCh)
+	deviceControllerDone := make(chan struct{})
+	go func() {
+		c.deviceManagerController.Run(stopCh)
+		close(deviceControllerDone)
+	}()
 
 	go c.downwardMetricsManager.Run(stopCh)
 
@@ -1800,6 +2110,8 @@ func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 
 	go c.ioErrorRetryManager.Run(stopCh)
 
+	go c.runJanitor(stopCh)
+
 	// Start the actual work
 	for i := 0; i < threadiness; i++ {
 		go wait.Until(c.runWorker, time.Second, stopCh)
@@ -1807,9 +2119,20 @@ func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 
 	<-stopCh
 	<-heartBeatDone
+	<-deviceControllerDone
 	log.Log.Info("Stopping virt-handler controller.")
 }
 
+// PrepareHandover leaves the node schedulable once the controller stops, for the incoming virt-handler
+func (c *VirtualMachineController) PrepareHandover() {
+	c.heartBeat.KeepSchedulable()
+}
+
+// RegisteredDevicePlugins returns the names of the device plugins registered with the kubelet
+func (c *VirtualMachineController) RegisteredDevicePlugins() []string {
+	return c.deviceManagerController.RegisteredDevicePlugins()
+}
+
 func (c *VirtualMachineController) runWorker() {
 	for c.Execute() {
 	}
@@ -1821,7 +2144,9 @@ func (c *VirtualMachineController) Execute() bool {
 		return false
 	}
 	defer c.queue.Done(key)
-	if err := c.execute(key); err != nil {
+	err := c.execute(key)
+	c.recordGhostRecordReclaim(key, err)
+	if err != nil {
 		log.Log.Reason(err).Infof("re-enqueuing VirtualMachineInstance %v", key)
 		c.queue.AddRateLimited(key)
 	} else {
@@ -2277,6 +2602,7 @@ func (d *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 	d.migrationProxy.StopSourceListener(vmiId)
 
 	d.downwardMetricsManager.StopServer(vmi)
+	d.maintenanceNotifier.Forget(vmi)
 
 	// Unmount container disks and clean up remaining files
 	if err := d.containerDiskMounter.Unmount(vmi); err != nil {
@@ -2294,6 +2620,12 @@ func (d *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 
 	d.sriovHotplugExecutorPool.Delete(vmi.UID)
 
+	d.storageHealthMonitor.Forget(vmi.UID)
+
+	d.appliedCPUResources.Delete(vmi.UID)
+
+	d.migrationAnnouncer.Forget(vmi.UID)
+
 	// Watch dog file and command client must be the last things removed here
 	if err := d.closeLauncherClient(vmi); err != nil {
 		return err
@@ -2442,10 +2774,15 @@ func (d *VirtualMachineController) helperVmShutdown(vmi *v1.VirtualMachineInstan
 	}
 
 	if domainHasGracePeriod(domain) && tryGracefully {
-		if expired, timeLeft := d.hasGracePeriodExpired(domain); !expired {
+		if expired, timeLeft := d.hasGracePeriodExpired(domain); expired {
+			log.Log.Object(vmi).Infof("Grace period expired, killing deleted VirtualMachineInstance %s", vmi.GetObjectMeta().GetName())
+		} else if hasShutdownSignalExpired(vmi, domain) && !hasPendingWindowsUpdates(vmi) {
+			log.Log.Object(vmi).Infof("Shutdown signal timed out, killing deleted VirtualMachineInstance %s", vmi.GetObjectMeta().GetName())
+			d.recorder.Eventf(vmi, k8sv1.EventTypeWarning, shutdownSignalTimeoutReason,
+				"The guest didn't shut down within %d seconds of the shutdown signal, forcing it off", *vmi.Spec.ShutdownPolicy.SignalTimeoutSeconds)
+		} else {
 			return d.handleVMIShutdown(vmi, domain, client, timeLeft)
 		}
-		log.Log.Object(vmi).Infof("Grace period expired, killing deleted VirtualMachineInstance %s", vmi.GetObjectMeta().GetName())
 	} else {
 		log.Log.Object(vmi).Infof("Graceful shutdown not set, killing deleted VirtualMachineInstance %s", vmi.GetObjectMeta().GetName())
 	}
@@ -2780,6 +3117,12 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			return nil
 		}
 
+		if delay := d.delayMigrationForGuest(origVMI); delay > 0 {
+			log.Log.Object(origVMI).Infof("Delaying the migration %s by %s for the guest to acknowledge it", origVMI.Status.MigrationState.MigrationUID, delay)
+			d.queue.AddAfter(controller.VirtualMachineInstanceKey(origVMI), delay)
+			return nil
+		}
+
 		err = d.handleSourceMigrationProxy(origVMI)
 		if err != nil {
 			return fmt.Errorf("failed to handle migration proxy: %v", err)
@@ -2904,7 +3247,10 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationTarget(origVMI *v1.Vir
 		return err
 	}
 
-	// Mount hotplug disks
+	// Mount hotplug disks, the domain can only arrive once they are all in place
+	if controller.VMIHasHotplugVolumes(vmi) && vmi.Status.MigrationState.TargetAttachmentPodUID == "" {
+		return fmt.Errorf("waiting for the target attachment pod to mount the hotplug volumes")
+	}
 	if attachmentPodUID := vmi.Status.MigrationState.TargetAttachmentPodUID; attachmentPodUID != types.UID("") {
 		cgroupManager, err := getCgroupManager(vmi)
 		if err != nil {
@@ -2957,6 +3303,7 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationTarget(origVMI *v1.Vir
 
 	options := virtualMachineOptions(nil, 0, nil, d.capabilities, disksInfo, d.clusterConfig)
 	options.InterfaceDomainAttachment = domainspec.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, d.clusterConfig.GetNetworkBindings())
+	options.DeniedGuestAgentCommands = deniedGuestAgentCommands(d.guestAgentPolicyStore, vmi.Namespace)
 
 	if err := client.SyncMigrationTarget(vmi, options); err != nil {
 		return fmt.Errorf("syncing migration target failed: %v", err)
@@ -3022,6 +3369,18 @@ func (d *VirtualMachineController) affinePitThread(vmi *v1.VirtualMachineInstanc
 	return unix.SchedSetaffinity(pitpid, &Mask)
 }
 
+func (d *VirtualMachineController) setCgroupWeights(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager) error {
+	isolationRes, err := d.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return fmt.Errorf(failedDetectIsolationFmt, err)
+	}
+	launcherRoot, err := isolationRes.MountRoot()
+	if err != nil {
+		return err
+	}
+	return applyCgroupWeights(vmi, cgroupManager, launcherRoot)
+}
+
 func (d *VirtualMachineController) configureHousekeepingCgroup(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager) error {
 	if err := cgroupManager.CreateChildCgroup("housekeeping", "cpuset"); err != nil {
 		log.Log.Reason(err).Error("CreateChildCgroup ")
@@ -3069,8 +3428,7 @@ func (d *VirtualMachineController) configureHousekeepingCgroup(vmi *v1.VirtualMa
 		if proc == nil {
 			return fmt.Errorf("failed to find process with tid: %d", tid)
 		}
-		comm := proc.Executable()
-		if strings.Contains(comm, "CPU ") && strings.Contains(comm, "KVM") {
+		if isVCPUThread(proc.Executable()) {
 			continue
 		}
 		hktids = append(hktids, tid)
@@ -3148,6 +3506,11 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 		if err != nil {
 			return fmt.Errorf(failedDetectIsolationFmt, err)
 		}
+
+		if err := d.netConf.SetupFirewall(vmi, isolationRes.Pid()); err != nil {
+			return fmt.Errorf("failed to configure vmi ingress firewall: %w", err)
+		}
+
 		virtLauncherRootMount, err := isolationRes.MountRoot()
 		if err != nil {
 			return err
@@ -3233,6 +3596,8 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 			return err
 		}
 
+		d.notifyGuestOfMaintenance(vmi, isolationRes.Pid())
+
 		netsToHotplug := netvmispec.NetworksToHotplugWhosePodIfacesAreReady(vmi)
 		nonAbsentIfaces := netvmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
 			return iface.State != v1.InterfaceStateAbsent
@@ -3250,6 +3615,12 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 			d.recorder.Event(vmi, k8sv1.EventTypeWarning, "NicHotplug", err.Error())
 			errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
 		}
+
+		if err := d.netConf.SetupFirewall(vmi, isolationRes.Pid()); err != nil {
+			log.Log.Object(vmi).Error(err.Error())
+			d.recorder.Event(vmi, k8sv1.EventTypeWarning, "IngressFirewall", err.Error())
+			errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
+		}
 	}
 
 	smbios := d.clusterConfig.GetSMBIOS()
@@ -3257,6 +3628,7 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 
 	options := virtualMachineOptions(smbios, period, preallocatedVolumes, d.capabilities, disksInfo, d.clusterConfig)
 	options.InterfaceDomainAttachment = domainspec.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, d.clusterConfig.GetNetworkBindings())
+	options.DeniedGuestAgentCommands = deniedGuestAgentCommands(d.guestAgentPolicyStore, vmi.Namespace)
 
 	err = client.SyncVirtualMachine(vmi, options)
 	if err != nil {
@@ -3273,6 +3645,27 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 			return err
 		}
 	}
+	if housekeepingCPUs := d.heartBeat.HousekeepingCPUs(); housekeepingCPUs != "" && !vmi.IsCPUDedicated() {
+		if err := confineInfraThreads(vmi, cgroupManager, housekeepingCPUs); err != nil {
+			log.Log.Object(vmi).Reason(err).Error("failed to confine the infrastructure threads to the housekeeping CPUs")
+			d.recorder.Event(vmi, k8sv1.EventTypeWarning, "VirtualizationInfraReservation", err.Error())
+			errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
+		}
+	}
+	if vmi.IsRunning() && !vmi.IsCPUDedicated() && d.clusterConfig.LiveCPUResourcesEnabled() {
+		if err := d.setCPUResources(vmi, cgroupManager); err != nil {
+			log.Log.Object(vmi).Reason(err).Error("failed to apply the CPU resources")
+			d.recorder.Event(vmi, k8sv1.EventTypeWarning, "CPUResources", err.Error())
+			errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
+		}
+	}
+	if vmi.Spec.Domain.Resources.CgroupWeights != nil && d.clusterConfig.CgroupWeightsEnabled() {
+		if err := d.setCgroupWeights(vmi, cgroupManager); err != nil {
+			log.Log.Object(vmi).Reason(err).Error("failed to set the cgroup weights")
+			d.recorder.Event(vmi, k8sv1.EventTypeWarning, "CgroupWeights", err.Error())
+			errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
+		}
+	}
 	if vmi.IsRealtimeEnabled() && !vmi.IsRunning() && !vmi.IsFinal() {
 		log.Log.Object(vmi).Info("Configuring vcpus for real time workloads")
 		if err := d.configureVCPUScheduler(vmi); err != nil {
@@ -3546,7 +3939,7 @@ func (d *VirtualMachineController) finalizeMigration(vmi *v1.VirtualMachineInsta
 	removeMigratedVolumes(vmi)
 
 	options := &cmdv1.VirtualMachineOptions{}
-	options.InterfaceMigration = domainspec.BindingMigrationByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, d.clusterConfig.GetNetworkBindings())
+	options.InterfaceMigration = domainspec.InterfaceMigrationByInterfaceName(vmi, d.clusterConfig.GetNetworkBindings())
 	if err := client.FinalizeVirtualMachineMigration(vmi, options); err != nil {
 		log.Log.Object(vmi).Reason(err).Error(errorMessage)
 		return fmt.Errorf("%s: %v", errorMessage, err)
@@ -3561,6 +3954,19 @@ func vmiHasTerminationGracePeriod(vmi *v1.VirtualMachineInstance) bool {
 		(vmi.Spec.TerminationGracePeriodSeconds != nil && *vmi.Spec.TerminationGracePeriodSeconds != 0)
 }
 
+// hasShutdownSignalExpired returns true once the guest was given the signal timeout of its shutdown policy
+// to shut down after being signaled
+func hasShutdownSignalExpired(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	if vmi.Spec.ShutdownPolicy == nil || vmi.Spec.ShutdownPolicy.SignalTimeoutSeconds == nil || !domainHasGracePeriod(domain) {
+		return false
+	}
+	signalTimestamp := domain.Spec.Metadata.KubeVirt.GracePeriod.ShutdownSignalTimestamp
+	if signalTimestamp == nil {
+		return false
+	}
+	return time.Since(signalTimestamp.Time) >= time.Duration(*vmi.Spec.ShutdownPolicy.SignalTimeoutSeconds)*time.Second
+}
+
 func domainHasGracePeriod(domain *api.Domain) bool {
 	return domain != nil &&
 		domain.Spec.Metadata.KubeVirt.GracePeriod != nil &&
@@ -3575,6 +3981,10 @@ func isACPIEnabled(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
 }
 
 func (d *VirtualMachineController) isHostModelMigratable(vmi *v1.VirtualMachineInstance) error {
+	if d.clusterConfig.DryRunVirtualizationEnabled() {
+		// No guest CPU is migrated
+		return nil
+	}
 	if cpu := vmi.Spec.Domain.CPU; cpu != nil && cpu.Model == v1.CPUModeHostModel {
 		if d.hostCpuModel == "" {
 			err := fmt.Errorf("the node \"%s\" does not allow migration with host-model", vmi.Status.NodeName)
@@ -3586,7 +3996,7 @@ func (d *VirtualMachineController) isHostModelMigratable(vmi *v1.VirtualMachineI
 }
 
 func (d *VirtualMachineController) claimDeviceOwnership(virtLauncherRootMount *safepath.Path, deviceName string) error {
-	softwareEmulation := d.clusterConfig.AllowEmulation()
+	softwareEmulation := d.clusterConfig.AllowEmulation() || d.clusterConfig.DryRunVirtualizationEnabled()
 	devicePath, err := safepath.JoinNoFollow(virtLauncherRootMount, filepath.Join("dev", deviceName))
 	if err != nil {
 		if softwareEmulation {
@@ -44,8 +44,10 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	testingclock "k8s.io/utils/clock/testing"
 
 	v1 "kubevirt.io/api/core/v1"
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
 	api2 "kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
@@ -53,8 +55,10 @@ import (
 	"kubevirt.io/kubevirt/pkg/certificates"
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
+	"kubevirt.io/kubevirt/pkg/emulation"
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/network/announce"
 	netcache "kubevirt.io/kubevirt/pkg/network/cache"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -161,6 +165,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 		vmiSourceInformer, vmiSource := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
 		vmiTargetInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
 		domainInformer, domainSource := testutils.NewFakeInformerFor(&api.Domain{})
+		guestAgentPolicyInformer, _ := testutils.NewFakeInformerFor(&guestagentv1alpha1.GuestAgentPolicy{})
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 
@@ -203,13 +208,14 @@ var _ = Describe("VirtualMachineInstance", func() {
 		controller, _ = NewController(recorder,
 			virtClient,
 			host,
-			podIpAddress,
+			[]string{podIpAddress},
 			shareDir,
 			privateDir,
 			podsDir,
 			vmiSourceInformer,
 			vmiTargetInformer,
 			domainInformer,
+			guestAgentPolicyInformer,
 			10,
 			config,
 			mockIsolationDetector,
@@ -238,11 +244,12 @@ var _ = Describe("VirtualMachineInstance", func() {
 		vmiFeeder = testutils.NewVirtualMachineFeeder(mockQueue, vmiSource)
 		domainFeeder = testutils.NewDomainFeeder(mockQueue, domainSource)
 
-		wg.Add(4)
+		wg.Add(5)
 		go func() { vmiSourceInformer.Run(stop); wg.Done() }()
 		go func() { vmiTargetInformer.Run(stop); wg.Done() }()
 		go func() { domainInformer.Run(stop); wg.Done() }()
-		Expect(cache.WaitForCacheSync(stop, vmiSourceInformer.HasSynced, vmiTargetInformer.HasSynced, domainInformer.HasSynced)).To(BeTrue())
+		go func() { guestAgentPolicyInformer.Run(stop); wg.Done() }()
+		Expect(cache.WaitForCacheSync(stop, vmiSourceInformer.HasSynced, vmiTargetInformer.HasSynced, domainInformer.HasSynced, guestAgentPolicyInformer.HasSynced)).To(BeTrue())
 
 		go func() {
 			notifyserver.RunServer(shareDir, stop, eventChan, nil, nil)
@@ -498,6 +505,63 @@ var _ = Describe("VirtualMachineInstance", func() {
 			testutils.ExpectEvent(recorder, VMIStopping)
 		})
 
+		Context("with a shutdown policy", func() {
+			var (
+				vmi    *v1.VirtualMachineInstance
+				domain *api.Domain
+			)
+
+			BeforeEach(func() {
+				vmi = api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Spec.ShutdownPolicy = &v1.ShutdownPolicy{SignalTimeoutSeconds: pointer.P(int64(10))}
+				domain = api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+
+				initGracePeriodHelper(30, vmi, domain)
+				domain.Spec.Metadata.KubeVirt.GracePeriod.DeletionTimestamp = pointer.P(metav1.NewTime(time.Now().Add(-20 * time.Second)))
+				client.EXPECT().Ping()
+			})
+
+			It("should force off the domain once the shutdown signal timed out", func() {
+				domain.Spec.Metadata.KubeVirt.GracePeriod.ShutdownSignalTimestamp = pointer.P(metav1.NewTime(time.Now().Add(-10 * time.Second)))
+				client.EXPECT().KillVirtualMachine(vmi)
+
+				Expect(controller.processVmShutdown(vmi, domain)).To(Succeed())
+				testutils.ExpectEvent(recorder, shutdownSignalTimeoutReason)
+				testutils.ExpectEvent(recorder, VMIStopping)
+			})
+
+			It("should wait for the pending Windows updates after the shutdown signal timed out", func() {
+				vmi.Spec.ShutdownPolicy.WindowsUpdates = v1.WindowsUpdatesWait
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+					Type:   v1.VirtualMachineInstanceWindowsUpdatesPending,
+					Status: k8sv1.ConditionTrue,
+					Reason: v1.VirtualMachineInstanceReasonWindowsRestartRequired,
+				}}
+				domain.Spec.Metadata.KubeVirt.GracePeriod.ShutdownSignalTimestamp = pointer.P(metav1.NewTime(time.Now().Add(-10 * time.Second)))
+				client.EXPECT().ShutdownVirtualMachine(vmi)
+
+				Expect(controller.processVmShutdown(vmi, domain)).To(Succeed())
+				testutils.ExpectEvent(recorder, VMIGracefulShutdown)
+			})
+
+			It("should keep signaling the guest until the shutdown signal timed out", func() {
+				domain.Spec.Metadata.KubeVirt.GracePeriod.ShutdownSignalTimestamp = pointer.P(metav1.NewTime(time.Now().Add(-5 * time.Second)))
+				client.EXPECT().ShutdownVirtualMachine(vmi)
+
+				Expect(controller.processVmShutdown(vmi, domain)).To(Succeed())
+				testutils.ExpectEvent(recorder, VMIGracefulShutdown)
+			})
+
+			It("should keep signaling the guest while the pre-shutdown command runs", func() {
+				client.EXPECT().ShutdownVirtualMachine(vmi)
+
+				Expect(controller.processVmShutdown(vmi, domain)).To(Succeed())
+				testutils.ExpectEvent(recorder, VMIGracefulShutdown)
+			})
+		})
+
 		It("should immediately kill domain with grace period of 0", func() {
 			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
 			domain.Status.Status = api.Running
@@ -873,6 +937,96 @@ var _ = Describe("VirtualMachineInstance", func() {
 			expectEvent(string(v1.AccessCredentialsSyncSuccess), false)
 		})
 
+		It("should update the access credential condition when changed credentials were applied", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:          v1.VirtualMachineInstanceAccessCredentialsSynchronized,
+					LastProbeTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+					Status:        k8sv1.ConditionTrue,
+				},
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+
+			syncTimestamp := metav1.Now()
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.AccessCredential = &api.AccessCredentialMetadata{
+				Succeeded:     true,
+				SyncTimestamp: &syncTimestamp,
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+			createVMI(vmi)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecute()
+
+			expectEvent(string(v1.AccessCredentialsSyncSuccess), false)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Conditions).To(ContainElement(
+				MatchFields(IgnoreExtras, Fields{
+					"Type":          Equal(v1.VirtualMachineInstanceAccessCredentialsSynchronized),
+					"Status":        Equal(k8sv1.ConditionTrue),
+					"LastProbeTime": Equal(syncTimestamp)},
+				),
+			))
+		})
+
+		DescribeTable("should report the result of the OnGuestBoot hooks", func(result *api.GuestBootHooksMetadata, expectedStatus k8sv1.ConditionStatus, expectedReason string) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.GuestBootHooks = result
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+			createVMI(vmi)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+
+			sanityExecute()
+
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Conditions).To(ContainElement(
+				MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(v1.VirtualMachineInstanceGuestBootHooksCompleted),
+					"Status":  Equal(expectedStatus),
+					"Reason":  Equal(expectedReason),
+					"Message": Equal(result.Message)},
+				),
+			))
+		},
+			Entry("when the hooks succeeded", &api.GuestBootHooksMetadata{Succeeded: true}, k8sv1.ConditionTrue, ""),
+			Entry("when a hook failed", &api.GuestBootHooksMetadata{Message: "sidecar failed"}, k8sv1.ConditionFalse, v1.VirtualMachineInstanceReasonGuestBootHookFailed),
+		)
+
 		It("should update access credential condition if agent disconnects", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1278,6 +1432,39 @@ var _ = Describe("VirtualMachineInstance", func() {
 				Expect(hasHotplug).To(BeFalse())
 			})
 
+			It("should report the flatten of the volumes", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{
+					{Name: "containerdisk"},
+					{Name: "ephemeral"},
+					{Name: "test"},
+				}
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				now := metav1.Now()
+				domain.Spec.Metadata.KubeVirt.Flatten = &api.FlattenMetadata{
+					Volumes: []api.VolumeFlattenMetadata{
+						{Name: "containerdisk", StartTimestamp: &now, Progress: 42},
+						{Name: "ephemeral", StartTimestamp: &now, EndTimestamp: &now, Completed: true, Failed: true, FailureReason: "block pull failed"},
+					},
+				}
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.VolumeStatus[0].Flatten).To(Equal(&v1.VolumeFlattenStatus{
+					Phase:          v1.VolumeFlattenInProgress,
+					Progress:       42,
+					StartTimestamp: &now,
+				}))
+				Expect(vmi.Status.VolumeStatus[1].Flatten).To(Equal(&v1.VolumeFlattenStatus{
+					Phase:          v1.VolumeFlattenFailed,
+					StartTimestamp: &now,
+					EndTimestamp:   &now,
+					Message:        "block pull failed",
+				}))
+				Expect(vmi.Status.VolumeStatus[2].Flatten).To(BeNil())
+			})
+
 			It("should have hashotplug true with hotplugged volumes", func() {
 				vmi := api2.NewMinimalVMI("testvmi")
 				vmi.UID = vmiTestUUID
@@ -1639,7 +1826,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 			destSrcPorts := controller.migrationProxy.GetTargetListenerPorts(string(vmi.UID))
 			updatedVmi := vmi.DeepCopy()
-			updatedVmi.Status.MigrationState.TargetNodeAddress = controller.migrationIpAddress
+			updatedVmi.Status.MigrationState.TargetNodeAddress = controller.migrationIpAddresses[0]
 			updatedVmi.Status.MigrationState.TargetDirectMigrationNodePorts = destSrcPorts
 
 			client.EXPECT().Ping()
@@ -1650,6 +1837,32 @@ var _ = Describe("VirtualMachineInstance", func() {
 			testutils.ExpectEvent(recorder, "Migration Target is listening")
 		})
 
+		It("should wait for the target attachment pod before preparing a migration target with hotplug volumes", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Labels = make(map[string]string)
+			vmi.Status.NodeName = "othernode"
+			vmi.Labels[v1.MigrationTargetNodeNameLabel] = host
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNode:   host,
+				SourceNode:   "othernode",
+				MigrationUID: "123",
+			}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+				Name:          "hotplug",
+				HotplugVolume: &v1.HotplugVolumeStatus{},
+			}}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			vmiFeeder.Add(vmi)
+
+			client.EXPECT().Ping()
+			sanityExecute()
+			Expect(mockQueue.GetRateLimitedEnqueueCount()).To(Equal(1))
+		})
+
 		It("should signal target pod to early exit on failed migration and immediately re-enqueue the vmi", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1712,7 +1925,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 			destSrcPorts := controller.migrationProxy.GetTargetListenerPorts(string(vmi.UID))
 			updatedVmi := vmi.DeepCopy()
-			updatedVmi.Status.MigrationState.TargetNodeAddress = controller.migrationIpAddress
+			updatedVmi.Status.MigrationState.TargetNodeAddress = controller.migrationIpAddresses[0]
 			updatedVmi.Status.MigrationState.TargetDirectMigrationNodePorts = destSrcPorts
 			mockHotplugVolumeMounter.EXPECT().UnmountAll(gomock.Any(), mockCgroupManager).Return(nil)
 
@@ -1909,10 +2122,11 @@ var _ = Describe("VirtualMachineInstance", func() {
 			domain.Status.Reason = api.ReasonMigrated
 
 			domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
-				UID:            "123",
-				StartTimestamp: &now,
-				EndTimestamp:   &now,
-				Completed:      true,
+				UID:                  "123",
+				StartTimestamp:       &now,
+				EndTimestamp:         &now,
+				Completed:            true,
+				DowntimeMilliseconds: pointer.P(uint64(87)),
 			}
 
 			domainFeeder.Add(domain)
@@ -1928,6 +2142,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.MigrationState.Completed).To(BeTrue())
 			Expect(updatedVMI.Status.MigrationState.StartTimestamp).To(Equal(&now))
 			Expect(updatedVMI.Status.MigrationState.EndTimestamp).To(Equal(&now))
+			Expect(updatedVMI.Status.MigrationState.ObservedDowntime).To(Equal(&metav1.Duration{Duration: 87 * time.Millisecond}))
 			Expect(updatedVMI.Status.NodeName).To(Equal("othernode"))
 			Expect(updatedVMI.Status.Interfaces).To(BeEmpty())
 			Expect(updatedVMI.Labels).To(HaveKeyWithValue(v1.NodeNameLabel, "othernode"))
@@ -2974,6 +3189,80 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.FSFreezeStatus).To(BeEmpty())
 		})
 
+		It("should report the status of the channels in VMI status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+			vmi.Spec.Domain.Devices.Channels = []v1.Channel{
+				{Name: "logs", Target: "org.example.logs.0"},
+				{Name: "agent", Target: "org.example.agent.0"},
+				{Name: "pending", Target: "org.example.pending.0"},
+			}
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Devices.Channels = []api.Channel{
+				{Type: "unix", Target: &api.ChannelTarget{Name: "org.example.logs.0", Type: v1.VirtIO, State: "disconnected"}},
+				{Type: "unix", Target: &api.ChannelTarget{Name: "org.example.agent.0", Type: v1.VirtIO, State: "connected"}},
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+			createVMI(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, VMIStarted)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.ChannelStatus).To(Equal([]v1.ChannelStatus{
+				{Name: "logs", Target: "org.example.logs.0", Phase: v1.ChannelAttached},
+				{Name: "agent", Target: "org.example.agent.0", Phase: v1.ChannelAttached, GuestConnected: true},
+				{Name: "pending", Target: "org.example.pending.0", Phase: v1.ChannelPending},
+			}))
+		})
+
+		It("should report the progress of the shutdown in VMI status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+			vmi.Spec.ShutdownPolicy = &v1.ShutdownPolicy{
+				PreShutdown: &v1.PreShutdownCommand{Command: []string{"/usr/bin/systemctl", "stop", "postgresql"}},
+			}
+
+			start := metav1.NewTime(time.Now().Add(-10 * time.Second).Truncate(time.Second))
+			end := metav1.NewTime(time.Now().Add(-5 * time.Second).Truncate(time.Second))
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.GracePeriod = &api.GracePeriodMetadata{
+				DeletionGracePeriodSeconds: 30,
+				DeletionTimestamp:          &start,
+				PreShutdownStartTimestamp:  &start,
+				PreShutdownEndTimestamp:    &end,
+				PreShutdownFailureReason:   "exited with error code:1",
+				ShutdownSignalTimestamp:    &end,
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+			createVMI(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, VMIStarted)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.ShutdownStatus).To(Equal(&v1.ShutdownStatus{
+				Phase:                     v1.ShutdownPhaseSignaled,
+				PreShutdownStartTimestamp: &start,
+				PreShutdownEndTimestamp:   &end,
+				PreShutdownFailureReason:  "exited with error code:1",
+				SignalTimestamp:           &end,
+			}))
+		})
+
 		It("should update Memory information in VMI status", func() {
 			initialMemory := resource.MustParse("128Ki")
 			vmi := api2.NewMinimalVMI("testvmi")
@@ -3102,6 +3391,128 @@ var _ = Describe("VirtualMachineInstance", func() {
 		})
 	})
 
+	Context("VirtualMachineInstance controller gets informed about storage health", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api2.NewMinimalVMI("testvmi")
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{Name: "healthy", Health: &v1.VolumeHealth{Status: v1.VolumeHealthy}},
+				{Name: "degraded1", Health: &v1.VolumeHealth{Status: v1.VolumeDegraded}},
+				{Name: "degraded2", Health: &v1.VolumeHealth{Status: v1.VolumeDegraded}},
+				{Name: "unprobed"},
+			}
+		})
+
+		It("should add the StorageDegraded condition listing the degraded volumes", func() {
+			controller.updateStorageDegradedCondition(vmi, virtcontroller.NewVirtualMachineInstanceConditionManager())
+			Expect(vmi.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(v1.VirtualMachineInstanceStorageDegraded),
+				"Status":  Equal(k8sv1.ConditionTrue),
+				"Reason":  Equal(v1.VirtualMachineInstanceReasonVolumeProbeFailed),
+				"Message": And(ContainSubstring("degraded1"), ContainSubstring("degraded2")),
+			})))
+		})
+
+		It("should remove the StorageDegraded condition once all volumes are healthy", func() {
+			condManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+			controller.updateStorageDegradedCondition(vmi, condManager)
+			Expect(vmi.Status.Conditions).To(HaveLen(1))
+
+			vmi.Status.VolumeStatus[1].Health.Status = v1.VolumeHealthy
+			vmi.Status.VolumeStatus[2].Health = nil
+			controller.updateStorageDegradedCondition(vmi, condManager)
+			Expect(vmi.Status.Conditions).To(BeEmpty())
+		})
+	})
+
+	Context("VirtualMachineInstance controller gets informed about cross-architecture emulation", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Architecture = "arm64"
+			if runtime.GOARCH == "arm64" {
+				vmi.Spec.Architecture = "amd64"
+			}
+			vmi.Annotations = map[string]string{emulation.NodeArchitectureAnnotation: runtime.GOARCH}
+		})
+
+		It("should add the Emulated condition with a performance warning", func() {
+			condManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+			controller.updateEmulatedCondition(vmi, api.NewMinimalDomain("testvmi"), condManager)
+			Expect(vmi.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(v1.VirtualMachineInstanceEmulated),
+				"Status":  Equal(k8sv1.ConditionTrue),
+				"Reason":  Equal(v1.VirtualMachineInstanceReasonCrossArchitectureEmulation),
+				"Message": Equal(emulation.Warning(vmi)),
+			})))
+			testutils.ExpectEvent(recorder, v1.VirtualMachineInstanceReasonCrossArchitectureEmulation)
+
+			controller.updateEmulatedCondition(vmi, api.NewMinimalDomain("testvmi"), condManager)
+			Expect(vmi.Status.Conditions).To(HaveLen(1))
+		})
+
+		It("should not add the Emulated condition before the domain exists", func() {
+			controller.updateEmulatedCondition(vmi, nil, virtcontroller.NewVirtualMachineInstanceConditionManager())
+			Expect(vmi.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should not add the Emulated condition to a guest of the node architecture", func() {
+			vmi.Spec.Architecture = runtime.GOARCH
+			controller.updateEmulatedCondition(vmi, api.NewMinimalDomain("testvmi"), virtcontroller.NewVirtualMachineInstanceConditionManager())
+			Expect(vmi.Status.Conditions).To(BeEmpty())
+		})
+	})
+
+	Context("VirtualMachineInstance controller reports the network announcements after migration", func() {
+		var vmi *v1.VirtualMachineInstance
+		var announced chan struct{}
+
+		BeforeEach(func() {
+			vmi = api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Annotations = map[string]string{announce.Annotation: `{"count": 1}`}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", MAC: "02:00:00:00:00:05"}}
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{TargetNode: host, MigrationUID: "123"}
+
+			announced = make(chan struct{}, 1)
+			open := func(*v1.VirtualMachineInstance, int, v1.Network) (announce.FrameWriter, error) {
+				return frameWriterStub{}, nil
+			}
+			controller.migrationAnnouncer = announce.New(open, testingclock.NewFakeClock(time.Now()), func(*v1.VirtualMachineInstance) {
+				announced <- struct{}{}
+			})
+		})
+
+		It("should report the announcements once they are sent", func() {
+			controller.startMigrationAnnouncements(vmi)
+			Eventually(announced).Should(Receive())
+
+			controller.updateMigrationAnnouncementsStatus(vmi)
+			Expect(vmi.Status.MigrationState.TargetNetworkAnnouncements).To(Equal([]v1.MigrationNetworkAnnouncement{
+				{Name: "default", Rounds: 1, Succeeded: true},
+			}))
+		})
+
+		It("should not report the announcements on the source node", func() {
+			controller.startMigrationAnnouncements(vmi)
+			Eventually(announced).Should(Receive())
+
+			vmi.Status.MigrationState.TargetNode = "othernode"
+			controller.updateMigrationAnnouncementsStatus(vmi)
+			Expect(vmi.Status.MigrationState.TargetNetworkAnnouncements).To(BeNil())
+		})
+
+		It("should record an event when the annotation is invalid", func() {
+			vmi.Annotations[announce.Annotation] = `{"count": 100}`
+			controller.startMigrationAnnouncements(vmi)
+			testutils.ExpectEvent(recorder, "MigrationAnnouncements")
+		})
+	})
+
 	Context("Guest Agent Compatibility", func() {
 		var vmi *v1.VirtualMachineInstance
 		var vmiWithPassword *v1.VirtualMachineInstance
@@ -3549,6 +3960,10 @@ func (nc *netConfStub) Setup(vmi *v1.VirtualMachineInstance, _ []v1.Network, lau
 	return nil
 }
 
+func (nc *netConfStub) SetupFirewall(_ *v1.VirtualMachineInstance, _ int) error {
+	return nil
+}
+
 func (nc *netConfStub) Teardown(vmi *v1.VirtualMachineInstance) error {
 	nc.vmiUID = ""
 	return nil
@@ -3608,3 +4023,9 @@ func (smc *stubNetBindingPluginMemoryCalculator) Calculate(_ *v1.VirtualMachineI
 
 	return resource.Quantity{}
 }
+
+type frameWriterStub struct{}
+
+func (frameWriterStub) Write([]byte) error { return nil }
+
+func (frameWriterStub) Close() error { return nil }
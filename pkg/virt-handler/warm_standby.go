@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virthandler
+
+import (
+	"encoding/json"
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// the claim command runs in the sync loop of the VMI, it is expected to be short
+const claimCommandTimeoutSeconds = 10
+
+type guestExecFunc func(command string, args []string) (int, string, error)
+
+// updateWarmStandbyConditions runs the claim command of a claimed warm standby VMI once it is
+// unpaused, and reports its outcome in the WarmStandbyReconfigured condition. The command runs once.
+func (d *VirtualMachineController) updateWarmStandbyConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	command, exists := vmi.Annotations[v1.VirtualMachinePoolClaimCommandAnnotation]
+	if !exists || domain == nil || domain.Status.Status != api.Running ||
+		condManager.HasCondition(vmi, v1.VirtualMachineInstanceWarmStandbyReconfigured) ||
+		!condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceAgentConnected, k8sv1.ConditionTrue) {
+		return
+	}
+
+	condition := runClaimCommand(command, func(command string, args []string) (int, string, error) {
+		client, err := d.getLauncherClient(vmi)
+		if err != nil {
+			return -1, "", err
+		}
+		return client.Exec(api.VMINamespaceKeyFunc(vmi), command, args, claimCommandTimeoutSeconds)
+	})
+	vmi.Status.Conditions = append(vmi.Status.Conditions, condition)
+	if condition.Status == k8sv1.ConditionFalse {
+		d.recorder.Event(vmi, k8sv1.EventTypeWarning, condition.Reason, condition.Message)
+	}
+}
+
+// runClaimCommand runs the claim command, a JSON array, in the guest and returns the resulting WarmStandbyReconfigured condition.
+func runClaimCommand(command string, exec guestExecFunc) v1.VirtualMachineInstanceCondition {
+	condition := v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceWarmStandbyReconfigured,
+		Status:             k8sv1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             v1.VirtualMachineInstanceReasonClaimCommandFailed,
+	}
+
+	var argv []string
+	if err := json.Unmarshal([]byte(command), &argv); err != nil || len(argv) == 0 || argv[0] == "" {
+		condition.Message = fmt.Sprintf("Invalid claim command %s", command)
+		return condition
+	}
+
+	exitCode, stdOut, err := exec(argv[0], argv[1:])
+	switch {
+	case err != nil:
+		condition.Message = fmt.Sprintf("Failed to run the claim command %s: %v", argv[0], err)
+	case exitCode != 0:
+		condition.Message = fmt.Sprintf("The claim command %s exited with code %d: %s", argv[0], exitCode, stdOut)
+	default:
+		condition.Status = k8sv1.ConditionTrue
+		condition.Reason = v1.VirtualMachineInstanceReasonClaimCommandSucceeded
+		condition.Message = fmt.Sprintf("The claim command %s succeeded", argv[0])
+	}
+	return condition
+}
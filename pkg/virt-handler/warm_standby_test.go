@@ -0,0 +1,67 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Warm standby claim command", func() {
+	It("should run the claim command with its arguments", func() {
+		var ranCommand string
+		var ranArgs []string
+		condition := runClaimCommand(`["/usr/local/bin/claimed.sh","--hostname","vm-1"]`, func(command string, args []string) (int, string, error) {
+			ranCommand, ranArgs = command, args
+			return 0, "", nil
+		})
+
+		Expect(ranCommand).To(Equal("/usr/local/bin/claimed.sh"))
+		Expect(ranArgs).To(Equal([]string{"--hostname", "vm-1"}))
+		Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceWarmStandbyReconfigured))
+		Expect(condition.Status).To(Equal(k8sv1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonClaimCommandSucceeded))
+	})
+
+	DescribeTable("should report a failed claim command", func(command string, exitCode int, err error, message string) {
+		condition := runClaimCommand(command, func(string, []string) (int, string, error) {
+			return exitCode, "no such interface", err
+		})
+
+		Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonClaimCommandFailed))
+		Expect(condition.Message).To(Equal(message))
+	},
+		Entry("which is not a JSON array", `/usr/local/bin/claimed.sh`, 0, nil,
+			"Invalid claim command /usr/local/bin/claimed.sh"),
+		Entry("which is empty", `[]`, 0, nil,
+			"Invalid claim command []"),
+		Entry("which can't be run", `["/usr/local/bin/claimed.sh"]`, -1, fmt.Errorf("guest agent command exec is denied"),
+			"Failed to run the claim command /usr/local/bin/claimed.sh: guest agent command exec is denied"),
+		Entry("which exits with an error", `["/usr/local/bin/claimed.sh"]`, 2, nil,
+			"The claim command /usr/local/bin/claimed.sh exited with code 2: no such interface"),
+	)
+})
@@ -0,0 +1,154 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	// windowsGuestOSID is the ID the guest agent reports for the Windows guests
+	windowsGuestOSID = "mswindows"
+
+	// windowsUpdatesCheckInterval is the interval the Windows guests are checked for pending updates at
+	windowsUpdatesCheckInterval = 5 * time.Minute
+
+	// the check runs in the sync loop of the VMI, it is expected to be short
+	windowsUpdatesCheckTimeoutSeconds = 15
+
+	windowsUpdatesInstalling      = "Installing"
+	windowsUpdatesRestartRequired = "RestartRequired"
+	windowsUpdatesNone            = "None"
+)
+
+// windowsUpdatesScript reports whether the Windows Modules Installer is installing updates, or whether
+// Windows Update or the component servicing require a restart to complete their installation
+var windowsUpdatesScript = strings.Join([]string{
+	"if (Get-Process -Name TiWorker -ErrorAction SilentlyContinue) { '" + windowsUpdatesInstalling + "' }",
+	"elseif ((Test-Path 'HKLM:/SOFTWARE/Microsoft/Windows/CurrentVersion/WindowsUpdate/Auto Update/RebootRequired') -or",
+	"(Test-Path 'HKLM:/SOFTWARE/Microsoft/Windows/CurrentVersion/Component Based Servicing/RebootPending')) { '" + windowsUpdatesRestartRequired + "' }",
+	"else { '" + windowsUpdatesNone + "' }",
+}, " ")
+
+func windowsUpdatesAction(vmi *v1.VirtualMachineInstance) v1.WindowsUpdatesAction {
+	if vmi.Spec.ShutdownPolicy == nil || vmi.Spec.ShutdownPolicy.WindowsUpdates == "" {
+		return v1.WindowsUpdatesForce
+	}
+	return vmi.Spec.ShutdownPolicy.WindowsUpdates
+}
+
+// hasPendingWindowsUpdates returns true when the shutdown of the VMI waits for the Windows updates pending in its guest
+func hasPendingWindowsUpdates(vmi *v1.VirtualMachineInstance) bool {
+	return windowsUpdatesAction(vmi) != v1.WindowsUpdatesForce &&
+		controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, v1.VirtualMachineInstanceWindowsUpdatesPending, k8sv1.ConditionTrue)
+}
+
+// updateWindowsUpdatesCondition checks the running Windows guest of a VMI whose shutdown policy doesn't ignore the
+// pending Windows updates, at most every windowsUpdatesCheckInterval, and reports them in the WindowsUpdatesPending condition.
+func (d *VirtualMachineController) updateWindowsUpdatesCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	action := windowsUpdatesAction(vmi)
+	if action == v1.WindowsUpdatesForce {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceWindowsUpdatesPending)
+		return
+	}
+	if domain == nil || domain.Status.Status != api.Running || vmi.Status.GuestOSInfo.ID != windowsGuestOSID ||
+		!condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceAgentConnected, k8sv1.ConditionTrue) {
+		return
+	}
+	if condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceWindowsUpdatesPending); condition != nil &&
+		time.Since(condition.LastProbeTime.Time) < windowsUpdatesCheckInterval {
+		return
+	}
+
+	condition := checkWindowsUpdates(action, func(command string, args []string) (int, string, error) {
+		client, err := d.getLauncherClient(vmi)
+		if err != nil {
+			return -1, "", err
+		}
+		return client.Exec(api.VMINamespaceKeyFunc(vmi), command, args, windowsUpdatesCheckTimeoutSeconds)
+	})
+	setWindowsUpdatesCondition(vmi, condition)
+	d.queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), windowsUpdatesCheckInterval)
+}
+
+// checkWindowsUpdates runs the check of the pending Windows updates in the guest and returns the resulting WindowsUpdatesPending condition
+func checkWindowsUpdates(action v1.WindowsUpdatesAction, exec guestExecFunc) v1.VirtualMachineInstanceCondition {
+	now := metav1.Now()
+	condition := v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceWindowsUpdatesPending,
+		Status:             k8sv1.ConditionUnknown,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+		Reason:             v1.VirtualMachineInstanceReasonWindowsUpdatesCheckFailed,
+	}
+
+	wait := "the shutdown of the VMI waits for them"
+	if action == v1.WindowsUpdatesReschedule {
+		wait = "the evictions of the VMI are refused and the shutdown of the VMI waits for them"
+	}
+
+	exitCode, stdOut, err := exec("powershell.exe", []string{"-NoProfile", "-NonInteractive", "-Command", windowsUpdatesScript})
+	switch state := strings.TrimSpace(stdOut); {
+	case err != nil:
+		condition.Message = fmt.Sprintf("Failed to check the guest for pending Windows updates: %v", err)
+	case exitCode != 0:
+		condition.Message = fmt.Sprintf("The check of the pending Windows updates exited with code %d: %s", exitCode, state)
+	case state == windowsUpdatesInstalling:
+		condition.Status = k8sv1.ConditionTrue
+		condition.Reason = v1.VirtualMachineInstanceReasonWindowsUpdatesInstalling
+		condition.Message = "Windows updates are being installed in the guest, " + wait
+	case state == windowsUpdatesRestartRequired:
+		condition.Status = k8sv1.ConditionTrue
+		condition.Reason = v1.VirtualMachineInstanceReasonWindowsRestartRequired
+		condition.Message = "Windows updates are installed on the restart of the guest, " + wait
+	case state == windowsUpdatesNone:
+		condition.Status = k8sv1.ConditionFalse
+		condition.Reason = v1.VirtualMachineInstanceReasonNoWindowsUpdatesPending
+	default:
+		condition.Message = fmt.Sprintf("Unexpected output of the check of the pending Windows updates: %s", state)
+	}
+	return condition
+}
+
+// setWindowsUpdatesCondition sets the WindowsUpdatesPending condition, keeping its transition time while its status doesn't change
+func setWindowsUpdatesCondition(vmi *v1.VirtualMachineInstance, condition v1.VirtualMachineInstanceCondition) {
+	for i := range vmi.Status.Conditions {
+		existing := &vmi.Status.Conditions[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		*existing = condition
+		return
+	}
+	vmi.Status.Conditions = append(vmi.Status.Conditions, condition)
+}
@@ -0,0 +1,124 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+package virthandler
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+)
+
+var _ = Describe("Windows updates", func() {
+	It("should check the pending updates with PowerShell", func() {
+		var ranCommand string
+		var ranArgs []string
+		checkWindowsUpdates(v1.WindowsUpdatesWait, func(command string, args []string) (int, string, error) {
+			ranCommand, ranArgs = command, args
+			return 0, "None\r\n", nil
+		})
+
+		Expect(ranCommand).To(Equal("powershell.exe"))
+		Expect(ranArgs).To(Equal([]string{"-NoProfile", "-NonInteractive", "-Command", windowsUpdatesScript}))
+	})
+
+	DescribeTable("should report the pending updates", func(action v1.WindowsUpdatesAction, exitCode int, stdOut string, err error,
+		status k8sv1.ConditionStatus, reason, message string) {
+		condition := checkWindowsUpdates(action, func(string, []string) (int, string, error) {
+			return exitCode, stdOut, err
+		})
+
+		Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceWindowsUpdatesPending))
+		Expect(condition.Status).To(Equal(status))
+		Expect(condition.Reason).To(Equal(reason))
+		Expect(condition.Message).To(Equal(message))
+		Expect(condition.LastProbeTime.IsZero()).To(BeFalse())
+	},
+		Entry("being installed", v1.WindowsUpdatesWait, 0, "Installing\r\n", nil,
+			k8sv1.ConditionTrue, v1.VirtualMachineInstanceReasonWindowsUpdatesInstalling,
+			"Windows updates are being installed in the guest, the shutdown of the VMI waits for them"),
+		Entry("requiring a restart", v1.WindowsUpdatesReschedule, 0, "RestartRequired\r\n", nil,
+			k8sv1.ConditionTrue, v1.VirtualMachineInstanceReasonWindowsRestartRequired,
+			"Windows updates are installed on the restart of the guest, the evictions of the VMI are refused and the shutdown of the VMI waits for them"),
+		Entry("without any", v1.WindowsUpdatesWait, 0, "None\r\n", nil,
+			k8sv1.ConditionFalse, v1.VirtualMachineInstanceReasonNoWindowsUpdatesPending, ""),
+		Entry("when the check can't be run", v1.WindowsUpdatesWait, -1, "", fmt.Errorf("guest agent command exec is denied"),
+			k8sv1.ConditionUnknown, v1.VirtualMachineInstanceReasonWindowsUpdatesCheckFailed,
+			"Failed to check the guest for pending Windows updates: guest agent command exec is denied"),
+		Entry("when the check fails", v1.WindowsUpdatesWait, 1, "access denied", nil,
+			k8sv1.ConditionUnknown, v1.VirtualMachineInstanceReasonWindowsUpdatesCheckFailed,
+			"The check of the pending Windows updates exited with code 1: access denied"),
+		Entry("when the check returns garbage", v1.WindowsUpdatesWait, 0, "Maybe", nil,
+			k8sv1.ConditionUnknown, v1.VirtualMachineInstanceReasonWindowsUpdatesCheckFailed,
+			"Unexpected output of the check of the pending Windows updates: Maybe"),
+	)
+
+	It("should keep the transition time of the condition while its status doesn't change", func() {
+		transition := metav1.NewTime(time.Now().Add(-time.Hour))
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:               v1.VirtualMachineInstanceWindowsUpdatesPending,
+			Status:             k8sv1.ConditionTrue,
+			Reason:             v1.VirtualMachineInstanceReasonWindowsUpdatesInstalling,
+			LastTransitionTime: transition,
+		}}
+
+		setWindowsUpdatesCondition(vmi, v1.VirtualMachineInstanceCondition{
+			Type:               v1.VirtualMachineInstanceWindowsUpdatesPending,
+			Status:             k8sv1.ConditionTrue,
+			Reason:             v1.VirtualMachineInstanceReasonWindowsRestartRequired,
+			LastProbeTime:      metav1.Now(),
+			LastTransitionTime: metav1.Now(),
+		})
+		Expect(vmi.Status.Conditions).To(HaveLen(1))
+		Expect(vmi.Status.Conditions[0].Reason).To(Equal(v1.VirtualMachineInstanceReasonWindowsRestartRequired))
+		Expect(vmi.Status.Conditions[0].LastTransitionTime).To(Equal(transition))
+
+		setWindowsUpdatesCondition(vmi, v1.VirtualMachineInstanceCondition{
+			Type:               v1.VirtualMachineInstanceWindowsUpdatesPending,
+			Status:             k8sv1.ConditionFalse,
+			Reason:             v1.VirtualMachineInstanceReasonNoWindowsUpdatesPending,
+			LastTransitionTime: metav1.Now(),
+		})
+		Expect(vmi.Status.Conditions[0].LastTransitionTime).ToNot(Equal(transition))
+	})
+
+	DescribeTable("should wait for the pending updates", func(action v1.WindowsUpdatesAction, status k8sv1.ConditionStatus, expected bool) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.ShutdownPolicy = &v1.ShutdownPolicy{WindowsUpdates: action}
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:   v1.VirtualMachineInstanceWindowsUpdatesPending,
+			Status: status,
+		}}
+		Expect(hasPendingWindowsUpdates(vmi)).To(Equal(expected))
+	},
+		Entry("with Wait", v1.WindowsUpdatesWait, k8sv1.ConditionTrue, true),
+		Entry("with Reschedule", v1.WindowsUpdatesReschedule, k8sv1.ConditionTrue, true),
+		Entry("but not with Force", v1.WindowsUpdatesForce, k8sv1.ConditionTrue, false),
+		Entry("but not by default", v1.WindowsUpdatesAction(""), k8sv1.ConditionTrue, false),
+		Entry("but not without pending updates", v1.WindowsUpdatesWait, k8sv1.ConditionFalse, false),
+	)
+})
@@ -31,6 +31,10 @@ type Cache struct {
 	GracePeriod      SafeData[api.GracePeriodMetadata]
 	AccessCredential SafeData[api.AccessCredentialMetadata]
 	MemoryDump       SafeData[api.MemoryDumpMetadata]
+	GuestBootHooks   SafeData[api.GuestBootHooksMetadata]
+	// Flatten holds a list, which is not comparable: it is replaced rather than modified,
+	// for the changes to be detected.
+	Flatten SafeData[*api.FlattenMetadata]
 
 	notificationSignal chan struct{}
 }
@@ -44,6 +48,8 @@ func NewCache() *Cache {
 	cache.GracePeriod.dirtyChanel = cache.notificationSignal
 	cache.AccessCredential.dirtyChanel = cache.notificationSignal
 	cache.MemoryDump.dirtyChanel = cache.notificationSignal
+	cache.GuestBootHooks.dirtyChanel = cache.notificationSignal
+	cache.Flatten.dirtyChanel = cache.notificationSignal
 	return cache
 }
 
@@ -44,5 +44,37 @@ func LoadKubevirtMetadata(metadataCache *Cache) api.KubeVirtMetadata {
 	if value, exists := metadataCache.MemoryDump.Load(); exists {
 		kubevirtMetadata.MemoryDump = &value
 	}
+	if value, exists := metadataCache.GuestBootHooks.Load(); exists {
+		kubevirtMetadata.GuestBootHooks = &value
+	}
+	if value, exists := metadataCache.Flatten.Load(); exists {
+		kubevirtMetadata.Flatten = value
+	}
 	return kubevirtMetadata
 }
+
+// SetKubevirtMetadata sets all KubeVirt metadata, without notifying the listeners of the cache.
+// It is the counterpart of LoadKubevirtMetadata, the data which is missing is left untouched.
+func SetKubevirtMetadata(metadataCache *Cache, kubevirtMetadata api.KubeVirtMetadata) {
+	if kubevirtMetadata.UID != "" {
+		metadataCache.UID.Set(kubevirtMetadata.UID)
+	}
+	if kubevirtMetadata.GracePeriod != nil {
+		metadataCache.GracePeriod.Set(*kubevirtMetadata.GracePeriod)
+	}
+	if kubevirtMetadata.Migration != nil {
+		metadataCache.Migration.Set(*kubevirtMetadata.Migration)
+	}
+	if kubevirtMetadata.AccessCredential != nil {
+		metadataCache.AccessCredential.Set(*kubevirtMetadata.AccessCredential)
+	}
+	if kubevirtMetadata.MemoryDump != nil {
+		metadataCache.MemoryDump.Set(*kubevirtMetadata.MemoryDump)
+	}
+	if kubevirtMetadata.GuestBootHooks != nil {
+		metadataCache.GuestBootHooks.Set(*kubevirtMetadata.GuestBootHooks)
+	}
+	if kubevirtMetadata.Flatten != nil {
+		metadataCache.Flatten.Set(kubevirtMetadata.Flatten)
+	}
+}
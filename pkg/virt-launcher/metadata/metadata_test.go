@@ -132,4 +132,18 @@ var _ = Describe("Metadata", func() {
 
 		Expect(metadataCache.Listen()).ShouldNot(Receive())
 	})
+
+	It("Set the whole KubeVirt metadata loaded from another cache", func() {
+		origCache := metadata.NewCache()
+		origCache.UID.Set("123")
+		origCache.GracePeriod.Set(api.GracePeriodMetadata{DeletionGracePeriodSeconds: 30})
+		origCache.Migration.Set(api.MigrationMetadata{FailureReason: "test123"})
+
+		metadata.SetKubevirtMetadata(metadataCache, metadata.LoadKubevirtMetadata(origCache))
+
+		Expect(metadata.LoadKubevirtMetadata(metadataCache)).To(Equal(metadata.LoadKubevirtMetadata(origCache)))
+		_, exists := metadataCache.MemoryDump.Load()
+		Expect(exists).To(BeFalse())
+		Expect(metadataCache.Listen()).ShouldNot(Receive())
+	})
 })
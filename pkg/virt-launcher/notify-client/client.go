@@ -57,6 +57,8 @@ type Notifier struct {
 	intervalTimeout time.Duration
 	sendTimeout     time.Duration
 	totalTimeout    time.Duration
+
+	announceRunningDomain bool
 }
 
 type libvirtEvent struct {
@@ -240,7 +242,7 @@ func newWatchEventError(err error) watch.Event {
 
 func eventCallback(c cli.Connection, domain *api.Domain, libvirtEvent libvirtEvent, client *Notifier, events chan watch.Event,
 	interfaceStatus []api.InterfaceStatus, osInfo *api.GuestOSInfo, vmi *v1.VirtualMachineInstance, fsFreezeStatus *api.FSFreeze,
-	metadataCache *metadata.Cache) {
+	guestDNS *api.GuestDNS, metadataCache *metadata.Cache) {
 
 	d, err := c.LookupDomainByName(util.DomainFromNamespaceName(domain.ObjectMeta.Namespace, domain.ObjectMeta.Name))
 	if err != nil {
@@ -351,6 +353,10 @@ func eventCallback(c cli.Connection, domain *api.Domain, libvirtEvent libvirtEve
 			domain.Status.FSFreezeStatus = *fsFreezeStatus
 		}
 
+		if guestDNS != nil {
+			domain.Status.GuestDNS = *guestDNS
+		}
+
 		err := client.SendDomainEvent(watch.Event{Type: watch.Modified, Object: domain})
 		if err != nil {
 			log.Log.Reason(err).Error("Could not send domain notify event.")
@@ -414,12 +420,13 @@ func (n *Notifier) StartDomainNotifier(
 		var interfaceStatuses []api.InterfaceStatus
 		var guestOsInfo *api.GuestOSInfo
 		var fsFreezeStatus *api.FSFreeze
+		var guestDNS *api.GuestDNS
 		for {
 			select {
 			case event := <-eventChan:
 				metadataCache.ResetNotification()
 				domainCache = util.NewDomainFromName(event.Domain, vmi.UID)
-				eventCallback(domainConn, domainCache, event, n, deleteNotificationSent, interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, metadataCache)
+				eventCallback(domainConn, domainCache, event, n, deleteNotificationSent, interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, guestDNS, metadataCache)
 				log.Log.Infof("Domain name event: %v", domainCache.Spec.Name)
 				if event.AgentEvent != nil {
 					if event.AgentEvent.State == libvirt.CONNECT_DOMAIN_EVENT_AGENT_LIFECYCLE_STATE_CONNECTED {
@@ -433,9 +440,10 @@ func (n *Notifier) StartDomainNotifier(
 				interfaceStatuses = agentUpdate.DomainInfo.Interfaces
 				guestOsInfo = agentUpdate.DomainInfo.OSInfo
 				fsFreezeStatus = agentUpdate.DomainInfo.FSFreezeStatus
+				guestDNS = agentUpdate.DomainInfo.GuestDNS
 
 				eventCallback(domainConn, domainCache, libvirtEvent{}, n, deleteNotificationSent,
-					interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, metadataCache)
+					interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, guestDNS, metadataCache)
 			case <-reconnectChan:
 				n.SendDomainEvent(newWatchEventError(fmt.Errorf("Libvirt reconnect, domain %s", domainName)))
 
@@ -457,6 +465,7 @@ func (n *Notifier) StartDomainNotifier(
 						guestOsInfo,
 						vmi,
 						fsFreezeStatus,
+						guestDNS,
 						metadataCache,
 					)
 				}
@@ -560,9 +569,41 @@ func (n *Notifier) StartDomainNotifier(
 	}
 
 	log.Log.Infof("Registered libvirt event notify callback")
+
+	if n.announceRunningDomain {
+		announceRunningDomain(domainConn, domainName, eventChan)
+	}
 	return nil
 }
 
+// AnnounceRunningDomain makes the domain notifier report the domain which already runs when it
+// starts, e.g. after virt-launcher reattached to it: no libvirt event announces it.
+func (n *Notifier) AnnounceRunningDomain() {
+	n.announceRunningDomain = true
+}
+
+func announceRunningDomain(domainConn cli.Connection, domainName string, eventChan chan libvirtEvent) {
+	dom, err := domainConn.LookupDomainByName(domainName)
+	if err != nil {
+		if !domainerrors.IsNotFound(err) {
+			log.Log.Reason(err).Error("Could not fetch the running Domain.")
+		}
+		return
+	}
+	defer dom.Free()
+
+	// The guest agent poller only starts on a connection of the guest agent
+	state := libvirt.CONNECT_DOMAIN_EVENT_AGENT_LIFECYCLE_STATE_DISCONNECTED
+	if spec, err := util.GetDomainSpecWithFlags(dom, 0); err == nil {
+		for _, channel := range spec.Devices.Channels {
+			if channel.Target != nil && channel.Target.Name == "org.qemu.guest_agent.0" && channel.Target.State == "connected" {
+				state = libvirt.CONNECT_DOMAIN_EVENT_AGENT_LIFECYCLE_STATE_CONNECTED
+			}
+		}
+	}
+	eventChan <- libvirtEvent{AgentEvent: &libvirt.DomainEventAgentLifecycle{State: state}, Domain: domainName}
+}
+
 func (n *Notifier) SendK8sEvent(vmi *v1.VirtualMachineInstance, severity string, reason string, message string) error {
 	vmiRef, err := reference.GetReference(scheme, vmi)
 	if err != nil {
@@ -25,15 +25,19 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "kubevirt.io/api/core/v1"
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/config"
+	"kubevirt.io/kubevirt/pkg/identitydocument"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -68,14 +72,19 @@ type AccessCredentialManager struct {
 
 	domainModifyLock *sync.Mutex
 	metadataCache    *metadata.Cache
+	agentPolicy      *agent.CommandPolicy
+
+	// time the last changed credentials were applied, only accessed by the secret watcher
+	syncTimestamp *metav1.Time
 }
 
-func NewManager(connection cli.Connection, domainModifyLock *sync.Mutex, metadataCache *metadata.Cache) *AccessCredentialManager {
+func NewManager(connection cli.Connection, domainModifyLock *sync.Mutex, metadataCache *metadata.Cache, agentPolicy *agent.CommandPolicy) *AccessCredentialManager {
 	return &AccessCredentialManager{
 		virConn:                    connection,
 		resyncCheckIntervalSeconds: 15,
 		domainModifyLock:           domainModifyLock,
 		metadataCache:              metadataCache,
+		agentPolicy:                agentPolicy,
 	}
 }
 
@@ -102,9 +111,21 @@ func getSecretDirs(vmi *v1.VirtualMachineInstance) []string {
 		dirs = append(dirs, getSecretDir(secretName))
 	}
 
+	if hasGuestAgentIdentityDocument(vmi) {
+		dirs = append(dirs, getIdentityDocumentDir())
+	}
+
 	return dirs
 }
 
+func hasGuestAgentIdentityDocument(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.IdentityDocument != nil && vmi.Spec.IdentityDocument.GuestAgentPath != ""
+}
+
+func getIdentityDocumentDir() string {
+	return filepath.Join(getSecretBaseDir(), identitydocument.VolumeName)
+}
+
 func getSecretDir(secretName string) string {
 	return filepath.Join(getSecretBaseDir(), secretName+"-access-cred")
 }
@@ -120,6 +141,9 @@ func getSecretBaseDir() string {
 }
 
 func (l *AccessCredentialManager) writeGuestFile(contents string, domName string, filePath string, owner string, fileExists bool) error {
+	if err := l.agentPolicy.Check(guestagentv1alpha1.GuestAgentCommandFileWrite); err != nil {
+		return err
+	}
 
 	// ensure the directory exists with the correct permissions
 	err := l.agentCreateDirectory(domName, filepath.Dir(filePath), "700", owner)
@@ -213,6 +237,9 @@ func (l *AccessCredentialManager) readGuestFile(domName string, filePath string)
 }
 
 func (l *AccessCredentialManager) agentGuestExec(domName string, command string, args []string) (string, error) {
+	if err := l.agentPolicy.Check(guestagentv1alpha1.GuestAgentCommandExec); err != nil {
+		return "", err
+	}
 	return agent.GuestExec(l.virConn, domName, command, args, 10)
 }
 
@@ -285,6 +312,9 @@ func (l *AccessCredentialManager) agentSetFilePermissions(domName string, filePa
 }
 
 func (l *AccessCredentialManager) agentSetUserPassword(domName string, user string, password string) error {
+	if err := l.agentPolicy.Check(guestagentv1alpha1.GuestAgentCommandSetUserPassword); err != nil {
+		return err
+	}
 
 	base64Str := base64.StdEncoding.EncodeToString([]byte(password))
 
@@ -305,6 +335,10 @@ func (l *AccessCredentialManager) pingAgent(domName string) error {
 }
 
 func (l *AccessCredentialManager) agentSetAuthorizedKeys(domName string, user string, authorizedKeys []string) error {
+	// A denied command must not fall back to writing the authorized_keys file
+	if err := l.agentPolicy.Check(guestagentv1alpha1.GuestAgentCommandSSHAuthorizedKeys); err != nil {
+		return err
+	}
 	err := func() error {
 		domain, err := l.virConn.LookupDomainByName(domName)
 		if err != nil {
@@ -372,6 +406,25 @@ func (l *AccessCredentialManager) agentWriteAuthorizedKeysFile(domName string, u
 	return nil
 }
 
+// agentWriteIdentityDocument writes the identity document to the given path of the guest, unless
+// the guest already has the current document.
+func (l *AccessCredentialManager) agentWriteIdentityDocument(domName string, filePath string, document string) error {
+	fileExists := true
+
+	curDocument, err := l.readGuestFile(domName, filePath)
+	if err != nil && strings.Contains(err.Error(), "No such file or directory") {
+		fileExists = false
+	} else if err != nil {
+		return err
+	}
+
+	if curDocument == document {
+		return nil
+	}
+
+	return l.writeGuestFile(document, domName, filePath, "root:root", fileExists)
+}
+
 func isSSHPublicKey(accessCred *v1.AccessCredential) bool {
 	if accessCred.SSHPublicKey != nil && accessCred.SSHPublicKey.PropagationMethod.QemuGuestAgent != nil {
 		return true
@@ -406,8 +459,9 @@ func getSecret(accessCred *v1.AccessCredential) string {
 
 func (l *AccessCredentialManager) reportAccessCredentialResult(succeeded bool, message string) {
 	acMetadata := api.AccessCredentialMetadata{
-		Succeeded: succeeded,
-		Message:   message,
+		Succeeded:     succeeded,
+		Message:       message,
+		SyncTimestamp: l.syncTimestamp,
 	}
 	l.metadataCache.AccessCredential.Store(acMetadata)
 	log.Log.V(4).Infof("Access credential set in metadata: %v", acMetadata)
@@ -419,6 +473,7 @@ func (l *AccessCredentialManager) watchSecrets(vmi *v1.VirtualMachineInstance) {
 
 	reload := true
 	fileChangeDetected := true
+	var appliedCredentialInfo *accessCredentialsInfo
 
 	domName := util.VMINamespaceKeyFunc(vmi)
 
@@ -481,6 +536,14 @@ func (l *AccessCredentialManager) watchSecrets(vmi *v1.VirtualMachineInstance) {
 				l.reportAccessCredentialResult(false, err.Error())
 			}
 		}
+		if hasGuestAgentIdentityDocument(vmi) {
+			if err := credentialInfo.addIdentityDocument(); err != nil {
+				reload = true
+				reportedErr = true
+				logger.Reason(err).Errorf("Error encountered")
+				l.reportAccessCredentialResult(false, err.Error())
+			}
+		}
 
 		// Step 2. Update Authorized keys
 		for user, secretNames := range credentialInfo.userSSHMap {
@@ -514,7 +577,25 @@ func (l *AccessCredentialManager) watchSecrets(vmi *v1.VirtualMachineInstance) {
 				continue
 			}
 		}
+
+		// Step 4. update the identity document
+		if credentialInfo.identityDocument != "" {
+			filePath := vmi.Spec.IdentityDocument.GuestAgentPath
+			err := l.agentWriteIdentityDocument(domName, filePath, credentialInfo.identityDocument)
+			if err != nil {
+				// if writing failed, reset reload to true so this will be tried again
+				reload = true
+				reportedErr = true
+				logger.Reason(err).Errorf("Error encountered writing the identity document to [%s]", filePath)
+				l.reportAccessCredentialResult(false, fmt.Sprintf("Error encountered writing the identity document to [%s]: %v", filePath, err))
+			}
+		}
 		if !reportedErr {
+			if !reflect.DeepEqual(appliedCredentialInfo, credentialInfo) {
+				now := metav1.Now()
+				l.syncTimestamp = &now
+				appliedCredentialInfo = credentialInfo
+			}
 			l.reportAccessCredentialResult(true, "")
 		}
 	}
@@ -587,6 +668,8 @@ type accessCredentialsInfo struct {
 	userSSHMap map[string][]string
 	// maps users to passwords
 	userPasswordMap map[string]string
+	// the identity document to write to the guest
+	identityDocument string
 }
 
 func (a *accessCredentialsInfo) addAccessCredential(accessCred *v1.AccessCredential) error {
@@ -653,6 +736,16 @@ func (a *accessCredentialsInfo) addAccessCredential(accessCred *v1.AccessCredent
 	return nil
 }
 
+func (a *accessCredentialsInfo) addIdentityDocument() error {
+	documentPath := filepath.Join(getIdentityDocumentDir(), identitydocument.DocumentSecretKey)
+	document, err := os.ReadFile(documentPath)
+	if err != nil {
+		return fmt.Errorf("error occurred while reading the identity document [%s]: %w", documentPath, err)
+	}
+	a.identityDocument = string(document)
+	return nil
+}
+
 func newAccessCredentialsInfo() *accessCredentialsInfo {
 	return &accessCredentialsInfo{
 		secretMap:       make(map[string][]string),
@@ -42,8 +42,10 @@ import (
 	"libvirt.org/go/libvirt"
 
 	v1 "kubevirt.io/api/core/v1"
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
 
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
@@ -63,7 +65,7 @@ var _ = Describe("AccessCredentials", func() {
 		mockConn = cli.NewMockConnection(ctrl)
 		mockDomain = cli.NewMockVirDomain(ctrl)
 
-		manager = NewManager(mockConn, &lock, metadata.NewCache())
+		manager = NewManager(mockConn, &lock, metadata.NewCache(), nil)
 		manager.resyncCheckIntervalSeconds = 1
 		tmpDir, err = os.MkdirTemp("", "credential-test")
 		Expect(err).ToNot(HaveOccurred())
@@ -113,6 +115,33 @@ var _ = Describe("AccessCredentials", func() {
 		Expect(manager.agentSetUserPassword(domName, user, password)).To(Succeed())
 	})
 
+	Context("with a guest agent policy", func() {
+		BeforeEach(func() {
+			manager.agentPolicy = agent.NewCommandPolicy()
+		})
+
+		It("should not set the password when SetUserPassword is denied", func() {
+			manager.agentPolicy.SetDenied([]string{string(guestagentv1alpha1.GuestAgentCommandSetUserPassword)})
+
+			err := manager.agentSetUserPassword("some-domain", "myuser", "1234")
+			Expect(err).To(MatchError(agent.CommandDeniedError{Command: guestagentv1alpha1.GuestAgentCommandSetUserPassword}))
+		})
+
+		It("should not fall back to writing the authorized_keys file when SSHAuthorizedKeys is denied", func() {
+			manager.agentPolicy.SetDenied([]string{string(guestagentv1alpha1.GuestAgentCommandSSHAuthorizedKeys)})
+
+			err := manager.agentSetAuthorizedKeys("some-domain", "someowner", []string{"ssh some injected key"})
+			Expect(err).To(MatchError(agent.CommandDeniedError{Command: guestagentv1alpha1.GuestAgentCommandSSHAuthorizedKeys}))
+		})
+
+		It("should not write guest files when FileWrite is denied", func() {
+			manager.agentPolicy.SetDenied([]string{string(guestagentv1alpha1.GuestAgentCommandFileWrite)})
+
+			err := manager.writeGuestFile("ssh some injected key", "some-domain", "/home/someowner/.ssh/authorized_keys", "someowner", false)
+			Expect(err).To(MatchError(agent.CommandDeniedError{Command: guestagentv1alpha1.GuestAgentCommandFileWrite}))
+		})
+	})
+
 	It("should handle dynamically updating ssh key with qemu agent", func() {
 		domName := "some-domain"
 		user := "someowner"
@@ -225,6 +254,47 @@ var _ = Describe("AccessCredentials", func() {
 			To(MatchError(ContainSubstring("failed to set SSH keys")))
 	})
 
+	Context("with an identity document", func() {
+		It("should watch the identity document only when it is written by the guest agent", func() {
+			vmi := &v1.VirtualMachineInstance{}
+			vmi.Spec.IdentityDocument = &v1.IdentityDocument{}
+			Expect(getSecretDirs(vmi)).To(BeEmpty())
+
+			vmi.Spec.IdentityDocument.GuestAgentPath = "/run/kubevirt/identity-document"
+			Expect(getSecretDirs(vmi)).To(ConsistOf(filepath.Join(tmpDir, "identity-document")))
+		})
+
+		It("should not rewrite an identity document the guest already has", func() {
+			domName := "some-domain"
+			filePath := "/run/kubevirt/identity-document"
+			document := `{"document":"doc","signature":"sig"}`
+
+			expectedOpenCmd := fmt.Sprintf(`{"execute": "guest-file-open", "arguments": { "path": "%s", "mode":"r" } }`, filePath)
+			expectedReadCmd := `{"execute": "guest-file-read", "arguments": { "handle": 1000 } }`
+			expectedReadCmdRes := fmt.Sprintf(`{"return":{"count":%d,"buf-b64": "%s"}}`, len(document), base64.StdEncoding.EncodeToString([]byte(document)))
+			expectedCloseCmd := `{"execute": "guest-file-close", "arguments": { "handle": 1000 } }`
+
+			mockConn.EXPECT().QemuAgentCommand(expectedOpenCmd, domName).Return(`{"return":1000}`, nil).Times(1)
+			mockConn.EXPECT().QemuAgentCommand(expectedReadCmd, domName).Return(expectedReadCmdRes, nil).Times(1)
+			mockConn.EXPECT().QemuAgentCommand(expectedCloseCmd, domName).Return("", nil).Times(1)
+
+			Expect(manager.agentWriteIdentityDocument(domName, filePath, document)).To(Succeed())
+		})
+
+		It("should fail to write the identity document when FileWrite is denied", func() {
+			domName := "some-domain"
+			filePath := "/run/kubevirt/identity-document"
+			manager.agentPolicy = agent.NewCommandPolicy()
+			manager.agentPolicy.SetDenied([]string{string(guestagentv1alpha1.GuestAgentCommandFileWrite)})
+
+			expectedOpenCmd := fmt.Sprintf(`{"execute": "guest-file-open", "arguments": { "path": "%s", "mode":"r" } }`, filePath)
+			mockConn.EXPECT().QemuAgentCommand(expectedOpenCmd, domName).Return("", fmt.Errorf("No such file or directory")).Times(1)
+
+			err := manager.agentWriteIdentityDocument(domName, filePath, "some document")
+			Expect(err).To(MatchError(agent.CommandDeniedError{Command: guestagentv1alpha1.GuestAgentCommandFileWrite}))
+		})
+	})
+
 	It("should support multiple ssh keys in one secret value", func() {
 		secretID := "some-secret-123"
 		user := "fakeuser"
@@ -366,6 +436,12 @@ var _ = Describe("AccessCredentials", func() {
 		manager.watchSecrets(vmi)
 		Expect(matched).To(BeTrue())
 
+		acMetadata, exists := manager.metadataCache.AccessCredential.Load()
+		Expect(exists).To(BeTrue())
+		Expect(acMetadata.Succeeded).To(BeTrue())
+		Expect(acMetadata.SyncTimestamp).ToNot(BeNil())
+		firstSyncTimestamp := *acMetadata.SyncTimestamp
+
 		// And wait again after modifying file
 		// Another execute command should occur with the updated password
 		matched = false
@@ -384,6 +460,10 @@ var _ = Describe("AccessCredentials", func() {
 		}()
 
 		manager.watchSecrets(vmi)
+
+		acMetadata, _ = manager.metadataCache.AccessCredential.Load()
+		Expect(acMetadata.SyncTimestamp).ToNot(BeNil())
+		Expect(acMetadata.SyncTimestamp.After(firstSyncTimestamp.Time)).To(BeTrue())
 	})
 
 })
@@ -1,9 +1,13 @@
 package agentpoller
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"net"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"kubevirt.io/client-go/log"
 
@@ -38,6 +42,18 @@ type IP struct {
 	Prefix int    `json:"prefix"`
 }
 
+// Route for json unmarshalling
+type Route struct {
+	Interface    string `json:"iface"`
+	Destination  string `json:"destination"`
+	Metric       int    `json:"metric"`
+	Gateway      string `json:"gateway,omitempty"`
+	Mask         string `json:"mask,omitempty"`
+	NextHop      string `json:"nexthop,omitempty"`
+	DesPrefixLen string `json:"desprefixlen,omitempty"`
+	Version      int    `json:"version"`
+}
+
 var stripRE = regexp.MustCompile(`{\s*\"return\":\s*([{\[][\s\S]*[}\]])\s*}`)
 var stripStringRE = regexp.MustCompile(`{\s*\"return\":\s*\"([\s\S]*)\"\s*}`)
 
@@ -143,6 +159,77 @@ func parseInterfaces(agentReply string) ([]api.InterfaceStatus, error) {
 	return resultInterfaces, nil
 }
 
+// parseDefaultRoutes parses agent reply string, extracts the routes
+// and converts the default ones to API domain routes
+func parseDefaultRoutes(agentReply string) ([]api.InterfaceRoute, error) {
+	routes := []Route{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &routes)
+	if err != nil {
+		return []api.InterfaceRoute{}, err
+	}
+
+	defaultRoutes := []api.InterfaceRoute{}
+	for _, route := range routes {
+		if !isDefaultRoute(route) {
+			continue
+		}
+		defaultRoutes = append(defaultRoutes, api.InterfaceRoute{
+			InterfaceName: route.Interface,
+			Gateway:       routeGateway(route),
+			Metric:        int32(route.Metric),
+		})
+	}
+
+	return defaultRoutes, nil
+}
+
+func isDefaultRoute(route Route) bool {
+	destination := net.ParseIP(route.Destination)
+	if destination == nil || !destination.IsUnspecified() {
+		return false
+	}
+	if route.Version == 6 {
+		prefixLength, err := strconv.Atoi(route.DesPrefixLen)
+		return err == nil && prefixLength == 0
+	}
+	mask := net.ParseIP(route.Mask)
+	return mask != nil && mask.IsUnspecified()
+}
+
+func routeGateway(route Route) string {
+	gateway := route.Gateway
+	if route.Version == 6 {
+		gateway = route.NextHop
+	}
+	if ip := net.ParseIP(gateway); ip == nil || ip.IsUnspecified() {
+		return ""
+	}
+	return gateway
+}
+
+// parseResolvConf extracts the name servers and the search domains
+// from the content of the resolv.conf file of the guest
+func parseResolvConf(content string) api.GuestDNS {
+	dns := api.GuestDNS{}
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") || strings.HasPrefix(fields[0], ";") {
+			continue
+		}
+		switch fields[0] {
+		case "nameserver":
+			dns.Nameservers = append(dns.Nameservers, fields[1])
+		case "search", "domain":
+			// The last search or domain line wins
+			dns.Searches = fields[1:]
+		}
+	}
+	return dns
+}
+
 // parseHostname from the agent response
 func parseHostname(agentReply string) (string, error) {
 	result := Hostname{}
@@ -273,6 +360,7 @@ func convertInterfaceStatusesFromAgentJSON(agentResult []Interface) []api.Interf
 			Mac:           ifc.MAC,
 			Ip:            interfaceIP,
 			IPs:           interfaceIPs,
+			IPsWithPrefix: extractIPsWithPrefix(ifc.IPs),
 			InterfaceName: ifc.Name,
 		})
 	}
@@ -296,3 +384,11 @@ func extractIPs(ipAddresses []IP) (string, []string) {
 	}
 	return interfaceIP, interfaceIPs
 }
+
+func extractIPsWithPrefix(ipAddresses []IP) []string {
+	ipsWithPrefix := []string{}
+	for _, ipAddr := range ipAddresses {
+		ipsWithPrefix = append(ipsWithPrefix, fmt.Sprintf("%s/%d", ipAddr.IP, ipAddr.Prefix))
+	}
+	return ipsWithPrefix
+}
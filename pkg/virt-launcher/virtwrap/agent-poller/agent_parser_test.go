@@ -129,6 +129,7 @@ var _ = Describe("Qemu agent poller", func() {
 					Mac:           "0a:58:0a:f4:00:51",
 					Ip:            "10.244.0.81",
 					IPs:           []string{"10.244.0.81", "fe80::858:aff:fef4:51"},
+					IPsWithPrefix: []string{"10.244.0.81/24", "fe80::858:aff:fef4:51/64"},
 					InterfaceName: "eth0",
 				})
 			expectedStatuses = append(expectedStatuses,
@@ -136,6 +137,7 @@ var _ = Describe("Qemu agent poller", func() {
 					Mac:           "02:00:00:b0:17:66",
 					Ip:            "fe80::ff:feb0:1766",
 					IPs:           []string{"fe80::ff:feb0:1766"},
+					IPsWithPrefix: []string{"fe80::ff:feb0:1766/64"},
 					InterfaceName: "eth1",
 				})
 			expectedStatuses = append(expectedStatuses,
@@ -143,6 +145,7 @@ var _ = Describe("Qemu agent poller", func() {
 					Mac:           "02:00:00:22:11:11",
 					Ip:            "1.2.3.4",
 					IPs:           []string{"1.2.3.4", "fe80::ff:1111:2222"},
+					IPsWithPrefix: []string{"1.2.3.4/24", "fe80::ff:1111:2222/64"},
 					InterfaceName: "eth5",
 				})
 			Expect(interfaceStatuses).To(Equal(expectedStatuses))
@@ -318,5 +321,73 @@ var _ = Describe("Qemu agent poller", func() {
 			}
 			Expect(parseUsers(jsonInput)).To(Equal(expectedUsers))
 		})
+
+		It("should parse the default routes", func() {
+			jsonInput := `{
+                "return":[
+                    {
+                        "iface":"eth0",
+                        "destination":"0.0.0.0",
+                        "gateway":"10.0.2.1",
+                        "mask":"0.0.0.0",
+                        "metric":100,
+                        "version":4
+                    },
+                    {
+                        "iface":"eth0",
+                        "destination":"10.0.2.0",
+                        "gateway":"0.0.0.0",
+                        "mask":"255.255.255.0",
+                        "metric":100,
+                        "version":4
+                    },
+                    {
+                        "iface":"tun0",
+                        "destination":"0.0.0.0",
+                        "gateway":"0.0.0.0",
+                        "mask":"0.0.0.0",
+                        "metric":50,
+                        "version":4
+                    },
+                    {
+                        "iface":"eth0",
+                        "destination":"::",
+                        "desprefixlen":"0",
+                        "nexthop":"fd10:0:2::1",
+                        "metric":1024,
+                        "version":6
+                    },
+                    {
+                        "iface":"eth0",
+                        "destination":"fe80::",
+                        "desprefixlen":"64",
+                        "nexthop":"::",
+                        "metric":256,
+                        "version":6
+                    }
+                ]
+            }`
+
+			expectedRoutes := []api.InterfaceRoute{
+				{InterfaceName: "eth0", Gateway: "10.0.2.1", Metric: 100},
+				{InterfaceName: "tun0", Metric: 50},
+				{InterfaceName: "eth0", Gateway: "fd10:0:2::1", Metric: 1024},
+			}
+			Expect(parseDefaultRoutes(jsonInput)).To(Equal(expectedRoutes))
+		})
+
+		It("should parse resolv.conf", func() {
+			resolvConf := `# Generated by NetworkManager
+search example.com
+nameserver 10.0.2.3
+; a comment
+nameserver fd10:0:2::3
+search cluster.local example.org
+`
+			Expect(parseResolvConf(resolvConf)).To(Equal(api.GuestDNS{
+				Nameservers: []string{"10.0.2.3", "fd10:0:2::3"},
+				Searches:    []string{"cluster.local", "example.org"},
+			}))
+		})
 	})
 })
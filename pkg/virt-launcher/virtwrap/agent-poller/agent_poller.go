@@ -19,6 +19,9 @@
 package agentpoller
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"sync"
 	"time"
 
@@ -47,8 +50,14 @@ const (
 	GET_FILESYSTEM      AgentCommand = "guest-get-fsinfo"
 	GET_AGENT           AgentCommand = "guest-info"
 	GET_FSFREEZE_STATUS AgentCommand = "guest-fsfreeze-status"
+	GET_ROUTES          AgentCommand = "guest-network-get-route"
+	// GET_DNS is not a guest agent command, the resolv.conf file of the guest
+	// is read with the guest-file-* commands instead
+	GET_DNS AgentCommand = "resolv.conf"
 
 	pollInitialInterval = 10 * time.Second
+
+	resolvConfPath = "/etc/resolv.conf"
 )
 
 // AgentUpdatedEvent fire up when data is changes in the store
@@ -84,10 +93,11 @@ func (s *AsyncAgentStore) Store(key AgentCommand, value interface{}) {
 	if updated {
 		domainInfo := api.DomainGuestInfo{}
 		switch key {
-		case GET_OSINFO, GET_INTERFACES, GET_FSFREEZE_STATUS:
+		case GET_OSINFO, GET_INTERFACES, GET_ROUTES, GET_DNS, GET_FSFREEZE_STATUS:
 			domainInfo.OSInfo = s.GetGuestOSInfo()
 			domainInfo.Interfaces = s.GetInterfaceStatus()
 			domainInfo.FSFreezeStatus = s.GetFSFreezeStatus()
+			domainInfo.GuestDNS = s.GetGuestDNS()
 		}
 
 		s.AgentUpdated <- AgentUpdatedEvent{
@@ -128,13 +138,42 @@ func (s *AsyncAgentStore) GetSysInfo() api.DomainSysInfo {
 }
 
 // GetInterfaceStatus returns the interfaces Guest Agent reported
+// together with their default routes
 func (s *AsyncAgentStore) GetInterfaceStatus() []api.InterfaceStatus {
 	data, ok := s.store.Load(GET_INTERFACES)
-	if ok {
-		return data.([]api.InterfaceStatus)
+	if !ok {
+		return nil
 	}
+	interfaces := data.([]api.InterfaceStatus)
 
-	return nil
+	data, ok = s.store.Load(GET_ROUTES)
+	if !ok {
+		return interfaces
+	}
+	routes := data.([]api.InterfaceRoute)
+
+	interfacesWithRoutes := make([]api.InterfaceStatus, 0, len(interfaces))
+	for _, iface := range interfaces {
+		iface.DefaultRoutes = nil
+		for _, route := range routes {
+			if route.InterfaceName == iface.InterfaceName {
+				iface.DefaultRoutes = append(iface.DefaultRoutes, route)
+			}
+		}
+		interfacesWithRoutes = append(interfacesWithRoutes, iface)
+	}
+	return interfacesWithRoutes
+}
+
+// GetGuestDNS returns the DNS configuration of the guest
+func (s *AsyncAgentStore) GetGuestDNS() *api.GuestDNS {
+	data, ok := s.store.Load(GET_DNS)
+	if !ok {
+		return nil
+	}
+
+	dns := data.(api.GuestDNS)
+	return &dns
 }
 
 // GetGuestOSInfo returns the Guest OS version and architecture
@@ -294,7 +333,7 @@ func CreatePoller(
 	// sys command group
 	p.workers = append(p.workers, PollerWorker{
 		CallTick:      qemuAgentSysInterval,
-		AgentCommands: []AgentCommand{GET_INTERFACES, GET_OSINFO, GET_TIMEZONE, GET_HOSTNAME},
+		AgentCommands: []AgentCommand{GET_INTERFACES, GET_ROUTES, GET_DNS, GET_OSINFO, GET_TIMEZONE, GET_HOSTNAME},
 	})
 	// filesystem command group
 	p.workers = append(p.workers, PollerWorker{
@@ -342,7 +381,7 @@ func (p *AgentPoller) Stop() {
 func executeAgentCommands(commands []AgentCommand, con cli.Connection, agentStore *AsyncAgentStore, domainName string) {
 	for _, command := range commands {
 		// replace with direct call to libvirt function when 5.6.0 is available
-		cmdResult, err := con.QemuAgentCommand(`{"execute":"`+string(command)+`"}`, domainName)
+		cmdResult, err := runAgentCommand(command, con, domainName)
 		if err != nil {
 			// skip the command on error, it is not vital
 			continue
@@ -358,6 +397,15 @@ func executeAgentCommands(commands []AgentCommand, con cli.Connection, agentStor
 				continue
 			}
 			agentStore.Store(GET_INTERFACES, interfaces)
+		case GET_ROUTES:
+			routes, err := parseDefaultRoutes(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent routes %s", err.Error())
+				continue
+			}
+			agentStore.Store(GET_ROUTES, routes)
+		case GET_DNS:
+			agentStore.Store(GET_DNS, parseResolvConf(cmdResult))
 		case GET_OSINFO:
 			osInfo, err := parseGuestOSInfo(cmdResult)
 			if err != nil {
@@ -410,3 +458,53 @@ func executeAgentCommands(commands []AgentCommand, con cli.Connection, agentStor
 		}
 	}
 }
+
+func runAgentCommand(command AgentCommand, con cli.Connection, domainName string) (string, error) {
+	if command == GET_DNS {
+		return readGuestFile(con, domainName, resolvConfPath)
+	}
+	return con.QemuAgentCommand(`{"execute":"`+string(command)+`"}`, domainName)
+}
+
+// readGuestFile returns the content of a small file of the guest
+func readGuestFile(con cli.Connection, domainName string, filePath string) (string, error) {
+	cmdOpenFile := fmt.Sprintf(`{"execute": "guest-file-open", "arguments": { "path": "%s", "mode":"r" } }`, filePath)
+	output, err := con.QemuAgentCommand(cmdOpenFile, domainName)
+	if err != nil {
+		return "", err
+	}
+
+	openRes := struct {
+		Return int `json:"return"`
+	}{}
+	if err := json.Unmarshal([]byte(output), &openRes); err != nil {
+		return "", err
+	}
+	defer func() {
+		cmdCloseFile := fmt.Sprintf(`{"execute": "guest-file-close", "arguments": { "handle": %d } }`, openRes.Return)
+		if _, err := con.QemuAgentCommand(cmdCloseFile, domainName); err != nil {
+			log.Log.Reason(err).Warningf("Failed to close guest file %s", filePath)
+		}
+	}()
+
+	cmdReadFile := fmt.Sprintf(`{"execute": "guest-file-read", "arguments": { "handle": %d } }`, openRes.Return)
+	output, err = con.QemuAgentCommand(cmdReadFile, domainName)
+	if err != nil {
+		return "", err
+	}
+
+	readRes := struct {
+		Return struct {
+			BufB64 string `json:"buf-b64"`
+		} `json:"return"`
+	}{}
+	if err := json.Unmarshal([]byte(output), &readRes); err != nil {
+		return "", err
+	}
+
+	content, err := base64.StdEncoding.DecodeString(readRes.Return.BufB64)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
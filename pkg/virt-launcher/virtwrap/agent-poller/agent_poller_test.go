@@ -156,6 +156,33 @@ var _ = Describe("Qemu agent poller", func() {
 			Expect(interfacesStatus).To(Equal(fakeInterfaces))
 		})
 
+		It("should report the default routes of the interfaces", func() {
+			var agentStore = NewAsyncAgentStore()
+			agentStore.Store(GET_INTERFACES, []api.InterfaceStatus{
+				{InterfaceName: "eth0"},
+				{InterfaceName: "eth1"},
+			})
+			route := api.InterfaceRoute{InterfaceName: "eth0", Gateway: "10.0.2.1", Metric: 100}
+			agentStore.Store(GET_ROUTES, []api.InterfaceRoute{route})
+
+			Expect(agentStore.GetInterfaceStatus()).To(Equal([]api.InterfaceStatus{
+				{InterfaceName: "eth0", DefaultRoutes: []api.InterfaceRoute{route}},
+				{InterfaceName: "eth1"},
+			}))
+		})
+
+		It("should fire an event with the guest DNS when it changes", func() {
+			var agentStore = NewAsyncAgentStore()
+			dns := api.GuestDNS{Nameservers: []string{"10.0.2.3"}}
+			agentStore.Store(GET_DNS, dns)
+			Expect(agentStore.AgentUpdated).To(Receive(Equal(AgentUpdatedEvent{
+				DomainInfo: api.DomainGuestInfo{GuestDNS: &dns},
+			})))
+
+			agentStore.Store(GET_DNS, dns)
+			Expect(agentStore.AgentUpdated).ToNot(Receive())
+		})
+
 		It("should report nil when no osInfo exists", func() {
 			var agentStore = NewAsyncAgentStore()
 			osInfo := agentStore.GetGuestOSInfo()
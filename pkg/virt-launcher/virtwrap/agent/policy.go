@@ -0,0 +1,88 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package agent
+
+import (
+	"fmt"
+	"sync"
+
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
+)
+
+// CommandDeniedError is returned when a GuestAgentPolicy of the namespace of the VMI denies a guest agent command
+type CommandDeniedError struct {
+	Command guestagentv1alpha1.GuestAgentCommand
+}
+
+func (e CommandDeniedError) Error() string {
+	return fmt.Sprintf("guest agent command %s is denied by the guest agent policy of the namespace", e.Command)
+}
+
+// CommandPolicy holds the guest agent commands denied for the VMI, as computed by virt-handler.
+// A nil CommandPolicy allows every command.
+type CommandPolicy struct {
+	lock     sync.RWMutex
+	denied   map[guestagentv1alpha1.GuestAgentCommand]bool
+	onDenied func(command guestagentv1alpha1.GuestAgentCommand)
+}
+
+func NewCommandPolicy() *CommandPolicy {
+	return &CommandPolicy{
+		denied: map[guestagentv1alpha1.GuestAgentCommand]bool{},
+	}
+}
+
+// SetDenied replaces the denied commands
+func (p *CommandPolicy) SetDenied(commands []string) {
+	denied := make(map[guestagentv1alpha1.GuestAgentCommand]bool, len(commands))
+	for _, command := range commands {
+		denied[guestagentv1alpha1.GuestAgentCommand(command)] = true
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.denied = denied
+}
+
+// OnDenied registers a callback invoked for every denied command, used to audit the denials
+func (p *CommandPolicy) OnDenied(callback func(command guestagentv1alpha1.GuestAgentCommand)) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.onDenied = callback
+}
+
+// Check returns a CommandDeniedError if the command is denied
+func (p *CommandPolicy) Check(command guestagentv1alpha1.GuestAgentCommand) error {
+	if p == nil {
+		return nil
+	}
+
+	p.lock.RLock()
+	denied, onDenied := p.denied[command], p.onDenied
+	p.lock.RUnlock()
+
+	if !denied {
+		return nil
+	}
+	if onDenied != nil {
+		onDenied(command)
+	}
+	return CommandDeniedError{Command: command}
+}
@@ -0,0 +1,66 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package agent
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
+)
+
+var _ = Describe("Guest agent command policy", func() {
+	var policy *CommandPolicy
+	var denials []guestagentv1alpha1.GuestAgentCommand
+
+	BeforeEach(func() {
+		denials = nil
+		policy = NewCommandPolicy()
+		policy.OnDenied(func(command guestagentv1alpha1.GuestAgentCommand) {
+			denials = append(denials, command)
+		})
+	})
+
+	It("should allow every command by default", func() {
+		Expect(policy.Check(guestagentv1alpha1.GuestAgentCommandExec)).To(Succeed())
+		Expect(denials).To(BeEmpty())
+	})
+
+	It("should allow every command without policy", func() {
+		var nilPolicy *CommandPolicy
+		Expect(nilPolicy.Check(guestagentv1alpha1.GuestAgentCommandExec)).To(Succeed())
+	})
+
+	It("should deny and audit the denied commands", func() {
+		policy.SetDenied([]string{string(guestagentv1alpha1.GuestAgentCommandSetUserPassword)})
+
+		err := policy.Check(guestagentv1alpha1.GuestAgentCommandSetUserPassword)
+		Expect(err).To(MatchError(CommandDeniedError{Command: guestagentv1alpha1.GuestAgentCommandSetUserPassword}))
+		Expect(policy.Check(guestagentv1alpha1.GuestAgentCommandExec)).To(Succeed())
+		Expect(denials).To(ConsistOf(guestagentv1alpha1.GuestAgentCommandSetUserPassword))
+	})
+
+	It("should replace the denied commands", func() {
+		policy.SetDenied([]string{string(guestagentv1alpha1.GuestAgentCommandExec)})
+		policy.SetDenied(nil)
+
+		Expect(policy.Check(guestagentv1alpha1.GuestAgentCommandExec)).To(Succeed())
+	})
+})
@@ -61,6 +61,10 @@ func (in *ACPITable) DeepCopy() *ACPITable {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessCredentialMetadata) DeepCopyInto(out *AccessCredentialMetadata) {
 	*out = *in
+	if in.SyncTimestamp != nil {
+		in, out := &in.SyncTimestamp, &out.SyncTimestamp
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -856,6 +860,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(BlockIO)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		**out = **in
+	}
 	if in.FilesystemOverhead != nil {
 		in, out := &in.FilesystemOverhead, &out.FilesystemOverhead
 		*out = new(v1.Percent)
@@ -931,6 +940,22 @@ func (in *DiskDriver) DeepCopy() *DiskDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSecret) DeepCopyInto(out *DiskSecret) {
 	*out = *in
@@ -1058,6 +1083,11 @@ func (in *DomainGuestInfo) DeepCopyInto(out *DomainGuestInfo) {
 		*out = new(FSFreeze)
 		**out = **in
 	}
+	if in.GuestDNS != nil {
+		in, out := &in.GuestDNS, &out.GuestDNS
+		*out = new(GuestDNS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1108,6 +1138,11 @@ func (in *DomainList) DeepCopyObject() runtime.Object {
 func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	*out = *in
 	out.XMLName = in.XMLName
+	if in.GenID != nil {
+		in, out := &in.GenID, &out.GenID
+		*out = new(GenID)
+		**out = **in
+	}
 	out.Memory = in.Memory
 	if in.CurrentMemory != nil {
 		in, out := &in.CurrentMemory, &out.CurrentMemory
@@ -1208,6 +1243,7 @@ func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
 	}
 	out.OSInfo = in.OSInfo
 	out.FSFreezeStatus = in.FSFreezeStatus
+	in.GuestDNS.DeepCopyInto(&out.GuestDNS)
 	return
 }
 
@@ -1484,6 +1520,27 @@ func (in *FeatureState) DeepCopy() *FeatureState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureTCG) DeepCopyInto(out *FeatureTCG) {
+	*out = *in
+	if in.TBCache != nil {
+		in, out := &in.TBCache, &out.TBCache
+		*out = new(Memory)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureTCG.
+func (in *FeatureTCG) DeepCopy() *FeatureTCG {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureTCG)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureVendorID) DeepCopyInto(out *FeatureVendorID) {
 	*out = *in
@@ -1543,6 +1600,11 @@ func (in *Features) DeepCopyInto(out *Features) {
 		*out = new(FeatureState)
 		**out = **in
 	}
+	if in.TCG != nil {
+		in, out := &in.TCG, &out.TCG
+		*out = new(FeatureTCG)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1735,6 +1797,45 @@ func (in *FilterRef) DeepCopy() *FilterRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlattenMetadata) DeepCopyInto(out *FlattenMetadata) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VolumeFlattenMetadata, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlattenMetadata.
+func (in *FlattenMetadata) DeepCopy() *FlattenMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(FlattenMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GenID) DeepCopyInto(out *GenID) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenID.
+func (in *GenID) DeepCopy() *GenID {
+	if in == nil {
+		return nil
+	}
+	out := new(GenID)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GracePeriodMetadata) DeepCopyInto(out *GracePeriodMetadata) {
 	*out = *in
@@ -1747,6 +1848,18 @@ func (in *GracePeriodMetadata) DeepCopyInto(out *GracePeriodMetadata) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PreShutdownStartTimestamp != nil {
+		in, out := &in.PreShutdownStartTimestamp, &out.PreShutdownStartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.PreShutdownEndTimestamp != nil {
+		in, out := &in.PreShutdownEndTimestamp, &out.PreShutdownEndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.ShutdownSignalTimestamp != nil {
+		in, out := &in.ShutdownSignalTimestamp, &out.ShutdownSignalTimestamp
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1797,6 +1910,48 @@ func (in *GraphicsListen) DeepCopy() *GraphicsListen {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestBootHooksMetadata) DeepCopyInto(out *GuestBootHooksMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestBootHooksMetadata.
+func (in *GuestBootHooksMetadata) DeepCopy() *GuestBootHooksMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestBootHooksMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestDNS) DeepCopyInto(out *GuestDNS) {
+	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Searches != nil {
+		in, out := &in.Searches, &out.Searches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestDNS.
+func (in *GuestDNS) DeepCopy() *GuestDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestOSInfo) DeepCopyInto(out *GuestOSInfo) {
 	*out = *in
@@ -2065,6 +2220,16 @@ func (in *InterfaceDriver) DeepCopyInto(out *InterfaceDriver) {
 		*out = new(uint)
 		**out = **in
 	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(InterfaceDriverHost)
+		**out = **in
+	}
+	if in.Guest != nil {
+		in, out := &in.Guest, &out.Guest
+		*out = new(InterfaceDriverGuest)
+		**out = **in
+	}
 	return
 }
 
@@ -2078,6 +2243,38 @@ func (in *InterfaceDriver) DeepCopy() *InterfaceDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceDriverGuest) DeepCopyInto(out *InterfaceDriverGuest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceDriverGuest.
+func (in *InterfaceDriverGuest) DeepCopy() *InterfaceDriverGuest {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceDriverGuest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceDriverHost) DeepCopyInto(out *InterfaceDriverHost) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceDriverHost.
+func (in *InterfaceDriverHost) DeepCopy() *InterfaceDriverHost {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceDriverHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfacePortForward) DeepCopyInto(out *InterfacePortForward) {
 	*out = *in
@@ -2115,6 +2312,22 @@ func (in *InterfacePortForwardRange) DeepCopy() *InterfacePortForwardRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceRoute) DeepCopyInto(out *InterfaceRoute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceRoute.
+func (in *InterfaceRoute) DeepCopy() *InterfaceRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceSource) DeepCopyInto(out *InterfaceSource) {
 	*out = *in
@@ -2144,6 +2357,16 @@ func (in *InterfaceStatus) DeepCopyInto(out *InterfaceStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IPsWithPrefix != nil {
+		in, out := &in.IPsWithPrefix, &out.IPsWithPrefix
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultRoutes != nil {
+		in, out := &in.DefaultRoutes, &out.DefaultRoutes
+		*out = make([]InterfaceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2189,13 +2412,23 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 	if in.AccessCredential != nil {
 		in, out := &in.AccessCredential, &out.AccessCredential
 		*out = new(AccessCredentialMetadata)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MemoryDump != nil {
 		in, out := &in.MemoryDump, &out.MemoryDump
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestBootHooks != nil {
+		in, out := &in.GuestBootHooks, &out.GuestBootHooks
+		*out = new(GuestBootHooksMetadata)
+		**out = **in
+	}
+	if in.Flatten != nil {
+		in, out := &in.Flatten, &out.Flatten
+		*out = new(FlattenMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2598,6 +2831,11 @@ func (in *MigrationMetadata) DeepCopyInto(out *MigrationMetadata) {
 		in, out := &in.EndTimestamp, &out.EndTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.DowntimeMilliseconds != nil {
+		in, out := &in.DowntimeMilliseconds, &out.DowntimeMilliseconds
+		*out = new(uint64)
+		**out = **in
+	}
 	return
 }
 
@@ -3518,6 +3756,30 @@ func (in *VideoModel) DeepCopy() *VideoModel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeFlattenMetadata) DeepCopyInto(out *VolumeFlattenMetadata) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeFlattenMetadata.
+func (in *VolumeFlattenMetadata) DeepCopy() *VolumeFlattenMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeFlattenMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Watchdog) DeepCopyInto(out *Watchdog) {
 	*out = *in
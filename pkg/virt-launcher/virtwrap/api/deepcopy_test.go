@@ -53,6 +53,9 @@ var _ = ginkgo.Describe("Generated deepcopy functions", func() {
 			&InterfaceSource{},
 			&Model{},
 			&InterfaceTarget{},
+			&InterfaceDriver{},
+			&InterfaceDriverHost{},
+			&InterfaceDriverGuest{},
 			&Alias{},
 			&OS{},
 			&OSType{},
@@ -117,6 +117,7 @@ type DomainStatus struct {
 	Interfaces     []InterfaceStatus
 	OSInfo         GuestOSInfo
 	FSFreezeStatus FSFreeze
+	GuestDNS       GuestDNS
 }
 
 type DomainSysInfo struct {
@@ -140,7 +141,20 @@ type InterfaceStatus struct {
 	Mac           string
 	Ip            string
 	IPs           []string
+	IPsWithPrefix []string
 	InterfaceName string
+	DefaultRoutes []InterfaceRoute
+}
+
+type InterfaceRoute struct {
+	InterfaceName string
+	Gateway       string
+	Metric        int32
+}
+
+type GuestDNS struct {
+	Nameservers []string
+	Searches    []string
 }
 
 type SEVNodeParameters struct {
@@ -182,6 +196,7 @@ type DomainGuestInfo struct {
 	Interfaces     []InterfaceStatus
 	OSInfo         *GuestOSInfo
 	FSFreezeStatus *FSFreeze
+	GuestDNS       *GuestDNS
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -200,6 +215,7 @@ type DomainSpec struct {
 	XmlNS          string          `xml:"xmlns:qemu,attr,omitempty"`
 	Name           string          `xml:"name"`
 	UUID           string          `xml:"uuid,omitempty"`
+	GenID          *GenID          `xml:"genid,omitempty"`
 	Memory         Memory          `xml:"memory"`
 	CurrentMemory  *Memory         `xml:"currentMemory,omitempty"`
 	MaxMemory      *MaxMemory      `xml:"maxMemory,omitempty"`
@@ -221,6 +237,11 @@ type DomainSpec struct {
 	LaunchSecurity *LaunchSecurity `xml:"launchSecurity,omitempty"`
 }
 
+// GenID is the generation ID of the VM, libvirt generates it when empty
+type GenID struct {
+	Value string `xml:",chardata"`
+}
+
 type CPUTune struct {
 	VCPUPin     []CPUTuneVCPUPin     `xml:"vcpupin"`
 	IOThreadPin []CPUTuneIOThreadPin `xml:"iothreadpin,omitempty"`
@@ -313,6 +334,7 @@ type Features struct {
 	PVSpinlock *FeaturePVSpinlock `xml:"pvspinlock,omitempty"`
 	PMU        *FeatureState      `xml:"pmu,omitempty"`
 	VMPort     *FeatureState      `xml:"vmport,omitempty"`
+	TCG        *FeatureTCG        `xml:"tcg,omitempty"`
 }
 
 const HypervModePassthrough = "passthrough"
@@ -378,6 +400,10 @@ type FeatureKVM struct {
 	HintDedicated *FeatureState `xml:"hint-dedicated,omitempty"`
 }
 
+type FeatureTCG struct {
+	TBCache *Memory `xml:"tb-cache,omitempty"`
+}
+
 type Metadata struct {
 	// KubeVirt contains kubevirt related metadata
 	// Note: Libvirt only accept one element at metadata root with a specific namespace
@@ -390,11 +416,21 @@ type KubeVirtMetadata struct {
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	GuestBootHooks   *GuestBootHooksMetadata   `xml:"guestBootHooks,omitempty"`
+	Flatten          *FlattenMetadata          `xml:"flatten,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
 	Succeeded bool   `xml:"succeeded,omitempty"`
 	Message   string `xml:"message,omitempty"`
+	// SyncTimestamp is the time the last changed credentials were applied to the guest
+	SyncTimestamp *metav1.Time `xml:"syncTimestamp,omitempty"`
+}
+
+// GuestBootHooksMetadata is the result of the OnGuestBoot hook sidecars
+type GuestBootHooksMetadata struct {
+	Succeeded bool   `xml:"succeeded,omitempty"`
+	Message   string `xml:"message,omitempty"`
 }
 
 type MemoryDumpMetadata struct {
@@ -406,21 +442,41 @@ type MemoryDumpMetadata struct {
 	FailureReason  string       `xml:"failureReason,omitempty"`
 }
 
+// FlattenMetadata tracks the block pull jobs flattening the backing chains of the volumes
+type FlattenMetadata struct {
+	Volumes []VolumeFlattenMetadata `xml:"volume,omitempty"`
+}
+
+type VolumeFlattenMetadata struct {
+	Name           string       `xml:"name,attr"`
+	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time `xml:"endTimestamp,omitempty"`
+	Progress       int32        `xml:"progress,omitempty"`
+	Completed      bool         `xml:"completed,omitempty"`
+	Failed         bool         `xml:"failed,omitempty"`
+	FailureReason  string       `xml:"failureReason,omitempty"`
+}
+
 type MigrationMetadata struct {
-	UID            types.UID        `xml:"uid,omitempty"`
-	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
-	EndTimestamp   *metav1.Time     `xml:"endTimestamp,omitempty"`
-	Completed      bool             `xml:"completed,omitempty"`
-	Failed         bool             `xml:"failed,omitempty"`
-	FailureReason  string           `xml:"failureReason,omitempty"`
-	AbortStatus    string           `xml:"abortStatus,omitempty"`
-	Mode           v1.MigrationMode `xml:"mode,omitempty"`
+	UID                  types.UID        `xml:"uid,omitempty"`
+	StartTimestamp       *metav1.Time     `xml:"startTimestamp,omitempty"`
+	EndTimestamp         *metav1.Time     `xml:"endTimestamp,omitempty"`
+	Completed            bool             `xml:"completed,omitempty"`
+	Failed               bool             `xml:"failed,omitempty"`
+	FailureReason        string           `xml:"failureReason,omitempty"`
+	AbortStatus          string           `xml:"abortStatus,omitempty"`
+	Mode                 v1.MigrationMode `xml:"mode,omitempty"`
+	DowntimeMilliseconds *uint64          `xml:"downtimeMilliseconds,omitempty"`
 }
 
 type GracePeriodMetadata struct {
 	DeletionGracePeriodSeconds int64        `xml:"deletionGracePeriodSeconds"`
 	DeletionTimestamp          *metav1.Time `xml:"deletionTimestamp,omitempty"`
 	MarkedForGracefulShutdown  *bool        `xml:"markedForGracefulShutdown,omitempty"`
+	PreShutdownStartTimestamp  *metav1.Time `xml:"preShutdownStartTimestamp,omitempty"`
+	PreShutdownEndTimestamp    *metav1.Time `xml:"preShutdownEndTimestamp,omitempty"`
+	PreShutdownFailureReason   string       `xml:"preShutdownFailureReason,omitempty"`
+	ShutdownSignalTimestamp    *metav1.Time `xml:"shutdownSignalTimestamp,omitempty"`
 }
 
 type Commandline struct {
@@ -672,6 +728,7 @@ type Disk struct {
 	Address            *Address      `xml:"address,omitempty"`
 	Model              string        `xml:"model,attr,omitempty"`
 	BlockIO            *BlockIO      `xml:"blockio,omitempty"`
+	IOTune             *DiskIOTune   `xml:"iotune,omitempty"`
 	FilesystemOverhead *v1.Percent   `xml:"filesystemOverhead,omitempty"`
 	Capacity           *int64        `xml:"capacity,omitempty"`
 	ExpandDisksEnabled bool          `xml:"expandDisksEnabled,omitempty"`
@@ -740,6 +797,11 @@ type BlockIO struct {
 	PhysicalBlockSize uint `xml:"physical_block_size,attr,omitempty"`
 }
 
+type DiskIOTune struct {
+	TotalIopsSec  uint64 `xml:"total_iops_sec,omitempty"`
+	TotalBytesSec uint64 `xml:"total_bytes_sec,omitempty"`
+}
+
 type Reservations struct {
 	Managed            string              `xml:"managed,attr,omitempty"`
 	SourceReservations *SourceReservations `xml:"source,omitempty"`
@@ -848,9 +910,28 @@ type ACPI struct {
 }
 
 type InterfaceDriver struct {
-	Name   string `xml:"name,attr"`
-	Queues *uint  `xml:"queues,attr,omitempty"`
-	IOMMU  string `xml:"iommu,attr,omitempty"`
+	Name   string                `xml:"name,attr"`
+	Queues *uint                 `xml:"queues,attr,omitempty"`
+	IOMMU  string                `xml:"iommu,attr,omitempty"`
+	Host   *InterfaceDriverHost  `xml:"host,omitempty"`
+	Guest  *InterfaceDriverGuest `xml:"guest,omitempty"`
+}
+
+type InterfaceDriverHost struct {
+	CSum string `xml:"csum,attr,omitempty"`
+	GSO  string `xml:"gso,attr,omitempty"`
+	TSO4 string `xml:"tso4,attr,omitempty"`
+	TSO6 string `xml:"tso6,attr,omitempty"`
+	ECN  string `xml:"ecn,attr,omitempty"`
+	UFO  string `xml:"ufo,attr,omitempty"`
+}
+
+type InterfaceDriverGuest struct {
+	CSum string `xml:"csum,attr,omitempty"`
+	TSO4 string `xml:"tso4,attr,omitempty"`
+	TSO6 string `xml:"tso6,attr,omitempty"`
+	ECN  string `xml:"ecn,attr,omitempty"`
+	UFO  string `xml:"ufo,attr,omitempty"`
 }
 
 type LinkState struct {
@@ -877,9 +958,11 @@ type FilterRef struct {
 }
 
 type InterfaceSource struct {
+	Type    string   `xml:"type,attr,omitempty"`
 	Network string   `xml:"network,attr,omitempty"`
 	Device  string   `xml:"dev,attr,omitempty"`
 	Bridge  string   `xml:"bridge,attr,omitempty"`
+	Path    string   `xml:"path,attr,omitempty"`
 	Mode    string   `xml:"mode,attr,omitempty"`
 	Address *Address `xml:"address,omitempty"`
 }
@@ -350,6 +350,25 @@ var _ = ginkgo.Describe("Schema", func() {
 			Expect(newDomain).To(Equal(*domain))
 		})
 	})
+
+	ginkgo.Context("With a vhost-user interface", func() {
+		ginkgo.It("Generate expected libvirt xml", func() {
+			iface := Interface{
+				Type:   "vhostuser",
+				Source: InterfaceSource{Type: "unix", Path: "/var/run/vhost/vhu0.sock", Mode: "server"},
+			}
+			buf, err := xml.Marshal(iface)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(buf)).To(Equal(`<interface type="vhostuser">` +
+				`<source type="unix" path="/var/run/vhost/vhu0.sock" mode="server"></source></interface>`))
+
+			newIface := Interface{}
+			Expect(xml.Unmarshal(buf, &newIface)).To(Succeed())
+
+			iface.XMLName.Local = "interface"
+			Expect(newIface).To(Equal(iface))
+		})
+	})
 })
 
 var testAliasName = "alias0"
@@ -54,6 +54,27 @@ func (_mr *_MockConnectionRecorder) DomainDefineXML(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DomainDefineXML", arg0)
 }
 
+func (_m *MockConnection) DomainRestoreFlags(srcFile string, xml string, flags libvirt.DomainSaveRestoreFlags) error {
+	ret := _m.ctrl.Call(_m, "DomainRestoreFlags", srcFile, xml, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockConnectionRecorder) DomainRestoreFlags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DomainRestoreFlags", arg0, arg1, arg2)
+}
+
+func (_m *MockConnection) DomainSaveImageGetXMLDesc(file string, flags libvirt.DomainSaveImageXMLFlags) (string, error) {
+	ret := _m.ctrl.Call(_m, "DomainSaveImageGetXMLDesc", file, flags)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockConnectionRecorder) DomainSaveImageGetXMLDesc(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DomainSaveImageGetXMLDesc", arg0, arg1)
+}
+
 func (_m *MockConnection) Close() (int, error) {
 	ret := _m.ctrl.Call(_m, "Close")
 	ret0, _ := ret[0].(int)
@@ -357,6 +378,27 @@ func (_mr *_MockVirDomainRecorder) GetBlockInfo(arg0, arg1 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetBlockInfo", arg0, arg1)
 }
 
+func (_m *MockVirDomain) BlockPull(disk string, bandwidth uint64, flags libvirt.DomainBlockPullFlags) error {
+	ret := _m.ctrl.Call(_m, "BlockPull", disk, bandwidth, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) BlockPull(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BlockPull", arg0, arg1, arg2)
+}
+
+func (_m *MockVirDomain) GetBlockJobInfo(disk string, flags libvirt.DomainBlockJobInfoFlags) (*libvirt.DomainBlockJobInfo, error) {
+	ret := _m.ctrl.Call(_m, "GetBlockJobInfo", disk, flags)
+	ret0, _ := ret[0].(*libvirt.DomainBlockJobInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirDomainRecorder) GetBlockJobInfo(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetBlockJobInfo", arg0, arg1)
+}
+
 func (_m *MockVirDomain) AttachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error {
 	ret := _m.ctrl.Call(_m, "AttachDeviceFlags", xml, flags)
 	ret0, _ := ret[0].(error)
@@ -574,6 +616,16 @@ func (_mr *_MockVirDomainRecorder) CoreDumpWithFormat(arg0, arg1, arg2 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CoreDumpWithFormat", arg0, arg1, arg2)
 }
 
+func (_m *MockVirDomain) SaveFlags(destFile string, destXml string, flags libvirt.DomainSaveRestoreFlags) error {
+	ret := _m.ctrl.Call(_m, "SaveFlags", destFile, destXml, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) SaveFlags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SaveFlags", arg0, arg1, arg2)
+}
+
 func (_m *MockVirDomain) PinVcpuFlags(vcpu uint, cpuMap []bool, flags libvirt.DomainModificationImpact) error {
 	ret := _m.ctrl.Call(_m, "PinVcpuFlags", vcpu, cpuMap, flags)
 	ret0, _ := ret[0].(error)
@@ -420,6 +420,9 @@ func (l *Launcher) GetDomain(_ context.Context, _ *cmdv1.EmptyRequest) (*cmdv1.D
 		if interfaces := l.domainManager.InterfacesStatus(); interfaces != nil {
 			domainObj.Status.Interfaces = interfaces
 		}
+		if guestDNS := l.domainManager.GuestDNS(); guestDNS != nil {
+			domainObj.Status.GuestDNS = *guestDNS
+		}
 		if domain, err := json.Marshal(domainObj); err != nil {
 			log.Log.Reason(err).Errorf("Failed to marshal domain")
 			response.Response.Success = false
@@ -718,6 +721,29 @@ func (l *Launcher) InjectLaunchSecret(_ context.Context, request *cmdv1.InjectLa
 	return response, nil
 }
 
+func (l *Launcher) FlattenVirtualMachineVolumes(_ context.Context, request *cmdv1.FlattenRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	var flattenOptions v1.FlattenOptions
+	if err := json.Unmarshal(request.Options, &flattenOptions); err != nil {
+		response.Success = false
+		response.Message = "No valid flatten options present in command server request"
+		return response, nil
+	}
+
+	if err := l.domainManager.FlattenVolumes(vmi, &flattenOptions); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to flatten the volumes")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	return response, nil
+}
+
 func (l *Launcher) SyncVirtualMachineMemory(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
 	vmi, response := getVMIFromRequest(request.Vmi)
 	if !response.Success {
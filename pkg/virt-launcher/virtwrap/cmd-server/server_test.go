@@ -147,6 +147,7 @@ var _ = Describe("Virt remote commands", func() {
 			domainManager.EXPECT().ListAllDomains().Return(list, nil)
 			domainManager.EXPECT().GetGuestOSInfo().Return(nil)
 			domainManager.EXPECT().InterfacesStatus().Return(nil)
+			domainManager.EXPECT().GuestDNS().Return(nil)
 			domain, exists, err := client.GetDomain()
 			Expect(err).ToNot(HaveOccurred())
 
@@ -155,6 +156,7 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(domain.ObjectMeta.Name).To(Equal("testvmi1"))
 			Expect(domain.Status.OSInfo).To(Equal(api.GuestOSInfo{}))
 			Expect(domain.Status.Interfaces).To(BeNil())
+			Expect(domain.Status.GuestDNS).To(Equal(api.GuestDNS{}))
 		})
 
 		It("should list domains when guest agent info exists", func() {
@@ -170,6 +172,8 @@ var _ = Describe("Virt remote commands", func() {
 			domainManager.EXPECT().ListAllDomains().Return(list, nil)
 			const osName = "fedora"
 			domainManager.EXPECT().GetGuestOSInfo().Return(&api.GuestOSInfo{Name: osName})
+			guestDNS := api.GuestDNS{Nameservers: []string{"10.0.2.3"}}
+			domainManager.EXPECT().GuestDNS().Return(&guestDNS)
 
 			domain, exists, err := client.GetDomain()
 			Expect(err).ToNot(HaveOccurred())
@@ -179,6 +183,7 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(domain.ObjectMeta.Name).To(Equal(vmiName))
 			Expect(domain.Status.OSInfo).To(Equal(api.GuestOSInfo{Name: osName}))
 			Expect(domain.Status.Interfaces).To(Equal(fakeInterfaces))
+			Expect(domain.Status.GuestDNS).To(Equal(guestDNS))
 		})
 
 		It("should list no domain if no domain is there yet", func() {
@@ -325,6 +330,20 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should flatten the volumes of a vmi", func() {
+			flattenOptions := &v1.FlattenOptions{Volumes: []string{"containerdisk"}}
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().FlattenVolumes(vmi, flattenOptions).Return(nil)
+			Expect(client.FlattenVolumes(vmi, flattenOptions)).To(Succeed())
+		})
+
+		It("should report the failure to flatten the volumes of a vmi", func() {
+			flattenOptions := &v1.FlattenOptions{}
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().FlattenVolumes(vmi, flattenOptions).Return(errors.New("no volume has a backing chain"))
+			Expect(client.FlattenVolumes(vmi, flattenOptions)).To(MatchError(ContainSubstring("no volume has a backing chain")))
+		})
+
 		It("should call UpdateGuestMemory", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domainManager.EXPECT().UpdateGuestMemory(vmi).Return(nil)
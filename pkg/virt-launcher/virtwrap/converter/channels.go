@@ -0,0 +1,52 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package converter
+
+import (
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// ChannelSocketDir holds the host side unix sockets of the user defined channels
+const ChannelSocketDir = util.VirtPrivateDir + "/channels"
+
+func ChannelSocketPath(channelName string) string {
+	return filepath.Join(ChannelSocketDir, channelName+".sock")
+}
+
+// Convert_v1_Channel_To_api_Channel exposes a virtio-serial port to the guest,
+// backed by a unix socket created by QEMU inside the virt-launcher pod
+func Convert_v1_Channel_To_api_Channel(channel v1.Channel) api.Channel {
+	return api.Channel{
+		Type: "unix",
+		Source: &api.ChannelSource{
+			Mode: "bind",
+			Path: ChannelSocketPath(channel.Name),
+		},
+		Target: &api.ChannelTarget{
+			Type: v1.VirtIO,
+			Name: channel.Target,
+		},
+	}
+}
@@ -53,7 +53,9 @@ import (
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	"kubevirt.io/kubevirt/pkg/identitydocument"
 	"kubevirt.io/kubevirt/pkg/ignition"
+	"kubevirt.io/kubevirt/pkg/maintenancenotifications"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
@@ -94,6 +96,7 @@ type EFIConfiguration struct {
 type ConverterContext struct {
 	Architecture                    ArchConverter
 	AllowEmulation                  bool
+	CrossArchitectureEmulation      bool
 	Secrets                         map[string]*k8sv1.Secret
 	VirtualMachine                  *v1.VirtualMachineInstance
 	CPUSet                          []int
@@ -1373,6 +1376,12 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 	domain.ObjectMeta.Name = vmi.ObjectMeta.Name
 	domain.ObjectMeta.Namespace = vmi.ObjectMeta.Namespace
 
+	// The VMIs forked from a parent are restored with a new generation ID, which tells their guest to
+	// reseed its random number generator
+	if _, isForkParent := vmi.Annotations[v1.ForkParentAnnotation]; isForkParent {
+		domain.Spec.GenID = &api.GenID{}
+	}
+
 	// Set VM CPU cores
 	// CPU topology will be created everytime, because user can specify
 	// number of cores in vmi.Spec.Domain.Resources.Requests/Limits, not only
@@ -1391,7 +1400,10 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 	}
 
 	kvmPath := "/dev/kvm"
-	if softwareEmulation, err := util.UseSoftwareEmulationForDevice(kvmPath, c.AllowEmulation); err != nil {
+	if c.CrossArchitectureEmulation {
+		log.DefaultLogger().Infof("Emulating the %s guest with TCG.", c.Architecture.GetArchitecture())
+		domain.Spec.Type = "qemu"
+	} else if softwareEmulation, err := util.UseSoftwareEmulationForDevice(kvmPath, c.AllowEmulation); err != nil {
 		return err
 	} else if softwareEmulation {
 		logger := log.DefaultLogger()
@@ -1411,6 +1423,14 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domain.Spec.Devices.Channels = append(domain.Spec.Devices.Channels, convertDownwardMetricsChannel())
 	}
 
+	if maintenancenotifications.HasDevice(&vmi.Spec) {
+		domain.Spec.Devices.Channels = append(domain.Spec.Devices.Channels, convertMaintenanceNotificationsChannel())
+	}
+
+	for _, channel := range vmi.Spec.Domain.Devices.Channels {
+		domain.Spec.Devices.Channels = append(domain.Spec.Devices.Channels, Convert_v1_Channel_To_api_Channel(channel))
+	}
+
 	domain.Spec.SysInfo = &api.SysInfo{}
 
 	err = Convert_v1_Firmware_To_related_apis(vmi, domain, c)
@@ -1744,6 +1764,10 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domain.Spec.CPU.Mode = v1.CPUModeHostModel
 	}
 
+	if c.CrossArchitectureEmulation {
+		convertTCGFeatures(domain)
+	}
+
 	if vmi.Spec.Domain.Devices.AutoattachSerialConsole == nil || *vmi.Spec.Domain.Devices.AutoattachSerialConsole {
 		// Add mandatory console device
 		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, api.Controller{
@@ -1817,6 +1841,16 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg, api.Arg{Value: fmt.Sprintf("name=opt/com.coreos/config,file=%s", ignitionpath)})
 	}
 
+	// Expose the identity document issued by virt-controller to the guest
+	if vmi.Spec.IdentityDocument != nil {
+		initializeQEMUCmdAndQEMUArg(domain)
+		documentPath := filepath.Join(config.SecretSourceDir, identitydocument.VolumeName, identitydocument.DocumentSecretKey)
+		domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg,
+			api.Arg{Value: "-fw_cfg"},
+			api.Arg{Value: fmt.Sprintf("name=%s,file=%s", identitydocument.FwCfgName, documentPath)},
+		)
+	}
+
 	if val := vmi.Annotations[v1.PlacePCIDevicesOnRootComplex]; val == "true" {
 		if err := PlacePCIDevicesOnRootComplex(&domain.Spec); err != nil {
 			return err
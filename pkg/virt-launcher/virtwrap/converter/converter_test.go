@@ -51,6 +51,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	"kubevirt.io/kubevirt/pkg/ephemeral-disk/fake"
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/maintenancenotifications"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 
@@ -778,6 +779,14 @@ var _ = Describe("Converter", func() {
 			Expect(vmiToDomainXMLToDomainSpec(vmi, c).Type).To(Equal(domainType))
 		})
 
+		It("should request a generation ID for a fork parent", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			Expect(vmiToDomainXMLToDomainSpec(vmi, c).GenID).To(BeNil())
+
+			vmi.Annotations = map[string]string{v1.ForkParentAnnotation: "rootdisk"}
+			Expect(vmiToDomainXML(vmi, c)).To(ContainSubstring("<genid></genid>"))
+		})
+
 		Context("when all addresses should be places at the root complex", func() {
 			It("should be converted to a libvirt Domain with vmi defaults set", func() {
 				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
@@ -1011,6 +1020,24 @@ var _ = Describe("Converter", func() {
 			Entry("should be nil for arm64", arm64, BeNil()),
 		)
 
+		DescribeTable("when the guest is emulated on a node of another architecture should run it with TCG", func(model, expectedMode string) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			c.Architecture = NewArchConverter(arm64)
+			c.CrossArchitectureEmulation = true
+			vmi.Spec.Domain.CPU = &v1.CPU{Model: model}
+			vmiArchMutate(arm64, vmi, c)
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+
+			Expect(domainSpec.Type).To(Equal("qemu"))
+			Expect(domainSpec.OS.Type.Arch).To(Equal("aarch64"))
+			Expect(domainSpec.CPU.Mode).To(Equal(expectedMode))
+			Expect(domainSpec.Features.TCG).To(Equal(&api.FeatureTCG{TBCache: &api.Memory{Value: 256, Unit: "MiB"}}))
+		},
+			Entry("and the maximum CPU instead of host-passthrough", v1.CPUModeHostPassthrough, "maximum"),
+			Entry("and the maximum CPU instead of host-model", v1.CPUModeHostModel, "maximum"),
+			Entry("and the requested CPU model", "cortex-a57", "custom"),
+		)
+
 		Context("when downwardMetrics are exposed via virtio-serial", func() {
 			It("should set socket options", func() {
 				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
@@ -1032,6 +1059,48 @@ var _ = Describe("Converter", func() {
 			})
 		})
 
+		Context("when maintenance notifications are enabled", func() {
+			It("should expose the maintenance channel", func() {
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				vmi.Spec.Domain.Devices.MaintenanceNotifications = &v1.MaintenanceNotifications{}
+				domain := vmiToDomain(vmi, c)
+
+				Expect(domain.Spec.Devices.Channels).To(ContainElement(
+					api.Channel{
+						Type: "unix",
+						Source: &api.ChannelSource{
+							Mode: "bind",
+							Path: maintenancenotifications.ChannelSocket,
+						},
+						Target: &api.ChannelTarget{
+							Type: v1.VirtIO,
+							Name: maintenancenotifications.SerialDeviceName,
+						},
+					}))
+			})
+		})
+
+		Context("when user defined channels are present", func() {
+			It("should expose them as unix socket backed virtio-serial ports", func() {
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				vmi.Spec.Domain.Devices.Channels = []v1.Channel{{Name: "logs", Target: "org.example.logs.0"}}
+				domain := vmiToDomain(vmi, c)
+
+				Expect(domain.Spec.Devices.Channels).To(ContainElement(
+					api.Channel{
+						Type: "unix",
+						Source: &api.ChannelSource{
+							Mode: "bind",
+							Path: "/var/run/kubevirt-private/channels/logs.sock",
+						},
+						Target: &api.ChannelTarget{
+							Type: v1.VirtIO,
+							Name: "org.example.logs.0",
+						},
+					}))
+			})
+		})
+
 		It("should set disk pci address when specified", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Disks[0].Disk.PciAddress = "0000:81:01.0"
@@ -1454,6 +1523,25 @@ var _ = Describe("Converter", func() {
 			Entry("disabled - virtLauncherLogVerbosity variable is not defined", false, -1, false),
 		)
 
+		It("should expose the identity document through fw_cfg when requested", func() {
+			vmi.Spec.IdentityDocument = &v1.IdentityDocument{}
+			domain := api.Domain{}
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &domain, c)).To(Succeed())
+			Expect(domain.Spec.QEMUCmd).ToNot(BeNil())
+			Expect(domain.Spec.QEMUCmd.QEMUArg).To(ContainElements(
+				api.Arg{Value: "-fw_cfg"},
+				api.Arg{Value: "name=opt/io.kubevirt/identity-document,file=/var/run/kubevirt-private/secret/identity-document/document"},
+			))
+		})
+
+		It("should not expose an identity document when the VMI does not request one", func() {
+			domain := api.Domain{}
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &domain, c)).To(Succeed())
+			if domain.Spec.QEMUCmd != nil {
+				Expect(domain.Spec.QEMUCmd.QEMUArg).ToNot(ContainElement(api.Arg{Value: "-fw_cfg"}))
+			}
+		})
+
 		DescribeTable("should add VSOCK section when present",
 			func(useVirtioTransitional bool) {
 				vmi.Status.VSOCKCID = pointer.P(uint32(100))
@@ -1622,6 +1710,44 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces[0].BootOrder.Order).To(Equal(uint(bootOrder)))
 			Expect(domain.Spec.Devices.Interfaces[1].BootOrder).To(BeNil())
 		})
+		DescribeTable("should set the offloads of a bridge interface with tap options", func(model string, tap v1.InterfaceTap, expectedDriver *api.InterfaceDriver) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			iface := v1.DefaultBridgeNetworkInterface()
+			iface.Name = netName1
+			iface.Model = model
+			iface.Bridge.Tap = &tap
+			net := v1.DefaultPodNetwork()
+			net.Name = netName1
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*iface}
+			vmi.Spec.Networks = []v1.Network{*net}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].Driver).To(Equal(expectedDriver))
+		},
+			Entry("without offload options", "", v1.InterfaceTap{TxQueueLength: pointer.P(int32(5000))}, nil),
+			Entry("with the TSO and UFO disabled", "",
+				v1.InterfaceTap{Offload: &v1.InterfaceTapOffload{TSO: pointer.P(false), GSO: pointer.P(true), UFO: pointer.P(false)}},
+				&api.InterfaceDriver{
+					Name:  "vhost",
+					Host:  &api.InterfaceDriverHost{TSO4: "off", TSO6: "off", UFO: "off"},
+					Guest: &api.InterfaceDriverGuest{TSO4: "off", TSO6: "off", UFO: "off"},
+				},
+			),
+			Entry("with the GSO disabled", v1.VirtIO,
+				v1.InterfaceTap{Offload: &v1.InterfaceTapOffload{GSO: pointer.P(false)}},
+				&api.InterfaceDriver{Name: "vhost", Host: &api.InterfaceDriverHost{GSO: "off"}},
+			),
+			Entry("with every offload disabled", "",
+				v1.InterfaceTap{DisableOffloads: pointer.P(true), Offload: &v1.InterfaceTapOffload{TSO: pointer.P(true)}},
+				&api.InterfaceDriver{
+					Name:  "vhost",
+					Host:  &api.InterfaceDriverHost{CSum: "off", GSO: "off", TSO4: "off", TSO6: "off", ECN: "off", UFO: "off"},
+					Guest: &api.InterfaceDriverGuest{CSum: "off", TSO4: "off", TSO6: "off", ECN: "off", UFO: "off"},
+				},
+			),
+			Entry("with a non virtio model", "e1000", v1.InterfaceTap{DisableOffloads: pointer.P(true)}, nil),
+		)
 		It("Should create network configuration for masquerade interface", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 
@@ -2490,6 +2616,50 @@ var _ = Describe("Converter", func() {
 			isExpectedThreadsLayout := equality.Semantic.DeepEqual(expectedLayout, domain.Spec.CPUTune.IOThreadPin)
 			Expect(isExpectedThreadsLayout).To(BeTrue())
 		})
+		DescribeTable("should pin the iothreads of a VMI with an isolated emulator thread according to its pinning policy",
+			func(ioThreadsPinning string, expectedLayout []api.CPUTuneIOThreadPin) {
+				vmi.Spec.Domain.CPU.Cores = 2
+				vmi.Spec.Domain.CPU.IsolateEmulatorThread = true
+				if ioThreadsPinning != "" {
+					vmi.Annotations = map[string]string{v1.IOThreadsPinningAnnotation: ioThreadsPinning}
+				}
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				c := &ConverterContext{
+					Architecture:   NewArchConverter(runtime.GOARCH),
+					CPUSet:         []int{5, 6, 7},
+					AllowEmulation: true,
+					Topology: &cmdv1.Topology{
+						NumaCells: []*cmdv1.Cell{{
+							Cpus: []*cmdv1.CPU{
+								{Id: 5},
+								{Id: 6},
+								{Id: 7},
+							},
+						}},
+					},
+				}
+				domain := vmiToDomain(vmi, c)
+				domain.Spec.IOThreads = &api.IOThreads{}
+				domain.Spec.IOThreads.IOThreads = uint(3)
+				domain.Spec.CPUTune.IOThreadPin = nil
+
+				Expect(vcpu.FormatDomainIOThreadPin(vmi, domain, "0", c.CPUSet)).To(Succeed())
+				Expect(domain.Spec.CPUTune.IOThreadPin).To(Equal(expectedLayout))
+			},
+			Entry("with the first iothread on the emulator thread by default", "", []api.CPUTuneIOThreadPin{
+				{IOThread: 1, CPUSet: "0"},
+			}),
+			Entry("with all the iothreads on the emulator thread", string(v1.IOThreadsPinningEmulatorThread), []api.CPUTuneIOThreadPin{
+				{IOThread: 1, CPUSet: "0"},
+				{IOThread: 2, CPUSet: "0"},
+				{IOThread: 3, CPUSet: "0"},
+			}),
+			Entry("with the iothreads on the vCPUs", string(v1.IOThreadsPinningVCPUs), []api.CPUTuneIOThreadPin{
+				{IOThread: 1, CPUSet: "6"},
+				{IOThread: 2, CPUSet: "5"},
+				{IOThread: 3, CPUSet: "6"},
+			}),
+		)
 	})
 	Context("virtio-net multi-queue", func() {
 		var vmi *v1.VirtualMachineInstance
@@ -77,6 +77,10 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 			domainIface.ACPI = &api.ACPI{Index: uint(iface.ACPIIndex)}
 		}
 
+		if iface.Bridge != nil && iface.Bridge.Tap != nil && ifaceType == v1.VirtIO {
+			setTapOffloads(&domainIface, iface.Bridge.Tap)
+		}
+
 		if c.DomainAttachmentByInterfaceName[iface.Name] == string(v1.Tap) {
 			// use "ethernet" interface type, since we're using pre-configured tap devices
 			// https://libvirt.org/formatdomain.html#elementsNICSEthernet
@@ -106,6 +110,42 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 	return domainInterfaces, nil
 }
 
+// setTapOffloads disables the offloads of the interface turned off by its tap options.
+func setTapOffloads(domainIface *api.Interface, tap *v1.InterfaceTap) {
+	const off = "off"
+	var host api.InterfaceDriverHost
+	var guest api.InterfaceDriverGuest
+	if tap.DisableOffloads != nil && *tap.DisableOffloads {
+		host = api.InterfaceDriverHost{CSum: off, GSO: off, TSO4: off, TSO6: off, ECN: off, UFO: off}
+		guest = api.InterfaceDriverGuest{CSum: off, TSO4: off, TSO6: off, ECN: off, UFO: off}
+	} else if offload := tap.Offload; offload != nil {
+		if offload.TSO != nil && !*offload.TSO {
+			host.TSO4, host.TSO6 = off, off
+			guest.TSO4, guest.TSO6 = off, off
+		}
+		if offload.GSO != nil && !*offload.GSO {
+			host.GSO = off
+		}
+		if offload.UFO != nil && !*offload.UFO {
+			host.UFO = off
+			guest.UFO = off
+		}
+	}
+
+	if host == (api.InterfaceDriverHost{}) && guest == (api.InterfaceDriverGuest{}) {
+		return
+	}
+	if domainIface.Driver == nil {
+		domainIface.Driver = &api.InterfaceDriver{Name: "vhost"}
+	}
+	if host != (api.InterfaceDriverHost{}) {
+		domainIface.Driver.Host = &host
+	}
+	if guest != (api.InterfaceDriverGuest{}) {
+		domainIface.Driver.Guest = &guest
+	}
+}
+
 func GetInterfaceType(iface *v1.Interface) string {
 	if iface.Model != "" {
 		return iface.Model
@@ -0,0 +1,45 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package converter
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/emulation"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// cpuModeMaximum is the most capable CPU the hypervisor provides, with all the features TCG emulates
+const cpuModeMaximum = "maximum"
+
+// convertTCGFeatures adapts the domain of a guest emulated on a node of another architecture.
+// The CPU of the node can't be passed to the guest, which gets the most capable emulated CPU instead.
+func convertTCGFeatures(domain *api.Domain) {
+	if domain.Spec.CPU.Mode == v1.CPUModeHostModel || domain.Spec.CPU.Mode == v1.CPUModeHostPassthrough {
+		domain.Spec.CPU.Mode = cpuModeMaximum
+	}
+
+	if domain.Spec.Features == nil {
+		domain.Spec.Features = &api.Features{}
+	}
+	domain.Spec.Features.TCG = &api.FeatureTCG{
+		TBCache: &api.Memory{Value: emulation.TranslationCacheMiB, Unit: "MiB"},
+	}
+}
@@ -377,8 +377,15 @@ func appendDomainIOThreadPin(domain *api.Domain, thread uint32, cpuset string) {
 func FormatDomainIOThreadPin(vmi *v12.VirtualMachineInstance, domain *api.Domain, emulatorThreadsCPUSet string, cpuset []int) error {
 	iothreads := int(domain.Spec.IOThreads.IOThreads)
 	vcpus := int(CalculateRequestedVCPUs(domain.Spec.CPU.Topology))
+	isolated := vmi.IsCPUDedicated() && vmi.Spec.Domain.CPU.IsolateEmulatorThread
+	ioThreadsPinning := v12.IOThreadsPinning(vmi.Annotations[v12.IOThreadsPinningAnnotation])
 
-	if vmi.IsCPUDedicated() && vmi.Spec.Domain.CPU.IsolateEmulatorThread {
+	if isolated && ioThreadsPinning == v12.IOThreadsPinningEmulatorThread {
+		// pin all the IOThreads on the same pCPUs as the emulator thread
+		for thread := 1; thread <= iothreads; thread++ {
+			appendDomainIOThreadPin(domain, uint32(thread), emulatorThreadsCPUSet)
+		}
+	} else if isolated && ioThreadsPinning != v12.IOThreadsPinningVCPUs {
 		// pin the IOThread on the same pCPU as the emulator thread
 		appendDomainIOThreadPin(domain, uint32(1), emulatorThreadsCPUSet)
 	} else if iothreads >= vcpus {
@@ -0,0 +1,496 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package dryrun
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+// DefaultDelay is the time a placeholder domain takes to boot, shut down or migrate
+const DefaultDelay = 2 * time.Second
+
+var errNoGuest = fmt.Errorf("not supported in dry-run virtualization mode, the VMI has no guest")
+
+// DomainManager is a virtwrap.DomainManager which runs no guest. It keeps a placeholder domain
+// for the VMI and moves it through the states libvirt reports for a real domain, notifying every
+// change of the domain.
+type DomainManager struct {
+	lock          sync.Mutex
+	domain        *api.Domain
+	metadataCache *metadata.Cache
+	agentPolicy   *agent.CommandPolicy
+	notify        func(watch.Event)
+	delay         time.Duration
+
+	stopped  chan struct{}
+	stopOnce sync.Once
+}
+
+// NewDomainManager returns a DomainManager whose domain transitions take the given delay.
+// notify is called with every event of the domain, in order.
+func NewDomainManager(metadataCache *metadata.Cache, delay time.Duration, notify func(watch.Event)) *DomainManager {
+	return &DomainManager{
+		metadataCache: metadataCache,
+		agentPolicy:   agent.NewCommandPolicy(),
+		notify:        notify,
+		delay:         delay,
+		stopped:       make(chan struct{}),
+	}
+}
+
+// Run notifies the domain again whenever its metadata changes, until stop is closed
+func (m *DomainManager) Run(stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case <-m.metadataCache.Listen():
+			m.metadataCache.ResetNotification()
+			m.lock.Lock()
+			if m.domain != nil {
+				m.notifyLocked(watch.Modified)
+			}
+			m.lock.Unlock()
+		}
+	}
+}
+
+// Stopped is closed once the domain is not running anymore: shut down, killed, migrated away or deleted
+func (m *DomainManager) Stopped() <-chan struct{} {
+	return m.stopped
+}
+
+func (m *DomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, _ bool, options *cmdv1.VirtualMachineOptions) (*api.DomainSpec, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.agentPolicy.SetDenied(options.GetDeniedGuestAgentCommands())
+
+	if m.domain == nil {
+		m.defineLocked(vmi)
+		m.setStateLocked(api.Paused, api.ReasonPausedStartingUp)
+		m.after(func() {
+			if m.isInStateLocked(api.Paused, api.ReasonPausedStartingUp) {
+				m.setStateLocked(api.Running, api.ReasonUnknown)
+			}
+		})
+	} else if syncDevices(&m.domain.Spec, vmi) {
+		m.notifyLocked(watch.Modified)
+	}
+
+	return m.domain.Spec.DeepCopy(), nil
+}
+
+func (m *DomainManager) PauseVMI(_ *v1.VirtualMachineInstance) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !m.isInStateLocked(api.Running, "") {
+		return fmt.Errorf("domain is not running")
+	}
+	m.setStateLocked(api.Paused, api.ReasonPausedUser)
+	return nil
+}
+
+func (m *DomainManager) UnpauseVMI(_ *v1.VirtualMachineInstance) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !m.isInStateLocked(api.Paused, api.ReasonPausedUser) {
+		return nil
+	}
+	m.setStateLocked(api.Running, api.ReasonUnknown)
+	return nil
+}
+
+func (m *DomainManager) FreezeVMI(_ *v1.VirtualMachineInstance, _ int32) error {
+	return errNoGuest
+}
+
+func (m *DomainManager) UnfreezeVMI(_ *v1.VirtualMachineInstance) error {
+	return errNoGuest
+}
+
+func (m *DomainManager) SoftRebootVMI(_ *v1.VirtualMachineInstance) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !m.isInStateLocked(api.Running, "") {
+		return fmt.Errorf("domain is not running")
+	}
+	return nil
+}
+
+func (m *DomainManager) SignalShutdownVMI(_ *v1.VirtualMachineInstance) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !m.isInStateLocked(api.Running, "") && !m.isInStateLocked(api.Paused, "") {
+		return nil
+	}
+
+	m.metadataCache.GracePeriod.WithSafeBlock(func(gracePeriodMetadata *api.GracePeriodMetadata, _ bool) {
+		if gracePeriodMetadata.DeletionTimestamp == nil {
+			now := metav1.Now()
+			gracePeriodMetadata.DeletionTimestamp = &now
+		}
+	})
+	m.setStateLocked(api.Shutdown, api.ReasonUser)
+	m.after(func() {
+		if m.isInStateLocked(api.Shutdown, api.ReasonUser) {
+			m.setStateLocked(api.Shutoff, api.ReasonShutdown)
+			m.stop()
+		}
+	})
+	return nil
+}
+
+func (m *DomainManager) MarkGracefulShutdownVMI() {
+	m.metadataCache.GracePeriod.WithSafeBlock(func(gracePeriodMetadata *api.GracePeriodMetadata, _ bool) {
+		gracePeriodMetadata.MarkedForGracefulShutdown = pointer.P(true)
+	})
+}
+
+func (m *DomainManager) KillVMI(_ *v1.VirtualMachineInstance) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.domain == nil || m.isInStateLocked(api.Shutoff, "") {
+		return nil
+	}
+	m.setStateLocked(api.Shutoff, api.ReasonDestroyed)
+	m.stop()
+	return nil
+}
+
+func (m *DomainManager) DeleteVMI(_ *v1.VirtualMachineInstance) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.domain == nil {
+		return nil
+	}
+	now := metav1.Now()
+	m.domain.ObjectMeta.DeletionTimestamp = &now
+	m.setStateLocked(api.NoState, api.ReasonNonExistent)
+	m.domain = nil
+	m.stop()
+	return nil
+}
+
+func (m *DomainManager) ListAllDomains() ([]*api.Domain, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.domain == nil {
+		return []*api.Domain{}, nil
+	}
+	return []*api.Domain{m.snapshotLocked()}, nil
+}
+
+// MigrateVMI completes the migration after the delay, the source domain is then shut off
+func (m *DomainManager) MigrateVMI(vmi *v1.VirtualMachineInstance, _ *cmdclient.MigrationOptions) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.domain == nil {
+		return fmt.Errorf("domain does not exist")
+	}
+	migrationUID := vmi.Status.MigrationState.MigrationUID
+	if migration, exists := m.metadataCache.Migration.Load(); exists && migration.UID == migrationUID {
+		if migration.EndTimestamp == nil {
+			return nil
+		}
+		return fmt.Errorf("migration job %v already executed", migrationUID)
+	}
+
+	now := metav1.Now()
+	m.metadataCache.Migration.Store(api.MigrationMetadata{
+		UID:            migrationUID,
+		StartTimestamp: &now,
+		Mode:           v1.MigrationPreCopy,
+	})
+	m.notifyLocked(watch.Modified)
+
+	m.after(func() {
+		migration, _ := m.metadataCache.Migration.Load()
+		if migration.UID != migrationUID || migration.EndTimestamp != nil {
+			return
+		}
+		m.metadataCache.Migration.WithSafeBlock(func(migration *api.MigrationMetadata, _ bool) {
+			end := metav1.Now()
+			migration.EndTimestamp = &end
+			migration.Completed = true
+		})
+		m.setStateLocked(api.Shutoff, api.ReasonMigrated)
+		m.stop()
+	})
+	return nil
+}
+
+// PrepareMigrationTarget defines the target domain paused for the migration, it resumes after the delay
+func (m *DomainManager) PrepareMigrationTarget(vmi *v1.VirtualMachineInstance, _ bool, _ *cmdv1.VirtualMachineOptions) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.domain != nil {
+		return nil
+	}
+	m.defineLocked(vmi)
+	m.setStateLocked(api.Paused, api.ReasonPausedMigration)
+	m.after(func() {
+		if m.isInStateLocked(api.Paused, api.ReasonPausedMigration) {
+			m.setStateLocked(api.Running, api.ReasonUnknown)
+		}
+	})
+	return nil
+}
+
+func (m *DomainManager) FinalizeVirtualMachineMigration(_ *v1.VirtualMachineInstance, _ *cmdv1.VirtualMachineOptions) error {
+	return nil
+}
+
+func (m *DomainManager) CancelVMIMigration(_ *v1.VirtualMachineInstance) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	migration, exists := m.metadataCache.Migration.Load()
+	if !exists || migration.EndTimestamp != nil {
+		return nil
+	}
+	m.metadataCache.Migration.WithSafeBlock(func(migration *api.MigrationMetadata, _ bool) {
+		end := metav1.Now()
+		migration.EndTimestamp = &end
+		migration.Completed = true
+		migration.Failed = true
+		migration.FailureReason = "Live migration aborted "
+		migration.AbortStatus = string(v1.MigrationAbortSucceeded)
+	})
+	m.notifyLocked(watch.Modified)
+	return nil
+}
+
+func (m *DomainManager) GetDomainStats() (*stats.DomainStats, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.domain == nil {
+		return nil, nil
+	}
+	return &stats.DomainStats{Name: m.domain.Spec.Name, UUID: m.domain.Spec.UUID}, nil
+}
+
+func (m *DomainManager) GetGuestInfo() v1.VirtualMachineInstanceGuestAgentInfo {
+	return v1.VirtualMachineInstanceGuestAgentInfo{}
+}
+
+func (m *DomainManager) GetUsers() []v1.VirtualMachineInstanceGuestOSUser {
+	return []v1.VirtualMachineInstanceGuestOSUser{}
+}
+
+func (m *DomainManager) GetFilesystems() []v1.VirtualMachineInstanceFileSystem {
+	return []v1.VirtualMachineInstanceFileSystem{}
+}
+
+func (m *DomainManager) HotplugHostDevices(_ *v1.VirtualMachineInstance) error {
+	return nil
+}
+
+func (m *DomainManager) InterfacesStatus() []api.InterfaceStatus {
+	return nil
+}
+
+func (m *DomainManager) GetGuestOSInfo() *api.GuestOSInfo {
+	return nil
+}
+
+func (m *DomainManager) GuestDNS() *api.GuestDNS {
+	return nil
+}
+
+func (m *DomainManager) Exec(_, _ string, _ []string, _ int32) (string, error) {
+	return "", errNoGuest
+}
+
+func (m *DomainManager) GuestPing(_ string) error {
+	return errNoGuest
+}
+
+func (m *DomainManager) MemoryDump(_ *v1.VirtualMachineInstance, _ string) error {
+	return errNoGuest
+}
+
+func (m *DomainManager) GetQemuVersion() (string, error) {
+	return "dry-run", nil
+}
+
+func (m *DomainManager) UpdateVCPUs(_ *v1.VirtualMachineInstance, _ *cmdv1.VirtualMachineOptions) error {
+	return nil
+}
+
+func (m *DomainManager) GetSEVInfo() (*v1.SEVPlatformInfo, error) {
+	return nil, errNoGuest
+}
+
+func (m *DomainManager) GetLaunchMeasurement(_ *v1.VirtualMachineInstance) (*v1.SEVMeasurementInfo, error) {
+	return nil, errNoGuest
+}
+
+func (m *DomainManager) InjectLaunchSecret(_ *v1.VirtualMachineInstance, _ *v1.SEVSecretOptions) error {
+	return errNoGuest
+}
+
+func (m *DomainManager) FlattenVolumes(_ *v1.VirtualMachineInstance, _ *v1.FlattenOptions) error {
+	return errNoGuest
+}
+
+func (m *DomainManager) UpdateGuestMemory(_ *v1.VirtualMachineInstance) error {
+	return nil
+}
+
+func (m *DomainManager) GuestAgentPolicy() *agent.CommandPolicy {
+	return m.agentPolicy
+}
+
+// SaveLauncherState is a no-op: there is no running domain to reattach to.
+func (m *DomainManager) SaveLauncherState(_ string) error {
+	return nil
+}
+
+// RestoreLauncherState is a no-op: there is no running domain to reattach to.
+func (m *DomainManager) RestoreLauncherState(_ string) error {
+	return nil
+}
+
+func (m *DomainManager) defineLocked(vmi *v1.VirtualMachineInstance) {
+	m.metadataCache.UID.Set(vmi.UID)
+	m.metadataCache.GracePeriod.Set(
+		api.GracePeriodMetadata{DeletionGracePeriodSeconds: converter.GracePeriodSeconds(vmi)},
+	)
+
+	m.domain = api.NewMinimalDomainWithNS(vmi.Namespace, vmi.Name)
+	m.domain.ObjectMeta.UID = vmi.UID
+	m.domain.Spec.UUID = string(vmi.UID)
+	if firmware := vmi.Spec.Domain.Firmware; firmware != nil && firmware.UUID != "" {
+		m.domain.Spec.UUID = string(firmware.UUID)
+	}
+	syncDevices(&m.domain.Spec, vmi)
+
+	m.domain.SetState(api.Shutoff, api.ReasonUnknown)
+	m.notifyLocked(watch.Added)
+}
+
+func (m *DomainManager) isInStateLocked(state api.LifeCycle, reason api.StateChangeReason) bool {
+	if m.domain == nil || m.domain.Status.Status != state {
+		return false
+	}
+	return reason == "" || m.domain.Status.Reason == reason
+}
+
+func (m *DomainManager) setStateLocked(state api.LifeCycle, reason api.StateChangeReason) {
+	log.Log.Infof("dry-run domain status: %s:%s", state, reason)
+	m.domain.SetState(state, reason)
+	m.notifyLocked(watch.Modified)
+}
+
+func (m *DomainManager) notifyLocked(eventType watch.EventType) {
+	m.notify(watch.Event{Type: eventType, Object: m.snapshotLocked()})
+}
+
+func (m *DomainManager) snapshotLocked() *api.Domain {
+	domain := m.domain.DeepCopy()
+	domain.Spec.Metadata.KubeVirt = metadata.LoadKubevirtMetadata(m.metadataCache)
+	return domain
+}
+
+// after runs the transition with the lock held, once the delay elapsed
+func (m *DomainManager) after(transition func()) {
+	time.AfterFunc(m.delay, func() {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		transition()
+	})
+}
+
+func (m *DomainManager) stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopped)
+	})
+}
+
+// syncDevices reflects the disks and interfaces of the VMI in the domain, so that their status
+// can be reported. It returns true if the devices changed.
+func syncDevices(spec *api.DomainSpec, vmi *v1.VirtualMachineInstance) bool {
+	var disks []api.Disk
+	for i, disk := range vmi.Spec.Domain.Devices.Disks {
+		disks = append(disks, api.Disk{
+			Device: "disk",
+			Type:   "file",
+			Alias:  api.NewUserDefinedAlias(disk.Name),
+			Target: api.DiskTarget{Bus: v1.DiskBusVirtio, Device: targetDevice(i)},
+		})
+	}
+
+	var interfaces []api.Interface
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		domainIface := api.Interface{
+			Type:  "ethernet",
+			Alias: api.NewUserDefinedAlias(iface.Name),
+		}
+		if iface.MacAddress != "" {
+			domainIface.MAC = &api.MAC{MAC: iface.MacAddress}
+		}
+		interfaces = append(interfaces, domainIface)
+	}
+
+	if reflect.DeepEqual(spec.Devices.Disks, disks) && reflect.DeepEqual(spec.Devices.Interfaces, interfaces) {
+		return false
+	}
+	spec.Devices.Disks = disks
+	spec.Devices.Interfaces = interfaces
+	return true
+}
+
+// targetDevice names the disks vda, vdb, ..., vdz, vdaa, ...
+func targetDevice(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('a'+(index-1)%26)) + name
+	}
+	return "vd" + name
+}
@@ -0,0 +1,227 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package dryrun
+
+import (
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/watch"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Dry-run domain manager", func() {
+	const delay = 10 * time.Millisecond
+
+	var (
+		manager       *DomainManager
+		metadataCache *metadata.Cache
+		vmi           *v1.VirtualMachineInstance
+
+		eventsLock sync.Mutex
+		events     []watch.Event
+	)
+
+	recordedEvents := func() []watch.Event {
+		eventsLock.Lock()
+		defer eventsLock.Unlock()
+		return append([]watch.Event{}, events...)
+	}
+
+	lastDomain := func() *api.Domain {
+		recorded := recordedEvents()
+		if len(recorded) == 0 {
+			return nil
+		}
+		return recorded[len(recorded)-1].Object.(*api.Domain)
+	}
+
+	haveState := func(state api.LifeCycle, reason api.StateChangeReason) func(g Gomega) {
+		return func(g Gomega) {
+			domain := lastDomain()
+			g.Expect(domain).ToNot(BeNil())
+			g.Expect(domain.Status.Status).To(Equal(state))
+			g.Expect(domain.Status.Reason).To(Equal(reason))
+		}
+	}
+
+	expectState := func(state api.LifeCycle, reason api.StateChangeReason) {
+		haveState(state, reason)(Default)
+	}
+
+	startDomain := func() {
+		_, err := manager.SyncVMI(vmi, false, &cmdv1.VirtualMachineOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(haveState(api.Running, api.ReasonUnknown)).Should(Succeed())
+	}
+
+	BeforeEach(func() {
+		events = nil
+		metadataCache = metadata.NewCache()
+		manager = NewDomainManager(metadataCache, delay, func(event watch.Event) {
+			eventsLock.Lock()
+			defer eventsLock.Unlock()
+			events = append(events, event)
+		})
+		vmi = libvmi.New(
+			libvmi.WithNamespace("default"),
+			libvmi.WithName("testvmi"),
+			libvmi.WithContainerDisk("disk0", "image"),
+			libvmi.WithInterface(v1.Interface{Name: "default", MacAddress: "02:00:00:00:00:01"}),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+		vmi.UID = "1234"
+	})
+
+	It("should define the domain and boot it after the delay", func() {
+		spec, err := manager.SyncVMI(vmi, false, &cmdv1.VirtualMachineOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec.UUID).To(Equal("1234"))
+		Expect(spec.Devices.Disks).To(ConsistOf(HaveField("Target.Device", "vda")))
+		Expect(spec.Devices.Interfaces).To(ConsistOf(HaveField("MAC", &api.MAC{MAC: "02:00:00:00:00:01"})))
+
+		Expect(recordedEvents()[0].Type).To(Equal(watch.Added))
+		Expect(lastDomain().Status.Status).To(Equal(api.Paused))
+		Expect(lastDomain().Status.Reason).To(Equal(api.ReasonPausedStartingUp))
+		Expect(lastDomain().Spec.Metadata.KubeVirt.UID).To(Equal(vmi.UID))
+
+		Eventually(haveState(api.Running, api.ReasonUnknown)).Should(Succeed())
+
+		domains, err := manager.ListAllDomains()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domains).To(HaveLen(1))
+	})
+
+	It("should report the device changes of the VMI", func() {
+		startDomain()
+
+		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{Name: "disk1"})
+		spec, err := manager.SyncVMI(vmi, false, &cmdv1.VirtualMachineOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec.Devices.Disks).To(HaveLen(2))
+		Expect(lastDomain().Spec.Devices.Disks).To(HaveLen(2))
+		Expect(recordedEvents()[len(recordedEvents())-1].Type).To(Equal(watch.Modified))
+	})
+
+	It("should pause and unpause the domain", func() {
+		startDomain()
+
+		Expect(manager.PauseVMI(vmi)).To(Succeed())
+		expectState(api.Paused, api.ReasonPausedUser)
+
+		Expect(manager.UnpauseVMI(vmi)).To(Succeed())
+		expectState(api.Running, api.ReasonUnknown)
+	})
+
+	It("should shut the domain off after the delay", func() {
+		startDomain()
+
+		Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+		expectState(api.Shutdown, api.ReasonUser)
+		Expect(manager.Stopped()).ToNot(BeClosed())
+
+		Eventually(haveState(api.Shutoff, api.ReasonShutdown)).Should(Succeed())
+		Eventually(manager.Stopped()).Should(BeClosed())
+	})
+
+	It("should shut the domain off immediately when killed", func() {
+		startDomain()
+
+		Expect(manager.KillVMI(vmi)).To(Succeed())
+		expectState(api.Shutoff, api.ReasonDestroyed)
+		Expect(manager.Stopped()).To(BeClosed())
+	})
+
+	It("should notify the deletion of the domain", func() {
+		startDomain()
+
+		Expect(manager.DeleteVMI(vmi)).To(Succeed())
+		Expect(lastDomain().ObjectMeta.DeletionTimestamp).ToNot(BeNil())
+		Expect(manager.Stopped()).To(BeClosed())
+
+		domains, err := manager.ListAllDomains()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domains).To(BeEmpty())
+	})
+
+	It("should complete the migration of the source domain", func() {
+		startDomain()
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{MigrationUID: "migration"}
+
+		Expect(manager.MigrateVMI(vmi, nil)).To(Succeed())
+		migration, exists := metadataCache.Migration.Load()
+		Expect(exists).To(BeTrue())
+		Expect(migration.UID).To(BeEquivalentTo("migration"))
+		Expect(migration.EndTimestamp).To(BeNil())
+
+		Eventually(haveState(api.Shutoff, api.ReasonMigrated)).Should(Succeed())
+		migration, _ = metadataCache.Migration.Load()
+		Expect(migration.Completed).To(BeTrue())
+		Expect(migration.Failed).To(BeFalse())
+		Expect(manager.Stopped()).To(BeClosed())
+	})
+
+	It("should abort a migration in progress", func() {
+		startDomain()
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{MigrationUID: "migration"}
+
+		Expect(manager.MigrateVMI(vmi, nil)).To(Succeed())
+		Expect(manager.CancelVMIMigration(vmi)).To(Succeed())
+
+		migration, _ := metadataCache.Migration.Load()
+		Expect(migration.Failed).To(BeTrue())
+		Expect(migration.AbortStatus).To(Equal(string(v1.MigrationAbortSucceeded)))
+		Consistently(haveState(api.Running, api.ReasonUnknown), 5*delay).Should(Succeed())
+	})
+
+	It("should resume the migration target after the delay", func() {
+		Expect(manager.PrepareMigrationTarget(vmi, false, &cmdv1.VirtualMachineOptions{})).To(Succeed())
+		expectState(api.Paused, api.ReasonPausedMigration)
+
+		Eventually(haveState(api.Running, api.ReasonUnknown)).Should(Succeed())
+	})
+
+	It("should report that no guest runs", func() {
+		startDomain()
+
+		Expect(manager.GuestPing("")).To(MatchError(errNoGuest))
+		_, err := manager.Exec("", "", nil, 0)
+		Expect(err).To(MatchError(errNoGuest))
+	})
+
+	DescribeTable("should name the disk targets", func(index int, expected string) {
+		Expect(targetDevice(index)).To(Equal(expected))
+	},
+		Entry("first disk", 0, "vda"),
+		Entry("last single letter disk", 25, "vdz"),
+		Entry("first double letter disk", 26, "vdaa"),
+		Entry("second double letter disk", 27, "vdab"),
+		Entry("first disk after vdaz", 52, "vdba"),
+	)
+})
@@ -0,0 +1,240 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// flattenPollInterval is the interval the block pull jobs flattening the volumes are polled at
+var flattenPollInterval = 2 * time.Second
+
+// FlattenVolumes starts a block pull job for each of the requested volumes, copying the data of the
+// backing chain of the volume into its overlay. The jobs run in the background, their progress is
+// reported in the flatten metadata of the domain.
+func (l *LibvirtDomainManager) FlattenVolumes(vmi *v1.VirtualMachineInstance, options *v1.FlattenOptions) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedGetDomain)
+		return err
+	}
+	defer dom.Free()
+
+	disks, err := getAllDomainDisks(dom)
+	if err != nil {
+		return err
+	}
+	targets, err := flattenTargets(disks, options.Volumes)
+	if err != nil {
+		return err
+	}
+
+	for _, volume := range targets {
+		if l.isVolumeFlattenInProgress(volume.name) {
+			log.Log.Object(vmi).Infof("volume %s is already being flattened", volume.name)
+			continue
+		}
+		if err := dom.BlockPull(volume.target, 0, 0); err != nil {
+			return fmt.Errorf("failed to start flattening volume %s: %v", volume.name, err)
+		}
+		log.Log.Object(vmi).Infof("Started flattening volume %s", volume.name)
+		l.updateVolumeFlatten(volume.name, func(flatten *api.VolumeFlattenMetadata) {
+			now := metav1.Now()
+			*flatten = api.VolumeFlattenMetadata{
+				Name:           volume.name,
+				StartTimestamp: &now,
+			}
+		})
+		go l.monitorVolumeFlatten(vmi, volume.name, volume.target)
+	}
+
+	return nil
+}
+
+type flattenTarget struct {
+	name   string
+	target string
+}
+
+func hasBackingChain(disk api.Disk) bool {
+	return disk.BackingStore != nil && disk.BackingStore.Source != nil
+}
+
+// flattenTargets returns the disk targets of the volumes to flatten, all the volumes with a backing chain
+// when no volume is requested
+func flattenTargets(disks []api.Disk, volumes []string) ([]flattenTarget, error) {
+	disksByName := map[string]api.Disk{}
+	var targets []flattenTarget
+	for _, disk := range disks {
+		if disk.Alias == nil {
+			continue
+		}
+		disksByName[disk.Alias.GetName()] = disk
+		if len(volumes) == 0 && hasBackingChain(disk) {
+			targets = append(targets, flattenTarget{name: disk.Alias.GetName(), target: disk.Target.Device})
+		}
+	}
+
+	if len(volumes) == 0 {
+		if len(targets) == 0 {
+			return nil, fmt.Errorf("no volume has a backing chain")
+		}
+		return targets, nil
+	}
+
+	for _, volume := range volumes {
+		disk, exists := disksByName[volume]
+		if !exists {
+			return nil, fmt.Errorf("volume %s is not attached as a disk", volume)
+		}
+		if !hasBackingChain(disk) {
+			return nil, fmt.Errorf("volume %s has no backing chain", volume)
+		}
+		targets = append(targets, flattenTarget{name: volume, target: disk.Target.Device})
+	}
+	return targets, nil
+}
+
+func (l *LibvirtDomainManager) isVolumeFlattenInProgress(volume string) bool {
+	flatten, _ := l.metadataCache.Flatten.Load()
+	if flatten == nil {
+		return false
+	}
+	for _, volumeFlatten := range flatten.Volumes {
+		if volumeFlatten.Name == volume {
+			return !volumeFlatten.Completed
+		}
+	}
+	return false
+}
+
+// updateVolumeFlatten updates the flatten metadata of a volume. The metadata is copied and replaced,
+// for the cache to detect the change.
+func (l *LibvirtDomainManager) updateVolumeFlatten(volume string, update func(flatten *api.VolumeFlattenMetadata)) {
+	l.metadataCache.Flatten.WithSafeBlock(func(flatten **api.FlattenMetadata, _ bool) {
+		newFlatten := (*flatten).DeepCopy()
+		if newFlatten == nil {
+			newFlatten = &api.FlattenMetadata{}
+		}
+
+		index := -1
+		for i := range newFlatten.Volumes {
+			if newFlatten.Volumes[i].Name == volume {
+				index = i
+			}
+		}
+		if index < 0 {
+			newFlatten.Volumes = append(newFlatten.Volumes, api.VolumeFlattenMetadata{Name: volume})
+			index = len(newFlatten.Volumes) - 1
+		}
+		update(&newFlatten.Volumes[index])
+
+		if *flatten == nil || !equality.Semantic.DeepEqual(*flatten, newFlatten) {
+			*flatten = newFlatten
+		}
+	})
+}
+
+func (l *LibvirtDomainManager) setVolumeFlattenResult(volume string, err error) {
+	l.updateVolumeFlatten(volume, func(flatten *api.VolumeFlattenMetadata) {
+		now := metav1.Now()
+		flatten.Completed = true
+		flatten.EndTimestamp = &now
+		if err != nil {
+			flatten.Failed = true
+			flatten.FailureReason = err.Error()
+		} else {
+			flatten.Progress = 100
+		}
+	})
+}
+
+func (l *LibvirtDomainManager) monitorVolumeFlatten(vmi *v1.VirtualMachineInstance, volume, target string) {
+	logger := log.Log.Object(vmi)
+
+	ticker := time.NewTicker(flattenPollInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		completed, err := l.pollVolumeFlatten(vmi, volume, target)
+		if err != nil {
+			logger.Reason(err).Errorf("Failed to flatten volume %s", volume)
+			l.setVolumeFlattenResult(volume, err)
+			return
+		}
+		if completed {
+			logger.Infof("Completed flattening volume %s", volume)
+			l.setVolumeFlattenResult(volume, nil)
+			return
+		}
+	}
+}
+
+// pollVolumeFlatten updates the progress of the block pull job of a volume, and returns whether it completed
+func (l *LibvirtDomainManager) pollVolumeFlatten(vmi *v1.VirtualMachineInstance, volume, target string) (bool, error) {
+	dom, err := l.virConn.LookupDomainByName(api.VMINamespaceKeyFunc(vmi))
+	if err != nil {
+		return false, err
+	}
+	defer dom.Free()
+
+	info, err := dom.GetBlockJobInfo(target, 0)
+	if err != nil {
+		return false, err
+	}
+	if info.Type != libvirt.DOMAIN_BLOCK_JOB_TYPE_UNKNOWN {
+		if info.End > 0 {
+			progress := int32(info.Cur * 100 / info.End)
+			l.updateVolumeFlatten(volume, func(flatten *api.VolumeFlattenMetadata) {
+				flatten.Progress = progress
+			})
+		}
+		return false, nil
+	}
+
+	// The job is gone, it succeeded when the backing chain was removed from the disk
+	disks, err := getAllDomainDisks(dom)
+	if err != nil {
+		return false, err
+	}
+	for _, disk := range disks {
+		if disk.Target.Device != target {
+			continue
+		}
+		if hasBackingChain(disk) {
+			return false, fmt.Errorf("the block pull job ended before the backing chain was removed")
+		}
+		return true, nil
+	}
+	return false, fmt.Errorf("disk %s was detached", target)
+}
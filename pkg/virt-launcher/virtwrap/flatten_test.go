@@ -0,0 +1,177 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virtwrap
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	virtwrapapi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Volume flatten", func() {
+	const domainXML = `<domain type="kvm">
+  <name>default_testvmi</name>
+  <devices>
+    <disk type="file" device="disk">
+      <driver name="qemu" type="qcow2"></driver>
+      <source file="/var/run/kubevirt-ephemeral-disks/disk-data/containerdisk/disk.qcow2"></source>
+      <backingStore type="file">
+        <format type="raw"></format>
+        <source file="/var/run/kubevirt/container-disks/disk_0.img"></source>
+      </backingStore>
+      <target dev="vda" bus="virtio"></target>
+      <alias name="ua-containerdisk"></alias>
+    </disk>
+    <disk type="file" device="disk">
+      <driver name="qemu" type="raw"></driver>
+      <source file="/var/run/kubevirt-private/vmi-disks/datadisk/disk.img"></source>
+      <backingStore></backingStore>
+      <target dev="vdb" bus="virtio"></target>
+      <alias name="ua-datadisk"></alias>
+    </disk>
+  </devices>
+</domain>`
+
+	const flattenedDomainXML = `<domain type="kvm">
+  <name>default_testvmi</name>
+  <devices>
+    <disk type="file" device="disk">
+      <driver name="qemu" type="qcow2"></driver>
+      <source file="/var/run/kubevirt-ephemeral-disks/disk-data/containerdisk/disk.qcow2"></source>
+      <backingStore></backingStore>
+      <target dev="vda" bus="virtio"></target>
+      <alias name="ua-containerdisk"></alias>
+    </disk>
+  </devices>
+</domain>`
+
+	var (
+		ctrl          *gomock.Controller
+		mockConn      *cli.MockConnection
+		mockDomain    *cli.MockVirDomain
+		metadataCache *metadata.Cache
+		manager       DomainManager
+		vmi           *v1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockConn = cli.NewMockConnection(ctrl)
+		mockDomain = cli.NewMockVirDomain(ctrl)
+		metadataCache = metadata.NewCache()
+
+		var err error
+		manager, err = NewLibvirtDomainManager(mockConn, "fake", "fake", nil, "/usr/share/OVMF", nil, metadataCache)
+		Expect(err).ToNot(HaveOccurred())
+
+		vmi = api.NewMinimalVMIWithNS("default", "testvmi")
+		mockConn.EXPECT().LookupDomainByName("default_testvmi").Return(mockDomain, nil).AnyTimes()
+		mockDomain.EXPECT().Free().AnyTimes()
+
+		originalInterval := flattenPollInterval
+		flattenPollInterval = 10 * time.Millisecond
+		DeferCleanup(func() {
+			flattenPollInterval = originalInterval
+		})
+	})
+
+	volumeFlatten := func(volume string) func() *virtwrapapi.VolumeFlattenMetadata {
+		return func() *virtwrapapi.VolumeFlattenMetadata {
+			flatten, _ := metadataCache.Flatten.Load()
+			if flatten == nil {
+				return nil
+			}
+			for _, volumeFlatten := range flatten.Volumes {
+				if volumeFlatten.Name == volume {
+					return &volumeFlatten
+				}
+			}
+			return nil
+		}
+	}
+
+	It("should pull the backing chains of all the volumes having one", func() {
+		gomock.InOrder(
+			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(domainXML, nil),
+			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(flattenedDomainXML, nil),
+		)
+		mockDomain.EXPECT().BlockPull("vda", uint64(0), libvirt.DomainBlockPullFlags(0)).Return(nil)
+		gomock.InOrder(
+			mockDomain.EXPECT().GetBlockJobInfo("vda", gomock.Any()).Return(&libvirt.DomainBlockJobInfo{
+				Type: libvirt.DOMAIN_BLOCK_JOB_TYPE_PULL, Cur: 50, End: 100,
+			}, nil),
+			mockDomain.EXPECT().GetBlockJobInfo("vda", gomock.Any()).Return(&libvirt.DomainBlockJobInfo{}, nil),
+		)
+
+		Expect(manager.FlattenVolumes(vmi, &v1.FlattenOptions{})).To(Succeed())
+
+		Eventually(volumeFlatten("containerdisk")).Should(And(
+			HaveField("Completed", BeTrue()),
+			HaveField("Failed", BeFalse()),
+			HaveField("Progress", BeEquivalentTo(100)),
+			HaveField("StartTimestamp", Not(BeNil())),
+			HaveField("EndTimestamp", Not(BeNil())),
+		))
+		Expect(volumeFlatten("datadisk")()).To(BeNil())
+	})
+
+	It("should fail the flatten when the job ends before the backing chain is pulled", func() {
+		mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(domainXML, nil).Times(2)
+		mockDomain.EXPECT().BlockPull("vda", uint64(0), libvirt.DomainBlockPullFlags(0)).Return(nil)
+		mockDomain.EXPECT().GetBlockJobInfo("vda", gomock.Any()).Return(&libvirt.DomainBlockJobInfo{}, nil)
+
+		Expect(manager.FlattenVolumes(vmi, &v1.FlattenOptions{Volumes: []string{"containerdisk"}})).To(Succeed())
+
+		Eventually(volumeFlatten("containerdisk")).Should(And(
+			HaveField("Completed", BeTrue()),
+			HaveField("Failed", BeTrue()),
+			HaveField("FailureReason", ContainSubstring("before the backing chain was removed")),
+		))
+	})
+
+	It("should not start a second job for a volume being flattened", func() {
+		metadataCache.Flatten.Set(&virtwrapapi.FlattenMetadata{
+			Volumes: []virtwrapapi.VolumeFlattenMetadata{{Name: "containerdisk"}},
+		})
+		mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(domainXML, nil)
+
+		Expect(manager.FlattenVolumes(vmi, &v1.FlattenOptions{})).To(Succeed())
+	})
+
+	DescribeTable("should refuse to flatten", func(volume, expectedError string) {
+		mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(domainXML, nil)
+
+		err := manager.FlattenVolumes(vmi, &v1.FlattenOptions{Volumes: []string{volume}})
+		Expect(err).To(MatchError(ContainSubstring(expectedError)))
+	},
+		Entry("a volume without a backing chain", "datadisk", "volume datadisk has no backing chain"),
+		Entry("a volume which is not attached", "missing", "volume missing is not attached as a disk"),
+	)
+})
@@ -0,0 +1,197 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"libvirt.org/go/libvirt"
+	"libvirt.org/go/libvirtxml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+const forkImageName = "fork-memory.img"
+
+func isForkParent(vmi *v1.VirtualMachineInstance) bool {
+	_, exists := vmi.Annotations[v1.ForkParentAnnotation]
+	return exists
+}
+
+func isForked(vmi *v1.VirtualMachineInstance) bool {
+	_, exists := vmi.Annotations[v1.ForkFromAnnotation]
+	return exists
+}
+
+// forkImagePath returns the path of the fork image on the volume holding it, next to the disk image
+// the forked VMIs are backed by.
+func forkImagePath(volumeName string) string {
+	return filepath.Join(hostdisk.GetMountedHostDiskDir(volumeName), forkImageName)
+}
+
+// saveForkImage saves the memory and the device state of the paused domain of a fork parent to
+// its fork image. The domain is stopped, its disks are left untouched for the forked VMIs to use
+// as backing images.
+func (l *LibvirtDomainManager) saveForkImage(vmi *v1.VirtualMachineInstance, dom cli.VirDomain) error {
+	logger := log.Log.Object(vmi)
+
+	imagePath := forkImagePath(vmi.Annotations[v1.ForkParentAnnotation])
+	if err := dom.SaveFlags(imagePath, "", libvirt.DOMAIN_SAVE_PAUSED); err != nil {
+		logger.Reason(err).Error("Saving the fork image failed.")
+		return err
+	}
+	l.paused.remove(vmi.UID)
+
+	logger.Infof("Fork image saved to %s.", imagePath)
+	return nil
+}
+
+// restoreForkedDomain starts the domain of a forked VMI from the fork image of its parent, in
+// place of booting it. The defined domain is replaced by the saved domain of the parent, which
+// keeps the devices of the parent but takes over the name, the metadata and the backing files
+// of the defined domain. It returns the restored domain.
+func (l *LibvirtDomainManager) restoreForkedDomain(vmi *v1.VirtualMachineInstance, dom cli.VirDomain) (cli.VirDomain, error) {
+	logger := log.Log.Object(vmi)
+
+	if err := l.generateCloudInitISO(vmi, &dom); err != nil {
+		return nil, err
+	}
+
+	definedXML, err := dom.GetXMLDesc(0)
+	if err != nil {
+		logger.Reason(err).Error("Getting the domain XML failed.")
+		return nil, err
+	}
+	imagePath := forkImagePath(vmi.Annotations[v1.ForkFromAnnotation])
+	savedXML, err := l.virConn.DomainSaveImageGetXMLDesc(imagePath, libvirt.DOMAIN_SAVE_IMAGE_XML_SECURE)
+	if err != nil {
+		logger.Reason(err).Errorf("Reading the fork image %s failed.", imagePath)
+		return nil, err
+	}
+	restoreXML, err := forkedDomainXML(savedXML, definedXML)
+	if err != nil {
+		logger.Reason(err).Error("Generating the forked domain failed.")
+		return nil, err
+	}
+
+	// The restored domain has the UUID of the parent, the defined domain would conflict with it
+	if err := dom.UndefineFlags(libvirt.DOMAIN_UNDEFINE_KEEP_NVRAM); err != nil {
+		logger.Reason(err).Error("Undefining the domain failed.")
+		return nil, err
+	}
+	// The restored domain gets a new generation ID
+	if err := l.virConn.DomainRestoreFlags(imagePath, restoreXML, libvirt.DOMAIN_SAVE_RUNNING); err != nil {
+		logger.Reason(err).Errorf("Restoring the fork image %s failed.", imagePath)
+		return nil, err
+	}
+
+	restored, err := l.virConn.LookupDomainByName(api.VMINamespaceKeyFunc(vmi))
+	if err != nil {
+		logger.Reason(err).Error(failedGetDomain)
+		return nil, err
+	}
+	// The restored domain is transient, it is defined to outlive its shutdown like a booted domain
+	restoredXML, err := restored.GetXMLDesc(libvirt.DOMAIN_XML_INACTIVE)
+	if err == nil {
+		var persistent cli.VirDomain
+		if persistent, err = l.virConn.DomainDefineXML(restoredXML); err == nil {
+			err = persistent.Free()
+		}
+	}
+	if err != nil {
+		restored.Free()
+		logger.Reason(err).Error("Defining the restored domain failed.")
+		return nil, err
+	}
+
+	logger.Infof("Domain restored from the fork image %s.", imagePath)
+	if err := l.setGuestTime(vmi); err != nil {
+		logger.Reason(err).Warning("Failed to sync the guest time of the forked domain.")
+	}
+	return restored, nil
+}
+
+// forkedDomainXML returns the saved domain of a fork parent adapted to the defined domain of a
+// forked VMI. The guest visible devices are the ones of the parent, libvirt refuses to restore
+// them otherwise, their backends are the ones of the forked VMI.
+func forkedDomainXML(savedXML, definedXML string) (string, error) {
+	saved := &libvirtxml.Domain{}
+	if err := saved.Unmarshal(savedXML); err != nil {
+		return "", err
+	}
+	defined := &libvirtxml.Domain{}
+	if err := defined.Unmarshal(definedXML); err != nil {
+		return "", err
+	}
+	if saved.Devices == nil || defined.Devices == nil {
+		return "", fmt.Errorf("the domain has no devices")
+	}
+
+	saved.Name = defined.Name
+	saved.Metadata = defined.Metadata
+	if saved.OS != nil && defined.OS != nil {
+		saved.OS.NVRam = defined.OS.NVRam
+	}
+
+	definedDisks := map[string]libvirtxml.DomainDisk{}
+	for _, disk := range defined.Devices.Disks {
+		if disk.Target != nil {
+			definedDisks[disk.Target.Dev] = disk
+		}
+	}
+	for i, disk := range saved.Devices.Disks {
+		if disk.Target == nil {
+			continue
+		}
+		definedDisk, exists := definedDisks[disk.Target.Dev]
+		if !exists {
+			return "", fmt.Errorf("the forked VMI has no disk %s of its parent", disk.Target.Dev)
+		}
+		saved.Devices.Disks[i].Driver = definedDisk.Driver
+		saved.Devices.Disks[i].Source = definedDisk.Source
+		saved.Devices.Disks[i].BackingStore = definedDisk.BackingStore
+	}
+
+	if len(saved.Devices.Serials) != len(defined.Devices.Serials) ||
+		len(saved.Devices.Consoles) != len(defined.Devices.Consoles) ||
+		len(saved.Devices.Channels) != len(defined.Devices.Channels) {
+		return "", fmt.Errorf("the character devices of the forked VMI differ from the ones of its parent")
+	}
+	for i := range saved.Devices.Serials {
+		saved.Devices.Serials[i].Source = defined.Devices.Serials[i].Source
+		saved.Devices.Serials[i].Log = defined.Devices.Serials[i].Log
+	}
+	for i := range saved.Devices.Consoles {
+		saved.Devices.Consoles[i].Source = defined.Devices.Consoles[i].Source
+		saved.Devices.Consoles[i].Log = defined.Devices.Consoles[i].Log
+	}
+	for i := range saved.Devices.Channels {
+		saved.Devices.Channels[i].Source = defined.Devices.Channels[i].Source
+	}
+	saved.Devices.Graphics = defined.Devices.Graphics
+
+	return saved.Marshal()
+}
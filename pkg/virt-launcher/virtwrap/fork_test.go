@@ -0,0 +1,97 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virtwrap
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"libvirt.org/go/libvirtxml"
+)
+
+var _ = Describe("forkedDomainXML", func() {
+	const savedXML = `<domain type="kvm">
+  <name>default_parent</name>
+  <uuid>4f3b9f5e-6f0b-4c7e-8c0e-2a1d3b5c7e90</uuid>
+  <genid>1f2e3d4c-5b6a-4978-8695-a4b3c2d1e0f9</genid>
+  <metadata><kubevirt xmlns="http://kubevirt.io"><uid>parent-uid</uid></kubevirt></metadata>
+  <devices>
+    <disk type="file" device="disk">
+      <driver name="qemu" type="raw"></driver>
+      <source file="/var/run/kubevirt-private/vmi-disks/rootdisk/disk.img"></source>
+      <target dev="vda" bus="virtio"></target>
+      <address type="pci" domain="0x0000" bus="0x07" slot="0x00" function="0x0"></address>
+    </disk>
+    <serial type="unix">
+      <source mode="bind" path="/var/run/kubevirt-private/parent-uid/virt-serial0"></source>
+      <target port="0"></target>
+    </serial>
+  </devices>
+</domain>`
+
+	const definedXML = `<domain type="kvm">
+  <name>default_child</name>
+  <uuid>9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d</uuid>
+  <metadata><kubevirt xmlns="http://kubevirt.io"><uid>child-uid</uid></kubevirt></metadata>
+  <devices>
+    <disk type="file" device="disk">
+      <driver name="qemu" type="qcow2"></driver>
+      <source file="/var/run/kubevirt-ephemeral-disks/disk-data/rootdisk/disk.qcow2"></source>
+      <backingStore type="file">
+        <format type="raw"></format>
+        <source file="/var/run/kubevirt-private/vmi-disks/rootdisk/disk.img"></source>
+      </backingStore>
+      <target dev="vda" bus="virtio"></target>
+    </disk>
+    <serial type="unix">
+      <source mode="bind" path="/var/run/kubevirt-private/child-uid/virt-serial0"></source>
+      <target port="0"></target>
+    </serial>
+  </devices>
+</domain>`
+
+	It("should keep the devices of the parent with the backends of the forked VMI", func() {
+		forkedXML, err := forkedDomainXML(savedXML, definedXML)
+		Expect(err).ToNot(HaveOccurred())
+
+		forked := &libvirtxml.Domain{}
+		Expect(forked.Unmarshal(forkedXML)).To(Succeed())
+		Expect(forked.Name).To(Equal("default_child"))
+		Expect(forked.UUID).To(Equal("4f3b9f5e-6f0b-4c7e-8c0e-2a1d3b5c7e90"))
+		Expect(forked.GenID).ToNot(BeNil())
+		Expect(forked.Metadata.XML).To(ContainSubstring("child-uid"))
+
+		Expect(forked.Devices.Disks).To(HaveLen(1))
+		disk := forked.Devices.Disks[0]
+		Expect(disk.Source.File.File).To(Equal("/var/run/kubevirt-ephemeral-disks/disk-data/rootdisk/disk.qcow2"))
+		Expect(disk.Driver.Type).To(Equal("qcow2"))
+		Expect(disk.BackingStore).ToNot(BeNil())
+		Expect(disk.Address.PCI).ToNot(BeNil())
+		Expect(*disk.Address.PCI.Bus).To(BeEquivalentTo(7))
+
+		Expect(forked.Devices.Serials).To(HaveLen(1))
+		Expect(forked.Devices.Serials[0].Source.UNIX.Path).To(Equal("/var/run/kubevirt-private/child-uid/virt-serial0"))
+	})
+
+	It("should fail when the forked VMI misses a disk of the parent", func() {
+		_, err := forkedDomainXML(savedXML, `<domain type="kvm"><name>default_child</name><devices></devices></domain>`)
+		Expect(err).To(MatchError(ContainSubstring("has no disk vda")))
+	})
+})
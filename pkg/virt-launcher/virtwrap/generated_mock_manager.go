@@ -9,6 +9,7 @@ import (
 
 	v10 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	cmd_client "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	agent "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	stats "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
@@ -255,6 +256,16 @@ func (_mr *_MockDomainManagerRecorder) GetGuestOSInfo() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetGuestOSInfo")
 }
 
+func (_m *MockDomainManager) GuestDNS() *api.GuestDNS {
+	ret := _m.ctrl.Call(_m, "GuestDNS")
+	ret0, _ := ret[0].(*api.GuestDNS)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestDNS() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestDNS")
+}
+
 func (_m *MockDomainManager) Exec(_param0 string, _param1 string, _param2 []string, _param3 int32) (string, error) {
 	ret := _m.ctrl.Call(_m, "Exec", _param0, _param1, _param2, _param3)
 	ret0, _ := ret[0].(string)
@@ -339,6 +350,16 @@ func (_mr *_MockDomainManagerRecorder) InjectLaunchSecret(arg0, arg1 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "InjectLaunchSecret", arg0, arg1)
 }
 
+func (_m *MockDomainManager) FlattenVolumes(_param0 *v1.VirtualMachineInstance, _param1 *v1.FlattenOptions) error {
+	ret := _m.ctrl.Call(_m, "FlattenVolumes", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) FlattenVolumes(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "FlattenVolumes", arg0, arg1)
+}
+
 func (_m *MockDomainManager) UpdateGuestMemory(vmi *v1.VirtualMachineInstance) error {
 	ret := _m.ctrl.Call(_m, "UpdateGuestMemory", vmi)
 	ret0, _ := ret[0].(error)
@@ -348,3 +369,33 @@ func (_m *MockDomainManager) UpdateGuestMemory(vmi *v1.VirtualMachineInstance) e
 func (_mr *_MockDomainManagerRecorder) UpdateGuestMemory(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateGuestMemory", arg0)
 }
+
+func (_m *MockDomainManager) GuestAgentPolicy() *agent.CommandPolicy {
+	ret := _m.ctrl.Call(_m, "GuestAgentPolicy")
+	ret0, _ := ret[0].(*agent.CommandPolicy)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestAgentPolicy() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestAgentPolicy")
+}
+
+func (_m *MockDomainManager) SaveLauncherState(path string) error {
+	ret := _m.ctrl.Call(_m, "SaveLauncherState", path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) SaveLauncherState(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SaveLauncherState", arg0)
+}
+
+func (_m *MockDomainManager) RestoreLauncherState(path string) error {
+	ret := _m.ctrl.Call(_m, "RestoreLauncherState", path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) RestoreLauncherState(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RestoreLauncherState", arg0)
+}
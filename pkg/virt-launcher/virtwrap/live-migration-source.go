@@ -29,10 +29,12 @@ import (
 
 	"libvirt.org/go/libvirtxml"
 
+	"kubevirt.io/kubevirt/pkg/faultinjection"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
 
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
 
@@ -89,6 +91,8 @@ type migrationMonitor struct {
 	progressTimeout          int64
 	acceptableCompletionTime int64
 	migrationFailedWithError error
+
+	faults *faultinjection.Config
 }
 
 type inflightMigrationAborted struct {
@@ -416,6 +420,23 @@ func (l *LibvirtDomainManager) setMigrationResult(failed bool, reason string, ab
 	return l.setMigrationResultHelper(failed, true, reason, abortStatus)
 }
 
+// setMigrationDowntime records the downtime of the guest measured by QEMU
+// at the switchover of a completed migration
+func (l *LibvirtDomainManager) setMigrationDowntime(stats *libvirt.DomainJobInfo) {
+	if !stats.DowntimeSet {
+		return
+	}
+	if _, exists := l.metadataCache.Migration.Load(); !exists {
+		return
+	}
+
+	downtime := stats.Downtime
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		migrationMetadata.DowntimeMilliseconds = &downtime
+	})
+	log.Log.V(2).Infof("the guest was down for %dms at the switchover of the migration", downtime)
+}
+
 func (l *LibvirtDomainManager) setMigrationAbortStatus(abortStatus v1.MigrationAbortStatus) error {
 	return l.setMigrationResultHelper(false, false, "", abortStatus)
 }
@@ -430,6 +451,7 @@ func newMigrationMonitor(vmi *v1.VirtualMachineInstance, l *LibvirtDomainManager
 		remainingData:            0,
 		progressTimeout:          options.ProgressTimeout,
 		acceptableCompletionTime: options.CompletionTimeoutPerGiB * getVMIMigrationDataSize(vmi, l.ephemeralDiskDir),
+		faults:                   faultinjection.ForVMI(vmi),
 	}
 
 	return monitor
@@ -536,6 +558,17 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 		// If we were to abort the migration due to a timeout while in post copy,
 		// then it would result in that active state being lost.
 
+	case m.faults.DropsMigration(m.remainingData, stats.DataTotal):
+		// the migration is reported as failed, as if its connection was lost
+		err := dom.AbortJob()
+		if err != nil {
+			logger.Reason(err).Error("failed to abort migration")
+			return nil
+		}
+
+		aborted := &inflightMigrationAborted{}
+		aborted.message = fmt.Sprintf("Live migration connection dropped at %d%% progress by fault injection", m.faults.Migration.DropConnectionAtProgress)
+		return aborted
 	case m.shouldAssistMigrationToComplete(elapsed) && !m.isPausedMigration():
 		if m.options.AllowPostCopy {
 			logger.Info("Starting post copy mode for migration")
@@ -681,6 +714,7 @@ func (m *migrationMonitor) startMonitor() {
 			completedJobInfo = m.determineNonRunningMigrationStatus(dom)
 		case libvirt.DOMAIN_JOB_COMPLETED:
 			logger.Info("Migration has been completed")
+			m.l.setMigrationDowntime(stats)
 			m.l.setMigrationResult(false, "", "")
 			return
 		case libvirt.DOMAIN_JOB_FAILED:
@@ -754,6 +788,11 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 	if err != nil {
 		return nil, err
 	}
+	// let the hook sidecars render the domain for the target node, e.g. its bridges
+	xmlstr, err = hooks.GetManager().OnMigrationSource(xmlstr, vmi)
+	if err != nil {
+		return nil, fmt.Errorf("executing the OnMigrationSource hooks failed: %v", err)
+	}
 
 	parallelMigrationSet, parallelMigrationThreads := shouldConfigureParallelMigration(options)
 
@@ -172,14 +172,16 @@ func (l *LibvirtDomainManager) prepareMigrationTarget(
 	if err != nil {
 		return err
 	}
-	// TODO this should probably a OnPrepareMigration hook or something.
-	// Right now we need to call OnDefineDomain, so that additional setup, which might be done
-	// by the hook can also be done for the new target pod
+	// OnDefineDomain is still called for the sidecars which predate OnMigrationTarget, so that additional
+	// setup, which might be done by the hook can also be done for the new target pod
 	hooksManager := hooks.GetManager()
 	_, err = hooksManager.OnDefineDomain(&dom.Spec, vmi)
 	if err != nil {
 		return fmt.Errorf("executing custom preStart hooks failed: %v", err)
 	}
+	if err := hooksManager.OnMigrationTarget(&dom.Spec, vmi); err != nil {
+		return fmt.Errorf("executing the OnMigrationTarget hooks failed: %v", err)
+	}
 
 	if shouldBlockMigrationTargetPreparation(vmi) {
 		return fmt.Errorf("Blocking preparation of migration target in order to satisfy a functional test condition")
@@ -66,6 +66,7 @@ import (
 	"libvirt.org/go/libvirt"
 
 	v1 "kubevirt.io/api/core/v1"
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
 	"kubevirt.io/client-go/log"
 
 	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
@@ -73,11 +74,15 @@ import (
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/emptydisk"
+	"kubevirt.io/kubevirt/pkg/emulation"
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
+	"kubevirt.io/kubevirt/pkg/faultinjection"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	"kubevirt.io/kubevirt/pkg/ignition"
 	netsriov "kubevirt.io/kubevirt/pkg/network/deviceinfo"
+	"kubevirt.io/kubevirt/pkg/network/imds"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
@@ -106,6 +111,7 @@ const (
 )
 
 const maxConcurrentHotplugHostDevices = 1
+const guestBootPollInterval = 5 * time.Second
 const maxConcurrentMemoryDumps = 1
 
 type contextStore struct {
@@ -136,6 +142,7 @@ type DomainManager interface {
 	HotplugHostDevices(vmi *v1.VirtualMachineInstance) error
 	InterfacesStatus() []api.InterfaceStatus
 	GetGuestOSInfo() *api.GuestOSInfo
+	GuestDNS() *api.GuestDNS
 	Exec(string, string, []string, int32) (string, error)
 	GuestPing(string) error
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
@@ -144,7 +151,11 @@ type DomainManager interface {
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
 	GetLaunchMeasurement(*v1.VirtualMachineInstance) (*v1.SEVMeasurementInfo, error)
 	InjectLaunchSecret(*v1.VirtualMachineInstance, *v1.SEVSecretOptions) error
+	FlattenVolumes(*v1.VirtualMachineInstance, *v1.FlattenOptions) error
 	UpdateGuestMemory(vmi *v1.VirtualMachineInstance) error
+	GuestAgentPolicy() *agent.CommandPolicy
+	SaveLauncherState(path string) error
+	RestoreLauncherState(path string) error
 }
 
 type LibvirtDomainManager struct {
@@ -156,6 +167,7 @@ type LibvirtDomainManager struct {
 	setGuestTimeLock sync.Mutex
 
 	credManager *accesscredentials.AccessCredentialManager
+	agentPolicy *agent.CommandPolicy
 
 	hotplugHostDevicesInProgress chan struct{}
 	memoryDumpInProgress         chan struct{}
@@ -165,6 +177,7 @@ type LibvirtDomainManager struct {
 	paused                   pausedVMIs
 	agentData                *agentpoller.AsyncAgentStore
 	cloudInitDataStore       *cloudinit.CloudInitData
+	metadataServiceStarted   bool
 	setGuestTimeContextPtr   *contextStore
 	efiEnvironment           *efi.EFIEnvironment
 	ovmfPath                 string
@@ -216,17 +229,19 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 		},
 		agentData:                agentStore,
 		efiEnvironment:           efi.DetectEFIEnvironment(runtime.GOARCH, ovmfPath),
+		ovmfPath:                 ovmfPath,
 		ephemeralDiskCreator:     ephemeralDiskCreator,
 		directIOChecker:          directIOChecker,
 		disksInfo:                map[string]*cmdv1.DiskInfo{},
 		cancelSafetyUnfreezeChan: make(chan struct{}),
 		migrateInfoStats:         &stats.DomainJobInfo{},
 		metadataCache:            metadataCache,
+		agentPolicy:              agent.NewCommandPolicy(),
 	}
 
 	manager.hotplugHostDevicesInProgress = make(chan struct{}, maxConcurrentHotplugHostDevices)
 	manager.memoryDumpInProgress = make(chan struct{}, maxConcurrentMemoryDumps)
-	manager.credManager = accesscredentials.NewManager(connection, &manager.domainModifyLock, metadataCache)
+	manager.credManager = accesscredentials.NewManager(connection, &manager.domainModifyLock, metadataCache, manager.agentPolicy)
 
 	reCalcDomainStats := func() (*stats.DomainStats, error) {
 		list, err := manager.getDomainStats()
@@ -436,6 +451,7 @@ func (l *LibvirtDomainManager) PrepareMigrationTarget(
 	allowEmulation bool,
 	options *cmdv1.VirtualMachineOptions,
 ) error {
+	l.agentPolicy.SetDenied(options.GetDeniedGuestAgentCommands())
 	return l.prepareMigrationTarget(vmi, allowEmulation, options)
 }
 
@@ -593,9 +609,17 @@ func (l *LibvirtDomainManager) hotPlugHostDevices(vmi *v1.VirtualMachineInstance
 }
 
 func (l *LibvirtDomainManager) Exec(domainName, command string, args []string, timeoutSeconds int32) (string, error) {
+	if err := l.agentPolicy.Check(guestagentv1alpha1.GuestAgentCommandExec); err != nil {
+		return "", err
+	}
 	return agent.GuestExec(l.virConn, domainName, command, args, timeoutSeconds)
 }
 
+// GuestAgentPolicy returns the guest agent commands denied for the VMI
+func (l *LibvirtDomainManager) GuestAgentPolicy() *agent.CommandPolicy {
+	return l.agentPolicy
+}
+
 func (l *LibvirtDomainManager) GuestPing(domainName string) error {
 	pingCmd := `{"execute":"guest-ping"}`
 	_, err := l.virConn.QemuAgentCommand(pingCmd, domainName)
@@ -707,6 +731,24 @@ func (l *LibvirtDomainManager) generateCloudInitEmptyISO(vmi *v1.VirtualMachineI
 	return fmt.Errorf("failed to find the status of volume %s", l.cloudInitDataStore.VolumeName)
 }
 
+// startMetadataService serves the metadata of the VMI on the gateway of its masquerade interface.
+// The VMI starts without the service when it can't be served, e.g. on an IPv6 only pod network.
+func (l *LibvirtDomainManager) startMetadataService(vmi *v1.VirtualMachineInstance, cloudInitData *cloudinit.CloudInitData) {
+	logger := log.Log.Object(vmi)
+
+	address, err := imds.GatewayAddress(vmi)
+	if err != nil {
+		logger.Reason(err).Error("failed to start the metadata service")
+		return
+	}
+	if err := imds.NewServer(imds.NewMetadata(vmi, cloudInitData)).Start(address); err != nil {
+		logger.Reason(err).Error("failed to start the metadata service")
+		return
+	}
+	l.metadataServiceStarted = true
+	logger.Infof("Serving the metadata service on %s", address)
+}
+
 // All local environment setup that needs to occur before VirtualMachineInstance starts
 // can be done in this function. This includes things like...
 //
@@ -782,6 +824,10 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return domain, fmt.Errorf("preparing the pod network failed: %v", err)
 	}
 
+	if imds.IsEnabled(vmi) && !l.metadataServiceStarted {
+		l.startMetadataService(vmi, cloudInitData)
+	}
+
 	// Create ephemeral disk for container disks
 	err = containerdisk.CreateEphemeralImages(vmi, l.ephemeralDiskCreator, disksInfo)
 	if err != nil {
@@ -998,38 +1044,48 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		}
 	}
 
+	// A guest emulated on a node of another architecture is converted, and boots, for its own architecture
+	arch := runtime.GOARCH
+	efiEnvironment := l.efiEnvironment
+	crossArchitectureEmulation := emulation.IsCrossArchitecture(vmi, runtime.GOARCH)
+	if crossArchitectureEmulation {
+		arch = vmi.Spec.Architecture
+		efiEnvironment = efi.DetectEFIEnvironment(arch, l.ovmfPath)
+	}
+
 	var efiConf *converter.EFIConfiguration
 	if vmi.IsBootloaderEFI() {
 		secureBoot := vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot == nil || *vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot
 		sev := kutil.IsSEVVMI(vmi)
 
-		if !l.efiEnvironment.Bootable(secureBoot, sev) {
+		if !efiEnvironment.Bootable(secureBoot, sev) {
 			log.Log.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV=%v", secureBoot, sev)
 			return nil, fmt.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV=%v", secureBoot, sev)
 		}
 
 		efiConf = &converter.EFIConfiguration{
-			EFICode:      l.efiEnvironment.EFICode(secureBoot, sev),
-			EFIVars:      l.efiEnvironment.EFIVars(secureBoot, sev),
+			EFICode:      efiEnvironment.EFICode(secureBoot, sev),
+			EFIVars:      efiEnvironment.EFIVars(secureBoot, sev),
 			SecureLoader: secureBoot,
 		}
 	}
 
 	// Map the VirtualMachineInstance to the Domain
 	c := &converter.ConverterContext{
-		Architecture:          converter.NewArchConverter(runtime.GOARCH),
-		VirtualMachine:        vmi,
-		AllowEmulation:        allowEmulation,
-		CPUSet:                podCPUSet,
-		IsBlockPVC:            isBlockPVCMap,
-		IsBlockDV:             isBlockDVMap,
-		EFIConfiguration:      efiConf,
-		UseVirtioTransitional: vmi.Spec.Domain.Devices.UseVirtioTransitional != nil && *vmi.Spec.Domain.Devices.UseVirtioTransitional,
-		PermanentVolumes:      permanentVolumes,
-		EphemeraldiskCreator:  l.ephemeralDiskCreator,
-		UseLaunchSecurity:     kutil.IsSEVVMI(vmi),
-		FreePageReporting:     isFreePageReportingEnabled(false, vmi),
-		SerialConsoleLog:      isSerialConsoleLogEnabled(false, vmi),
+		Architecture:               converter.NewArchConverter(arch),
+		VirtualMachine:             vmi,
+		AllowEmulation:             allowEmulation,
+		CrossArchitectureEmulation: crossArchitectureEmulation,
+		CPUSet:                     podCPUSet,
+		IsBlockPVC:                 isBlockPVCMap,
+		IsBlockDV:                  isBlockDVMap,
+		EFIConfiguration:           efiConf,
+		UseVirtioTransitional:      vmi.Spec.Domain.Devices.UseVirtioTransitional != nil && *vmi.Spec.Domain.Devices.UseVirtioTransitional,
+		PermanentVolumes:           permanentVolumes,
+		EphemeraldiskCreator:       l.ephemeralDiskCreator,
+		UseLaunchSecurity:          kutil.IsSEVVMI(vmi),
+		FreePageReporting:          isFreePageReportingEnabled(false, vmi),
+		SerialConsoleLog:           isSerialConsoleLogEnabled(false, vmi),
 	}
 
 	if options != nil {
@@ -1105,6 +1161,8 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 
 	logger := log.Log.Object(vmi)
 
+	l.agentPolicy.SetDenied(options.GetDeniedGuestAgentCommands())
+
 	domain := &api.Domain{}
 
 	c, err := l.generateConverterContext(vmi, allowEmulation, options, false)
@@ -1121,6 +1179,8 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 	// Set defaults which are not coming from the cluster
 	api.NewDefaulter(c.Architecture.GetArchitecture()).SetObjectDefaults_Domain(domain)
 
+	throttleDisks(faultinjection.ForVMI(vmi), domain)
+
 	dom, err := l.lookupOrCreateVirDomain(domain, vmi, options)
 	if err != nil {
 		return nil, err
@@ -1136,10 +1196,24 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 	// TODO for migration and error detection we also need the state change reason
 	// TODO blocked state
 	switch {
+	case cli.IsDown(domState) && !vmi.IsRunning() && !vmi.IsFinal() && isForked(vmi):
+		restored, err := l.restoreForkedDomain(vmi, dom)
+		if err != nil {
+			return nil, err
+		}
+		defer restored.Free()
+		dom = restored
 	case cli.IsDown(domState) && !vmi.IsRunning() && !vmi.IsFinal():
 		if err := l.startDomain(vmi, dom); err != nil {
 			return nil, err
 		}
+		hooksManager := hooks.GetManager()
+		if err := runPostDomainStartHooks(vmi, dom, hooksManager); err != nil {
+			return nil, err
+		}
+		if hooksManager.HasHookPoint(hooksInfo.OnGuestBootHookPointName) {
+			go l.runGuestBootHooks(vmi.DeepCopy(), hooksManager, guestBootPollInterval)
+		}
 	case cli.IsPaused(domState) && !l.paused.contains(vmi.UID):
 		// TODO: if state change reason indicates a system error, we could try something smarter
 		if err := dom.Resume(); err != nil {
@@ -1163,10 +1237,29 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		return nil, err
 	}
 
+	if err := l.syncChannelHotplug(domain, oldSpec, dom, vmi); err != nil {
+		return nil, err
+	}
+
 	// TODO: check if VirtualMachineInstance Spec and Domain Spec are equal or if we have to sync
 	return oldSpec, nil
 }
 
+// throttleDisks limits the I/O of the disks slowed down by fault injection
+func throttleDisks(faults *faultinjection.Config, domain *api.Domain) {
+	for i, disk := range domain.Spec.Devices.Disks {
+		if disk.Alias == nil {
+			continue
+		}
+		if throttling := faults.ThrottledDisk(disk.Alias.GetName()); throttling != nil {
+			domain.Spec.Devices.Disks[i].IOTune = &api.DiskIOTune{
+				TotalIopsSec:  throttling.IOPS,
+				TotalBytesSec: throttling.BytesPerSecond,
+			}
+		}
+	}
+}
+
 func (l *LibvirtDomainManager) syncDiskHotplug(
 	domain *api.Domain,
 	spec *api.DomainSpec,
@@ -1255,7 +1348,7 @@ func (l *LibvirtDomainManager) syncNetworkHotplug(
 	}
 
 	networkConfigurator := netsetup.NewVMNetworkConfigurator(vmi, cache.CacheCreator{}, netsetup.WithDomainAttachments(domainAttachments))
-	networkInterfaceManager := newVirtIOInterfaceManager(dom, networkConfigurator)
+	networkInterfaceManager := newVirtIOInterfaceManager(dom, networkConfigurator, hooks.GetManager())
 	if err := networkInterfaceManager.hotplugVirtioInterface(vmi, &api.Domain{Spec: *oldSpec}, domain); err != nil {
 		return err
 	}
@@ -1288,6 +1381,59 @@ func (l *LibvirtDomainManager) startDomain(
 	return nil
 }
 
+// runPostDomainStartHooks passes the domain XML reported by libvirt for the started domain to the
+// PostDomainStart hook sidecars
+func runPostDomainStartHooks(vmi *v1.VirtualMachineInstance, dom cli.VirDomain, hooksManager hooks.Manager) error {
+	if !hooksManager.HasHookPoint(hooksInfo.PostDomainStartHookPointName) {
+		return nil
+	}
+
+	logger := log.Log.Object(vmi)
+	domainXML, err := dom.GetXMLDesc(0)
+	if err != nil {
+		logger.Reason(err).Error("Failed to get the XML of the started domain")
+		return err
+	}
+	if err := hooksManager.PostDomainStart(domainXML, vmi); err != nil {
+		logger.Reason(err).Error("PostDomainStart hooks failed")
+		return err
+	}
+	return nil
+}
+
+// runGuestBootHooks waits until the guest agent reported the guest OS after the boot of the domain,
+// then passes it to the OnGuestBoot hook sidecars. The result is reported in the domain metadata.
+func (l *LibvirtDomainManager) runGuestBootHooks(vmi *v1.VirtualMachineInstance, hooksManager hooks.Manager, pollInterval time.Duration) {
+	logger := log.Log.Object(vmi)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var osInfo *api.GuestOSInfo
+	for osInfo = l.agentData.GetGuestOSInfo(); osInfo == nil || osInfo.Name == ""; osInfo = l.agentData.GetGuestOSInfo() {
+		<-ticker.C
+	}
+
+	guestOSInfo := &v1.VirtualMachineInstanceGuestOSInfo{
+		Name:          osInfo.Name,
+		KernelRelease: osInfo.KernelRelease,
+		Version:       osInfo.Version,
+		PrettyName:    osInfo.PrettyName,
+		VersionID:     osInfo.VersionId,
+		KernelVersion: osInfo.KernelVersion,
+		Machine:       osInfo.Machine,
+		ID:            osInfo.Id,
+	}
+
+	logger.Info("Guest agent connected, running the OnGuestBoot hooks")
+	result := api.GuestBootHooksMetadata{Succeeded: true}
+	if err := hooksManager.OnGuestBoot(vmi, guestOSInfo); err != nil {
+		logger.Reason(err).Error("OnGuestBoot hooks failed")
+		result = api.GuestBootHooksMetadata{Message: err.Error()}
+	}
+	l.metadataCache.GuestBootHooks.Set(result)
+}
+
 func (l *LibvirtDomainManager) lookupOrCreateVirDomain(
 	domain *api.Domain,
 	vmi *v1.VirtualMachineInstance,
@@ -1393,6 +1539,57 @@ func getDetachedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	return res
 }
 
+func (l *LibvirtDomainManager) syncChannelHotplug(
+	domain *api.Domain,
+	spec *api.DomainSpec,
+	dom cli.VirDomain,
+	vmi *v1.VirtualMachineInstance,
+) error {
+	if !vmi.IsRunning() {
+		return nil
+	}
+	logger := log.Log.Object(vmi)
+
+	for _, attachChannel := range getAttachedChannels(spec.Devices.Channels, domain.Spec.Devices.Channels) {
+		logger.V(1).Infof("Attaching channel %s", attachChannel.Target.Name)
+		// The channel struct carries no element name, and the target name is case sensitive
+		attachBytes, err := xml.Marshal(struct {
+			XMLName xml.Name `xml:"channel"`
+			api.Channel
+		}{Channel: attachChannel})
+		if err != nil {
+			logger.Reason(err).Error("marshalling attached channel failed")
+			return err
+		}
+		if err := dom.AttachDeviceFlags(string(attachBytes), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			logger.Reason(err).Error("attaching channel")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getAttachedChannels returns the virtio channels which are not yet part of the running domain
+func getAttachedChannels(oldChannels, newChannels []api.Channel) []api.Channel {
+	oldTargets := make(map[string]struct{})
+	for _, channel := range oldChannels {
+		if channel.Target != nil {
+			oldTargets[channel.Target.Name] = struct{}{}
+		}
+	}
+	res := make([]api.Channel, 0)
+	for _, newChannel := range newChannels {
+		if newChannel.Target == nil || newChannel.Target.Type != v1.VirtIO {
+			continue
+		}
+		if _, ok := oldTargets[newChannel.Target.Name]; !ok {
+			res = append(res, newChannel)
+		}
+	}
+	return res
+}
+
 func getAttachedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	oldDiskMap := make(map[string]api.Disk)
 	for _, disk := range oldDisks {
@@ -1626,6 +1823,9 @@ func (l *LibvirtDomainManager) PauseVMI(vmi *v1.VirtualMachineInstance) error {
 		}
 		logger.Infof("Signaled pause for %s", vmi.GetObjectMeta().GetName())
 		l.paused.add(vmi.UID)
+		if isForkParent(vmi) {
+			return l.saveForkImage(vmi, dom)
+		}
 	} else {
 		logger.Infof("Domain is not running for %s", vmi.GetObjectMeta().GetName())
 	}
@@ -1826,6 +2026,11 @@ func (l *LibvirtDomainManager) SignalShutdownVMI(vmi *v1.VirtualMachineInstance)
 		return err
 	}
 
+	// The guest is only signaled once the pre-shutdown command of its shutdown policy exited
+	if domState == libvirt.DOMAIN_RUNNING && l.runPreShutdown(vmi) {
+		return nil
+	}
+
 	if domState == libvirt.DOMAIN_RUNNING || domState == libvirt.DOMAIN_PAUSED {
 		err = dom.ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT)
 		if err != nil {
@@ -1835,10 +2040,13 @@ func (l *LibvirtDomainManager) SignalShutdownVMI(vmi *v1.VirtualMachineInstance)
 		log.Log.Object(vmi).Infof("Signaled graceful shutdown for %s", vmi.GetObjectMeta().GetName())
 
 		l.metadataCache.GracePeriod.WithSafeBlock(func(gracePeriodMetadata *api.GracePeriodMetadata, _ bool) {
+			now := metav1.Now()
 			if gracePeriodMetadata.DeletionTimestamp == nil {
-				now := metav1.Now()
 				gracePeriodMetadata.DeletionTimestamp = &now
 			}
+			if vmi.Spec.ShutdownPolicy != nil && gracePeriodMetadata.ShutdownSignalTimestamp == nil {
+				gracePeriodMetadata.ShutdownSignalTimestamp = &now
+			}
 		})
 		log.Log.V(4).Infof("Graceful period set in metadata: %s", l.metadataCache.GracePeriod.String())
 	}
@@ -2173,6 +2381,11 @@ func (l *LibvirtDomainManager) GetGuestOSInfo() *api.GuestOSInfo {
 	return l.agentData.GetGuestOSInfo()
 }
 
+// GuestDNS returns the DNS configuration the Guest Agent reported
+func (l *LibvirtDomainManager) GuestDNS() *api.GuestDNS {
+	return l.agentData.GetGuestDNS()
+}
+
 // GetUsers return the full list of users on the guest machine
 func (l *LibvirtDomainManager) GetUsers() []v1.VirtualMachineInstanceGuestOSUser {
 	userInfo := l.agentData.GetUsers(-1)
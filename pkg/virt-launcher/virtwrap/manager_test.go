@@ -35,6 +35,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 
+	"kubevirt.io/kubevirt/pkg/faultinjection"
+	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -701,6 +704,19 @@ var _ = Describe("Manager", func() {
 
 			Expect(manager.PauseVMI(vmi)).To(Succeed())
 		})
+		It("should save the fork image of a paused fork parent", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Annotations = map[string]string{v1.ForkParentAnnotation: "parent-disk"}
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockDomain.EXPECT().Suspend().Return(nil)
+			mockDomain.EXPECT().SaveFlags("/var/run/kubevirt-private/vmi-disks/parent-disk/fork-memory.img", "", libvirt.DOMAIN_SAVE_PAUSED).Return(nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+
+			Expect(manager.PauseVMI(vmi)).To(Succeed())
+			Expect(manager.(*LibvirtDomainManager).paused.contains(vmi.UID)).To(BeFalse())
+		})
 		It("should not try to pause a paused VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
 
@@ -1519,6 +1535,44 @@ var _ = Describe("Manager", func() {
 			monitor := newMigrationMonitor(vmi, manager, options, migrationErrorChan)
 			monitor.startMonitor()
 		})
+		It("migration should record the downtime of the guest once completed", func() {
+			migrationErrorChan := make(chan error)
+			defer close(migrationErrorChan)
+			fake_jobinfo := &libvirt.DomainJobInfo{
+				Type:        libvirt.DOMAIN_JOB_COMPLETED,
+				Downtime:    87,
+				DowntimeSet: true,
+			}
+
+			options := &cmdclient.MigrationOptions{
+				Bandwidth:               resource.MustParse("64Mi"),
+				ProgressTimeout:         2,
+				CompletionTimeoutPerGiB: 300,
+			}
+
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: "111222333",
+			}
+
+			manager := &LibvirtDomainManager{
+				virConn:       mockConn,
+				virtShareDir:  testVirtShareDir,
+				metadataCache: metadataCache,
+			}
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().Return(fake_jobinfo, nil)
+			metadataCache.Migration.Store(api.MigrationMetadata{UID: "111222333"})
+
+			monitor := newMigrationMonitor(vmi, manager, options, migrationErrorChan)
+			monitor.startMonitor()
+
+			migration, _ := metadataCache.Migration.Load()
+			Expect(migration.Completed).To(BeTrue())
+			Expect(migration.Failed).To(BeFalse())
+			Expect(migration.DowntimeMilliseconds).To(HaveValue(Equal(uint64(87))))
+		})
 		It("migration should be canceled if timeout has been reached", func() {
 			migrationErrorChan := make(chan error)
 			defer close(migrationErrorChan)
@@ -1556,6 +1610,47 @@ var _ = Describe("Manager", func() {
 			monitor := newMigrationMonitor(vmi, manager, options, migrationErrorChan)
 			monitor.startMonitor()
 		})
+		It("migration should fail when its connection is dropped by fault injection", func() {
+			migrationErrorChan := make(chan error)
+			defer close(migrationErrorChan)
+			fake_jobinfo := &libvirt.DomainJobInfo{
+				Type:             libvirt.DOMAIN_JOB_UNBOUNDED,
+				DataRemaining:    40,
+				DataRemainingSet: true,
+				DataTotal:        100,
+				DataTotalSet:     true,
+			}
+
+			options := &cmdclient.MigrationOptions{
+				Bandwidth:               resource.MustParse("64Mi"),
+				ProgressTimeout:         150,
+				CompletionTimeoutPerGiB: 800,
+			}
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: "111222333",
+			}
+
+			manager := &LibvirtDomainManager{
+				virConn:       mockConn,
+				virtShareDir:  testVirtShareDir,
+				metadataCache: metadataCache,
+			}
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().Return(fake_jobinfo, nil)
+			mockDomain.EXPECT().AbortJob()
+			metadataCache.Migration.Store(api.MigrationMetadata{UID: "111222333"})
+
+			monitor := newMigrationMonitor(vmi, manager, options, migrationErrorChan)
+			monitor.faults = &faultinjection.Config{Migration: &faultinjection.MigrationFault{DropConnectionAtProgress: 50}}
+			monitor.startMonitor()
+
+			migration, _ := metadataCache.Migration.Load()
+			Expect(migration.Failed).To(BeTrue())
+			Expect(migration.AbortStatus).To(BeEmpty())
+			Expect(migration.FailureReason).To(Equal("Live migration connection dropped at 50% progress by fault injection"))
+		})
 		It("migration should switch to PostCopy", func() {
 			migrationErrorChan := make(chan error)
 			defer close(migrationErrorChan)
@@ -2204,6 +2299,82 @@ var _ = Describe("Manager", func() {
 			})
 		})
 
+		Context("on guest boot", func() {
+			var libvirtmanager *LibvirtDomainManager
+			var agentStore agentpoller.AsyncAgentStore
+			var hooksManager *hooks.MockManager
+
+			BeforeEach(func() {
+				agentStore = agentpoller.NewAsyncAgentStore()
+				manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, &agentStore, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+				libvirtmanager = manager.(*LibvirtDomainManager)
+				hooksManager = hooks.NewMockManager(ctrl)
+			})
+
+			It("should run the OnGuestBoot hooks once the guest agent reported the guest OS", func() {
+				vmi := newVMI(testNamespace, testVmName)
+				hooksManager.EXPECT().OnGuestBoot(vmi, &v1.VirtualMachineInstanceGuestOSInfo{Name: "Fedora Linux", VersionID: "40"}).Return(nil)
+
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					libvirtmanager.runGuestBootHooks(vmi, hooksManager, 10*time.Millisecond)
+				}()
+				Consistently(done).ShouldNot(BeClosed())
+				_, exists := metadataCache.GuestBootHooks.Load()
+				Expect(exists).To(BeFalse())
+
+				agentStore.Store(agentpoller.GET_OSINFO, api.GuestOSInfo{Name: "Fedora Linux", VersionId: "40"})
+				Eventually(done).Should(BeClosed())
+				result, exists := metadataCache.GuestBootHooks.Load()
+				Expect(exists).To(BeTrue())
+				Expect(result).To(Equal(api.GuestBootHooksMetadata{Succeeded: true}))
+			})
+
+			It("should report failed OnGuestBoot hooks", func() {
+				vmi := newVMI(testNamespace, testVmName)
+				agentStore.Store(agentpoller.GET_OSINFO, api.GuestOSInfo{Name: "Fedora Linux"})
+				hooksManager.EXPECT().OnGuestBoot(vmi, gomock.Any()).Return(fmt.Errorf("sidecar failed"))
+
+				libvirtmanager.runGuestBootHooks(vmi, hooksManager, 10*time.Millisecond)
+				result, exists := metadataCache.GuestBootHooks.Load()
+				Expect(exists).To(BeTrue())
+				Expect(result).To(Equal(api.GuestBootHooksMetadata{Message: "sidecar failed"}))
+			})
+		})
+
+		Context("on domain start", func() {
+			var hooksManager *hooks.MockManager
+
+			BeforeEach(func() {
+				hooksManager = hooks.NewMockManager(ctrl)
+			})
+
+			It("should pass the XML of the started domain to the PostDomainStart hooks", func() {
+				vmi := newVMI(testNamespace, testVmName)
+				hooksManager.EXPECT().HasHookPoint(hooksInfo.PostDomainStartHookPointName).Return(true)
+				mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return("<domain/>", nil)
+				hooksManager.EXPECT().PostDomainStart("<domain/>", vmi).Return(nil)
+
+				Expect(runPostDomainStartHooks(vmi, mockDomain, hooksManager)).To(Succeed())
+			})
+
+			It("should fail when the PostDomainStart hooks fail", func() {
+				vmi := newVMI(testNamespace, testVmName)
+				hooksManager.EXPECT().HasHookPoint(hooksInfo.PostDomainStartHookPointName).Return(true)
+				mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return("<domain/>", nil)
+				hooksManager.EXPECT().PostDomainStart("<domain/>", vmi).Return(fmt.Errorf("sidecar failed"))
+
+				Expect(runPostDomainStartHooks(vmi, mockDomain, hooksManager)).To(MatchError("sidecar failed"))
+			})
+
+			It("should not query the domain without PostDomainStart hooks", func() {
+				hooksManager.EXPECT().HasHookPoint(hooksInfo.PostDomainStartHookPointName).Return(false)
+
+				Expect(runPostDomainStartHooks(newVMI(testNamespace, testVmName), mockDomain, hooksManager)).To(Succeed())
+			})
+		})
+
 		Context("on call to InterfacesStatus", func() {
 			var libvirtmanager DomainManager
 			var agentStore agentpoller.AsyncAgentStore
@@ -2462,6 +2633,32 @@ var _ = Describe("Manager", func() {
 	// TODO: test error reporting on non successful VirtualMachineInstance syncs and kill attempts
 })
 
+var _ = Describe("getAttachedChannels", func() {
+	agentChannel := api.Channel{Type: "unix", Target: &api.ChannelTarget{Name: "org.qemu.guest_agent.0", Type: v1.VirtIO}}
+	userChannel := api.Channel{
+		Type:   "unix",
+		Source: &api.ChannelSource{Mode: "bind", Path: "/var/run/kubevirt-private/channels/logs.sock"},
+		Target: &api.ChannelTarget{Name: "org.example.logs.0", Type: v1.VirtIO},
+	}
+
+	DescribeTable("should return the correct values", func(oldChannels, newChannels, expected []api.Channel) {
+		Expect(getAttachedChannels(oldChannels, newChannels)).To(Equal(expected))
+	},
+		Entry("be empty when old and new are identical",
+			[]api.Channel{agentChannel},
+			[]api.Channel{agentChannel},
+			[]api.Channel{}),
+		Entry("contain the channel missing from the domain",
+			[]api.Channel{agentChannel},
+			[]api.Channel{agentChannel, userChannel},
+			[]api.Channel{userChannel}),
+		Entry("be empty when the channel is already attached",
+			[]api.Channel{agentChannel, userChannel},
+			[]api.Channel{agentChannel, userChannel},
+			[]api.Channel{}),
+	)
+})
+
 var _ = Describe("getAttachedDisks", func() {
 	DescribeTable("should return the correct values", func(oldDisks, newDisks, expected []api.Disk) {
 		res := getAttachedDisks(oldDisks, newDisks)
@@ -2849,6 +3046,30 @@ var _ = Describe("migratableDomXML", func() {
 
 var _ = Describe("Manager helper functions", func() {
 
+	Context("throttleDisks", func() {
+		It("should throttle the disks slowed down by fault injection", func() {
+			domain := &api.Domain{}
+			domain.Spec.Devices.Disks = []api.Disk{
+				{Alias: api.NewUserDefinedAlias("rootdisk")},
+				{Alias: api.NewUserDefinedAlias("datadisk")},
+			}
+
+			throttleDisks(&faultinjection.Config{
+				Storage: &faultinjection.StorageFault{Disks: []string{"datadisk"}, IOPS: 100, BytesPerSecond: 1024},
+			}, domain)
+			Expect(domain.Spec.Devices.Disks[0].IOTune).To(BeNil())
+			Expect(domain.Spec.Devices.Disks[1].IOTune).To(Equal(&api.DiskIOTune{TotalIopsSec: 100, TotalBytesSec: 1024}))
+		})
+
+		It("should not throttle the disks without fault", func() {
+			domain := &api.Domain{}
+			domain.Spec.Devices.Disks = []api.Disk{{Alias: api.NewUserDefinedAlias("rootdisk")}}
+
+			throttleDisks(nil, domain)
+			Expect(domain.Spec.Devices.Disks[0].IOTune).To(BeNil())
+		})
+	})
+
 	Context("getVMIEphemeralDisksTotalSize", func() {
 
 		var tmpDir string
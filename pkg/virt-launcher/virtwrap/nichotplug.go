@@ -31,6 +31,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	virtnetlink "kubevirt.io/kubevirt/pkg/network/link"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -46,6 +48,7 @@ type vmConfigurator interface {
 type virtIOInterfaceManager struct {
 	dom          cli.VirDomain
 	configurator vmConfigurator
+	hooksManager hooks.Manager
 }
 
 const (
@@ -57,10 +60,12 @@ const (
 func newVirtIOInterfaceManager(
 	libvirtClient cli.VirDomain,
 	configurator vmConfigurator,
+	hooksManager hooks.Manager,
 ) *virtIOInterfaceManager {
 	return &virtIOInterfaceManager{
 		dom:          libvirtClient,
 		configurator: configurator,
+		hooksManager: hooksManager,
 	}
 }
 
@@ -72,7 +77,10 @@ func (vim *virtIOInterfaceManager) hotplugVirtioInterface(vmi *v1.VirtualMachine
 			return err
 		}
 
-		relevantIface := lookupDomainInterfaceByName(updatedDomain.Spec.Devices.Interfaces, network.Name)
+		relevantIface, err := vim.lookupDomainInterfaceDefinedByHooks(vmi, &updatedDomain.Spec, network.Name)
+		if err != nil {
+			return err
+		}
 		if relevantIface == nil {
 			return fmt.Errorf("could not retrieve the api.Interface object from the dummy domain")
 		}
@@ -95,6 +103,25 @@ func (vim *virtIOInterfaceManager) hotplugVirtioInterface(vmi *v1.VirtualMachine
 	return nil
 }
 
+// lookupDomainInterfaceDefinedByHooks passes the domain to the OnDefineDomain hook sidecars, e.g. the
+// network binding plugin sidecars, as when the domain is defined, and returns the interface to plug
+// as they defined it. Only the interface is taken from the domain they return.
+func (vim *virtIOInterfaceManager) lookupDomainInterfaceDefinedByHooks(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec, networkName string) (*api.Interface, error) {
+	if !vim.hooksManager.HasHookPoint(hooksInfo.OnDefineDomainHookPointName) {
+		return lookupDomainInterfaceByName(domainSpec.Devices.Interfaces, networkName), nil
+	}
+
+	domainXML, err := vim.hooksManager.OnDefineDomain(domainSpec.DeepCopy(), vmi)
+	if err != nil {
+		return nil, fmt.Errorf("executing the OnDefineDomain hooks for the hotplug of %s failed: %v", networkName, err)
+	}
+	hookedDomainSpec := &api.DomainSpec{}
+	if err := xml.Unmarshal([]byte(domainXML), hookedDomainSpec); err != nil {
+		return nil, err
+	}
+	return lookupDomainInterfaceByName(hookedDomainSpec.Devices.Interfaces, networkName), nil
+}
+
 func (vim *virtIOInterfaceManager) hotUnplugVirtioInterface(vmi *v1.VirtualMachineInstance, currentDomain *api.Domain) error {
 	for _, domainIface := range interfacesToHotUnplug(vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks, currentDomain.Spec.Devices.Interfaces) {
 		log.Log.Infof("preparing to hot-unplug %s", domainIface.Alias.GetName())
@@ -203,7 +230,8 @@ func withNetworkIfacesResources(vmi *v1.VirtualMachineInstance, domainSpec *api.
 }
 
 func appendPlaceholderInterfacesToTheDomain(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) *api.DomainSpec {
-	if len(vmi.Spec.Domain.Devices.Interfaces) == 0 {
+	// A fork parent has no interfaces, the interfaces of the VMIs forked from it are hotplugged
+	if _, isForkParent := vmi.Annotations[v1.ForkParentAnnotation]; len(vmi.Spec.Domain.Devices.Interfaces) == 0 && !isForkParent {
 		return domainSpec
 	}
 	if val := vmi.Annotations[v1.PlacePCIDevicesOnRootComplex]; val == "true" {
@@ -22,6 +22,7 @@ package virtwrap
 import (
 	"encoding/xml"
 	"fmt"
+	"strings"
 
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 
@@ -33,6 +34,8 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
@@ -144,9 +147,11 @@ var _ = Describe("nic hotplug on virt-launcher", func() {
 	DescribeTable(
 		"hotplugVirtioInterface SUCCEEDS for",
 		func(vmi *v1.VirtualMachineInstance, currentDomain *api.Domain, updatedDomain *api.Domain, result libvirtClientResult) {
+			ctrl := gomock.NewController(GinkgoT())
 			networkInterfaceManager := newVirtIOInterfaceManager(
-				mockLibvirtClient(gomock.NewController(GinkgoT()), result),
+				mockLibvirtClient(ctrl, result),
 				&fakeVMConfigurator{},
+				hooksManagerWithoutSidecars(ctrl),
 			)
 			Expect(networkInterfaceManager.hotplugVirtioInterface(vmi, currentDomain, updatedDomain)).To(Succeed())
 		},
@@ -168,9 +173,11 @@ var _ = Describe("nic hotplug on virt-launcher", func() {
 	DescribeTable(
 		"hotplugVirtioInterface FAILS when",
 		func(vmi *v1.VirtualMachineInstance, currentDomain *api.Domain, updatedDomain *api.Domain, configurator vmConfigurator, result libvirtClientResult) {
+			ctrl := gomock.NewController(GinkgoT())
 			networkInterfaceManager := newVirtIOInterfaceManager(
-				mockLibvirtClient(gomock.NewController(GinkgoT()), result),
+				mockLibvirtClient(ctrl, result),
 				configurator,
+				hooksManagerWithoutSidecars(ctrl),
 			)
 			Expect(networkInterfaceManager.hotplugVirtioInterface(vmi, currentDomain, updatedDomain)).To(MatchError("boom"))
 		},
@@ -189,6 +196,51 @@ var _ = Describe("nic hotplug on virt-launcher", func() {
 			libvirtClientResult{expectedError: fmt.Errorf("boom")},
 		),
 	)
+
+	Context("with OnDefineDomain hook sidecars", func() {
+		var (
+			ctrl         *gomock.Controller
+			domainClient *cli.MockVirDomain
+			hooksManager *hooks.MockManager
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			domainClient = cli.NewMockVirDomain(ctrl)
+			hooksManager = hooks.NewMockManager(ctrl)
+			hooksManager.EXPECT().HasHookPoint(hooksInfo.OnDefineDomainHookPointName).Return(true).AnyTimes()
+		})
+
+		It("attaches the interface as defined by the sidecars", func() {
+			hookedDomain := dummyDomain(networkName)
+			hookedDomain.Spec.Devices.Interfaces[0].Backend = &api.InterfaceBackend{Type: "passt"}
+			hookedDomainXML, err := xml.Marshal(hookedDomain.Spec)
+			Expect(err).NotTo(HaveOccurred())
+			hooksManager.EXPECT().OnDefineDomain(gomock.Any(), gomock.Any()).Return(string(hookedDomainXML), nil)
+
+			expectedIfaceXML, err := xml.Marshal(hookedDomain.Spec.Devices.Interfaces[0])
+			Expect(err).NotTo(HaveOccurred())
+			domainClient.EXPECT().AttachDeviceFlags(strings.ToLower(string(expectedIfaceXML)), gomock.Any()).Return(nil)
+
+			networkInterfaceManager := newVirtIOInterfaceManager(domainClient, &fakeVMConfigurator{}, hooksManager)
+			Expect(networkInterfaceManager.hotplugVirtioInterface(
+				vmiWithSingleBridgeInterfaceWithPodInterfaceReady(networkName, nadName),
+				dummyDomain(),
+				dummyDomain(networkName),
+			)).To(Succeed())
+		})
+
+		It("fails when the sidecars fail", func() {
+			hooksManager.EXPECT().OnDefineDomain(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("boom"))
+
+			networkInterfaceManager := newVirtIOInterfaceManager(domainClient, &fakeVMConfigurator{}, hooksManager)
+			Expect(networkInterfaceManager.hotplugVirtioInterface(
+				vmiWithSingleBridgeInterfaceWithPodInterfaceReady(networkName, nadName),
+				dummyDomain(),
+				dummyDomain(networkName),
+			)).To(MatchError(ContainSubstring("boom")))
+		})
+	})
 })
 
 var _ = Describe("nic hot-unplug on virt-launcher", func() {
@@ -328,6 +380,12 @@ func mockLibvirtClient(mockController *gomock.Controller, clientResult libvirtCl
 	return mockClient
 }
 
+func hooksManagerWithoutSidecars(mockController *gomock.Controller) *hooks.MockManager {
+	hooksManager := hooks.NewMockManager(mockController)
+	hooksManager.EXPECT().HasHookPoint(gomock.Any()).Return(false).AnyTimes()
+	return hooksManager
+}
+
 func vmiWithSingleBridgeInterfaceWithPodInterfaceReady(ifaceName string, nadName string) *v1.VirtualMachineInstance {
 	return &v1.VirtualMachineInstance{
 		Spec: v1.VirtualMachineInstanceSpec{
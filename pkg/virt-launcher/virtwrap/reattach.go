@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/json"
+	"os"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// launcherState is the state of virt-launcher which libvirt doesn't keep. A virt-launcher restarted
+// after a crash restores it to reattach to the domain, which kept running.
+type launcherState struct {
+	Metadata   api.KubeVirtMetadata `json:"metadata"`
+	PausedVMIs []types.UID          `json:"pausedVMIs,omitempty"`
+}
+
+// SaveLauncherState saves the state of virt-launcher to the given path. The state is written to a
+// staging file first, a crash while saving it leaves the previous state intact.
+func (l *LibvirtDomainManager) SaveLauncherState(path string) error {
+	state := launcherState{
+		Metadata: metadata.LoadKubevirtMetadata(l.metadataCache),
+	}
+
+	l.domainModifyLock.Lock()
+	for uid := range l.paused.paused {
+		state.PausedVMIs = append(state.PausedVMIs, uid)
+	}
+	l.domainModifyLock.Unlock()
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	stagingPath := path + ".staging"
+	if err := os.WriteFile(stagingPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(stagingPath, path)
+}
+
+// RestoreLauncherState restores the state of virt-launcher saved to the given path.
+func (l *LibvirtDomainManager) RestoreLauncherState(path string) error {
+	// #nosec No risk for path injection. The path is in the private directory of the VMI
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var state launcherState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+
+	metadata.SetKubevirtMetadata(l.metadataCache, state.Metadata)
+
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+	for _, uid := range state.PausedVMIs {
+		l.paused.add(uid)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virtwrap
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Launcher state", func() {
+	It("should restore the metadata and the paused VMIs saved by a previous virt-launcher", func() {
+		statePath := filepath.Join(GinkgoT().TempDir(), "launcher-state.json")
+
+		savedCache := metadata.NewCache()
+		savedCache.UID.Set("123")
+		savedCache.Migration.Set(api.MigrationMetadata{UID: "456", Completed: true})
+		saved, err := newLibvirtDomainManager(nil, "fake", "fake", nil, "/usr/share/OVMF", nil, nil, savedCache)
+		Expect(err).ToNot(HaveOccurred())
+		saved.(*LibvirtDomainManager).paused.add("123")
+		Expect(saved.SaveLauncherState(statePath)).To(Succeed())
+
+		restoredCache := metadata.NewCache()
+		restored, err := newLibvirtDomainManager(nil, "fake", "fake", nil, "/usr/share/OVMF", nil, nil, restoredCache)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restored.RestoreLauncherState(statePath)).To(Succeed())
+
+		Expect(metadata.LoadKubevirtMetadata(restoredCache)).To(Equal(metadata.LoadKubevirtMetadata(savedCache)))
+		Expect(restored.(*LibvirtDomainManager).paused.contains("123")).To(BeTrue())
+	})
+
+	It("should fail to restore a state which was never saved", func() {
+		restored, err := newLibvirtDomainManager(nil, "fake", "fake", nil, "/usr/share/OVMF", nil, nil, metadata.NewCache())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restored.RestoreLauncherState(filepath.Join(GinkgoT().TempDir(), "launcher-state.json"))).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// runPreShutdown starts the pre-shutdown command of the shutdown policy of the VMI in the guest, once.
+// It returns true while the command runs: the guest is signaled to shut down once it exited, failed or
+// timed out. The grace period of the VMI starts with the command.
+func (l *LibvirtDomainManager) runPreShutdown(vmi *v1.VirtualMachineInstance) bool {
+	policy := vmi.Spec.ShutdownPolicy
+	if policy == nil || policy.PreShutdown == nil || len(policy.PreShutdown.Command) == 0 {
+		return false
+	}
+
+	started, running := false, false
+	l.metadataCache.GracePeriod.WithSafeBlock(func(gracePeriodMetadata *api.GracePeriodMetadata, _ bool) {
+		if gracePeriodMetadata.PreShutdownStartTimestamp != nil {
+			running = gracePeriodMetadata.PreShutdownEndTimestamp == nil
+			return
+		}
+		now := metav1.Now()
+		if gracePeriodMetadata.DeletionTimestamp == nil {
+			gracePeriodMetadata.DeletionTimestamp = &now
+		}
+		gracePeriodMetadata.PreShutdownStartTimestamp = &now
+		started, running = true, true
+	})
+	if started {
+		go l.execPreShutdown(vmi, policy.PreShutdown)
+	}
+	return running
+}
+
+func (l *LibvirtDomainManager) execPreShutdown(vmi *v1.VirtualMachineInstance, preShutdown *v1.PreShutdownCommand) {
+	logger := log.Log.Object(vmi)
+
+	timeout := v1.DefaultPreShutdownTimeoutSeconds
+	if preShutdown.TimeoutSeconds != nil {
+		timeout = *preShutdown.TimeoutSeconds
+	}
+	logger.Infof("Running the pre-shutdown command %s in the guest", preShutdown.Command[0])
+	_, err := l.Exec(api.VMINamespaceKeyFunc(vmi), preShutdown.Command[0], preShutdown.Command[1:], timeout)
+	if err != nil {
+		logger.Reason(err).Warning("The pre-shutdown command failed, signaling graceful shutdown")
+	} else {
+		logger.Info("The pre-shutdown command exited, signaling graceful shutdown")
+	}
+
+	l.metadataCache.GracePeriod.WithSafeBlock(func(gracePeriodMetadata *api.GracePeriodMetadata, _ bool) {
+		now := metav1.Now()
+		gracePeriodMetadata.PreShutdownEndTimestamp = &now
+		if err != nil {
+			gracePeriodMetadata.PreShutdownFailureReason = err.Error()
+		}
+	})
+
+	if err := l.SignalShutdownVMI(vmi); err != nil {
+		logger.Reason(err).Error("Signalling graceful shutdown after the pre-shutdown command failed.")
+	}
+}
@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	virtwrapapi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Shutdown policy", func() {
+	const (
+		domainName = "default_testvmi"
+		execCmd    = `{"execute": "guest-exec", "arguments": { "path": "/usr/bin/systemctl", "arg": [ "stop", "postgresql" ], "capture-output":true } }`
+		statusCmd  = `{"execute": "guest-exec-status", "arguments": { "pid": 42 } }`
+	)
+
+	var (
+		ctrl          *gomock.Controller
+		mockConn      *cli.MockConnection
+		mockDomain    *cli.MockVirDomain
+		metadataCache *metadata.Cache
+		manager       DomainManager
+		vmi           *v1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockConn = cli.NewMockConnection(ctrl)
+		mockDomain = cli.NewMockVirDomain(ctrl)
+		metadataCache = metadata.NewCache()
+
+		var err error
+		manager, err = NewLibvirtDomainManager(mockConn, "fake", "fake", nil, "/usr/share/OVMF", nil, metadataCache)
+		Expect(err).ToNot(HaveOccurred())
+
+		vmi = api.NewMinimalVMIWithNS("default", "testvmi")
+		vmi.Spec.ShutdownPolicy = &v1.ShutdownPolicy{
+			PreShutdown: &v1.PreShutdownCommand{
+				Command:        []string{"/usr/bin/systemctl", "stop", "postgresql"},
+				TimeoutSeconds: pointer.P(int32(1)),
+			},
+		}
+		mockConn.EXPECT().LookupDomainByName(domainName).Return(mockDomain, nil).AnyTimes()
+		mockDomain.EXPECT().Free().AnyTimes()
+	})
+
+	gracePeriod := func() virtwrapapi.GracePeriodMetadata {
+		gracePeriodMetadata, _ := metadataCache.GracePeriod.Load()
+		return gracePeriodMetadata
+	}
+
+	It("should signal the guest once the pre-shutdown command exited", func() {
+		mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil).Times(2)
+		mockConn.EXPECT().QemuAgentCommand(execCmd, domainName).Return(`{"return":{"pid":42}}`, nil)
+		mockConn.EXPECT().QemuAgentCommand(statusCmd, domainName).Return(`{"return":{"exited":true,"exitcode":0}}`, nil)
+		mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT).Return(nil)
+
+		Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+		Expect(gracePeriod().PreShutdownStartTimestamp).ToNot(BeNil())
+		Expect(gracePeriod().DeletionTimestamp).To(Equal(gracePeriod().PreShutdownStartTimestamp))
+
+		Eventually(gracePeriod).Should(And(
+			HaveField("PreShutdownEndTimestamp", Not(BeNil())),
+			HaveField("PreShutdownFailureReason", BeEmpty()),
+			HaveField("ShutdownSignalTimestamp", Not(BeNil())),
+		))
+	})
+
+	It("should signal the guest when the pre-shutdown command failed", func() {
+		mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil).Times(2)
+		mockConn.EXPECT().QemuAgentCommand(execCmd, domainName).Return("", fmt.Errorf("guest agent is not connected"))
+		mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT).Return(nil)
+
+		Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+
+		Eventually(gracePeriod).Should(And(
+			HaveField("PreShutdownEndTimestamp", Not(BeNil())),
+			HaveField("PreShutdownFailureReason", "guest agent is not connected"),
+			HaveField("ShutdownSignalTimestamp", Not(BeNil())),
+		))
+	})
+
+	It("should not signal the guest while the pre-shutdown command runs", func() {
+		metadataCache.GracePeriod.Set(virtwrapapi.GracePeriodMetadata{PreShutdownStartTimestamp: pointer.P(metav1.Now())})
+		mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+
+		Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+		Expect(gracePeriod().ShutdownSignalTimestamp).To(BeNil())
+	})
+
+	It("should signal a paused guest without running the pre-shutdown command", func() {
+		mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_PAUSED, 1, nil)
+		mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT).Return(nil)
+
+		Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+		Expect(gracePeriod()).To(And(
+			HaveField("PreShutdownStartTimestamp", BeNil()),
+			HaveField("DeletionTimestamp", Not(BeNil())),
+			HaveField("ShutdownSignalTimestamp", Not(BeNil())),
+		))
+	})
+})
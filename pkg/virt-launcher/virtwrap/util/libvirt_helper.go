@@ -389,6 +389,64 @@ func StartVirtlog(stopChan chan struct{}, domainName string, nonRoot bool) {
 	go startQEMUSeaBiosLogging(stopChan)
 }
 
+// TerminateOrphanedLibvirtDaemons terminates the virtqemud and virtlogd processes left behind by a
+// crashed virt-launcher, for the restarted virt-launcher to start and supervise its own. The
+// domains keep running: virtqemud reconnects to their QEMU processes when it starts again.
+func TerminateOrphanedLibvirtDaemons(timeout time.Duration) error {
+	var pids []int
+	for _, daemon := range []string{"virtqemud", "virtlogd"} {
+		daemonPids, err := findProcesses(daemon)
+		if err != nil {
+			return err
+		}
+		pids = append(pids, daemonPids...)
+	}
+
+	for _, pid := range pids {
+		log.Log.Infof("Terminating the orphaned libvirt daemon with pid %d", pid)
+		if err := syscall.Kill(pid, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+			return err
+		}
+	}
+
+	deadline := time.Now().Add(timeout)
+	for _, pid := range pids {
+		for {
+			if _, err := os.Stat(filepath.Join("/proc", strconv.Itoa(pid))); errors.Is(err, os.ErrNotExist) {
+				break
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("the orphaned libvirt daemon with pid %d did not terminate within %s", pid, timeout)
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+	return nil
+}
+
+// findProcesses returns the pids of the processes with the given command name
+func findProcesses(name string) ([]int, error) {
+	entries, err := filepath.Glob("/proc/[0-9]*/comm")
+	if err != nil {
+		return nil, err
+	}
+
+	var pids []int
+	for _, entry := range entries {
+		// #nosec No risk for path injection. Reading specific entries under /proc
+		comm, err := os.ReadFile(entry)
+		if err != nil || strings.TrimSpace(string(comm)) != name {
+			continue
+		}
+		pid, err := strconv.Atoi(filepath.Base(filepath.Dir(entry)))
+		if err != nil {
+			return nil, err
+		}
+		pids = append(pids, pid)
+	}
+	return pids, nil
+}
+
 // returns the namespace and name that is encoded in the
 // domain name.
 func SplitVMINamespaceKey(domainName string) (namespace, name string) {
@@ -22,8 +22,8 @@ package virt_operator
 import (
 	"context"
 	"crypto/tls"
-	"fmt"
 	golog "log"
+	"net"
 	"net/http"
 	"os"
 
@@ -372,7 +372,7 @@ func (app *VirtOperatorApp) Run() {
 	tlsConfig := kvtls.SetupTLSWithCertManager(caManager, app.operatorCertManager, tls.VerifyClientCertIfGiven, app.clusterConfig)
 
 	webhookServer := &http.Server{
-		Addr:      fmt.Sprintf("%s:%d", app.BindAddress, 8444),
+		Addr:      net.JoinHostPort(app.BindAddress, "8444"),
 		TLSConfig: tlsConfig,
 	}
 
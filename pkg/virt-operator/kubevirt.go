@@ -42,7 +42,9 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/fips"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 	install "kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/install"
 	"kubevirt.io/kubevirt/pkg/virt-operator/util"
 	operatorutil "kubevirt.io/kubevirt/pkg/virt-operator/util"
@@ -1023,6 +1025,9 @@ func (c *KubeVirtController) syncInstallation(kv *v1.KubeVirt) error {
 	// Set the default architecture
 	config.SetDefaultArchitecture(kv)
 
+	// Record the FIPS posture of the components
+	setFIPSPosture(kv)
+
 	if kv.Status.Phase == "" {
 		kv.Status.Phase = v1.KubeVirtPhaseDeploying
 	}
@@ -1115,6 +1120,30 @@ func (c *KubeVirtController) isReady(kv *v1.KubeVirt) bool {
 	return true
 }
 
+// setFIPSPosture records the FIPS posture of every component. The components are built
+// from the same tree and against the same crypto module as virt-operator.
+func setFIPSPosture(kv *v1.KubeVirt) {
+	validatedCrypto := fips.Enabled()
+	tlsConfiguration := kv.Spec.Configuration.TLSConfiguration
+	approvedCiphersOnly := validatedCrypto || (tlsConfiguration != nil && tlsConfiguration.FIPSMode)
+
+	kv.Status.FIPSPosture = nil
+	for _, component := range []string{
+		components.VirtAPIName,
+		components.VirtControllerName,
+		components.VirtHandlerName,
+		components.VirtExportProxyName,
+		components.VirtExportServerName,
+		components.VirtOperatorName,
+	} {
+		kv.Status.FIPSPosture = append(kv.Status.FIPSPosture, v1.ComponentFIPSPosture{
+			Component:           component,
+			ValidatedCrypto:     validatedCrypto,
+			ApprovedCiphersOnly: approvedCiphersOnly,
+		})
+	}
+}
+
 func (c *KubeVirtController) syncDeletion(kv *v1.KubeVirt) error {
 	logger := log.Log.Object(kv)
 	logger.Info("Handling deletion")
@@ -69,6 +69,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/monitoring/rules"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/fips"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 	install "kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/install"
@@ -84,9 +85,9 @@ const (
 
 	NAMESPACE = "kubevirt-test"
 
-	resourceCount = 77
-	patchCount    = 50
-	updateCount   = 28
+	resourceCount = 84
+	patchCount    = 56
+	updateCount   = 29
 )
 
 type KubeVirtTestData struct {
@@ -1197,6 +1198,9 @@ func (k *KubeVirtTestData) addAllWithExclusionMap(config *util.KubeVirtDeploymen
 		components.NewVirtualMachineClusterInstancetypeCrd, components.NewVirtualMachinePoolCrd,
 		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineCloneCrd,
+		components.NewGuestAgentPolicyCrd, components.NewLifecycleNotificationCrd,
+		components.NewVirtualMachineTimelineCrd, components.NewVirtualMachineDiskCheckCrd,
+		components.NewConsoleAccessGrantCrd, components.NewHostDeviceInventoryCrd,
 	}
 	for _, f := range functions {
 		crd, err := f()
@@ -2401,11 +2405,11 @@ var _ = Describe("KubeVirt Operator", func() {
 			Expect(kvTestData.totalAdds).To(Equal(resourceCount - expectedUncreatedResources + expectedTemporaryResources))
 
 			Expect(kvTestData.controller.stores.ServiceAccountCache.List()).To(HaveLen(4))
-			Expect(kvTestData.controller.stores.ClusterRoleCache.List()).To(HaveLen(9))
+			Expect(kvTestData.controller.stores.ClusterRoleCache.List()).To(HaveLen(10))
 			Expect(kvTestData.controller.stores.ClusterRoleBindingCache.List()).To(HaveLen(7))
 			Expect(kvTestData.controller.stores.RoleCache.List()).To(HaveLen(5))
 			Expect(kvTestData.controller.stores.RoleBindingCache.List()).To(HaveLen(5))
-			Expect(kvTestData.controller.stores.OperatorCrdCache.List()).To(HaveLen(16))
+			Expect(kvTestData.controller.stores.OperatorCrdCache.List()).To(HaveLen(22))
 			Expect(kvTestData.controller.stores.ServiceCache.List()).To(HaveLen(4))
 			Expect(kvTestData.controller.stores.DeploymentCache.List()).To(HaveLen(1))
 			Expect(kvTestData.controller.stores.DaemonSetCache.List()).To(BeEmpty())
@@ -3019,6 +3023,25 @@ var _ = Describe("KubeVirt Operator", func() {
 			install.DumpInstallStrategyToConfigMap(kvTestData.virtClient, NAMESPACE)
 		})
 	})
+
+	Context("FIPS posture", func() {
+		DescribeTable("should report the posture of every component", func(tlsConfiguration *v1.TLSConfiguration, approvedCiphersOnly bool) {
+			kv := &v1.KubeVirt{}
+			kv.Spec.Configuration.TLSConfiguration = tlsConfiguration
+			setFIPSPosture(kv)
+
+			Expect(kv.Status.FIPSPosture).To(HaveLen(6))
+			for _, posture := range kv.Status.FIPSPosture {
+				Expect(posture.ValidatedCrypto).To(Equal(fips.Enabled()))
+				Expect(posture.ApprovedCiphersOnly).To(Equal(approvedCiphersOnly))
+			}
+			Expect(kv.Status.FIPSPosture[0].Component).To(Equal(components.VirtAPIName))
+		},
+			Entry("without TLS configuration", nil, false),
+			Entry("without FIPS mode", &v1.TLSConfiguration{MinTLSVersion: v1.VersionTLS12}, false),
+			Entry("with FIPS mode", &v1.TLSConfiguration{FIPSMode: true}, true),
+		)
+	})
 })
 
 func now() *metav1.Time {
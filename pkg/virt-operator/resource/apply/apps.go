@@ -18,6 +18,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 	"kubevirt.io/kubevirt/pkg/virt-operator/util"
 )
@@ -69,6 +70,10 @@ func (r *Reconciler) syncDeployment(origDeployment *appsv1.Deployment) (*appsv1.
 		}
 	}
 
+	if deployment.Name == components.VirtAPIName {
+		setExternallyManagedCertificates(kv, &deployment.Spec.Template.Spec)
+	}
+
 	obj, exists, _ := r.stores.DeploymentCache.Get(deployment)
 	if !exists {
 		r.expectations.Deployment.RaiseExpectations(r.kvKey, 1, 0)
@@ -130,6 +135,21 @@ func setMaxUnavailable(daemonSet *appsv1.DaemonSet, maxUnavailable intstr.IntOrS
 	}
 }
 
+// setRolloutPace sets how many pods of the daemonSet are replaced at once. With the
+// VirtHandlerHandover feature gate, the incoming virt-handler pods are surged next to the outgoing
+// ones, which hand their state over, instead of replacing them.
+func (r *Reconciler) setRolloutPace(daemonSet *appsv1.DaemonSet, pace intstr.IntOrString) {
+	if daemonSet.GetName() != "virt-handler" || !r.isFeatureGateEnabled(virtconfig.VirtHandlerHandoverGate) {
+		setMaxUnavailable(daemonSet, pace)
+		return
+	}
+	maxUnavailable := intstr.FromInt(0)
+	daemonSet.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateDaemonSet{
+		MaxUnavailable: &maxUnavailable,
+		MaxSurge:       &pace,
+	}
+}
+
 func generateDaemonSetPatch(oldDs, newDs *appsv1.DaemonSet) ([]byte, error) {
 	return patch.New(
 		getPatchWithObjectMetaAndSpec([]patch.PatchOption{
@@ -202,7 +222,7 @@ func (r *Reconciler) processCanaryUpgrade(cachedDaemonSet, newDS *appsv1.DaemonS
 	case updatedAndReadyPods == 0:
 		if !isDaemonSetUpdated {
 			// start canary upgrade
-			setMaxUnavailable(newDS, daemonSetDefaultMaxUnavailable)
+			r.setRolloutPace(newDS, daemonSetDefaultMaxUnavailable)
 			_, err := r.patchDaemonSet(cachedDaemonSet, newDS)
 			if err != nil {
 				return false, fmt.Errorf("unable to start canary upgrade for daemonset %+v: %v", newDS, err), CanaryUpgradeStatusFailed
@@ -221,7 +241,7 @@ func (r *Reconciler) processCanaryUpgrade(cachedDaemonSet, newDS *appsv1.DaemonS
 	case updatedAndReadyPods > 0 && updatedAndReadyPods < desiredReadyPods:
 		if daemonHasDefaultRolloutStrategy(cachedDaemonSet) {
 			// canary was ok, start real rollout
-			setMaxUnavailable(newDS, daemonSetFastMaxUnavailable)
+			r.setRolloutPace(newDS, daemonSetFastMaxUnavailable)
 			// start rollout again
 			_, err := r.patchDaemonSet(cachedDaemonSet, newDS)
 			if err != nil {
@@ -237,7 +257,7 @@ func (r *Reconciler) processCanaryUpgrade(cachedDaemonSet, newDS *appsv1.DaemonS
 	case updatedAndReadyPods > 0 && updatedAndReadyPods == desiredReadyPods:
 		// rollout has completed and all virt-handlers are ready
 		// revert maxUnavailable to default value
-		setMaxUnavailable(newDS, daemonSetDefaultMaxUnavailable)
+		r.setRolloutPace(newDS, daemonSetDefaultMaxUnavailable)
 		newDS, err := r.patchDaemonSet(cachedDaemonSet, newDS)
 		if err != nil {
 			return false, err, CanaryUpgradeStatusFailed
@@ -255,6 +275,10 @@ func getMaxUnavailable(daemonSet *appsv1.DaemonSet) int {
 	if update == nil {
 		return 0
 	}
+	// surged rollouts keep all the pods available, the pace is the surge
+	if update.MaxSurge != nil && update.MaxSurge.IntValue() > 0 {
+		return update.MaxSurge.IntValue()
+	}
 	if update.MaxUnavailable != nil {
 		return update.MaxUnavailable.IntValue()
 	}
@@ -275,6 +299,7 @@ func (r *Reconciler) syncDaemonSet(daemonSet *appsv1.DaemonSet) (bool, error) {
 
 	if daemonSet.GetName() == "virt-handler" {
 		setMaxDevices(r.kv, daemonSet)
+		setExternallyManagedCertificates(r.kv, &daemonSet.Spec.Template.Spec)
 	}
 
 	var cachedDaemonSet *appsv1.DaemonSet
@@ -326,6 +351,16 @@ func setMaxDevices(kv *v1.KubeVirt, vh *appsv1.DaemonSet) {
 		fmt.Sprintf("%d", *kv.Spec.Configuration.VirtualMachineInstancesPerNode))
 }
 
+// setExternallyManagedCertificates lets the component accept certificate chains with
+// intermediate CAs, as external issuers commonly sign with one
+func setExternallyManagedCertificates(kv *v1.KubeVirt, podSpec *corev1.PodSpec) {
+	if kv.Spec.CertificateRotationStrategy.External == nil {
+		return
+	}
+
+	podSpec.Containers[0].Command = append(podSpec.Containers[0].Command, "--externally-managed")
+}
+
 func (r *Reconciler) syncPodDisruptionBudgetForDeployment(deployment *appsv1.Deployment) error {
 	kv := r.kv
 	podDisruptionBudget := components.NewPodDisruptionBudgetForDeployment(deployment)
@@ -52,6 +52,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 	"kubevirt.io/kubevirt/pkg/virt-operator/util"
 )
@@ -519,6 +520,12 @@ var _ = Describe("Apply Apps", func() {
 				Expect(done).To(BeFalse())
 			})
 
+			enableHandover := func(kv *v1.KubeVirt) {
+				kv.Spec.Configuration.DeveloperConfiguration = &v1.DeveloperConfiguration{
+					FeatureGates: []string{virtconfig.VirtHandlerHandoverGate},
+				}
+			}
+
 			type daemonSetBuilder func(*v1.KubeVirt, *appsv1.DaemonSet) (current *appsv1.DaemonSet,
 				target *appsv1.DaemonSet)
 			type daemonSetPatchChecker func(*v1.KubeVirt, *appsv1.DaemonSet)
@@ -693,6 +700,44 @@ var _ = Describe("Apply Apps", func() {
 					},
 					CanaryUpgradeStatusSuccessful, true, false, true,
 				),
+				Entry("should start a surged canary upgrade with the VirtHandlerHandover feature gate",
+					func(kv *v1.KubeVirt, currentDs *appsv1.DaemonSet) (*appsv1.DaemonSet, *appsv1.DaemonSet) {
+						enableHandover(kv)
+						newDs := daemonSet.DeepCopy()
+						addCustomTargetDeployment(kv, newDs)
+						return currentDs, newDs
+					},
+					func(kv *v1.KubeVirt, daemonSet *appsv1.DaemonSet) {
+						rollingUpdate := daemonSet.Spec.UpdateStrategy.RollingUpdate
+						Expect(rollingUpdate).ToNot(BeNil())
+						Expect(rollingUpdate.MaxUnavailable).To(HaveValue(Equal(intstr.FromInt(0))))
+						Expect(rollingUpdate.MaxSurge).To(HaveValue(Equal(intstr.FromInt(1))))
+					},
+					CanaryUpgradeStatusStarted, false, false, true,
+				),
+				Entry("should restart a surged daemonset rollout with MaxSurge 10% with the VirtHandlerHandover feature gate",
+					func(kv *v1.KubeVirt, currentDs *appsv1.DaemonSet) (*appsv1.DaemonSet, *appsv1.DaemonSet) {
+						enableHandover(kv)
+						maxUnavailable := intstr.FromInt(0)
+						maxSurge := intstr.FromInt(1)
+						newDs := daemonSet.DeepCopy()
+						addCustomTargetDeployment(kv, newDs)
+						addCustomTargetDeployment(kv, currentDs)
+						markHandlerCanaryReady(daemonSet)
+						currentDs.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateDaemonSet{
+							MaxUnavailable: &maxUnavailable,
+							MaxSurge:       &maxSurge,
+						}
+						return currentDs, newDs
+					},
+					func(kv *v1.KubeVirt, daemonSet *appsv1.DaemonSet) {
+						rollingUpdate := daemonSet.Spec.UpdateStrategy.RollingUpdate
+						Expect(rollingUpdate).ToNot(BeNil())
+						Expect(rollingUpdate.MaxUnavailable).To(HaveValue(Equal(intstr.FromInt(0))))
+						Expect(rollingUpdate.MaxSurge).To(HaveValue(Equal(intstr.FromString("10%"))))
+					},
+					CanaryUpgradeStatusUpgradingDaemonSet, false, false, true,
+				),
 			)
 		})
 
@@ -18,6 +18,7 @@ import (
 	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/util/workqueue"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
@@ -289,7 +290,8 @@ func createSecretPatch(secret *corev1.Secret) ([]byte, error) {
 	return patch.New(ops...).GeneratePayload()
 }
 
-func (r *Reconciler) createOrUpdateCertificateSecrets(queue workqueue.TypedRateLimitingInterface[string], caCert *tls.Certificate, duration *metav1.Duration, renewBefore *metav1.Duration, caRenewBefore *metav1.Duration) error {
+func (r *Reconciler) createOrUpdateCertificateSecrets(queue workqueue.TypedRateLimitingInterface[string], caCert *tls.Certificate, duration *metav1.Duration, renewBefore *metav1.Duration, caRenewBefore *metav1.Duration) ([]v1.KubeVirtCertificateStatus, error) {
+	var statuses []v1.KubeVirtCertificateStatus
 
 	for _, secret := range r.targetStrategy.CertificateSecrets() {
 
@@ -298,15 +300,24 @@ func (r *Reconciler) createOrUpdateCertificateSecrets(queue workqueue.TypedRateL
 			continue
 		}
 
-		_, err := r.createOrUpdateCertificateSecret(queue, caCert, secret, duration, renewBefore, caRenewBefore)
+		crt, err := r.createOrUpdateCertificateSecret(queue, caCert, secret, duration, renewBefore, caRenewBefore)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		statuses = append(statuses, selfSignedCertificateStatus(secret.Name, crt))
 	}
-	return nil
+	return statuses, nil
 }
 
 func (r *Reconciler) createOrUpdateComponentsWithCertificates(queue workqueue.TypedRateLimitingInterface[string]) error {
+	if err := r.deleteStaleCertManagerCertificates(); err != nil {
+		return err
+	}
+
+	if external := r.kv.Spec.CertificateRotationStrategy.External; external != nil {
+		return r.createOrUpdateComponentsWithExternalCertificates(queue, external)
+	}
+
 	caDuration := GetCADuration(r.kv.Spec.CertificateRotationStrategy.SelfSigned)
 	caExportDuration := GetCADuration(r.kv.Spec.CertificateRotationStrategy.SelfSigned)
 	caRenewBefore := GetCARenewBefore(r.kv.Spec.CertificateRotationStrategy.SelfSigned)
@@ -363,11 +374,16 @@ func (r *Reconciler) createOrUpdateComponentsWithCertificates(queue workqueue.Ty
 	}
 
 	// create/update Certificate secrets
-	err = r.createOrUpdateCertificateSecrets(queue, caCert, certDuration, certRenewBefore, caRenewBefore)
+	statuses, err := r.createOrUpdateCertificateSecrets(queue, caCert, certDuration, certRenewBefore, caRenewBefore)
 	if err != nil {
 		return err
 	}
 
+	r.kv.Status.Certificates = append([]v1.KubeVirtCertificateStatus{
+		selfSignedCertificateStatus(components.KubeVirtCASecretName, caCert),
+		selfSignedCertificateStatus(components.KubeVirtExportCASecretName, caExportCert),
+	}, statuses...)
+
 	return nil
 }
 
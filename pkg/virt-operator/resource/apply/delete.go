@@ -386,6 +386,13 @@ func DeleteAll(kv *v1.KubeVirt,
 		}
 	}
 
+	// delete cert-manager certificates before their secrets, so they are not issued again
+	if hasCertManagerCertificates(kv) {
+		if err := deleteCertManagerCertificates(clientset, []string{kv.Namespace}); err != nil {
+			return err
+		}
+	}
+
 	objects = stores.SecretCache.List()
 	for _, obj := range objects {
 		if secret, ok := obj.(*corev1.Secret); ok && secret.DeletionTimestamp == nil {
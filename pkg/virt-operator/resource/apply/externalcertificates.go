@@ -0,0 +1,400 @@
+package apply
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourcemerge"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+)
+
+const (
+	// caCertKey is where cert-manager and most external issuers store the CA certificate
+	caCertKey = "ca.crt"
+
+	certManagerGroup             = "cert-manager.io"
+	certManagerDefaultIssuerKind = "Issuer"
+
+	// Externally issued certificates are rotated on their own schedule, and the secrets
+	// of admins are not labeled for the informers of virt-operator
+	externalCertificatesResyncInterval = 5 * time.Minute
+)
+
+var certManagerCertificateGVR = schema.GroupVersionResource{
+	Group:    certManagerGroup,
+	Version:  "v1",
+	Resource: "certificates",
+}
+
+func (r *Reconciler) createOrUpdateComponentsWithExternalCertificates(queue workqueue.TypedRateLimitingInterface[string], external *v1.KubeVirtExternalCertConfiguration) error {
+	caExportDuration := GetCADuration(r.kv.Spec.CertificateRotationStrategy.SelfSigned)
+	caExportRenewBefore := GetCertRenewBefore(r.kv.Spec.CertificateRotationStrategy.SelfSigned)
+
+	// VirtualMachineExports keep using the built-in export CA
+	caExportCert, err := r.createOrUpdateCACertificateSecret(queue, components.KubeVirtExportCASecretName, caExportDuration, caExportRenewBefore)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.createOrUpdateKubeVirtCAConfigMap(queue, caExportCert, caExportRenewBefore, findRequiredCAConfigMap(components.KubeVirtExportCASecretName, r.targetStrategy.ConfigMaps()))
+	if err != nil {
+		return err
+	}
+
+	issuer := v1.CertificateIssuerExternal
+	if external.CertManager != nil {
+		issuer = v1.CertificateIssuerCertManager
+		for _, secret := range r.targetStrategy.CertificateSecrets() {
+			spec, ok := components.GetCertificateSpec(secret)
+			if !ok {
+				continue
+			}
+			if err := r.createOrUpdateCertManagerCertificate(r.newCertManagerCertificate(secret, spec, external.CertManager)); err != nil {
+				return err
+			}
+		}
+	}
+
+	queue.AddAfter(r.kvKey, externalCertificatesResyncInterval)
+
+	statuses := []v1.KubeVirtCertificateStatus{selfSignedCertificateStatus(components.KubeVirtExportCASecretName, caExportCert)}
+	var secrets []*corev1.Secret
+	now := time.Now()
+	for _, secret := range r.targetStrategy.CertificateSecrets() {
+		if _, ok := components.GetCertificateSpec(secret); !ok {
+			continue
+		}
+
+		cachedSecret, exists, err := r.getSecret(secret)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, externalCertificateStatus(secret.Name, cachedSecret, issuer, now))
+		if exists {
+			secrets = append(secrets, cachedSecret)
+		}
+	}
+	r.kv.Status.Certificates = statuses
+
+	caBundle := collectExternalCABundle(secrets)
+	if len(caBundle) == 0 {
+		log.Log.Infof("No CA certificate found in the externally issued certificates, waiting for them to be issued")
+		return nil
+	}
+
+	// create/update CA config map
+	err = r.createOrUpdateExternalCAConfigMap(caBundle, findRequiredCAConfigMap(components.KubeVirtCASecretName, r.targetStrategy.ConfigMaps()))
+	if err != nil {
+		return err
+	}
+
+	// create/update ValidatingWebhookConfiguration
+	err = r.createOrUpdateValidatingWebhookConfigurations(caBundle)
+	if err != nil {
+		return err
+	}
+
+	// create/update MutatingWebhookConfiguration
+	err = r.createOrUpdateMutatingWebhookConfigurations(caBundle)
+	if err != nil {
+		return err
+	}
+
+	// create/update APIServices
+	err = r.createOrUpdateAPIServices(caBundle)
+	if err != nil {
+		return err
+	}
+
+	// create/update Routes
+	return r.createOrUpdateRoutes(caBundle)
+}
+
+func (r *Reconciler) newCertManagerCertificate(secret *corev1.Secret, spec components.CertificateSpec, config *v1.CertManagerConfiguration) *unstructured.Unstructured {
+	usage := "server auth"
+	if spec.Client {
+		usage = "client auth"
+	}
+
+	issuerRef := map[string]interface{}{
+		"name":  config.IssuerRef.Name,
+		"kind":  certManagerDefaultIssuerKind,
+		"group": certManagerGroup,
+	}
+	if config.IssuerRef.Kind != "" {
+		issuerRef["kind"] = config.IssuerRef.Kind
+	}
+	if config.IssuerRef.Group != "" {
+		issuerRef["group"] = config.IssuerRef.Group
+	}
+
+	certificateSpec := map[string]interface{}{
+		"secretName": secret.Name,
+		// the label lets the secret informer of virt-operator notice rotations
+		"secretTemplate": map[string]interface{}{
+			"labels": map[string]interface{}{
+				v1.ManagedByLabel: v1.ManagedByLabelOperatorValue,
+			},
+		},
+		"commonName": spec.CommonName,
+		"usages":     []interface{}{"digital signature", "key encipherment", usage},
+		"privateKey": map[string]interface{}{
+			"algorithm":      "ECDSA",
+			"size":           int64(256),
+			"rotationPolicy": "Always",
+		},
+		"issuerRef": issuerRef,
+	}
+	if len(spec.DNSNames) > 0 {
+		dnsNames := []interface{}{}
+		for _, name := range spec.DNSNames {
+			dnsNames = append(dnsNames, name)
+		}
+		certificateSpec["dnsNames"] = dnsNames
+	}
+	if config.Server != nil && config.Server.Duration != nil {
+		certificateSpec["duration"] = config.Server.Duration.Duration.String()
+	}
+	if config.Server != nil && config.Server.RenewBefore != nil {
+		certificateSpec["renewBefore"] = config.Server.RenewBefore.Duration.String()
+	}
+
+	objectMeta := metav1.ObjectMeta{}
+	version, imageRegistry, id := getTargetVersionRegistryID(r.kv)
+	injectOperatorMetadata(r.kv, &objectMeta, version, imageRegistry, id, true)
+
+	certificate := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": certificateSpec,
+		},
+	}
+	certificate.SetAPIVersion(certManagerCertificateGVR.GroupVersion().String())
+	certificate.SetKind("Certificate")
+	certificate.SetName(secret.Name)
+	certificate.SetNamespace(secret.Namespace)
+	certificate.SetLabels(objectMeta.Labels)
+	certificate.SetAnnotations(objectMeta.Annotations)
+
+	return certificate
+}
+
+func (r *Reconciler) createOrUpdateCertManagerCertificate(certificate *unstructured.Unstructured) error {
+	client := r.clientset.DynamicClient().Resource(certManagerCertificateGVR).Namespace(certificate.GetNamespace())
+
+	existing, err := client.Get(context.Background(), certificate.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = client.Create(context.Background(), certificate, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("unable to create cert-manager certificate %s/%s: %v", certificate.GetNamespace(), certificate.GetName(), err)
+		}
+		log.Log.V(2).Infof("cert-manager certificate %v created", certificate.GetName())
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to get cert-manager certificate %s/%s: %v", certificate.GetNamespace(), certificate.GetName(), err)
+	}
+
+	modified := resourcemerge.BoolPtr(false)
+	existingMeta := metav1.ObjectMeta{
+		Labels:      existing.GetLabels(),
+		Annotations: existing.GetAnnotations(),
+	}
+	resourcemerge.EnsureObjectMeta(modified, &existingMeta, metav1.ObjectMeta{
+		Labels:      certificate.GetLabels(),
+		Annotations: certificate.GetAnnotations(),
+	})
+
+	if !*modified && equality.Semantic.DeepEqual(existing.Object["spec"], certificate.Object["spec"]) {
+		log.Log.V(4).Infof("cert-manager certificate %v is up-to-date", certificate.GetName())
+		return nil
+	}
+
+	existing.SetLabels(existingMeta.Labels)
+	existing.SetAnnotations(existingMeta.Annotations)
+	existing.Object["spec"] = certificate.Object["spec"]
+
+	_, err = client.Update(context.Background(), existing, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to update cert-manager certificate %s/%s: %v", certificate.GetNamespace(), certificate.GetName(), err)
+	}
+	log.Log.V(2).Infof("cert-manager certificate %v updated", certificate.GetName())
+
+	return nil
+}
+
+func (r *Reconciler) createOrUpdateExternalCAConfigMap(caBundle []byte, configMap *corev1.ConfigMap) error {
+	if configMap == nil {
+		return nil
+	}
+
+	version, imageRegistry, id := getTargetVersionRegistryID(r.kv)
+	injectOperatorMetadata(r.kv, &configMap.ObjectMeta, version, imageRegistry, id, true)
+	configMap.Data = map[string]string{components.CABundleKey: string(caBundle)}
+
+	obj, exists, _ := r.stores.ConfigMapCache.Get(configMap)
+	if !exists {
+		r.expectations.ConfigMap.RaiseExpectations(r.kvKey, 1, 0)
+		_, err := r.clientset.CoreV1().ConfigMaps(configMap.Namespace).Create(context.Background(), configMap, metav1.CreateOptions{})
+		if err != nil {
+			r.expectations.ConfigMap.LowerExpectations(r.kvKey, 1, 0)
+			return fmt.Errorf("unable to create configMap %+v: %v", configMap, err)
+		}
+
+		return nil
+	}
+
+	existing := obj.(*corev1.ConfigMap)
+	modified := resourcemerge.BoolPtr(false)
+	resourcemerge.EnsureObjectMeta(modified, &existing.DeepCopy().ObjectMeta, configMap.ObjectMeta)
+
+	if !*modified && equality.Semantic.DeepEqual(existing.Data, configMap.Data) {
+		log.Log.V(4).Infof("configMap %v is up-to-date", configMap.GetName())
+		return nil
+	}
+
+	patchBytes, err := createConfigMapPatch(configMap)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.clientset.CoreV1().ConfigMaps(configMap.Namespace).Patch(context.Background(), configMap.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to patch configMap %+v: %v", configMap, err)
+	}
+
+	log.Log.V(2).Infof("configMap %v updated", configMap.GetName())
+	return nil
+}
+
+// collectExternalCABundle merges the CA certificates of the given secrets. While an
+// issuer rotates its CA the secrets may be signed by different CAs, all of them are
+// trusted until every secret carries the new one.
+func collectExternalCABundle(secrets []*corev1.Secret) []byte {
+	var bundle []byte
+	seen := map[string]bool{}
+	for _, secret := range secrets {
+		caCerts, err := cert.ParseCertsPEM(secret.Data[caCertKey])
+		if err != nil {
+			continue
+		}
+		for _, caCert := range caCerts {
+			if seen[string(caCert.Raw)] {
+				continue
+			}
+			seen[string(caCert.Raw)] = true
+			bundle = append(bundle, cert.EncodeCertPEM(caCert)...)
+		}
+	}
+	return bundle
+}
+
+func externalCertificateStatus(secretName string, secret *corev1.Secret, issuer v1.KubeVirtCertificateIssuer, now time.Time) v1.KubeVirtCertificateStatus {
+	status := v1.KubeVirtCertificateStatus{
+		SecretName: secretName,
+		Issuer:     issuer,
+	}
+
+	if secret == nil {
+		status.Message = "secret not found"
+		return status
+	}
+
+	crt, err := components.LoadCertificates(secret)
+	if err != nil {
+		status.Message = strings.TrimSpace(err.Error())
+		return status
+	}
+	status.NotBefore = &metav1.Time{Time: crt.Leaf.NotBefore}
+	status.NotAfter = &metav1.Time{Time: crt.Leaf.NotAfter}
+
+	if now.Before(crt.Leaf.NotBefore) {
+		status.Message = "certificate is not valid yet"
+		return status
+	}
+	if now.After(crt.Leaf.NotAfter) {
+		status.Message = "certificate expired"
+		return status
+	}
+	if _, err := cert.ParseCertsPEM(secret.Data[caCertKey]); err != nil {
+		status.Message = fmt.Sprintf("no CA certificate found in %s: %v", caCertKey, err)
+		return status
+	}
+
+	status.Ready = true
+	return status
+}
+
+func selfSignedCertificateStatus(secretName string, crt *tls.Certificate) v1.KubeVirtCertificateStatus {
+	status := v1.KubeVirtCertificateStatus{
+		SecretName: secretName,
+		Issuer:     v1.CertificateIssuerSelfSigned,
+	}
+	if crt == nil || crt.Leaf == nil {
+		status.Message = "certificate not found"
+		return status
+	}
+
+	status.Ready = true
+	status.NotBefore = &metav1.Time{Time: crt.Leaf.NotBefore}
+	status.NotAfter = &metav1.Time{Time: crt.Leaf.NotAfter}
+	return status
+}
+
+func hasCertManagerCertificates(kv *v1.KubeVirt) bool {
+	for _, status := range kv.Status.Certificates {
+		if status.Issuer == v1.CertificateIssuerCertManager {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteCertManagerCertificates removes the cert-manager Certificates of virt-operator.
+// The secrets are left in place, the built-in rotation takes them over.
+func deleteCertManagerCertificates(clientset kubecli.KubevirtClient, namespaces []string) error {
+	seen := map[string]bool{}
+	for _, namespace := range namespaces {
+		if seen[namespace] {
+			continue
+		}
+		seen[namespace] = true
+
+		err := clientset.DynamicClient().Resource(certManagerCertificateGVR).Namespace(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", v1.ManagedByLabel, v1.ManagedByLabelOperatorValue),
+		})
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete cert-manager certificates in namespace %s: %v", namespace, err)
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) deleteStaleCertManagerCertificates() error {
+	external := r.kv.Spec.CertificateRotationStrategy.External
+	if (external != nil && external.CertManager != nil) || !hasCertManagerCertificates(r.kv) {
+		return nil
+	}
+
+	var namespaces []string
+	for _, secret := range r.targetStrategy.CertificateSecrets() {
+		namespaces = append(namespaces, secret.Namespace)
+	}
+	return deleteCertManagerCertificates(r.clientset, namespaces)
+}
@@ -0,0 +1,232 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package apply
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/certificates/bootstrap"
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
+	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+)
+
+var _ = Describe("External certificates", func() {
+	const namespace = "kubevirt"
+
+	newSecretSignedBy := func(name string, ca *triple.KeyPair) *corev1.Secret {
+		keyPair, err := triple.NewServerKeyPair(ca, "virt-api.kubevirt.pod.cluster.local", "virt-api", namespace, components.CaClusterLocal, nil, nil, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Data: map[string][]byte{
+				bootstrap.CertBytesValue: cert.EncodeCertPEM(keyPair.Cert),
+				bootstrap.KeyBytesValue:  cert.EncodePrivateKeyPEM(keyPair.Key),
+				caCertKey:                cert.EncodeCertPEM(ca.Cert),
+			},
+		}
+	}
+
+	newCA := func() *triple.KeyPair {
+		ca, err := triple.NewCA("external.io", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		return ca
+	}
+
+	Context("cert-manager Certificates", func() {
+		var r *Reconciler
+
+		BeforeEach(func() {
+			r = &Reconciler{
+				kv: &v1.KubeVirt{},
+			}
+		})
+
+		findSecret := func(name string) *corev1.Secret {
+			for _, secret := range components.NewCertSecrets(namespace, namespace) {
+				if secret.Name == name {
+					return secret
+				}
+			}
+			Fail("secret not found")
+			return nil
+		}
+
+		newCertificate := func(secretName string, config *v1.CertManagerConfiguration) *unstructured.Unstructured {
+			secret := findSecret(secretName)
+			spec, ok := components.GetCertificateSpec(secret)
+			Expect(ok).To(BeTrue())
+			return r.newCertManagerCertificate(secret, spec, config)
+		}
+
+		It("should request a server certificate for the service of the component", func() {
+			certificate := newCertificate(components.VirtApiCertSecretName, &v1.CertManagerConfiguration{
+				IssuerRef: v1.CertManagerIssuerReference{Name: "issuer"},
+			})
+
+			Expect(certificate.GetAPIVersion()).To(Equal("cert-manager.io/v1"))
+			Expect(certificate.GetKind()).To(Equal("Certificate"))
+			Expect(certificate.GetName()).To(Equal(components.VirtApiCertSecretName))
+			Expect(certificate.GetNamespace()).To(Equal(namespace))
+			Expect(certificate.GetLabels()).To(HaveKeyWithValue(v1.ManagedByLabel, v1.ManagedByLabelOperatorValue))
+
+			spec := certificate.Object["spec"].(map[string]interface{})
+			Expect(spec).To(HaveKeyWithValue("secretName", components.VirtApiCertSecretName))
+			Expect(spec).To(HaveKeyWithValue("commonName", "virt-api.kubevirt.pod.cluster.local"))
+			Expect(spec["dnsNames"]).To(ConsistOf("virt-api", "virt-api.kubevirt", "virt-api.kubevirt.svc", "virt-api.kubevirt.svc.cluster.local"))
+			Expect(spec["usages"]).To(ContainElement("server auth"))
+			Expect(spec["issuerRef"]).To(Equal(map[string]interface{}{
+				"name":  "issuer",
+				"kind":  "Issuer",
+				"group": "cert-manager.io",
+			}))
+			Expect(spec).ToNot(HaveKey("duration"))
+			Expect(spec).ToNot(HaveKey("renewBefore"))
+
+			secretTemplate := spec["secretTemplate"].(map[string]interface{})
+			Expect(secretTemplate["labels"]).To(HaveKeyWithValue(v1.ManagedByLabel, v1.ManagedByLabelOperatorValue))
+		})
+
+		It("should request a client certificate for virt-handler", func() {
+			certificate := newCertificate(components.VirtHandlerCertSecretName, &v1.CertManagerConfiguration{
+				IssuerRef: v1.CertManagerIssuerReference{Name: "issuer", Kind: "ClusterIssuer"},
+			})
+
+			spec := certificate.Object["spec"].(map[string]interface{})
+			Expect(spec).To(HaveKeyWithValue("commonName", "kubevirt.io:system:client:virt-handler"))
+			Expect(spec).ToNot(HaveKey("dnsNames"))
+			Expect(spec["usages"]).To(ContainElement("client auth"))
+			Expect(spec["issuerRef"]).To(HaveKeyWithValue("kind", "ClusterIssuer"))
+		})
+
+		It("should pass the duration and the renewal to cert-manager", func() {
+			certificate := newCertificate(components.VirtControllerCertSecretName, &v1.CertManagerConfiguration{
+				IssuerRef: v1.CertManagerIssuerReference{Name: "issuer"},
+				Server: &v1.CertConfig{
+					Duration:    &metav1.Duration{Duration: 48 * time.Hour},
+					RenewBefore: &metav1.Duration{Duration: 12 * time.Hour},
+				},
+			})
+
+			spec := certificate.Object["spec"].(map[string]interface{})
+			Expect(spec).To(HaveKeyWithValue("duration", "48h0m0s"))
+			Expect(spec).To(HaveKeyWithValue("renewBefore", "12h0m0s"))
+		})
+	})
+
+	Context("CA bundle", func() {
+		It("should merge the CAs of all secrets once", func() {
+			ca1 := newCA()
+			ca2 := newCA()
+
+			bundle := collectExternalCABundle([]*corev1.Secret{
+				newSecretSignedBy("first", ca1),
+				newSecretSignedBy("second", ca1),
+				newSecretSignedBy("third", ca2),
+			})
+
+			caCerts, err := cert.ParseCertsPEM(bundle)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(caCerts).To(HaveLen(2))
+			Expect(caCerts[0].Equal(ca1.Cert)).To(BeTrue())
+			Expect(caCerts[1].Equal(ca2.Cert)).To(BeTrue())
+		})
+
+		It("should skip secrets without CA", func() {
+			secret := newSecretSignedBy("first", newCA())
+			delete(secret.Data, caCertKey)
+
+			Expect(collectExternalCABundle([]*corev1.Secret{secret})).To(BeEmpty())
+		})
+	})
+
+	Context("status", func() {
+		It("should report a valid certificate as ready", func() {
+			status := externalCertificateStatus("first", newSecretSignedBy("first", newCA()), v1.CertificateIssuerCertManager, time.Now())
+
+			Expect(status.SecretName).To(Equal("first"))
+			Expect(status.Issuer).To(Equal(v1.CertificateIssuerCertManager))
+			Expect(status.Ready).To(BeTrue())
+			Expect(status.Message).To(BeEmpty())
+			Expect(status.NotBefore).ToNot(BeNil())
+			Expect(status.NotAfter).ToNot(BeNil())
+		})
+
+		It("should report a missing secret", func() {
+			status := externalCertificateStatus("first", nil, v1.CertificateIssuerExternal, time.Now())
+
+			Expect(status.Ready).To(BeFalse())
+			Expect(status.Message).To(Equal("secret not found"))
+		})
+
+		It("should report an expired certificate", func() {
+			status := externalCertificateStatus("first", newSecretSignedBy("first", newCA()), v1.CertificateIssuerExternal, time.Now().Add(2*time.Hour))
+
+			Expect(status.Ready).To(BeFalse())
+			Expect(status.Message).To(Equal("certificate expired"))
+		})
+
+		It("should report a missing CA", func() {
+			secret := newSecretSignedBy("first", newCA())
+			delete(secret.Data, caCertKey)
+			status := externalCertificateStatus("first", secret, v1.CertificateIssuerExternal, time.Now())
+
+			Expect(status.Ready).To(BeFalse())
+			Expect(status.Message).To(ContainSubstring("no CA certificate found in ca.crt"))
+		})
+
+		It("should detect certificates issued by cert-manager", func() {
+			kv := &v1.KubeVirt{}
+			kv.Status.Certificates = []v1.KubeVirtCertificateStatus{
+				{SecretName: components.KubeVirtExportCASecretName, Issuer: v1.CertificateIssuerSelfSigned},
+			}
+			Expect(hasCertManagerCertificates(kv)).To(BeFalse())
+
+			kv.Status.Certificates = append(kv.Status.Certificates, v1.KubeVirtCertificateStatus{
+				SecretName: components.VirtApiCertSecretName, Issuer: v1.CertificateIssuerCertManager,
+			})
+			Expect(hasCertManagerCertificates(kv)).To(BeTrue())
+		})
+	})
+
+	It("should let virt-api and virt-handler accept intermediate CAs", func() {
+		kv := &v1.KubeVirt{}
+		podSpec := &corev1.PodSpec{Containers: []corev1.Container{{Command: []string{"virt-api"}}}}
+
+		setExternallyManagedCertificates(kv, podSpec)
+		Expect(podSpec.Containers[0].Command).To(Equal([]string{"virt-api"}))
+
+		kv.Spec.CertificateRotationStrategy.External = &v1.KubeVirtExternalCertConfiguration{}
+		setExternallyManagedCertificates(kv, podSpec)
+		Expect(podSpec.Containers[0].Command).To(Equal([]string{"virt-api", "--externally-managed"}))
+	})
+})
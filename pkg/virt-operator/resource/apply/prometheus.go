@@ -14,6 +14,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/monitoring/rules/alerts"
 )
 
 func (r *Reconciler) createOrUpdateServiceMonitors() error {
@@ -97,7 +98,11 @@ func (r *Reconciler) createOrUpdatePrometheusRules() error {
 	}
 
 	for _, prometheusRule := range r.targetStrategy.PrometheusRules() {
-		if err := r.createOrUpdatePrometheusRule(prometheusRule.DeepCopy()); err != nil {
+		prometheusRule = prometheusRule.DeepCopy()
+		if err := alerts.Customize(prometheusRule, r.kv.Spec.Alerts); err != nil {
+			return fmt.Errorf("unable to customize the alerts of PrometheusRule %s: %v", prometheusRule.Name, err)
+		}
+		if err := r.createOrUpdatePrometheusRule(prometheusRule); err != nil {
 			return err
 		}
 	}
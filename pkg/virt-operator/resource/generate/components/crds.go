@@ -22,9 +22,13 @@ import (
 	"fmt"
 	"strings"
 
+	"kubevirt.io/api/breakglass"
+	breakglassv1alpha1 "kubevirt.io/api/breakglass/v1alpha1"
 	"kubevirt.io/api/clone"
 
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+	"kubevirt.io/api/diskcheck"
+	diskcheckv1alpha1 "kubevirt.io/api/diskcheck/v1alpha1"
 
 	"kubevirt.io/api/instancetype"
 
@@ -39,12 +43,20 @@ import (
 	virtv1 "kubevirt.io/api/core/v1"
 	exportv1alpha1 "kubevirt.io/api/export/v1alpha1"
 	exportv1beta1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/api/guestagent"
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
+	"kubevirt.io/api/hostdevices"
+	hostdevicesv1alpha1 "kubevirt.io/api/hostdevices/v1alpha1"
 	instancetypev1alpha1 "kubevirt.io/api/instancetype/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/api/notifications"
+	notificationsv1alpha1 "kubevirt.io/api/notifications/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
 	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/api/timeline"
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
 )
@@ -68,6 +80,12 @@ var (
 	VIRTUALMACHINEEXPORT             = "virtualmachineexports." + exportv1beta1.SchemeGroupVersion.Group
 	MIGRATIONPOLICY                  = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
 	VIRTUALMACHINECLONE              = "virtualmachineclones." + clonev1alpha1.VirtualMachineCloneKind.Group
+	GUESTAGENTPOLICY                 = "guestagentpolicies." + guestagentv1alpha1.GuestAgentPolicyKind.Group
+	LIFECYCLENOTIFICATION            = "lifecyclenotifications." + notificationsv1alpha1.LifecycleNotificationKind.Group
+	VIRTUALMACHINETIMELINE           = "virtualmachinetimelines." + timelinev1alpha1.VirtualMachineTimelineKind.Group
+	VIRTUALMACHINEDISKCHECK          = "virtualmachinediskchecks." + diskcheckv1alpha1.VirtualMachineDiskCheckKind.Group
+	CONSOLEACCESSGRANT               = "consoleaccessgrants." + breakglassv1alpha1.ConsoleAccessGrantKind.Group
+	HOSTDEVICEINVENTORY              = "hostdeviceinventories." + hostdevicesv1alpha1.HostDeviceInventoryKind.Group
 )
 
 func addFieldsToVersion(version *extv1.CustomResourceDefinitionVersion, fields ...interface{}) error {
@@ -908,6 +926,232 @@ func NewVirtualMachineCloneCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewGuestAgentPolicyCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = GUESTAGENTPOLICY
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: guestagentv1alpha1.GuestAgentPolicyKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    guestagentv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:   guestagent.ResourceGuestAgentPolicies,
+			Singular: guestagent.ResourceGuestAgentPolicySingular,
+			Kind:     guestagentv1alpha1.GuestAgentPolicyKind.Kind,
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	if err := patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewLifecycleNotificationCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = LIFECYCLENOTIFICATION
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: notificationsv1alpha1.LifecycleNotificationKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    notificationsv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:   notifications.ResourceLifecycleNotifications,
+			Singular: notifications.ResourceLifecycleNotificationSingular,
+			Kind:     notificationsv1alpha1.LifecycleNotificationKind.Kind,
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	if err := patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineTimelineCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINETIMELINE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: timelinev1alpha1.VirtualMachineTimelineKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    timelinev1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     timeline.ResourceVirtualMachineTimelines,
+			Singular:   timeline.ResourceVirtualMachineTimelineSingular,
+			Kind:       timelinev1alpha1.VirtualMachineTimelineKind.Kind,
+			ShortNames: []string{"vmtimeline", "vmtimelines"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	if err := patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineDiskCheckCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEDISKCHECK
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: diskcheckv1alpha1.VirtualMachineDiskCheckKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    diskcheckv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     diskcheck.ResourceVirtualMachineDiskChecks,
+			Singular:   diskcheck.ResourceVirtualMachineDiskCheckSingular,
+			Kind:       diskcheckv1alpha1.VirtualMachineDiskCheckKind.Kind,
+			ShortNames: []string{"vmdiskcheck", "vmdiskchecks"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd,
+		&extv1.CustomResourceSubresources{
+			Status: &extv1.CustomResourceSubresourceStatus{},
+		},
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "SourceKind", Type: "string", JSONPath: ".spec.source.kind"},
+			{Name: "SourceName", Type: "string", JSONPath: ".spec.source.name"},
+			{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewConsoleAccessGrantCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = CONSOLEACCESSGRANT
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: breakglassv1alpha1.ConsoleAccessGrantKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    breakglassv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     breakglass.ResourceConsoleAccessGrants,
+			Singular:   breakglass.ResourceConsoleAccessGrantSingular,
+			Kind:       breakglassv1alpha1.ConsoleAccessGrantKind.Kind,
+			ShortNames: []string{"cag", "cags"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd,
+		&extv1.CustomResourceSubresources{
+			Status: &extv1.CustomResourceSubresourceStatus{},
+		},
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "User", Type: "string", JSONPath: ".spec.user"},
+			{Name: "VirtualMachine", Type: "string", JSONPath: ".spec.virtualMachineName"},
+			{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+			{Name: "ExpiresAt", Type: "string", JSONPath: ".status.expiresAt"},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewHostDeviceInventoryCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = HOSTDEVICEINVENTORY
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: hostdevicesv1alpha1.HostDeviceInventoryKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    hostdevicesv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.ClusterScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     hostdevices.ResourceHostDeviceInventories,
+			Singular:   hostdevices.ResourceHostDeviceInventorySingular,
+			Kind:       hostdevicesv1alpha1.HostDeviceInventoryKind.Kind,
+			ShortNames: []string{"hdi", "hdis"},
+		},
+	}
+	err := addFieldsToAllVersions(crd,
+		&extv1.CustomResourceSubresources{
+			Status: &extv1.CustomResourceSubresourceStatus{},
+		},
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "LastChange", Type: "date", JSONPath: ".status.lastChangeTime"},
+			{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 // NewKubeVirtPriorityClassCR is used for manifest generation
 func NewKubeVirtPriorityClassCR() *schedulingv1.PriorityClass {
 	return &schedulingv1.PriorityClass{
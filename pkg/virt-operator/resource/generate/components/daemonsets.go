@@ -180,6 +180,8 @@ func NewHandlerDaemonSet(namespace, repository, imagePrefix, version, launcherVe
 		"$(NODE_NAME)",
 		"--pod-ip-address",
 		"$(MY_POD_IP)",
+		"--pod-ip-addresses",
+		"$(MY_POD_IPS)",
 		"--max-metric-requests",
 		"3",
 		"--console-server-port",
@@ -219,6 +221,14 @@ func NewHandlerDaemonSet(namespace, repository, imagePrefix, version, launcherVe
 				},
 			},
 		},
+		{
+			Name: "MY_POD_IPS",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "status.podIPs",
+				},
+			},
+		},
 	}
 
 	container.Env = append(container.Env, containerEnv...)
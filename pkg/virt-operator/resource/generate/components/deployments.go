@@ -41,10 +41,11 @@ import (
 const (
 	nodeLabellerVolumePath = "/var/lib/kubevirt-node-labeller"
 
-	VirtAPIName         = "virt-api"
-	VirtControllerName  = "virt-controller"
-	VirtOperatorName    = "virt-operator"
-	VirtExportProxyName = "virt-exportproxy"
+	VirtAPIName          = "virt-api"
+	VirtControllerName   = "virt-controller"
+	VirtOperatorName     = "virt-operator"
+	VirtExportProxyName  = "virt-exportproxy"
+	VirtExportServerName = "virt-exportserver"
 
 	kubevirtLabelKey = "kubevirt.io"
 
@@ -165,6 +165,47 @@ func PopulateSecretWithCertificate(secret *k8sv1.Secret, caCert *tls.Certificate
 	return nil
 }
 
+// CertificateSpec describes the certificate a component secret has to hold
+// when it is issued outside of virt-operator
+type CertificateSpec struct {
+	CommonName string
+	DNSNames   []string
+	Client     bool
+}
+
+// GetCertificateSpec returns the subject of the certificate expected in the
+// given component secret. CA secrets have no spec.
+func GetCertificateSpec(secret *k8sv1.Secret) (CertificateSpec, bool) {
+	serverSpec := func(commonName, svcName string) CertificateSpec {
+		namespacedName := fmt.Sprintf("%s.%s", svcName, secret.Namespace)
+		return CertificateSpec{
+			CommonName: commonName,
+			DNSNames: []string{
+				svcName,
+				namespacedName,
+				fmt.Sprintf("%s.svc", namespacedName),
+				fmt.Sprintf("%s.svc.%s", namespacedName, CaClusterLocal),
+			},
+		}
+	}
+
+	switch secret.Name {
+	case VirtOperatorCertSecretName:
+		return serverSpec(fmt.Sprintf(LocalPodDNStemplateString, VirtOperatorServiceName, secret.Namespace), VirtOperatorServiceName), true
+	case VirtApiCertSecretName:
+		return serverSpec(fmt.Sprintf(LocalPodDNStemplateString, VirtApiServiceName, secret.Namespace), VirtApiServiceName), true
+	case VirtControllerCertSecretName:
+		return serverSpec(fmt.Sprintf(LocalPodDNStemplateString, VirtControllerServiceName, secret.Namespace), VirtControllerServiceName), true
+	case VirtExportProxyCertSecretName:
+		return serverSpec(fmt.Sprintf(LocalPodDNStemplateString, VirtExportProxyServiceName, secret.Namespace), VirtExportProxyServiceName), true
+	case VirtHandlerServerCertSecretName:
+		return serverSpec("kubevirt.io:system:node:virt-handler", VirtHandlerServiceName), true
+	case VirtHandlerCertSecretName:
+		return CertificateSpec{CommonName: "kubevirt.io:system:client:virt-handler", Client: true}, true
+	}
+	return CertificateSpec{}, false
+}
+
 func NewCACertSecrets(operatorNamespace string) []*k8sv1.Secret {
 	return []*k8sv1.Secret{
 		{
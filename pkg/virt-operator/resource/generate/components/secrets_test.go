@@ -307,6 +307,34 @@ var _ = Describe("Certificate Management", func() {
 			Expect(err).ToNot(HaveOccurred())
 		}
 	})
+
+	It("should describe the same certificates as the built-in CA issues", func() {
+		caSecret := NewCACertSecrets("test")[0]
+		Expect(PopulateSecretWithCertificate(caSecret, nil, &v1.Duration{Duration: 1 * time.Hour})).To(Succeed())
+		caCert, err := LoadCertificates(caSecret)
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, secret := range NewCertSecrets("install_namespace", "operator_namespace") {
+			spec, ok := GetCertificateSpec(secret)
+			Expect(ok).To(BeTrue())
+
+			Expect(PopulateSecretWithCertificate(secret, caCert, &v1.Duration{Duration: 1 * time.Hour})).To(Succeed())
+			crt, err := LoadCertificates(secret)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crt.Leaf.Subject.CommonName).To(Equal(spec.CommonName))
+			Expect(crt.Leaf.DNSNames).To(ConsistOf(spec.DNSNames))
+			if spec.Client {
+				Expect(crt.Leaf.ExtKeyUsage).To(ConsistOf(x509.ExtKeyUsageClientAuth))
+			} else {
+				Expect(crt.Leaf.ExtKeyUsage).To(ConsistOf(x509.ExtKeyUsageServerAuth))
+			}
+		}
+
+		for _, secret := range NewCACertSecrets("test") {
+			_, ok := GetCertificateSpec(secret)
+			Expect(ok).To(BeFalse())
+		}
+	})
 })
 
 // newSelfSignedCert creates a CA certificate
@@ -1,6 +1,105 @@
 package components
 
 var CRDsValidation map[string]string = map[string]string{
+	"consoleaccessgrant": `openAPIV3Schema:
+  description: |-
+    ConsoleAccessGrant grants a user a temporary access to the console of a VirtualMachine,
+    e.g. to respond to an incident in an environment where nobody has it otherwise.
+    The access is revoked once the grant expires or is deleted, and each access made with it is recorded.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: ConsoleAccessGrantSpec is the spec of a ConsoleAccessGrant, it
+        can't be changed once created
+      properties:
+        duration:
+          description: Duration is the time the access is granted for, from the creation
+            of the grant, at most 24 hours
+          type: string
+        grantedBy:
+          description: GrantedBy is the name of the user granting the access, it has
+            to be the user creating the grant
+          type: string
+        reason:
+          description: Reason is the reason the access is granted for, e.g. an incident
+            ticket
+          type: string
+        subresources:
+          description: |-
+            Subresources are the subresources the access is granted to, among console and vnc,
+            console by default
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+        user:
+          description: User is the name of the user granted the access
+          type: string
+        virtualMachineName:
+          description: |-
+            VirtualMachineName is the name of the VirtualMachine the access is granted to,
+            in the namespace of the grant
+          type: string
+      required:
+      - user
+      - virtualMachineName
+      - duration
+      - reason
+      - grantedBy
+      type: object
+    status:
+      description: ConsoleAccessGrantStatus is the status of a ConsoleAccessGrant
+      properties:
+        accesses:
+          description: Accesses are the accesses made with the grant, oldest first
+          items:
+            description: ConsoleAccessRecord is an access made with a ConsoleAccessGrant
+            properties:
+              subresource:
+                description: Subresource is the subresource accessed
+                type: string
+              timestamp:
+                description: Timestamp is the time of the access
+                format: date-time
+                type: string
+            required:
+            - subresource
+            - timestamp
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        expiresAt:
+          description: ExpiresAt is the time the access is revoked at
+          format: date-time
+          nullable: true
+          type: string
+        phase:
+          description: Phase is the phase of the grant
+          enum:
+          - Active
+          - Expired
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
 	"datavolumetemplatespec": `openAPIV3Schema:
   nullable: true
   properties:
@@ -653,6 +752,223 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"guestagentpolicy": `openAPIV3Schema:
+  description: GuestAgentPolicy restricts the guest agent commands which KubeVirt
+    may invoke on the VMIs of its namespace
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        allowedCommands:
+          description: |-
+            AllowedCommands lists the guest agent commands modifying the guest which may be invoked.
+            The commands which are not listed are denied.
+          items:
+            description: GuestAgentCommand is a class of guest agent commands modifying
+              the guest
+            enum:
+            - Exec
+            - FileWrite
+            - SetUserPassword
+            - SSHAuthorizedKeys
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"hostdeviceinventory": `openAPIV3Schema:
+  description: |-
+    HostDeviceInventory lists the host devices of a node, as discovered by its virt-handler: the PCI
+    devices, their SR-IOV and NUMA topology, the mediated devices they support and the VMIs they are
+    assigned to. It is named after its node.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    status:
+      description: HostDeviceInventoryStatus is the status of a HostDeviceInventory
+      properties:
+        lastChangeTime:
+          description: LastChangeTime is the last time the devices of the inventory
+            changed
+          format: date-time
+          nullable: true
+          type: string
+        mediatedDevices:
+          description: MediatedDevices are the mediated devices created on the node
+          items:
+            description: MediatedDevice is a mediated device created on a node
+            properties:
+              claimedBy:
+                description: ClaimedBy is the VMI the mediated device is assigned
+                  to, if any
+                properties:
+                  name:
+                    description: Name is the name of the VMI
+                    type: string
+                  namespace:
+                    description: Namespace is the namespace of the VMI
+                    type: string
+                required:
+                - namespace
+                - name
+                type: object
+              parentAddress:
+                description: ParentAddress is the PCI address of the device providing
+                  the mediated device
+                type: string
+              resourceName:
+                description: ResourceName is the resource name the mediated device
+                  is permitted as in the KubeVirt CR, if any
+                type: string
+              type:
+                description: Type is the name of the type of the mediated device
+                type: string
+              uuid:
+                description: UUID is the UUID of the mediated device
+                type: string
+            required:
+            - uuid
+            - type
+            - parentAddress
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        pciDevices:
+          description: PCIDevices are the PCI devices of the node, except the PCI
+            bridges
+          items:
+            description: PCIDevice is a PCI device of a node
+            properties:
+              address:
+                description: Address is the PCI address of the device, e.g. 0000:3b:00.0
+                type: string
+              claimedBy:
+                description: ClaimedBy is the VMI the device is assigned to, if any
+                properties:
+                  name:
+                    description: Name is the name of the VMI
+                    type: string
+                  namespace:
+                    description: Namespace is the namespace of the VMI
+                    type: string
+                required:
+                - namespace
+                - name
+                type: object
+              class:
+                description: Class is the PCI class code of the device, e.g. 0x030200
+                type: string
+              driver:
+                description: Driver is the kernel driver bound to the device, e.g.
+                  vfio-pci
+                type: string
+              iommuGroup:
+                description: IOMMUGroup is the IOMMU group of the device
+                type: string
+              mediatedDeviceTypes:
+                description: MediatedDeviceTypes are the mediated device types the
+                  device can provide
+                items:
+                  description: MediatedDeviceType is a mediated device type a PCI
+                    device can provide
+                  properties:
+                    availableInstances:
+                      description: AvailableInstances is the number of mediated devices
+                        of the type which can still be created
+                      format: int32
+                      type: integer
+                    id:
+                      description: ID is the ID of the type, e.g. nvidia-222
+                      type: string
+                    name:
+                      description: |-
+                        Name is the name of the type, e.g. GRID_T4-1Q, as matched by the mdevNameSelector of the
+                        permitted host devices
+                      type: string
+                  required:
+                  - id
+                  - availableInstances
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              numaNode:
+                description: NUMANode is the NUMA node the device is attached to,
+                  unset when the node doesn't report it
+                format: int32
+                type: integer
+              pciVendorSelector:
+                description: |-
+                  PCIVendorSelector is the vendor and device IDs of the device, e.g. 10de:1eb8, as matched by the
+                  pciVendorSelector of the permitted host devices
+                type: string
+              physicalFunction:
+                description: PhysicalFunction is the address of the SR-IOV physical
+                  function of a virtual function
+                type: string
+              resourceName:
+                description: ResourceName is the resource name the device is permitted
+                  as in the KubeVirt CR, if any
+                type: string
+              sriov:
+                description: SRIOV reports the virtual functions of an SR-IOV physical
+                  function
+                properties:
+                  numVFs:
+                    description: NumVFs is the number of virtual functions enabled
+                    format: int32
+                    type: integer
+                  totalVFs:
+                    description: TotalVFs is the number of virtual functions the physical
+                      function supports
+                    format: int32
+                    type: integer
+                required:
+                - totalVFs
+                - numVFs
+                type: object
+            required:
+            - address
+            - pciVendorSelector
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  type: object
 `,
 	"kubevirt": `openAPIV3Schema:
   description: KubeVirt represents the object deploying all KubeVirt resources
@@ -676,8 +992,103 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
+        alerts:
+          description: Alerts tunes or disables the bundled alerts of the PrometheusRule
+            deployed by KubeVirt
+          properties:
+            disabled:
+              description: Disabled lists the names of the bundled alerts which are
+                not deployed
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
+            overrides:
+              description: Overrides tunes individual bundled alerts
+              items:
+                description: AlertOverride tunes a bundled alert of KubeVirt
+                properties:
+                  alert:
+                    description: Alert is the name of the bundled alert
+                    type: string
+                  for:
+                    description: For is how long the expression of the alert has to
+                      hold before the alert fires, e.g. 30m
+                    pattern: ^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$
+                    type: string
+                  severity:
+                    description: Severity replaces the severity label of the alert
+                    enum:
+                    - info
+                    - warning
+                    - critical
+                    type: string
+                  threshold:
+                    description: |-
+                      Threshold replaces the number the expression of the alert is compared with.
+                      Only the alerts whose expression ends with a comparison to a number have a threshold.
+                    type: string
+                required:
+                - alert
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - alert
+              x-kubernetes-list-type: map
+          type: object
         certificateRotateStrategy:
           properties:
+            external:
+              description: |-
+                External delegates the issuance of the serving and client certificates of the KubeVirt
+                components. The built-in CA keeps signing the certificates of VirtualMachineExports.
+              properties:
+                certManager:
+                  description: |-
+                    CertManager requests the certificates from a cert-manager issuer.
+                    If unset, the certificate secrets are provided by the cluster admin, with the
+                    CA certificate in the ca.crt key of every secret.
+                  properties:
+                    issuerRef:
+                      description: IssuerRef references the cert-manager issuer signing
+                        the certificates
+                      properties:
+                        group:
+                          description: Group of the issuer. Defaults to cert-manager.io
+                          type: string
+                        kind:
+                          description: |-
+                            Kind of the issuer, Issuer or ClusterIssuer. Defaults to Issuer.
+                            An Issuer has to be in the namespace of KubeVirt.
+                          enum:
+                          - Issuer
+                          - ClusterIssuer
+                          type: string
+                        name:
+                          description: Name of the issuer
+                          type: string
+                      required:
+                      - name
+                      type: object
+                    server:
+                      description: |-
+                        Server configures the duration and the renewal of the certificates.
+                        Defaults to the cert-manager defaults.
+                      properties:
+                        duration:
+                          description: The requested 'duration' (i.e. lifetime) of
+                            the Certificate.
+                          type: string
+                        renewBefore:
+                          description: |-
+                            The amount of time before the currently issued certificate's "notAfter"
+                            time that we will begin to attempt to renew the certificate.
+                          type: string
+                      type: object
+                  required:
+                  - issuerRef
+                  type: object
+              type: object
             selfSigned:
               properties:
                 ca:
@@ -735,6 +1146,20 @@ var CRDsValidation map[string]string = map[string]string{
                 by node pressures, but would mean that fewer VMs could be scheduled to a node.
                 If not set, the default is 1.
               type: string
+            admissionWarnings:
+              description: AdmissionWarnings configures the best-practice warnings
+                returned on the admission of VMs and VMIs.
+              nullable: true
+              properties:
+                disabled:
+                  description: Disabled lists the warnings not returned.
+                  items:
+                    description: AdmissionWarning names a best-practice warning
+                      returned on the admission of VMs and VMIs.
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+              type: object
             apiConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -1013,6 +1438,58 @@ var CRDsValidation map[string]string = map[string]string{
                 migrated instead of shut-off in case of a node drain. If the VirtualMachineInstance specific
                 field is set it overrides the cluster level one.
               type: string
+            externalPolicyServices:
+              description: |-
+                ExternalPolicyServices lists external policy services consulted at VM admission and
+                migration target selection.
+                It takes effect only when the ExternalPolicy feature gate is enabled.
+              items:
+                description: |-
+                  ExternalPolicyService describes an HTTPS service which reviews VirtualMachines and migrations
+                  on behalf of KubeVirt, e.g. to enforce license aware placement or compliance zones.
+                properties:
+                  caBundle:
+                    description: |-
+                      CABundle is a PEM encoded CA bundle used to verify the certificate of the service.
+                      The system trust roots are used if unset.
+                    format: byte
+                    type: string
+                  failurePolicy:
+                    description: |-
+                      FailurePolicy defines how an unreachable service or an invalid answer is handled.
+                      Defaults to Fail.
+                    enum:
+                    - Fail
+                    - Ignore
+                    type: string
+                  hooks:
+                    description: Hooks lists the points at which the service is consulted.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: set
+                  name:
+                    description: Name identifies the service in events and error messages.
+                    type: string
+                  timeoutSeconds:
+                    description: |-
+                      TimeoutSeconds after which a call to the service is considered failed.
+                      Must be between 1 and 10, defaults to 5.
+                    format: int32
+                    type: integer
+                  url:
+                    description: URL of the endpoint receiving the policy review requests.
+                      Must use the https scheme.
+                    type: string
+                required:
+                - hooks
+                - name
+                - url
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
             handlerConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -1045,10 +1522,101 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            hugepagesPools:
+              description: |-
+                HugepagesPools lets virt-handler grow and shrink the hugepage pools of the nodes, within the bounds
+                of each pool, to fit the pending VMIs requesting hugepages.
+                It takes effect only when the HugepagesPoolManagement feature gate is enabled.
+              items:
+                description: HugepagesPool bounds the hugepages of a size virt-handler
+                  allocates on a pool of nodes.
+                properties:
+                  max:
+                    description: Max is the number of hugepages the pool can't grow
+                      beyond.
+                    format: int32
+                    type: integer
+                  min:
+                    description: Min is the number of hugepages always kept allocated.
+                    format: int32
+                    type: integer
+                  name:
+                    description: Name of the pool.
+                    type: string
+                  nodeSelector:
+                    additionalProperties:
+                      type: string
+                    description: NodeSelector selects the nodes of the pool by their
+                      labels, all the nodes when empty.
+                    type: object
+                  pageSize:
+                    description: PageSize of the hugepages, 2Mi or 1Gi.
+                    type: string
+                required:
+                - max
+                - name
+                - pageSize
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
             imagePullPolicy:
               description: PullPolicy describes a policy for if/when to pull a container
                 image
               type: string
+            infraThreadsPinning:
+              description: |-
+                InfraThreadsPinning is the cluster wide pinning policy of the emulator threads and iothreads of the VMIs.
+                It takes effect only when the InfraThreadsPinning feature gate is enabled.
+              nullable: true
+              properties:
+                housekeepingCPUs:
+                  description: |-
+                    HousekeepingCPUs sets, per pool of nodes, the CPUs the emulator threads, iothreads and vhost kernel threads
+                    of the VMIs without dedicated CPUs share. They override virtualizationInfraReservation.cpus on the nodes
+                    they select, the first matching pool applies.
+                  items:
+                    description: HousekeepingCPUPool sets the housekeeping CPUs of
+                      a pool of nodes.
+                    properties:
+                      cpus:
+                        description: CPUs is the list of host CPUs, e.g. 0-1,8, shared
+                          by the infrastructure threads on the nodes of the pool.
+                        type: string
+                      name:
+                        description: Name of the pool.
+                        type: string
+                      nodeSelector:
+                        additionalProperties:
+                          type: string
+                        description: NodeSelector selects the nodes of the pool by
+                          their labels.
+                        type: object
+                    required:
+                    - cpus
+                    - name
+                    - nodeSelector
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+                ioThreads:
+                  description: |-
+                    IOThreads pins the iothreads of the VMIs with dedicated CPUs and an isolated emulator thread:
+                    VCPUs spreads them on the pCPUs of the vCPUs, EmulatorThread pins them all with the emulator thread.
+                    When not set, the first iothread is pinned with the emulator thread.
+                  enum:
+                  - VCPUs
+                  - EmulatorThread
+                  type: string
+                isolateEmulatorThread:
+                  description: |-
+                    IsolateEmulatorThread isolates the emulator thread of every VMI with dedicated CPUs on an additional pCPU,
+                    as if its spec.domain.cpu.isolateEmulatorThread was set.
+                  type: boolean
+              type: object
             instancetype:
               description: Instancetype configuration
               nullable: true
@@ -1119,6 +1687,63 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
                   x-kubernetes-map-type: atomic
               type: object
+            launcherImageOverrides:
+              description: |-
+                LauncherImageOverrides pin the virt-launcher image, and so the QEMU and libvirt versions, of the
+                VMIs placed on node pools, the first matching override applies.
+                It takes effect only when the LauncherImageOverrides feature gate is enabled.
+              items:
+                description: LauncherImageOverride pins the virt-launcher image of
+                  the VMIs placed on a pool of nodes.
+                properties:
+                  image:
+                    description: Image is the virt-launcher image of the VMIs of the
+                      pool.
+                    type: string
+                  name:
+                    description: Name of the override.
+                    type: string
+                  nodeSelector:
+                    additionalProperties:
+                      type: string
+                    description: |-
+                      NodeSelector selects the nodes of the pool by their labels. The override applies to the VMIs whose
+                      pods select all of them.
+                    type: object
+                required:
+                - image
+                - name
+                - nodeSelector
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
+            licenseGroups:
+              description: |-
+                LicenseGroups restricts the nodes VMIs labeled with kubevirt.io/license-group may run on.
+                It takes effect only when the LicenseTracking feature gate is enabled.
+              items:
+                description: LicenseGroup pins the VMIs of a license group to a set
+                  of licensed hosts.
+                properties:
+                  name:
+                    description: Name of the license group, matched against the kubevirt.io/license-group
+                      label of the VMIs.
+                    type: string
+                  nodeSelector:
+                    additionalProperties:
+                      type: string
+                    description: NodeSelector is added to the launcher pods of the
+                      VMIs in the group, including migration targets.
+                    type: object
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
             liveUpdateConfiguration:
               description: LiveUpdateConfiguration holds defaults for live update
                 features
@@ -1358,6 +1983,21 @@ var CRDsValidation map[string]string = map[string]string{
                           If namespace is not specified, VMI namespace is assumed.
                           version: 1alphav1
                         type: string
+                      sidecarConfigMap:
+                        description: |-
+                          SidecarConfigMap references a ConfigMap in the namespace of the VMI, holding the configuration file of the sidecar.
+                          version: v1alphav1
+                        properties:
+                          key:
+                            description: Key of the ConfigMap holding the configuration
+                              file, "config.yaml" by default
+                            type: string
+                          name:
+                            description: Name of the ConfigMap
+                            type: string
+                        required:
+                        - name
+                        type: object
                       sidecarImage:
                         description: |-
                           SidecarImage references a container image that runs in the virt-launcher pod.
@@ -1368,6 +2008,21 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
                 defaultNetworkInterface:
                   type: string
+                gateway:
+                  description: |-
+                    Gateway is the Gateway API Gateway the routes of the VMs exposing guest ports through
+                    annotations are bound to. Requires the VMGatewayRoutes feature gate.
+                  properties:
+                    name:
+                      description: Name of the Gateway
+                      type: string
+                    namespace:
+                      description: Namespace of the Gateway
+                      type: string
+                  required:
+                  - name
+                  - namespace
+                  type: object
                 permitBridgeInterfaceOnPodNetwork:
                   type: boolean
                 permitSlirpInterface:
@@ -1375,6 +2030,14 @@ var CRDsValidation map[string]string = map[string]string{
                     DeprecatedPermitSlirpInterface is an alias for the deprecated PermitSlirpInterface.
                     Deprecated: Removed in v1.3.
                   type: boolean
+                preferredIPFamily:
+                  description: |-
+                    PreferredIPFamily is the IP family of the migration and export endpoints on dual-stack clusters.
+                    Defaults to the primary IP family of the cluster.
+                  enum:
+                  - IPv4
+                  - IPv6
+                  type: string
               type: object
             obsoleteCPUModels:
               additionalProperties:
@@ -1495,6 +2158,80 @@ var CRDsValidation map[string]string = map[string]string{
                 version:
                   type: string
               type: object
+            storageHealthCheck:
+              description: |-
+                StorageHealthCheck configures the periodic probing of the storage backing the volumes of running VMIs.
+                It takes effect only when the StorageHealthCheck feature gate is enabled.
+              nullable: true
+              properties:
+                degradedPolicy:
+                  description: |-
+                    DegradedPolicy defines the action taken on a VMI once one of its volumes is degraded.
+                    Defaults to None.
+                  enum:
+                  - None
+                  - Pause
+                  - LiveMigrate
+                  type: string
+                failureThreshold:
+                  description: |-
+                    FailureThreshold is the number of consecutive failed probes after which a volume is reported as degraded.
+                    Defaults to 3.
+                  format: int32
+                  type: integer
+                interval:
+                  description: |-
+                    Interval between two probes of the same volume.
+                    Defaults to 30s.
+                  type: string
+                latencyThreshold:
+                  description: |-
+                    LatencyThreshold is the probe latency above which a volume is considered slow.
+                    Slow probes are accounted as failures.
+                    Defaults to 2s.
+                  type: string
+                timeout:
+                  description: |-
+                    Timeout after which a pending probe is considered failed.
+                    Defaults to 10s.
+                  type: string
+              type: object
+            subresourceAccessWebhook:
+              description: |-
+                SubresourceAccessWebhook configures a webhook authorizing the interactive accesses to VMIs, e.g. to
+                their console, in addition to RBAC.
+              nullable: true
+              properties:
+                caBundle:
+                  description: |-
+                    CABundle is a PEM encoded CA bundle the certificate of the webhook is verified with.
+                    The system trust roots are used if unset.
+                  format: byte
+                  type: string
+                failurePolicy:
+                  description: |-
+                    FailurePolicy sets whether the access is denied, Fail, or allowed, Ignore, when the webhook
+                    can't be reached or fails. Fail by default.
+                  type: string
+                subresources:
+                  description: |-
+                    Subresources the webhook is consulted for, among console, vnc, usbredir, portforward and vsock.
+                    All of them by default.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+                timeoutSeconds:
+                  description: TimeoutSeconds is the time given to the webhook to
+                    answer, 10 seconds by default.
+                  format: int32
+                  type: integer
+                url:
+                  description: URL of the webhook, it has to use https.
+                  type: string
+              required:
+              - url
+              type: object
             supportContainerResources:
               description: SupportContainerResources specifies the resource requirements
                 for various types of supporting containers such as container disks/virtiofs/sidecars
@@ -1551,6 +2288,123 @@ var CRDsValidation map[string]string = map[string]string{
               items:
                 type: string
               type: array
+            tenantNodePools:
+              description: |-
+                TenantNodePools dedicates node pools to the VMIs of the namespaces they select.
+                It takes effect only when the TenantNodePools feature gate is enabled.
+              items:
+                description: TenantNodePool restricts the VMIs of a set of namespaces
+                  to a pool of nodes.
+                properties:
+                  name:
+                    description: Name of the node pool.
+                    type: string
+                  namespaceSelector:
+                    description: |-
+                      NamespaceSelector selects the namespaces of the tenant.
+                      Namespaces can be selected by name with the kubernetes.io/metadata.name label.
+                      If several pools select a namespace, the first one applies.
+                    properties:
+                      matchExpressions:
+                        description: matchExpressions is a list of label selector
+                          requirements. The requirements are ANDed.
+                        items:
+                          description: |-
+                            A label selector requirement is a selector that contains values, a key, and an operator that
+                            relates the key and values.
+                          properties:
+                            key:
+                              description: key is the label key that the selector
+                                applies to.
+                              type: string
+                            operator:
+                              description: |-
+                                operator represents a key's relationship to a set of values.
+                                Valid operators are In, NotIn, Exists and DoesNotExist.
+                              type: string
+                            values:
+                              description: |-
+                                values is an array of string values. If the operator is In or NotIn,
+                                the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                the values array must be empty. This array is replaced during a strategic
+                                merge patch.
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
+                          required:
+                          - key
+                          - operator
+                          type: object
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      matchLabels:
+                        additionalProperties:
+                          type: string
+                        description: |-
+                          matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                          map is equivalent to an element of matchExpressions, whose key field is "key", the
+                          operator is "In", and the values array contains only "value". The requirements are ANDed.
+                        type: object
+                    type: object
+                    x-kubernetes-map-type: atomic
+                  nodeSelector:
+                    additionalProperties:
+                      type: string
+                    description: |-
+                      NodeSelector is enforced on the launcher pods of the VMIs, including migration targets.
+                      It takes precedence over the node selector of the VMIs.
+                    type: object
+                  tolerations:
+                    description: |-
+                      Tolerations are added to the launcher pods of the VMIs, e.g. to tolerate the taints
+                      reserving the pool to the tenant.
+                    items:
+                      description: |-
+                        The pod this Toleration is attached to tolerates any taint that matches
+                        the triple <key,value,effect> using the matching operator <operator>.
+                      properties:
+                        effect:
+                          description: |-
+                            Effect indicates the taint effect to match. Empty means match all taint effects.
+                            When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                          type: string
+                        key:
+                          description: |-
+                            Key is the taint key that the toleration applies to. Empty means match all taint keys.
+                            If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                          type: string
+                        operator:
+                          description: |-
+                            Operator represents a key's relationship to the value.
+                            Valid operators are Exists and Equal. Defaults to Equal.
+                            Exists is equivalent to wildcard for value, so that a pod can
+                            tolerate all taints of a particular category.
+                          type: string
+                        tolerationSeconds:
+                          description: |-
+                            TolerationSeconds represents the period of time the toleration (which must be
+                            of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default,
+                            it is not set, which means tolerate the taint forever (do not evict). Zero and
+                            negative values will be treated as 0 (evict immediately) by the system.
+                          format: int64
+                          type: integer
+                        value:
+                          description: |-
+                            Value is the taint value the toleration matches to.
+                            If the operator is Exists, the value should be empty, otherwise just a regular string.
+                          type: string
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - name
+                - namespaceSelector
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
             tlsConfiguration:
               description: TLSConfiguration holds TLS options
               properties:
@@ -1559,6 +2413,11 @@ var CRDsValidation map[string]string = map[string]string{
                     type: string
                   type: array
                   x-kubernetes-list-type: set
+                fipsMode:
+                  description: |-
+                    FIPSMode restricts the TLS endpoints of the KubeVirt components to the FIPS approved
+                    protocol versions, cipher suites and curves. Only TLS 1.2 is negotiated in this mode.
+                  type: boolean
                 minTLSVersion:
                   description: |-
                     MinTLSVersion is a way to specify the minimum protocol version that is acceptable for TLS connections.
@@ -1595,6 +2454,28 @@ var CRDsValidation map[string]string = map[string]string{
                     The value can be individually overridden for each VM, not relevant if AutoattachSerialConsole is disabled.
                   type: object
               type: object
+            virtualizationInfraReservation:
+              description: |-
+                VirtualizationInfraReservation reserves CPUs and memory of each node for the virtualization
+                infrastructure of the VMIs: their emulator threads, iothreads and vhost kernel threads.
+                It takes effect only when the VirtualizationInfraReservation feature gate is enabled.
+              nullable: true
+              properties:
+                cpus:
+                  description: |-
+                    CPUs is the list of host CPUs, e.g. 0-1,8, the emulator threads, iothreads and vhost kernel threads
+                    of the VMIs without dedicated CPUs are confined to. The vCPUs of these VMIs don't run on them.
+                  type: string
+                memory:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    Memory is kept available on each node for the virtualization infrastructure.
+                    No new VMI is scheduled on a node whose available memory falls below it.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+              type: object
             vmRolloutStrategy:
               description: VMRolloutStrategy defines how changes to a VM object propagate
                 to its VMI
@@ -3790,6 +4671,43 @@ var CRDsValidation map[string]string = map[string]string{
       description: KubeVirtStatus represents information pertaining to a KubeVirt
         deployment.
       properties:
+        certificates:
+          description: Certificates reports the state of the certificates of the KubeVirt
+            components
+          items:
+            description: KubeVirtCertificateStatus reports the state of a certificate
+              of the KubeVirt components
+            properties:
+              issuer:
+                description: Issuer of the certificate
+                type: string
+              message:
+                description: Message explains why the certificate is not ready
+                type: string
+              notAfter:
+                description: NotAfter is the expiration of the certificate
+                format: date-time
+                nullable: true
+                type: string
+              notBefore:
+                description: NotBefore is the start of the validity of the certificate.
+                  It changes on every rotation.
+                format: date-time
+                nullable: true
+                type: string
+              ready:
+                description: Ready is true when the secret holds a valid certificate
+                type: boolean
+              secretName:
+                description: SecretName is the name of the secret holding the certificate
+                type: string
+            required:
+            - issuer
+            - ready
+            - secretName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         conditions:
           items:
             description: KubeVirtCondition represents a condition of a KubeVirt deployment
@@ -3817,6 +4735,34 @@ var CRDsValidation map[string]string = map[string]string{
           type: array
         defaultArchitecture:
           type: string
+        fipsPosture:
+          description: |-
+            FIPSPosture reports for each KubeVirt component whether it runs with FIPS validated
+            cryptography and only negotiates FIPS approved ciphers.
+          items:
+            description: ComponentFIPSPosture reports the FIPS posture of a KubeVirt
+              component
+            properties:
+              approvedCiphersOnly:
+                description: |-
+                  ApprovedCiphersOnly is true when the TLS endpoints of the component only negotiate
+                  FIPS approved protocol versions and cipher suites
+                type: boolean
+              component:
+                description: Component is the name of the KubeVirt component, e.g.
+                  virt-api
+                type: string
+              validatedCrypto:
+                description: ValidatedCrypto is true when the component is built against
+                  a FIPS validated cryptographic module
+                type: boolean
+            required:
+            - approvedCiphersOnly
+            - component
+            - validatedCrypto
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         generations:
           items:
             description: GenerationStatus keeps track of the generation for a given
@@ -3882,6 +4828,68 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"lifecyclenotification": `openAPIV3Schema:
+  description: LifecycleNotification registers an HTTPS endpoint which is notified
+    about the lifecycle events of the VMIs of its namespace
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        caBundle:
+          description: |-
+            CABundle is a PEM encoded CA bundle used to verify the certificate of the endpoint.
+            The system trust roots are used if empty.
+          format: byte
+          type: string
+        events:
+          description: |-
+            Events lists the lifecycle events which are posted to the endpoint.
+            All the events are posted if empty.
+          items:
+            description: LifecycleEvent is a lifecycle event of a VMI
+            enum:
+            - Started
+            - Stopped
+            - Migrated
+            - Crashed
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+        maxRetries:
+          description: |-
+            MaxRetries is the number of times a failed delivery is retried with an exponential backoff.
+            Defaults to 5.
+          format: int32
+          maximum: 20
+          minimum: 0
+          type: integer
+        url:
+          description: URL is the HTTPS endpoint the notifications are posted to
+          pattern: ^https://
+          type: string
+      required:
+      - url
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"migrationpolicy": `openAPIV3Schema:
   description: MigrationPolicy holds migration policy (i.e. configurations) to apply
@@ -4667,6 +5675,30 @@ var CRDsValidation map[string]string = map[string]string{
                 captured the first time the instancetype is applied to the VirtualMachineInstance.
               type: string
           type: object
+        lease:
+          description: |-
+            Lease limits the lifetime of the VirtualMachine, which is stopped or deleted once the lease expired.
+            The lease is extended with the renewlease subresource. It requires the VMLease feature gate.
+          properties:
+            action:
+              description: Action is what happens to the VirtualMachine once the lease
+                expired, Stop by default
+              enum:
+              - Stop
+              - Delete
+              type: string
+            expiresAt:
+              description: ExpiresAt is the time the lease expires at
+              format: date-time
+              type: string
+            warningPeriod:
+              description: |-
+                WarningPeriod is how long before the expiry of the lease the VirtualMachine gets the LeaseExpiring
+                condition and a warning event. One hour by default.
+              type: string
+          required:
+          - expiresAt
+          type: object
         preference:
           description: PreferenceMatcher references a set of preference that is used
             to fill fields in Template
@@ -4710,6 +5742,37 @@ var CRDsValidation map[string]string = map[string]string{
             Mutually exclusive with RunStrategy
             Deprecated: VirtualMachineInstance field "Running" is now deprecated, please use RunStrategy instead.
           type: boolean
+        startDependencies:
+          description: |-
+            StartDependencies lists resources which have to be available before the VirtualMachineInstance is created.
+            Whenever the run strategy requires the VirtualMachine to start, the start is delayed until all dependencies are ready.
+          items:
+            description: StartDependency references a resource in the namespace of
+              the VirtualMachine
+            properties:
+              kind:
+                description: Kind of the resource
+                enum:
+                - PersistentVolumeClaim
+                - NetworkAttachmentDefinition
+                - Service
+                type: string
+              name:
+                description: Name of the resource, NetworkAttachmentDefinitions from
+                  another namespace can be referenced as <namespace>/<name>
+                type: string
+              timeout:
+                description: |-
+                  Timeout after which waiting for the dependency is reported as failed.
+                  The VirtualMachine keeps waiting and starts as soon as the dependency is ready.
+                  Defaults to no timeout.
+                type: string
+            required:
+            - kind
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         template:
           description: Template is the direct specification of VirtualMachineInstance
           properties:
@@ -6054,6 +7117,29 @@ var CRDsValidation map[string]string = map[string]string{
                             Whether or not to enable virtio multi-queue for block devices.
                             Defaults to false.
                           type: boolean
+                        channels:
+                          description: |-
+                            Channels describes additional virtio-serial channels exposed to the guest.
+                            Channels can be added to a running vmi through the addchannel subresource.
+                          items:
+                            description: Channel represents a virtio-serial port,
+                              backed by a unix socket in the virt-launcher pod
+                            properties:
+                              name:
+                                description: Name of the channel, also used to name
+                                  its socket in the virt-launcher pod
+                                type: string
+                              target:
+                                description: Target is the name of the port as seen
+                                  by the guest, e.g. org.example.agent.0
+                                type: string
+                            required:
+                            - name
+                            - target
+                            type: object
+                          maxItems: 16
+                          type: array
+                          x-kubernetes-list-type: atomic
                         clientPassthrough:
                           description: To configure and access client devices such
                             as redirecting USB
@@ -6341,6 +7427,53 @@ var CRDsValidation map[string]string = map[string]string{
                               bridge:
                                 description: InterfaceBridge connects to a given network
                                   via a linux bridge.
+                                properties:
+                                  macLearning:
+                                    description: |-
+                                      MACLearning sets whether the bridge learns the MAC addresses the guest sends from, so that
+                                      the frames destined to them are forwarded to the guest only. Defaults to true.
+                                    type: boolean
+                                  tap:
+                                    description: Tap tunes the tap device connecting
+                                      the guest to the bridge.
+                                    properties:
+                                      disableOffloads:
+                                        description: |-
+                                          DisableOffloads disables every offload of the interface, the checksum, segmentation and ECN
+                                          offloads of the host and the guest, overriding Offload.
+                                          Only supported with the virtio model. Defaults to false.
+                                        type: boolean
+                                      offload:
+                                        description: |-
+                                          Offload sets the segmentation offloads of the interface.
+                                          Only supported with the virtio model.
+                                        properties:
+                                          gso:
+                                            description: GSO sets the generic segmentation
+                                              offload of the host.
+                                            type: boolean
+                                          tso:
+                                            description: TSO sets the TCP segmentation
+                                              offload, over IPv4 and IPv6, of the
+                                              host and the guest.
+                                            type: boolean
+                                          ufo:
+                                            description: UFO sets the UDP fragmentation
+                                              offload of the host and the guest.
+                                            type: boolean
+                                        type: object
+                                      txQueueLength:
+                                        description: |-
+                                          TxQueueLength is the length of the transmit queue of the tap device, in packets.
+                                          Defaults to the kernel default, 1000.
+                                        format: int32
+                                        type: integer
+                                    type: object
+                                  unicastFlood:
+                                    description: |-
+                                      UnicastFlood sets whether the bridge forwards to the guest the frames destined to the MAC
+                                      addresses it did not learn. It can't be disabled along with MACLearning. Defaults to true.
+                                    type: boolean
                                 type: object
                               dhcpOptions:
                                 description: If specified the network interface will
@@ -6480,6 +7613,20 @@ var CRDsValidation map[string]string = map[string]string{
                             Not relevant if autoattachSerialConsole is disabled.
                             Defaults to cluster wide setting on VirtualMachineOptions.
                           type: boolean
+                        maintenanceNotifications:
+                          description: |-
+                            MaintenanceNotifications creates a virtio serial for notifying the guest of the migrations
+                            and the host maintenance ahead of them.
+                          properties:
+                            leadTimeSeconds:
+                              description: |-
+                                LeadTimeSeconds is how long a migration waits for the guest to acknowledge its
+                                notification before it starts. Defaults to 30.
+                              format: int32
+                              maximum: 600
+                              minimum: 1
+                              type: integer
+                          type: object
                         networkInterfaceMultiqueue:
                           description: If specified, virtual network interfaces configured
                             with a virtio bus will also enable the vhost multiqueue
@@ -6959,6 +8106,46 @@ var CRDsValidation map[string]string = map[string]string{
                       description: Resources describes the Compute Resources required
                         by this vmi.
                       properties:
+                        cgroupWeights:
+                          description: |-
+                            CgroupWeights sets the cgroup v2 weights of the virt-launcher pod, to prioritize the VMI against the other
+                            VMIs of its node beyond its requests and limits. It requires the CgroupWeights feature gate.
+                          properties:
+                            cpu:
+                              description: CPU is the cpu.weight of the pod, which
+                                otherwise derives from its CPU request.
+                              format: int32
+                              type: integer
+                            io:
+                              description: IO is the default io.weight of the pod,
+                                on all the devices.
+                              format: int32
+                              type: integer
+                            volumeIO:
+                              description: VolumeIO overrides the io.weight of the
+                                pod on the devices backing the given volumes.
+                              items:
+                                description: VolumeIOWeight is the io.weight of a
+                                  pod on the device backing one of its volumes.
+                                properties:
+                                  volume:
+                                    description: Volume is the name of a persistentVolumeClaim
+                                      or dataVolume volume of the VMI.
+                                    type: string
+                                  weight:
+                                    description: Weight is the io.weight of the pod
+                                      on the device backing the volume.
+                                    format: int32
+                                    type: integer
+                                required:
+                                - volume
+                                - weight
+                                type: object
+                              type: array
+                              x-kubernetes-list-map-keys:
+                              - volume
+                              x-kubernetes-list-type: map
+                          type: object
                         limits:
                           additionalProperties:
                             anyOf:
@@ -7005,6 +8192,18 @@ var CRDsValidation map[string]string = map[string]string{
                     Specifies the hostname of the vmi
                     If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                   type: string
+                identityDocument:
+                  description: |-
+                    IdentityDocument makes KubeVirt issue a signed identity document for the VMI, which the
+                    workloads in the guest can present to external services to authenticate as the VMI.
+                    It requires the VMIdentityDocuments feature gate.
+                  properties:
+                    guestAgentPath:
+                      description: |-
+                        GuestAgentPath is the path of the file in the guest the guest agent keeps the rotated identity
+                        document in, readable by root only. Without it, the guest only gets the document it booted with.
+                      type: string
+                  type: object
                 livenessProbe:
                   description: |-
                     Periodic probe of VirtualMachineInstance liveness.
@@ -7343,6 +8542,47 @@ var CRDsValidation map[string]string = map[string]string{
                     If specified, the VMI will be dispatched by specified scheduler.
                     If not specified, the VMI will be dispatched by default scheduler.
                   type: string
+                shutdownPolicy:
+                  description: |-
+                    ShutdownPolicy orchestrates the graceful shutdown of the guest within the termination grace period: a
+                    pre-shutdown command run by the guest agent, then the shutdown signal, each phase with its own timeout.
+                    It requires the ShutdownPolicy feature gate.
+                  properties:
+                    preShutdown:
+                      description: |-
+                        PreShutdown is a command run in the guest by the guest agent before the guest is signaled to shut
+                        down, e.g. to stop a database cleanly. The guest is signaled once the command exits, fails or
+                        times out.
+                      properties:
+                        command:
+                          description: Command is the path of the command in the guest,
+                            followed by its arguments
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        timeoutSeconds:
+                          description: TimeoutSeconds is the time given to the command
+                            to exit, 60 seconds by default
+                          format: int32
+                          type: integer
+                      required:
+                      - command
+                      type: object
+                    signalTimeoutSeconds:
+                      description: |-
+                        SignalTimeoutSeconds is the time given to the guest to shut down once signaled, before it is
+                        forced off. The remainder of the termination grace period by default.
+                      format: int64
+                      type: integer
+                    windowsUpdates:
+                      description: |-
+                        WindowsUpdates is how the shutdown of a Windows guest with pending updates is handled, the guest
+                        agent is used to check for them. Wait doesn't force the guest off before the termination grace
+                        period expires while they are pending, Reschedule also refuses the evictions of the VMI for the
+                        drains to retry them later, Force ignores them. Force by default.
+                      type: string
+                  type: object
                 startStrategy:
                   description: StartStrategy can be set to "Paused" if Virtual Machine
                     should be started in paused state.
@@ -9669,6 +10909,191 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinediskcheck": `openAPIV3Schema:
+  description: |-
+    VirtualMachineDiskCheck verifies the integrity of the disk images of a stopped VirtualMachine,
+    or of a VirtualMachineSnapshot, with qemu-img in a pod, and reports the findings in its conditions.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        source:
+          description: |-
+            Source is the object whose disks are checked. Currently supported source types are:
+            VirtualMachine of kubevirt.io API group, checked once it is stopped,
+            VirtualMachineSnapshot of snapshot.kubevirt.io API group
+          properties:
+            apiGroup:
+              description: |-
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+          x-kubernetes-map-type: atomic
+        sparsify:
+          description: |-
+            Sparsify rewrites the qcow2 images found consistent by the check, to reclaim the space of their
+            unused and zeroed clusters. Only supported with a VirtualMachine source, checked once it is
+            stopped with the Halted run strategy
+          properties:
+            compress:
+              description: |-
+                Compress compresses the clusters of the rewritten images, trading the read performance
+                of the guest for space
+              type: boolean
+          type: object
+        volumes:
+          description: |-
+            Volumes are the names of the volumes of the source to check.
+            All the volumes backed by a PVC or a DataVolume are checked if empty.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+      required:
+      - source
+      type: object
+    status:
+      properties:
+        completionTime:
+          description: CompletionTime is the time the check completed or failed
+          format: date-time
+          nullable: true
+          type: string
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        phase:
+          type: string
+        startTime:
+          description: StartTime is the time the volumes started being checked
+          format: date-time
+          nullable: true
+          type: string
+        volumes:
+          description: Volumes are the findings of the check of each volume
+          items:
+            description: VolumeCheckResult is the finding of the check of a volume
+            properties:
+              claimName:
+                description: ClaimName is the name of the checked PVC
+                type: string
+              corruptions:
+                description: Corruptions is the number of corrupted clusters of a
+                  qcow2 image
+                format: int64
+                type: integer
+              format:
+                description: Format is the format of the disk image, as detected
+                  by qemu-img
+                type: string
+              leaks:
+                description: |-
+                  Leaks is the number of leaked clusters of a qcow2 image, they waste space but don't
+                  corrupt the image
+                format: int64
+                type: integer
+              message:
+                type: string
+              name:
+                description: Name is the name of the volume in the source
+                type: string
+              result:
+                description: Result is the outcome of the check of the volume
+                enum:
+                - Clean
+                - Leaked
+                - Corrupted
+                - Error
+                type: string
+              sparsify:
+                description: Sparsify is the outcome of the rewrite of the image,
+                  when requested
+                properties:
+                  message:
+                    type: string
+                  result:
+                    description: Result is the outcome of the rewrite of the image
+                    enum:
+                    - Sparsified
+                    - Skipped
+                    - Error
+                    type: string
+                  sizeAfter:
+                    description: SizeAfter is the space allocated to the image after
+                      its rewrite, in bytes
+                    format: int64
+                    type: integer
+                  sizeBefore:
+                    description: SizeBefore is the space allocated to the image before
+                      its rewrite, in bytes
+                    format: int64
+                    type: integer
+                required:
+                - result
+                type: object
+            required:
+            - claimName
+            - name
+            - result
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachineexport": `openAPIV3Schema:
   description: VirtualMachineExport defines the operation of exporting a VM source
@@ -11272,6 +12697,29 @@ var CRDsValidation map[string]string = map[string]string{
                     Whether or not to enable virtio multi-queue for block devices.
                     Defaults to false.
                   type: boolean
+                channels:
+                  description: |-
+                    Channels describes additional virtio-serial channels exposed to the guest.
+                    Channels can be added to a running vmi through the addchannel subresource.
+                  items:
+                    description: Channel represents a virtio-serial port, backed by
+                      a unix socket in the virt-launcher pod
+                    properties:
+                      name:
+                        description: Name of the channel, also used to name its socket
+                          in the virt-launcher pod
+                        type: string
+                      target:
+                        description: Target is the name of the port as seen by the
+                          guest, e.g. org.example.agent.0
+                        type: string
+                    required:
+                    - name
+                    - target
+                    type: object
+                  maxItems: 16
+                  type: array
+                  x-kubernetes-list-type: atomic
                 clientPassthrough:
                   description: To configure and access client devices such as redirecting
                     USB
@@ -11554,6 +13002,52 @@ var CRDsValidation map[string]string = map[string]string{
                       bridge:
                         description: InterfaceBridge connects to a given network via
                           a linux bridge.
+                        properties:
+                          macLearning:
+                            description: |-
+                              MACLearning sets whether the bridge learns the MAC addresses the guest sends from, so that
+                              the frames destined to them are forwarded to the guest only. Defaults to true.
+                            type: boolean
+                          tap:
+                            description: Tap tunes the tap device connecting the guest
+                              to the bridge.
+                            properties:
+                              disableOffloads:
+                                description: |-
+                                  DisableOffloads disables every offload of the interface, the checksum, segmentation and ECN
+                                  offloads of the host and the guest, overriding Offload.
+                                  Only supported with the virtio model. Defaults to false.
+                                type: boolean
+                              offload:
+                                description: |-
+                                  Offload sets the segmentation offloads of the interface.
+                                  Only supported with the virtio model.
+                                properties:
+                                  gso:
+                                    description: GSO sets the generic segmentation
+                                      offload of the host.
+                                    type: boolean
+                                  tso:
+                                    description: TSO sets the TCP segmentation offload,
+                                      over IPv4 and IPv6, of the host and the guest.
+                                    type: boolean
+                                  ufo:
+                                    description: UFO sets the UDP fragmentation offload
+                                      of the host and the guest.
+                                    type: boolean
+                                type: object
+                              txQueueLength:
+                                description: |-
+                                  TxQueueLength is the length of the transmit queue of the tap device, in packets.
+                                  Defaults to the kernel default, 1000.
+                                format: int32
+                                type: integer
+                            type: object
+                          unicastFlood:
+                            description: |-
+                              UnicastFlood sets whether the bridge forwards to the guest the frames destined to the MAC
+                              addresses it did not learn. It can't be disabled along with MACLearning. Defaults to true.
+                            type: boolean
                         type: object
                       dhcpOptions:
                         description: If specified the network interface will pass
@@ -11692,6 +13186,20 @@ var CRDsValidation map[string]string = map[string]string{
                     Not relevant if autoattachSerialConsole is disabled.
                     Defaults to cluster wide setting on VirtualMachineOptions.
                   type: boolean
+                maintenanceNotifications:
+                  description: |-
+                    MaintenanceNotifications creates a virtio serial for notifying the guest of the migrations
+                    and the host maintenance ahead of them.
+                  properties:
+                    leadTimeSeconds:
+                      description: |-
+                        LeadTimeSeconds is how long a migration waits for the guest to acknowledge its
+                        notification before it starts. Defaults to 30.
+                      format: int32
+                      maximum: 600
+                      minimum: 1
+                      type: integer
+                  type: object
                 networkInterfaceMultiqueue:
                   description: If specified, virtual network interfaces configured
                     with a virtio bus will also enable the vhost multiqueue feature
@@ -12163,6 +13671,46 @@ var CRDsValidation map[string]string = map[string]string{
               description: Resources describes the Compute Resources required by this
                 vmi.
               properties:
+                cgroupWeights:
+                  description: |-
+                    CgroupWeights sets the cgroup v2 weights of the virt-launcher pod, to prioritize the VMI against the other
+                    VMIs of its node beyond its requests and limits. It requires the CgroupWeights feature gate.
+                  properties:
+                    cpu:
+                      description: CPU is the cpu.weight of the pod, which otherwise
+                        derives from its CPU request.
+                      format: int32
+                      type: integer
+                    io:
+                      description: IO is the default io.weight of the pod, on all
+                        the devices.
+                      format: int32
+                      type: integer
+                    volumeIO:
+                      description: VolumeIO overrides the io.weight of the pod on
+                        the devices backing the given volumes.
+                      items:
+                        description: VolumeIOWeight is the io.weight of a pod on the
+                          device backing one of its volumes.
+                        properties:
+                          volume:
+                            description: Volume is the name of a persistentVolumeClaim
+                              or dataVolume volume of the VMI.
+                            type: string
+                          weight:
+                            description: Weight is the io.weight of the pod on the
+                              device backing the volume.
+                            format: int32
+                            type: integer
+                        required:
+                        - volume
+                        - weight
+                        type: object
+                      type: array
+                      x-kubernetes-list-map-keys:
+                      - volume
+                      x-kubernetes-list-type: map
+                  type: object
                 limits:
                   additionalProperties:
                     anyOf:
@@ -12209,6 +13757,18 @@ var CRDsValidation map[string]string = map[string]string{
             Specifies the hostname of the vmi
             If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
           type: string
+        identityDocument:
+          description: |-
+            IdentityDocument makes KubeVirt issue a signed identity document for the VMI, which the
+            workloads in the guest can present to external services to authenticate as the VMI.
+            It requires the VMIdentityDocuments feature gate.
+          properties:
+            guestAgentPath:
+              description: |-
+                GuestAgentPath is the path of the file in the guest the guest agent keeps the rotated identity
+                document in, readable by root only. Without it, the guest only gets the document it booted with.
+              type: string
+          type: object
         livenessProbe:
           description: |-
             Periodic probe of VirtualMachineInstance liveness.
@@ -12546,6 +14106,47 @@ var CRDsValidation map[string]string = map[string]string{
             If specified, the VMI will be dispatched by specified scheduler.
             If not specified, the VMI will be dispatched by default scheduler.
           type: string
+        shutdownPolicy:
+          description: |-
+            ShutdownPolicy orchestrates the graceful shutdown of the guest within the termination grace period: a
+            pre-shutdown command run by the guest agent, then the shutdown signal, each phase with its own timeout.
+            It requires the ShutdownPolicy feature gate.
+          properties:
+            preShutdown:
+              description: |-
+                PreShutdown is a command run in the guest by the guest agent before the guest is signaled to shut
+                down, e.g. to stop a database cleanly. The guest is signaled once the command exits, fails or
+                times out.
+              properties:
+                command:
+                  description: Command is the path of the command in the guest, followed
+                    by its arguments
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+                timeoutSeconds:
+                  description: TimeoutSeconds is the time given to the command to
+                    exit, 60 seconds by default
+                  format: int32
+                  type: integer
+              required:
+              - command
+              type: object
+            signalTimeoutSeconds:
+              description: |-
+                SignalTimeoutSeconds is the time given to the guest to shut down once signaled, before it is
+                forced off. The remainder of the termination grace period by default.
+              format: int64
+              type: integer
+            windowsUpdates:
+              description: |-
+                WindowsUpdates is how the shutdown of a Windows guest with pending updates is handled, the guest
+                agent is used to check for them. Wait doesn't force the guest off before the termination grace
+                period expires while they are pending, Reschedule also refuses the evictions of the VMI for the
+                drains to retry them later, Force ignores them. Force by default.
+              type: string
+          type: object
         startStrategy:
           description: StartStrategy can be set to "Paused" if Virtual Machine should
             be started in paused state.
@@ -13250,6 +14851,32 @@ var CRDsValidation map[string]string = map[string]string{
             ActivePods is a mapping of pod UID to node name.
             It is possible for multiple pods to be running for a single VMI during migration.
           type: object
+        channelStatus:
+          description: ChannelStatus contains the statuses of the channels defined
+            in the spec
+          items:
+            description: ChannelStatus represents the status of a channel
+            properties:
+              guestConnected:
+                description: GuestConnected reports whether a process in the guest
+                  has the port open
+                type: boolean
+              name:
+                description: Name of the channel
+                type: string
+              phase:
+                description: Phase is either Pending, until the channel is plugged
+                  into the domain, or Attached
+                type: string
+              target:
+                description: Target is the name of the port as seen by the guest
+                type: string
+            required:
+            - name
+            - target
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         conditions:
           description: Conditions are specific points in VirtualMachineInstance's
             pod runtime.
@@ -13276,6 +14903,44 @@ var CRDsValidation map[string]string = map[string]string{
             - type
             type: object
           type: array
+        cpuResources:
+          description: |-
+            CPUResources shows the CPU request and limit of the VirtualMachineInstance, as desired in its spec
+            and as applied to its virt-launcher pod. It requires the LiveCPUResources feature gate.
+          properties:
+            appliedLimit:
+              anyOf:
+              - type: integer
+              - type: string
+              description: AppliedLimit is the CPU limit the cgroups of the virt-launcher
+                pod were set to.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            appliedRequest:
+              anyOf:
+              - type: integer
+              - type: string
+              description: AppliedRequest is the CPU request the cgroups of the virt-launcher
+                pod were set to.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            desiredLimit:
+              anyOf:
+              - type: integer
+              - type: string
+              description: DesiredLimit is the CPU limit of the VirtualMachineInstance
+                spec.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            desiredRequest:
+              anyOf:
+              - type: integer
+              - type: string
+              description: DesiredRequest is the CPU request of the VirtualMachineInstance
+                spec.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+          type: object
         currentCPUTopology:
           description: |-
             CurrentCPUTopology specifies the current CPU topology used by the VM workload.
@@ -13311,6 +14976,21 @@ var CRDsValidation map[string]string = map[string]string{
             FSFreezeStatus is the state of the fs of the guest
             it can be either frozen or thawed
           type: string
+        guestDNS:
+          description: DNS configuration of the guest, as reported by the guest agent
+          properties:
+            nameservers:
+              description: Nameservers are the addresses of the name servers the guest
+                resolves names with
+              items:
+                type: string
+              type: array
+            searches:
+              description: Searches are the search domains of the guest
+              items:
+                type: string
+              type: array
+          type: object
         guestOSInfo:
           description: Guest OS Information
           properties:
@@ -13343,6 +15023,21 @@ var CRDsValidation map[string]string = map[string]string{
           description: Interfaces represent the details of available network interfaces.
           items:
             properties:
+              defaultRoutes:
+                description: Default routes of the guest through the interface, as
+                  reported by the guest agent
+                items:
+                  properties:
+                    gateway:
+                      description: Gateway is the address of the next hop of the route,
+                        empty when the route has no gateway
+                      type: string
+                    metric:
+                      description: Metric is the metric of the route in the guest
+                      format: int32
+                      type: integer
+                  type: object
+                type: array
               infoSource:
                 description: 'Specifies the origin of the interface data collected.
                   values: domain, guest-agent, multus-status.'
@@ -13360,6 +15055,13 @@ var CRDsValidation map[string]string = map[string]string{
                 items:
                   type: string
                 type: array
+              ipAddressesWithPrefix:
+                description: |-
+                  List of all IP addresses of the interface with their prefix length, e.g. 10.0.2.2/24,
+                  as reported by the guest agent
+                items:
+                  type: string
+                type: array
               mac:
                 description: Hardware address of a Virtual Machine interface
                 type: string
@@ -13678,6 +15380,10 @@ var CRDsValidation map[string]string = map[string]string{
               description: Lets us know if the vmi is currently running pre or post
                 copy migration
               type: string
+            observedDowntime:
+              description: The downtime of the guest at the switchover of the migration,
+                measured by QEMU
+              type: string
             sourceNode:
               description: The source node that the VMI originated on
               type: string
@@ -13709,6 +15415,35 @@ var CRDsValidation map[string]string = map[string]string{
               description: The list of ports opened for live migration on the destination
                 node
               type: object
+            targetNetworkAnnouncements:
+              description: |-
+                The network announcements sent by the target node for the bridge bound interfaces,
+                once the migrated domain is running
+              items:
+                description: |-
+                  MigrationNetworkAnnouncement reports the announcements of an interface to the network
+                  after a migration, for the switches to learn its new location.
+                properties:
+                  message:
+                    description: The reason the announcements failed
+                    type: string
+                  name:
+                    description: Name of the interface
+                    type: string
+                  rounds:
+                    description: The number of announcement rounds sent
+                    format: int32
+                    type: integer
+                  succeeded:
+                    description: Indicates that all the announcement rounds were sent
+                    type: boolean
+                required:
+                - name
+                - rounds
+                - succeeded
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
             targetNode:
               description: The target node that the VMI is moving to
               type: string
@@ -13787,6 +15522,35 @@ var CRDsValidation map[string]string = map[string]string{
           description: SELinuxContext is the actual SELinux context of the virt-launcher
             pod
           type: string
+        shutdownStatus:
+          description: ShutdownStatus reports the progress of the graceful shutdown
+            of a VMI with a shutdown policy
+          properties:
+            phase:
+              description: Phase is the current phase of the shutdown
+              type: string
+            preShutdownEndTimestamp:
+              description: PreShutdownEndTimestamp is the time the pre-shutdown command
+                exited, failed or timed out
+              format: date-time
+              type: string
+            preShutdownFailureReason:
+              description: PreShutdownFailureReason is the reason the pre-shutdown
+                command failed or timed out
+              type: string
+            preShutdownStartTimestamp:
+              description: PreShutdownStartTimestamp is the time the pre-shutdown
+                command started
+              format: date-time
+              type: string
+            signalTimestamp:
+              description: SignalTimestamp is the time the guest was first signaled
+                to shut down
+              format: date-time
+              type: string
+          required:
+          - phase
+          type: object
         topologyHints:
           properties:
             tscFrequency:
@@ -13814,6 +15578,54 @@ var CRDsValidation map[string]string = map[string]string{
                     format: int32
                     type: integer
                 type: object
+              flatten:
+                description: If the backing chain of the volume is being flattened,
+                  this will contain the flatten status.
+                properties:
+                  endTimestamp:
+                    description: EndTimestamp is the time when the flattening completed
+                      or failed
+                    format: date-time
+                    type: string
+                  message:
+                    description: Message is a detailed message about the current phase
+                    type: string
+                  phase:
+                    description: Phase is either InProgress, Completed or Failed
+                    type: string
+                  progress:
+                    description: Progress is the percentage of the backing chain already
+                      copied into the active image
+                    format: int32
+                    type: integer
+                  startTimestamp:
+                    description: StartTimestamp is the time when the flattening started
+                    format: date-time
+                    type: string
+                required:
+                - phase
+                type: object
+              health:
+                description: Health reflects the result of the storage heartbeat of
+                  the volume, if enabled
+                properties:
+                  lastTransitionTime:
+                    description: LastTransitionTime is the time the status last changed
+                    format: date-time
+                    type: string
+                  message:
+                    description: Message is a detailed message about the current status
+                    type: string
+                  reason:
+                    description: Reason is a brief description of why the volume is
+                      in the current status
+                    type: string
+                  status:
+                    description: Status is either Healthy or Degraded
+                    type: string
+                required:
+                - status
+                type: object
               hotplugVolume:
                 description: If the volume is hotplug, this will contain the hotplug
                   status.
@@ -14107,6 +15919,10 @@ var CRDsValidation map[string]string = map[string]string{
               description: Lets us know if the vmi is currently running pre or post
                 copy migration
               type: string
+            observedDowntime:
+              description: The downtime of the guest at the switchover of the migration,
+                measured by QEMU
+              type: string
             sourceNode:
               description: The source node that the VMI originated on
               type: string
@@ -14138,6 +15954,35 @@ var CRDsValidation map[string]string = map[string]string{
               description: The list of ports opened for live migration on the destination
                 node
               type: object
+            targetNetworkAnnouncements:
+              description: |-
+                The network announcements sent by the target node for the bridge bound interfaces,
+                once the migrated domain is running
+              items:
+                description: |-
+                  MigrationNetworkAnnouncement reports the announcements of an interface to the network
+                  after a migration, for the switches to learn its new location.
+                properties:
+                  message:
+                    description: The reason the announcements failed
+                    type: string
+                  name:
+                    description: Name of the interface
+                    type: string
+                  rounds:
+                    description: The number of announcement rounds sent
+                    format: int32
+                    type: integer
+                  succeeded:
+                    description: Indicates that all the announcement rounds were sent
+                    type: boolean
+                required:
+                - name
+                - rounds
+                - succeeded
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
             targetNode:
               description: The target node that the VMI is moving to
               type: string
@@ -14460,6 +16305,29 @@ var CRDsValidation map[string]string = map[string]string{
                     Whether or not to enable virtio multi-queue for block devices.
                     Defaults to false.
                   type: boolean
+                channels:
+                  description: |-
+                    Channels describes additional virtio-serial channels exposed to the guest.
+                    Channels can be added to a running vmi through the addchannel subresource.
+                  items:
+                    description: Channel represents a virtio-serial port, backed by
+                      a unix socket in the virt-launcher pod
+                    properties:
+                      name:
+                        description: Name of the channel, also used to name its socket
+                          in the virt-launcher pod
+                        type: string
+                      target:
+                        description: Target is the name of the port as seen by the
+                          guest, e.g. org.example.agent.0
+                        type: string
+                    required:
+                    - name
+                    - target
+                    type: object
+                  maxItems: 16
+                  type: array
+                  x-kubernetes-list-type: atomic
                 clientPassthrough:
                   description: To configure and access client devices such as redirecting
                     USB
@@ -14742,6 +16610,52 @@ var CRDsValidation map[string]string = map[string]string{
                       bridge:
                         description: InterfaceBridge connects to a given network via
                           a linux bridge.
+                        properties:
+                          macLearning:
+                            description: |-
+                              MACLearning sets whether the bridge learns the MAC addresses the guest sends from, so that
+                              the frames destined to them are forwarded to the guest only. Defaults to true.
+                            type: boolean
+                          tap:
+                            description: Tap tunes the tap device connecting the guest
+                              to the bridge.
+                            properties:
+                              disableOffloads:
+                                description: |-
+                                  DisableOffloads disables every offload of the interface, the checksum, segmentation and ECN
+                                  offloads of the host and the guest, overriding Offload.
+                                  Only supported with the virtio model. Defaults to false.
+                                type: boolean
+                              offload:
+                                description: |-
+                                  Offload sets the segmentation offloads of the interface.
+                                  Only supported with the virtio model.
+                                properties:
+                                  gso:
+                                    description: GSO sets the generic segmentation
+                                      offload of the host.
+                                    type: boolean
+                                  tso:
+                                    description: TSO sets the TCP segmentation offload,
+                                      over IPv4 and IPv6, of the host and the guest.
+                                    type: boolean
+                                  ufo:
+                                    description: UFO sets the UDP fragmentation offload
+                                      of the host and the guest.
+                                    type: boolean
+                                type: object
+                              txQueueLength:
+                                description: |-
+                                  TxQueueLength is the length of the transmit queue of the tap device, in packets.
+                                  Defaults to the kernel default, 1000.
+                                format: int32
+                                type: integer
+                            type: object
+                          unicastFlood:
+                            description: |-
+                              UnicastFlood sets whether the bridge forwards to the guest the frames destined to the MAC
+                              addresses it did not learn. It can't be disabled along with MACLearning. Defaults to true.
+                            type: boolean
                         type: object
                       dhcpOptions:
                         description: If specified the network interface will pass
@@ -14880,6 +16794,20 @@ var CRDsValidation map[string]string = map[string]string{
                     Not relevant if autoattachSerialConsole is disabled.
                     Defaults to cluster wide setting on VirtualMachineOptions.
                   type: boolean
+                maintenanceNotifications:
+                  description: |-
+                    MaintenanceNotifications creates a virtio serial for notifying the guest of the migrations
+                    and the host maintenance ahead of them.
+                  properties:
+                    leadTimeSeconds:
+                      description: |-
+                        LeadTimeSeconds is how long a migration waits for the guest to acknowledge its
+                        notification before it starts. Defaults to 30.
+                      format: int32
+                      maximum: 600
+                      minimum: 1
+                      type: integer
+                  type: object
                 networkInterfaceMultiqueue:
                   description: If specified, virtual network interfaces configured
                     with a virtio bus will also enable the vhost multiqueue feature
@@ -15351,6 +17279,46 @@ var CRDsValidation map[string]string = map[string]string{
               description: Resources describes the Compute Resources required by this
                 vmi.
               properties:
+                cgroupWeights:
+                  description: |-
+                    CgroupWeights sets the cgroup v2 weights of the virt-launcher pod, to prioritize the VMI against the other
+                    VMIs of its node beyond its requests and limits. It requires the CgroupWeights feature gate.
+                  properties:
+                    cpu:
+                      description: CPU is the cpu.weight of the pod, which otherwise
+                        derives from its CPU request.
+                      format: int32
+                      type: integer
+                    io:
+                      description: IO is the default io.weight of the pod, on all
+                        the devices.
+                      format: int32
+                      type: integer
+                    volumeIO:
+                      description: VolumeIO overrides the io.weight of the pod on
+                        the devices backing the given volumes.
+                      items:
+                        description: VolumeIOWeight is the io.weight of a pod on the
+                          device backing one of its volumes.
+                        properties:
+                          volume:
+                            description: Volume is the name of a persistentVolumeClaim
+                              or dataVolume volume of the VMI.
+                            type: string
+                          weight:
+                            description: Weight is the io.weight of the pod on the
+                              device backing the volume.
+                            format: int32
+                            type: integer
+                        required:
+                        - volume
+                        - weight
+                        type: object
+                      type: array
+                      x-kubernetes-list-map-keys:
+                      - volume
+                      x-kubernetes-list-type: map
+                  type: object
                 limits:
                   additionalProperties:
                     anyOf:
@@ -16860,6 +18828,29 @@ var CRDsValidation map[string]string = map[string]string{
                             Whether or not to enable virtio multi-queue for block devices.
                             Defaults to false.
                           type: boolean
+                        channels:
+                          description: |-
+                            Channels describes additional virtio-serial channels exposed to the guest.
+                            Channels can be added to a running vmi through the addchannel subresource.
+                          items:
+                            description: Channel represents a virtio-serial port,
+                              backed by a unix socket in the virt-launcher pod
+                            properties:
+                              name:
+                                description: Name of the channel, also used to name
+                                  its socket in the virt-launcher pod
+                                type: string
+                              target:
+                                description: Target is the name of the port as seen
+                                  by the guest, e.g. org.example.agent.0
+                                type: string
+                            required:
+                            - name
+                            - target
+                            type: object
+                          maxItems: 16
+                          type: array
+                          x-kubernetes-list-type: atomic
                         clientPassthrough:
                           description: To configure and access client devices such
                             as redirecting USB
@@ -17147,6 +19138,53 @@ var CRDsValidation map[string]string = map[string]string{
                               bridge:
                                 description: InterfaceBridge connects to a given network
                                   via a linux bridge.
+                                properties:
+                                  macLearning:
+                                    description: |-
+                                      MACLearning sets whether the bridge learns the MAC addresses the guest sends from, so that
+                                      the frames destined to them are forwarded to the guest only. Defaults to true.
+                                    type: boolean
+                                  tap:
+                                    description: Tap tunes the tap device connecting
+                                      the guest to the bridge.
+                                    properties:
+                                      disableOffloads:
+                                        description: |-
+                                          DisableOffloads disables every offload of the interface, the checksum, segmentation and ECN
+                                          offloads of the host and the guest, overriding Offload.
+                                          Only supported with the virtio model. Defaults to false.
+                                        type: boolean
+                                      offload:
+                                        description: |-
+                                          Offload sets the segmentation offloads of the interface.
+                                          Only supported with the virtio model.
+                                        properties:
+                                          gso:
+                                            description: GSO sets the generic segmentation
+                                              offload of the host.
+                                            type: boolean
+                                          tso:
+                                            description: TSO sets the TCP segmentation
+                                              offload, over IPv4 and IPv6, of the
+                                              host and the guest.
+                                            type: boolean
+                                          ufo:
+                                            description: UFO sets the UDP fragmentation
+                                              offload of the host and the guest.
+                                            type: boolean
+                                        type: object
+                                      txQueueLength:
+                                        description: |-
+                                          TxQueueLength is the length of the transmit queue of the tap device, in packets.
+                                          Defaults to the kernel default, 1000.
+                                        format: int32
+                                        type: integer
+                                    type: object
+                                  unicastFlood:
+                                    description: |-
+                                      UnicastFlood sets whether the bridge forwards to the guest the frames destined to the MAC
+                                      addresses it did not learn. It can't be disabled along with MACLearning. Defaults to true.
+                                    type: boolean
                                 type: object
                               dhcpOptions:
                                 description: If specified the network interface will
@@ -17286,6 +19324,20 @@ var CRDsValidation map[string]string = map[string]string{
                             Not relevant if autoattachSerialConsole is disabled.
                             Defaults to cluster wide setting on VirtualMachineOptions.
                           type: boolean
+                        maintenanceNotifications:
+                          description: |-
+                            MaintenanceNotifications creates a virtio serial for notifying the guest of the migrations
+                            and the host maintenance ahead of them.
+                          properties:
+                            leadTimeSeconds:
+                              description: |-
+                                LeadTimeSeconds is how long a migration waits for the guest to acknowledge its
+                                notification before it starts. Defaults to 30.
+                              format: int32
+                              maximum: 600
+                              minimum: 1
+                              type: integer
+                          type: object
                         networkInterfaceMultiqueue:
                           description: If specified, virtual network interfaces configured
                             with a virtio bus will also enable the vhost multiqueue
@@ -17765,6 +19817,46 @@ var CRDsValidation map[string]string = map[string]string{
                       description: Resources describes the Compute Resources required
                         by this vmi.
                       properties:
+                        cgroupWeights:
+                          description: |-
+                            CgroupWeights sets the cgroup v2 weights of the virt-launcher pod, to prioritize the VMI against the other
+                            VMIs of its node beyond its requests and limits. It requires the CgroupWeights feature gate.
+                          properties:
+                            cpu:
+                              description: CPU is the cpu.weight of the pod, which
+                                otherwise derives from its CPU request.
+                              format: int32
+                              type: integer
+                            io:
+                              description: IO is the default io.weight of the pod,
+                                on all the devices.
+                              format: int32
+                              type: integer
+                            volumeIO:
+                              description: VolumeIO overrides the io.weight of the
+                                pod on the devices backing the given volumes.
+                              items:
+                                description: VolumeIOWeight is the io.weight of a
+                                  pod on the device backing one of its volumes.
+                                properties:
+                                  volume:
+                                    description: Volume is the name of a persistentVolumeClaim
+                                      or dataVolume volume of the VMI.
+                                    type: string
+                                  weight:
+                                    description: Weight is the io.weight of the pod
+                                      on the device backing the volume.
+                                    format: int32
+                                    type: integer
+                                required:
+                                - volume
+                                - weight
+                                type: object
+                              type: array
+                              x-kubernetes-list-map-keys:
+                              - volume
+                              x-kubernetes-list-type: map
+                          type: object
                         limits:
                           additionalProperties:
                             anyOf:
@@ -17811,6 +19903,18 @@ var CRDsValidation map[string]string = map[string]string{
                     Specifies the hostname of the vmi
                     If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                   type: string
+                identityDocument:
+                  description: |-
+                    IdentityDocument makes KubeVirt issue a signed identity document for the VMI, which the
+                    workloads in the guest can present to external services to authenticate as the VMI.
+                    It requires the VMIdentityDocuments feature gate.
+                  properties:
+                    guestAgentPath:
+                      description: |-
+                        GuestAgentPath is the path of the file in the guest the guest agent keeps the rotated identity
+                        document in, readable by root only. Without it, the guest only gets the document it booted with.
+                      type: string
+                  type: object
                 livenessProbe:
                   description: |-
                     Periodic probe of VirtualMachineInstance liveness.
@@ -18130,25 +20234,66 @@ var CRDsValidation map[string]string = map[string]string{
                             Name must be an IANA_SVC_NAME.
                           x-kubernetes-int-or-string: true
                       required:
-                      - port
+                      - port
+                      type: object
+                    timeoutSeconds:
+                      description: |-
+                        Number of seconds after which the probe times out.
+                        For exec probes the timeout fails the probe but does not terminate the command running on the guest.
+                        This means a blocking command can result in an increasing load on the guest.
+                        A small buffer will be added to the resulting workload exec probe to compensate for delays
+                        caused by the qemu guest exec mechanism.
+                        Defaults to 1 second. Minimum value is 1.
+                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                      format: int32
+                      type: integer
+                  type: object
+                schedulerName:
+                  description: |-
+                    If specified, the VMI will be dispatched by specified scheduler.
+                    If not specified, the VMI will be dispatched by default scheduler.
+                  type: string
+                shutdownPolicy:
+                  description: |-
+                    ShutdownPolicy orchestrates the graceful shutdown of the guest within the termination grace period: a
+                    pre-shutdown command run by the guest agent, then the shutdown signal, each phase with its own timeout.
+                    It requires the ShutdownPolicy feature gate.
+                  properties:
+                    preShutdown:
+                      description: |-
+                        PreShutdown is a command run in the guest by the guest agent before the guest is signaled to shut
+                        down, e.g. to stop a database cleanly. The guest is signaled once the command exits, fails or
+                        times out.
+                      properties:
+                        command:
+                          description: Command is the path of the command in the guest,
+                            followed by its arguments
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        timeoutSeconds:
+                          description: TimeoutSeconds is the time given to the command
+                            to exit, 60 seconds by default
+                          format: int32
+                          type: integer
+                      required:
+                      - command
                       type: object
-                    timeoutSeconds:
+                    signalTimeoutSeconds:
                       description: |-
-                        Number of seconds after which the probe times out.
-                        For exec probes the timeout fails the probe but does not terminate the command running on the guest.
-                        This means a blocking command can result in an increasing load on the guest.
-                        A small buffer will be added to the resulting workload exec probe to compensate for delays
-                        caused by the qemu guest exec mechanism.
-                        Defaults to 1 second. Minimum value is 1.
-                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
-                      format: int32
+                        SignalTimeoutSeconds is the time given to the guest to shut down once signaled, before it is
+                        forced off. The remainder of the termination grace period by default.
+                      format: int64
                       type: integer
+                    windowsUpdates:
+                      description: |-
+                        WindowsUpdates is how the shutdown of a Windows guest with pending updates is handled, the guest
+                        agent is used to check for them. Wait doesn't force the guest off before the termination grace
+                        period expires while they are pending, Reschedule also refuses the evictions of the VMI for the
+                        drains to retry them later, Force ignores them. Force by default.
+                      type: string
                   type: object
-                schedulerName:
-                  description: |-
-                    If specified, the VMI will be dispatched by specified scheduler.
-                    If not specified, the VMI will be dispatched by default scheduler.
-                  type: string
                 startStrategy:
                   description: StartStrategy can be set to "Paused" if Virtual Machine
                     should be started in paused state.
@@ -19955,6 +22100,30 @@ var CRDsValidation map[string]string = map[string]string{
                         captured the first time the instancetype is applied to the VirtualMachineInstance.
                       type: string
                   type: object
+                lease:
+                  description: |-
+                    Lease limits the lifetime of the VirtualMachine, which is stopped or deleted once the lease expired.
+                    The lease is extended with the renewlease subresource. It requires the VMLease feature gate.
+                  properties:
+                    action:
+                      description: Action is what happens to the VirtualMachine once
+                        the lease expired, Stop by default
+                      enum:
+                      - Stop
+                      - Delete
+                      type: string
+                    expiresAt:
+                      description: ExpiresAt is the time the lease expires at
+                      format: date-time
+                      type: string
+                    warningPeriod:
+                      description: |-
+                        WarningPeriod is how long before the expiry of the lease the VirtualMachine gets the LeaseExpiring
+                        condition and a warning event. One hour by default.
+                      type: string
+                  required:
+                  - expiresAt
+                  type: object
                 preference:
                   description: PreferenceMatcher references a set of preference that
                     is used to fill fields in Template
@@ -19999,6 +22168,37 @@ var CRDsValidation map[string]string = map[string]string{
                     Mutually exclusive with RunStrategy
                     Deprecated: VirtualMachineInstance field "Running" is now deprecated, please use RunStrategy instead.
                   type: boolean
+                startDependencies:
+                  description: |-
+                    StartDependencies lists resources which have to be available before the VirtualMachineInstance is created.
+                    Whenever the run strategy requires the VirtualMachine to start, the start is delayed until all dependencies are ready.
+                  items:
+                    description: StartDependency references a resource in the namespace
+                      of the VirtualMachine
+                    properties:
+                      kind:
+                        description: Kind of the resource
+                        enum:
+                        - PersistentVolumeClaim
+                        - NetworkAttachmentDefinition
+                        - Service
+                        type: string
+                      name:
+                        description: Name of the resource, NetworkAttachmentDefinitions
+                          from another namespace can be referenced as <namespace>/<name>
+                        type: string
+                      timeout:
+                        description: |-
+                          Timeout after which waiting for the dependency is reported as failed.
+                          The VirtualMachine keeps waiting and starts as soon as the dependency is ready.
+                          Defaults to no timeout.
+                        type: string
+                    required:
+                    - kind
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 template:
                   description: Template is the direct specification of VirtualMachineInstance
                   properties:
@@ -21355,6 +23555,31 @@ var CRDsValidation map[string]string = map[string]string{
                                     Whether or not to enable virtio multi-queue for block devices.
                                     Defaults to false.
                                   type: boolean
+                                channels:
+                                  description: |-
+                                    Channels describes additional virtio-serial channels exposed to the guest.
+                                    Channels can be added to a running vmi through the addchannel subresource.
+                                  items:
+                                    description: Channel represents a virtio-serial
+                                      port, backed by a unix socket in the virt-launcher
+                                      pod
+                                    properties:
+                                      name:
+                                        description: Name of the channel, also used
+                                          to name its socket in the virt-launcher
+                                          pod
+                                        type: string
+                                      target:
+                                        description: Target is the name of the port
+                                          as seen by the guest, e.g. org.example.agent.0
+                                        type: string
+                                    required:
+                                    - name
+                                    - target
+                                    type: object
+                                  maxItems: 16
+                                  type: array
+                                  x-kubernetes-list-type: atomic
                                 clientPassthrough:
                                   description: To configure and access client devices
                                     such as redirecting USB
@@ -21651,6 +23876,56 @@ var CRDsValidation map[string]string = map[string]string{
                                       bridge:
                                         description: InterfaceBridge connects to a
                                           given network via a linux bridge.
+                                        properties:
+                                          macLearning:
+                                            description: |-
+                                              MACLearning sets whether the bridge learns the MAC addresses the guest sends from, so that
+                                              the frames destined to them are forwarded to the guest only. Defaults to true.
+                                            type: boolean
+                                          tap:
+                                            description: Tap tunes the tap device
+                                              connecting the guest to the bridge.
+                                            properties:
+                                              disableOffloads:
+                                                description: |-
+                                                  DisableOffloads disables every offload of the interface, the checksum, segmentation and ECN
+                                                  offloads of the host and the guest, overriding Offload.
+                                                  Only supported with the virtio model. Defaults to false.
+                                                type: boolean
+                                              offload:
+                                                description: |-
+                                                  Offload sets the segmentation offloads of the interface.
+                                                  Only supported with the virtio model.
+                                                properties:
+                                                  gso:
+                                                    description: GSO sets the generic
+                                                      segmentation offload of the
+                                                      host.
+                                                    type: boolean
+                                                  tso:
+                                                    description: TSO sets the TCP
+                                                      segmentation offload, over IPv4
+                                                      and IPv6, of the host and the
+                                                      guest.
+                                                    type: boolean
+                                                  ufo:
+                                                    description: UFO sets the UDP
+                                                      fragmentation offload of the
+                                                      host and the guest.
+                                                    type: boolean
+                                                type: object
+                                              txQueueLength:
+                                                description: |-
+                                                  TxQueueLength is the length of the transmit queue of the tap device, in packets.
+                                                  Defaults to the kernel default, 1000.
+                                                format: int32
+                                                type: integer
+                                            type: object
+                                          unicastFlood:
+                                            description: |-
+                                              UnicastFlood sets whether the bridge forwards to the guest the frames destined to the MAC
+                                              addresses it did not learn. It can't be disabled along with MACLearning. Defaults to true.
+                                            type: boolean
                                         type: object
                                       dhcpOptions:
                                         description: If specified the network interface
@@ -21796,6 +24071,20 @@ var CRDsValidation map[string]string = map[string]string{
                                     Not relevant if autoattachSerialConsole is disabled.
                                     Defaults to cluster wide setting on VirtualMachineOptions.
                                   type: boolean
+                                maintenanceNotifications:
+                                  description: |-
+                                    MaintenanceNotifications creates a virtio serial for notifying the guest of the migrations
+                                    and the host maintenance ahead of them.
+                                  properties:
+                                    leadTimeSeconds:
+                                      description: |-
+                                        LeadTimeSeconds is how long a migration waits for the guest to acknowledge its
+                                        notification before it starts. Defaults to 30.
+                                      format: int32
+                                      maximum: 600
+                                      minimum: 1
+                                      type: integer
+                                  type: object
                                 networkInterfaceMultiqueue:
                                   description: If specified, virtual network interfaces
                                     configured with a virtio bus will also enable
@@ -22285,6 +24574,48 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Resources describes the Compute Resources
                                 required by this vmi.
                               properties:
+                                cgroupWeights:
+                                  description: |-
+                                    CgroupWeights sets the cgroup v2 weights of the virt-launcher pod, to prioritize the VMI against the other
+                                    VMIs of its node beyond its requests and limits. It requires the CgroupWeights feature gate.
+                                  properties:
+                                    cpu:
+                                      description: CPU is the cpu.weight of the pod,
+                                        which otherwise derives from its CPU request.
+                                      format: int32
+                                      type: integer
+                                    io:
+                                      description: IO is the default io.weight of
+                                        the pod, on all the devices.
+                                      format: int32
+                                      type: integer
+                                    volumeIO:
+                                      description: VolumeIO overrides the io.weight
+                                        of the pod on the devices backing the given
+                                        volumes.
+                                      items:
+                                        description: VolumeIOWeight is the io.weight
+                                          of a pod on the device backing one of its
+                                          volumes.
+                                        properties:
+                                          volume:
+                                            description: Volume is the name of a persistentVolumeClaim
+                                              or dataVolume volume of the VMI.
+                                            type: string
+                                          weight:
+                                            description: Weight is the io.weight of
+                                              the pod on the device backing the volume.
+                                            format: int32
+                                            type: integer
+                                        required:
+                                        - volume
+                                        - weight
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-map-keys:
+                                      - volume
+                                      x-kubernetes-list-type: map
+                                  type: object
                                 limits:
                                   additionalProperties:
                                     anyOf:
@@ -22331,6 +24662,18 @@ var CRDsValidation map[string]string = map[string]string{
                             Specifies the hostname of the vmi
                             If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                           type: string
+                        identityDocument:
+                          description: |-
+                            IdentityDocument makes KubeVirt issue a signed identity document for the VMI, which the
+                            workloads in the guest can present to external services to authenticate as the VMI.
+                            It requires the VMIdentityDocuments feature gate.
+                          properties:
+                            guestAgentPath:
+                              description: |-
+                                GuestAgentPath is the path of the file in the guest the guest agent keeps the rotated identity
+                                document in, readable by root only. Without it, the guest only gets the document it booted with.
+                              type: string
+                          type: object
                         livenessProbe:
                           description: |-
                             Periodic probe of VirtualMachineInstance liveness.
@@ -22669,6 +25012,47 @@ var CRDsValidation map[string]string = map[string]string{
                             If specified, the VMI will be dispatched by specified scheduler.
                             If not specified, the VMI will be dispatched by default scheduler.
                           type: string
+                        shutdownPolicy:
+                          description: |-
+                            ShutdownPolicy orchestrates the graceful shutdown of the guest within the termination grace period: a
+                            pre-shutdown command run by the guest agent, then the shutdown signal, each phase with its own timeout.
+                            It requires the ShutdownPolicy feature gate.
+                          properties:
+                            preShutdown:
+                              description: |-
+                                PreShutdown is a command run in the guest by the guest agent before the guest is signaled to shut
+                                down, e.g. to stop a database cleanly. The guest is signaled once the command exits, fails or
+                                times out.
+                              properties:
+                                command:
+                                  description: Command is the path of the command
+                                    in the guest, followed by its arguments
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                timeoutSeconds:
+                                  description: TimeoutSeconds is the time given to
+                                    the command to exit, 60 seconds by default
+                                  format: int32
+                                  type: integer
+                              required:
+                              - command
+                              type: object
+                            signalTimeoutSeconds:
+                              description: |-
+                                SignalTimeoutSeconds is the time given to the guest to shut down once signaled, before it is
+                                forced off. The remainder of the termination grace period by default.
+                              format: int64
+                              type: integer
+                            windowsUpdates:
+                              description: |-
+                                WindowsUpdates is how the shutdown of a Windows guest with pending updates is handled, the guest
+                                agent is used to check for them. Wait doesn't force the guest off before the termination grace
+                                period expires while they are pending, Reschedule also refuses the evictions of the VMI for the
+                                drains to retry them later, Force ignores them. Force by default.
+                              type: string
+                          type: object
                         startStrategy:
                           description: StartStrategy can be set to "Paused" if Virtual
                             Machine should be started in paused state.
@@ -23387,6 +25771,26 @@ var CRDsValidation map[string]string = map[string]string{
               - template
               type: object
           type: object
+        warmStandby:
+          description: |-
+            WarmStandby keeps booted and paused VMs on top of the replicas, scaling the pool out
+            claims them before creating new VMs.
+          properties:
+            claimCommand:
+              description: |-
+                ClaimCommand is run in the guest by the guest agent once a warm standby VM is claimed and
+                unpaused, e.g. to configure the hotplugged interfaces.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            replicas:
+              description: Number of warm standby VMs kept booted and paused.
+              format: int32
+              type: integer
+          required:
+          - replicas
+          type: object
       required:
       - selector
       - virtualMachineTemplate
@@ -23428,6 +25832,11 @@ var CRDsValidation map[string]string = map[string]string{
         replicas:
           format: int32
           type: integer
+        warmReplicas:
+          description: Number of warm standby VMs which are booted and paused, ready
+            to be claimed.
+          format: int32
+          type: integer
       type: object
   required:
   - spec
@@ -25140,6 +27549,30 @@ var CRDsValidation map[string]string = map[string]string{
                             captured the first time the instancetype is applied to the VirtualMachineInstance.
                           type: string
                       type: object
+                    lease:
+                      description: |-
+                        Lease limits the lifetime of the VirtualMachine, which is stopped or deleted once the lease expired.
+                        The lease is extended with the renewlease subresource. It requires the VMLease feature gate.
+                      properties:
+                        action:
+                          description: Action is what happens to the VirtualMachine
+                            once the lease expired, Stop by default
+                          enum:
+                          - Stop
+                          - Delete
+                          type: string
+                        expiresAt:
+                          description: ExpiresAt is the time the lease expires at
+                          format: date-time
+                          type: string
+                        warningPeriod:
+                          description: |-
+                            WarningPeriod is how long before the expiry of the lease the VirtualMachine gets the LeaseExpiring
+                            condition and a warning event. One hour by default.
+                          type: string
+                      required:
+                      - expiresAt
+                      type: object
                     preference:
                       description: PreferenceMatcher references a set of preference
                         that is used to fill fields in Template
@@ -25184,6 +27617,37 @@ var CRDsValidation map[string]string = map[string]string{
                         Mutually exclusive with RunStrategy
                         Deprecated: VirtualMachineInstance field "Running" is now deprecated, please use RunStrategy instead.
                       type: boolean
+                    startDependencies:
+                      description: |-
+                        StartDependencies lists resources which have to be available before the VirtualMachineInstance is created.
+                        Whenever the run strategy requires the VirtualMachine to start, the start is delayed until all dependencies are ready.
+                      items:
+                        description: StartDependency references a resource in the
+                          namespace of the VirtualMachine
+                        properties:
+                          kind:
+                            description: Kind of the resource
+                            enum:
+                            - PersistentVolumeClaim
+                            - NetworkAttachmentDefinition
+                            - Service
+                            type: string
+                          name:
+                            description: Name of the resource, NetworkAttachmentDefinitions
+                              from another namespace can be referenced as <namespace>/<name>
+                            type: string
+                          timeout:
+                            description: |-
+                              Timeout after which waiting for the dependency is reported as failed.
+                              The VirtualMachine keeps waiting and starts as soon as the dependency is ready.
+                              Defaults to no timeout.
+                            type: string
+                        required:
+                        - kind
+                        - name
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
                     template:
                       description: Template is the direct specification of VirtualMachineInstance
                       properties:
@@ -26556,6 +29020,31 @@ var CRDsValidation map[string]string = map[string]string{
                                         Whether or not to enable virtio multi-queue for block devices.
                                         Defaults to false.
                                       type: boolean
+                                    channels:
+                                      description: |-
+                                        Channels describes additional virtio-serial channels exposed to the guest.
+                                        Channels can be added to a running vmi through the addchannel subresource.
+                                      items:
+                                        description: Channel represents a virtio-serial
+                                          port, backed by a unix socket in the virt-launcher
+                                          pod
+                                        properties:
+                                          name:
+                                            description: Name of the channel, also
+                                              used to name its socket in the virt-launcher
+                                              pod
+                                            type: string
+                                          target:
+                                            description: Target is the name of the
+                                              port as seen by the guest, e.g. org.example.agent.0
+                                            type: string
+                                        required:
+                                        - name
+                                        - target
+                                        type: object
+                                      maxItems: 16
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                     clientPassthrough:
                                       description: To configure and access client
                                         devices such as redirecting USB
@@ -26860,6 +29349,56 @@ var CRDsValidation map[string]string = map[string]string{
                                           bridge:
                                             description: InterfaceBridge connects
                                               to a given network via a linux bridge.
+                                            properties:
+                                              macLearning:
+                                                description: |-
+                                                  MACLearning sets whether the bridge learns the MAC addresses the guest sends from, so that
+                                                  the frames destined to them are forwarded to the guest only. Defaults to true.
+                                                type: boolean
+                                              tap:
+                                                description: Tap tunes the tap device
+                                                  connecting the guest to the bridge.
+                                                properties:
+                                                  disableOffloads:
+                                                    description: |-
+                                                      DisableOffloads disables every offload of the interface, the checksum, segmentation and ECN
+                                                      offloads of the host and the guest, overriding Offload.
+                                                      Only supported with the virtio model. Defaults to false.
+                                                    type: boolean
+                                                  offload:
+                                                    description: |-
+                                                      Offload sets the segmentation offloads of the interface.
+                                                      Only supported with the virtio model.
+                                                    properties:
+                                                      gso:
+                                                        description: GSO sets the
+                                                          generic segmentation offload
+                                                          of the host.
+                                                        type: boolean
+                                                      tso:
+                                                        description: TSO sets the
+                                                          TCP segmentation offload,
+                                                          over IPv4 and IPv6, of the
+                                                          host and the guest.
+                                                        type: boolean
+                                                      ufo:
+                                                        description: UFO sets the
+                                                          UDP fragmentation offload
+                                                          of the host and the guest.
+                                                        type: boolean
+                                                    type: object
+                                                  txQueueLength:
+                                                    description: |-
+                                                      TxQueueLength is the length of the transmit queue of the tap device, in packets.
+                                                      Defaults to the kernel default, 1000.
+                                                    format: int32
+                                                    type: integer
+                                                type: object
+                                              unicastFlood:
+                                                description: |-
+                                                  UnicastFlood sets whether the bridge forwards to the guest the frames destined to the MAC
+                                                  addresses it did not learn. It can't be disabled along with MACLearning. Defaults to true.
+                                                type: boolean
                                             type: object
                                           dhcpOptions:
                                             description: If specified the network
@@ -27006,6 +29545,20 @@ var CRDsValidation map[string]string = map[string]string{
                                         Not relevant if autoattachSerialConsole is disabled.
                                         Defaults to cluster wide setting on VirtualMachineOptions.
                                       type: boolean
+                                    maintenanceNotifications:
+                                      description: |-
+                                        MaintenanceNotifications creates a virtio serial for notifying the guest of the migrations
+                                        and the host maintenance ahead of them.
+                                      properties:
+                                        leadTimeSeconds:
+                                          description: |-
+                                            LeadTimeSeconds is how long a migration waits for the guest to acknowledge its
+                                            notification before it starts. Defaults to 30.
+                                          format: int32
+                                          maximum: 600
+                                          minimum: 1
+                                          type: integer
+                                      type: object
                                     networkInterfaceMultiqueue:
                                       description: If specified, virtual network interfaces
                                         configured with a virtio bus will also enable
@@ -27496,6 +30049,51 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Resources describes the Compute Resources
                                     required by this vmi.
                                   properties:
+                                    cgroupWeights:
+                                      description: |-
+                                        CgroupWeights sets the cgroup v2 weights of the virt-launcher pod, to prioritize the VMI against the other
+                                        VMIs of its node beyond its requests and limits. It requires the CgroupWeights feature gate.
+                                      properties:
+                                        cpu:
+                                          description: CPU is the cpu.weight of the
+                                            pod, which otherwise derives from its
+                                            CPU request.
+                                          format: int32
+                                          type: integer
+                                        io:
+                                          description: IO is the default io.weight
+                                            of the pod, on all the devices.
+                                          format: int32
+                                          type: integer
+                                        volumeIO:
+                                          description: VolumeIO overrides the io.weight
+                                            of the pod on the devices backing the
+                                            given volumes.
+                                          items:
+                                            description: VolumeIOWeight is the io.weight
+                                              of a pod on the device backing one of
+                                              its volumes.
+                                            properties:
+                                              volume:
+                                                description: Volume is the name of
+                                                  a persistentVolumeClaim or dataVolume
+                                                  volume of the VMI.
+                                                type: string
+                                              weight:
+                                                description: Weight is the io.weight
+                                                  of the pod on the device backing
+                                                  the volume.
+                                                format: int32
+                                                type: integer
+                                            required:
+                                            - volume
+                                            - weight
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-map-keys:
+                                          - volume
+                                          x-kubernetes-list-type: map
+                                      type: object
                                     limits:
                                       additionalProperties:
                                         anyOf:
@@ -27542,6 +30140,18 @@ var CRDsValidation map[string]string = map[string]string{
                                 Specifies the hostname of the vmi
                                 If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                               type: string
+                            identityDocument:
+                              description: |-
+                                IdentityDocument makes KubeVirt issue a signed identity document for the VMI, which the
+                                workloads in the guest can present to external services to authenticate as the VMI.
+                                It requires the VMIdentityDocuments feature gate.
+                              properties:
+                                guestAgentPath:
+                                  description: |-
+                                    GuestAgentPath is the path of the file in the guest the guest agent keeps the rotated identity
+                                    document in, readable by root only. Without it, the guest only gets the document it booted with.
+                                  type: string
+                              type: object
                             livenessProbe:
                               description: |-
                                 Periodic probe of VirtualMachineInstance liveness.
@@ -27883,6 +30493,47 @@ var CRDsValidation map[string]string = map[string]string{
                                 If specified, the VMI will be dispatched by specified scheduler.
                                 If not specified, the VMI will be dispatched by default scheduler.
                               type: string
+                            shutdownPolicy:
+                              description: |-
+                                ShutdownPolicy orchestrates the graceful shutdown of the guest within the termination grace period: a
+                                pre-shutdown command run by the guest agent, then the shutdown signal, each phase with its own timeout.
+                                It requires the ShutdownPolicy feature gate.
+                              properties:
+                                preShutdown:
+                                  description: |-
+                                    PreShutdown is a command run in the guest by the guest agent before the guest is signaled to shut
+                                    down, e.g. to stop a database cleanly. The guest is signaled once the command exits, fails or
+                                    times out.
+                                  properties:
+                                    command:
+                                      description: Command is the path of the command
+                                        in the guest, followed by its arguments
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    timeoutSeconds:
+                                      description: TimeoutSeconds is the time given
+                                        to the command to exit, 60 seconds by default
+                                      format: int32
+                                      type: integer
+                                  required:
+                                  - command
+                                  type: object
+                                signalTimeoutSeconds:
+                                  description: |-
+                                    SignalTimeoutSeconds is the time given to the guest to shut down once signaled, before it is
+                                    forced off. The remainder of the termination grace period by default.
+                                  format: int64
+                                  type: integer
+                                windowsUpdates:
+                                  description: |-
+                                    WindowsUpdates is how the shutdown of a Windows guest with pending updates is handled, the guest
+                                    agent is used to check for them. Wait doesn't force the guest off before the termination grace
+                                    period expires while they are pending, Reschedule also refuses the evictions of the VMI for the
+                                    drains to retry them later, Force ignores them. Force by default.
+                                  type: string
+                              type: object
                             startStrategy:
                               description: StartStrategy can be set to "Paused" if
                                 Virtual Machine should be started in paused state.
@@ -29439,5 +32090,82 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinetimeline": `openAPIV3Schema:
+  description: |-
+    VirtualMachineTimeline keeps the deduplicated events, condition transitions and migrations
+    of a VirtualMachine across the restarts of its VMIs.
+    It is maintained by KubeVirt, has the name of its VirtualMachine and is removed with it.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    entries:
+      description: Entries are the recorded entries, oldest first
+      items:
+        description: |-
+          VirtualMachineTimelineEntry is an entry of the timeline of a VirtualMachine.
+          Identical entries of the same VMI are recorded once, with the number of their occurrences.
+        properties:
+          count:
+            description: Count is the number of times the entry was recorded
+            format: int32
+            type: integer
+          firstTimestamp:
+            description: FirstTimestamp is the time the entry was first recorded
+            format: date-time
+            type: string
+          lastTimestamp:
+            description: LastTimestamp is the time the entry was last recorded
+            format: date-time
+            type: string
+          message:
+            description: Message is the message of an event or a condition, or the
+              status of a condition
+            type: string
+          reason:
+            description: |-
+              Reason is the reason of an event, the phase of the VMI or of a migration,
+              or the type of a condition
+            type: string
+          type:
+            description: Type is the kind of the entry
+            enum:
+            - Event
+            - Phase
+            - Condition
+            - Migration
+            type: string
+          vmiUID:
+            description: VMIUID is the UID of the VMI the entry belongs to, empty
+              for the entries of the VirtualMachine itself
+            type: string
+          warning:
+            description: Warning is set for warning events and failures
+            type: boolean
+        required:
+        - type
+        - reason
+        - firstTimestamp
+        - lastTimestamp
+        - count
+        type: object
+      type: array
+      x-kubernetes-list-type: atomic
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+  type: object
 `,
 }
@@ -10,6 +10,8 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/pointer"
 
+	"kubevirt.io/api/breakglass"
+	breakglassv1alpha1 "kubevirt.io/api/breakglass/v1alpha1"
 	"kubevirt.io/api/clone"
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 
@@ -311,6 +313,7 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 	statusValidatePath := StatusValidatePath
 	migrationPolicyCreateValidatePath := MigrationPolicyCreateValidatePath
 	vmCloneCreateValidatePath := VMCloneCreateValidatePath
+	consoleAccessGrantValidatePath := ConsoleAccessGrantValidatePath
 	failurePolicy := admissionregistrationv1.Fail
 	ignorePolicy := admissionregistrationv1.Ignore
 
@@ -822,6 +825,31 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "console-access-grant-validator.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{breakglassv1alpha1.SchemeGroupVersion.Group},
+						APIVersions: []string{breakglassv1alpha1.SchemeGroupVersion.Version},
+						Resources:   []string{breakglass.ResourceConsoleAccessGrants},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &consoleAccessGrantValidatePath,
+					},
+				},
+			},
 		},
 	}
 }
@@ -893,3 +921,5 @@ const MigrationPolicyCreateValidatePath = "/migration-policy-validate-create"
 const VMCloneCreateValidatePath = "/vm-clone-validate-create"
 
 const VMCloneCreateMutatePath = "/vm-clone-mutate-create"
+
+const ConsoleAccessGrantValidatePath = "/console-access-grant-validate"
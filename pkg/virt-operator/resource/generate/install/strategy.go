@@ -465,6 +465,9 @@ func GenerateCurrentInstallStrategy(config *operatorutil.KubeVirtDeploymentConfi
 		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineExportCrd,
 		components.NewVirtualMachineCloneCrd,
+		components.NewGuestAgentPolicyCrd, components.NewLifecycleNotificationCrd,
+		components.NewVirtualMachineTimelineCrd, components.NewVirtualMachineDiskCheckCrd,
+		components.NewConsoleAccessGrantCrd, components.NewHostDeviceInventoryCrd,
 	}
 	for _, f := range functions {
 		crd, err := f()
@@ -24,12 +24,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"kubevirt.io/api/breakglass"
 	"kubevirt.io/api/instancetype"
 
+	"kubevirt.io/kubevirt/pkg/identitydocument"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 
 	virtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
+	"kubevirt.io/api/timeline"
 )
 
 const (
@@ -256,6 +259,39 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					timeline.GroupName,
+				},
+				Resources: []string{
+					timeline.ResourceVirtualMachineTimelines,
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
+			{
+				APIGroups: []string{
+					breakglass.GroupName,
+				},
+				Resources: []string{
+					breakglass.ResourceConsoleAccessGrants,
+				},
+				Verbs: []string{
+					"get", "list",
+				},
+			},
+			{
+				APIGroups: []string{
+					breakglass.GroupName,
+				},
+				Resources: []string{
+					breakglass.ResourceConsoleAccessGrants + "/status",
+				},
+				Verbs: []string{
+					"update", "patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"apps",
@@ -352,6 +388,20 @@ func newApiServerRole(namespace string) *rbacv1.Role {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"secrets",
+				},
+				ResourceNames: []string{
+					identitydocument.KeySecretName,
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 		},
 	}
 }
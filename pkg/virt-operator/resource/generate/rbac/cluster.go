@@ -24,9 +24,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"kubevirt.io/api/clone"
+	"kubevirt.io/api/diskcheck"
 	"kubevirt.io/api/export"
+	"kubevirt.io/api/guestagent"
+	"kubevirt.io/api/notifications"
 	"kubevirt.io/api/pool"
 	"kubevirt.io/api/snapshot"
+	"kubevirt.io/api/timeline"
 
 	"kubevirt.io/api/instancetype"
 
@@ -37,11 +41,14 @@ import (
 
 const (
 	defaultClusterRoleName          = "kubevirt.io:default"
+	portalClusterRoleName           = "kubevirt.io:portal"
 	instancetypeViewClusterRoleName = "instancetype.kubevirt.io:view"
 
 	apiVersion            = "version"
 	apiGuestFs            = "guestfs"
+	apiCapabilities       = "capabilities"
 	apiExpandVmSpec       = "expand-vm-spec"
+	apiSearch             = "search"
 	apiKubevirts          = "kubevirts"
 	apiVM                 = "virtualmachines"
 	apiVMInstances        = "virtualmachineinstances"
@@ -56,6 +63,8 @@ const (
 	apiVMPools            = "virtualmachinepools"
 
 	apiVMExpandSpec   = "virtualmachines/expand-spec"
+	apiVMPortalView   = "virtualmachines/portalview"
+	apiVMTimeline     = "virtualmachines/timeline"
 	apiVMPortForward  = "virtualmachines/portforward"
 	apiVMStart        = "virtualmachines/start"
 	apiVMStop         = "virtualmachines/stop"
@@ -64,6 +73,8 @@ const (
 	apiVMRemoveVolume = "virtualmachines/removevolume"
 	apiVMMigrate      = "virtualmachines/migrate"
 	apiVMMemoryDump   = "virtualmachines/memorydump"
+	apiVMRenewLease   = "virtualmachines/renewlease"
+	apiVMModernize    = "virtualmachines/modernize"
 
 	apiVMInstancesConsole                   = "virtualmachineinstances/console"
 	apiVMInstancesVNC                       = "virtualmachineinstances/vnc"
@@ -73,17 +84,22 @@ const (
 	apiVMInstancesUnpause                   = "virtualmachineinstances/unpause"
 	apiVMInstancesAddVolume                 = "virtualmachineinstances/addvolume"
 	apiVMInstancesRemoveVolume              = "virtualmachineinstances/removevolume"
+	apiVMInstancesAddChannel                = "virtualmachineinstances/addchannel"
+	apiVMInstancesFlatten                   = "virtualmachineinstances/flatten"
 	apiVMInstancesFreeze                    = "virtualmachineinstances/freeze"
 	apiVMInstancesUnfreeze                  = "virtualmachineinstances/unfreeze"
 	apiVMInstancesSoftReboot                = "virtualmachineinstances/softreboot"
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
 	apiVMInstancesUserList                  = "virtualmachineinstances/userlist"
+	apiVMInstancesDevices                   = "virtualmachineinstances/devices"
+	apiVMInstancesPortalView                = "virtualmachineinstances/portalview"
 	apiVMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
 	apiVMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
 	apiVMInstancesSEVInjectLaunchSecret     = "virtualmachineinstances/sev/injectlaunchsecret"
 	apiVMInstancesUSBRedir                  = "virtualmachineinstances/usbredir"
+	apiVMInstancesVerifyIdentity            = "virtualmachineinstances/verifyidentity"
 )
 
 func GetAllCluster() []runtime.Object {
@@ -93,6 +109,7 @@ func GetAllCluster() []runtime.Object {
 		newAdminClusterRole(),
 		newEditClusterRole(),
 		newViewClusterRole(),
+		newPortalClusterRole(),
 		newInstancetypeViewClusterRole(),
 		newInstancetypeViewClusterRoleBinding(),
 	}
@@ -133,6 +150,7 @@ func newDefaultClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVersion,
 					apiGuestFs,
+					apiCapabilities,
 				},
 				Verbs: []string{
 					"get", "list",
@@ -198,6 +216,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesDevices,
+					apiVMInstancesPortalView,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
@@ -215,11 +235,14 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUnpause,
 					apiVMInstancesAddVolume,
 					apiVMInstancesRemoveVolume,
+					apiVMInstancesAddChannel,
+					apiVMInstancesFlatten,
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
+					apiVMInstancesVerifyIdentity,
 				},
 				Verbs: []string{
 					"update",
@@ -232,6 +255,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMExpandSpec,
 					apiVMPortForward,
+					apiVMPortalView,
+					apiVMTimeline,
 				},
 				Verbs: []string{
 					"get",
@@ -249,6 +274,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMRemoveVolume,
 					apiVMMigrate,
 					apiVMMemoryDump,
+					apiVMRenewLease,
+					apiVMModernize,
 				},
 				Verbs: []string{
 					"update",
@@ -265,6 +292,17 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiSearch,
+				},
+				Verbs: []string{
+					"get", "list",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -351,6 +389,50 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					guestagent.GroupName,
+				},
+				Resources: []string{
+					guestagent.ResourceGuestAgentPolicies,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					notifications.GroupName,
+				},
+				Resources: []string{
+					notifications.ResourceLifecycleNotifications,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					timeline.GroupName,
+				},
+				Resources: []string{
+					timeline.ResourceVirtualMachineTimelines,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					diskcheck.GroupName,
+				},
+				Resources: []string{
+					diskcheck.ResourceVirtualMachineDiskChecks,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
 		},
 	}
 }
@@ -381,6 +463,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesDevices,
+					apiVMInstancesPortalView,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
@@ -398,11 +482,14 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUnpause,
 					apiVMInstancesAddVolume,
 					apiVMInstancesRemoveVolume,
+					apiVMInstancesAddChannel,
+					apiVMInstancesFlatten,
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
+					apiVMInstancesVerifyIdentity,
 				},
 				Verbs: []string{
 					"update",
@@ -415,6 +502,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMExpandSpec,
 					apiVMPortForward,
+					apiVMPortalView,
+					apiVMTimeline,
 				},
 				Verbs: []string{
 					"get",
@@ -432,6 +521,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMRemoveVolume,
 					apiVMMigrate,
 					apiVMMemoryDump,
+					apiVMRenewLease,
+					apiVMModernize,
 				},
 				Verbs: []string{
 					"update",
@@ -448,6 +539,17 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiSearch,
+				},
+				Verbs: []string{
+					"get", "list",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -545,6 +647,50 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					guestagent.GroupName,
+				},
+				Resources: []string{
+					guestagent.ResourceGuestAgentPolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					notifications.GroupName,
+				},
+				Resources: []string{
+					notifications.ResourceLifecycleNotifications,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					timeline.GroupName,
+				},
+				Resources: []string{
+					timeline.ResourceVirtualMachineTimelines,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					diskcheck.GroupName,
+				},
+				Resources: []string{
+					diskcheck.ResourceVirtualMachineDiskChecks,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
 		},
 	}
 }
@@ -580,9 +726,13 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMExpandSpec,
+					apiVMPortalView,
+					apiVMTimeline,
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesDevices,
+					apiVMInstancesPortalView,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 				},
@@ -601,6 +751,17 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiSearch,
+				},
+				Verbs: []string{
+					"get", "list",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -687,9 +848,87 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					guestagent.GroupName,
+				},
+				Resources: []string{
+					guestagent.ResourceGuestAgentPolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					notifications.GroupName,
+				},
+				Resources: []string{
+					notifications.ResourceLifecycleNotifications,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					timeline.GroupName,
+				},
+				Resources: []string{
+					timeline.ResourceVirtualMachineTimelines,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					diskcheck.GroupName,
+				},
+				Resources: []string{
+					diskcheck.ResourceVirtualMachineDiskChecks,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
+
+// newPortalClusterRole is meant to be bound to the end users of portals, who should not get the
+// VirtualMachineInstances themselves since they expose infrastructure details like node names.
+func newPortalClusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: VersionNamev1,
+			Kind:       "ClusterRole",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: portalClusterRoleName,
+			Labels: map[string]string{
+				virtv1.AppLabel: "",
+			},
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiVMPortalView,
+					apiVMInstancesPortalView,
+					apiVMInstancesConsole,
+					apiVMInstancesVNC,
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
+		},
+	}
+}
+
 func newInstancetypeViewClusterRole() *rbacv1.ClusterRole {
 	return &rbacv1.ClusterRole{
 		TypeMeta: metav1.TypeMeta{
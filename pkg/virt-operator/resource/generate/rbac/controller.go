@@ -24,7 +24,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"kubevirt.io/api/breakglass"
 	"kubevirt.io/api/clone"
+	"kubevirt.io/api/diskcheck"
 
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 
@@ -32,6 +34,8 @@ import (
 
 	virtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
+	"kubevirt.io/api/notifications"
+	"kubevirt.io/api/timeline"
 )
 
 func GetAllController(namespace string) []runtime.Object {
@@ -205,6 +209,28 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "delete", "update", "create", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"discovery.k8s.io",
+				},
+				Resources: []string{
+					"endpointslices",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "delete", "update", "create",
+				},
+			},
+			{
+				APIGroups: []string{
+					"gateway.networking.k8s.io",
+				},
+				Resources: []string{
+					"httproutes", "tcproutes",
+				},
+				Verbs: []string{
+					"get", "delete", "update", "create",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -213,7 +239,7 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"events",
 				},
 				Verbs: []string{
-					"update", "create", "patch",
+					"update", "create", "patch", "list", "watch",
 				},
 			},
 			{
@@ -225,6 +251,7 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 				},
 				Verbs: []string{
 					"create",
+					"update",
 				},
 			},
 			{
@@ -396,6 +423,8 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/freeze",
 					"virtualmachineinstances/unfreeze",
 					"virtualmachineinstances/softreboot",
+					"virtualmachineinstances/pause",
+					"virtualmachineinstances/unpause",
 					"virtualmachineinstances/sev/setupsession",
 					"virtualmachineinstances/sev/injectlaunchsecret",
 				},
@@ -527,6 +556,77 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "update", "patch", "delete",
 				},
 			},
+			{
+				APIGroups: []string{
+					notifications.GroupName,
+				},
+				Resources: []string{
+					notifications.ResourceLifecycleNotifications,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					timeline.GroupName,
+				},
+				Resources: []string{
+					timeline.ResourceVirtualMachineTimelines,
+				},
+				Verbs: []string{
+					"get", "list", "watch", "create", "update", "patch", "delete",
+				},
+			},
+			{
+				APIGroups: []string{
+					diskcheck.GroupName,
+				},
+				Resources: []string{
+					diskcheck.ResourceVirtualMachineDiskChecks,
+					diskcheck.ResourceVirtualMachineDiskChecks + "/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "create", "update", "patch", "delete",
+				},
+			},
+			{
+				APIGroups: []string{
+					breakglass.GroupName,
+				},
+				Resources: []string{
+					breakglass.ResourceConsoleAccessGrants,
+					breakglass.ResourceConsoleAccessGrants + "/status",
+					breakglass.ResourceConsoleAccessGrants + "/finalizers",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"rbac.authorization.k8s.io",
+				},
+				Resources: []string{
+					"roles",
+					"rolebindings",
+				},
+				Verbs: []string{
+					"get", "create", "delete",
+				},
+			},
+			{
+				APIGroups: []string{
+					"subresources.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineinstances/console",
+					"virtualmachineinstances/vnc",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
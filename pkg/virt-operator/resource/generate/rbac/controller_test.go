@@ -50,6 +50,7 @@ var _ = Describe("RBAC", func() {
 				})), "appropriate rule for finalizers not found",
 			)
 		},
+			Entry("for consoleaccessgrants", "breakglass.kubevirt.io", "consoleaccessgrants"),
 			Entry("for vmclones", "clone.kubevirt.io", "virtualmachineclones"),
 			Entry("for vmexports", "export.kubevirt.io", "virtualmachineexports"),
 			Entry("for vmpools", "pool.kubevirt.io", "virtualmachinepools"),
@@ -26,6 +26,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/guestagent"
+	"kubevirt.io/api/hostdevices"
 	"kubevirt.io/api/migrations"
 
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
@@ -95,6 +97,17 @@ func newHandlerClusterRole() *rbacv1.ClusterRole {
 					"get",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"nodes/status",
+				},
+				Verbs: []string{
+					"patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -156,6 +169,39 @@ func newHandlerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					guestagent.GroupName,
+				},
+				Resources: []string{
+					guestagent.ResourceGuestAgentPolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					hostdevices.GroupName,
+				},
+				Resources: []string{
+					hostdevices.ResourceHostDeviceInventories,
+				},
+				Verbs: []string{
+					"get", "create", "update",
+				},
+			},
+			{
+				APIGroups: []string{
+					hostdevices.GroupName,
+				},
+				Resources: []string{
+					hostdevices.ResourceHostDeviceInventories + "/status",
+				},
+				Verbs: []string{
+					"update",
+				},
+			},
 		},
 	}
 }
@@ -301,6 +301,17 @@ func NewOperatorClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "create", "delete", "update", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"cert-manager.io",
+				},
+				Resources: []string{
+					"certificates",
+				},
+				Verbs: []string{
+					"get", "create", "update", "deletecollection",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -23,6 +23,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"slices"
 	"strconv"
 
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
@@ -43,7 +45,10 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/externalpolicy"
+	"kubevirt.io/kubevirt/pkg/monitoring/rules/alerts"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
@@ -79,6 +84,16 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 	results = append(results, validateCustomizeComponents(newKV.Spec.CustomizeComponents)...)
 	results = append(results, validateCertificates(newKV.Spec.CertificateRotationStrategy.SelfSigned)...)
 	results = append(results, validateGuestToRequestHeadroom(newKV.Spec.Configuration.AdditionalGuestMemoryOverheadRatio)...)
+	results = append(results, validateStorageHealthCheck(field.NewPath("spec").Child("configuration", "storageHealthCheck"), newKV.Spec.Configuration.StorageHealthCheck)...)
+	results = append(results, validateExternalPolicyServices(field.NewPath("spec").Child("configuration", "externalPolicyServices"), newKV.Spec.Configuration.ExternalPolicyServices)...)
+	results = append(results, validateTenantNodePools(field.NewPath("spec").Child("configuration", "tenantNodePools"), newKV.Spec.Configuration.TenantNodePools)...)
+	results = append(results, validateVirtualizationInfraReservation(field.NewPath("spec").Child("configuration", "virtualizationInfraReservation"), newKV.Spec.Configuration.VirtualizationInfraReservation)...)
+	results = append(results, validateInfraThreadsPinning(field.NewPath("spec").Child("configuration", "infraThreadsPinning"), newKV.Spec.Configuration.InfraThreadsPinning)...)
+	results = append(results, validateHugepagesPools(field.NewPath("spec").Child("configuration", "hugepagesPools"), newKV.Spec.Configuration.HugepagesPools)...)
+	results = append(results, validateLauncherImageOverrides(field.NewPath("spec").Child("configuration", "launcherImageOverrides"), newKV.Spec.Configuration.LauncherImageOverrides)...)
+	results = append(results, validateAdmissionWarnings(field.NewPath("spec").Child("configuration", "admissionWarnings"), newKV.Spec.Configuration.AdmissionWarnings)...)
+	results = append(results, validateAlerts(field.NewPath("spec").Child("alerts"), newKV.Spec.Alerts)...)
+	results = append(results, validateSubresourceAccessWebhook(field.NewPath("spec").Child("configuration", "subresourceAccessWebhook"), newKV.Spec.Configuration.SubresourceAccessWebhook)...)
 
 	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.TLSConfiguration, newKV.Spec.Configuration.TLSConfiguration) {
 		if newKV.Spec.Configuration.TLSConfiguration != nil {
@@ -244,6 +259,10 @@ func validateTLSConfiguration(tlsConfiguration *v1.TLSConfiguration) []metav1.St
 		return statuses
 	}
 
+	if tlsConfiguration.FIPSMode {
+		statuses = append(statuses, validateFIPSTLSConfiguration(tlsConfiguration)...)
+	}
+
 	if tlsConfiguration.MinTLSVersion == v1.VersionTLS13 || tlsConfiguration.MinTLSVersion == "" {
 		if len(tlsConfiguration.Ciphers) > 0 {
 			statuses = append(statuses, metav1.StatusCause{
@@ -273,6 +292,31 @@ func validateTLSConfiguration(tlsConfiguration *v1.TLSConfiguration) []metav1.St
 	return statuses
 }
 
+func validateFIPSTLSConfiguration(tlsConfiguration *v1.TLSConfiguration) []metav1.StatusCause {
+	var statuses []metav1.StatusCause
+
+	if tlsConfiguration.MinTLSVersion != "" && tlsConfiguration.MinTLSVersion != v1.VersionTLS12 {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "Only VersionTLS12 is supported when spec.configuration.tlsConfiguration.fipsMode is enabled",
+			Field:   "spec.configuration.tlsConfiguration.minTLSVersion",
+		})
+	}
+
+	var idByName = kvtls.CipherSuiteNameMap()
+	for index, cipher := range tlsConfiguration.Ciphers {
+		if id, exists := idByName[cipher]; exists && !kvtls.IsFIPSApprovedCipherSuite(id) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not a FIPS approved cipher", cipher),
+				Field:   fmt.Sprintf("spec.configuration.tlsConfiguration.ciphers#%d", index),
+			})
+		}
+	}
+
+	return statuses
+}
+
 func validateSeccompConfiguration(field *field.Path, seccompConf *v1.SeccompConfiguration) []metav1.StatusCause {
 	statuses := []metav1.StatusCause{}
 	if seccompConf == nil || seccompConf.VirtualMachineInstanceProfile == nil {
@@ -485,3 +529,326 @@ func validateGuestToRequestHeadroom(ratioStrPtr *string) (causes []metav1.Status
 
 	return
 }
+
+func validateStorageHealthCheck(field *field.Path, config *v1.StorageHealthCheckConfiguration) (causes []metav1.StatusCause) {
+	if config == nil {
+		return
+	}
+
+	nonPositive := func(name string) metav1.StatusCause {
+		return metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0", field.Child(name).String()),
+			Field:   field.Child(name).String(),
+		}
+	}
+
+	if config.Interval != nil && config.Interval.Duration <= 0 {
+		causes = append(causes, nonPositive("interval"))
+	}
+	if config.Timeout != nil && config.Timeout.Duration <= 0 {
+		causes = append(causes, nonPositive("timeout"))
+	}
+	if config.LatencyThreshold != nil && config.LatencyThreshold.Duration <= 0 {
+		causes = append(causes, nonPositive("latencyThreshold"))
+	}
+	if config.FailureThreshold != nil && *config.FailureThreshold == 0 {
+		causes = append(causes, nonPositive("failureThreshold"))
+	}
+
+	return
+}
+
+func validateExternalPolicyServices(path *field.Path, services []v1.ExternalPolicyService) (causes []metav1.StatusCause) {
+	invalid := func(f *field.Path, message string) metav1.StatusCause {
+		return metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s %s", f.String(), message),
+			Field:   f.String(),
+		}
+	}
+
+	for i, service := range services {
+		f := path.Index(i)
+		if service.Name == "" {
+			causes = append(causes, invalid(f.Child("name"), "must not be empty"))
+		}
+		if u, err := url.Parse(service.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+			causes = append(causes, invalid(f.Child("url"), "must be a valid https URL"))
+		}
+		if len(service.Hooks) == 0 {
+			causes = append(causes, invalid(f.Child("hooks"), "must contain at least one hook"))
+		}
+		if service.TimeoutSeconds != nil && (*service.TimeoutSeconds < 1 || *service.TimeoutSeconds > externalpolicy.MaxTimeoutSeconds) {
+			causes = append(causes, invalid(f.Child("timeoutSeconds"), fmt.Sprintf("must be between 1 and %d", externalpolicy.MaxTimeoutSeconds)))
+		}
+	}
+
+	return
+}
+
+func validateTenantNodePools(path *field.Path, pools []v1.TenantNodePool) (causes []metav1.StatusCause) {
+	for i, pool := range pools {
+		f := path.Index(i)
+		if pool.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be empty", f.Child("name").String()),
+				Field:   f.Child("name").String(),
+			})
+		}
+		if _, err := metav1.LabelSelectorAsSelector(&pool.NamespaceSelector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is invalid: %v", f.Child("namespaceSelector").String(), err),
+				Field:   f.Child("namespaceSelector").String(),
+			})
+		}
+		if len(pool.NodeSelector) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be empty", f.Child("nodeSelector").String()),
+				Field:   f.Child("nodeSelector").String(),
+			})
+		}
+	}
+
+	return
+}
+
+func validateVirtualizationInfraReservation(path *field.Path, reservation *v1.VirtualizationInfraReservation) (causes []metav1.StatusCause) {
+	if reservation == nil {
+		return
+	}
+
+	if reservation.CPUs != "" {
+		if _, err := hardware.ParseCPUSetLine(reservation.CPUs, virtconfig.MaxVirtualizationInfraReservedCPUs); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid CPU list: %v", path.Child("cpus").String(), err),
+				Field:   path.Child("cpus").String(),
+			})
+		}
+	}
+	if reservation.Memory != nil && reservation.Memory.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be negative", path.Child("memory").String()),
+			Field:   path.Child("memory").String(),
+		})
+	}
+
+	return
+}
+
+func validateInfraThreadsPinning(path *field.Path, pinning *v1.InfraThreadsPinning) (causes []metav1.StatusCause) {
+	if pinning == nil {
+		return
+	}
+
+	switch pinning.IOThreads {
+	case "", v1.IOThreadsPinningVCPUs, v1.IOThreadsPinningEmulatorThread:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be one of %s, %s", path.Child("ioThreads").String(),
+				v1.IOThreadsPinningVCPUs, v1.IOThreadsPinningEmulatorThread),
+			Field: path.Child("ioThreads").String(),
+		})
+	}
+
+	for i, pool := range pinning.HousekeepingCPUs {
+		f := path.Child("housekeepingCPUs").Index(i)
+		if pool.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be empty", f.Child("name").String()),
+				Field:   f.Child("name").String(),
+			})
+		}
+		if len(pool.NodeSelector) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be empty", f.Child("nodeSelector").String()),
+				Field:   f.Child("nodeSelector").String(),
+			})
+		}
+		if _, err := hardware.ParseCPUSetLine(pool.CPUs, virtconfig.MaxVirtualizationInfraReservedCPUs); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid CPU list: %v", f.Child("cpus").String(), err),
+				Field:   f.Child("cpus").String(),
+			})
+		}
+	}
+
+	return
+}
+
+func validateHugepagesPools(path *field.Path, pools []v1.HugepagesPool) (causes []metav1.StatusCause) {
+	for i, pool := range pools {
+		f := path.Index(i)
+		if pool.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be empty", f.Child("name").String()),
+				Field:   f.Child("name").String(),
+			})
+		}
+		switch pool.PageSize {
+		case "2Mi", "1Gi":
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s must be one of 2Mi, 1Gi", f.Child("pageSize").String()),
+				Field:   f.Child("pageSize").String(),
+			})
+		}
+		if pool.Min > pool.Max {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be greater than %s", f.Child("min").String(), f.Child("max").String()),
+				Field:   f.Child("min").String(),
+			})
+		}
+	}
+
+	return
+}
+
+func validateLauncherImageOverrides(path *field.Path, overrides []v1.LauncherImageOverride) (causes []metav1.StatusCause) {
+	for i, override := range overrides {
+		f := path.Index(i)
+		if override.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be empty", f.Child("name").String()),
+				Field:   f.Child("name").String(),
+			})
+		}
+		// an empty node selector would pin the image of all the VMIs
+		if len(override.NodeSelector) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be empty", f.Child("nodeSelector").String()),
+				Field:   f.Child("nodeSelector").String(),
+			})
+		}
+		if override.Image == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be empty", f.Child("image").String()),
+				Field:   f.Child("image").String(),
+			})
+		}
+	}
+
+	return
+}
+
+func validateAdmissionWarnings(path *field.Path, config *v1.AdmissionWarningsConfiguration) (causes []metav1.StatusCause) {
+	if config == nil {
+		return
+	}
+	for i, warning := range config.Disabled {
+		switch warning {
+		case v1.AdmissionWarningSATABus, v1.AdmissionWarningDedicatedCPUsWithoutEvictionStrategy:
+		default:
+			f := path.Child("disabled").Index(i)
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s: unknown admission warning %s", f.String(), warning),
+				Field:   f.String(),
+			})
+		}
+	}
+
+	return
+}
+
+// interactiveSubresources are the subresources the subresource access webhook can be consulted for
+var interactiveSubresources = []string{"console", "vnc", "usbredir", "portforward", "vsock"}
+
+func validateSubresourceAccessWebhook(path *field.Path, config *v1.SubresourceAccessWebhook) (causes []metav1.StatusCause) {
+	if config == nil {
+		return
+	}
+
+	if u, err := url.Parse(config.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+		f := path.Child("url")
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s: %q is not an https URL", f.String(), config.URL),
+			Field:   f.String(),
+		})
+	}
+	for i, subresource := range config.Subresources {
+		if !slices.Contains(interactiveSubresources, subresource) {
+			f := path.Child("subresources").Index(i)
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s: unsupported subresource %s, supported ones are %v", f.String(), subresource, interactiveSubresources),
+				Field:   f.String(),
+			})
+		}
+	}
+	if config.TimeoutSeconds != nil && *config.TimeoutSeconds <= 0 {
+		f := path.Child("timeoutSeconds")
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s: has to be greater than zero", f.String()),
+			Field:   f.String(),
+		})
+	}
+	if config.FailurePolicy != nil {
+		switch *config.FailurePolicy {
+		case v1.SubresourceAccessFailurePolicyFail, v1.SubresourceAccessFailurePolicyIgnore:
+		default:
+			f := path.Child("failurePolicy")
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s: unsupported failure policy %s", f.String(), *config.FailurePolicy),
+				Field:   f.String(),
+			})
+		}
+	}
+
+	return
+}
+
+func validateAlerts(path *field.Path, config *v1.AlertsConfiguration) (causes []metav1.StatusCause) {
+	if config == nil {
+		return
+	}
+
+	notFound := func(f *field.Path, name string) metav1.StatusCause {
+		return metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: fmt.Sprintf("%s: %s is not a bundled alert", f.String(), name),
+			Field:   f.String(),
+		}
+	}
+
+	for i, name := range config.Disabled {
+		if _, exists := alerts.Find(name); !exists {
+			causes = append(causes, notFound(path.Child("disabled").Index(i), name))
+		}
+	}
+	for i, override := range config.Overrides {
+		f := path.Child("overrides").Index(i)
+		alert, exists := alerts.Find(override.Alert)
+		if !exists {
+			causes = append(causes, notFound(f.Child("alert"), override.Alert))
+			continue
+		}
+		if err := alerts.Override(alert, override); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is invalid: %v", f.Child("threshold").String(), err),
+				Field:   f.Child("threshold").String(),
+			})
+		}
+	}
+
+	return
+}
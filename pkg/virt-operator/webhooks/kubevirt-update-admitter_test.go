@@ -30,6 +30,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -137,6 +138,43 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 				1,
 			),
 		)
+
+		DescribeTable("in FIPS mode should reject", func(tlsConfiguration *v1.TLSConfiguration, expectedErrorMessage, expectedField string) {
+			tlsConfiguration.FIPSMode = true
+			causes := validateTLSConfiguration(tlsConfiguration)
+
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(Equal(expectedErrorMessage))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("minTLSVersion = 1.3",
+				&v1.TLSConfiguration{MinTLSVersion: v1.VersionTLS13},
+				"Only VersionTLS12 is supported when spec.configuration.tlsConfiguration.fipsMode is enabled",
+				"spec.configuration.tlsConfiguration.minTLSVersion",
+			),
+			Entry("minTLSVersion = 1.1",
+				&v1.TLSConfiguration{MinTLSVersion: v1.VersionTLS11},
+				"Only VersionTLS12 is supported when spec.configuration.tlsConfiguration.fipsMode is enabled",
+				"spec.configuration.tlsConfiguration.minTLSVersion",
+			),
+			Entry("a cipher which is not FIPS approved",
+				&v1.TLSConfiguration{
+					MinTLSVersion: v1.VersionTLS12,
+					Ciphers:       []string{tls.CipherSuiteName(tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256), tls.CipherSuiteName(tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256)},
+				},
+				"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256 is not a FIPS approved cipher",
+				"spec.configuration.tlsConfiguration.ciphers#1",
+			),
+		)
+
+		It("in FIPS mode should accept FIPS approved ciphers", func() {
+			causes := validateTLSConfiguration(&v1.TLSConfiguration{
+				FIPSMode:      true,
+				MinTLSVersion: v1.VersionTLS12,
+				Ciphers:       []string{tls.CipherSuiteName(tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384)},
+			})
+			Expect(causes).To(BeEmpty())
+		})
 	})
 
 	Context("with AdditionalGuestMemoryOverheadRatio", func() {
@@ -165,6 +203,237 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		)
 	})
 
+	DescribeTable("validateStorageHealthCheck", func(config *v1.StorageHealthCheckConfiguration, expectedFields []string) {
+		causes := validateStorageHealthCheck(test, config)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(ConsistOf(expectedFields))
+	},
+		Entry("should accept an unset configuration", nil, []string{}),
+		Entry("should accept positive values", &v1.StorageHealthCheckConfiguration{
+			Interval:         &metav1.Duration{Duration: 1},
+			Timeout:          &metav1.Duration{Duration: 1},
+			LatencyThreshold: &metav1.Duration{Duration: 1},
+			FailureThreshold: pointer.P(uint32(1)),
+		}, []string{}),
+		Entry("should reject zero and negative values", &v1.StorageHealthCheckConfiguration{
+			Interval:         &metav1.Duration{Duration: 0},
+			Timeout:          &metav1.Duration{Duration: -1},
+			LatencyThreshold: &metav1.Duration{Duration: 0},
+			FailureThreshold: pointer.P(uint32(0)),
+		}, []string{"test.interval", "test.timeout", "test.latencyThreshold", "test.failureThreshold"}),
+	)
+
+	DescribeTable("validateExternalPolicyServices", func(services []v1.ExternalPolicyService, expectedFields []string) {
+		causes := validateExternalPolicyServices(test, services)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(ConsistOf(expectedFields))
+	},
+		Entry("should accept no services", nil, []string{}),
+		Entry("should accept a valid service", []v1.ExternalPolicyService{{
+			Name:           "licensing",
+			URL:            "https://licensing.example.com/review",
+			Hooks:          []v1.ExternalPolicyHook{v1.ExternalPolicyHookMigrationTargetSelection},
+			TimeoutSeconds: pointer.P(int32(10)),
+		}}, []string{}),
+		Entry("should reject missing fields", []v1.ExternalPolicyService{{}}, []string{"test[0].name", "test[0].url", "test[0].hooks"}),
+		Entry("should reject a plain http URL and an out of range timeout", []v1.ExternalPolicyService{{
+			Name:           "licensing",
+			URL:            "http://licensing.example.com/review",
+			Hooks:          []v1.ExternalPolicyHook{v1.ExternalPolicyHookVirtualMachineAdmission},
+			TimeoutSeconds: pointer.P(int32(30)),
+		}}, []string{"test[0].url", "test[0].timeoutSeconds"}),
+	)
+
+	DescribeTable("validateTenantNodePools", func(pools []v1.TenantNodePool, expectedFields []string) {
+		causes := validateTenantNodePools(test, pools)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(ConsistOf(expectedFields))
+	},
+		Entry("should accept no pools", nil, []string{}),
+		Entry("should accept a valid pool", []v1.TenantNodePool{{
+			Name:              "tenant-a",
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}},
+			NodeSelector:      map[string]string{"pool": "tenant-a"},
+		}}, []string{}),
+		Entry("should reject missing fields", []v1.TenantNodePool{{}}, []string{"test[0].name", "test[0].nodeSelector"}),
+		Entry("should reject an invalid namespace selector", []v1.TenantNodePool{{
+			Name: "tenant-a",
+			NamespaceSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key: "tenant", Operator: "Unknown",
+			}}},
+			NodeSelector: map[string]string{"pool": "tenant-a"},
+		}}, []string{"test[0].namespaceSelector"}),
+	)
+
+	DescribeTable("validateVirtualizationInfraReservation", func(reservation *v1.VirtualizationInfraReservation, expectedFields []string) {
+		causes := validateVirtualizationInfraReservation(test, reservation)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(ConsistOf(expectedFields))
+	},
+		Entry("should accept no reservation", nil, []string{}),
+		Entry("should accept a valid reservation", &v1.VirtualizationInfraReservation{
+			CPUs:   "0-1,8",
+			Memory: pointer.P(resource.MustParse("2Gi")),
+		}, []string{}),
+		Entry("should reject a malformed CPU list", &v1.VirtualizationInfraReservation{CPUs: "0-a"}, []string{"test.cpus"}),
+		Entry("should reject a negative memory", &v1.VirtualizationInfraReservation{Memory: pointer.P(resource.MustParse("-1Gi"))}, []string{"test.memory"}),
+	)
+
+	DescribeTable("validateInfraThreadsPinning", func(pinning *v1.InfraThreadsPinning, expectedFields []string) {
+		causes := validateInfraThreadsPinning(test, pinning)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(ConsistOf(expectedFields))
+	},
+		Entry("should accept no policy", nil, []string{}),
+		Entry("should accept a valid policy", &v1.InfraThreadsPinning{
+			IsolateEmulatorThread: true,
+			IOThreads:             v1.IOThreadsPinningEmulatorThread,
+			HousekeepingCPUs: []v1.HousekeepingCPUPool{{
+				Name:         "infra",
+				NodeSelector: map[string]string{"pool": "infra"},
+				CPUs:         "0-1,8",
+			}},
+		}, []string{}),
+		Entry("should reject an unknown iothreads policy", &v1.InfraThreadsPinning{IOThreads: "Spread"}, []string{"test.ioThreads"}),
+		Entry("should reject missing pool fields", &v1.InfraThreadsPinning{
+			HousekeepingCPUs: []v1.HousekeepingCPUPool{{}},
+		}, []string{"test.housekeepingCPUs[0].name", "test.housekeepingCPUs[0].nodeSelector", "test.housekeepingCPUs[0].cpus"}),
+		Entry("should reject a malformed CPU list", &v1.InfraThreadsPinning{
+			HousekeepingCPUs: []v1.HousekeepingCPUPool{{
+				Name:         "infra",
+				NodeSelector: map[string]string{"pool": "infra"},
+				CPUs:         "0-a",
+			}},
+		}, []string{"test.housekeepingCPUs[0].cpus"}),
+	)
+
+	DescribeTable("validateHugepagesPools", func(pools []v1.HugepagesPool, expectedFields []string) {
+		causes := validateHugepagesPools(test, pools)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(ConsistOf(expectedFields))
+	},
+		Entry("should accept no pool", nil, []string{}),
+		Entry("should accept valid pools", []v1.HugepagesPool{
+			{Name: "large", NodeSelector: map[string]string{"pool": "large"}, PageSize: "1Gi", Min: 4, Max: 64},
+			{Name: "default", PageSize: "2Mi", Max: 4096},
+		}, []string{}),
+		Entry("should reject missing pool fields", []v1.HugepagesPool{{}}, []string{"test[0].name", "test[0].pageSize"}),
+		Entry("should reject an unsupported page size", []v1.HugepagesPool{{Name: "default", PageSize: "16Gi", Max: 1}}, []string{"test[0].pageSize"}),
+		Entry("should reject a min above the max", []v1.HugepagesPool{{Name: "default", PageSize: "1Gi", Min: 8, Max: 4}}, []string{"test[0].min"}),
+	)
+
+	DescribeTable("validateLauncherImageOverrides", func(overrides []v1.LauncherImageOverride, expectedFields []string) {
+		causes := validateLauncherImageOverrides(test, overrides)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(ConsistOf(expectedFields))
+	},
+		Entry("should accept no override", nil, []string{}),
+		Entry("should accept valid overrides", []v1.LauncherImageOverride{
+			{Name: "canary", NodeSelector: map[string]string{"pool": "canary"}, Image: "registry:5000/kubevirt/virt-launcher:canary"},
+		}, []string{}),
+		Entry("should reject missing override fields", []v1.LauncherImageOverride{{}}, []string{"test[0].name", "test[0].nodeSelector", "test[0].image"}),
+	)
+
+	DescribeTable("validateAdmissionWarnings", func(config *v1.AdmissionWarningsConfiguration, expectedFields []string) {
+		causes := validateAdmissionWarnings(test, config)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(ConsistOf(expectedFields))
+	},
+		Entry("should accept no configuration", nil, []string{}),
+		Entry("should accept known warnings", &v1.AdmissionWarningsConfiguration{
+			Disabled: []v1.AdmissionWarning{v1.AdmissionWarningSATABus, v1.AdmissionWarningDedicatedCPUsWithoutEvictionStrategy},
+		}, []string{}),
+		Entry("should reject unknown warnings", &v1.AdmissionWarningsConfiguration{
+			Disabled: []v1.AdmissionWarning{v1.AdmissionWarningSATABus, "Unknown"},
+		}, []string{"test.disabled[1]"}),
+	)
+
+	DescribeTable("validateSubresourceAccessWebhook", func(config *v1.SubresourceAccessWebhook, expectedFields []string) {
+		causes := validateSubresourceAccessWebhook(test, config)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(ConsistOf(expectedFields))
+	},
+		Entry("should accept no configuration", nil, []string{}),
+		Entry("should accept a valid configuration", &v1.SubresourceAccessWebhook{
+			URL:            "https://access.example.com/review",
+			Subresources:   []string{"console", "vnc"},
+			TimeoutSeconds: pointer.P(int32(5)),
+			FailurePolicy:  pointer.P(v1.SubresourceAccessFailurePolicyIgnore),
+		}, []string{}),
+		Entry("should reject a plain http URL", &v1.SubresourceAccessWebhook{
+			URL: "http://access.example.com/review",
+		}, []string{"test.url"}),
+		Entry("should reject unknown subresources", &v1.SubresourceAccessWebhook{
+			URL:          "https://access.example.com/review",
+			Subresources: []string{"console", "guestosinfo"},
+		}, []string{"test.subresources[1]"}),
+		Entry("should reject a timeout of zero", &v1.SubresourceAccessWebhook{
+			URL:            "https://access.example.com/review",
+			TimeoutSeconds: pointer.P(int32(0)),
+		}, []string{"test.timeoutSeconds"}),
+		Entry("should reject an unknown failure policy", &v1.SubresourceAccessWebhook{
+			URL:           "https://access.example.com/review",
+			FailurePolicy: pointer.P(v1.SubresourceAccessFailurePolicy("Retry")),
+		}, []string{"test.failurePolicy"}),
+	)
+
+	DescribeTable("validateAlerts", func(config *v1.AlertsConfiguration, expectedFields []string) {
+		causes := validateAlerts(test, config)
+		fields := []string{}
+		for _, cause := range causes {
+			fields = append(fields, cause.Field)
+		}
+		Expect(fields).To(ConsistOf(expectedFields))
+	},
+		Entry("should accept no configuration", nil, []string{}),
+		Entry("should accept a valid configuration", &v1.AlertsConfiguration{
+			Disabled: []string{"KubeVirtVMIExcessiveMigrations"},
+			Overrides: []v1.AlertOverride{{
+				Alert:     "VirtApiRESTErrorsHigh",
+				For:       pointer.P("1h"),
+				Threshold: pointer.P("0.1"),
+				Severity:  v1.AlertSeverityCritical,
+			}},
+		}, []string{}),
+		Entry("should reject unknown alerts", &v1.AlertsConfiguration{
+			Disabled:  []string{"UnknownAlert"},
+			Overrides: []v1.AlertOverride{{Alert: "UnknownAlert", For: pointer.P("1h")}},
+		}, []string{"test.disabled[0]", "test.overrides[0].alert"}),
+		Entry("should reject a threshold which can't be overridden", &v1.AlertsConfiguration{
+			Overrides: []v1.AlertOverride{{Alert: "KubeVirtDeprecatedAPIRequested", Threshold: pointer.P("1")}},
+		}, []string{"test.overrides[0].threshold"}),
+		Entry("should reject a threshold which is not a number", &v1.AlertsConfiguration{
+			Overrides: []v1.AlertOverride{{Alert: "VirtApiRESTErrorsHigh", Threshold: pointer.P("high")}},
+		}, []string{"test.overrides[0].threshold"}),
+	)
+
 	Context("deprecations", func() {
 		var admitter *KubeVirtUpdateAdmitter
 
@@ -39,6 +39,8 @@ import (
 
 type consoleCommand struct {
 	timeout      int
+	shared       bool
+	readOnly     bool
 	namespace    string
 	virtCli      kubecli.KubevirtClient
 	clientConfig clientcmd.ClientConfig
@@ -56,6 +58,8 @@ func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	}
 
 	cmd.Flags().IntVar(&c.timeout, "timeout", 5, "The number of minutes to wait for the virtual machine instance to be ready.")
+	cmd.Flags().BoolVar(&c.shared, "shared", false, "Attach to the console shared with the other users connected with --shared, instead of taking the console over.")
+	cmd.Flags().BoolVar(&c.readOnly, "read-only", false, "Attach to the shared console without sending the input to the console, implies --shared.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -64,7 +68,9 @@ func usage() string {
 	usage := `  # Connect to the console on VirtualMachineInstance 'myvmi':
   {{ProgramName}} console myvmi
   # Configure one minute timeout (default 5 minutes)
-  {{ProgramName}} console --timeout=1 myvmi`
+  {{ProgramName}} console --timeout=1 myvmi
+  # Watch the console on VirtualMachineInstance 'myvmi', shared with other users:
+  {{ProgramName}} console --read-only myvmi`
 
 	return usage
 }
@@ -99,7 +105,11 @@ func (c *consoleCommand) handleConsoleConnection(vmi string) error {
 	signal.Notify(waitInterrupt, os.Interrupt)
 
 	go func() {
-		con, err := c.virtCli.VirtualMachineInstance(c.namespace).SerialConsole(vmi, &kvcorev1.SerialConsoleOptions{ConnectionTimeout: time.Duration(c.timeout) * time.Minute})
+		con, err := c.virtCli.VirtualMachineInstance(c.namespace).SerialConsole(vmi, &kvcorev1.SerialConsoleOptions{
+			ConnectionTimeout: time.Duration(c.timeout) * time.Minute,
+			Shared:            c.shared || c.readOnly,
+			ReadOnly:          c.readOnly,
+		})
 		runningChan <- err
 
 		if err != nil {
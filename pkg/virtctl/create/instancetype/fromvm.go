@@ -0,0 +1,222 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package instancetype
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	promapi "github.com/prometheus/client_golang/api"
+	prometheusv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/transport"
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+const (
+	// The vCPUs are sized on the 95th percentile of their usage, the memory on its peak,
+	// both with some headroom on top.
+	usageCPUQuery    = `quantile_over_time(0.95, sum(rate(kubevirt_vmi_vcpu_seconds_total{namespace="%s",name="%s"}[5m]))[%s:5m])`
+	usageMemoryQuery = `max(max_over_time(kubevirt_vmi_memory_used_bytes{namespace="%s",name="%s"}[%s]))`
+	usageHeadroom    = 1.2
+
+	memoryGranularity = 128 * 1024 * 1024
+)
+
+// NewPrometheusClient returns the client of the Prometheus API queried for the usage of VMs,
+// authenticated with the bearer token of the kubeconfig if any.
+var NewPrometheusClient = func(address string, clientConfig clientcmd.ClientConfig) (prometheusv1.API, error) {
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	roundTripper := http.DefaultTransport
+	if restConfig.BearerToken != "" {
+		roundTripper = transport.NewBearerAuthRoundTripper(restConfig.BearerToken, roundTripper)
+	}
+	client, err := promapi.NewClient(promapi.Config{Address: address, RoundTripper: roundTripper})
+	if err != nil {
+		return nil, err
+	}
+	return prometheusv1.NewAPI(client), nil
+}
+
+// fromVM derives the sizing of the instancetype from the guest topology of the VM, or from its
+// usage over the period given, along with a preference for its topology and machine type.
+func (c *createInstancetype) fromVM(cmd *cobra.Command) (*instancetypev1beta1.VirtualMachinePreferenceSpec, error) {
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return nil, err
+	}
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(cmd.Context(), c.fromVMName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// The VMI holds the topology expanded from the instancetype of the VM, if any
+	spec := &vm.Spec.Template.Spec
+	vmi, err := virtClient.VirtualMachineInstance(namespace).Get(cmd.Context(), c.fromVMName, metav1.GetOptions{})
+	if err == nil {
+		spec = &vmi.Spec
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	} else if vm.Spec.Instancetype != nil {
+		return nil, fmt.Errorf("VM %s uses instancetype %s, it has to be running for its topology to be known", vm.Name, vm.Spec.Instancetype.Name)
+	}
+
+	cpu, memory, err := guestSizing(spec)
+	if err != nil {
+		return nil, fmt.Errorf("VM %s: %v", vm.Name, err)
+	}
+	if cmd.Flags().Changed(BasedOnUsageFlag) {
+		cpu, memory, err = c.usageSizing(cmd, namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !cmd.Flags().Changed(CPUFlag) {
+		c.cpu = cpu
+	}
+	if !cmd.Flags().Changed(MemoryFlag) {
+		c.memory = memory.String()
+	}
+
+	return guestPreference(spec), nil
+}
+
+func guestSizing(spec *v1.VirtualMachineInstanceSpec) (uint32, resource.Quantity, error) {
+	cpu := uint32(1)
+	if spec.Domain.CPU != nil {
+		cpu = max(spec.Domain.CPU.Sockets, 1) * max(spec.Domain.CPU.Cores, 1) * max(spec.Domain.CPU.Threads, 1)
+	}
+
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		return cpu, *spec.Domain.Memory.Guest, nil
+	}
+	if memory, exists := spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; exists {
+		return cpu, memory, nil
+	}
+	return 0, resource.Quantity{}, fmt.Errorf("the guest memory is not set")
+}
+
+func guestPreference(spec *v1.VirtualMachineInstanceSpec) *instancetypev1beta1.VirtualMachinePreferenceSpec {
+	preferenceSpec := &instancetypev1beta1.VirtualMachinePreferenceSpec{}
+	if cpu := spec.Domain.CPU; cpu != nil {
+		topology := instancetypev1beta1.Sockets
+		switch {
+		case cpu.Threads > 1:
+			topology = instancetypev1beta1.Threads
+		case cpu.Cores > 1:
+			topology = instancetypev1beta1.Cores
+		}
+		preferenceSpec.CPU = &instancetypev1beta1.CPUPreferences{
+			PreferredCPUTopology: &topology,
+		}
+	}
+	if spec.Domain.Machine != nil && spec.Domain.Machine.Type != "" {
+		preferenceSpec.Machine = &instancetypev1beta1.MachinePreferences{
+			PreferredMachineType: spec.Domain.Machine.Type,
+		}
+	}
+	return preferenceSpec
+}
+
+func (c *createInstancetype) usageSizing(cmd *cobra.Command, namespace string) (uint32, resource.Quantity, error) {
+	period, err := model.ParseDuration(c.basedOnUsage)
+	if err != nil {
+		return 0, resource.Quantity{}, err
+	}
+	client, err := NewPrometheusClient(c.prometheusURL, c.clientConfig)
+	if err != nil {
+		return 0, resource.Quantity{}, fmt.Errorf("cannot obtain Prometheus client: %v", err)
+	}
+
+	now := time.Now()
+	cpuUsage, err := queryUsage(cmd, client, fmt.Sprintf(usageCPUQuery, namespace, c.fromVMName, period), now)
+	if err != nil {
+		return 0, resource.Quantity{}, err
+	}
+	memoryUsage, err := queryUsage(cmd, client, fmt.Sprintf(usageMemoryQuery, namespace, c.fromVMName, period), now)
+	if err != nil {
+		return 0, resource.Quantity{}, err
+	}
+
+	cpu := uint32(max(math.Ceil(cpuUsage*usageHeadroom), 1))
+	memory := int64(max(math.Ceil(memoryUsage*usageHeadroom/memoryGranularity), 1)) * memoryGranularity
+	return cpu, *resource.NewQuantity(memory, resource.BinarySI), nil
+}
+
+func queryUsage(cmd *cobra.Command, client prometheusv1.API, query string, ts time.Time) (float64, error) {
+	value, _, err := client.Query(cmd.Context(), query, ts)
+	if err != nil {
+		return 0, fmt.Errorf("error querying the usage of the VM: %v", err)
+	}
+	vector, ok := value.(model.Vector)
+	if !ok {
+		return 0, fmt.Errorf("unexpected result type %s of query %s", value.Type(), query)
+	}
+	if len(vector) == 0 {
+		return 0, fmt.Errorf("no usage of the VM over the period, is it running and are its metrics collected?")
+	}
+	return float64(vector[0].Value), nil
+}
+
+func (c *createInstancetype) marshalPreference(preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec) ([]byte, error) {
+	if !c.namespaced {
+		return yaml.Marshal(&instancetypev1beta1.VirtualMachineClusterPreference{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "VirtualMachineClusterPreference",
+				APIVersion: instancetypev1beta1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: c.name,
+			},
+			Spec: *preferenceSpec,
+		})
+	}
+
+	return yaml.Marshal(&instancetypev1beta1.VirtualMachinePreference{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "VirtualMachinePreference",
+			APIVersion: instancetypev1beta1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      c.name,
+			Namespace: c.namespace,
+		},
+		Spec: *preferenceSpec,
+	})
+}
@@ -43,6 +43,9 @@ const (
 	IOThreadsPolicyFlag = "iothreadspolicy"
 	NameFlag            = "name"
 	NamespacedFlag      = "namespaced"
+	FromVMFlag          = "from-vm"
+	BasedOnUsageFlag    = "based-on-usage"
+	PrometheusURLFlag   = "prometheus-url"
 
 	nameErr       = "name must be specified"
 	deviceNameErr = "deviceName must be specified"
@@ -57,6 +60,9 @@ type createInstancetype struct {
 	hostDevices     []string
 	ioThreadsPolicy string
 	namespaced      bool
+	fromVMName      string
+	basedOnUsage    string
+	prometheusURL   string
 
 	clientConfig clientcmd.ClientConfig
 }
@@ -79,6 +85,7 @@ func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 		Use:     "instancetype",
 		Short:   "Create VirtualMachineInstancetype or VirtualMachineClusterInstancetype manifest.",
 		Example: c.usage(),
+		PreRunE: c.preRun,
 		RunE:    c.run,
 	}
 	cmd.Flags().StringVar(&c.name, NameFlag, c.name, "Specify the name of the Instancetype.")
@@ -88,15 +95,22 @@ func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd.Flags().BoolVar(&c.namespaced, NamespacedFlag, false, "Specify if VirtualMachineInstancetype should be created. By default VirtualMachineClusterInstancetype is created.")
 	cmd.Flags().StringArrayVar(&c.gpus, GPUFlag, c.gpus, "Specify the list of vGPUs to passthrough. Can be provided multiple times.")
 	cmd.Flags().StringArrayVar(&c.hostDevices, HostDeviceFlag, c.hostDevices, "Specify list of HostDevices to passthrough. Can be provided multiple times.")
+	cmd.Flags().StringVar(&c.fromVMName, FromVMFlag, c.fromVMName, "Specify the VM to derive the CPU and memory of the Instancetype from, along with a Preference for its guest topology.")
+	cmd.Flags().StringVar(&c.basedOnUsage, BasedOnUsageFlag, c.basedOnUsage, "Specify the period, e.g. 7d, over which the usage of the VM given with --from-vm sizes the Instancetype, instead of its guest topology.")
+	cmd.Flags().StringVar(&c.prometheusURL, PrometheusURLFlag, c.prometheusURL, "Specify the URL of the Prometheus API to query the usage of the VM from.")
 
-	if err := cmd.MarkFlagRequired(CPUFlag); err != nil {
-		panic(err)
+	return cmd
+}
+
+// preRun requires the CPU and memory unless they are derived from a VM
+func (c *createInstancetype) preRun(cmd *cobra.Command, _ []string) error {
+	if cmd.Flags().Changed(FromVMFlag) {
+		return nil
 	}
-	if err := cmd.MarkFlagRequired(MemoryFlag); err != nil {
-		panic(err)
+	if err := cmd.MarkFlagRequired(CPUFlag); err != nil {
+		return err
 	}
-
-	return cmd
+	return cmd.MarkFlagRequired(MemoryFlag)
 }
 
 func (c *createInstancetype) setDefaults(cmd *cobra.Command) error {
@@ -199,7 +213,13 @@ func (c *createInstancetype) usage() string {
   {{ProgramName}} create instancetype --namespaced --name my-instancetype --cpu 2 --memory 256Mi
   
   # Create a manifest for a ClusterInstancetype and use it to create a resource with kubectl
-  {{ProgramName}} create instancetype --cpu 2 --memory 256Mi | kubectl create -f -`
+  {{ProgramName}} create instancetype --cpu 2 --memory 256Mi | kubectl create -f -
+
+  # Create the manifests of an Instancetype and a Preference matching the guest topology of a VM:
+  {{ProgramName}} create instancetype --namespaced --from-vm my-vm
+
+  # Create the manifests of an Instancetype and a Preference sized on the usage of a VM over the last week:
+  {{ProgramName}} create instancetype --namespaced --from-vm my-vm --based-on-usage 7d --prometheus-url https://prometheus.example.com`
 }
 
 func (c *createInstancetype) newInstancetype() *instancetypev1beta1.VirtualMachineInstancetype {
@@ -260,6 +280,19 @@ func (c *createInstancetype) applyFlags(cmd *cobra.Command, instancetypeSpec *in
 	return nil
 }
 
+func (c *createInstancetype) validateUsageFlags(cmd *cobra.Command) error {
+	if !cmd.Flags().Changed(BasedOnUsageFlag) {
+		return nil
+	}
+	if c.fromVMName == "" {
+		return params.FlagErr(BasedOnUsageFlag, "--%s must be specified", FromVMFlag)
+	}
+	if c.prometheusURL == "" {
+		return params.FlagErr(BasedOnUsageFlag, "--%s must be specified", PrometheusURLFlag)
+	}
+	return nil
+}
+
 func (c *createInstancetype) validateFlags() error {
 	if _, err := resource.ParseQuantity(c.memory); err != nil {
 		return err
@@ -277,6 +310,18 @@ func (c *createInstancetype) run(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if err := c.validateUsageFlags(cmd); err != nil {
+		return err
+	}
+
+	var preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec
+	if c.fromVMName != "" {
+		var err error
+		if preferenceSpec, err = c.fromVM(cmd); err != nil {
+			return err
+		}
+	}
+
 	if err := c.validateFlags(); err != nil {
 		return err
 	}
@@ -309,5 +354,13 @@ func (c *createInstancetype) run(cmd *cobra.Command, _ []string) error {
 
 	cmd.Print(string(out))
 
+	if preferenceSpec != nil {
+		out, err = c.marshalPreference(preferenceSpec)
+		if err != nil {
+			return err
+		}
+		cmd.Print("---\n" + string(out))
+	}
+
 	return nil
 }
@@ -19,23 +19,32 @@
 package instancetype_test
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	prometheusv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	k8sv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	k8sclientcmd "k8s.io/client-go/tools/clientcmd"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 	generatedscheme "kubevirt.io/client-go/kubevirt/scheme"
 
+	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks/validating-webhook/admitters"
 	"kubevirt.io/kubevirt/pkg/virtctl/create"
 	. "kubevirt.io/kubevirt/pkg/virtctl/create/instancetype"
@@ -199,6 +208,105 @@ var _ = Describe("create instancetype", func() {
 		)
 	})
 
+	Context("from a VM", func() {
+		const vmName = "my-vm"
+
+		var (
+			virtClient *kubevirtfake.Clientset
+			prometheus *fakePrometheus
+		)
+
+		BeforeEach(func() {
+			virtClient = kubevirtfake.NewSimpleClientset()
+
+			ctrl := gomock.NewController(GinkgoT())
+			kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+			kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8sv1.NamespaceDefault).
+				Return(virtClient.KubevirtV1().VirtualMachines(k8sv1.NamespaceDefault)).AnyTimes()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8sv1.NamespaceDefault).
+				Return(virtClient.KubevirtV1().VirtualMachineInstances(k8sv1.NamespaceDefault)).AnyTimes()
+
+			prometheus = &fakePrometheus{results: map[string]float64{}}
+			NewPrometheusClient = func(_ string, _ k8sclientcmd.ClientConfig) (prometheusv1.API, error) {
+				return prometheus, nil
+			}
+
+			vm := libvmi.NewVirtualMachine(libvmi.New(
+				libvmi.WithNamespace(k8sv1.NamespaceDefault),
+				libvmi.WithName(vmName),
+				libvmi.WithCPUCount(2, 1, 2),
+				libvmi.WithGuestMemory("3Gi"),
+			))
+			vm.Spec.Template.Spec.Domain.Machine = &v1.Machine{Type: "q35"}
+			_, err := virtClient.KubevirtV1().VirtualMachines(k8sv1.NamespaceDefault).Create(context.Background(), vm, k8sv1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should derive the instancetype and preference from the guest topology", func() {
+			out, err := runCmd(setFlag(FromVMFlag, vmName))
+			Expect(err).ToNot(HaveOccurred())
+
+			spec, preferenceSpec := getInstancetypeAndPreferenceSpecs(out)
+			Expect(spec.CPU.Guest).To(Equal(uint32(4)))
+			Expect(spec.Memory.Guest).To(Equal(resource.MustParse("3Gi")))
+			Expect(validateInstancetypeSpec(spec)).To(BeEmpty())
+			Expect(*preferenceSpec.CPU.PreferredCPUTopology).To(Equal(instancetypev1beta1.Cores))
+			Expect(preferenceSpec.Machine.PreferredMachineType).To(Equal("q35"))
+		})
+
+		It("should let the cpu and memory flags override the guest topology", func() {
+			out, err := runCmd(setFlag(FromVMFlag, vmName), setFlag(CPUFlag, "1"), setFlag(MemoryFlag, "1Gi"))
+			Expect(err).ToNot(HaveOccurred())
+
+			spec, _ := getInstancetypeAndPreferenceSpecs(out)
+			Expect(spec.CPU.Guest).To(Equal(uint32(1)))
+			Expect(spec.Memory.Guest).To(Equal(resource.MustParse("1Gi")))
+		})
+
+		It("should size the instancetype on the usage of the VM", func() {
+			prometheus.results["kubevirt_vmi_vcpu_seconds_total"] = 1.5
+			prometheus.results["kubevirt_vmi_memory_used_bytes"] = 1000 * 1024 * 1024
+
+			out, err := runCmd(
+				setFlag(FromVMFlag, vmName),
+				setFlag(BasedOnUsageFlag, "7d"),
+				setFlag(PrometheusURLFlag, "http://prometheus"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			spec, preferenceSpec := getInstancetypeAndPreferenceSpecs(out)
+			// 1.5 vCPUs and 1000Mi with 20% of headroom, the memory rounded up to 128Mi
+			Expect(spec.CPU.Guest).To(Equal(uint32(2)))
+			Expect(spec.Memory.Guest).To(Equal(resource.MustParse("1280Mi")))
+			Expect(*preferenceSpec.CPU.PreferredCPUTopology).To(Equal(instancetypev1beta1.Cores))
+			Expect(prometheus.queries).To(ContainElement(ContainSubstring(`name="my-vm"`)))
+			Expect(prometheus.queries).To(ContainElement(ContainSubstring("[1w:5m]")))
+		})
+
+		It("should fail without usage of the VM", func() {
+			_, err := runCmd(
+				setFlag(FromVMFlag, vmName),
+				setFlag(BasedOnUsageFlag, "7d"),
+				setFlag(PrometheusURLFlag, "http://prometheus"),
+			)
+			Expect(err).To(MatchError(ContainSubstring("no usage of the VM")))
+		})
+
+		It("should fail if the VM does not exist", func() {
+			_, err := runCmd(setFlag(FromVMFlag, "unknown"))
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+		})
+
+		DescribeTable("should fail with usage flags missing", func(errMsg string, args ...string) {
+			_, err := runCmd(append(args, setFlag(BasedOnUsageFlag, "7d"))...)
+			Expect(err).To(MatchError(ContainSubstring(errMsg)))
+		},
+			Entry("without --from-vm", "--from-vm must be specified", setFlag(CPUFlag, "1"), setFlag(MemoryFlag, "1Gi")),
+			Entry("without --prometheus-url", "--prometheus-url must be specified", setFlag(FromVMFlag, vmName)),
+		)
+	})
+
 	It("should create namespaced object and apply namespace when namespace is specified", func() {
 		const namespace = "my-namespace"
 		out, err := runCmd(
@@ -243,6 +351,35 @@ func getInstancetypeSpec(bytes []byte) *instancetypev1beta1.VirtualMachineInstan
 	}
 }
 
+func getInstancetypeAndPreferenceSpecs(bytes []byte) (*instancetypev1beta1.VirtualMachineInstancetypeSpec, *instancetypev1beta1.VirtualMachinePreferenceSpec) {
+	docs := strings.Split(string(bytes), "---\n")
+	Expect(docs).To(HaveLen(2))
+
+	decodedObj, err := runtime.Decode(generatedscheme.Codecs.UniversalDeserializer(), []byte(docs[1]))
+	Expect(err).ToNot(HaveOccurred())
+	preference, ok := decodedObj.(*instancetypev1beta1.VirtualMachineClusterPreference)
+	Expect(ok).To(BeTrue())
+
+	return getInstancetypeSpec([]byte(docs[0])), &preference.Spec
+}
+
+// fakePrometheus answers the queries on the metrics of its results
+type fakePrometheus struct {
+	prometheusv1.API
+	results map[string]float64
+	queries []string
+}
+
+func (p *fakePrometheus) Query(_ context.Context, query string, _ time.Time, _ ...prometheusv1.Option) (model.Value, prometheusv1.Warnings, error) {
+	p.queries = append(p.queries, query)
+	for metric, result := range p.results {
+		if strings.Contains(query, metric) {
+			return model.Vector{{Value: model.SampleValue(result)}}, nil, nil
+		}
+	}
+	return model.Vector{}, nil, nil
+}
+
 func validateInstancetypeSpec(spec *instancetypev1beta1.VirtualMachineInstancetypeSpec) []k8sv1.StatusCause {
 	return admitters.ValidateInstanceTypeSpec(field.NewPath("spec"), spec)
 }
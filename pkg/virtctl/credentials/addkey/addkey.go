@@ -42,6 +42,9 @@ const exampleUsage = `  # Add an SSH key for a running virtual machine.
   # Add an SSH key for a running virtual machine. Key is provided as literal parameter.
   {{ProgramName}} credentials add-ssh-key --user <username> --value <literal-ssh-public-key> <vm-name>
 
+  # Add an SSH key for a running virtual machine, and wait until it is applied to the guest.
+  {{ProgramName}} credentials add-ssh-key --user <username> --file <path-to-ssh-public-key> --wait vm/<vm-name>
+
   # Add an SSH key to a secret that is not owned by the virtual machine.
   {{ProgramName}} credentials add-ssh-key --user <username> --file <path-to-ssh-public-key> --force <vm-name>
 
@@ -80,10 +83,9 @@ func (a *addSSHKeyFlags) AddToCommand(cmd *cobra.Command) {
 }
 
 func runAddKeyCommand(clientConfig clientcmd.ClientConfig, cmdFlags *addSSHKeyFlags, cmd *cobra.Command, args []string) error {
-	vmName := args[0]
-	vmNamespace, _, err := clientConfig.Namespace()
+	vmNamespace, vmName, err := common.ParseTarget(args[0], clientConfig)
 	if err != nil {
-		return fmt.Errorf("error getting namespace: %w", err)
+		return err
 	}
 
 	// Reading the key before accessing cluster
@@ -201,6 +203,15 @@ func updateSecretWithSSHKey(
 			return fmt.Errorf("secret %s does not have an owner reference pointing to VM %s", secretName, vm.Name)
 		}
 	}
+
+	var waiter *common.SyncWaiter
+	if cmdFlags.Wait {
+		waiter, err = common.NewSyncWaiter(cmd.Context(), cli, vm)
+		if err != nil {
+			return err
+		}
+	}
+
 	keyPath := fmt.Sprintf("/data/%s", common.RandomWithPrefix("ssh-key-"))
 	addKeyPatch, err := patch.New(patch.WithAdd(keyPath, []byte(sshKey))).GeneratePayload()
 	if err != nil {
@@ -230,6 +241,9 @@ func updateSecretWithSSHKey(
 	}
 
 	cmd.Printf("Successfully added the key to secret \"%s\"", secretName)
+	if waiter != nil {
+		return waiter.Wait(cmd, cmdFlags.WaitTimeout)
+	}
 	return nil
 }
 
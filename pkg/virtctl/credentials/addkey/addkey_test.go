@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -290,6 +291,101 @@ var _ = Describe("Credentials add-ssh-key", func() {
 		expectSecretToContainKey(kubeClient, secretName, testKey)
 	})
 
+	It("should patch secret of a VM given as vm/NAME.NAMESPACE", func() {
+		err := runAddKeyCommand(
+			"--user", userName,
+			"--value", testKey,
+			"vm/"+vmName+"."+metav1.NamespaceDefault,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		expectSecretToContainKey(kubeClient, secretName, testKey)
+	})
+
+	It("should fail if the target is not a VM", func() {
+		err := runAddKeyCommand(
+			"--user", userName,
+			"--value", testKey,
+			"vmi/"+vmName,
+		)
+		Expect(err).To(MatchError(ContainSubstring("unsupported resource kind vmi")))
+	})
+
+	Context("with --wait", func() {
+		setVMIStatus := func(status v1.VirtualMachineInstanceStatus) {
+			vmi.Status = status
+			var err error
+			vmi, err = virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).
+				Update(context.Background(), vmi, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("should wait until the key is applied to the running VM", func() {
+			setVMIStatus(v1.VirtualMachineInstanceStatus{
+				Phase: v1.Running,
+				Conditions: []v1.VirtualMachineInstanceCondition{{
+					Type:          v1.VirtualMachineInstanceAccessCredentialsSynchronized,
+					Status:        corev1.ConditionTrue,
+					LastProbeTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+				}},
+			})
+
+			// Simulate virt-launcher applying the changed secret
+			kubeClient.Fake.PrependReactor("patch", "secrets", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				vmi.Status.Conditions[0].LastProbeTime = metav1.Now()
+				_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).
+					Update(context.Background(), vmi, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				return false, nil, nil
+			})
+
+			err := runAddKeyCommand(
+				"--user", userName,
+				"--value", testKey,
+				"--wait",
+				vmName,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			expectSecretToContainKey(kubeClient, secretName, testKey)
+		})
+
+		It("should report the failure of the synchronization on timeout", func() {
+			setVMIStatus(v1.VirtualMachineInstanceStatus{
+				Phase: v1.Running,
+				Conditions: []v1.VirtualMachineInstanceCondition{{
+					Type:    v1.VirtualMachineInstanceAccessCredentialsSynchronized,
+					Status:  corev1.ConditionFalse,
+					Message: "Guest agent is offline",
+				}},
+			})
+
+			err := runAddKeyCommand(
+				"--user", userName,
+				"--value", testKey,
+				"--wait",
+				"--timeout", "1ms",
+				vmName,
+			)
+			Expect(err).To(MatchError(ContainSubstring("timed out waiting for the credentials to be applied: Guest agent is offline")))
+
+			expectSecretToContainKey(kubeClient, secretName, testKey)
+		})
+
+		It("should not wait if the VM is not running", func() {
+			err := runAddKeyCommand(
+				"--user", userName,
+				"--value", testKey,
+				"--wait",
+				"--timeout", "1ms",
+				vmName,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			expectSecretToContainKey(kubeClient, secretName, testKey)
+		})
+	})
+
 	It("should fail if secret is not owned by the VM", func() {
 		patchSecret(kubeClient, secretName, patch.WithRemove("/metadata/ownerReferences"))
 
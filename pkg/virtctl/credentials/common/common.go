@@ -4,16 +4,23 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/tools/clientcmd"
 	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
 type CommandFlags struct {
 	User   string
 	Secret string
+
+	Wait        bool
+	WaitTimeout time.Duration
 }
 
 func (c *CommandFlags) AddToCommand(cmd *cobra.Command) {
@@ -25,6 +32,35 @@ func (c *CommandFlags) AddToCommand(cmd *cobra.Command) {
 	}
 
 	cmd.Flags().StringVar(&c.Secret, "secret", "", "Name of the secret with SSH keys.")
+
+	cmd.Flags().BoolVar(&c.Wait, "wait", false,
+		"Wait until the guest agent applied the changed credentials to the running VM.")
+	cmd.Flags().DurationVar(&c.WaitTimeout, "timeout", defaultWaitTimeout,
+		"Maximum time to wait for the guest agent to apply the changed credentials, used with --wait.")
+}
+
+// ParseTarget returns the namespace and the name of the VM, given either as NAME or as vm/NAME[.NAMESPACE].
+// The namespace defaults to the one of the client config.
+func ParseTarget(arg string, clientConfig clientcmd.ClientConfig) (namespace, name string, err error) {
+	name = arg
+	if strings.Contains(arg, "/") {
+		var kind string
+		kind, namespace, name, err = templates.ParseTarget(arg)
+		if err != nil {
+			return "", "", err
+		}
+		if !templates.KindIsVM(kind) {
+			return "", "", fmt.Errorf("unsupported resource kind %s, credentials are set on virtual machines", kind)
+		}
+	}
+
+	if namespace == "" {
+		namespace, _, err = clientConfig.Namespace()
+		if err != nil {
+			return "", "", fmt.Errorf("error getting namespace: %w", err)
+		}
+	}
+	return namespace, name, nil
 }
 
 type SSHCommandFlags struct {
@@ -0,0 +1,102 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+const (
+	defaultWaitTimeout = 5 * time.Minute
+	syncPollInterval   = 2 * time.Second
+)
+
+// SyncWaiter waits until the guest agent applied changed credentials to the running VMI of a VM.
+// virt-launcher sets the LastProbeTime of the AccessCredentialsSynchronized condition every time
+// it applied changed credentials, so the waiter records the condition before the secret is changed.
+type SyncWaiter struct {
+	cli       kubecli.KubevirtClient
+	namespace string
+	name      string
+
+	running   bool
+	lastProbe metav1.Time
+}
+
+func NewSyncWaiter(ctx context.Context, cli kubecli.KubevirtClient, vm *v1.VirtualMachine) (*SyncWaiter, error) {
+	waiter := &SyncWaiter{
+		cli:       cli,
+		namespace: vm.Namespace,
+		name:      vm.Name,
+	}
+
+	vmi, err := cli.VirtualMachineInstance(vm.Namespace).Get(ctx, vm.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return waiter, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting virtual machine instance: %w", err)
+	}
+
+	waiter.running = vmi.Status.Phase == v1.Running
+	if condition := getSyncCondition(vmi); condition != nil {
+		waiter.lastProbe = condition.LastProbeTime
+	}
+	return waiter, nil
+}
+
+// Wait blocks until the guest agent applied the changed credentials, or until the timeout expires
+func (w *SyncWaiter) Wait(cmd *cobra.Command, timeout time.Duration) error {
+	if !w.running {
+		cmd.Printf("\nVirtual machine %s is not running, the credentials will be applied when it starts.\n", w.name)
+		return nil
+	}
+
+	var lastMessage string
+	err := wait.PollUntilContextTimeout(cmd.Context(), syncPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		vmi, err := w.cli.VirtualMachineInstance(w.namespace).Get(ctx, w.name, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Errorf("error getting virtual machine instance: %w", err)
+		}
+
+		condition := getSyncCondition(vmi)
+		if condition == nil {
+			return false, nil
+		}
+		if condition.Status != k8sv1.ConditionTrue {
+			// virt-launcher retries failed synchronizations, keep waiting
+			lastMessage = condition.Message
+			return false, nil
+		}
+		return condition.LastProbeTime.After(w.lastProbe.Time), nil
+	})
+	if wait.Interrupted(err) {
+		if lastMessage != "" {
+			return fmt.Errorf("timed out waiting for the credentials to be applied: %s", lastMessage)
+		}
+		return fmt.Errorf("timed out waiting for the credentials to be applied")
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("\nCredentials were applied to virtual machine %s.\n", w.name)
+	return nil
+}
+
+func getSyncCondition(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstanceCondition {
+	for i := range vmi.Status.Conditions {
+		if vmi.Status.Conditions[i].Type == v1.VirtualMachineInstanceAccessCredentialsSynchronized {
+			return &vmi.Status.Conditions[i]
+		}
+	}
+	return nil
+}
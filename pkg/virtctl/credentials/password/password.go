@@ -35,6 +35,9 @@ func SetPasswordCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 const exampleUsage = `  # Set a user password for a virtual machine.
   {{ProgramName}} credentials set-password --user <username> --password <password> <vm-name>
 
+  # Set a user password for a virtual machine in another namespace, and wait until it is applied to the running guest.
+  {{ProgramName}} credentials set-password --user <username> --password <password> --wait vm/<vm-name>.<namespace>
+
   # Set a user password in a secret that is not owned by the virtual machine.
   {{ProgramName}} credentials set-password --user <username> --password <password> --force <vm-name>
 `
@@ -61,11 +64,9 @@ func (p *passwordCommandFlags) AddToCommand(cmd *cobra.Command) {
 }
 
 func runSetPasswordCommand(clientConfig clientcmd.ClientConfig, cmdFlags *passwordCommandFlags, cmd *cobra.Command, args []string) error {
-	vmName := args[0]
-
-	vmNamespace, _, err := clientConfig.Namespace()
+	vmNamespace, vmName, err := common.ParseTarget(args[0], clientConfig)
 	if err != nil {
-		return fmt.Errorf("error getting namespace: %w", err)
+		return err
 	}
 
 	cli, err := kubecli.GetKubevirtClientFromClientConfig(clientConfig)
@@ -88,17 +89,31 @@ func runSetPasswordCommand(clientConfig clientcmd.ClientConfig, cmdFlags *passwo
 		return err
 	}
 
-	if !cmdFlags.Force {
-		secret, errSecret := cli.CoreV1().Secrets(vm.Namespace).Get(cmd.Context(), secretName, metav1.GetOptions{})
-		if errSecret != nil {
-			return fmt.Errorf("error getting secret \"%s\": %w", secretName, errSecret)
-		}
+	secret, err := cli.CoreV1().Secrets(vm.Namespace).Get(cmd.Context(), secretName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting secret \"%s\": %w", secretName, err)
+	}
 
+	if !cmdFlags.Force {
 		// Check if secret is owned by the VM. This is useful to not accidentally update a secret that is used by multiple VMs.
 		if !common.IsOwnedByVM(secret, vm) {
 			return fmt.Errorf("secret %s does not have an owner reference pointing to VM %s", secretName, vm.Name)
 		}
 	}
+
+	if string(secret.Data[cmdFlags.User]) == cmdFlags.Password {
+		cmd.Printf("Secret \"%s\" already contains this password.", secretName)
+		return nil
+	}
+
+	var waiter *common.SyncWaiter
+	if cmdFlags.Wait {
+		waiter, err = common.NewSyncWaiter(cmd.Context(), cli, vm)
+		if err != nil {
+			return err
+		}
+	}
+
 	passwordPath := fmt.Sprintf("/data/%s", cmdFlags.User)
 	addKeyPatch, err := patch.New(patch.WithAdd(passwordPath, []byte(cmdFlags.Password))).GeneratePayload()
 	if err != nil {
@@ -128,6 +143,9 @@ func runSetPasswordCommand(clientConfig clientcmd.ClientConfig, cmdFlags *passwo
 	}
 
 	cmd.Printf("Successfully set password in secret \"%s\"", secretName)
+	if waiter != nil {
+		return waiter.Wait(cmd, cmdFlags.WaitTimeout)
+	}
 	return nil
 }
 
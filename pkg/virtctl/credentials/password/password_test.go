@@ -9,9 +9,11 @@ import (
 	"github.com/golang/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/client-go/kubecli"
@@ -45,6 +47,8 @@ var _ = Describe("Credentials set-password", func() {
 		ctrl := gomock.NewController(GinkgoT())
 		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
 		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
 		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).
 			Return(virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
 		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
@@ -149,6 +153,51 @@ var _ = Describe("Credentials set-password", func() {
 		expectSecretToContainUserWithPassword(kubeClient, secretName, userName, testPass)
 	})
 
+	It("should patch secret of a VM given as vm/NAME", func() {
+		err := runSetPasswordCommand(
+			"--user", userName,
+			"--password", testPass,
+			"vm/"+vmName,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		expectSecretToContainUserWithPassword(kubeClient, secretName, userName, testPass)
+	})
+
+	It("should not patch secret if it already contains the password", func() {
+		secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), secretName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		secret.Data = map[string][]byte{userName: []byte(testPass)}
+		_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Update(context.Background(), secret, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		kubeClient.Fake.PrependReactor("patch", "secrets", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			Fail("secret should not be patched")
+			return true, nil, nil
+		})
+
+		err = runSetPasswordCommand(
+			"--user", userName,
+			"--password", testPass,
+			"--wait",
+			vmName,
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should not wait if the VM is not running", func() {
+		err := runSetPasswordCommand(
+			"--user", userName,
+			"--password", testPass,
+			"--wait",
+			"--timeout", "1ms",
+			vmName,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		expectSecretToContainUserWithPassword(kubeClient, secretName, userName, testPass)
+	})
+
 	It("should patch the secret specified by parameter", func() {
 		const secondSecretName = "second-secret"
 		secondSecret := &corev1.Secret{
@@ -21,6 +21,7 @@ func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "remove-ssh-key",
 		Short:   "Remove credentials from a virtual machine.",
+		Args:    cobra.ExactArgs(1),
 		Example: exampleUsage,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runRemoveKeyCommand(clientConfig, cmdFlags, cmd, args)
@@ -37,6 +38,9 @@ const exampleUsage = `  # Remove an SSH key for a running virtual machine.
   # Remove an SSH key for a running virtual machine. Key is provided as literal parameter.
   {{ProgramName}} credentials remove-ssh-key --user <username> --value <literal-ssh-public-key> <vm-name>
 
+  # Remove an SSH key for a running virtual machine, and wait until it is removed from the guest.
+  {{ProgramName}} credentials remove-ssh-key --user <username> --file <path-to-ssh-public-key> --wait vm/<vm-name>
+
   # Remove an SSH key from a secret that is not owned by the virtual machine.
   {{ProgramName}} credentials remove-ssh-key --user <username> --file <path-to-ssh-public-key> --force <vm-name>
 `
@@ -54,11 +58,9 @@ func (r *removeSSHKeyFlags) AddToCommand(cmd *cobra.Command) {
 }
 
 func runRemoveKeyCommand(clientConfig clientcmd.ClientConfig, cmdFlags *removeSSHKeyFlags, cmd *cobra.Command, args []string) error {
-	vmName := args[0]
-
-	vmNamespace, _, err := clientConfig.Namespace()
+	vmNamespace, vmName, err := common.ParseTarget(args[0], clientConfig)
 	if err != nil {
-		return fmt.Errorf("error getting namespace: %w", err)
+		return err
 	}
 
 	// Reading the key before accessing cluster
@@ -94,13 +96,26 @@ func runRemoveKeyCommand(clientConfig clientcmd.ClientConfig, cmdFlags *removeSS
 		}
 	}
 
+	var waiter *common.SyncWaiter
+	if cmdFlags.Wait {
+		waiter, err = common.NewSyncWaiter(cmd.Context(), cli, vm)
+		if err != nil {
+			return err
+		}
+	}
+
+	removed := false
 	for _, secretName := range filteredSecrets {
-		err := removeKeyFromSecret(cmd.Context(), cli, vm, secretName, sshKey, cmdFlags.Force)
+		secretChanged, err := removeKeyFromSecret(cmd.Context(), cli, vm, secretName, sshKey, cmdFlags.Force)
 		if err != nil {
 			return err
 		}
+		removed = removed || secretChanged
 	}
 
+	if waiter != nil && removed {
+		return waiter.Wait(cmd, cmdFlags.WaitTimeout)
+	}
 	return nil
 }
 
@@ -111,9 +126,10 @@ func removeKeyFromSecret(
 	secretName string,
 	key string,
 	force bool,
-) error {
+) (changed bool, err error) {
 	// Looping, because Update API call can fail with conflict
-	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		changed = false
 		select {
 		case <-ctx.Done():
 			return nil
@@ -137,6 +153,9 @@ func removeKeyFromSecret(
 		}
 
 		for fileName, data := range secret.Data {
+			if containsSSHKey(key, data) {
+				changed = true
+			}
 			updatedData := removeSSHKeyFromBytes(key, data)
 			if len(updatedData) == 0 {
 				delete(secret.Data, fileName)
@@ -147,10 +166,12 @@ func removeKeyFromSecret(
 
 		_, err = cli.CoreV1().Secrets(vm.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
 		if errors.IsNotFound(err) {
+			changed = false
 			return nil
 		}
 		return err
 	})
+	return changed, err
 }
 
 func removeSSHKeyFromBytes(key string, data []byte) []byte {
@@ -165,3 +186,12 @@ func removeSSHKeyFromBytes(key string, data []byte) []byte {
 
 	return []byte(strings.Join(resultLines, "\n"))
 }
+
+func containsSSHKey(key string, data []byte) bool {
+	for _, line := range strings.Split(string(data), "\n") {
+		if common.LineContainsKey(line, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -149,6 +149,33 @@ var _ = Describe("Credentials remove-ssh-key", func() {
 		expectSecretToBeEmpty(kubeClient, secretName)
 	})
 
+	It("should remove key from secret of a VM given as vm/NAME", func() {
+		err := runRemoveKeyCommand(
+			"--user", userName,
+			"--value", testKey,
+			"vm/"+vmName,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		expectSecretToBeEmpty(kubeClient, secretName)
+	})
+
+	It("should not wait if the secret does not contain the key", func() {
+		vmi.Status.Phase = v1.Running
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).
+			Update(context.Background(), vmi, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = runRemoveKeyCommand(
+			"--user", userName,
+			"--value", "other-key",
+			"--wait",
+			"--timeout", "1ms",
+			vmName,
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
 	It("should remove key from secret with multiple keys", func() {
 		const secondDataKey = "second-key"
 		const secondDataValue = "second-key-value"
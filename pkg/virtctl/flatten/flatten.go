@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package flatten
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_FLATTEN = "flatten"
+)
+
+func NewFlattenCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := Flatten{
+		clientConfig: clientConfig,
+	}
+	cmd := &cobra.Command{
+		Use:   "flatten (VMI)",
+		Short: "Flatten the backing chains of the volumes of a virtual machine instance",
+		Long: `Flatten the backing chains of the volumes of a running virtual machine instance.
+The data of the backing images is copied into the overlays of the volumes, in the background.
+The progress is reported in the flatten status of the volumes of the virtual machine instance.`,
+		Args:    cobra.ExactArgs(1),
+		Example: usage(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.Run(args)
+		},
+	}
+	cmd.Flags().StringArrayVar(&c.volumes, "volume", nil, "The name of a volume to flatten, can be repeated. All the volumes with a backing chain are flattened when omitted.")
+	cmd.Flags().BoolVar(&c.dryRun, "dry-run", false, "--dry-run=false: Flag used to set whether to perform a dry run or not. If true the command will be executed without performing any changes.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	usage := "  # Flatten all the volumes of a virtualmachineinstance called 'myvmi':\n"
+	usage += fmt.Sprintf("  {{ProgramName}} %s myvmi\n\n", COMMAND_FLATTEN)
+	usage += "  # Flatten the volume 'rootdisk' of a virtualmachineinstance called 'myvmi':\n"
+	usage += fmt.Sprintf("  {{ProgramName}} %s myvmi --volume=rootdisk", COMMAND_FLATTEN)
+	return usage
+}
+
+type Flatten struct {
+	clientConfig clientcmd.ClientConfig
+	volumes      []string
+	dryRun       bool
+}
+
+func (o *Flatten) Run(args []string) error {
+	vmi := args[0]
+
+	namespace, _, err := o.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(o.clientConfig)
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	var dryRunOption []string
+	if o.dryRun {
+		fmt.Printf("Dry Run execution\n")
+		dryRunOption = []string{metav1.DryRunAll}
+	}
+
+	flattenOptions := &v1.FlattenOptions{
+		Volumes: o.volumes,
+		DryRun:  dryRunOption,
+	}
+	if err = virtClient.VirtualMachineInstance(namespace).Flatten(context.Background(), vmi, flattenOptions); err != nil {
+		return fmt.Errorf("Error flattening the volumes of VirtualMachineInstance %s: %v", vmi, err)
+	}
+
+	fmt.Printf("The volumes of VMI %s are being flattened\n", vmi)
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package flatten_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/flatten"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Flattening", func() {
+	const vmiName = "testvmi"
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+	})
+
+	It("should fail with missing input parameters", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(flatten.COMMAND_FLATTEN)
+		Expect(cmd()).ToNot(Succeed())
+	})
+
+	DescribeTable("should flatten the volumes of the VMI", func(args []string, expectedOptions *v1.FlattenOptions) {
+		vmiInterface.EXPECT().Flatten(context.Background(), vmiName, expectedOptions).Return(nil)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand(append([]string{flatten.COMMAND_FLATTEN, vmiName}, args...)...)
+		Expect(cmd()).To(Succeed())
+	},
+		Entry("all of them", nil, &v1.FlattenOptions{}),
+		Entry("the requested ones", []string{"--volume=rootdisk", "--volume=datadisk"}, &v1.FlattenOptions{Volumes: []string{"rootdisk", "datadisk"}}),
+		Entry("with dry run", []string{"--dry-run"}, &v1.FlattenOptions{DryRun: []string{metav1.DryRunAll}}),
+	)
+
+	It("should report the failure to flatten the volumes", func() {
+		vmiInterface.EXPECT().Flatten(context.Background(), vmiName, gomock.Any()).Return(errors.New("no volume has a backing chain"))
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand(flatten.COMMAND_FLATTEN, vmiName)
+		Expect(cmd()).To(MatchError(ContainSubstring("no volume has a backing chain")))
+	})
+})
@@ -0,0 +1,310 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package perf
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const COMMAND_BOOT_STORM = "boot-storm"
+
+type bootStorm struct {
+	clientConfig clientcmd.ClientConfig
+
+	count              int
+	template           string
+	parallelism        int
+	timeout            time.Duration
+	waitGuestBootHooks bool
+	keep               bool
+	output             string
+}
+
+// vmiProgress records when the VMI of a VM was first seen in each stage of its boot,
+// created is zero when the VM could not be created
+type vmiProgress struct {
+	created        time.Time
+	running        time.Time
+	agentConnected time.Time
+	hooksCompleted time.Time
+	failed         bool
+	hooksFailed    bool
+}
+
+func NewBootStormCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := bootStorm{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   COMMAND_BOOT_STORM,
+		Short: "Start many copies of a VM at once and report their boot latency.",
+		Long: `Creates copies of the template VM, running, and measures the time until their VMIs run.
+The copies are named after the template and labeled with ` + RunLabel + `, they are deleted at the end unless --keep is set.
+The MAC addresses and the firmware UUID of the template are not copied, its data volume templates are renamed.`,
+		Example: bootStormUsage(),
+		Args:    cobra.NoArgs,
+		RunE:    c.run,
+	}
+
+	cmd.Flags().IntVar(&c.count, "count", 10, "Number of VMs to start.")
+	cmd.Flags().StringVar(&c.template, "template", "", "Name of the VM the started VMs are copied from.")
+	if err := cmd.MarkFlagRequired("template"); err != nil {
+		panic(err)
+	}
+	cmd.Flags().IntVar(&c.parallelism, "parallelism", 10, "Number of VMs created concurrently.")
+	cmd.Flags().DurationVar(&c.timeout, "timeout", 10*time.Minute, "Time to wait for the VMs to boot, the VMs which did not boot by then are reported as failed.")
+	cmd.Flags().BoolVar(&c.waitGuestBootHooks, "wait-guest-boot-hooks", false, "Wait for the OnGuestBoot hook sidecars of the VMs and report their latency, from the connection of the guest agent.")
+	cmd.Flags().BoolVar(&c.keep, "keep", false, "Keep the VMs once measured.")
+	cmd.Flags().StringVarP(&c.output, "output", "o", outputText, "Format of the report: text or json.")
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func bootStormUsage() string {
+	return `  # Start 100 copies of the VM 'fedora' and report their boot latency:
+  {{ProgramName}} perf boot-storm --count=100 --template=fedora
+
+  # Include the latency of the OnGuestBoot hooks and keep the VMs:
+  {{ProgramName}} perf boot-storm --count=20 --template=fedora-hooks --wait-guest-boot-hooks --keep`
+}
+
+func (c *bootStorm) run(cmd *cobra.Command, _ []string) error {
+	if err := validateOutput(c.output); err != nil {
+		return err
+	}
+	if c.count < 1 || c.parallelism < 1 {
+		return fmt.Errorf("count and parallelism must be at least 1")
+	}
+
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	client, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	ctx := context.Background()
+	template, err := client.VirtualMachine(namespace).Get(ctx, c.template, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get the template VM: %v", err)
+	}
+
+	runID := rand.String(5)
+	progress := c.createVMs(ctx, cmd, client, template, runID)
+	if !c.keep {
+		defer deleteVMs(cmd, client, namespace, progress)
+	}
+
+	if err := c.waitForVMIs(ctx, client, namespace, runID, progress); err != nil {
+		return err
+	}
+
+	return writeSummaries(cmd.OutOrStdout(), c.output, c.summarize(progress))
+}
+
+func (c *bootStorm) createVMs(ctx context.Context, cmd *cobra.Command, client kubecli.KubevirtClient,
+	template *v1.VirtualMachine, runID string) map[string]*vmiProgress {
+	var (
+		lock     sync.Mutex
+		wg       sync.WaitGroup
+		progress = map[string]*vmiProgress{}
+		slots    = make(chan struct{}, c.parallelism)
+	)
+	for i := 0; i < c.count; i++ {
+		vm := newVMFromTemplate(template, runID, i)
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			vmProgress := &vmiProgress{}
+			start := time.Now()
+			if _, err := client.VirtualMachine(vm.Namespace).Create(ctx, vm, metav1.CreateOptions{}); err != nil {
+				cmd.PrintErrf("failed to create VM %s: %v\n", vm.Name, err)
+				vmProgress.failed = true
+			} else {
+				vmProgress.created = start
+			}
+			lock.Lock()
+			progress[vm.Name] = vmProgress
+			lock.Unlock()
+		}()
+	}
+	wg.Wait()
+	return progress
+}
+
+func (c *bootStorm) waitForVMIs(ctx context.Context, client kubecli.KubevirtClient, namespace, runID string, progress map[string]*vmiProgress) error {
+	err := wait.PollUntilContextTimeout(ctx, pollInterval, c.timeout, true, func(ctx context.Context) (bool, error) {
+		vmis, err := client.VirtualMachineInstance(namespace).List(ctx, metav1.ListOptions{LabelSelector: RunLabel + "=" + runID})
+		if err != nil {
+			return false, nil
+		}
+		now := time.Now()
+		for i := range vmis.Items {
+			if vmProgress, exists := progress[vmis.Items[i].Name]; exists {
+				vmProgress.observe(&vmis.Items[i], now)
+			}
+		}
+		for _, vmProgress := range progress {
+			if !vmProgress.done(c.waitGuestBootHooks) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil && !wait.Interrupted(err) {
+		return err
+	}
+	return nil
+}
+
+func (p *vmiProgress) observe(vmi *v1.VirtualMachineInstance, now time.Time) {
+	if vmi.IsFinal() && p.running.IsZero() {
+		p.failed = true
+	}
+	if vmi.IsRunning() && p.running.IsZero() {
+		p.running = now
+	}
+	for _, condition := range vmi.Status.Conditions {
+		switch {
+		case condition.Type == v1.VirtualMachineInstanceAgentConnected && condition.Status == k8sv1.ConditionTrue:
+			if p.agentConnected.IsZero() {
+				p.agentConnected = now
+			}
+		case condition.Type == v1.VirtualMachineInstanceGuestBootHooksCompleted:
+			if p.hooksCompleted.IsZero() {
+				p.hooksCompleted = now
+				p.hooksFailed = condition.Status != k8sv1.ConditionTrue
+			}
+		}
+	}
+}
+
+func (p *vmiProgress) done(waitGuestBootHooks bool) bool {
+	if p.failed {
+		return true
+	}
+	if waitGuestBootHooks {
+		return !p.hooksCompleted.IsZero()
+	}
+	return !p.running.IsZero()
+}
+
+func (c *bootStorm) summarize(progress map[string]*vmiProgress) []Summary {
+	var bootLatencies, hookLatencies []time.Duration
+	var bootFailed, hooksFailed int
+	for _, vmProgress := range progress {
+		if vmProgress.running.IsZero() {
+			bootFailed++
+			continue
+		}
+		bootLatencies = append(bootLatencies, vmProgress.running.Sub(vmProgress.created))
+
+		if !c.waitGuestBootHooks {
+			continue
+		}
+		if vmProgress.hooksCompleted.IsZero() || vmProgress.hooksFailed {
+			hooksFailed++
+		} else {
+			start := vmProgress.agentConnected
+			if start.IsZero() {
+				start = vmProgress.hooksCompleted
+			}
+			hookLatencies = append(hookLatencies, vmProgress.hooksCompleted.Sub(start))
+		}
+	}
+
+	summaries := []Summary{Summarize("boot", bootLatencies, bootFailed)}
+	if c.waitGuestBootHooks {
+		summaries = append(summaries, Summarize("guest-boot-hooks", hookLatencies, hooksFailed))
+	}
+	return summaries
+}
+
+func newVMFromTemplate(template *v1.VirtualMachine, runID string, index int) *v1.VirtualMachine {
+	suffix := fmt.Sprintf("%s-%d", runID, index)
+	vm := &v1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", template.Name, suffix),
+			Namespace: template.Namespace,
+			Labels:    map[string]string{RunLabel: runID},
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+	vm.Spec.Running = nil
+	vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+
+	vmiTemplate := vm.Spec.Template
+	if vmiTemplate.ObjectMeta.Labels == nil {
+		vmiTemplate.ObjectMeta.Labels = map[string]string{}
+	}
+	vmiTemplate.ObjectMeta.Labels[RunLabel] = runID
+
+	for i := range vmiTemplate.Spec.Domain.Devices.Interfaces {
+		vmiTemplate.Spec.Domain.Devices.Interfaces[i].MacAddress = ""
+	}
+	if vmiTemplate.Spec.Domain.Firmware != nil {
+		vmiTemplate.Spec.Domain.Firmware.UUID = ""
+	}
+
+	for i := range vm.Spec.DataVolumeTemplates {
+		dataVolumeTemplate := &vm.Spec.DataVolumeTemplates[i]
+		name := fmt.Sprintf("%s-%s", dataVolumeTemplate.Name, suffix)
+		for j := range vmiTemplate.Spec.Volumes {
+			if dataVolume := vmiTemplate.Spec.Volumes[j].DataVolume; dataVolume != nil && dataVolume.Name == dataVolumeTemplate.Name {
+				dataVolume.Name = name
+			}
+		}
+		dataVolumeTemplate.Name = name
+	}
+	return vm
+}
+
+func deleteVMs(cmd *cobra.Command, client kubecli.KubevirtClient, namespace string, progress map[string]*vmiProgress) {
+	for name, vmProgress := range progress {
+		if vmProgress.created.IsZero() {
+			continue
+		}
+		err := client.VirtualMachine(namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+		if err != nil {
+			cmd.PrintErrf("failed to delete VM %s: %v\n", name, err)
+		}
+	}
+}
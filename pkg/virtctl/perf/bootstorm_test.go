@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package perf_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virtctl/perf"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Boot storm", func() {
+	var (
+		vmInterface  *kubecli.MockVirtualMachineInterface
+		vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		template     *v1.VirtualMachine
+
+		lock    sync.Mutex
+		created []*v1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+
+		vmi := libvmi.New(
+			libvmi.WithName("fedora"),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithInterface(*libvmi.InterfaceWithMac(&v1.Interface{Name: "default"}, "02:00:00:00:00:01")),
+			libvmi.WithDataVolume("disk0", "fedora-disk"),
+		)
+		template = libvmi.NewVirtualMachine(vmi)
+		template.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{ObjectMeta: metav1.ObjectMeta{Name: "fedora-disk"}}}
+		vmInterface.EXPECT().Get(gomock.Any(), "fedora", gomock.Any()).Return(template, nil)
+
+		created = nil
+		vmInterface.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, vm *v1.VirtualMachine, _ metav1.CreateOptions) (*v1.VirtualMachine, error) {
+				lock.Lock()
+				defer lock.Unlock()
+				created = append(created, vm)
+				return vm, nil
+			}).Times(3)
+	})
+
+	runningVMIs := func(_ context.Context, _ metav1.ListOptions) (*v1.VirtualMachineInstanceList, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		list := &v1.VirtualMachineInstanceList{}
+		for _, vm := range created {
+			vmi := v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: vm.Name}}
+			vmi.Status.Phase = v1.Running
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{Type: v1.VirtualMachineInstanceAgentConnected, Status: k8sv1.ConditionTrue},
+				{Type: v1.VirtualMachineInstanceGuestBootHooksCompleted, Status: k8sv1.ConditionTrue},
+			}
+			list.Items = append(list.Items, vmi)
+		}
+		return list, nil
+	}
+
+	It("should start copies of the template and report their boot latency", func() {
+		vmiInterface.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(runningVMIs).AnyTimes()
+		vmInterface.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(perf.COMMAND_PERF, perf.COMMAND_BOOT_STORM,
+			"--template=fedora", "--count=3", "--wait-guest-boot-hooks", "--output=json")()
+		Expect(err).ToNot(HaveOccurred())
+
+		var summaries []perf.Summary
+		Expect(json.Unmarshal(out, &summaries)).To(Succeed())
+		Expect(summaries).To(HaveLen(2))
+		Expect(summaries[0].Name).To(Equal("boot"))
+		Expect(summaries[0].Count).To(Equal(3))
+		Expect(summaries[0].Failed).To(BeZero())
+		Expect(summaries[1].Name).To(Equal("guest-boot-hooks"))
+		Expect(summaries[1].Count).To(Equal(3))
+
+		for _, vm := range created {
+			Expect(vm.Name).To(HavePrefix("fedora-"))
+			Expect(vm.Labels).To(HaveKey(perf.RunLabel))
+			Expect(vm.Spec.Template.ObjectMeta.Labels).To(HaveKeyWithValue(perf.RunLabel, vm.Labels[perf.RunLabel]))
+			Expect(*vm.Spec.RunStrategy).To(Equal(v1.RunStrategyAlways))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+			Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal(vm.Spec.Template.Spec.Volumes[0].DataVolume.Name))
+			Expect(vm.Spec.DataVolumeTemplates[0].Name).ToNot(Equal("fedora-disk"))
+		}
+	})
+
+	It("should report the VMs which failed to boot and keep the VMs", func() {
+		vmiInterface.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, options metav1.ListOptions) (*v1.VirtualMachineInstanceList, error) {
+				list, _ := runningVMIs(ctx, options)
+				for i := range list.Items[1:] {
+					list.Items[i+1].Status.Phase = v1.Failed
+				}
+				return list, nil
+			}).AnyTimes()
+
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(perf.COMMAND_PERF, perf.COMMAND_BOOT_STORM,
+			"--template=fedora", "--count=3", "--keep", "--output=json")()
+		Expect(err).ToNot(HaveOccurred())
+
+		var summaries []perf.Summary
+		Expect(json.Unmarshal(out, &summaries)).To(Succeed())
+		Expect(summaries).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Name":   Equal("boot"),
+			"Count":  Equal(1),
+			"Failed": Equal(2),
+		})))
+	})
+})
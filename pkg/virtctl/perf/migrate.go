@@ -0,0 +1,163 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package perf
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const COMMAND_MIGRATE = "migrate"
+
+type migrate struct {
+	clientConfig clientcmd.ClientConfig
+
+	vms     []string
+	count   int
+	timeout time.Duration
+	output  string
+}
+
+func NewMigrateCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := migrate{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   COMMAND_MIGRATE,
+		Short: "Live migrate running VMs repeatedly and report the migration latency.",
+		Long: `Live migrates the given VMs, all at once, as many times as requested, and measures the time from the creation of each migration until it succeeds.
+The migrations are labeled with ` + RunLabel + `, the next round starts once all the migrations of the current one ended.`,
+		Example: migrateUsage(),
+		Args:    cobra.NoArgs,
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringSliceVar(&c.vms, "vm", nil, "Name of a running VM to migrate, can be repeated.")
+	if err := cmd.MarkFlagRequired("vm"); err != nil {
+		panic(err)
+	}
+	cmd.Flags().IntVar(&c.count, "count", 1, "Number of times each VM is migrated.")
+	cmd.Flags().DurationVar(&c.timeout, "timeout", 10*time.Minute, "Time to wait for a migration to end, the migrations which did not end by then are reported as failed.")
+	cmd.Flags().StringVarP(&c.output, "output", "o", outputText, "Format of the report: text or json.")
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func migrateUsage() string {
+	return `  # Migrate the VM 'fedora' 10 times and report the migration latency:
+  {{ProgramName}} perf migrate --vm=fedora --count=10
+
+  # Migrate the VMs 'fedora' and 'windows' concurrently, 5 times:
+  {{ProgramName}} perf migrate --vm=fedora,windows --count=5`
+}
+
+func (c *migrate) run(cmd *cobra.Command, _ []string) error {
+	if err := validateOutput(c.output); err != nil {
+		return err
+	}
+	if c.count < 1 {
+		return fmt.Errorf("count must be at least 1")
+	}
+
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	client, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	runID := rand.String(5)
+	var (
+		lock      sync.Mutex
+		latencies []time.Duration
+		failed    int
+	)
+	for round := 0; round < c.count; round++ {
+		var wg sync.WaitGroup
+		for _, vmName := range c.vms {
+			wg.Add(1)
+			go func(vmName string) {
+				defer wg.Done()
+				latency, err := c.migrateVM(client, namespace, vmName, runID)
+
+				lock.Lock()
+				defer lock.Unlock()
+				if err != nil {
+					cmd.PrintErrf("failed to migrate VM %s: %v\n", vmName, err)
+					failed++
+					return
+				}
+				latencies = append(latencies, latency)
+			}(vmName)
+		}
+		wg.Wait()
+	}
+
+	return writeSummaries(cmd.OutOrStdout(), c.output, []Summary{Summarize("migration", latencies, failed)})
+}
+
+func (c *migrate) migrateVM(client kubecli.KubevirtClient, namespace, vmName, runID string) (time.Duration, error) {
+	ctx := context.Background()
+	migration := &v1.VirtualMachineInstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: vmName + "-perf-",
+			Namespace:    namespace,
+			Labels:       map[string]string{RunLabel: runID},
+		},
+		Spec: v1.VirtualMachineInstanceMigrationSpec{VMIName: vmName},
+	}
+
+	start := time.Now()
+	migration, err := client.VirtualMachineInstanceMigration(namespace).Create(ctx, migration, metav1.CreateOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	var latency time.Duration
+	err = wait.PollUntilContextTimeout(ctx, pollInterval, c.timeout, true, func(ctx context.Context) (bool, error) {
+		current, err := client.VirtualMachineInstanceMigration(namespace).Get(ctx, migration.Name, metav1.GetOptions{})
+		if err != nil || !current.IsFinal() {
+			return false, nil
+		}
+		if current.Status.Phase == v1.MigrationFailed {
+			return false, fmt.Errorf("migration %s failed", migration.Name)
+		}
+		latency = time.Since(start)
+		return true, nil
+	})
+	if wait.Interrupted(err) {
+		return 0, fmt.Errorf("migration %s did not end within %s", migration.Name, c.timeout)
+	}
+	return latency, err
+}
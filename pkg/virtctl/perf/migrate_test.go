@@ -0,0 +1,97 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package perf_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/perf"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Migration benchmark", func() {
+	var migrationInterface *kubecli.MockVirtualMachineInstanceMigrationInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		migrationInterface = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstanceMigration(metav1.NamespaceDefault).Return(migrationInterface).AnyTimes()
+
+		migrationInterface.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, migration *v1.VirtualMachineInstanceMigration, _ metav1.CreateOptions) (*v1.VirtualMachineInstanceMigration, error) {
+				Expect(migration.Labels).To(HaveKey(perf.RunLabel))
+				migration = migration.DeepCopy()
+				migration.Name = migration.GenerateName + migration.Spec.VMIName
+				return migration, nil
+			}).AnyTimes()
+	})
+
+	withPhase := func(phase v1.VirtualMachineInstanceMigrationPhase) func(context.Context, string, metav1.GetOptions) (*v1.VirtualMachineInstanceMigration, error) {
+		return func(_ context.Context, name string, _ metav1.GetOptions) (*v1.VirtualMachineInstanceMigration, error) {
+			migration := &v1.VirtualMachineInstanceMigration{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			migration.Status.Phase = phase
+			return migration, nil
+		}
+	}
+
+	It("should migrate every VM the requested number of times", func() {
+		migrationInterface.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(withPhase(v1.MigrationSucceeded)).Times(4)
+
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(perf.COMMAND_PERF, perf.COMMAND_MIGRATE,
+			"--vm=fedora,windows", "--count=2", "--output=json")()
+		Expect(err).ToNot(HaveOccurred())
+
+		var summaries []perf.Summary
+		Expect(json.Unmarshal(out, &summaries)).To(Succeed())
+		Expect(summaries).To(HaveLen(1))
+		Expect(summaries[0].Name).To(Equal("migration"))
+		Expect(summaries[0].Count).To(Equal(4))
+		Expect(summaries[0].Failed).To(BeZero())
+	})
+
+	It("should report the failed migrations", func() {
+		migrationInterface.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(withPhase(v1.MigrationFailed)).Times(1)
+
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(perf.COMMAND_PERF, perf.COMMAND_MIGRATE, "--vm=fedora")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(MatchRegexp(`migration\s+0\s+1\s`))
+	})
+
+	It("should require a VM", func() {
+		Expect(clientcmd.NewRepeatableVirtctlCommand(perf.COMMAND_PERF, perf.COMMAND_MIGRATE)()).ToNot(Succeed())
+	})
+
+	It("should reject an unsupported output format", func() {
+		err := clientcmd.NewRepeatableVirtctlCommand(perf.COMMAND_PERF, perf.COMMAND_MIGRATE, "--vm=fedora", "--output=yaml")()
+		Expect(err).To(MatchError(`unsupported output format "yaml", use text or json`))
+	})
+})
@@ -0,0 +1,125 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package perf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_PERF = "perf"
+
+	// RunLabel marks the objects created by a benchmark run, with the ID of the run
+	RunLabel = "perf.kubevirt.io/run"
+
+	outputText = "text"
+	outputJSON = "json"
+
+	pollInterval = 500 * time.Millisecond
+)
+
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_PERF,
+		Short: "Drive a controlled load against the cluster and report its latencies.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Printf(cmd.UsageString())
+		},
+	}
+
+	cmd.AddCommand(NewBootStormCommand(clientConfig))
+	cmd.AddCommand(NewMigrateCommand(clientConfig))
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+// Summary holds the latency percentiles of a measured operation
+type Summary struct {
+	Name   string        `json:"name"`
+	Count  int           `json:"count"`
+	Failed int           `json:"failed"`
+	Min    time.Duration `json:"min"`
+	P50    time.Duration `json:"p50"`
+	P90    time.Duration `json:"p90"`
+	P99    time.Duration `json:"p99"`
+	Max    time.Duration `json:"max"`
+}
+
+// Summarize computes the percentiles of the latencies, with the nearest-rank method
+func Summarize(name string, latencies []time.Duration, failed int) Summary {
+	summary := Summary{Name: name, Count: len(latencies), Failed: failed}
+	if len(latencies) == 0 {
+		return summary
+	}
+
+	sorted := append([]time.Duration{}, latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	percentile := func(p float64) time.Duration {
+		rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+		return sorted[max(rank, 1)-1]
+	}
+	summary.Min = sorted[0]
+	summary.P50 = percentile(50)
+	summary.P90 = percentile(90)
+	summary.P99 = percentile(99)
+	summary.Max = sorted[len(sorted)-1]
+	return summary
+}
+
+func validateOutput(output string) error {
+	if output != outputText && output != outputJSON {
+		return fmt.Errorf("unsupported output format %q, use text or json", output)
+	}
+	return nil
+}
+
+func writeSummaries(w io.Writer, output string, summaries []Summary) error {
+	if output == outputJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(summaries)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "OPERATION\tCOUNT\tFAILED\tMIN\tP50\tP90\tP99\tMAX")
+	for _, s := range summaries {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\n", s.Name, s.Count, s.Failed,
+			round(s.Min), round(s.P50), round(s.P90), round(s.P99), round(s.Max))
+	}
+	return tw.Flush()
+}
+
+func round(d time.Duration) time.Duration {
+	return d.Round(10 * time.Millisecond)
+}
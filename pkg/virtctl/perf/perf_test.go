@@ -0,0 +1,61 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package perf_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/perf"
+)
+
+var _ = Describe("Latency summary", func() {
+	It("should compute the nearest-rank percentiles", func() {
+		var latencies []time.Duration
+		for i := 100; i > 0; i-- {
+			latencies = append(latencies, time.Duration(i)*time.Second)
+		}
+
+		Expect(perf.Summarize("boot", latencies, 3)).To(Equal(perf.Summary{
+			Name:   "boot",
+			Count:  100,
+			Failed: 3,
+			Min:    1 * time.Second,
+			P50:    50 * time.Second,
+			P90:    90 * time.Second,
+			P99:    99 * time.Second,
+			Max:    100 * time.Second,
+		}))
+	})
+
+	It("should use the only sample for every percentile", func() {
+		summary := perf.Summarize("migration", []time.Duration{time.Second}, 0)
+		Expect(summary.Min).To(Equal(time.Second))
+		Expect(summary.P50).To(Equal(time.Second))
+		Expect(summary.P99).To(Equal(time.Second))
+		Expect(summary.Max).To(Equal(time.Second))
+	})
+
+	It("should report no latency without sample", func() {
+		Expect(perf.Summarize("boot", nil, 2)).To(Equal(perf.Summary{Name: "boot", Failed: 2}))
+	})
+})
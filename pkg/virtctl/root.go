@@ -19,15 +19,18 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/create"
 	"kubevirt.io/kubevirt/pkg/virtctl/credentials"
 	"kubevirt.io/kubevirt/pkg/virtctl/expose"
+	"kubevirt.io/kubevirt/pkg/virtctl/flatten"
 	"kubevirt.io/kubevirt/pkg/virtctl/guestfs"
 	"kubevirt.io/kubevirt/pkg/virtctl/imageupload"
 	"kubevirt.io/kubevirt/pkg/virtctl/memorydump"
 	"kubevirt.io/kubevirt/pkg/virtctl/pause"
+	"kubevirt.io/kubevirt/pkg/virtctl/perf"
 	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
 	"kubevirt.io/kubevirt/pkg/virtctl/scp"
 	"kubevirt.io/kubevirt/pkg/virtctl/softreboot"
 	"kubevirt.io/kubevirt/pkg/virtctl/ssh"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+	"kubevirt.io/kubevirt/pkg/virtctl/testbinding"
 	"kubevirt.io/kubevirt/pkg/virtctl/unpause"
 	"kubevirt.io/kubevirt/pkg/virtctl/usbredir"
 	"kubevirt.io/kubevirt/pkg/virtctl/version"
@@ -93,16 +96,20 @@ func NewVirtctlCommand() (*cobra.Command, clientcmd.ClientConfig) {
 		vm.NewRestartCommand(clientConfig),
 		vm.NewMigrateCommand(clientConfig),
 		vm.NewMigrateCancelCommand(clientConfig),
+		vm.NewRenewLeaseCommand(clientConfig),
+		vm.NewModernizeCommand(clientConfig),
 		vm.NewGuestOsInfoCommand(clientConfig),
 		vm.NewUserListCommand(clientConfig),
 		vm.NewFSListCommand(clientConfig),
 		vm.NewAddVolumeCommand(clientConfig),
 		vm.NewRemoveVolumeCommand(clientConfig),
 		vm.NewExpandCommand(clientConfig),
+		vm.NewExportManifestCommand(clientConfig),
 		memorydump.NewMemoryDumpCommand(clientConfig),
 		pause.NewCommand(clientConfig),
 		unpause.NewCommand(clientConfig),
 		softreboot.NewSoftRebootCommand(clientConfig),
+		flatten.NewFlattenCommand(clientConfig),
 		expose.NewCommand(clientConfig),
 		version.VersionCommand(clientConfig),
 		imageupload.NewImageUploadCommand(clientConfig),
@@ -111,6 +118,8 @@ func NewVirtctlCommand() (*cobra.Command, clientcmd.ClientConfig) {
 		create.NewCommand(clientConfig),
 		credentials.NewCommand(clientConfig),
 		adm.NewCommand(clientConfig),
+		testbinding.NewCommand(clientConfig),
+		perf.NewCommand(clientConfig),
 		optionsCmd,
 	)
 	return rootCmd, clientConfig
@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package testbinding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/network/conformance"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_TEST_BINDING = "test-binding"
+
+	outputText = "text"
+	outputJSON = "json"
+)
+
+type command struct {
+	clientConfig clientcmd.ClientConfig
+
+	networkName string
+	image       string
+	scenarios   []string
+	timeout     time.Duration
+	output      string
+}
+
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := command{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   "test-binding (BINDING)",
+		Short: "Run the conformance scenarios against a network binding plugin.",
+		Long: `Creates VMs with an interface bound with the given binding plugin, as registered in the KubeVirt CR, and checks that the plugin supports the features of KubeVirt.
+The VMs are created in the namespace of the context and removed once their scenario ends. The command fails when a scenario fails.
+
+Scenarios:
+` + scenariosUsage(),
+		Example: usage(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVar(&c.networkName, "network", "", "Name of the network attachment definition the interface is connected to. The pod network is used when not set.")
+	cmd.Flags().StringVar(&c.image, "image", conformance.DefaultImage, "Container disk the VMs boot from.")
+	cmd.Flags().StringSliceVar(&c.scenarios, "scenarios", nil, "Comma separated list of the scenarios to run. All the scenarios run when not set.")
+	cmd.Flags().DurationVar(&c.timeout, "timeout", conformance.DefaultTimeout, "Timeout of each step of a scenario, e.g. the start of a VM or a migration.")
+	cmd.Flags().StringVarP(&c.output, "output", "o", outputText, "Format of the report: text or json.")
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Test the passt binding plugin on the pod network:
+  {{ProgramName}} test-binding passt
+
+  # Test the macvtap binding plugin on a secondary network, reporting as JSON:
+  {{ProgramName}} test-binding macvtap --network=macvtap-net --output=json
+
+  # Run only the migration and hotplug scenarios:
+  {{ProgramName}} test-binding macvtap --network=macvtap-net --scenarios=migration,hotplug`
+}
+
+func scenariosUsage() string {
+	var lines []string
+	for _, scenario := range conformance.Scenarios() {
+		lines = append(lines, fmt.Sprintf("  %-12s %s", scenario.Name, scenario.Description))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	if c.output != outputText && c.output != outputJSON {
+		return fmt.Errorf("unsupported output format %q, use text or json", c.output)
+	}
+	scenarios, err := conformance.SelectScenarios(conformance.Scenarios(), c.scenarios)
+	if err != nil {
+		return err
+	}
+
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	client, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	env := conformance.NewEnv(client, conformance.Config{
+		Namespace:   namespace,
+		Binding:     args[0],
+		NetworkName: c.networkName,
+		Image:       c.image,
+		Timeout:     c.timeout,
+	})
+	report := conformance.Run(context.Background(), env, scenarios)
+
+	if c.output == outputJSON {
+		err = report.WriteJSON(cmd.OutOrStdout())
+	} else {
+		err = report.WriteText(cmd.OutOrStdout())
+	}
+	if err != nil {
+		return err
+	}
+
+	if failed := report.Count(conformance.StatusFailed); failed > 0 {
+		return fmt.Errorf("%d of %d scenario(s) failed", failed, len(report.Results))
+	}
+	return nil
+}
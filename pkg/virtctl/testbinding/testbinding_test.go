@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package testbinding_test
+
+import (
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"kubevirt.io/kubevirt/tests/clientcmd"
+
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/network/conformance"
+	"kubevirt.io/kubevirt/pkg/virtctl/testbinding"
+)
+
+var _ = Describe("Testing a binding plugin", func() {
+	BeforeEach(func() {
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+	})
+
+	It("should fail without binding", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(testbinding.COMMAND_TEST_BINDING)
+		Expect(cmd()).ToNot(Succeed())
+	})
+
+	It("should fail with an unsupported output format", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(testbinding.COMMAND_TEST_BINDING, "passt", "--output=yaml")
+		Expect(cmd()).To(MatchError(`unsupported output format "yaml", use text or json`))
+	})
+
+	It("should fail with an unknown scenario", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(testbinding.COMMAND_TEST_BINDING, "passt", "--scenarios=boot,unknown")
+		Expect(cmd()).To(MatchError(`unknown scenario "unknown"`))
+	})
+
+	It("should report the selected scenarios", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommandWithOut(testbinding.COMMAND_TEST_BINDING, "passt", "--scenarios=hotplug", "--output=json")
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+
+		var report conformance.Report
+		Expect(json.Unmarshal(out, &report)).To(Succeed())
+		Expect(report.Binding).To(Equal("passt"))
+		Expect(report.Results).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Scenario": Equal("hotplug"),
+			"Status":   Equal(conformance.StatusSkipped),
+		})))
+	})
+})
@@ -22,6 +22,7 @@ package vm
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
@@ -35,6 +36,7 @@ const (
 	dryRunCommandUsage    = "--dry-run=false: Flag used to set whether to perform a dry run or not. If true the command will be executed without performing any changes."
 
 	dryRunArg      = "dry-run"
+	durationArg    = "duration"
 	forceArg       = "force"
 	gracePeriodArg = "grace-period"
 	persistArg     = "persist"
@@ -44,11 +46,12 @@ const (
 )
 
 var (
-	forceRestart bool
-	gracePeriod  int64
-	volumeName   string
-	persist      bool
-	dryRun       bool
+	forceRestart  bool
+	gracePeriod   int64
+	volumeName    string
+	persist       bool
+	dryRun        bool
+	leaseDuration time.Duration
 )
 
 type Command struct {
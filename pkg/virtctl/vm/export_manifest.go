@@ -0,0 +1,306 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"sigs.k8s.io/yaml"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_EXPORT_MANIFEST = "export-manifest"
+
+	schemaArg = "schema"
+
+	schemaMinimal = "minimal"
+	schemaFull    = "full"
+
+	lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+var schema string
+
+// defaultedFields are the fields of the spec the server may set on its own. They are left out of
+// the minimal manifests when the server sets them to the same values again.
+var defaultedFields = [][]string{
+	{"spec", "instancetype", "kind"},
+	{"spec", "instancetype", "revisionName"},
+	{"spec", "preference", "kind"},
+	{"spec", "preference", "revisionName"},
+	{"spec", "template", "spec", "architecture"},
+	{"spec", "template", "spec", "domain", "machine"},
+}
+
+func NewExportManifestCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "export-manifest vm/(VM)",
+		Short:   "Export a stable manifest of an existing virtual machine, re-applying it is a no-op.",
+		Example: usageExportManifest(),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := Command{command: COMMAND_EXPORT_MANIFEST, clientConfig: clientConfig}
+			return c.exportManifestRun(cmd, args)
+		},
+	}
+	cmd.Flags().StringVar(&schema, schemaArg, schemaMinimal, "Specify the schema of the manifest, \"minimal\" leaves out the values defaulted by the server, \"full\" keeps them.")
+	cmd.Flags().StringVarP(&outputFormat, outputFormatArg, outputFormatArgShort, YAML, "Specify a format that will be used to display output.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageExportManifest() string {
+	return `  # Export the minimal manifest of a virtual machine called 'myvm', e.g. to adopt it in an infrastructure as code tool:
+  {{ProgramName}} export-manifest vm/myvm > myvm.yaml
+
+  # Export the manifest of a virtual machine called 'myvm' in the namespace 'mynamespace', with the values defaulted by the server:
+  {{ProgramName}} export-manifest vm/myvm.mynamespace --schema full
+
+  # Export the minimal manifest of a virtual machine called 'myvm' in json format:
+  {{ProgramName}} export-manifest vm/myvm --output json`
+}
+
+func (o *Command) exportManifestRun(cmd *cobra.Command, args []string) error {
+	kind, namespace, vmName, err := templates.ParseTarget(args[0])
+	if err != nil {
+		return err
+	}
+	if !templates.KindIsVM(kind) {
+		return fmt.Errorf("unsupported resource kind %s, only manifests of VMs can be exported", kind)
+	}
+	if schema != schemaMinimal && schema != schemaFull {
+		return fmt.Errorf("error not supported schema defined: %s", schema)
+	}
+	if outputFormat != YAML && outputFormat != JSON {
+		return fmt.Errorf("error not supported output format defined: %s", outputFormat)
+	}
+
+	virtClient, defaultNamespace, err := GetNamespaceAndClient(o.clientConfig)
+	if err != nil {
+		return err
+	}
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(context.Background(), vmName, k8smetav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Error getting VirtualMachine %s: %v", vmName, err)
+	}
+
+	manifest, err := exportManifest(virtClient, vm, schema)
+	if err != nil {
+		return fmt.Errorf("Error exporting the manifest of VirtualMachine %s: %v", vmName, err)
+	}
+
+	var output []byte
+	switch outputFormat {
+	case JSON:
+		output, err = json.MarshalIndent(manifest, "", " ")
+	case YAML:
+		output, err = yaml.Marshal(manifest)
+	}
+	if err != nil {
+		return err
+	}
+	cmd.Print(string(output))
+	return nil
+}
+
+// exportManifest returns the manifest of the VM without its status and the metadata managed by the
+// server. Its spec is checked with a dry run update to be the one of the VM once defaulted by the
+// server, so that re-applying the manifest is a no-op.
+func exportManifest(virtClient kubecli.KubevirtClient, vm *v1.VirtualMachine, schema string) (map[string]interface{}, error) {
+	manifest, err := manifestOf(vm)
+	if err != nil {
+		return nil, err
+	}
+
+	if schema == schemaMinimal {
+		if err := pruneEmptyValues(manifest, manifest, vm); err != nil {
+			return nil, err
+		}
+	}
+
+	noop, err := isNoopUpdate(virtClient, vm, manifest)
+	if err != nil {
+		return nil, err
+	}
+	if !noop {
+		return nil, fmt.Errorf("re-applying the manifest would change the VM")
+	}
+
+	if schema == schemaMinimal {
+		for _, path := range defaultedFields {
+			value, removed := removeField(manifest, path)
+			if !removed {
+				continue
+			}
+			if noop, err := isNoopUpdate(virtClient, vm, manifest); err != nil || !noop {
+				setField(manifest, path, value)
+			}
+		}
+	}
+	return manifest, nil
+}
+
+// manifestOf returns the VM as an unstructured manifest, holding only the metadata set by users
+func manifestOf(vm *v1.VirtualMachine) (map[string]interface{}, error) {
+	spec, err := toUnstructured(vm.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]interface{}{
+		"name":      vm.Name,
+		"namespace": vm.Namespace,
+	}
+	if len(vm.Labels) > 0 {
+		metadata["labels"] = vm.Labels
+	}
+	annotations := map[string]string{}
+	for key, value := range vm.Annotations {
+		switch key {
+		case lastAppliedConfigAnnotation, v1.ControllerAPILatestVersionObservedAnnotation, v1.ControllerAPIStorageVersionObservedAnnotation:
+		default:
+			annotations[key] = value
+		}
+	}
+	if len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+
+	return map[string]interface{}{
+		"apiVersion": v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+		"kind":       v1.VirtualMachineGroupVersionKind.Kind,
+		"metadata":   metadata,
+		"spec":       spec,
+	}, nil
+}
+
+// pruneEmptyValues removes the null and empty values of the spec which don't change the VM they
+// are decoded into
+func pruneEmptyValues(object, manifest map[string]interface{}, vm *v1.VirtualMachine) error {
+	for key, value := range object {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			if err := pruneEmptyValues(value, manifest, vm); err != nil {
+				return err
+			}
+			if len(value) > 0 {
+				continue
+			}
+		case []interface{}:
+			for _, item := range value {
+				if item, ok := item.(map[string]interface{}); ok {
+					if err := pruneEmptyValues(item, manifest, vm); err != nil {
+						return err
+					}
+				}
+			}
+			if len(value) > 0 {
+				continue
+			}
+		case nil:
+		default:
+			continue
+		}
+
+		delete(object, key)
+		spec, err := specOf(manifest)
+		if err != nil {
+			return err
+		}
+		if !equality.Semantic.DeepEqual(spec, &vm.Spec) {
+			object[key] = value
+		}
+	}
+	return nil
+}
+
+// isNoopUpdate tells whether updating the VM with the spec of the manifest leaves it unchanged once
+// the spec was defaulted by the server
+func isNoopUpdate(virtClient kubecli.KubevirtClient, vm *v1.VirtualMachine, manifest map[string]interface{}) (bool, error) {
+	spec, err := specOf(manifest)
+	if err != nil {
+		return false, err
+	}
+	updatedVM := vm.DeepCopy()
+	updatedVM.Spec = *spec
+	updatedVM, err = virtClient.VirtualMachine(vm.Namespace).Update(context.Background(), updatedVM, k8smetav1.UpdateOptions{DryRun: []string{k8smetav1.DryRunAll}})
+	if err != nil {
+		return false, err
+	}
+	return equality.Semantic.DeepEqual(updatedVM.Spec, vm.Spec), nil
+}
+
+func specOf(manifest map[string]interface{}) (*v1.VirtualMachineSpec, error) {
+	data, err := json.Marshal(manifest["spec"])
+	if err != nil {
+		return nil, err
+	}
+	spec := &v1.VirtualMachineSpec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func toUnstructured(obj interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	object := map[string]interface{}{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+func removeField(object map[string]interface{}, path []string) (interface{}, bool) {
+	for _, key := range path[:len(path)-1] {
+		nested, ok := object[key].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		object = nested
+	}
+	value, exists := object[path[len(path)-1]]
+	delete(object, path[len(path)-1])
+	return value, exists
+}
+
+func setField(object map[string]interface{}, path []string, value interface{}) {
+	for _, key := range path[:len(path)-1] {
+		object = object[key].(map[string]interface{})
+	}
+	object[path[len(path)-1]] = value
+}
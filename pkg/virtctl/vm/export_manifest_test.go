@@ -0,0 +1,167 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Export manifest command", func() {
+	var vmInterface *kubecli.MockVirtualMachineInterface
+	var ctrl *gomock.Controller
+	const vmName = "testvm"
+
+	// defaultVM sets the fields defaulted by the server on the VM
+	defaultVM := func(vm *v1.VirtualMachine) {
+		if vm.Spec.Instancetype != nil && vm.Spec.Instancetype.Kind == "" {
+			vm.Spec.Instancetype.Kind = "virtualmachineclusterinstancetype"
+		}
+		if vm.Spec.Template.Spec.Domain.Machine == nil {
+			vm.Spec.Template.Spec.Domain.Machine = &v1.Machine{Type: "q35"}
+		}
+	}
+
+	newVM := func() *v1.VirtualMachine {
+		vm := &v1.VirtualMachine{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:            vmName,
+				Namespace:       k8smetav1.NamespaceDefault,
+				UID:             "1234",
+				ResourceVersion: "42",
+				Generation:      3,
+				Labels:          map[string]string{"app": "web"},
+				Annotations: map[string]string{
+					"kubectl.kubernetes.io/last-applied-configuration": "{}",
+					v1.ControllerAPILatestVersionObservedAnnotation:    "v1",
+					"description": "web server",
+				},
+				Finalizers: []string{v1.VirtualMachineControllerFinalizer},
+			},
+			Spec: v1.VirtualMachineSpec{
+				RunStrategy:  pointer.P(v1.RunStrategyAlways),
+				Instancetype: &v1.InstancetypeMatcher{Name: "u1.medium", RevisionName: "testvm-u1.medium-1"},
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							Features: &v1.Features{SMM: &v1.FeatureState{}},
+						},
+					},
+				},
+			},
+			Status: v1.VirtualMachineStatus{Ready: true},
+		}
+		defaultVM(vm)
+		return vm
+	}
+
+	expectDryRunUpdates := func(vm *v1.VirtualMachine, defaulting func(*v1.VirtualMachine)) {
+		vmInterface.EXPECT().Update(context.Background(), gomock.Any(), k8smetav1.UpdateOptions{DryRun: []string{k8smetav1.DryRunAll}}).DoAndReturn(
+			func(_ context.Context, updatedVM *v1.VirtualMachine, _ k8smetav1.UpdateOptions) (*v1.VirtualMachine, error) {
+				Expect(updatedVM.ResourceVersion).To(Equal(vm.ResourceVersion))
+				updatedVM = updatedVM.DeepCopy()
+				defaulting(updatedVM)
+				return updatedVM, nil
+			}).AnyTimes()
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+	})
+
+	It("should fail with a VMI", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand("export-manifest", "vmi/"+vmName)
+		Expect(cmd()).To(MatchError(ContainSubstring("only manifests of VMs can be exported")))
+	})
+
+	It("should fail with an unknown schema", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand("export-manifest", "vm/"+vmName, "--schema", "compact")
+		Expect(cmd()).To(MatchError(ContainSubstring("not supported schema")))
+	})
+
+	It("should leave out the status, the server metadata and the values defaulted by the server", func() {
+		vm := newVM()
+		vmInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(vm, nil)
+		expectDryRunUpdates(vm, defaultVM)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommandWithOut("export-manifest", "vm/"+vmName)
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal(`apiVersion: kubevirt.io/v1
+kind: VirtualMachine
+metadata:
+  annotations:
+    description: web server
+  labels:
+    app: web
+  name: testvm
+  namespace: default
+spec:
+  instancetype:
+    name: u1.medium
+    revisionName: testvm-u1.medium-1
+  runStrategy: Always
+  template:
+    spec:
+      domain:
+        features:
+          smm: {}
+`))
+	})
+
+	It("should keep the values defaulted by the server with the full schema", func() {
+		vm := newVM()
+		vmInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(vm, nil)
+		expectDryRunUpdates(vm, defaultVM)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommandWithOut("export-manifest", "vm/"+vmName, "--schema", "full", "--output", "json")
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring(`"kind": "virtualmachineclusterinstancetype"`))
+		Expect(string(out)).To(ContainSubstring(`"type": "q35"`))
+		Expect(string(out)).ToNot(ContainSubstring("status"))
+		Expect(string(out)).ToNot(ContainSubstring("resourceVersion"))
+	})
+
+	It("should fail when re-applying the manifest would change the VM", func() {
+		vm := newVM()
+		vmInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(vm, nil)
+		expectDryRunUpdates(vm, func(vm *v1.VirtualMachine) {
+			vm.Spec.RunStrategy = pointer.P(v1.RunStrategyHalted)
+		})
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand("export-manifest", "vm/"+vmName)
+		Expect(cmd()).To(MatchError(ContainSubstring("re-applying the manifest would change the VM")))
+	})
+})
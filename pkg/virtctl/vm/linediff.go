@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import "strings"
+
+// diffContext is the number of unchanged lines printed around the changed ones
+const diffContext = 2
+
+// lineDiff returns the lines removed from a prefixed with "-", the lines added to b prefixed with "+"
+// and the unchanged lines around them prefixed with " ". Skipped unchanged lines are shown as "...".
+func lineDiff(a, b []string) string {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []string
+	var changed []bool
+	for i, j := 0, 0; i < len(a) || j < len(b); {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, " "+a[i])
+			changed = append(changed, false)
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, "-"+a[i])
+			changed = append(changed, true)
+			i++
+		default:
+			lines = append(lines, "+"+b[j])
+			changed = append(changed, true)
+			j++
+		}
+	}
+
+	var diff strings.Builder
+	skipped := false
+	for k, line := range lines {
+		if !nearChange(changed, k) {
+			skipped = true
+			continue
+		}
+		if skipped && diff.Len() > 0 {
+			diff.WriteString("...\n")
+		}
+		skipped = false
+		diff.WriteString(line + "\n")
+	}
+	return diff.String()
+}
+
+// nearChange returns whether a line is changed or at most diffContext lines away from a changed one
+func nearChange(changed []bool, k int) bool {
+	for n := max(0, k-diffContext); n <= min(len(changed)-1, k+diffContext); n++ {
+		if changed[n] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Line diff", func() {
+	lines := func(from, to int) []string {
+		var l []string
+		for n := from; n <= to; n++ {
+			l = append(l, fmt.Sprintf("l%d", n))
+		}
+		return l
+	}
+	concat := func(parts ...[]string) []string {
+		var l []string
+		for _, part := range parts {
+			l = append(l, part...)
+		}
+		return l
+	}
+
+	DescribeTable("should print", func(a, b []string, expected ...string) {
+		Expect(lineDiff(a, b)).To(Equal(strings.Join(expected, "")))
+	},
+		Entry("nothing for the same lines", lines(1, 3), lines(1, 3)),
+		Entry("an inserted line", lines(1, 3), concat(lines(1, 2), []string{"x"}, lines(3, 3)),
+			" l1\n", " l2\n", "+x\n", " l3\n"),
+		Entry("a deleted line", lines(1, 3), concat(lines(1, 1), lines(3, 3)),
+			" l1\n", "-l2\n", " l3\n"),
+		Entry("a replaced line as deleted then inserted", lines(1, 3), concat(lines(1, 1), []string{"x"}, lines(3, 3)),
+			" l1\n", "-l2\n", "+x\n", " l3\n"),
+		Entry("the lines added at the end", lines(1, 1), lines(1, 3),
+			" l1\n", "+l2\n", "+l3\n"),
+		Entry("only two unchanged lines around a change", lines(1, 9), concat(lines(1, 4), []string{"x"}, lines(6, 9)),
+			" l3\n", " l4\n", "-l5\n", "+x\n", " l6\n", " l7\n"),
+		Entry("the unchanged lines skipped between two changes", lines(1, 12),
+			concat(lines(1, 1), []string{"x"}, lines(3, 10), []string{"y"}, lines(12, 12)),
+			" l1\n", "-l2\n", "+x\n", " l3\n", " l4\n", "...\n", " l9\n", " l10\n", "-l11\n", "+y\n", " l12\n"),
+	)
+})
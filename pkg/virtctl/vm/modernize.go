@@ -0,0 +1,127 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	v1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const COMMAND_MODERNIZE = "modernize"
+
+func NewModernizeCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "modernize vm/(VM)",
+		Short:   "Rewrite the deprecated API usages of a virtual machine into their current equivalents.",
+		Example: usageModernize(),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := Command{command: COMMAND_MODERNIZE, clientConfig: clientConfig}
+			return c.modernizeRun(cmd, args)
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageModernize() string {
+	return `  # Show the changes modernizing a virtual machine called 'myvm', without applying them:
+  {{ProgramName}} modernize vm/myvm --dry-run
+
+  # Modernize a virtual machine called 'myvm' in the namespace 'mynamespace':
+  {{ProgramName}} modernize vm/myvm.mynamespace`
+}
+
+func (o *Command) modernizeRun(cmd *cobra.Command, args []string) error {
+	kind, namespace, vmName, err := templates.ParseTarget(args[0])
+	if err != nil {
+		return err
+	}
+	if !templates.KindIsVM(kind) {
+		return fmt.Errorf("unsupported resource kind %s, only VMs can be modernized", kind)
+	}
+
+	virtClient, defaultNamespace, err := GetNamespaceAndClient(o.clientConfig)
+	if err != nil {
+		return err
+	}
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(context.Background(), vmName, k8smetav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Error getting VirtualMachine %s: %v", vmName, err)
+	}
+
+	dryRunOption := setDryRunOption(dryRun)
+	result, err := virtClient.VirtualMachine(namespace).Modernize(context.Background(), vmName, &v1.ModernizeOptions{DryRun: dryRunOption})
+	if err != nil {
+		return fmt.Errorf("Error modernizing VirtualMachine %s: %v", vmName, err)
+	}
+
+	if len(result.Changes) == 0 {
+		cmd.Printf("VM %s has no deprecated API usage to modernize\n", vmName)
+	} else {
+		cmd.Printf("VM %s was modernized:\n", vmName)
+		for _, change := range result.Changes {
+			cmd.Printf("  %s: %s\n", change.Path, change.Message)
+		}
+	}
+	if len(result.Unsupported) > 0 {
+		cmd.Printf("VM %s has deprecated API usages that can't be modernized:\n", vmName)
+		for _, change := range result.Unsupported {
+			cmd.Printf("  %s: %s\n", change.Path, change.Message)
+		}
+	}
+
+	if len(result.Changes) > 0 && result.VirtualMachine != nil {
+		diff, err := specDiff(vm, result.VirtualMachine)
+		if err != nil {
+			return err
+		}
+		cmd.Printf("\n%s", diff)
+	}
+
+	return nil
+}
+
+// specDiff returns the changes between the specs of the VMs, as a diff of their YAML lines
+func specDiff(vm, modernized *v1.VirtualMachine) (string, error) {
+	before, err := yaml.Marshal(vm.Spec)
+	if err != nil {
+		return "", err
+	}
+	after, err := yaml.Marshal(modernized.Spec)
+	if err != nil {
+		return "", err
+	}
+	return lineDiff(strings.Split(strings.TrimSuffix(string(before), "\n"), "\n"), strings.Split(strings.TrimSuffix(string(after), "\n"), "\n")), nil
+}
@@ -0,0 +1,162 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Modernize command", func() {
+	var vmInterface *kubecli.MockVirtualMachineInterface
+	var ctrl *gomock.Controller
+	const vmName = "testvm"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+	})
+
+	It("should fail with a VMI", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand("modernize", "vmi/"+vmName)
+		Expect(cmd()).To(MatchError(ContainSubstring("only VMs can be modernized")))
+	})
+
+	DescribeTable("should print the changes and their diff", func(modernizeOptions *v1.ModernizeOptions, args ...string) {
+		vm := &v1.VirtualMachine{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: vmName, Namespace: k8smetav1.NamespaceDefault},
+			Spec:       v1.VirtualMachineSpec{Running: pointer.P(true)},
+		}
+		modernized := vm.DeepCopy()
+		modernized.Spec.Running = nil
+		modernized.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(2)
+		vmInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(vm, nil).Times(1)
+		vmInterface.EXPECT().Modernize(context.Background(), vmName, modernizeOptions).Return(&v1.VirtualMachineModernization{
+			Changes:        []v1.ModernizeChange{{Path: "spec.running", Message: "replaced by runStrategy Always"}},
+			Unsupported:    []v1.ModernizeChange{{Path: "spec.template.spec.domain.devices.interfaces[0].slirp", Message: "slirp has no equivalent"}},
+			VirtualMachine: modernized,
+		}, nil).Times(1)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommandWithOut(append([]string{"modernize", "vm/" + vmName}, args...)...)
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal(`VM testvm was modernized:
+  spec.running: replaced by runStrategy Always
+VM testvm has deprecated API usages that can't be modernized:
+  spec.template.spec.domain.devices.interfaces[0].slirp: slirp has no equivalent
+
+-running: true
++runStrategy: Always
+ template: null
+`))
+	},
+		Entry("without options", &v1.ModernizeOptions{}),
+		Entry("with dry-run option", &v1.ModernizeOptions{DryRun: []string{k8smetav1.DryRunAll}}, "--dry-run"),
+	)
+
+	It("should only print the lines around the changes in the diff", func() {
+		vm := &v1.VirtualMachine{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: vmName, Namespace: k8smetav1.NamespaceDefault},
+			Spec: v1.VirtualMachineSpec{
+				Running: pointer.P(true),
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					ObjectMeta: k8smetav1.ObjectMeta{
+						Annotations: map[string]string{"kubevirt.io/nonroot": ""},
+						Labels:      map[string]string{"a": "1", "b": "2", "c": "3", "d": "4"},
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							Devices: v1.Devices{
+								Interfaces: []v1.Interface{{
+									Name:                   "default",
+									InterfaceBindingMethod: v1.InterfaceBindingMethod{DeprecatedMacvtap: &v1.DeprecatedInterfaceMacvtap{}},
+								}},
+							},
+						},
+					},
+				},
+			},
+		}
+		modernized := vm.DeepCopy()
+		modernized.Spec.Template.ObjectMeta.Annotations = nil
+		modernized.Spec.Template.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = v1.InterfaceBindingMethod{}
+		modernized.Spec.Template.Spec.Domain.Devices.Interfaces[0].Binding = &v1.PluginBinding{Name: "macvtap"}
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(2)
+		vmInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(vm, nil).Times(1)
+		vmInterface.EXPECT().Modernize(context.Background(), vmName, &v1.ModernizeOptions{}).Return(&v1.VirtualMachineModernization{
+			Changes: []v1.ModernizeChange{
+				{Path: "spec.template.metadata.annotations[kubevirt.io/nonroot]", Message: "removed, VMIs always run as non-root"},
+				{Path: "spec.template.spec.domain.devices.interfaces[0].macvtap", Message: "replaced by the macvtap network binding plugin"},
+			},
+			VirtualMachine: modernized,
+		}, nil).Times(1)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommandWithOut("modernize", "vm/"+vmName)
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal(`VM testvm was modernized:
+  spec.template.metadata.annotations[kubevirt.io/nonroot]: removed, VMIs always run as non-root
+  spec.template.spec.domain.devices.interfaces[0].macvtap: replaced by the macvtap network binding plugin
+
+ template:
+   metadata:
+-    annotations:
+-      kubevirt.io/nonroot: ""
+     creationTimestamp: null
+     labels:
+...
+       devices:
+         interfaces:
+-        - macvtap: {}
++        - binding:
++            name: macvtap
+           name: default
+       resources: {}
+`))
+	})
+
+	It("should report a VM without deprecated API usage", func() {
+		vm := &v1.VirtualMachine{ObjectMeta: k8smetav1.ObjectMeta{Name: vmName, Namespace: k8smetav1.NamespaceDefault}}
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(2)
+		vmInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(vm, nil).Times(1)
+		vmInterface.EXPECT().Modernize(context.Background(), vmName, &v1.ModernizeOptions{}).Return(&v1.VirtualMachineModernization{VirtualMachine: vm}, nil).Times(1)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommandWithOut("modernize", "vm/"+vmName)
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("has no deprecated API usage to modernize"))
+	})
+})
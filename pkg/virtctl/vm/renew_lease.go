@@ -0,0 +1,77 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const COMMAND_RENEWLEASE = "renewlease"
+
+func NewRenewLeaseCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "renewlease (VM)",
+		Short:   "Renew the lease of a virtual machine.",
+		Example: "  # Renew the lease of a virtual machine called 'myvm' for 8 hours:\n  {{ProgramName}} renewlease myvm --duration 8h",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := Command{command: COMMAND_RENEWLEASE, clientConfig: clientConfig}
+			return c.renewLeaseRun(args)
+		},
+	}
+	cmd.Flags().DurationVar(&leaseDuration, durationArg, 0, "--duration=8h: How long after the renewal the lease expires.")
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	if err := cmd.MarkFlagRequired(durationArg); err != nil {
+		panic(err)
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func (o *Command) renewLeaseRun(args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, err := GetNamespaceAndClient(o.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	dryRunOption := setDryRunOption(dryRun)
+
+	err = virtClient.VirtualMachine(namespace).RenewLease(context.Background(), vmName, &v1.RenewLeaseOptions{
+		Duration: metav1.Duration{Duration: leaseDuration},
+		DryRun:   dryRunOption,
+	})
+	if err != nil {
+		return fmt.Errorf("Error renewing the lease of VirtualMachine %s: %v", vmName, err)
+	}
+
+	fmt.Printf("The lease of VM %s was renewed for %s\n", vmName, leaseDuration)
+
+	return nil
+}
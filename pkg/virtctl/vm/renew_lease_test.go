@@ -0,0 +1,64 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Renew lease command", func() {
+	var vmInterface *kubecli.MockVirtualMachineInterface
+	var ctrl *gomock.Controller
+	const vmName = "testvm"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+	})
+
+	It("should fail without a duration", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand("renewlease", vmName)
+		Expect(cmd()).To(MatchError(ContainSubstring(`required flag(s) "duration" not set`)))
+	})
+
+	DescribeTable("should renew the lease of a vm according to options", func(renewLeaseOptions *v1.RenewLeaseOptions, args ...string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().RenewLease(context.Background(), vmName, renewLeaseOptions).Return(nil).Times(1)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand(append([]string{"renewlease", vmName}, args...)...)
+		Expect(cmd()).To(Succeed())
+	},
+		Entry("with a duration", &v1.RenewLeaseOptions{Duration: k8smetav1.Duration{Duration: 8 * time.Hour}}, "--duration", "8h"),
+		Entry("with dry-run option", &v1.RenewLeaseOptions{Duration: k8smetav1.Duration{Duration: time.Hour}, DryRun: []string{k8smetav1.DryRunAll}}, "--duration", "1h", "--dry-run"),
+	)
+})
@@ -0,0 +1,316 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmexport
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	pb "github.com/cheggaaa/pb/v3"
+
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+const (
+	// progressFileSuffix is appended to the output file to name the file tracking the downloaded ranges
+	progressFileSuffix = ".progress"
+
+	rangeHeader        = "Range"
+	contentRangeHeader = "Content-Range"
+	connectionHeader   = "Connection"
+	// wantDigestHeader requests the digest of the exported volume, see RFC 3230
+	wantDigestHeader      = "Want-Digest"
+	digestHeader          = "Digest"
+	sha256DigestAlgorithm = "sha-256"
+)
+
+// RangeSize is the size of the ranges of a volume downloaded in parallel
+var RangeSize int64 = 64 * 1024 * 1024
+
+// downloadProgress tracks the ranges of a volume already downloaded, for an interrupted download to be resumed
+type downloadProgress struct {
+	Size      int64        `json:"size"`
+	RangeSize int64        `json:"rangeSize"`
+	Completed map[int]bool `json:"completed"`
+
+	path string
+	lock sync.Mutex
+}
+
+func loadDownloadProgress(path string, size int64) *downloadProgress {
+	progress := &downloadProgress{}
+	if data, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(data, progress); err != nil {
+			printToOutput("Ignoring the unreadable progress file %s: %v\n", path, err)
+		}
+	}
+	if progress.Size != size || progress.RangeSize != RangeSize || progress.Completed == nil {
+		progress = &downloadProgress{Size: size, RangeSize: RangeSize, Completed: map[int]bool{}}
+	}
+	progress.path = path
+	return progress
+}
+
+func (p *downloadProgress) ranges() int {
+	return int((p.Size + p.RangeSize - 1) / p.RangeSize)
+}
+
+// bounds returns the first and last byte of a range, the last range may be shorter
+func (p *downloadProgress) bounds(index int) (int64, int64) {
+	start := int64(index) * p.RangeSize
+	return start, min(start+p.RangeSize, p.Size) - 1
+}
+
+func (p *downloadProgress) completedBytes() int64 {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var completed int64
+	for index := range p.Completed {
+		start, end := p.bounds(index)
+		completed += end - start + 1
+	}
+	return completed
+}
+
+func (p *downloadProgress) isCompleted(index int) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.Completed[index]
+}
+
+func (p *downloadProgress) complete(index int) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.Completed[index] = true
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p.path, data, 0644)
+}
+
+// downloadVolumeInRanges downloads the volume with several connections in parallel, each one requesting
+// ranges of the volume. The downloaded ranges are tracked, a retry or a new download to the same output file
+// only requests the missing ones. The downloaded volume is then verified against the digest of the export server.
+func downloadVolumeInRanges(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl string) (bool, error) {
+	output, ok := vmeInfo.OutputWriter.(*os.File)
+	if !ok || vmeInfo.OutputFile == "" {
+		return false, fmt.Errorf("the '%s' flag needs '%s' to be a file", PARALLEL_FLAG, OUTPUT_FLAG)
+	}
+
+	// Request the first byte, to learn the size of the volume
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, rangeHeaders(0, 0))
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		// The whole volume is being returned
+		printToOutput("The export server doesn't support range requests, downloading the volume over a single connection\n")
+		if err := output.Truncate(0); err != nil {
+			return false, err
+		}
+		return true, copyFileWithProgressBar(output, resp, false)
+	default:
+		printToOutput("Bad status: %s\n", resp.Status)
+		return false, nil
+	}
+
+	size, err := parseContentRangeSize(resp.Header.Get(contentRangeHeader))
+	if err != nil {
+		return false, err
+	}
+
+	// The export server computes the digest while the ranges are downloaded
+	digestChan := make(chan string, 1)
+	go func() {
+		digestChan <- getVolumeDigest(client, vmexport, vmeInfo, downloadUrl)
+	}()
+
+	progressPath := vmeInfo.OutputFile + progressFileSuffix
+	progress := loadDownloadProgress(progressPath, size)
+	if err := output.Truncate(size); err != nil {
+		return false, err
+	}
+
+	if succeeded := downloadRanges(client, vmexport, vmeInfo, downloadUrl, output, progress); !succeeded {
+		return false, nil
+	}
+
+	if err := verifyVolumeDigest(vmeInfo.OutputFile, <-digestChan); err != nil {
+		// Start the next download from scratch
+		os.Remove(progressPath)
+		return false, err
+	}
+	if err := os.Remove(progressPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+	return true, nil
+}
+
+func downloadRanges(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl string, output *os.File, progress *downloadProgress) bool {
+	barTemplate := fmt.Sprintf(`{{ "Downloading file:" }} {{counters . }} {{ cycle . %s }} {{speed . }}`, progressBarCycle)
+	bar := pb.ProgressBarTemplate(barTemplate).Start64(progress.Size)
+	defer bar.Finish()
+	bar.SetCurrent(progress.completedBytes())
+
+	indexes := make(chan int)
+	failed := make(chan struct{})
+	var failOnce sync.Once
+	var wg sync.WaitGroup
+
+	for i := 0; i < vmeInfo.Parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				if err := downloadRange(client, vmexport, vmeInfo, downloadUrl, output, progress, bar, index); err != nil {
+					printToOutput("Failed to download range %d of the volume: %v\n", index, err)
+					failOnce.Do(func() { close(failed) })
+					return
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for index := 0; index < progress.ranges(); index++ {
+		if progress.isCompleted(index) {
+			continue
+		}
+		select {
+		case indexes <- index:
+		case <-failed:
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	select {
+	case <-failed:
+		return false
+	default:
+		return true
+	}
+}
+
+func downloadRange(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl string, output *os.File, progress *downloadProgress, bar *pb.ProgressBar, index int) error {
+	start, end := progress.bounds(index)
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, rangeHeaders(start, end))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusPartialContent {
+		return fmt.Errorf("bad status: %s", resp.Status)
+	}
+
+	length := end - start + 1
+	n, err := io.Copy(io.NewOffsetWriter(output, start), bar.NewProxyReader(io.LimitReader(resp.Body, length)))
+	if err != nil {
+		return err
+	}
+	if n != length {
+		return fmt.Errorf("received %d bytes instead of %d", n, length)
+	}
+	return progress.complete(index)
+}
+
+func rangeHeaders(start, end int64) map[string]string {
+	return map[string]string{
+		rangeHeader: fmt.Sprintf("bytes=%d-%d", start, end),
+		// Each request gets its own connection, don't leave them open
+		connectionHeader: "close",
+	}
+}
+
+// parseContentRangeSize returns the complete length of a "Content-Range: bytes 0-0/1234" header
+func parseContentRangeSize(contentRange string) (int64, error) {
+	_, size, found := strings.Cut(contentRange, "/")
+	if !found || !strings.HasPrefix(contentRange, "bytes ") {
+		return 0, fmt.Errorf("invalid %s header: %q", contentRangeHeader, contentRange)
+	}
+	length, err := strconv.ParseInt(size, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s header: %q", contentRangeHeader, contentRange)
+	}
+	return length, nil
+}
+
+// getVolumeDigest returns the base64 encoded SHA-256 digest of the exported volume,
+// or an empty string when the export server doesn't provide it
+func getVolumeDigest(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl string) string {
+	headers := rangeHeaders(0, 0)
+	headers[wantDigestHeader] = sha256DigestAlgorithm
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, headers)
+	if err != nil {
+		printToOutput("Failed to get the digest of the volume: %v\n", err)
+		return ""
+	}
+	defer resp.Body.Close()
+
+	for _, digest := range strings.Split(resp.Header.Get(digestHeader), ",") {
+		algorithm, value, found := strings.Cut(strings.TrimSpace(digest), "=")
+		if found && strings.EqualFold(algorithm, sha256DigestAlgorithm) {
+			return value
+		}
+	}
+	return ""
+}
+
+func verifyVolumeDigest(outputFile, expected string) error {
+	if expected == "" {
+		printToOutput("The export server didn't provide the digest of the volume, skipping its verification\n")
+		return nil
+	}
+
+	printToOutput("Verifying the checksum of the volume\n")
+	f, err := os.Open(outputFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return err
+	}
+	if actual := base64.StdEncoding.EncodeToString(hash.Sum(nil)); actual != expected {
+		return fmt.Errorf("the SHA-256 checksum of the downloaded volume %s doesn't match the one of the exported volume %s", actual, expected)
+	}
+	return nil
+}
@@ -86,6 +86,7 @@ const (
 	LABELS_FLAG            = "--labels"
 	ANNOTATIONS_FLAG       = "--annotations"
 	READINESS_TIMEOUT_FLAG = "--readiness-timeout"
+	PARALLEL_FLAG          = "--parallel"
 
 	// Possible output format for manifests
 	OUTPUT_FORMAT_JSON = "json"
@@ -151,6 +152,7 @@ var (
 	resourceLabels       []string
 	resourceAnnotations  []string
 	readinessTimeout     string
+	parallel             int
 )
 
 type VMExportInfo struct {
@@ -176,6 +178,7 @@ type VMExportInfo struct {
 	ReadinessTimeout time.Duration
 	Labels           map[string]string
 	Annotations      map[string]string
+	Parallel         int
 }
 
 type command struct {
@@ -223,6 +226,9 @@ func usage() string {
 	# Create a VirtualMachineExport and download the requested volume from it
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --volume=volume1 --output=disk.img.gz
 
+	# Download a raw volume over 4 connections, resuming the download if it was interrupted
+	{{ProgramName}} vmexport download vm1-export --volume=volume1 --format=raw --parallel=4 --output=disk.img
+
 	# Create a VirtualMachineExport and get the VirtualMachine manifest in Yaml format
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --manifest
 
@@ -268,6 +274,7 @@ func NewVirtualMachineExportCommand(clientConfig clientcmd.ClientConfig) *cobra.
 	cmd.Flags().StringSliceVar(&resourceLabels, "labels", nil, "Specify custom labels to VM export object and its associated pod")
 	cmd.Flags().StringSliceVar(&resourceAnnotations, "annotations", nil, "Specify custom annotations to VM export object and its associated pod")
 	cmd.Flags().StringVar(&readinessTimeout, "readiness-timeout", "", "Specify maximum wait for VM export object to be ready")
+	cmd.Flags().IntVar(&parallel, "parallel", 1, "Number of connections downloading ranges of the volume in parallel. When greater than 1, requires the raw format and an output file, resumes an interrupted download and verifies the checksum of the volume")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
@@ -346,7 +353,14 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	// User wants the output in a file, create
 	if outputFile != "" && outputFile != "-" {
 		vmeInfo.OutputFile = outputFile
-		output, err := os.Create(vmeInfo.OutputFile)
+		var output *os.File
+		var err error
+		if parallel > 1 {
+			// Keep the ranges already downloaded by an interrupted download, to resume it
+			output, err = os.OpenFile(vmeInfo.OutputFile, os.O_RDWR|os.O_CREATE, 0666)
+		} else {
+			output, err = os.Create(vmeInfo.OutputFile)
+		}
 		if err != nil {
 			return err
 		}
@@ -365,6 +379,7 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 		vmeInfo.Decompress = true
 	}
 	vmeInfo.DownloadRetries = downloadRetries
+	vmeInfo.Parallel = parallel
 	vmeInfo.ShouldCreate = shouldCreate
 	vmeInfo.Insecure = insecure
 	vmeInfo.KeepVme = keepVme
@@ -599,6 +614,15 @@ func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMac
 		return false, err
 	}
 
+	if vmeInfo.Parallel > 1 {
+		succeeded, err := downloadVolumeInRanges(client, vmexport, vmeInfo, downloadUrl)
+		if err != nil || !succeeded {
+			return false, err
+		}
+		printToOutput("Download finished succesfully\n")
+		return true, nil
+	}
+
 	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
 	if err != nil {
 		return false, err
@@ -664,6 +688,17 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 		// Access the requested volume
 		if volumeNumber == 1 || exportVolume.Name == vmeInfo.VolumeName {
 			for _, format = range exportVolume.Formats {
+				// Only the raw format can be downloaded in ranges
+				if vmeInfo.Parallel > 1 {
+					if format.Format == exportv1.KubeVirtRaw {
+						downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
+						if err != nil {
+							return "", err
+						}
+						break
+					}
+					continue
+				}
 				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
 					downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
 					if err != nil {
@@ -684,6 +719,9 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 		vmeInfo.Decompress = false
 	}
 
+	if downloadUrl == "" && vmeInfo.Parallel > 1 {
+		return "", fmt.Errorf("unable to get a raw format URL from '%s/%s' VirtualMachineExport, which '%s' requires", vmexport.Namespace, vmexport.Name, PARALLEL_FLAG)
+	}
 	if downloadUrl == "" {
 		return "", fmt.Errorf("unable to get a valid URL from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
 	}
@@ -1023,6 +1061,10 @@ func handleDownloadFlags() error {
 		return fmt.Errorf(ErrInvalidValue, RETRY_FLAG, "positive integers")
 	}
 
+	if parallel < 1 {
+		return fmt.Errorf(ErrInvalidValue, PARALLEL_FLAG, "positive integers")
+	}
+
 	if exportManifest {
 		if volumeName != "" {
 			return fmt.Errorf(ErrIncompatibleFlag, VOLUME_FLAG, MANIFEST_FLAG)
@@ -1041,6 +1083,18 @@ func handleDownloadFlags() error {
 		return fmt.Errorf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", OUTPUT_FLAG, OUTPUT_FLAG)
 	}
 
+	if parallel > 1 {
+		if exportManifest {
+			return fmt.Errorf(ErrIncompatibleFlag, PARALLEL_FLAG, MANIFEST_FLAG)
+		}
+		if outputFile == "-" {
+			return fmt.Errorf("the '%s' flag needs '%s' to be a file", PARALLEL_FLAG, OUTPUT_FLAG)
+		}
+		if format != RAW_FORMAT {
+			return fmt.Errorf(ErrRequiredFlag, FORMAT_FLAG+"="+RAW_FORMAT, PARALLEL_FLAG)
+		}
+	}
+
 	return nil
 }
 
@@ -1,8 +1,11 @@
 package vmexport_test
 
 import (
+	"bytes"
 	"context"
 	cryptorand "crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
@@ -11,6 +14,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -366,6 +370,10 @@ var _ = Describe("vmexport", func() {
 			Entry("Using 'port-forward' with invalid port", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.LOCAL_PORT_FLAG, "valid port numbers"), runDownloadCmd, vmexport.PORT_FORWARD_FLAG, setFlag(vmexport.LOCAL_PORT_FLAG, "test")),
 			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
 			Entry("Downloading volume without specifying output", fmt.Sprintf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", vmexport.OUTPUT_FLAG, vmexport.OUTPUT_FLAG), runDownloadCmd),
+			Entry("Using 'parallel' with an invalid value", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.PARALLEL_FLAG, "positive integers"), runDownloadCmd, setFlag(vmexport.PARALLEL_FLAG, "0")),
+			Entry("Using 'parallel' with 'manifest'", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.PARALLEL_FLAG, vmexport.MANIFEST_FLAG), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.PARALLEL_FLAG, "4")),
+			Entry("Using 'parallel' with stdout", fmt.Sprintf("the '%s' flag needs '%s' to be a file", vmexport.PARALLEL_FLAG, vmexport.OUTPUT_FLAG), runDownloadCmd, setFlag(vmexport.PARALLEL_FLAG, "4"), setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT), setFlag(vmexport.OUTPUT_FLAG, "-")),
+			Entry("Using 'parallel' without the raw format", fmt.Sprintf(vmexport.ErrRequiredFlag, vmexport.FORMAT_FLAG+"="+vmexport.RAW_FORMAT, vmexport.PARALLEL_FLAG), runDownloadCmd, setFlag(vmexport.PARALLEL_FLAG, "4"), setFlag(vmexport.OUTPUT_FLAG, "disk.img")),
 		)
 	})
 
@@ -901,6 +909,167 @@ var _ = Describe("vmexport", func() {
 		})
 	})
 
+	Context("Parallel download", func() {
+		const length = 1000
+
+		var (
+			data              []byte
+			lock              sync.Mutex
+			requestedRanges   []string
+			originalRangeSize int64
+		)
+
+		serveData := func(withDigest bool) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				lock.Lock()
+				requestedRanges = append(requestedRanges, r.Header.Get("Range"))
+				lock.Unlock()
+				if withDigest && r.Header.Get("Want-Digest") == "sha-256" {
+					sum := sha256.Sum256(data)
+					w.Header().Set("Digest", "sha-256="+base64.StdEncoding.EncodeToString(sum[:]))
+				}
+				http.ServeContent(w, r, "disk.img", time.Time{}, bytes.NewReader(data))
+			}
+		}
+
+		createVMExport := func(formats ...exportv1.ExportVolumeFormat) {
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{Name: volumeName}})
+			for _, format := range formats {
+				vme.Status.Links.External.Volumes[0].Formats = append(vme.Status.Links.External.Volumes[0].Formats, exportv1.VirtualMachineExportVolumeFormat{
+					Format: format,
+					Url:    server.URL + "/" + string(format),
+				})
+			}
+			_, err := virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), secret, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		runParallelDownloadCmd := func(args ...string) error {
+			return runDownloadCmd(append([]string{
+				setFlag(vmexport.VOLUME_FLAG, volumeName),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT),
+				setFlag(vmexport.PARALLEL_FLAG, "3"),
+				vmexport.INSECURE_FLAG,
+			}, args...)...)
+		}
+
+		BeforeEach(func() {
+			data = make([]byte, length)
+			_, err := cryptorand.Read(data)
+			Expect(err).ToNot(HaveOccurred())
+			requestedRanges = nil
+
+			originalRangeSize = vmexport.RangeSize
+			vmexport.RangeSize = 100
+		})
+
+		AfterEach(func() {
+			vmexport.RangeSize = originalRangeSize
+		})
+
+		It("should download the raw volume in ranges and verify its checksum", func() {
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/" + string(exportv1.KubeVirtRaw)))
+				serveData(true)(w, r)
+			})
+			createVMExport(exportv1.KubeVirtGz, exportv1.KubeVirtRaw)
+
+			Expect(runParallelDownloadCmd()).To(Succeed())
+
+			outputData, err := os.ReadFile(outputPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outputData).To(Equal(data))
+			Expect(outputPath + ".progress").ToNot(BeAnExistingFile())
+			Expect(requestedRanges).To(ContainElements("bytes=0-99", "bytes=500-599", "bytes=900-999"))
+		})
+
+		It("should resume an interrupted download", func() {
+			server.Config.Handler = serveData(true)
+			createVMExport(exportv1.KubeVirtRaw)
+
+			By("Leaving the first ranges downloaded by an earlier download")
+			Expect(os.WriteFile(outputPath, data[:300], 0644)).To(Succeed())
+			Expect(os.WriteFile(outputPath+".progress", []byte(`{"size":1000,"rangeSize":100,"completed":{"0":true,"1":true,"2":true}}`), 0644)).To(Succeed())
+
+			Expect(runParallelDownloadCmd()).To(Succeed())
+
+			outputData, err := os.ReadFile(outputPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outputData).To(Equal(data))
+			Expect(requestedRanges).ToNot(ContainElements("bytes=0-99", "bytes=100-199", "bytes=200-299"))
+			Expect(requestedRanges).To(ContainElements("bytes=300-399", "bytes=900-999"))
+		})
+
+		It("should fail when the checksum of the volume doesn't match", func() {
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Want-Digest") != "" {
+					w.Header().Set("Digest", "sha-256="+base64.StdEncoding.EncodeToString(make([]byte, sha256.Size)))
+				}
+				http.ServeContent(w, r, "disk.img", time.Time{}, bytes.NewReader(data))
+			})
+			createVMExport(exportv1.KubeVirtRaw)
+
+			Expect(runParallelDownloadCmd()).To(MatchError(ContainSubstring("doesn't match the one of the exported volume")))
+			Expect(outputPath + ".progress").ToNot(BeAnExistingFile())
+		})
+
+		It("should skip the verification when the export server doesn't provide the checksum", func() {
+			server.Config.Handler = serveData(false)
+			createVMExport(exportv1.KubeVirtRaw)
+
+			Expect(runParallelDownloadCmd()).To(Succeed())
+
+			outputData, err := os.ReadFile(outputPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outputData).To(Equal(data))
+		})
+
+		It("should download over a single connection when the export server doesn't support range requests", func() {
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, err := w.Write(data)
+				Expect(err).ToNot(HaveOccurred())
+			})
+			createVMExport(exportv1.KubeVirtRaw)
+
+			Expect(runParallelDownloadCmd()).To(Succeed())
+
+			outputData, err := os.ReadFile(outputPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outputData).To(Equal(data))
+		})
+
+		It("should resume after a failed range when retrying", func() {
+			failed := false
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				lock.Lock()
+				fail := !failed && r.Header.Get("Range") == "bytes=500-599"
+				failed = failed || fail
+				lock.Unlock()
+				if fail {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				serveData(true)(w, r)
+			})
+			createVMExport(exportv1.KubeVirtRaw)
+
+			Expect(runParallelDownloadCmd(setFlag(vmexport.RETRY_FLAG, "1"))).To(Succeed())
+
+			outputData, err := os.ReadFile(outputPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outputData).To(Equal(data))
+		})
+
+		It("should fail when the export has no raw format URL", func() {
+			createVMExport(exportv1.KubeVirtGz)
+
+			Expect(runParallelDownloadCmd()).To(MatchError(ContainSubstring("unable to get a raw format URL")))
+		})
+	})
+
 	Context("getUrlFromVirtualMachineExport", func() {
 		It("Should get compressed URL even when there's multiple URLs", func() {
 			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
@@ -117,7 +117,7 @@ func (o *VNC) Run(cmd *cobra.Command, args []string) error {
 		listenAddress = "127.0.0.1"
 		log.Log.V(2).Infof("--proxy-only is set to false, listening on %s\n", listenAddress)
 	}
-	listenAddressFmt = listenAddress + ":%d"
+	listenAddressFmt = net.JoinHostPort(listenAddress, "%d")
 	lnAddr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf(listenAddressFmt, customPort))
 	if err != nil {
 		return fmt.Errorf("Can't resolve the address: %s", err.Error())
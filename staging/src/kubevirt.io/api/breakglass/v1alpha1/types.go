@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConsoleAccessGrant grants a user a temporary access to the console of a VirtualMachine,
+// e.g. to respond to an incident in an environment where nobody has it otherwise.
+// The access is revoked once the grant expires or is deleted, and each access made with it is recorded.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type ConsoleAccessGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ConsoleAccessGrantSpec `json:"spec"`
+	// +optional
+	Status ConsoleAccessGrantStatus `json:"status,omitempty"`
+}
+
+// ConsoleAccessGrantSpec is the spec of a ConsoleAccessGrant, it can't be changed once created
+type ConsoleAccessGrantSpec struct {
+	// User is the name of the user granted the access
+	User string `json:"user"`
+	// VirtualMachineName is the name of the VirtualMachine the access is granted to,
+	// in the namespace of the grant
+	VirtualMachineName string `json:"virtualMachineName"`
+	// Subresources are the subresources the access is granted to, among console and vnc,
+	// console by default
+	// +listType=set
+	// +optional
+	Subresources []string `json:"subresources,omitempty"`
+	// Duration is the time the access is granted for, from the creation of the grant, at most 24 hours
+	Duration metav1.Duration `json:"duration"`
+	// Reason is the reason the access is granted for, e.g. an incident ticket
+	Reason string `json:"reason"`
+	// GrantedBy is the name of the user granting the access, it has to be the user creating the grant
+	GrantedBy string `json:"grantedBy"`
+}
+
+// ConsoleAccessGrantStatus is the status of a ConsoleAccessGrant
+type ConsoleAccessGrantStatus struct {
+	// Phase is the phase of the grant
+	// +optional
+	Phase ConsoleAccessGrantPhase `json:"phase,omitempty"`
+	// ExpiresAt is the time the access is revoked at
+	// +optional
+	// +nullable
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+	// Accesses are the accesses made with the grant, oldest first
+	// +listType=atomic
+	// +optional
+	Accesses []ConsoleAccessRecord `json:"accesses,omitempty"`
+}
+
+// ConsoleAccessRecord is an access made with a ConsoleAccessGrant
+type ConsoleAccessRecord struct {
+	// Subresource is the subresource accessed
+	Subresource string `json:"subresource"`
+	// Timestamp is the time of the access
+	Timestamp metav1.Time `json:"timestamp"`
+}
+
+// ConsoleAccessGrantPhase is the phase of a ConsoleAccessGrant
+// +kubebuilder:validation:Enum=Active;Expired
+type ConsoleAccessGrantPhase string
+
+const (
+	// ConsoleAccessGrantActive is the phase of a grant whose access is granted
+	ConsoleAccessGrantActive ConsoleAccessGrantPhase = "Active"
+	// ConsoleAccessGrantExpired is the phase of a grant whose access was revoked
+	ConsoleAccessGrantExpired ConsoleAccessGrantPhase = "Expired"
+)
+
+// ConsoleAccessGrantList is a list of ConsoleAccessGrant
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ConsoleAccessGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []ConsoleAccessGrant `json:"items"`
+}
@@ -86,6 +86,27 @@ func (in *AccessCredentialSecretSource) DeepCopy() *AccessCredentialSecretSource
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddChannelOptions) DeepCopyInto(out *AddChannelOptions) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddChannelOptions.
+func (in *AddChannelOptions) DeepCopy() *AddChannelOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AddChannelOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddVolumeOptions) DeepCopyInto(out *AddVolumeOptions) {
 	*out = *in
@@ -117,6 +138,81 @@ func (in *AddVolumeOptions) DeepCopy() *AddVolumeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionWarningsConfiguration) DeepCopyInto(out *AdmissionWarningsConfiguration) {
+	*out = *in
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = make([]AdmissionWarning, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionWarningsConfiguration.
+func (in *AdmissionWarningsConfiguration) DeepCopy() *AdmissionWarningsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionWarningsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertOverride) DeepCopyInto(out *AlertOverride) {
+	*out = *in
+	if in.For != nil {
+		in, out := &in.For, &out.For
+		*out = new(string)
+		**out = **in
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertOverride.
+func (in *AlertOverride) DeepCopy() *AlertOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertsConfiguration) DeepCopyInto(out *AlertsConfiguration) {
+	*out = *in
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]AlertOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertsConfiguration.
+func (in *AlertsConfiguration) DeepCopy() *AlertsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArchConfiguration) DeepCopyInto(out *ArchConfiguration) {
 	*out = *in
@@ -169,6 +265,90 @@ func (in *ArchSpecificConfiguration) DeepCopy() *ArchSpecificConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchitectureCapabilities) DeepCopyInto(out *ArchitectureCapabilities) {
+	*out = *in
+	if in.MachineTypes != nil {
+		in, out := &in.MachineTypes, &out.MachineTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CPUModels != nil {
+		in, out := &in.CPUModels, &out.CPUModels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchitectureCapabilities.
+func (in *ArchitectureCapabilities) DeepCopy() *ArchitectureCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchitectureCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttachedDisk) DeepCopyInto(out *AttachedDisk) {
+	*out = *in
+	if in.Queues != nil {
+		in, out := &in.Queues, &out.Queues
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttachedDisk.
+func (in *AttachedDisk) DeepCopy() *AttachedDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(AttachedDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttachedHostDevice) DeepCopyInto(out *AttachedHostDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttachedHostDevice.
+func (in *AttachedHostDevice) DeepCopy() *AttachedHostDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(AttachedHostDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttachedInterface) DeepCopyInto(out *AttachedInterface) {
+	*out = *in
+	if in.Queues != nil {
+		in, out := &in.Queues, &out.Queues
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttachedInterface.
+func (in *AttachedInterface) DeepCopy() *AttachedInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(AttachedInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthorizedKeysFile) DeepCopyInto(out *AuthorizedKeysFile) {
 	*out = *in
@@ -326,6 +506,42 @@ func (in *CPUFeature) DeepCopy() *CPUFeature {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUResourcesStatus) DeepCopyInto(out *CPUResourcesStatus) {
+	*out = *in
+	if in.DesiredRequest != nil {
+		in, out := &in.DesiredRequest, &out.DesiredRequest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DesiredLimit != nil {
+		in, out := &in.DesiredLimit, &out.DesiredLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.AppliedRequest != nil {
+		in, out := &in.AppliedRequest, &out.AppliedRequest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.AppliedLimit != nil {
+		in, out := &in.AppliedLimit, &out.AppliedLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUResourcesStatus.
+func (in *CPUResourcesStatus) DeepCopy() *CPUResourcesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUResourcesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUTopology) DeepCopyInto(out *CPUTopology) {
 	*out = *in
@@ -368,6 +584,107 @@ func (in *CertConfig) DeepCopy() *CertConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerConfiguration) DeepCopyInto(out *CertManagerConfiguration) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(CertConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerConfiguration.
+func (in *CertManagerConfiguration) DeepCopy() *CertManagerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerReference) DeepCopyInto(out *CertManagerIssuerReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerReference.
+func (in *CertManagerIssuerReference) DeepCopy() *CertManagerIssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CgroupWeights) DeepCopyInto(out *CgroupWeights) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.IO != nil {
+		in, out := &in.IO, &out.IO
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.VolumeIO != nil {
+		in, out := &in.VolumeIO, &out.VolumeIO
+		*out = make([]VolumeIOWeight, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CgroupWeights.
+func (in *CgroupWeights) DeepCopy() *CgroupWeights {
+	if in == nil {
+		return nil
+	}
+	out := new(CgroupWeights)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Channel) DeepCopyInto(out *Channel) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Channel.
+func (in *Channel) DeepCopy() *Channel {
+	if in == nil {
+		return nil
+	}
+	out := new(Channel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelStatus) DeepCopyInto(out *ChannelStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelStatus.
+func (in *ChannelStatus) DeepCopy() *ChannelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chassis) DeepCopyInto(out *Chassis) {
 	*out = *in
@@ -521,6 +838,60 @@ func (in *CloudInitNoCloudSource) DeepCopy() *CloudInitNoCloudSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCapabilities) DeepCopyInto(out *ClusterCapabilities) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]ArchitectureCapabilities, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkBindings != nil {
+		in, out := &in.NetworkBindings, &out.NetworkBindings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostDevices != nil {
+		in, out := &in.HostDevices, &out.HostDevices
+		*out = make([]HostDeviceCapability, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageProfiles != nil {
+		in, out := &in.StorageProfiles, &out.StorageProfiles
+		*out = make([]StorageProfileCapability, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCapabilities.
+func (in *ClusterCapabilities) DeepCopy() *ClusterCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterCapabilities) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterProfilerRequest) DeepCopyInto(out *ClusterProfilerRequest) {
 	*out = *in
@@ -607,6 +978,22 @@ func (in *ComponentConfig) DeepCopy() *ComponentConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentFIPSPosture) DeepCopyInto(out *ComponentFIPSPosture) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentFIPSPosture.
+func (in *ComponentFIPSPosture) DeepCopy() *ComponentFIPSPosture {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentFIPSPosture)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigDriveSSHPublicKeyAccessCredentialPropagation) DeepCopyInto(out *ConfigDriveSSHPublicKeyAccessCredentialPropagation) {
 	*out = *in
@@ -1039,6 +1426,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(DownwardMetrics)
 		**out = **in
 	}
+	if in.MaintenanceNotifications != nil {
+		in, out := &in.MaintenanceNotifications, &out.MaintenanceNotifications
+		*out = new(MaintenanceNotifications)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Filesystems != nil {
 		in, out := &in.Filesystems, &out.Filesystems
 		*out = make([]Filesystem, len(*in))
@@ -1066,6 +1458,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(TPMDevice)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]Channel, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1427,6 +1824,42 @@ func (in *EphemeralVolumeSource) DeepCopy() *EphemeralVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalPolicyService) DeepCopyInto(out *ExternalPolicyService) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]ExternalPolicyHook, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(ExternalPolicyFailurePolicy)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalPolicyService.
+func (in *ExternalPolicyService) DeepCopy() *ExternalPolicyService {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalPolicyService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureAPIC) DeepCopyInto(out *FeatureAPIC) {
 	*out = *in
@@ -1770,6 +2203,32 @@ func (in *Flags) DeepCopy() *Flags {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlattenOptions) DeepCopyInto(out *FlattenOptions) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlattenOptions.
+func (in *FlattenOptions) DeepCopy() *FlattenOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(FlattenOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreezeUnfreezeTimeout) DeepCopyInto(out *FreezeUnfreezeTimeout) {
 	*out = *in
@@ -1812,6 +2271,22 @@ func (in *GPU) DeepCopy() *GPU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayReference) DeepCopyInto(out *GatewayReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayReference.
+func (in *GatewayReference) DeepCopy() *GatewayReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenerationStatus) DeepCopyInto(out *GenerationStatus) {
 	*out = *in
@@ -1933,6 +2408,22 @@ func (in *HostDevice) DeepCopy() *HostDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDeviceCapability) DeepCopyInto(out *HostDeviceCapability) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDeviceCapability.
+func (in *HostDeviceCapability) DeepCopy() *HostDeviceCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDeviceCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDisk) DeepCopyInto(out *HostDisk) {
 	*out = *in
@@ -1997,6 +2488,29 @@ func (in *HotplugVolumeStatus) DeepCopy() *HotplugVolumeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HousekeepingCPUPool) DeepCopyInto(out *HousekeepingCPUPool) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HousekeepingCPUPool.
+func (in *HousekeepingCPUPool) DeepCopy() *HousekeepingCPUPool {
+	if in == nil {
+		return nil
+	}
+	out := new(HousekeepingCPUPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Hugepages) DeepCopyInto(out *Hugepages) {
 	*out = *in
@@ -2013,6 +2527,29 @@ func (in *Hugepages) DeepCopy() *Hugepages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HugepagesPool) DeepCopyInto(out *HugepagesPool) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HugepagesPool.
+func (in *HugepagesPool) DeepCopy() *HugepagesPool {
+	if in == nil {
+		return nil
+	}
+	out := new(HugepagesPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperVPassthrough) DeepCopyInto(out *HyperVPassthrough) {
 	*out = *in
@@ -2071,6 +2608,45 @@ func (in *I6300ESBWatchdog) DeepCopy() *I6300ESBWatchdog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityDocument) DeepCopyInto(out *IdentityDocument) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityDocument.
+func (in *IdentityDocument) DeepCopy() *IdentityDocument {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityDocument)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfraThreadsPinning) DeepCopyInto(out *InfraThreadsPinning) {
+	*out = *in
+	if in.HousekeepingCPUs != nil {
+		in, out := &in.HousekeepingCPUs, &out.HousekeepingCPUs
+		*out = make([]HousekeepingCPUPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfraThreadsPinning.
+func (in *InfraThreadsPinning) DeepCopy() *InfraThreadsPinning {
+	if in == nil {
+		return nil
+	}
+	out := new(InfraThreadsPinning)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitrdInfo) DeepCopyInto(out *InitrdInfo) {
 	*out = *in
@@ -2188,7 +2764,7 @@ func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	if in.Bridge != nil {
 		in, out := &in.Bridge, &out.Bridge
 		*out = new(InterfaceBridge)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.DeprecatedSlirp != nil {
 		in, out := &in.DeprecatedSlirp, &out.DeprecatedSlirp
@@ -2257,6 +2833,11 @@ func (in *InterfaceBindingPlugin) DeepCopyInto(out *InterfaceBindingPlugin) {
 		*out = new(ResourceRequirementsWithoutClaims)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SidecarConfigMap != nil {
+		in, out := &in.SidecarConfigMap, &out.SidecarConfigMap
+		*out = new(InterfaceBindingSidecarConfigMap)
+		**out = **in
+	}
 	return
 }
 
@@ -2270,9 +2851,40 @@ func (in *InterfaceBindingPlugin) DeepCopy() *InterfaceBindingPlugin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBindingSidecarConfigMap) DeepCopyInto(out *InterfaceBindingSidecarConfigMap) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingSidecarConfigMap.
+func (in *InterfaceBindingSidecarConfigMap) DeepCopy() *InterfaceBindingSidecarConfigMap {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBindingSidecarConfigMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBridge) DeepCopyInto(out *InterfaceBridge) {
 	*out = *in
+	if in.MACLearning != nil {
+		in, out := &in.MACLearning, &out.MACLearning
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UnicastFlood != nil {
+		in, out := &in.UnicastFlood, &out.UnicastFlood
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tap != nil {
+		in, out := &in.Tap, &out.Tap
+		*out = new(InterfaceTap)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2287,33 +2899,95 @@ func (in *InterfaceBridge) DeepCopy() *InterfaceBridge {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceMasquerade) DeepCopyInto(out *InterfaceMasquerade) {
+func (in *InterfaceMasquerade) DeepCopyInto(out *InterfaceMasquerade) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceMasquerade.
+func (in *InterfaceMasquerade) DeepCopy() *InterfaceMasquerade {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceMasquerade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceSRIOV) DeepCopyInto(out *InterfaceSRIOV) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSRIOV.
+func (in *InterfaceSRIOV) DeepCopy() *InterfaceSRIOV {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceSRIOV)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceTap) DeepCopyInto(out *InterfaceTap) {
 	*out = *in
+	if in.TxQueueLength != nil {
+		in, out := &in.TxQueueLength, &out.TxQueueLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DisableOffloads != nil {
+		in, out := &in.DisableOffloads, &out.DisableOffloads
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Offload != nil {
+		in, out := &in.Offload, &out.Offload
+		*out = new(InterfaceTapOffload)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceMasquerade.
-func (in *InterfaceMasquerade) DeepCopy() *InterfaceMasquerade {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceTap.
+func (in *InterfaceTap) DeepCopy() *InterfaceTap {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceMasquerade)
+	out := new(InterfaceTap)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSRIOV) DeepCopyInto(out *InterfaceSRIOV) {
+func (in *InterfaceTapOffload) DeepCopyInto(out *InterfaceTapOffload) {
 	*out = *in
+	if in.TSO != nil {
+		in, out := &in.TSO, &out.TSO
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GSO != nil {
+		in, out := &in.GSO, &out.GSO
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UFO != nil {
+		in, out := &in.UFO, &out.UFO
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSRIOV.
-func (in *InterfaceSRIOV) DeepCopy() *InterfaceSRIOV {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceTapOffload.
+func (in *InterfaceTapOffload) DeepCopy() *InterfaceTapOffload {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceSRIOV)
+	out := new(InterfaceTapOffload)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2475,6 +3149,11 @@ func (in *KubeVirtCertificateRotateStrategy) DeepCopyInto(out *KubeVirtCertifica
 		*out = new(KubeVirtSelfSignConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(KubeVirtExternalCertConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2488,6 +3167,30 @@ func (in *KubeVirtCertificateRotateStrategy) DeepCopy() *KubeVirtCertificateRota
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtCertificateStatus) DeepCopyInto(out *KubeVirtCertificateStatus) {
+	*out = *in
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtCertificateStatus.
+func (in *KubeVirtCertificateStatus) DeepCopy() *KubeVirtCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtCondition) DeepCopyInto(out *KubeVirtCondition) {
 	*out = *in
@@ -2658,6 +3361,66 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(InstancetypeConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StorageHealthCheck != nil {
+		in, out := &in.StorageHealthCheck, &out.StorageHealthCheck
+		*out = new(StorageHealthCheckConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalPolicyServices != nil {
+		in, out := &in.ExternalPolicyServices, &out.ExternalPolicyServices
+		*out = make([]ExternalPolicyService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LicenseGroups != nil {
+		in, out := &in.LicenseGroups, &out.LicenseGroups
+		*out = make([]LicenseGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TenantNodePools != nil {
+		in, out := &in.TenantNodePools, &out.TenantNodePools
+		*out = make([]TenantNodePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VirtualizationInfraReservation != nil {
+		in, out := &in.VirtualizationInfraReservation, &out.VirtualizationInfraReservation
+		*out = new(VirtualizationInfraReservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InfraThreadsPinning != nil {
+		in, out := &in.InfraThreadsPinning, &out.InfraThreadsPinning
+		*out = new(InfraThreadsPinning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HugepagesPools != nil {
+		in, out := &in.HugepagesPools, &out.HugepagesPools
+		*out = make([]HugepagesPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LauncherImageOverrides != nil {
+		in, out := &in.LauncherImageOverrides, &out.LauncherImageOverrides
+		*out = make([]LauncherImageOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdmissionWarnings != nil {
+		in, out := &in.AdmissionWarnings, &out.AdmissionWarnings
+		*out = new(AdmissionWarningsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubresourceAccessWebhook != nil {
+		in, out := &in.SubresourceAccessWebhook, &out.SubresourceAccessWebhook
+		*out = new(SubresourceAccessWebhook)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2671,6 +3434,27 @@ func (in *KubeVirtConfiguration) DeepCopy() *KubeVirtConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtExternalCertConfiguration) DeepCopyInto(out *KubeVirtExternalCertConfiguration) {
+	*out = *in
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(CertManagerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtExternalCertConfiguration.
+func (in *KubeVirtExternalCertConfiguration) DeepCopy() *KubeVirtExternalCertConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtExternalCertConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtList) DeepCopyInto(out *KubeVirtList) {
 	*out = *in
@@ -2767,6 +3551,11 @@ func (in *KubeVirtSpec) DeepCopyInto(out *KubeVirtSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.CustomizeComponents.DeepCopyInto(&out.CustomizeComponents)
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = new(AlertsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2805,6 +3594,18 @@ func (in *KubeVirtStatus) DeepCopyInto(out *KubeVirtStatus) {
 		*out = make([]GenerationStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.FIPSPosture != nil {
+		in, out := &in.FIPSPosture, &out.FIPSPosture
+		*out = make([]ComponentFIPSPosture, len(*in))
+		copy(*out, *in)
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]KubeVirtCertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2870,6 +3671,52 @@ func (in *LaunchSecurity) DeepCopy() *LaunchSecurity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LauncherImageOverride) DeepCopyInto(out *LauncherImageOverride) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LauncherImageOverride.
+func (in *LauncherImageOverride) DeepCopy() *LauncherImageOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(LauncherImageOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseGroup) DeepCopyInto(out *LicenseGroup) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseGroup.
+func (in *LicenseGroup) DeepCopy() *LicenseGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LiveUpdateConfiguration) DeepCopyInto(out *LiveUpdateConfiguration) {
 	*out = *in
@@ -2951,6 +3798,27 @@ func (in *Machine) DeepCopy() *Machine {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceNotifications) DeepCopyInto(out *MaintenanceNotifications) {
+	*out = *in
+	if in.LeadTimeSeconds != nil {
+		in, out := &in.LeadTimeSeconds, &out.LeadTimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceNotifications.
+func (in *MaintenanceNotifications) DeepCopy() *MaintenanceNotifications {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceNotifications)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
 	*out = *in
@@ -3182,6 +4050,59 @@ func (in *MigrationConfiguration) DeepCopy() *MigrationConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationNetworkAnnouncement) DeepCopyInto(out *MigrationNetworkAnnouncement) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationNetworkAnnouncement.
+func (in *MigrationNetworkAnnouncement) DeepCopy() *MigrationNetworkAnnouncement {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationNetworkAnnouncement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModernizeChange) DeepCopyInto(out *ModernizeChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModernizeChange.
+func (in *ModernizeChange) DeepCopy() *ModernizeChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ModernizeChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModernizeOptions) DeepCopyInto(out *ModernizeOptions) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModernizeOptions.
+func (in *ModernizeOptions) DeepCopy() *ModernizeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ModernizeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultusNetwork) DeepCopyInto(out *MultusNetwork) {
 	*out = *in
@@ -3272,6 +4193,16 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.PreferredIPFamily != nil {
+		in, out := &in.PreferredIPFamily, &out.PreferredIPFamily
+		*out = new(corev1.IPFamily)
+		**out = **in
+	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(GatewayReference)
+		**out = **in
+	}
 	return
 }
 
@@ -3597,6 +4528,70 @@ func (in *Port) DeepCopy() *Port {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortalViewCondition) DeepCopyInto(out *PortalViewCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortalViewCondition.
+func (in *PortalViewCondition) DeepCopy() *PortalViewCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(PortalViewCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortalViewInterface) DeepCopyInto(out *PortalViewInterface) {
+	*out = *in
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortalViewInterface.
+func (in *PortalViewInterface) DeepCopy() *PortalViewInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(PortalViewInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreShutdownCommand) DeepCopyInto(out *PreShutdownCommand) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreShutdownCommand.
+func (in *PreShutdownCommand) DeepCopy() *PreShutdownCommand {
+	if in == nil {
+		return nil
+	}
+	out := new(PreShutdownCommand)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PreferenceMatcher) DeepCopyInto(out *PreferenceMatcher) {
 	*out = *in
@@ -3824,6 +4819,28 @@ func (in *RemoveVolumeOptions) DeepCopy() *RemoveVolumeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenewLeaseOptions) DeepCopyInto(out *RenewLeaseOptions) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenewLeaseOptions.
+func (in *RenewLeaseOptions) DeepCopy() *RenewLeaseOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RenewLeaseOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
 	*out = *in
@@ -3841,6 +4858,11 @@ func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.CgroupWeights != nil {
+		in, out := &in.CgroupWeights, &out.CgroupWeights
+		*out = new(CgroupWeights)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4232,6 +5254,76 @@ func (in *ServiceAccountVolumeSource) DeepCopy() *ServiceAccountVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShutdownPolicy) DeepCopyInto(out *ShutdownPolicy) {
+	*out = *in
+	if in.PreShutdown != nil {
+		in, out := &in.PreShutdown, &out.PreShutdown
+		*out = new(PreShutdownCommand)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SignalTimeoutSeconds != nil {
+		in, out := &in.SignalTimeoutSeconds, &out.SignalTimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShutdownPolicy.
+func (in *ShutdownPolicy) DeepCopy() *ShutdownPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ShutdownPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShutdownStatus) DeepCopyInto(out *ShutdownStatus) {
+	*out = *in
+	if in.PreShutdownStartTimestamp != nil {
+		in, out := &in.PreShutdownStartTimestamp, &out.PreShutdownStartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.PreShutdownEndTimestamp != nil {
+		in, out := &in.PreShutdownEndTimestamp, &out.PreShutdownEndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.SignalTimestamp != nil {
+		in, out := &in.SignalTimestamp, &out.SignalTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShutdownStatus.
+func (in *ShutdownStatus) DeepCopy() *ShutdownStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShutdownStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignedIdentityDocument) DeepCopyInto(out *SignedIdentityDocument) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignedIdentityDocument.
+func (in *SignedIdentityDocument) DeepCopy() *SignedIdentityDocument {
+	if in == nil {
+		return nil
+	}
+	out := new(SignedIdentityDocument)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SoundDevice) DeepCopyInto(out *SoundDevice) {
 	*out = *in
@@ -4248,6 +5340,27 @@ func (in *SoundDevice) DeepCopy() *SoundDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartDependency) DeepCopyInto(out *StartDependency) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartDependency.
+func (in *StartDependency) DeepCopy() *StartDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(StartDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StartOptions) DeepCopyInto(out *StartOptions) {
 	*out = *in
@@ -4297,6 +5410,47 @@ func (in *StopOptions) DeepCopy() *StopOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageHealthCheckConfiguration) DeepCopyInto(out *StorageHealthCheckConfiguration) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LatencyThreshold != nil {
+		in, out := &in.LatencyThreshold, &out.LatencyThreshold
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.DegradedPolicy != nil {
+		in, out := &in.DegradedPolicy, &out.DegradedPolicy
+		*out = new(StorageDegradedPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageHealthCheckConfiguration.
+func (in *StorageHealthCheckConfiguration) DeepCopy() *StorageHealthCheckConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageHealthCheckConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageMigratedVolumeInfo) DeepCopyInto(out *StorageMigratedVolumeInfo) {
 	*out = *in
@@ -4323,6 +5477,162 @@ func (in *StorageMigratedVolumeInfo) DeepCopy() *StorageMigratedVolumeInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageProfileCapability) DeepCopyInto(out *StorageProfileCapability) {
+	*out = *in
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]corev1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeModes != nil {
+		in, out := &in.VolumeModes, &out.VolumeModes
+		*out = make([]corev1.PersistentVolumeMode, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageProfileCapability.
+func (in *StorageProfileCapability) DeepCopy() *StorageProfileCapability {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageProfileCapability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubresourceAccessReview) DeepCopyInto(out *SubresourceAccessReview) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubresourceAccessReview.
+func (in *SubresourceAccessReview) DeepCopy() *SubresourceAccessReview {
+	if in == nil {
+		return nil
+	}
+	out := new(SubresourceAccessReview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubresourceAccessReview) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubresourceAccessReviewSpec) DeepCopyInto(out *SubresourceAccessReviewSpec) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Extra != nil {
+		in, out := &in.Extra, &out.Extra
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.VMILabels != nil {
+		in, out := &in.VMILabels, &out.VMILabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VMLabels != nil {
+		in, out := &in.VMLabels, &out.VMLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.RequestTime.DeepCopyInto(&out.RequestTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubresourceAccessReviewSpec.
+func (in *SubresourceAccessReviewSpec) DeepCopy() *SubresourceAccessReviewSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SubresourceAccessReviewSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubresourceAccessReviewStatus) DeepCopyInto(out *SubresourceAccessReviewStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubresourceAccessReviewStatus.
+func (in *SubresourceAccessReviewStatus) DeepCopy() *SubresourceAccessReviewStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubresourceAccessReviewStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubresourceAccessWebhook) DeepCopyInto(out *SubresourceAccessWebhook) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subresources != nil {
+		in, out := &in.Subresources, &out.Subresources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(SubresourceAccessFailurePolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubresourceAccessWebhook.
+func (in *SubresourceAccessWebhook) DeepCopy() *SubresourceAccessWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(SubresourceAccessWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SupportContainerResources) DeepCopyInto(out *SupportContainerResources) {
 	*out = *in
@@ -4434,6 +5744,37 @@ func (in *TPMDevice) DeepCopy() *TPMDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantNodePool) DeepCopyInto(out *TenantNodePool) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantNodePool.
+func (in *TenantNodePool) DeepCopy() *TenantNodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantNodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timer) DeepCopyInto(out *Timer) {
 	*out = *in
@@ -4797,16 +6138,60 @@ func (in *VirtualMachineInstanceCondition) DeepCopyInto(out *VirtualMachineInsta
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceCondition.
-func (in *VirtualMachineInstanceCondition) DeepCopy() *VirtualMachineInstanceCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceCondition.
+func (in *VirtualMachineInstanceCondition) DeepCopy() *VirtualMachineInstanceCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceDeviceList) DeepCopyInto(out *VirtualMachineInstanceDeviceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]AttachedDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]AttachedInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HostDevices != nil {
+		in, out := &in.HostDevices, &out.HostDevices
+		*out = make([]AttachedHostDevice, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceDeviceList.
+func (in *VirtualMachineInstanceDeviceList) DeepCopy() *VirtualMachineInstanceDeviceList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineInstanceCondition)
+	out := new(VirtualMachineInstanceDeviceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceDeviceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceFileSystem) DeepCopyInto(out *VirtualMachineInstanceFileSystem) {
 	*out = *in
@@ -4937,6 +6322,32 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestDNS) DeepCopyInto(out *VirtualMachineInstanceGuestDNS) {
+	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Searches != nil {
+		in, out := &in.Searches, &out.Searches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestDNS.
+func (in *VirtualMachineInstanceGuestDNS) DeepCopy() *VirtualMachineInstanceGuestDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -5000,6 +6411,51 @@ func (in *VirtualMachineInstanceGuestOSUserList) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceIdentity) DeepCopyInto(out *VirtualMachineInstanceIdentity) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NamespaceLabels != nil {
+		in, out := &in.NamespaceLabels, &out.NamespaceLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BootTime != nil {
+		in, out := &in.BootTime, &out.BootTime
+		*out = (*in).DeepCopy()
+	}
+	in.IssuedAt.DeepCopyInto(&out.IssuedAt)
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceIdentity.
+func (in *VirtualMachineInstanceIdentity) DeepCopy() *VirtualMachineInstanceIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceIdentity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceList) DeepCopyInto(out *VirtualMachineInstanceList) {
 	*out = *in
@@ -5033,6 +6489,23 @@ func (in *VirtualMachineInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMetricsSnapshot) DeepCopyInto(out *VirtualMachineInstanceMetricsSnapshot) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMetricsSnapshot.
+func (in *VirtualMachineInstanceMetricsSnapshot) DeepCopy() *VirtualMachineInstanceMetricsSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMetricsSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigration) DeepCopyInto(out *VirtualMachineInstanceMigration) {
 	*out = *in
@@ -5167,6 +6640,11 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 			(*out)[key] = val
 		}
 	}
+	if in.ObservedDowntime != nil {
+		in, out := &in.ObservedDowntime, &out.ObservedDowntime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.MigrationPolicyName != nil {
 		in, out := &in.MigrationPolicyName, &out.MigrationPolicyName
 		*out = new(string)
@@ -5182,6 +6660,11 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 		*out = make([]int, len(*in))
 		copy(*out, *in)
 	}
+	if in.TargetNetworkAnnouncements != nil {
+		in, out := &in.TargetNetworkAnnouncements, &out.TargetNetworkAnnouncements
+		*out = make([]MigrationNetworkAnnouncement, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -5238,6 +6721,16 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopyInto(out *VirtualMachi
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IPsWithPrefix != nil {
+		in, out := &in.IPsWithPrefix, &out.IPsWithPrefix
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultRoutes != nil {
+		in, out := &in.DefaultRoutes, &out.DefaultRoutes
+		*out = make([]VirtualMachineInstanceNetworkInterfaceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -5251,6 +6744,22 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopy() *VirtualMachineInst
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceNetworkInterfaceRoute) DeepCopyInto(out *VirtualMachineInstanceNetworkInterfaceRoute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceNetworkInterfaceRoute.
+func (in *VirtualMachineInstanceNetworkInterfaceRoute) DeepCopy() *VirtualMachineInstanceNetworkInterfaceRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceNetworkInterfaceRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstancePhaseTransitionTimestamp) DeepCopyInto(out *VirtualMachineInstancePhaseTransitionTimestamp) {
 	*out = *in
@@ -5549,6 +7058,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ShutdownPolicy != nil {
+		in, out := &in.ShutdownPolicy, &out.ShutdownPolicy
+		*out = new(ShutdownPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]Volume, len(*in))
@@ -5585,6 +7099,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.IdentityDocument != nil {
+		in, out := &in.IdentityDocument, &out.IdentityDocument
+		*out = new(IdentityDocument)
+		**out = **in
+	}
 	return
 }
 
@@ -5623,6 +7142,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		}
 	}
 	out.GuestOSInfo = in.GuestOSInfo
+	if in.GuestDNS != nil {
+		in, out := &in.GuestDNS, &out.GuestDNS
+		*out = new(VirtualMachineInstanceGuestDNS)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MigrationState != nil {
 		in, out := &in.MigrationState, &out.MigrationState
 		*out = new(VirtualMachineInstanceMigrationState)
@@ -5677,6 +7201,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(MemoryStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CPUResources != nil {
+		in, out := &in.CPUResources, &out.CPUResources
+		*out = new(CPUResourcesStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MigratedVolumes != nil {
 		in, out := &in.MigratedVolumes, &out.MigratedVolumes
 		*out = make([]StorageMigratedVolumeInfo, len(*in))
@@ -5684,6 +7213,16 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ChannelStatus != nil {
+		in, out := &in.ChannelStatus, &out.ChannelStatus
+		*out = make([]ChannelStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ShutdownStatus != nil {
+		in, out := &in.ShutdownStatus, &out.ShutdownStatus
+		*out = new(ShutdownStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5715,6 +7254,28 @@ func (in *VirtualMachineInstanceTemplateSpec) DeepCopy() *VirtualMachineInstance
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineLease) DeepCopyInto(out *VirtualMachineLease) {
+	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	if in.WarningPeriod != nil {
+		in, out := &in.WarningPeriod, &out.WarningPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineLease.
+func (in *VirtualMachineLease) DeepCopy() *VirtualMachineLease {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineLease)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineList) DeepCopyInto(out *VirtualMachineList) {
 	*out = *in
@@ -5777,6 +7338,46 @@ func (in *VirtualMachineMemoryDumpRequest) DeepCopy() *VirtualMachineMemoryDumpR
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineModernization) DeepCopyInto(out *VirtualMachineModernization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]ModernizeChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Unsupported != nil {
+		in, out := &in.Unsupported, &out.Unsupported
+		*out = make([]ModernizeChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.VirtualMachine != nil {
+		in, out := &in.VirtualMachine, &out.VirtualMachine
+		*out = new(VirtualMachine)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineModernization.
+func (in *VirtualMachineModernization) DeepCopy() *VirtualMachineModernization {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineModernization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineModernization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineOptions) DeepCopyInto(out *VirtualMachineOptions) {
 	*out = *in
@@ -5803,6 +7404,55 @@ func (in *VirtualMachineOptions) DeepCopy() *VirtualMachineOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePortalView) DeepCopyInto(out *VirtualMachinePortalView) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]PortalViewCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]PortalViewInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GuestOSInfo != nil {
+		in, out := &in.GuestOSInfo, &out.GuestOSInfo
+		*out = new(VirtualMachineInstanceGuestOSInfo)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(VirtualMachineInstanceMetricsSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePortalView.
+func (in *VirtualMachinePortalView) DeepCopy() *VirtualMachinePortalView {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePortalView)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachinePortalView) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 	*out = *in
@@ -5843,6 +7493,18 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(UpdateVolumesStrategy)
 		**out = **in
 	}
+	if in.StartDependencies != nil {
+		in, out := &in.StartDependencies, &out.StartDependencies
+		*out = make([]StartDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Lease != nil {
+		in, out := &in.Lease, &out.Lease
+		*out = new(VirtualMachineLease)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5997,6 +7659,27 @@ func (in *VirtualMachineVolumeRequest) DeepCopy() *VirtualMachineVolumeRequest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualizationInfraReservation) DeepCopyInto(out *VirtualizationInfraReservation) {
+	*out = *in
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualizationInfraReservation.
+func (in *VirtualizationInfraReservation) DeepCopy() *VirtualizationInfraReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualizationInfraReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Volume) DeepCopyInto(out *Volume) {
 	*out = *in
@@ -6014,6 +7697,63 @@ func (in *Volume) DeepCopy() *Volume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeFlattenStatus) DeepCopyInto(out *VolumeFlattenStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeFlattenStatus.
+func (in *VolumeFlattenStatus) DeepCopy() *VolumeFlattenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeFlattenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeHealth) DeepCopyInto(out *VolumeHealth) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeHealth.
+func (in *VolumeHealth) DeepCopy() *VolumeHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeIOWeight) DeepCopyInto(out *VolumeIOWeight) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeIOWeight.
+func (in *VolumeIOWeight) DeepCopy() *VolumeIOWeight {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeIOWeight)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMigrationState) DeepCopyInto(out *VolumeMigrationState) {
 	*out = *in
@@ -6167,6 +7907,16 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 		*out = new(ContainerDiskInfo)
 		**out = **in
 	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(VolumeHealth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Flatten != nil {
+		in, out := &in.Flatten, &out.Flatten
+		*out = new(VolumeFlattenStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -296,6 +296,33 @@ type ResourceRequirements struct {
 	// put the overhead only into the container's memory limit. This can lead to crashes if
 	// all memory is in use on a node. Defaults to false.
 	OvercommitGuestOverhead bool `json:"overcommitGuestOverhead,omitempty"`
+	// CgroupWeights sets the cgroup v2 weights of the virt-launcher pod, to prioritize the VMI against the other
+	// VMIs of its node beyond its requests and limits. It requires the CgroupWeights feature gate.
+	// +optional
+	CgroupWeights *CgroupWeights `json:"cgroupWeights,omitempty"`
+}
+
+// CgroupWeights holds the cgroup v2 weights of a virt-launcher pod, between 1 and 10000.
+type CgroupWeights struct {
+	// CPU is the cpu.weight of the pod, which otherwise derives from its CPU request.
+	// +optional
+	CPU *uint32 `json:"cpu,omitempty"`
+	// IO is the default io.weight of the pod, on all the devices.
+	// +optional
+	IO *uint32 `json:"io,omitempty"`
+	// VolumeIO overrides the io.weight of the pod on the devices backing the given volumes.
+	// +optional
+	// +listType=map
+	// +listMapKey=volume
+	VolumeIO []VolumeIOWeight `json:"volumeIO,omitempty"`
+}
+
+// VolumeIOWeight is the io.weight of a pod on the device backing one of its volumes.
+type VolumeIOWeight struct {
+	// Volume is the name of a persistentVolumeClaim or dataVolume volume of the VMI.
+	Volume string `json:"volume"`
+	// Weight is the io.weight of the pod on the device backing the volume.
+	Weight uint32 `json:"weight"`
 }
 
 // CPU allows specifying the CPU topology.
@@ -403,6 +430,22 @@ type MemoryStatus struct {
 	GuestRequested *resource.Quantity `json:"guestRequested,omitempty"`
 }
 
+// CPUResourcesStatus reports the CPU request and limit of a VirtualMachineInstance without dedicated CPUs.
+type CPUResourcesStatus struct {
+	// DesiredRequest is the CPU request of the VirtualMachineInstance spec.
+	// +optional
+	DesiredRequest *resource.Quantity `json:"desiredRequest,omitempty"`
+	// DesiredLimit is the CPU limit of the VirtualMachineInstance spec.
+	// +optional
+	DesiredLimit *resource.Quantity `json:"desiredLimit,omitempty"`
+	// AppliedRequest is the CPU request the cgroups of the virt-launcher pod were set to.
+	// +optional
+	AppliedRequest *resource.Quantity `json:"appliedRequest,omitempty"`
+	// AppliedLimit is the CPU limit the cgroups of the virt-launcher pod were set to.
+	// +optional
+	AppliedLimit *resource.Quantity `json:"appliedLimit,omitempty"`
+}
+
 // Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
 type Hugepages struct {
 	// PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
@@ -497,6 +540,10 @@ type Devices struct {
 	// DownwardMetrics creates a virtio serials for exposing the downward metrics to the vmi.
 	// +optional
 	DownwardMetrics *DownwardMetrics `json:"downwardMetrics,omitempty"`
+	// MaintenanceNotifications creates a virtio serial for notifying the guest of the migrations
+	// and the host maintenance ahead of them.
+	// +optional
+	MaintenanceNotifications *MaintenanceNotifications `json:"maintenanceNotifications,omitempty"`
 	// Filesystems describes filesystem which is connected to the vmi.
 	// +optional
 	// +listType=atomic
@@ -514,6 +561,12 @@ type Devices struct {
 	// Whether to emulate a TPM device.
 	// +optional
 	TPM *TPMDevice `json:"tpm,omitempty"`
+	// Channels describes additional virtio-serial channels exposed to the guest.
+	// Channels can be added to a running vmi through the addchannel subresource.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=16
+	Channels []Channel `json:"channels,omitempty"`
 }
 
 // Represent a subset of client devices that can be accessed by VMI. At the
@@ -582,8 +635,26 @@ type Filesystem struct {
 
 type FilesystemVirtiofs struct{}
 
+// Channel represents a virtio-serial port, backed by a unix socket in the virt-launcher pod
+type Channel struct {
+	// Name of the channel, also used to name its socket in the virt-launcher pod
+	Name string `json:"name"`
+	// Target is the name of the port as seen by the guest, e.g. org.example.agent.0
+	Target string `json:"target"`
+}
+
 type DownwardMetrics struct{}
 
+// MaintenanceNotifications configures the notifications of the guest ahead of the migrations
+type MaintenanceNotifications struct {
+	// LeadTimeSeconds is how long a migration waits for the guest to acknowledge its
+	// notification before it starts. Defaults to 30.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=600
+	LeadTimeSeconds *int32 `json:"leadTimeSeconds,omitempty"`
+}
+
 type GPU struct {
 	// Name of the GPU device as exposed by a device plugin
 	Name              string       `json:"name"`
@@ -1354,7 +1425,50 @@ type InterfaceBindingMethod struct {
 }
 
 // InterfaceBridge connects to a given network via a linux bridge.
-type InterfaceBridge struct{}
+type InterfaceBridge struct {
+	// MACLearning sets whether the bridge learns the MAC addresses the guest sends from, so that
+	// the frames destined to them are forwarded to the guest only. Defaults to true.
+	// +optional
+	MACLearning *bool `json:"macLearning,omitempty"`
+	// UnicastFlood sets whether the bridge forwards to the guest the frames destined to the MAC
+	// addresses it did not learn. It can't be disabled along with MACLearning. Defaults to true.
+	// +optional
+	UnicastFlood *bool `json:"unicastFlood,omitempty"`
+	// Tap tunes the tap device connecting the guest to the bridge.
+	// +optional
+	Tap *InterfaceTap `json:"tap,omitempty"`
+}
+
+// InterfaceTap tunes the tap device of an interface, e.g. for the guest network stacks which
+// mishandle the offloads, like DPDK in the guest or old kernels.
+type InterfaceTap struct {
+	// TxQueueLength is the length of the transmit queue of the tap device, in packets.
+	// Defaults to the kernel default, 1000.
+	// +optional
+	TxQueueLength *int32 `json:"txQueueLength,omitempty"`
+	// DisableOffloads disables every offload of the interface, the checksum, segmentation and ECN
+	// offloads of the host and the guest, overriding Offload.
+	// Only supported with the virtio model. Defaults to false.
+	// +optional
+	DisableOffloads *bool `json:"disableOffloads,omitempty"`
+	// Offload sets the segmentation offloads of the interface.
+	// Only supported with the virtio model.
+	// +optional
+	Offload *InterfaceTapOffload `json:"offload,omitempty"`
+}
+
+// InterfaceTapOffload sets the segmentation offloads of an interface, all enabled by default.
+type InterfaceTapOffload struct {
+	// TSO sets the TCP segmentation offload, over IPv4 and IPv6, of the host and the guest.
+	// +optional
+	TSO *bool `json:"tso,omitempty"`
+	// GSO sets the generic segmentation offload of the host.
+	// +optional
+	GSO *bool `json:"gso,omitempty"`
+	// UFO sets the UDP fragmentation offload of the host and the guest.
+	// +optional
+	UFO *bool `json:"ufo,omitempty"`
+}
 
 // DeprecatedInterfaceSlirp is an alias to the deprecated InterfaceSlirp
 // that connects to a given network using QEMU user networking mode.
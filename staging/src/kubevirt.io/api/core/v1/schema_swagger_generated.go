@@ -151,6 +151,24 @@ func (ResourceRequirements) SwaggerDoc() map[string]string {
 		"requests":                "Requests is a description of the initial vmi resources.\nValid resource keys are \"memory\" and \"cpu\".\n+optional",
 		"limits":                  "Limits describes the maximum amount of compute resources allowed.\nValid resource keys are \"memory\" and \"cpu\".\n+optional",
 		"overcommitGuestOverhead": "Don't ask the scheduler to take the guest-management overhead into account. Instead\nput the overhead only into the container's memory limit. This can lead to crashes if\nall memory is in use on a node. Defaults to false.",
+		"cgroupWeights":           "CgroupWeights sets the cgroup v2 weights of the virt-launcher pod, to prioritize the VMI against the other\nVMIs of its node beyond its requests and limits. It requires the CgroupWeights feature gate.\n+optional",
+	}
+}
+
+func (CgroupWeights) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "CgroupWeights holds the cgroup v2 weights of a virt-launcher pod, between 1 and 10000.",
+		"cpu":      "CPU is the cpu.weight of the pod, which otherwise derives from its CPU request.\n+optional",
+		"io":       "IO is the default io.weight of the pod, on all the devices.\n+optional",
+		"volumeIO": "VolumeIO overrides the io.weight of the pod on the devices backing the given volumes.\n+optional\n+listType=map\n+listMapKey=volume",
+	}
+}
+
+func (VolumeIOWeight) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VolumeIOWeight is the io.weight of a pod on the device backing one of its volumes.",
+		"volume": "Volume is the name of a persistentVolumeClaim or dataVolume volume of the VMI.",
+		"weight": "Weight is the io.weight of the pod on the device backing the volume.",
 	}
 }
 
@@ -214,6 +232,16 @@ func (MemoryStatus) SwaggerDoc() map[string]string {
 	}
 }
 
+func (CPUResourcesStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "CPUResourcesStatus reports the CPU request and limit of a VirtualMachineInstance without dedicated CPUs.",
+		"desiredRequest": "DesiredRequest is the CPU request of the VirtualMachineInstance spec.\n+optional",
+		"desiredLimit":   "DesiredLimit is the CPU limit of the VirtualMachineInstance spec.\n+optional",
+		"appliedRequest": "AppliedRequest is the CPU request the cgroups of the virt-launcher pod were set to.\n+optional",
+		"appliedLimit":   "AppliedLimit is the CPU limit the cgroups of the virt-launcher pod were set to.\n+optional",
+	}
+}
+
 func (Hugepages) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.",
@@ -263,11 +291,13 @@ func (Devices) SwaggerDoc() map[string]string {
 		"networkInterfaceMultiqueue": "If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.\n+optional",
 		"gpus":                       "Whether to attach a GPU device to the vmi.\n+optional\n+listType=atomic",
 		"downwardMetrics":            "DownwardMetrics creates a virtio serials for exposing the downward metrics to the vmi.\n+optional",
+		"maintenanceNotifications":   "MaintenanceNotifications creates a virtio serial for notifying the guest of the migrations\nand the host maintenance ahead of them.\n+optional",
 		"filesystems":                "Filesystems describes filesystem which is connected to the vmi.\n+optional\n+listType=atomic",
 		"hostDevices":                "Whether to attach a host device to the vmi.\n+optional\n+listType=atomic",
 		"clientPassthrough":          "To configure and access client devices such as redirecting USB\n+optional",
 		"sound":                      "Whether to emulate a sound device.\n+optional",
 		"tpm":                        "Whether to emulate a TPM device.\n+optional",
+		"channels":                   "Channels describes additional virtio-serial channels exposed to the guest.\nChannels can be added to a running vmi through the addchannel subresource.\n+optional\n+listType=atomic\n+kubebuilder:validation:MaxItems:=16",
 	}
 }
 
@@ -310,10 +340,25 @@ func (FilesystemVirtiofs) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (Channel) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "Channel represents a virtio-serial port, backed by a unix socket in the virt-launcher pod",
+		"name":   "Name of the channel, also used to name its socket in the virt-launcher pod",
+		"target": "Target is the name of the port as seen by the guest, e.g. org.example.agent.0",
+	}
+}
+
 func (DownwardMetrics) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (MaintenanceNotifications) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "MaintenanceNotifications configures the notifications of the guest ahead of the migrations",
+		"leadTimeSeconds": "LeadTimeSeconds is how long a migration waits for the guest to acknowledge its\nnotification before it starts. Defaults to 30.\n+optional\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=600",
+	}
+}
+
 func (GPU) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"name": "Name of the GPU device as exposed by a device plugin",
@@ -710,7 +755,28 @@ func (InterfaceBindingMethod) SwaggerDoc() map[string]string {
 
 func (InterfaceBridge) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "InterfaceBridge connects to a given network via a linux bridge.",
+		"":             "InterfaceBridge connects to a given network via a linux bridge.",
+		"macLearning":  "MACLearning sets whether the bridge learns the MAC addresses the guest sends from, so that\nthe frames destined to them are forwarded to the guest only. Defaults to true.\n+optional",
+		"unicastFlood": "UnicastFlood sets whether the bridge forwards to the guest the frames destined to the MAC\naddresses it did not learn. It can't be disabled along with MACLearning. Defaults to true.\n+optional",
+		"tap":          "Tap tunes the tap device connecting the guest to the bridge.\n+optional",
+	}
+}
+
+func (InterfaceTap) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "InterfaceTap tunes the tap device of an interface, e.g. for the guest network stacks which\nmishandle the offloads, like DPDK in the guest or old kernels.",
+		"txQueueLength":   "TxQueueLength is the length of the transmit queue of the tap device, in packets.\nDefaults to the kernel default, 1000.\n+optional",
+		"disableOffloads": "DisableOffloads disables every offload of the interface, the checksum, segmentation and ECN\noffloads of the host and the guest, overriding Offload.\nOnly supported with the virtio model. Defaults to false.\n+optional",
+		"offload":         "Offload sets the segmentation offloads of the interface.\nOnly supported with the virtio model.\n+optional",
+	}
+}
+
+func (InterfaceTapOffload) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":    "InterfaceTapOffload sets the segmentation offloads of an interface, all enabled by default.",
+		"tso": "TSO sets the TCP segmentation offload, over IPv4 and IPv6, of the host and the guest.\n+optional",
+		"gso": "GSO sets the generic segmentation offload of the host.\n+optional",
+		"ufo": "UFO sets the UDP fragmentation offload of the host and the guest.\n+optional",
 	}
 }
 
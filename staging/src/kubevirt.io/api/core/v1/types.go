@@ -123,6 +123,11 @@ type VirtualMachineInstanceSpec struct {
 	StartStrategy *StartStrategy `json:"startStrategy,omitempty"`
 	// Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
 	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// ShutdownPolicy orchestrates the graceful shutdown of the guest within the termination grace period: a
+	// pre-shutdown command run by the guest agent, then the shutdown signal, each phase with its own timeout.
+	// It requires the ShutdownPolicy feature gate.
+	// +optional
+	ShutdownPolicy *ShutdownPolicy `json:"shutdownPolicy,omitempty"`
 	// List of volumes that can be mounted by disks belonging to the vmi.
 	// +kubebuilder:validation:MaxItems:=256
 	Volumes []Volume `json:"volumes,omitempty"`
@@ -168,6 +173,11 @@ type VirtualMachineInstanceSpec struct {
 	// +optional
 	// +kubebuilder:validation:MaxItems:=256
 	AccessCredentials []AccessCredential `json:"accessCredentials,omitempty"`
+	// IdentityDocument makes KubeVirt issue a signed identity document for the VMI, which the
+	// workloads in the guest can present to external services to authenticate as the VMI.
+	// It requires the VMIdentityDocuments feature gate.
+	// +optional
+	IdentityDocument *IdentityDocument `json:"identityDocument,omitempty"`
 	// Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components
 	Architecture string `json:"architecture,omitempty"`
 }
@@ -224,6 +234,9 @@ type VirtualMachineInstanceStatus struct {
 	Interfaces []VirtualMachineInstanceNetworkInterface `json:"interfaces,omitempty"`
 	// Guest OS Information
 	GuestOSInfo VirtualMachineInstanceGuestOSInfo `json:"guestOSInfo,omitempty"`
+	// DNS configuration of the guest, as reported by the guest agent
+	// +optional
+	GuestDNS *VirtualMachineInstanceGuestDNS `json:"guestDNS,omitempty"`
 	// Represents the status of a live migration
 	MigrationState *VirtualMachineInstanceMigrationState `json:"migrationState,omitempty"`
 	// Represents the method using which the vmi can be migrated: live migration or block migration
@@ -295,12 +308,129 @@ type VirtualMachineInstanceStatus struct {
 	// +optional
 	Memory *MemoryStatus `json:"memory,omitempty"`
 
+	// CPUResources shows the CPU request and limit of the VirtualMachineInstance, as desired in its spec
+	// and as applied to its virt-launcher pod. It requires the LiveCPUResources feature gate.
+	// +optional
+	CPUResources *CPUResourcesStatus `json:"cpuResources,omitempty"`
+
 	// MigratedVolumes lists the source and destination volumes during the volume migration
 	// +listType=atomic
 	// +optional
 	MigratedVolumes []StorageMigratedVolumeInfo `json:"migratedVolumes,omitempty"`
+
+	// ChannelStatus contains the statuses of the channels defined in the spec
+	// +optional
+	// +listType=atomic
+	ChannelStatus []ChannelStatus `json:"channelStatus,omitempty"`
+
+	// ShutdownStatus reports the progress of the graceful shutdown of a VMI with a shutdown policy
+	// +optional
+	ShutdownStatus *ShutdownStatus `json:"shutdownStatus,omitempty"`
+}
+
+// ChannelStatus represents the status of a channel
+type ChannelStatus struct {
+	// Name of the channel
+	Name string `json:"name"`
+	// Target is the name of the port as seen by the guest
+	Target string `json:"target"`
+	// Phase is either Pending, until the channel is plugged into the domain, or Attached
+	Phase ChannelPhase `json:"phase,omitempty"`
+	// GuestConnected reports whether a process in the guest has the port open
+	GuestConnected bool `json:"guestConnected,omitempty"`
+}
+
+type ChannelPhase string
+
+const (
+	// ChannelPending means the channel is not yet plugged into the domain
+	ChannelPending ChannelPhase = "Pending"
+	// ChannelAttached means the channel is plugged into the domain
+	ChannelAttached ChannelPhase = "Attached"
+)
+
+// DefaultPreShutdownTimeoutSeconds is the time given to a pre-shutdown command without timeout
+const DefaultPreShutdownTimeoutSeconds int32 = 60
+
+// ShutdownPolicy configures the phases of the graceful shutdown of the guest. The guest is forced off
+// once the termination grace period expires, whatever the phase.
+type ShutdownPolicy struct {
+	// PreShutdown is a command run in the guest by the guest agent before the guest is signaled to shut
+	// down, e.g. to stop a database cleanly. The guest is signaled once the command exits, fails or
+	// times out.
+	// +optional
+	PreShutdown *PreShutdownCommand `json:"preShutdown,omitempty"`
+	// SignalTimeoutSeconds is the time given to the guest to shut down once signaled, before it is
+	// forced off. The remainder of the termination grace period by default.
+	// +optional
+	SignalTimeoutSeconds *int64 `json:"signalTimeoutSeconds,omitempty"`
+	// WindowsUpdates is how the shutdown of a Windows guest with pending updates is handled, the guest
+	// agent is used to check for them. Wait doesn't force the guest off before the termination grace
+	// period expires while they are pending, Reschedule also refuses the evictions of the VMI for the
+	// drains to retry them later, Force ignores them. Force by default.
+	// +optional
+	WindowsUpdates WindowsUpdatesAction `json:"windowsUpdates,omitempty"`
+}
+
+type WindowsUpdatesAction string
+
+const (
+	// WindowsUpdatesWait waits for the pending Windows updates before forcing the guest off
+	WindowsUpdatesWait WindowsUpdatesAction = "Wait"
+	// WindowsUpdatesForce shuts the guest down regardless of its pending Windows updates
+	WindowsUpdatesForce WindowsUpdatesAction = "Force"
+	// WindowsUpdatesReschedule refuses the evictions of the VMI while Windows updates are pending, and waits for them
+	// otherwise
+	WindowsUpdatesReschedule WindowsUpdatesAction = "Reschedule"
+)
+
+// PreShutdownCommand is a command run in the guest before it is signaled to shut down
+type PreShutdownCommand struct {
+	// Command is the path of the command in the guest, followed by its arguments
+	// +listType=atomic
+	Command []string `json:"command"`
+	// TimeoutSeconds is the time given to the command to exit, 60 seconds by default
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// ShutdownStatus reports the progress of the graceful shutdown of the guest
+type ShutdownStatus struct {
+	// Phase is the current phase of the shutdown
+	Phase ShutdownPhase `json:"phase"`
+	// PreShutdownStartTimestamp is the time the pre-shutdown command started
+	// +optional
+	PreShutdownStartTimestamp *metav1.Time `json:"preShutdownStartTimestamp,omitempty"`
+	// PreShutdownEndTimestamp is the time the pre-shutdown command exited, failed or timed out
+	// +optional
+	PreShutdownEndTimestamp *metav1.Time `json:"preShutdownEndTimestamp,omitempty"`
+	// PreShutdownFailureReason is the reason the pre-shutdown command failed or timed out
+	// +optional
+	PreShutdownFailureReason string `json:"preShutdownFailureReason,omitempty"`
+	// SignalTimestamp is the time the guest was first signaled to shut down
+	// +optional
+	SignalTimestamp *metav1.Time `json:"signalTimestamp,omitempty"`
+}
+
+// IdentityDocument configures how the signed identity document of the VMI reaches the guest. The
+// document the VMI boots with is always exposed to the guest through fw_cfg, as
+// opt/io.kubevirt/identity-document.
+type IdentityDocument struct {
+	// GuestAgentPath is the path of the file in the guest the guest agent keeps the rotated identity
+	// document in, readable by root only. Without it, the guest only gets the document it booted with.
+	// +optional
+	GuestAgentPath string `json:"guestAgentPath,omitempty"`
 }
 
+type ShutdownPhase string
+
+const (
+	// ShutdownPhasePreShutdown means the pre-shutdown command is running in the guest
+	ShutdownPhasePreShutdown ShutdownPhase = "PreShutdown"
+	// ShutdownPhaseSignaled means the guest was signaled to shut down
+	ShutdownPhaseSignaled ShutdownPhase = "Signaled"
+)
+
 // StorageMigratedVolumeInfo tracks the information about the source and destination volumes during the volume migration
 type StorageMigratedVolumeInfo struct {
 	// VolumeName is the name of the volume that is being migrated
@@ -369,8 +499,58 @@ type VolumeStatus struct {
 	MemoryDumpVolume *DomainMemoryDumpInfo `json:"memoryDumpVolume,omitempty"`
 	// ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk
 	ContainerDiskVolume *ContainerDiskInfo `json:"containerDiskVolume,omitempty"`
+	// Health reflects the result of the storage heartbeat of the volume, if enabled
+	Health *VolumeHealth `json:"health,omitempty"`
+	// If the backing chain of the volume is being flattened, this will contain the flatten status.
+	Flatten *VolumeFlattenStatus `json:"flatten,omitempty"`
 }
 
+// VolumeHealth represents the health of the storage backing a volume as observed by virt-handler
+type VolumeHealth struct {
+	// Status is either Healthy or Degraded
+	Status VolumeHealthStatus `json:"status"`
+	// Reason is a brief description of why the volume is in the current status
+	Reason string `json:"reason,omitempty"`
+	// Message is a detailed message about the current status
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is the time the status last changed
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+type VolumeHealthStatus string
+
+const (
+	// VolumeHealthy means the probes against the volume are succeeding within the latency threshold
+	VolumeHealthy VolumeHealthStatus = "Healthy"
+	// VolumeDegraded means the volume failed or exceeded the latency threshold repeatedly
+	VolumeDegraded VolumeHealthStatus = "Degraded"
+)
+
+// VolumeFlattenStatus represents the flattening of the backing chain of a volume into its active image
+type VolumeFlattenStatus struct {
+	// Phase is either InProgress, Completed or Failed
+	Phase VolumeFlattenPhase `json:"phase"`
+	// Progress is the percentage of the backing chain already copied into the active image
+	Progress int32 `json:"progress,omitempty"`
+	// StartTimestamp is the time when the flattening started
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// EndTimestamp is the time when the flattening completed or failed
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// Message is a detailed message about the current phase
+	Message string `json:"message,omitempty"`
+}
+
+type VolumeFlattenPhase string
+
+const (
+	// VolumeFlattenInProgress means the backing chain is being copied into the active image
+	VolumeFlattenInProgress VolumeFlattenPhase = "InProgress"
+	// VolumeFlattenCompleted means the active image doesn't depend on a backing chain anymore
+	VolumeFlattenCompleted VolumeFlattenPhase = "Completed"
+	// VolumeFlattenFailed means the flattening was aborted, the volume still has its backing chain
+	VolumeFlattenFailed VolumeFlattenPhase = "Failed"
+)
+
 // KernelInfo show info about the kernel image
 type KernelInfo struct {
 	// Checksum is the checksum of the kernel image
@@ -580,6 +760,21 @@ const (
 
 	// Indicates whether the VMI is live migratable
 	VirtualMachineInstanceIsStorageLiveMigratable VirtualMachineInstanceConditionType = "StorageLiveMigratable"
+
+	// Indicates that the storage backing at least one of the VMI volumes is degraded
+	VirtualMachineInstanceStorageDegraded VirtualMachineInstanceConditionType = "StorageDegraded"
+
+	// Reflects whether the OnGuestBoot hook sidecars completed after the guest agent connected
+	VirtualMachineInstanceGuestBootHooksCompleted VirtualMachineInstanceConditionType = "GuestBootHooksCompleted"
+
+	// Indicates that the guest is emulated on a node of another architecture
+	VirtualMachineInstanceEmulated VirtualMachineInstanceConditionType = "Emulated"
+
+	// Indicates that the claim command of a VMI claimed from the warm standby VMs of its pool ran in the guest
+	VirtualMachineInstanceWarmStandbyReconfigured VirtualMachineInstanceConditionType = "WarmStandbyReconfigured"
+
+	// Indicates that Windows updates are being installed in the guest, or require a restart, and that its shutdown waits for them
+	VirtualMachineInstanceWindowsUpdatesPending VirtualMachineInstanceConditionType = "WindowsUpdatesPending"
 )
 
 // These are valid reasons for VMI conditions.
@@ -612,6 +807,24 @@ const (
 	VirtualMachineInstanceReasonNotMigratable = "NotMigratable"
 	// Reason means that the volume update change was cancelled
 	VirtualMachineInstanceReasonVolumesChangeCancellation = "VolumesChangeCancellation"
+	// Reason means that the storage heartbeat failed for at least one volume
+	VirtualMachineInstanceReasonVolumeProbeFailed = "VolumeProbeFailed"
+	// Reason means that at least one of the OnGuestBoot hook sidecars failed
+	VirtualMachineInstanceReasonGuestBootHookFailed = "GuestBootHookFailed"
+	// Reason means that the guest is emulated with TCG on a node of another architecture
+	VirtualMachineInstanceReasonCrossArchitectureEmulation = "CrossArchitectureEmulation"
+	// Reason means that the claim command of a warm standby VMI exited successfully
+	VirtualMachineInstanceReasonClaimCommandSucceeded = "ClaimCommandSucceeded"
+	// Reason means that the claim command of a warm standby VMI failed
+	VirtualMachineInstanceReasonClaimCommandFailed = "ClaimCommandFailed"
+	// Reason means that Windows updates are being installed in the guest
+	VirtualMachineInstanceReasonWindowsUpdatesInstalling = "WindowsUpdatesInstalling"
+	// Reason means that the guest has to restart to complete the installation of Windows updates
+	VirtualMachineInstanceReasonWindowsRestartRequired = "WindowsRestartRequired"
+	// Reason means that no Windows update is pending in the guest
+	VirtualMachineInstanceReasonNoWindowsUpdatesPending = "NoWindowsUpdatesPending"
+	// Reason means that the guest couldn't be checked for pending Windows updates
+	VirtualMachineInstanceReasonWindowsUpdatesCheckFailed = "WindowsUpdatesCheckFailed"
 )
 
 const (
@@ -704,6 +917,25 @@ type VirtualMachineInstanceNetworkInterface struct {
 	InfoSource string `json:"infoSource,omitempty"`
 	// Specifies how many queues are allocated by MultiQueue
 	QueueCount int32 `json:"queueCount,omitempty"`
+	// List of all IP addresses of the interface with their prefix length, e.g. 10.0.2.2/24,
+	// as reported by the guest agent
+	IPsWithPrefix []string `json:"ipAddressesWithPrefix,omitempty"`
+	// Default routes of the guest through the interface, as reported by the guest agent
+	DefaultRoutes []VirtualMachineInstanceNetworkInterfaceRoute `json:"defaultRoutes,omitempty"`
+}
+
+type VirtualMachineInstanceNetworkInterfaceRoute struct {
+	// Gateway is the address of the next hop of the route, empty when the route has no gateway
+	Gateway string `json:"gateway,omitempty"`
+	// Metric is the metric of the route in the guest
+	Metric int32 `json:"metric,omitempty"`
+}
+
+type VirtualMachineInstanceGuestDNS struct {
+	// Nameservers are the addresses of the name servers the guest resolves names with
+	Nameservers []string `json:"nameservers,omitempty"`
+	// Searches are the search domains of the guest
+	Searches []string `json:"searches,omitempty"`
 }
 
 type VirtualMachineInstanceGuestOSInfo struct {
@@ -772,6 +1004,8 @@ type VirtualMachineInstanceMigrationState struct {
 	MigrationUID types.UID `json:"migrationUid,omitempty"`
 	// Lets us know if the vmi is currently running pre or post copy migration
 	Mode MigrationMode `json:"mode,omitempty"`
+	// The downtime of the guest at the switchover of the migration, measured by QEMU
+	ObservedDowntime *metav1.Duration `json:"observedDowntime,omitempty"`
 	// Name of the migration policy. If string is empty, no policy is matched
 	MigrationPolicyName *string `json:"migrationPolicyName,omitempty"`
 	// Migration configurations to apply
@@ -788,6 +1022,27 @@ type VirtualMachineInstanceMigrationState struct {
 	SourcePersistentStatePVCName string `json:"sourcePersistentStatePVCName,omitempty"`
 	// If the VMI being migrated uses persistent features (backend-storage), its target PVC name is saved here
 	TargetPersistentStatePVCName string `json:"targetPersistentStatePVCName,omitempty"`
+	// The network announcements sent by the target node for the bridge bound interfaces,
+	// once the migrated domain is running
+	// +listType=atomic
+	// +optional
+	TargetNetworkAnnouncements []MigrationNetworkAnnouncement `json:"targetNetworkAnnouncements,omitempty"`
+}
+
+// MigrationNetworkAnnouncement reports the announcements of an interface to the network
+// after a migration, for the switches to learn its new location.
+//
+// +k8s:openapi-gen=true
+type MigrationNetworkAnnouncement struct {
+	// Name of the interface
+	Name string `json:"name"`
+	// The number of announcement rounds sent
+	Rounds uint32 `json:"rounds"`
+	// Indicates that all the announcement rounds were sent
+	Succeeded bool `json:"succeeded"`
+	// The reason the announcements failed
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 type MigrationAbortStatus string
@@ -987,6 +1242,12 @@ const (
 	HypervLabel = "hyperv.node.kubevirt.io/"
 	// This label represents vendor of cpu model on the node
 	CPUModelVendorLabel = "cpu-vendor.node.kubevirt.io/"
+	// This label represents the number of physical CPU cores of the node
+	HostPhysicalCoresLabel = "cpu-topology.node.kubevirt.io/physical-cores"
+	// This label assigns a VirtualMachineInstance to a license group for host core accounting
+	LicenseGroupLabel = "kubevirt.io/license-group"
+	// This label exempts a VirtualMachine from the expiry of its lease when set to "true"
+	LeaseExemptLabel = "kubevirt.io/lease-exempt"
 
 	VirtIO = "virtio"
 
@@ -1008,6 +1269,10 @@ const (
 	// This annotation is to keep virt launcher container alive when an VMI encounters a failure for debugging purpose
 	KeepLauncherAfterFailureAnnotation string = "kubevirt.io/keep-launcher-alive-after-failure"
 
+	// InfraDetailsAnnotation holds the unredacted messages of the events and conditions of a VMI
+	// whose infrastructure details were redacted. Only KubeVirt components may set it.
+	InfraDetailsAnnotation string = "kubevirt.io/infra-details"
+
 	// MigrationTransportUnixAnnotation means that the VMI will be migrated using the unix URI
 	MigrationTransportUnixAnnotation string = "kubevirt.io/migrationTransportUnix"
 
@@ -1043,6 +1308,14 @@ const (
 	// KSMHandlerManagedAnnotation is an annotation used to mark the nodes where the virt-handler has enabled the ksm
 	KSMHandlerManagedAnnotation string = "kubevirt.io/ksm-handler-managed"
 
+	// HousekeepingCPUsConflictAnnotation is set by virt-handler on its node when the housekeeping CPUs conflict
+	// with the CPUs of the kubelet, with the reason they are not enforced
+	HousekeepingCPUsConflictAnnotation string = "kubevirt.io/housekeeping-cpus-conflict"
+
+	// NodeMemoryOvercommitPressure is the condition virt-handler sets on its node, true when the memory in use,
+	// including the swap space in use, approaches the physical memory of the node
+	NodeMemoryOvercommitPressure k8sv1.NodeConditionType = "kubevirt.io/memory-overcommit-pressure"
+
 	// KSM debug annotations to override default constants
 	KSMPagesBoostOverride      string = "kubevirt.io/ksm-pages-boost-override"
 	KSMPagesDecayOverride      string = "kubevirt.io/ksm-pages-decay-override"
@@ -1068,6 +1341,22 @@ const (
 	// originated from.
 	VirtualMachinePoolRevisionName string = "kubevirt.io/vm-pool-revision-name"
 
+	// VirtualMachinePoolWarmStandbyLabel marks the warm standby VMs of a vmpool, and their VMIs
+	// until they are claimed.
+	VirtualMachinePoolWarmStandbyLabel string = "kubevirt.io/vm-pool-warm-standby"
+
+	// VirtualMachinePoolClaimCommandAnnotation holds the command, as a JSON array, the guest agent runs
+	// in a VMI claimed from the warm standby VMs of its vmpool.
+	VirtualMachinePoolClaimCommandAnnotation string = "kubevirt.io/vm-pool-claim-command"
+
+	// ForkParentAnnotation marks a VMI as the parent of forked VMIs. Its value is the name of the
+	// filesystem persistentVolumeClaim or dataVolume volume the fork image is saved to when the VMI is paused.
+	ForkParentAnnotation string = "kubevirt.io/fork-parent"
+
+	// ForkFromAnnotation marks a VMI as forked. Its value is the name of the ephemeral volume holding the
+	// fork image of the parent, which the VMI is restored from instead of booting.
+	ForkFromAnnotation string = "kubevirt.io/fork-from"
+
 	// VirtualMachineNameLabel is the name of the Virtual Machine
 	VirtualMachineNameLabel string = "vm.kubevirt.io/name"
 
@@ -1115,6 +1404,9 @@ const (
 	// EmulatorThreadCompleteToEvenParity alpha annotation will cause Kubevirt to complete the VMI's CPU count to an even parity when IsolateEmulatorThread options are requested
 	EmulatorThreadCompleteToEvenParity string = "alpha.kubevirt.io/EmulatorThreadCompleteToEvenParity"
 
+	// IOThreadsPinningAnnotation carries the iothreads pinning policy of the cluster to the VMIs with an isolated emulator thread
+	IOThreadsPinningAnnotation string = "kubevirt.io/iothreads-pinning"
+
 	// VolumesUpdateMigration indicates that the migration copies and update
 	// the volumes
 	VolumesUpdateMigration string = "kubevirt.io/volume-update-migration"
@@ -1122,6 +1414,27 @@ const (
 	// ImmediateDataVolumeCreation indicates that the data volumes should be created immediately
 	// Even if the VM is halted
 	ImmediateDataVolumeCreation string = "kubevirt.io/immediate-data-volume-creation"
+
+	// ServiceNetworkLabel marks a Service without selector whose endpoints are published by
+	// virt-controller from the addresses of the VMIs on the network named by the label
+	ServiceNetworkLabel string = "kubevirt.io/service-network"
+
+	// ServiceVMISelectorAnnotation is the label selector of the VMIs backing a Service marked with
+	// the ServiceNetworkLabel
+	ServiceVMISelectorAnnotation string = "kubevirt.io/service-vmi-selector"
+
+	// EndpointSliceManagedByVirtController is the value of the managed-by label of the
+	// EndpointSlices published by virt-controller
+	EndpointSliceManagedByVirtController string = "virt-controller.kubevirt.io"
+
+	// RoutePortAnnotation is the guest port a VM exposes through a route of the configured Gateway
+	RoutePortAnnotation string = "kubevirt.io/route-port"
+
+	// RouteProtocolAnnotation is the protocol of the route of a VM, HTTP or TCP. Defaults to HTTP.
+	RouteProtocolAnnotation string = "kubevirt.io/route-protocol"
+
+	// RouteHostnameAnnotation is the hostname the HTTP route of a VM matches
+	RouteHostnameAnnotation string = "kubevirt.io/route-hostname"
 )
 
 func NewVMI(name string, uid types.UID) *VirtualMachineInstance {
@@ -1577,6 +1890,67 @@ type VirtualMachineSpec struct {
 
 	// UpdateVolumesStrategy is the strategy to apply on volumes updates
 	UpdateVolumesStrategy *UpdateVolumesStrategy `json:"updateVolumesStrategy,omitempty"`
+
+	// StartDependencies lists resources which have to be available before the VirtualMachineInstance is created.
+	// Whenever the run strategy requires the VirtualMachine to start, the start is delayed until all dependencies are ready.
+	// +optional
+	// +listType=atomic
+	StartDependencies []StartDependency `json:"startDependencies,omitempty"`
+
+	// Lease limits the lifetime of the VirtualMachine, which is stopped or deleted once the lease expired.
+	// The lease is extended with the renewlease subresource. It requires the VMLease feature gate.
+	// +optional
+	Lease *VirtualMachineLease `json:"lease,omitempty"`
+}
+
+// VirtualMachineLeaseAction is what happens to a VirtualMachine once its lease expired
+type VirtualMachineLeaseAction string
+
+const (
+	// VirtualMachineLeaseActionStop halts the VirtualMachine
+	VirtualMachineLeaseActionStop VirtualMachineLeaseAction = "Stop"
+	// VirtualMachineLeaseActionDelete deletes the VirtualMachine
+	VirtualMachineLeaseActionDelete VirtualMachineLeaseAction = "Delete"
+)
+
+// VirtualMachineLease limits the lifetime of a VirtualMachine
+type VirtualMachineLease struct {
+	// ExpiresAt is the time the lease expires at
+	ExpiresAt metav1.Time `json:"expiresAt"`
+	// Action is what happens to the VirtualMachine once the lease expired, Stop by default
+	// +kubebuilder:validation:Enum=Stop;Delete
+	// +optional
+	Action VirtualMachineLeaseAction `json:"action,omitempty"`
+	// WarningPeriod is how long before the expiry of the lease the VirtualMachine gets the LeaseExpiring
+	// condition and a warning event. One hour by default.
+	// +optional
+	WarningPeriod *metav1.Duration `json:"warningPeriod,omitempty"`
+}
+
+// StartDependencyKind is the kind of resource a VirtualMachine start can depend on
+type StartDependencyKind string
+
+const (
+	// StartDependencyPersistentVolumeClaim is ready as soon as the PersistentVolumeClaim is bound
+	StartDependencyPersistentVolumeClaim StartDependencyKind = "PersistentVolumeClaim"
+	// StartDependencyNetworkAttachmentDefinition is ready as soon as the NetworkAttachmentDefinition exists
+	StartDependencyNetworkAttachmentDefinition StartDependencyKind = "NetworkAttachmentDefinition"
+	// StartDependencyService is ready as soon as the Service has at least one ready endpoint
+	StartDependencyService StartDependencyKind = "Service"
+)
+
+// StartDependency references a resource in the namespace of the VirtualMachine
+type StartDependency struct {
+	// Kind of the resource
+	// +kubebuilder:validation:Enum=PersistentVolumeClaim;NetworkAttachmentDefinition;Service
+	Kind StartDependencyKind `json:"kind"`
+	// Name of the resource, NetworkAttachmentDefinitions from another namespace can be referenced as <namespace>/<name>
+	Name string `json:"name"`
+	// Timeout after which waiting for the dependency is reported as failed.
+	// The VirtualMachine keeps waiting and starts as soon as the dependency is ready.
+	// Defaults to no timeout.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 // StateChangeRequestType represents the existing state change requests that are possible
@@ -1634,6 +2008,9 @@ const (
 	// VirtualMachineStatusWaitingForVolumeBinding indicates that some PersistentVolumeClaims backing
 	// the virtual machine volume are still not bound.
 	VirtualMachineStatusWaitingForVolumeBinding VirtualMachinePrintableStatus = "WaitingForVolumeBinding"
+	// VirtualMachineStatusWaitingForDependencies indicates that the start of the virtual machine
+	// is delayed until its start dependencies are ready.
+	VirtualMachineStatusWaitingForDependencies VirtualMachinePrintableStatus = "WaitingForDependencies"
 )
 
 // VirtualMachineStartFailure tracks VMIs which failed to transition successfully
@@ -1776,6 +2153,23 @@ const (
 
 	// VirtualMachineManualRecoveryRequired is added when the VM spec needs to be manually recovered by the user
 	VirtualMachineManualRecoveryRequired VirtualMachineConditionType = "ManualRecoveryRequired"
+
+	// VirtualMachineWaitingForDependencies is added when the start of the VM is delayed by dependencies which aren't ready
+	VirtualMachineWaitingForDependencies VirtualMachineConditionType = "WaitingForDependencies"
+
+	// VirtualMachineLeaseExpiring is added when the lease of the VM expires soon, or expired
+	VirtualMachineLeaseExpiring VirtualMachineConditionType = "LeaseExpiring"
+)
+
+const (
+	// VirtualMachineReasonDependenciesNotReady is set while the VM waits for its start dependencies
+	VirtualMachineReasonDependenciesNotReady = "DependenciesNotReady"
+	// VirtualMachineReasonDependencyTimedOut is set once a start dependency did not become ready within its timeout
+	VirtualMachineReasonDependencyTimedOut = "DependencyTimedOut"
+	// VirtualMachineReasonLeaseExpiresSoon is set once the lease of the VM entered its warning period
+	VirtualMachineReasonLeaseExpiresSoon = "LeaseExpiresSoon"
+	// VirtualMachineReasonLeaseExpired is set once the lease of the VM expired
+	VirtualMachineReasonLeaseExpired = "LeaseExpired"
 )
 
 type HostDiskType string
@@ -1925,6 +2319,43 @@ type CertConfig struct {
 
 type KubeVirtCertificateRotateStrategy struct {
 	SelfSigned *KubeVirtSelfSignConfiguration `json:"selfSigned,omitempty"`
+	// External delegates the issuance of the serving and client certificates of the KubeVirt
+	// components. The built-in CA keeps signing the certificates of VirtualMachineExports.
+	// +optional
+	External *KubeVirtExternalCertConfiguration `json:"external,omitempty"`
+}
+
+// KubeVirtExternalCertConfiguration configures the issuance of the component certificates outside of virt-operator
+type KubeVirtExternalCertConfiguration struct {
+	// CertManager requests the certificates from a cert-manager issuer.
+	// If unset, the certificate secrets are provided by the cluster admin, with the
+	// CA certificate in the ca.crt key of every secret.
+	// +optional
+	CertManager *CertManagerConfiguration `json:"certManager,omitempty"`
+}
+
+// CertManagerConfiguration configures the cert-manager Certificates requested by virt-operator
+type CertManagerConfiguration struct {
+	// IssuerRef references the cert-manager issuer signing the certificates
+	IssuerRef CertManagerIssuerReference `json:"issuerRef"`
+	// Server configures the duration and the renewal of the certificates.
+	// Defaults to the cert-manager defaults.
+	// +optional
+	Server *CertConfig `json:"server,omitempty"`
+}
+
+// CertManagerIssuerReference references a cert-manager Issuer or ClusterIssuer
+type CertManagerIssuerReference struct {
+	// Name of the issuer
+	Name string `json:"name"`
+	// Kind of the issuer, Issuer or ClusterIssuer. Defaults to Issuer.
+	// An Issuer has to be in the namespace of KubeVirt.
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Group of the issuer. Defaults to cert-manager.io
+	// +optional
+	Group string `json:"group,omitempty"`
 }
 
 type WorkloadUpdateMethod string
@@ -2040,6 +2471,10 @@ type KubeVirtSpec struct {
 	Workloads *ComponentConfig `json:"workloads,omitempty"`
 
 	CustomizeComponents CustomizeComponents `json:"customizeComponents,omitempty"`
+
+	// Alerts tunes or disables the bundled alerts of the PrometheusRule deployed by KubeVirt
+	// +optional
+	Alerts *AlertsConfiguration `json:"alerts,omitempty"`
 }
 
 type CustomizeComponents struct {
@@ -2077,6 +2512,46 @@ const (
 	StrategicMergePatchType PatchType = "strategic"
 )
 
+// AlertsConfiguration customizes the bundled alerts of KubeVirt
+type AlertsConfiguration struct {
+	// Disabled lists the names of the bundled alerts which are not deployed
+	// +listType=set
+	// +optional
+	Disabled []string `json:"disabled,omitempty"`
+	// Overrides tunes individual bundled alerts
+	// +listType=map
+	// +listMapKey=alert
+	// +optional
+	Overrides []AlertOverride `json:"overrides,omitempty"`
+}
+
+// AlertOverride tunes a bundled alert of KubeVirt
+type AlertOverride struct {
+	// Alert is the name of the bundled alert
+	Alert string `json:"alert"`
+	// For is how long the expression of the alert has to hold before the alert fires, e.g. 30m
+	// +kubebuilder:validation:Pattern="^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$"
+	// +optional
+	For *string `json:"for,omitempty"`
+	// Threshold replaces the number the expression of the alert is compared with.
+	// Only the alerts whose expression ends with a comparison to a number have a threshold.
+	// +optional
+	Threshold *string `json:"threshold,omitempty"`
+	// Severity replaces the severity label of the alert
+	// +optional
+	Severity AlertSeverity `json:"severity,omitempty"`
+}
+
+// AlertSeverity is the severity label of an alert
+// +kubebuilder:validation:Enum=info;warning;critical
+type AlertSeverity string
+
+const (
+	AlertSeverityInfo     AlertSeverity = "info"
+	AlertSeverityWarning  AlertSeverity = "warning"
+	AlertSeverityCritical AlertSeverity = "critical"
+)
+
 type KubeVirtUninstallStrategy string
 
 const (
@@ -2120,6 +2595,57 @@ type KubeVirtStatus struct {
 	DefaultArchitecture                     string              `json:"defaultArchitecture,omitempty"`
 	// +listType=atomic
 	Generations []GenerationStatus `json:"generations,omitempty" optional:"true"`
+	// FIPSPosture reports for each KubeVirt component whether it runs with FIPS validated
+	// cryptography and only negotiates FIPS approved ciphers.
+	// +listType=atomic
+	FIPSPosture []ComponentFIPSPosture `json:"fipsPosture,omitempty" optional:"true"`
+	// Certificates reports the state of the certificates of the KubeVirt components
+	// +listType=atomic
+	Certificates []KubeVirtCertificateStatus `json:"certificates,omitempty" optional:"true"`
+}
+
+// KubeVirtCertificateIssuer is the issuer of a certificate of the KubeVirt components
+type KubeVirtCertificateIssuer string
+
+const (
+	// CertificateIssuerSelfSigned certificates are issued and rotated by virt-operator
+	CertificateIssuerSelfSigned KubeVirtCertificateIssuer = "SelfSigned"
+	// CertificateIssuerCertManager certificates are issued and rotated by cert-manager
+	CertificateIssuerCertManager KubeVirtCertificateIssuer = "CertManager"
+	// CertificateIssuerExternal certificates are provided by the cluster admin
+	CertificateIssuerExternal KubeVirtCertificateIssuer = "External"
+)
+
+// KubeVirtCertificateStatus reports the state of a certificate of the KubeVirt components
+type KubeVirtCertificateStatus struct {
+	// SecretName is the name of the secret holding the certificate
+	SecretName string `json:"secretName"`
+	// Issuer of the certificate
+	Issuer KubeVirtCertificateIssuer `json:"issuer"`
+	// Ready is true when the secret holds a valid certificate
+	Ready bool `json:"ready"`
+	// NotBefore is the start of the validity of the certificate. It changes on every rotation.
+	// +optional
+	// +nullable
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+	// NotAfter is the expiration of the certificate
+	// +optional
+	// +nullable
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+	// Message explains why the certificate is not ready
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ComponentFIPSPosture reports the FIPS posture of a KubeVirt component
+type ComponentFIPSPosture struct {
+	// Component is the name of the KubeVirt component, e.g. virt-api
+	Component string `json:"component"`
+	// ValidatedCrypto is true when the component is built against a FIPS validated cryptographic module
+	ValidatedCrypto bool `json:"validatedCrypto"`
+	// ApprovedCiphersOnly is true when the TLS endpoints of the component only negotiate
+	// FIPS approved protocol versions and cipher suites
+	ApprovedCiphersOnly bool `json:"approvedCiphersOnly"`
 }
 
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
@@ -2265,6 +2791,105 @@ type StopOptions struct {
 	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,2,rep,name=dryRun"`
 }
 
+// RenewLeaseOptions is provided when renewing the lease of a VirtualMachine
+type RenewLeaseOptions struct {
+	// Duration of the renewed lease, which then expires that long after the renewal
+	Duration metav1.Duration `json:"duration"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// SubresourceAccessReview is sent to the subresource access webhook to authorize a user to access an
+// interactive subresource of a VirtualMachineInstance. The webhook answers with the review, its status set.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type SubresourceAccessReview struct {
+	metav1.TypeMeta `json:",inline"`
+	Spec            SubresourceAccessReviewSpec `json:"spec"`
+	// +optional
+	Status SubresourceAccessReviewStatus `json:"status,omitempty"`
+}
+
+// SubresourceAccessReviewSpec describes the access to authorize
+type SubresourceAccessReviewSpec struct {
+	// User requesting the access, as authenticated by the API server
+	User string `json:"user"`
+	// Groups of the user
+	// +optional
+	// +listType=atomic
+	Groups []string `json:"groups,omitempty"`
+	// Extra information on the user, as provided by the authenticator
+	// +optional
+	Extra map[string][]string `json:"extra,omitempty"`
+	// Namespace of the VirtualMachineInstance
+	Namespace string `json:"namespace"`
+	// Name of the VirtualMachineInstance
+	Name string `json:"name"`
+	// Subresource accessed, e.g. console
+	Subresource string `json:"subresource"`
+	// VMILabels are the labels of the VirtualMachineInstance
+	// +optional
+	VMILabels map[string]string `json:"vmiLabels,omitempty"`
+	// VMLabels are the labels of the VirtualMachine owning the VirtualMachineInstance, if any
+	// +optional
+	VMLabels map[string]string `json:"vmLabels,omitempty"`
+	// RequestTime is the time the access was requested at
+	RequestTime metav1.Time `json:"requestTime"`
+}
+
+// SubresourceAccessReviewStatus is the decision of the webhook
+type SubresourceAccessReviewStatus struct {
+	// Allowed is true if the access is authorized
+	Allowed bool `json:"allowed"`
+	// Reason of the decision, reported to the user when denied
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// ModernizeOptions are provided when rewriting the deprecated API usages of a VirtualMachine
+type ModernizeOptions struct {
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// VirtualMachineModernization is the result of rewriting the deprecated API usages of a VirtualMachine
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineModernization struct {
+	metav1.TypeMeta `json:",inline"`
+	// Changes lists the deprecated API usages rewritten into their current equivalents
+	// +optional
+	// +listType=atomic
+	Changes []ModernizeChange `json:"changes,omitempty"`
+	// Unsupported lists the deprecated API usages left as they are, having no equivalent in the cluster
+	// +optional
+	// +listType=atomic
+	Unsupported []ModernizeChange `json:"unsupported,omitempty"`
+	// VirtualMachine as modernized, or as it would be on a dry run
+	// +optional
+	VirtualMachine *VirtualMachine `json:"virtualMachine,omitempty"`
+}
+
+// ModernizeChange describes the rewrite of a deprecated API usage
+type ModernizeChange struct {
+	// Path of the deprecated field
+	Path string `json:"path"`
+	// Message describes the rewrite
+	Message string `json:"message"`
+}
+
 // MigrateOptions may be provided on migrate request.
 type MigrateOptions struct {
 	metav1.TypeMeta `json:",inline"`
@@ -2303,6 +2928,292 @@ type VirtualMachineInstanceGuestAgentInfo struct {
 	FSFreezeStatus string `json:"fsFreezeStatus,omitempty"`
 }
 
+// VirtualMachinePortalView is a sanitized view of a VirtualMachine or VirtualMachineInstance meant for
+// end user portals. It leaves out infrastructure details like node, pod or host interface names.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachinePortalView struct {
+	metav1.TypeMeta `json:",inline"`
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	// PrintableStatus of the VirtualMachine, unset when the view was requested for a VirtualMachineInstance
+	// +optional
+	PrintableStatus VirtualMachinePrintableStatus `json:"printableStatus,omitempty"`
+	// Phase of the VirtualMachineInstance, unset if the VirtualMachine is not running
+	// +optional
+	Phase VirtualMachineInstancePhase `json:"phase,omitempty"`
+	// Ready is true if the VirtualMachineInstance is ready
+	Ready bool `json:"ready"`
+	// Conditions of the VirtualMachineInstance, without their messages
+	// +optional
+	// +listType=atomic
+	Conditions []PortalViewCondition `json:"conditions,omitempty"`
+	// Interfaces of the VirtualMachineInstance as reported by the guest
+	// +optional
+	// +listType=atomic
+	Interfaces []PortalViewInterface `json:"interfaces,omitempty"`
+	// GuestOSInfo as reported by the guest agent
+	// +optional
+	GuestOSInfo *VirtualMachineInstanceGuestOSInfo `json:"guestOSInfo,omitempty"`
+	// Metrics is a snapshot of the resource usage, only set while the VirtualMachineInstance is running
+	// +optional
+	Metrics *VirtualMachineInstanceMetricsSnapshot `json:"metrics,omitempty"`
+}
+
+// PortalViewCondition is a VirtualMachineInstance condition stripped of its message
+type PortalViewCondition struct {
+	Type   VirtualMachineInstanceConditionType `json:"type"`
+	Status k8sv1.ConditionStatus               `json:"status"`
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// PortalViewInterface is a VirtualMachineInstance network interface as shown to end users
+type PortalViewInterface struct {
+	// Name of the interface in the VirtualMachineInstance spec
+	// +optional
+	Name string `json:"name,omitempty"`
+	// +optional
+	MAC string `json:"mac,omitempty"`
+	// +optional
+	// +listType=atomic
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+}
+
+// VirtualMachineInstanceMetricsSnapshot is a point in time view of the resources used by a VirtualMachineInstance
+type VirtualMachineInstanceMetricsSnapshot struct {
+	// Timestamp at which the snapshot was taken
+	Timestamp metav1.Time `json:"timestamp"`
+	// CPUTimeNanoseconds is the total CPU time consumed by the guest
+	// +optional
+	CPUTimeNanoseconds int64 `json:"cpuTimeNanoseconds,omitempty"`
+	// MemoryUsedBytes is the memory used by the guest, as reported by the balloon driver
+	// +optional
+	MemoryUsedBytes int64 `json:"memoryUsedBytes,omitempty"`
+	// MemoryAvailableBytes is the memory available to the guest, as reported by the balloon driver
+	// +optional
+	MemoryAvailableBytes int64 `json:"memoryAvailableBytes,omitempty"`
+	// NetworkReceivedBytes is the total of bytes received on all interfaces
+	// +optional
+	NetworkReceivedBytes int64 `json:"networkReceivedBytes,omitempty"`
+	// NetworkTransmittedBytes is the total of bytes transmitted on all interfaces
+	// +optional
+	NetworkTransmittedBytes int64 `json:"networkTransmittedBytes,omitempty"`
+	// StorageReadBytes is the total of bytes read from all disks
+	// +optional
+	StorageReadBytes int64 `json:"storageReadBytes,omitempty"`
+	// StorageWrittenBytes is the total of bytes written to all disks
+	// +optional
+	StorageWrittenBytes int64 `json:"storageWrittenBytes,omitempty"`
+}
+
+// VirtualMachineInstanceDeviceList is the live view of the devices attached to the domain of a running
+// VirtualMachineInstance, as reported by libvirt. It differs from the spec while devices are being
+// hotplugged or unplugged.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceDeviceList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Disks attached to the domain
+	// +optional
+	// +listType=atomic
+	Disks []AttachedDisk `json:"disks,omitempty"`
+	// Interfaces attached to the domain, except the SR-IOV interfaces which are host devices
+	// +optional
+	// +listType=atomic
+	Interfaces []AttachedInterface `json:"interfaces,omitempty"`
+	// HostDevices assigned to the domain, e.g. the GPUs and the SR-IOV interfaces
+	// +optional
+	// +listType=atomic
+	HostDevices []AttachedHostDevice `json:"hostDevices,omitempty"`
+}
+
+// AttachedDisk is a disk attached to the domain of a VirtualMachineInstance
+type AttachedDisk struct {
+	// Name of the disk in the VirtualMachineInstance spec, unset for the disks not defined in the spec
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Target is the device name of the disk in the domain, e.g. vda
+	Target string `json:"target"`
+	// Device is either disk, cdrom or lun
+	// +optional
+	Device string `json:"device,omitempty"`
+	// Bus of the disk, e.g. virtio, sata or scsi
+	// +optional
+	Bus DiskBus `json:"bus,omitempty"`
+	// Source is the file or block device backing the disk in the virt-launcher pod
+	// +optional
+	Source string `json:"source,omitempty"`
+	// ReadOnly is true if the disk is attached read only
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// Address of the disk in the guest, e.g. its PCI address
+	// +optional
+	Address string `json:"address,omitempty"`
+	// Queues is the number of queues of the disk, unset when it has a single queue
+	// +optional
+	Queues *int32 `json:"queues,omitempty"`
+}
+
+// AttachedInterface is a network interface attached to the domain of a VirtualMachineInstance
+type AttachedInterface struct {
+	// Name of the interface in the VirtualMachineInstance spec, unset for the interfaces not defined in the spec
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Model of the interface, e.g. virtio or e1000e
+	// +optional
+	Model string `json:"model,omitempty"`
+	// MAC address of the interface
+	// +optional
+	MAC string `json:"mac,omitempty"`
+	// Target is the device backing the interface in the virt-launcher pod, e.g. its tap device
+	// +optional
+	Target string `json:"target,omitempty"`
+	// LinkState is either up or down
+	// +optional
+	LinkState string `json:"linkState,omitempty"`
+	// Address of the interface in the guest, e.g. its PCI address
+	// +optional
+	Address string `json:"address,omitempty"`
+	// Queues is the number of queues of the interface, unset when it has a single queue
+	// +optional
+	Queues *int32 `json:"queues,omitempty"`
+}
+
+// AttachedHostDevice is a host device assigned to the domain of a VirtualMachineInstance
+type AttachedHostDevice struct {
+	// Name of the device in the VirtualMachineInstance spec, unset for the devices not defined in the spec
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Type of the device, e.g. pci, mdev or usb
+	Type string `json:"type"`
+	// Source is the device on the node, its PCI address or the UUID of the mediated device
+	// +optional
+	Source string `json:"source,omitempty"`
+	// Address of the device in the guest, e.g. its PCI address
+	// +optional
+	Address string `json:"address,omitempty"`
+}
+
+// VirtualMachineInstanceIdentity is the identity of a VirtualMachineInstance attested by its identity document
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceIdentity struct {
+	metav1.TypeMeta `json:",inline"`
+	// UID of the VirtualMachineInstance
+	UID types.UID `json:"uid"`
+	// Name of the VirtualMachineInstance
+	Name string `json:"name"`
+	// Namespace of the VirtualMachineInstance
+	Namespace string `json:"namespace"`
+	// Labels of the VirtualMachineInstance
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// NamespaceLabels are the labels of the namespace of the VirtualMachineInstance, e.g. its project
+	// +optional
+	NamespaceLabels map[string]string `json:"namespaceLabels,omitempty"`
+	// BootTime is the time the VirtualMachineInstance started running, unset in the documents issued before
+	// +optional
+	BootTime *metav1.Time `json:"bootTime,omitempty"`
+	// IssuedAt is the time the document was issued
+	IssuedAt metav1.Time `json:"issuedAt"`
+	// ExpiresAt is the time the document stops being valid
+	ExpiresAt metav1.Time `json:"expiresAt"`
+}
+
+// SignedIdentityDocument is the identity document of a VirtualMachineInstance signed by KubeVirt
+type SignedIdentityDocument struct {
+	// Document is the base64 encoded JSON of the VirtualMachineInstanceIdentity
+	Document string `json:"document"`
+	// Signature is the base64 encoded HMAC-SHA256 of the document, only KubeVirt holds its key
+	Signature string `json:"signature"`
+}
+
+// ClusterCapabilities summarizes what the cluster supports, for user interfaces and tooling to only
+// offer the options which are valid
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterCapabilities struct {
+	metav1.TypeMeta `json:",inline"`
+	// FeatureGates enabled in the KubeVirt CR, sorted
+	// +optional
+	// +listType=atomic
+	FeatureGates []string `json:"featureGates,omitempty"`
+	// DefaultArchitecture of the VirtualMachines which don't set one
+	// +optional
+	DefaultArchitecture string `json:"defaultArchitecture,omitempty"`
+	// Architectures of the schedulable nodes
+	// +optional
+	// +listType=atomic
+	Architectures []ArchitectureCapabilities `json:"architectures,omitempty"`
+	// NetworkBindings are the network binding plugins registered, in addition to the core bindings
+	// +optional
+	// +listType=atomic
+	NetworkBindings []string `json:"networkBindings,omitempty"`
+	// HostDevices permitted in the KubeVirt CR, e.g. the GPUs
+	// +optional
+	// +listType=atomic
+	HostDevices []HostDeviceCapability `json:"hostDevices,omitempty"`
+	// StorageProfiles of the storage classes, unset when CDI is not installed
+	// +optional
+	// +listType=atomic
+	StorageProfiles []StorageProfileCapability `json:"storageProfiles,omitempty"`
+}
+
+// ArchitectureCapabilities describes what the schedulable nodes of an architecture support
+type ArchitectureCapabilities struct {
+	// Name of the architecture, e.g. amd64
+	Name string `json:"name"`
+	// Nodes is the number of schedulable nodes of the architecture
+	Nodes int32 `json:"nodes"`
+	// DefaultMachineType of the VirtualMachines which don't set one
+	// +optional
+	DefaultMachineType string `json:"defaultMachineType,omitempty"`
+	// MachineTypes the VirtualMachines can use, as glob patterns, e.g. pc-q35*
+	// +optional
+	// +listType=atomic
+	MachineTypes []string `json:"machineTypes,omitempty"`
+	// CPUModels available on at least one of the nodes, sorted
+	// +optional
+	// +listType=atomic
+	CPUModels []string `json:"cpuModels,omitempty"`
+}
+
+type HostDeviceCapabilityType string
+
+const (
+	HostDeviceCapabilityPCI      HostDeviceCapabilityType = "PCI"
+	HostDeviceCapabilityMediated HostDeviceCapabilityType = "Mediated"
+	HostDeviceCapabilityUSB      HostDeviceCapabilityType = "USB"
+)
+
+// HostDeviceCapability is a host device permitted in the KubeVirt CR
+type HostDeviceCapability struct {
+	// ResourceName the device is requested with in the VirtualMachine spec
+	ResourceName string `json:"resourceName"`
+	// Type of the device, one of PCI, Mediated or USB
+	Type HostDeviceCapabilityType `json:"type"`
+	// Allocatable is the number of devices allocatable on the schedulable nodes
+	Allocatable int64 `json:"allocatable"`
+}
+
+// StorageProfileCapability summarizes the CDI storage profile of a storage class
+type StorageProfileCapability struct {
+	// StorageClass the profile is about
+	StorageClass string `json:"storageClass"`
+	// AccessModes supported by the storage class
+	// +optional
+	// +listType=atomic
+	AccessModes []k8sv1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+	// VolumeModes supported by the storage class
+	// +optional
+	// +listType=atomic
+	VolumeModes []k8sv1.PersistentVolumeMode `json:"volumeModes,omitempty"`
+}
+
 // List of commands that QEMU guest agent supports
 type GuestAgentCommandInfo struct {
 	Name    string `json:"name"`
@@ -2420,6 +3331,38 @@ type AddVolumeOptions struct {
 	DryRun []string `json:"dryRun,omitempty"`
 }
 
+// AddChannelOptions is provided when dynamically hot plugging a channel
+type AddChannelOptions struct {
+	// Name of the channel to add
+	Name string `json:"name"`
+	// Target is the name of the port as seen by the guest, e.g. org.example.agent.0
+	Target string `json:"target"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// FlattenOptions is provided when flattening the backing chains of the volumes of a running VMI
+type FlattenOptions struct {
+	// Volumes are the names of the volumes to flatten, all the volumes with a backing chain when empty
+	// +optional
+	// +listType=atomic
+	Volumes []string `json:"volumes,omitempty"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
 type ScreenshotOptions struct {
 	MoveCursor bool `json:"moveCursor"`
 }
@@ -2550,8 +3493,310 @@ type KubeVirtConfiguration struct {
 	// Instancetype configuration
 	// +nullable
 	Instancetype *InstancetypeConfiguration `json:"instancetype,omitempty"`
+
+	// StorageHealthCheck configures the periodic probing of the storage backing the volumes of running VMIs.
+	// It takes effect only when the StorageHealthCheck feature gate is enabled.
+	// +nullable
+	StorageHealthCheck *StorageHealthCheckConfiguration `json:"storageHealthCheck,omitempty"`
+
+	// ExternalPolicyServices lists external policy services consulted at VM admission and
+	// migration target selection.
+	// It takes effect only when the ExternalPolicy feature gate is enabled.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ExternalPolicyServices []ExternalPolicyService `json:"externalPolicyServices,omitempty"`
+
+	// LicenseGroups restricts the nodes VMIs labeled with kubevirt.io/license-group may run on.
+	// It takes effect only when the LicenseTracking feature gate is enabled.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	LicenseGroups []LicenseGroup `json:"licenseGroups,omitempty"`
+
+	// TenantNodePools dedicates node pools to the VMIs of the namespaces they select.
+	// It takes effect only when the TenantNodePools feature gate is enabled.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	TenantNodePools []TenantNodePool `json:"tenantNodePools,omitempty"`
+
+	// VirtualizationInfraReservation reserves CPUs and memory of each node for the virtualization
+	// infrastructure of the VMIs: their emulator threads, iothreads and vhost kernel threads.
+	// It takes effect only when the VirtualizationInfraReservation feature gate is enabled.
+	// +nullable
+	VirtualizationInfraReservation *VirtualizationInfraReservation `json:"virtualizationInfraReservation,omitempty"`
+
+	// InfraThreadsPinning is the cluster wide pinning policy of the emulator threads and iothreads of the VMIs.
+	// It takes effect only when the InfraThreadsPinning feature gate is enabled.
+	// +nullable
+	InfraThreadsPinning *InfraThreadsPinning `json:"infraThreadsPinning,omitempty"`
+
+	// HugepagesPools lets virt-handler grow and shrink the hugepage pools of the nodes, within the bounds
+	// of each pool, to fit the pending VMIs requesting hugepages.
+	// It takes effect only when the HugepagesPoolManagement feature gate is enabled.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	HugepagesPools []HugepagesPool `json:"hugepagesPools,omitempty"`
+
+	// LauncherImageOverrides pin the virt-launcher image, and so the QEMU and libvirt versions, of the
+	// VMIs placed on node pools, the first matching override applies.
+	// It takes effect only when the LauncherImageOverrides feature gate is enabled.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	LauncherImageOverrides []LauncherImageOverride `json:"launcherImageOverrides,omitempty"`
+
+	// AdmissionWarnings configures the best-practice warnings returned on the admission of VMs and VMIs.
+	// +nullable
+	AdmissionWarnings *AdmissionWarningsConfiguration `json:"admissionWarnings,omitempty"`
+
+	// SubresourceAccessWebhook configures a webhook authorizing the interactive accesses to VMIs, e.g. to
+	// their console, in addition to RBAC.
+	// +nullable
+	SubresourceAccessWebhook *SubresourceAccessWebhook `json:"subresourceAccessWebhook,omitempty"`
+}
+
+// VirtualizationInfraReservation holds the node resources reserved for the virtualization infrastructure.
+type VirtualizationInfraReservation struct {
+	// CPUs is the list of host CPUs, e.g. 0-1,8, the emulator threads, iothreads and vhost kernel threads
+	// of the VMIs without dedicated CPUs are confined to. The vCPUs of these VMIs don't run on them.
+	// +optional
+	CPUs string `json:"cpus,omitempty"`
+	// Memory is kept available on each node for the virtualization infrastructure.
+	// No new VMI is scheduled on a node whose available memory falls below it.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+}
+
+// InfraThreadsPinning extends isolateEmulatorThread into a cluster wide policy.
+type InfraThreadsPinning struct {
+	// IsolateEmulatorThread isolates the emulator thread of every VMI with dedicated CPUs on an additional pCPU,
+	// as if its spec.domain.cpu.isolateEmulatorThread was set.
+	// +optional
+	IsolateEmulatorThread bool `json:"isolateEmulatorThread,omitempty"`
+	// IOThreads pins the iothreads of the VMIs with dedicated CPUs and an isolated emulator thread:
+	// VCPUs spreads them on the pCPUs of the vCPUs, EmulatorThread pins them all with the emulator thread.
+	// When not set, the first iothread is pinned with the emulator thread.
+	// +kubebuilder:validation:Enum=VCPUs;EmulatorThread
+	// +optional
+	IOThreads IOThreadsPinning `json:"ioThreads,omitempty"`
+	// HousekeepingCPUs sets, per pool of nodes, the CPUs the emulator threads, iothreads and vhost kernel threads
+	// of the VMIs without dedicated CPUs share. They override virtualizationInfraReservation.cpus on the nodes
+	// they select, the first matching pool applies.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	HousekeepingCPUs []HousekeepingCPUPool `json:"housekeepingCPUs,omitempty"`
+}
+
+// IOThreadsPinning is the pinning policy of the iothreads of the VMIs with an isolated emulator thread
+type IOThreadsPinning string
+
+const (
+	IOThreadsPinningVCPUs          IOThreadsPinning = "VCPUs"
+	IOThreadsPinningEmulatorThread IOThreadsPinning = "EmulatorThread"
+)
+
+// HousekeepingCPUPool sets the housekeeping CPUs of a pool of nodes.
+type HousekeepingCPUPool struct {
+	// Name of the pool.
+	Name string `json:"name"`
+	// NodeSelector selects the nodes of the pool by their labels.
+	NodeSelector map[string]string `json:"nodeSelector"`
+	// CPUs is the list of host CPUs, e.g. 0-1,8, shared by the infrastructure threads on the nodes of the pool.
+	CPUs string `json:"cpus"`
+}
+
+// HugepagesPool bounds the hugepages of a size virt-handler allocates on a pool of nodes.
+type HugepagesPool struct {
+	// Name of the pool.
+	Name string `json:"name"`
+	// NodeSelector selects the nodes of the pool by their labels, all the nodes when empty.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// PageSize of the hugepages, 2Mi or 1Gi.
+	PageSize string `json:"pageSize"`
+	// Min is the number of hugepages always kept allocated.
+	// +optional
+	Min uint32 `json:"min,omitempty"`
+	// Max is the number of hugepages the pool can't grow beyond.
+	Max uint32 `json:"max"`
+}
+
+// LauncherImageOverride pins the virt-launcher image of the VMIs placed on a pool of nodes.
+type LauncherImageOverride struct {
+	// Name of the override.
+	Name string `json:"name"`
+	// NodeSelector selects the nodes of the pool by their labels. The override applies to the VMIs whose
+	// pods select all of them.
+	NodeSelector map[string]string `json:"nodeSelector"`
+	// Image is the virt-launcher image of the VMIs of the pool.
+	Image string `json:"image"`
+}
+
+// AdmissionWarningsConfiguration configures the best-practice warnings returned, not as errors, on the
+// admission of VMs and VMIs with suboptimal specs.
+type AdmissionWarningsConfiguration struct {
+	// Disabled lists the warnings not returned.
+	// +optional
+	// +listType=set
+	Disabled []AdmissionWarning `json:"disabled,omitempty"`
+}
+
+// AdmissionWarning names a best-practice warning returned on the admission of VMs and VMIs.
+type AdmissionWarning string
+
+const (
+	// AdmissionWarningSATABus warns of disks on the SATA bus, slower than virtio.
+	AdmissionWarningSATABus AdmissionWarning = "SATABus"
+	// AdmissionWarningDedicatedCPUsWithoutEvictionStrategy warns of VMIs with dedicated CPUs shut down
+	// when their node is drained, for lack of an eviction strategy.
+	AdmissionWarningDedicatedCPUsWithoutEvictionStrategy AdmissionWarning = "DedicatedCPUsWithoutEvictionStrategy"
+)
+
+// SubresourceAccessWebhook configures a webhook virt-api sends a SubresourceAccessReview to before
+// connecting a user to an interactive subresource of a VMI.
+type SubresourceAccessWebhook struct {
+	// URL of the webhook, it has to use https.
+	URL string `json:"url"`
+	// CABundle is a PEM encoded CA bundle the certificate of the webhook is verified with.
+	// The system trust roots are used if unset.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// Subresources the webhook is consulted for, among console, vnc, usbredir, portforward and vsock.
+	// All of them by default.
+	// +optional
+	// +listType=set
+	Subresources []string `json:"subresources,omitempty"`
+	// TimeoutSeconds is the time given to the webhook to answer, 10 seconds by default.
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+	// FailurePolicy sets whether the access is denied, Fail, or allowed, Ignore, when the webhook
+	// can't be reached or fails. Fail by default.
+	// +optional
+	FailurePolicy *SubresourceAccessFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// SubresourceAccessFailurePolicy sets how an access is authorized when the webhook fails.
+type SubresourceAccessFailurePolicy string
+
+const (
+	// SubresourceAccessFailurePolicyFail denies the access.
+	SubresourceAccessFailurePolicyFail SubresourceAccessFailurePolicy = "Fail"
+	// SubresourceAccessFailurePolicyIgnore allows the access, as authorized by RBAC.
+	SubresourceAccessFailurePolicyIgnore SubresourceAccessFailurePolicy = "Ignore"
+)
+
+// TenantNodePool restricts the VMIs of a set of namespaces to a pool of nodes.
+type TenantNodePool struct {
+	// Name of the node pool.
+	Name string `json:"name"`
+	// NamespaceSelector selects the namespaces of the tenant.
+	// Namespaces can be selected by name with the kubernetes.io/metadata.name label.
+	// If several pools select a namespace, the first one applies.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// NodeSelector is enforced on the launcher pods of the VMIs, including migration targets.
+	// It takes precedence over the node selector of the VMIs.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations are added to the launcher pods of the VMIs, e.g. to tolerate the taints
+	// reserving the pool to the tenant.
+	// +optional
+	// +listType=atomic
+	Tolerations []k8sv1.Toleration `json:"tolerations,omitempty"`
+}
+
+// LicenseGroup pins the VMIs of a license group to a set of licensed hosts.
+type LicenseGroup struct {
+	// Name of the license group, matched against the kubevirt.io/license-group label of the VMIs.
+	Name string `json:"name"`
+	// NodeSelector is added to the launcher pods of the VMIs in the group, including migration targets.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// ExternalPolicyService describes an HTTPS service which reviews VirtualMachines and migrations
+// on behalf of KubeVirt, e.g. to enforce license aware placement or compliance zones.
+type ExternalPolicyService struct {
+	// Name identifies the service in events and error messages.
+	Name string `json:"name"`
+	// URL of the endpoint receiving the policy review requests. Must use the https scheme.
+	URL string `json:"url"`
+	// CABundle is a PEM encoded CA bundle used to verify the certificate of the service.
+	// The system trust roots are used if unset.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// Hooks lists the points at which the service is consulted.
+	// +listType=set
+	Hooks []ExternalPolicyHook `json:"hooks"`
+	// FailurePolicy defines how an unreachable service or an invalid answer is handled.
+	// Defaults to Fail.
+	// +optional
+	// +kubebuilder:validation:Enum=Fail;Ignore
+	FailurePolicy *ExternalPolicyFailurePolicy `json:"failurePolicy,omitempty"`
+	// TimeoutSeconds after which a call to the service is considered failed.
+	// Must be between 1 and 10, defaults to 5.
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+type ExternalPolicyHook string
+
+const (
+	// ExternalPolicyHookVirtualMachineAdmission consults the service when a VirtualMachine is created or updated
+	ExternalPolicyHookVirtualMachineAdmission ExternalPolicyHook = "VirtualMachineAdmission"
+	// ExternalPolicyHookMigrationTargetSelection consults the service before the target pod of a migration is created
+	ExternalPolicyHookMigrationTargetSelection ExternalPolicyHook = "MigrationTargetSelection"
+)
+
+type ExternalPolicyFailurePolicy string
+
+const (
+	// ExternalPolicyFailurePolicyFail rejects the request when the service can't be consulted
+	ExternalPolicyFailurePolicyFail ExternalPolicyFailurePolicy = "Fail"
+	// ExternalPolicyFailurePolicyIgnore proceeds as if the service allowed the request when it can't be consulted
+	ExternalPolicyFailurePolicyIgnore ExternalPolicyFailurePolicy = "Ignore"
+)
+
+// StorageHealthCheckConfiguration holds the tunables of the storage heartbeat performed by virt-handler.
+type StorageHealthCheckConfiguration struct {
+	// Interval between two probes of the same volume.
+	// Defaults to 30s.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Timeout after which a pending probe is considered failed.
+	// Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// LatencyThreshold is the probe latency above which a volume is considered slow.
+	// Slow probes are accounted as failures.
+	// Defaults to 2s.
+	// +optional
+	LatencyThreshold *metav1.Duration `json:"latencyThreshold,omitempty"`
+	// FailureThreshold is the number of consecutive failed probes after which a volume is reported as degraded.
+	// Defaults to 3.
+	// +optional
+	FailureThreshold *uint32 `json:"failureThreshold,omitempty"`
+	// DegradedPolicy defines the action taken on a VMI once one of its volumes is degraded.
+	// Defaults to None.
+	// +optional
+	// +kubebuilder:validation:Enum=None;Pause;LiveMigrate
+	DegradedPolicy *StorageDegradedPolicy `json:"degradedPolicy,omitempty"`
 }
 
+type StorageDegradedPolicy string
+
+const (
+	// StorageDegradedPolicyNone only reports the degradation on the VMI
+	StorageDegradedPolicyNone StorageDegradedPolicy = "None"
+	// StorageDegradedPolicyPause pauses the VMI to prevent the guest from piling up failing IO
+	StorageDegradedPolicyPause StorageDegradedPolicy = "Pause"
+	// StorageDegradedPolicyLiveMigrate live migrates the VMI away from the node
+	StorageDegradedPolicyLiveMigrate StorageDegradedPolicy = "LiveMigrate"
+)
+
 type InstancetypeConfiguration struct {
 	// ReferencePolicy defines how an instance type or preference should be referenced by the VM after submission, supported values are:
 	// reference (default) - Where a copy of the original object is stashed in a ControllerRevision and referenced by the VM.
@@ -2693,6 +3938,10 @@ type TLSConfiguration struct {
 	MinTLSVersion TLSProtocolVersion `json:"minTLSVersion,omitempty"`
 	// +listType=set
 	Ciphers []string `json:"ciphers,omitempty"`
+	// FIPSMode restricts the TLS endpoints of the KubeVirt components to the FIPS approved
+	// protocol versions, cipher suites and curves. Only TLS 1.2 is negotiated in this mode.
+	// +optional
+	FIPSMode bool `json:"fipsMode,omitempty"`
 }
 
 // MigrationConfiguration holds migration options.
@@ -2901,6 +4150,23 @@ type NetworkConfiguration struct {
 	DeprecatedPermitSlirpInterface    *bool                             `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool                             `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
 	Binding                           map[string]InterfaceBindingPlugin `json:"binding,omitempty"`
+	// PreferredIPFamily is the IP family of the migration and export endpoints on dual-stack clusters.
+	// Defaults to the primary IP family of the cluster.
+	// +optional
+	// +kubebuilder:validation:Enum=IPv4;IPv6
+	PreferredIPFamily *k8sv1.IPFamily `json:"preferredIPFamily,omitempty"`
+	// Gateway is the Gateway API Gateway the routes of the VMs exposing guest ports through
+	// annotations are bound to. Requires the VMGatewayRoutes feature gate.
+	// +optional
+	Gateway *GatewayReference `json:"gateway,omitempty"`
+}
+
+// GatewayReference references a Gateway of the Gateway API
+type GatewayReference struct {
+	// Namespace of the Gateway
+	Namespace string `json:"namespace"`
+	// Name of the Gateway
+	Name string `json:"name"`
 }
 
 type InterfaceBindingPlugin struct {
@@ -2931,6 +4197,11 @@ type InterfaceBindingPlugin struct {
 	// version: v1alphav1
 	// +optional
 	ComputeResourceOverhead *ResourceRequirementsWithoutClaims `json:"computeResourceOverhead,omitempty"`
+
+	// SidecarConfigMap references a ConfigMap in the namespace of the VMI, holding the configuration file of the sidecar.
+	// version: v1alphav1
+	// +optional
+	SidecarConfigMap *InterfaceBindingSidecarConfigMap `json:"sidecarConfigMap,omitempty"`
 }
 
 // ResourceRequirementsWithoutClaims describes the compute resource requirements.
@@ -2981,6 +4252,15 @@ const (
 	LinkRefresh MigrationMethod = "link-refresh"
 )
 
+// InterfaceBindingSidecarConfigMap references the ConfigMap holding the configuration file of a binding plugin sidecar
+type InterfaceBindingSidecarConfigMap struct {
+	// Name of the ConfigMap
+	Name string `json:"name"`
+	// Key of the ConfigMap holding the configuration file, "config.yaml" by default
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
 // GuestAgentPing configures the guest-agent based ping probe
 type GuestAgentPing struct {
 }
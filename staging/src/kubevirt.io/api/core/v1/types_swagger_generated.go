@@ -29,6 +29,7 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"evictionStrategy":              "EvictionStrategy describes the strategy to follow when a node drain occurs.\nThe possible options are:\n- \"None\": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.\n- \"LiveMigrate\": the VirtualMachineInstance will be migrated instead of being shutdown.\n- \"LiveMigrateIfPossible\": the same as \"LiveMigrate\" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as \"None\".\n- \"External\": the VirtualMachineInstance will be protected by a PDB and `vmi.Status.EvacuationNodeName` will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.\n+optional",
 		"startStrategy":                 "StartStrategy can be set to \"Paused\" if Virtual Machine should be started in paused state.\n\n+optional",
 		"terminationGracePeriodSeconds": "Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.",
+		"shutdownPolicy":                "ShutdownPolicy orchestrates the graceful shutdown of the guest within the termination grace period: a\npre-shutdown command run by the guest agent, then the shutdown signal, each phase with its own timeout.\nIt requires the ShutdownPolicy feature gate.\n+optional",
 		"volumes":                       "List of volumes that can be mounted by disks belonging to the vmi.\n+kubebuilder:validation:MaxItems:=256",
 		"livenessProbe":                 "Periodic probe of VirtualMachineInstance liveness.\nVirtualmachineInstances will be stopped if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
 		"readinessProbe":                "Periodic probe of VirtualMachineInstance service readiness.\nVirtualmachineInstances will be removed from service endpoints if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
@@ -38,6 +39,7 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"dnsPolicy":                     "Set DNS policy for the pod.\nDefaults to \"ClusterFirst\".\nValid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.\nDNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.\nTo have DNS options set along with hostNetwork, you have to specify DNS policy\nexplicitly to 'ClusterFirstWithHostNet'.\n+optional",
 		"dnsConfig":                     "Specifies the DNS parameters of a pod.\nParameters specified here will be merged to the generated DNS\nconfiguration based on DNSPolicy.\n+optional",
 		"accessCredentials":             "Specifies a set of public keys to inject into the vm guest\n+listType=atomic\n+optional\n+kubebuilder:validation:MaxItems:=256",
+		"identityDocument":              "IdentityDocument makes KubeVirt issue a signed identity document for the VMI, which the\nworkloads in the guest can present to external services to authenticate as the VMI.\nIt requires the VMIdentityDocuments feature gate.\n+optional",
 		"architecture":                  "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
 	}
 }
@@ -64,6 +66,7 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"phaseTransitionTimestamps":     "PhaseTransitionTimestamp is the timestamp of when the last phase change occurred\n+listType=atomic\n+optional",
 		"interfaces":                    "Interfaces represent the details of available network interfaces.",
 		"guestOSInfo":                   "Guest OS Information",
+		"guestDNS":                      "DNS configuration of the guest, as reported by the guest agent\n+optional",
 		"migrationState":                "Represents the status of a live migration",
 		"migrationMethod":               "Represents the method using which the vmi can be migrated: live migration or block migration",
 		"migrationTransport":            "This represents the migration transport",
@@ -82,7 +85,55 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"machine":                       "Machine shows the final resulting qemu machine type. This can be different\nthan the machine type selected in the spec, due to qemus machine type alias mechanism.\n+optional",
 		"currentCPUTopology":            "CurrentCPUTopology specifies the current CPU topology used by the VM workload.\nCurrent topology may differ from the desired topology in the spec while CPU hotplug\ntakes place.",
 		"memory":                        "Memory shows various informations about the VirtualMachine memory.\n+optional",
+		"cpuResources":                  "CPUResources shows the CPU request and limit of the VirtualMachineInstance, as desired in its spec\nand as applied to its virt-launcher pod. It requires the LiveCPUResources feature gate.\n+optional",
 		"migratedVolumes":               "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
+		"channelStatus":                 "ChannelStatus contains the statuses of the channels defined in the spec\n+optional\n+listType=atomic",
+		"shutdownStatus":                "ShutdownStatus reports the progress of the graceful shutdown of a VMI with a shutdown policy\n+optional",
+	}
+}
+
+func (ChannelStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "ChannelStatus represents the status of a channel",
+		"name":           "Name of the channel",
+		"target":         "Target is the name of the port as seen by the guest",
+		"phase":          "Phase is either Pending, until the channel is plugged into the domain, or Attached",
+		"guestConnected": "GuestConnected reports whether a process in the guest has the port open",
+	}
+}
+
+func (ShutdownPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "ShutdownPolicy configures the phases of the graceful shutdown of the guest. The guest is forced off\nonce the termination grace period expires, whatever the phase.",
+		"preShutdown":          "PreShutdown is a command run in the guest by the guest agent before the guest is signaled to shut\ndown, e.g. to stop a database cleanly. The guest is signaled once the command exits, fails or\ntimes out.\n+optional",
+		"signalTimeoutSeconds": "SignalTimeoutSeconds is the time given to the guest to shut down once signaled, before it is\nforced off. The remainder of the termination grace period by default.\n+optional",
+		"windowsUpdates":       "WindowsUpdates is how the shutdown of a Windows guest with pending updates is handled, the guest\nagent is used to check for them. Wait doesn't force the guest off before the termination grace\nperiod expires while they are pending, Reschedule also refuses the evictions of the VMI for the\ndrains to retry them later, Force ignores them. Force by default.\n+optional",
+	}
+}
+
+func (PreShutdownCommand) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "PreShutdownCommand is a command run in the guest before it is signaled to shut down",
+		"command":        "Command is the path of the command in the guest, followed by its arguments\n+listType=atomic",
+		"timeoutSeconds": "TimeoutSeconds is the time given to the command to exit, 60 seconds by default\n+optional",
+	}
+}
+
+func (ShutdownStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "ShutdownStatus reports the progress of the graceful shutdown of the guest",
+		"phase":                     "Phase is the current phase of the shutdown",
+		"preShutdownStartTimestamp": "PreShutdownStartTimestamp is the time the pre-shutdown command started\n+optional",
+		"preShutdownEndTimestamp":   "PreShutdownEndTimestamp is the time the pre-shutdown command exited, failed or timed out\n+optional",
+		"preShutdownFailureReason":  "PreShutdownFailureReason is the reason the pre-shutdown command failed or timed out\n+optional",
+		"signalTimestamp":           "SignalTimestamp is the time the guest was first signaled to shut down\n+optional",
+	}
+}
+
+func (IdentityDocument) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "IdentityDocument configures how the signed identity document of the VMI reaches the guest. The\ndocument the VMI boots with is always exposed to the guest through fw_cfg, as\nopt/io.kubevirt/identity-document.",
+		"guestAgentPath": "GuestAgentPath is the path of the file in the guest the guest agent keeps the rotated identity\ndocument in, readable by root only. Without it, the guest only gets the document it booted with.\n+optional",
 	}
 }
 
@@ -121,6 +172,29 @@ func (VolumeStatus) SwaggerDoc() map[string]string {
 		"size":                      "Represents the size of the volume",
 		"memoryDumpVolume":          "If the volume is memorydump volume, this will contain the memorydump info.",
 		"containerDiskVolume":       "ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk",
+		"health":                    "Health reflects the result of the storage heartbeat of the volume, if enabled",
+		"flatten":                   "If the backing chain of the volume is being flattened, this will contain the flatten status.",
+	}
+}
+
+func (VolumeHealth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VolumeHealth represents the health of the storage backing a volume as observed by virt-handler",
+		"status":             "Status is either Healthy or Degraded",
+		"reason":             "Reason is a brief description of why the volume is in the current status",
+		"message":            "Message is a detailed message about the current status",
+		"lastTransitionTime": "LastTransitionTime is the time the status last changed",
+	}
+}
+
+func (VolumeFlattenStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VolumeFlattenStatus represents the flattening of the backing chain of a volume into its active image",
+		"phase":          "Phase is either InProgress, Completed or Failed",
+		"progress":       "Progress is the percentage of the backing chain already copied into the active image",
+		"startTimestamp": "StartTimestamp is the time when the flattening started",
+		"endTimestamp":   "EndTimestamp is the time when the flattening completed or failed",
+		"message":        "Message is a detailed message about the current phase",
 	}
 }
 
@@ -187,14 +261,30 @@ func (VirtualMachineInstanceMigrationCondition) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"ipAddress":        "IP address of a Virtual Machine interface. It is always the first item of\nIPs",
-		"mac":              "Hardware address of a Virtual Machine interface",
-		"name":             "Name of the interface, corresponds to name of the network assigned to the interface",
-		"ipAddresses":      "List of all IP addresses of a Virtual Machine interface",
-		"podInterfaceName": "PodInterfaceName represents the name of the pod network interface",
-		"interfaceName":    "The interface name inside the Virtual Machine",
-		"infoSource":       "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
-		"queueCount":       "Specifies how many queues are allocated by MultiQueue",
+		"ipAddress":             "IP address of a Virtual Machine interface. It is always the first item of\nIPs",
+		"mac":                   "Hardware address of a Virtual Machine interface",
+		"name":                  "Name of the interface, corresponds to name of the network assigned to the interface",
+		"ipAddresses":           "List of all IP addresses of a Virtual Machine interface",
+		"podInterfaceName":      "PodInterfaceName represents the name of the pod network interface",
+		"interfaceName":         "The interface name inside the Virtual Machine",
+		"infoSource":            "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
+		"queueCount":            "Specifies how many queues are allocated by MultiQueue",
+		"ipAddressesWithPrefix": "List of all IP addresses of the interface with their prefix length, e.g. 10.0.2.2/24,\nas reported by the guest agent",
+		"defaultRoutes":         "Default routes of the guest through the interface, as reported by the guest agent",
+	}
+}
+
+func (VirtualMachineInstanceNetworkInterfaceRoute) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"gateway": "Gateway is the address of the next hop of the route, empty when the route has no gateway",
+		"metric":  "Metric is the metric of the route in the guest",
+	}
+}
+
+func (VirtualMachineInstanceGuestDNS) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"nameservers": "Nameservers are the addresses of the name servers the guest resolves names with",
+		"searches":    "Searches are the search domains of the guest",
 	}
 }
 
@@ -231,12 +321,24 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"failureReason":                  "Contains the reason why the migration failed",
 		"migrationUid":                   "The VirtualMachineInstanceMigration object associated with this migration",
 		"mode":                           "Lets us know if the vmi is currently running pre or post copy migration",
+		"observedDowntime":               "The downtime of the guest at the switchover of the migration, measured by QEMU",
 		"migrationPolicyName":            "Name of the migration policy. If string is empty, no policy is matched",
 		"migrationConfiguration":         "Migration configurations to apply",
 		"targetCPUSet":                   "If the VMI requires dedicated CPUs, this field will\nhold the dedicated CPU set on the target node\n+listType=atomic",
 		"targetNodeTopology":             "If the VMI requires dedicated CPUs, this field will\nhold the numa topology on the target node",
 		"sourcePersistentStatePVCName":   "If the VMI being migrated uses persistent features (backend-storage), its source PVC name is saved here",
 		"targetPersistentStatePVCName":   "If the VMI being migrated uses persistent features (backend-storage), its target PVC name is saved here",
+		"targetNetworkAnnouncements":     "The network announcements sent by the target node for the bridge bound interfaces,\nonce the migrated domain is running\n+listType=atomic\n+optional",
+	}
+}
+
+func (MigrationNetworkAnnouncement) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "MigrationNetworkAnnouncement reports the announcements of an interface to the network\nafter a migration, for the switches to learn its new location.\n\n+k8s:openapi-gen=true",
+		"name":      "Name of the interface",
+		"rounds":    "The number of announcement rounds sent",
+		"succeeded": "Indicates that all the announcement rounds were sent",
+		"message":   "The reason the announcements failed\n+optional",
 	}
 }
 
@@ -380,6 +482,26 @@ func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 		"template":              "Template is the direct specification of VirtualMachineInstance",
 		"dataVolumeTemplates":   "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
 		"updateVolumesStrategy": "UpdateVolumesStrategy is the strategy to apply on volumes updates",
+		"startDependencies":     "StartDependencies lists resources which have to be available before the VirtualMachineInstance is created.\nWhenever the run strategy requires the VirtualMachine to start, the start is delayed until all dependencies are ready.\n+optional\n+listType=atomic",
+		"lease":                 "Lease limits the lifetime of the VirtualMachine, which is stopped or deleted once the lease expired.\nThe lease is extended with the renewlease subresource. It requires the VMLease feature gate.\n+optional",
+	}
+}
+
+func (VirtualMachineLease) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachineLease limits the lifetime of a VirtualMachine",
+		"expiresAt":     "ExpiresAt is the time the lease expires at",
+		"action":        "Action is what happens to the VirtualMachine once the lease expired, Stop by default\n+kubebuilder:validation:Enum=Stop;Delete\n+optional",
+		"warningPeriod": "WarningPeriod is how long before the expiry of the lease the VirtualMachine gets the LeaseExpiring\ncondition and a warning event. One hour by default.\n+optional",
+	}
+}
+
+func (StartDependency) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "StartDependency references a resource in the namespace of the VirtualMachine",
+		"kind":    "Kind of the resource\n+kubebuilder:validation:Enum=PersistentVolumeClaim;NetworkAttachmentDefinition;Service",
+		"name":    "Name of the resource, NetworkAttachmentDefinitions from another namespace can be referenced as <namespace>/<name>",
+		"timeout": "Timeout after which waiting for the dependency is reported as failed.\nThe VirtualMachine keeps waiting and starts as soon as the dependency is ready.\nDefaults to no timeout.\n+optional",
 	}
 }
 
@@ -505,7 +627,33 @@ func (CertConfig) SwaggerDoc() map[string]string {
 }
 
 func (KubeVirtCertificateRotateStrategy) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"external": "External delegates the issuance of the serving and client certificates of the KubeVirt\ncomponents. The built-in CA keeps signing the certificates of VirtualMachineExports.\n+optional",
+	}
+}
+
+func (KubeVirtExternalCertConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "KubeVirtExternalCertConfiguration configures the issuance of the component certificates outside of virt-operator",
+		"certManager": "CertManager requests the certificates from a cert-manager issuer.\nIf unset, the certificate secrets are provided by the cluster admin, with the\nCA certificate in the ca.crt key of every secret.\n+optional",
+	}
+}
+
+func (CertManagerConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "CertManagerConfiguration configures the cert-manager Certificates requested by virt-operator",
+		"issuerRef": "IssuerRef references the cert-manager issuer signing the certificates",
+		"server":    "Server configures the duration and the renewal of the certificates.\nDefaults to the cert-manager defaults.\n+optional",
+	}
+}
+
+func (CertManagerIssuerReference) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "CertManagerIssuerReference references a cert-manager Issuer or ClusterIssuer",
+		"name":  "Name of the issuer",
+		"kind":  "Kind of the issuer, Issuer or ClusterIssuer. Defaults to Issuer.\nAn Issuer has to be in the namespace of KubeVirt.\n+kubebuilder:validation:Enum=Issuer;ClusterIssuer\n+optional",
+		"group": "Group of the issuer. Defaults to cert-manager.io\n+optional",
+	}
 }
 
 func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
@@ -534,6 +682,7 @@ func (KubeVirtSpec) SwaggerDoc() map[string]string {
 		"configuration":           "holds kubevirt configurations.\nsame as the virt-configMap",
 		"infra":                   "selectors and tolerations that should apply to KubeVirt infrastructure components\n+optional",
 		"workloads":               "selectors and tolerations that should apply to KubeVirt workloads\n+optional",
+		"alerts":                  "Alerts tunes or disables the bundled alerts of the PrometheusRule deployed by KubeVirt\n+optional",
 	}
 }
 
@@ -557,6 +706,24 @@ func (CustomizeComponentsPatch) SwaggerDoc() map[string]string {
 	}
 }
 
+func (AlertsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "AlertsConfiguration customizes the bundled alerts of KubeVirt",
+		"disabled":  "Disabled lists the names of the bundled alerts which are not deployed\n+listType=set\n+optional",
+		"overrides": "Overrides tunes individual bundled alerts\n+listType=map\n+listMapKey=alert\n+optional",
+	}
+}
+
+func (AlertOverride) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "AlertOverride tunes a bundled alert of KubeVirt",
+		"alert":     "Alert is the name of the bundled alert",
+		"for":       "For is how long the expression of the alert has to hold before the alert fires, e.g. 30m\n+kubebuilder:validation:Pattern=\"^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$\"\n+optional",
+		"threshold": "Threshold replaces the number the expression of the alert is compared with.\nOnly the alerts whose expression ends with a comparison to a number have a threshold.\n+optional",
+		"severity":  "Severity replaces the severity label of the alert\n+optional",
+	}
+}
+
 func (GenerationStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "GenerationStatus keeps track of the generation for a given resource so that decisions about forced updates can be made.",
@@ -571,8 +738,31 @@ func (GenerationStatus) SwaggerDoc() map[string]string {
 
 func (KubeVirtStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":            "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
-		"generations": "+listType=atomic",
+		"":             "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
+		"generations":  "+listType=atomic",
+		"fipsPosture":  "FIPSPosture reports for each KubeVirt component whether it runs with FIPS validated\ncryptography and only negotiates FIPS approved ciphers.\n+listType=atomic",
+		"certificates": "Certificates reports the state of the certificates of the KubeVirt components\n+listType=atomic",
+	}
+}
+
+func (ComponentFIPSPosture) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "ComponentFIPSPosture reports the FIPS posture of a KubeVirt component",
+		"component":           "Component is the name of the KubeVirt component, e.g. virt-api",
+		"validatedCrypto":     "ValidatedCrypto is true when the component is built against a FIPS validated cryptographic module",
+		"approvedCiphersOnly": "ApprovedCiphersOnly is true when the TLS endpoints of the component only negotiate\nFIPS approved protocol versions and cipher suites",
+	}
+}
+
+func (KubeVirtCertificateStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "KubeVirtCertificateStatus reports the state of a certificate of the KubeVirt components",
+		"secretName": "SecretName is the name of the secret holding the certificate",
+		"issuer":     "Issuer of the certificate",
+		"ready":      "Ready is true when the secret holds a valid certificate",
+		"notBefore":  "NotBefore is the start of the validity of the certificate. It changes on every rotation.\n+optional\n+nullable",
+		"notAfter":   "NotAfter is the expiration of the certificate\n+optional\n+nullable",
+		"message":    "Message explains why the certificate is not ready\n+optional",
 	}
 }
 
@@ -622,6 +812,68 @@ func (StopOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (RenewLeaseOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "RenewLeaseOptions is provided when renewing the lease of a VirtualMachine",
+		"duration": "Duration of the renewed lease, which then expires that long after the renewal",
+		"dryRun":   "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (SubresourceAccessReview) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "SubresourceAccessReview is sent to the subresource access webhook to authorize a user to access an\ninteractive subresource of a VirtualMachineInstance. The webhook answers with the review, its status set.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (SubresourceAccessReviewSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "SubresourceAccessReviewSpec describes the access to authorize",
+		"user":        "User requesting the access, as authenticated by the API server",
+		"groups":      "Groups of the user\n+optional\n+listType=atomic",
+		"extra":       "Extra information on the user, as provided by the authenticator\n+optional",
+		"namespace":   "Namespace of the VirtualMachineInstance",
+		"name":        "Name of the VirtualMachineInstance",
+		"subresource": "Subresource accessed, e.g. console",
+		"vmiLabels":   "VMILabels are the labels of the VirtualMachineInstance\n+optional",
+		"vmLabels":    "VMLabels are the labels of the VirtualMachine owning the VirtualMachineInstance, if any\n+optional",
+		"requestTime": "RequestTime is the time the access was requested at",
+	}
+}
+
+func (SubresourceAccessReviewStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "SubresourceAccessReviewStatus is the decision of the webhook",
+		"allowed": "Allowed is true if the access is authorized",
+		"reason":  "Reason of the decision, reported to the user when denied\n+optional",
+	}
+}
+
+func (ModernizeOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "ModernizeOptions are provided when rewriting the deprecated API usages of a VirtualMachine",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineModernization) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineModernization is the result of rewriting the deprecated API usages of a VirtualMachine\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"changes":        "Changes lists the deprecated API usages rewritten into their current equivalents\n+optional\n+listType=atomic",
+		"unsupported":    "Unsupported lists the deprecated API usages left as they are, having no equivalent in the cluster\n+optional\n+listType=atomic",
+		"virtualMachine": "VirtualMachine as modernized, or as it would be on a dry run\n+optional",
+	}
+}
+
+func (ModernizeChange) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "ModernizeChange describes the rewrite of a deprecated API usage",
+		"path":    "Path of the deprecated field",
+		"message": "Message describes the rewrite",
+	}
+}
+
 func (MigrateOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "MigrateOptions may be provided on migrate request.",
@@ -643,6 +895,159 @@ func (VirtualMachineInstanceGuestAgentInfo) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachinePortalView) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachinePortalView is a sanitized view of a VirtualMachine or VirtualMachineInstance meant for\nend user portals. It leaves out infrastructure details like node, pod or host interface names.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"printableStatus": "PrintableStatus of the VirtualMachine, unset when the view was requested for a VirtualMachineInstance\n+optional",
+		"phase":           "Phase of the VirtualMachineInstance, unset if the VirtualMachine is not running\n+optional",
+		"ready":           "Ready is true if the VirtualMachineInstance is ready",
+		"conditions":      "Conditions of the VirtualMachineInstance, without their messages\n+optional\n+listType=atomic",
+		"interfaces":      "Interfaces of the VirtualMachineInstance as reported by the guest\n+optional\n+listType=atomic",
+		"guestOSInfo":     "GuestOSInfo as reported by the guest agent\n+optional",
+		"metrics":         "Metrics is a snapshot of the resource usage, only set while the VirtualMachineInstance is running\n+optional",
+	}
+}
+
+func (PortalViewCondition) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "PortalViewCondition is a VirtualMachineInstance condition stripped of its message",
+		"reason":             "+optional",
+		"lastTransitionTime": "+optional\n+nullable",
+	}
+}
+
+func (PortalViewInterface) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "PortalViewInterface is a VirtualMachineInstance network interface as shown to end users",
+		"name":        "Name of the interface in the VirtualMachineInstance spec\n+optional",
+		"mac":         "+optional",
+		"ipAddresses": "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineInstanceMetricsSnapshot) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "VirtualMachineInstanceMetricsSnapshot is a point in time view of the resources used by a VirtualMachineInstance",
+		"timestamp":               "Timestamp at which the snapshot was taken",
+		"cpuTimeNanoseconds":      "CPUTimeNanoseconds is the total CPU time consumed by the guest\n+optional",
+		"memoryUsedBytes":         "MemoryUsedBytes is the memory used by the guest, as reported by the balloon driver\n+optional",
+		"memoryAvailableBytes":    "MemoryAvailableBytes is the memory available to the guest, as reported by the balloon driver\n+optional",
+		"networkReceivedBytes":    "NetworkReceivedBytes is the total of bytes received on all interfaces\n+optional",
+		"networkTransmittedBytes": "NetworkTransmittedBytes is the total of bytes transmitted on all interfaces\n+optional",
+		"storageReadBytes":        "StorageReadBytes is the total of bytes read from all disks\n+optional",
+		"storageWrittenBytes":     "StorageWrittenBytes is the total of bytes written to all disks\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceDeviceList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceDeviceList is the live view of the devices attached to the domain of a running\nVirtualMachineInstance, as reported by libvirt. It differs from the spec while devices are being\nhotplugged or unplugged.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"disks":       "Disks attached to the domain\n+optional\n+listType=atomic",
+		"interfaces":  "Interfaces attached to the domain, except the SR-IOV interfaces which are host devices\n+optional\n+listType=atomic",
+		"hostDevices": "HostDevices assigned to the domain, e.g. the GPUs and the SR-IOV interfaces\n+optional\n+listType=atomic",
+	}
+}
+
+func (AttachedDisk) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "AttachedDisk is a disk attached to the domain of a VirtualMachineInstance",
+		"name":     "Name of the disk in the VirtualMachineInstance spec, unset for the disks not defined in the spec\n+optional",
+		"target":   "Target is the device name of the disk in the domain, e.g. vda",
+		"device":   "Device is either disk, cdrom or lun\n+optional",
+		"bus":      "Bus of the disk, e.g. virtio, sata or scsi\n+optional",
+		"source":   "Source is the file or block device backing the disk in the virt-launcher pod\n+optional",
+		"readOnly": "ReadOnly is true if the disk is attached read only\n+optional",
+		"address":  "Address of the disk in the guest, e.g. its PCI address\n+optional",
+		"queues":   "Queues is the number of queues of the disk, unset when it has a single queue\n+optional",
+	}
+}
+
+func (AttachedInterface) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "AttachedInterface is a network interface attached to the domain of a VirtualMachineInstance",
+		"name":      "Name of the interface in the VirtualMachineInstance spec, unset for the interfaces not defined in the spec\n+optional",
+		"model":     "Model of the interface, e.g. virtio or e1000e\n+optional",
+		"mac":       "MAC address of the interface\n+optional",
+		"target":    "Target is the device backing the interface in the virt-launcher pod, e.g. its tap device\n+optional",
+		"linkState": "LinkState is either up or down\n+optional",
+		"address":   "Address of the interface in the guest, e.g. its PCI address\n+optional",
+		"queues":    "Queues is the number of queues of the interface, unset when it has a single queue\n+optional",
+	}
+}
+
+func (AttachedHostDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "AttachedHostDevice is a host device assigned to the domain of a VirtualMachineInstance",
+		"name":    "Name of the device in the VirtualMachineInstance spec, unset for the devices not defined in the spec\n+optional",
+		"type":    "Type of the device, e.g. pci, mdev or usb",
+		"source":  "Source is the device on the node, its PCI address or the UUID of the mediated device\n+optional",
+		"address": "Address of the device in the guest, e.g. its PCI address\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceIdentity) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineInstanceIdentity is the identity of a VirtualMachineInstance attested by its identity document",
+		"uid":             "UID of the VirtualMachineInstance",
+		"name":            "Name of the VirtualMachineInstance",
+		"namespace":       "Namespace of the VirtualMachineInstance",
+		"labels":          "Labels of the VirtualMachineInstance\n+optional",
+		"namespaceLabels": "NamespaceLabels are the labels of the namespace of the VirtualMachineInstance, e.g. its project\n+optional",
+		"bootTime":        "BootTime is the time the VirtualMachineInstance started running, unset in the documents issued before\n+optional",
+		"issuedAt":        "IssuedAt is the time the document was issued",
+		"expiresAt":       "ExpiresAt is the time the document stops being valid",
+	}
+}
+
+func (SignedIdentityDocument) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "SignedIdentityDocument is the identity document of a VirtualMachineInstance signed by KubeVirt",
+		"document":  "Document is the base64 encoded JSON of the VirtualMachineInstanceIdentity",
+		"signature": "Signature is the base64 encoded HMAC-SHA256 of the document, only KubeVirt holds its key",
+	}
+}
+
+func (ClusterCapabilities) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "ClusterCapabilities summarizes what the cluster supports, for user interfaces and tooling to only\noffer the options which are valid\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"featureGates":        "FeatureGates enabled in the KubeVirt CR, sorted\n+optional\n+listType=atomic",
+		"defaultArchitecture": "DefaultArchitecture of the VirtualMachines which don't set one\n+optional",
+		"architectures":       "Architectures of the schedulable nodes\n+optional\n+listType=atomic",
+		"networkBindings":     "NetworkBindings are the network binding plugins registered, in addition to the core bindings\n+optional\n+listType=atomic",
+		"hostDevices":         "HostDevices permitted in the KubeVirt CR, e.g. the GPUs\n+optional\n+listType=atomic",
+		"storageProfiles":     "StorageProfiles of the storage classes, unset when CDI is not installed\n+optional\n+listType=atomic",
+	}
+}
+
+func (ArchitectureCapabilities) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "ArchitectureCapabilities describes what the schedulable nodes of an architecture support",
+		"name":               "Name of the architecture, e.g. amd64",
+		"nodes":              "Nodes is the number of schedulable nodes of the architecture",
+		"defaultMachineType": "DefaultMachineType of the VirtualMachines which don't set one\n+optional",
+		"machineTypes":       "MachineTypes the VirtualMachines can use, as glob patterns, e.g. pc-q35*\n+optional\n+listType=atomic",
+		"cpuModels":          "CPUModels available on at least one of the nodes, sorted\n+optional\n+listType=atomic",
+	}
+}
+
+func (HostDeviceCapability) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "HostDeviceCapability is a host device permitted in the KubeVirt CR",
+		"resourceName": "ResourceName the device is requested with in the VirtualMachine spec",
+		"type":         "Type of the device, one of PCI, Mediated or USB",
+		"allocatable":  "Allocatable is the number of devices allocatable on the schedulable nodes",
+	}
+}
+
+func (StorageProfileCapability) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "StorageProfileCapability summarizes the CDI storage profile of a storage class",
+		"storageClass": "StorageClass the profile is about",
+		"accessModes":  "AccessModes supported by the storage class\n+optional\n+listType=atomic",
+		"volumeModes":  "VolumeModes supported by the storage class\n+optional\n+listType=atomic",
+	}
+}
+
 func (GuestAgentCommandInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "List of commands that QEMU guest agent supports",
@@ -714,6 +1119,23 @@ func (AddVolumeOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (AddChannelOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "AddChannelOptions is provided when dynamically hot plugging a channel",
+		"name":   "Name of the channel to add",
+		"target": "Target is the name of the port as seen by the guest, e.g. org.example.agent.0",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (FlattenOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "FlattenOptions is provided when flattening the backing chains of the volumes of a running VMI",
+		"volumes": "Volumes are the names of the volumes to flatten, all the volumes with a backing chain when empty\n+optional\n+listType=atomic",
+		"dryRun":  "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
 func (ScreenshotOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
@@ -772,6 +1194,121 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"vmRolloutStrategy":                  "VMRolloutStrategy defines how changes to a VM object propagate to its VMI\n+nullable\n+kubebuilder:validation:Enum=Stage;LiveUpdate",
 		"commonInstancetypesDeployment":      "CommonInstancetypesDeployment controls the deployment of common-instancetypes resources\n+nullable",
 		"instancetype":                       "Instancetype configuration\n+nullable",
+		"storageHealthCheck":                 "StorageHealthCheck configures the periodic probing of the storage backing the volumes of running VMIs.\nIt takes effect only when the StorageHealthCheck feature gate is enabled.\n+nullable",
+		"externalPolicyServices":             "ExternalPolicyServices lists external policy services consulted at VM admission and\nmigration target selection.\nIt takes effect only when the ExternalPolicy feature gate is enabled.\n+optional\n+listType=map\n+listMapKey=name",
+		"licenseGroups":                      "LicenseGroups restricts the nodes VMIs labeled with kubevirt.io/license-group may run on.\nIt takes effect only when the LicenseTracking feature gate is enabled.\n+optional\n+listType=map\n+listMapKey=name",
+		"tenantNodePools":                    "TenantNodePools dedicates node pools to the VMIs of the namespaces they select.\nIt takes effect only when the TenantNodePools feature gate is enabled.\n+optional\n+listType=map\n+listMapKey=name",
+		"virtualizationInfraReservation":     "VirtualizationInfraReservation reserves CPUs and memory of each node for the virtualization\ninfrastructure of the VMIs: their emulator threads, iothreads and vhost kernel threads.\nIt takes effect only when the VirtualizationInfraReservation feature gate is enabled.\n+nullable",
+		"infraThreadsPinning":                "InfraThreadsPinning is the cluster wide pinning policy of the emulator threads and iothreads of the VMIs.\nIt takes effect only when the InfraThreadsPinning feature gate is enabled.\n+nullable",
+		"hugepagesPools":                     "HugepagesPools lets virt-handler grow and shrink the hugepage pools of the nodes, within the bounds\nof each pool, to fit the pending VMIs requesting hugepages.\nIt takes effect only when the HugepagesPoolManagement feature gate is enabled.\n+optional\n+listType=map\n+listMapKey=name",
+		"launcherImageOverrides":             "LauncherImageOverrides pin the virt-launcher image, and so the QEMU and libvirt versions, of the\nVMIs placed on node pools, the first matching override applies.\nIt takes effect only when the LauncherImageOverrides feature gate is enabled.\n+optional\n+listType=map\n+listMapKey=name",
+		"admissionWarnings":                  "AdmissionWarnings configures the best-practice warnings returned on the admission of VMs and VMIs.\n+nullable",
+		"subresourceAccessWebhook":           "SubresourceAccessWebhook configures a webhook authorizing the interactive accesses to VMIs, e.g. to\ntheir console, in addition to RBAC.\n+nullable",
+	}
+}
+
+func (AdmissionWarningsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "AdmissionWarningsConfiguration configures the best-practice warnings returned, not as errors, on the\nadmission of VMs and VMIs with suboptimal specs.",
+		"disabled": "Disabled lists the warnings not returned.\n+optional\n+listType=set",
+	}
+}
+
+func (SubresourceAccessWebhook) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "SubresourceAccessWebhook configures a webhook virt-api sends a SubresourceAccessReview to before\nconnecting a user to an interactive subresource of a VMI.",
+		"url":            "URL of the webhook, it has to use https.",
+		"caBundle":       "CABundle is a PEM encoded CA bundle the certificate of the webhook is verified with.\nThe system trust roots are used if unset.\n+optional",
+		"subresources":   "Subresources the webhook is consulted for, among console, vnc, usbredir, portforward and vsock.\nAll of them by default.\n+optional\n+listType=set",
+		"timeoutSeconds": "TimeoutSeconds is the time given to the webhook to answer, 10 seconds by default.\n+optional",
+		"failurePolicy":  "FailurePolicy sets whether the access is denied, Fail, or allowed, Ignore, when the webhook\ncan't be reached or fails. Fail by default.\n+optional",
+	}
+}
+
+func (TenantNodePool) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "TenantNodePool restricts the VMIs of a set of namespaces to a pool of nodes.",
+		"name":              "Name of the node pool.",
+		"namespaceSelector": "NamespaceSelector selects the namespaces of the tenant.\nNamespaces can be selected by name with the kubernetes.io/metadata.name label.\nIf several pools select a namespace, the first one applies.",
+		"nodeSelector":      "NodeSelector is enforced on the launcher pods of the VMIs, including migration targets.\nIt takes precedence over the node selector of the VMIs.\n+optional",
+		"tolerations":       "Tolerations are added to the launcher pods of the VMIs, e.g. to tolerate the taints\nreserving the pool to the tenant.\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualizationInfraReservation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualizationInfraReservation holds the node resources reserved for the virtualization infrastructure.",
+		"cpus":   "CPUs is the list of host CPUs, e.g. 0-1,8, the emulator threads, iothreads and vhost kernel threads\nof the VMIs without dedicated CPUs are confined to. The vCPUs of these VMIs don't run on them.\n+optional",
+		"memory": "Memory is kept available on each node for the virtualization infrastructure.\nNo new VMI is scheduled on a node whose available memory falls below it.\n+optional",
+	}
+}
+
+func (InfraThreadsPinning) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "InfraThreadsPinning extends isolateEmulatorThread into a cluster wide policy.",
+		"isolateEmulatorThread": "IsolateEmulatorThread isolates the emulator thread of every VMI with dedicated CPUs on an additional pCPU,\nas if its spec.domain.cpu.isolateEmulatorThread was set.\n+optional",
+		"ioThreads":             "IOThreads pins the iothreads of the VMIs with dedicated CPUs and an isolated emulator thread:\nVCPUs spreads them on the pCPUs of the vCPUs, EmulatorThread pins them all with the emulator thread.\nWhen not set, the first iothread is pinned with the emulator thread.\n+kubebuilder:validation:Enum=VCPUs;EmulatorThread\n+optional",
+		"housekeepingCPUs":      "HousekeepingCPUs sets, per pool of nodes, the CPUs the emulator threads, iothreads and vhost kernel threads\nof the VMIs without dedicated CPUs share. They override virtualizationInfraReservation.cpus on the nodes\nthey select, the first matching pool applies.\n+optional\n+listType=map\n+listMapKey=name",
+	}
+}
+
+func (HousekeepingCPUPool) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "HousekeepingCPUPool sets the housekeeping CPUs of a pool of nodes.",
+		"name":         "Name of the pool.",
+		"nodeSelector": "NodeSelector selects the nodes of the pool by their labels.",
+		"cpus":         "CPUs is the list of host CPUs, e.g. 0-1,8, shared by the infrastructure threads on the nodes of the pool.",
+	}
+}
+
+func (HugepagesPool) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "HugepagesPool bounds the hugepages of a size virt-handler allocates on a pool of nodes.",
+		"name":         "Name of the pool.",
+		"nodeSelector": "NodeSelector selects the nodes of the pool by their labels, all the nodes when empty.\n+optional",
+		"pageSize":     "PageSize of the hugepages, 2Mi or 1Gi.",
+		"min":          "Min is the number of hugepages always kept allocated.\n+optional",
+		"max":          "Max is the number of hugepages the pool can't grow beyond.",
+	}
+}
+
+func (LauncherImageOverride) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "LauncherImageOverride pins the virt-launcher image of the VMIs placed on a pool of nodes.",
+		"name":         "Name of the override.",
+		"nodeSelector": "NodeSelector selects the nodes of the pool by their labels. The override applies to the VMIs whose\npods select all of them.",
+		"image":        "Image is the virt-launcher image of the VMIs of the pool.",
+	}
+}
+
+func (LicenseGroup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "LicenseGroup pins the VMIs of a license group to a set of licensed hosts.",
+		"name":         "Name of the license group, matched against the kubevirt.io/license-group label of the VMIs.",
+		"nodeSelector": "NodeSelector is added to the launcher pods of the VMIs in the group, including migration targets.\n+optional",
+	}
+}
+
+func (ExternalPolicyService) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "ExternalPolicyService describes an HTTPS service which reviews VirtualMachines and migrations\non behalf of KubeVirt, e.g. to enforce license aware placement or compliance zones.",
+		"name":           "Name identifies the service in events and error messages.",
+		"url":            "URL of the endpoint receiving the policy review requests. Must use the https scheme.",
+		"caBundle":       "CABundle is a PEM encoded CA bundle used to verify the certificate of the service.\nThe system trust roots are used if unset.\n+optional",
+		"hooks":          "Hooks lists the points at which the service is consulted.\n+listType=set",
+		"failurePolicy":  "FailurePolicy defines how an unreachable service or an invalid answer is handled.\nDefaults to Fail.\n+optional\n+kubebuilder:validation:Enum=Fail;Ignore",
+		"timeoutSeconds": "TimeoutSeconds after which a call to the service is considered failed.\nMust be between 1 and 10, defaults to 5.\n+optional",
+	}
+}
+
+func (StorageHealthCheckConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "StorageHealthCheckConfiguration holds the tunables of the storage heartbeat performed by virt-handler.",
+		"interval":         "Interval between two probes of the same volume.\nDefaults to 30s.\n+optional",
+		"timeout":          "Timeout after which a pending probe is considered failed.\nDefaults to 10s.\n+optional",
+		"latencyThreshold": "LatencyThreshold is the probe latency above which a volume is considered slow.\nSlow probes are accounted as failures.\nDefaults to 2s.\n+optional",
+		"failureThreshold": "FailureThreshold is the number of consecutive failed probes after which a volume is reported as degraded.\nDefaults to 3.\n+optional",
+		"degradedPolicy":   "DegradedPolicy defines the action taken on a VMI once one of its volumes is degraded.\nDefaults to None.\n+optional\n+kubebuilder:validation:Enum=None;Pause;LiveMigrate",
 	}
 }
 
@@ -845,6 +1382,7 @@ func (TLSConfiguration) SwaggerDoc() map[string]string {
 		"":              "TLSConfiguration holds TLS options",
 		"minTLSVersion": "MinTLSVersion is a way to specify the minimum protocol version that is acceptable for TLS connections.\nProtocol versions are based on the following most common TLS configurations:\n\n  https://ssl-config.mozilla.org/\n\nNote that SSLv3.0 is not a supported protocol version due to well known\nvulnerabilities such as POODLE: https://en.wikipedia.org/wiki/POODLE\n+kubebuilder:validation:Enum=VersionTLS10;VersionTLS11;VersionTLS12;VersionTLS13",
 		"ciphers":       "+listType=set",
+		"fipsMode":      "FIPSMode restricts the TLS endpoints of the KubeVirt components to the FIPS approved\nprotocol versions, cipher suites and curves. Only TLS 1.2 is negotiated in this mode.\n+optional",
 	}
 }
 
@@ -959,6 +1497,16 @@ func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                     "NetworkConfiguration holds network options",
 		"permitSlirpInterface": "DeprecatedPermitSlirpInterface is an alias for the deprecated PermitSlirpInterface.\nDeprecated: Removed in v1.3.",
+		"preferredIPFamily":    "PreferredIPFamily is the IP family of the migration and export endpoints on dual-stack clusters.\nDefaults to the primary IP family of the cluster.\n+optional",
+		"gateway":              "Gateway is the Gateway API Gateway the routes of the VMs exposing guest ports through\nannotations are bound to. Requires the VMGatewayRoutes feature gate.\n+optional",
+	}
+}
+
+func (GatewayReference) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "GatewayReference references a Gateway of the Gateway API",
+		"namespace": "Namespace of the Gateway",
+		"name":      "Name of the Gateway",
 	}
 }
 
@@ -970,6 +1518,7 @@ func (InterfaceBindingPlugin) SwaggerDoc() map[string]string {
 		"migration":                   "Migration means the VM using the plugin can be safely migrated\nversion: 1alphav1",
 		"downwardAPI":                 "DownwardAPI specifies what kind of data should be exposed to the binding plugin sidecar.\nSupported values: \"device-info\"\nversion: v1alphav1\n+optional",
 		"computeResourceOverhead":     "ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding.\nversion: v1alphav1\n+optional",
+		"sidecarConfigMap":            "SidecarConfigMap references a ConfigMap in the namespace of the VMI, holding the configuration file of the sidecar.\nversion: v1alphav1\n+optional",
 	}
 }
 
@@ -987,6 +1536,14 @@ func (InterfaceBindingMigration) SwaggerDoc() map[string]string {
 	}
 }
 
+func (InterfaceBindingSidecarConfigMap) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "InterfaceBindingSidecarConfigMap references the ConfigMap holding the configuration file of a binding plugin sidecar",
+		"name": "Name of the ConfigMap",
+		"key":  "Key of the ConfigMap holding the configuration file, \"config.yaml\" by default\n+optional",
+	}
+}
+
 func (GuestAgentPing) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "GuestAgentPing configures the guest-agent based ping probe",
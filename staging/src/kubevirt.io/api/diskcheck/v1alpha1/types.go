@@ -0,0 +1,214 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineDiskCheck verifies the integrity of the disk images of a stopped VirtualMachine,
+// or of a VirtualMachineSnapshot, with qemu-img in a pod, and reports the findings in its conditions.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type VirtualMachineDiskCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineDiskCheckSpec `json:"spec" valid:"required"`
+	// +optional
+	Status VirtualMachineDiskCheckStatus `json:"status,omitempty"`
+}
+
+type VirtualMachineDiskCheckSpec struct {
+	// Source is the object whose disks are checked. Currently supported source types are:
+	// VirtualMachine of kubevirt.io API group, checked once it is stopped,
+	// VirtualMachineSnapshot of snapshot.kubevirt.io API group
+	Source corev1.TypedLocalObjectReference `json:"source"`
+	// Volumes are the names of the volumes of the source to check.
+	// All the volumes backed by a PVC or a DataVolume are checked if empty.
+	// +listType=set
+	// +optional
+	Volumes []string `json:"volumes,omitempty"`
+	// Sparsify rewrites the qcow2 images found consistent by the check, to reclaim the space of their
+	// unused and zeroed clusters. Only supported with a VirtualMachine source, checked once it is
+	// stopped with the Halted run strategy
+	// +optional
+	Sparsify *DiskSparsifyOptions `json:"sparsify,omitempty"`
+}
+
+// DiskSparsifyOptions are the options of the rewrite of the images
+type DiskSparsifyOptions struct {
+	// Compress compresses the clusters of the rewritten images, trading the read performance
+	// of the guest for space
+	// +optional
+	Compress bool `json:"compress,omitempty"`
+}
+
+type VirtualMachineDiskCheckPhase string
+
+const (
+	PhaseUnset VirtualMachineDiskCheckPhase = ""
+	// Pending means the source is not ready to be checked yet, e.g. the VirtualMachine is running
+	Pending VirtualMachineDiskCheckPhase = "Pending"
+	// Running means the volumes are being checked
+	Running VirtualMachineDiskCheckPhase = "Running"
+	// Succeeded means all the volumes were checked, the findings are reported in the Corrupted condition
+	Succeeded VirtualMachineDiskCheckPhase = "Succeeded"
+	// Failed means the volumes could not be checked
+	Failed VirtualMachineDiskCheckPhase = "Failed"
+)
+
+type VirtualMachineDiskCheckStatus struct {
+	// +optional
+	Phase VirtualMachineDiskCheckPhase `json:"phase,omitempty"`
+
+	// StartTime is the time the volumes started being checked
+	// +optional
+	// +nullable
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time the check completed or failed
+	// +optional
+	// +nullable
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Volumes are the findings of the check of each volume
+	// +optional
+	// +listType=atomic
+	Volumes []VolumeCheckResult `json:"volumes,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VolumeCheckResult is the finding of the check of a volume
+type VolumeCheckResult struct {
+	// Name is the name of the volume in the source
+	Name string `json:"name"`
+	// ClaimName is the name of the checked PVC
+	ClaimName string `json:"claimName"`
+	// Format is the format of the disk image, as detected by qemu-img
+	// +optional
+	Format string `json:"format,omitempty"`
+	// Result is the outcome of the check of the volume
+	Result VolumeCheckResultType `json:"result"`
+	// Corruptions is the number of corrupted clusters of a qcow2 image
+	// +optional
+	Corruptions int64 `json:"corruptions,omitempty"`
+	// Leaks is the number of leaked clusters of a qcow2 image, they waste space but don't
+	// corrupt the image
+	// +optional
+	Leaks int64 `json:"leaks,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Sparsify is the outcome of the rewrite of the image, when requested
+	// +optional
+	Sparsify *VolumeSparsifyResult `json:"sparsify,omitempty"`
+}
+
+// VolumeSparsifyResult is the outcome of the rewrite of the image of a volume
+type VolumeSparsifyResult struct {
+	// Result is the outcome of the rewrite of the image
+	Result VolumeSparsifyResultType `json:"result"`
+	// SizeBefore is the space allocated to the image before its rewrite, in bytes
+	// +optional
+	SizeBefore int64 `json:"sizeBefore,omitempty"`
+	// SizeAfter is the space allocated to the image after its rewrite, in bytes
+	// +optional
+	SizeAfter int64 `json:"sizeAfter,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VolumeSparsifyResultType is the outcome of the rewrite of the image of a volume
+// +kubebuilder:validation:Enum=Sparsified;Skipped;Error
+type VolumeSparsifyResultType string
+
+const (
+	// VolumeSparsified means the image was replaced by its smaller rewrite
+	VolumeSparsified VolumeSparsifyResultType = "Sparsified"
+	// VolumeSparsifySkipped means the image was left untouched, e.g. it is not a qcow2 image, or
+	// its rewrite is not smaller
+	VolumeSparsifySkipped VolumeSparsifyResultType = "Skipped"
+	// VolumeSparsifyError means the image could not be rewritten, it was left untouched
+	VolumeSparsifyError VolumeSparsifyResultType = "Error"
+)
+
+// VolumeCheckResultType is the outcome of the check of a volume
+// +kubebuilder:validation:Enum=Clean;Leaked;Corrupted;Error
+type VolumeCheckResultType string
+
+const (
+	// VolumeClean means no corruption and no leak was found
+	VolumeClean VolumeCheckResultType = "Clean"
+	// VolumeLeaked means leaked clusters were found, but no corruption
+	VolumeLeaked VolumeCheckResultType = "Leaked"
+	// VolumeCorrupted means corruptions, or unreadable blocks, were found
+	VolumeCorrupted VolumeCheckResultType = "Corrupted"
+	// VolumeCheckError means the volume could not be checked
+	VolumeCheckError VolumeCheckResultType = "Error"
+)
+
+// ConditionType is the const type for Conditions
+type ConditionType string
+
+const (
+	// ConditionProgressing is the "progressing" condition type
+	ConditionProgressing ConditionType = "Progressing"
+
+	// ConditionCorrupted is the "corrupted" condition type, it is set once all the volumes were checked
+	ConditionCorrupted ConditionType = "Corrupted"
+)
+
+// Condition defines conditions
+type Condition struct {
+	Type ConditionType `json:"type"`
+
+	Status corev1.ConditionStatus `json:"status"`
+
+	// +optional
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineDiskCheckList is a list of VirtualMachineDiskCheck
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineDiskCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []VirtualMachineDiskCheck `json:"items"`
+}
@@ -0,0 +1,70 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GuestAgentPolicy restricts the guest agent commands which KubeVirt may invoke on the VMIs of its namespace
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+// +genclient:noStatus
+type GuestAgentPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GuestAgentPolicySpec `json:"spec" valid:"required"`
+}
+
+type GuestAgentPolicySpec struct {
+	// AllowedCommands lists the guest agent commands modifying the guest which may be invoked.
+	// The commands which are not listed are denied.
+	// +listType=set
+	// +optional
+	AllowedCommands []GuestAgentCommand `json:"allowedCommands,omitempty"`
+}
+
+// GuestAgentCommand is a class of guest agent commands modifying the guest
+// +kubebuilder:validation:Enum=Exec;FileWrite;SetUserPassword;SSHAuthorizedKeys
+type GuestAgentCommand string
+
+const (
+	// GuestAgentCommandExec covers guest-exec, which is used by exec probes
+	GuestAgentCommandExec GuestAgentCommand = "Exec"
+	// GuestAgentCommandFileWrite covers guest-file-write, which is used to propagate SSH keys to older guest agents
+	GuestAgentCommandFileWrite GuestAgentCommand = "FileWrite"
+	// GuestAgentCommandSetUserPassword covers guest-set-user-password, which is used to propagate user passwords
+	GuestAgentCommandSetUserPassword GuestAgentCommand = "SetUserPassword"
+	// GuestAgentCommandSSHAuthorizedKeys covers guest-ssh-add-authorized-keys, which is used to propagate SSH keys
+	GuestAgentCommandSSHAuthorizedKeys GuestAgentCommand = "SSHAuthorizedKeys"
+)
+
+// GuestAgentPolicyList is a list of GuestAgentPolicy
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type GuestAgentPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []GuestAgentPolicy `json:"items"`
+}
@@ -0,0 +1,147 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HostDeviceInventory lists the host devices of a node, as discovered by its virt-handler: the PCI
+// devices, their SR-IOV and NUMA topology, the mediated devices they support and the VMIs they are
+// assigned to. It is named after its node.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+// +genclient:nonNamespaced
+type HostDeviceInventory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Status HostDeviceInventoryStatus `json:"status,omitempty"`
+}
+
+// HostDeviceInventoryStatus is the status of a HostDeviceInventory
+type HostDeviceInventoryStatus struct {
+	// PCIDevices are the PCI devices of the node, except the PCI bridges
+	// +listType=atomic
+	// +optional
+	PCIDevices []PCIDevice `json:"pciDevices,omitempty"`
+	// MediatedDevices are the mediated devices created on the node
+	// +listType=atomic
+	// +optional
+	MediatedDevices []MediatedDevice `json:"mediatedDevices,omitempty"`
+	// LastChangeTime is the last time the devices of the inventory changed
+	// +optional
+	// +nullable
+	LastChangeTime *metav1.Time `json:"lastChangeTime,omitempty"`
+}
+
+// PCIDevice is a PCI device of a node
+type PCIDevice struct {
+	// Address is the PCI address of the device, e.g. 0000:3b:00.0
+	Address string `json:"address"`
+	// PCIVendorSelector is the vendor and device IDs of the device, e.g. 10de:1eb8, as matched by the
+	// pciVendorSelector of the permitted host devices
+	PCIVendorSelector string `json:"pciVendorSelector"`
+	// Class is the PCI class code of the device, e.g. 0x030200
+	// +optional
+	Class string `json:"class,omitempty"`
+	// Driver is the kernel driver bound to the device, e.g. vfio-pci
+	// +optional
+	Driver string `json:"driver,omitempty"`
+	// IOMMUGroup is the IOMMU group of the device
+	// +optional
+	IOMMUGroup string `json:"iommuGroup,omitempty"`
+	// NUMANode is the NUMA node the device is attached to, unset when the node doesn't report it
+	// +optional
+	NUMANode *int32 `json:"numaNode,omitempty"`
+	// ResourceName is the resource name the device is permitted as in the KubeVirt CR, if any
+	// +optional
+	ResourceName string `json:"resourceName,omitempty"`
+	// PhysicalFunction is the address of the SR-IOV physical function of a virtual function
+	// +optional
+	PhysicalFunction string `json:"physicalFunction,omitempty"`
+	// SRIOV reports the virtual functions of an SR-IOV physical function
+	// +optional
+	SRIOV *SRIOVCapacity `json:"sriov,omitempty"`
+	// MediatedDeviceTypes are the mediated device types the device can provide
+	// +listType=atomic
+	// +optional
+	MediatedDeviceTypes []MediatedDeviceType `json:"mediatedDeviceTypes,omitempty"`
+	// ClaimedBy is the VMI the device is assigned to, if any
+	// +optional
+	ClaimedBy *DeviceClaim `json:"claimedBy,omitempty"`
+}
+
+// SRIOVCapacity reports the virtual functions of an SR-IOV physical function
+type SRIOVCapacity struct {
+	// TotalVFs is the number of virtual functions the physical function supports
+	TotalVFs int32 `json:"totalVFs"`
+	// NumVFs is the number of virtual functions enabled
+	NumVFs int32 `json:"numVFs"`
+}
+
+// MediatedDeviceType is a mediated device type a PCI device can provide
+type MediatedDeviceType struct {
+	// ID is the ID of the type, e.g. nvidia-222
+	ID string `json:"id"`
+	// Name is the name of the type, e.g. GRID_T4-1Q, as matched by the mdevNameSelector of the
+	// permitted host devices
+	// +optional
+	Name string `json:"name,omitempty"`
+	// AvailableInstances is the number of mediated devices of the type which can still be created
+	AvailableInstances int32 `json:"availableInstances"`
+}
+
+// MediatedDevice is a mediated device created on a node
+type MediatedDevice struct {
+	// UUID is the UUID of the mediated device
+	UUID string `json:"uuid"`
+	// Type is the name of the type of the mediated device
+	Type string `json:"type"`
+	// ParentAddress is the PCI address of the device providing the mediated device
+	ParentAddress string `json:"parentAddress"`
+	// ResourceName is the resource name the mediated device is permitted as in the KubeVirt CR, if any
+	// +optional
+	ResourceName string `json:"resourceName,omitempty"`
+	// ClaimedBy is the VMI the mediated device is assigned to, if any
+	// +optional
+	ClaimedBy *DeviceClaim `json:"claimedBy,omitempty"`
+}
+
+// DeviceClaim is the VMI a host device is assigned to
+type DeviceClaim struct {
+	// Namespace is the namespace of the VMI
+	Namespace string `json:"namespace"`
+	// Name is the name of the VMI
+	Name string `json:"name"`
+}
+
+// HostDeviceInventoryList is a list of HostDeviceInventory
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type HostDeviceInventoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []HostDeviceInventory `json:"items"`
+}
@@ -46,6 +46,15 @@ const (
 	DefaultPreferenceKindLabel   = "instancetype.kubevirt.io/default-preference-kind"
 )
 
+const (
+	// InferPreferenceAnnotation set to "false" on a VirtualMachine disables the inference of its
+	// preference from its boot volume
+	InferPreferenceAnnotation = "instancetype.kubevirt.io/infer-preference"
+	// InferredPreferenceFromVolumeAnnotation records the boot volume the preference of a
+	// VirtualMachine was inferred from
+	InferredPreferenceFromVolumeAnnotation = "instancetype.kubevirt.io/inferred-preference-from-volume"
+)
+
 const (
 	ControllerRevisionObjectGenerationLabel = "instancetype.kubevirt.io/object-generation"
 	ControllerRevisionObjectKindLabel       = "instancetype.kubevirt.io/object-kind"
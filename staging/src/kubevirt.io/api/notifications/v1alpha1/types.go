@@ -0,0 +1,83 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LifecycleNotification registers an HTTPS endpoint which is notified about the lifecycle events of the VMIs of its namespace
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+// +genclient:noStatus
+type LifecycleNotification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              LifecycleNotificationSpec `json:"spec" valid:"required"`
+}
+
+type LifecycleNotificationSpec struct {
+	// URL is the HTTPS endpoint the notifications are posted to
+	// +kubebuilder:validation:Pattern=`^https://`
+	URL string `json:"url"`
+	// Events lists the lifecycle events which are posted to the endpoint.
+	// All the events are posted if empty.
+	// +listType=set
+	// +optional
+	Events []LifecycleEvent `json:"events,omitempty"`
+	// CABundle is a PEM encoded CA bundle used to verify the certificate of the endpoint.
+	// The system trust roots are used if empty.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// MaxRetries is the number of times a failed delivery is retried with an exponential backoff.
+	// Defaults to 5.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+}
+
+// LifecycleEvent is a lifecycle event of a VMI
+// +kubebuilder:validation:Enum=Started;Stopped;Migrated;Crashed
+type LifecycleEvent string
+
+const (
+	// LifecycleEventStarted is posted when a VMI started running
+	LifecycleEventStarted LifecycleEvent = "Started"
+	// LifecycleEventStopped is posted when a VMI stopped, or was deleted before it stopped
+	LifecycleEventStopped LifecycleEvent = "Stopped"
+	// LifecycleEventMigrated is posted when a VMI was live migrated to another node
+	LifecycleEventMigrated LifecycleEvent = "Migrated"
+	// LifecycleEventCrashed is posted when a VMI failed
+	LifecycleEventCrashed LifecycleEvent = "Crashed"
+)
+
+// LifecycleNotificationList is a list of LifecycleNotification
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type LifecycleNotificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []LifecycleNotification `json:"items"`
+}
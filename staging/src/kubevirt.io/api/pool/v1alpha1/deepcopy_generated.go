@@ -123,6 +123,11 @@ func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
 		*out = new(VirtualMachineTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WarmStandby != nil {
+		in, out := &in.WarmStandby, &out.WarmStandby
+		*out = new(VirtualMachinePoolWarmStandby)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -159,6 +164,27 @@ func (in *VirtualMachinePoolStatus) DeepCopy() *VirtualMachinePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolWarmStandby) DeepCopyInto(out *VirtualMachinePoolWarmStandby) {
+	*out = *in
+	if in.ClaimCommand != nil {
+		in, out := &in.ClaimCommand, &out.ClaimCommand
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolWarmStandby.
+func (in *VirtualMachinePoolWarmStandby) DeepCopy() *VirtualMachinePoolWarmStandby {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolWarmStandby)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineTemplateSpec) DeepCopyInto(out *VirtualMachineTemplateSpec) {
 	*out = *in
@@ -89,6 +89,9 @@ type VirtualMachinePoolStatus struct {
 
 	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// Number of warm standby VMs which are booted and paused, ready to be claimed.
+	WarmReplicas int32 `json:"warmReplicas,omitempty" optional:"true"`
 }
 
 // +k8s:openapi-gen=true
@@ -108,6 +111,25 @@ type VirtualMachinePoolSpec struct {
 	// Indicates that the pool is paused.
 	// +optional
 	Paused bool `json:"paused,omitempty" protobuf:"varint,7,opt,name=paused"`
+
+	// WarmStandby keeps booted and paused VMs on top of the replicas, scaling the pool out
+	// claims them before creating new VMs.
+	// +optional
+	WarmStandby *VirtualMachinePoolWarmStandby `json:"warmStandby,omitempty"`
+}
+
+// VirtualMachinePoolWarmStandby configures the warm standby VMs of a pool.
+// The warm standby VMs run without their networks, which are hotplugged once they are claimed.
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolWarmStandby struct {
+	// Number of warm standby VMs kept booted and paused.
+	Replicas int32 `json:"replicas"`
+	// ClaimCommand is run in the guest by the guest agent once a warm standby VM is claimed and
+	// unpaused, e.g. to configure the hotplugged interfaces.
+	// +optional
+	// +listType=atomic
+	ClaimCommand []string `json:"claimCommand,omitempty"`
 }
 
 // VirtualMachinePoolList is a list of VirtualMachinePool resources.
@@ -29,6 +29,7 @@ func (VirtualMachinePoolStatus) SwaggerDoc() map[string]string {
 		"":              "+k8s:openapi-gen=true",
 		"conditions":    "+listType=atomic",
 		"labelSelector": "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"warmReplicas":  "Number of warm standby VMs which are booted and paused, ready to be claimed.",
 	}
 }
 
@@ -39,6 +40,15 @@ func (VirtualMachinePoolSpec) SwaggerDoc() map[string]string {
 		"selector":               "Label selector for pods. Existing Poolss whose pods are\nselected by this will be the ones affected by this deployment.",
 		"virtualMachineTemplate": "Template describes the VM that will be created.",
 		"paused":                 "Indicates that the pool is paused.\n+optional",
+		"warmStandby":            "WarmStandby keeps booted and paused VMs on top of the replicas, scaling the pool out\nclaims them before creating new VMs.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolWarmStandby) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachinePoolWarmStandby configures the warm standby VMs of a pool.\nThe warm standby VMs run without their networks, which are hotplugged once they are claimed.\n\n+k8s:openapi-gen=true",
+		"replicas":     "Number of warm standby VMs kept booted and paused.",
+		"claimCommand": "ClaimCommand is run in the guest by the guest agent once a warm standby VM is claimed and\nunpaused, e.g. to configure the hotplugged interfaces.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// VirtualMachineTimeline keeps the deduplicated events, condition transitions and migrations
+// of a VirtualMachine across the restarts of its VMIs.
+// It is maintained by KubeVirt, has the name of its VirtualMachine and is removed with it.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+// +genclient:noStatus
+type VirtualMachineTimeline struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Entries are the recorded entries, oldest first
+	// +listType=atomic
+	// +optional
+	Entries []VirtualMachineTimelineEntry `json:"entries,omitempty"`
+}
+
+// VirtualMachineTimelineEntry is an entry of the timeline of a VirtualMachine.
+// Identical entries of the same VMI are recorded once, with the number of their occurrences.
+type VirtualMachineTimelineEntry struct {
+	// Type is the kind of the entry
+	Type VirtualMachineTimelineEntryType `json:"type"`
+	// Reason is the reason of an event, the phase of the VMI or of a migration,
+	// or the type of a condition
+	Reason string `json:"reason"`
+	// Message is the message of an event or a condition, or the status of a condition
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Warning is set for warning events and failures
+	// +optional
+	Warning bool `json:"warning,omitempty"`
+	// VMIUID is the UID of the VMI the entry belongs to, empty for the entries of the VirtualMachine itself
+	// +optional
+	VMIUID types.UID `json:"vmiUID,omitempty"`
+	// FirstTimestamp is the time the entry was first recorded
+	FirstTimestamp metav1.Time `json:"firstTimestamp"`
+	// LastTimestamp is the time the entry was last recorded
+	LastTimestamp metav1.Time `json:"lastTimestamp"`
+	// Count is the number of times the entry was recorded
+	Count int32 `json:"count"`
+}
+
+// VirtualMachineTimelineEntryType is the kind of a timeline entry
+// +kubebuilder:validation:Enum=Event;Phase;Condition;Migration
+type VirtualMachineTimelineEntryType string
+
+const (
+	// VirtualMachineTimelineEntryEvent is a Kubernetes event of the VirtualMachine or of its VMI
+	VirtualMachineTimelineEntryEvent VirtualMachineTimelineEntryType = "Event"
+	// VirtualMachineTimelineEntryPhase is a phase transition of the VMI
+	VirtualMachineTimelineEntryPhase VirtualMachineTimelineEntryType = "Phase"
+	// VirtualMachineTimelineEntryCondition is a transition of a condition of the VMI
+	VirtualMachineTimelineEntryCondition VirtualMachineTimelineEntryType = "Condition"
+	// VirtualMachineTimelineEntryMigration is a phase transition of a migration of the VMI
+	VirtualMachineTimelineEntryMigration VirtualMachineTimelineEntryType = "Migration"
+)
+
+// VirtualMachineTimelineList is a list of VirtualMachineTimeline
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineTimelineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []VirtualMachineTimeline `json:"items"`
+}
@@ -0,0 +1,55 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package kubecli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/client-go/rest"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func (k *kubevirtClient) ClusterCapabilities() ClusterCapabilitiesInterface {
+	return &clusterCapabilities{
+		restClient: k.restClient,
+	}
+}
+
+type clusterCapabilities struct {
+	restClient *rest.RESTClient
+}
+
+func (c *clusterCapabilities) Get(ctx context.Context) (*v1.ClusterCapabilities, error) {
+	uri := fmt.Sprintf("/apis/%s/%s/capabilities", v1.SubresourceGroupName, v1.ApiStorageVersion)
+	// The result is not registered in the scheme
+	rawResult, err := c.restClient.Get().AbsPath(uri).Do(ctx).Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities := &v1.ClusterCapabilities{}
+	if err := json.Unmarshal(rawResult, capabilities); err != nil {
+		return nil, err
+	}
+	return capabilities, nil
+}
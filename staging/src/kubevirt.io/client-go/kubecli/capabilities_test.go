@@ -0,0 +1,69 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package kubecli
+
+import (
+	"context"
+	"net/http"
+	"path"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Kubevirt ClusterCapabilities Client", func() {
+
+	var server *ghttp.Server
+	capabilitiesPath := "/apis/subresources.kubevirt.io/v1/capabilities"
+	proxyPath := "/proxy/path"
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+
+	DescribeTable("should fetch the capabilities of the cluster", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		capabilities := &v1.ClusterCapabilities{
+			FeatureGates:        []string{"VMSearch"},
+			DefaultArchitecture: "amd64",
+		}
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, capabilitiesPath)),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, capabilities),
+		))
+		fetchedCapabilities, err := client.ClusterCapabilities().Get(context.Background())
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedCapabilities).To(Equal(capabilities))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
+	AfterEach(func() {
+		server.Close()
+	})
+})
@@ -72,6 +72,7 @@ import (
 	v1alpha19 "k8s.io/client-go/kubernetes/typed/storagemigration/v1alpha1"
 	rest "k8s.io/client-go/rest"
 	v121 "kubevirt.io/api/core/v1"
+	v1alpha113 "kubevirt.io/api/timeline/v1alpha1"
 	containerizeddataimporter "kubevirt.io/client-go/containerizeddataimporter"
 	externalsnapshotter "kubevirt.io/client-go/externalsnapshotter"
 	kubevirt "kubevirt.io/client-go/kubevirt"
@@ -278,6 +279,26 @@ func (_mr *_MockKubevirtClientRecorder) ExpandSpec(arg0 interface{}) *gomock.Cal
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExpandSpec", arg0)
 }
 
+func (_m *MockKubevirtClient) ClusterCapabilities() ClusterCapabilitiesInterface {
+	ret := _m.ctrl.Call(_m, "ClusterCapabilities")
+	ret0, _ := ret[0].(ClusterCapabilitiesInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) ClusterCapabilities() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ClusterCapabilities")
+}
+
+func (_m *MockKubevirtClient) Search(namespace string) SearchInterface {
+	ret := _m.ctrl.Call(_m, "Search", namespace)
+	ret0, _ := ret[0].(SearchInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) Search(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Search", arg0)
+}
+
 func (_m *MockKubevirtClient) ServerVersion() ServerVersionInterface {
 	ret := _m.ctrl.Call(_m, "ServerVersion")
 	ret0, _ := ret[0].(ServerVersionInterface)
@@ -1280,6 +1301,26 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) RemoveVolume(arg0, arg1
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveVolume", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) AddChannel(ctx context.Context, name string, addChannelOptions *v121.AddChannelOptions) error {
+	ret := _m.ctrl.Call(_m, "AddChannel", ctx, name, addChannelOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) AddChannel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddChannel", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) Flatten(ctx context.Context, name string, flattenOptions *v121.FlattenOptions) error {
+	ret := _m.ctrl.Call(_m, "Flatten", ctx, name, flattenOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Flatten(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Flatten", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) VSOCK(name string, options *v121.VSOCKOptions) (v122.StreamInterface, error) {
 	ret := _m.ctrl.Call(_m, "VSOCK", name, options)
 	ret0, _ := ret[0].(v122.StreamInterface)
@@ -1333,6 +1374,39 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) SEVInjectLaunchSecret(a
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SEVInjectLaunchSecret", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) VerifyIdentity(ctx context.Context, name string, document *v121.SignedIdentityDocument) (*v121.VirtualMachineInstanceIdentity, error) {
+	ret := _m.ctrl.Call(_m, "VerifyIdentity", ctx, name, document)
+	ret0, _ := ret[0].(*v121.VirtualMachineInstanceIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) VerifyIdentity(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VerifyIdentity", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) DeviceList(ctx context.Context, name string) (*v121.VirtualMachineInstanceDeviceList, error) {
+	ret := _m.ctrl.Call(_m, "DeviceList", ctx, name)
+	ret0, _ := ret[0].(*v121.VirtualMachineInstanceDeviceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) DeviceList(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeviceList", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) PortalView(ctx context.Context, name string) (*v121.VirtualMachinePortalView, error) {
+	ret := _m.ctrl.Call(_m, "PortalView", ctx, name)
+	ret0, _ := ret[0].(*v121.VirtualMachinePortalView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) PortalView(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PortalView", arg0, arg1)
+}
+
 // Mock of ReplicaSetInterface interface
 type MockReplicaSetInterface struct {
 	ctrl     *gomock.Controller
@@ -1837,6 +1911,49 @@ func (_mr *_MockVirtualMachineInterfaceRecorder) RemoveMemoryDump(arg0, arg1 int
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveMemoryDump", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInterface) RenewLease(ctx context.Context, name string, renewLeaseOptions *v121.RenewLeaseOptions) error {
+	ret := _m.ctrl.Call(_m, "RenewLease", ctx, name, renewLeaseOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) RenewLease(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RenewLease", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInterface) Modernize(ctx context.Context, name string, modernizeOptions *v121.ModernizeOptions) (*v121.VirtualMachineModernization, error) {
+	ret := _m.ctrl.Call(_m, "Modernize", ctx, name, modernizeOptions)
+	ret0, _ := ret[0].(*v121.VirtualMachineModernization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) Modernize(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Modernize", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInterface) PortalView(ctx context.Context, name string) (*v121.VirtualMachinePortalView, error) {
+	ret := _m.ctrl.Call(_m, "PortalView", ctx, name)
+	ret0, _ := ret[0].(*v121.VirtualMachinePortalView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) PortalView(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PortalView", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInterface) Timeline(ctx context.Context, name string) (*v1alpha113.VirtualMachineTimeline, error) {
+	ret := _m.ctrl.Call(_m, "Timeline", ctx, name)
+	ret0, _ := ret[0].(*v1alpha113.VirtualMachineTimeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) Timeline(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Timeline", arg0, arg1)
+}
+
 // Mock of VirtualMachineInstanceMigrationInterface interface
 type MockVirtualMachineInstanceMigrationInterface struct {
 	ctrl     *gomock.Controller
@@ -2168,3 +2285,67 @@ func (_m *MockExpandSpecInterface) ForVirtualMachine(vm *v121.VirtualMachine) (*
 func (_mr *_MockExpandSpecInterfaceRecorder) ForVirtualMachine(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ForVirtualMachine", arg0)
 }
+
+// Mock of ClusterCapabilitiesInterface interface
+type MockClusterCapabilitiesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *_MockClusterCapabilitiesInterfaceRecorder
+}
+
+// Recorder for MockClusterCapabilitiesInterface (not exported)
+type _MockClusterCapabilitiesInterfaceRecorder struct {
+	mock *MockClusterCapabilitiesInterface
+}
+
+func NewMockClusterCapabilitiesInterface(ctrl *gomock.Controller) *MockClusterCapabilitiesInterface {
+	mock := &MockClusterCapabilitiesInterface{ctrl: ctrl}
+	mock.recorder = &_MockClusterCapabilitiesInterfaceRecorder{mock}
+	return mock
+}
+
+func (_m *MockClusterCapabilitiesInterface) EXPECT() *_MockClusterCapabilitiesInterfaceRecorder {
+	return _m.recorder
+}
+
+func (_m *MockClusterCapabilitiesInterface) Get(ctx context.Context) (*v121.ClusterCapabilities, error) {
+	ret := _m.ctrl.Call(_m, "Get", ctx)
+	ret0, _ := ret[0].(*v121.ClusterCapabilities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockClusterCapabilitiesInterfaceRecorder) Get(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Get", arg0)
+}
+
+// Mock of SearchInterface interface
+type MockSearchInterface struct {
+	ctrl     *gomock.Controller
+	recorder *_MockSearchInterfaceRecorder
+}
+
+// Recorder for MockSearchInterface (not exported)
+type _MockSearchInterfaceRecorder struct {
+	mock *MockSearchInterface
+}
+
+func NewMockSearchInterface(ctrl *gomock.Controller) *MockSearchInterface {
+	mock := &MockSearchInterface{ctrl: ctrl}
+	mock.recorder = &_MockSearchInterfaceRecorder{mock}
+	return mock
+}
+
+func (_m *MockSearchInterface) EXPECT() *_MockSearchInterfaceRecorder {
+	return _m.recorder
+}
+
+func (_m *MockSearchInterface) VirtualMachineInstances(ctx context.Context, options *SearchOptions) (*v121.VirtualMachineInstanceList, error) {
+	ret := _m.ctrl.Call(_m, "VirtualMachineInstances", ctx, options)
+	ret0, _ := ret[0].(*v121.VirtualMachineInstanceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockSearchInterfaceRecorder) VirtualMachineInstances(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineInstances", arg0, arg1)
+}
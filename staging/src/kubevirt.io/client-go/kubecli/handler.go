@@ -18,18 +18,21 @@ import (
 )
 
 const (
-	consoleTemplateURI        = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/console"
-	usbredirTemplateURI       = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/usbredir"
-	vncTemplateURI            = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc"
-	vsockTemplateURI          = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vsock"
-	pauseTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pause"
-	unpauseTemplateURI        = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unpause"
-	freezeTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/freeze"
-	unfreezeTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unfreeze"
-	softRebootTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/softreboot"
-	guestInfoTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
-	userListTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
-	filesystemListTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
+	consoleTemplateURI         = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/console"
+	usbredirTemplateURI        = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/usbredir"
+	vncTemplateURI             = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc"
+	vsockTemplateURI           = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vsock"
+	pauseTemplateURI           = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pause"
+	unpauseTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unpause"
+	freezeTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/freeze"
+	unfreezeTemplateURI        = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unfreeze"
+	softRebootTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/softreboot"
+	flattenTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/flatten"
+	guestInfoTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
+	userListTemplateURI        = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
+	filesystemListTemplateURI  = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
+	metricsSnapshotTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/metricssnapshot"
+	devicesTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/devices"
 
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
 	sevQueryLaunchMeasurementTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/querylaunchmeasurement"
@@ -62,6 +65,7 @@ type VirtHandlerConn interface {
 	FreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UnfreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SoftRebootURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	FlattenURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVQueryLaunchMeasurementURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVInjectLaunchSecretURI(vmi *virtv1.VirtualMachineInstance) (string, error)
@@ -71,6 +75,8 @@ type VirtHandlerConn interface {
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	MetricsSnapshotURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	DevicesURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
 
 type virtHandler struct {
@@ -205,6 +211,10 @@ func (v *virtHandlerConn) SoftRebootURI(vmi *virtv1.VirtualMachineInstance) (str
 	return v.formatURI(softRebootTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) FlattenURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(flattenTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) PauseURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(pauseTemplateURI, vmi)
 }
@@ -292,6 +302,14 @@ func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance)
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) MetricsSnapshotURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(metricsSnapshotTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) DevicesURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(devicesTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevFetchCertChainTemplateURI, vmi)
 }
@@ -26,6 +26,7 @@ package kubecli
 */
 
 import (
+	"context"
 	"time"
 
 	routev1 "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
@@ -72,6 +73,8 @@ type KubevirtClient interface {
 	VirtualMachineClusterPreference() instancetypev1beta1.VirtualMachineClusterPreferenceInterface
 	MigrationPolicy() migrationsv1.MigrationPolicyInterface
 	ExpandSpec(namespace string) ExpandSpecInterface
+	ClusterCapabilities() ClusterCapabilitiesInterface
+	Search(namespace string) SearchInterface
 	ServerVersion() ServerVersionInterface
 	VirtualMachineClone(namespace string) clonev1alpha1.VirtualMachineCloneInterface
 	ClusterProfiler() *ClusterProfiler
@@ -260,3 +263,12 @@ type ServerVersionInterface interface {
 type ExpandSpecInterface interface {
 	ForVirtualMachine(vm *v1.VirtualMachine) (*v1.VirtualMachine, error)
 }
+
+type ClusterCapabilitiesInterface interface {
+	Get(ctx context.Context) (*v1.ClusterCapabilities, error)
+}
+
+// SearchInterface searches the VMIs of a namespace, or of the cluster when the namespace is empty
+type SearchInterface interface {
+	VirtualMachineInstances(ctx context.Context, options *SearchOptions) (*v1.VirtualMachineInstanceList, error)
+}
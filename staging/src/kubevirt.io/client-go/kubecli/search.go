@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package kubecli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/client-go/rest"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// SearchOptions select the VMIs of a search. The VMIs match all the set options, and any of the
+// IP and MAC addresses.
+type SearchOptions struct {
+	// LabelSelector matches the labels of the VMIs
+	LabelSelector string
+	// GuestOS matches the ID or the name of the OS reported by the guest agent, ignoring the case
+	GuestOS string
+	// IPs match the IP addresses reported on the interfaces of the VMIs
+	IPs []string
+	// MACs match the MAC addresses reported on the interfaces of the VMIs
+	MACs []string
+}
+
+func (k *kubevirtClient) Search(namespace string) SearchInterface {
+	return &search{
+		restClient: k.restClient,
+		namespace:  namespace,
+	}
+}
+
+type search struct {
+	restClient *rest.RESTClient
+	namespace  string
+}
+
+func (s *search) VirtualMachineInstances(ctx context.Context, options *SearchOptions) (*v1.VirtualMachineInstanceList, error) {
+	uri := fmt.Sprintf("/apis/%s/%s/search", v1.SubresourceGroupName, v1.ApiStorageVersion)
+	if s.namespace != "" {
+		uri = fmt.Sprintf("/apis/%s/%s/namespaces/%s/search", v1.SubresourceGroupName, v1.ApiStorageVersion, s.namespace)
+	}
+
+	request := s.restClient.Get().AbsPath(uri)
+	if options != nil {
+		if options.LabelSelector != "" {
+			request.Param("labelSelector", options.LabelSelector)
+		}
+		if options.GuestOS != "" {
+			request.Param("guestOS", options.GuestOS)
+		}
+		for _, ip := range options.IPs {
+			request.Param("ip", ip)
+		}
+		for _, mac := range options.MACs {
+			request.Param("mac", mac)
+		}
+	}
+
+	rawResult, err := request.Do(ctx).Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	vmis := &v1.VirtualMachineInstanceList{}
+	if err := json.Unmarshal(rawResult, vmis); err != nil {
+		return nil, err
+	}
+	return vmis, nil
+}
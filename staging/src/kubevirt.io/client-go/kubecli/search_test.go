@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package kubecli
+
+import (
+	"context"
+	"net/http"
+	"path"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	k8sv1 "k8s.io/api/core/v1"
+
+	"kubevirt.io/client-go/api"
+)
+
+var _ = Describe("Kubevirt Search Client", func() {
+
+	var server *ghttp.Server
+	searchPath := "/apis/subresources.kubevirt.io/v1/search"
+	namespacedSearchPath := "/apis/subresources.kubevirt.io/v1/namespaces/default/search"
+	proxyPath := "/proxy/path"
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+
+	DescribeTable("should search the VMIs", func(proxyPath, namespace, searchPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		vmis := NewVMIList(*api.NewMinimalVMI("testvmi"))
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, searchPath),
+				"labelSelector=app%3Dweb&guestOS=fedora&ip=10.0.0.1&ip=fd10%3A%3A1&mac=02%3A00%3A00%3A00%3A00%3A01"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, vmis),
+		))
+		foundVMIs, err := client.Search(namespace).VirtualMachineInstances(context.Background(), &SearchOptions{
+			LabelSelector: "app=web",
+			GuestOS:       "fedora",
+			IPs:           []string{"10.0.0.1", "fd10::1"},
+			MACs:          []string{"02:00:00:00:00:01"},
+		})
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(foundVMIs).To(Equal(vmis))
+	},
+		Entry("of the cluster with regular server URL", "", k8sv1.NamespaceAll, searchPath),
+		Entry("of the cluster with proxied server URL", proxyPath, k8sv1.NamespaceAll, searchPath),
+		Entry("of a namespace with regular server URL", "", k8sv1.NamespaceDefault, namespacedSearchPath),
+		Entry("of a namespace with proxied server URL", proxyPath, k8sv1.NamespaceDefault, namespacedSearchPath),
+	)
+
+	It("should search without options", func() {
+		client, err := GetKubevirtClientFromFlags(server.URL(), "")
+		Expect(err).ToNot(HaveOccurred())
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", searchPath, ""),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, NewVMIList()),
+		))
+		foundVMIs, err := client.Search(k8sv1.NamespaceAll).VirtualMachineInstances(context.Background(), nil)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(foundVMIs.Items).To(BeEmpty())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+})
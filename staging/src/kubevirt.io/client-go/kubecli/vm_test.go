@@ -37,6 +37,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 	virtv1 "kubevirt.io/api/core/v1"
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
 )
 
 var _ = Describe("Kubevirt VirtualMachine Client", func() {
@@ -247,6 +248,50 @@ var _ = Describe("Kubevirt VirtualMachine Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should fetch the portal view of a VirtualMachine via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		portalView := &virtv1.VirtualMachinePortalView{
+			Name:            "testvm",
+			Namespace:       k8sv1.NamespaceDefault,
+			PrintableStatus: virtv1.VirtualMachineStatusRunning,
+		}
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMPath, "portalview")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, portalView),
+		))
+		fetchedView, err := client.VirtualMachine(k8sv1.NamespaceDefault).PortalView(context.Background(), "testvm")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedView).To(Equal(portalView))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
+	DescribeTable("should fetch the timeline of a VirtualMachine via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		timeline := &timelinev1alpha1.VirtualMachineTimeline{
+			Entries: []timelinev1alpha1.VirtualMachineTimelineEntry{
+				{Type: timelinev1alpha1.VirtualMachineTimelineEntryEvent, Reason: "SuccessfulCreate", Count: 1},
+			},
+		}
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMPath, "timeline")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, timeline),
+		))
+		fetchedTimeline, err := client.VirtualMachine(k8sv1.NamespaceDefault).Timeline(context.Background(), "testvm")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedTimeline).To(Equal(timeline))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	AfterEach(func() {
 		server.Close()
 	})
@@ -90,6 +90,13 @@ type connectionStruct struct {
 }
 
 func (v *vmis) SerialConsole(name string, options *kvcorev1.SerialConsoleOptions) (kvcorev1.StreamInterface, error) {
+	queryParams := url.Values{}
+	if options != nil && options.Shared {
+		queryParams.Set("shared", "true")
+		if options.ReadOnly {
+			queryParams.Set("role", "read-only")
+		}
+	}
 
 	if options != nil && options.ConnectionTimeout != 0 {
 		timeoutChan := time.Tick(options.ConnectionTimeout)
@@ -108,7 +115,7 @@ func (v *vmis) SerialConsole(name string, options *kvcorev1.SerialConsoleOptions
 				default:
 				}
 
-				con, err := kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", url.Values{})
+				con, err := kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", queryParams)
 				if err != nil {
 					asyncSubresourceError, ok := err.(*kvcorev1.AsyncSubresourceError)
 					// return if response status code does not equal to 400
@@ -128,7 +135,7 @@ func (v *vmis) SerialConsole(name string, options *kvcorev1.SerialConsoleOptions
 		conStruct := <-connectionChan
 		return conStruct.con, conStruct.err
 	} else {
-		return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", url.Values{})
+		return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", queryParams)
 	}
 }
 
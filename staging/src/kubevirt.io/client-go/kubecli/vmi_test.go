@@ -513,6 +513,49 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should fetch the device list of a VirtualMachineInstance via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		deviceList := &v1.VirtualMachineInstanceDeviceList{
+			Disks: []v1.AttachedDisk{{Name: "rootdisk", Target: "vda"}},
+		}
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMIPath, "devices")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, deviceList),
+		))
+		fetchedList, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).DeviceList(context.Background(), "testvm")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedList).To(Equal(deviceList))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
+	DescribeTable("should fetch the portal view of a VirtualMachineInstance via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		portalView := &v1.VirtualMachinePortalView{
+			Name:      "testvm",
+			Namespace: k8sv1.NamespaceDefault,
+			Phase:     v1.Running,
+			Ready:     true,
+		}
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMIPath, "portalview")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, portalView),
+		))
+		fetchedView, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).PortalView(context.Background(), "testvm")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedView).To(Equal(portalView))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	AfterEach(func() {
 		server.Close()
 	})
@@ -25,48 +25,71 @@ import (
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
+	breakglassv1alpha1 "kubevirt.io/client-go/kubevirt/typed/breakglass/v1alpha1"
 	clonev1alpha1 "kubevirt.io/client-go/kubevirt/typed/clone/v1alpha1"
 	kubevirtv1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	diskcheckv1alpha1 "kubevirt.io/client-go/kubevirt/typed/diskcheck/v1alpha1"
 	exportv1alpha1 "kubevirt.io/client-go/kubevirt/typed/export/v1alpha1"
 	exportv1beta1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
+	guestagentv1alpha1 "kubevirt.io/client-go/kubevirt/typed/guestagent/v1alpha1"
+	hostdevicesv1alpha1 "kubevirt.io/client-go/kubevirt/typed/hostdevices/v1alpha1"
 	instancetypev1alpha1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
 	migrationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
+	notificationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/notifications/v1alpha1"
 	poolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1alpha1"
 	snapshotv1beta1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
+	timelinev1alpha1 "kubevirt.io/client-go/kubevirt/typed/timeline/v1alpha1"
 )
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
+	BreakglassV1alpha1() breakglassv1alpha1.BreakglassV1alpha1Interface
 	CloneV1alpha1() clonev1alpha1.CloneV1alpha1Interface
 	KubevirtV1() kubevirtv1.KubevirtV1Interface
+	DiskcheckV1alpha1() diskcheckv1alpha1.DiskcheckV1alpha1Interface
 	ExportV1alpha1() exportv1alpha1.ExportV1alpha1Interface
 	ExportV1beta1() exportv1beta1.ExportV1beta1Interface
+	GuestagentV1alpha1() guestagentv1alpha1.GuestagentV1alpha1Interface
+	HostdevicesV1alpha1() hostdevicesv1alpha1.HostdevicesV1alpha1Interface
 	InstancetypeV1alpha1() instancetypev1alpha1.InstancetypeV1alpha1Interface
 	InstancetypeV1alpha2() instancetypev1alpha2.InstancetypeV1alpha2Interface
 	InstancetypeV1beta1() instancetypev1beta1.InstancetypeV1beta1Interface
 	MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface
+	NotificationsV1alpha1() notificationsv1alpha1.NotificationsV1alpha1Interface
 	PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface
 	SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interface
 	SnapshotV1beta1() snapshotv1beta1.SnapshotV1beta1Interface
+	TimelineV1alpha1() timelinev1alpha1.TimelineV1alpha1Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
-	cloneV1alpha1        *clonev1alpha1.CloneV1alpha1Client
-	kubevirtV1           *kubevirtv1.KubevirtV1Client
-	exportV1alpha1       *exportv1alpha1.ExportV1alpha1Client
-	exportV1beta1        *exportv1beta1.ExportV1beta1Client
-	instancetypeV1alpha1 *instancetypev1alpha1.InstancetypeV1alpha1Client
-	instancetypeV1alpha2 *instancetypev1alpha2.InstancetypeV1alpha2Client
-	instancetypeV1beta1  *instancetypev1beta1.InstancetypeV1beta1Client
-	migrationsV1alpha1   *migrationsv1alpha1.MigrationsV1alpha1Client
-	poolV1alpha1         *poolv1alpha1.PoolV1alpha1Client
-	snapshotV1alpha1     *snapshotv1alpha1.SnapshotV1alpha1Client
-	snapshotV1beta1      *snapshotv1beta1.SnapshotV1beta1Client
+	breakglassV1alpha1    *breakglassv1alpha1.BreakglassV1alpha1Client
+	cloneV1alpha1         *clonev1alpha1.CloneV1alpha1Client
+	kubevirtV1            *kubevirtv1.KubevirtV1Client
+	diskcheckV1alpha1     *diskcheckv1alpha1.DiskcheckV1alpha1Client
+	exportV1alpha1        *exportv1alpha1.ExportV1alpha1Client
+	exportV1beta1         *exportv1beta1.ExportV1beta1Client
+	guestagentV1alpha1    *guestagentv1alpha1.GuestagentV1alpha1Client
+	hostdevicesV1alpha1   *hostdevicesv1alpha1.HostdevicesV1alpha1Client
+	instancetypeV1alpha1  *instancetypev1alpha1.InstancetypeV1alpha1Client
+	instancetypeV1alpha2  *instancetypev1alpha2.InstancetypeV1alpha2Client
+	instancetypeV1beta1   *instancetypev1beta1.InstancetypeV1beta1Client
+	migrationsV1alpha1    *migrationsv1alpha1.MigrationsV1alpha1Client
+	notificationsV1alpha1 *notificationsv1alpha1.NotificationsV1alpha1Client
+	poolV1alpha1          *poolv1alpha1.PoolV1alpha1Client
+	snapshotV1alpha1      *snapshotv1alpha1.SnapshotV1alpha1Client
+	snapshotV1beta1       *snapshotv1beta1.SnapshotV1beta1Client
+	timelineV1alpha1      *timelinev1alpha1.TimelineV1alpha1Client
+}
+
+// BreakglassV1alpha1 retrieves the BreakglassV1alpha1Client
+func (c *Clientset) BreakglassV1alpha1() breakglassv1alpha1.BreakglassV1alpha1Interface {
+	return c.breakglassV1alpha1
 }
 
 // CloneV1alpha1 retrieves the CloneV1alpha1Client
@@ -79,6 +102,11 @@ func (c *Clientset) KubevirtV1() kubevirtv1.KubevirtV1Interface {
 	return c.kubevirtV1
 }
 
+// DiskcheckV1alpha1 retrieves the DiskcheckV1alpha1Client
+func (c *Clientset) DiskcheckV1alpha1() diskcheckv1alpha1.DiskcheckV1alpha1Interface {
+	return c.diskcheckV1alpha1
+}
+
 // ExportV1alpha1 retrieves the ExportV1alpha1Client
 func (c *Clientset) ExportV1alpha1() exportv1alpha1.ExportV1alpha1Interface {
 	return c.exportV1alpha1
@@ -89,6 +117,16 @@ func (c *Clientset) ExportV1beta1() exportv1beta1.ExportV1beta1Interface {
 	return c.exportV1beta1
 }
 
+// GuestagentV1alpha1 retrieves the GuestagentV1alpha1Client
+func (c *Clientset) GuestagentV1alpha1() guestagentv1alpha1.GuestagentV1alpha1Interface {
+	return c.guestagentV1alpha1
+}
+
+// HostdevicesV1alpha1 retrieves the HostdevicesV1alpha1Client
+func (c *Clientset) HostdevicesV1alpha1() hostdevicesv1alpha1.HostdevicesV1alpha1Interface {
+	return c.hostdevicesV1alpha1
+}
+
 // InstancetypeV1alpha1 retrieves the InstancetypeV1alpha1Client
 func (c *Clientset) InstancetypeV1alpha1() instancetypev1alpha1.InstancetypeV1alpha1Interface {
 	return c.instancetypeV1alpha1
@@ -109,6 +147,11 @@ func (c *Clientset) MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1In
 	return c.migrationsV1alpha1
 }
 
+// NotificationsV1alpha1 retrieves the NotificationsV1alpha1Client
+func (c *Clientset) NotificationsV1alpha1() notificationsv1alpha1.NotificationsV1alpha1Interface {
+	return c.notificationsV1alpha1
+}
+
 // PoolV1alpha1 retrieves the PoolV1alpha1Client
 func (c *Clientset) PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface {
 	return c.poolV1alpha1
@@ -124,6 +167,11 @@ func (c *Clientset) SnapshotV1beta1() snapshotv1beta1.SnapshotV1beta1Interface {
 	return c.snapshotV1beta1
 }
 
+// TimelineV1alpha1 retrieves the TimelineV1alpha1Client
+func (c *Clientset) TimelineV1alpha1() timelinev1alpha1.TimelineV1alpha1Interface {
+	return c.timelineV1alpha1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -168,6 +216,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 
 	var cs Clientset
 	var err error
+	cs.breakglassV1alpha1, err = breakglassv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.cloneV1alpha1, err = clonev1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -176,6 +228,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.diskcheckV1alpha1, err = diskcheckv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.exportV1alpha1, err = exportv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -184,6 +240,14 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.guestagentV1alpha1, err = guestagentv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	cs.hostdevicesV1alpha1, err = hostdevicesv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.instancetypeV1alpha1, err = instancetypev1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -200,6 +264,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.notificationsV1alpha1, err = notificationsv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.poolV1alpha1, err = poolv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -212,6 +280,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.timelineV1alpha1, err = timelinev1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
@@ -233,17 +305,23 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 // New creates a new Clientset for the given RESTClient.
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
+	cs.breakglassV1alpha1 = breakglassv1alpha1.New(c)
 	cs.cloneV1alpha1 = clonev1alpha1.New(c)
 	cs.kubevirtV1 = kubevirtv1.New(c)
+	cs.diskcheckV1alpha1 = diskcheckv1alpha1.New(c)
 	cs.exportV1alpha1 = exportv1alpha1.New(c)
 	cs.exportV1beta1 = exportv1beta1.New(c)
+	cs.guestagentV1alpha1 = guestagentv1alpha1.New(c)
+	cs.hostdevicesV1alpha1 = hostdevicesv1alpha1.New(c)
 	cs.instancetypeV1alpha1 = instancetypev1alpha1.New(c)
 	cs.instancetypeV1alpha2 = instancetypev1alpha2.New(c)
 	cs.instancetypeV1beta1 = instancetypev1beta1.New(c)
 	cs.migrationsV1alpha1 = migrationsv1alpha1.New(c)
+	cs.notificationsV1alpha1 = notificationsv1alpha1.New(c)
 	cs.poolV1alpha1 = poolv1alpha1.New(c)
 	cs.snapshotV1alpha1 = snapshotv1alpha1.New(c)
 	cs.snapshotV1beta1 = snapshotv1beta1.New(c)
+	cs.timelineV1alpha1 = timelinev1alpha1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
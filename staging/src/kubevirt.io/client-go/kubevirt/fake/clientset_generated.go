@@ -25,14 +25,22 @@ import (
 	fakediscovery "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/testing"
 	clientset "kubevirt.io/client-go/kubevirt"
+	breakglassv1alpha1 "kubevirt.io/client-go/kubevirt/typed/breakglass/v1alpha1"
+	fakebreakglassv1alpha1 "kubevirt.io/client-go/kubevirt/typed/breakglass/v1alpha1/fake"
 	clonev1alpha1 "kubevirt.io/client-go/kubevirt/typed/clone/v1alpha1"
 	fakeclonev1alpha1 "kubevirt.io/client-go/kubevirt/typed/clone/v1alpha1/fake"
 	kubevirtv1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	fakekubevirtv1 "kubevirt.io/client-go/kubevirt/typed/core/v1/fake"
+	diskcheckv1alpha1 "kubevirt.io/client-go/kubevirt/typed/diskcheck/v1alpha1"
+	fakediskcheckv1alpha1 "kubevirt.io/client-go/kubevirt/typed/diskcheck/v1alpha1/fake"
 	exportv1alpha1 "kubevirt.io/client-go/kubevirt/typed/export/v1alpha1"
 	fakeexportv1alpha1 "kubevirt.io/client-go/kubevirt/typed/export/v1alpha1/fake"
 	exportv1beta1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	fakeexportv1beta1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1/fake"
+	guestagentv1alpha1 "kubevirt.io/client-go/kubevirt/typed/guestagent/v1alpha1"
+	fakeguestagentv1alpha1 "kubevirt.io/client-go/kubevirt/typed/guestagent/v1alpha1/fake"
+	hostdevicesv1alpha1 "kubevirt.io/client-go/kubevirt/typed/hostdevices/v1alpha1"
+	fakehostdevicesv1alpha1 "kubevirt.io/client-go/kubevirt/typed/hostdevices/v1alpha1/fake"
 	instancetypev1alpha1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1alpha1"
 	fakeinstancetypev1alpha1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1alpha1/fake"
 	instancetypev1alpha2 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1alpha2"
@@ -41,12 +49,16 @@ import (
 	fakeinstancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1/fake"
 	migrationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
 	fakemigrationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1/fake"
+	notificationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/notifications/v1alpha1"
+	fakenotificationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/notifications/v1alpha1/fake"
 	poolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
 	fakepoolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1/fake"
 	snapshotv1alpha1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1alpha1"
 	fakesnapshotv1alpha1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1alpha1/fake"
 	snapshotv1beta1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
 	fakesnapshotv1beta1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1/fake"
+	timelinev1alpha1 "kubevirt.io/client-go/kubevirt/typed/timeline/v1alpha1"
+	faketimelinev1alpha1 "kubevirt.io/client-go/kubevirt/typed/timeline/v1alpha1/fake"
 )
 
 // NewSimpleClientset returns a clientset that will respond with the provided objects.
@@ -103,6 +115,11 @@ var (
 	_ testing.FakeClient  = &Clientset{}
 )
 
+// BreakglassV1alpha1 retrieves the BreakglassV1alpha1Client
+func (c *Clientset) BreakglassV1alpha1() breakglassv1alpha1.BreakglassV1alpha1Interface {
+	return &fakebreakglassv1alpha1.FakeBreakglassV1alpha1{Fake: &c.Fake}
+}
+
 // CloneV1alpha1 retrieves the CloneV1alpha1Client
 func (c *Clientset) CloneV1alpha1() clonev1alpha1.CloneV1alpha1Interface {
 	return &fakeclonev1alpha1.FakeCloneV1alpha1{Fake: &c.Fake}
@@ -113,6 +130,11 @@ func (c *Clientset) KubevirtV1() kubevirtv1.KubevirtV1Interface {
 	return &fakekubevirtv1.FakeKubevirtV1{Fake: &c.Fake}
 }
 
+// DiskcheckV1alpha1 retrieves the DiskcheckV1alpha1Client
+func (c *Clientset) DiskcheckV1alpha1() diskcheckv1alpha1.DiskcheckV1alpha1Interface {
+	return &fakediskcheckv1alpha1.FakeDiskcheckV1alpha1{Fake: &c.Fake}
+}
+
 // ExportV1alpha1 retrieves the ExportV1alpha1Client
 func (c *Clientset) ExportV1alpha1() exportv1alpha1.ExportV1alpha1Interface {
 	return &fakeexportv1alpha1.FakeExportV1alpha1{Fake: &c.Fake}
@@ -123,6 +145,16 @@ func (c *Clientset) ExportV1beta1() exportv1beta1.ExportV1beta1Interface {
 	return &fakeexportv1beta1.FakeExportV1beta1{Fake: &c.Fake}
 }
 
+// GuestagentV1alpha1 retrieves the GuestagentV1alpha1Client
+func (c *Clientset) GuestagentV1alpha1() guestagentv1alpha1.GuestagentV1alpha1Interface {
+	return &fakeguestagentv1alpha1.FakeGuestagentV1alpha1{Fake: &c.Fake}
+}
+
+// HostdevicesV1alpha1 retrieves the HostdevicesV1alpha1Client
+func (c *Clientset) HostdevicesV1alpha1() hostdevicesv1alpha1.HostdevicesV1alpha1Interface {
+	return &fakehostdevicesv1alpha1.FakeHostdevicesV1alpha1{Fake: &c.Fake}
+}
+
 // InstancetypeV1alpha1 retrieves the InstancetypeV1alpha1Client
 func (c *Clientset) InstancetypeV1alpha1() instancetypev1alpha1.InstancetypeV1alpha1Interface {
 	return &fakeinstancetypev1alpha1.FakeInstancetypeV1alpha1{Fake: &c.Fake}
@@ -143,6 +175,11 @@ func (c *Clientset) MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1In
 	return &fakemigrationsv1alpha1.FakeMigrationsV1alpha1{Fake: &c.Fake}
 }
 
+// NotificationsV1alpha1 retrieves the NotificationsV1alpha1Client
+func (c *Clientset) NotificationsV1alpha1() notificationsv1alpha1.NotificationsV1alpha1Interface {
+	return &fakenotificationsv1alpha1.FakeNotificationsV1alpha1{Fake: &c.Fake}
+}
+
 // PoolV1alpha1 retrieves the PoolV1alpha1Client
 func (c *Clientset) PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface {
 	return &fakepoolv1alpha1.FakePoolV1alpha1{Fake: &c.Fake}
@@ -157,3 +194,8 @@ func (c *Clientset) SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interfac
 func (c *Clientset) SnapshotV1beta1() snapshotv1beta1.SnapshotV1beta1Interface {
 	return &fakesnapshotv1beta1.FakeSnapshotV1beta1{Fake: &c.Fake}
 }
+
+// TimelineV1alpha1 retrieves the TimelineV1alpha1Client
+func (c *Clientset) TimelineV1alpha1() timelinev1alpha1.TimelineV1alpha1Interface {
+	return &faketimelinev1alpha1.FakeTimelineV1alpha1{Fake: &c.Fake}
+}
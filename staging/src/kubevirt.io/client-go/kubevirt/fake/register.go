@@ -24,34 +24,46 @@ import (
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	serializer "k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	breakglassv1alpha1 "kubevirt.io/api/breakglass/v1alpha1"
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	diskcheckv1alpha1 "kubevirt.io/api/diskcheck/v1alpha1"
 	exportv1alpha1 "kubevirt.io/api/export/v1alpha1"
 	exportv1beta1 "kubevirt.io/api/export/v1beta1"
+	guestagentv1alpha1 "kubevirt.io/api/guestagent/v1alpha1"
+	hostdevicesv1alpha1 "kubevirt.io/api/hostdevices/v1alpha1"
 	instancetypev1alpha1 "kubevirt.io/api/instancetype/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+	notificationsv1alpha1 "kubevirt.io/api/notifications/v1alpha1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
 	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
 )
 
 var scheme = runtime.NewScheme()
 var codecs = serializer.NewCodecFactory(scheme)
 
 var localSchemeBuilder = runtime.SchemeBuilder{
+	breakglassv1alpha1.AddToScheme,
 	clonev1alpha1.AddToScheme,
 	kubevirtv1.AddToScheme,
+	diskcheckv1alpha1.AddToScheme,
 	exportv1alpha1.AddToScheme,
 	exportv1beta1.AddToScheme,
+	guestagentv1alpha1.AddToScheme,
+	hostdevicesv1alpha1.AddToScheme,
 	instancetypev1alpha1.AddToScheme,
 	instancetypev1alpha2.AddToScheme,
 	instancetypev1beta1.AddToScheme,
 	migrationsv1alpha1.AddToScheme,
+	notificationsv1alpha1.AddToScheme,
 	poolv1alpha1.AddToScheme,
 	snapshotv1alpha1.AddToScheme,
 	snapshotv1beta1.AddToScheme,
+	timelinev1alpha1.AddToScheme,
 }
 
 // AddToScheme adds all types of this clientset into the given scheme. This allows composition
@@ -27,6 +27,7 @@ import (
 	"k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
 	kubevirtv1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	fake2 "kubevirt.io/client-go/testing"
 )
@@ -87,6 +88,43 @@ func (c *FakeVirtualMachines) RemoveMemoryDump(ctx context.Context, name string)
 	return err
 }
 
+func (c *FakeVirtualMachines) RenewLease(ctx context.Context, name string, renewLeaseOptions *v1.RenewLeaseOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "renewlease", name, renewLeaseOptions), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachines) Modernize(ctx context.Context, name string, modernizeOptions *v1.ModernizeOptions) (*v1.VirtualMachineModernization, error) {
+	obj, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "modernize", name, modernizeOptions), &v1.VirtualMachineModernization{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.VirtualMachineModernization), err
+}
+
+func (c *FakeVirtualMachines) PortalView(ctx context.Context, name string) (*v1.VirtualMachinePortalView, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachinesResource, c.ns, "portalview", name), &v1.VirtualMachinePortalView{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.VirtualMachinePortalView), err
+}
+
+func (c *FakeVirtualMachines) Timeline(ctx context.Context, name string) (*timelinev1alpha1.VirtualMachineTimeline, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachinesResource, c.ns, "timeline", name), &timelinev1alpha1.VirtualMachineTimeline{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*timelinev1alpha1.VirtualMachineTimeline), err
+}
+
 func (c *FakeVirtualMachines) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "addvolume", name, addVolumeOptions), nil)
@@ -121,6 +121,20 @@ func (c *FakeVirtualMachineInstances) RemoveVolume(ctx context.Context, name str
 	return err
 }
 
+func (c *FakeVirtualMachineInstances) AddChannel(ctx context.Context, name string, addChannelOptions *v1.AddChannelOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "addchannel", name, addChannelOptions), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachineInstances) Flatten(ctx context.Context, name string, flattenOptions *v1.FlattenOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "flatten", name, flattenOptions), nil)
+
+	return err
+}
+
 func (c *FakeVirtualMachineInstances) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInterface, error) {
 	return nil, nil
 }
@@ -152,3 +166,33 @@ func (c *FakeVirtualMachineInstances) SEVInjectLaunchSecret(ctx context.Context,
 
 	return err
 }
+
+func (c *FakeVirtualMachineInstances) VerifyIdentity(ctx context.Context, name string, document *v1.SignedIdentityDocument) (*v1.VirtualMachineInstanceIdentity, error) {
+	obj, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "verifyidentity", name, document), &v1.VirtualMachineInstanceIdentity{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.VirtualMachineInstanceIdentity), err
+}
+
+func (c *FakeVirtualMachineInstances) DeviceList(ctx context.Context, name string) (*v1.VirtualMachineInstanceDeviceList, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "devices", name), &v1.VirtualMachineInstanceDeviceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.VirtualMachineInstanceDeviceList), err
+}
+
+func (c *FakeVirtualMachineInstances) PortalView(ctx context.Context, name string) (*v1.VirtualMachinePortalView, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "portalview", name), &v1.VirtualMachinePortalView{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.VirtualMachinePortalView), err
+}
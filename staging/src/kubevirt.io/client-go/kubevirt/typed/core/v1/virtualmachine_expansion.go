@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	v1 "kubevirt.io/api/core/v1"
+	timelinev1alpha1 "kubevirt.io/api/timeline/v1alpha1"
 )
 
 const (
@@ -46,6 +47,10 @@ type VirtualMachineExpansion interface {
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error
 	RemoveMemoryDump(ctx context.Context, name string) error
+	RenewLease(ctx context.Context, name string, renewLeaseOptions *v1.RenewLeaseOptions) error
+	Modernize(ctx context.Context, name string, modernizeOptions *v1.ModernizeOptions) (*v1.VirtualMachineModernization, error)
+	PortalView(ctx context.Context, name string) (*v1.VirtualMachinePortalView, error)
+	Timeline(ctx context.Context, name string) (*timelinev1alpha1.VirtualMachineTimeline, error)
 }
 
 func (c *virtualMachines) GetWithExpandedSpec(ctx context.Context, name string) (*v1.VirtualMachine, error) {
@@ -197,3 +202,89 @@ func (c *virtualMachines) RemoveMemoryDump(ctx context.Context, name string) err
 		Do(ctx).
 		Error()
 }
+
+func (c *virtualMachines) RenewLease(ctx context.Context, name string, renewLeaseOptions *v1.RenewLeaseOptions) error {
+	body, err := json.Marshal(renewLeaseOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("renewlease").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachines) Modernize(ctx context.Context, name string, modernizeOptions *v1.ModernizeOptions) (*v1.VirtualMachineModernization, error) {
+	body, err := json.Marshal(modernizeOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	// The result is not registered in the scheme, see GuestOsInfo
+	rawResult, err := c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("modernize").
+		Body(body).
+		Do(ctx).
+		Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1.VirtualMachineModernization{}
+	if err := json.Unmarshal(rawResult, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *virtualMachines) PortalView(ctx context.Context, name string) (*v1.VirtualMachinePortalView, error) {
+	// The result is not registered in the scheme, see GuestOsInfo
+	rawResult, err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("portalview").
+		Do(ctx).
+		Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	portalView := &v1.VirtualMachinePortalView{}
+	if err := json.Unmarshal(rawResult, portalView); err != nil {
+		return nil, err
+	}
+	return portalView, nil
+}
+
+func (c *virtualMachines) Timeline(ctx context.Context, name string) (*timelinev1alpha1.VirtualMachineTimeline, error) {
+	// The result is not registered in the scheme, see GuestOsInfo
+	rawResult, err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("timeline").
+		Do(ctx).
+		Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := &timelinev1alpha1.VirtualMachineTimeline{}
+	if err := json.Unmarshal(rawResult, timeline); err != nil {
+		return nil, err
+	}
+	return timeline, nil
+}
@@ -35,6 +35,11 @@ const vmiSubresourceURL = "/apis/subresources.kubevirt.io/%s"
 
 type SerialConsoleOptions struct {
 	ConnectionTimeout time.Duration
+	// Shared attaches to the console shared with the other users connected with Shared,
+	// instead of taking the console over
+	Shared bool
+	// ReadOnly attaches to a shared console without sending the input to the console
+	ReadOnly bool
 }
 
 type VirtualMachineInstanceExpansion interface {
@@ -53,11 +58,16 @@ type VirtualMachineInstanceExpansion interface {
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
+	AddChannel(ctx context.Context, name string, addChannelOptions *v1.AddChannelOptions) error
+	Flatten(ctx context.Context, name string, flattenOptions *v1.FlattenOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
 	SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error)
 	SEVQueryLaunchMeasurement(ctx context.Context, name string) (v1.SEVMeasurementInfo, error)
 	SEVSetupSession(ctx context.Context, name string, sevSessionOptions *v1.SEVSessionOptions) error
 	SEVInjectLaunchSecret(ctx context.Context, name string, sevSecretOptions *v1.SEVSecretOptions) error
+	VerifyIdentity(ctx context.Context, name string, document *v1.SignedIdentityDocument) (*v1.VirtualMachineInstanceIdentity, error)
+	DeviceList(ctx context.Context, name string) (*v1.VirtualMachineInstanceDeviceList, error)
+	PortalView(ctx context.Context, name string) (*v1.VirtualMachinePortalView, error)
 }
 
 func (c *virtualMachineInstances) SerialConsole(name string, options *SerialConsoleOptions) (StreamInterface, error) {
@@ -296,6 +306,40 @@ func (c *virtualMachineInstances) RemoveVolume(ctx context.Context, name string,
 		Error()
 }
 
+func (c *virtualMachineInstances) AddChannel(ctx context.Context, name string, addChannelOptions *v1.AddChannelOptions) error {
+	body, err := json.Marshal(addChannelOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("addchannel").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachineInstances) Flatten(ctx context.Context, name string, flattenOptions *v1.FlattenOptions) error {
+	body, err := json.Marshal(flattenOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("flatten").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error) {
 	// TODO not implemented yet
 	//  requires clientConfig
@@ -362,3 +406,65 @@ func (c *virtualMachineInstances) SEVInjectLaunchSecret(ctx context.Context, nam
 		Do(context.Background()).
 		Error()
 }
+
+func (c *virtualMachineInstances) VerifyIdentity(ctx context.Context, name string, document *v1.SignedIdentityDocument) (*v1.VirtualMachineInstanceIdentity, error) {
+	body, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &v1.VirtualMachineInstanceIdentity{}
+	err = c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("verifyidentity").
+		Body(body).
+		Do(ctx).
+		Into(identity)
+
+	return identity, err
+}
+
+func (c *virtualMachineInstances) DeviceList(ctx context.Context, name string) (*v1.VirtualMachineInstanceDeviceList, error) {
+	// The result is not registered in the scheme, see GuestOsInfo
+	rawResult, err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("devices").
+		Do(ctx).
+		Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	deviceList := &v1.VirtualMachineInstanceDeviceList{}
+	if err := json.Unmarshal(rawResult, deviceList); err != nil {
+		return nil, err
+	}
+	return deviceList, nil
+}
+
+func (c *virtualMachineInstances) PortalView(ctx context.Context, name string) (*v1.VirtualMachinePortalView, error) {
+	// The result is not registered in the scheme, see GuestOsInfo
+	rawResult, err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("portalview").
+		Do(ctx).
+		Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	portalView := &v1.VirtualMachinePortalView{}
+	if err := json.Unmarshal(rawResult, portalView); err != nil {
+		return nil, err
+	}
+	return portalView, nil
+}
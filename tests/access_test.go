@@ -446,6 +446,10 @@ var _ = Describe("[rfe_id:500][crit:high][arm64][vendor:cnv-qe@redhat.com][level
 				"virtualmachineinstances", "usbredir",
 				allowGetFor("admin", "edit"),
 				denyAllFor("view", "default")),
+			Entry("on vmi verifyidentity",
+				"virtualmachineinstances", "verifyidentity",
+				allowUpdateFor("admin", "edit"),
+				denyAllFor("view", "default")),
 		)
 	})
 })
@@ -5,6 +5,7 @@ import (
 
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
 
+	hooksidecar "kubevirt.io/kubevirt/pkg/monitoring/metrics/hook-sidecar"
 	virtapi "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
 	virtcontroller "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	virthandler "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
@@ -41,7 +42,7 @@ this document.
 `
 
 func main() {
-	if err := virtcontroller.SetupMetrics(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil); err != nil {
+	if err := virtcontroller.SetupMetrics(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil); err != nil {
 		panic(err)
 	}
 
@@ -65,6 +66,10 @@ func main() {
 		panic(err)
 	}
 
+	if err := hooksidecar.SetupMetrics(); err != nil {
+		panic(err)
+	}
+
 	if err := rules.SetupRules(""); err != nil {
 		panic(err)
 	}